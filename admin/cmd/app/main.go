@@ -9,6 +9,7 @@ import (
 	"github.com/artem13815/hr/admin/config"
 	"github.com/artem13815/hr/admin/internal/bootstrap"
 	"github.com/artem13815/hr/admin/internal/infrastructure/auth_client"
+	"github.com/artem13815/hr/admin/internal/infrastructure/ownership_client"
 )
 
 func main() {
@@ -37,12 +38,27 @@ func run() error {
 		return err
 	}
 
-	service := bootstrap.InitAdminService(storage, auth_client.NewRoleUpdater(authClient))
+	vacancyClient, vacancyCleanup, err := ownership_client.NewVacancyClient(cfg.Vacancy.GRPCAddr)
+	if err != nil {
+		authCleanup()
+		storage.Close()
+		return err
+	}
+
+	resumeClient, resumeCleanup, err := ownership_client.NewResumeClient(cfg.Resume.GRPCAddr)
+	if err != nil {
+		vacancyCleanup()
+		authCleanup()
+		storage.Close()
+		return err
+	}
+
+	service := bootstrap.InitAdminService(storage, auth_client.NewRoleUpdater(authClient), vacancyClient, resumeClient)
 	api := bootstrap.InitAdminServiceAPI(service)
 
-	// Hooks run LIFO during shutdown — close the auth conn before the
+	// Hooks run LIFO during shutdown — close the downstream conns before the
 	// pgxpool, mirroring construction order.
-	return bootstrap.AppRun(api, authClient, cfg, storage.Close, authCleanup)
+	return bootstrap.AppRun(api, authClient, cfg, storage.Close, authCleanup, vacancyCleanup, resumeCleanup)
 }
 
 func defaultConfigPathByEnv(appEnv string) string {
@@ -13,6 +13,8 @@ import (
 type Config struct {
 	Database DatabaseConfig `yaml:"database"`
 	Auth     AuthClientCfg  `yaml:"auth"`
+	Vacancy  ServiceCfg     `yaml:"vacancy"`
+	Resume   ServiceCfg     `yaml:"resume"`
 	Server   ServerConfig   `yaml:"server"`
 }
 
@@ -29,6 +31,11 @@ type AuthClientCfg struct {
 	GRPCAddr string `yaml:"grpc_addr"`
 }
 
+// ServiceCfg points at a domain service admin calls for TransferOwnership.
+type ServiceCfg struct {
+	GRPCAddr string `yaml:"grpc_addr"`
+}
+
 type ServerConfig struct {
 	GRPCAddr string    `yaml:"grpc_addr"`
 	TLS      TLSConfig `yaml:"tls"`
@@ -77,6 +84,12 @@ func (c *Config) validate() error {
 	if c.Auth.GRPCAddr == "" {
 		return errors.New("auth.grpc_addr is required")
 	}
+	if c.Vacancy.GRPCAddr == "" {
+		return errors.New("vacancy.grpc_addr is required")
+	}
+	if c.Resume.GRPCAddr == "" {
+		return errors.New("resume.grpc_addr is required")
+	}
 	if c.Server.GRPCAddr == "" {
 		return errors.New("server.grpc_addr is required")
 	}
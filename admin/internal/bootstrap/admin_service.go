@@ -5,6 +5,6 @@ import (
 	"github.com/artem13815/hr/admin/internal/usecase"
 )
 
-func InitAdminService(storage *persistence.AdminStorage, authClient usecase.AuthClient) *usecase.AdminService {
-	return usecase.NewAdminService(storage, authClient)
+func InitAdminService(storage *persistence.AdminStorage, authClient usecase.AuthClient, vacancies usecase.VacancyClient, resumes usecase.ResumeClient) *usecase.AdminService {
+	return usecase.NewAdminService(storage, authClient, vacancies, resumes)
 }
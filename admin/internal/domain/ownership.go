@@ -0,0 +1,75 @@
+package domain
+
+import (
+	"errors"
+	"time"
+)
+
+// Transfer scopes: what TransferOwnership moves.
+const (
+	TransferScopeAll        = "all"
+	TransferScopeVacancies  = "vacancies"
+	TransferScopeCandidates = "candidates"
+)
+
+// Transfer statuses. A transfer is unfinished while running or failed; the
+// next TransferOwnership call with the same users and scope resumes a failed
+// one, one with another target or scope abandons it.
+const (
+	TransferStatusRunning   = "running"
+	TransferStatusCompleted = "completed"
+	TransferStatusFailed    = "failed"
+	TransferStatusAbandoned = "abandoned"
+)
+
+// TransferStaleAfter is how long a running transfer may go without
+// progress before it is taken for dead (its caller crashed or was cut off)
+// and can be resumed or abandoned like a failed one. Progress is recorded
+// after every batch, and one batch is far quicker than this.
+const TransferStaleAfter = 10 * time.Minute
+
+// ErrTransferInProgress is returned when the user being transferred from
+// already has a transfer running.
+var ErrTransferInProgress = errors.New("ownership transfer in progress")
+
+// ErrUserNotFound is returned when the user being transferred to has no
+// account.
+var ErrUserNotFound = errors.New("user not found")
+
+// IsKnownTransferScope reports whether scope is one of the TransferScope
+// constants.
+func IsKnownTransferScope(scope string) bool {
+	switch scope {
+	case TransferScopeAll, TransferScopeVacancies, TransferScopeCandidates:
+		return true
+	}
+	return false
+}
+
+// TransferOwnershipInput hands everything FromUserID owns within Scope to
+// ToUserID. Scope defaults to TransferScopeAll.
+type TransferOwnershipInput struct {
+	CallerUserID uint64
+	IsAdmin      bool
+	FromUserID   uint64
+	ToUserID     uint64
+	Scope        string
+}
+
+// OwnershipTransfer is the audit entry of one transfer. The counters add up
+// over every call that worked on it, resumed ones included.
+type OwnershipTransfer struct {
+	ID                    uint64
+	ActorUserID           uint64
+	FromUserID            uint64
+	ToUserID              uint64
+	Scope                 string
+	Status                string
+	VacanciesTransferred  uint64
+	CandidatesTransferred uint64
+	// Error is the last failure of a failed transfer.
+	Error      string
+	CreatedAt  time.Time
+	UpdatedAt  time.Time
+	FinishedAt *time.Time
+}
@@ -0,0 +1,116 @@
+// Package ownership_client dials the vacancy and resume services for the
+// admin TransferOwnership run. Both RPCs are admin-only on the other side,
+// so every call carries the calling admin's bearer.
+package ownership_client
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"time"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/metadata"
+
+	"github.com/artem13815/hr/admin/internal/pb/resume_api"
+	"github.com/artem13815/hr/admin/internal/pb/vacancy_api"
+	"github.com/artem13815/hr/admin/internal/usecase"
+)
+
+// batchTimeout bounds one transfer batch. A batch is a single UPDATE on the
+// other side; anything slower means that service is in trouble and the
+// transfer should stop and be resumed later.
+const batchTimeout = 30 * time.Second
+
+// VacancyClient implements usecase.VacancyClient.
+type VacancyClient struct {
+	client vacancy_api.VacancyServiceClient
+}
+
+var _ usecase.VacancyClient = (*VacancyClient)(nil)
+
+// NewVacancyClient dials the vacancy service. Same lifetime rules as
+// auth_client.New: one long-lived conn, closed by the returned cleanup
+// during shutdown.
+func NewVacancyClient(addr string) (*VacancyClient, func(), error) {
+	conn, cleanup, err := dial(addr, "vacancy")
+	if err != nil {
+		return nil, nil, err
+	}
+	return &VacancyClient{client: vacancy_api.NewVacancyServiceClient(conn)}, cleanup, nil
+}
+
+func (c *VacancyClient) TransferVacancyOwnership(ctx context.Context, fromUserID, toUserID uint64, limit uint32) (uint32, error) {
+	ctx, cancel := context.WithTimeout(forwardAuthMetadata(ctx), batchTimeout)
+	defer cancel()
+
+	resp, err := c.client.TransferVacancyOwnership(ctx, &vacancy_api.TransferVacancyOwnershipRequest{
+		FromUserId: fromUserID,
+		ToUserId:   toUserID,
+		Limit:      limit,
+	})
+	if err != nil {
+		return 0, fmt.Errorf("vacancy.TransferVacancyOwnership: %w", err)
+	}
+	return resp.GetTransferred(), nil
+}
+
+// ResumeClient implements usecase.ResumeClient.
+type ResumeClient struct {
+	client resume_api.ResumeServiceClient
+}
+
+var _ usecase.ResumeClient = (*ResumeClient)(nil)
+
+// NewResumeClient dials the resume service; see NewVacancyClient.
+func NewResumeClient(addr string) (*ResumeClient, func(), error) {
+	conn, cleanup, err := dial(addr, "resume")
+	if err != nil {
+		return nil, nil, err
+	}
+	return &ResumeClient{client: resume_api.NewResumeServiceClient(conn)}, cleanup, nil
+}
+
+func (c *ResumeClient) TransferCandidateOwnership(ctx context.Context, fromUserID, toUserID uint64, limit uint32) (uint32, error) {
+	ctx, cancel := context.WithTimeout(forwardAuthMetadata(ctx), batchTimeout)
+	defer cancel()
+
+	resp, err := c.client.TransferCandidateOwnership(ctx, &resume_api.TransferCandidateOwnershipRequest{
+		FromUserId: fromUserID,
+		ToUserId:   toUserID,
+		Limit:      limit,
+	})
+	if err != nil {
+		return 0, fmt.Errorf("resume.TransferCandidateOwnership: %w", err)
+	}
+	return resp.GetTransferred(), nil
+}
+
+func dial(addr, service string) (*grpc.ClientConn, func(), error) {
+	conn, err := grpc.NewClient(addr, grpc.WithTransportCredentials(insecure.NewCredentials()))
+	if err != nil {
+		return nil, nil, fmt.Errorf("dial %s service: %w", service, err)
+	}
+	cleanup := func() {
+		if err := conn.Close(); err != nil {
+			slog.Warn("close "+service+" client conn", "err", err)
+		}
+	}
+	return conn, cleanup, nil
+}
+
+// forwardAuthMetadata copies the Authorization header from the incoming
+// metadata into the outgoing context, as auth_client does for
+// UpdateUserRole.
+func forwardAuthMetadata(ctx context.Context) context.Context {
+	md, ok := metadata.FromIncomingContext(ctx)
+	if !ok {
+		return ctx
+	}
+	auth := md.Get("authorization")
+	if len(auth) == 0 {
+		return ctx
+	}
+	return metadata.AppendToOutgoingContext(ctx, "authorization", auth[0])
+}
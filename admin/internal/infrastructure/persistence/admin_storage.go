@@ -1,33 +1,45 @@
 // Package persistence implements admin's storage port via pgxpool.
-// All queries are READ-ONLY against the shared `hr` database — admin is the
-// only service that crosses per-service table ownership; documented as a
-// deliberate operational exception in admin/README.md so adding aggregate
-// counters doesn't require a new RPC in every domain service.
+// Queries against other services' tables are READ-ONLY against the shared
+// `hr` database — admin is the only service that crosses per-service table
+// ownership; documented as a deliberate operational exception in
+// admin/README.md so adding aggregate counters doesn't require a new RPC in
+// every domain service. The one table admin writes, ownership_transfers, is
+// its own and comes with its own goose migrations.
 package persistence
 
 import (
 	"context"
+	"database/sql"
+	"embed"
 	"fmt"
 	"time"
 
 	"github.com/jackc/pgx/v5/pgxpool"
+	_ "github.com/jackc/pgx/v5/stdlib" // sql.Open("pgx", ...) driver
+	"github.com/pressly/goose/v3"
 )
 
-// initTimeout bounds the total time a startup pass (parse + connect + ping)
-// is allowed to take. Without a deadline a stalled postgres would hang the
-// boot indefinitely — the container would never get to the point where Docker
-// could give up and restart it.
+// initTimeout bounds the total time a startup pass (parse + connect + ping +
+// migrations) is allowed to take. Without a deadline a stalled postgres would
+// hang the boot indefinitely — the container would never get to the point
+// where Docker could give up and restart it.
 const initTimeout = 30 * time.Second
 
+// embeddedMigrations are baked into the binary, the same goose layout as the
+// other services.
+//
+//go:embed migrations/*.sql
+var embeddedMigrations embed.FS
+
 // AdminStorage holds a pgxpool connected to the shared `hr` database. Closed
 // via Close() during graceful shutdown.
 type AdminStorage struct {
 	db *pgxpool.Pool
 }
 
-// NewAdminStorage initialises the pool and Pings to fail fast if the DB is
-// unreachable. The pool is intentionally small — admin's workload is bursty
-// dashboard reads, not steady throughput.
+// NewAdminStorage initialises the pool, Pings to fail fast if the DB is
+// unreachable and applies admin's migrations. The pool is intentionally
+// small — admin's workload is bursty dashboard reads, not steady throughput.
 func NewAdminStorage(connString string) (*AdminStorage, error) {
 	cfg, err := pgxpool.ParseConfig(connString)
 	if err != nil {
@@ -46,9 +58,34 @@ func NewAdminStorage(connString string) (*AdminStorage, error) {
 		pool.Close()
 		return nil, fmt.Errorf("ping pg: %w", err)
 	}
+	if err := applyMigrations(ctx, connString); err != nil {
+		pool.Close()
+		return nil, err
+	}
 	return &AdminStorage{db: pool}, nil
 }
 
+// applyMigrations runs goose over a short-lived database/sql connection, as
+// the other services do. admin keeps its progress in its own version table
+// because every service shares the `hr` database.
+func applyMigrations(ctx context.Context, connString string) error {
+	sqlDB, err := sql.Open("pgx", connString)
+	if err != nil {
+		return fmt.Errorf("open migration db: %w", err)
+	}
+	defer sqlDB.Close()
+
+	goose.SetBaseFS(embeddedMigrations)
+	goose.SetTableName("admin_goose_db_version")
+	if err := goose.SetDialect("postgres"); err != nil {
+		return fmt.Errorf("goose dialect: %w", err)
+	}
+	if err := goose.UpContext(ctx, sqlDB, "migrations"); err != nil {
+		return fmt.Errorf("goose up: %w", err)
+	}
+	return nil
+}
+
 // Close releases all connections in the pool. Safe to call multiple times.
 func (s *AdminStorage) Close() {
 	if s.db != nil {
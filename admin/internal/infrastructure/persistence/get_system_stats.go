@@ -11,12 +11,14 @@ import (
 // via UNION ALL so the dashboard fetch is one network hop. PG plans
 // each as a sequential scan on a single column → cheap until tens of
 // millions of rows, at which point we'd add a materialised view.
+// Soft-deleted vacancies are not counted; their candidates are until the
+// retention job purges them.
 func (s *AdminStorage) GetSystemStats(ctx context.Context) (*domain.SystemStats, error) {
 	const query = `
 SELECT
   (SELECT count(*) FROM auth_users)                                   AS users_total,
   (SELECT count(*) FROM auth_users WHERE role = 'admin')              AS admins_total,
-  (SELECT count(*) FROM vacancies)                                    AS vacancies_total,
+  (SELECT count(*) FROM vacancies WHERE deleted_at IS NULL)           AS vacancies_total,
   (SELECT count(*) FROM candidates)                                   AS candidates_total,
   (SELECT count(*) FROM analyses)                                     AS analyses_total,
   (SELECT count(*) FROM analyses WHERE status = 'done')               AS analyses_done,
@@ -9,7 +9,8 @@ import (
 
 // ListUsers joins auth_users with vacancy/candidate counts so the table
 // can render activity metrics inline. LEFT JOIN + GROUP BY keeps users
-// who never created anything (counter shows 0).
+// who never created anything (counter shows 0). Soft-deleted vacancies
+// are left out of the count.
 func (s *AdminStorage) ListUsers(ctx context.Context) ([]domain.AdminUserView, error) {
 	const query = `
 SELECT
@@ -18,7 +19,7 @@ SELECT
   COALESCE(c.cnt, 0) AS candidates_uploaded
 FROM auth_users u
 LEFT JOIN (
-  SELECT owner_user_id, count(*) AS cnt FROM vacancies GROUP BY owner_user_id
+  SELECT owner_user_id, count(*) AS cnt FROM vacancies WHERE deleted_at IS NULL GROUP BY owner_user_id
 ) v ON v.owner_user_id = u.id
 LEFT JOIN (
   SELECT owner_user_id, count(*) AS cnt FROM candidates GROUP BY owner_user_id
@@ -0,0 +1,35 @@
+-- +goose Up
+-- Audit trail of TransferOwnership, also its resume point: an unfinished
+-- row (running or failed) is picked up again by the next call for the same
+-- users. At most one unfinished transfer per source user.
+-- +goose StatementBegin
+CREATE TABLE IF NOT EXISTS ownership_transfers (
+    id                     BIGSERIAL   PRIMARY KEY,
+    actor_user_id          BIGINT      NOT NULL,
+    from_user_id           BIGINT      NOT NULL,
+    to_user_id             BIGINT      NOT NULL,
+    scope                  VARCHAR(16) NOT NULL CHECK (scope IN ('all', 'vacancies', 'candidates')),
+    status                 VARCHAR(16) NOT NULL CHECK (status IN ('running', 'completed', 'failed')),
+    vacancies_transferred  BIGINT      NOT NULL DEFAULT 0,
+    candidates_transferred BIGINT      NOT NULL DEFAULT 0,
+    error                  TEXT        NOT NULL DEFAULT '',
+    created_at             TIMESTAMP   NOT NULL DEFAULT NOW(),
+    updated_at             TIMESTAMP   NOT NULL DEFAULT NOW(),
+    finished_at            TIMESTAMP
+);
+-- +goose StatementEnd
+
+-- +goose StatementBegin
+CREATE UNIQUE INDEX IF NOT EXISTS idx_ownership_transfers_unfinished
+    ON ownership_transfers(from_user_id) WHERE status <> 'completed';
+-- +goose StatementEnd
+
+-- +goose StatementBegin
+CREATE INDEX IF NOT EXISTS idx_ownership_transfers_created_at
+    ON ownership_transfers(created_at DESC, id DESC);
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP TABLE IF EXISTS ownership_transfers;
+-- +goose StatementEnd
@@ -0,0 +1,44 @@
+-- +goose Up
+-- A failed or stale transfer can be superseded by one to another user or
+-- with another scope: it is closed as abandoned and no longer counts as
+-- unfinished.
+-- +goose StatementBegin
+ALTER TABLE ownership_transfers DROP CONSTRAINT IF EXISTS ownership_transfers_status_check;
+-- +goose StatementEnd
+
+-- +goose StatementBegin
+ALTER TABLE ownership_transfers ADD CONSTRAINT ownership_transfers_status_check
+    CHECK (status IN ('running', 'completed', 'failed', 'abandoned'));
+-- +goose StatementEnd
+
+-- +goose StatementBegin
+DROP INDEX IF EXISTS idx_ownership_transfers_unfinished;
+-- +goose StatementEnd
+
+-- +goose StatementBegin
+CREATE UNIQUE INDEX idx_ownership_transfers_unfinished
+    ON ownership_transfers(from_user_id) WHERE status IN ('running', 'failed');
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DELETE FROM ownership_transfers WHERE status = 'abandoned';
+-- +goose StatementEnd
+
+-- +goose StatementBegin
+DROP INDEX IF EXISTS idx_ownership_transfers_unfinished;
+-- +goose StatementEnd
+
+-- +goose StatementBegin
+CREATE UNIQUE INDEX idx_ownership_transfers_unfinished
+    ON ownership_transfers(from_user_id) WHERE status <> 'completed';
+-- +goose StatementEnd
+
+-- +goose StatementBegin
+ALTER TABLE ownership_transfers DROP CONSTRAINT IF EXISTS ownership_transfers_status_check;
+-- +goose StatementEnd
+
+-- +goose StatementBegin
+ALTER TABLE ownership_transfers ADD CONSTRAINT ownership_transfers_status_check
+    CHECK (status IN ('running', 'completed', 'failed'));
+-- +goose StatementEnd
@@ -0,0 +1,173 @@
+package persistence
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/jackc/pgx/v5"
+
+	"github.com/artem13815/hr/admin/internal/domain"
+)
+
+const transferColumns = `id, actor_user_id, from_user_id, to_user_id, scope, status,
+       vacancies_transferred, candidates_transferred, error, created_at, updated_at, finished_at`
+
+func transferFields(t *domain.OwnershipTransfer) []any {
+	return []any{
+		&t.ID, &t.ActorUserID, &t.FromUserID, &t.ToUserID, &t.Scope, &t.Status,
+		&t.VacanciesTransferred, &t.CandidatesTransferred, &t.Error, &t.CreatedAt, &t.UpdatedAt, &t.FinishedAt,
+	}
+}
+
+// StartOwnershipTransfer opens the audit entry for a transfer, or reopens
+// the unfinished one for the same users and scope so the caller resumes it.
+// A transfer that is running and made progress within
+// domain.TransferStaleAfter belongs to another call: domain.ErrTransferInProgress,
+// as is losing the race to insert against a concurrent call. A failed or
+// stale one for another target or scope is closed as abandoned and a new
+// transfer starts. The target user must exist in auth_users, else
+// domain.ErrUserNotFound.
+func (s *AdminStorage) StartOwnershipTransfer(ctx context.Context, in domain.TransferOwnershipInput) (*domain.OwnershipTransfer, error) {
+	tx, err := s.db.Begin(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("start ownership transfer: %w", err)
+	}
+	defer tx.Rollback(ctx)
+
+	var exists bool
+	if err := tx.QueryRow(ctx, `SELECT EXISTS (SELECT 1 FROM auth_users WHERE id = $1)`, in.ToUserID).Scan(&exists); err != nil {
+		return nil, fmt.Errorf("check transfer target: %w", err)
+	}
+	if !exists {
+		return nil, domain.ErrUserNotFound
+	}
+
+	var (
+		t     domain.OwnershipTransfer
+		stale bool
+	)
+	err = tx.QueryRow(ctx, `
+SELECT `+transferColumns+`, updated_at < NOW() - make_interval(secs => $2)
+FROM ownership_transfers
+WHERE from_user_id = $1 AND status IN ('running', 'failed')
+FOR UPDATE
+`, in.FromUserID, domain.TransferStaleAfter.Seconds()).Scan(append(transferFields(&t), &stale)...)
+	switch {
+	case errors.Is(err, pgx.ErrNoRows):
+		return s.insertOwnershipTransfer(ctx, tx, in)
+	case err != nil:
+		return nil, fmt.Errorf("start ownership transfer: %w", err)
+	case t.Status == domain.TransferStatusRunning && !stale:
+		return nil, domain.ErrTransferInProgress
+	case t.ToUserID != in.ToUserID || t.Scope != in.Scope:
+		_, err = tx.Exec(ctx, `
+UPDATE ownership_transfers
+SET status = 'abandoned',
+    error = $2,
+    updated_at = NOW(),
+    finished_at = NOW()
+WHERE id = $1
+`, t.ID, fmt.Sprintf("superseded by a transfer to user %d, scope %s", in.ToUserID, in.Scope))
+		if err != nil {
+			return nil, fmt.Errorf("abandon ownership transfer: %w", err)
+		}
+		return s.insertOwnershipTransfer(ctx, tx, in)
+	}
+
+	err = tx.QueryRow(ctx, `
+UPDATE ownership_transfers
+SET status = 'running', updated_at = NOW()
+WHERE id = $1
+RETURNING `+transferColumns+`
+`, t.ID).Scan(transferFields(&t)...)
+	if err != nil {
+		return nil, fmt.Errorf("start ownership transfer: %w", err)
+	}
+	if err := tx.Commit(ctx); err != nil {
+		return nil, fmt.Errorf("start ownership transfer: %w", err)
+	}
+	return &t, nil
+}
+
+// insertOwnershipTransfer opens a new running entry and commits tx.
+func (s *AdminStorage) insertOwnershipTransfer(ctx context.Context, tx pgx.Tx, in domain.TransferOwnershipInput) (*domain.OwnershipTransfer, error) {
+	var t domain.OwnershipTransfer
+	err := tx.QueryRow(ctx, `
+INSERT INTO ownership_transfers (actor_user_id, from_user_id, to_user_id, scope, status)
+VALUES ($1, $2, $3, $4, 'running')
+ON CONFLICT DO NOTHING
+RETURNING `+transferColumns+`
+`, in.CallerUserID, in.FromUserID, in.ToUserID, in.Scope).Scan(transferFields(&t)...)
+	if errors.Is(err, pgx.ErrNoRows) {
+		return nil, domain.ErrTransferInProgress
+	}
+	if err != nil {
+		return nil, fmt.Errorf("start ownership transfer: %w", err)
+	}
+	if err := tx.Commit(ctx); err != nil {
+		return nil, fmt.Errorf("start ownership transfer: %w", err)
+	}
+	return &t, nil
+}
+
+// AddOwnershipTransferProgress adds one batch to the transfer's counters.
+func (s *AdminStorage) AddOwnershipTransferProgress(ctx context.Context, transferID uint64, vacancies, candidates uint32) error {
+	_, err := s.db.Exec(ctx, `
+UPDATE ownership_transfers
+SET vacancies_transferred = vacancies_transferred + $2,
+    candidates_transferred = candidates_transferred + $3,
+    updated_at = NOW()
+WHERE id = $1
+`, transferID, vacancies, candidates)
+	if err != nil {
+		return fmt.Errorf("record transfer progress: %w", err)
+	}
+	return nil
+}
+
+// FinishOwnershipTransfer closes the transfer as completed or failed and
+// returns the final entry. errMsg is stored for failed transfers.
+func (s *AdminStorage) FinishOwnershipTransfer(ctx context.Context, transferID uint64, status, errMsg string) (*domain.OwnershipTransfer, error) {
+	var t domain.OwnershipTransfer
+	err := s.db.QueryRow(ctx, `
+UPDATE ownership_transfers
+SET status = $2,
+    error = $3,
+    updated_at = NOW(),
+    finished_at = CASE WHEN $2 = 'completed' THEN NOW() END
+WHERE id = $1
+RETURNING `+transferColumns+`
+`, transferID, status, errMsg).Scan(transferFields(&t)...)
+	if err != nil {
+		return nil, fmt.Errorf("finish ownership transfer: %w", err)
+	}
+	return &t, nil
+}
+
+// ListOwnershipTransfers returns the newest transfers first, up to limit.
+func (s *AdminStorage) ListOwnershipTransfers(ctx context.Context, limit uint32) ([]domain.OwnershipTransfer, error) {
+	rows, err := s.db.Query(ctx, `
+SELECT `+transferColumns+`
+FROM ownership_transfers
+ORDER BY created_at DESC, id DESC
+LIMIT $1
+`, limit)
+	if err != nil {
+		return nil, fmt.Errorf("list ownership transfers: %w", err)
+	}
+	defer rows.Close()
+
+	out := make([]domain.OwnershipTransfer, 0)
+	for rows.Next() {
+		var t domain.OwnershipTransfer
+		if err := rows.Scan(transferFields(&t)...); err != nil {
+			return nil, fmt.Errorf("scan ownership transfer: %w", err)
+		}
+		out = append(out, t)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("iterate ownership transfers: %w", err)
+	}
+	return out, nil
+}
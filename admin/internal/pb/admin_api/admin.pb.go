@@ -27,7 +27,7 @@ var File_admin_api_admin_proto protoreflect.FileDescriptor
 
 const file_admin_api_admin_proto_rawDesc = "" +
 	"\n" +
-	"\x15admin_api/admin.proto\x12\x10admin.service.v1\x1a\x1cgoogle/api/annotations.proto\x1a\x18models/admin_model.proto\x1a.protoc-gen-openapiv2/options/annotations.proto2\xe1\x04\n" +
+	"\x15admin_api/admin.proto\x12\x10admin.service.v1\x1a\x1cgoogle/api/annotations.proto\x1a\x18models/admin_model.proto\x1a.protoc-gen-openapiv2/options/annotations.proto2\xcd\a\n" +
 	"\fAdminService\x12\x8a\x01\n" +
 	"\vGetOverview\x12#.admin.models.v1.GetOverviewRequest\x1a!.admin.models.v1.OverviewResponse\"3\x92A\x12b\x10\n" +
 	"\x0e\n" +
@@ -45,31 +45,47 @@ const file_admin_api_admin_proto_rawDesc = "" +
 	"DemoteUser\x12\".admin.models.v1.DemoteUserRequest\x1a#.admin.models.v1.UpdateRoleResponse\"D\x92A\x12b\x10\n" +
 	"\x0e\n" +
 	"\n" +
-	"BearerAuth\x12\x00\x82\xd3\xe4\x93\x02):\x01*\"$/api/v1/admin/users/{user_id}/demoteB6Z4github.com/artem13815/hr/admin/internal/pb/admin_apib\x06proto3"
+	"BearerAuth\x12\x00\x82\xd3\xe4\x93\x02):\x01*\"$/api/v1/admin/users/{user_id}/demote\x12\xad\x01\n" +
+	"\x11TransferOwnership\x12).admin.models.v1.TransferOwnershipRequest\x1a*.admin.models.v1.OwnershipTransferResponse\"A\x92A\x12b\x10\n" +
+	"\x0e\n" +
+	"\n" +
+	"BearerAuth\x12\x00\x82\xd3\xe4\x93\x02&:\x01*\"!/api/v1/admin/ownership-transfers\x12\xb9\x01\n" +
+	"\x16ListOwnershipTransfers\x12..admin.models.v1.ListOwnershipTransfersRequest\x1a/.admin.models.v1.ListOwnershipTransfersResponse\">\x92A\x12b\x10\n" +
+	"\x0e\n" +
+	"\n" +
+	"BearerAuth\x12\x00\x82\xd3\xe4\x93\x02#\x12!/api/v1/admin/ownership-transfersB6Z4github.com/artem13815/hr/admin/internal/pb/admin_apib\x06proto3"
 
 var file_admin_api_admin_proto_goTypes = []any{
-	(*models.GetOverviewRequest)(nil), // 0: admin.models.v1.GetOverviewRequest
-	(*models.ListUsersRequest)(nil),   // 1: admin.models.v1.ListUsersRequest
-	(*models.PromoteUserRequest)(nil), // 2: admin.models.v1.PromoteUserRequest
-	(*models.DemoteUserRequest)(nil),  // 3: admin.models.v1.DemoteUserRequest
-	(*models.OverviewResponse)(nil),   // 4: admin.models.v1.OverviewResponse
-	(*models.ListUsersResponse)(nil),  // 5: admin.models.v1.ListUsersResponse
-	(*models.UpdateRoleResponse)(nil), // 6: admin.models.v1.UpdateRoleResponse
+	(*models.GetOverviewRequest)(nil),             // 0: admin.models.v1.GetOverviewRequest
+	(*models.ListUsersRequest)(nil),               // 1: admin.models.v1.ListUsersRequest
+	(*models.PromoteUserRequest)(nil),             // 2: admin.models.v1.PromoteUserRequest
+	(*models.DemoteUserRequest)(nil),              // 3: admin.models.v1.DemoteUserRequest
+	(*models.TransferOwnershipRequest)(nil),       // 4: admin.models.v1.TransferOwnershipRequest
+	(*models.ListOwnershipTransfersRequest)(nil),  // 5: admin.models.v1.ListOwnershipTransfersRequest
+	(*models.OverviewResponse)(nil),               // 6: admin.models.v1.OverviewResponse
+	(*models.ListUsersResponse)(nil),              // 7: admin.models.v1.ListUsersResponse
+	(*models.UpdateRoleResponse)(nil),             // 8: admin.models.v1.UpdateRoleResponse
+	(*models.OwnershipTransferResponse)(nil),      // 9: admin.models.v1.OwnershipTransferResponse
+	(*models.ListOwnershipTransfersResponse)(nil), // 10: admin.models.v1.ListOwnershipTransfersResponse
 }
 var file_admin_api_admin_proto_depIdxs = []int32{
-	0, // 0: admin.service.v1.AdminService.GetOverview:input_type -> admin.models.v1.GetOverviewRequest
-	1, // 1: admin.service.v1.AdminService.ListUsers:input_type -> admin.models.v1.ListUsersRequest
-	2, // 2: admin.service.v1.AdminService.PromoteUser:input_type -> admin.models.v1.PromoteUserRequest
-	3, // 3: admin.service.v1.AdminService.DemoteUser:input_type -> admin.models.v1.DemoteUserRequest
-	4, // 4: admin.service.v1.AdminService.GetOverview:output_type -> admin.models.v1.OverviewResponse
-	5, // 5: admin.service.v1.AdminService.ListUsers:output_type -> admin.models.v1.ListUsersResponse
-	6, // 6: admin.service.v1.AdminService.PromoteUser:output_type -> admin.models.v1.UpdateRoleResponse
-	6, // 7: admin.service.v1.AdminService.DemoteUser:output_type -> admin.models.v1.UpdateRoleResponse
-	4, // [4:8] is the sub-list for method output_type
-	0, // [0:4] is the sub-list for method input_type
-	0, // [0:0] is the sub-list for extension type_name
-	0, // [0:0] is the sub-list for extension extendee
-	0, // [0:0] is the sub-list for field type_name
+	0,  // 0: admin.service.v1.AdminService.GetOverview:input_type -> admin.models.v1.GetOverviewRequest
+	1,  // 1: admin.service.v1.AdminService.ListUsers:input_type -> admin.models.v1.ListUsersRequest
+	2,  // 2: admin.service.v1.AdminService.PromoteUser:input_type -> admin.models.v1.PromoteUserRequest
+	3,  // 3: admin.service.v1.AdminService.DemoteUser:input_type -> admin.models.v1.DemoteUserRequest
+	4,  // 4: admin.service.v1.AdminService.TransferOwnership:input_type -> admin.models.v1.TransferOwnershipRequest
+	5,  // 5: admin.service.v1.AdminService.ListOwnershipTransfers:input_type -> admin.models.v1.ListOwnershipTransfersRequest
+	6,  // 6: admin.service.v1.AdminService.GetOverview:output_type -> admin.models.v1.OverviewResponse
+	7,  // 7: admin.service.v1.AdminService.ListUsers:output_type -> admin.models.v1.ListUsersResponse
+	8,  // 8: admin.service.v1.AdminService.PromoteUser:output_type -> admin.models.v1.UpdateRoleResponse
+	8,  // 9: admin.service.v1.AdminService.DemoteUser:output_type -> admin.models.v1.UpdateRoleResponse
+	9,  // 10: admin.service.v1.AdminService.TransferOwnership:output_type -> admin.models.v1.OwnershipTransferResponse
+	10, // 11: admin.service.v1.AdminService.ListOwnershipTransfers:output_type -> admin.models.v1.ListOwnershipTransfersResponse
+	6,  // [6:12] is the sub-list for method output_type
+	0,  // [0:6] is the sub-list for method input_type
+	0,  // [0:0] is the sub-list for extension type_name
+	0,  // [0:0] is the sub-list for extension extendee
+	0,  // [0:0] is the sub-list for field type_name
 }
 
 func init() { file_admin_api_admin_proto_init() }
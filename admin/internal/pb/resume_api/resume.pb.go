@@ -0,0 +1,199 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.11
+// 	protoc        v7.34.1
+// source: resume_api/resume.proto
+
+// Narrow contract: admin only needs TransferCandidateOwnership from the
+// resume service, for TransferOwnership. Same FQDN rule as
+// vacancy_api/vacancy.proto. Field tags MUST stay in sync with
+// resume_model.proto: TransferCandidateOwnershipRequest and
+// TransferCandidateOwnershipResponse.
+
+package resume_api
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type TransferCandidateOwnershipRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	FromUserId    uint64                 `protobuf:"varint,1,opt,name=from_user_id,json=fromUserId,proto3" json:"from_user_id,omitempty"`
+	ToUserId      uint64                 `protobuf:"varint,2,opt,name=to_user_id,json=toUserId,proto3" json:"to_user_id,omitempty"`
+	Limit         uint32                 `protobuf:"varint,3,opt,name=limit,proto3" json:"limit,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *TransferCandidateOwnershipRequest) Reset() {
+	*x = TransferCandidateOwnershipRequest{}
+	mi := &file_resume_api_resume_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TransferCandidateOwnershipRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TransferCandidateOwnershipRequest) ProtoMessage() {}
+
+func (x *TransferCandidateOwnershipRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_resume_api_resume_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TransferCandidateOwnershipRequest.ProtoReflect.Descriptor instead.
+func (*TransferCandidateOwnershipRequest) Descriptor() ([]byte, []int) {
+	return file_resume_api_resume_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *TransferCandidateOwnershipRequest) GetFromUserId() uint64 {
+	if x != nil {
+		return x.FromUserId
+	}
+	return 0
+}
+
+func (x *TransferCandidateOwnershipRequest) GetToUserId() uint64 {
+	if x != nil {
+		return x.ToUserId
+	}
+	return 0
+}
+
+func (x *TransferCandidateOwnershipRequest) GetLimit() uint32 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+type TransferCandidateOwnershipResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Transferred   uint32                 `protobuf:"varint,1,opt,name=transferred,proto3" json:"transferred,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *TransferCandidateOwnershipResponse) Reset() {
+	*x = TransferCandidateOwnershipResponse{}
+	mi := &file_resume_api_resume_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TransferCandidateOwnershipResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TransferCandidateOwnershipResponse) ProtoMessage() {}
+
+func (x *TransferCandidateOwnershipResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_resume_api_resume_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TransferCandidateOwnershipResponse.ProtoReflect.Descriptor instead.
+func (*TransferCandidateOwnershipResponse) Descriptor() ([]byte, []int) {
+	return file_resume_api_resume_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *TransferCandidateOwnershipResponse) GetTransferred() uint32 {
+	if x != nil {
+		return x.Transferred
+	}
+	return 0
+}
+
+var File_resume_api_resume_proto protoreflect.FileDescriptor
+
+const file_resume_api_resume_proto_rawDesc = "" +
+	"\n" +
+	"\x17resume_api/resume.proto\x12\x11resume.service.v1\"y\n" +
+	"!TransferCandidateOwnershipRequest\x12 \n" +
+	"\ffrom_user_id\x18\x01 \x01(\x04R\n" +
+	"fromUserId\x12\x1c\n" +
+	"\n" +
+	"to_user_id\x18\x02 \x01(\x04R\btoUserId\x12\x14\n" +
+	"\x05limit\x18\x03 \x01(\rR\x05limit\"F\n" +
+	"\"TransferCandidateOwnershipResponse\x12 \n" +
+	"\vtransferred\x18\x01 \x01(\rR\vtransferred2\x9d\x01\n" +
+	"\rResumeService\x12\x8b\x01\n" +
+	"\x1aTransferCandidateOwnership\x124.resume.service.v1.TransferCandidateOwnershipRequest\x1a5.resume.service.v1.TransferCandidateOwnershipResponse\"\x00B7Z5github.com/artem13815/hr/admin/internal/pb/resume_apib\x06proto3"
+
+var (
+	file_resume_api_resume_proto_rawDescOnce sync.Once
+	file_resume_api_resume_proto_rawDescData []byte
+)
+
+func file_resume_api_resume_proto_rawDescGZIP() []byte {
+	file_resume_api_resume_proto_rawDescOnce.Do(func() {
+		file_resume_api_resume_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_resume_api_resume_proto_rawDesc), len(file_resume_api_resume_proto_rawDesc)))
+	})
+	return file_resume_api_resume_proto_rawDescData
+}
+
+var file_resume_api_resume_proto_msgTypes = make([]protoimpl.MessageInfo, 2)
+var file_resume_api_resume_proto_goTypes = []any{
+	(*TransferCandidateOwnershipRequest)(nil),  // 0: resume.service.v1.TransferCandidateOwnershipRequest
+	(*TransferCandidateOwnershipResponse)(nil), // 1: resume.service.v1.TransferCandidateOwnershipResponse
+}
+var file_resume_api_resume_proto_depIdxs = []int32{
+	0, // 0: resume.service.v1.ResumeService.TransferCandidateOwnership:input_type -> resume.service.v1.TransferCandidateOwnershipRequest
+	1, // 1: resume.service.v1.ResumeService.TransferCandidateOwnership:output_type -> resume.service.v1.TransferCandidateOwnershipResponse
+	1, // [1:2] is the sub-list for method output_type
+	0, // [0:1] is the sub-list for method input_type
+	0, // [0:0] is the sub-list for extension type_name
+	0, // [0:0] is the sub-list for extension extendee
+	0, // [0:0] is the sub-list for field type_name
+}
+
+func init() { file_resume_api_resume_proto_init() }
+func file_resume_api_resume_proto_init() {
+	if File_resume_api_resume_proto != nil {
+		return
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_resume_api_resume_proto_rawDesc), len(file_resume_api_resume_proto_rawDesc)),
+			NumEnums:      0,
+			NumMessages:   2,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_resume_api_resume_proto_goTypes,
+		DependencyIndexes: file_resume_api_resume_proto_depIdxs,
+		MessageInfos:      file_resume_api_resume_proto_msgTypes,
+	}.Build()
+	File_resume_api_resume_proto = out.File
+	file_resume_api_resume_proto_goTypes = nil
+	file_resume_api_resume_proto_depIdxs = nil
+}
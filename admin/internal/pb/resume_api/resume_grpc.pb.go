@@ -0,0 +1,127 @@
+// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
+// versions:
+// - protoc-gen-go-grpc v1.6.0
+// - protoc             v7.34.1
+// source: resume_api/resume.proto
+
+// Narrow contract: admin only needs TransferCandidateOwnership from the
+// resume service, for TransferOwnership. Same FQDN rule as
+// vacancy_api/vacancy.proto. Field tags MUST stay in sync with
+// resume_model.proto: TransferCandidateOwnershipRequest and
+// TransferCandidateOwnershipResponse.
+
+package resume_api
+
+import (
+	context "context"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+// Requires gRPC-Go v1.64.0 or later.
+const _ = grpc.SupportPackageIsVersion9
+
+const (
+	ResumeService_TransferCandidateOwnership_FullMethodName = "/resume.service.v1.ResumeService/TransferCandidateOwnership"
+)
+
+// ResumeServiceClient is the client API for ResumeService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type ResumeServiceClient interface {
+	TransferCandidateOwnership(ctx context.Context, in *TransferCandidateOwnershipRequest, opts ...grpc.CallOption) (*TransferCandidateOwnershipResponse, error)
+}
+
+type resumeServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewResumeServiceClient(cc grpc.ClientConnInterface) ResumeServiceClient {
+	return &resumeServiceClient{cc}
+}
+
+func (c *resumeServiceClient) TransferCandidateOwnership(ctx context.Context, in *TransferCandidateOwnershipRequest, opts ...grpc.CallOption) (*TransferCandidateOwnershipResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(TransferCandidateOwnershipResponse)
+	err := c.cc.Invoke(ctx, ResumeService_TransferCandidateOwnership_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// ResumeServiceServer is the server API for ResumeService service.
+// All implementations must embed UnimplementedResumeServiceServer
+// for forward compatibility.
+type ResumeServiceServer interface {
+	TransferCandidateOwnership(context.Context, *TransferCandidateOwnershipRequest) (*TransferCandidateOwnershipResponse, error)
+	mustEmbedUnimplementedResumeServiceServer()
+}
+
+// UnimplementedResumeServiceServer must be embedded to have
+// forward compatible implementations.
+//
+// NOTE: this should be embedded by value instead of pointer to avoid a nil
+// pointer dereference when methods are called.
+type UnimplementedResumeServiceServer struct{}
+
+func (UnimplementedResumeServiceServer) TransferCandidateOwnership(context.Context, *TransferCandidateOwnershipRequest) (*TransferCandidateOwnershipResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method TransferCandidateOwnership not implemented")
+}
+func (UnimplementedResumeServiceServer) mustEmbedUnimplementedResumeServiceServer() {}
+func (UnimplementedResumeServiceServer) testEmbeddedByValue()                       {}
+
+// UnsafeResumeServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to ResumeServiceServer will
+// result in compilation errors.
+type UnsafeResumeServiceServer interface {
+	mustEmbedUnimplementedResumeServiceServer()
+}
+
+func RegisterResumeServiceServer(s grpc.ServiceRegistrar, srv ResumeServiceServer) {
+	// If the following call panics, it indicates UnimplementedResumeServiceServer was
+	// embedded by pointer and is nil.  This will cause panics if an
+	// unimplemented method is ever invoked, so we test this at initialization
+	// time to prevent it from happening at runtime later due to I/O.
+	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
+		t.testEmbeddedByValue()
+	}
+	s.RegisterService(&ResumeService_ServiceDesc, srv)
+}
+
+func _ResumeService_TransferCandidateOwnership_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(TransferCandidateOwnershipRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ResumeServiceServer).TransferCandidateOwnership(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ResumeService_TransferCandidateOwnership_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ResumeServiceServer).TransferCandidateOwnership(ctx, req.(*TransferCandidateOwnershipRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// ResumeService_ServiceDesc is the grpc.ServiceDesc for ResumeService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var ResumeService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "resume.service.v1.ResumeService",
+	HandlerType: (*ResumeServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "TransferCandidateOwnership",
+			Handler:    _ResumeService_TransferCandidateOwnership_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "resume_api/resume.proto",
+}
@@ -0,0 +1,201 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.11
+// 	protoc        v7.34.1
+// source: vacancy_api/vacancy.proto
+
+// Narrow contract: admin only needs TransferVacancyOwnership from the vacancy
+// service, for TransferOwnership. The package and service names match
+// vacancy's full FQDN (vacancy.service.v1.VacancyService/TransferVacancyOwnership)
+// so the gRPC wire call reaches the same handler — message type names are
+// local and do not affect the wire format. Field tags MUST stay in sync with
+// vacancy_model.proto: TransferVacancyOwnershipRequest and
+// TransferVacancyOwnershipResponse.
+
+package vacancy_api
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type TransferVacancyOwnershipRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	FromUserId    uint64                 `protobuf:"varint,1,opt,name=from_user_id,json=fromUserId,proto3" json:"from_user_id,omitempty"`
+	ToUserId      uint64                 `protobuf:"varint,2,opt,name=to_user_id,json=toUserId,proto3" json:"to_user_id,omitempty"`
+	Limit         uint32                 `protobuf:"varint,3,opt,name=limit,proto3" json:"limit,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *TransferVacancyOwnershipRequest) Reset() {
+	*x = TransferVacancyOwnershipRequest{}
+	mi := &file_vacancy_api_vacancy_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TransferVacancyOwnershipRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TransferVacancyOwnershipRequest) ProtoMessage() {}
+
+func (x *TransferVacancyOwnershipRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_vacancy_api_vacancy_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TransferVacancyOwnershipRequest.ProtoReflect.Descriptor instead.
+func (*TransferVacancyOwnershipRequest) Descriptor() ([]byte, []int) {
+	return file_vacancy_api_vacancy_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *TransferVacancyOwnershipRequest) GetFromUserId() uint64 {
+	if x != nil {
+		return x.FromUserId
+	}
+	return 0
+}
+
+func (x *TransferVacancyOwnershipRequest) GetToUserId() uint64 {
+	if x != nil {
+		return x.ToUserId
+	}
+	return 0
+}
+
+func (x *TransferVacancyOwnershipRequest) GetLimit() uint32 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+type TransferVacancyOwnershipResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Transferred   uint32                 `protobuf:"varint,1,opt,name=transferred,proto3" json:"transferred,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *TransferVacancyOwnershipResponse) Reset() {
+	*x = TransferVacancyOwnershipResponse{}
+	mi := &file_vacancy_api_vacancy_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TransferVacancyOwnershipResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TransferVacancyOwnershipResponse) ProtoMessage() {}
+
+func (x *TransferVacancyOwnershipResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_vacancy_api_vacancy_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TransferVacancyOwnershipResponse.ProtoReflect.Descriptor instead.
+func (*TransferVacancyOwnershipResponse) Descriptor() ([]byte, []int) {
+	return file_vacancy_api_vacancy_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *TransferVacancyOwnershipResponse) GetTransferred() uint32 {
+	if x != nil {
+		return x.Transferred
+	}
+	return 0
+}
+
+var File_vacancy_api_vacancy_proto protoreflect.FileDescriptor
+
+const file_vacancy_api_vacancy_proto_rawDesc = "" +
+	"\n" +
+	"\x19vacancy_api/vacancy.proto\x12\x12vacancy.service.v1\"w\n" +
+	"\x1fTransferVacancyOwnershipRequest\x12 \n" +
+	"\ffrom_user_id\x18\x01 \x01(\x04R\n" +
+	"fromUserId\x12\x1c\n" +
+	"\n" +
+	"to_user_id\x18\x02 \x01(\x04R\btoUserId\x12\x14\n" +
+	"\x05limit\x18\x03 \x01(\rR\x05limit\"D\n" +
+	" TransferVacancyOwnershipResponse\x12 \n" +
+	"\vtransferred\x18\x01 \x01(\rR\vtransferred2\x9a\x01\n" +
+	"\x0eVacancyService\x12\x87\x01\n" +
+	"\x18TransferVacancyOwnership\x123.vacancy.service.v1.TransferVacancyOwnershipRequest\x1a4.vacancy.service.v1.TransferVacancyOwnershipResponse\"\x00B8Z6github.com/artem13815/hr/admin/internal/pb/vacancy_apib\x06proto3"
+
+var (
+	file_vacancy_api_vacancy_proto_rawDescOnce sync.Once
+	file_vacancy_api_vacancy_proto_rawDescData []byte
+)
+
+func file_vacancy_api_vacancy_proto_rawDescGZIP() []byte {
+	file_vacancy_api_vacancy_proto_rawDescOnce.Do(func() {
+		file_vacancy_api_vacancy_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_vacancy_api_vacancy_proto_rawDesc), len(file_vacancy_api_vacancy_proto_rawDesc)))
+	})
+	return file_vacancy_api_vacancy_proto_rawDescData
+}
+
+var file_vacancy_api_vacancy_proto_msgTypes = make([]protoimpl.MessageInfo, 2)
+var file_vacancy_api_vacancy_proto_goTypes = []any{
+	(*TransferVacancyOwnershipRequest)(nil),  // 0: vacancy.service.v1.TransferVacancyOwnershipRequest
+	(*TransferVacancyOwnershipResponse)(nil), // 1: vacancy.service.v1.TransferVacancyOwnershipResponse
+}
+var file_vacancy_api_vacancy_proto_depIdxs = []int32{
+	0, // 0: vacancy.service.v1.VacancyService.TransferVacancyOwnership:input_type -> vacancy.service.v1.TransferVacancyOwnershipRequest
+	1, // 1: vacancy.service.v1.VacancyService.TransferVacancyOwnership:output_type -> vacancy.service.v1.TransferVacancyOwnershipResponse
+	1, // [1:2] is the sub-list for method output_type
+	0, // [0:1] is the sub-list for method input_type
+	0, // [0:0] is the sub-list for extension type_name
+	0, // [0:0] is the sub-list for extension extendee
+	0, // [0:0] is the sub-list for field type_name
+}
+
+func init() { file_vacancy_api_vacancy_proto_init() }
+func file_vacancy_api_vacancy_proto_init() {
+	if File_vacancy_api_vacancy_proto != nil {
+		return
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_vacancy_api_vacancy_proto_rawDesc), len(file_vacancy_api_vacancy_proto_rawDesc)),
+			NumEnums:      0,
+			NumMessages:   2,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_vacancy_api_vacancy_proto_goTypes,
+		DependencyIndexes: file_vacancy_api_vacancy_proto_depIdxs,
+		MessageInfos:      file_vacancy_api_vacancy_proto_msgTypes,
+	}.Build()
+	File_vacancy_api_vacancy_proto = out.File
+	file_vacancy_api_vacancy_proto_goTypes = nil
+	file_vacancy_api_vacancy_proto_depIdxs = nil
+}
@@ -0,0 +1,129 @@
+// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
+// versions:
+// - protoc-gen-go-grpc v1.6.0
+// - protoc             v7.34.1
+// source: vacancy_api/vacancy.proto
+
+// Narrow contract: admin only needs TransferVacancyOwnership from the vacancy
+// service, for TransferOwnership. The package and service names match
+// vacancy's full FQDN (vacancy.service.v1.VacancyService/TransferVacancyOwnership)
+// so the gRPC wire call reaches the same handler — message type names are
+// local and do not affect the wire format. Field tags MUST stay in sync with
+// vacancy_model.proto: TransferVacancyOwnershipRequest and
+// TransferVacancyOwnershipResponse.
+
+package vacancy_api
+
+import (
+	context "context"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+// Requires gRPC-Go v1.64.0 or later.
+const _ = grpc.SupportPackageIsVersion9
+
+const (
+	VacancyService_TransferVacancyOwnership_FullMethodName = "/vacancy.service.v1.VacancyService/TransferVacancyOwnership"
+)
+
+// VacancyServiceClient is the client API for VacancyService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type VacancyServiceClient interface {
+	TransferVacancyOwnership(ctx context.Context, in *TransferVacancyOwnershipRequest, opts ...grpc.CallOption) (*TransferVacancyOwnershipResponse, error)
+}
+
+type vacancyServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewVacancyServiceClient(cc grpc.ClientConnInterface) VacancyServiceClient {
+	return &vacancyServiceClient{cc}
+}
+
+func (c *vacancyServiceClient) TransferVacancyOwnership(ctx context.Context, in *TransferVacancyOwnershipRequest, opts ...grpc.CallOption) (*TransferVacancyOwnershipResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(TransferVacancyOwnershipResponse)
+	err := c.cc.Invoke(ctx, VacancyService_TransferVacancyOwnership_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// VacancyServiceServer is the server API for VacancyService service.
+// All implementations must embed UnimplementedVacancyServiceServer
+// for forward compatibility.
+type VacancyServiceServer interface {
+	TransferVacancyOwnership(context.Context, *TransferVacancyOwnershipRequest) (*TransferVacancyOwnershipResponse, error)
+	mustEmbedUnimplementedVacancyServiceServer()
+}
+
+// UnimplementedVacancyServiceServer must be embedded to have
+// forward compatible implementations.
+//
+// NOTE: this should be embedded by value instead of pointer to avoid a nil
+// pointer dereference when methods are called.
+type UnimplementedVacancyServiceServer struct{}
+
+func (UnimplementedVacancyServiceServer) TransferVacancyOwnership(context.Context, *TransferVacancyOwnershipRequest) (*TransferVacancyOwnershipResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method TransferVacancyOwnership not implemented")
+}
+func (UnimplementedVacancyServiceServer) mustEmbedUnimplementedVacancyServiceServer() {}
+func (UnimplementedVacancyServiceServer) testEmbeddedByValue()                        {}
+
+// UnsafeVacancyServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to VacancyServiceServer will
+// result in compilation errors.
+type UnsafeVacancyServiceServer interface {
+	mustEmbedUnimplementedVacancyServiceServer()
+}
+
+func RegisterVacancyServiceServer(s grpc.ServiceRegistrar, srv VacancyServiceServer) {
+	// If the following call panics, it indicates UnimplementedVacancyServiceServer was
+	// embedded by pointer and is nil.  This will cause panics if an
+	// unimplemented method is ever invoked, so we test this at initialization
+	// time to prevent it from happening at runtime later due to I/O.
+	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
+		t.testEmbeddedByValue()
+	}
+	s.RegisterService(&VacancyService_ServiceDesc, srv)
+}
+
+func _VacancyService_TransferVacancyOwnership_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(TransferVacancyOwnershipRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(VacancyServiceServer).TransferVacancyOwnership(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: VacancyService_TransferVacancyOwnership_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(VacancyServiceServer).TransferVacancyOwnership(ctx, req.(*TransferVacancyOwnershipRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// VacancyService_ServiceDesc is the grpc.ServiceDesc for VacancyService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var VacancyService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "vacancy.service.v1.VacancyService",
+	HandlerType: (*VacancyServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "TransferVacancyOwnership",
+			Handler:    _VacancyService_TransferVacancyOwnership_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "vacancy_api/vacancy.proto",
+}
@@ -11,6 +11,8 @@ type adminService interface {
 	GetOverview(ctx context.Context) (*domain.SystemStats, error)
 	ListUsers(ctx context.Context) ([]domain.AdminUserView, error)
 	UpdateRole(ctx context.Context, in domain.UpdateRoleInput) error
+	TransferOwnership(ctx context.Context, in domain.TransferOwnershipInput) (*domain.OwnershipTransfer, error)
+	ListOwnershipTransfers(ctx context.Context) ([]domain.OwnershipTransfer, error)
 }
 
 type AdminServiceAPI struct {
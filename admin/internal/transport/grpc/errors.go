@@ -15,6 +15,9 @@ const (
 	ErrCodeNotFound     = "NOT_FOUND"
 	ErrCodeInternal     = "INTERNAL"
 
+	ErrCodeTransferInProgress  = "TRANSFER_IN_PROGRESS"
+	ErrCodeTransferInterrupted = "TRANSFER_INTERRUPTED"
+
 	errDomain = "admin.service.v1"
 )
 
@@ -0,0 +1,111 @@
+package grpc
+
+import (
+	"context"
+	"errors"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"github.com/artem13815/hr/admin/internal/domain"
+	pb_models "github.com/artem13815/hr/admin/internal/pb/models"
+	"github.com/artem13815/hr/admin/internal/transport/middleware"
+	"github.com/artem13815/hr/admin/internal/usecase"
+)
+
+func (a *AdminServiceAPI) TransferOwnership(ctx context.Context, req *pb_models.TransferOwnershipRequest) (*pb_models.OwnershipTransferResponse, error) {
+	uc, ok := middleware.Get(ctx)
+	if !ok {
+		return nil, newError(codes.Unauthenticated, ErrCodeUnauthorized, "Authentication required.")
+	}
+
+	transfer, err := a.svc.TransferOwnership(ctx, domain.TransferOwnershipInput{
+		CallerUserID: uc.UserID,
+		IsAdmin:      uc.IsAdmin,
+		FromUserID:   req.GetFromUserId(),
+		ToUserID:     req.GetToUserId(),
+		Scope:        fromPBTransferScope(req.GetScope()),
+	})
+	if err != nil {
+		switch {
+		case errors.Is(err, usecase.ErrInvalidArgument):
+			return nil, newError(codes.InvalidArgument, ErrCodeInvalidInput, "Invalid ownership transfer request.")
+		case errors.Is(err, usecase.ErrUnauthorized):
+			return nil, newError(codes.PermissionDenied, ErrCodeForbidden, "Admin privileges required.")
+		case errors.Is(err, usecase.ErrNotFound):
+			return nil, newError(codes.NotFound, ErrCodeNotFound, "Target user not found.")
+		case errors.Is(err, usecase.ErrTransferInProgress):
+			return nil, newError(codes.FailedPrecondition, ErrCodeTransferInProgress, "Another ownership transfer from this user is running.")
+		case errors.Is(err, usecase.ErrTransferInterrupted):
+			return nil, newError(codes.Unavailable, ErrCodeTransferInterrupted, "Ownership transfer interrupted; repeat the request to resume it.")
+		default:
+			return nil, newError(codes.Internal, ErrCodeInternal, "Ownership transfer failed.")
+		}
+	}
+
+	return &pb_models.OwnershipTransferResponse{Transfer: toPBOwnershipTransfer(*transfer)}, nil
+}
+
+func (a *AdminServiceAPI) ListOwnershipTransfers(ctx context.Context, _ *pb_models.ListOwnershipTransfersRequest) (*pb_models.ListOwnershipTransfersResponse, error) {
+	if _, ok := middleware.Get(ctx); !ok {
+		return nil, newError(codes.Unauthenticated, ErrCodeUnauthorized, "Authentication required.")
+	}
+
+	transfers, err := a.svc.ListOwnershipTransfers(ctx)
+	if err != nil {
+		return nil, newError(codes.Internal, ErrCodeInternal, "Failed to list ownership transfers.")
+	}
+
+	out := make([]*pb_models.OwnershipTransfer, 0, len(transfers))
+	for _, t := range transfers {
+		out = append(out, toPBOwnershipTransfer(t))
+	}
+	return &pb_models.ListOwnershipTransfersResponse{Transfers: out}, nil
+}
+
+var pbTransferScopes = map[string]pb_models.TransferScope{
+	domain.TransferScopeAll:        pb_models.TransferScope_TRANSFER_SCOPE_ALL,
+	domain.TransferScopeVacancies:  pb_models.TransferScope_TRANSFER_SCOPE_VACANCIES,
+	domain.TransferScopeCandidates: pb_models.TransferScope_TRANSFER_SCOPE_CANDIDATES,
+}
+
+var pbTransferStatuses = map[string]pb_models.TransferStatus{
+	domain.TransferStatusRunning:   pb_models.TransferStatus_TRANSFER_STATUS_RUNNING,
+	domain.TransferStatusCompleted: pb_models.TransferStatus_TRANSFER_STATUS_COMPLETED,
+	domain.TransferStatusFailed:    pb_models.TransferStatus_TRANSFER_STATUS_FAILED,
+	domain.TransferStatusAbandoned: pb_models.TransferStatus_TRANSFER_STATUS_ABANDONED,
+}
+
+// fromPBTransferScope maps UNSPECIFIED to "", which the usecase reads as
+// all. Values outside the enum pass through by name and are rejected there.
+func fromPBTransferScope(scope pb_models.TransferScope) string {
+	if scope == pb_models.TransferScope_TRANSFER_SCOPE_UNSPECIFIED {
+		return ""
+	}
+	for s, pb := range pbTransferScopes {
+		if pb == scope {
+			return s
+		}
+	}
+	return scope.String()
+}
+
+func toPBOwnershipTransfer(t domain.OwnershipTransfer) *pb_models.OwnershipTransfer {
+	out := &pb_models.OwnershipTransfer{
+		Id:                    t.ID,
+		ActorUserId:           t.ActorUserID,
+		FromUserId:            t.FromUserID,
+		ToUserId:              t.ToUserID,
+		Scope:                 pbTransferScopes[t.Scope],
+		Status:                pbTransferStatuses[t.Status],
+		VacanciesTransferred:  t.VacanciesTransferred,
+		CandidatesTransferred: t.CandidatesTransferred,
+		Error:                 t.Error,
+		CreatedAt:             timestamppb.New(t.CreatedAt),
+		UpdatedAt:             timestamppb.New(t.UpdatedAt),
+	}
+	if t.FinishedAt != nil {
+		out.FinishedAt = timestamppb.New(*t.FinishedAt)
+	}
+	return out
+}
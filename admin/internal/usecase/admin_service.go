@@ -1,6 +1,6 @@
 package usecase
 
-//go:generate go run github.com/gojuno/minimock/v3/cmd/minimock@v3.4.7 -i AdminStorage,AuthClient -o ./mocks -s _mock.go -g
+//go:generate go run github.com/gojuno/minimock/v3/cmd/minimock@v3.4.7 -i AdminStorage,AuthClient,VacancyClient,ResumeClient -o ./mocks -s _mock.go -g
 
 import (
 	"context"
@@ -10,11 +10,17 @@ import (
 
 // AdminStorage is the persistence-driven port. Implemented by
 // infrastructure/persistence (read-only pgx queries against the shared `hr`
-// database). Admin operates at a layer above per-service ownership boundaries
-// — documented as a deliberate exception in admin/README.md.
+// database, plus admin's own ownership_transfers audit table). Admin operates
+// at a layer above per-service ownership boundaries — documented as a
+// deliberate exception in admin/README.md.
 type AdminStorage interface {
 	GetSystemStats(ctx context.Context) (*domain.SystemStats, error)
 	ListUsers(ctx context.Context) ([]domain.AdminUserView, error)
+
+	StartOwnershipTransfer(ctx context.Context, in domain.TransferOwnershipInput) (*domain.OwnershipTransfer, error)
+	AddOwnershipTransferProgress(ctx context.Context, transferID uint64, vacancies, candidates uint32) error
+	FinishOwnershipTransfer(ctx context.Context, transferID uint64, status, errMsg string) (*domain.OwnershipTransfer, error)
+	ListOwnershipTransfers(ctx context.Context, limit uint32) ([]domain.OwnershipTransfer, error)
 }
 
 // AuthClient wraps the gRPC call to auth.UpdateUserRole. Defined here (not in
@@ -24,11 +30,27 @@ type AuthClient interface {
 	UpdateUserRole(ctx context.Context, userID uint64, newRole string) error
 }
 
+// VacancyClient wraps vacancy.TransferVacancyOwnership: moves at most limit
+// of fromUserID's vacancies to toUserID and reports how many moved. Owned
+// data stays behind its service's RPC — admin never writes another service's
+// tables.
+type VacancyClient interface {
+	TransferVacancyOwnership(ctx context.Context, fromUserID, toUserID uint64, limit uint32) (uint32, error)
+}
+
+// ResumeClient wraps resume.TransferCandidateOwnership, the candidate
+// counterpart of VacancyClient.
+type ResumeClient interface {
+	TransferCandidateOwnership(ctx context.Context, fromUserID, toUserID uint64, limit uint32) (uint32, error)
+}
+
 type AdminService struct {
 	storage    AdminStorage
 	authClient AuthClient
+	vacancies  VacancyClient
+	resumes    ResumeClient
 }
 
-func NewAdminService(storage AdminStorage, authClient AuthClient) *AdminService {
-	return &AdminService{storage: storage, authClient: authClient}
+func NewAdminService(storage AdminStorage, authClient AuthClient, vacancies VacancyClient, resumes ResumeClient) *AdminService {
+	return &AdminService{storage: storage, authClient: authClient, vacancies: vacancies, resumes: resumes}
 }
@@ -5,4 +5,13 @@ import "errors"
 var (
 	ErrInvalidArgument = errors.New("invalid argument")
 	ErrUnauthorized    = errors.New("unauthorized")
+	ErrNotFound        = errors.New("not found")
+
+	// ErrTransferInProgress: the source user already has an ownership
+	// transfer running in another call.
+	ErrTransferInProgress = errors.New("ownership transfer in progress")
+	// ErrTransferInterrupted wraps the failure that stopped a transfer
+	// midway. The transfer is recorded as failed; repeating the call with the
+	// same arguments resumes it.
+	ErrTransferInterrupted = errors.New("ownership transfer interrupted")
 )
@@ -17,6 +17,20 @@ type AdminStorageMock struct {
 	t          minimock.Tester
 	finishOnce sync.Once
 
+	funcAddOwnershipTransferProgress          func(ctx context.Context, transferID uint64, vacancies uint32, candidates uint32) (err error)
+	funcAddOwnershipTransferProgressOrigin    string
+	inspectFuncAddOwnershipTransferProgress   func(ctx context.Context, transferID uint64, vacancies uint32, candidates uint32)
+	afterAddOwnershipTransferProgressCounter  uint64
+	beforeAddOwnershipTransferProgressCounter uint64
+	AddOwnershipTransferProgressMock          mAdminStorageMockAddOwnershipTransferProgress
+
+	funcFinishOwnershipTransfer          func(ctx context.Context, transferID uint64, status string, errMsg string) (op1 *domain.OwnershipTransfer, err error)
+	funcFinishOwnershipTransferOrigin    string
+	inspectFuncFinishOwnershipTransfer   func(ctx context.Context, transferID uint64, status string, errMsg string)
+	afterFinishOwnershipTransferCounter  uint64
+	beforeFinishOwnershipTransferCounter uint64
+	FinishOwnershipTransferMock          mAdminStorageMockFinishOwnershipTransfer
+
 	funcGetSystemStats          func(ctx context.Context) (sp1 *domain.SystemStats, err error)
 	funcGetSystemStatsOrigin    string
 	inspectFuncGetSystemStats   func(ctx context.Context)
@@ -24,12 +38,26 @@ type AdminStorageMock struct {
 	beforeGetSystemStatsCounter uint64
 	GetSystemStatsMock          mAdminStorageMockGetSystemStats
 
+	funcListOwnershipTransfers          func(ctx context.Context, limit uint32) (oa1 []domain.OwnershipTransfer, err error)
+	funcListOwnershipTransfersOrigin    string
+	inspectFuncListOwnershipTransfers   func(ctx context.Context, limit uint32)
+	afterListOwnershipTransfersCounter  uint64
+	beforeListOwnershipTransfersCounter uint64
+	ListOwnershipTransfersMock          mAdminStorageMockListOwnershipTransfers
+
 	funcListUsers          func(ctx context.Context) (aa1 []domain.AdminUserView, err error)
 	funcListUsersOrigin    string
 	inspectFuncListUsers   func(ctx context.Context)
 	afterListUsersCounter  uint64
 	beforeListUsersCounter uint64
 	ListUsersMock          mAdminStorageMockListUsers
+
+	funcStartOwnershipTransfer          func(ctx context.Context, in domain.TransferOwnershipInput) (op1 *domain.OwnershipTransfer, err error)
+	funcStartOwnershipTransferOrigin    string
+	inspectFuncStartOwnershipTransfer   func(ctx context.Context, in domain.TransferOwnershipInput)
+	afterStartOwnershipTransferCounter  uint64
+	beforeStartOwnershipTransferCounter uint64
+	StartOwnershipTransferMock          mAdminStorageMockStartOwnershipTransfer
 }
 
 // NewAdminStorageMock returns a mock for mm_usecase.AdminStorage
@@ -40,15 +68,836 @@ func NewAdminStorageMock(t minimock.Tester) *AdminStorageMock {
 		controller.RegisterMocker(m)
 	}
 
+	m.AddOwnershipTransferProgressMock = mAdminStorageMockAddOwnershipTransferProgress{mock: m}
+	m.AddOwnershipTransferProgressMock.callArgs = []*AdminStorageMockAddOwnershipTransferProgressParams{}
+
+	m.FinishOwnershipTransferMock = mAdminStorageMockFinishOwnershipTransfer{mock: m}
+	m.FinishOwnershipTransferMock.callArgs = []*AdminStorageMockFinishOwnershipTransferParams{}
+
 	m.GetSystemStatsMock = mAdminStorageMockGetSystemStats{mock: m}
 	m.GetSystemStatsMock.callArgs = []*AdminStorageMockGetSystemStatsParams{}
 
+	m.ListOwnershipTransfersMock = mAdminStorageMockListOwnershipTransfers{mock: m}
+	m.ListOwnershipTransfersMock.callArgs = []*AdminStorageMockListOwnershipTransfersParams{}
+
 	m.ListUsersMock = mAdminStorageMockListUsers{mock: m}
 	m.ListUsersMock.callArgs = []*AdminStorageMockListUsersParams{}
 
-	t.Cleanup(m.MinimockFinish)
+	m.StartOwnershipTransferMock = mAdminStorageMockStartOwnershipTransfer{mock: m}
+	m.StartOwnershipTransferMock.callArgs = []*AdminStorageMockStartOwnershipTransferParams{}
+
+	t.Cleanup(m.MinimockFinish)
+
+	return m
+}
+
+type mAdminStorageMockAddOwnershipTransferProgress struct {
+	optional           bool
+	mock               *AdminStorageMock
+	defaultExpectation *AdminStorageMockAddOwnershipTransferProgressExpectation
+	expectations       []*AdminStorageMockAddOwnershipTransferProgressExpectation
+
+	callArgs []*AdminStorageMockAddOwnershipTransferProgressParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// AdminStorageMockAddOwnershipTransferProgressExpectation specifies expectation struct of the AdminStorage.AddOwnershipTransferProgress
+type AdminStorageMockAddOwnershipTransferProgressExpectation struct {
+	mock               *AdminStorageMock
+	params             *AdminStorageMockAddOwnershipTransferProgressParams
+	paramPtrs          *AdminStorageMockAddOwnershipTransferProgressParamPtrs
+	expectationOrigins AdminStorageMockAddOwnershipTransferProgressExpectationOrigins
+	results            *AdminStorageMockAddOwnershipTransferProgressResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// AdminStorageMockAddOwnershipTransferProgressParams contains parameters of the AdminStorage.AddOwnershipTransferProgress
+type AdminStorageMockAddOwnershipTransferProgressParams struct {
+	ctx        context.Context
+	transferID uint64
+	vacancies  uint32
+	candidates uint32
+}
+
+// AdminStorageMockAddOwnershipTransferProgressParamPtrs contains pointers to parameters of the AdminStorage.AddOwnershipTransferProgress
+type AdminStorageMockAddOwnershipTransferProgressParamPtrs struct {
+	ctx        *context.Context
+	transferID *uint64
+	vacancies  *uint32
+	candidates *uint32
+}
+
+// AdminStorageMockAddOwnershipTransferProgressResults contains results of the AdminStorage.AddOwnershipTransferProgress
+type AdminStorageMockAddOwnershipTransferProgressResults struct {
+	err error
+}
+
+// AdminStorageMockAddOwnershipTransferProgressOrigins contains origins of expectations of the AdminStorage.AddOwnershipTransferProgress
+type AdminStorageMockAddOwnershipTransferProgressExpectationOrigins struct {
+	origin           string
+	originCtx        string
+	originTransferID string
+	originVacancies  string
+	originCandidates string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmAddOwnershipTransferProgress *mAdminStorageMockAddOwnershipTransferProgress) Optional() *mAdminStorageMockAddOwnershipTransferProgress {
+	mmAddOwnershipTransferProgress.optional = true
+	return mmAddOwnershipTransferProgress
+}
+
+// Expect sets up expected params for AdminStorage.AddOwnershipTransferProgress
+func (mmAddOwnershipTransferProgress *mAdminStorageMockAddOwnershipTransferProgress) Expect(ctx context.Context, transferID uint64, vacancies uint32, candidates uint32) *mAdminStorageMockAddOwnershipTransferProgress {
+	if mmAddOwnershipTransferProgress.mock.funcAddOwnershipTransferProgress != nil {
+		mmAddOwnershipTransferProgress.mock.t.Fatalf("AdminStorageMock.AddOwnershipTransferProgress mock is already set by Set")
+	}
+
+	if mmAddOwnershipTransferProgress.defaultExpectation == nil {
+		mmAddOwnershipTransferProgress.defaultExpectation = &AdminStorageMockAddOwnershipTransferProgressExpectation{}
+	}
+
+	if mmAddOwnershipTransferProgress.defaultExpectation.paramPtrs != nil {
+		mmAddOwnershipTransferProgress.mock.t.Fatalf("AdminStorageMock.AddOwnershipTransferProgress mock is already set by ExpectParams functions")
+	}
+
+	mmAddOwnershipTransferProgress.defaultExpectation.params = &AdminStorageMockAddOwnershipTransferProgressParams{ctx, transferID, vacancies, candidates}
+	mmAddOwnershipTransferProgress.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmAddOwnershipTransferProgress.expectations {
+		if minimock.Equal(e.params, mmAddOwnershipTransferProgress.defaultExpectation.params) {
+			mmAddOwnershipTransferProgress.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmAddOwnershipTransferProgress.defaultExpectation.params)
+		}
+	}
+
+	return mmAddOwnershipTransferProgress
+}
+
+// ExpectCtxParam1 sets up expected param ctx for AdminStorage.AddOwnershipTransferProgress
+func (mmAddOwnershipTransferProgress *mAdminStorageMockAddOwnershipTransferProgress) ExpectCtxParam1(ctx context.Context) *mAdminStorageMockAddOwnershipTransferProgress {
+	if mmAddOwnershipTransferProgress.mock.funcAddOwnershipTransferProgress != nil {
+		mmAddOwnershipTransferProgress.mock.t.Fatalf("AdminStorageMock.AddOwnershipTransferProgress mock is already set by Set")
+	}
+
+	if mmAddOwnershipTransferProgress.defaultExpectation == nil {
+		mmAddOwnershipTransferProgress.defaultExpectation = &AdminStorageMockAddOwnershipTransferProgressExpectation{}
+	}
+
+	if mmAddOwnershipTransferProgress.defaultExpectation.params != nil {
+		mmAddOwnershipTransferProgress.mock.t.Fatalf("AdminStorageMock.AddOwnershipTransferProgress mock is already set by Expect")
+	}
+
+	if mmAddOwnershipTransferProgress.defaultExpectation.paramPtrs == nil {
+		mmAddOwnershipTransferProgress.defaultExpectation.paramPtrs = &AdminStorageMockAddOwnershipTransferProgressParamPtrs{}
+	}
+	mmAddOwnershipTransferProgress.defaultExpectation.paramPtrs.ctx = &ctx
+	mmAddOwnershipTransferProgress.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmAddOwnershipTransferProgress
+}
+
+// ExpectTransferIDParam2 sets up expected param transferID for AdminStorage.AddOwnershipTransferProgress
+func (mmAddOwnershipTransferProgress *mAdminStorageMockAddOwnershipTransferProgress) ExpectTransferIDParam2(transferID uint64) *mAdminStorageMockAddOwnershipTransferProgress {
+	if mmAddOwnershipTransferProgress.mock.funcAddOwnershipTransferProgress != nil {
+		mmAddOwnershipTransferProgress.mock.t.Fatalf("AdminStorageMock.AddOwnershipTransferProgress mock is already set by Set")
+	}
+
+	if mmAddOwnershipTransferProgress.defaultExpectation == nil {
+		mmAddOwnershipTransferProgress.defaultExpectation = &AdminStorageMockAddOwnershipTransferProgressExpectation{}
+	}
+
+	if mmAddOwnershipTransferProgress.defaultExpectation.params != nil {
+		mmAddOwnershipTransferProgress.mock.t.Fatalf("AdminStorageMock.AddOwnershipTransferProgress mock is already set by Expect")
+	}
+
+	if mmAddOwnershipTransferProgress.defaultExpectation.paramPtrs == nil {
+		mmAddOwnershipTransferProgress.defaultExpectation.paramPtrs = &AdminStorageMockAddOwnershipTransferProgressParamPtrs{}
+	}
+	mmAddOwnershipTransferProgress.defaultExpectation.paramPtrs.transferID = &transferID
+	mmAddOwnershipTransferProgress.defaultExpectation.expectationOrigins.originTransferID = minimock.CallerInfo(1)
+
+	return mmAddOwnershipTransferProgress
+}
+
+// ExpectVacanciesParam3 sets up expected param vacancies for AdminStorage.AddOwnershipTransferProgress
+func (mmAddOwnershipTransferProgress *mAdminStorageMockAddOwnershipTransferProgress) ExpectVacanciesParam3(vacancies uint32) *mAdminStorageMockAddOwnershipTransferProgress {
+	if mmAddOwnershipTransferProgress.mock.funcAddOwnershipTransferProgress != nil {
+		mmAddOwnershipTransferProgress.mock.t.Fatalf("AdminStorageMock.AddOwnershipTransferProgress mock is already set by Set")
+	}
+
+	if mmAddOwnershipTransferProgress.defaultExpectation == nil {
+		mmAddOwnershipTransferProgress.defaultExpectation = &AdminStorageMockAddOwnershipTransferProgressExpectation{}
+	}
+
+	if mmAddOwnershipTransferProgress.defaultExpectation.params != nil {
+		mmAddOwnershipTransferProgress.mock.t.Fatalf("AdminStorageMock.AddOwnershipTransferProgress mock is already set by Expect")
+	}
+
+	if mmAddOwnershipTransferProgress.defaultExpectation.paramPtrs == nil {
+		mmAddOwnershipTransferProgress.defaultExpectation.paramPtrs = &AdminStorageMockAddOwnershipTransferProgressParamPtrs{}
+	}
+	mmAddOwnershipTransferProgress.defaultExpectation.paramPtrs.vacancies = &vacancies
+	mmAddOwnershipTransferProgress.defaultExpectation.expectationOrigins.originVacancies = minimock.CallerInfo(1)
+
+	return mmAddOwnershipTransferProgress
+}
+
+// ExpectCandidatesParam4 sets up expected param candidates for AdminStorage.AddOwnershipTransferProgress
+func (mmAddOwnershipTransferProgress *mAdminStorageMockAddOwnershipTransferProgress) ExpectCandidatesParam4(candidates uint32) *mAdminStorageMockAddOwnershipTransferProgress {
+	if mmAddOwnershipTransferProgress.mock.funcAddOwnershipTransferProgress != nil {
+		mmAddOwnershipTransferProgress.mock.t.Fatalf("AdminStorageMock.AddOwnershipTransferProgress mock is already set by Set")
+	}
+
+	if mmAddOwnershipTransferProgress.defaultExpectation == nil {
+		mmAddOwnershipTransferProgress.defaultExpectation = &AdminStorageMockAddOwnershipTransferProgressExpectation{}
+	}
+
+	if mmAddOwnershipTransferProgress.defaultExpectation.params != nil {
+		mmAddOwnershipTransferProgress.mock.t.Fatalf("AdminStorageMock.AddOwnershipTransferProgress mock is already set by Expect")
+	}
+
+	if mmAddOwnershipTransferProgress.defaultExpectation.paramPtrs == nil {
+		mmAddOwnershipTransferProgress.defaultExpectation.paramPtrs = &AdminStorageMockAddOwnershipTransferProgressParamPtrs{}
+	}
+	mmAddOwnershipTransferProgress.defaultExpectation.paramPtrs.candidates = &candidates
+	mmAddOwnershipTransferProgress.defaultExpectation.expectationOrigins.originCandidates = minimock.CallerInfo(1)
+
+	return mmAddOwnershipTransferProgress
+}
+
+// Inspect accepts an inspector function that has same arguments as the AdminStorage.AddOwnershipTransferProgress
+func (mmAddOwnershipTransferProgress *mAdminStorageMockAddOwnershipTransferProgress) Inspect(f func(ctx context.Context, transferID uint64, vacancies uint32, candidates uint32)) *mAdminStorageMockAddOwnershipTransferProgress {
+	if mmAddOwnershipTransferProgress.mock.inspectFuncAddOwnershipTransferProgress != nil {
+		mmAddOwnershipTransferProgress.mock.t.Fatalf("Inspect function is already set for AdminStorageMock.AddOwnershipTransferProgress")
+	}
+
+	mmAddOwnershipTransferProgress.mock.inspectFuncAddOwnershipTransferProgress = f
+
+	return mmAddOwnershipTransferProgress
+}
+
+// Return sets up results that will be returned by AdminStorage.AddOwnershipTransferProgress
+func (mmAddOwnershipTransferProgress *mAdminStorageMockAddOwnershipTransferProgress) Return(err error) *AdminStorageMock {
+	if mmAddOwnershipTransferProgress.mock.funcAddOwnershipTransferProgress != nil {
+		mmAddOwnershipTransferProgress.mock.t.Fatalf("AdminStorageMock.AddOwnershipTransferProgress mock is already set by Set")
+	}
+
+	if mmAddOwnershipTransferProgress.defaultExpectation == nil {
+		mmAddOwnershipTransferProgress.defaultExpectation = &AdminStorageMockAddOwnershipTransferProgressExpectation{mock: mmAddOwnershipTransferProgress.mock}
+	}
+	mmAddOwnershipTransferProgress.defaultExpectation.results = &AdminStorageMockAddOwnershipTransferProgressResults{err}
+	mmAddOwnershipTransferProgress.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmAddOwnershipTransferProgress.mock
+}
+
+// Set uses given function f to mock the AdminStorage.AddOwnershipTransferProgress method
+func (mmAddOwnershipTransferProgress *mAdminStorageMockAddOwnershipTransferProgress) Set(f func(ctx context.Context, transferID uint64, vacancies uint32, candidates uint32) (err error)) *AdminStorageMock {
+	if mmAddOwnershipTransferProgress.defaultExpectation != nil {
+		mmAddOwnershipTransferProgress.mock.t.Fatalf("Default expectation is already set for the AdminStorage.AddOwnershipTransferProgress method")
+	}
+
+	if len(mmAddOwnershipTransferProgress.expectations) > 0 {
+		mmAddOwnershipTransferProgress.mock.t.Fatalf("Some expectations are already set for the AdminStorage.AddOwnershipTransferProgress method")
+	}
+
+	mmAddOwnershipTransferProgress.mock.funcAddOwnershipTransferProgress = f
+	mmAddOwnershipTransferProgress.mock.funcAddOwnershipTransferProgressOrigin = minimock.CallerInfo(1)
+	return mmAddOwnershipTransferProgress.mock
+}
+
+// When sets expectation for the AdminStorage.AddOwnershipTransferProgress which will trigger the result defined by the following
+// Then helper
+func (mmAddOwnershipTransferProgress *mAdminStorageMockAddOwnershipTransferProgress) When(ctx context.Context, transferID uint64, vacancies uint32, candidates uint32) *AdminStorageMockAddOwnershipTransferProgressExpectation {
+	if mmAddOwnershipTransferProgress.mock.funcAddOwnershipTransferProgress != nil {
+		mmAddOwnershipTransferProgress.mock.t.Fatalf("AdminStorageMock.AddOwnershipTransferProgress mock is already set by Set")
+	}
+
+	expectation := &AdminStorageMockAddOwnershipTransferProgressExpectation{
+		mock:               mmAddOwnershipTransferProgress.mock,
+		params:             &AdminStorageMockAddOwnershipTransferProgressParams{ctx, transferID, vacancies, candidates},
+		expectationOrigins: AdminStorageMockAddOwnershipTransferProgressExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmAddOwnershipTransferProgress.expectations = append(mmAddOwnershipTransferProgress.expectations, expectation)
+	return expectation
+}
+
+// Then sets up AdminStorage.AddOwnershipTransferProgress return parameters for the expectation previously defined by the When method
+func (e *AdminStorageMockAddOwnershipTransferProgressExpectation) Then(err error) *AdminStorageMock {
+	e.results = &AdminStorageMockAddOwnershipTransferProgressResults{err}
+	return e.mock
+}
+
+// Times sets number of times AdminStorage.AddOwnershipTransferProgress should be invoked
+func (mmAddOwnershipTransferProgress *mAdminStorageMockAddOwnershipTransferProgress) Times(n uint64) *mAdminStorageMockAddOwnershipTransferProgress {
+	if n == 0 {
+		mmAddOwnershipTransferProgress.mock.t.Fatalf("Times of AdminStorageMock.AddOwnershipTransferProgress mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmAddOwnershipTransferProgress.expectedInvocations, n)
+	mmAddOwnershipTransferProgress.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmAddOwnershipTransferProgress
+}
+
+func (mmAddOwnershipTransferProgress *mAdminStorageMockAddOwnershipTransferProgress) invocationsDone() bool {
+	if len(mmAddOwnershipTransferProgress.expectations) == 0 && mmAddOwnershipTransferProgress.defaultExpectation == nil && mmAddOwnershipTransferProgress.mock.funcAddOwnershipTransferProgress == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmAddOwnershipTransferProgress.mock.afterAddOwnershipTransferProgressCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmAddOwnershipTransferProgress.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// AddOwnershipTransferProgress implements mm_usecase.AdminStorage
+func (mmAddOwnershipTransferProgress *AdminStorageMock) AddOwnershipTransferProgress(ctx context.Context, transferID uint64, vacancies uint32, candidates uint32) (err error) {
+	mm_atomic.AddUint64(&mmAddOwnershipTransferProgress.beforeAddOwnershipTransferProgressCounter, 1)
+	defer mm_atomic.AddUint64(&mmAddOwnershipTransferProgress.afterAddOwnershipTransferProgressCounter, 1)
+
+	mmAddOwnershipTransferProgress.t.Helper()
+
+	if mmAddOwnershipTransferProgress.inspectFuncAddOwnershipTransferProgress != nil {
+		mmAddOwnershipTransferProgress.inspectFuncAddOwnershipTransferProgress(ctx, transferID, vacancies, candidates)
+	}
+
+	mm_params := AdminStorageMockAddOwnershipTransferProgressParams{ctx, transferID, vacancies, candidates}
+
+	// Record call args
+	mmAddOwnershipTransferProgress.AddOwnershipTransferProgressMock.mutex.Lock()
+	mmAddOwnershipTransferProgress.AddOwnershipTransferProgressMock.callArgs = append(mmAddOwnershipTransferProgress.AddOwnershipTransferProgressMock.callArgs, &mm_params)
+	mmAddOwnershipTransferProgress.AddOwnershipTransferProgressMock.mutex.Unlock()
+
+	for _, e := range mmAddOwnershipTransferProgress.AddOwnershipTransferProgressMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.err
+		}
+	}
+
+	if mmAddOwnershipTransferProgress.AddOwnershipTransferProgressMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmAddOwnershipTransferProgress.AddOwnershipTransferProgressMock.defaultExpectation.Counter, 1)
+		mm_want := mmAddOwnershipTransferProgress.AddOwnershipTransferProgressMock.defaultExpectation.params
+		mm_want_ptrs := mmAddOwnershipTransferProgress.AddOwnershipTransferProgressMock.defaultExpectation.paramPtrs
+
+		mm_got := AdminStorageMockAddOwnershipTransferProgressParams{ctx, transferID, vacancies, candidates}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmAddOwnershipTransferProgress.t.Errorf("AdminStorageMock.AddOwnershipTransferProgress got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmAddOwnershipTransferProgress.AddOwnershipTransferProgressMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.transferID != nil && !minimock.Equal(*mm_want_ptrs.transferID, mm_got.transferID) {
+				mmAddOwnershipTransferProgress.t.Errorf("AdminStorageMock.AddOwnershipTransferProgress got unexpected parameter transferID, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmAddOwnershipTransferProgress.AddOwnershipTransferProgressMock.defaultExpectation.expectationOrigins.originTransferID, *mm_want_ptrs.transferID, mm_got.transferID, minimock.Diff(*mm_want_ptrs.transferID, mm_got.transferID))
+			}
+
+			if mm_want_ptrs.vacancies != nil && !minimock.Equal(*mm_want_ptrs.vacancies, mm_got.vacancies) {
+				mmAddOwnershipTransferProgress.t.Errorf("AdminStorageMock.AddOwnershipTransferProgress got unexpected parameter vacancies, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmAddOwnershipTransferProgress.AddOwnershipTransferProgressMock.defaultExpectation.expectationOrigins.originVacancies, *mm_want_ptrs.vacancies, mm_got.vacancies, minimock.Diff(*mm_want_ptrs.vacancies, mm_got.vacancies))
+			}
+
+			if mm_want_ptrs.candidates != nil && !minimock.Equal(*mm_want_ptrs.candidates, mm_got.candidates) {
+				mmAddOwnershipTransferProgress.t.Errorf("AdminStorageMock.AddOwnershipTransferProgress got unexpected parameter candidates, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmAddOwnershipTransferProgress.AddOwnershipTransferProgressMock.defaultExpectation.expectationOrigins.originCandidates, *mm_want_ptrs.candidates, mm_got.candidates, minimock.Diff(*mm_want_ptrs.candidates, mm_got.candidates))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmAddOwnershipTransferProgress.t.Errorf("AdminStorageMock.AddOwnershipTransferProgress got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmAddOwnershipTransferProgress.AddOwnershipTransferProgressMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmAddOwnershipTransferProgress.AddOwnershipTransferProgressMock.defaultExpectation.results
+		if mm_results == nil {
+			mmAddOwnershipTransferProgress.t.Fatal("No results are set for the AdminStorageMock.AddOwnershipTransferProgress")
+		}
+		return (*mm_results).err
+	}
+	if mmAddOwnershipTransferProgress.funcAddOwnershipTransferProgress != nil {
+		return mmAddOwnershipTransferProgress.funcAddOwnershipTransferProgress(ctx, transferID, vacancies, candidates)
+	}
+	mmAddOwnershipTransferProgress.t.Fatalf("Unexpected call to AdminStorageMock.AddOwnershipTransferProgress. %v %v %v %v", ctx, transferID, vacancies, candidates)
+	return
+}
+
+// AddOwnershipTransferProgressAfterCounter returns a count of finished AdminStorageMock.AddOwnershipTransferProgress invocations
+func (mmAddOwnershipTransferProgress *AdminStorageMock) AddOwnershipTransferProgressAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmAddOwnershipTransferProgress.afterAddOwnershipTransferProgressCounter)
+}
+
+// AddOwnershipTransferProgressBeforeCounter returns a count of AdminStorageMock.AddOwnershipTransferProgress invocations
+func (mmAddOwnershipTransferProgress *AdminStorageMock) AddOwnershipTransferProgressBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmAddOwnershipTransferProgress.beforeAddOwnershipTransferProgressCounter)
+}
+
+// Calls returns a list of arguments used in each call to AdminStorageMock.AddOwnershipTransferProgress.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmAddOwnershipTransferProgress *mAdminStorageMockAddOwnershipTransferProgress) Calls() []*AdminStorageMockAddOwnershipTransferProgressParams {
+	mmAddOwnershipTransferProgress.mutex.RLock()
+
+	argCopy := make([]*AdminStorageMockAddOwnershipTransferProgressParams, len(mmAddOwnershipTransferProgress.callArgs))
+	copy(argCopy, mmAddOwnershipTransferProgress.callArgs)
+
+	mmAddOwnershipTransferProgress.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockAddOwnershipTransferProgressDone returns true if the count of the AddOwnershipTransferProgress invocations corresponds
+// the number of defined expectations
+func (m *AdminStorageMock) MinimockAddOwnershipTransferProgressDone() bool {
+	if m.AddOwnershipTransferProgressMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.AddOwnershipTransferProgressMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.AddOwnershipTransferProgressMock.invocationsDone()
+}
+
+// MinimockAddOwnershipTransferProgressInspect logs each unmet expectation
+func (m *AdminStorageMock) MinimockAddOwnershipTransferProgressInspect() {
+	for _, e := range m.AddOwnershipTransferProgressMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to AdminStorageMock.AddOwnershipTransferProgress at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterAddOwnershipTransferProgressCounter := mm_atomic.LoadUint64(&m.afterAddOwnershipTransferProgressCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.AddOwnershipTransferProgressMock.defaultExpectation != nil && afterAddOwnershipTransferProgressCounter < 1 {
+		if m.AddOwnershipTransferProgressMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to AdminStorageMock.AddOwnershipTransferProgress at\n%s", m.AddOwnershipTransferProgressMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to AdminStorageMock.AddOwnershipTransferProgress at\n%s with params: %#v", m.AddOwnershipTransferProgressMock.defaultExpectation.expectationOrigins.origin, *m.AddOwnershipTransferProgressMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcAddOwnershipTransferProgress != nil && afterAddOwnershipTransferProgressCounter < 1 {
+		m.t.Errorf("Expected call to AdminStorageMock.AddOwnershipTransferProgress at\n%s", m.funcAddOwnershipTransferProgressOrigin)
+	}
+
+	if !m.AddOwnershipTransferProgressMock.invocationsDone() && afterAddOwnershipTransferProgressCounter > 0 {
+		m.t.Errorf("Expected %d calls to AdminStorageMock.AddOwnershipTransferProgress at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.AddOwnershipTransferProgressMock.expectedInvocations), m.AddOwnershipTransferProgressMock.expectedInvocationsOrigin, afterAddOwnershipTransferProgressCounter)
+	}
+}
+
+type mAdminStorageMockFinishOwnershipTransfer struct {
+	optional           bool
+	mock               *AdminStorageMock
+	defaultExpectation *AdminStorageMockFinishOwnershipTransferExpectation
+	expectations       []*AdminStorageMockFinishOwnershipTransferExpectation
+
+	callArgs []*AdminStorageMockFinishOwnershipTransferParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// AdminStorageMockFinishOwnershipTransferExpectation specifies expectation struct of the AdminStorage.FinishOwnershipTransfer
+type AdminStorageMockFinishOwnershipTransferExpectation struct {
+	mock               *AdminStorageMock
+	params             *AdminStorageMockFinishOwnershipTransferParams
+	paramPtrs          *AdminStorageMockFinishOwnershipTransferParamPtrs
+	expectationOrigins AdminStorageMockFinishOwnershipTransferExpectationOrigins
+	results            *AdminStorageMockFinishOwnershipTransferResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// AdminStorageMockFinishOwnershipTransferParams contains parameters of the AdminStorage.FinishOwnershipTransfer
+type AdminStorageMockFinishOwnershipTransferParams struct {
+	ctx        context.Context
+	transferID uint64
+	status     string
+	errMsg     string
+}
+
+// AdminStorageMockFinishOwnershipTransferParamPtrs contains pointers to parameters of the AdminStorage.FinishOwnershipTransfer
+type AdminStorageMockFinishOwnershipTransferParamPtrs struct {
+	ctx        *context.Context
+	transferID *uint64
+	status     *string
+	errMsg     *string
+}
+
+// AdminStorageMockFinishOwnershipTransferResults contains results of the AdminStorage.FinishOwnershipTransfer
+type AdminStorageMockFinishOwnershipTransferResults struct {
+	op1 *domain.OwnershipTransfer
+	err error
+}
+
+// AdminStorageMockFinishOwnershipTransferOrigins contains origins of expectations of the AdminStorage.FinishOwnershipTransfer
+type AdminStorageMockFinishOwnershipTransferExpectationOrigins struct {
+	origin           string
+	originCtx        string
+	originTransferID string
+	originStatus     string
+	originErrMsg     string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmFinishOwnershipTransfer *mAdminStorageMockFinishOwnershipTransfer) Optional() *mAdminStorageMockFinishOwnershipTransfer {
+	mmFinishOwnershipTransfer.optional = true
+	return mmFinishOwnershipTransfer
+}
+
+// Expect sets up expected params for AdminStorage.FinishOwnershipTransfer
+func (mmFinishOwnershipTransfer *mAdminStorageMockFinishOwnershipTransfer) Expect(ctx context.Context, transferID uint64, status string, errMsg string) *mAdminStorageMockFinishOwnershipTransfer {
+	if mmFinishOwnershipTransfer.mock.funcFinishOwnershipTransfer != nil {
+		mmFinishOwnershipTransfer.mock.t.Fatalf("AdminStorageMock.FinishOwnershipTransfer mock is already set by Set")
+	}
+
+	if mmFinishOwnershipTransfer.defaultExpectation == nil {
+		mmFinishOwnershipTransfer.defaultExpectation = &AdminStorageMockFinishOwnershipTransferExpectation{}
+	}
+
+	if mmFinishOwnershipTransfer.defaultExpectation.paramPtrs != nil {
+		mmFinishOwnershipTransfer.mock.t.Fatalf("AdminStorageMock.FinishOwnershipTransfer mock is already set by ExpectParams functions")
+	}
+
+	mmFinishOwnershipTransfer.defaultExpectation.params = &AdminStorageMockFinishOwnershipTransferParams{ctx, transferID, status, errMsg}
+	mmFinishOwnershipTransfer.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmFinishOwnershipTransfer.expectations {
+		if minimock.Equal(e.params, mmFinishOwnershipTransfer.defaultExpectation.params) {
+			mmFinishOwnershipTransfer.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmFinishOwnershipTransfer.defaultExpectation.params)
+		}
+	}
+
+	return mmFinishOwnershipTransfer
+}
+
+// ExpectCtxParam1 sets up expected param ctx for AdminStorage.FinishOwnershipTransfer
+func (mmFinishOwnershipTransfer *mAdminStorageMockFinishOwnershipTransfer) ExpectCtxParam1(ctx context.Context) *mAdminStorageMockFinishOwnershipTransfer {
+	if mmFinishOwnershipTransfer.mock.funcFinishOwnershipTransfer != nil {
+		mmFinishOwnershipTransfer.mock.t.Fatalf("AdminStorageMock.FinishOwnershipTransfer mock is already set by Set")
+	}
+
+	if mmFinishOwnershipTransfer.defaultExpectation == nil {
+		mmFinishOwnershipTransfer.defaultExpectation = &AdminStorageMockFinishOwnershipTransferExpectation{}
+	}
+
+	if mmFinishOwnershipTransfer.defaultExpectation.params != nil {
+		mmFinishOwnershipTransfer.mock.t.Fatalf("AdminStorageMock.FinishOwnershipTransfer mock is already set by Expect")
+	}
+
+	if mmFinishOwnershipTransfer.defaultExpectation.paramPtrs == nil {
+		mmFinishOwnershipTransfer.defaultExpectation.paramPtrs = &AdminStorageMockFinishOwnershipTransferParamPtrs{}
+	}
+	mmFinishOwnershipTransfer.defaultExpectation.paramPtrs.ctx = &ctx
+	mmFinishOwnershipTransfer.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmFinishOwnershipTransfer
+}
+
+// ExpectTransferIDParam2 sets up expected param transferID for AdminStorage.FinishOwnershipTransfer
+func (mmFinishOwnershipTransfer *mAdminStorageMockFinishOwnershipTransfer) ExpectTransferIDParam2(transferID uint64) *mAdminStorageMockFinishOwnershipTransfer {
+	if mmFinishOwnershipTransfer.mock.funcFinishOwnershipTransfer != nil {
+		mmFinishOwnershipTransfer.mock.t.Fatalf("AdminStorageMock.FinishOwnershipTransfer mock is already set by Set")
+	}
+
+	if mmFinishOwnershipTransfer.defaultExpectation == nil {
+		mmFinishOwnershipTransfer.defaultExpectation = &AdminStorageMockFinishOwnershipTransferExpectation{}
+	}
+
+	if mmFinishOwnershipTransfer.defaultExpectation.params != nil {
+		mmFinishOwnershipTransfer.mock.t.Fatalf("AdminStorageMock.FinishOwnershipTransfer mock is already set by Expect")
+	}
+
+	if mmFinishOwnershipTransfer.defaultExpectation.paramPtrs == nil {
+		mmFinishOwnershipTransfer.defaultExpectation.paramPtrs = &AdminStorageMockFinishOwnershipTransferParamPtrs{}
+	}
+	mmFinishOwnershipTransfer.defaultExpectation.paramPtrs.transferID = &transferID
+	mmFinishOwnershipTransfer.defaultExpectation.expectationOrigins.originTransferID = minimock.CallerInfo(1)
+
+	return mmFinishOwnershipTransfer
+}
+
+// ExpectStatusParam3 sets up expected param status for AdminStorage.FinishOwnershipTransfer
+func (mmFinishOwnershipTransfer *mAdminStorageMockFinishOwnershipTransfer) ExpectStatusParam3(status string) *mAdminStorageMockFinishOwnershipTransfer {
+	if mmFinishOwnershipTransfer.mock.funcFinishOwnershipTransfer != nil {
+		mmFinishOwnershipTransfer.mock.t.Fatalf("AdminStorageMock.FinishOwnershipTransfer mock is already set by Set")
+	}
+
+	if mmFinishOwnershipTransfer.defaultExpectation == nil {
+		mmFinishOwnershipTransfer.defaultExpectation = &AdminStorageMockFinishOwnershipTransferExpectation{}
+	}
+
+	if mmFinishOwnershipTransfer.defaultExpectation.params != nil {
+		mmFinishOwnershipTransfer.mock.t.Fatalf("AdminStorageMock.FinishOwnershipTransfer mock is already set by Expect")
+	}
+
+	if mmFinishOwnershipTransfer.defaultExpectation.paramPtrs == nil {
+		mmFinishOwnershipTransfer.defaultExpectation.paramPtrs = &AdminStorageMockFinishOwnershipTransferParamPtrs{}
+	}
+	mmFinishOwnershipTransfer.defaultExpectation.paramPtrs.status = &status
+	mmFinishOwnershipTransfer.defaultExpectation.expectationOrigins.originStatus = minimock.CallerInfo(1)
+
+	return mmFinishOwnershipTransfer
+}
+
+// ExpectErrMsgParam4 sets up expected param errMsg for AdminStorage.FinishOwnershipTransfer
+func (mmFinishOwnershipTransfer *mAdminStorageMockFinishOwnershipTransfer) ExpectErrMsgParam4(errMsg string) *mAdminStorageMockFinishOwnershipTransfer {
+	if mmFinishOwnershipTransfer.mock.funcFinishOwnershipTransfer != nil {
+		mmFinishOwnershipTransfer.mock.t.Fatalf("AdminStorageMock.FinishOwnershipTransfer mock is already set by Set")
+	}
+
+	if mmFinishOwnershipTransfer.defaultExpectation == nil {
+		mmFinishOwnershipTransfer.defaultExpectation = &AdminStorageMockFinishOwnershipTransferExpectation{}
+	}
+
+	if mmFinishOwnershipTransfer.defaultExpectation.params != nil {
+		mmFinishOwnershipTransfer.mock.t.Fatalf("AdminStorageMock.FinishOwnershipTransfer mock is already set by Expect")
+	}
+
+	if mmFinishOwnershipTransfer.defaultExpectation.paramPtrs == nil {
+		mmFinishOwnershipTransfer.defaultExpectation.paramPtrs = &AdminStorageMockFinishOwnershipTransferParamPtrs{}
+	}
+	mmFinishOwnershipTransfer.defaultExpectation.paramPtrs.errMsg = &errMsg
+	mmFinishOwnershipTransfer.defaultExpectation.expectationOrigins.originErrMsg = minimock.CallerInfo(1)
+
+	return mmFinishOwnershipTransfer
+}
+
+// Inspect accepts an inspector function that has same arguments as the AdminStorage.FinishOwnershipTransfer
+func (mmFinishOwnershipTransfer *mAdminStorageMockFinishOwnershipTransfer) Inspect(f func(ctx context.Context, transferID uint64, status string, errMsg string)) *mAdminStorageMockFinishOwnershipTransfer {
+	if mmFinishOwnershipTransfer.mock.inspectFuncFinishOwnershipTransfer != nil {
+		mmFinishOwnershipTransfer.mock.t.Fatalf("Inspect function is already set for AdminStorageMock.FinishOwnershipTransfer")
+	}
+
+	mmFinishOwnershipTransfer.mock.inspectFuncFinishOwnershipTransfer = f
+
+	return mmFinishOwnershipTransfer
+}
+
+// Return sets up results that will be returned by AdminStorage.FinishOwnershipTransfer
+func (mmFinishOwnershipTransfer *mAdminStorageMockFinishOwnershipTransfer) Return(op1 *domain.OwnershipTransfer, err error) *AdminStorageMock {
+	if mmFinishOwnershipTransfer.mock.funcFinishOwnershipTransfer != nil {
+		mmFinishOwnershipTransfer.mock.t.Fatalf("AdminStorageMock.FinishOwnershipTransfer mock is already set by Set")
+	}
+
+	if mmFinishOwnershipTransfer.defaultExpectation == nil {
+		mmFinishOwnershipTransfer.defaultExpectation = &AdminStorageMockFinishOwnershipTransferExpectation{mock: mmFinishOwnershipTransfer.mock}
+	}
+	mmFinishOwnershipTransfer.defaultExpectation.results = &AdminStorageMockFinishOwnershipTransferResults{op1, err}
+	mmFinishOwnershipTransfer.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmFinishOwnershipTransfer.mock
+}
+
+// Set uses given function f to mock the AdminStorage.FinishOwnershipTransfer method
+func (mmFinishOwnershipTransfer *mAdminStorageMockFinishOwnershipTransfer) Set(f func(ctx context.Context, transferID uint64, status string, errMsg string) (op1 *domain.OwnershipTransfer, err error)) *AdminStorageMock {
+	if mmFinishOwnershipTransfer.defaultExpectation != nil {
+		mmFinishOwnershipTransfer.mock.t.Fatalf("Default expectation is already set for the AdminStorage.FinishOwnershipTransfer method")
+	}
+
+	if len(mmFinishOwnershipTransfer.expectations) > 0 {
+		mmFinishOwnershipTransfer.mock.t.Fatalf("Some expectations are already set for the AdminStorage.FinishOwnershipTransfer method")
+	}
+
+	mmFinishOwnershipTransfer.mock.funcFinishOwnershipTransfer = f
+	mmFinishOwnershipTransfer.mock.funcFinishOwnershipTransferOrigin = minimock.CallerInfo(1)
+	return mmFinishOwnershipTransfer.mock
+}
+
+// When sets expectation for the AdminStorage.FinishOwnershipTransfer which will trigger the result defined by the following
+// Then helper
+func (mmFinishOwnershipTransfer *mAdminStorageMockFinishOwnershipTransfer) When(ctx context.Context, transferID uint64, status string, errMsg string) *AdminStorageMockFinishOwnershipTransferExpectation {
+	if mmFinishOwnershipTransfer.mock.funcFinishOwnershipTransfer != nil {
+		mmFinishOwnershipTransfer.mock.t.Fatalf("AdminStorageMock.FinishOwnershipTransfer mock is already set by Set")
+	}
+
+	expectation := &AdminStorageMockFinishOwnershipTransferExpectation{
+		mock:               mmFinishOwnershipTransfer.mock,
+		params:             &AdminStorageMockFinishOwnershipTransferParams{ctx, transferID, status, errMsg},
+		expectationOrigins: AdminStorageMockFinishOwnershipTransferExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmFinishOwnershipTransfer.expectations = append(mmFinishOwnershipTransfer.expectations, expectation)
+	return expectation
+}
+
+// Then sets up AdminStorage.FinishOwnershipTransfer return parameters for the expectation previously defined by the When method
+func (e *AdminStorageMockFinishOwnershipTransferExpectation) Then(op1 *domain.OwnershipTransfer, err error) *AdminStorageMock {
+	e.results = &AdminStorageMockFinishOwnershipTransferResults{op1, err}
+	return e.mock
+}
+
+// Times sets number of times AdminStorage.FinishOwnershipTransfer should be invoked
+func (mmFinishOwnershipTransfer *mAdminStorageMockFinishOwnershipTransfer) Times(n uint64) *mAdminStorageMockFinishOwnershipTransfer {
+	if n == 0 {
+		mmFinishOwnershipTransfer.mock.t.Fatalf("Times of AdminStorageMock.FinishOwnershipTransfer mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmFinishOwnershipTransfer.expectedInvocations, n)
+	mmFinishOwnershipTransfer.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmFinishOwnershipTransfer
+}
+
+func (mmFinishOwnershipTransfer *mAdminStorageMockFinishOwnershipTransfer) invocationsDone() bool {
+	if len(mmFinishOwnershipTransfer.expectations) == 0 && mmFinishOwnershipTransfer.defaultExpectation == nil && mmFinishOwnershipTransfer.mock.funcFinishOwnershipTransfer == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmFinishOwnershipTransfer.mock.afterFinishOwnershipTransferCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmFinishOwnershipTransfer.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// FinishOwnershipTransfer implements mm_usecase.AdminStorage
+func (mmFinishOwnershipTransfer *AdminStorageMock) FinishOwnershipTransfer(ctx context.Context, transferID uint64, status string, errMsg string) (op1 *domain.OwnershipTransfer, err error) {
+	mm_atomic.AddUint64(&mmFinishOwnershipTransfer.beforeFinishOwnershipTransferCounter, 1)
+	defer mm_atomic.AddUint64(&mmFinishOwnershipTransfer.afterFinishOwnershipTransferCounter, 1)
+
+	mmFinishOwnershipTransfer.t.Helper()
+
+	if mmFinishOwnershipTransfer.inspectFuncFinishOwnershipTransfer != nil {
+		mmFinishOwnershipTransfer.inspectFuncFinishOwnershipTransfer(ctx, transferID, status, errMsg)
+	}
+
+	mm_params := AdminStorageMockFinishOwnershipTransferParams{ctx, transferID, status, errMsg}
+
+	// Record call args
+	mmFinishOwnershipTransfer.FinishOwnershipTransferMock.mutex.Lock()
+	mmFinishOwnershipTransfer.FinishOwnershipTransferMock.callArgs = append(mmFinishOwnershipTransfer.FinishOwnershipTransferMock.callArgs, &mm_params)
+	mmFinishOwnershipTransfer.FinishOwnershipTransferMock.mutex.Unlock()
+
+	for _, e := range mmFinishOwnershipTransfer.FinishOwnershipTransferMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.op1, e.results.err
+		}
+	}
+
+	if mmFinishOwnershipTransfer.FinishOwnershipTransferMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmFinishOwnershipTransfer.FinishOwnershipTransferMock.defaultExpectation.Counter, 1)
+		mm_want := mmFinishOwnershipTransfer.FinishOwnershipTransferMock.defaultExpectation.params
+		mm_want_ptrs := mmFinishOwnershipTransfer.FinishOwnershipTransferMock.defaultExpectation.paramPtrs
+
+		mm_got := AdminStorageMockFinishOwnershipTransferParams{ctx, transferID, status, errMsg}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmFinishOwnershipTransfer.t.Errorf("AdminStorageMock.FinishOwnershipTransfer got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmFinishOwnershipTransfer.FinishOwnershipTransferMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.transferID != nil && !minimock.Equal(*mm_want_ptrs.transferID, mm_got.transferID) {
+				mmFinishOwnershipTransfer.t.Errorf("AdminStorageMock.FinishOwnershipTransfer got unexpected parameter transferID, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmFinishOwnershipTransfer.FinishOwnershipTransferMock.defaultExpectation.expectationOrigins.originTransferID, *mm_want_ptrs.transferID, mm_got.transferID, minimock.Diff(*mm_want_ptrs.transferID, mm_got.transferID))
+			}
+
+			if mm_want_ptrs.status != nil && !minimock.Equal(*mm_want_ptrs.status, mm_got.status) {
+				mmFinishOwnershipTransfer.t.Errorf("AdminStorageMock.FinishOwnershipTransfer got unexpected parameter status, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmFinishOwnershipTransfer.FinishOwnershipTransferMock.defaultExpectation.expectationOrigins.originStatus, *mm_want_ptrs.status, mm_got.status, minimock.Diff(*mm_want_ptrs.status, mm_got.status))
+			}
+
+			if mm_want_ptrs.errMsg != nil && !minimock.Equal(*mm_want_ptrs.errMsg, mm_got.errMsg) {
+				mmFinishOwnershipTransfer.t.Errorf("AdminStorageMock.FinishOwnershipTransfer got unexpected parameter errMsg, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmFinishOwnershipTransfer.FinishOwnershipTransferMock.defaultExpectation.expectationOrigins.originErrMsg, *mm_want_ptrs.errMsg, mm_got.errMsg, minimock.Diff(*mm_want_ptrs.errMsg, mm_got.errMsg))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmFinishOwnershipTransfer.t.Errorf("AdminStorageMock.FinishOwnershipTransfer got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmFinishOwnershipTransfer.FinishOwnershipTransferMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmFinishOwnershipTransfer.FinishOwnershipTransferMock.defaultExpectation.results
+		if mm_results == nil {
+			mmFinishOwnershipTransfer.t.Fatal("No results are set for the AdminStorageMock.FinishOwnershipTransfer")
+		}
+		return (*mm_results).op1, (*mm_results).err
+	}
+	if mmFinishOwnershipTransfer.funcFinishOwnershipTransfer != nil {
+		return mmFinishOwnershipTransfer.funcFinishOwnershipTransfer(ctx, transferID, status, errMsg)
+	}
+	mmFinishOwnershipTransfer.t.Fatalf("Unexpected call to AdminStorageMock.FinishOwnershipTransfer. %v %v %v %v", ctx, transferID, status, errMsg)
+	return
+}
+
+// FinishOwnershipTransferAfterCounter returns a count of finished AdminStorageMock.FinishOwnershipTransfer invocations
+func (mmFinishOwnershipTransfer *AdminStorageMock) FinishOwnershipTransferAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmFinishOwnershipTransfer.afterFinishOwnershipTransferCounter)
+}
+
+// FinishOwnershipTransferBeforeCounter returns a count of AdminStorageMock.FinishOwnershipTransfer invocations
+func (mmFinishOwnershipTransfer *AdminStorageMock) FinishOwnershipTransferBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmFinishOwnershipTransfer.beforeFinishOwnershipTransferCounter)
+}
+
+// Calls returns a list of arguments used in each call to AdminStorageMock.FinishOwnershipTransfer.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmFinishOwnershipTransfer *mAdminStorageMockFinishOwnershipTransfer) Calls() []*AdminStorageMockFinishOwnershipTransferParams {
+	mmFinishOwnershipTransfer.mutex.RLock()
+
+	argCopy := make([]*AdminStorageMockFinishOwnershipTransferParams, len(mmFinishOwnershipTransfer.callArgs))
+	copy(argCopy, mmFinishOwnershipTransfer.callArgs)
+
+	mmFinishOwnershipTransfer.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockFinishOwnershipTransferDone returns true if the count of the FinishOwnershipTransfer invocations corresponds
+// the number of defined expectations
+func (m *AdminStorageMock) MinimockFinishOwnershipTransferDone() bool {
+	if m.FinishOwnershipTransferMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.FinishOwnershipTransferMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.FinishOwnershipTransferMock.invocationsDone()
+}
+
+// MinimockFinishOwnershipTransferInspect logs each unmet expectation
+func (m *AdminStorageMock) MinimockFinishOwnershipTransferInspect() {
+	for _, e := range m.FinishOwnershipTransferMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to AdminStorageMock.FinishOwnershipTransfer at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterFinishOwnershipTransferCounter := mm_atomic.LoadUint64(&m.afterFinishOwnershipTransferCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.FinishOwnershipTransferMock.defaultExpectation != nil && afterFinishOwnershipTransferCounter < 1 {
+		if m.FinishOwnershipTransferMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to AdminStorageMock.FinishOwnershipTransfer at\n%s", m.FinishOwnershipTransferMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to AdminStorageMock.FinishOwnershipTransfer at\n%s with params: %#v", m.FinishOwnershipTransferMock.defaultExpectation.expectationOrigins.origin, *m.FinishOwnershipTransferMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcFinishOwnershipTransfer != nil && afterFinishOwnershipTransferCounter < 1 {
+		m.t.Errorf("Expected call to AdminStorageMock.FinishOwnershipTransfer at\n%s", m.funcFinishOwnershipTransferOrigin)
+	}
 
-	return m
+	if !m.FinishOwnershipTransferMock.invocationsDone() && afterFinishOwnershipTransferCounter > 0 {
+		m.t.Errorf("Expected %d calls to AdminStorageMock.FinishOwnershipTransfer at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.FinishOwnershipTransferMock.expectedInvocations), m.FinishOwnershipTransferMock.expectedInvocationsOrigin, afterFinishOwnershipTransferCounter)
+	}
 }
 
 type mAdminStorageMockGetSystemStats struct {
@@ -197,169 +1046,512 @@ func (mmGetSystemStats *mAdminStorageMockGetSystemStats) Set(f func(ctx context.
 
 // When sets expectation for the AdminStorage.GetSystemStats which will trigger the result defined by the following
 // Then helper
-func (mmGetSystemStats *mAdminStorageMockGetSystemStats) When(ctx context.Context) *AdminStorageMockGetSystemStatsExpectation {
-	if mmGetSystemStats.mock.funcGetSystemStats != nil {
-		mmGetSystemStats.mock.t.Fatalf("AdminStorageMock.GetSystemStats mock is already set by Set")
+func (mmGetSystemStats *mAdminStorageMockGetSystemStats) When(ctx context.Context) *AdminStorageMockGetSystemStatsExpectation {
+	if mmGetSystemStats.mock.funcGetSystemStats != nil {
+		mmGetSystemStats.mock.t.Fatalf("AdminStorageMock.GetSystemStats mock is already set by Set")
+	}
+
+	expectation := &AdminStorageMockGetSystemStatsExpectation{
+		mock:               mmGetSystemStats.mock,
+		params:             &AdminStorageMockGetSystemStatsParams{ctx},
+		expectationOrigins: AdminStorageMockGetSystemStatsExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmGetSystemStats.expectations = append(mmGetSystemStats.expectations, expectation)
+	return expectation
+}
+
+// Then sets up AdminStorage.GetSystemStats return parameters for the expectation previously defined by the When method
+func (e *AdminStorageMockGetSystemStatsExpectation) Then(sp1 *domain.SystemStats, err error) *AdminStorageMock {
+	e.results = &AdminStorageMockGetSystemStatsResults{sp1, err}
+	return e.mock
+}
+
+// Times sets number of times AdminStorage.GetSystemStats should be invoked
+func (mmGetSystemStats *mAdminStorageMockGetSystemStats) Times(n uint64) *mAdminStorageMockGetSystemStats {
+	if n == 0 {
+		mmGetSystemStats.mock.t.Fatalf("Times of AdminStorageMock.GetSystemStats mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmGetSystemStats.expectedInvocations, n)
+	mmGetSystemStats.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmGetSystemStats
+}
+
+func (mmGetSystemStats *mAdminStorageMockGetSystemStats) invocationsDone() bool {
+	if len(mmGetSystemStats.expectations) == 0 && mmGetSystemStats.defaultExpectation == nil && mmGetSystemStats.mock.funcGetSystemStats == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmGetSystemStats.mock.afterGetSystemStatsCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmGetSystemStats.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// GetSystemStats implements mm_usecase.AdminStorage
+func (mmGetSystemStats *AdminStorageMock) GetSystemStats(ctx context.Context) (sp1 *domain.SystemStats, err error) {
+	mm_atomic.AddUint64(&mmGetSystemStats.beforeGetSystemStatsCounter, 1)
+	defer mm_atomic.AddUint64(&mmGetSystemStats.afterGetSystemStatsCounter, 1)
+
+	mmGetSystemStats.t.Helper()
+
+	if mmGetSystemStats.inspectFuncGetSystemStats != nil {
+		mmGetSystemStats.inspectFuncGetSystemStats(ctx)
+	}
+
+	mm_params := AdminStorageMockGetSystemStatsParams{ctx}
+
+	// Record call args
+	mmGetSystemStats.GetSystemStatsMock.mutex.Lock()
+	mmGetSystemStats.GetSystemStatsMock.callArgs = append(mmGetSystemStats.GetSystemStatsMock.callArgs, &mm_params)
+	mmGetSystemStats.GetSystemStatsMock.mutex.Unlock()
+
+	for _, e := range mmGetSystemStats.GetSystemStatsMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.sp1, e.results.err
+		}
+	}
+
+	if mmGetSystemStats.GetSystemStatsMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmGetSystemStats.GetSystemStatsMock.defaultExpectation.Counter, 1)
+		mm_want := mmGetSystemStats.GetSystemStatsMock.defaultExpectation.params
+		mm_want_ptrs := mmGetSystemStats.GetSystemStatsMock.defaultExpectation.paramPtrs
+
+		mm_got := AdminStorageMockGetSystemStatsParams{ctx}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmGetSystemStats.t.Errorf("AdminStorageMock.GetSystemStats got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmGetSystemStats.GetSystemStatsMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmGetSystemStats.t.Errorf("AdminStorageMock.GetSystemStats got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmGetSystemStats.GetSystemStatsMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmGetSystemStats.GetSystemStatsMock.defaultExpectation.results
+		if mm_results == nil {
+			mmGetSystemStats.t.Fatal("No results are set for the AdminStorageMock.GetSystemStats")
+		}
+		return (*mm_results).sp1, (*mm_results).err
+	}
+	if mmGetSystemStats.funcGetSystemStats != nil {
+		return mmGetSystemStats.funcGetSystemStats(ctx)
+	}
+	mmGetSystemStats.t.Fatalf("Unexpected call to AdminStorageMock.GetSystemStats. %v", ctx)
+	return
+}
+
+// GetSystemStatsAfterCounter returns a count of finished AdminStorageMock.GetSystemStats invocations
+func (mmGetSystemStats *AdminStorageMock) GetSystemStatsAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmGetSystemStats.afterGetSystemStatsCounter)
+}
+
+// GetSystemStatsBeforeCounter returns a count of AdminStorageMock.GetSystemStats invocations
+func (mmGetSystemStats *AdminStorageMock) GetSystemStatsBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmGetSystemStats.beforeGetSystemStatsCounter)
+}
+
+// Calls returns a list of arguments used in each call to AdminStorageMock.GetSystemStats.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmGetSystemStats *mAdminStorageMockGetSystemStats) Calls() []*AdminStorageMockGetSystemStatsParams {
+	mmGetSystemStats.mutex.RLock()
+
+	argCopy := make([]*AdminStorageMockGetSystemStatsParams, len(mmGetSystemStats.callArgs))
+	copy(argCopy, mmGetSystemStats.callArgs)
+
+	mmGetSystemStats.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockGetSystemStatsDone returns true if the count of the GetSystemStats invocations corresponds
+// the number of defined expectations
+func (m *AdminStorageMock) MinimockGetSystemStatsDone() bool {
+	if m.GetSystemStatsMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.GetSystemStatsMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.GetSystemStatsMock.invocationsDone()
+}
+
+// MinimockGetSystemStatsInspect logs each unmet expectation
+func (m *AdminStorageMock) MinimockGetSystemStatsInspect() {
+	for _, e := range m.GetSystemStatsMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to AdminStorageMock.GetSystemStats at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterGetSystemStatsCounter := mm_atomic.LoadUint64(&m.afterGetSystemStatsCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.GetSystemStatsMock.defaultExpectation != nil && afterGetSystemStatsCounter < 1 {
+		if m.GetSystemStatsMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to AdminStorageMock.GetSystemStats at\n%s", m.GetSystemStatsMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to AdminStorageMock.GetSystemStats at\n%s with params: %#v", m.GetSystemStatsMock.defaultExpectation.expectationOrigins.origin, *m.GetSystemStatsMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcGetSystemStats != nil && afterGetSystemStatsCounter < 1 {
+		m.t.Errorf("Expected call to AdminStorageMock.GetSystemStats at\n%s", m.funcGetSystemStatsOrigin)
+	}
+
+	if !m.GetSystemStatsMock.invocationsDone() && afterGetSystemStatsCounter > 0 {
+		m.t.Errorf("Expected %d calls to AdminStorageMock.GetSystemStats at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.GetSystemStatsMock.expectedInvocations), m.GetSystemStatsMock.expectedInvocationsOrigin, afterGetSystemStatsCounter)
+	}
+}
+
+type mAdminStorageMockListOwnershipTransfers struct {
+	optional           bool
+	mock               *AdminStorageMock
+	defaultExpectation *AdminStorageMockListOwnershipTransfersExpectation
+	expectations       []*AdminStorageMockListOwnershipTransfersExpectation
+
+	callArgs []*AdminStorageMockListOwnershipTransfersParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// AdminStorageMockListOwnershipTransfersExpectation specifies expectation struct of the AdminStorage.ListOwnershipTransfers
+type AdminStorageMockListOwnershipTransfersExpectation struct {
+	mock               *AdminStorageMock
+	params             *AdminStorageMockListOwnershipTransfersParams
+	paramPtrs          *AdminStorageMockListOwnershipTransfersParamPtrs
+	expectationOrigins AdminStorageMockListOwnershipTransfersExpectationOrigins
+	results            *AdminStorageMockListOwnershipTransfersResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// AdminStorageMockListOwnershipTransfersParams contains parameters of the AdminStorage.ListOwnershipTransfers
+type AdminStorageMockListOwnershipTransfersParams struct {
+	ctx   context.Context
+	limit uint32
+}
+
+// AdminStorageMockListOwnershipTransfersParamPtrs contains pointers to parameters of the AdminStorage.ListOwnershipTransfers
+type AdminStorageMockListOwnershipTransfersParamPtrs struct {
+	ctx   *context.Context
+	limit *uint32
+}
+
+// AdminStorageMockListOwnershipTransfersResults contains results of the AdminStorage.ListOwnershipTransfers
+type AdminStorageMockListOwnershipTransfersResults struct {
+	oa1 []domain.OwnershipTransfer
+	err error
+}
+
+// AdminStorageMockListOwnershipTransfersOrigins contains origins of expectations of the AdminStorage.ListOwnershipTransfers
+type AdminStorageMockListOwnershipTransfersExpectationOrigins struct {
+	origin      string
+	originCtx   string
+	originLimit string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmListOwnershipTransfers *mAdminStorageMockListOwnershipTransfers) Optional() *mAdminStorageMockListOwnershipTransfers {
+	mmListOwnershipTransfers.optional = true
+	return mmListOwnershipTransfers
+}
+
+// Expect sets up expected params for AdminStorage.ListOwnershipTransfers
+func (mmListOwnershipTransfers *mAdminStorageMockListOwnershipTransfers) Expect(ctx context.Context, limit uint32) *mAdminStorageMockListOwnershipTransfers {
+	if mmListOwnershipTransfers.mock.funcListOwnershipTransfers != nil {
+		mmListOwnershipTransfers.mock.t.Fatalf("AdminStorageMock.ListOwnershipTransfers mock is already set by Set")
+	}
+
+	if mmListOwnershipTransfers.defaultExpectation == nil {
+		mmListOwnershipTransfers.defaultExpectation = &AdminStorageMockListOwnershipTransfersExpectation{}
+	}
+
+	if mmListOwnershipTransfers.defaultExpectation.paramPtrs != nil {
+		mmListOwnershipTransfers.mock.t.Fatalf("AdminStorageMock.ListOwnershipTransfers mock is already set by ExpectParams functions")
+	}
+
+	mmListOwnershipTransfers.defaultExpectation.params = &AdminStorageMockListOwnershipTransfersParams{ctx, limit}
+	mmListOwnershipTransfers.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmListOwnershipTransfers.expectations {
+		if minimock.Equal(e.params, mmListOwnershipTransfers.defaultExpectation.params) {
+			mmListOwnershipTransfers.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmListOwnershipTransfers.defaultExpectation.params)
+		}
+	}
+
+	return mmListOwnershipTransfers
+}
+
+// ExpectCtxParam1 sets up expected param ctx for AdminStorage.ListOwnershipTransfers
+func (mmListOwnershipTransfers *mAdminStorageMockListOwnershipTransfers) ExpectCtxParam1(ctx context.Context) *mAdminStorageMockListOwnershipTransfers {
+	if mmListOwnershipTransfers.mock.funcListOwnershipTransfers != nil {
+		mmListOwnershipTransfers.mock.t.Fatalf("AdminStorageMock.ListOwnershipTransfers mock is already set by Set")
+	}
+
+	if mmListOwnershipTransfers.defaultExpectation == nil {
+		mmListOwnershipTransfers.defaultExpectation = &AdminStorageMockListOwnershipTransfersExpectation{}
+	}
+
+	if mmListOwnershipTransfers.defaultExpectation.params != nil {
+		mmListOwnershipTransfers.mock.t.Fatalf("AdminStorageMock.ListOwnershipTransfers mock is already set by Expect")
+	}
+
+	if mmListOwnershipTransfers.defaultExpectation.paramPtrs == nil {
+		mmListOwnershipTransfers.defaultExpectation.paramPtrs = &AdminStorageMockListOwnershipTransfersParamPtrs{}
+	}
+	mmListOwnershipTransfers.defaultExpectation.paramPtrs.ctx = &ctx
+	mmListOwnershipTransfers.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmListOwnershipTransfers
+}
+
+// ExpectLimitParam2 sets up expected param limit for AdminStorage.ListOwnershipTransfers
+func (mmListOwnershipTransfers *mAdminStorageMockListOwnershipTransfers) ExpectLimitParam2(limit uint32) *mAdminStorageMockListOwnershipTransfers {
+	if mmListOwnershipTransfers.mock.funcListOwnershipTransfers != nil {
+		mmListOwnershipTransfers.mock.t.Fatalf("AdminStorageMock.ListOwnershipTransfers mock is already set by Set")
+	}
+
+	if mmListOwnershipTransfers.defaultExpectation == nil {
+		mmListOwnershipTransfers.defaultExpectation = &AdminStorageMockListOwnershipTransfersExpectation{}
+	}
+
+	if mmListOwnershipTransfers.defaultExpectation.params != nil {
+		mmListOwnershipTransfers.mock.t.Fatalf("AdminStorageMock.ListOwnershipTransfers mock is already set by Expect")
+	}
+
+	if mmListOwnershipTransfers.defaultExpectation.paramPtrs == nil {
+		mmListOwnershipTransfers.defaultExpectation.paramPtrs = &AdminStorageMockListOwnershipTransfersParamPtrs{}
+	}
+	mmListOwnershipTransfers.defaultExpectation.paramPtrs.limit = &limit
+	mmListOwnershipTransfers.defaultExpectation.expectationOrigins.originLimit = minimock.CallerInfo(1)
+
+	return mmListOwnershipTransfers
+}
+
+// Inspect accepts an inspector function that has same arguments as the AdminStorage.ListOwnershipTransfers
+func (mmListOwnershipTransfers *mAdminStorageMockListOwnershipTransfers) Inspect(f func(ctx context.Context, limit uint32)) *mAdminStorageMockListOwnershipTransfers {
+	if mmListOwnershipTransfers.mock.inspectFuncListOwnershipTransfers != nil {
+		mmListOwnershipTransfers.mock.t.Fatalf("Inspect function is already set for AdminStorageMock.ListOwnershipTransfers")
+	}
+
+	mmListOwnershipTransfers.mock.inspectFuncListOwnershipTransfers = f
+
+	return mmListOwnershipTransfers
+}
+
+// Return sets up results that will be returned by AdminStorage.ListOwnershipTransfers
+func (mmListOwnershipTransfers *mAdminStorageMockListOwnershipTransfers) Return(oa1 []domain.OwnershipTransfer, err error) *AdminStorageMock {
+	if mmListOwnershipTransfers.mock.funcListOwnershipTransfers != nil {
+		mmListOwnershipTransfers.mock.t.Fatalf("AdminStorageMock.ListOwnershipTransfers mock is already set by Set")
+	}
+
+	if mmListOwnershipTransfers.defaultExpectation == nil {
+		mmListOwnershipTransfers.defaultExpectation = &AdminStorageMockListOwnershipTransfersExpectation{mock: mmListOwnershipTransfers.mock}
+	}
+	mmListOwnershipTransfers.defaultExpectation.results = &AdminStorageMockListOwnershipTransfersResults{oa1, err}
+	mmListOwnershipTransfers.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmListOwnershipTransfers.mock
+}
+
+// Set uses given function f to mock the AdminStorage.ListOwnershipTransfers method
+func (mmListOwnershipTransfers *mAdminStorageMockListOwnershipTransfers) Set(f func(ctx context.Context, limit uint32) (oa1 []domain.OwnershipTransfer, err error)) *AdminStorageMock {
+	if mmListOwnershipTransfers.defaultExpectation != nil {
+		mmListOwnershipTransfers.mock.t.Fatalf("Default expectation is already set for the AdminStorage.ListOwnershipTransfers method")
+	}
+
+	if len(mmListOwnershipTransfers.expectations) > 0 {
+		mmListOwnershipTransfers.mock.t.Fatalf("Some expectations are already set for the AdminStorage.ListOwnershipTransfers method")
+	}
+
+	mmListOwnershipTransfers.mock.funcListOwnershipTransfers = f
+	mmListOwnershipTransfers.mock.funcListOwnershipTransfersOrigin = minimock.CallerInfo(1)
+	return mmListOwnershipTransfers.mock
+}
+
+// When sets expectation for the AdminStorage.ListOwnershipTransfers which will trigger the result defined by the following
+// Then helper
+func (mmListOwnershipTransfers *mAdminStorageMockListOwnershipTransfers) When(ctx context.Context, limit uint32) *AdminStorageMockListOwnershipTransfersExpectation {
+	if mmListOwnershipTransfers.mock.funcListOwnershipTransfers != nil {
+		mmListOwnershipTransfers.mock.t.Fatalf("AdminStorageMock.ListOwnershipTransfers mock is already set by Set")
 	}
 
-	expectation := &AdminStorageMockGetSystemStatsExpectation{
-		mock:               mmGetSystemStats.mock,
-		params:             &AdminStorageMockGetSystemStatsParams{ctx},
-		expectationOrigins: AdminStorageMockGetSystemStatsExpectationOrigins{origin: minimock.CallerInfo(1)},
+	expectation := &AdminStorageMockListOwnershipTransfersExpectation{
+		mock:               mmListOwnershipTransfers.mock,
+		params:             &AdminStorageMockListOwnershipTransfersParams{ctx, limit},
+		expectationOrigins: AdminStorageMockListOwnershipTransfersExpectationOrigins{origin: minimock.CallerInfo(1)},
 	}
-	mmGetSystemStats.expectations = append(mmGetSystemStats.expectations, expectation)
+	mmListOwnershipTransfers.expectations = append(mmListOwnershipTransfers.expectations, expectation)
 	return expectation
 }
 
-// Then sets up AdminStorage.GetSystemStats return parameters for the expectation previously defined by the When method
-func (e *AdminStorageMockGetSystemStatsExpectation) Then(sp1 *domain.SystemStats, err error) *AdminStorageMock {
-	e.results = &AdminStorageMockGetSystemStatsResults{sp1, err}
+// Then sets up AdminStorage.ListOwnershipTransfers return parameters for the expectation previously defined by the When method
+func (e *AdminStorageMockListOwnershipTransfersExpectation) Then(oa1 []domain.OwnershipTransfer, err error) *AdminStorageMock {
+	e.results = &AdminStorageMockListOwnershipTransfersResults{oa1, err}
 	return e.mock
 }
 
-// Times sets number of times AdminStorage.GetSystemStats should be invoked
-func (mmGetSystemStats *mAdminStorageMockGetSystemStats) Times(n uint64) *mAdminStorageMockGetSystemStats {
+// Times sets number of times AdminStorage.ListOwnershipTransfers should be invoked
+func (mmListOwnershipTransfers *mAdminStorageMockListOwnershipTransfers) Times(n uint64) *mAdminStorageMockListOwnershipTransfers {
 	if n == 0 {
-		mmGetSystemStats.mock.t.Fatalf("Times of AdminStorageMock.GetSystemStats mock can not be zero")
+		mmListOwnershipTransfers.mock.t.Fatalf("Times of AdminStorageMock.ListOwnershipTransfers mock can not be zero")
 	}
-	mm_atomic.StoreUint64(&mmGetSystemStats.expectedInvocations, n)
-	mmGetSystemStats.expectedInvocationsOrigin = minimock.CallerInfo(1)
-	return mmGetSystemStats
+	mm_atomic.StoreUint64(&mmListOwnershipTransfers.expectedInvocations, n)
+	mmListOwnershipTransfers.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmListOwnershipTransfers
 }
 
-func (mmGetSystemStats *mAdminStorageMockGetSystemStats) invocationsDone() bool {
-	if len(mmGetSystemStats.expectations) == 0 && mmGetSystemStats.defaultExpectation == nil && mmGetSystemStats.mock.funcGetSystemStats == nil {
+func (mmListOwnershipTransfers *mAdminStorageMockListOwnershipTransfers) invocationsDone() bool {
+	if len(mmListOwnershipTransfers.expectations) == 0 && mmListOwnershipTransfers.defaultExpectation == nil && mmListOwnershipTransfers.mock.funcListOwnershipTransfers == nil {
 		return true
 	}
 
-	totalInvocations := mm_atomic.LoadUint64(&mmGetSystemStats.mock.afterGetSystemStatsCounter)
-	expectedInvocations := mm_atomic.LoadUint64(&mmGetSystemStats.expectedInvocations)
+	totalInvocations := mm_atomic.LoadUint64(&mmListOwnershipTransfers.mock.afterListOwnershipTransfersCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmListOwnershipTransfers.expectedInvocations)
 
 	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
 }
 
-// GetSystemStats implements mm_usecase.AdminStorage
-func (mmGetSystemStats *AdminStorageMock) GetSystemStats(ctx context.Context) (sp1 *domain.SystemStats, err error) {
-	mm_atomic.AddUint64(&mmGetSystemStats.beforeGetSystemStatsCounter, 1)
-	defer mm_atomic.AddUint64(&mmGetSystemStats.afterGetSystemStatsCounter, 1)
+// ListOwnershipTransfers implements mm_usecase.AdminStorage
+func (mmListOwnershipTransfers *AdminStorageMock) ListOwnershipTransfers(ctx context.Context, limit uint32) (oa1 []domain.OwnershipTransfer, err error) {
+	mm_atomic.AddUint64(&mmListOwnershipTransfers.beforeListOwnershipTransfersCounter, 1)
+	defer mm_atomic.AddUint64(&mmListOwnershipTransfers.afterListOwnershipTransfersCounter, 1)
 
-	mmGetSystemStats.t.Helper()
+	mmListOwnershipTransfers.t.Helper()
 
-	if mmGetSystemStats.inspectFuncGetSystemStats != nil {
-		mmGetSystemStats.inspectFuncGetSystemStats(ctx)
+	if mmListOwnershipTransfers.inspectFuncListOwnershipTransfers != nil {
+		mmListOwnershipTransfers.inspectFuncListOwnershipTransfers(ctx, limit)
 	}
 
-	mm_params := AdminStorageMockGetSystemStatsParams{ctx}
+	mm_params := AdminStorageMockListOwnershipTransfersParams{ctx, limit}
 
 	// Record call args
-	mmGetSystemStats.GetSystemStatsMock.mutex.Lock()
-	mmGetSystemStats.GetSystemStatsMock.callArgs = append(mmGetSystemStats.GetSystemStatsMock.callArgs, &mm_params)
-	mmGetSystemStats.GetSystemStatsMock.mutex.Unlock()
+	mmListOwnershipTransfers.ListOwnershipTransfersMock.mutex.Lock()
+	mmListOwnershipTransfers.ListOwnershipTransfersMock.callArgs = append(mmListOwnershipTransfers.ListOwnershipTransfersMock.callArgs, &mm_params)
+	mmListOwnershipTransfers.ListOwnershipTransfersMock.mutex.Unlock()
 
-	for _, e := range mmGetSystemStats.GetSystemStatsMock.expectations {
+	for _, e := range mmListOwnershipTransfers.ListOwnershipTransfersMock.expectations {
 		if minimock.Equal(*e.params, mm_params) {
 			mm_atomic.AddUint64(&e.Counter, 1)
-			return e.results.sp1, e.results.err
+			return e.results.oa1, e.results.err
 		}
 	}
 
-	if mmGetSystemStats.GetSystemStatsMock.defaultExpectation != nil {
-		mm_atomic.AddUint64(&mmGetSystemStats.GetSystemStatsMock.defaultExpectation.Counter, 1)
-		mm_want := mmGetSystemStats.GetSystemStatsMock.defaultExpectation.params
-		mm_want_ptrs := mmGetSystemStats.GetSystemStatsMock.defaultExpectation.paramPtrs
+	if mmListOwnershipTransfers.ListOwnershipTransfersMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmListOwnershipTransfers.ListOwnershipTransfersMock.defaultExpectation.Counter, 1)
+		mm_want := mmListOwnershipTransfers.ListOwnershipTransfersMock.defaultExpectation.params
+		mm_want_ptrs := mmListOwnershipTransfers.ListOwnershipTransfersMock.defaultExpectation.paramPtrs
 
-		mm_got := AdminStorageMockGetSystemStatsParams{ctx}
+		mm_got := AdminStorageMockListOwnershipTransfersParams{ctx, limit}
 
 		if mm_want_ptrs != nil {
 
 			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
-				mmGetSystemStats.t.Errorf("AdminStorageMock.GetSystemStats got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
-					mmGetSystemStats.GetSystemStatsMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+				mmListOwnershipTransfers.t.Errorf("AdminStorageMock.ListOwnershipTransfers got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmListOwnershipTransfers.ListOwnershipTransfersMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.limit != nil && !minimock.Equal(*mm_want_ptrs.limit, mm_got.limit) {
+				mmListOwnershipTransfers.t.Errorf("AdminStorageMock.ListOwnershipTransfers got unexpected parameter limit, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmListOwnershipTransfers.ListOwnershipTransfersMock.defaultExpectation.expectationOrigins.originLimit, *mm_want_ptrs.limit, mm_got.limit, minimock.Diff(*mm_want_ptrs.limit, mm_got.limit))
 			}
 
 		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
-			mmGetSystemStats.t.Errorf("AdminStorageMock.GetSystemStats got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
-				mmGetSystemStats.GetSystemStatsMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+			mmListOwnershipTransfers.t.Errorf("AdminStorageMock.ListOwnershipTransfers got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmListOwnershipTransfers.ListOwnershipTransfersMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
 		}
 
-		mm_results := mmGetSystemStats.GetSystemStatsMock.defaultExpectation.results
+		mm_results := mmListOwnershipTransfers.ListOwnershipTransfersMock.defaultExpectation.results
 		if mm_results == nil {
-			mmGetSystemStats.t.Fatal("No results are set for the AdminStorageMock.GetSystemStats")
+			mmListOwnershipTransfers.t.Fatal("No results are set for the AdminStorageMock.ListOwnershipTransfers")
 		}
-		return (*mm_results).sp1, (*mm_results).err
+		return (*mm_results).oa1, (*mm_results).err
 	}
-	if mmGetSystemStats.funcGetSystemStats != nil {
-		return mmGetSystemStats.funcGetSystemStats(ctx)
+	if mmListOwnershipTransfers.funcListOwnershipTransfers != nil {
+		return mmListOwnershipTransfers.funcListOwnershipTransfers(ctx, limit)
 	}
-	mmGetSystemStats.t.Fatalf("Unexpected call to AdminStorageMock.GetSystemStats. %v", ctx)
+	mmListOwnershipTransfers.t.Fatalf("Unexpected call to AdminStorageMock.ListOwnershipTransfers. %v %v", ctx, limit)
 	return
 }
 
-// GetSystemStatsAfterCounter returns a count of finished AdminStorageMock.GetSystemStats invocations
-func (mmGetSystemStats *AdminStorageMock) GetSystemStatsAfterCounter() uint64 {
-	return mm_atomic.LoadUint64(&mmGetSystemStats.afterGetSystemStatsCounter)
+// ListOwnershipTransfersAfterCounter returns a count of finished AdminStorageMock.ListOwnershipTransfers invocations
+func (mmListOwnershipTransfers *AdminStorageMock) ListOwnershipTransfersAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmListOwnershipTransfers.afterListOwnershipTransfersCounter)
 }
 
-// GetSystemStatsBeforeCounter returns a count of AdminStorageMock.GetSystemStats invocations
-func (mmGetSystemStats *AdminStorageMock) GetSystemStatsBeforeCounter() uint64 {
-	return mm_atomic.LoadUint64(&mmGetSystemStats.beforeGetSystemStatsCounter)
+// ListOwnershipTransfersBeforeCounter returns a count of AdminStorageMock.ListOwnershipTransfers invocations
+func (mmListOwnershipTransfers *AdminStorageMock) ListOwnershipTransfersBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmListOwnershipTransfers.beforeListOwnershipTransfersCounter)
 }
 
-// Calls returns a list of arguments used in each call to AdminStorageMock.GetSystemStats.
+// Calls returns a list of arguments used in each call to AdminStorageMock.ListOwnershipTransfers.
 // The list is in the same order as the calls were made (i.e. recent calls have a higher index)
-func (mmGetSystemStats *mAdminStorageMockGetSystemStats) Calls() []*AdminStorageMockGetSystemStatsParams {
-	mmGetSystemStats.mutex.RLock()
+func (mmListOwnershipTransfers *mAdminStorageMockListOwnershipTransfers) Calls() []*AdminStorageMockListOwnershipTransfersParams {
+	mmListOwnershipTransfers.mutex.RLock()
 
-	argCopy := make([]*AdminStorageMockGetSystemStatsParams, len(mmGetSystemStats.callArgs))
-	copy(argCopy, mmGetSystemStats.callArgs)
+	argCopy := make([]*AdminStorageMockListOwnershipTransfersParams, len(mmListOwnershipTransfers.callArgs))
+	copy(argCopy, mmListOwnershipTransfers.callArgs)
 
-	mmGetSystemStats.mutex.RUnlock()
+	mmListOwnershipTransfers.mutex.RUnlock()
 
 	return argCopy
 }
 
-// MinimockGetSystemStatsDone returns true if the count of the GetSystemStats invocations corresponds
+// MinimockListOwnershipTransfersDone returns true if the count of the ListOwnershipTransfers invocations corresponds
 // the number of defined expectations
-func (m *AdminStorageMock) MinimockGetSystemStatsDone() bool {
-	if m.GetSystemStatsMock.optional {
+func (m *AdminStorageMock) MinimockListOwnershipTransfersDone() bool {
+	if m.ListOwnershipTransfersMock.optional {
 		// Optional methods provide '0 or more' call count restriction.
 		return true
 	}
 
-	for _, e := range m.GetSystemStatsMock.expectations {
+	for _, e := range m.ListOwnershipTransfersMock.expectations {
 		if mm_atomic.LoadUint64(&e.Counter) < 1 {
 			return false
 		}
 	}
 
-	return m.GetSystemStatsMock.invocationsDone()
+	return m.ListOwnershipTransfersMock.invocationsDone()
 }
 
-// MinimockGetSystemStatsInspect logs each unmet expectation
-func (m *AdminStorageMock) MinimockGetSystemStatsInspect() {
-	for _, e := range m.GetSystemStatsMock.expectations {
+// MinimockListOwnershipTransfersInspect logs each unmet expectation
+func (m *AdminStorageMock) MinimockListOwnershipTransfersInspect() {
+	for _, e := range m.ListOwnershipTransfersMock.expectations {
 		if mm_atomic.LoadUint64(&e.Counter) < 1 {
-			m.t.Errorf("Expected call to AdminStorageMock.GetSystemStats at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+			m.t.Errorf("Expected call to AdminStorageMock.ListOwnershipTransfers at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
 		}
 	}
 
-	afterGetSystemStatsCounter := mm_atomic.LoadUint64(&m.afterGetSystemStatsCounter)
+	afterListOwnershipTransfersCounter := mm_atomic.LoadUint64(&m.afterListOwnershipTransfersCounter)
 	// if default expectation was set then invocations count should be greater than zero
-	if m.GetSystemStatsMock.defaultExpectation != nil && afterGetSystemStatsCounter < 1 {
-		if m.GetSystemStatsMock.defaultExpectation.params == nil {
-			m.t.Errorf("Expected call to AdminStorageMock.GetSystemStats at\n%s", m.GetSystemStatsMock.defaultExpectation.returnOrigin)
+	if m.ListOwnershipTransfersMock.defaultExpectation != nil && afterListOwnershipTransfersCounter < 1 {
+		if m.ListOwnershipTransfersMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to AdminStorageMock.ListOwnershipTransfers at\n%s", m.ListOwnershipTransfersMock.defaultExpectation.returnOrigin)
 		} else {
-			m.t.Errorf("Expected call to AdminStorageMock.GetSystemStats at\n%s with params: %#v", m.GetSystemStatsMock.defaultExpectation.expectationOrigins.origin, *m.GetSystemStatsMock.defaultExpectation.params)
+			m.t.Errorf("Expected call to AdminStorageMock.ListOwnershipTransfers at\n%s with params: %#v", m.ListOwnershipTransfersMock.defaultExpectation.expectationOrigins.origin, *m.ListOwnershipTransfersMock.defaultExpectation.params)
 		}
 	}
 	// if func was set then invocations count should be greater than zero
-	if m.funcGetSystemStats != nil && afterGetSystemStatsCounter < 1 {
-		m.t.Errorf("Expected call to AdminStorageMock.GetSystemStats at\n%s", m.funcGetSystemStatsOrigin)
+	if m.funcListOwnershipTransfers != nil && afterListOwnershipTransfersCounter < 1 {
+		m.t.Errorf("Expected call to AdminStorageMock.ListOwnershipTransfers at\n%s", m.funcListOwnershipTransfersOrigin)
 	}
 
-	if !m.GetSystemStatsMock.invocationsDone() && afterGetSystemStatsCounter > 0 {
-		m.t.Errorf("Expected %d calls to AdminStorageMock.GetSystemStats at\n%s but found %d calls",
-			mm_atomic.LoadUint64(&m.GetSystemStatsMock.expectedInvocations), m.GetSystemStatsMock.expectedInvocationsOrigin, afterGetSystemStatsCounter)
+	if !m.ListOwnershipTransfersMock.invocationsDone() && afterListOwnershipTransfersCounter > 0 {
+		m.t.Errorf("Expected %d calls to AdminStorageMock.ListOwnershipTransfers at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.ListOwnershipTransfersMock.expectedInvocations), m.ListOwnershipTransfersMock.expectedInvocationsOrigin, afterListOwnershipTransfersCounter)
 	}
 }
 
@@ -675,13 +1867,364 @@ func (m *AdminStorageMock) MinimockListUsersInspect() {
 	}
 }
 
+type mAdminStorageMockStartOwnershipTransfer struct {
+	optional           bool
+	mock               *AdminStorageMock
+	defaultExpectation *AdminStorageMockStartOwnershipTransferExpectation
+	expectations       []*AdminStorageMockStartOwnershipTransferExpectation
+
+	callArgs []*AdminStorageMockStartOwnershipTransferParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// AdminStorageMockStartOwnershipTransferExpectation specifies expectation struct of the AdminStorage.StartOwnershipTransfer
+type AdminStorageMockStartOwnershipTransferExpectation struct {
+	mock               *AdminStorageMock
+	params             *AdminStorageMockStartOwnershipTransferParams
+	paramPtrs          *AdminStorageMockStartOwnershipTransferParamPtrs
+	expectationOrigins AdminStorageMockStartOwnershipTransferExpectationOrigins
+	results            *AdminStorageMockStartOwnershipTransferResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// AdminStorageMockStartOwnershipTransferParams contains parameters of the AdminStorage.StartOwnershipTransfer
+type AdminStorageMockStartOwnershipTransferParams struct {
+	ctx context.Context
+	in  domain.TransferOwnershipInput
+}
+
+// AdminStorageMockStartOwnershipTransferParamPtrs contains pointers to parameters of the AdminStorage.StartOwnershipTransfer
+type AdminStorageMockStartOwnershipTransferParamPtrs struct {
+	ctx *context.Context
+	in  *domain.TransferOwnershipInput
+}
+
+// AdminStorageMockStartOwnershipTransferResults contains results of the AdminStorage.StartOwnershipTransfer
+type AdminStorageMockStartOwnershipTransferResults struct {
+	op1 *domain.OwnershipTransfer
+	err error
+}
+
+// AdminStorageMockStartOwnershipTransferOrigins contains origins of expectations of the AdminStorage.StartOwnershipTransfer
+type AdminStorageMockStartOwnershipTransferExpectationOrigins struct {
+	origin    string
+	originCtx string
+	originIn  string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmStartOwnershipTransfer *mAdminStorageMockStartOwnershipTransfer) Optional() *mAdminStorageMockStartOwnershipTransfer {
+	mmStartOwnershipTransfer.optional = true
+	return mmStartOwnershipTransfer
+}
+
+// Expect sets up expected params for AdminStorage.StartOwnershipTransfer
+func (mmStartOwnershipTransfer *mAdminStorageMockStartOwnershipTransfer) Expect(ctx context.Context, in domain.TransferOwnershipInput) *mAdminStorageMockStartOwnershipTransfer {
+	if mmStartOwnershipTransfer.mock.funcStartOwnershipTransfer != nil {
+		mmStartOwnershipTransfer.mock.t.Fatalf("AdminStorageMock.StartOwnershipTransfer mock is already set by Set")
+	}
+
+	if mmStartOwnershipTransfer.defaultExpectation == nil {
+		mmStartOwnershipTransfer.defaultExpectation = &AdminStorageMockStartOwnershipTransferExpectation{}
+	}
+
+	if mmStartOwnershipTransfer.defaultExpectation.paramPtrs != nil {
+		mmStartOwnershipTransfer.mock.t.Fatalf("AdminStorageMock.StartOwnershipTransfer mock is already set by ExpectParams functions")
+	}
+
+	mmStartOwnershipTransfer.defaultExpectation.params = &AdminStorageMockStartOwnershipTransferParams{ctx, in}
+	mmStartOwnershipTransfer.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmStartOwnershipTransfer.expectations {
+		if minimock.Equal(e.params, mmStartOwnershipTransfer.defaultExpectation.params) {
+			mmStartOwnershipTransfer.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmStartOwnershipTransfer.defaultExpectation.params)
+		}
+	}
+
+	return mmStartOwnershipTransfer
+}
+
+// ExpectCtxParam1 sets up expected param ctx for AdminStorage.StartOwnershipTransfer
+func (mmStartOwnershipTransfer *mAdminStorageMockStartOwnershipTransfer) ExpectCtxParam1(ctx context.Context) *mAdminStorageMockStartOwnershipTransfer {
+	if mmStartOwnershipTransfer.mock.funcStartOwnershipTransfer != nil {
+		mmStartOwnershipTransfer.mock.t.Fatalf("AdminStorageMock.StartOwnershipTransfer mock is already set by Set")
+	}
+
+	if mmStartOwnershipTransfer.defaultExpectation == nil {
+		mmStartOwnershipTransfer.defaultExpectation = &AdminStorageMockStartOwnershipTransferExpectation{}
+	}
+
+	if mmStartOwnershipTransfer.defaultExpectation.params != nil {
+		mmStartOwnershipTransfer.mock.t.Fatalf("AdminStorageMock.StartOwnershipTransfer mock is already set by Expect")
+	}
+
+	if mmStartOwnershipTransfer.defaultExpectation.paramPtrs == nil {
+		mmStartOwnershipTransfer.defaultExpectation.paramPtrs = &AdminStorageMockStartOwnershipTransferParamPtrs{}
+	}
+	mmStartOwnershipTransfer.defaultExpectation.paramPtrs.ctx = &ctx
+	mmStartOwnershipTransfer.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmStartOwnershipTransfer
+}
+
+// ExpectInParam2 sets up expected param in for AdminStorage.StartOwnershipTransfer
+func (mmStartOwnershipTransfer *mAdminStorageMockStartOwnershipTransfer) ExpectInParam2(in domain.TransferOwnershipInput) *mAdminStorageMockStartOwnershipTransfer {
+	if mmStartOwnershipTransfer.mock.funcStartOwnershipTransfer != nil {
+		mmStartOwnershipTransfer.mock.t.Fatalf("AdminStorageMock.StartOwnershipTransfer mock is already set by Set")
+	}
+
+	if mmStartOwnershipTransfer.defaultExpectation == nil {
+		mmStartOwnershipTransfer.defaultExpectation = &AdminStorageMockStartOwnershipTransferExpectation{}
+	}
+
+	if mmStartOwnershipTransfer.defaultExpectation.params != nil {
+		mmStartOwnershipTransfer.mock.t.Fatalf("AdminStorageMock.StartOwnershipTransfer mock is already set by Expect")
+	}
+
+	if mmStartOwnershipTransfer.defaultExpectation.paramPtrs == nil {
+		mmStartOwnershipTransfer.defaultExpectation.paramPtrs = &AdminStorageMockStartOwnershipTransferParamPtrs{}
+	}
+	mmStartOwnershipTransfer.defaultExpectation.paramPtrs.in = &in
+	mmStartOwnershipTransfer.defaultExpectation.expectationOrigins.originIn = minimock.CallerInfo(1)
+
+	return mmStartOwnershipTransfer
+}
+
+// Inspect accepts an inspector function that has same arguments as the AdminStorage.StartOwnershipTransfer
+func (mmStartOwnershipTransfer *mAdminStorageMockStartOwnershipTransfer) Inspect(f func(ctx context.Context, in domain.TransferOwnershipInput)) *mAdminStorageMockStartOwnershipTransfer {
+	if mmStartOwnershipTransfer.mock.inspectFuncStartOwnershipTransfer != nil {
+		mmStartOwnershipTransfer.mock.t.Fatalf("Inspect function is already set for AdminStorageMock.StartOwnershipTransfer")
+	}
+
+	mmStartOwnershipTransfer.mock.inspectFuncStartOwnershipTransfer = f
+
+	return mmStartOwnershipTransfer
+}
+
+// Return sets up results that will be returned by AdminStorage.StartOwnershipTransfer
+func (mmStartOwnershipTransfer *mAdminStorageMockStartOwnershipTransfer) Return(op1 *domain.OwnershipTransfer, err error) *AdminStorageMock {
+	if mmStartOwnershipTransfer.mock.funcStartOwnershipTransfer != nil {
+		mmStartOwnershipTransfer.mock.t.Fatalf("AdminStorageMock.StartOwnershipTransfer mock is already set by Set")
+	}
+
+	if mmStartOwnershipTransfer.defaultExpectation == nil {
+		mmStartOwnershipTransfer.defaultExpectation = &AdminStorageMockStartOwnershipTransferExpectation{mock: mmStartOwnershipTransfer.mock}
+	}
+	mmStartOwnershipTransfer.defaultExpectation.results = &AdminStorageMockStartOwnershipTransferResults{op1, err}
+	mmStartOwnershipTransfer.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmStartOwnershipTransfer.mock
+}
+
+// Set uses given function f to mock the AdminStorage.StartOwnershipTransfer method
+func (mmStartOwnershipTransfer *mAdminStorageMockStartOwnershipTransfer) Set(f func(ctx context.Context, in domain.TransferOwnershipInput) (op1 *domain.OwnershipTransfer, err error)) *AdminStorageMock {
+	if mmStartOwnershipTransfer.defaultExpectation != nil {
+		mmStartOwnershipTransfer.mock.t.Fatalf("Default expectation is already set for the AdminStorage.StartOwnershipTransfer method")
+	}
+
+	if len(mmStartOwnershipTransfer.expectations) > 0 {
+		mmStartOwnershipTransfer.mock.t.Fatalf("Some expectations are already set for the AdminStorage.StartOwnershipTransfer method")
+	}
+
+	mmStartOwnershipTransfer.mock.funcStartOwnershipTransfer = f
+	mmStartOwnershipTransfer.mock.funcStartOwnershipTransferOrigin = minimock.CallerInfo(1)
+	return mmStartOwnershipTransfer.mock
+}
+
+// When sets expectation for the AdminStorage.StartOwnershipTransfer which will trigger the result defined by the following
+// Then helper
+func (mmStartOwnershipTransfer *mAdminStorageMockStartOwnershipTransfer) When(ctx context.Context, in domain.TransferOwnershipInput) *AdminStorageMockStartOwnershipTransferExpectation {
+	if mmStartOwnershipTransfer.mock.funcStartOwnershipTransfer != nil {
+		mmStartOwnershipTransfer.mock.t.Fatalf("AdminStorageMock.StartOwnershipTransfer mock is already set by Set")
+	}
+
+	expectation := &AdminStorageMockStartOwnershipTransferExpectation{
+		mock:               mmStartOwnershipTransfer.mock,
+		params:             &AdminStorageMockStartOwnershipTransferParams{ctx, in},
+		expectationOrigins: AdminStorageMockStartOwnershipTransferExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmStartOwnershipTransfer.expectations = append(mmStartOwnershipTransfer.expectations, expectation)
+	return expectation
+}
+
+// Then sets up AdminStorage.StartOwnershipTransfer return parameters for the expectation previously defined by the When method
+func (e *AdminStorageMockStartOwnershipTransferExpectation) Then(op1 *domain.OwnershipTransfer, err error) *AdminStorageMock {
+	e.results = &AdminStorageMockStartOwnershipTransferResults{op1, err}
+	return e.mock
+}
+
+// Times sets number of times AdminStorage.StartOwnershipTransfer should be invoked
+func (mmStartOwnershipTransfer *mAdminStorageMockStartOwnershipTransfer) Times(n uint64) *mAdminStorageMockStartOwnershipTransfer {
+	if n == 0 {
+		mmStartOwnershipTransfer.mock.t.Fatalf("Times of AdminStorageMock.StartOwnershipTransfer mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmStartOwnershipTransfer.expectedInvocations, n)
+	mmStartOwnershipTransfer.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmStartOwnershipTransfer
+}
+
+func (mmStartOwnershipTransfer *mAdminStorageMockStartOwnershipTransfer) invocationsDone() bool {
+	if len(mmStartOwnershipTransfer.expectations) == 0 && mmStartOwnershipTransfer.defaultExpectation == nil && mmStartOwnershipTransfer.mock.funcStartOwnershipTransfer == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmStartOwnershipTransfer.mock.afterStartOwnershipTransferCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmStartOwnershipTransfer.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// StartOwnershipTransfer implements mm_usecase.AdminStorage
+func (mmStartOwnershipTransfer *AdminStorageMock) StartOwnershipTransfer(ctx context.Context, in domain.TransferOwnershipInput) (op1 *domain.OwnershipTransfer, err error) {
+	mm_atomic.AddUint64(&mmStartOwnershipTransfer.beforeStartOwnershipTransferCounter, 1)
+	defer mm_atomic.AddUint64(&mmStartOwnershipTransfer.afterStartOwnershipTransferCounter, 1)
+
+	mmStartOwnershipTransfer.t.Helper()
+
+	if mmStartOwnershipTransfer.inspectFuncStartOwnershipTransfer != nil {
+		mmStartOwnershipTransfer.inspectFuncStartOwnershipTransfer(ctx, in)
+	}
+
+	mm_params := AdminStorageMockStartOwnershipTransferParams{ctx, in}
+
+	// Record call args
+	mmStartOwnershipTransfer.StartOwnershipTransferMock.mutex.Lock()
+	mmStartOwnershipTransfer.StartOwnershipTransferMock.callArgs = append(mmStartOwnershipTransfer.StartOwnershipTransferMock.callArgs, &mm_params)
+	mmStartOwnershipTransfer.StartOwnershipTransferMock.mutex.Unlock()
+
+	for _, e := range mmStartOwnershipTransfer.StartOwnershipTransferMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.op1, e.results.err
+		}
+	}
+
+	if mmStartOwnershipTransfer.StartOwnershipTransferMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmStartOwnershipTransfer.StartOwnershipTransferMock.defaultExpectation.Counter, 1)
+		mm_want := mmStartOwnershipTransfer.StartOwnershipTransferMock.defaultExpectation.params
+		mm_want_ptrs := mmStartOwnershipTransfer.StartOwnershipTransferMock.defaultExpectation.paramPtrs
+
+		mm_got := AdminStorageMockStartOwnershipTransferParams{ctx, in}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmStartOwnershipTransfer.t.Errorf("AdminStorageMock.StartOwnershipTransfer got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmStartOwnershipTransfer.StartOwnershipTransferMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.in != nil && !minimock.Equal(*mm_want_ptrs.in, mm_got.in) {
+				mmStartOwnershipTransfer.t.Errorf("AdminStorageMock.StartOwnershipTransfer got unexpected parameter in, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmStartOwnershipTransfer.StartOwnershipTransferMock.defaultExpectation.expectationOrigins.originIn, *mm_want_ptrs.in, mm_got.in, minimock.Diff(*mm_want_ptrs.in, mm_got.in))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmStartOwnershipTransfer.t.Errorf("AdminStorageMock.StartOwnershipTransfer got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmStartOwnershipTransfer.StartOwnershipTransferMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmStartOwnershipTransfer.StartOwnershipTransferMock.defaultExpectation.results
+		if mm_results == nil {
+			mmStartOwnershipTransfer.t.Fatal("No results are set for the AdminStorageMock.StartOwnershipTransfer")
+		}
+		return (*mm_results).op1, (*mm_results).err
+	}
+	if mmStartOwnershipTransfer.funcStartOwnershipTransfer != nil {
+		return mmStartOwnershipTransfer.funcStartOwnershipTransfer(ctx, in)
+	}
+	mmStartOwnershipTransfer.t.Fatalf("Unexpected call to AdminStorageMock.StartOwnershipTransfer. %v %v", ctx, in)
+	return
+}
+
+// StartOwnershipTransferAfterCounter returns a count of finished AdminStorageMock.StartOwnershipTransfer invocations
+func (mmStartOwnershipTransfer *AdminStorageMock) StartOwnershipTransferAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmStartOwnershipTransfer.afterStartOwnershipTransferCounter)
+}
+
+// StartOwnershipTransferBeforeCounter returns a count of AdminStorageMock.StartOwnershipTransfer invocations
+func (mmStartOwnershipTransfer *AdminStorageMock) StartOwnershipTransferBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmStartOwnershipTransfer.beforeStartOwnershipTransferCounter)
+}
+
+// Calls returns a list of arguments used in each call to AdminStorageMock.StartOwnershipTransfer.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmStartOwnershipTransfer *mAdminStorageMockStartOwnershipTransfer) Calls() []*AdminStorageMockStartOwnershipTransferParams {
+	mmStartOwnershipTransfer.mutex.RLock()
+
+	argCopy := make([]*AdminStorageMockStartOwnershipTransferParams, len(mmStartOwnershipTransfer.callArgs))
+	copy(argCopy, mmStartOwnershipTransfer.callArgs)
+
+	mmStartOwnershipTransfer.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockStartOwnershipTransferDone returns true if the count of the StartOwnershipTransfer invocations corresponds
+// the number of defined expectations
+func (m *AdminStorageMock) MinimockStartOwnershipTransferDone() bool {
+	if m.StartOwnershipTransferMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.StartOwnershipTransferMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.StartOwnershipTransferMock.invocationsDone()
+}
+
+// MinimockStartOwnershipTransferInspect logs each unmet expectation
+func (m *AdminStorageMock) MinimockStartOwnershipTransferInspect() {
+	for _, e := range m.StartOwnershipTransferMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to AdminStorageMock.StartOwnershipTransfer at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterStartOwnershipTransferCounter := mm_atomic.LoadUint64(&m.afterStartOwnershipTransferCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.StartOwnershipTransferMock.defaultExpectation != nil && afterStartOwnershipTransferCounter < 1 {
+		if m.StartOwnershipTransferMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to AdminStorageMock.StartOwnershipTransfer at\n%s", m.StartOwnershipTransferMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to AdminStorageMock.StartOwnershipTransfer at\n%s with params: %#v", m.StartOwnershipTransferMock.defaultExpectation.expectationOrigins.origin, *m.StartOwnershipTransferMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcStartOwnershipTransfer != nil && afterStartOwnershipTransferCounter < 1 {
+		m.t.Errorf("Expected call to AdminStorageMock.StartOwnershipTransfer at\n%s", m.funcStartOwnershipTransferOrigin)
+	}
+
+	if !m.StartOwnershipTransferMock.invocationsDone() && afterStartOwnershipTransferCounter > 0 {
+		m.t.Errorf("Expected %d calls to AdminStorageMock.StartOwnershipTransfer at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.StartOwnershipTransferMock.expectedInvocations), m.StartOwnershipTransferMock.expectedInvocationsOrigin, afterStartOwnershipTransferCounter)
+	}
+}
+
 // MinimockFinish checks that all mocked methods have been called the expected number of times
 func (m *AdminStorageMock) MinimockFinish() {
 	m.finishOnce.Do(func() {
 		if !m.minimockDone() {
+			m.MinimockAddOwnershipTransferProgressInspect()
+
+			m.MinimockFinishOwnershipTransferInspect()
+
 			m.MinimockGetSystemStatsInspect()
 
+			m.MinimockListOwnershipTransfersInspect()
+
 			m.MinimockListUsersInspect()
+
+			m.MinimockStartOwnershipTransferInspect()
 		}
 	})
 }
@@ -705,6 +2248,10 @@ func (m *AdminStorageMock) MinimockWait(timeout mm_time.Duration) {
 func (m *AdminStorageMock) minimockDone() bool {
 	done := true
 	return done &&
+		m.MinimockAddOwnershipTransferProgressDone() &&
+		m.MinimockFinishOwnershipTransferDone() &&
 		m.MinimockGetSystemStatsDone() &&
-		m.MinimockListUsersDone()
+		m.MinimockListOwnershipTransfersDone() &&
+		m.MinimockListUsersDone() &&
+		m.MinimockStartOwnershipTransferDone()
 }
@@ -0,0 +1,477 @@
+// Code generated by http://github.com/gojuno/minimock (v3.4.7). DO NOT EDIT.
+
+package mocks
+
+import (
+	"context"
+	"sync"
+	mm_atomic "sync/atomic"
+	mm_time "time"
+
+	"github.com/gojuno/minimock/v3"
+)
+
+// ResumeClientMock implements mm_usecase.ResumeClient
+type ResumeClientMock struct {
+	t          minimock.Tester
+	finishOnce sync.Once
+
+	funcTransferCandidateOwnership          func(ctx context.Context, fromUserID uint64, toUserID uint64, limit uint32) (u1 uint32, err error)
+	funcTransferCandidateOwnershipOrigin    string
+	inspectFuncTransferCandidateOwnership   func(ctx context.Context, fromUserID uint64, toUserID uint64, limit uint32)
+	afterTransferCandidateOwnershipCounter  uint64
+	beforeTransferCandidateOwnershipCounter uint64
+	TransferCandidateOwnershipMock          mResumeClientMockTransferCandidateOwnership
+}
+
+// NewResumeClientMock returns a mock for mm_usecase.ResumeClient
+func NewResumeClientMock(t minimock.Tester) *ResumeClientMock {
+	m := &ResumeClientMock{t: t}
+
+	if controller, ok := t.(minimock.MockController); ok {
+		controller.RegisterMocker(m)
+	}
+
+	m.TransferCandidateOwnershipMock = mResumeClientMockTransferCandidateOwnership{mock: m}
+	m.TransferCandidateOwnershipMock.callArgs = []*ResumeClientMockTransferCandidateOwnershipParams{}
+
+	t.Cleanup(m.MinimockFinish)
+
+	return m
+}
+
+type mResumeClientMockTransferCandidateOwnership struct {
+	optional           bool
+	mock               *ResumeClientMock
+	defaultExpectation *ResumeClientMockTransferCandidateOwnershipExpectation
+	expectations       []*ResumeClientMockTransferCandidateOwnershipExpectation
+
+	callArgs []*ResumeClientMockTransferCandidateOwnershipParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// ResumeClientMockTransferCandidateOwnershipExpectation specifies expectation struct of the ResumeClient.TransferCandidateOwnership
+type ResumeClientMockTransferCandidateOwnershipExpectation struct {
+	mock               *ResumeClientMock
+	params             *ResumeClientMockTransferCandidateOwnershipParams
+	paramPtrs          *ResumeClientMockTransferCandidateOwnershipParamPtrs
+	expectationOrigins ResumeClientMockTransferCandidateOwnershipExpectationOrigins
+	results            *ResumeClientMockTransferCandidateOwnershipResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// ResumeClientMockTransferCandidateOwnershipParams contains parameters of the ResumeClient.TransferCandidateOwnership
+type ResumeClientMockTransferCandidateOwnershipParams struct {
+	ctx        context.Context
+	fromUserID uint64
+	toUserID   uint64
+	limit      uint32
+}
+
+// ResumeClientMockTransferCandidateOwnershipParamPtrs contains pointers to parameters of the ResumeClient.TransferCandidateOwnership
+type ResumeClientMockTransferCandidateOwnershipParamPtrs struct {
+	ctx        *context.Context
+	fromUserID *uint64
+	toUserID   *uint64
+	limit      *uint32
+}
+
+// ResumeClientMockTransferCandidateOwnershipResults contains results of the ResumeClient.TransferCandidateOwnership
+type ResumeClientMockTransferCandidateOwnershipResults struct {
+	u1  uint32
+	err error
+}
+
+// ResumeClientMockTransferCandidateOwnershipOrigins contains origins of expectations of the ResumeClient.TransferCandidateOwnership
+type ResumeClientMockTransferCandidateOwnershipExpectationOrigins struct {
+	origin           string
+	originCtx        string
+	originFromUserID string
+	originToUserID   string
+	originLimit      string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmTransferCandidateOwnership *mResumeClientMockTransferCandidateOwnership) Optional() *mResumeClientMockTransferCandidateOwnership {
+	mmTransferCandidateOwnership.optional = true
+	return mmTransferCandidateOwnership
+}
+
+// Expect sets up expected params for ResumeClient.TransferCandidateOwnership
+func (mmTransferCandidateOwnership *mResumeClientMockTransferCandidateOwnership) Expect(ctx context.Context, fromUserID uint64, toUserID uint64, limit uint32) *mResumeClientMockTransferCandidateOwnership {
+	if mmTransferCandidateOwnership.mock.funcTransferCandidateOwnership != nil {
+		mmTransferCandidateOwnership.mock.t.Fatalf("ResumeClientMock.TransferCandidateOwnership mock is already set by Set")
+	}
+
+	if mmTransferCandidateOwnership.defaultExpectation == nil {
+		mmTransferCandidateOwnership.defaultExpectation = &ResumeClientMockTransferCandidateOwnershipExpectation{}
+	}
+
+	if mmTransferCandidateOwnership.defaultExpectation.paramPtrs != nil {
+		mmTransferCandidateOwnership.mock.t.Fatalf("ResumeClientMock.TransferCandidateOwnership mock is already set by ExpectParams functions")
+	}
+
+	mmTransferCandidateOwnership.defaultExpectation.params = &ResumeClientMockTransferCandidateOwnershipParams{ctx, fromUserID, toUserID, limit}
+	mmTransferCandidateOwnership.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmTransferCandidateOwnership.expectations {
+		if minimock.Equal(e.params, mmTransferCandidateOwnership.defaultExpectation.params) {
+			mmTransferCandidateOwnership.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmTransferCandidateOwnership.defaultExpectation.params)
+		}
+	}
+
+	return mmTransferCandidateOwnership
+}
+
+// ExpectCtxParam1 sets up expected param ctx for ResumeClient.TransferCandidateOwnership
+func (mmTransferCandidateOwnership *mResumeClientMockTransferCandidateOwnership) ExpectCtxParam1(ctx context.Context) *mResumeClientMockTransferCandidateOwnership {
+	if mmTransferCandidateOwnership.mock.funcTransferCandidateOwnership != nil {
+		mmTransferCandidateOwnership.mock.t.Fatalf("ResumeClientMock.TransferCandidateOwnership mock is already set by Set")
+	}
+
+	if mmTransferCandidateOwnership.defaultExpectation == nil {
+		mmTransferCandidateOwnership.defaultExpectation = &ResumeClientMockTransferCandidateOwnershipExpectation{}
+	}
+
+	if mmTransferCandidateOwnership.defaultExpectation.params != nil {
+		mmTransferCandidateOwnership.mock.t.Fatalf("ResumeClientMock.TransferCandidateOwnership mock is already set by Expect")
+	}
+
+	if mmTransferCandidateOwnership.defaultExpectation.paramPtrs == nil {
+		mmTransferCandidateOwnership.defaultExpectation.paramPtrs = &ResumeClientMockTransferCandidateOwnershipParamPtrs{}
+	}
+	mmTransferCandidateOwnership.defaultExpectation.paramPtrs.ctx = &ctx
+	mmTransferCandidateOwnership.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmTransferCandidateOwnership
+}
+
+// ExpectFromUserIDParam2 sets up expected param fromUserID for ResumeClient.TransferCandidateOwnership
+func (mmTransferCandidateOwnership *mResumeClientMockTransferCandidateOwnership) ExpectFromUserIDParam2(fromUserID uint64) *mResumeClientMockTransferCandidateOwnership {
+	if mmTransferCandidateOwnership.mock.funcTransferCandidateOwnership != nil {
+		mmTransferCandidateOwnership.mock.t.Fatalf("ResumeClientMock.TransferCandidateOwnership mock is already set by Set")
+	}
+
+	if mmTransferCandidateOwnership.defaultExpectation == nil {
+		mmTransferCandidateOwnership.defaultExpectation = &ResumeClientMockTransferCandidateOwnershipExpectation{}
+	}
+
+	if mmTransferCandidateOwnership.defaultExpectation.params != nil {
+		mmTransferCandidateOwnership.mock.t.Fatalf("ResumeClientMock.TransferCandidateOwnership mock is already set by Expect")
+	}
+
+	if mmTransferCandidateOwnership.defaultExpectation.paramPtrs == nil {
+		mmTransferCandidateOwnership.defaultExpectation.paramPtrs = &ResumeClientMockTransferCandidateOwnershipParamPtrs{}
+	}
+	mmTransferCandidateOwnership.defaultExpectation.paramPtrs.fromUserID = &fromUserID
+	mmTransferCandidateOwnership.defaultExpectation.expectationOrigins.originFromUserID = minimock.CallerInfo(1)
+
+	return mmTransferCandidateOwnership
+}
+
+// ExpectToUserIDParam3 sets up expected param toUserID for ResumeClient.TransferCandidateOwnership
+func (mmTransferCandidateOwnership *mResumeClientMockTransferCandidateOwnership) ExpectToUserIDParam3(toUserID uint64) *mResumeClientMockTransferCandidateOwnership {
+	if mmTransferCandidateOwnership.mock.funcTransferCandidateOwnership != nil {
+		mmTransferCandidateOwnership.mock.t.Fatalf("ResumeClientMock.TransferCandidateOwnership mock is already set by Set")
+	}
+
+	if mmTransferCandidateOwnership.defaultExpectation == nil {
+		mmTransferCandidateOwnership.defaultExpectation = &ResumeClientMockTransferCandidateOwnershipExpectation{}
+	}
+
+	if mmTransferCandidateOwnership.defaultExpectation.params != nil {
+		mmTransferCandidateOwnership.mock.t.Fatalf("ResumeClientMock.TransferCandidateOwnership mock is already set by Expect")
+	}
+
+	if mmTransferCandidateOwnership.defaultExpectation.paramPtrs == nil {
+		mmTransferCandidateOwnership.defaultExpectation.paramPtrs = &ResumeClientMockTransferCandidateOwnershipParamPtrs{}
+	}
+	mmTransferCandidateOwnership.defaultExpectation.paramPtrs.toUserID = &toUserID
+	mmTransferCandidateOwnership.defaultExpectation.expectationOrigins.originToUserID = minimock.CallerInfo(1)
+
+	return mmTransferCandidateOwnership
+}
+
+// ExpectLimitParam4 sets up expected param limit for ResumeClient.TransferCandidateOwnership
+func (mmTransferCandidateOwnership *mResumeClientMockTransferCandidateOwnership) ExpectLimitParam4(limit uint32) *mResumeClientMockTransferCandidateOwnership {
+	if mmTransferCandidateOwnership.mock.funcTransferCandidateOwnership != nil {
+		mmTransferCandidateOwnership.mock.t.Fatalf("ResumeClientMock.TransferCandidateOwnership mock is already set by Set")
+	}
+
+	if mmTransferCandidateOwnership.defaultExpectation == nil {
+		mmTransferCandidateOwnership.defaultExpectation = &ResumeClientMockTransferCandidateOwnershipExpectation{}
+	}
+
+	if mmTransferCandidateOwnership.defaultExpectation.params != nil {
+		mmTransferCandidateOwnership.mock.t.Fatalf("ResumeClientMock.TransferCandidateOwnership mock is already set by Expect")
+	}
+
+	if mmTransferCandidateOwnership.defaultExpectation.paramPtrs == nil {
+		mmTransferCandidateOwnership.defaultExpectation.paramPtrs = &ResumeClientMockTransferCandidateOwnershipParamPtrs{}
+	}
+	mmTransferCandidateOwnership.defaultExpectation.paramPtrs.limit = &limit
+	mmTransferCandidateOwnership.defaultExpectation.expectationOrigins.originLimit = minimock.CallerInfo(1)
+
+	return mmTransferCandidateOwnership
+}
+
+// Inspect accepts an inspector function that has same arguments as the ResumeClient.TransferCandidateOwnership
+func (mmTransferCandidateOwnership *mResumeClientMockTransferCandidateOwnership) Inspect(f func(ctx context.Context, fromUserID uint64, toUserID uint64, limit uint32)) *mResumeClientMockTransferCandidateOwnership {
+	if mmTransferCandidateOwnership.mock.inspectFuncTransferCandidateOwnership != nil {
+		mmTransferCandidateOwnership.mock.t.Fatalf("Inspect function is already set for ResumeClientMock.TransferCandidateOwnership")
+	}
+
+	mmTransferCandidateOwnership.mock.inspectFuncTransferCandidateOwnership = f
+
+	return mmTransferCandidateOwnership
+}
+
+// Return sets up results that will be returned by ResumeClient.TransferCandidateOwnership
+func (mmTransferCandidateOwnership *mResumeClientMockTransferCandidateOwnership) Return(u1 uint32, err error) *ResumeClientMock {
+	if mmTransferCandidateOwnership.mock.funcTransferCandidateOwnership != nil {
+		mmTransferCandidateOwnership.mock.t.Fatalf("ResumeClientMock.TransferCandidateOwnership mock is already set by Set")
+	}
+
+	if mmTransferCandidateOwnership.defaultExpectation == nil {
+		mmTransferCandidateOwnership.defaultExpectation = &ResumeClientMockTransferCandidateOwnershipExpectation{mock: mmTransferCandidateOwnership.mock}
+	}
+	mmTransferCandidateOwnership.defaultExpectation.results = &ResumeClientMockTransferCandidateOwnershipResults{u1, err}
+	mmTransferCandidateOwnership.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmTransferCandidateOwnership.mock
+}
+
+// Set uses given function f to mock the ResumeClient.TransferCandidateOwnership method
+func (mmTransferCandidateOwnership *mResumeClientMockTransferCandidateOwnership) Set(f func(ctx context.Context, fromUserID uint64, toUserID uint64, limit uint32) (u1 uint32, err error)) *ResumeClientMock {
+	if mmTransferCandidateOwnership.defaultExpectation != nil {
+		mmTransferCandidateOwnership.mock.t.Fatalf("Default expectation is already set for the ResumeClient.TransferCandidateOwnership method")
+	}
+
+	if len(mmTransferCandidateOwnership.expectations) > 0 {
+		mmTransferCandidateOwnership.mock.t.Fatalf("Some expectations are already set for the ResumeClient.TransferCandidateOwnership method")
+	}
+
+	mmTransferCandidateOwnership.mock.funcTransferCandidateOwnership = f
+	mmTransferCandidateOwnership.mock.funcTransferCandidateOwnershipOrigin = minimock.CallerInfo(1)
+	return mmTransferCandidateOwnership.mock
+}
+
+// When sets expectation for the ResumeClient.TransferCandidateOwnership which will trigger the result defined by the following
+// Then helper
+func (mmTransferCandidateOwnership *mResumeClientMockTransferCandidateOwnership) When(ctx context.Context, fromUserID uint64, toUserID uint64, limit uint32) *ResumeClientMockTransferCandidateOwnershipExpectation {
+	if mmTransferCandidateOwnership.mock.funcTransferCandidateOwnership != nil {
+		mmTransferCandidateOwnership.mock.t.Fatalf("ResumeClientMock.TransferCandidateOwnership mock is already set by Set")
+	}
+
+	expectation := &ResumeClientMockTransferCandidateOwnershipExpectation{
+		mock:               mmTransferCandidateOwnership.mock,
+		params:             &ResumeClientMockTransferCandidateOwnershipParams{ctx, fromUserID, toUserID, limit},
+		expectationOrigins: ResumeClientMockTransferCandidateOwnershipExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmTransferCandidateOwnership.expectations = append(mmTransferCandidateOwnership.expectations, expectation)
+	return expectation
+}
+
+// Then sets up ResumeClient.TransferCandidateOwnership return parameters for the expectation previously defined by the When method
+func (e *ResumeClientMockTransferCandidateOwnershipExpectation) Then(u1 uint32, err error) *ResumeClientMock {
+	e.results = &ResumeClientMockTransferCandidateOwnershipResults{u1, err}
+	return e.mock
+}
+
+// Times sets number of times ResumeClient.TransferCandidateOwnership should be invoked
+func (mmTransferCandidateOwnership *mResumeClientMockTransferCandidateOwnership) Times(n uint64) *mResumeClientMockTransferCandidateOwnership {
+	if n == 0 {
+		mmTransferCandidateOwnership.mock.t.Fatalf("Times of ResumeClientMock.TransferCandidateOwnership mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmTransferCandidateOwnership.expectedInvocations, n)
+	mmTransferCandidateOwnership.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmTransferCandidateOwnership
+}
+
+func (mmTransferCandidateOwnership *mResumeClientMockTransferCandidateOwnership) invocationsDone() bool {
+	if len(mmTransferCandidateOwnership.expectations) == 0 && mmTransferCandidateOwnership.defaultExpectation == nil && mmTransferCandidateOwnership.mock.funcTransferCandidateOwnership == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmTransferCandidateOwnership.mock.afterTransferCandidateOwnershipCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmTransferCandidateOwnership.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// TransferCandidateOwnership implements mm_usecase.ResumeClient
+func (mmTransferCandidateOwnership *ResumeClientMock) TransferCandidateOwnership(ctx context.Context, fromUserID uint64, toUserID uint64, limit uint32) (u1 uint32, err error) {
+	mm_atomic.AddUint64(&mmTransferCandidateOwnership.beforeTransferCandidateOwnershipCounter, 1)
+	defer mm_atomic.AddUint64(&mmTransferCandidateOwnership.afterTransferCandidateOwnershipCounter, 1)
+
+	mmTransferCandidateOwnership.t.Helper()
+
+	if mmTransferCandidateOwnership.inspectFuncTransferCandidateOwnership != nil {
+		mmTransferCandidateOwnership.inspectFuncTransferCandidateOwnership(ctx, fromUserID, toUserID, limit)
+	}
+
+	mm_params := ResumeClientMockTransferCandidateOwnershipParams{ctx, fromUserID, toUserID, limit}
+
+	// Record call args
+	mmTransferCandidateOwnership.TransferCandidateOwnershipMock.mutex.Lock()
+	mmTransferCandidateOwnership.TransferCandidateOwnershipMock.callArgs = append(mmTransferCandidateOwnership.TransferCandidateOwnershipMock.callArgs, &mm_params)
+	mmTransferCandidateOwnership.TransferCandidateOwnershipMock.mutex.Unlock()
+
+	for _, e := range mmTransferCandidateOwnership.TransferCandidateOwnershipMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.u1, e.results.err
+		}
+	}
+
+	if mmTransferCandidateOwnership.TransferCandidateOwnershipMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmTransferCandidateOwnership.TransferCandidateOwnershipMock.defaultExpectation.Counter, 1)
+		mm_want := mmTransferCandidateOwnership.TransferCandidateOwnershipMock.defaultExpectation.params
+		mm_want_ptrs := mmTransferCandidateOwnership.TransferCandidateOwnershipMock.defaultExpectation.paramPtrs
+
+		mm_got := ResumeClientMockTransferCandidateOwnershipParams{ctx, fromUserID, toUserID, limit}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmTransferCandidateOwnership.t.Errorf("ResumeClientMock.TransferCandidateOwnership got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmTransferCandidateOwnership.TransferCandidateOwnershipMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.fromUserID != nil && !minimock.Equal(*mm_want_ptrs.fromUserID, mm_got.fromUserID) {
+				mmTransferCandidateOwnership.t.Errorf("ResumeClientMock.TransferCandidateOwnership got unexpected parameter fromUserID, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmTransferCandidateOwnership.TransferCandidateOwnershipMock.defaultExpectation.expectationOrigins.originFromUserID, *mm_want_ptrs.fromUserID, mm_got.fromUserID, minimock.Diff(*mm_want_ptrs.fromUserID, mm_got.fromUserID))
+			}
+
+			if mm_want_ptrs.toUserID != nil && !minimock.Equal(*mm_want_ptrs.toUserID, mm_got.toUserID) {
+				mmTransferCandidateOwnership.t.Errorf("ResumeClientMock.TransferCandidateOwnership got unexpected parameter toUserID, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmTransferCandidateOwnership.TransferCandidateOwnershipMock.defaultExpectation.expectationOrigins.originToUserID, *mm_want_ptrs.toUserID, mm_got.toUserID, minimock.Diff(*mm_want_ptrs.toUserID, mm_got.toUserID))
+			}
+
+			if mm_want_ptrs.limit != nil && !minimock.Equal(*mm_want_ptrs.limit, mm_got.limit) {
+				mmTransferCandidateOwnership.t.Errorf("ResumeClientMock.TransferCandidateOwnership got unexpected parameter limit, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmTransferCandidateOwnership.TransferCandidateOwnershipMock.defaultExpectation.expectationOrigins.originLimit, *mm_want_ptrs.limit, mm_got.limit, minimock.Diff(*mm_want_ptrs.limit, mm_got.limit))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmTransferCandidateOwnership.t.Errorf("ResumeClientMock.TransferCandidateOwnership got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmTransferCandidateOwnership.TransferCandidateOwnershipMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmTransferCandidateOwnership.TransferCandidateOwnershipMock.defaultExpectation.results
+		if mm_results == nil {
+			mmTransferCandidateOwnership.t.Fatal("No results are set for the ResumeClientMock.TransferCandidateOwnership")
+		}
+		return (*mm_results).u1, (*mm_results).err
+	}
+	if mmTransferCandidateOwnership.funcTransferCandidateOwnership != nil {
+		return mmTransferCandidateOwnership.funcTransferCandidateOwnership(ctx, fromUserID, toUserID, limit)
+	}
+	mmTransferCandidateOwnership.t.Fatalf("Unexpected call to ResumeClientMock.TransferCandidateOwnership. %v %v %v %v", ctx, fromUserID, toUserID, limit)
+	return
+}
+
+// TransferCandidateOwnershipAfterCounter returns a count of finished ResumeClientMock.TransferCandidateOwnership invocations
+func (mmTransferCandidateOwnership *ResumeClientMock) TransferCandidateOwnershipAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmTransferCandidateOwnership.afterTransferCandidateOwnershipCounter)
+}
+
+// TransferCandidateOwnershipBeforeCounter returns a count of ResumeClientMock.TransferCandidateOwnership invocations
+func (mmTransferCandidateOwnership *ResumeClientMock) TransferCandidateOwnershipBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmTransferCandidateOwnership.beforeTransferCandidateOwnershipCounter)
+}
+
+// Calls returns a list of arguments used in each call to ResumeClientMock.TransferCandidateOwnership.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmTransferCandidateOwnership *mResumeClientMockTransferCandidateOwnership) Calls() []*ResumeClientMockTransferCandidateOwnershipParams {
+	mmTransferCandidateOwnership.mutex.RLock()
+
+	argCopy := make([]*ResumeClientMockTransferCandidateOwnershipParams, len(mmTransferCandidateOwnership.callArgs))
+	copy(argCopy, mmTransferCandidateOwnership.callArgs)
+
+	mmTransferCandidateOwnership.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockTransferCandidateOwnershipDone returns true if the count of the TransferCandidateOwnership invocations corresponds
+// the number of defined expectations
+func (m *ResumeClientMock) MinimockTransferCandidateOwnershipDone() bool {
+	if m.TransferCandidateOwnershipMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.TransferCandidateOwnershipMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.TransferCandidateOwnershipMock.invocationsDone()
+}
+
+// MinimockTransferCandidateOwnershipInspect logs each unmet expectation
+func (m *ResumeClientMock) MinimockTransferCandidateOwnershipInspect() {
+	for _, e := range m.TransferCandidateOwnershipMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to ResumeClientMock.TransferCandidateOwnership at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterTransferCandidateOwnershipCounter := mm_atomic.LoadUint64(&m.afterTransferCandidateOwnershipCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.TransferCandidateOwnershipMock.defaultExpectation != nil && afterTransferCandidateOwnershipCounter < 1 {
+		if m.TransferCandidateOwnershipMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to ResumeClientMock.TransferCandidateOwnership at\n%s", m.TransferCandidateOwnershipMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to ResumeClientMock.TransferCandidateOwnership at\n%s with params: %#v", m.TransferCandidateOwnershipMock.defaultExpectation.expectationOrigins.origin, *m.TransferCandidateOwnershipMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcTransferCandidateOwnership != nil && afterTransferCandidateOwnershipCounter < 1 {
+		m.t.Errorf("Expected call to ResumeClientMock.TransferCandidateOwnership at\n%s", m.funcTransferCandidateOwnershipOrigin)
+	}
+
+	if !m.TransferCandidateOwnershipMock.invocationsDone() && afterTransferCandidateOwnershipCounter > 0 {
+		m.t.Errorf("Expected %d calls to ResumeClientMock.TransferCandidateOwnership at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.TransferCandidateOwnershipMock.expectedInvocations), m.TransferCandidateOwnershipMock.expectedInvocationsOrigin, afterTransferCandidateOwnershipCounter)
+	}
+}
+
+// MinimockFinish checks that all mocked methods have been called the expected number of times
+func (m *ResumeClientMock) MinimockFinish() {
+	m.finishOnce.Do(func() {
+		if !m.minimockDone() {
+			m.MinimockTransferCandidateOwnershipInspect()
+		}
+	})
+}
+
+// MinimockWait waits for all mocked methods to be called the expected number of times
+func (m *ResumeClientMock) MinimockWait(timeout mm_time.Duration) {
+	timeoutCh := mm_time.After(timeout)
+	for {
+		if m.minimockDone() {
+			return
+		}
+		select {
+		case <-timeoutCh:
+			m.MinimockFinish()
+			return
+		case <-mm_time.After(10 * mm_time.Millisecond):
+		}
+	}
+}
+
+func (m *ResumeClientMock) minimockDone() bool {
+	done := true
+	return done &&
+		m.MinimockTransferCandidateOwnershipDone()
+}
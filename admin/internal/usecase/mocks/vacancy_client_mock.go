@@ -0,0 +1,477 @@
+// Code generated by http://github.com/gojuno/minimock (v3.4.7). DO NOT EDIT.
+
+package mocks
+
+import (
+	"context"
+	"sync"
+	mm_atomic "sync/atomic"
+	mm_time "time"
+
+	"github.com/gojuno/minimock/v3"
+)
+
+// VacancyClientMock implements mm_usecase.VacancyClient
+type VacancyClientMock struct {
+	t          minimock.Tester
+	finishOnce sync.Once
+
+	funcTransferVacancyOwnership          func(ctx context.Context, fromUserID uint64, toUserID uint64, limit uint32) (u1 uint32, err error)
+	funcTransferVacancyOwnershipOrigin    string
+	inspectFuncTransferVacancyOwnership   func(ctx context.Context, fromUserID uint64, toUserID uint64, limit uint32)
+	afterTransferVacancyOwnershipCounter  uint64
+	beforeTransferVacancyOwnershipCounter uint64
+	TransferVacancyOwnershipMock          mVacancyClientMockTransferVacancyOwnership
+}
+
+// NewVacancyClientMock returns a mock for mm_usecase.VacancyClient
+func NewVacancyClientMock(t minimock.Tester) *VacancyClientMock {
+	m := &VacancyClientMock{t: t}
+
+	if controller, ok := t.(minimock.MockController); ok {
+		controller.RegisterMocker(m)
+	}
+
+	m.TransferVacancyOwnershipMock = mVacancyClientMockTransferVacancyOwnership{mock: m}
+	m.TransferVacancyOwnershipMock.callArgs = []*VacancyClientMockTransferVacancyOwnershipParams{}
+
+	t.Cleanup(m.MinimockFinish)
+
+	return m
+}
+
+type mVacancyClientMockTransferVacancyOwnership struct {
+	optional           bool
+	mock               *VacancyClientMock
+	defaultExpectation *VacancyClientMockTransferVacancyOwnershipExpectation
+	expectations       []*VacancyClientMockTransferVacancyOwnershipExpectation
+
+	callArgs []*VacancyClientMockTransferVacancyOwnershipParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// VacancyClientMockTransferVacancyOwnershipExpectation specifies expectation struct of the VacancyClient.TransferVacancyOwnership
+type VacancyClientMockTransferVacancyOwnershipExpectation struct {
+	mock               *VacancyClientMock
+	params             *VacancyClientMockTransferVacancyOwnershipParams
+	paramPtrs          *VacancyClientMockTransferVacancyOwnershipParamPtrs
+	expectationOrigins VacancyClientMockTransferVacancyOwnershipExpectationOrigins
+	results            *VacancyClientMockTransferVacancyOwnershipResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// VacancyClientMockTransferVacancyOwnershipParams contains parameters of the VacancyClient.TransferVacancyOwnership
+type VacancyClientMockTransferVacancyOwnershipParams struct {
+	ctx        context.Context
+	fromUserID uint64
+	toUserID   uint64
+	limit      uint32
+}
+
+// VacancyClientMockTransferVacancyOwnershipParamPtrs contains pointers to parameters of the VacancyClient.TransferVacancyOwnership
+type VacancyClientMockTransferVacancyOwnershipParamPtrs struct {
+	ctx        *context.Context
+	fromUserID *uint64
+	toUserID   *uint64
+	limit      *uint32
+}
+
+// VacancyClientMockTransferVacancyOwnershipResults contains results of the VacancyClient.TransferVacancyOwnership
+type VacancyClientMockTransferVacancyOwnershipResults struct {
+	u1  uint32
+	err error
+}
+
+// VacancyClientMockTransferVacancyOwnershipOrigins contains origins of expectations of the VacancyClient.TransferVacancyOwnership
+type VacancyClientMockTransferVacancyOwnershipExpectationOrigins struct {
+	origin           string
+	originCtx        string
+	originFromUserID string
+	originToUserID   string
+	originLimit      string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmTransferVacancyOwnership *mVacancyClientMockTransferVacancyOwnership) Optional() *mVacancyClientMockTransferVacancyOwnership {
+	mmTransferVacancyOwnership.optional = true
+	return mmTransferVacancyOwnership
+}
+
+// Expect sets up expected params for VacancyClient.TransferVacancyOwnership
+func (mmTransferVacancyOwnership *mVacancyClientMockTransferVacancyOwnership) Expect(ctx context.Context, fromUserID uint64, toUserID uint64, limit uint32) *mVacancyClientMockTransferVacancyOwnership {
+	if mmTransferVacancyOwnership.mock.funcTransferVacancyOwnership != nil {
+		mmTransferVacancyOwnership.mock.t.Fatalf("VacancyClientMock.TransferVacancyOwnership mock is already set by Set")
+	}
+
+	if mmTransferVacancyOwnership.defaultExpectation == nil {
+		mmTransferVacancyOwnership.defaultExpectation = &VacancyClientMockTransferVacancyOwnershipExpectation{}
+	}
+
+	if mmTransferVacancyOwnership.defaultExpectation.paramPtrs != nil {
+		mmTransferVacancyOwnership.mock.t.Fatalf("VacancyClientMock.TransferVacancyOwnership mock is already set by ExpectParams functions")
+	}
+
+	mmTransferVacancyOwnership.defaultExpectation.params = &VacancyClientMockTransferVacancyOwnershipParams{ctx, fromUserID, toUserID, limit}
+	mmTransferVacancyOwnership.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmTransferVacancyOwnership.expectations {
+		if minimock.Equal(e.params, mmTransferVacancyOwnership.defaultExpectation.params) {
+			mmTransferVacancyOwnership.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmTransferVacancyOwnership.defaultExpectation.params)
+		}
+	}
+
+	return mmTransferVacancyOwnership
+}
+
+// ExpectCtxParam1 sets up expected param ctx for VacancyClient.TransferVacancyOwnership
+func (mmTransferVacancyOwnership *mVacancyClientMockTransferVacancyOwnership) ExpectCtxParam1(ctx context.Context) *mVacancyClientMockTransferVacancyOwnership {
+	if mmTransferVacancyOwnership.mock.funcTransferVacancyOwnership != nil {
+		mmTransferVacancyOwnership.mock.t.Fatalf("VacancyClientMock.TransferVacancyOwnership mock is already set by Set")
+	}
+
+	if mmTransferVacancyOwnership.defaultExpectation == nil {
+		mmTransferVacancyOwnership.defaultExpectation = &VacancyClientMockTransferVacancyOwnershipExpectation{}
+	}
+
+	if mmTransferVacancyOwnership.defaultExpectation.params != nil {
+		mmTransferVacancyOwnership.mock.t.Fatalf("VacancyClientMock.TransferVacancyOwnership mock is already set by Expect")
+	}
+
+	if mmTransferVacancyOwnership.defaultExpectation.paramPtrs == nil {
+		mmTransferVacancyOwnership.defaultExpectation.paramPtrs = &VacancyClientMockTransferVacancyOwnershipParamPtrs{}
+	}
+	mmTransferVacancyOwnership.defaultExpectation.paramPtrs.ctx = &ctx
+	mmTransferVacancyOwnership.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmTransferVacancyOwnership
+}
+
+// ExpectFromUserIDParam2 sets up expected param fromUserID for VacancyClient.TransferVacancyOwnership
+func (mmTransferVacancyOwnership *mVacancyClientMockTransferVacancyOwnership) ExpectFromUserIDParam2(fromUserID uint64) *mVacancyClientMockTransferVacancyOwnership {
+	if mmTransferVacancyOwnership.mock.funcTransferVacancyOwnership != nil {
+		mmTransferVacancyOwnership.mock.t.Fatalf("VacancyClientMock.TransferVacancyOwnership mock is already set by Set")
+	}
+
+	if mmTransferVacancyOwnership.defaultExpectation == nil {
+		mmTransferVacancyOwnership.defaultExpectation = &VacancyClientMockTransferVacancyOwnershipExpectation{}
+	}
+
+	if mmTransferVacancyOwnership.defaultExpectation.params != nil {
+		mmTransferVacancyOwnership.mock.t.Fatalf("VacancyClientMock.TransferVacancyOwnership mock is already set by Expect")
+	}
+
+	if mmTransferVacancyOwnership.defaultExpectation.paramPtrs == nil {
+		mmTransferVacancyOwnership.defaultExpectation.paramPtrs = &VacancyClientMockTransferVacancyOwnershipParamPtrs{}
+	}
+	mmTransferVacancyOwnership.defaultExpectation.paramPtrs.fromUserID = &fromUserID
+	mmTransferVacancyOwnership.defaultExpectation.expectationOrigins.originFromUserID = minimock.CallerInfo(1)
+
+	return mmTransferVacancyOwnership
+}
+
+// ExpectToUserIDParam3 sets up expected param toUserID for VacancyClient.TransferVacancyOwnership
+func (mmTransferVacancyOwnership *mVacancyClientMockTransferVacancyOwnership) ExpectToUserIDParam3(toUserID uint64) *mVacancyClientMockTransferVacancyOwnership {
+	if mmTransferVacancyOwnership.mock.funcTransferVacancyOwnership != nil {
+		mmTransferVacancyOwnership.mock.t.Fatalf("VacancyClientMock.TransferVacancyOwnership mock is already set by Set")
+	}
+
+	if mmTransferVacancyOwnership.defaultExpectation == nil {
+		mmTransferVacancyOwnership.defaultExpectation = &VacancyClientMockTransferVacancyOwnershipExpectation{}
+	}
+
+	if mmTransferVacancyOwnership.defaultExpectation.params != nil {
+		mmTransferVacancyOwnership.mock.t.Fatalf("VacancyClientMock.TransferVacancyOwnership mock is already set by Expect")
+	}
+
+	if mmTransferVacancyOwnership.defaultExpectation.paramPtrs == nil {
+		mmTransferVacancyOwnership.defaultExpectation.paramPtrs = &VacancyClientMockTransferVacancyOwnershipParamPtrs{}
+	}
+	mmTransferVacancyOwnership.defaultExpectation.paramPtrs.toUserID = &toUserID
+	mmTransferVacancyOwnership.defaultExpectation.expectationOrigins.originToUserID = minimock.CallerInfo(1)
+
+	return mmTransferVacancyOwnership
+}
+
+// ExpectLimitParam4 sets up expected param limit for VacancyClient.TransferVacancyOwnership
+func (mmTransferVacancyOwnership *mVacancyClientMockTransferVacancyOwnership) ExpectLimitParam4(limit uint32) *mVacancyClientMockTransferVacancyOwnership {
+	if mmTransferVacancyOwnership.mock.funcTransferVacancyOwnership != nil {
+		mmTransferVacancyOwnership.mock.t.Fatalf("VacancyClientMock.TransferVacancyOwnership mock is already set by Set")
+	}
+
+	if mmTransferVacancyOwnership.defaultExpectation == nil {
+		mmTransferVacancyOwnership.defaultExpectation = &VacancyClientMockTransferVacancyOwnershipExpectation{}
+	}
+
+	if mmTransferVacancyOwnership.defaultExpectation.params != nil {
+		mmTransferVacancyOwnership.mock.t.Fatalf("VacancyClientMock.TransferVacancyOwnership mock is already set by Expect")
+	}
+
+	if mmTransferVacancyOwnership.defaultExpectation.paramPtrs == nil {
+		mmTransferVacancyOwnership.defaultExpectation.paramPtrs = &VacancyClientMockTransferVacancyOwnershipParamPtrs{}
+	}
+	mmTransferVacancyOwnership.defaultExpectation.paramPtrs.limit = &limit
+	mmTransferVacancyOwnership.defaultExpectation.expectationOrigins.originLimit = minimock.CallerInfo(1)
+
+	return mmTransferVacancyOwnership
+}
+
+// Inspect accepts an inspector function that has same arguments as the VacancyClient.TransferVacancyOwnership
+func (mmTransferVacancyOwnership *mVacancyClientMockTransferVacancyOwnership) Inspect(f func(ctx context.Context, fromUserID uint64, toUserID uint64, limit uint32)) *mVacancyClientMockTransferVacancyOwnership {
+	if mmTransferVacancyOwnership.mock.inspectFuncTransferVacancyOwnership != nil {
+		mmTransferVacancyOwnership.mock.t.Fatalf("Inspect function is already set for VacancyClientMock.TransferVacancyOwnership")
+	}
+
+	mmTransferVacancyOwnership.mock.inspectFuncTransferVacancyOwnership = f
+
+	return mmTransferVacancyOwnership
+}
+
+// Return sets up results that will be returned by VacancyClient.TransferVacancyOwnership
+func (mmTransferVacancyOwnership *mVacancyClientMockTransferVacancyOwnership) Return(u1 uint32, err error) *VacancyClientMock {
+	if mmTransferVacancyOwnership.mock.funcTransferVacancyOwnership != nil {
+		mmTransferVacancyOwnership.mock.t.Fatalf("VacancyClientMock.TransferVacancyOwnership mock is already set by Set")
+	}
+
+	if mmTransferVacancyOwnership.defaultExpectation == nil {
+		mmTransferVacancyOwnership.defaultExpectation = &VacancyClientMockTransferVacancyOwnershipExpectation{mock: mmTransferVacancyOwnership.mock}
+	}
+	mmTransferVacancyOwnership.defaultExpectation.results = &VacancyClientMockTransferVacancyOwnershipResults{u1, err}
+	mmTransferVacancyOwnership.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmTransferVacancyOwnership.mock
+}
+
+// Set uses given function f to mock the VacancyClient.TransferVacancyOwnership method
+func (mmTransferVacancyOwnership *mVacancyClientMockTransferVacancyOwnership) Set(f func(ctx context.Context, fromUserID uint64, toUserID uint64, limit uint32) (u1 uint32, err error)) *VacancyClientMock {
+	if mmTransferVacancyOwnership.defaultExpectation != nil {
+		mmTransferVacancyOwnership.mock.t.Fatalf("Default expectation is already set for the VacancyClient.TransferVacancyOwnership method")
+	}
+
+	if len(mmTransferVacancyOwnership.expectations) > 0 {
+		mmTransferVacancyOwnership.mock.t.Fatalf("Some expectations are already set for the VacancyClient.TransferVacancyOwnership method")
+	}
+
+	mmTransferVacancyOwnership.mock.funcTransferVacancyOwnership = f
+	mmTransferVacancyOwnership.mock.funcTransferVacancyOwnershipOrigin = minimock.CallerInfo(1)
+	return mmTransferVacancyOwnership.mock
+}
+
+// When sets expectation for the VacancyClient.TransferVacancyOwnership which will trigger the result defined by the following
+// Then helper
+func (mmTransferVacancyOwnership *mVacancyClientMockTransferVacancyOwnership) When(ctx context.Context, fromUserID uint64, toUserID uint64, limit uint32) *VacancyClientMockTransferVacancyOwnershipExpectation {
+	if mmTransferVacancyOwnership.mock.funcTransferVacancyOwnership != nil {
+		mmTransferVacancyOwnership.mock.t.Fatalf("VacancyClientMock.TransferVacancyOwnership mock is already set by Set")
+	}
+
+	expectation := &VacancyClientMockTransferVacancyOwnershipExpectation{
+		mock:               mmTransferVacancyOwnership.mock,
+		params:             &VacancyClientMockTransferVacancyOwnershipParams{ctx, fromUserID, toUserID, limit},
+		expectationOrigins: VacancyClientMockTransferVacancyOwnershipExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmTransferVacancyOwnership.expectations = append(mmTransferVacancyOwnership.expectations, expectation)
+	return expectation
+}
+
+// Then sets up VacancyClient.TransferVacancyOwnership return parameters for the expectation previously defined by the When method
+func (e *VacancyClientMockTransferVacancyOwnershipExpectation) Then(u1 uint32, err error) *VacancyClientMock {
+	e.results = &VacancyClientMockTransferVacancyOwnershipResults{u1, err}
+	return e.mock
+}
+
+// Times sets number of times VacancyClient.TransferVacancyOwnership should be invoked
+func (mmTransferVacancyOwnership *mVacancyClientMockTransferVacancyOwnership) Times(n uint64) *mVacancyClientMockTransferVacancyOwnership {
+	if n == 0 {
+		mmTransferVacancyOwnership.mock.t.Fatalf("Times of VacancyClientMock.TransferVacancyOwnership mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmTransferVacancyOwnership.expectedInvocations, n)
+	mmTransferVacancyOwnership.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmTransferVacancyOwnership
+}
+
+func (mmTransferVacancyOwnership *mVacancyClientMockTransferVacancyOwnership) invocationsDone() bool {
+	if len(mmTransferVacancyOwnership.expectations) == 0 && mmTransferVacancyOwnership.defaultExpectation == nil && mmTransferVacancyOwnership.mock.funcTransferVacancyOwnership == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmTransferVacancyOwnership.mock.afterTransferVacancyOwnershipCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmTransferVacancyOwnership.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// TransferVacancyOwnership implements mm_usecase.VacancyClient
+func (mmTransferVacancyOwnership *VacancyClientMock) TransferVacancyOwnership(ctx context.Context, fromUserID uint64, toUserID uint64, limit uint32) (u1 uint32, err error) {
+	mm_atomic.AddUint64(&mmTransferVacancyOwnership.beforeTransferVacancyOwnershipCounter, 1)
+	defer mm_atomic.AddUint64(&mmTransferVacancyOwnership.afterTransferVacancyOwnershipCounter, 1)
+
+	mmTransferVacancyOwnership.t.Helper()
+
+	if mmTransferVacancyOwnership.inspectFuncTransferVacancyOwnership != nil {
+		mmTransferVacancyOwnership.inspectFuncTransferVacancyOwnership(ctx, fromUserID, toUserID, limit)
+	}
+
+	mm_params := VacancyClientMockTransferVacancyOwnershipParams{ctx, fromUserID, toUserID, limit}
+
+	// Record call args
+	mmTransferVacancyOwnership.TransferVacancyOwnershipMock.mutex.Lock()
+	mmTransferVacancyOwnership.TransferVacancyOwnershipMock.callArgs = append(mmTransferVacancyOwnership.TransferVacancyOwnershipMock.callArgs, &mm_params)
+	mmTransferVacancyOwnership.TransferVacancyOwnershipMock.mutex.Unlock()
+
+	for _, e := range mmTransferVacancyOwnership.TransferVacancyOwnershipMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.u1, e.results.err
+		}
+	}
+
+	if mmTransferVacancyOwnership.TransferVacancyOwnershipMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmTransferVacancyOwnership.TransferVacancyOwnershipMock.defaultExpectation.Counter, 1)
+		mm_want := mmTransferVacancyOwnership.TransferVacancyOwnershipMock.defaultExpectation.params
+		mm_want_ptrs := mmTransferVacancyOwnership.TransferVacancyOwnershipMock.defaultExpectation.paramPtrs
+
+		mm_got := VacancyClientMockTransferVacancyOwnershipParams{ctx, fromUserID, toUserID, limit}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmTransferVacancyOwnership.t.Errorf("VacancyClientMock.TransferVacancyOwnership got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmTransferVacancyOwnership.TransferVacancyOwnershipMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.fromUserID != nil && !minimock.Equal(*mm_want_ptrs.fromUserID, mm_got.fromUserID) {
+				mmTransferVacancyOwnership.t.Errorf("VacancyClientMock.TransferVacancyOwnership got unexpected parameter fromUserID, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmTransferVacancyOwnership.TransferVacancyOwnershipMock.defaultExpectation.expectationOrigins.originFromUserID, *mm_want_ptrs.fromUserID, mm_got.fromUserID, minimock.Diff(*mm_want_ptrs.fromUserID, mm_got.fromUserID))
+			}
+
+			if mm_want_ptrs.toUserID != nil && !minimock.Equal(*mm_want_ptrs.toUserID, mm_got.toUserID) {
+				mmTransferVacancyOwnership.t.Errorf("VacancyClientMock.TransferVacancyOwnership got unexpected parameter toUserID, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmTransferVacancyOwnership.TransferVacancyOwnershipMock.defaultExpectation.expectationOrigins.originToUserID, *mm_want_ptrs.toUserID, mm_got.toUserID, minimock.Diff(*mm_want_ptrs.toUserID, mm_got.toUserID))
+			}
+
+			if mm_want_ptrs.limit != nil && !minimock.Equal(*mm_want_ptrs.limit, mm_got.limit) {
+				mmTransferVacancyOwnership.t.Errorf("VacancyClientMock.TransferVacancyOwnership got unexpected parameter limit, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmTransferVacancyOwnership.TransferVacancyOwnershipMock.defaultExpectation.expectationOrigins.originLimit, *mm_want_ptrs.limit, mm_got.limit, minimock.Diff(*mm_want_ptrs.limit, mm_got.limit))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmTransferVacancyOwnership.t.Errorf("VacancyClientMock.TransferVacancyOwnership got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmTransferVacancyOwnership.TransferVacancyOwnershipMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmTransferVacancyOwnership.TransferVacancyOwnershipMock.defaultExpectation.results
+		if mm_results == nil {
+			mmTransferVacancyOwnership.t.Fatal("No results are set for the VacancyClientMock.TransferVacancyOwnership")
+		}
+		return (*mm_results).u1, (*mm_results).err
+	}
+	if mmTransferVacancyOwnership.funcTransferVacancyOwnership != nil {
+		return mmTransferVacancyOwnership.funcTransferVacancyOwnership(ctx, fromUserID, toUserID, limit)
+	}
+	mmTransferVacancyOwnership.t.Fatalf("Unexpected call to VacancyClientMock.TransferVacancyOwnership. %v %v %v %v", ctx, fromUserID, toUserID, limit)
+	return
+}
+
+// TransferVacancyOwnershipAfterCounter returns a count of finished VacancyClientMock.TransferVacancyOwnership invocations
+func (mmTransferVacancyOwnership *VacancyClientMock) TransferVacancyOwnershipAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmTransferVacancyOwnership.afterTransferVacancyOwnershipCounter)
+}
+
+// TransferVacancyOwnershipBeforeCounter returns a count of VacancyClientMock.TransferVacancyOwnership invocations
+func (mmTransferVacancyOwnership *VacancyClientMock) TransferVacancyOwnershipBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmTransferVacancyOwnership.beforeTransferVacancyOwnershipCounter)
+}
+
+// Calls returns a list of arguments used in each call to VacancyClientMock.TransferVacancyOwnership.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmTransferVacancyOwnership *mVacancyClientMockTransferVacancyOwnership) Calls() []*VacancyClientMockTransferVacancyOwnershipParams {
+	mmTransferVacancyOwnership.mutex.RLock()
+
+	argCopy := make([]*VacancyClientMockTransferVacancyOwnershipParams, len(mmTransferVacancyOwnership.callArgs))
+	copy(argCopy, mmTransferVacancyOwnership.callArgs)
+
+	mmTransferVacancyOwnership.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockTransferVacancyOwnershipDone returns true if the count of the TransferVacancyOwnership invocations corresponds
+// the number of defined expectations
+func (m *VacancyClientMock) MinimockTransferVacancyOwnershipDone() bool {
+	if m.TransferVacancyOwnershipMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.TransferVacancyOwnershipMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.TransferVacancyOwnershipMock.invocationsDone()
+}
+
+// MinimockTransferVacancyOwnershipInspect logs each unmet expectation
+func (m *VacancyClientMock) MinimockTransferVacancyOwnershipInspect() {
+	for _, e := range m.TransferVacancyOwnershipMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to VacancyClientMock.TransferVacancyOwnership at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterTransferVacancyOwnershipCounter := mm_atomic.LoadUint64(&m.afterTransferVacancyOwnershipCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.TransferVacancyOwnershipMock.defaultExpectation != nil && afterTransferVacancyOwnershipCounter < 1 {
+		if m.TransferVacancyOwnershipMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to VacancyClientMock.TransferVacancyOwnership at\n%s", m.TransferVacancyOwnershipMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to VacancyClientMock.TransferVacancyOwnership at\n%s with params: %#v", m.TransferVacancyOwnershipMock.defaultExpectation.expectationOrigins.origin, *m.TransferVacancyOwnershipMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcTransferVacancyOwnership != nil && afterTransferVacancyOwnershipCounter < 1 {
+		m.t.Errorf("Expected call to VacancyClientMock.TransferVacancyOwnership at\n%s", m.funcTransferVacancyOwnershipOrigin)
+	}
+
+	if !m.TransferVacancyOwnershipMock.invocationsDone() && afterTransferVacancyOwnershipCounter > 0 {
+		m.t.Errorf("Expected %d calls to VacancyClientMock.TransferVacancyOwnership at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.TransferVacancyOwnershipMock.expectedInvocations), m.TransferVacancyOwnershipMock.expectedInvocationsOrigin, afterTransferVacancyOwnershipCounter)
+	}
+}
+
+// MinimockFinish checks that all mocked methods have been called the expected number of times
+func (m *VacancyClientMock) MinimockFinish() {
+	m.finishOnce.Do(func() {
+		if !m.minimockDone() {
+			m.MinimockTransferVacancyOwnershipInspect()
+		}
+	})
+}
+
+// MinimockWait waits for all mocked methods to be called the expected number of times
+func (m *VacancyClientMock) MinimockWait(timeout mm_time.Duration) {
+	timeoutCh := mm_time.After(timeout)
+	for {
+		if m.minimockDone() {
+			return
+		}
+		select {
+		case <-timeoutCh:
+			m.MinimockFinish()
+			return
+		case <-mm_time.After(10 * mm_time.Millisecond):
+		}
+	}
+}
+
+func (m *VacancyClientMock) minimockDone() bool {
+	done := true
+	return done &&
+		m.MinimockTransferVacancyOwnershipDone()
+}
@@ -14,6 +14,8 @@ type baseSuite struct {
 	suite.Suite
 	storage    *mocks.AdminStorageMock
 	authClient *mocks.AuthClientMock
+	vacancies  *mocks.VacancyClientMock
+	resumes    *mocks.ResumeClientMock
 	svc        *AdminService
 }
 
@@ -21,5 +23,7 @@ func (s *baseSuite) SetupTest() {
 	t := s.T()
 	s.storage = mocks.NewAdminStorageMock(t)
 	s.authClient = mocks.NewAuthClientMock(t)
-	s.svc = NewAdminService(s.storage, s.authClient)
+	s.vacancies = mocks.NewVacancyClientMock(t)
+	s.resumes = mocks.NewResumeClientMock(t)
+	s.svc = NewAdminService(s.storage, s.authClient, s.vacancies, s.resumes)
 }
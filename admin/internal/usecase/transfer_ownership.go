@@ -0,0 +1,112 @@
+package usecase
+
+import (
+	"cmp"
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/artem13815/hr/admin/internal/domain"
+)
+
+const (
+	// transferBatchSize is how many vacancies or candidates one RPC to the
+	// owning service moves. Progress is recorded after every batch, so an
+	// interrupted transfer loses at most one batch worth of counting.
+	transferBatchSize uint32 = 200
+	// transferListLimit caps ListOwnershipTransfers; the dashboard shows the
+	// recent history only.
+	transferListLimit uint32 = 100
+)
+
+// transferBatch moves up to limit entities from one owner to another and
+// reports how many moved. Matches VacancyClient and ResumeClient.
+type transferBatch func(ctx context.Context, fromUserID, toUserID uint64, limit uint32) (uint32, error)
+
+// TransferOwnership hands everything in.FromUserID owns within in.Scope to
+// in.ToUserID: vacancies through the vacancy service, candidates through the
+// resume service, in batches. The run is recorded in ownership_transfers.
+//
+// The call is idempotent and resumable: both services only move what the
+// source user still owns, and a failed transfer with the same users and
+// scope is picked up where it stopped instead of starting a new one. A
+// failure midway marks the transfer failed and returns
+// ErrTransferInterrupted; repeating the call finishes the job, and a call
+// with another target or scope abandons it instead. A transfer still
+// running in another call is ErrTransferInProgress until it finishes or
+// goes domain.TransferStaleAfter without progress.
+func (s *AdminService) TransferOwnership(ctx context.Context, in domain.TransferOwnershipInput) (*domain.OwnershipTransfer, error) {
+	if !in.IsAdmin {
+		return nil, ErrUnauthorized
+	}
+	if in.FromUserID == 0 || in.ToUserID == 0 || in.FromUserID == in.ToUserID {
+		return nil, ErrInvalidArgument
+	}
+	in.Scope = cmp.Or(in.Scope, domain.TransferScopeAll)
+	if !domain.IsKnownTransferScope(in.Scope) {
+		return nil, ErrInvalidArgument
+	}
+
+	transfer, err := s.storage.StartOwnershipTransfer(ctx, in)
+	switch {
+	case errors.Is(err, domain.ErrUserNotFound):
+		return nil, ErrNotFound
+	case errors.Is(err, domain.ErrTransferInProgress):
+		return nil, ErrTransferInProgress
+	case err != nil:
+		return nil, err
+	}
+
+	if err := s.runTransfer(ctx, transfer); err != nil {
+		// The failure is recorded even when ctx itself is what failed.
+		_, finishErr := s.storage.FinishOwnershipTransfer(context.WithoutCancel(ctx), transfer.ID, domain.TransferStatusFailed, err.Error())
+		return nil, fmt.Errorf("%w: %w", ErrTransferInterrupted, errors.Join(err, finishErr))
+	}
+	return s.storage.FinishOwnershipTransfer(ctx, transfer.ID, domain.TransferStatusCompleted, "")
+}
+
+// ListOwnershipTransfers returns the most recent transfers, newest first.
+// Like the other dashboard reads, admin-only access is enforced by the
+// transport interceptor.
+func (s *AdminService) ListOwnershipTransfers(ctx context.Context) ([]domain.OwnershipTransfer, error) {
+	return s.storage.ListOwnershipTransfers(ctx, transferListLimit)
+}
+
+func (s *AdminService) runTransfer(ctx context.Context, t *domain.OwnershipTransfer) error {
+	if t.Scope != domain.TransferScopeCandidates {
+		err := s.drainTransfer(ctx, t, s.vacancies.TransferVacancyOwnership, func(n uint32) error {
+			return s.storage.AddOwnershipTransferProgress(ctx, t.ID, n, 0)
+		})
+		if err != nil {
+			return fmt.Errorf("transfer vacancies: %w", err)
+		}
+	}
+	if t.Scope != domain.TransferScopeVacancies {
+		err := s.drainTransfer(ctx, t, s.resumes.TransferCandidateOwnership, func(n uint32) error {
+			return s.storage.AddOwnershipTransferProgress(ctx, t.ID, 0, n)
+		})
+		if err != nil {
+			return fmt.Errorf("transfer candidates: %w", err)
+		}
+	}
+	return nil
+}
+
+// drainTransfer calls move until a batch comes back short, recording each
+// non-empty batch.
+func (s *AdminService) drainTransfer(ctx context.Context, t *domain.OwnershipTransfer, move transferBatch, record func(n uint32) error) error {
+	for {
+		n, err := move(ctx, t.FromUserID, t.ToUserID, transferBatchSize)
+		if err != nil {
+			return err
+		}
+		if n > 0 {
+			if err := record(n); err != nil {
+				return err
+			}
+		}
+		if n < transferBatchSize {
+			return nil
+		}
+	}
+}
@@ -0,0 +1,180 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+	"gotest.tools/v3/assert"
+
+	"github.com/artem13815/hr/admin/internal/domain"
+)
+
+type TransferOwnershipSuite struct{ baseSuite }
+
+func transferInput(scope string) domain.TransferOwnershipInput {
+	return domain.TransferOwnershipInput{
+		CallerUserID: 1,
+		IsAdmin:      true,
+		FromUserID:   7,
+		ToUserID:     9,
+		Scope:        scope,
+	}
+}
+
+// batches returns a transferBatch that replays counts, one per call, and
+// fails the test if called more often.
+func (s *TransferOwnershipSuite) batches(counts ...uint32) transferBatch {
+	return func(_ context.Context, fromUserID, toUserID uint64, limit uint32) (uint32, error) {
+		t := s.T()
+		assert.Equal(t, fromUserID, uint64(7))
+		assert.Equal(t, toUserID, uint64(9))
+		assert.Equal(t, limit, transferBatchSize)
+		assert.Assert(t, len(counts) > 0, "unexpected extra batch")
+		n := counts[0]
+		counts = counts[1:]
+		return n, nil
+	}
+}
+
+func (s *TransferOwnershipSuite) TestEmptyScopeMovesEverythingInBatches() {
+	t := s.T()
+	ctx := t.Context()
+	started := &domain.OwnershipTransfer{ID: 3, FromUserID: 7, ToUserID: 9, Scope: domain.TransferScopeAll, Status: domain.TransferStatusRunning}
+	want := &domain.OwnershipTransfer{ID: 3, Status: domain.TransferStatusCompleted, VacanciesTransferred: 205}
+
+	s.storage.StartOwnershipTransferMock.Expect(ctx, transferInput(domain.TransferScopeAll)).Return(started, nil)
+	s.vacancies.TransferVacancyOwnershipMock.Set(s.batches(transferBatchSize, 5))
+	s.resumes.TransferCandidateOwnershipMock.Set(s.batches(0))
+	var recorded [][2]uint32
+	s.storage.AddOwnershipTransferProgressMock.Set(func(_ context.Context, transferID uint64, vacancies, candidates uint32) error {
+		assert.Equal(t, transferID, uint64(3))
+		recorded = append(recorded, [2]uint32{vacancies, candidates})
+		return nil
+	})
+	s.storage.FinishOwnershipTransferMock.Expect(ctx, uint64(3), domain.TransferStatusCompleted, "").Return(want, nil)
+
+	got, err := s.svc.TransferOwnership(ctx, transferInput(""))
+	assert.NilError(t, err)
+	assert.Equal(t, got, want)
+	assert.DeepEqual(t, recorded, [][2]uint32{{transferBatchSize, 0}, {5, 0}})
+}
+
+func (s *TransferOwnershipSuite) TestCandidatesScopeSkipsVacancies() {
+	t := s.T()
+	ctx := t.Context()
+	in := transferInput(domain.TransferScopeCandidates)
+	started := &domain.OwnershipTransfer{ID: 3, FromUserID: 7, ToUserID: 9, Scope: domain.TransferScopeCandidates}
+	want := &domain.OwnershipTransfer{ID: 3, Status: domain.TransferStatusCompleted, CandidatesTransferred: 12}
+
+	s.storage.StartOwnershipTransferMock.Expect(ctx, in).Return(started, nil)
+	s.resumes.TransferCandidateOwnershipMock.Set(s.batches(12))
+	s.storage.AddOwnershipTransferProgressMock.Expect(ctx, uint64(3), 0, 12).Return(nil)
+	s.storage.FinishOwnershipTransferMock.Expect(ctx, uint64(3), domain.TransferStatusCompleted, "").Return(want, nil)
+
+	got, err := s.svc.TransferOwnership(ctx, in)
+	assert.NilError(t, err)
+	assert.Equal(t, got, want)
+}
+
+func (s *TransferOwnershipSuite) TestVacanciesScopeSkipsCandidates() {
+	t := s.T()
+	ctx := t.Context()
+	in := transferInput(domain.TransferScopeVacancies)
+	started := &domain.OwnershipTransfer{ID: 3, FromUserID: 7, ToUserID: 9, Scope: domain.TransferScopeVacancies}
+	want := &domain.OwnershipTransfer{ID: 3, Status: domain.TransferStatusCompleted}
+
+	s.storage.StartOwnershipTransferMock.Expect(ctx, in).Return(started, nil)
+	s.vacancies.TransferVacancyOwnershipMock.Set(s.batches(0))
+	s.storage.FinishOwnershipTransferMock.Expect(ctx, uint64(3), domain.TransferStatusCompleted, "").Return(want, nil)
+
+	got, err := s.svc.TransferOwnership(ctx, in)
+	assert.NilError(t, err)
+	assert.Equal(t, got, want)
+}
+
+// TestFailureIsRecordedAndResumable: a batch that fails leaves the transfer
+// failed with the cause; the caller sees ErrTransferInterrupted and repeats.
+func (s *TransferOwnershipSuite) TestFailureIsRecordedAndResumable() {
+	t := s.T()
+	ctx := t.Context()
+	rpcErr := errors.New("resume: unavailable")
+	started := &domain.OwnershipTransfer{ID: 3, FromUserID: 7, ToUserID: 9, Scope: domain.TransferScopeAll}
+
+	s.storage.StartOwnershipTransferMock.Expect(ctx, transferInput(domain.TransferScopeAll)).Return(started, nil)
+	s.vacancies.TransferVacancyOwnershipMock.Set(s.batches(4))
+	s.storage.AddOwnershipTransferProgressMock.Expect(ctx, uint64(3), 4, 0).Return(nil)
+	s.resumes.TransferCandidateOwnershipMock.Return(0, rpcErr)
+	s.storage.FinishOwnershipTransferMock.Set(func(_ context.Context, transferID uint64, status, errMsg string) (*domain.OwnershipTransfer, error) {
+		assert.Equal(t, transferID, uint64(3))
+		assert.Equal(t, status, domain.TransferStatusFailed)
+		assert.Equal(t, errMsg, "transfer candidates: resume: unavailable")
+		return &domain.OwnershipTransfer{ID: 3, Status: status}, nil
+	})
+
+	got, err := s.svc.TransferOwnership(ctx, transferInput(domain.TransferScopeAll))
+	assert.ErrorIs(t, err, ErrTransferInterrupted)
+	assert.ErrorIs(t, err, rpcErr)
+	assert.Assert(t, got == nil)
+}
+
+func (s *TransferOwnershipSuite) TestTargetUserNotFound() {
+	t := s.T()
+	ctx := t.Context()
+
+	s.storage.StartOwnershipTransferMock.Expect(ctx, transferInput(domain.TransferScopeAll)).Return(nil, domain.ErrUserNotFound)
+
+	got, err := s.svc.TransferOwnership(ctx, transferInput(domain.TransferScopeAll))
+	assert.ErrorIs(t, err, ErrNotFound)
+	assert.Assert(t, got == nil)
+}
+
+func (s *TransferOwnershipSuite) TestConflictingTransferInProgress() {
+	t := s.T()
+	ctx := t.Context()
+
+	s.storage.StartOwnershipTransferMock.Expect(ctx, transferInput(domain.TransferScopeAll)).Return(nil, domain.ErrTransferInProgress)
+
+	got, err := s.svc.TransferOwnership(ctx, transferInput(domain.TransferScopeAll))
+	assert.ErrorIs(t, err, ErrTransferInProgress)
+	assert.Assert(t, got == nil)
+}
+
+func (s *TransferOwnershipSuite) TestRejectsNonAdminCaller() {
+	t := s.T()
+	in := transferInput(domain.TransferScopeAll)
+	in.IsAdmin = false
+
+	_, err := s.svc.TransferOwnership(t.Context(), in)
+	assert.ErrorIs(t, err, ErrUnauthorized)
+}
+
+func (s *TransferOwnershipSuite) TestRejectsInvalidInput() {
+	t := s.T()
+	for name, mutate := range map[string]func(*domain.TransferOwnershipInput){
+		"zero from":     func(in *domain.TransferOwnershipInput) { in.FromUserID = 0 },
+		"zero to":       func(in *domain.TransferOwnershipInput) { in.ToUserID = 0 },
+		"same user":     func(in *domain.TransferOwnershipInput) { in.ToUserID = in.FromUserID },
+		"unknown scope": func(in *domain.TransferOwnershipInput) { in.Scope = "templates" },
+	} {
+		in := transferInput(domain.TransferScopeAll)
+		mutate(&in)
+		_, err := s.svc.TransferOwnership(t.Context(), in)
+		assert.ErrorIs(t, err, ErrInvalidArgument, name)
+	}
+}
+
+func (s *TransferOwnershipSuite) TestListOwnershipTransfers() {
+	t := s.T()
+	ctx := t.Context()
+	want := []domain.OwnershipTransfer{{ID: 3, Status: domain.TransferStatusCompleted}}
+
+	s.storage.ListOwnershipTransfersMock.Expect(ctx, transferListLimit).Return(want, nil)
+
+	got, err := s.svc.ListOwnershipTransfers(ctx)
+	assert.NilError(t, err)
+	assert.DeepEqual(t, got, want)
+}
+
+func TestTransferOwnershipSuite(t *testing.T) { suite.Run(t, new(TransferOwnershipSuite)) }
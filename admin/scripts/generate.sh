@@ -15,7 +15,9 @@ protoc -I ./api \
   ./api/common/common.proto \
   ./api/models/admin_model.proto \
   ./api/admin_api/admin.proto \
-  ./api/auth_api/auth.proto
+  ./api/auth_api/auth.proto \
+  ./api/vacancy_api/vacancy.proto \
+  ./api/resume_api/resume.proto
 
 protoc -I ./api \
   -I ./api/google/api \
@@ -48,9 +48,18 @@ func run() error {
 		return err
 	}
 
-	// Hooks run LIFO during shutdown — close the auth conn, then the
-	// multiagent conn, then the pgxpool — mirroring construction order.
-	return bootstrap.AppRun(api, authClient, cfg, storage.Close, multiAgentCleanup, authCleanup)
+	stopEvents, err := bootstrap.StartVacancyEventConsumer(cfg, storage)
+	if err != nil {
+		authCleanup()
+		multiAgentCleanup()
+		storage.Close()
+		return err
+	}
+
+	// Hooks run LIFO during shutdown — stop the event consumer, close the
+	// auth conn, then the multiagent conn, then the pgxpool — mirroring
+	// construction order.
+	return bootstrap.AppRun(api, authClient, cfg, storage.Close, multiAgentCleanup, authCleanup, stopEvents)
 }
 
 func defaultConfigPathByEnv(appEnv string) string {
@@ -12,6 +12,9 @@ type Config struct {
 	Server     ServerConfig     `yaml:"server"`
 	Auth       AuthConfig       `yaml:"auth"`
 	MultiAgent MultiAgentConfig `yaml:"multiagent"`
+	// VacancyEvents is optional: an empty grpc_addr leaves the vacancy
+	// event consumer off.
+	VacancyEvents VacancyEventsConfig `yaml:"vacancy_events"`
 }
 
 // AuthConfig points at the auth gRPC service. Analysis calls
@@ -62,6 +65,12 @@ type MultiAgentConfig struct {
 	GRPCAddr string `yaml:"grpc_addr"`
 }
 
+// VacancyEventsConfig points at the vacancy service's internal
+// SubscribeVacancyEvents stream.
+type VacancyEventsConfig struct {
+	GRPCAddr string `yaml:"grpc_addr"`
+}
+
 func LoadConfig(filename string) (*Config, error) {
 	data, err := os.ReadFile(filename)
 	if err != nil {
@@ -0,0 +1,41 @@
+package bootstrap
+
+import (
+	"context"
+	"log/slog"
+
+	"github.com/artem13815/hr/analysis/config"
+	"github.com/artem13815/hr/analysis/internal/infrastructure/persistence"
+	"github.com/artem13815/hr/analysis/internal/infrastructure/vacancy_events"
+	"github.com/artem13815/hr/analysis/internal/usecase"
+)
+
+// StartVacancyEventConsumer subscribes to vacancy events in the background.
+// The returned hook stops the subscription, waits for it and closes the
+// conn; it must run before storage.Close. With vacancy_events.grpc_addr
+// empty the consumer stays off and the hook is a no-op.
+func StartVacancyEventConsumer(cfg *config.Config, storage *persistence.AnalysisStorage) (func(), error) {
+	if cfg.VacancyEvents.GRPCAddr == "" {
+		slog.Info("vacancy event consumer disabled")
+		return func() {}, nil
+	}
+
+	consumer := usecase.NewVacancyEventConsumer(storage)
+	subscriber, cleanup, err := vacancy_events.New(cfg.VacancyEvents.GRPCAddr, consumer)
+	if err != nil {
+		return nil, err
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	done := make(chan struct{})
+	go func() {
+		defer close(done)
+		subscriber.Run(ctx)
+	}()
+
+	return func() {
+		cancel()
+		<-done
+		cleanup()
+	}, nil
+}
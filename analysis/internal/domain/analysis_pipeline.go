@@ -17,6 +17,15 @@ type ResumeContext struct {
 	// during the LLM fan-out. Empty when the vacancy has no role set —
 	// multiagent handles the fallback to default prompt.
 	VacancyRole string
+	// VacancySeniority and the salary range are the vacancy attributes
+	// forwarded to multiagent with the role. Empty / zero when unset.
+	VacancySeniority      string
+	VacancySalaryMin      uint64
+	VacancySalaryMax      uint64
+	VacancySalaryCurrency string
+	// CandidateSource is candidates.source (owned by the resume service);
+	// StartApplicationAnalysis only runs for SourcePublicApply.
+	CandidateSource string
 }
 
 // AnalysisPayload is the structured outcome of the scoring step. The Scorer
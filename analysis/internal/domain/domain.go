@@ -9,6 +9,22 @@ const (
 	StatusFailed  = "failed"
 )
 
+// SourcePublicApply is the candidates.source value the resume service writes
+// for candidates who applied through the public apply form.
+const SourcePublicApply = "public_apply"
+
+// VacancyAcceptsCandidates reports whether a vacancy in the given
+// vacancies.status (owned by the vacancy service) may take new candidates.
+// Closed, filled and archived vacancies may not; an empty status (vacancy
+// row missing) is not blocked here — LoadVacancySkills decides that.
+func VacancyAcceptsCandidates(status string) bool {
+	switch status {
+	case "closed", "filled", "archived":
+		return false
+	}
+	return true
+}
+
 type CandidateProfile struct {
 	Skills          []string `json:"skills"`
 	YearsExperience float32  `json:"years_experience"`
@@ -26,6 +42,12 @@ type ScoreBreakdown struct {
 	MustHavePenalty float32  `json:"must_have_penalty"`
 	NiceToHaveBonus float32  `json:"nice_to_have_bonus"`
 	Explanation     string   `json:"explanation"`
+	// Policy is the scoring policy the analysis ran under; nil for
+	// analyses saved before policies existed, which used the default.
+	Policy *ScoringPolicy `json:"policy,omitempty"`
+	// MustHaveVeto is set when the policy's "any missing must-have ⇒ no"
+	// rule overrode the score-based recommendation.
+	MustHaveVeto bool `json:"must_have_veto,omitempty"`
 }
 
 type AgentResult struct {
@@ -74,6 +96,11 @@ type StartAnalysisResult struct {
 	Status     string
 }
 
+// PurgeVacancyAnalysesResult counts what PurgeVacancyAnalyses removed.
+type PurgeVacancyAnalysesResult struct {
+	Analyses uint32
+}
+
 type GetAnalysisInput struct {
 	RequestUserID uint64
 	IsAdmin       bool
@@ -89,6 +116,9 @@ type ListCandidatesByVacancyInput struct {
 	MinScore       float32
 	RequiredSkill  string
 	ScoreOrderDesc bool
+	// Stage filters by current pipeline stage (owned by the vacancy
+	// service); empty means every stage.
+	Stage string
 }
 
 type CandidateWithAnalysis struct {
@@ -100,6 +130,7 @@ type CandidateWithAnalysis struct {
 	AnalysisID     string
 	AnalysisStatus string
 	CreatedAt      time.Time
+	Stage          string
 }
 
 type ListCandidatesByVacancyResult struct {
@@ -112,4 +143,8 @@ type VacancySkill struct {
 	Weight     float32
 	MustHave   bool
 	NiceToHave bool
+	// Aliases are the lower-case spellings the vacancy service's skill
+	// taxonomy knows for Name ("golang", "голанг" for Go), Name's own
+	// included. Empty for skills outside the taxonomy.
+	Aliases []string
 }
@@ -0,0 +1,29 @@
+package domain
+
+// ScoringPolicy is the vacancy's scoring policy, kept by the vacancy service
+// in the vacancies.score_* columns. Each analysis records the policy it was
+// scored under in its breakdown, so later policy edits do not make old
+// scores unexplainable.
+type ScoringPolicy struct {
+	// MustHavePenalty is subtracted per missed must-have skill,
+	// NiceToHaveBonus added per matched nice-to-have.
+	MustHavePenalty float32 `json:"must_have_penalty"`
+	NiceToHaveBonus float32 `json:"nice_to_have_bonus"`
+	// HireThreshold and MaybeThreshold are the minimum scores for the
+	// "hire" and "maybe" recommendations.
+	HireThreshold  float32 `json:"hire_threshold"`
+	MaybeThreshold float32 `json:"maybe_threshold"`
+	// RejectOnMissingMustHave turns any missed must-have into "no".
+	RejectOnMissingMustHave bool `json:"reject_on_missing_must_have"`
+}
+
+// DefaultScoringPolicy is the policy of vacancies that never set one — and
+// the one every analysis saved before policies existed was scored under.
+func DefaultScoringPolicy() ScoringPolicy {
+	return ScoringPolicy{
+		MustHavePenalty: 10,
+		NiceToHaveBonus: 2,
+		HireThreshold:   75,
+		MaybeThreshold:  45,
+	}
+}
@@ -0,0 +1,23 @@
+package domain
+
+// Vacancy event types as published by the vacancy service outbox.
+const (
+	EventVacancyCreated       = "vacancy_created"
+	EventVacancyUpdated       = "vacancy_updated"
+	EventVacancyStatusChanged = "vacancy_status_changed"
+	EventVacancyDeleted       = "vacancy_deleted"
+	EventVacancyRestored      = "vacancy_restored"
+	EventVacancyPurged        = "vacancy_purged"
+)
+
+// VacancyEvent is one delivery from vacancy's SubscribeVacancyEvents. ID is
+// the deduplication key; Seq is the resume cursor.
+type VacancyEvent struct {
+	Seq       uint64
+	ID        string
+	Type      string
+	VacancyID string
+	Version   uint32
+	Status    string
+	OldStatus string
+}
@@ -0,0 +1,39 @@
+package persistence
+
+// Access predicates over the tables the vacancy and resume services own in
+// the shared hr database. They mirror the checks those services apply, so
+// a user sees the same candidates and analyses through every service.
+// Soft-deleted vacancies, and the candidates on them, fail every predicate,
+// admins included.
+
+// liveCandidateVacancy holds unless the vacancy of the candidate row
+// aliased c is soft-deleted.
+const liveCandidateVacancy = `NOT EXISTS (SELECT 1 FROM vacancies dv
+                WHERE dv.id = c.vacancy_id AND dv.deleted_at IS NOT NULL)`
+
+// vacancyViewAccess passes admins, the owner of the vacancy row aliased v
+// and every collaborator on it.
+func vacancyViewAccess(isAdmin, userID string) string {
+	return `(v.deleted_at IS NULL AND (` + isAdmin + ` OR v.owner_user_id = ` + userID + ` OR EXISTS (
+        SELECT 1 FROM vacancy_collaborators vc
+        WHERE vc.vacancy_id = v.id AND vc.user_id = ` + userID + `)))`
+}
+
+// candidateViewAccess passes admins, whoever added the candidate row
+// aliased c, the owner of its vacancy and every collaborator on it.
+func candidateViewAccess(isAdmin, userID string) string {
+	return `(` + liveCandidateVacancy + ` AND (` + isAdmin + ` OR c.owner_user_id = ` + userID + `
+    OR EXISTS (SELECT 1 FROM vacancies cv WHERE cv.id = c.vacancy_id AND cv.owner_user_id = ` + userID + `)
+    OR EXISTS (SELECT 1 FROM vacancy_collaborators vc
+               WHERE vc.vacancy_id = c.vacancy_id AND vc.user_id = ` + userID + `)))`
+}
+
+// candidateEditAccess is candidateViewAccess with the collaborator branch
+// narrowed to editors.
+func candidateEditAccess(isAdmin, userID string) string {
+	return `(` + liveCandidateVacancy + ` AND (` + isAdmin + ` OR c.owner_user_id = ` + userID + `
+    OR EXISTS (SELECT 1 FROM vacancies cv WHERE cv.id = c.vacancy_id AND cv.owner_user_id = ` + userID + `)
+    OR EXISTS (SELECT 1 FROM vacancy_collaborators vc
+               WHERE vc.vacancy_id = c.vacancy_id AND vc.user_id = ` + userID + `
+                 AND vc.role = 'editor')))`
+}
@@ -19,7 +19,7 @@ SELECT a.id, a.vacancy_id, a.candidate_id, a.resume_id, a.vacancy_version, a.sta
        a.created_at, a.updated_at
 FROM analyses a
 JOIN candidates c ON c.id = a.candidate_id
-WHERE a.id = $1 AND ($2 OR c.owner_user_id = $3)
+WHERE a.id = $1 AND `+candidateViewAccess("$2", "$3")+`
 `, analysisID, isAdmin, requestUserID).Scan(
 		&a.ID,
 		&a.VacancyID,
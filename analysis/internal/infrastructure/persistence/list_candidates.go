@@ -8,12 +8,18 @@ import (
 	"github.com/artem13815/hr/analysis/internal/domain"
 )
 
+// candidateStage renders a candidate's current pipeline stage from the
+// vacancy service's tables: the recorded stage, or the vacancy's first stage
+// for candidates never moved. cs and v are the candidate_stages and
+// vacancies aliases of the surrounding query.
+const candidateStage = `COALESCE(cs.stage, v.pipeline_stages->0->>'key')`
+
 func (s *AnalysisStorage) ListCandidatesByVacancy(ctx context.Context, in domain.ListCandidatesByVacancyInput) (*domain.ListCandidatesByVacancyResult, error) {
 	var access int
 	err := s.db.QueryRow(ctx, `
 SELECT 1
-FROM vacancies
-WHERE id = $1 AND ($2 OR owner_user_id = $3)
+FROM vacancies v
+WHERE v.id = $1 AND `+vacancyViewAccess("$2", "$3")+`
 `, in.VacancyID, in.IsAdmin, in.RequestUserID).Scan(&access)
 	if err != nil {
 		return nil, err
@@ -35,11 +41,14 @@ WITH latest AS (
 )
 SELECT COUNT(*)
 FROM latest
+JOIN vacancies v ON v.id = $1
+LEFT JOIN candidate_stages cs ON cs.candidate_id = latest.candidate_id
 WHERE ($2::real <= 0 OR latest.match_score >= $2)
   AND ($3 = '' OR (latest.breakdown_json->'matched_skills') ? $3)
+  AND ($4 = '' OR ` + candidateStage + ` = $4)
 `
 	var total uint64
-	if err := s.db.QueryRow(ctx, countQuery, in.VacancyID, in.MinScore, requiredSkill).Scan(&total); err != nil {
+	if err := s.db.QueryRow(ctx, countQuery, in.VacancyID, in.MinScore, requiredSkill, in.Stage).Scan(&total); err != nil {
 		return nil, err
 	}
 
@@ -52,17 +61,21 @@ WITH latest AS (
   ORDER BY a.candidate_id, a.created_at DESC
 )
 SELECT latest.candidate_id, c.full_name, c.email, c.phone,
-       latest.match_score, latest.id, latest.status, latest.created_at
+       latest.match_score, latest.id, latest.status, latest.created_at,
+       %[2]s
 FROM latest
 JOIN candidates c ON c.id = latest.candidate_id
+JOIN vacancies v ON v.id = $1
+LEFT JOIN candidate_stages cs ON cs.candidate_id = latest.candidate_id
 LEFT JOIN analyses ax ON ax.id = latest.id
 WHERE ($2::real <= 0 OR latest.match_score >= $2)
   AND ($3 = '' OR (ax.breakdown_json->'matched_skills') ? $3)
-ORDER BY latest.match_score %s, latest.created_at DESC
+  AND ($6 = '' OR %[2]s = $6)
+ORDER BY latest.match_score %[1]s, latest.created_at DESC
 LIMIT $4 OFFSET $5
-`, order)
+`, order, candidateStage)
 
-	rows, err := s.db.Query(ctx, query, in.VacancyID, in.MinScore, requiredSkill, in.Limit, in.Offset)
+	rows, err := s.db.Query(ctx, query, in.VacancyID, in.MinScore, requiredSkill, in.Limit, in.Offset, in.Stage)
 	if err != nil {
 		return nil, err
 	}
@@ -80,6 +93,7 @@ LIMIT $4 OFFSET $5
 			&item.AnalysisID,
 			&item.AnalysisStatus,
 			&item.CreatedAt,
+			&item.Stage,
 		); err != nil {
 			return nil, err
 		}
@@ -0,0 +1,23 @@
+-- +goose Up
+-- vacancy_event_inbox records every vacancy event analysis has handled.
+-- The primary key is the deduplication check for at-least-once delivery;
+-- MAX(seq) is the cursor a new subscription resumes from, valid because
+-- the relay delivers in seq order.
+-- +goose StatementBegin
+CREATE TABLE IF NOT EXISTS vacancy_event_inbox (
+    event_id    TEXT        PRIMARY KEY,
+    seq         BIGINT      NOT NULL,
+    type        TEXT        NOT NULL,
+    vacancy_id  VARCHAR(64) NOT NULL,
+    received_at TIMESTAMP   NOT NULL DEFAULT NOW()
+);
+-- +goose StatementEnd
+
+-- +goose StatementBegin
+CREATE INDEX IF NOT EXISTS idx_vacancy_event_inbox_seq ON vacancy_event_inbox(seq DESC);
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP TABLE IF EXISTS vacancy_event_inbox;
+-- +goose StatementEnd
@@ -0,0 +1,49 @@
+package persistence
+
+import (
+	"context"
+	"errors"
+
+	"github.com/jackc/pgx/v5"
+
+	"github.com/artem13815/hr/analysis/internal/domain"
+)
+
+// PurgeVacancyAnalyses deletes the analyses of a vacancy the vacancy
+// service has started purging (vacancies.purge_started_at is set). The
+// vacancy row is locked so the check and the delete agree. A vacancy
+// already gone was purged by an earlier run; whatever is left of it is
+// swept. Any other vacancy returns nil.
+func (s *AnalysisStorage) PurgeVacancyAnalyses(ctx context.Context, vacancyID string) (*domain.PurgeVacancyAnalysesResult, error) {
+	tx, err := s.db.Begin(ctx)
+	if err != nil {
+		return nil, err
+	}
+	defer tx.Rollback(ctx)
+
+	var purging bool
+	err = tx.QueryRow(ctx, `
+SELECT purge_started_at IS NOT NULL
+FROM vacancies
+WHERE id = $1
+FOR UPDATE
+`, vacancyID).Scan(&purging)
+	switch {
+	case errors.Is(err, pgx.ErrNoRows):
+		// Already purged; sweep whatever is left below.
+	case err != nil:
+		return nil, err
+	case !purging:
+		return nil, nil
+	}
+
+	tag, err := tx.Exec(ctx, `DELETE FROM analyses WHERE vacancy_id = $1`, vacancyID)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := tx.Commit(ctx); err != nil {
+		return nil, err
+	}
+	return &domain.PurgeVacancyAnalysesResult{Analyses: uint32(tag.RowsAffected())}, nil
+}
@@ -2,23 +2,27 @@ package persistence
 
 import (
 	"context"
+	"errors"
 	"fmt"
 
+	"github.com/jackc/pgx/v5"
+
 	"github.com/artem13815/hr/analysis/internal/domain"
 )
 
 // LoadResumeContext returns the joined resume / candidate / vacancy slice
-// the usecase needs to score. The OR-ownership clause keeps this query
-// authoritative for tenant isolation: a non-admin caller can never read
-// another owner's row, even if they guess the resume id.
+// the usecase needs to score. The access clause keeps this query
+// authoritative for tenant isolation: a non-admin caller can never read a
+// row they have no edit grant on, even if they guess the resume id.
 func (s *AnalysisStorage) LoadResumeContext(ctx context.Context, resumeID string, requestUserID uint64, isAdmin bool) (*domain.ResumeContext, error) {
 	var rc domain.ResumeContext
 	err := s.db.QueryRow(ctx, `
-SELECT r.id, r.candidate_id, c.vacancy_id, c.owner_user_id, c.full_name, c.email, c.phone, r.extracted_text, COALESCE(v.version, 1), COALESCE(v.role, '')
+SELECT r.id, r.candidate_id, c.vacancy_id, c.owner_user_id, c.full_name, c.email, c.phone, r.extracted_text, COALESCE(v.version, 1), COALESCE(v.role, ''), c.source,
+       COALESCE(v.seniority, ''), COALESCE(v.salary_min, 0), COALESCE(v.salary_max, 0), COALESCE(v.salary_currency, '')
 FROM resumes r
 JOIN candidates c ON c.id = r.candidate_id
 LEFT JOIN vacancies v ON v.id = c.vacancy_id
-WHERE r.id = $1 AND ($2 OR c.owner_user_id = $3)
+WHERE r.id = $1 AND `+candidateEditAccess("$2", "$3")+`
 `, resumeID, isAdmin, requestUserID).Scan(
 		&rc.ResumeID,
 		&rc.CandidateID,
@@ -30,6 +34,11 @@ WHERE r.id = $1 AND ($2 OR c.owner_user_id = $3)
 		&rc.ResumeText,
 		&rc.VacancyVersion,
 		&rc.VacancyRole,
+		&rc.CandidateSource,
+		&rc.VacancySeniority,
+		&rc.VacancySalaryMin,
+		&rc.VacancySalaryMax,
+		&rc.VacancySalaryCurrency,
 	)
 	if err != nil {
 		return nil, err
@@ -40,12 +49,23 @@ WHERE r.id = $1 AND ($2 OR c.owner_user_id = $3)
 // LoadVacancySkills reads the ordered skills set the scorer compares against.
 // Weights default to 1 when the row stores 0 — a defensive normalisation
 // the heuristic relies on (0 weights would zero-divide the base score).
+//
+// Aliases come from the skill taxonomy the vacancy service keeps in
+// skill_aliases. The skill name is normalized the way vacancy builds alias
+// keys (lower-case, single spaces), so names saved before the taxonomy
+// existed — or spelled as an alias — still resolve.
 func (s *AnalysisStorage) LoadVacancySkills(ctx context.Context, vacancyID string) ([]domain.VacancySkill, error) {
 	rows, err := s.db.Query(ctx, `
-SELECT name, weight, must_have, nice_to_have
-FROM vacancy_skills
-WHERE vacancy_id = $1
-ORDER BY position ASC
+SELECT vs.name, vs.weight, vs.must_have, vs.nice_to_have,
+       COALESCE((
+           SELECT array_agg(sa.alias ORDER BY sa.alias)
+           FROM skill_aliases k
+           JOIN skill_aliases sa ON sa.skill_name = k.skill_name
+           WHERE k.alias = regexp_replace(lower(btrim(vs.name)), '\s+', ' ', 'g')
+       ), '{}')
+FROM vacancy_skills vs
+WHERE vs.vacancy_id = $1
+ORDER BY vs.position ASC
 `, vacancyID)
 	if err != nil {
 		return nil, err
@@ -55,7 +75,7 @@ ORDER BY position ASC
 	out := make([]domain.VacancySkill, 0)
 	for rows.Next() {
 		var sk domain.VacancySkill
-		if err := rows.Scan(&sk.Name, &sk.Weight, &sk.MustHave, &sk.NiceToHave); err != nil {
+		if err := rows.Scan(&sk.Name, &sk.Weight, &sk.MustHave, &sk.NiceToHave, &sk.Aliases); err != nil {
 			return nil, err
 		}
 		if sk.Weight <= 0 {
@@ -69,6 +89,41 @@ ORDER BY position ASC
 	return out, nil
 }
 
+// LoadScoringPolicy reads the scoring policy the vacancy service keeps in
+// the vacancies.score_* columns. A missing row yields the default policy,
+// matching LoadVacancySkills returning no skills for it.
+func (s *AnalysisStorage) LoadScoringPolicy(ctx context.Context, vacancyID string) (domain.ScoringPolicy, error) {
+	var p domain.ScoringPolicy
+	err := s.db.QueryRow(ctx, `
+SELECT score_must_have_penalty, score_nice_to_have_bonus, score_hire_threshold, score_maybe_threshold,
+       score_reject_missing_must_have
+FROM vacancies
+WHERE id = $1
+`, vacancyID).Scan(&p.MustHavePenalty, &p.NiceToHaveBonus, &p.HireThreshold, &p.MaybeThreshold, &p.RejectOnMissingMustHave)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return domain.DefaultScoringPolicy(), nil
+		}
+		return domain.ScoringPolicy{}, err
+	}
+	return p, nil
+}
+
+// LoadVacancyStatus reads the lifecycle status the vacancy service keeps in
+// vacancies.status. A missing row yields "" so the usecase can treat it
+// like any other status instead of special-casing pgx.ErrNoRows.
+func (s *AnalysisStorage) LoadVacancyStatus(ctx context.Context, vacancyID string) (string, error) {
+	var status string
+	err := s.db.QueryRow(ctx, `SELECT status FROM vacancies WHERE id = $1`, vacancyID).Scan(&status)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return "", nil
+		}
+		return "", err
+	}
+	return status, nil
+}
+
 // SaveAnalysis writes the scored result. The usecase has already:
 //   - loaded the ResumeContext (so candidate/vacancy/owner are pinned),
 //   - generated an analysis id (so retries are idempotent at the caller),
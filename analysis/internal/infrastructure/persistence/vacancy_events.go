@@ -0,0 +1,32 @@
+package persistence
+
+import (
+	"context"
+
+	"github.com/artem13815/hr/analysis/internal/domain"
+)
+
+// RecordVacancyEvent inserts ev into the inbox and reports whether it was
+// new. A redelivered event hits the primary key and reports false.
+func (s *AnalysisStorage) RecordVacancyEvent(ctx context.Context, ev domain.VacancyEvent) (bool, error) {
+	tag, err := s.db.Exec(ctx, `
+INSERT INTO vacancy_event_inbox (event_id, seq, type, vacancy_id)
+VALUES ($1, $2, $3, $4)
+ON CONFLICT (event_id) DO NOTHING
+`, ev.ID, int64(ev.Seq), ev.Type, ev.VacancyID)
+	if err != nil {
+		return false, err
+	}
+	return tag.RowsAffected() == 1, nil
+}
+
+// LastVacancyEventSeq returns the highest recorded seq, or 0 when the inbox
+// is empty.
+func (s *AnalysisStorage) LastVacancyEventSeq(ctx context.Context) (uint64, error) {
+	var seq int64
+	err := s.db.QueryRow(ctx, `SELECT COALESCE(MAX(seq), 0) FROM vacancy_event_inbox`).Scan(&seq)
+	if err != nil {
+		return 0, err
+	}
+	return uint64(seq), nil
+}
@@ -0,0 +1,55 @@
+package scorer
+
+import (
+	"strings"
+	"unicode"
+	"unicode/utf8"
+
+	"github.com/artem13815/hr/analysis/internal/domain"
+)
+
+// mentions reports whether the lower-cased resume text mentions the skill:
+// its own name as a plain substring (the original heuristic, kept so scores
+// of skills outside the taxonomy do not move), or any taxonomy alias as a
+// whole word. Aliases need word boundaries because many are short — "k8s",
+// "ts", "ml" — and would otherwise fire inside unrelated words.
+func mentions(lowerText string, sk domain.VacancySkill) bool {
+	if strings.Contains(lowerText, strings.ToLower(strings.TrimSpace(sk.Name))) {
+		return true
+	}
+	for _, alias := range sk.Aliases {
+		if containsWord(lowerText, alias) {
+			return true
+		}
+	}
+	return false
+}
+
+// containsWord reports whether term occurs in text with no letter or digit
+// immediately before or after it. Both are expected lower-case.
+func containsWord(text, term string) bool {
+	if term == "" {
+		return false
+	}
+	for offset := 0; ; {
+		i := strings.Index(text[offset:], term)
+		if i < 0 {
+			return false
+		}
+		start := offset + i
+		end := start + len(term)
+		before, _ := utf8.DecodeLastRuneInString(text[:start])
+		after, _ := utf8.DecodeRuneInString(text[end:])
+		if !isWordRune(before) && !isWordRune(after) {
+			return true
+		}
+		_, size := utf8.DecodeRuneInString(text[start:])
+		offset = start + size
+	}
+}
+
+// isWordRune is false for utf8.RuneError, which DecodeRune returns at the
+// edges of the text — the start and end of the text count as boundaries.
+func isWordRune(r rune) bool {
+	return unicode.IsLetter(r) || unicode.IsDigit(r)
+}
@@ -17,17 +17,23 @@ import (
 type HeuristicScorer struct{}
 
 // New returns a fresh HeuristicScorer. Constructor exists for symmetry with
-// other infrastructure adapters and to leave room for configurable thresholds.
+// other infrastructure adapters; thresholds come per call with the
+// vacancy's scoring policy.
 func New() *HeuristicScorer { return &HeuristicScorer{} }
 
 // Score runs the keyword-matching pass and returns the full AnalysisPayload.
 // The algorithm:
-//   - count weighted matches of each vacancy skill in the resume text;
-//   - apply must-have penalty (-10 per missed must-have) and nice-to-have
-//     bonus (+2 per matched nice-to-have);
+//   - count weighted matches of each vacancy skill in the resume text — by
+//     name or by any taxonomy alias (see mentions);
+//   - apply the policy's must-have penalty per missed must-have and
+//     nice-to-have bonus per matched nice-to-have;
 //   - clamp to [0, 100];
-//   - derive a recommendation tier from the final score.
-func (HeuristicScorer) Score(resumeText string, skills []domain.VacancySkill) domain.AnalysisPayload {
+//   - derive a recommendation tier from the final score and the policy's
+//     thresholds, or "no" outright when the policy rejects on any missed
+//     must-have and one is missing.
+//
+// The policy is copied into the breakdown.
+func (HeuristicScorer) Score(resumeText string, skills []domain.VacancySkill, policy domain.ScoringPolicy) domain.AnalysisPayload {
 	lowerText := strings.ToLower(resumeText)
 	matched := make([]string, 0)
 	missing := make([]string, 0)
@@ -44,7 +50,10 @@ func (HeuristicScorer) Score(resumeText string, skills []domain.VacancySkill) do
 			continue
 		}
 		requiredSet[strings.ToLower(name)] = struct{}{}
-		if strings.Contains(lowerText, strings.ToLower(name)) {
+		for _, alias := range sk.Aliases {
+			requiredSet[alias] = struct{}{}
+		}
+		if mentions(lowerText, sk) {
 			matched = append(matched, name)
 			matchedWeight += sk.Weight
 			if sk.NiceToHave {
@@ -62,8 +71,8 @@ func (HeuristicScorer) Score(resumeText string, skills []domain.VacancySkill) do
 	}
 
 	baseScore := (matchedWeight / totalWeight) * 100
-	mustPenalty := float32(mustMissingCount) * 10
-	niceBonus := float32(niceMatchedCount) * 2
+	mustPenalty := float32(mustMissingCount) * policy.MustHavePenalty
+	niceBonus := float32(niceMatchedCount) * policy.NiceToHaveBonus
 	matchScore := min(max(baseScore-mustPenalty+niceBonus, 0), 100)
 
 	extra := extractExtraSkills(lowerText, requiredSet)
@@ -74,6 +83,11 @@ func (HeuristicScorer) Score(resumeText string, skills []domain.VacancySkill) do
 		Technologies:    append([]string{}, matched...),
 		YearsExperience: extractYearsExperience(resumeText),
 	}
+	veto := policy.RejectOnMissingMustHave && mustMissingCount > 0
+	explanation := fmt.Sprintf("совпадений: %d, не хватает: %d", len(matched), len(missing))
+	if veto {
+		explanation += fmt.Sprintf("; не хватает обязательных: %d — по правилам вакансии это отказ", mustMissingCount)
+	}
 	breakdown := domain.ScoreBreakdown{
 		MatchedSkills:   matched,
 		MissingSkills:   missing,
@@ -81,19 +95,20 @@ func (HeuristicScorer) Score(resumeText string, skills []domain.VacancySkill) do
 		BaseScore:       round2(baseScore),
 		MustHavePenalty: round2(mustPenalty),
 		NiceToHaveBonus: round2(niceBonus),
-		Explanation: fmt.Sprintf(
-			"совпадений: %d, не хватает: %d",
-			len(matched), len(missing),
-		),
+		Explanation:     explanation,
+		Policy:          &policy,
+		MustHaveVeto:    veto,
 	}
 
 	recommendation := "no"
 	confidence := float32(0.55)
 	switch {
-	case matchScore >= 75:
+	case veto:
+		// The rejection rule wins over the score; "no" stands.
+	case matchScore >= policy.HireThreshold:
 		recommendation = "hire"
 		confidence = 0.82
-	case matchScore >= 45:
+	case matchScore >= policy.MaybeThreshold:
 		recommendation = "maybe"
 		confidence = 0.67
 	}
@@ -0,0 +1,126 @@
+package vacancy_events
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"log/slog"
+	"time"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials/insecure"
+
+	"github.com/artem13815/hr/analysis/internal/domain"
+	pb_events "github.com/artem13815/hr/analysis/internal/pb/vacancy_events_api"
+)
+
+const (
+	minResubscribeBackoff = time.Second
+	maxResubscribeBackoff = 30 * time.Second
+)
+
+var eventTypes = map[pb_events.VacancyEventType]string{
+	pb_events.VacancyEventType_VACANCY_EVENT_TYPE_CREATED:        domain.EventVacancyCreated,
+	pb_events.VacancyEventType_VACANCY_EVENT_TYPE_UPDATED:        domain.EventVacancyUpdated,
+	pb_events.VacancyEventType_VACANCY_EVENT_TYPE_STATUS_CHANGED: domain.EventVacancyStatusChanged,
+	pb_events.VacancyEventType_VACANCY_EVENT_TYPE_DELETED:        domain.EventVacancyDeleted,
+	pb_events.VacancyEventType_VACANCY_EVENT_TYPE_RESTORED:       domain.EventVacancyRestored,
+	pb_events.VacancyEventType_VACANCY_EVENT_TYPE_PURGED:         domain.EventVacancyPurged,
+}
+
+// Handler is the consumer side of the subscription. Implemented by
+// usecase.VacancyEventConsumer.
+type Handler interface {
+	Cursor(ctx context.Context) (uint64, error)
+	HandleVacancyEvent(ctx context.Context, ev domain.VacancyEvent) error
+}
+
+// Subscriber keeps one SubscribeVacancyEvents stream open against the
+// vacancy service and feeds it to a Handler.
+type Subscriber struct {
+	client  pb_events.VacancyEventServiceClient
+	handler Handler
+}
+
+// New dials the vacancy service. Same plaintext trade-off as the other
+// in-cluster clients; the returned cleanup closes the conn and must run
+// after Run has returned.
+func New(addr string, handler Handler) (*Subscriber, func(), error) {
+	conn, err := grpc.NewClient(addr, grpc.WithTransportCredentials(insecure.NewCredentials()))
+	if err != nil {
+		return nil, nil, fmt.Errorf("dial vacancy events: %w", err)
+	}
+	cleanup := func() {
+		if err := conn.Close(); err != nil {
+			slog.Warn("close vacancy events conn", "err", err)
+		}
+	}
+	return &Subscriber{client: pb_events.NewVacancyEventServiceClient(conn), handler: handler}, cleanup, nil
+}
+
+// Run resubscribes from the handler's cursor whenever the stream ends, with
+// exponential backoff, until ctx is done. A handler error also ends the
+// stream: the event was not recorded, so the next subscription redelivers
+// it.
+func (s *Subscriber) Run(ctx context.Context) {
+	backoff := minResubscribeBackoff
+	for {
+		received, err := s.consume(ctx)
+		if ctx.Err() != nil {
+			return
+		}
+		if received {
+			backoff = minResubscribeBackoff
+		}
+		slog.Warn("vacancy event stream ended; resubscribing", "err", err, "backoff", backoff)
+		select {
+		case <-ctx.Done():
+			return
+		case <-time.After(backoff):
+		}
+		backoff = min(backoff*2, maxResubscribeBackoff)
+	}
+}
+
+// consume runs one subscription and reports whether it delivered anything.
+func (s *Subscriber) consume(ctx context.Context) (bool, error) {
+	cursor, err := s.handler.Cursor(ctx)
+	if err != nil {
+		return false, fmt.Errorf("load cursor: %w", err)
+	}
+
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+	stream, err := s.client.SubscribeVacancyEvents(ctx, &pb_events.SubscribeVacancyEventsRequest{AfterSeq: cursor})
+	if err != nil {
+		return false, err
+	}
+
+	received := false
+	for {
+		msg, err := stream.Recv()
+		if errors.Is(err, io.EOF) {
+			return received, errors.New("stream closed by server")
+		}
+		if err != nil {
+			return received, err
+		}
+		received = true
+		if err := s.handler.HandleVacancyEvent(ctx, toDomainEvent(msg)); err != nil {
+			return received, fmt.Errorf("handle event %s: %w", msg.GetId(), err)
+		}
+	}
+}
+
+func toDomainEvent(msg *pb_events.VacancyEvent) domain.VacancyEvent {
+	return domain.VacancyEvent{
+		Seq:       msg.GetSeq(),
+		ID:        msg.GetId(),
+		Type:      eventTypes[msg.GetType()],
+		VacancyID: msg.GetVacancyId(),
+		Version:   msg.GetVersion(),
+		Status:    msg.GetStatus(),
+		OldStatus: msg.GetOldStatus(),
+	}
+}
@@ -0,0 +1,357 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.11
+// 	protoc        v7.34.1
+// source: vacancy_events_api/vacancy_events.proto
+
+package vacancy_events_api
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type VacancyEventType int32
+
+const (
+	VacancyEventType_VACANCY_EVENT_TYPE_UNSPECIFIED    VacancyEventType = 0
+	VacancyEventType_VACANCY_EVENT_TYPE_CREATED        VacancyEventType = 1
+	VacancyEventType_VACANCY_EVENT_TYPE_UPDATED        VacancyEventType = 2
+	VacancyEventType_VACANCY_EVENT_TYPE_STATUS_CHANGED VacancyEventType = 3
+	// DELETED: soft-deleted, hidden everywhere; RESTORED: an admin undid the
+	// delete within the grace period; PURGED: the retention job removed the
+	// vacancy for good. They carry the version and status it had.
+	VacancyEventType_VACANCY_EVENT_TYPE_DELETED  VacancyEventType = 4
+	VacancyEventType_VACANCY_EVENT_TYPE_RESTORED VacancyEventType = 5
+	VacancyEventType_VACANCY_EVENT_TYPE_PURGED   VacancyEventType = 6
+)
+
+// Enum value maps for VacancyEventType.
+var (
+	VacancyEventType_name = map[int32]string{
+		0: "VACANCY_EVENT_TYPE_UNSPECIFIED",
+		1: "VACANCY_EVENT_TYPE_CREATED",
+		2: "VACANCY_EVENT_TYPE_UPDATED",
+		3: "VACANCY_EVENT_TYPE_STATUS_CHANGED",
+		4: "VACANCY_EVENT_TYPE_DELETED",
+		5: "VACANCY_EVENT_TYPE_RESTORED",
+		6: "VACANCY_EVENT_TYPE_PURGED",
+	}
+	VacancyEventType_value = map[string]int32{
+		"VACANCY_EVENT_TYPE_UNSPECIFIED":    0,
+		"VACANCY_EVENT_TYPE_CREATED":        1,
+		"VACANCY_EVENT_TYPE_UPDATED":        2,
+		"VACANCY_EVENT_TYPE_STATUS_CHANGED": 3,
+		"VACANCY_EVENT_TYPE_DELETED":        4,
+		"VACANCY_EVENT_TYPE_RESTORED":       5,
+		"VACANCY_EVENT_TYPE_PURGED":         6,
+	}
+)
+
+func (x VacancyEventType) Enum() *VacancyEventType {
+	p := new(VacancyEventType)
+	*p = x
+	return p
+}
+
+func (x VacancyEventType) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (VacancyEventType) Descriptor() protoreflect.EnumDescriptor {
+	return file_vacancy_events_api_vacancy_events_proto_enumTypes[0].Descriptor()
+}
+
+func (VacancyEventType) Type() protoreflect.EnumType {
+	return &file_vacancy_events_api_vacancy_events_proto_enumTypes[0]
+}
+
+func (x VacancyEventType) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use VacancyEventType.Descriptor instead.
+func (VacancyEventType) EnumDescriptor() ([]byte, []int) {
+	return file_vacancy_events_api_vacancy_events_proto_rawDescGZIP(), []int{0}
+}
+
+type SubscribeVacancyEventsRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// after_seq is the seq of the last event the consumer has processed;
+	// 0 replays the whole outbox.
+	AfterSeq      uint64 `protobuf:"varint,1,opt,name=after_seq,json=afterSeq,proto3" json:"after_seq,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SubscribeVacancyEventsRequest) Reset() {
+	*x = SubscribeVacancyEventsRequest{}
+	mi := &file_vacancy_events_api_vacancy_events_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SubscribeVacancyEventsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SubscribeVacancyEventsRequest) ProtoMessage() {}
+
+func (x *SubscribeVacancyEventsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_vacancy_events_api_vacancy_events_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SubscribeVacancyEventsRequest.ProtoReflect.Descriptor instead.
+func (*SubscribeVacancyEventsRequest) Descriptor() ([]byte, []int) {
+	return file_vacancy_events_api_vacancy_events_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *SubscribeVacancyEventsRequest) GetAfterSeq() uint64 {
+	if x != nil {
+		return x.AfterSeq
+	}
+	return 0
+}
+
+type VacancyEvent struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// id is unique per event; consumers deduplicate on it.
+	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	// seq orders events in commit order and only grows.
+	Seq         uint64           `protobuf:"varint,2,opt,name=seq,proto3" json:"seq,omitempty"`
+	Type        VacancyEventType `protobuf:"varint,3,opt,name=type,proto3,enum=vacancy.events.v1.VacancyEventType" json:"type,omitempty"`
+	VacancyId   string           `protobuf:"bytes,4,opt,name=vacancy_id,json=vacancyId,proto3" json:"vacancy_id,omitempty"`
+	OwnerUserId uint64           `protobuf:"varint,5,opt,name=owner_user_id,json=ownerUserId,proto3" json:"owner_user_id,omitempty"`
+	// actor_user_id made the change (owner, collaborator or admin); 0 for
+	// the system (role reclassification, retention purge).
+	ActorUserId uint64                 `protobuf:"varint,6,opt,name=actor_user_id,json=actorUserId,proto3" json:"actor_user_id,omitempty"`
+	OccurredAt  *timestamppb.Timestamp `protobuf:"bytes,7,opt,name=occurred_at,json=occurredAt,proto3" json:"occurred_at,omitempty"`
+	// version is the vacancy version after the change; old_version is set
+	// on UPDATED only.
+	Version    uint32 `protobuf:"varint,8,opt,name=version,proto3" json:"version,omitempty"`
+	OldVersion uint32 `protobuf:"varint,9,opt,name=old_version,json=oldVersion,proto3" json:"old_version,omitempty"`
+	// status is the status after the change; old_status is set on
+	// STATUS_CHANGED only.
+	Status        string `protobuf:"bytes,10,opt,name=status,proto3" json:"status,omitempty"`
+	OldStatus     string `protobuf:"bytes,11,opt,name=old_status,json=oldStatus,proto3" json:"old_status,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *VacancyEvent) Reset() {
+	*x = VacancyEvent{}
+	mi := &file_vacancy_events_api_vacancy_events_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *VacancyEvent) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*VacancyEvent) ProtoMessage() {}
+
+func (x *VacancyEvent) ProtoReflect() protoreflect.Message {
+	mi := &file_vacancy_events_api_vacancy_events_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use VacancyEvent.ProtoReflect.Descriptor instead.
+func (*VacancyEvent) Descriptor() ([]byte, []int) {
+	return file_vacancy_events_api_vacancy_events_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *VacancyEvent) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *VacancyEvent) GetSeq() uint64 {
+	if x != nil {
+		return x.Seq
+	}
+	return 0
+}
+
+func (x *VacancyEvent) GetType() VacancyEventType {
+	if x != nil {
+		return x.Type
+	}
+	return VacancyEventType_VACANCY_EVENT_TYPE_UNSPECIFIED
+}
+
+func (x *VacancyEvent) GetVacancyId() string {
+	if x != nil {
+		return x.VacancyId
+	}
+	return ""
+}
+
+func (x *VacancyEvent) GetOwnerUserId() uint64 {
+	if x != nil {
+		return x.OwnerUserId
+	}
+	return 0
+}
+
+func (x *VacancyEvent) GetActorUserId() uint64 {
+	if x != nil {
+		return x.ActorUserId
+	}
+	return 0
+}
+
+func (x *VacancyEvent) GetOccurredAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.OccurredAt
+	}
+	return nil
+}
+
+func (x *VacancyEvent) GetVersion() uint32 {
+	if x != nil {
+		return x.Version
+	}
+	return 0
+}
+
+func (x *VacancyEvent) GetOldVersion() uint32 {
+	if x != nil {
+		return x.OldVersion
+	}
+	return 0
+}
+
+func (x *VacancyEvent) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *VacancyEvent) GetOldStatus() string {
+	if x != nil {
+		return x.OldStatus
+	}
+	return ""
+}
+
+var File_vacancy_events_api_vacancy_events_proto protoreflect.FileDescriptor
+
+const file_vacancy_events_api_vacancy_events_proto_rawDesc = "" +
+	"\n" +
+	"'vacancy_events_api/vacancy_events.proto\x12\x11vacancy.events.v1\x1a\x1fgoogle/protobuf/timestamp.proto\"<\n" +
+	"\x1dSubscribeVacancyEventsRequest\x12\x1b\n" +
+	"\tafter_seq\x18\x01 \x01(\x04R\bafterSeq\"\xff\x02\n" +
+	"\fVacancyEvent\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x10\n" +
+	"\x03seq\x18\x02 \x01(\x04R\x03seq\x127\n" +
+	"\x04type\x18\x03 \x01(\x0e2#.vacancy.events.v1.VacancyEventTypeR\x04type\x12\x1d\n" +
+	"\n" +
+	"vacancy_id\x18\x04 \x01(\tR\tvacancyId\x12\"\n" +
+	"\rowner_user_id\x18\x05 \x01(\x04R\vownerUserId\x12\"\n" +
+	"\ractor_user_id\x18\x06 \x01(\x04R\vactorUserId\x12;\n" +
+	"\voccurred_at\x18\a \x01(\v2\x1a.google.protobuf.TimestampR\n" +
+	"occurredAt\x12\x18\n" +
+	"\aversion\x18\b \x01(\rR\aversion\x12\x1f\n" +
+	"\vold_version\x18\t \x01(\rR\n" +
+	"oldVersion\x12\x16\n" +
+	"\x06status\x18\n" +
+	" \x01(\tR\x06status\x12\x1d\n" +
+	"\n" +
+	"old_status\x18\v \x01(\tR\toldStatus*\xfd\x01\n" +
+	"\x10VacancyEventType\x12\"\n" +
+	"\x1eVACANCY_EVENT_TYPE_UNSPECIFIED\x10\x00\x12\x1e\n" +
+	"\x1aVACANCY_EVENT_TYPE_CREATED\x10\x01\x12\x1e\n" +
+	"\x1aVACANCY_EVENT_TYPE_UPDATED\x10\x02\x12%\n" +
+	"!VACANCY_EVENT_TYPE_STATUS_CHANGED\x10\x03\x12\x1e\n" +
+	"\x1aVACANCY_EVENT_TYPE_DELETED\x10\x04\x12\x1f\n" +
+	"\x1bVACANCY_EVENT_TYPE_RESTORED\x10\x05\x12\x1d\n" +
+	"\x19VACANCY_EVENT_TYPE_PURGED\x10\x062\x84\x01\n" +
+	"\x13VacancyEventService\x12m\n" +
+	"\x16SubscribeVacancyEvents\x120.vacancy.events.v1.SubscribeVacancyEventsRequest\x1a\x1f.vacancy.events.v1.VacancyEvent0\x01BBZ@github.com/artem13815/hr/analysis/internal/pb/vacancy_events_apib\x06proto3"
+
+var (
+	file_vacancy_events_api_vacancy_events_proto_rawDescOnce sync.Once
+	file_vacancy_events_api_vacancy_events_proto_rawDescData []byte
+)
+
+func file_vacancy_events_api_vacancy_events_proto_rawDescGZIP() []byte {
+	file_vacancy_events_api_vacancy_events_proto_rawDescOnce.Do(func() {
+		file_vacancy_events_api_vacancy_events_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_vacancy_events_api_vacancy_events_proto_rawDesc), len(file_vacancy_events_api_vacancy_events_proto_rawDesc)))
+	})
+	return file_vacancy_events_api_vacancy_events_proto_rawDescData
+}
+
+var file_vacancy_events_api_vacancy_events_proto_enumTypes = make([]protoimpl.EnumInfo, 1)
+var file_vacancy_events_api_vacancy_events_proto_msgTypes = make([]protoimpl.MessageInfo, 2)
+var file_vacancy_events_api_vacancy_events_proto_goTypes = []any{
+	(VacancyEventType)(0),                 // 0: vacancy.events.v1.VacancyEventType
+	(*SubscribeVacancyEventsRequest)(nil), // 1: vacancy.events.v1.SubscribeVacancyEventsRequest
+	(*VacancyEvent)(nil),                  // 2: vacancy.events.v1.VacancyEvent
+	(*timestamppb.Timestamp)(nil),         // 3: google.protobuf.Timestamp
+}
+var file_vacancy_events_api_vacancy_events_proto_depIdxs = []int32{
+	0, // 0: vacancy.events.v1.VacancyEvent.type:type_name -> vacancy.events.v1.VacancyEventType
+	3, // 1: vacancy.events.v1.VacancyEvent.occurred_at:type_name -> google.protobuf.Timestamp
+	1, // 2: vacancy.events.v1.VacancyEventService.SubscribeVacancyEvents:input_type -> vacancy.events.v1.SubscribeVacancyEventsRequest
+	2, // 3: vacancy.events.v1.VacancyEventService.SubscribeVacancyEvents:output_type -> vacancy.events.v1.VacancyEvent
+	3, // [3:4] is the sub-list for method output_type
+	2, // [2:3] is the sub-list for method input_type
+	2, // [2:2] is the sub-list for extension type_name
+	2, // [2:2] is the sub-list for extension extendee
+	0, // [0:2] is the sub-list for field type_name
+}
+
+func init() { file_vacancy_events_api_vacancy_events_proto_init() }
+func file_vacancy_events_api_vacancy_events_proto_init() {
+	if File_vacancy_events_api_vacancy_events_proto != nil {
+		return
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_vacancy_events_api_vacancy_events_proto_rawDesc), len(file_vacancy_events_api_vacancy_events_proto_rawDesc)),
+			NumEnums:      1,
+			NumMessages:   2,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_vacancy_events_api_vacancy_events_proto_goTypes,
+		DependencyIndexes: file_vacancy_events_api_vacancy_events_proto_depIdxs,
+		EnumInfos:         file_vacancy_events_api_vacancy_events_proto_enumTypes,
+		MessageInfos:      file_vacancy_events_api_vacancy_events_proto_msgTypes,
+	}.Build()
+	File_vacancy_events_api_vacancy_events_proto = out.File
+	file_vacancy_events_api_vacancy_events_proto_goTypes = nil
+	file_vacancy_events_api_vacancy_events_proto_depIdxs = nil
+}
@@ -9,6 +9,8 @@ import (
 
 type analysisService interface {
 	StartAnalysis(ctx context.Context, in domain.StartAnalysisInput) (*domain.StartAnalysisResult, error)
+	StartApplicationAnalysis(ctx context.Context, resumeID string) (*domain.StartAnalysisResult, error)
+	PurgeVacancyAnalyses(ctx context.Context, vacancyID string) (*domain.PurgeVacancyAnalysesResult, error)
 	GetAnalysis(ctx context.Context, in domain.GetAnalysisInput) (*domain.Analysis, error)
 	ListCandidatesByVacancy(ctx context.Context, in domain.ListCandidatesByVacancyInput) (*domain.ListCandidatesByVacancyResult, error)
 }
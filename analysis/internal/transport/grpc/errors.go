@@ -11,6 +11,9 @@ const (
 	ErrCodeUnauthorized = "UNAUTHORIZED"
 	ErrCodeNotFound     = "NOT_FOUND"
 	ErrCodeInternal     = "INTERNAL_ERROR"
+	// ErrCodeVacancyClosed accompanies codes.FailedPrecondition when the
+	// target vacancy is closed, filled or archived.
+	ErrCodeVacancyClosed = "VACANCY_CLOSED"
 
 	// errorDomain identifies which service surface produced the error. Clients
 	// that aggregate errors from multiple services use Domain to disambiguate
@@ -65,6 +65,8 @@ func toPBAnalysis(a domain.Analysis) *pb_models.Analysis {
 			MustHavePenalty: a.Breakdown.MustHavePenalty,
 			NiceToHaveBonus: a.Breakdown.NiceToHaveBonus,
 			Explanation:     a.Breakdown.Explanation,
+			Policy:          toPBScoringPolicy(a.Breakdown.Policy),
+			MustHaveVeto:    a.Breakdown.MustHaveVeto,
 		},
 		Ai: &pb_models.AIDecision{
 			HrRecommendation:  a.AI.HRRecommendation,
@@ -80,3 +82,19 @@ func toPBAnalysis(a domain.Analysis) *pb_models.Analysis {
 		UpdatedAt:    timestamppb.New(a.UpdatedAt),
 	}
 }
+
+// toPBScoringPolicy reports the policy recorded in the breakdown. Analyses
+// stored before the policy was recorded were scored with the defaults.
+func toPBScoringPolicy(p *domain.ScoringPolicy) *pb_models.AppliedScoringPolicy {
+	policy := domain.DefaultScoringPolicy()
+	if p != nil {
+		policy = *p
+	}
+	return &pb_models.AppliedScoringPolicy{
+		MustHavePenalty:         policy.MustHavePenalty,
+		NiceToHaveBonus:         policy.NiceToHaveBonus,
+		HireThreshold:           policy.HireThreshold,
+		MaybeThreshold:          policy.MaybeThreshold,
+		RejectOnMissingMustHave: policy.RejectOnMissingMustHave,
+	}
+}
@@ -34,6 +34,7 @@ func (a *AnalysisServiceAPI) ListCandidatesByVacancy(ctx context.Context, req *p
 		MinScore:       req.GetMinScore(),
 		RequiredSkill:  req.GetRequiredSkill(),
 		ScoreOrderDesc: toPBOrderDesc(req.GetScoreOrder()),
+		Stage:          req.GetStage(),
 	})
 	if err != nil {
 		switch {
@@ -55,6 +56,7 @@ func (a *AnalysisServiceAPI) ListCandidatesByVacancy(ctx context.Context, req *p
 			AnalysisId:     c.AnalysisID,
 			AnalysisStatus: toPBStatus(c.AnalysisStatus),
 			CreatedAt:      timestamppb.New(c.CreatedAt),
+			Stage:          c.Stage,
 		})
 	}
 
@@ -0,0 +1,31 @@
+package grpc
+
+import (
+	"context"
+	"errors"
+	"log/slog"
+
+	pb_models "github.com/artem13815/hr/analysis/internal/pb/models"
+	"github.com/artem13815/hr/analysis/internal/usecase"
+	"google.golang.org/grpc/codes"
+)
+
+// PurgeVacancyAnalyses is internal (see middleware.internalMethods): the
+// resume service calls it while the vacancy retention job purges a
+// vacancy.
+func (a *AnalysisServiceAPI) PurgeVacancyAnalyses(ctx context.Context, req *pb_models.PurgeVacancyAnalysesRequest) (*pb_models.PurgeVacancyAnalysesResponse, error) {
+	slog.InfoContext(ctx, "PurgeVacancyAnalyses request", "vacancy_id", req.GetVacancyId())
+	res, err := a.analysisService.PurgeVacancyAnalyses(ctx, req.GetVacancyId())
+	if err != nil {
+		switch {
+		case errors.Is(err, usecase.ErrInvalidArgument):
+			return nil, newError(codes.InvalidArgument, ErrCodeInvalidInput, "Invalid vacancy id.")
+		case errors.Is(err, usecase.ErrNotFound):
+			return nil, newError(codes.NotFound, ErrCodeNotFound, "Vacancy is not being purged.")
+		default:
+			return nil, newError(codes.Internal, ErrCodeInternal, "Internal error.")
+		}
+	}
+
+	return &pb_models.PurgeVacancyAnalysesResponse{Analyses: res.Analyses}, nil
+}
@@ -33,6 +33,8 @@ func (a *AnalysisServiceAPI) StartAnalysis(ctx context.Context, req *pb_models.S
 		switch {
 		case errors.Is(err, usecase.ErrInvalidArgument):
 			return nil, newError(codes.InvalidArgument, ErrCodeInvalidInput, "Invalid analysis payload.")
+		case errors.Is(err, usecase.ErrVacancyClosed):
+			return nil, newError(codes.FailedPrecondition, ErrCodeVacancyClosed, "Vacancy is not accepting candidates.")
 		default:
 			return nil, newError(codes.Internal, ErrCodeInternal, "Internal error.")
 		}
@@ -0,0 +1,30 @@
+package grpc
+
+import (
+	"context"
+	"errors"
+	"log/slog"
+
+	pb_models "github.com/artem13815/hr/analysis/internal/pb/models"
+	"github.com/artem13815/hr/analysis/internal/usecase"
+	"google.golang.org/grpc/codes"
+)
+
+// StartApplicationAnalysis is internal (see middleware.internalMethods): the
+// resume service calls it once a public application is stored.
+func (a *AnalysisServiceAPI) StartApplicationAnalysis(ctx context.Context, req *pb_models.StartApplicationAnalysisRequest) (*pb_models.StartAnalysisResponse, error) {
+	slog.InfoContext(ctx, "StartApplicationAnalysis request", "resume_id", req.GetResumeId())
+	res, err := a.analysisService.StartApplicationAnalysis(ctx, req.GetResumeId())
+	if err != nil {
+		switch {
+		case errors.Is(err, usecase.ErrInvalidArgument):
+			return nil, newError(codes.InvalidArgument, ErrCodeInvalidInput, "Invalid analysis payload.")
+		case errors.Is(err, usecase.ErrNotFound):
+			return nil, newError(codes.NotFound, ErrCodeNotFound, "Application not found.")
+		default:
+			return nil, newError(codes.Internal, ErrCodeInternal, "Internal error.")
+		}
+	}
+
+	return &pb_models.StartAnalysisResponse{AnalysisId: res.AnalysisID, Status: toPBStatus(res.Status)}, nil
+}
@@ -10,6 +10,7 @@ import (
 	"google.golang.org/grpc/metadata"
 	"google.golang.org/grpc/status"
 
+	"github.com/artem13815/hr/analysis/internal/pb/analysis_api"
 	"github.com/artem13815/hr/analysis/internal/pb/auth_api"
 )
 
@@ -20,13 +21,24 @@ type authValidator interface {
 	ValidateAccessToken(ctx context.Context, in *auth_api.ValidateAccessTokenRequest, opts ...grpc.CallOption) (*auth_api.ValidateAccessTokenResponse, error)
 }
 
+// internalMethods are called service-to-service without a user bearer and
+// have no HTTP binding, so the gateway never exposes them. Their handlers
+// never call Get; the usecase narrows what they may touch instead.
+var internalMethods = map[string]struct{}{
+	analysis_api.AnalysisService_StartApplicationAnalysis_FullMethodName: {},
+	analysis_api.AnalysisService_PurgeVacancyAnalyses_FullMethodName:     {},
+}
+
 // UnaryAuthInterceptor validates the JWT on every RPC by calling the auth
 // service. The resulting identity is attached to the context — handlers MUST
 // read it via Get, never from the raw gRPC metadata, otherwise an attacker
 // on the docker network could impersonate any user just by setting
-// x-user-id.
+// x-user-id. internalMethods pass through unauthenticated.
 func UnaryAuthInterceptor(authClient authValidator) grpc.UnaryServerInterceptor {
 	return func(ctx context.Context, req any, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (any, error) {
+		if _, ok := internalMethods[info.FullMethod]; ok {
+			return handler(ctx, req)
+		}
 		uc, err := authenticate(ctx, authClient)
 		if err != nil {
 			return nil, err
@@ -17,6 +17,12 @@ type AnalysisStorage interface {
 	NewID() (string, error)
 	LoadResumeContext(ctx context.Context, resumeID string, requestUserID uint64, isAdmin bool) (*domain.ResumeContext, error)
 	LoadVacancySkills(ctx context.Context, vacancyID string) ([]domain.VacancySkill, error)
+	// LoadScoringPolicy returns the vacancy's scoring policy, or the default
+	// when the vacancy does not exist.
+	LoadScoringPolicy(ctx context.Context, vacancyID string) (domain.ScoringPolicy, error)
+	// LoadVacancyStatus returns vacancies.status, or "" when the vacancy
+	// does not exist.
+	LoadVacancyStatus(ctx context.Context, vacancyID string) (string, error)
 	SaveAnalysis(ctx context.Context, in domain.SaveAnalysisInput) error
 	GetAnalysis(ctx context.Context, analysisID string, requestUserID uint64, isAdmin bool) (*domain.Analysis, error)
 	ListCandidatesByVacancy(ctx context.Context, in domain.ListCandidatesByVacancyInput) (*domain.ListCandidatesByVacancyResult, error)
@@ -30,13 +36,17 @@ type AnalysisStorage interface {
 	// candidate blurb. Empty input means the model didn't write one — caller
 	// must skip the call instead of clearing the heuristic preview.
 	UpdateProfileSummary(ctx context.Context, analysisID string, summary string) error
+	// PurgeVacancyAnalyses deletes the analyses of a vacancy the vacancy
+	// service is purging. Returns nil when the vacancy exists and is not
+	// being purged.
+	PurgeVacancyAnalyses(ctx context.Context, vacancyID string) (*domain.PurgeVacancyAnalysesResult, error)
 }
 
 // Scorer is the heuristic / LLM scoring port. Pure compute, no I/O.
 // Implemented today by internal/infrastructure/scorer (keyword heuristic);
 // swappable for an LLM-backed adapter without touching usecase or storage.
 type Scorer interface {
-	Score(resumeText string, skills []domain.VacancySkill) domain.AnalysisPayload
+	Score(resumeText string, skills []domain.VacancySkill, policy domain.ScoringPolicy) domain.AnalysisPayload
 }
 
 type AnalysisService struct {
@@ -5,4 +5,7 @@ import "errors"
 var (
 	ErrInvalidArgument = errors.New("invalid argument")
 	ErrNotFound        = errors.New("not found")
+	// ErrVacancyClosed means the analysis would attach the candidate to a
+	// vacancy that no longer accepts candidates.
+	ErrVacancyClosed = errors.New("vacancy is not accepting candidates")
 )
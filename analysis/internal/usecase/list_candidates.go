@@ -13,6 +13,7 @@ func (s *AnalysisService) ListCandidatesByVacancy(ctx context.Context, in domain
 		return nil, ErrInvalidArgument
 	}
 	in.Limit = cmp.Or(in.Limit, 20)
+	in.Stage = strings.ToLower(strings.TrimSpace(in.Stage))
 
 	res, err := s.storage.ListCandidatesByVacancy(ctx, in)
 	if err != nil {
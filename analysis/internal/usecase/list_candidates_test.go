@@ -52,6 +52,22 @@ func (s *ListCandidatesByVacancySuite) TestZeroLimitDefaultsTo20() {
 	assert.Equal(t, got, want)
 }
 
+func (s *ListCandidatesByVacancySuite) TestStageIsNormalised() {
+	t := s.T()
+	ctx := t.Context()
+	want := &domain.ListCandidatesByVacancyResult{Candidates: []domain.CandidateWithAnalysis{}}
+	expected := domain.ListCandidatesByVacancyInput{RequestUserID: 1, VacancyID: "v-1", Limit: 20, Stage: "interview"}
+
+	s.storage.ListCandidatesByVacancyMock.Expect(ctx, expected).Return(want, nil)
+
+	_, err := s.svc.ListCandidatesByVacancy(ctx, domain.ListCandidatesByVacancyInput{
+		RequestUserID: 1,
+		VacancyID:     "v-1",
+		Stage:         " Interview ",
+	})
+	assert.NilError(t, err)
+}
+
 func (s *ListCandidatesByVacancySuite) TestInvalidArgumentZeroUser() {
 	t := s.T()
 	got, err := s.svc.ListCandidatesByVacancy(t.Context(), domain.ListCandidatesByVacancyInput{VacancyID: "v-1"})
@@ -38,6 +38,13 @@ type AnalysisStorageMock struct {
 	beforeLoadResumeContextCounter uint64
 	LoadResumeContextMock          mAnalysisStorageMockLoadResumeContext
 
+	funcLoadScoringPolicy          func(ctx context.Context, vacancyID string) (s1 domain.ScoringPolicy, err error)
+	funcLoadScoringPolicyOrigin    string
+	inspectFuncLoadScoringPolicy   func(ctx context.Context, vacancyID string)
+	afterLoadScoringPolicyCounter  uint64
+	beforeLoadScoringPolicyCounter uint64
+	LoadScoringPolicyMock          mAnalysisStorageMockLoadScoringPolicy
+
 	funcLoadVacancySkills          func(ctx context.Context, vacancyID string) (va1 []domain.VacancySkill, err error)
 	funcLoadVacancySkillsOrigin    string
 	inspectFuncLoadVacancySkills   func(ctx context.Context, vacancyID string)
@@ -45,6 +52,13 @@ type AnalysisStorageMock struct {
 	beforeLoadVacancySkillsCounter uint64
 	LoadVacancySkillsMock          mAnalysisStorageMockLoadVacancySkills
 
+	funcLoadVacancyStatus          func(ctx context.Context, vacancyID string) (s1 string, err error)
+	funcLoadVacancyStatusOrigin    string
+	inspectFuncLoadVacancyStatus   func(ctx context.Context, vacancyID string)
+	afterLoadVacancyStatusCounter  uint64
+	beforeLoadVacancyStatusCounter uint64
+	LoadVacancyStatusMock          mAnalysisStorageMockLoadVacancyStatus
+
 	funcNewID          func() (s1 string, err error)
 	funcNewIDOrigin    string
 	inspectFuncNewID   func()
@@ -52,6 +66,13 @@ type AnalysisStorageMock struct {
 	beforeNewIDCounter uint64
 	NewIDMock          mAnalysisStorageMockNewID
 
+	funcPurgeVacancyAnalyses          func(ctx context.Context, vacancyID string) (pp1 *domain.PurgeVacancyAnalysesResult, err error)
+	funcPurgeVacancyAnalysesOrigin    string
+	inspectFuncPurgeVacancyAnalyses   func(ctx context.Context, vacancyID string)
+	afterPurgeVacancyAnalysesCounter  uint64
+	beforePurgeVacancyAnalysesCounter uint64
+	PurgeVacancyAnalysesMock          mAnalysisStorageMockPurgeVacancyAnalyses
+
 	funcSaveAnalysis          func(ctx context.Context, in domain.SaveAnalysisInput) (err error)
 	funcSaveAnalysisOrigin    string
 	inspectFuncSaveAnalysis   func(ctx context.Context, in domain.SaveAnalysisInput)
@@ -98,11 +119,20 @@ func NewAnalysisStorageMock(t minimock.Tester) *AnalysisStorageMock {
 	m.LoadResumeContextMock = mAnalysisStorageMockLoadResumeContext{mock: m}
 	m.LoadResumeContextMock.callArgs = []*AnalysisStorageMockLoadResumeContextParams{}
 
+	m.LoadScoringPolicyMock = mAnalysisStorageMockLoadScoringPolicy{mock: m}
+	m.LoadScoringPolicyMock.callArgs = []*AnalysisStorageMockLoadScoringPolicyParams{}
+
 	m.LoadVacancySkillsMock = mAnalysisStorageMockLoadVacancySkills{mock: m}
 	m.LoadVacancySkillsMock.callArgs = []*AnalysisStorageMockLoadVacancySkillsParams{}
 
+	m.LoadVacancyStatusMock = mAnalysisStorageMockLoadVacancyStatus{mock: m}
+	m.LoadVacancyStatusMock.callArgs = []*AnalysisStorageMockLoadVacancyStatusParams{}
+
 	m.NewIDMock = mAnalysisStorageMockNewID{mock: m}
 
+	m.PurgeVacancyAnalysesMock = mAnalysisStorageMockPurgeVacancyAnalyses{mock: m}
+	m.PurgeVacancyAnalysesMock.callArgs = []*AnalysisStorageMockPurgeVacancyAnalysesParams{}
+
 	m.SaveAnalysisMock = mAnalysisStorageMockSaveAnalysis{mock: m}
 	m.SaveAnalysisMock.callArgs = []*AnalysisStorageMockSaveAnalysisParams{}
 
@@ -1273,6 +1303,349 @@ func (m *AnalysisStorageMock) MinimockLoadResumeContextInspect() {
 	}
 }
 
+type mAnalysisStorageMockLoadScoringPolicy struct {
+	optional           bool
+	mock               *AnalysisStorageMock
+	defaultExpectation *AnalysisStorageMockLoadScoringPolicyExpectation
+	expectations       []*AnalysisStorageMockLoadScoringPolicyExpectation
+
+	callArgs []*AnalysisStorageMockLoadScoringPolicyParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// AnalysisStorageMockLoadScoringPolicyExpectation specifies expectation struct of the AnalysisStorage.LoadScoringPolicy
+type AnalysisStorageMockLoadScoringPolicyExpectation struct {
+	mock               *AnalysisStorageMock
+	params             *AnalysisStorageMockLoadScoringPolicyParams
+	paramPtrs          *AnalysisStorageMockLoadScoringPolicyParamPtrs
+	expectationOrigins AnalysisStorageMockLoadScoringPolicyExpectationOrigins
+	results            *AnalysisStorageMockLoadScoringPolicyResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// AnalysisStorageMockLoadScoringPolicyParams contains parameters of the AnalysisStorage.LoadScoringPolicy
+type AnalysisStorageMockLoadScoringPolicyParams struct {
+	ctx       context.Context
+	vacancyID string
+}
+
+// AnalysisStorageMockLoadScoringPolicyParamPtrs contains pointers to parameters of the AnalysisStorage.LoadScoringPolicy
+type AnalysisStorageMockLoadScoringPolicyParamPtrs struct {
+	ctx       *context.Context
+	vacancyID *string
+}
+
+// AnalysisStorageMockLoadScoringPolicyResults contains results of the AnalysisStorage.LoadScoringPolicy
+type AnalysisStorageMockLoadScoringPolicyResults struct {
+	s1  domain.ScoringPolicy
+	err error
+}
+
+// AnalysisStorageMockLoadScoringPolicyOrigins contains origins of expectations of the AnalysisStorage.LoadScoringPolicy
+type AnalysisStorageMockLoadScoringPolicyExpectationOrigins struct {
+	origin          string
+	originCtx       string
+	originVacancyID string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmLoadScoringPolicy *mAnalysisStorageMockLoadScoringPolicy) Optional() *mAnalysisStorageMockLoadScoringPolicy {
+	mmLoadScoringPolicy.optional = true
+	return mmLoadScoringPolicy
+}
+
+// Expect sets up expected params for AnalysisStorage.LoadScoringPolicy
+func (mmLoadScoringPolicy *mAnalysisStorageMockLoadScoringPolicy) Expect(ctx context.Context, vacancyID string) *mAnalysisStorageMockLoadScoringPolicy {
+	if mmLoadScoringPolicy.mock.funcLoadScoringPolicy != nil {
+		mmLoadScoringPolicy.mock.t.Fatalf("AnalysisStorageMock.LoadScoringPolicy mock is already set by Set")
+	}
+
+	if mmLoadScoringPolicy.defaultExpectation == nil {
+		mmLoadScoringPolicy.defaultExpectation = &AnalysisStorageMockLoadScoringPolicyExpectation{}
+	}
+
+	if mmLoadScoringPolicy.defaultExpectation.paramPtrs != nil {
+		mmLoadScoringPolicy.mock.t.Fatalf("AnalysisStorageMock.LoadScoringPolicy mock is already set by ExpectParams functions")
+	}
+
+	mmLoadScoringPolicy.defaultExpectation.params = &AnalysisStorageMockLoadScoringPolicyParams{ctx, vacancyID}
+	mmLoadScoringPolicy.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmLoadScoringPolicy.expectations {
+		if minimock.Equal(e.params, mmLoadScoringPolicy.defaultExpectation.params) {
+			mmLoadScoringPolicy.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmLoadScoringPolicy.defaultExpectation.params)
+		}
+	}
+
+	return mmLoadScoringPolicy
+}
+
+// ExpectCtxParam1 sets up expected param ctx for AnalysisStorage.LoadScoringPolicy
+func (mmLoadScoringPolicy *mAnalysisStorageMockLoadScoringPolicy) ExpectCtxParam1(ctx context.Context) *mAnalysisStorageMockLoadScoringPolicy {
+	if mmLoadScoringPolicy.mock.funcLoadScoringPolicy != nil {
+		mmLoadScoringPolicy.mock.t.Fatalf("AnalysisStorageMock.LoadScoringPolicy mock is already set by Set")
+	}
+
+	if mmLoadScoringPolicy.defaultExpectation == nil {
+		mmLoadScoringPolicy.defaultExpectation = &AnalysisStorageMockLoadScoringPolicyExpectation{}
+	}
+
+	if mmLoadScoringPolicy.defaultExpectation.params != nil {
+		mmLoadScoringPolicy.mock.t.Fatalf("AnalysisStorageMock.LoadScoringPolicy mock is already set by Expect")
+	}
+
+	if mmLoadScoringPolicy.defaultExpectation.paramPtrs == nil {
+		mmLoadScoringPolicy.defaultExpectation.paramPtrs = &AnalysisStorageMockLoadScoringPolicyParamPtrs{}
+	}
+	mmLoadScoringPolicy.defaultExpectation.paramPtrs.ctx = &ctx
+	mmLoadScoringPolicy.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmLoadScoringPolicy
+}
+
+// ExpectVacancyIDParam2 sets up expected param vacancyID for AnalysisStorage.LoadScoringPolicy
+func (mmLoadScoringPolicy *mAnalysisStorageMockLoadScoringPolicy) ExpectVacancyIDParam2(vacancyID string) *mAnalysisStorageMockLoadScoringPolicy {
+	if mmLoadScoringPolicy.mock.funcLoadScoringPolicy != nil {
+		mmLoadScoringPolicy.mock.t.Fatalf("AnalysisStorageMock.LoadScoringPolicy mock is already set by Set")
+	}
+
+	if mmLoadScoringPolicy.defaultExpectation == nil {
+		mmLoadScoringPolicy.defaultExpectation = &AnalysisStorageMockLoadScoringPolicyExpectation{}
+	}
+
+	if mmLoadScoringPolicy.defaultExpectation.params != nil {
+		mmLoadScoringPolicy.mock.t.Fatalf("AnalysisStorageMock.LoadScoringPolicy mock is already set by Expect")
+	}
+
+	if mmLoadScoringPolicy.defaultExpectation.paramPtrs == nil {
+		mmLoadScoringPolicy.defaultExpectation.paramPtrs = &AnalysisStorageMockLoadScoringPolicyParamPtrs{}
+	}
+	mmLoadScoringPolicy.defaultExpectation.paramPtrs.vacancyID = &vacancyID
+	mmLoadScoringPolicy.defaultExpectation.expectationOrigins.originVacancyID = minimock.CallerInfo(1)
+
+	return mmLoadScoringPolicy
+}
+
+// Inspect accepts an inspector function that has same arguments as the AnalysisStorage.LoadScoringPolicy
+func (mmLoadScoringPolicy *mAnalysisStorageMockLoadScoringPolicy) Inspect(f func(ctx context.Context, vacancyID string)) *mAnalysisStorageMockLoadScoringPolicy {
+	if mmLoadScoringPolicy.mock.inspectFuncLoadScoringPolicy != nil {
+		mmLoadScoringPolicy.mock.t.Fatalf("Inspect function is already set for AnalysisStorageMock.LoadScoringPolicy")
+	}
+
+	mmLoadScoringPolicy.mock.inspectFuncLoadScoringPolicy = f
+
+	return mmLoadScoringPolicy
+}
+
+// Return sets up results that will be returned by AnalysisStorage.LoadScoringPolicy
+func (mmLoadScoringPolicy *mAnalysisStorageMockLoadScoringPolicy) Return(s1 domain.ScoringPolicy, err error) *AnalysisStorageMock {
+	if mmLoadScoringPolicy.mock.funcLoadScoringPolicy != nil {
+		mmLoadScoringPolicy.mock.t.Fatalf("AnalysisStorageMock.LoadScoringPolicy mock is already set by Set")
+	}
+
+	if mmLoadScoringPolicy.defaultExpectation == nil {
+		mmLoadScoringPolicy.defaultExpectation = &AnalysisStorageMockLoadScoringPolicyExpectation{mock: mmLoadScoringPolicy.mock}
+	}
+	mmLoadScoringPolicy.defaultExpectation.results = &AnalysisStorageMockLoadScoringPolicyResults{s1, err}
+	mmLoadScoringPolicy.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmLoadScoringPolicy.mock
+}
+
+// Set uses given function f to mock the AnalysisStorage.LoadScoringPolicy method
+func (mmLoadScoringPolicy *mAnalysisStorageMockLoadScoringPolicy) Set(f func(ctx context.Context, vacancyID string) (s1 domain.ScoringPolicy, err error)) *AnalysisStorageMock {
+	if mmLoadScoringPolicy.defaultExpectation != nil {
+		mmLoadScoringPolicy.mock.t.Fatalf("Default expectation is already set for the AnalysisStorage.LoadScoringPolicy method")
+	}
+
+	if len(mmLoadScoringPolicy.expectations) > 0 {
+		mmLoadScoringPolicy.mock.t.Fatalf("Some expectations are already set for the AnalysisStorage.LoadScoringPolicy method")
+	}
+
+	mmLoadScoringPolicy.mock.funcLoadScoringPolicy = f
+	mmLoadScoringPolicy.mock.funcLoadScoringPolicyOrigin = minimock.CallerInfo(1)
+	return mmLoadScoringPolicy.mock
+}
+
+// When sets expectation for the AnalysisStorage.LoadScoringPolicy which will trigger the result defined by the following
+// Then helper
+func (mmLoadScoringPolicy *mAnalysisStorageMockLoadScoringPolicy) When(ctx context.Context, vacancyID string) *AnalysisStorageMockLoadScoringPolicyExpectation {
+	if mmLoadScoringPolicy.mock.funcLoadScoringPolicy != nil {
+		mmLoadScoringPolicy.mock.t.Fatalf("AnalysisStorageMock.LoadScoringPolicy mock is already set by Set")
+	}
+
+	expectation := &AnalysisStorageMockLoadScoringPolicyExpectation{
+		mock:               mmLoadScoringPolicy.mock,
+		params:             &AnalysisStorageMockLoadScoringPolicyParams{ctx, vacancyID},
+		expectationOrigins: AnalysisStorageMockLoadScoringPolicyExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmLoadScoringPolicy.expectations = append(mmLoadScoringPolicy.expectations, expectation)
+	return expectation
+}
+
+// Then sets up AnalysisStorage.LoadScoringPolicy return parameters for the expectation previously defined by the When method
+func (e *AnalysisStorageMockLoadScoringPolicyExpectation) Then(s1 domain.ScoringPolicy, err error) *AnalysisStorageMock {
+	e.results = &AnalysisStorageMockLoadScoringPolicyResults{s1, err}
+	return e.mock
+}
+
+// Times sets number of times AnalysisStorage.LoadScoringPolicy should be invoked
+func (mmLoadScoringPolicy *mAnalysisStorageMockLoadScoringPolicy) Times(n uint64) *mAnalysisStorageMockLoadScoringPolicy {
+	if n == 0 {
+		mmLoadScoringPolicy.mock.t.Fatalf("Times of AnalysisStorageMock.LoadScoringPolicy mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmLoadScoringPolicy.expectedInvocations, n)
+	mmLoadScoringPolicy.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmLoadScoringPolicy
+}
+
+func (mmLoadScoringPolicy *mAnalysisStorageMockLoadScoringPolicy) invocationsDone() bool {
+	if len(mmLoadScoringPolicy.expectations) == 0 && mmLoadScoringPolicy.defaultExpectation == nil && mmLoadScoringPolicy.mock.funcLoadScoringPolicy == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmLoadScoringPolicy.mock.afterLoadScoringPolicyCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmLoadScoringPolicy.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// LoadScoringPolicy implements mm_usecase.AnalysisStorage
+func (mmLoadScoringPolicy *AnalysisStorageMock) LoadScoringPolicy(ctx context.Context, vacancyID string) (s1 domain.ScoringPolicy, err error) {
+	mm_atomic.AddUint64(&mmLoadScoringPolicy.beforeLoadScoringPolicyCounter, 1)
+	defer mm_atomic.AddUint64(&mmLoadScoringPolicy.afterLoadScoringPolicyCounter, 1)
+
+	mmLoadScoringPolicy.t.Helper()
+
+	if mmLoadScoringPolicy.inspectFuncLoadScoringPolicy != nil {
+		mmLoadScoringPolicy.inspectFuncLoadScoringPolicy(ctx, vacancyID)
+	}
+
+	mm_params := AnalysisStorageMockLoadScoringPolicyParams{ctx, vacancyID}
+
+	// Record call args
+	mmLoadScoringPolicy.LoadScoringPolicyMock.mutex.Lock()
+	mmLoadScoringPolicy.LoadScoringPolicyMock.callArgs = append(mmLoadScoringPolicy.LoadScoringPolicyMock.callArgs, &mm_params)
+	mmLoadScoringPolicy.LoadScoringPolicyMock.mutex.Unlock()
+
+	for _, e := range mmLoadScoringPolicy.LoadScoringPolicyMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.s1, e.results.err
+		}
+	}
+
+	if mmLoadScoringPolicy.LoadScoringPolicyMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmLoadScoringPolicy.LoadScoringPolicyMock.defaultExpectation.Counter, 1)
+		mm_want := mmLoadScoringPolicy.LoadScoringPolicyMock.defaultExpectation.params
+		mm_want_ptrs := mmLoadScoringPolicy.LoadScoringPolicyMock.defaultExpectation.paramPtrs
+
+		mm_got := AnalysisStorageMockLoadScoringPolicyParams{ctx, vacancyID}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmLoadScoringPolicy.t.Errorf("AnalysisStorageMock.LoadScoringPolicy got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmLoadScoringPolicy.LoadScoringPolicyMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.vacancyID != nil && !minimock.Equal(*mm_want_ptrs.vacancyID, mm_got.vacancyID) {
+				mmLoadScoringPolicy.t.Errorf("AnalysisStorageMock.LoadScoringPolicy got unexpected parameter vacancyID, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmLoadScoringPolicy.LoadScoringPolicyMock.defaultExpectation.expectationOrigins.originVacancyID, *mm_want_ptrs.vacancyID, mm_got.vacancyID, minimock.Diff(*mm_want_ptrs.vacancyID, mm_got.vacancyID))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmLoadScoringPolicy.t.Errorf("AnalysisStorageMock.LoadScoringPolicy got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmLoadScoringPolicy.LoadScoringPolicyMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmLoadScoringPolicy.LoadScoringPolicyMock.defaultExpectation.results
+		if mm_results == nil {
+			mmLoadScoringPolicy.t.Fatal("No results are set for the AnalysisStorageMock.LoadScoringPolicy")
+		}
+		return (*mm_results).s1, (*mm_results).err
+	}
+	if mmLoadScoringPolicy.funcLoadScoringPolicy != nil {
+		return mmLoadScoringPolicy.funcLoadScoringPolicy(ctx, vacancyID)
+	}
+	mmLoadScoringPolicy.t.Fatalf("Unexpected call to AnalysisStorageMock.LoadScoringPolicy. %v %v", ctx, vacancyID)
+	return
+}
+
+// LoadScoringPolicyAfterCounter returns a count of finished AnalysisStorageMock.LoadScoringPolicy invocations
+func (mmLoadScoringPolicy *AnalysisStorageMock) LoadScoringPolicyAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmLoadScoringPolicy.afterLoadScoringPolicyCounter)
+}
+
+// LoadScoringPolicyBeforeCounter returns a count of AnalysisStorageMock.LoadScoringPolicy invocations
+func (mmLoadScoringPolicy *AnalysisStorageMock) LoadScoringPolicyBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmLoadScoringPolicy.beforeLoadScoringPolicyCounter)
+}
+
+// Calls returns a list of arguments used in each call to AnalysisStorageMock.LoadScoringPolicy.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmLoadScoringPolicy *mAnalysisStorageMockLoadScoringPolicy) Calls() []*AnalysisStorageMockLoadScoringPolicyParams {
+	mmLoadScoringPolicy.mutex.RLock()
+
+	argCopy := make([]*AnalysisStorageMockLoadScoringPolicyParams, len(mmLoadScoringPolicy.callArgs))
+	copy(argCopy, mmLoadScoringPolicy.callArgs)
+
+	mmLoadScoringPolicy.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockLoadScoringPolicyDone returns true if the count of the LoadScoringPolicy invocations corresponds
+// the number of defined expectations
+func (m *AnalysisStorageMock) MinimockLoadScoringPolicyDone() bool {
+	if m.LoadScoringPolicyMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.LoadScoringPolicyMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.LoadScoringPolicyMock.invocationsDone()
+}
+
+// MinimockLoadScoringPolicyInspect logs each unmet expectation
+func (m *AnalysisStorageMock) MinimockLoadScoringPolicyInspect() {
+	for _, e := range m.LoadScoringPolicyMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to AnalysisStorageMock.LoadScoringPolicy at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterLoadScoringPolicyCounter := mm_atomic.LoadUint64(&m.afterLoadScoringPolicyCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.LoadScoringPolicyMock.defaultExpectation != nil && afterLoadScoringPolicyCounter < 1 {
+		if m.LoadScoringPolicyMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to AnalysisStorageMock.LoadScoringPolicy at\n%s", m.LoadScoringPolicyMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to AnalysisStorageMock.LoadScoringPolicy at\n%s with params: %#v", m.LoadScoringPolicyMock.defaultExpectation.expectationOrigins.origin, *m.LoadScoringPolicyMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcLoadScoringPolicy != nil && afterLoadScoringPolicyCounter < 1 {
+		m.t.Errorf("Expected call to AnalysisStorageMock.LoadScoringPolicy at\n%s", m.funcLoadScoringPolicyOrigin)
+	}
+
+	if !m.LoadScoringPolicyMock.invocationsDone() && afterLoadScoringPolicyCounter > 0 {
+		m.t.Errorf("Expected %d calls to AnalysisStorageMock.LoadScoringPolicy at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.LoadScoringPolicyMock.expectedInvocations), m.LoadScoringPolicyMock.expectedInvocationsOrigin, afterLoadScoringPolicyCounter)
+	}
+}
+
 type mAnalysisStorageMockLoadVacancySkills struct {
 	optional           bool
 	mock               *AnalysisStorageMock
@@ -1455,164 +1828,507 @@ func (mmLoadVacancySkills *mAnalysisStorageMockLoadVacancySkills) When(ctx conte
 		params:             &AnalysisStorageMockLoadVacancySkillsParams{ctx, vacancyID},
 		expectationOrigins: AnalysisStorageMockLoadVacancySkillsExpectationOrigins{origin: minimock.CallerInfo(1)},
 	}
-	mmLoadVacancySkills.expectations = append(mmLoadVacancySkills.expectations, expectation)
+	mmLoadVacancySkills.expectations = append(mmLoadVacancySkills.expectations, expectation)
+	return expectation
+}
+
+// Then sets up AnalysisStorage.LoadVacancySkills return parameters for the expectation previously defined by the When method
+func (e *AnalysisStorageMockLoadVacancySkillsExpectation) Then(va1 []domain.VacancySkill, err error) *AnalysisStorageMock {
+	e.results = &AnalysisStorageMockLoadVacancySkillsResults{va1, err}
+	return e.mock
+}
+
+// Times sets number of times AnalysisStorage.LoadVacancySkills should be invoked
+func (mmLoadVacancySkills *mAnalysisStorageMockLoadVacancySkills) Times(n uint64) *mAnalysisStorageMockLoadVacancySkills {
+	if n == 0 {
+		mmLoadVacancySkills.mock.t.Fatalf("Times of AnalysisStorageMock.LoadVacancySkills mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmLoadVacancySkills.expectedInvocations, n)
+	mmLoadVacancySkills.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmLoadVacancySkills
+}
+
+func (mmLoadVacancySkills *mAnalysisStorageMockLoadVacancySkills) invocationsDone() bool {
+	if len(mmLoadVacancySkills.expectations) == 0 && mmLoadVacancySkills.defaultExpectation == nil && mmLoadVacancySkills.mock.funcLoadVacancySkills == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmLoadVacancySkills.mock.afterLoadVacancySkillsCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmLoadVacancySkills.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// LoadVacancySkills implements mm_usecase.AnalysisStorage
+func (mmLoadVacancySkills *AnalysisStorageMock) LoadVacancySkills(ctx context.Context, vacancyID string) (va1 []domain.VacancySkill, err error) {
+	mm_atomic.AddUint64(&mmLoadVacancySkills.beforeLoadVacancySkillsCounter, 1)
+	defer mm_atomic.AddUint64(&mmLoadVacancySkills.afterLoadVacancySkillsCounter, 1)
+
+	mmLoadVacancySkills.t.Helper()
+
+	if mmLoadVacancySkills.inspectFuncLoadVacancySkills != nil {
+		mmLoadVacancySkills.inspectFuncLoadVacancySkills(ctx, vacancyID)
+	}
+
+	mm_params := AnalysisStorageMockLoadVacancySkillsParams{ctx, vacancyID}
+
+	// Record call args
+	mmLoadVacancySkills.LoadVacancySkillsMock.mutex.Lock()
+	mmLoadVacancySkills.LoadVacancySkillsMock.callArgs = append(mmLoadVacancySkills.LoadVacancySkillsMock.callArgs, &mm_params)
+	mmLoadVacancySkills.LoadVacancySkillsMock.mutex.Unlock()
+
+	for _, e := range mmLoadVacancySkills.LoadVacancySkillsMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.va1, e.results.err
+		}
+	}
+
+	if mmLoadVacancySkills.LoadVacancySkillsMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmLoadVacancySkills.LoadVacancySkillsMock.defaultExpectation.Counter, 1)
+		mm_want := mmLoadVacancySkills.LoadVacancySkillsMock.defaultExpectation.params
+		mm_want_ptrs := mmLoadVacancySkills.LoadVacancySkillsMock.defaultExpectation.paramPtrs
+
+		mm_got := AnalysisStorageMockLoadVacancySkillsParams{ctx, vacancyID}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmLoadVacancySkills.t.Errorf("AnalysisStorageMock.LoadVacancySkills got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmLoadVacancySkills.LoadVacancySkillsMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.vacancyID != nil && !minimock.Equal(*mm_want_ptrs.vacancyID, mm_got.vacancyID) {
+				mmLoadVacancySkills.t.Errorf("AnalysisStorageMock.LoadVacancySkills got unexpected parameter vacancyID, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmLoadVacancySkills.LoadVacancySkillsMock.defaultExpectation.expectationOrigins.originVacancyID, *mm_want_ptrs.vacancyID, mm_got.vacancyID, minimock.Diff(*mm_want_ptrs.vacancyID, mm_got.vacancyID))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmLoadVacancySkills.t.Errorf("AnalysisStorageMock.LoadVacancySkills got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmLoadVacancySkills.LoadVacancySkillsMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmLoadVacancySkills.LoadVacancySkillsMock.defaultExpectation.results
+		if mm_results == nil {
+			mmLoadVacancySkills.t.Fatal("No results are set for the AnalysisStorageMock.LoadVacancySkills")
+		}
+		return (*mm_results).va1, (*mm_results).err
+	}
+	if mmLoadVacancySkills.funcLoadVacancySkills != nil {
+		return mmLoadVacancySkills.funcLoadVacancySkills(ctx, vacancyID)
+	}
+	mmLoadVacancySkills.t.Fatalf("Unexpected call to AnalysisStorageMock.LoadVacancySkills. %v %v", ctx, vacancyID)
+	return
+}
+
+// LoadVacancySkillsAfterCounter returns a count of finished AnalysisStorageMock.LoadVacancySkills invocations
+func (mmLoadVacancySkills *AnalysisStorageMock) LoadVacancySkillsAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmLoadVacancySkills.afterLoadVacancySkillsCounter)
+}
+
+// LoadVacancySkillsBeforeCounter returns a count of AnalysisStorageMock.LoadVacancySkills invocations
+func (mmLoadVacancySkills *AnalysisStorageMock) LoadVacancySkillsBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmLoadVacancySkills.beforeLoadVacancySkillsCounter)
+}
+
+// Calls returns a list of arguments used in each call to AnalysisStorageMock.LoadVacancySkills.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmLoadVacancySkills *mAnalysisStorageMockLoadVacancySkills) Calls() []*AnalysisStorageMockLoadVacancySkillsParams {
+	mmLoadVacancySkills.mutex.RLock()
+
+	argCopy := make([]*AnalysisStorageMockLoadVacancySkillsParams, len(mmLoadVacancySkills.callArgs))
+	copy(argCopy, mmLoadVacancySkills.callArgs)
+
+	mmLoadVacancySkills.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockLoadVacancySkillsDone returns true if the count of the LoadVacancySkills invocations corresponds
+// the number of defined expectations
+func (m *AnalysisStorageMock) MinimockLoadVacancySkillsDone() bool {
+	if m.LoadVacancySkillsMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.LoadVacancySkillsMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.LoadVacancySkillsMock.invocationsDone()
+}
+
+// MinimockLoadVacancySkillsInspect logs each unmet expectation
+func (m *AnalysisStorageMock) MinimockLoadVacancySkillsInspect() {
+	for _, e := range m.LoadVacancySkillsMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to AnalysisStorageMock.LoadVacancySkills at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterLoadVacancySkillsCounter := mm_atomic.LoadUint64(&m.afterLoadVacancySkillsCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.LoadVacancySkillsMock.defaultExpectation != nil && afterLoadVacancySkillsCounter < 1 {
+		if m.LoadVacancySkillsMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to AnalysisStorageMock.LoadVacancySkills at\n%s", m.LoadVacancySkillsMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to AnalysisStorageMock.LoadVacancySkills at\n%s with params: %#v", m.LoadVacancySkillsMock.defaultExpectation.expectationOrigins.origin, *m.LoadVacancySkillsMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcLoadVacancySkills != nil && afterLoadVacancySkillsCounter < 1 {
+		m.t.Errorf("Expected call to AnalysisStorageMock.LoadVacancySkills at\n%s", m.funcLoadVacancySkillsOrigin)
+	}
+
+	if !m.LoadVacancySkillsMock.invocationsDone() && afterLoadVacancySkillsCounter > 0 {
+		m.t.Errorf("Expected %d calls to AnalysisStorageMock.LoadVacancySkills at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.LoadVacancySkillsMock.expectedInvocations), m.LoadVacancySkillsMock.expectedInvocationsOrigin, afterLoadVacancySkillsCounter)
+	}
+}
+
+type mAnalysisStorageMockLoadVacancyStatus struct {
+	optional           bool
+	mock               *AnalysisStorageMock
+	defaultExpectation *AnalysisStorageMockLoadVacancyStatusExpectation
+	expectations       []*AnalysisStorageMockLoadVacancyStatusExpectation
+
+	callArgs []*AnalysisStorageMockLoadVacancyStatusParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// AnalysisStorageMockLoadVacancyStatusExpectation specifies expectation struct of the AnalysisStorage.LoadVacancyStatus
+type AnalysisStorageMockLoadVacancyStatusExpectation struct {
+	mock               *AnalysisStorageMock
+	params             *AnalysisStorageMockLoadVacancyStatusParams
+	paramPtrs          *AnalysisStorageMockLoadVacancyStatusParamPtrs
+	expectationOrigins AnalysisStorageMockLoadVacancyStatusExpectationOrigins
+	results            *AnalysisStorageMockLoadVacancyStatusResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// AnalysisStorageMockLoadVacancyStatusParams contains parameters of the AnalysisStorage.LoadVacancyStatus
+type AnalysisStorageMockLoadVacancyStatusParams struct {
+	ctx       context.Context
+	vacancyID string
+}
+
+// AnalysisStorageMockLoadVacancyStatusParamPtrs contains pointers to parameters of the AnalysisStorage.LoadVacancyStatus
+type AnalysisStorageMockLoadVacancyStatusParamPtrs struct {
+	ctx       *context.Context
+	vacancyID *string
+}
+
+// AnalysisStorageMockLoadVacancyStatusResults contains results of the AnalysisStorage.LoadVacancyStatus
+type AnalysisStorageMockLoadVacancyStatusResults struct {
+	s1  string
+	err error
+}
+
+// AnalysisStorageMockLoadVacancyStatusOrigins contains origins of expectations of the AnalysisStorage.LoadVacancyStatus
+type AnalysisStorageMockLoadVacancyStatusExpectationOrigins struct {
+	origin          string
+	originCtx       string
+	originVacancyID string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmLoadVacancyStatus *mAnalysisStorageMockLoadVacancyStatus) Optional() *mAnalysisStorageMockLoadVacancyStatus {
+	mmLoadVacancyStatus.optional = true
+	return mmLoadVacancyStatus
+}
+
+// Expect sets up expected params for AnalysisStorage.LoadVacancyStatus
+func (mmLoadVacancyStatus *mAnalysisStorageMockLoadVacancyStatus) Expect(ctx context.Context, vacancyID string) *mAnalysisStorageMockLoadVacancyStatus {
+	if mmLoadVacancyStatus.mock.funcLoadVacancyStatus != nil {
+		mmLoadVacancyStatus.mock.t.Fatalf("AnalysisStorageMock.LoadVacancyStatus mock is already set by Set")
+	}
+
+	if mmLoadVacancyStatus.defaultExpectation == nil {
+		mmLoadVacancyStatus.defaultExpectation = &AnalysisStorageMockLoadVacancyStatusExpectation{}
+	}
+
+	if mmLoadVacancyStatus.defaultExpectation.paramPtrs != nil {
+		mmLoadVacancyStatus.mock.t.Fatalf("AnalysisStorageMock.LoadVacancyStatus mock is already set by ExpectParams functions")
+	}
+
+	mmLoadVacancyStatus.defaultExpectation.params = &AnalysisStorageMockLoadVacancyStatusParams{ctx, vacancyID}
+	mmLoadVacancyStatus.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmLoadVacancyStatus.expectations {
+		if minimock.Equal(e.params, mmLoadVacancyStatus.defaultExpectation.params) {
+			mmLoadVacancyStatus.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmLoadVacancyStatus.defaultExpectation.params)
+		}
+	}
+
+	return mmLoadVacancyStatus
+}
+
+// ExpectCtxParam1 sets up expected param ctx for AnalysisStorage.LoadVacancyStatus
+func (mmLoadVacancyStatus *mAnalysisStorageMockLoadVacancyStatus) ExpectCtxParam1(ctx context.Context) *mAnalysisStorageMockLoadVacancyStatus {
+	if mmLoadVacancyStatus.mock.funcLoadVacancyStatus != nil {
+		mmLoadVacancyStatus.mock.t.Fatalf("AnalysisStorageMock.LoadVacancyStatus mock is already set by Set")
+	}
+
+	if mmLoadVacancyStatus.defaultExpectation == nil {
+		mmLoadVacancyStatus.defaultExpectation = &AnalysisStorageMockLoadVacancyStatusExpectation{}
+	}
+
+	if mmLoadVacancyStatus.defaultExpectation.params != nil {
+		mmLoadVacancyStatus.mock.t.Fatalf("AnalysisStorageMock.LoadVacancyStatus mock is already set by Expect")
+	}
+
+	if mmLoadVacancyStatus.defaultExpectation.paramPtrs == nil {
+		mmLoadVacancyStatus.defaultExpectation.paramPtrs = &AnalysisStorageMockLoadVacancyStatusParamPtrs{}
+	}
+	mmLoadVacancyStatus.defaultExpectation.paramPtrs.ctx = &ctx
+	mmLoadVacancyStatus.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmLoadVacancyStatus
+}
+
+// ExpectVacancyIDParam2 sets up expected param vacancyID for AnalysisStorage.LoadVacancyStatus
+func (mmLoadVacancyStatus *mAnalysisStorageMockLoadVacancyStatus) ExpectVacancyIDParam2(vacancyID string) *mAnalysisStorageMockLoadVacancyStatus {
+	if mmLoadVacancyStatus.mock.funcLoadVacancyStatus != nil {
+		mmLoadVacancyStatus.mock.t.Fatalf("AnalysisStorageMock.LoadVacancyStatus mock is already set by Set")
+	}
+
+	if mmLoadVacancyStatus.defaultExpectation == nil {
+		mmLoadVacancyStatus.defaultExpectation = &AnalysisStorageMockLoadVacancyStatusExpectation{}
+	}
+
+	if mmLoadVacancyStatus.defaultExpectation.params != nil {
+		mmLoadVacancyStatus.mock.t.Fatalf("AnalysisStorageMock.LoadVacancyStatus mock is already set by Expect")
+	}
+
+	if mmLoadVacancyStatus.defaultExpectation.paramPtrs == nil {
+		mmLoadVacancyStatus.defaultExpectation.paramPtrs = &AnalysisStorageMockLoadVacancyStatusParamPtrs{}
+	}
+	mmLoadVacancyStatus.defaultExpectation.paramPtrs.vacancyID = &vacancyID
+	mmLoadVacancyStatus.defaultExpectation.expectationOrigins.originVacancyID = minimock.CallerInfo(1)
+
+	return mmLoadVacancyStatus
+}
+
+// Inspect accepts an inspector function that has same arguments as the AnalysisStorage.LoadVacancyStatus
+func (mmLoadVacancyStatus *mAnalysisStorageMockLoadVacancyStatus) Inspect(f func(ctx context.Context, vacancyID string)) *mAnalysisStorageMockLoadVacancyStatus {
+	if mmLoadVacancyStatus.mock.inspectFuncLoadVacancyStatus != nil {
+		mmLoadVacancyStatus.mock.t.Fatalf("Inspect function is already set for AnalysisStorageMock.LoadVacancyStatus")
+	}
+
+	mmLoadVacancyStatus.mock.inspectFuncLoadVacancyStatus = f
+
+	return mmLoadVacancyStatus
+}
+
+// Return sets up results that will be returned by AnalysisStorage.LoadVacancyStatus
+func (mmLoadVacancyStatus *mAnalysisStorageMockLoadVacancyStatus) Return(s1 string, err error) *AnalysisStorageMock {
+	if mmLoadVacancyStatus.mock.funcLoadVacancyStatus != nil {
+		mmLoadVacancyStatus.mock.t.Fatalf("AnalysisStorageMock.LoadVacancyStatus mock is already set by Set")
+	}
+
+	if mmLoadVacancyStatus.defaultExpectation == nil {
+		mmLoadVacancyStatus.defaultExpectation = &AnalysisStorageMockLoadVacancyStatusExpectation{mock: mmLoadVacancyStatus.mock}
+	}
+	mmLoadVacancyStatus.defaultExpectation.results = &AnalysisStorageMockLoadVacancyStatusResults{s1, err}
+	mmLoadVacancyStatus.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmLoadVacancyStatus.mock
+}
+
+// Set uses given function f to mock the AnalysisStorage.LoadVacancyStatus method
+func (mmLoadVacancyStatus *mAnalysisStorageMockLoadVacancyStatus) Set(f func(ctx context.Context, vacancyID string) (s1 string, err error)) *AnalysisStorageMock {
+	if mmLoadVacancyStatus.defaultExpectation != nil {
+		mmLoadVacancyStatus.mock.t.Fatalf("Default expectation is already set for the AnalysisStorage.LoadVacancyStatus method")
+	}
+
+	if len(mmLoadVacancyStatus.expectations) > 0 {
+		mmLoadVacancyStatus.mock.t.Fatalf("Some expectations are already set for the AnalysisStorage.LoadVacancyStatus method")
+	}
+
+	mmLoadVacancyStatus.mock.funcLoadVacancyStatus = f
+	mmLoadVacancyStatus.mock.funcLoadVacancyStatusOrigin = minimock.CallerInfo(1)
+	return mmLoadVacancyStatus.mock
+}
+
+// When sets expectation for the AnalysisStorage.LoadVacancyStatus which will trigger the result defined by the following
+// Then helper
+func (mmLoadVacancyStatus *mAnalysisStorageMockLoadVacancyStatus) When(ctx context.Context, vacancyID string) *AnalysisStorageMockLoadVacancyStatusExpectation {
+	if mmLoadVacancyStatus.mock.funcLoadVacancyStatus != nil {
+		mmLoadVacancyStatus.mock.t.Fatalf("AnalysisStorageMock.LoadVacancyStatus mock is already set by Set")
+	}
+
+	expectation := &AnalysisStorageMockLoadVacancyStatusExpectation{
+		mock:               mmLoadVacancyStatus.mock,
+		params:             &AnalysisStorageMockLoadVacancyStatusParams{ctx, vacancyID},
+		expectationOrigins: AnalysisStorageMockLoadVacancyStatusExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmLoadVacancyStatus.expectations = append(mmLoadVacancyStatus.expectations, expectation)
 	return expectation
 }
 
-// Then sets up AnalysisStorage.LoadVacancySkills return parameters for the expectation previously defined by the When method
-func (e *AnalysisStorageMockLoadVacancySkillsExpectation) Then(va1 []domain.VacancySkill, err error) *AnalysisStorageMock {
-	e.results = &AnalysisStorageMockLoadVacancySkillsResults{va1, err}
+// Then sets up AnalysisStorage.LoadVacancyStatus return parameters for the expectation previously defined by the When method
+func (e *AnalysisStorageMockLoadVacancyStatusExpectation) Then(s1 string, err error) *AnalysisStorageMock {
+	e.results = &AnalysisStorageMockLoadVacancyStatusResults{s1, err}
 	return e.mock
 }
 
-// Times sets number of times AnalysisStorage.LoadVacancySkills should be invoked
-func (mmLoadVacancySkills *mAnalysisStorageMockLoadVacancySkills) Times(n uint64) *mAnalysisStorageMockLoadVacancySkills {
+// Times sets number of times AnalysisStorage.LoadVacancyStatus should be invoked
+func (mmLoadVacancyStatus *mAnalysisStorageMockLoadVacancyStatus) Times(n uint64) *mAnalysisStorageMockLoadVacancyStatus {
 	if n == 0 {
-		mmLoadVacancySkills.mock.t.Fatalf("Times of AnalysisStorageMock.LoadVacancySkills mock can not be zero")
+		mmLoadVacancyStatus.mock.t.Fatalf("Times of AnalysisStorageMock.LoadVacancyStatus mock can not be zero")
 	}
-	mm_atomic.StoreUint64(&mmLoadVacancySkills.expectedInvocations, n)
-	mmLoadVacancySkills.expectedInvocationsOrigin = minimock.CallerInfo(1)
-	return mmLoadVacancySkills
+	mm_atomic.StoreUint64(&mmLoadVacancyStatus.expectedInvocations, n)
+	mmLoadVacancyStatus.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmLoadVacancyStatus
 }
 
-func (mmLoadVacancySkills *mAnalysisStorageMockLoadVacancySkills) invocationsDone() bool {
-	if len(mmLoadVacancySkills.expectations) == 0 && mmLoadVacancySkills.defaultExpectation == nil && mmLoadVacancySkills.mock.funcLoadVacancySkills == nil {
+func (mmLoadVacancyStatus *mAnalysisStorageMockLoadVacancyStatus) invocationsDone() bool {
+	if len(mmLoadVacancyStatus.expectations) == 0 && mmLoadVacancyStatus.defaultExpectation == nil && mmLoadVacancyStatus.mock.funcLoadVacancyStatus == nil {
 		return true
 	}
 
-	totalInvocations := mm_atomic.LoadUint64(&mmLoadVacancySkills.mock.afterLoadVacancySkillsCounter)
-	expectedInvocations := mm_atomic.LoadUint64(&mmLoadVacancySkills.expectedInvocations)
+	totalInvocations := mm_atomic.LoadUint64(&mmLoadVacancyStatus.mock.afterLoadVacancyStatusCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmLoadVacancyStatus.expectedInvocations)
 
 	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
 }
 
-// LoadVacancySkills implements mm_usecase.AnalysisStorage
-func (mmLoadVacancySkills *AnalysisStorageMock) LoadVacancySkills(ctx context.Context, vacancyID string) (va1 []domain.VacancySkill, err error) {
-	mm_atomic.AddUint64(&mmLoadVacancySkills.beforeLoadVacancySkillsCounter, 1)
-	defer mm_atomic.AddUint64(&mmLoadVacancySkills.afterLoadVacancySkillsCounter, 1)
+// LoadVacancyStatus implements mm_usecase.AnalysisStorage
+func (mmLoadVacancyStatus *AnalysisStorageMock) LoadVacancyStatus(ctx context.Context, vacancyID string) (s1 string, err error) {
+	mm_atomic.AddUint64(&mmLoadVacancyStatus.beforeLoadVacancyStatusCounter, 1)
+	defer mm_atomic.AddUint64(&mmLoadVacancyStatus.afterLoadVacancyStatusCounter, 1)
 
-	mmLoadVacancySkills.t.Helper()
+	mmLoadVacancyStatus.t.Helper()
 
-	if mmLoadVacancySkills.inspectFuncLoadVacancySkills != nil {
-		mmLoadVacancySkills.inspectFuncLoadVacancySkills(ctx, vacancyID)
+	if mmLoadVacancyStatus.inspectFuncLoadVacancyStatus != nil {
+		mmLoadVacancyStatus.inspectFuncLoadVacancyStatus(ctx, vacancyID)
 	}
 
-	mm_params := AnalysisStorageMockLoadVacancySkillsParams{ctx, vacancyID}
+	mm_params := AnalysisStorageMockLoadVacancyStatusParams{ctx, vacancyID}
 
 	// Record call args
-	mmLoadVacancySkills.LoadVacancySkillsMock.mutex.Lock()
-	mmLoadVacancySkills.LoadVacancySkillsMock.callArgs = append(mmLoadVacancySkills.LoadVacancySkillsMock.callArgs, &mm_params)
-	mmLoadVacancySkills.LoadVacancySkillsMock.mutex.Unlock()
+	mmLoadVacancyStatus.LoadVacancyStatusMock.mutex.Lock()
+	mmLoadVacancyStatus.LoadVacancyStatusMock.callArgs = append(mmLoadVacancyStatus.LoadVacancyStatusMock.callArgs, &mm_params)
+	mmLoadVacancyStatus.LoadVacancyStatusMock.mutex.Unlock()
 
-	for _, e := range mmLoadVacancySkills.LoadVacancySkillsMock.expectations {
+	for _, e := range mmLoadVacancyStatus.LoadVacancyStatusMock.expectations {
 		if minimock.Equal(*e.params, mm_params) {
 			mm_atomic.AddUint64(&e.Counter, 1)
-			return e.results.va1, e.results.err
+			return e.results.s1, e.results.err
 		}
 	}
 
-	if mmLoadVacancySkills.LoadVacancySkillsMock.defaultExpectation != nil {
-		mm_atomic.AddUint64(&mmLoadVacancySkills.LoadVacancySkillsMock.defaultExpectation.Counter, 1)
-		mm_want := mmLoadVacancySkills.LoadVacancySkillsMock.defaultExpectation.params
-		mm_want_ptrs := mmLoadVacancySkills.LoadVacancySkillsMock.defaultExpectation.paramPtrs
+	if mmLoadVacancyStatus.LoadVacancyStatusMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmLoadVacancyStatus.LoadVacancyStatusMock.defaultExpectation.Counter, 1)
+		mm_want := mmLoadVacancyStatus.LoadVacancyStatusMock.defaultExpectation.params
+		mm_want_ptrs := mmLoadVacancyStatus.LoadVacancyStatusMock.defaultExpectation.paramPtrs
 
-		mm_got := AnalysisStorageMockLoadVacancySkillsParams{ctx, vacancyID}
+		mm_got := AnalysisStorageMockLoadVacancyStatusParams{ctx, vacancyID}
 
 		if mm_want_ptrs != nil {
 
 			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
-				mmLoadVacancySkills.t.Errorf("AnalysisStorageMock.LoadVacancySkills got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
-					mmLoadVacancySkills.LoadVacancySkillsMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+				mmLoadVacancyStatus.t.Errorf("AnalysisStorageMock.LoadVacancyStatus got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmLoadVacancyStatus.LoadVacancyStatusMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
 			}
 
 			if mm_want_ptrs.vacancyID != nil && !minimock.Equal(*mm_want_ptrs.vacancyID, mm_got.vacancyID) {
-				mmLoadVacancySkills.t.Errorf("AnalysisStorageMock.LoadVacancySkills got unexpected parameter vacancyID, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
-					mmLoadVacancySkills.LoadVacancySkillsMock.defaultExpectation.expectationOrigins.originVacancyID, *mm_want_ptrs.vacancyID, mm_got.vacancyID, minimock.Diff(*mm_want_ptrs.vacancyID, mm_got.vacancyID))
+				mmLoadVacancyStatus.t.Errorf("AnalysisStorageMock.LoadVacancyStatus got unexpected parameter vacancyID, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmLoadVacancyStatus.LoadVacancyStatusMock.defaultExpectation.expectationOrigins.originVacancyID, *mm_want_ptrs.vacancyID, mm_got.vacancyID, minimock.Diff(*mm_want_ptrs.vacancyID, mm_got.vacancyID))
 			}
 
 		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
-			mmLoadVacancySkills.t.Errorf("AnalysisStorageMock.LoadVacancySkills got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
-				mmLoadVacancySkills.LoadVacancySkillsMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+			mmLoadVacancyStatus.t.Errorf("AnalysisStorageMock.LoadVacancyStatus got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmLoadVacancyStatus.LoadVacancyStatusMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
 		}
 
-		mm_results := mmLoadVacancySkills.LoadVacancySkillsMock.defaultExpectation.results
+		mm_results := mmLoadVacancyStatus.LoadVacancyStatusMock.defaultExpectation.results
 		if mm_results == nil {
-			mmLoadVacancySkills.t.Fatal("No results are set for the AnalysisStorageMock.LoadVacancySkills")
+			mmLoadVacancyStatus.t.Fatal("No results are set for the AnalysisStorageMock.LoadVacancyStatus")
 		}
-		return (*mm_results).va1, (*mm_results).err
+		return (*mm_results).s1, (*mm_results).err
 	}
-	if mmLoadVacancySkills.funcLoadVacancySkills != nil {
-		return mmLoadVacancySkills.funcLoadVacancySkills(ctx, vacancyID)
+	if mmLoadVacancyStatus.funcLoadVacancyStatus != nil {
+		return mmLoadVacancyStatus.funcLoadVacancyStatus(ctx, vacancyID)
 	}
-	mmLoadVacancySkills.t.Fatalf("Unexpected call to AnalysisStorageMock.LoadVacancySkills. %v %v", ctx, vacancyID)
+	mmLoadVacancyStatus.t.Fatalf("Unexpected call to AnalysisStorageMock.LoadVacancyStatus. %v %v", ctx, vacancyID)
 	return
 }
 
-// LoadVacancySkillsAfterCounter returns a count of finished AnalysisStorageMock.LoadVacancySkills invocations
-func (mmLoadVacancySkills *AnalysisStorageMock) LoadVacancySkillsAfterCounter() uint64 {
-	return mm_atomic.LoadUint64(&mmLoadVacancySkills.afterLoadVacancySkillsCounter)
+// LoadVacancyStatusAfterCounter returns a count of finished AnalysisStorageMock.LoadVacancyStatus invocations
+func (mmLoadVacancyStatus *AnalysisStorageMock) LoadVacancyStatusAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmLoadVacancyStatus.afterLoadVacancyStatusCounter)
 }
 
-// LoadVacancySkillsBeforeCounter returns a count of AnalysisStorageMock.LoadVacancySkills invocations
-func (mmLoadVacancySkills *AnalysisStorageMock) LoadVacancySkillsBeforeCounter() uint64 {
-	return mm_atomic.LoadUint64(&mmLoadVacancySkills.beforeLoadVacancySkillsCounter)
+// LoadVacancyStatusBeforeCounter returns a count of AnalysisStorageMock.LoadVacancyStatus invocations
+func (mmLoadVacancyStatus *AnalysisStorageMock) LoadVacancyStatusBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmLoadVacancyStatus.beforeLoadVacancyStatusCounter)
 }
 
-// Calls returns a list of arguments used in each call to AnalysisStorageMock.LoadVacancySkills.
+// Calls returns a list of arguments used in each call to AnalysisStorageMock.LoadVacancyStatus.
 // The list is in the same order as the calls were made (i.e. recent calls have a higher index)
-func (mmLoadVacancySkills *mAnalysisStorageMockLoadVacancySkills) Calls() []*AnalysisStorageMockLoadVacancySkillsParams {
-	mmLoadVacancySkills.mutex.RLock()
+func (mmLoadVacancyStatus *mAnalysisStorageMockLoadVacancyStatus) Calls() []*AnalysisStorageMockLoadVacancyStatusParams {
+	mmLoadVacancyStatus.mutex.RLock()
 
-	argCopy := make([]*AnalysisStorageMockLoadVacancySkillsParams, len(mmLoadVacancySkills.callArgs))
-	copy(argCopy, mmLoadVacancySkills.callArgs)
+	argCopy := make([]*AnalysisStorageMockLoadVacancyStatusParams, len(mmLoadVacancyStatus.callArgs))
+	copy(argCopy, mmLoadVacancyStatus.callArgs)
 
-	mmLoadVacancySkills.mutex.RUnlock()
+	mmLoadVacancyStatus.mutex.RUnlock()
 
 	return argCopy
 }
 
-// MinimockLoadVacancySkillsDone returns true if the count of the LoadVacancySkills invocations corresponds
+// MinimockLoadVacancyStatusDone returns true if the count of the LoadVacancyStatus invocations corresponds
 // the number of defined expectations
-func (m *AnalysisStorageMock) MinimockLoadVacancySkillsDone() bool {
-	if m.LoadVacancySkillsMock.optional {
+func (m *AnalysisStorageMock) MinimockLoadVacancyStatusDone() bool {
+	if m.LoadVacancyStatusMock.optional {
 		// Optional methods provide '0 or more' call count restriction.
 		return true
 	}
 
-	for _, e := range m.LoadVacancySkillsMock.expectations {
+	for _, e := range m.LoadVacancyStatusMock.expectations {
 		if mm_atomic.LoadUint64(&e.Counter) < 1 {
 			return false
 		}
 	}
 
-	return m.LoadVacancySkillsMock.invocationsDone()
+	return m.LoadVacancyStatusMock.invocationsDone()
 }
 
-// MinimockLoadVacancySkillsInspect logs each unmet expectation
-func (m *AnalysisStorageMock) MinimockLoadVacancySkillsInspect() {
-	for _, e := range m.LoadVacancySkillsMock.expectations {
+// MinimockLoadVacancyStatusInspect logs each unmet expectation
+func (m *AnalysisStorageMock) MinimockLoadVacancyStatusInspect() {
+	for _, e := range m.LoadVacancyStatusMock.expectations {
 		if mm_atomic.LoadUint64(&e.Counter) < 1 {
-			m.t.Errorf("Expected call to AnalysisStorageMock.LoadVacancySkills at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+			m.t.Errorf("Expected call to AnalysisStorageMock.LoadVacancyStatus at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
 		}
 	}
 
-	afterLoadVacancySkillsCounter := mm_atomic.LoadUint64(&m.afterLoadVacancySkillsCounter)
+	afterLoadVacancyStatusCounter := mm_atomic.LoadUint64(&m.afterLoadVacancyStatusCounter)
 	// if default expectation was set then invocations count should be greater than zero
-	if m.LoadVacancySkillsMock.defaultExpectation != nil && afterLoadVacancySkillsCounter < 1 {
-		if m.LoadVacancySkillsMock.defaultExpectation.params == nil {
-			m.t.Errorf("Expected call to AnalysisStorageMock.LoadVacancySkills at\n%s", m.LoadVacancySkillsMock.defaultExpectation.returnOrigin)
+	if m.LoadVacancyStatusMock.defaultExpectation != nil && afterLoadVacancyStatusCounter < 1 {
+		if m.LoadVacancyStatusMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to AnalysisStorageMock.LoadVacancyStatus at\n%s", m.LoadVacancyStatusMock.defaultExpectation.returnOrigin)
 		} else {
-			m.t.Errorf("Expected call to AnalysisStorageMock.LoadVacancySkills at\n%s with params: %#v", m.LoadVacancySkillsMock.defaultExpectation.expectationOrigins.origin, *m.LoadVacancySkillsMock.defaultExpectation.params)
+			m.t.Errorf("Expected call to AnalysisStorageMock.LoadVacancyStatus at\n%s with params: %#v", m.LoadVacancyStatusMock.defaultExpectation.expectationOrigins.origin, *m.LoadVacancyStatusMock.defaultExpectation.params)
 		}
 	}
 	// if func was set then invocations count should be greater than zero
-	if m.funcLoadVacancySkills != nil && afterLoadVacancySkillsCounter < 1 {
-		m.t.Errorf("Expected call to AnalysisStorageMock.LoadVacancySkills at\n%s", m.funcLoadVacancySkillsOrigin)
+	if m.funcLoadVacancyStatus != nil && afterLoadVacancyStatusCounter < 1 {
+		m.t.Errorf("Expected call to AnalysisStorageMock.LoadVacancyStatus at\n%s", m.funcLoadVacancyStatusOrigin)
 	}
 
-	if !m.LoadVacancySkillsMock.invocationsDone() && afterLoadVacancySkillsCounter > 0 {
-		m.t.Errorf("Expected %d calls to AnalysisStorageMock.LoadVacancySkills at\n%s but found %d calls",
-			mm_atomic.LoadUint64(&m.LoadVacancySkillsMock.expectedInvocations), m.LoadVacancySkillsMock.expectedInvocationsOrigin, afterLoadVacancySkillsCounter)
+	if !m.LoadVacancyStatusMock.invocationsDone() && afterLoadVacancyStatusCounter > 0 {
+		m.t.Errorf("Expected %d calls to AnalysisStorageMock.LoadVacancyStatus at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.LoadVacancyStatusMock.expectedInvocations), m.LoadVacancyStatusMock.expectedInvocationsOrigin, afterLoadVacancyStatusCounter)
 	}
 }
 
@@ -1803,6 +2519,349 @@ func (m *AnalysisStorageMock) MinimockNewIDInspect() {
 	}
 }
 
+type mAnalysisStorageMockPurgeVacancyAnalyses struct {
+	optional           bool
+	mock               *AnalysisStorageMock
+	defaultExpectation *AnalysisStorageMockPurgeVacancyAnalysesExpectation
+	expectations       []*AnalysisStorageMockPurgeVacancyAnalysesExpectation
+
+	callArgs []*AnalysisStorageMockPurgeVacancyAnalysesParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// AnalysisStorageMockPurgeVacancyAnalysesExpectation specifies expectation struct of the AnalysisStorage.PurgeVacancyAnalyses
+type AnalysisStorageMockPurgeVacancyAnalysesExpectation struct {
+	mock               *AnalysisStorageMock
+	params             *AnalysisStorageMockPurgeVacancyAnalysesParams
+	paramPtrs          *AnalysisStorageMockPurgeVacancyAnalysesParamPtrs
+	expectationOrigins AnalysisStorageMockPurgeVacancyAnalysesExpectationOrigins
+	results            *AnalysisStorageMockPurgeVacancyAnalysesResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// AnalysisStorageMockPurgeVacancyAnalysesParams contains parameters of the AnalysisStorage.PurgeVacancyAnalyses
+type AnalysisStorageMockPurgeVacancyAnalysesParams struct {
+	ctx       context.Context
+	vacancyID string
+}
+
+// AnalysisStorageMockPurgeVacancyAnalysesParamPtrs contains pointers to parameters of the AnalysisStorage.PurgeVacancyAnalyses
+type AnalysisStorageMockPurgeVacancyAnalysesParamPtrs struct {
+	ctx       *context.Context
+	vacancyID *string
+}
+
+// AnalysisStorageMockPurgeVacancyAnalysesResults contains results of the AnalysisStorage.PurgeVacancyAnalyses
+type AnalysisStorageMockPurgeVacancyAnalysesResults struct {
+	pp1 *domain.PurgeVacancyAnalysesResult
+	err error
+}
+
+// AnalysisStorageMockPurgeVacancyAnalysesOrigins contains origins of expectations of the AnalysisStorage.PurgeVacancyAnalyses
+type AnalysisStorageMockPurgeVacancyAnalysesExpectationOrigins struct {
+	origin          string
+	originCtx       string
+	originVacancyID string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmPurgeVacancyAnalyses *mAnalysisStorageMockPurgeVacancyAnalyses) Optional() *mAnalysisStorageMockPurgeVacancyAnalyses {
+	mmPurgeVacancyAnalyses.optional = true
+	return mmPurgeVacancyAnalyses
+}
+
+// Expect sets up expected params for AnalysisStorage.PurgeVacancyAnalyses
+func (mmPurgeVacancyAnalyses *mAnalysisStorageMockPurgeVacancyAnalyses) Expect(ctx context.Context, vacancyID string) *mAnalysisStorageMockPurgeVacancyAnalyses {
+	if mmPurgeVacancyAnalyses.mock.funcPurgeVacancyAnalyses != nil {
+		mmPurgeVacancyAnalyses.mock.t.Fatalf("AnalysisStorageMock.PurgeVacancyAnalyses mock is already set by Set")
+	}
+
+	if mmPurgeVacancyAnalyses.defaultExpectation == nil {
+		mmPurgeVacancyAnalyses.defaultExpectation = &AnalysisStorageMockPurgeVacancyAnalysesExpectation{}
+	}
+
+	if mmPurgeVacancyAnalyses.defaultExpectation.paramPtrs != nil {
+		mmPurgeVacancyAnalyses.mock.t.Fatalf("AnalysisStorageMock.PurgeVacancyAnalyses mock is already set by ExpectParams functions")
+	}
+
+	mmPurgeVacancyAnalyses.defaultExpectation.params = &AnalysisStorageMockPurgeVacancyAnalysesParams{ctx, vacancyID}
+	mmPurgeVacancyAnalyses.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmPurgeVacancyAnalyses.expectations {
+		if minimock.Equal(e.params, mmPurgeVacancyAnalyses.defaultExpectation.params) {
+			mmPurgeVacancyAnalyses.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmPurgeVacancyAnalyses.defaultExpectation.params)
+		}
+	}
+
+	return mmPurgeVacancyAnalyses
+}
+
+// ExpectCtxParam1 sets up expected param ctx for AnalysisStorage.PurgeVacancyAnalyses
+func (mmPurgeVacancyAnalyses *mAnalysisStorageMockPurgeVacancyAnalyses) ExpectCtxParam1(ctx context.Context) *mAnalysisStorageMockPurgeVacancyAnalyses {
+	if mmPurgeVacancyAnalyses.mock.funcPurgeVacancyAnalyses != nil {
+		mmPurgeVacancyAnalyses.mock.t.Fatalf("AnalysisStorageMock.PurgeVacancyAnalyses mock is already set by Set")
+	}
+
+	if mmPurgeVacancyAnalyses.defaultExpectation == nil {
+		mmPurgeVacancyAnalyses.defaultExpectation = &AnalysisStorageMockPurgeVacancyAnalysesExpectation{}
+	}
+
+	if mmPurgeVacancyAnalyses.defaultExpectation.params != nil {
+		mmPurgeVacancyAnalyses.mock.t.Fatalf("AnalysisStorageMock.PurgeVacancyAnalyses mock is already set by Expect")
+	}
+
+	if mmPurgeVacancyAnalyses.defaultExpectation.paramPtrs == nil {
+		mmPurgeVacancyAnalyses.defaultExpectation.paramPtrs = &AnalysisStorageMockPurgeVacancyAnalysesParamPtrs{}
+	}
+	mmPurgeVacancyAnalyses.defaultExpectation.paramPtrs.ctx = &ctx
+	mmPurgeVacancyAnalyses.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmPurgeVacancyAnalyses
+}
+
+// ExpectVacancyIDParam2 sets up expected param vacancyID for AnalysisStorage.PurgeVacancyAnalyses
+func (mmPurgeVacancyAnalyses *mAnalysisStorageMockPurgeVacancyAnalyses) ExpectVacancyIDParam2(vacancyID string) *mAnalysisStorageMockPurgeVacancyAnalyses {
+	if mmPurgeVacancyAnalyses.mock.funcPurgeVacancyAnalyses != nil {
+		mmPurgeVacancyAnalyses.mock.t.Fatalf("AnalysisStorageMock.PurgeVacancyAnalyses mock is already set by Set")
+	}
+
+	if mmPurgeVacancyAnalyses.defaultExpectation == nil {
+		mmPurgeVacancyAnalyses.defaultExpectation = &AnalysisStorageMockPurgeVacancyAnalysesExpectation{}
+	}
+
+	if mmPurgeVacancyAnalyses.defaultExpectation.params != nil {
+		mmPurgeVacancyAnalyses.mock.t.Fatalf("AnalysisStorageMock.PurgeVacancyAnalyses mock is already set by Expect")
+	}
+
+	if mmPurgeVacancyAnalyses.defaultExpectation.paramPtrs == nil {
+		mmPurgeVacancyAnalyses.defaultExpectation.paramPtrs = &AnalysisStorageMockPurgeVacancyAnalysesParamPtrs{}
+	}
+	mmPurgeVacancyAnalyses.defaultExpectation.paramPtrs.vacancyID = &vacancyID
+	mmPurgeVacancyAnalyses.defaultExpectation.expectationOrigins.originVacancyID = minimock.CallerInfo(1)
+
+	return mmPurgeVacancyAnalyses
+}
+
+// Inspect accepts an inspector function that has same arguments as the AnalysisStorage.PurgeVacancyAnalyses
+func (mmPurgeVacancyAnalyses *mAnalysisStorageMockPurgeVacancyAnalyses) Inspect(f func(ctx context.Context, vacancyID string)) *mAnalysisStorageMockPurgeVacancyAnalyses {
+	if mmPurgeVacancyAnalyses.mock.inspectFuncPurgeVacancyAnalyses != nil {
+		mmPurgeVacancyAnalyses.mock.t.Fatalf("Inspect function is already set for AnalysisStorageMock.PurgeVacancyAnalyses")
+	}
+
+	mmPurgeVacancyAnalyses.mock.inspectFuncPurgeVacancyAnalyses = f
+
+	return mmPurgeVacancyAnalyses
+}
+
+// Return sets up results that will be returned by AnalysisStorage.PurgeVacancyAnalyses
+func (mmPurgeVacancyAnalyses *mAnalysisStorageMockPurgeVacancyAnalyses) Return(pp1 *domain.PurgeVacancyAnalysesResult, err error) *AnalysisStorageMock {
+	if mmPurgeVacancyAnalyses.mock.funcPurgeVacancyAnalyses != nil {
+		mmPurgeVacancyAnalyses.mock.t.Fatalf("AnalysisStorageMock.PurgeVacancyAnalyses mock is already set by Set")
+	}
+
+	if mmPurgeVacancyAnalyses.defaultExpectation == nil {
+		mmPurgeVacancyAnalyses.defaultExpectation = &AnalysisStorageMockPurgeVacancyAnalysesExpectation{mock: mmPurgeVacancyAnalyses.mock}
+	}
+	mmPurgeVacancyAnalyses.defaultExpectation.results = &AnalysisStorageMockPurgeVacancyAnalysesResults{pp1, err}
+	mmPurgeVacancyAnalyses.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmPurgeVacancyAnalyses.mock
+}
+
+// Set uses given function f to mock the AnalysisStorage.PurgeVacancyAnalyses method
+func (mmPurgeVacancyAnalyses *mAnalysisStorageMockPurgeVacancyAnalyses) Set(f func(ctx context.Context, vacancyID string) (pp1 *domain.PurgeVacancyAnalysesResult, err error)) *AnalysisStorageMock {
+	if mmPurgeVacancyAnalyses.defaultExpectation != nil {
+		mmPurgeVacancyAnalyses.mock.t.Fatalf("Default expectation is already set for the AnalysisStorage.PurgeVacancyAnalyses method")
+	}
+
+	if len(mmPurgeVacancyAnalyses.expectations) > 0 {
+		mmPurgeVacancyAnalyses.mock.t.Fatalf("Some expectations are already set for the AnalysisStorage.PurgeVacancyAnalyses method")
+	}
+
+	mmPurgeVacancyAnalyses.mock.funcPurgeVacancyAnalyses = f
+	mmPurgeVacancyAnalyses.mock.funcPurgeVacancyAnalysesOrigin = minimock.CallerInfo(1)
+	return mmPurgeVacancyAnalyses.mock
+}
+
+// When sets expectation for the AnalysisStorage.PurgeVacancyAnalyses which will trigger the result defined by the following
+// Then helper
+func (mmPurgeVacancyAnalyses *mAnalysisStorageMockPurgeVacancyAnalyses) When(ctx context.Context, vacancyID string) *AnalysisStorageMockPurgeVacancyAnalysesExpectation {
+	if mmPurgeVacancyAnalyses.mock.funcPurgeVacancyAnalyses != nil {
+		mmPurgeVacancyAnalyses.mock.t.Fatalf("AnalysisStorageMock.PurgeVacancyAnalyses mock is already set by Set")
+	}
+
+	expectation := &AnalysisStorageMockPurgeVacancyAnalysesExpectation{
+		mock:               mmPurgeVacancyAnalyses.mock,
+		params:             &AnalysisStorageMockPurgeVacancyAnalysesParams{ctx, vacancyID},
+		expectationOrigins: AnalysisStorageMockPurgeVacancyAnalysesExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmPurgeVacancyAnalyses.expectations = append(mmPurgeVacancyAnalyses.expectations, expectation)
+	return expectation
+}
+
+// Then sets up AnalysisStorage.PurgeVacancyAnalyses return parameters for the expectation previously defined by the When method
+func (e *AnalysisStorageMockPurgeVacancyAnalysesExpectation) Then(pp1 *domain.PurgeVacancyAnalysesResult, err error) *AnalysisStorageMock {
+	e.results = &AnalysisStorageMockPurgeVacancyAnalysesResults{pp1, err}
+	return e.mock
+}
+
+// Times sets number of times AnalysisStorage.PurgeVacancyAnalyses should be invoked
+func (mmPurgeVacancyAnalyses *mAnalysisStorageMockPurgeVacancyAnalyses) Times(n uint64) *mAnalysisStorageMockPurgeVacancyAnalyses {
+	if n == 0 {
+		mmPurgeVacancyAnalyses.mock.t.Fatalf("Times of AnalysisStorageMock.PurgeVacancyAnalyses mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmPurgeVacancyAnalyses.expectedInvocations, n)
+	mmPurgeVacancyAnalyses.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmPurgeVacancyAnalyses
+}
+
+func (mmPurgeVacancyAnalyses *mAnalysisStorageMockPurgeVacancyAnalyses) invocationsDone() bool {
+	if len(mmPurgeVacancyAnalyses.expectations) == 0 && mmPurgeVacancyAnalyses.defaultExpectation == nil && mmPurgeVacancyAnalyses.mock.funcPurgeVacancyAnalyses == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmPurgeVacancyAnalyses.mock.afterPurgeVacancyAnalysesCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmPurgeVacancyAnalyses.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// PurgeVacancyAnalyses implements mm_usecase.AnalysisStorage
+func (mmPurgeVacancyAnalyses *AnalysisStorageMock) PurgeVacancyAnalyses(ctx context.Context, vacancyID string) (pp1 *domain.PurgeVacancyAnalysesResult, err error) {
+	mm_atomic.AddUint64(&mmPurgeVacancyAnalyses.beforePurgeVacancyAnalysesCounter, 1)
+	defer mm_atomic.AddUint64(&mmPurgeVacancyAnalyses.afterPurgeVacancyAnalysesCounter, 1)
+
+	mmPurgeVacancyAnalyses.t.Helper()
+
+	if mmPurgeVacancyAnalyses.inspectFuncPurgeVacancyAnalyses != nil {
+		mmPurgeVacancyAnalyses.inspectFuncPurgeVacancyAnalyses(ctx, vacancyID)
+	}
+
+	mm_params := AnalysisStorageMockPurgeVacancyAnalysesParams{ctx, vacancyID}
+
+	// Record call args
+	mmPurgeVacancyAnalyses.PurgeVacancyAnalysesMock.mutex.Lock()
+	mmPurgeVacancyAnalyses.PurgeVacancyAnalysesMock.callArgs = append(mmPurgeVacancyAnalyses.PurgeVacancyAnalysesMock.callArgs, &mm_params)
+	mmPurgeVacancyAnalyses.PurgeVacancyAnalysesMock.mutex.Unlock()
+
+	for _, e := range mmPurgeVacancyAnalyses.PurgeVacancyAnalysesMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.pp1, e.results.err
+		}
+	}
+
+	if mmPurgeVacancyAnalyses.PurgeVacancyAnalysesMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmPurgeVacancyAnalyses.PurgeVacancyAnalysesMock.defaultExpectation.Counter, 1)
+		mm_want := mmPurgeVacancyAnalyses.PurgeVacancyAnalysesMock.defaultExpectation.params
+		mm_want_ptrs := mmPurgeVacancyAnalyses.PurgeVacancyAnalysesMock.defaultExpectation.paramPtrs
+
+		mm_got := AnalysisStorageMockPurgeVacancyAnalysesParams{ctx, vacancyID}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmPurgeVacancyAnalyses.t.Errorf("AnalysisStorageMock.PurgeVacancyAnalyses got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmPurgeVacancyAnalyses.PurgeVacancyAnalysesMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.vacancyID != nil && !minimock.Equal(*mm_want_ptrs.vacancyID, mm_got.vacancyID) {
+				mmPurgeVacancyAnalyses.t.Errorf("AnalysisStorageMock.PurgeVacancyAnalyses got unexpected parameter vacancyID, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmPurgeVacancyAnalyses.PurgeVacancyAnalysesMock.defaultExpectation.expectationOrigins.originVacancyID, *mm_want_ptrs.vacancyID, mm_got.vacancyID, minimock.Diff(*mm_want_ptrs.vacancyID, mm_got.vacancyID))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmPurgeVacancyAnalyses.t.Errorf("AnalysisStorageMock.PurgeVacancyAnalyses got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmPurgeVacancyAnalyses.PurgeVacancyAnalysesMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmPurgeVacancyAnalyses.PurgeVacancyAnalysesMock.defaultExpectation.results
+		if mm_results == nil {
+			mmPurgeVacancyAnalyses.t.Fatal("No results are set for the AnalysisStorageMock.PurgeVacancyAnalyses")
+		}
+		return (*mm_results).pp1, (*mm_results).err
+	}
+	if mmPurgeVacancyAnalyses.funcPurgeVacancyAnalyses != nil {
+		return mmPurgeVacancyAnalyses.funcPurgeVacancyAnalyses(ctx, vacancyID)
+	}
+	mmPurgeVacancyAnalyses.t.Fatalf("Unexpected call to AnalysisStorageMock.PurgeVacancyAnalyses. %v %v", ctx, vacancyID)
+	return
+}
+
+// PurgeVacancyAnalysesAfterCounter returns a count of finished AnalysisStorageMock.PurgeVacancyAnalyses invocations
+func (mmPurgeVacancyAnalyses *AnalysisStorageMock) PurgeVacancyAnalysesAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmPurgeVacancyAnalyses.afterPurgeVacancyAnalysesCounter)
+}
+
+// PurgeVacancyAnalysesBeforeCounter returns a count of AnalysisStorageMock.PurgeVacancyAnalyses invocations
+func (mmPurgeVacancyAnalyses *AnalysisStorageMock) PurgeVacancyAnalysesBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmPurgeVacancyAnalyses.beforePurgeVacancyAnalysesCounter)
+}
+
+// Calls returns a list of arguments used in each call to AnalysisStorageMock.PurgeVacancyAnalyses.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmPurgeVacancyAnalyses *mAnalysisStorageMockPurgeVacancyAnalyses) Calls() []*AnalysisStorageMockPurgeVacancyAnalysesParams {
+	mmPurgeVacancyAnalyses.mutex.RLock()
+
+	argCopy := make([]*AnalysisStorageMockPurgeVacancyAnalysesParams, len(mmPurgeVacancyAnalyses.callArgs))
+	copy(argCopy, mmPurgeVacancyAnalyses.callArgs)
+
+	mmPurgeVacancyAnalyses.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockPurgeVacancyAnalysesDone returns true if the count of the PurgeVacancyAnalyses invocations corresponds
+// the number of defined expectations
+func (m *AnalysisStorageMock) MinimockPurgeVacancyAnalysesDone() bool {
+	if m.PurgeVacancyAnalysesMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.PurgeVacancyAnalysesMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.PurgeVacancyAnalysesMock.invocationsDone()
+}
+
+// MinimockPurgeVacancyAnalysesInspect logs each unmet expectation
+func (m *AnalysisStorageMock) MinimockPurgeVacancyAnalysesInspect() {
+	for _, e := range m.PurgeVacancyAnalysesMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to AnalysisStorageMock.PurgeVacancyAnalyses at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterPurgeVacancyAnalysesCounter := mm_atomic.LoadUint64(&m.afterPurgeVacancyAnalysesCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.PurgeVacancyAnalysesMock.defaultExpectation != nil && afterPurgeVacancyAnalysesCounter < 1 {
+		if m.PurgeVacancyAnalysesMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to AnalysisStorageMock.PurgeVacancyAnalyses at\n%s", m.PurgeVacancyAnalysesMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to AnalysisStorageMock.PurgeVacancyAnalyses at\n%s with params: %#v", m.PurgeVacancyAnalysesMock.defaultExpectation.expectationOrigins.origin, *m.PurgeVacancyAnalysesMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcPurgeVacancyAnalyses != nil && afterPurgeVacancyAnalysesCounter < 1 {
+		m.t.Errorf("Expected call to AnalysisStorageMock.PurgeVacancyAnalyses at\n%s", m.funcPurgeVacancyAnalysesOrigin)
+	}
+
+	if !m.PurgeVacancyAnalysesMock.invocationsDone() && afterPurgeVacancyAnalysesCounter > 0 {
+		m.t.Errorf("Expected %d calls to AnalysisStorageMock.PurgeVacancyAnalyses at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.PurgeVacancyAnalysesMock.expectedInvocations), m.PurgeVacancyAnalysesMock.expectedInvocationsOrigin, afterPurgeVacancyAnalysesCounter)
+	}
+}
+
 type mAnalysisStorageMockSaveAnalysis struct {
 	optional           bool
 	mock               *AnalysisStorageMock
@@ -3274,10 +4333,16 @@ func (m *AnalysisStorageMock) MinimockFinish() {
 
 			m.MinimockLoadResumeContextInspect()
 
+			m.MinimockLoadScoringPolicyInspect()
+
 			m.MinimockLoadVacancySkillsInspect()
 
+			m.MinimockLoadVacancyStatusInspect()
+
 			m.MinimockNewIDInspect()
 
+			m.MinimockPurgeVacancyAnalysesInspect()
+
 			m.MinimockSaveAnalysisInspect()
 
 			m.MinimockUpdateAIDecisionInspect()
@@ -3311,8 +4376,11 @@ func (m *AnalysisStorageMock) minimockDone() bool {
 		m.MinimockGetAnalysisDone() &&
 		m.MinimockListCandidatesByVacancyDone() &&
 		m.MinimockLoadResumeContextDone() &&
+		m.MinimockLoadScoringPolicyDone() &&
 		m.MinimockLoadVacancySkillsDone() &&
+		m.MinimockLoadVacancyStatusDone() &&
 		m.MinimockNewIDDone() &&
+		m.MinimockPurgeVacancyAnalysesDone() &&
 		m.MinimockSaveAnalysisDone() &&
 		m.MinimockUpdateAIDecisionDone() &&
 		m.MinimockUpdateProfileSummaryDone() &&
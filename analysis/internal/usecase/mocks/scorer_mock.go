@@ -16,9 +16,9 @@ type ScorerMock struct {
 	t          minimock.Tester
 	finishOnce sync.Once
 
-	funcScore          func(resumeText string, skills []domain.VacancySkill) (a1 domain.AnalysisPayload)
+	funcScore          func(resumeText string, skills []domain.VacancySkill, policy domain.ScoringPolicy) (a1 domain.AnalysisPayload)
 	funcScoreOrigin    string
-	inspectFuncScore   func(resumeText string, skills []domain.VacancySkill)
+	inspectFuncScore   func(resumeText string, skills []domain.VacancySkill, policy domain.ScoringPolicy)
 	afterScoreCounter  uint64
 	beforeScoreCounter uint64
 	ScoreMock          mScorerMockScore
@@ -68,12 +68,14 @@ type ScorerMockScoreExpectation struct {
 type ScorerMockScoreParams struct {
 	resumeText string
 	skills     []domain.VacancySkill
+	policy     domain.ScoringPolicy
 }
 
 // ScorerMockScoreParamPtrs contains pointers to parameters of the Scorer.Score
 type ScorerMockScoreParamPtrs struct {
 	resumeText *string
 	skills     *[]domain.VacancySkill
+	policy     *domain.ScoringPolicy
 }
 
 // ScorerMockScoreResults contains results of the Scorer.Score
@@ -86,6 +88,7 @@ type ScorerMockScoreExpectationOrigins struct {
 	origin           string
 	originResumeText string
 	originSkills     string
+	originPolicy     string
 }
 
 // Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
@@ -99,7 +102,7 @@ func (mmScore *mScorerMockScore) Optional() *mScorerMockScore {
 }
 
 // Expect sets up expected params for Scorer.Score
-func (mmScore *mScorerMockScore) Expect(resumeText string, skills []domain.VacancySkill) *mScorerMockScore {
+func (mmScore *mScorerMockScore) Expect(resumeText string, skills []domain.VacancySkill, policy domain.ScoringPolicy) *mScorerMockScore {
 	if mmScore.mock.funcScore != nil {
 		mmScore.mock.t.Fatalf("ScorerMock.Score mock is already set by Set")
 	}
@@ -112,7 +115,7 @@ func (mmScore *mScorerMockScore) Expect(resumeText string, skills []domain.Vacan
 		mmScore.mock.t.Fatalf("ScorerMock.Score mock is already set by ExpectParams functions")
 	}
 
-	mmScore.defaultExpectation.params = &ScorerMockScoreParams{resumeText, skills}
+	mmScore.defaultExpectation.params = &ScorerMockScoreParams{resumeText, skills, policy}
 	mmScore.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
 	for _, e := range mmScore.expectations {
 		if minimock.Equal(e.params, mmScore.defaultExpectation.params) {
@@ -169,8 +172,31 @@ func (mmScore *mScorerMockScore) ExpectSkillsParam2(skills []domain.VacancySkill
 	return mmScore
 }
 
+// ExpectPolicyParam3 sets up expected param policy for Scorer.Score
+func (mmScore *mScorerMockScore) ExpectPolicyParam3(policy domain.ScoringPolicy) *mScorerMockScore {
+	if mmScore.mock.funcScore != nil {
+		mmScore.mock.t.Fatalf("ScorerMock.Score mock is already set by Set")
+	}
+
+	if mmScore.defaultExpectation == nil {
+		mmScore.defaultExpectation = &ScorerMockScoreExpectation{}
+	}
+
+	if mmScore.defaultExpectation.params != nil {
+		mmScore.mock.t.Fatalf("ScorerMock.Score mock is already set by Expect")
+	}
+
+	if mmScore.defaultExpectation.paramPtrs == nil {
+		mmScore.defaultExpectation.paramPtrs = &ScorerMockScoreParamPtrs{}
+	}
+	mmScore.defaultExpectation.paramPtrs.policy = &policy
+	mmScore.defaultExpectation.expectationOrigins.originPolicy = minimock.CallerInfo(1)
+
+	return mmScore
+}
+
 // Inspect accepts an inspector function that has same arguments as the Scorer.Score
-func (mmScore *mScorerMockScore) Inspect(f func(resumeText string, skills []domain.VacancySkill)) *mScorerMockScore {
+func (mmScore *mScorerMockScore) Inspect(f func(resumeText string, skills []domain.VacancySkill, policy domain.ScoringPolicy)) *mScorerMockScore {
 	if mmScore.mock.inspectFuncScore != nil {
 		mmScore.mock.t.Fatalf("Inspect function is already set for ScorerMock.Score")
 	}
@@ -195,7 +221,7 @@ func (mmScore *mScorerMockScore) Return(a1 domain.AnalysisPayload) *ScorerMock {
 }
 
 // Set uses given function f to mock the Scorer.Score method
-func (mmScore *mScorerMockScore) Set(f func(resumeText string, skills []domain.VacancySkill) (a1 domain.AnalysisPayload)) *ScorerMock {
+func (mmScore *mScorerMockScore) Set(f func(resumeText string, skills []domain.VacancySkill, policy domain.ScoringPolicy) (a1 domain.AnalysisPayload)) *ScorerMock {
 	if mmScore.defaultExpectation != nil {
 		mmScore.mock.t.Fatalf("Default expectation is already set for the Scorer.Score method")
 	}
@@ -211,14 +237,14 @@ func (mmScore *mScorerMockScore) Set(f func(resumeText string, skills []domain.V
 
 // When sets expectation for the Scorer.Score which will trigger the result defined by the following
 // Then helper
-func (mmScore *mScorerMockScore) When(resumeText string, skills []domain.VacancySkill) *ScorerMockScoreExpectation {
+func (mmScore *mScorerMockScore) When(resumeText string, skills []domain.VacancySkill, policy domain.ScoringPolicy) *ScorerMockScoreExpectation {
 	if mmScore.mock.funcScore != nil {
 		mmScore.mock.t.Fatalf("ScorerMock.Score mock is already set by Set")
 	}
 
 	expectation := &ScorerMockScoreExpectation{
 		mock:               mmScore.mock,
-		params:             &ScorerMockScoreParams{resumeText, skills},
+		params:             &ScorerMockScoreParams{resumeText, skills, policy},
 		expectationOrigins: ScorerMockScoreExpectationOrigins{origin: minimock.CallerInfo(1)},
 	}
 	mmScore.expectations = append(mmScore.expectations, expectation)
@@ -253,17 +279,17 @@ func (mmScore *mScorerMockScore) invocationsDone() bool {
 }
 
 // Score implements mm_usecase.Scorer
-func (mmScore *ScorerMock) Score(resumeText string, skills []domain.VacancySkill) (a1 domain.AnalysisPayload) {
+func (mmScore *ScorerMock) Score(resumeText string, skills []domain.VacancySkill, policy domain.ScoringPolicy) (a1 domain.AnalysisPayload) {
 	mm_atomic.AddUint64(&mmScore.beforeScoreCounter, 1)
 	defer mm_atomic.AddUint64(&mmScore.afterScoreCounter, 1)
 
 	mmScore.t.Helper()
 
 	if mmScore.inspectFuncScore != nil {
-		mmScore.inspectFuncScore(resumeText, skills)
+		mmScore.inspectFuncScore(resumeText, skills, policy)
 	}
 
-	mm_params := ScorerMockScoreParams{resumeText, skills}
+	mm_params := ScorerMockScoreParams{resumeText, skills, policy}
 
 	// Record call args
 	mmScore.ScoreMock.mutex.Lock()
@@ -282,7 +308,7 @@ func (mmScore *ScorerMock) Score(resumeText string, skills []domain.VacancySkill
 		mm_want := mmScore.ScoreMock.defaultExpectation.params
 		mm_want_ptrs := mmScore.ScoreMock.defaultExpectation.paramPtrs
 
-		mm_got := ScorerMockScoreParams{resumeText, skills}
+		mm_got := ScorerMockScoreParams{resumeText, skills, policy}
 
 		if mm_want_ptrs != nil {
 
@@ -296,6 +322,11 @@ func (mmScore *ScorerMock) Score(resumeText string, skills []domain.VacancySkill
 					mmScore.ScoreMock.defaultExpectation.expectationOrigins.originSkills, *mm_want_ptrs.skills, mm_got.skills, minimock.Diff(*mm_want_ptrs.skills, mm_got.skills))
 			}
 
+			if mm_want_ptrs.policy != nil && !minimock.Equal(*mm_want_ptrs.policy, mm_got.policy) {
+				mmScore.t.Errorf("ScorerMock.Score got unexpected parameter policy, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmScore.ScoreMock.defaultExpectation.expectationOrigins.originPolicy, *mm_want_ptrs.policy, mm_got.policy, minimock.Diff(*mm_want_ptrs.policy, mm_got.policy))
+			}
+
 		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
 			mmScore.t.Errorf("ScorerMock.Score got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
 				mmScore.ScoreMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
@@ -308,9 +339,9 @@ func (mmScore *ScorerMock) Score(resumeText string, skills []domain.VacancySkill
 		return (*mm_results).a1
 	}
 	if mmScore.funcScore != nil {
-		return mmScore.funcScore(resumeText, skills)
+		return mmScore.funcScore(resumeText, skills, policy)
 	}
-	mmScore.t.Fatalf("Unexpected call to ScorerMock.Score. %v %v", resumeText, skills)
+	mmScore.t.Fatalf("Unexpected call to ScorerMock.Score. %v %v %v", resumeText, skills, policy)
 	return
 }
 
@@ -0,0 +1,741 @@
+// Code generated by http://github.com/gojuno/minimock (v3.4.7). DO NOT EDIT.
+
+package mocks
+
+import (
+	"context"
+	"sync"
+	mm_atomic "sync/atomic"
+	mm_time "time"
+
+	"github.com/artem13815/hr/analysis/internal/domain"
+	"github.com/gojuno/minimock/v3"
+)
+
+// VacancyEventInboxMock implements mm_usecase.VacancyEventInbox
+type VacancyEventInboxMock struct {
+	t          minimock.Tester
+	finishOnce sync.Once
+
+	funcLastVacancyEventSeq          func(ctx context.Context) (u1 uint64, err error)
+	funcLastVacancyEventSeqOrigin    string
+	inspectFuncLastVacancyEventSeq   func(ctx context.Context)
+	afterLastVacancyEventSeqCounter  uint64
+	beforeLastVacancyEventSeqCounter uint64
+	LastVacancyEventSeqMock          mVacancyEventInboxMockLastVacancyEventSeq
+
+	funcRecordVacancyEvent          func(ctx context.Context, ev domain.VacancyEvent) (b1 bool, err error)
+	funcRecordVacancyEventOrigin    string
+	inspectFuncRecordVacancyEvent   func(ctx context.Context, ev domain.VacancyEvent)
+	afterRecordVacancyEventCounter  uint64
+	beforeRecordVacancyEventCounter uint64
+	RecordVacancyEventMock          mVacancyEventInboxMockRecordVacancyEvent
+}
+
+// NewVacancyEventInboxMock returns a mock for mm_usecase.VacancyEventInbox
+func NewVacancyEventInboxMock(t minimock.Tester) *VacancyEventInboxMock {
+	m := &VacancyEventInboxMock{t: t}
+
+	if controller, ok := t.(minimock.MockController); ok {
+		controller.RegisterMocker(m)
+	}
+
+	m.LastVacancyEventSeqMock = mVacancyEventInboxMockLastVacancyEventSeq{mock: m}
+	m.LastVacancyEventSeqMock.callArgs = []*VacancyEventInboxMockLastVacancyEventSeqParams{}
+
+	m.RecordVacancyEventMock = mVacancyEventInboxMockRecordVacancyEvent{mock: m}
+	m.RecordVacancyEventMock.callArgs = []*VacancyEventInboxMockRecordVacancyEventParams{}
+
+	t.Cleanup(m.MinimockFinish)
+
+	return m
+}
+
+type mVacancyEventInboxMockLastVacancyEventSeq struct {
+	optional           bool
+	mock               *VacancyEventInboxMock
+	defaultExpectation *VacancyEventInboxMockLastVacancyEventSeqExpectation
+	expectations       []*VacancyEventInboxMockLastVacancyEventSeqExpectation
+
+	callArgs []*VacancyEventInboxMockLastVacancyEventSeqParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// VacancyEventInboxMockLastVacancyEventSeqExpectation specifies expectation struct of the VacancyEventInbox.LastVacancyEventSeq
+type VacancyEventInboxMockLastVacancyEventSeqExpectation struct {
+	mock               *VacancyEventInboxMock
+	params             *VacancyEventInboxMockLastVacancyEventSeqParams
+	paramPtrs          *VacancyEventInboxMockLastVacancyEventSeqParamPtrs
+	expectationOrigins VacancyEventInboxMockLastVacancyEventSeqExpectationOrigins
+	results            *VacancyEventInboxMockLastVacancyEventSeqResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// VacancyEventInboxMockLastVacancyEventSeqParams contains parameters of the VacancyEventInbox.LastVacancyEventSeq
+type VacancyEventInboxMockLastVacancyEventSeqParams struct {
+	ctx context.Context
+}
+
+// VacancyEventInboxMockLastVacancyEventSeqParamPtrs contains pointers to parameters of the VacancyEventInbox.LastVacancyEventSeq
+type VacancyEventInboxMockLastVacancyEventSeqParamPtrs struct {
+	ctx *context.Context
+}
+
+// VacancyEventInboxMockLastVacancyEventSeqResults contains results of the VacancyEventInbox.LastVacancyEventSeq
+type VacancyEventInboxMockLastVacancyEventSeqResults struct {
+	u1  uint64
+	err error
+}
+
+// VacancyEventInboxMockLastVacancyEventSeqOrigins contains origins of expectations of the VacancyEventInbox.LastVacancyEventSeq
+type VacancyEventInboxMockLastVacancyEventSeqExpectationOrigins struct {
+	origin    string
+	originCtx string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmLastVacancyEventSeq *mVacancyEventInboxMockLastVacancyEventSeq) Optional() *mVacancyEventInboxMockLastVacancyEventSeq {
+	mmLastVacancyEventSeq.optional = true
+	return mmLastVacancyEventSeq
+}
+
+// Expect sets up expected params for VacancyEventInbox.LastVacancyEventSeq
+func (mmLastVacancyEventSeq *mVacancyEventInboxMockLastVacancyEventSeq) Expect(ctx context.Context) *mVacancyEventInboxMockLastVacancyEventSeq {
+	if mmLastVacancyEventSeq.mock.funcLastVacancyEventSeq != nil {
+		mmLastVacancyEventSeq.mock.t.Fatalf("VacancyEventInboxMock.LastVacancyEventSeq mock is already set by Set")
+	}
+
+	if mmLastVacancyEventSeq.defaultExpectation == nil {
+		mmLastVacancyEventSeq.defaultExpectation = &VacancyEventInboxMockLastVacancyEventSeqExpectation{}
+	}
+
+	if mmLastVacancyEventSeq.defaultExpectation.paramPtrs != nil {
+		mmLastVacancyEventSeq.mock.t.Fatalf("VacancyEventInboxMock.LastVacancyEventSeq mock is already set by ExpectParams functions")
+	}
+
+	mmLastVacancyEventSeq.defaultExpectation.params = &VacancyEventInboxMockLastVacancyEventSeqParams{ctx}
+	mmLastVacancyEventSeq.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmLastVacancyEventSeq.expectations {
+		if minimock.Equal(e.params, mmLastVacancyEventSeq.defaultExpectation.params) {
+			mmLastVacancyEventSeq.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmLastVacancyEventSeq.defaultExpectation.params)
+		}
+	}
+
+	return mmLastVacancyEventSeq
+}
+
+// ExpectCtxParam1 sets up expected param ctx for VacancyEventInbox.LastVacancyEventSeq
+func (mmLastVacancyEventSeq *mVacancyEventInboxMockLastVacancyEventSeq) ExpectCtxParam1(ctx context.Context) *mVacancyEventInboxMockLastVacancyEventSeq {
+	if mmLastVacancyEventSeq.mock.funcLastVacancyEventSeq != nil {
+		mmLastVacancyEventSeq.mock.t.Fatalf("VacancyEventInboxMock.LastVacancyEventSeq mock is already set by Set")
+	}
+
+	if mmLastVacancyEventSeq.defaultExpectation == nil {
+		mmLastVacancyEventSeq.defaultExpectation = &VacancyEventInboxMockLastVacancyEventSeqExpectation{}
+	}
+
+	if mmLastVacancyEventSeq.defaultExpectation.params != nil {
+		mmLastVacancyEventSeq.mock.t.Fatalf("VacancyEventInboxMock.LastVacancyEventSeq mock is already set by Expect")
+	}
+
+	if mmLastVacancyEventSeq.defaultExpectation.paramPtrs == nil {
+		mmLastVacancyEventSeq.defaultExpectation.paramPtrs = &VacancyEventInboxMockLastVacancyEventSeqParamPtrs{}
+	}
+	mmLastVacancyEventSeq.defaultExpectation.paramPtrs.ctx = &ctx
+	mmLastVacancyEventSeq.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmLastVacancyEventSeq
+}
+
+// Inspect accepts an inspector function that has same arguments as the VacancyEventInbox.LastVacancyEventSeq
+func (mmLastVacancyEventSeq *mVacancyEventInboxMockLastVacancyEventSeq) Inspect(f func(ctx context.Context)) *mVacancyEventInboxMockLastVacancyEventSeq {
+	if mmLastVacancyEventSeq.mock.inspectFuncLastVacancyEventSeq != nil {
+		mmLastVacancyEventSeq.mock.t.Fatalf("Inspect function is already set for VacancyEventInboxMock.LastVacancyEventSeq")
+	}
+
+	mmLastVacancyEventSeq.mock.inspectFuncLastVacancyEventSeq = f
+
+	return mmLastVacancyEventSeq
+}
+
+// Return sets up results that will be returned by VacancyEventInbox.LastVacancyEventSeq
+func (mmLastVacancyEventSeq *mVacancyEventInboxMockLastVacancyEventSeq) Return(u1 uint64, err error) *VacancyEventInboxMock {
+	if mmLastVacancyEventSeq.mock.funcLastVacancyEventSeq != nil {
+		mmLastVacancyEventSeq.mock.t.Fatalf("VacancyEventInboxMock.LastVacancyEventSeq mock is already set by Set")
+	}
+
+	if mmLastVacancyEventSeq.defaultExpectation == nil {
+		mmLastVacancyEventSeq.defaultExpectation = &VacancyEventInboxMockLastVacancyEventSeqExpectation{mock: mmLastVacancyEventSeq.mock}
+	}
+	mmLastVacancyEventSeq.defaultExpectation.results = &VacancyEventInboxMockLastVacancyEventSeqResults{u1, err}
+	mmLastVacancyEventSeq.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmLastVacancyEventSeq.mock
+}
+
+// Set uses given function f to mock the VacancyEventInbox.LastVacancyEventSeq method
+func (mmLastVacancyEventSeq *mVacancyEventInboxMockLastVacancyEventSeq) Set(f func(ctx context.Context) (u1 uint64, err error)) *VacancyEventInboxMock {
+	if mmLastVacancyEventSeq.defaultExpectation != nil {
+		mmLastVacancyEventSeq.mock.t.Fatalf("Default expectation is already set for the VacancyEventInbox.LastVacancyEventSeq method")
+	}
+
+	if len(mmLastVacancyEventSeq.expectations) > 0 {
+		mmLastVacancyEventSeq.mock.t.Fatalf("Some expectations are already set for the VacancyEventInbox.LastVacancyEventSeq method")
+	}
+
+	mmLastVacancyEventSeq.mock.funcLastVacancyEventSeq = f
+	mmLastVacancyEventSeq.mock.funcLastVacancyEventSeqOrigin = minimock.CallerInfo(1)
+	return mmLastVacancyEventSeq.mock
+}
+
+// When sets expectation for the VacancyEventInbox.LastVacancyEventSeq which will trigger the result defined by the following
+// Then helper
+func (mmLastVacancyEventSeq *mVacancyEventInboxMockLastVacancyEventSeq) When(ctx context.Context) *VacancyEventInboxMockLastVacancyEventSeqExpectation {
+	if mmLastVacancyEventSeq.mock.funcLastVacancyEventSeq != nil {
+		mmLastVacancyEventSeq.mock.t.Fatalf("VacancyEventInboxMock.LastVacancyEventSeq mock is already set by Set")
+	}
+
+	expectation := &VacancyEventInboxMockLastVacancyEventSeqExpectation{
+		mock:               mmLastVacancyEventSeq.mock,
+		params:             &VacancyEventInboxMockLastVacancyEventSeqParams{ctx},
+		expectationOrigins: VacancyEventInboxMockLastVacancyEventSeqExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmLastVacancyEventSeq.expectations = append(mmLastVacancyEventSeq.expectations, expectation)
+	return expectation
+}
+
+// Then sets up VacancyEventInbox.LastVacancyEventSeq return parameters for the expectation previously defined by the When method
+func (e *VacancyEventInboxMockLastVacancyEventSeqExpectation) Then(u1 uint64, err error) *VacancyEventInboxMock {
+	e.results = &VacancyEventInboxMockLastVacancyEventSeqResults{u1, err}
+	return e.mock
+}
+
+// Times sets number of times VacancyEventInbox.LastVacancyEventSeq should be invoked
+func (mmLastVacancyEventSeq *mVacancyEventInboxMockLastVacancyEventSeq) Times(n uint64) *mVacancyEventInboxMockLastVacancyEventSeq {
+	if n == 0 {
+		mmLastVacancyEventSeq.mock.t.Fatalf("Times of VacancyEventInboxMock.LastVacancyEventSeq mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmLastVacancyEventSeq.expectedInvocations, n)
+	mmLastVacancyEventSeq.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmLastVacancyEventSeq
+}
+
+func (mmLastVacancyEventSeq *mVacancyEventInboxMockLastVacancyEventSeq) invocationsDone() bool {
+	if len(mmLastVacancyEventSeq.expectations) == 0 && mmLastVacancyEventSeq.defaultExpectation == nil && mmLastVacancyEventSeq.mock.funcLastVacancyEventSeq == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmLastVacancyEventSeq.mock.afterLastVacancyEventSeqCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmLastVacancyEventSeq.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// LastVacancyEventSeq implements mm_usecase.VacancyEventInbox
+func (mmLastVacancyEventSeq *VacancyEventInboxMock) LastVacancyEventSeq(ctx context.Context) (u1 uint64, err error) {
+	mm_atomic.AddUint64(&mmLastVacancyEventSeq.beforeLastVacancyEventSeqCounter, 1)
+	defer mm_atomic.AddUint64(&mmLastVacancyEventSeq.afterLastVacancyEventSeqCounter, 1)
+
+	mmLastVacancyEventSeq.t.Helper()
+
+	if mmLastVacancyEventSeq.inspectFuncLastVacancyEventSeq != nil {
+		mmLastVacancyEventSeq.inspectFuncLastVacancyEventSeq(ctx)
+	}
+
+	mm_params := VacancyEventInboxMockLastVacancyEventSeqParams{ctx}
+
+	// Record call args
+	mmLastVacancyEventSeq.LastVacancyEventSeqMock.mutex.Lock()
+	mmLastVacancyEventSeq.LastVacancyEventSeqMock.callArgs = append(mmLastVacancyEventSeq.LastVacancyEventSeqMock.callArgs, &mm_params)
+	mmLastVacancyEventSeq.LastVacancyEventSeqMock.mutex.Unlock()
+
+	for _, e := range mmLastVacancyEventSeq.LastVacancyEventSeqMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.u1, e.results.err
+		}
+	}
+
+	if mmLastVacancyEventSeq.LastVacancyEventSeqMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmLastVacancyEventSeq.LastVacancyEventSeqMock.defaultExpectation.Counter, 1)
+		mm_want := mmLastVacancyEventSeq.LastVacancyEventSeqMock.defaultExpectation.params
+		mm_want_ptrs := mmLastVacancyEventSeq.LastVacancyEventSeqMock.defaultExpectation.paramPtrs
+
+		mm_got := VacancyEventInboxMockLastVacancyEventSeqParams{ctx}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmLastVacancyEventSeq.t.Errorf("VacancyEventInboxMock.LastVacancyEventSeq got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmLastVacancyEventSeq.LastVacancyEventSeqMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmLastVacancyEventSeq.t.Errorf("VacancyEventInboxMock.LastVacancyEventSeq got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmLastVacancyEventSeq.LastVacancyEventSeqMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmLastVacancyEventSeq.LastVacancyEventSeqMock.defaultExpectation.results
+		if mm_results == nil {
+			mmLastVacancyEventSeq.t.Fatal("No results are set for the VacancyEventInboxMock.LastVacancyEventSeq")
+		}
+		return (*mm_results).u1, (*mm_results).err
+	}
+	if mmLastVacancyEventSeq.funcLastVacancyEventSeq != nil {
+		return mmLastVacancyEventSeq.funcLastVacancyEventSeq(ctx)
+	}
+	mmLastVacancyEventSeq.t.Fatalf("Unexpected call to VacancyEventInboxMock.LastVacancyEventSeq. %v", ctx)
+	return
+}
+
+// LastVacancyEventSeqAfterCounter returns a count of finished VacancyEventInboxMock.LastVacancyEventSeq invocations
+func (mmLastVacancyEventSeq *VacancyEventInboxMock) LastVacancyEventSeqAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmLastVacancyEventSeq.afterLastVacancyEventSeqCounter)
+}
+
+// LastVacancyEventSeqBeforeCounter returns a count of VacancyEventInboxMock.LastVacancyEventSeq invocations
+func (mmLastVacancyEventSeq *VacancyEventInboxMock) LastVacancyEventSeqBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmLastVacancyEventSeq.beforeLastVacancyEventSeqCounter)
+}
+
+// Calls returns a list of arguments used in each call to VacancyEventInboxMock.LastVacancyEventSeq.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmLastVacancyEventSeq *mVacancyEventInboxMockLastVacancyEventSeq) Calls() []*VacancyEventInboxMockLastVacancyEventSeqParams {
+	mmLastVacancyEventSeq.mutex.RLock()
+
+	argCopy := make([]*VacancyEventInboxMockLastVacancyEventSeqParams, len(mmLastVacancyEventSeq.callArgs))
+	copy(argCopy, mmLastVacancyEventSeq.callArgs)
+
+	mmLastVacancyEventSeq.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockLastVacancyEventSeqDone returns true if the count of the LastVacancyEventSeq invocations corresponds
+// the number of defined expectations
+func (m *VacancyEventInboxMock) MinimockLastVacancyEventSeqDone() bool {
+	if m.LastVacancyEventSeqMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.LastVacancyEventSeqMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.LastVacancyEventSeqMock.invocationsDone()
+}
+
+// MinimockLastVacancyEventSeqInspect logs each unmet expectation
+func (m *VacancyEventInboxMock) MinimockLastVacancyEventSeqInspect() {
+	for _, e := range m.LastVacancyEventSeqMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to VacancyEventInboxMock.LastVacancyEventSeq at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterLastVacancyEventSeqCounter := mm_atomic.LoadUint64(&m.afterLastVacancyEventSeqCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.LastVacancyEventSeqMock.defaultExpectation != nil && afterLastVacancyEventSeqCounter < 1 {
+		if m.LastVacancyEventSeqMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to VacancyEventInboxMock.LastVacancyEventSeq at\n%s", m.LastVacancyEventSeqMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to VacancyEventInboxMock.LastVacancyEventSeq at\n%s with params: %#v", m.LastVacancyEventSeqMock.defaultExpectation.expectationOrigins.origin, *m.LastVacancyEventSeqMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcLastVacancyEventSeq != nil && afterLastVacancyEventSeqCounter < 1 {
+		m.t.Errorf("Expected call to VacancyEventInboxMock.LastVacancyEventSeq at\n%s", m.funcLastVacancyEventSeqOrigin)
+	}
+
+	if !m.LastVacancyEventSeqMock.invocationsDone() && afterLastVacancyEventSeqCounter > 0 {
+		m.t.Errorf("Expected %d calls to VacancyEventInboxMock.LastVacancyEventSeq at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.LastVacancyEventSeqMock.expectedInvocations), m.LastVacancyEventSeqMock.expectedInvocationsOrigin, afterLastVacancyEventSeqCounter)
+	}
+}
+
+type mVacancyEventInboxMockRecordVacancyEvent struct {
+	optional           bool
+	mock               *VacancyEventInboxMock
+	defaultExpectation *VacancyEventInboxMockRecordVacancyEventExpectation
+	expectations       []*VacancyEventInboxMockRecordVacancyEventExpectation
+
+	callArgs []*VacancyEventInboxMockRecordVacancyEventParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// VacancyEventInboxMockRecordVacancyEventExpectation specifies expectation struct of the VacancyEventInbox.RecordVacancyEvent
+type VacancyEventInboxMockRecordVacancyEventExpectation struct {
+	mock               *VacancyEventInboxMock
+	params             *VacancyEventInboxMockRecordVacancyEventParams
+	paramPtrs          *VacancyEventInboxMockRecordVacancyEventParamPtrs
+	expectationOrigins VacancyEventInboxMockRecordVacancyEventExpectationOrigins
+	results            *VacancyEventInboxMockRecordVacancyEventResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// VacancyEventInboxMockRecordVacancyEventParams contains parameters of the VacancyEventInbox.RecordVacancyEvent
+type VacancyEventInboxMockRecordVacancyEventParams struct {
+	ctx context.Context
+	ev  domain.VacancyEvent
+}
+
+// VacancyEventInboxMockRecordVacancyEventParamPtrs contains pointers to parameters of the VacancyEventInbox.RecordVacancyEvent
+type VacancyEventInboxMockRecordVacancyEventParamPtrs struct {
+	ctx *context.Context
+	ev  *domain.VacancyEvent
+}
+
+// VacancyEventInboxMockRecordVacancyEventResults contains results of the VacancyEventInbox.RecordVacancyEvent
+type VacancyEventInboxMockRecordVacancyEventResults struct {
+	b1  bool
+	err error
+}
+
+// VacancyEventInboxMockRecordVacancyEventOrigins contains origins of expectations of the VacancyEventInbox.RecordVacancyEvent
+type VacancyEventInboxMockRecordVacancyEventExpectationOrigins struct {
+	origin    string
+	originCtx string
+	originEv  string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmRecordVacancyEvent *mVacancyEventInboxMockRecordVacancyEvent) Optional() *mVacancyEventInboxMockRecordVacancyEvent {
+	mmRecordVacancyEvent.optional = true
+	return mmRecordVacancyEvent
+}
+
+// Expect sets up expected params for VacancyEventInbox.RecordVacancyEvent
+func (mmRecordVacancyEvent *mVacancyEventInboxMockRecordVacancyEvent) Expect(ctx context.Context, ev domain.VacancyEvent) *mVacancyEventInboxMockRecordVacancyEvent {
+	if mmRecordVacancyEvent.mock.funcRecordVacancyEvent != nil {
+		mmRecordVacancyEvent.mock.t.Fatalf("VacancyEventInboxMock.RecordVacancyEvent mock is already set by Set")
+	}
+
+	if mmRecordVacancyEvent.defaultExpectation == nil {
+		mmRecordVacancyEvent.defaultExpectation = &VacancyEventInboxMockRecordVacancyEventExpectation{}
+	}
+
+	if mmRecordVacancyEvent.defaultExpectation.paramPtrs != nil {
+		mmRecordVacancyEvent.mock.t.Fatalf("VacancyEventInboxMock.RecordVacancyEvent mock is already set by ExpectParams functions")
+	}
+
+	mmRecordVacancyEvent.defaultExpectation.params = &VacancyEventInboxMockRecordVacancyEventParams{ctx, ev}
+	mmRecordVacancyEvent.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmRecordVacancyEvent.expectations {
+		if minimock.Equal(e.params, mmRecordVacancyEvent.defaultExpectation.params) {
+			mmRecordVacancyEvent.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmRecordVacancyEvent.defaultExpectation.params)
+		}
+	}
+
+	return mmRecordVacancyEvent
+}
+
+// ExpectCtxParam1 sets up expected param ctx for VacancyEventInbox.RecordVacancyEvent
+func (mmRecordVacancyEvent *mVacancyEventInboxMockRecordVacancyEvent) ExpectCtxParam1(ctx context.Context) *mVacancyEventInboxMockRecordVacancyEvent {
+	if mmRecordVacancyEvent.mock.funcRecordVacancyEvent != nil {
+		mmRecordVacancyEvent.mock.t.Fatalf("VacancyEventInboxMock.RecordVacancyEvent mock is already set by Set")
+	}
+
+	if mmRecordVacancyEvent.defaultExpectation == nil {
+		mmRecordVacancyEvent.defaultExpectation = &VacancyEventInboxMockRecordVacancyEventExpectation{}
+	}
+
+	if mmRecordVacancyEvent.defaultExpectation.params != nil {
+		mmRecordVacancyEvent.mock.t.Fatalf("VacancyEventInboxMock.RecordVacancyEvent mock is already set by Expect")
+	}
+
+	if mmRecordVacancyEvent.defaultExpectation.paramPtrs == nil {
+		mmRecordVacancyEvent.defaultExpectation.paramPtrs = &VacancyEventInboxMockRecordVacancyEventParamPtrs{}
+	}
+	mmRecordVacancyEvent.defaultExpectation.paramPtrs.ctx = &ctx
+	mmRecordVacancyEvent.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmRecordVacancyEvent
+}
+
+// ExpectEvParam2 sets up expected param ev for VacancyEventInbox.RecordVacancyEvent
+func (mmRecordVacancyEvent *mVacancyEventInboxMockRecordVacancyEvent) ExpectEvParam2(ev domain.VacancyEvent) *mVacancyEventInboxMockRecordVacancyEvent {
+	if mmRecordVacancyEvent.mock.funcRecordVacancyEvent != nil {
+		mmRecordVacancyEvent.mock.t.Fatalf("VacancyEventInboxMock.RecordVacancyEvent mock is already set by Set")
+	}
+
+	if mmRecordVacancyEvent.defaultExpectation == nil {
+		mmRecordVacancyEvent.defaultExpectation = &VacancyEventInboxMockRecordVacancyEventExpectation{}
+	}
+
+	if mmRecordVacancyEvent.defaultExpectation.params != nil {
+		mmRecordVacancyEvent.mock.t.Fatalf("VacancyEventInboxMock.RecordVacancyEvent mock is already set by Expect")
+	}
+
+	if mmRecordVacancyEvent.defaultExpectation.paramPtrs == nil {
+		mmRecordVacancyEvent.defaultExpectation.paramPtrs = &VacancyEventInboxMockRecordVacancyEventParamPtrs{}
+	}
+	mmRecordVacancyEvent.defaultExpectation.paramPtrs.ev = &ev
+	mmRecordVacancyEvent.defaultExpectation.expectationOrigins.originEv = minimock.CallerInfo(1)
+
+	return mmRecordVacancyEvent
+}
+
+// Inspect accepts an inspector function that has same arguments as the VacancyEventInbox.RecordVacancyEvent
+func (mmRecordVacancyEvent *mVacancyEventInboxMockRecordVacancyEvent) Inspect(f func(ctx context.Context, ev domain.VacancyEvent)) *mVacancyEventInboxMockRecordVacancyEvent {
+	if mmRecordVacancyEvent.mock.inspectFuncRecordVacancyEvent != nil {
+		mmRecordVacancyEvent.mock.t.Fatalf("Inspect function is already set for VacancyEventInboxMock.RecordVacancyEvent")
+	}
+
+	mmRecordVacancyEvent.mock.inspectFuncRecordVacancyEvent = f
+
+	return mmRecordVacancyEvent
+}
+
+// Return sets up results that will be returned by VacancyEventInbox.RecordVacancyEvent
+func (mmRecordVacancyEvent *mVacancyEventInboxMockRecordVacancyEvent) Return(b1 bool, err error) *VacancyEventInboxMock {
+	if mmRecordVacancyEvent.mock.funcRecordVacancyEvent != nil {
+		mmRecordVacancyEvent.mock.t.Fatalf("VacancyEventInboxMock.RecordVacancyEvent mock is already set by Set")
+	}
+
+	if mmRecordVacancyEvent.defaultExpectation == nil {
+		mmRecordVacancyEvent.defaultExpectation = &VacancyEventInboxMockRecordVacancyEventExpectation{mock: mmRecordVacancyEvent.mock}
+	}
+	mmRecordVacancyEvent.defaultExpectation.results = &VacancyEventInboxMockRecordVacancyEventResults{b1, err}
+	mmRecordVacancyEvent.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmRecordVacancyEvent.mock
+}
+
+// Set uses given function f to mock the VacancyEventInbox.RecordVacancyEvent method
+func (mmRecordVacancyEvent *mVacancyEventInboxMockRecordVacancyEvent) Set(f func(ctx context.Context, ev domain.VacancyEvent) (b1 bool, err error)) *VacancyEventInboxMock {
+	if mmRecordVacancyEvent.defaultExpectation != nil {
+		mmRecordVacancyEvent.mock.t.Fatalf("Default expectation is already set for the VacancyEventInbox.RecordVacancyEvent method")
+	}
+
+	if len(mmRecordVacancyEvent.expectations) > 0 {
+		mmRecordVacancyEvent.mock.t.Fatalf("Some expectations are already set for the VacancyEventInbox.RecordVacancyEvent method")
+	}
+
+	mmRecordVacancyEvent.mock.funcRecordVacancyEvent = f
+	mmRecordVacancyEvent.mock.funcRecordVacancyEventOrigin = minimock.CallerInfo(1)
+	return mmRecordVacancyEvent.mock
+}
+
+// When sets expectation for the VacancyEventInbox.RecordVacancyEvent which will trigger the result defined by the following
+// Then helper
+func (mmRecordVacancyEvent *mVacancyEventInboxMockRecordVacancyEvent) When(ctx context.Context, ev domain.VacancyEvent) *VacancyEventInboxMockRecordVacancyEventExpectation {
+	if mmRecordVacancyEvent.mock.funcRecordVacancyEvent != nil {
+		mmRecordVacancyEvent.mock.t.Fatalf("VacancyEventInboxMock.RecordVacancyEvent mock is already set by Set")
+	}
+
+	expectation := &VacancyEventInboxMockRecordVacancyEventExpectation{
+		mock:               mmRecordVacancyEvent.mock,
+		params:             &VacancyEventInboxMockRecordVacancyEventParams{ctx, ev},
+		expectationOrigins: VacancyEventInboxMockRecordVacancyEventExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmRecordVacancyEvent.expectations = append(mmRecordVacancyEvent.expectations, expectation)
+	return expectation
+}
+
+// Then sets up VacancyEventInbox.RecordVacancyEvent return parameters for the expectation previously defined by the When method
+func (e *VacancyEventInboxMockRecordVacancyEventExpectation) Then(b1 bool, err error) *VacancyEventInboxMock {
+	e.results = &VacancyEventInboxMockRecordVacancyEventResults{b1, err}
+	return e.mock
+}
+
+// Times sets number of times VacancyEventInbox.RecordVacancyEvent should be invoked
+func (mmRecordVacancyEvent *mVacancyEventInboxMockRecordVacancyEvent) Times(n uint64) *mVacancyEventInboxMockRecordVacancyEvent {
+	if n == 0 {
+		mmRecordVacancyEvent.mock.t.Fatalf("Times of VacancyEventInboxMock.RecordVacancyEvent mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmRecordVacancyEvent.expectedInvocations, n)
+	mmRecordVacancyEvent.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmRecordVacancyEvent
+}
+
+func (mmRecordVacancyEvent *mVacancyEventInboxMockRecordVacancyEvent) invocationsDone() bool {
+	if len(mmRecordVacancyEvent.expectations) == 0 && mmRecordVacancyEvent.defaultExpectation == nil && mmRecordVacancyEvent.mock.funcRecordVacancyEvent == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmRecordVacancyEvent.mock.afterRecordVacancyEventCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmRecordVacancyEvent.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// RecordVacancyEvent implements mm_usecase.VacancyEventInbox
+func (mmRecordVacancyEvent *VacancyEventInboxMock) RecordVacancyEvent(ctx context.Context, ev domain.VacancyEvent) (b1 bool, err error) {
+	mm_atomic.AddUint64(&mmRecordVacancyEvent.beforeRecordVacancyEventCounter, 1)
+	defer mm_atomic.AddUint64(&mmRecordVacancyEvent.afterRecordVacancyEventCounter, 1)
+
+	mmRecordVacancyEvent.t.Helper()
+
+	if mmRecordVacancyEvent.inspectFuncRecordVacancyEvent != nil {
+		mmRecordVacancyEvent.inspectFuncRecordVacancyEvent(ctx, ev)
+	}
+
+	mm_params := VacancyEventInboxMockRecordVacancyEventParams{ctx, ev}
+
+	// Record call args
+	mmRecordVacancyEvent.RecordVacancyEventMock.mutex.Lock()
+	mmRecordVacancyEvent.RecordVacancyEventMock.callArgs = append(mmRecordVacancyEvent.RecordVacancyEventMock.callArgs, &mm_params)
+	mmRecordVacancyEvent.RecordVacancyEventMock.mutex.Unlock()
+
+	for _, e := range mmRecordVacancyEvent.RecordVacancyEventMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.b1, e.results.err
+		}
+	}
+
+	if mmRecordVacancyEvent.RecordVacancyEventMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmRecordVacancyEvent.RecordVacancyEventMock.defaultExpectation.Counter, 1)
+		mm_want := mmRecordVacancyEvent.RecordVacancyEventMock.defaultExpectation.params
+		mm_want_ptrs := mmRecordVacancyEvent.RecordVacancyEventMock.defaultExpectation.paramPtrs
+
+		mm_got := VacancyEventInboxMockRecordVacancyEventParams{ctx, ev}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmRecordVacancyEvent.t.Errorf("VacancyEventInboxMock.RecordVacancyEvent got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmRecordVacancyEvent.RecordVacancyEventMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.ev != nil && !minimock.Equal(*mm_want_ptrs.ev, mm_got.ev) {
+				mmRecordVacancyEvent.t.Errorf("VacancyEventInboxMock.RecordVacancyEvent got unexpected parameter ev, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmRecordVacancyEvent.RecordVacancyEventMock.defaultExpectation.expectationOrigins.originEv, *mm_want_ptrs.ev, mm_got.ev, minimock.Diff(*mm_want_ptrs.ev, mm_got.ev))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmRecordVacancyEvent.t.Errorf("VacancyEventInboxMock.RecordVacancyEvent got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmRecordVacancyEvent.RecordVacancyEventMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmRecordVacancyEvent.RecordVacancyEventMock.defaultExpectation.results
+		if mm_results == nil {
+			mmRecordVacancyEvent.t.Fatal("No results are set for the VacancyEventInboxMock.RecordVacancyEvent")
+		}
+		return (*mm_results).b1, (*mm_results).err
+	}
+	if mmRecordVacancyEvent.funcRecordVacancyEvent != nil {
+		return mmRecordVacancyEvent.funcRecordVacancyEvent(ctx, ev)
+	}
+	mmRecordVacancyEvent.t.Fatalf("Unexpected call to VacancyEventInboxMock.RecordVacancyEvent. %v %v", ctx, ev)
+	return
+}
+
+// RecordVacancyEventAfterCounter returns a count of finished VacancyEventInboxMock.RecordVacancyEvent invocations
+func (mmRecordVacancyEvent *VacancyEventInboxMock) RecordVacancyEventAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmRecordVacancyEvent.afterRecordVacancyEventCounter)
+}
+
+// RecordVacancyEventBeforeCounter returns a count of VacancyEventInboxMock.RecordVacancyEvent invocations
+func (mmRecordVacancyEvent *VacancyEventInboxMock) RecordVacancyEventBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmRecordVacancyEvent.beforeRecordVacancyEventCounter)
+}
+
+// Calls returns a list of arguments used in each call to VacancyEventInboxMock.RecordVacancyEvent.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmRecordVacancyEvent *mVacancyEventInboxMockRecordVacancyEvent) Calls() []*VacancyEventInboxMockRecordVacancyEventParams {
+	mmRecordVacancyEvent.mutex.RLock()
+
+	argCopy := make([]*VacancyEventInboxMockRecordVacancyEventParams, len(mmRecordVacancyEvent.callArgs))
+	copy(argCopy, mmRecordVacancyEvent.callArgs)
+
+	mmRecordVacancyEvent.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockRecordVacancyEventDone returns true if the count of the RecordVacancyEvent invocations corresponds
+// the number of defined expectations
+func (m *VacancyEventInboxMock) MinimockRecordVacancyEventDone() bool {
+	if m.RecordVacancyEventMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.RecordVacancyEventMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.RecordVacancyEventMock.invocationsDone()
+}
+
+// MinimockRecordVacancyEventInspect logs each unmet expectation
+func (m *VacancyEventInboxMock) MinimockRecordVacancyEventInspect() {
+	for _, e := range m.RecordVacancyEventMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to VacancyEventInboxMock.RecordVacancyEvent at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterRecordVacancyEventCounter := mm_atomic.LoadUint64(&m.afterRecordVacancyEventCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.RecordVacancyEventMock.defaultExpectation != nil && afterRecordVacancyEventCounter < 1 {
+		if m.RecordVacancyEventMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to VacancyEventInboxMock.RecordVacancyEvent at\n%s", m.RecordVacancyEventMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to VacancyEventInboxMock.RecordVacancyEvent at\n%s with params: %#v", m.RecordVacancyEventMock.defaultExpectation.expectationOrigins.origin, *m.RecordVacancyEventMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcRecordVacancyEvent != nil && afterRecordVacancyEventCounter < 1 {
+		m.t.Errorf("Expected call to VacancyEventInboxMock.RecordVacancyEvent at\n%s", m.funcRecordVacancyEventOrigin)
+	}
+
+	if !m.RecordVacancyEventMock.invocationsDone() && afterRecordVacancyEventCounter > 0 {
+		m.t.Errorf("Expected %d calls to VacancyEventInboxMock.RecordVacancyEvent at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.RecordVacancyEventMock.expectedInvocations), m.RecordVacancyEventMock.expectedInvocationsOrigin, afterRecordVacancyEventCounter)
+	}
+}
+
+// MinimockFinish checks that all mocked methods have been called the expected number of times
+func (m *VacancyEventInboxMock) MinimockFinish() {
+	m.finishOnce.Do(func() {
+		if !m.minimockDone() {
+			m.MinimockLastVacancyEventSeqInspect()
+
+			m.MinimockRecordVacancyEventInspect()
+		}
+	})
+}
+
+// MinimockWait waits for all mocked methods to be called the expected number of times
+func (m *VacancyEventInboxMock) MinimockWait(timeout mm_time.Duration) {
+	timeoutCh := mm_time.After(timeout)
+	for {
+		if m.minimockDone() {
+			return
+		}
+		select {
+		case <-timeoutCh:
+			m.MinimockFinish()
+			return
+		case <-mm_time.After(10 * mm_time.Millisecond):
+		}
+	}
+}
+
+func (m *VacancyEventInboxMock) minimockDone() bool {
+	done := true
+	return done &&
+		m.MinimockLastVacancyEventSeqDone() &&
+		m.MinimockRecordVacancyEventDone()
+}
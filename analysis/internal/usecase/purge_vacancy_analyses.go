@@ -0,0 +1,29 @@
+package usecase
+
+import (
+	"context"
+	"strings"
+
+	"github.com/artem13815/hr/analysis/internal/domain"
+)
+
+// PurgeVacancyAnalyses is the analysis side of the vacancy retention job:
+// it hard-deletes every analysis of a vacancy whose grace period is over,
+// so the resume-derived profile and summary go with the resumes. The RPC
+// is internal, so there is no user to authorise; the storage only acts on
+// vacancies the vacancy service has marked for purge and anything else is
+// ErrNotFound. Repeating the call is safe.
+func (s *AnalysisService) PurgeVacancyAnalyses(ctx context.Context, vacancyID string) (*domain.PurgeVacancyAnalysesResult, error) {
+	if strings.TrimSpace(vacancyID) == "" {
+		return nil, ErrInvalidArgument
+	}
+
+	res, err := s.storage.PurgeVacancyAnalyses(ctx, vacancyID)
+	if err != nil {
+		return nil, err
+	}
+	if res == nil {
+		return nil, ErrNotFound
+	}
+	return res, nil
+}
@@ -0,0 +1,60 @@
+package usecase
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+	"gotest.tools/v3/assert"
+
+	"github.com/artem13815/hr/analysis/internal/domain"
+)
+
+type PurgeVacancyAnalysesSuite struct{ baseSuite }
+
+func (s *PurgeVacancyAnalysesSuite) TestPurges() {
+	t := s.T()
+	ctx := t.Context()
+	want := &domain.PurgeVacancyAnalysesResult{Analyses: 3}
+
+	s.storage.PurgeVacancyAnalysesMock.Expect(ctx, "v-1").Return(want, nil)
+
+	res, err := s.svc.PurgeVacancyAnalyses(ctx, "v-1")
+	assert.NilError(t, err)
+	assert.DeepEqual(t, res, want)
+}
+
+// TestNotPurging: a vacancy the vacancy service has not marked keeps its
+// analyses.
+func (s *PurgeVacancyAnalysesSuite) TestNotPurging() {
+	t := s.T()
+	ctx := t.Context()
+
+	s.storage.PurgeVacancyAnalysesMock.Expect(ctx, "v-1").Return(nil, nil)
+
+	res, err := s.svc.PurgeVacancyAnalyses(ctx, "v-1")
+	assert.ErrorIs(t, err, ErrNotFound)
+	assert.Assert(t, res == nil)
+}
+
+func (s *PurgeVacancyAnalysesSuite) TestInvalidArgument() {
+	t := s.T()
+
+	res, err := s.svc.PurgeVacancyAnalyses(t.Context(), "  ")
+	assert.ErrorIs(t, err, ErrInvalidArgument)
+	assert.Assert(t, res == nil)
+}
+
+func (s *PurgeVacancyAnalysesSuite) TestStorageError() {
+	t := s.T()
+	ctx := t.Context()
+	storageErr := errors.New("pgx: connection refused")
+
+	s.storage.PurgeVacancyAnalysesMock.Return(nil, storageErr)
+
+	res, err := s.svc.PurgeVacancyAnalyses(ctx, "v-1")
+	assert.ErrorIs(t, err, storageErr)
+	assert.Assert(t, res == nil)
+}
+
+func TestPurgeVacancyAnalysesSuite(t *testing.T) { suite.Run(t, new(PurgeVacancyAnalysesSuite)) }
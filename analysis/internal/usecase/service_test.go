@@ -53,10 +53,18 @@ func (m *multiagentClientStub) GenerateDecision(_ context.Context, in *pb_multia
 	return m.resp, m.err
 }
 
-// ClassifyRole is required by pb.MultiAgentServiceClient but never invoked
-// by analysis usecase (only vacancy calls it). The stub keeps the interface
+// ClassifyRole, SuggestSkills and ListRoles are required by pb.MultiAgentServiceClient
+// but never invoked by analysis usecase (only vacancy calls them). The stub keeps the interface
 // satisfied; if analysis ever starts using it, tests will need to assert on
 // the captured request like GenerateDecision does.
 func (m *multiagentClientStub) ClassifyRole(_ context.Context, _ *pb_multiagent.ClassifyRoleRequest, _ ...grpc.CallOption) (*pb_multiagent.ClassifyRoleResponse, error) {
 	return nil, nil
 }
+
+func (m *multiagentClientStub) SuggestSkills(_ context.Context, _ *pb_multiagent.SuggestSkillsRequest, _ ...grpc.CallOption) (*pb_multiagent.SuggestSkillsResponse, error) {
+	return nil, nil
+}
+
+func (m *multiagentClientStub) ListRoles(_ context.Context, _ *pb_multiagent.ListRolesRequest, _ ...grpc.CallOption) (*pb_multiagent.ListRolesResponse, error) {
+	return nil, nil
+}
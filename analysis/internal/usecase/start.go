@@ -42,13 +42,36 @@ func (s *AnalysisService) StartAnalysis(ctx context.Context, in domain.StartAnal
 	if vacancyID == "" {
 		return nil, ErrInvalidArgument
 	}
+	// Scoring a candidate against a different vacancy effectively adds
+	// them to it, so it is refused once that vacancy is closed. Re-scoring
+	// against the candidate's own vacancy stays allowed.
+	if vacancyID != rc.VacancyID {
+		status, err := s.storage.LoadVacancyStatus(ctx, vacancyID)
+		if err != nil {
+			return nil, err
+		}
+		if !domain.VacancyAcceptsCandidates(status) {
+			return nil, ErrVacancyClosed
+		}
+	}
+
+	return s.runAnalysis(ctx, rc, vacancyID, in.UseLLM)
+}
 
+// runAnalysis scores rc against vacancyID, saves the result and, when
+// useLLM is set, refreshes the AI decision. Callers have already checked
+// access to the resume and the vacancy.
+func (s *AnalysisService) runAnalysis(ctx context.Context, rc *domain.ResumeContext, vacancyID string, useLLM bool) (*domain.StartAnalysisResult, error) {
 	skills, err := s.storage.LoadVacancySkills(ctx, vacancyID)
 	if err != nil {
 		return nil, err
 	}
+	policy, err := s.storage.LoadScoringPolicy(ctx, vacancyID)
+	if err != nil {
+		return nil, err
+	}
 
-	payload := s.scorer.Score(rc.ResumeText, skills)
+	payload := s.scorer.Score(rc.ResumeText, skills, policy)
 
 	analysisID, err := s.storage.NewID()
 	if err != nil {
@@ -72,8 +95,8 @@ func (s *AnalysisService) StartAnalysis(ctx context.Context, in domain.StartAnal
 
 	res := &domain.StartAnalysisResult{AnalysisID: analysisID, Status: domain.StatusQueued}
 
-	if in.UseLLM && s.multiAgentClient != nil {
-		s.refreshAIDecisionAsync(ctx, analysisID, rc.VacancyRole, rc.ResumeText, payload)
+	if useLLM && s.multiAgentClient != nil {
+		s.refreshAIDecisionAsync(ctx, analysisID, rc, payload)
 	}
 
 	return res, nil
@@ -85,13 +108,14 @@ func (s *AnalysisService) StartAnalysis(ctx context.Context, in domain.StartAnal
 // the authoritative fallback, so failing here just means the row keeps the
 // heuristic AI it already has.
 //
-// role is forwarded so multiagent can pick the prompt for this vacancy
-// (empty -> default prompt; multiagent owns the fallback).
+// The vacancy role is forwarded so multiagent can pick the prompt for this
+// vacancy (empty -> default prompt; multiagent owns the fallback), along
+// with its seniority and salary range.
 //
 // Inlined into StartAnalysis flow synchronously today (not actually async)
 // because we want the freshly-saved row visible to the next read. If this
 // becomes too slow, lift it to a background worker fed by an outbox table.
-func (s *AnalysisService) refreshAIDecisionAsync(ctx context.Context, analysisID string, role string, resumeText string, payload domain.AnalysisPayload) {
+func (s *AnalysisService) refreshAIDecisionAsync(ctx context.Context, analysisID string, rc *domain.ResumeContext, payload domain.AnalysisPayload) {
 	// gRPC `string` fields must be valid UTF-8 — protobuf marshaling rejects
 	// otherwise. PDF extraction (pdftotext, ledongthuc fallback) occasionally
 	// emits stray bytes from glyphs without proper encoding. Sanitize every
@@ -105,7 +129,8 @@ func (s *AnalysisService) refreshAIDecisionAsync(ctx context.Context, analysisID
 		}
 		return out
 	}
-	resumeText = clean(resumeText)
+	role := rc.VacancyRole
+	resumeText := clean(rc.ResumeText)
 	candidateSkills := cleanSlice(payload.Profile.Skills)
 	missingSkills := cleanSlice(payload.Breakdown.MissingSkills)
 	matchedSkills := cleanSlice(payload.Breakdown.MatchedSkills)
@@ -132,7 +157,12 @@ func (s *AnalysisService) refreshAIDecisionAsync(ctx context.Context, analysisID
 		// Full resume text — date ranges, durations, and other context
 		// regex extractors miss. Required for the model to compute
 		// years_experience accurately.
-		ResumeText:        resumeText,
+		ResumeText: resumeText,
+
+		VacancySeniority:      rc.VacancySeniority,
+		VacancySalaryMin:      rc.VacancySalaryMin,
+		VacancySalaryMax:      rc.VacancySalaryMax,
+		VacancySalaryCurrency: rc.VacancySalaryCurrency,
 	})
 	if err != nil || maResp == nil {
 		slog.WarnContext(ctx, "multiagent call failed", "analysis_id", analysisID, "err", err, "resp_nil", maResp == nil)
@@ -0,0 +1,33 @@
+package usecase
+
+import (
+	"context"
+	"strings"
+
+	"github.com/artem13815/hr/analysis/internal/domain"
+)
+
+// StartApplicationAnalysis analyses a resume that arrived through the public
+// apply form. There is no end user behind the call — the resume service
+// triggers it right after storing the application — so the resume is loaded
+// with admin visibility and the run is refused for any candidate that did
+// not come from public apply. That keeps the unauthenticated internal RPC
+// from becoming a way to re-run (and pay for) arbitrary analyses.
+func (s *AnalysisService) StartApplicationAnalysis(ctx context.Context, resumeID string) (*domain.StartAnalysisResult, error) {
+	if strings.TrimSpace(resumeID) == "" {
+		return nil, ErrInvalidArgument
+	}
+
+	rc, err := s.storage.LoadResumeContext(ctx, resumeID, 0, true)
+	if err != nil {
+		return nil, err
+	}
+	if rc == nil || rc.CandidateSource != domain.SourcePublicApply {
+		return nil, ErrNotFound
+	}
+	if rc.VacancyID == "" {
+		return nil, ErrInvalidArgument
+	}
+
+	return s.runAnalysis(ctx, rc, rc.VacancyID, true)
+}
@@ -0,0 +1,116 @@
+package usecase
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+	"gotest.tools/v3/assert"
+
+	"github.com/artem13815/hr/analysis/internal/domain"
+)
+
+type StartApplicationAnalysisSuite struct{ baseSuite }
+
+func applicationResume() *domain.ResumeContext {
+	rc := happyResume()
+	rc.CandidateSource = domain.SourcePublicApply
+	return rc
+}
+
+func (s *StartApplicationAnalysisSuite) TestSuccess() {
+	t := s.T()
+	ctx := t.Context()
+	rc := applicationResume()
+	skills := []domain.VacancySkill{{Name: "go", Weight: 0.7, MustHave: true}}
+	payload := happyPayload()
+
+	s.storage.LoadResumeContextMock.Expect(ctx, "r-1", uint64(0), true).Return(rc, nil)
+	s.storage.LoadVacancySkillsMock.Expect(ctx, "v-ctx").Return(skills, nil)
+	s.storage.LoadScoringPolicyMock.Expect(ctx, "v-ctx").Return(domain.DefaultScoringPolicy(), nil)
+	s.scorer.ScoreMock.Expect(rc.ResumeText, skills, domain.DefaultScoringPolicy()).Return(payload)
+	s.storage.NewIDMock.Expect().Return("a-1", nil)
+	s.storage.SaveAnalysisMock.Expect(ctx, domain.SaveAnalysisInput{
+		AnalysisID:     "a-1",
+		VacancyID:      "v-ctx",
+		CandidateID:    "c-1",
+		ResumeID:       "r-1",
+		VacancyVersion: 3,
+		Status:         domain.StatusDone,
+		Score:          payload.Score,
+		Profile:        payload.Profile,
+		Breakdown:      payload.Breakdown,
+		AI:             payload.AI,
+	}).Return(nil)
+
+	res, err := s.svc.StartApplicationAnalysis(ctx, "r-1")
+	assert.NilError(t, err)
+	assert.DeepEqual(t, res, &domain.StartAnalysisResult{AnalysisID: "a-1", Status: domain.StatusQueued})
+}
+
+func (s *StartApplicationAnalysisSuite) TestUsesLLM() {
+	t := s.T()
+	ctx := t.Context()
+	stub := &multiagentClientStub{err: errors.New("llm down")}
+	svc := NewAnalysisService(s.storage, s.scorer, stub)
+
+	s.storage.LoadResumeContextMock.Return(applicationResume(), nil)
+	s.storage.LoadVacancySkillsMock.Return(nil, nil)
+	s.storage.LoadScoringPolicyMock.Return(domain.DefaultScoringPolicy(), nil)
+	s.scorer.ScoreMock.Return(happyPayload())
+	s.storage.NewIDMock.Return("a-1", nil)
+	s.storage.SaveAnalysisMock.Return(nil)
+
+	_, err := svc.StartApplicationAnalysis(ctx, "r-1")
+	assert.NilError(t, err)
+	assert.Assert(t, stub.called)
+	assert.Equal(t, stub.capturedReq.GetRole(), "programmer")
+}
+
+func (s *StartApplicationAnalysisSuite) TestEmptyResumeID() {
+	_, err := s.svc.StartApplicationAnalysis(s.T().Context(), "  ")
+	assert.ErrorIs(s.T(), err, ErrInvalidArgument)
+}
+
+// TestRefusesOtherSources: candidates HR added themselves are analysed
+// through the authenticated StartAnalysis only.
+func (s *StartApplicationAnalysisSuite) TestRefusesOtherSources() {
+	t := s.T()
+	rc := happyResume()
+	rc.CandidateSource = "resume_auto"
+	s.storage.LoadResumeContextMock.Return(rc, nil)
+
+	_, err := s.svc.StartApplicationAnalysis(t.Context(), "r-1")
+	assert.ErrorIs(t, err, ErrNotFound)
+}
+
+func (s *StartApplicationAnalysisSuite) TestResumeNotFound() {
+	t := s.T()
+	s.storage.LoadResumeContextMock.Return(nil, nil)
+
+	_, err := s.svc.StartApplicationAnalysis(t.Context(), "r-1")
+	assert.ErrorIs(t, err, ErrNotFound)
+}
+
+func (s *StartApplicationAnalysisSuite) TestNoVacancy() {
+	t := s.T()
+	rc := applicationResume()
+	rc.VacancyID = ""
+	s.storage.LoadResumeContextMock.Return(rc, nil)
+
+	_, err := s.svc.StartApplicationAnalysis(t.Context(), "r-1")
+	assert.ErrorIs(t, err, ErrInvalidArgument)
+}
+
+func (s *StartApplicationAnalysisSuite) TestLoadError() {
+	t := s.T()
+	loadErr := errors.New("db down")
+	s.storage.LoadResumeContextMock.Return(nil, loadErr)
+
+	_, err := s.svc.StartApplicationAnalysis(t.Context(), "r-1")
+	assert.ErrorIs(t, err, loadErr)
+}
+
+func TestStartApplicationAnalysisSuite(t *testing.T) {
+	suite.Run(t, new(StartApplicationAnalysisSuite))
+}
@@ -53,7 +53,8 @@ func (s *StartAnalysisSuite) TestSuccessUsesContextVacancyID() {
 
 	s.storage.LoadResumeContextMock.Expect(ctx, "r-1", uint64(7), false).Return(rc, nil)
 	s.storage.LoadVacancySkillsMock.Expect(ctx, "v-ctx").Return(skills, nil)
-	s.scorer.ScoreMock.Expect(rc.ResumeText, skills).Return(payload)
+	s.storage.LoadScoringPolicyMock.Expect(ctx, "v-ctx").Return(domain.DefaultScoringPolicy(), nil)
+	s.scorer.ScoreMock.Expect(rc.ResumeText, skills, domain.DefaultScoringPolicy()).Return(payload)
 	s.storage.NewIDMock.Expect().Return("a-1", nil)
 	s.storage.SaveAnalysisMock.Expect(ctx, domain.SaveAnalysisInput{
 		AnalysisID:     "a-1",
@@ -89,8 +90,10 @@ func (s *StartAnalysisSuite) TestSuccessOverridesVacancyID() {
 	payload := happyPayload()
 
 	s.storage.LoadResumeContextMock.Expect(ctx, "r-1", uint64(7), false).Return(rc, nil)
+	s.storage.LoadVacancyStatusMock.Expect(ctx, "v-override").Return("open", nil)
 	s.storage.LoadVacancySkillsMock.Expect(ctx, "v-override").Return(skills, nil)
-	s.scorer.ScoreMock.Expect(rc.ResumeText, skills).Return(payload)
+	s.storage.LoadScoringPolicyMock.Expect(ctx, "v-override").Return(domain.DefaultScoringPolicy(), nil)
+	s.scorer.ScoreMock.Expect(rc.ResumeText, skills, domain.DefaultScoringPolicy()).Return(payload)
 	s.storage.NewIDMock.Expect().Return("a-1", nil)
 	s.storage.SaveAnalysisMock.Inspect(func(_ context.Context, in domain.SaveAnalysisInput) {
 		assert.Equal(t, in.VacancyID, "v-override")
@@ -105,6 +108,24 @@ func (s *StartAnalysisSuite) TestSuccessOverridesVacancyID() {
 	assert.Equal(t, res.AnalysisID, "a-1")
 }
 
+// TestOverrideToClosedVacancyRefused: scoring against another vacancy
+// attaches the candidate to it, which a closed vacancy must refuse.
+func (s *StartAnalysisSuite) TestOverrideToClosedVacancyRefused() {
+	t := s.T()
+	ctx := t.Context()
+
+	s.storage.LoadResumeContextMock.Expect(ctx, "r-1", uint64(7), false).Return(happyResume(), nil)
+	s.storage.LoadVacancyStatusMock.Expect(ctx, "v-closed").Return("filled", nil)
+
+	res, err := s.svc.StartAnalysis(ctx, domain.StartAnalysisInput{
+		RequestUserID: 7,
+		ResumeID:      "r-1",
+		VacancyID:     "v-closed",
+	})
+	assert.ErrorIs(t, err, ErrVacancyClosed)
+	assert.Assert(t, res == nil)
+}
+
 func (s *StartAnalysisSuite) TestInvalidArgumentZeroUser() {
 	t := s.T()
 	res, err := s.svc.StartAnalysis(t.Context(), domain.StartAnalysisInput{ResumeID: "r-1"})
@@ -190,6 +211,53 @@ func (s *StartAnalysisSuite) TestLoadVacancySkillsError() {
 	assert.Assert(t, res == nil)
 }
 
+// TestScoresWithVacancyPolicy: the vacancy's own scoring policy, not the
+// defaults, reaches the scorer.
+func (s *StartAnalysisSuite) TestScoresWithVacancyPolicy() {
+	t := s.T()
+	ctx := t.Context()
+	rc := happyResume()
+	policy := domain.ScoringPolicy{
+		MustHavePenalty:         25,
+		NiceToHaveBonus:         0,
+		HireThreshold:           85,
+		MaybeThreshold:          60,
+		RejectOnMissingMustHave: true,
+	}
+
+	s.storage.LoadResumeContextMock.Expect(ctx, "r-1", uint64(7), false).Return(rc, nil)
+	s.storage.LoadVacancySkillsMock.Expect(ctx, "v-ctx").Return(nil, nil)
+	s.storage.LoadScoringPolicyMock.Expect(ctx, "v-ctx").Return(policy, nil)
+	s.scorer.ScoreMock.Expect(rc.ResumeText, nil, policy).Return(happyPayload())
+	s.storage.NewIDMock.Expect().Return("a-1", nil)
+	s.storage.SaveAnalysisMock.Return(nil)
+
+	res, err := s.svc.StartAnalysis(ctx, domain.StartAnalysisInput{
+		RequestUserID: 7,
+		ResumeID:      "r-1",
+	})
+	assert.NilError(t, err)
+	assert.Equal(t, res.AnalysisID, "a-1")
+}
+
+func (s *StartAnalysisSuite) TestLoadScoringPolicyError() {
+	t := s.T()
+	ctx := t.Context()
+	rc := happyResume()
+	storageErr := errors.New("pgx: down")
+
+	s.storage.LoadResumeContextMock.Expect(ctx, "r-1", uint64(7), false).Return(rc, nil)
+	s.storage.LoadVacancySkillsMock.Expect(ctx, "v-ctx").Return(nil, nil)
+	s.storage.LoadScoringPolicyMock.Expect(ctx, "v-ctx").Return(domain.ScoringPolicy{}, storageErr)
+
+	res, err := s.svc.StartAnalysis(ctx, domain.StartAnalysisInput{
+		RequestUserID: 7,
+		ResumeID:      "r-1",
+	})
+	assert.ErrorIs(t, err, storageErr)
+	assert.Assert(t, res == nil)
+}
+
 func (s *StartAnalysisSuite) TestNewIDError() {
 	t := s.T()
 	ctx := t.Context()
@@ -198,6 +266,7 @@ func (s *StartAnalysisSuite) TestNewIDError() {
 
 	s.storage.LoadResumeContextMock.Expect(ctx, "r-1", uint64(7), false).Return(rc, nil)
 	s.storage.LoadVacancySkillsMock.Expect(ctx, "v-ctx").Return(nil, nil)
+	s.storage.LoadScoringPolicyMock.Expect(ctx, "v-ctx").Return(domain.DefaultScoringPolicy(), nil)
 	s.scorer.ScoreMock.Return(happyPayload())
 	s.storage.NewIDMock.Expect().Return("", idErr)
 
@@ -217,6 +286,7 @@ func (s *StartAnalysisSuite) TestSaveAnalysisError() {
 
 	s.storage.LoadResumeContextMock.Expect(ctx, "r-1", uint64(7), false).Return(rc, nil)
 	s.storage.LoadVacancySkillsMock.Expect(ctx, "v-ctx").Return(nil, nil)
+	s.storage.LoadScoringPolicyMock.Expect(ctx, "v-ctx").Return(domain.DefaultScoringPolicy(), nil)
 	s.scorer.ScoreMock.Return(happyPayload())
 	s.storage.NewIDMock.Expect().Return("a-1", nil)
 	s.storage.SaveAnalysisMock.Return(saveErr)
@@ -240,6 +310,7 @@ func (s *StartAnalysisSuite) TestUseLLMTrueButNilClientSkipsFanOut() {
 
 	s.storage.LoadResumeContextMock.Expect(ctx, "r-1", uint64(7), false).Return(rc, nil)
 	s.storage.LoadVacancySkillsMock.Expect(ctx, "v-ctx").Return(nil, nil)
+	s.storage.LoadScoringPolicyMock.Expect(ctx, "v-ctx").Return(domain.DefaultScoringPolicy(), nil)
 	s.scorer.ScoreMock.Return(happyPayload())
 	s.storage.NewIDMock.Expect().Return("a-1", nil)
 	s.storage.SaveAnalysisMock.Return(nil)
@@ -261,10 +332,13 @@ func (s *StartAnalysisSuite) TestLLMFanOutOverwritesAIDecision() {
 	t := s.T()
 	ctx := t.Context()
 	rc := happyResume()
+	rc.VacancySeniority = "senior"
+	rc.VacancySalaryMin, rc.VacancySalaryMax, rc.VacancySalaryCurrency = 250000, 350000, "RUB"
 	payload := happyPayload()
 
 	s.storage.LoadResumeContextMock.Expect(ctx, "r-1", uint64(7), false).Return(rc, nil)
 	s.storage.LoadVacancySkillsMock.Expect(ctx, "v-ctx").Return(nil, nil)
+	s.storage.LoadScoringPolicyMock.Expect(ctx, "v-ctx").Return(domain.DefaultScoringPolicy(), nil)
 	s.scorer.ScoreMock.Return(payload)
 	s.storage.NewIDMock.Expect().Return("a-1", nil)
 	s.storage.SaveAnalysisMock.Return(nil)
@@ -305,6 +379,10 @@ func (s *StartAnalysisSuite) TestLLMFanOutOverwritesAIDecision() {
 	// Role from the joined vacancy must reach multiagent so it can select
 	// the right prompt — this is the whole point of the role plumbing.
 	assert.Equal(t, maStub.capturedReq.GetRole(), rc.VacancyRole)
+	assert.Equal(t, maStub.capturedReq.GetVacancySeniority(), "senior")
+	assert.Equal(t, maStub.capturedReq.GetVacancySalaryMin(), uint64(250000))
+	assert.Equal(t, maStub.capturedReq.GetVacancySalaryMax(), uint64(350000))
+	assert.Equal(t, maStub.capturedReq.GetVacancySalaryCurrency(), "RUB")
 }
 
 // TestLLMFanOutErrorIsSwallowed covers the fail-closed contract for LLM
@@ -319,6 +397,7 @@ func (s *StartAnalysisSuite) TestLLMFanOutErrorIsSwallowed() {
 
 	s.storage.LoadResumeContextMock.Expect(ctx, "r-1", uint64(7), false).Return(rc, nil)
 	s.storage.LoadVacancySkillsMock.Expect(ctx, "v-ctx").Return(nil, nil)
+	s.storage.LoadScoringPolicyMock.Expect(ctx, "v-ctx").Return(domain.DefaultScoringPolicy(), nil)
 	s.scorer.ScoreMock.Return(happyPayload())
 	s.storage.NewIDMock.Expect().Return("a-1", nil)
 	s.storage.SaveAnalysisMock.Return(nil)
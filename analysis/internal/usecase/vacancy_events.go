@@ -0,0 +1,59 @@
+package usecase
+
+//go:generate go run github.com/gojuno/minimock/v3/cmd/minimock@v3.4.7 -i VacancyEventInbox -o ./mocks -s _mock.go -g
+
+import (
+	"context"
+	"log/slog"
+
+	"github.com/artem13815/hr/analysis/internal/domain"
+)
+
+// VacancyEventInbox is the consumer-side record of handled vacancy events.
+// Implemented by internal/infrastructure/persistence.
+type VacancyEventInbox interface {
+	// RecordVacancyEvent reports false when the event ID is already
+	// recorded.
+	RecordVacancyEvent(ctx context.Context, ev domain.VacancyEvent) (bool, error)
+	LastVacancyEventSeq(ctx context.Context) (uint64, error)
+}
+
+// VacancyEventConsumer handles the vacancy event stream. Delivery is
+// at-least-once, so every event goes through the inbox first and repeats
+// are dropped there.
+type VacancyEventConsumer struct {
+	inbox VacancyEventInbox
+}
+
+func NewVacancyEventConsumer(inbox VacancyEventInbox) *VacancyEventConsumer {
+	return &VacancyEventConsumer{inbox: inbox}
+}
+
+// Cursor is the seq to resubscribe after.
+func (c *VacancyEventConsumer) Cursor(ctx context.Context) (uint64, error) {
+	return c.inbox.LastVacancyEventSeq(ctx)
+}
+
+// HandleVacancyEvent records ev and logs it once. Analysis reads vacancy
+// state live when it scores, so nothing else depends on the stream yet;
+// reactions to specific event types belong after the dedup check.
+func (c *VacancyEventConsumer) HandleVacancyEvent(ctx context.Context, ev domain.VacancyEvent) error {
+	fresh, err := c.inbox.RecordVacancyEvent(ctx, ev)
+	if err != nil {
+		return err
+	}
+	if !fresh {
+		slog.DebugContext(ctx, "duplicate vacancy event dropped", "event_id", ev.ID, "seq", ev.Seq)
+		return nil
+	}
+	slog.InfoContext(ctx, "vacancy event",
+		"event_id", ev.ID,
+		"seq", ev.Seq,
+		"type", ev.Type,
+		"vacancy_id", ev.VacancyID,
+		"version", ev.Version,
+		"status", ev.Status,
+		"old_status", ev.OldStatus,
+	)
+	return nil
+}
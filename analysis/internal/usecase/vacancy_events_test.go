@@ -0,0 +1,67 @@
+package usecase
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+	"gotest.tools/v3/assert"
+
+	"github.com/artem13815/hr/analysis/internal/domain"
+	"github.com/artem13815/hr/analysis/internal/usecase/mocks"
+)
+
+type VacancyEventConsumerSuite struct {
+	suite.Suite
+	inbox    *mocks.VacancyEventInboxMock
+	consumer *VacancyEventConsumer
+}
+
+func (s *VacancyEventConsumerSuite) SetupTest() {
+	s.inbox = mocks.NewVacancyEventInboxMock(s.T())
+	s.consumer = NewVacancyEventConsumer(s.inbox)
+}
+
+func (s *VacancyEventConsumerSuite) TestRecordsNewEvent() {
+	t := s.T()
+	ctx := t.Context()
+	ev := domain.VacancyEvent{Seq: 3, ID: "e-3", Type: domain.EventVacancyCreated, VacancyID: "v-1"}
+
+	s.inbox.RecordVacancyEventMock.Expect(ctx, ev).Return(true, nil)
+
+	assert.NilError(t, s.consumer.HandleVacancyEvent(ctx, ev))
+}
+
+func (s *VacancyEventConsumerSuite) TestDuplicateIsNotAnError() {
+	t := s.T()
+	ctx := t.Context()
+	ev := domain.VacancyEvent{Seq: 3, ID: "e-3", Type: domain.EventVacancyCreated, VacancyID: "v-1"}
+
+	s.inbox.RecordVacancyEventMock.Expect(ctx, ev).Return(false, nil)
+
+	assert.NilError(t, s.consumer.HandleVacancyEvent(ctx, ev))
+}
+
+func (s *VacancyEventConsumerSuite) TestInboxError() {
+	t := s.T()
+	ctx := t.Context()
+	boom := errors.New("db down")
+
+	s.inbox.RecordVacancyEventMock.Return(false, boom)
+
+	err := s.consumer.HandleVacancyEvent(ctx, domain.VacancyEvent{ID: "e-1"})
+	assert.ErrorIs(t, err, boom)
+}
+
+func (s *VacancyEventConsumerSuite) TestCursor() {
+	t := s.T()
+	ctx := t.Context()
+
+	s.inbox.LastVacancyEventSeqMock.Expect(ctx).Return(uint64(42), nil)
+
+	got, err := s.consumer.Cursor(ctx)
+	assert.NilError(t, err)
+	assert.Equal(t, got, uint64(42))
+}
+
+func TestVacancyEventConsumerSuite(t *testing.T) { suite.Run(t, new(VacancyEventConsumerSuite)) }
@@ -11,7 +11,7 @@ protoc -I ./api \
   -I "${GATEWAY_PATH}" \
   --go_out=./internal/pb --go_opt=paths=source_relative \
   --go-grpc_out=./internal/pb --go-grpc_opt=paths=source_relative \
-  ./api/common/common.proto ./api/models/analysis_model.proto ./api/analysis_api/analysis.proto ./api/multiagent_api/multiagent.proto ./api/auth_api/auth.proto
+  ./api/common/common.proto ./api/models/analysis_model.proto ./api/analysis_api/analysis.proto ./api/multiagent_api/multiagent.proto ./api/auth_api/auth.proto ./api/vacancy_events_api/vacancy_events.proto
 
 protoc -I ./api \
   -I ./api/google/api \
@@ -1,14 +1,31 @@
 import type {
   CreateVacancyInput,
   ListVacanciesParams,
+  PossibleDuplicate,
   UpdateVacancyInput,
   Vacancy,
   VacancyPage,
 } from '@/domain/vacancy/types'
 
+/**
+ * Thrown by `VacancyGateway.create` when nothing was created because open
+ * vacancies look like duplicates. Retrying with `force: true` creates the
+ * vacancy anyway.
+ */
+export class PossibleDuplicatesError extends Error {
+  readonly duplicates: PossibleDuplicate[]
+
+  constructor(duplicates: PossibleDuplicate[]) {
+    super('Similar open vacancies exist')
+    this.name = 'PossibleDuplicatesError'
+    this.duplicates = duplicates
+  }
+}
+
 export interface VacancyGateway {
   list(params: ListVacanciesParams): Promise<VacancyPage>
   get(id: string): Promise<Vacancy>
+  /** Rejects with `PossibleDuplicatesError` unless `input.force` is set. */
   create(input: CreateVacancyInput): Promise<Vacancy>
   update(input: UpdateVacancyInput): Promise<Vacancy>
   archive(id: string): Promise<void>
@@ -40,5 +40,5 @@ export type BatchIngestResult = {
 }
 
 /** Files we accept in the upload zone — extension hint, not validation. */
-export const ACCEPTED_RESUME_TYPES = '.pdf,.doc,.docx,.txt'
+export const ACCEPTED_RESUME_TYPES = '.pdf,.doc,.docx,.rtf,.odt,.txt,.md,.html,.htm'
 export const MAX_RESUME_BYTES = 10 * 1024 * 1024 // 10 MB
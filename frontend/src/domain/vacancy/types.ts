@@ -2,15 +2,26 @@ export type VacancyId = string
 
 /**
  * Backend exposes status as a numeric enum. The vacancy proto uses:
- *   0 — DRAFT, 1 — OPEN, 2 — ARCHIVED  (cf. beev/vacancy/api/models)
+ *   1 — OPEN, 2 — ARCHIVED, 3 — DRAFT, 4 — PAUSED, 5 — CLOSED, 6 — FILLED
+ * (cf. vacancy/api/models). 0 is UNSPECIFIED.
  * If the schema evolves we keep an `unknown` fallback so the UI never crashes.
  */
-export type VacancyStatus = 'draft' | 'open' | 'archived' | 'unknown'
+export type VacancyStatus =
+  | 'draft'
+  | 'open'
+  | 'paused'
+  | 'closed'
+  | 'filled'
+  | 'archived'
+  | 'unknown'
 
 export const VACANCY_STATUS_BY_CODE: Record<number, VacancyStatus> = {
-  0: 'draft',
   1: 'open',
   2: 'archived',
+  3: 'draft',
+  4: 'paused',
+  5: 'closed',
+  6: 'filled',
 }
 
 /**
@@ -21,6 +32,9 @@ export const VACANCY_STATUS_BY_CODE: Record<number, VacancyStatus> = {
 const VACANCY_STATUS_BY_NAME: Record<string, VacancyStatus> = {
   VACANCY_STATUS_DRAFT: 'draft',
   VACANCY_STATUS_OPEN: 'open',
+  VACANCY_STATUS_PAUSED: 'paused',
+  VACANCY_STATUS_CLOSED: 'closed',
+  VACANCY_STATUS_FILLED: 'filled',
   VACANCY_STATUS_ARCHIVED: 'archived',
   VACANCY_STATUS_UNSPECIFIED: 'unknown',
 }
@@ -102,6 +116,18 @@ export type CreateVacancyInput = {
   description: string
   role?: string
   skills: SkillWeight[]
+  /** Create even when open vacancies look like duplicates. */
+  force?: boolean
+}
+
+/**
+ * An open vacancy the backend thinks the one being created duplicates.
+ * `similarity` is in [0, 1]; the backend reports from 0.7 up.
+ */
+export type PossibleDuplicate = {
+  vacancyId: VacancyId
+  title: string
+  similarity: number
 }
 
 export type UpdateVacancyInput = {
@@ -1,14 +1,19 @@
-import type { VacancyGateway } from '@/application/vacancy/ports'
+import {
+  PossibleDuplicatesError,
+  type VacancyGateway,
+} from '@/application/vacancy/ports'
 import {
   parseVacancyStatus,
   type CreateVacancyInput,
   type ListVacanciesParams,
+  type PossibleDuplicate,
   type SkillWeight,
   type UpdateVacancyInput,
   type Vacancy,
   type VacancyPage,
 } from '@/domain/vacancy/types'
 import type { HttpClient } from '@/infrastructure/http/client'
+import { ApiError } from '@/infrastructure/http/errors'
 
 type SkillWeightDto = {
   name: string
@@ -37,6 +42,18 @@ type ListResponseDto = {
 
 type SingleResponseDto = { vacancy: VacancyDto }
 
+type VacancyDuplicateDto = {
+  vacancyId?: string
+  title?: string
+  similarity?: number
+}
+
+/** The `PossibleDuplicates` detail of a 409 from POST /api/v1/vacancies. */
+type PossibleDuplicatesDetailDto = {
+  '@type'?: string
+  duplicates?: VacancyDuplicateDto[]
+}
+
 const PATH = {
   base: '/api/v1/vacancies',
   byId: (id: string) => `/api/v1/vacancies/${encodeURIComponent(id)}`,
@@ -77,13 +94,20 @@ export class VacancyHttpGateway implements VacancyGateway {
   }
 
   async create(input: CreateVacancyInput): Promise<Vacancy> {
-    const dto = await this.http.post<SingleResponseDto>(PATH.base, {
-      title: input.title,
-      description: input.description,
-      role: input.role,
-      skills: input.skills.map(toSkillDto),
-    })
-    return toVacancy(dto.vacancy)
+    try {
+      const dto = await this.http.post<SingleResponseDto>(PATH.base, {
+        title: input.title,
+        description: input.description,
+        role: input.role,
+        skills: input.skills.map(toSkillDto),
+        force: input.force,
+      })
+      return toVacancy(dto.vacancy)
+    } catch (cause) {
+      const duplicates = possibleDuplicates(cause)
+      if (duplicates) throw new PossibleDuplicatesError(duplicates)
+      throw cause
+    }
   }
 
   async update(input: UpdateVacancyInput): Promise<Vacancy> {
@@ -138,6 +162,24 @@ function toSkillDto(skill: SkillWeight): SkillWeightDto {
   }
 }
 
+/**
+ * Reads the duplicates out of a 409 ALREADY_EXISTS. Any other error —
+ * including a 409 without the detail — is not a duplicate report.
+ */
+function possibleDuplicates(cause: unknown): PossibleDuplicate[] | null {
+  if (!(cause instanceof ApiError) || cause.status !== 409) return null
+  if (!Array.isArray(cause.details)) return null
+  const detail = (cause.details as PossibleDuplicatesDetailDto[]).find((d) =>
+    d['@type']?.endsWith('.PossibleDuplicates'),
+  )
+  if (!detail) return null
+  return (detail.duplicates ?? []).map((d) => ({
+    vacancyId: d.vacancyId ?? '',
+    title: d.title ?? '',
+    similarity: typeof d.similarity === 'number' ? d.similarity : 0,
+  }))
+}
+
 function parseTotal(raw: string | undefined): number {
   if (!raw) return 0
   const n = Number(raw)
@@ -1,3 +1,4 @@
+import { Link } from 'react-router-dom'
 import { useI18n } from '@/app/providers/I18nProvider'
 import { Button, Card, TextArea, TextInput } from '@/presentation/ui'
 import { SkillsEditor } from './SkillsEditor'
@@ -9,7 +10,15 @@ import { useVacancyForm } from './useVacancyForm'
  */
 export function VacancyForm({ onCancel }: { onCancel?: () => void }) {
   const { t } = useI18n()
-  const { values, errors, pending, updateField, submit } = useVacancyForm()
+  const {
+    values,
+    errors,
+    duplicates,
+    pending,
+    updateField,
+    submit,
+    createAnyway,
+  } = useVacancyForm()
 
   return (
     <Card variant="feature" elevated>
@@ -59,6 +68,40 @@ export function VacancyForm({ onCancel }: { onCancel?: () => void }) {
           </p>
         )}
 
+        {duplicates.length > 0 && (
+          <div
+            role="alert"
+            className="text-body-sm rounded-md bg-surface-soft px-4 py-3 flex flex-col gap-2"
+          >
+            <p>{t('form.duplicates.title')}</p>
+            <ul className="flex flex-col gap-1">
+              {duplicates.map((d) => (
+                <li key={d.vacancyId}>
+                  <Link
+                    to={`/vacancies/${d.vacancyId}`}
+                    className="underline"
+                  >
+                    {d.title}
+                  </Link>{' '}
+                  {t('form.duplicates.similarity', {
+                    percent: Math.round(d.similarity * 100),
+                  })}
+                </li>
+              ))}
+            </ul>
+            <div>
+              <Button
+                type="button"
+                variant="secondary-light"
+                onClick={createAnyway}
+                loading={pending}
+              >
+                {t('form.duplicates.createAnyway')}
+              </Button>
+            </div>
+          </div>
+        )}
+
         <div className="flex flex-wrap items-center gap-3">
           <Button type="submit" variant="primary-cta" loading={pending}>
             {t('form.submit')}
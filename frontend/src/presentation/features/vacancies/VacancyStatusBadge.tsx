@@ -17,6 +17,12 @@ export function VacancyStatusBadge({ status }: { status: VacancyStatus }) {
       return <BadgePill tone="down">{t('status.archived')}</BadgePill>
     case 'draft':
       return <BadgePill>{t('status.draft')}</BadgePill>
+    case 'paused':
+      return <BadgePill>{t('status.paused')}</BadgePill>
+    case 'closed':
+      return <BadgePill tone="down">{t('status.closed')}</BadgePill>
+    case 'filled':
+      return <BadgePill tone="up">{t('status.filled')}</BadgePill>
     default:
       return null
   }
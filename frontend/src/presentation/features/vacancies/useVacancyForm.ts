@@ -2,11 +2,16 @@ import { useState, type FormEvent } from 'react'
 import { useNavigate } from 'react-router-dom'
 import { useGateways } from '@/app/providers/GatewaysProvider'
 import { useI18n } from '@/app/providers/I18nProvider'
+import { PossibleDuplicatesError } from '@/application/vacancy/ports'
 import {
   vacancyFormSchema,
   type VacancyFormInput,
 } from '@/domain/vacancy/rules'
-import type { CreateVacancyInput, SkillWeight } from '@/domain/vacancy/types'
+import type {
+  CreateVacancyInput,
+  PossibleDuplicate,
+  SkillWeight,
+} from '@/domain/vacancy/types'
 import { ApiError } from '@/infrastructure/http/errors'
 import type { SkillRowError } from './SkillsEditor'
 
@@ -43,6 +48,8 @@ const ZOD_TO_KEY: Record<string, string> = {
  * Drives VacancyForm. Holds form state, runs zod validation on submit,
  * shapes errors into per-field + per-row + form-level slots, and on
  * success calls vacancy.create then navigates to the new detail page.
+ * When the backend reports likely duplicates nothing is created:
+ * `duplicates` lists them and `createAnyway` resends with `force`.
  *
  * Extracting this from the JSX keeps the component file under the
  * project-wide 200-LOC budget and lets us test the form logic in
@@ -55,12 +62,14 @@ export function useVacancyForm(onSubmitNavigateTo?: (id: string) => string) {
   const [values, setValues] = useState<VacancyFormInput>(INITIAL_VALUES)
   const [errors, setErrors] = useState<FieldErrors>({})
   const [pending, setPending] = useState(false)
+  const [duplicates, setDuplicates] = useState<PossibleDuplicate[]>([])
 
   const updateField = <K extends keyof VacancyFormInput>(
     field: K,
     value: VacancyFormInput[K],
   ) => {
     setValues((v) => ({ ...v, [field]: value }))
+    setDuplicates([])
     if (errors[field as keyof FieldErrors] || errors._form) {
       setErrors((e) => {
         const next = { ...e }
@@ -71,6 +80,27 @@ export function useVacancyForm(onSubmitNavigateTo?: (id: string) => string) {
     }
   }
 
+  const send = async (payload: CreateVacancyInput) => {
+    setPending(true)
+    setErrors({})
+    setDuplicates([])
+    try {
+      const created = await gateway.create(payload)
+      navigate(
+        onSubmitNavigateTo
+          ? onSubmitNavigateTo(created.id)
+          : `/vacancies/${created.id}`,
+      )
+    } catch (cause) {
+      if (cause instanceof PossibleDuplicatesError) {
+        setDuplicates(cause.duplicates)
+      } else {
+        setErrors({ _form: messageFor(cause, t) })
+      }
+      setPending(false)
+    }
+  }
+
   const submit = async (e: FormEvent<HTMLFormElement>) => {
     e.preventDefault()
     if (pending) return
@@ -100,27 +130,36 @@ export function useVacancyForm(onSubmitNavigateTo?: (id: string) => string) {
       return
     }
 
-    setPending(true)
-    setErrors({})
-    try {
-      const payload: CreateVacancyInput = {
-        title: parsed.data.title,
-        description: parsed.data.description ?? '',
-        skills: parsed.data.skills.map(toSkillWeight),
-      }
-      const created = await gateway.create(payload)
-      navigate(
-        onSubmitNavigateTo
-          ? onSubmitNavigateTo(created.id)
-          : `/vacancies/${created.id}`,
-      )
-    } catch (cause) {
-      setErrors({ _form: messageFor(cause, t) })
-      setPending(false)
-    }
+    await send({
+      title: parsed.data.title,
+      description: parsed.data.description ?? '',
+      skills: parsed.data.skills.map(toSkillWeight),
+    })
+  }
+
+  // Only reachable after submit reported duplicates, so the values already
+  // passed validation and editing any field clears the list.
+  const createAnyway = async () => {
+    if (pending || duplicates.length === 0) return
+    const parsed = vacancyFormSchema.safeParse(values)
+    if (!parsed.success) return
+    await send({
+      title: parsed.data.title,
+      description: parsed.data.description ?? '',
+      skills: parsed.data.skills.map(toSkillWeight),
+      force: true,
+    })
   }
 
-  return { values, errors, pending, updateField, submit }
+  return {
+    values,
+    errors,
+    duplicates,
+    pending,
+    updateField,
+    submit,
+    createAnyway,
+  }
 }
 
 function translate(zod: string, t: (k: string) => string): string {
@@ -17,8 +17,9 @@ export function SupportBodyEn() {
       <H3>How do I upload a candidate's resume?</H3>
       <P>
         Open a vacancy → drag &amp; drop the file into the "Drop a resume"
-        zone. PDF, DOC, DOCX, TXT up to 10 MB are accepted. Cadence creates
-        the candidate, extracts the profile, and runs analysis automatically.
+        zone. PDF, DOC, DOCX, RTF, ODT, HTML, Markdown and TXT up to 10 MB
+        are accepted. Cadence creates the candidate, extracts the profile, and
+        runs analysis automatically.
       </P>
 
       <H3>What does the match score mean?</H3>
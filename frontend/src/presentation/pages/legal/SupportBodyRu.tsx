@@ -17,8 +17,9 @@ export function SupportBodyRu() {
       <H3>Как загрузить резюме кандидата?</H3>
       <P>
         Откройте вакансию → drag&drop файла в зону «Перетащите резюме».
-        Принимаются PDF, DOC, DOCX, TXT до 10 МБ. Cadence автоматически
-        создаст карточку кандидата, извлечёт профиль и запустит анализ.
+        Принимаются PDF, DOC, DOCX, RTF, ODT, HTML, Markdown и TXT до 10 МБ.
+        Cadence автоматически создаст карточку кандидата, извлечёт профиль и
+        запустит анализ.
       </P>
 
       <H3>Что означает match score?</H3>
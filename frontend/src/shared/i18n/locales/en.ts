@@ -93,6 +93,9 @@ export const en: Dict = {
   'status.open': 'Open',
   'status.archived': 'Archived',
   'status.draft': 'Draft',
+  'status.paused': 'Paused',
+  'status.closed': 'Closed',
+  'status.filled': 'Filled',
 
   // Vacancy create
   'create.back': '← Vacancies',
@@ -129,6 +132,10 @@ export const en: Dict = {
   'form.error.rateLimited': 'Too many requests — try again in a moment.',
   'form.error.network': 'Cannot reach the server.',
   'form.error.fallback': 'Could not create the vacancy.',
+  'form.duplicates.title':
+    'Similar open vacancies already exist. Nothing was created.',
+  'form.duplicates.similarity': '· {percent}% similar',
+  'form.duplicates.createAnyway': 'Create anyway',
 
   // Skills editor
   'skills.legend': 'Skills & weights',
@@ -169,7 +176,7 @@ export const en: Dict = {
   // Resume uploader
   'upload.title': 'Drop one or more resumes',
   'upload.subtitle':
-    'PDF, DOC, DOCX, or TXT up to 10 MB each. Cadence will create candidates, extract profiles, and run analysis automatically.',
+    'PDF, DOC, DOCX, RTF, ODT, HTML, Markdown or TXT up to 10 MB each. Cadence will create candidates, extract profiles, and run analysis automatically.',
   'upload.cta': 'Choose files',
   'upload.uploading': 'Uploading',
   'upload.tooLarge': '"{name}" is over 10 MB. Pick a smaller PDF or DOCX.',
@@ -94,6 +94,9 @@ export const ru: Dict = {
   'status.open': 'Открыта',
   'status.archived': 'В архиве',
   'status.draft': 'Черновик',
+  'status.paused': 'На паузе',
+  'status.closed': 'Закрыта',
+  'status.filled': 'Укомплектована',
 
   // Vacancy create
   'create.back': '← Вакансии',
@@ -132,6 +135,10 @@ export const ru: Dict = {
     'Слишком много запросов — попробуйте чуть позже.',
   'form.error.network': 'Не удаётся связаться с сервером.',
   'form.error.fallback': 'Не удалось создать вакансию.',
+  'form.duplicates.title':
+    'Похожие открытые вакансии уже есть. Ничего не создано.',
+  'form.duplicates.similarity': '· сходство {percent}%',
+  'form.duplicates.createAnyway': 'Всё равно создать',
 
   // Skills editor
   'skills.legend': 'Навыки и веса',
@@ -172,7 +179,7 @@ export const ru: Dict = {
   // Resume uploader
   'upload.title': 'Перетащите одно или несколько резюме',
   'upload.subtitle':
-    'PDF, DOC, DOCX или TXT до 10 МБ каждое. Cadence создаст кандидатов, извлечёт профили и запустит анализ автоматически.',
+    'PDF, DOC, DOCX, RTF, ODT, HTML, Markdown или TXT до 10 МБ каждое. Cadence создаст кандидатов, извлечёт профили и запустит анализ автоматически.',
   'upload.cta': 'Выбрать файлы',
   'upload.uploading': 'Загрузка',
   'upload.tooLarge': 'Файл «{name}» больше 10 МБ. Выберите PDF или DOCX поменьше.',
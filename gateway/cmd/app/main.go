@@ -46,23 +46,31 @@ func run() error {
 	// RegisterXxxHandlerFromEndpoint registers a finalizer on ctx.Done
 	// that closes the conn; we don't want that fired during the in-flight
 	// drain.
+	resumeClient, resumeCleanup, err := bootstrap.InitResumeClient(cfg)
+	if err != nil {
+		authCleanup()
+		return err
+	}
+
 	gwMux, err := bootstrap.InitGatewayMux(context.Background(), cfg)
 	if err != nil {
+		resumeCleanup()
 		authCleanup()
 		return err
 	}
 
 	swaggerSpecs, err := bootstrap.InitSwaggerSpecs()
 	if err != nil {
+		resumeCleanup()
 		authCleanup()
 		return err
 	}
 
-	handler := bootstrap.InitHTTPHandler(authClient, gwMux, swaggerSpecs, cfg.Server.CORS.AllowedOrigins)
+	handler := bootstrap.InitHTTPHandler(authClient, resumeClient, gwMux, swaggerSpecs, cfg.Server.CORS.AllowedOrigins)
 
-	// Cleanup runs LIFO during shutdown — auth conn closes after the
+	// Cleanup runs LIFO during shutdown — the client conns close after the
 	// HTTP server stops accepting new requests.
-	return bootstrap.AppRun(handler, cfg, authCleanup)
+	return bootstrap.AppRun(handler, cfg, authCleanup, resumeCleanup)
 }
 
 func defaultConfigPathByEnv(appEnv string) string {
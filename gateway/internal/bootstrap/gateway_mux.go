@@ -24,6 +24,8 @@ import (
 func InitGatewayMux(ctx context.Context, cfg *config.Config) (*runtime.ServeMux, error) {
 	mux := runtime.NewServeMux(
 		runtime.WithIncomingHeaderMatcher(transport_http.IncomingHeaderMatcher),
+		runtime.WithOutgoingHeaderMatcher(transport_http.OutgoingHeaderMatcher),
+		runtime.WithForwardResponseOption(transport_http.ForwardVacancyETag),
 	)
 	opts := []grpc.DialOption{grpc.WithTransportCredentials(insecure.NewCredentials())}
 
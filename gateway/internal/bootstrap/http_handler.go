@@ -6,6 +6,7 @@ import (
 	"github.com/grpc-ecosystem/grpc-gateway/v2/runtime"
 
 	"github.com/artem13815/hr/gateway/internal/pb/auth_api"
+	"github.com/artem13815/hr/gateway/internal/pb/resume_api"
 	transport_http "github.com/artem13815/hr/gateway/internal/transport/http"
 )
 
@@ -19,6 +20,10 @@ import (
 //	                          /healthz                -> HealthHandler
 //	                          /docs                   -> SwaggerHandler
 //	                          /openapi/<svc>.yaml     -> SwaggerHandler (per-service)
+//	                          POST /public/v1/vacancies/{id}/apply -> ApplyHandler (multipart, no auth)
+//	                          POST /api/v1/candidates/{id}/resume/upload -> withAuthContext(UploadResumeHandler)
+//	                          POST /api/v1/vacancies/{id}/candidates/from-resume/upload
+//	                                                  -> withAuthContext(UploadCandidateFromResumeHandler)
 //	                          /                       -> withAuthContext(grpc-gateway mux)
 //
 // SwaggerHandler is one sub-mux that owns both /docs and every
@@ -30,13 +35,18 @@ import (
 // preflights short-circuit before hitting auth/clientIP/JSON; clientIP
 // runs before auth so the auth check sees the populated header in case
 // it ever needs IP-scoped rate limiting.
-func InitHTTPHandler(authClient auth_api.AuthServiceClient, gwMux *runtime.ServeMux, swaggerSpecs []transport_http.SwaggerSpec, allowedOrigins []string) http.Handler {
+func InitHTTPHandler(authClient auth_api.AuthServiceClient, resumeClient resume_api.ResumeServiceClient, gwMux *runtime.ServeMux, swaggerSpecs []transport_http.SwaggerSpec, allowedOrigins []string) http.Handler {
 	root := http.NewServeMux()
 
 	swaggerH := transport_http.SwaggerHandler(swaggerSpecs)
 	root.Handle("/docs", swaggerH)
 	root.Handle("/openapi/", swaggerH)
 	root.Handle("/healthz", transport_http.HealthHandler())
+	root.Handle("POST /public/v1/vacancies/{vacancy_id}/apply", transport_http.ApplyHandler(resumeClient, gwMux))
+	root.Handle("POST /api/v1/candidates/{candidate_id}/resume/upload",
+		transport_http.WithAuthContext(authClient, transport_http.UploadResumeHandler(resumeClient, gwMux)))
+	root.Handle("POST /api/v1/vacancies/{vacancy_id}/candidates/from-resume/upload",
+		transport_http.WithAuthContext(authClient, transport_http.UploadCandidateFromResumeHandler(resumeClient, gwMux)))
 	root.Handle("/", transport_http.WithAuthContext(authClient, gwMux))
 
 	return transport_http.WithLogging(
@@ -0,0 +1,13 @@
+package bootstrap
+
+import (
+	"github.com/artem13815/hr/gateway/config"
+	"github.com/artem13815/hr/gateway/internal/infrastructure/resume_client"
+	"github.com/artem13815/hr/gateway/internal/pb/resume_api"
+)
+
+// InitResumeClient builds the resume gRPC client used by the hand-written
+// multipart handlers.
+func InitResumeClient(cfg *config.Config) (resume_api.ResumeServiceClient, func(), error) {
+	return resume_client.New(cfg)
+}
@@ -0,0 +1,31 @@
+package resume_client
+
+import (
+	"fmt"
+	"log/slog"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials/insecure"
+
+	"github.com/artem13815/hr/gateway/config"
+	"github.com/artem13815/hr/gateway/internal/pb/resume_api"
+)
+
+// New dials the resume service for the handlers grpc-gateway cannot
+// generate — multipart forms such as the public apply form. JSON routes keep
+// going through the gateway mux and its own connection.
+//
+// Same lifetime rules as auth_client.New: one long-lived conn, closed by the
+// returned cleanup during graceful shutdown.
+func New(cfg *config.Config) (resume_api.ResumeServiceClient, func(), error) {
+	conn, err := grpc.NewClient(cfg.Resume.GRPCAddr, grpc.WithTransportCredentials(insecure.NewCredentials()))
+	if err != nil {
+		return nil, nil, fmt.Errorf("dial resume service: %w", err)
+	}
+	cleanup := func() {
+		if err := conn.Close(); err != nil {
+			slog.Warn("close resume client conn", "err", err)
+		}
+	}
+	return resume_api.NewResumeServiceClient(conn), cleanup, nil
+}
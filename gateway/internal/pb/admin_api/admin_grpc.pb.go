@@ -20,10 +20,12 @@ import (
 const _ = grpc.SupportPackageIsVersion9
 
 const (
-	AdminService_GetOverview_FullMethodName = "/admin.service.v1.AdminService/GetOverview"
-	AdminService_ListUsers_FullMethodName   = "/admin.service.v1.AdminService/ListUsers"
-	AdminService_PromoteUser_FullMethodName = "/admin.service.v1.AdminService/PromoteUser"
-	AdminService_DemoteUser_FullMethodName  = "/admin.service.v1.AdminService/DemoteUser"
+	AdminService_GetOverview_FullMethodName            = "/admin.service.v1.AdminService/GetOverview"
+	AdminService_ListUsers_FullMethodName              = "/admin.service.v1.AdminService/ListUsers"
+	AdminService_PromoteUser_FullMethodName            = "/admin.service.v1.AdminService/PromoteUser"
+	AdminService_DemoteUser_FullMethodName             = "/admin.service.v1.AdminService/DemoteUser"
+	AdminService_TransferOwnership_FullMethodName      = "/admin.service.v1.AdminService/TransferOwnership"
+	AdminService_ListOwnershipTransfers_FullMethodName = "/admin.service.v1.AdminService/ListOwnershipTransfers"
 )
 
 // AdminServiceClient is the client API for AdminService service.
@@ -43,6 +45,15 @@ type AdminServiceClient interface {
 	PromoteUser(ctx context.Context, in *models.PromoteUserRequest, opts ...grpc.CallOption) (*models.UpdateRoleResponse, error)
 	// DemoteUser sets role = "user".
 	DemoteUser(ctx context.Context, in *models.DemoteUserRequest, opts ...grpc.CallOption) (*models.UpdateRoleResponse, error)
+	// TransferOwnership moves everything from_user_id owns within scope —
+	// vacancies in the vacancy service, candidates in the resume service — to
+	// to_user_id, in batches, and records the run. Idempotent and resumable:
+	// when a call fails midway (UNAVAILABLE, reason TRANSFER_INTERRUPTED),
+	// repeating it with the same arguments continues the same transfer. A
+	// different unfinished transfer from the same user is FAILED_PRECONDITION.
+	TransferOwnership(ctx context.Context, in *models.TransferOwnershipRequest, opts ...grpc.CallOption) (*models.OwnershipTransferResponse, error)
+	// ListOwnershipTransfers returns the transfer audit log, newest first.
+	ListOwnershipTransfers(ctx context.Context, in *models.ListOwnershipTransfersRequest, opts ...grpc.CallOption) (*models.ListOwnershipTransfersResponse, error)
 }
 
 type adminServiceClient struct {
@@ -93,6 +104,26 @@ func (c *adminServiceClient) DemoteUser(ctx context.Context, in *models.DemoteUs
 	return out, nil
 }
 
+func (c *adminServiceClient) TransferOwnership(ctx context.Context, in *models.TransferOwnershipRequest, opts ...grpc.CallOption) (*models.OwnershipTransferResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(models.OwnershipTransferResponse)
+	err := c.cc.Invoke(ctx, AdminService_TransferOwnership_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *adminServiceClient) ListOwnershipTransfers(ctx context.Context, in *models.ListOwnershipTransfersRequest, opts ...grpc.CallOption) (*models.ListOwnershipTransfersResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(models.ListOwnershipTransfersResponse)
+	err := c.cc.Invoke(ctx, AdminService_ListOwnershipTransfers_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // AdminServiceServer is the server API for AdminService service.
 // All implementations must embed UnimplementedAdminServiceServer
 // for forward compatibility.
@@ -110,6 +141,15 @@ type AdminServiceServer interface {
 	PromoteUser(context.Context, *models.PromoteUserRequest) (*models.UpdateRoleResponse, error)
 	// DemoteUser sets role = "user".
 	DemoteUser(context.Context, *models.DemoteUserRequest) (*models.UpdateRoleResponse, error)
+	// TransferOwnership moves everything from_user_id owns within scope —
+	// vacancies in the vacancy service, candidates in the resume service — to
+	// to_user_id, in batches, and records the run. Idempotent and resumable:
+	// when a call fails midway (UNAVAILABLE, reason TRANSFER_INTERRUPTED),
+	// repeating it with the same arguments continues the same transfer. A
+	// different unfinished transfer from the same user is FAILED_PRECONDITION.
+	TransferOwnership(context.Context, *models.TransferOwnershipRequest) (*models.OwnershipTransferResponse, error)
+	// ListOwnershipTransfers returns the transfer audit log, newest first.
+	ListOwnershipTransfers(context.Context, *models.ListOwnershipTransfersRequest) (*models.ListOwnershipTransfersResponse, error)
 	mustEmbedUnimplementedAdminServiceServer()
 }
 
@@ -132,6 +172,12 @@ func (UnimplementedAdminServiceServer) PromoteUser(context.Context, *models.Prom
 func (UnimplementedAdminServiceServer) DemoteUser(context.Context, *models.DemoteUserRequest) (*models.UpdateRoleResponse, error) {
 	return nil, status.Error(codes.Unimplemented, "method DemoteUser not implemented")
 }
+func (UnimplementedAdminServiceServer) TransferOwnership(context.Context, *models.TransferOwnershipRequest) (*models.OwnershipTransferResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method TransferOwnership not implemented")
+}
+func (UnimplementedAdminServiceServer) ListOwnershipTransfers(context.Context, *models.ListOwnershipTransfersRequest) (*models.ListOwnershipTransfersResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method ListOwnershipTransfers not implemented")
+}
 func (UnimplementedAdminServiceServer) mustEmbedUnimplementedAdminServiceServer() {}
 func (UnimplementedAdminServiceServer) testEmbeddedByValue()                      {}
 
@@ -225,6 +271,42 @@ func _AdminService_DemoteUser_Handler(srv interface{}, ctx context.Context, dec
 	return interceptor(ctx, in, info, handler)
 }
 
+func _AdminService_TransferOwnership_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(models.TransferOwnershipRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminServiceServer).TransferOwnership(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AdminService_TransferOwnership_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminServiceServer).TransferOwnership(ctx, req.(*models.TransferOwnershipRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AdminService_ListOwnershipTransfers_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(models.ListOwnershipTransfersRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminServiceServer).ListOwnershipTransfers(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AdminService_ListOwnershipTransfers_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminServiceServer).ListOwnershipTransfers(ctx, req.(*models.ListOwnershipTransfersRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // AdminService_ServiceDesc is the grpc.ServiceDesc for AdminService service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -248,6 +330,14 @@ var AdminService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "DemoteUser",
 			Handler:    _AdminService_DemoteUser_Handler,
 		},
+		{
+			MethodName: "TransferOwnership",
+			Handler:    _AdminService_TransferOwnership_Handler,
+		},
+		{
+			MethodName: "ListOwnershipTransfers",
+			Handler:    _AdminService_ListOwnershipTransfers_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "admin_api/admin.proto",
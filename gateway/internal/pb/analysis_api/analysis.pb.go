@@ -27,12 +27,14 @@ var File_analysis_api_analysis_proto protoreflect.FileDescriptor
 
 const file_analysis_api_analysis_proto_rawDesc = "" +
 	"\n" +
-	"\x1banalysis_api/analysis.proto\x12\x13analysis.service.v1\x1a\x1cgoogle/api/annotations.proto\x1a\x1bmodels/analysis_model.proto\x1a.protoc-gen-openapiv2/options/annotations.proto2\xa5\x04\n" +
+	"\x1banalysis_api/analysis.proto\x12\x13analysis.service.v1\x1a\x1cgoogle/api/annotations.proto\x1a\x1bmodels/analysis_model.proto\x1a.protoc-gen-openapiv2/options/annotations.proto2\x9c\x06\n" +
 	"\x0fAnalysisService\x12\xa9\x01\n" +
 	"\rStartAnalysis\x12(.analysis.models.v1.StartAnalysisRequest\x1a).analysis.models.v1.StartAnalysisResponse\"C\x92A\x12b\x10\n" +
 	"\x0e\n" +
 	"\n" +
-	"BearerAuth\x12\x00\x82\xd3\xe4\x93\x02(:\x01*\"#/api/v1/resumes/{resume_id}/analyze\x12\x98\x01\n" +
+	"BearerAuth\x12\x00\x82\xd3\xe4\x93\x02(:\x01*\"#/api/v1/resumes/{resume_id}/analyze\x12z\n" +
+	"\x18StartApplicationAnalysis\x123.analysis.models.v1.StartApplicationAnalysisRequest\x1a).analysis.models.v1.StartAnalysisResponse\x12y\n" +
+	"\x14PurgeVacancyAnalyses\x12/.analysis.models.v1.PurgeVacancyAnalysesRequest\x1a0.analysis.models.v1.PurgeVacancyAnalysesResponse\x12\x98\x01\n" +
 	"\vGetAnalysis\x12&.analysis.models.v1.GetAnalysisRequest\x1a$.analysis.models.v1.AnalysisResponse\";\x92A\x12b\x10\n" +
 	"\x0e\n" +
 	"\n" +
@@ -48,21 +50,28 @@ const file_analysis_api_analysis_proto_rawDesc = "" +
 
 var file_analysis_api_analysis_proto_goTypes = []any{
 	(*models.StartAnalysisRequest)(nil),            // 0: analysis.models.v1.StartAnalysisRequest
-	(*models.GetAnalysisRequest)(nil),              // 1: analysis.models.v1.GetAnalysisRequest
-	(*models.ListCandidatesByVacancyRequest)(nil),  // 2: analysis.models.v1.ListCandidatesByVacancyRequest
-	(*models.StartAnalysisResponse)(nil),           // 3: analysis.models.v1.StartAnalysisResponse
-	(*models.AnalysisResponse)(nil),                // 4: analysis.models.v1.AnalysisResponse
-	(*models.ListCandidatesByVacancyResponse)(nil), // 5: analysis.models.v1.ListCandidatesByVacancyResponse
+	(*models.StartApplicationAnalysisRequest)(nil), // 1: analysis.models.v1.StartApplicationAnalysisRequest
+	(*models.PurgeVacancyAnalysesRequest)(nil),     // 2: analysis.models.v1.PurgeVacancyAnalysesRequest
+	(*models.GetAnalysisRequest)(nil),              // 3: analysis.models.v1.GetAnalysisRequest
+	(*models.ListCandidatesByVacancyRequest)(nil),  // 4: analysis.models.v1.ListCandidatesByVacancyRequest
+	(*models.StartAnalysisResponse)(nil),           // 5: analysis.models.v1.StartAnalysisResponse
+	(*models.PurgeVacancyAnalysesResponse)(nil),    // 6: analysis.models.v1.PurgeVacancyAnalysesResponse
+	(*models.AnalysisResponse)(nil),                // 7: analysis.models.v1.AnalysisResponse
+	(*models.ListCandidatesByVacancyResponse)(nil), // 8: analysis.models.v1.ListCandidatesByVacancyResponse
 }
 var file_analysis_api_analysis_proto_depIdxs = []int32{
 	0, // 0: analysis.service.v1.AnalysisService.StartAnalysis:input_type -> analysis.models.v1.StartAnalysisRequest
-	1, // 1: analysis.service.v1.AnalysisService.GetAnalysis:input_type -> analysis.models.v1.GetAnalysisRequest
-	2, // 2: analysis.service.v1.AnalysisService.ListCandidatesByVacancy:input_type -> analysis.models.v1.ListCandidatesByVacancyRequest
-	3, // 3: analysis.service.v1.AnalysisService.StartAnalysis:output_type -> analysis.models.v1.StartAnalysisResponse
-	4, // 4: analysis.service.v1.AnalysisService.GetAnalysis:output_type -> analysis.models.v1.AnalysisResponse
-	5, // 5: analysis.service.v1.AnalysisService.ListCandidatesByVacancy:output_type -> analysis.models.v1.ListCandidatesByVacancyResponse
-	3, // [3:6] is the sub-list for method output_type
-	0, // [0:3] is the sub-list for method input_type
+	1, // 1: analysis.service.v1.AnalysisService.StartApplicationAnalysis:input_type -> analysis.models.v1.StartApplicationAnalysisRequest
+	2, // 2: analysis.service.v1.AnalysisService.PurgeVacancyAnalyses:input_type -> analysis.models.v1.PurgeVacancyAnalysesRequest
+	3, // 3: analysis.service.v1.AnalysisService.GetAnalysis:input_type -> analysis.models.v1.GetAnalysisRequest
+	4, // 4: analysis.service.v1.AnalysisService.ListCandidatesByVacancy:input_type -> analysis.models.v1.ListCandidatesByVacancyRequest
+	5, // 5: analysis.service.v1.AnalysisService.StartAnalysis:output_type -> analysis.models.v1.StartAnalysisResponse
+	5, // 6: analysis.service.v1.AnalysisService.StartApplicationAnalysis:output_type -> analysis.models.v1.StartAnalysisResponse
+	6, // 7: analysis.service.v1.AnalysisService.PurgeVacancyAnalyses:output_type -> analysis.models.v1.PurgeVacancyAnalysesResponse
+	7, // 8: analysis.service.v1.AnalysisService.GetAnalysis:output_type -> analysis.models.v1.AnalysisResponse
+	8, // 9: analysis.service.v1.AnalysisService.ListCandidatesByVacancy:output_type -> analysis.models.v1.ListCandidatesByVacancyResponse
+	5, // [5:10] is the sub-list for method output_type
+	0, // [0:5] is the sub-list for method input_type
 	0, // [0:0] is the sub-list for extension type_name
 	0, // [0:0] is the sub-list for extension extendee
 	0, // [0:0] is the sub-list for field type_name
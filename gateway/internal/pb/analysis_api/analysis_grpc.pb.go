@@ -20,9 +20,11 @@ import (
 const _ = grpc.SupportPackageIsVersion9
 
 const (
-	AnalysisService_StartAnalysis_FullMethodName           = "/analysis.service.v1.AnalysisService/StartAnalysis"
-	AnalysisService_GetAnalysis_FullMethodName             = "/analysis.service.v1.AnalysisService/GetAnalysis"
-	AnalysisService_ListCandidatesByVacancy_FullMethodName = "/analysis.service.v1.AnalysisService/ListCandidatesByVacancy"
+	AnalysisService_StartAnalysis_FullMethodName            = "/analysis.service.v1.AnalysisService/StartAnalysis"
+	AnalysisService_StartApplicationAnalysis_FullMethodName = "/analysis.service.v1.AnalysisService/StartApplicationAnalysis"
+	AnalysisService_PurgeVacancyAnalyses_FullMethodName     = "/analysis.service.v1.AnalysisService/PurgeVacancyAnalyses"
+	AnalysisService_GetAnalysis_FullMethodName              = "/analysis.service.v1.AnalysisService/GetAnalysis"
+	AnalysisService_ListCandidatesByVacancy_FullMethodName  = "/analysis.service.v1.AnalysisService/ListCandidatesByVacancy"
 )
 
 // AnalysisServiceClient is the client API for AnalysisService service.
@@ -30,6 +32,17 @@ const (
 // For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
 type AnalysisServiceClient interface {
 	StartAnalysis(ctx context.Context, in *models.StartAnalysisRequest, opts ...grpc.CallOption) (*models.StartAnalysisResponse, error)
+	// StartApplicationAnalysis is called by the resume service for candidates
+	// who applied through the public form. Internal only: no HTTP binding,
+	// skipped by the auth interceptor, and refused for any other candidate
+	// source.
+	StartApplicationAnalysis(ctx context.Context, in *models.StartApplicationAnalysisRequest, opts ...grpc.CallOption) (*models.StartAnalysisResponse, error)
+	// PurgeVacancyAnalyses hard-deletes every analysis of a soft-deleted
+	// vacancy once its grace period is over, resume-derived profile and
+	// summary included. Called by the resume service while it purges the
+	// vacancy's candidates. Internal, like StartApplicationAnalysis, and
+	// refused unless the vacancy service has started purging the vacancy.
+	PurgeVacancyAnalyses(ctx context.Context, in *models.PurgeVacancyAnalysesRequest, opts ...grpc.CallOption) (*models.PurgeVacancyAnalysesResponse, error)
 	GetAnalysis(ctx context.Context, in *models.GetAnalysisRequest, opts ...grpc.CallOption) (*models.AnalysisResponse, error)
 	ListCandidatesByVacancy(ctx context.Context, in *models.ListCandidatesByVacancyRequest, opts ...grpc.CallOption) (*models.ListCandidatesByVacancyResponse, error)
 }
@@ -52,6 +65,26 @@ func (c *analysisServiceClient) StartAnalysis(ctx context.Context, in *models.St
 	return out, nil
 }
 
+func (c *analysisServiceClient) StartApplicationAnalysis(ctx context.Context, in *models.StartApplicationAnalysisRequest, opts ...grpc.CallOption) (*models.StartAnalysisResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(models.StartAnalysisResponse)
+	err := c.cc.Invoke(ctx, AnalysisService_StartApplicationAnalysis_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *analysisServiceClient) PurgeVacancyAnalyses(ctx context.Context, in *models.PurgeVacancyAnalysesRequest, opts ...grpc.CallOption) (*models.PurgeVacancyAnalysesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(models.PurgeVacancyAnalysesResponse)
+	err := c.cc.Invoke(ctx, AnalysisService_PurgeVacancyAnalyses_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *analysisServiceClient) GetAnalysis(ctx context.Context, in *models.GetAnalysisRequest, opts ...grpc.CallOption) (*models.AnalysisResponse, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(models.AnalysisResponse)
@@ -77,6 +110,17 @@ func (c *analysisServiceClient) ListCandidatesByVacancy(ctx context.Context, in
 // for forward compatibility.
 type AnalysisServiceServer interface {
 	StartAnalysis(context.Context, *models.StartAnalysisRequest) (*models.StartAnalysisResponse, error)
+	// StartApplicationAnalysis is called by the resume service for candidates
+	// who applied through the public form. Internal only: no HTTP binding,
+	// skipped by the auth interceptor, and refused for any other candidate
+	// source.
+	StartApplicationAnalysis(context.Context, *models.StartApplicationAnalysisRequest) (*models.StartAnalysisResponse, error)
+	// PurgeVacancyAnalyses hard-deletes every analysis of a soft-deleted
+	// vacancy once its grace period is over, resume-derived profile and
+	// summary included. Called by the resume service while it purges the
+	// vacancy's candidates. Internal, like StartApplicationAnalysis, and
+	// refused unless the vacancy service has started purging the vacancy.
+	PurgeVacancyAnalyses(context.Context, *models.PurgeVacancyAnalysesRequest) (*models.PurgeVacancyAnalysesResponse, error)
 	GetAnalysis(context.Context, *models.GetAnalysisRequest) (*models.AnalysisResponse, error)
 	ListCandidatesByVacancy(context.Context, *models.ListCandidatesByVacancyRequest) (*models.ListCandidatesByVacancyResponse, error)
 	mustEmbedUnimplementedAnalysisServiceServer()
@@ -92,6 +136,12 @@ type UnimplementedAnalysisServiceServer struct{}
 func (UnimplementedAnalysisServiceServer) StartAnalysis(context.Context, *models.StartAnalysisRequest) (*models.StartAnalysisResponse, error) {
 	return nil, status.Error(codes.Unimplemented, "method StartAnalysis not implemented")
 }
+func (UnimplementedAnalysisServiceServer) StartApplicationAnalysis(context.Context, *models.StartApplicationAnalysisRequest) (*models.StartAnalysisResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method StartApplicationAnalysis not implemented")
+}
+func (UnimplementedAnalysisServiceServer) PurgeVacancyAnalyses(context.Context, *models.PurgeVacancyAnalysesRequest) (*models.PurgeVacancyAnalysesResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method PurgeVacancyAnalyses not implemented")
+}
 func (UnimplementedAnalysisServiceServer) GetAnalysis(context.Context, *models.GetAnalysisRequest) (*models.AnalysisResponse, error) {
 	return nil, status.Error(codes.Unimplemented, "method GetAnalysis not implemented")
 }
@@ -137,6 +187,42 @@ func _AnalysisService_StartAnalysis_Handler(srv interface{}, ctx context.Context
 	return interceptor(ctx, in, info, handler)
 }
 
+func _AnalysisService_StartApplicationAnalysis_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(models.StartApplicationAnalysisRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AnalysisServiceServer).StartApplicationAnalysis(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AnalysisService_StartApplicationAnalysis_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AnalysisServiceServer).StartApplicationAnalysis(ctx, req.(*models.StartApplicationAnalysisRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AnalysisService_PurgeVacancyAnalyses_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(models.PurgeVacancyAnalysesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AnalysisServiceServer).PurgeVacancyAnalyses(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AnalysisService_PurgeVacancyAnalyses_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AnalysisServiceServer).PurgeVacancyAnalyses(ctx, req.(*models.PurgeVacancyAnalysesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _AnalysisService_GetAnalysis_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(models.GetAnalysisRequest)
 	if err := dec(in); err != nil {
@@ -184,6 +270,14 @@ var AnalysisService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "StartAnalysis",
 			Handler:    _AnalysisService_StartAnalysis_Handler,
 		},
+		{
+			MethodName: "StartApplicationAnalysis",
+			Handler:    _AnalysisService_StartApplicationAnalysis_Handler,
+		},
+		{
+			MethodName: "PurgeVacancyAnalyses",
+			Handler:    _AnalysisService_PurgeVacancyAnalyses_Handler,
+		},
 		{
 			MethodName: "GetAnalysis",
 			Handler:    _AnalysisService_GetAnalysis_Handler,
@@ -22,6 +22,119 @@ const (
 	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
 )
 
+// TransferScope selects what TransferOwnership moves. UNSPECIFIED means ALL.
+type TransferScope int32
+
+const (
+	TransferScope_TRANSFER_SCOPE_UNSPECIFIED TransferScope = 0
+	TransferScope_TRANSFER_SCOPE_ALL         TransferScope = 1
+	TransferScope_TRANSFER_SCOPE_VACANCIES   TransferScope = 2
+	TransferScope_TRANSFER_SCOPE_CANDIDATES  TransferScope = 3
+)
+
+// Enum value maps for TransferScope.
+var (
+	TransferScope_name = map[int32]string{
+		0: "TRANSFER_SCOPE_UNSPECIFIED",
+		1: "TRANSFER_SCOPE_ALL",
+		2: "TRANSFER_SCOPE_VACANCIES",
+		3: "TRANSFER_SCOPE_CANDIDATES",
+	}
+	TransferScope_value = map[string]int32{
+		"TRANSFER_SCOPE_UNSPECIFIED": 0,
+		"TRANSFER_SCOPE_ALL":         1,
+		"TRANSFER_SCOPE_VACANCIES":   2,
+		"TRANSFER_SCOPE_CANDIDATES":  3,
+	}
+)
+
+func (x TransferScope) Enum() *TransferScope {
+	p := new(TransferScope)
+	*p = x
+	return p
+}
+
+func (x TransferScope) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (TransferScope) Descriptor() protoreflect.EnumDescriptor {
+	return file_models_admin_model_proto_enumTypes[0].Descriptor()
+}
+
+func (TransferScope) Type() protoreflect.EnumType {
+	return &file_models_admin_model_proto_enumTypes[0]
+}
+
+func (x TransferScope) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use TransferScope.Descriptor instead.
+func (TransferScope) EnumDescriptor() ([]byte, []int) {
+	return file_models_admin_model_proto_rawDescGZIP(), []int{0}
+}
+
+// TransferStatus of an ownership transfer. RUNNING and FAILED transfers are
+// unfinished: repeating TransferOwnership with the same users and scope
+// resumes a FAILED one (or a RUNNING one nobody has touched for a while).
+// A request with another target or scope closes a FAILED or stale transfer
+// as ABANDONED and starts a new one.
+type TransferStatus int32
+
+const (
+	TransferStatus_TRANSFER_STATUS_UNSPECIFIED TransferStatus = 0
+	TransferStatus_TRANSFER_STATUS_RUNNING     TransferStatus = 1
+	TransferStatus_TRANSFER_STATUS_COMPLETED   TransferStatus = 2
+	TransferStatus_TRANSFER_STATUS_FAILED      TransferStatus = 3
+	TransferStatus_TRANSFER_STATUS_ABANDONED   TransferStatus = 4
+)
+
+// Enum value maps for TransferStatus.
+var (
+	TransferStatus_name = map[int32]string{
+		0: "TRANSFER_STATUS_UNSPECIFIED",
+		1: "TRANSFER_STATUS_RUNNING",
+		2: "TRANSFER_STATUS_COMPLETED",
+		3: "TRANSFER_STATUS_FAILED",
+		4: "TRANSFER_STATUS_ABANDONED",
+	}
+	TransferStatus_value = map[string]int32{
+		"TRANSFER_STATUS_UNSPECIFIED": 0,
+		"TRANSFER_STATUS_RUNNING":     1,
+		"TRANSFER_STATUS_COMPLETED":   2,
+		"TRANSFER_STATUS_FAILED":      3,
+		"TRANSFER_STATUS_ABANDONED":   4,
+	}
+)
+
+func (x TransferStatus) Enum() *TransferStatus {
+	p := new(TransferStatus)
+	*p = x
+	return p
+}
+
+func (x TransferStatus) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (TransferStatus) Descriptor() protoreflect.EnumDescriptor {
+	return file_models_admin_model_proto_enumTypes[1].Descriptor()
+}
+
+func (TransferStatus) Type() protoreflect.EnumType {
+	return &file_models_admin_model_proto_enumTypes[1]
+}
+
+func (x TransferStatus) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use TransferStatus.Descriptor instead.
+func (TransferStatus) EnumDescriptor() ([]byte, []int) {
+	return file_models_admin_model_proto_rawDescGZIP(), []int{1}
+}
+
 // SystemStats is the dashboard headline — total counts across the
 // platform. Computed via SELECT COUNT(*) per table; cheap on a 1-instance
 // shared DB, would need batch aggregation if services move to per-DB.
@@ -503,6 +616,327 @@ func (x *UpdateRoleResponse) GetNewRole() string {
 	return ""
 }
 
+// OwnershipTransfer is the audit entry of one transfer. The counters add up
+// over every call that worked on it, resumed ones included.
+type OwnershipTransfer struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	Id    uint64                 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	// actor_user_id is the admin who started the transfer.
+	ActorUserId           uint64         `protobuf:"varint,2,opt,name=actor_user_id,json=actorUserId,proto3" json:"actor_user_id,omitempty"`
+	FromUserId            uint64         `protobuf:"varint,3,opt,name=from_user_id,json=fromUserId,proto3" json:"from_user_id,omitempty"`
+	ToUserId              uint64         `protobuf:"varint,4,opt,name=to_user_id,json=toUserId,proto3" json:"to_user_id,omitempty"`
+	Scope                 TransferScope  `protobuf:"varint,5,opt,name=scope,proto3,enum=admin.models.v1.TransferScope" json:"scope,omitempty"`
+	Status                TransferStatus `protobuf:"varint,6,opt,name=status,proto3,enum=admin.models.v1.TransferStatus" json:"status,omitempty"`
+	VacanciesTransferred  uint64         `protobuf:"varint,7,opt,name=vacancies_transferred,json=vacanciesTransferred,proto3" json:"vacancies_transferred,omitempty"`
+	CandidatesTransferred uint64         `protobuf:"varint,8,opt,name=candidates_transferred,json=candidatesTransferred,proto3" json:"candidates_transferred,omitempty"`
+	// error is the last failure of a FAILED transfer.
+	Error         string                 `protobuf:"bytes,9,opt,name=error,proto3" json:"error,omitempty"`
+	CreatedAt     *timestamppb.Timestamp `protobuf:"bytes,10,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	UpdatedAt     *timestamppb.Timestamp `protobuf:"bytes,11,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	FinishedAt    *timestamppb.Timestamp `protobuf:"bytes,12,opt,name=finished_at,json=finishedAt,proto3" json:"finished_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *OwnershipTransfer) Reset() {
+	*x = OwnershipTransfer{}
+	mi := &file_models_admin_model_proto_msgTypes[9]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *OwnershipTransfer) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*OwnershipTransfer) ProtoMessage() {}
+
+func (x *OwnershipTransfer) ProtoReflect() protoreflect.Message {
+	mi := &file_models_admin_model_proto_msgTypes[9]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use OwnershipTransfer.ProtoReflect.Descriptor instead.
+func (*OwnershipTransfer) Descriptor() ([]byte, []int) {
+	return file_models_admin_model_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *OwnershipTransfer) GetId() uint64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *OwnershipTransfer) GetActorUserId() uint64 {
+	if x != nil {
+		return x.ActorUserId
+	}
+	return 0
+}
+
+func (x *OwnershipTransfer) GetFromUserId() uint64 {
+	if x != nil {
+		return x.FromUserId
+	}
+	return 0
+}
+
+func (x *OwnershipTransfer) GetToUserId() uint64 {
+	if x != nil {
+		return x.ToUserId
+	}
+	return 0
+}
+
+func (x *OwnershipTransfer) GetScope() TransferScope {
+	if x != nil {
+		return x.Scope
+	}
+	return TransferScope_TRANSFER_SCOPE_UNSPECIFIED
+}
+
+func (x *OwnershipTransfer) GetStatus() TransferStatus {
+	if x != nil {
+		return x.Status
+	}
+	return TransferStatus_TRANSFER_STATUS_UNSPECIFIED
+}
+
+func (x *OwnershipTransfer) GetVacanciesTransferred() uint64 {
+	if x != nil {
+		return x.VacanciesTransferred
+	}
+	return 0
+}
+
+func (x *OwnershipTransfer) GetCandidatesTransferred() uint64 {
+	if x != nil {
+		return x.CandidatesTransferred
+	}
+	return 0
+}
+
+func (x *OwnershipTransfer) GetError() string {
+	if x != nil {
+		return x.Error
+	}
+	return ""
+}
+
+func (x *OwnershipTransfer) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+func (x *OwnershipTransfer) GetUpdatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return nil
+}
+
+func (x *OwnershipTransfer) GetFinishedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.FinishedAt
+	}
+	return nil
+}
+
+type TransferOwnershipRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	FromUserId    uint64                 `protobuf:"varint,1,opt,name=from_user_id,json=fromUserId,proto3" json:"from_user_id,omitempty"`
+	ToUserId      uint64                 `protobuf:"varint,2,opt,name=to_user_id,json=toUserId,proto3" json:"to_user_id,omitempty"`
+	Scope         TransferScope          `protobuf:"varint,3,opt,name=scope,proto3,enum=admin.models.v1.TransferScope" json:"scope,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *TransferOwnershipRequest) Reset() {
+	*x = TransferOwnershipRequest{}
+	mi := &file_models_admin_model_proto_msgTypes[10]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TransferOwnershipRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TransferOwnershipRequest) ProtoMessage() {}
+
+func (x *TransferOwnershipRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_models_admin_model_proto_msgTypes[10]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TransferOwnershipRequest.ProtoReflect.Descriptor instead.
+func (*TransferOwnershipRequest) Descriptor() ([]byte, []int) {
+	return file_models_admin_model_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *TransferOwnershipRequest) GetFromUserId() uint64 {
+	if x != nil {
+		return x.FromUserId
+	}
+	return 0
+}
+
+func (x *TransferOwnershipRequest) GetToUserId() uint64 {
+	if x != nil {
+		return x.ToUserId
+	}
+	return 0
+}
+
+func (x *TransferOwnershipRequest) GetScope() TransferScope {
+	if x != nil {
+		return x.Scope
+	}
+	return TransferScope_TRANSFER_SCOPE_UNSPECIFIED
+}
+
+type OwnershipTransferResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Transfer      *OwnershipTransfer     `protobuf:"bytes,1,opt,name=transfer,proto3" json:"transfer,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *OwnershipTransferResponse) Reset() {
+	*x = OwnershipTransferResponse{}
+	mi := &file_models_admin_model_proto_msgTypes[11]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *OwnershipTransferResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*OwnershipTransferResponse) ProtoMessage() {}
+
+func (x *OwnershipTransferResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_models_admin_model_proto_msgTypes[11]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use OwnershipTransferResponse.ProtoReflect.Descriptor instead.
+func (*OwnershipTransferResponse) Descriptor() ([]byte, []int) {
+	return file_models_admin_model_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *OwnershipTransferResponse) GetTransfer() *OwnershipTransfer {
+	if x != nil {
+		return x.Transfer
+	}
+	return nil
+}
+
+type ListOwnershipTransfersRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListOwnershipTransfersRequest) Reset() {
+	*x = ListOwnershipTransfersRequest{}
+	mi := &file_models_admin_model_proto_msgTypes[12]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListOwnershipTransfersRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListOwnershipTransfersRequest) ProtoMessage() {}
+
+func (x *ListOwnershipTransfersRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_models_admin_model_proto_msgTypes[12]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListOwnershipTransfersRequest.ProtoReflect.Descriptor instead.
+func (*ListOwnershipTransfersRequest) Descriptor() ([]byte, []int) {
+	return file_models_admin_model_proto_rawDescGZIP(), []int{12}
+}
+
+type ListOwnershipTransfersResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Newest first, the last 100.
+	Transfers     []*OwnershipTransfer `protobuf:"bytes,1,rep,name=transfers,proto3" json:"transfers,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListOwnershipTransfersResponse) Reset() {
+	*x = ListOwnershipTransfersResponse{}
+	mi := &file_models_admin_model_proto_msgTypes[13]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListOwnershipTransfersResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListOwnershipTransfersResponse) ProtoMessage() {}
+
+func (x *ListOwnershipTransfersResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_models_admin_model_proto_msgTypes[13]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListOwnershipTransfersResponse.ProtoReflect.Descriptor instead.
+func (*ListOwnershipTransfersResponse) Descriptor() ([]byte, []int) {
+	return file_models_admin_model_proto_rawDescGZIP(), []int{13}
+}
+
+func (x *ListOwnershipTransfersResponse) GetTransfers() []*OwnershipTransfer {
+	if x != nil {
+		return x.Transfers
+	}
+	return nil
+}
+
 var File_models_admin_model_proto protoreflect.FileDescriptor
 
 const file_models_admin_model_proto_rawDesc = "" +
@@ -537,7 +971,48 @@ const file_models_admin_model_proto_rawDesc = "" +
 	"\auser_id\x18\x01 \x01(\x04R\x06userId\"H\n" +
 	"\x12UpdateRoleResponse\x12\x17\n" +
 	"\auser_id\x18\x01 \x01(\x04R\x06userId\x12\x19\n" +
-	"\bnew_role\x18\x02 \x01(\tR\anewRoleB5Z3github.com/artem13815/hr/gateway/internal/pb/modelsb\x06proto3"
+	"\bnew_role\x18\x02 \x01(\tR\anewRole\"\xab\x04\n" +
+	"\x11OwnershipTransfer\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x04R\x02id\x12\"\n" +
+	"\ractor_user_id\x18\x02 \x01(\x04R\vactorUserId\x12 \n" +
+	"\ffrom_user_id\x18\x03 \x01(\x04R\n" +
+	"fromUserId\x12\x1c\n" +
+	"\n" +
+	"to_user_id\x18\x04 \x01(\x04R\btoUserId\x124\n" +
+	"\x05scope\x18\x05 \x01(\x0e2\x1e.admin.models.v1.TransferScopeR\x05scope\x127\n" +
+	"\x06status\x18\x06 \x01(\x0e2\x1f.admin.models.v1.TransferStatusR\x06status\x123\n" +
+	"\x15vacancies_transferred\x18\a \x01(\x04R\x14vacanciesTransferred\x125\n" +
+	"\x16candidates_transferred\x18\b \x01(\x04R\x15candidatesTransferred\x12\x14\n" +
+	"\x05error\x18\t \x01(\tR\x05error\x129\n" +
+	"\n" +
+	"created_at\x18\n" +
+	" \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\x129\n" +
+	"\n" +
+	"updated_at\x18\v \x01(\v2\x1a.google.protobuf.TimestampR\tupdatedAt\x12;\n" +
+	"\vfinished_at\x18\f \x01(\v2\x1a.google.protobuf.TimestampR\n" +
+	"finishedAt\"\x90\x01\n" +
+	"\x18TransferOwnershipRequest\x12 \n" +
+	"\ffrom_user_id\x18\x01 \x01(\x04R\n" +
+	"fromUserId\x12\x1c\n" +
+	"\n" +
+	"to_user_id\x18\x02 \x01(\x04R\btoUserId\x124\n" +
+	"\x05scope\x18\x03 \x01(\x0e2\x1e.admin.models.v1.TransferScopeR\x05scope\"[\n" +
+	"\x19OwnershipTransferResponse\x12>\n" +
+	"\btransfer\x18\x01 \x01(\v2\".admin.models.v1.OwnershipTransferR\btransfer\"\x1f\n" +
+	"\x1dListOwnershipTransfersRequest\"b\n" +
+	"\x1eListOwnershipTransfersResponse\x12@\n" +
+	"\ttransfers\x18\x01 \x03(\v2\".admin.models.v1.OwnershipTransferR\ttransfers*\x84\x01\n" +
+	"\rTransferScope\x12\x1e\n" +
+	"\x1aTRANSFER_SCOPE_UNSPECIFIED\x10\x00\x12\x16\n" +
+	"\x12TRANSFER_SCOPE_ALL\x10\x01\x12\x1c\n" +
+	"\x18TRANSFER_SCOPE_VACANCIES\x10\x02\x12\x1d\n" +
+	"\x19TRANSFER_SCOPE_CANDIDATES\x10\x03*\xa8\x01\n" +
+	"\x0eTransferStatus\x12\x1f\n" +
+	"\x1bTRANSFER_STATUS_UNSPECIFIED\x10\x00\x12\x1b\n" +
+	"\x17TRANSFER_STATUS_RUNNING\x10\x01\x12\x1d\n" +
+	"\x19TRANSFER_STATUS_COMPLETED\x10\x02\x12\x1a\n" +
+	"\x16TRANSFER_STATUS_FAILED\x10\x03\x12\x1d\n" +
+	"\x19TRANSFER_STATUS_ABANDONED\x10\x04B5Z3github.com/artem13815/hr/gateway/internal/pb/modelsb\x06proto3"
 
 var (
 	file_models_admin_model_proto_rawDescOnce sync.Once
@@ -551,28 +1026,44 @@ func file_models_admin_model_proto_rawDescGZIP() []byte {
 	return file_models_admin_model_proto_rawDescData
 }
 
-var file_models_admin_model_proto_msgTypes = make([]protoimpl.MessageInfo, 9)
+var file_models_admin_model_proto_enumTypes = make([]protoimpl.EnumInfo, 2)
+var file_models_admin_model_proto_msgTypes = make([]protoimpl.MessageInfo, 14)
 var file_models_admin_model_proto_goTypes = []any{
-	(*SystemStats)(nil),           // 0: admin.models.v1.SystemStats
-	(*GetOverviewRequest)(nil),    // 1: admin.models.v1.GetOverviewRequest
-	(*OverviewResponse)(nil),      // 2: admin.models.v1.OverviewResponse
-	(*AdminUserView)(nil),         // 3: admin.models.v1.AdminUserView
-	(*ListUsersRequest)(nil),      // 4: admin.models.v1.ListUsersRequest
-	(*ListUsersResponse)(nil),     // 5: admin.models.v1.ListUsersResponse
-	(*PromoteUserRequest)(nil),    // 6: admin.models.v1.PromoteUserRequest
-	(*DemoteUserRequest)(nil),     // 7: admin.models.v1.DemoteUserRequest
-	(*UpdateRoleResponse)(nil),    // 8: admin.models.v1.UpdateRoleResponse
-	(*timestamppb.Timestamp)(nil), // 9: google.protobuf.Timestamp
+	(TransferScope)(0),                     // 0: admin.models.v1.TransferScope
+	(TransferStatus)(0),                    // 1: admin.models.v1.TransferStatus
+	(*SystemStats)(nil),                    // 2: admin.models.v1.SystemStats
+	(*GetOverviewRequest)(nil),             // 3: admin.models.v1.GetOverviewRequest
+	(*OverviewResponse)(nil),               // 4: admin.models.v1.OverviewResponse
+	(*AdminUserView)(nil),                  // 5: admin.models.v1.AdminUserView
+	(*ListUsersRequest)(nil),               // 6: admin.models.v1.ListUsersRequest
+	(*ListUsersResponse)(nil),              // 7: admin.models.v1.ListUsersResponse
+	(*PromoteUserRequest)(nil),             // 8: admin.models.v1.PromoteUserRequest
+	(*DemoteUserRequest)(nil),              // 9: admin.models.v1.DemoteUserRequest
+	(*UpdateRoleResponse)(nil),             // 10: admin.models.v1.UpdateRoleResponse
+	(*OwnershipTransfer)(nil),              // 11: admin.models.v1.OwnershipTransfer
+	(*TransferOwnershipRequest)(nil),       // 12: admin.models.v1.TransferOwnershipRequest
+	(*OwnershipTransferResponse)(nil),      // 13: admin.models.v1.OwnershipTransferResponse
+	(*ListOwnershipTransfersRequest)(nil),  // 14: admin.models.v1.ListOwnershipTransfersRequest
+	(*ListOwnershipTransfersResponse)(nil), // 15: admin.models.v1.ListOwnershipTransfersResponse
+	(*timestamppb.Timestamp)(nil),          // 16: google.protobuf.Timestamp
 }
 var file_models_admin_model_proto_depIdxs = []int32{
-	0, // 0: admin.models.v1.OverviewResponse.stats:type_name -> admin.models.v1.SystemStats
-	9, // 1: admin.models.v1.AdminUserView.created_at:type_name -> google.protobuf.Timestamp
-	3, // 2: admin.models.v1.ListUsersResponse.users:type_name -> admin.models.v1.AdminUserView
-	3, // [3:3] is the sub-list for method output_type
-	3, // [3:3] is the sub-list for method input_type
-	3, // [3:3] is the sub-list for extension type_name
-	3, // [3:3] is the sub-list for extension extendee
-	0, // [0:3] is the sub-list for field type_name
+	2,  // 0: admin.models.v1.OverviewResponse.stats:type_name -> admin.models.v1.SystemStats
+	16, // 1: admin.models.v1.AdminUserView.created_at:type_name -> google.protobuf.Timestamp
+	5,  // 2: admin.models.v1.ListUsersResponse.users:type_name -> admin.models.v1.AdminUserView
+	0,  // 3: admin.models.v1.OwnershipTransfer.scope:type_name -> admin.models.v1.TransferScope
+	1,  // 4: admin.models.v1.OwnershipTransfer.status:type_name -> admin.models.v1.TransferStatus
+	16, // 5: admin.models.v1.OwnershipTransfer.created_at:type_name -> google.protobuf.Timestamp
+	16, // 6: admin.models.v1.OwnershipTransfer.updated_at:type_name -> google.protobuf.Timestamp
+	16, // 7: admin.models.v1.OwnershipTransfer.finished_at:type_name -> google.protobuf.Timestamp
+	0,  // 8: admin.models.v1.TransferOwnershipRequest.scope:type_name -> admin.models.v1.TransferScope
+	11, // 9: admin.models.v1.OwnershipTransferResponse.transfer:type_name -> admin.models.v1.OwnershipTransfer
+	11, // 10: admin.models.v1.ListOwnershipTransfersResponse.transfers:type_name -> admin.models.v1.OwnershipTransfer
+	11, // [11:11] is the sub-list for method output_type
+	11, // [11:11] is the sub-list for method input_type
+	11, // [11:11] is the sub-list for extension type_name
+	11, // [11:11] is the sub-list for extension extendee
+	0,  // [0:11] is the sub-list for field type_name
 }
 
 func init() { file_models_admin_model_proto_init() }
@@ -585,13 +1076,14 @@ func file_models_admin_model_proto_init() {
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_models_admin_model_proto_rawDesc), len(file_models_admin_model_proto_rawDesc)),
-			NumEnums:      0,
-			NumMessages:   9,
+			NumEnums:      2,
+			NumMessages:   14,
 			NumExtensions: 0,
 			NumServices:   0,
 		},
 		GoTypes:           file_models_admin_model_proto_goTypes,
 		DependencyIndexes: file_models_admin_model_proto_depIdxs,
+		EnumInfos:         file_models_admin_model_proto_enumTypes,
 		MessageInfos:      file_models_admin_model_proto_msgTypes,
 	}.Build()
 	File_models_admin_model_proto = out.File
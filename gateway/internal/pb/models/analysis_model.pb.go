@@ -171,8 +171,14 @@ type ScoreBreakdown struct {
 	MustHavePenalty float32                `protobuf:"fixed32,5,opt,name=must_have_penalty,json=mustHavePenalty,proto3" json:"must_have_penalty,omitempty"`
 	NiceToHaveBonus float32                `protobuf:"fixed32,6,opt,name=nice_to_have_bonus,json=niceToHaveBonus,proto3" json:"nice_to_have_bonus,omitempty"`
 	Explanation     string                 `protobuf:"bytes,7,opt,name=explanation,proto3" json:"explanation,omitempty"`
-	unknownFields   protoimpl.UnknownFields
-	sizeCache       protoimpl.SizeCache
+	// Scoring policy of the vacancy the score was computed with. Analyses
+	// made before per-vacancy policies report the defaults.
+	Policy *AppliedScoringPolicy `protobuf:"bytes,8,opt,name=policy,proto3" json:"policy,omitempty"`
+	// True when the policy rejects any missing must-have skill and the
+	// recommendation was forced to "no" regardless of the score.
+	MustHaveVeto  bool `protobuf:"varint,9,opt,name=must_have_veto,json=mustHaveVeto,proto3" json:"must_have_veto,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
 func (x *ScoreBreakdown) Reset() {
@@ -254,6 +260,98 @@ func (x *ScoreBreakdown) GetExplanation() string {
 	return ""
 }
 
+func (x *ScoreBreakdown) GetPolicy() *AppliedScoringPolicy {
+	if x != nil {
+		return x.Policy
+	}
+	return nil
+}
+
+func (x *ScoreBreakdown) GetMustHaveVeto() bool {
+	if x != nil {
+		return x.MustHaveVeto
+	}
+	return false
+}
+
+// AppliedScoringPolicy mirrors vacancy.models.v1.ScoringPolicy as it stood
+// when the analysis ran.
+type AppliedScoringPolicy struct {
+	state                   protoimpl.MessageState `protogen:"open.v1"`
+	MustHavePenalty         float32                `protobuf:"fixed32,1,opt,name=must_have_penalty,json=mustHavePenalty,proto3" json:"must_have_penalty,omitempty"`
+	NiceToHaveBonus         float32                `protobuf:"fixed32,2,opt,name=nice_to_have_bonus,json=niceToHaveBonus,proto3" json:"nice_to_have_bonus,omitempty"`
+	HireThreshold           float32                `protobuf:"fixed32,3,opt,name=hire_threshold,json=hireThreshold,proto3" json:"hire_threshold,omitempty"`
+	MaybeThreshold          float32                `protobuf:"fixed32,4,opt,name=maybe_threshold,json=maybeThreshold,proto3" json:"maybe_threshold,omitempty"`
+	RejectOnMissingMustHave bool                   `protobuf:"varint,5,opt,name=reject_on_missing_must_have,json=rejectOnMissingMustHave,proto3" json:"reject_on_missing_must_have,omitempty"`
+	unknownFields           protoimpl.UnknownFields
+	sizeCache               protoimpl.SizeCache
+}
+
+func (x *AppliedScoringPolicy) Reset() {
+	*x = AppliedScoringPolicy{}
+	mi := &file_models_analysis_model_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AppliedScoringPolicy) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AppliedScoringPolicy) ProtoMessage() {}
+
+func (x *AppliedScoringPolicy) ProtoReflect() protoreflect.Message {
+	mi := &file_models_analysis_model_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AppliedScoringPolicy.ProtoReflect.Descriptor instead.
+func (*AppliedScoringPolicy) Descriptor() ([]byte, []int) {
+	return file_models_analysis_model_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *AppliedScoringPolicy) GetMustHavePenalty() float32 {
+	if x != nil {
+		return x.MustHavePenalty
+	}
+	return 0
+}
+
+func (x *AppliedScoringPolicy) GetNiceToHaveBonus() float32 {
+	if x != nil {
+		return x.NiceToHaveBonus
+	}
+	return 0
+}
+
+func (x *AppliedScoringPolicy) GetHireThreshold() float32 {
+	if x != nil {
+		return x.HireThreshold
+	}
+	return 0
+}
+
+func (x *AppliedScoringPolicy) GetMaybeThreshold() float32 {
+	if x != nil {
+		return x.MaybeThreshold
+	}
+	return 0
+}
+
+func (x *AppliedScoringPolicy) GetRejectOnMissingMustHave() bool {
+	if x != nil {
+		return x.RejectOnMissingMustHave
+	}
+	return false
+}
+
 type AgentResult struct {
 	state          protoimpl.MessageState `protogen:"open.v1"`
 	AgentName      string                 `protobuf:"bytes,1,opt,name=agent_name,json=agentName,proto3" json:"agent_name,omitempty"`
@@ -266,7 +364,7 @@ type AgentResult struct {
 
 func (x *AgentResult) Reset() {
 	*x = AgentResult{}
-	mi := &file_models_analysis_model_proto_msgTypes[2]
+	mi := &file_models_analysis_model_proto_msgTypes[3]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -278,7 +376,7 @@ func (x *AgentResult) String() string {
 func (*AgentResult) ProtoMessage() {}
 
 func (x *AgentResult) ProtoReflect() protoreflect.Message {
-	mi := &file_models_analysis_model_proto_msgTypes[2]
+	mi := &file_models_analysis_model_proto_msgTypes[3]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -291,7 +389,7 @@ func (x *AgentResult) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use AgentResult.ProtoReflect.Descriptor instead.
 func (*AgentResult) Descriptor() ([]byte, []int) {
-	return file_models_analysis_model_proto_rawDescGZIP(), []int{2}
+	return file_models_analysis_model_proto_rawDescGZIP(), []int{3}
 }
 
 func (x *AgentResult) GetAgentName() string {
@@ -337,7 +435,7 @@ type AIDecision struct {
 
 func (x *AIDecision) Reset() {
 	*x = AIDecision{}
-	mi := &file_models_analysis_model_proto_msgTypes[3]
+	mi := &file_models_analysis_model_proto_msgTypes[4]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -349,7 +447,7 @@ func (x *AIDecision) String() string {
 func (*AIDecision) ProtoMessage() {}
 
 func (x *AIDecision) ProtoReflect() protoreflect.Message {
-	mi := &file_models_analysis_model_proto_msgTypes[3]
+	mi := &file_models_analysis_model_proto_msgTypes[4]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -362,7 +460,7 @@ func (x *AIDecision) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use AIDecision.ProtoReflect.Descriptor instead.
 func (*AIDecision) Descriptor() ([]byte, []int) {
-	return file_models_analysis_model_proto_rawDescGZIP(), []int{3}
+	return file_models_analysis_model_proto_rawDescGZIP(), []int{4}
 }
 
 func (x *AIDecision) GetHrRecommendation() string {
@@ -435,7 +533,7 @@ type Analysis struct {
 
 func (x *Analysis) Reset() {
 	*x = Analysis{}
-	mi := &file_models_analysis_model_proto_msgTypes[4]
+	mi := &file_models_analysis_model_proto_msgTypes[5]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -447,7 +545,7 @@ func (x *Analysis) String() string {
 func (*Analysis) ProtoMessage() {}
 
 func (x *Analysis) ProtoReflect() protoreflect.Message {
-	mi := &file_models_analysis_model_proto_msgTypes[4]
+	mi := &file_models_analysis_model_proto_msgTypes[5]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -460,7 +558,7 @@ func (x *Analysis) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use Analysis.ProtoReflect.Descriptor instead.
 func (*Analysis) Descriptor() ([]byte, []int) {
-	return file_models_analysis_model_proto_rawDescGZIP(), []int{4}
+	return file_models_analysis_model_proto_rawDescGZIP(), []int{5}
 }
 
 func (x *Analysis) GetId() string {
@@ -565,7 +663,7 @@ type StartAnalysisRequest struct {
 
 func (x *StartAnalysisRequest) Reset() {
 	*x = StartAnalysisRequest{}
-	mi := &file_models_analysis_model_proto_msgTypes[5]
+	mi := &file_models_analysis_model_proto_msgTypes[6]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -577,7 +675,7 @@ func (x *StartAnalysisRequest) String() string {
 func (*StartAnalysisRequest) ProtoMessage() {}
 
 func (x *StartAnalysisRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_models_analysis_model_proto_msgTypes[5]
+	mi := &file_models_analysis_model_proto_msgTypes[6]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -590,7 +688,7 @@ func (x *StartAnalysisRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use StartAnalysisRequest.ProtoReflect.Descriptor instead.
 func (*StartAnalysisRequest) Descriptor() ([]byte, []int) {
-	return file_models_analysis_model_proto_rawDescGZIP(), []int{5}
+	return file_models_analysis_model_proto_rawDescGZIP(), []int{6}
 }
 
 func (x *StartAnalysisRequest) GetResumeId() string {
@@ -624,7 +722,7 @@ type StartAnalysisResponse struct {
 
 func (x *StartAnalysisResponse) Reset() {
 	*x = StartAnalysisResponse{}
-	mi := &file_models_analysis_model_proto_msgTypes[6]
+	mi := &file_models_analysis_model_proto_msgTypes[7]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -636,7 +734,7 @@ func (x *StartAnalysisResponse) String() string {
 func (*StartAnalysisResponse) ProtoMessage() {}
 
 func (x *StartAnalysisResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_models_analysis_model_proto_msgTypes[6]
+	mi := &file_models_analysis_model_proto_msgTypes[7]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -649,7 +747,7 @@ func (x *StartAnalysisResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use StartAnalysisResponse.ProtoReflect.Descriptor instead.
 func (*StartAnalysisResponse) Descriptor() ([]byte, []int) {
-	return file_models_analysis_model_proto_rawDescGZIP(), []int{6}
+	return file_models_analysis_model_proto_rawDescGZIP(), []int{7}
 }
 
 func (x *StartAnalysisResponse) GetAnalysisId() string {
@@ -666,6 +764,144 @@ func (x *StartAnalysisResponse) GetStatus() AnalysisStatus {
 	return AnalysisStatus_ANALYSIS_STATUS_UNSPECIFIED
 }
 
+// StartApplicationAnalysisRequest is sent by the resume service after a
+// public application is stored. Internal only: no HTTP binding.
+type StartApplicationAnalysisRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ResumeId      string                 `protobuf:"bytes,1,opt,name=resume_id,json=resumeId,proto3" json:"resume_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *StartApplicationAnalysisRequest) Reset() {
+	*x = StartApplicationAnalysisRequest{}
+	mi := &file_models_analysis_model_proto_msgTypes[8]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *StartApplicationAnalysisRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StartApplicationAnalysisRequest) ProtoMessage() {}
+
+func (x *StartApplicationAnalysisRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_models_analysis_model_proto_msgTypes[8]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StartApplicationAnalysisRequest.ProtoReflect.Descriptor instead.
+func (*StartApplicationAnalysisRequest) Descriptor() ([]byte, []int) {
+	return file_models_analysis_model_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *StartApplicationAnalysisRequest) GetResumeId() string {
+	if x != nil {
+		return x.ResumeId
+	}
+	return ""
+}
+
+// PurgeVacancyAnalysesRequest is sent by the resume service's side of the
+// vacancy retention job. Internal only: no HTTP binding.
+type PurgeVacancyAnalysesRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	VacancyId     string                 `protobuf:"bytes,1,opt,name=vacancy_id,json=vacancyId,proto3" json:"vacancy_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PurgeVacancyAnalysesRequest) Reset() {
+	*x = PurgeVacancyAnalysesRequest{}
+	mi := &file_models_analysis_model_proto_msgTypes[9]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PurgeVacancyAnalysesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PurgeVacancyAnalysesRequest) ProtoMessage() {}
+
+func (x *PurgeVacancyAnalysesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_models_analysis_model_proto_msgTypes[9]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PurgeVacancyAnalysesRequest.ProtoReflect.Descriptor instead.
+func (*PurgeVacancyAnalysesRequest) Descriptor() ([]byte, []int) {
+	return file_models_analysis_model_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *PurgeVacancyAnalysesRequest) GetVacancyId() string {
+	if x != nil {
+		return x.VacancyId
+	}
+	return ""
+}
+
+// PurgeVacancyAnalysesResponse counts the analyses removed; zero when an
+// earlier call already purged them.
+type PurgeVacancyAnalysesResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Analyses      uint32                 `protobuf:"varint,1,opt,name=analyses,proto3" json:"analyses,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PurgeVacancyAnalysesResponse) Reset() {
+	*x = PurgeVacancyAnalysesResponse{}
+	mi := &file_models_analysis_model_proto_msgTypes[10]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PurgeVacancyAnalysesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PurgeVacancyAnalysesResponse) ProtoMessage() {}
+
+func (x *PurgeVacancyAnalysesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_models_analysis_model_proto_msgTypes[10]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PurgeVacancyAnalysesResponse.ProtoReflect.Descriptor instead.
+func (*PurgeVacancyAnalysesResponse) Descriptor() ([]byte, []int) {
+	return file_models_analysis_model_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *PurgeVacancyAnalysesResponse) GetAnalyses() uint32 {
+	if x != nil {
+		return x.Analyses
+	}
+	return 0
+}
+
 type GetAnalysisRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	AnalysisId    string                 `protobuf:"bytes,1,opt,name=analysis_id,json=analysisId,proto3" json:"analysis_id,omitempty"`
@@ -675,7 +911,7 @@ type GetAnalysisRequest struct {
 
 func (x *GetAnalysisRequest) Reset() {
 	*x = GetAnalysisRequest{}
-	mi := &file_models_analysis_model_proto_msgTypes[7]
+	mi := &file_models_analysis_model_proto_msgTypes[11]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -687,7 +923,7 @@ func (x *GetAnalysisRequest) String() string {
 func (*GetAnalysisRequest) ProtoMessage() {}
 
 func (x *GetAnalysisRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_models_analysis_model_proto_msgTypes[7]
+	mi := &file_models_analysis_model_proto_msgTypes[11]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -700,7 +936,7 @@ func (x *GetAnalysisRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetAnalysisRequest.ProtoReflect.Descriptor instead.
 func (*GetAnalysisRequest) Descriptor() ([]byte, []int) {
-	return file_models_analysis_model_proto_rawDescGZIP(), []int{7}
+	return file_models_analysis_model_proto_rawDescGZIP(), []int{11}
 }
 
 func (x *GetAnalysisRequest) GetAnalysisId() string {
@@ -719,7 +955,7 @@ type AnalysisResponse struct {
 
 func (x *AnalysisResponse) Reset() {
 	*x = AnalysisResponse{}
-	mi := &file_models_analysis_model_proto_msgTypes[8]
+	mi := &file_models_analysis_model_proto_msgTypes[12]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -731,7 +967,7 @@ func (x *AnalysisResponse) String() string {
 func (*AnalysisResponse) ProtoMessage() {}
 
 func (x *AnalysisResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_models_analysis_model_proto_msgTypes[8]
+	mi := &file_models_analysis_model_proto_msgTypes[12]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -744,7 +980,7 @@ func (x *AnalysisResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use AnalysisResponse.ProtoReflect.Descriptor instead.
 func (*AnalysisResponse) Descriptor() ([]byte, []int) {
-	return file_models_analysis_model_proto_rawDescGZIP(), []int{8}
+	return file_models_analysis_model_proto_rawDescGZIP(), []int{12}
 }
 
 func (x *AnalysisResponse) GetAnalysis() *Analysis {
@@ -761,13 +997,17 @@ type ListCandidatesByVacancyRequest struct {
 	MinScore      float32                `protobuf:"fixed32,3,opt,name=min_score,json=minScore,proto3" json:"min_score,omitempty"`
 	RequiredSkill string                 `protobuf:"bytes,4,opt,name=required_skill,json=requiredSkill,proto3" json:"required_skill,omitempty"`
 	ScoreOrder    common.SortOrder       `protobuf:"varint,5,opt,name=score_order,json=scoreOrder,proto3,enum=common.v1.SortOrder" json:"score_order,omitempty"`
+	// stage keeps candidates currently in this pipeline stage of the vacancy
+	// (a stage key, "hired" or "rejected"; see vacancy GetVacancyPipeline).
+	// Empty means every stage.
+	Stage         string `protobuf:"bytes,6,opt,name=stage,proto3" json:"stage,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
 func (x *ListCandidatesByVacancyRequest) Reset() {
 	*x = ListCandidatesByVacancyRequest{}
-	mi := &file_models_analysis_model_proto_msgTypes[9]
+	mi := &file_models_analysis_model_proto_msgTypes[13]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -779,7 +1019,7 @@ func (x *ListCandidatesByVacancyRequest) String() string {
 func (*ListCandidatesByVacancyRequest) ProtoMessage() {}
 
 func (x *ListCandidatesByVacancyRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_models_analysis_model_proto_msgTypes[9]
+	mi := &file_models_analysis_model_proto_msgTypes[13]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -792,7 +1032,7 @@ func (x *ListCandidatesByVacancyRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ListCandidatesByVacancyRequest.ProtoReflect.Descriptor instead.
 func (*ListCandidatesByVacancyRequest) Descriptor() ([]byte, []int) {
-	return file_models_analysis_model_proto_rawDescGZIP(), []int{9}
+	return file_models_analysis_model_proto_rawDescGZIP(), []int{13}
 }
 
 func (x *ListCandidatesByVacancyRequest) GetVacancyId() string {
@@ -830,6 +1070,13 @@ func (x *ListCandidatesByVacancyRequest) GetScoreOrder() common.SortOrder {
 	return common.SortOrder(0)
 }
 
+func (x *ListCandidatesByVacancyRequest) GetStage() string {
+	if x != nil {
+		return x.Stage
+	}
+	return ""
+}
+
 type CandidateWithAnalysis struct {
 	state          protoimpl.MessageState `protogen:"open.v1"`
 	CandidateId    string                 `protobuf:"bytes,1,opt,name=candidate_id,json=candidateId,proto3" json:"candidate_id,omitempty"`
@@ -840,13 +1087,15 @@ type CandidateWithAnalysis struct {
 	AnalysisId     string                 `protobuf:"bytes,6,opt,name=analysis_id,json=analysisId,proto3" json:"analysis_id,omitempty"`
 	AnalysisStatus AnalysisStatus         `protobuf:"varint,7,opt,name=analysis_status,json=analysisStatus,proto3,enum=analysis.models.v1.AnalysisStatus" json:"analysis_status,omitempty"`
 	CreatedAt      *timestamppb.Timestamp `protobuf:"bytes,8,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
-	unknownFields  protoimpl.UnknownFields
-	sizeCache      protoimpl.SizeCache
+	// stage is the candidate's current pipeline stage.
+	Stage         string `protobuf:"bytes,9,opt,name=stage,proto3" json:"stage,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
 func (x *CandidateWithAnalysis) Reset() {
 	*x = CandidateWithAnalysis{}
-	mi := &file_models_analysis_model_proto_msgTypes[10]
+	mi := &file_models_analysis_model_proto_msgTypes[14]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -858,7 +1107,7 @@ func (x *CandidateWithAnalysis) String() string {
 func (*CandidateWithAnalysis) ProtoMessage() {}
 
 func (x *CandidateWithAnalysis) ProtoReflect() protoreflect.Message {
-	mi := &file_models_analysis_model_proto_msgTypes[10]
+	mi := &file_models_analysis_model_proto_msgTypes[14]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -871,7 +1120,7 @@ func (x *CandidateWithAnalysis) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use CandidateWithAnalysis.ProtoReflect.Descriptor instead.
 func (*CandidateWithAnalysis) Descriptor() ([]byte, []int) {
-	return file_models_analysis_model_proto_rawDescGZIP(), []int{10}
+	return file_models_analysis_model_proto_rawDescGZIP(), []int{14}
 }
 
 func (x *CandidateWithAnalysis) GetCandidateId() string {
@@ -930,6 +1179,13 @@ func (x *CandidateWithAnalysis) GetCreatedAt() *timestamppb.Timestamp {
 	return nil
 }
 
+func (x *CandidateWithAnalysis) GetStage() string {
+	if x != nil {
+		return x.Stage
+	}
+	return ""
+}
+
 type ListCandidatesByVacancyResponse struct {
 	state         protoimpl.MessageState   `protogen:"open.v1"`
 	Candidates    []*CandidateWithAnalysis `protobuf:"bytes,1,rep,name=candidates,proto3" json:"candidates,omitempty"`
@@ -940,7 +1196,7 @@ type ListCandidatesByVacancyResponse struct {
 
 func (x *ListCandidatesByVacancyResponse) Reset() {
 	*x = ListCandidatesByVacancyResponse{}
-	mi := &file_models_analysis_model_proto_msgTypes[11]
+	mi := &file_models_analysis_model_proto_msgTypes[15]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -952,7 +1208,7 @@ func (x *ListCandidatesByVacancyResponse) String() string {
 func (*ListCandidatesByVacancyResponse) ProtoMessage() {}
 
 func (x *ListCandidatesByVacancyResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_models_analysis_model_proto_msgTypes[11]
+	mi := &file_models_analysis_model_proto_msgTypes[15]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -965,7 +1221,7 @@ func (x *ListCandidatesByVacancyResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ListCandidatesByVacancyResponse.ProtoReflect.Descriptor instead.
 func (*ListCandidatesByVacancyResponse) Descriptor() ([]byte, []int) {
-	return file_models_analysis_model_proto_rawDescGZIP(), []int{11}
+	return file_models_analysis_model_proto_rawDescGZIP(), []int{15}
 }
 
 func (x *ListCandidatesByVacancyResponse) GetCandidates() []*CandidateWithAnalysis {
@@ -993,7 +1249,7 @@ const file_models_analysis_model_proto_rawDesc = "" +
 	"\tpositions\x18\x03 \x03(\tR\tpositions\x12\"\n" +
 	"\ftechnologies\x18\x04 \x03(\tR\ftechnologies\x12\x1c\n" +
 	"\teducation\x18\x05 \x03(\tR\teducation\x12\x18\n" +
-	"\asummary\x18\x06 \x01(\tR\asummary\"\x9b\x02\n" +
+	"\asummary\x18\x06 \x01(\tR\asummary\"\x83\x03\n" +
 	"\x0eScoreBreakdown\x12%\n" +
 	"\x0ematched_skills\x18\x01 \x03(\tR\rmatchedSkills\x12%\n" +
 	"\x0emissing_skills\x18\x02 \x03(\tR\rmissingSkills\x12!\n" +
@@ -1002,7 +1258,15 @@ const file_models_analysis_model_proto_rawDesc = "" +
 	"base_score\x18\x04 \x01(\x02R\tbaseScore\x12*\n" +
 	"\x11must_have_penalty\x18\x05 \x01(\x02R\x0fmustHavePenalty\x12+\n" +
 	"\x12nice_to_have_bonus\x18\x06 \x01(\x02R\x0fniceToHaveBonus\x12 \n" +
-	"\vexplanation\x18\a \x01(\tR\vexplanation\"\x8f\x01\n" +
+	"\vexplanation\x18\a \x01(\tR\vexplanation\x12@\n" +
+	"\x06policy\x18\b \x01(\v2(.analysis.models.v1.AppliedScoringPolicyR\x06policy\x12$\n" +
+	"\x0emust_have_veto\x18\t \x01(\bR\fmustHaveVeto\"\xfd\x01\n" +
+	"\x14AppliedScoringPolicy\x12*\n" +
+	"\x11must_have_penalty\x18\x01 \x01(\x02R\x0fmustHavePenalty\x12+\n" +
+	"\x12nice_to_have_bonus\x18\x02 \x01(\x02R\x0fniceToHaveBonus\x12%\n" +
+	"\x0ehire_threshold\x18\x03 \x01(\x02R\rhireThreshold\x12'\n" +
+	"\x0fmaybe_threshold\x18\x04 \x01(\x02R\x0emaybeThreshold\x12<\n" +
+	"\x1breject_on_missing_must_have\x18\x05 \x01(\bR\x17rejectOnMissingMustHave\"\x8f\x01\n" +
 	"\vAgentResult\x12\x1d\n" +
 	"\n" +
 	"agent_name\x18\x01 \x01(\tR\tagentName\x12\x18\n" +
@@ -1049,12 +1313,19 @@ const file_models_analysis_model_proto_rawDesc = "" +
 	"\x15StartAnalysisResponse\x12\x1f\n" +
 	"\vanalysis_id\x18\x01 \x01(\tR\n" +
 	"analysisId\x12:\n" +
-	"\x06status\x18\x02 \x01(\x0e2\".analysis.models.v1.AnalysisStatusR\x06status\"5\n" +
+	"\x06status\x18\x02 \x01(\x0e2\".analysis.models.v1.AnalysisStatusR\x06status\">\n" +
+	"\x1fStartApplicationAnalysisRequest\x12\x1b\n" +
+	"\tresume_id\x18\x01 \x01(\tR\bresumeId\"<\n" +
+	"\x1bPurgeVacancyAnalysesRequest\x12\x1d\n" +
+	"\n" +
+	"vacancy_id\x18\x01 \x01(\tR\tvacancyId\":\n" +
+	"\x1cPurgeVacancyAnalysesResponse\x12\x1a\n" +
+	"\banalyses\x18\x01 \x01(\rR\banalyses\"5\n" +
 	"\x12GetAnalysisRequest\x12\x1f\n" +
 	"\vanalysis_id\x18\x01 \x01(\tR\n" +
 	"analysisId\"L\n" +
 	"\x10AnalysisResponse\x128\n" +
-	"\banalysis\x18\x01 \x01(\v2\x1c.analysis.models.v1.AnalysisR\banalysis\"\xe6\x01\n" +
+	"\banalysis\x18\x01 \x01(\v2\x1c.analysis.models.v1.AnalysisR\banalysis\"\xfc\x01\n" +
 	"\x1eListCandidatesByVacancyRequest\x12\x1d\n" +
 	"\n" +
 	"vacancy_id\x18\x01 \x01(\tR\tvacancyId\x12*\n" +
@@ -1062,7 +1333,8 @@ const file_models_analysis_model_proto_rawDesc = "" +
 	"\tmin_score\x18\x03 \x01(\x02R\bminScore\x12%\n" +
 	"\x0erequired_skill\x18\x04 \x01(\tR\rrequiredSkill\x125\n" +
 	"\vscore_order\x18\x05 \x01(\x0e2\x14.common.v1.SortOrderR\n" +
-	"scoreOrder\"\xcd\x02\n" +
+	"scoreOrder\x12\x14\n" +
+	"\x05stage\x18\x06 \x01(\tR\x05stage\"\xe3\x02\n" +
 	"\x15CandidateWithAnalysis\x12!\n" +
 	"\fcandidate_id\x18\x01 \x01(\tR\vcandidateId\x12\x1b\n" +
 	"\tfull_name\x18\x02 \x01(\tR\bfullName\x12\x14\n" +
@@ -1074,7 +1346,8 @@ const file_models_analysis_model_proto_rawDesc = "" +
 	"analysisId\x12K\n" +
 	"\x0fanalysis_status\x18\a \x01(\x0e2\".analysis.models.v1.AnalysisStatusR\x0eanalysisStatus\x129\n" +
 	"\n" +
-	"created_at\x18\b \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\"\x99\x01\n" +
+	"created_at\x18\b \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\x12\x14\n" +
+	"\x05stage\x18\t \x01(\tR\x05stage\"\x99\x01\n" +
 	"\x1fListCandidatesByVacancyResponse\x12I\n" +
 	"\n" +
 	"candidates\x18\x01 \x03(\v2).analysis.models.v1.CandidateWithAnalysisR\n" +
@@ -1100,47 +1373,52 @@ func file_models_analysis_model_proto_rawDescGZIP() []byte {
 }
 
 var file_models_analysis_model_proto_enumTypes = make([]protoimpl.EnumInfo, 1)
-var file_models_analysis_model_proto_msgTypes = make([]protoimpl.MessageInfo, 12)
+var file_models_analysis_model_proto_msgTypes = make([]protoimpl.MessageInfo, 16)
 var file_models_analysis_model_proto_goTypes = []any{
 	(AnalysisStatus)(0),                     // 0: analysis.models.v1.AnalysisStatus
 	(*CandidateProfile)(nil),                // 1: analysis.models.v1.CandidateProfile
 	(*ScoreBreakdown)(nil),                  // 2: analysis.models.v1.ScoreBreakdown
-	(*AgentResult)(nil),                     // 3: analysis.models.v1.AgentResult
-	(*AIDecision)(nil),                      // 4: analysis.models.v1.AIDecision
-	(*Analysis)(nil),                        // 5: analysis.models.v1.Analysis
-	(*StartAnalysisRequest)(nil),            // 6: analysis.models.v1.StartAnalysisRequest
-	(*StartAnalysisResponse)(nil),           // 7: analysis.models.v1.StartAnalysisResponse
-	(*GetAnalysisRequest)(nil),              // 8: analysis.models.v1.GetAnalysisRequest
-	(*AnalysisResponse)(nil),                // 9: analysis.models.v1.AnalysisResponse
-	(*ListCandidatesByVacancyRequest)(nil),  // 10: analysis.models.v1.ListCandidatesByVacancyRequest
-	(*CandidateWithAnalysis)(nil),           // 11: analysis.models.v1.CandidateWithAnalysis
-	(*ListCandidatesByVacancyResponse)(nil), // 12: analysis.models.v1.ListCandidatesByVacancyResponse
-	(*timestamppb.Timestamp)(nil),           // 13: google.protobuf.Timestamp
-	(*common.PageRequest)(nil),              // 14: common.v1.PageRequest
-	(common.SortOrder)(0),                   // 15: common.v1.SortOrder
-	(*common.PageResponse)(nil),             // 16: common.v1.PageResponse
+	(*AppliedScoringPolicy)(nil),            // 3: analysis.models.v1.AppliedScoringPolicy
+	(*AgentResult)(nil),                     // 4: analysis.models.v1.AgentResult
+	(*AIDecision)(nil),                      // 5: analysis.models.v1.AIDecision
+	(*Analysis)(nil),                        // 6: analysis.models.v1.Analysis
+	(*StartAnalysisRequest)(nil),            // 7: analysis.models.v1.StartAnalysisRequest
+	(*StartAnalysisResponse)(nil),           // 8: analysis.models.v1.StartAnalysisResponse
+	(*StartApplicationAnalysisRequest)(nil), // 9: analysis.models.v1.StartApplicationAnalysisRequest
+	(*PurgeVacancyAnalysesRequest)(nil),     // 10: analysis.models.v1.PurgeVacancyAnalysesRequest
+	(*PurgeVacancyAnalysesResponse)(nil),    // 11: analysis.models.v1.PurgeVacancyAnalysesResponse
+	(*GetAnalysisRequest)(nil),              // 12: analysis.models.v1.GetAnalysisRequest
+	(*AnalysisResponse)(nil),                // 13: analysis.models.v1.AnalysisResponse
+	(*ListCandidatesByVacancyRequest)(nil),  // 14: analysis.models.v1.ListCandidatesByVacancyRequest
+	(*CandidateWithAnalysis)(nil),           // 15: analysis.models.v1.CandidateWithAnalysis
+	(*ListCandidatesByVacancyResponse)(nil), // 16: analysis.models.v1.ListCandidatesByVacancyResponse
+	(*timestamppb.Timestamp)(nil),           // 17: google.protobuf.Timestamp
+	(*common.PageRequest)(nil),              // 18: common.v1.PageRequest
+	(common.SortOrder)(0),                   // 19: common.v1.SortOrder
+	(*common.PageResponse)(nil),             // 20: common.v1.PageResponse
 }
 var file_models_analysis_model_proto_depIdxs = []int32{
-	3,  // 0: analysis.models.v1.AIDecision.agent_results:type_name -> analysis.models.v1.AgentResult
-	0,  // 1: analysis.models.v1.Analysis.status:type_name -> analysis.models.v1.AnalysisStatus
-	1,  // 2: analysis.models.v1.Analysis.profile:type_name -> analysis.models.v1.CandidateProfile
-	2,  // 3: analysis.models.v1.Analysis.breakdown:type_name -> analysis.models.v1.ScoreBreakdown
-	4,  // 4: analysis.models.v1.Analysis.ai:type_name -> analysis.models.v1.AIDecision
-	13, // 5: analysis.models.v1.Analysis.created_at:type_name -> google.protobuf.Timestamp
-	13, // 6: analysis.models.v1.Analysis.updated_at:type_name -> google.protobuf.Timestamp
-	0,  // 7: analysis.models.v1.StartAnalysisResponse.status:type_name -> analysis.models.v1.AnalysisStatus
-	5,  // 8: analysis.models.v1.AnalysisResponse.analysis:type_name -> analysis.models.v1.Analysis
-	14, // 9: analysis.models.v1.ListCandidatesByVacancyRequest.page:type_name -> common.v1.PageRequest
-	15, // 10: analysis.models.v1.ListCandidatesByVacancyRequest.score_order:type_name -> common.v1.SortOrder
-	0,  // 11: analysis.models.v1.CandidateWithAnalysis.analysis_status:type_name -> analysis.models.v1.AnalysisStatus
-	13, // 12: analysis.models.v1.CandidateWithAnalysis.created_at:type_name -> google.protobuf.Timestamp
-	11, // 13: analysis.models.v1.ListCandidatesByVacancyResponse.candidates:type_name -> analysis.models.v1.CandidateWithAnalysis
-	16, // 14: analysis.models.v1.ListCandidatesByVacancyResponse.page:type_name -> common.v1.PageResponse
-	15, // [15:15] is the sub-list for method output_type
-	15, // [15:15] is the sub-list for method input_type
-	15, // [15:15] is the sub-list for extension type_name
-	15, // [15:15] is the sub-list for extension extendee
-	0,  // [0:15] is the sub-list for field type_name
+	3,  // 0: analysis.models.v1.ScoreBreakdown.policy:type_name -> analysis.models.v1.AppliedScoringPolicy
+	4,  // 1: analysis.models.v1.AIDecision.agent_results:type_name -> analysis.models.v1.AgentResult
+	0,  // 2: analysis.models.v1.Analysis.status:type_name -> analysis.models.v1.AnalysisStatus
+	1,  // 3: analysis.models.v1.Analysis.profile:type_name -> analysis.models.v1.CandidateProfile
+	2,  // 4: analysis.models.v1.Analysis.breakdown:type_name -> analysis.models.v1.ScoreBreakdown
+	5,  // 5: analysis.models.v1.Analysis.ai:type_name -> analysis.models.v1.AIDecision
+	17, // 6: analysis.models.v1.Analysis.created_at:type_name -> google.protobuf.Timestamp
+	17, // 7: analysis.models.v1.Analysis.updated_at:type_name -> google.protobuf.Timestamp
+	0,  // 8: analysis.models.v1.StartAnalysisResponse.status:type_name -> analysis.models.v1.AnalysisStatus
+	6,  // 9: analysis.models.v1.AnalysisResponse.analysis:type_name -> analysis.models.v1.Analysis
+	18, // 10: analysis.models.v1.ListCandidatesByVacancyRequest.page:type_name -> common.v1.PageRequest
+	19, // 11: analysis.models.v1.ListCandidatesByVacancyRequest.score_order:type_name -> common.v1.SortOrder
+	0,  // 12: analysis.models.v1.CandidateWithAnalysis.analysis_status:type_name -> analysis.models.v1.AnalysisStatus
+	17, // 13: analysis.models.v1.CandidateWithAnalysis.created_at:type_name -> google.protobuf.Timestamp
+	15, // 14: analysis.models.v1.ListCandidatesByVacancyResponse.candidates:type_name -> analysis.models.v1.CandidateWithAnalysis
+	20, // 15: analysis.models.v1.ListCandidatesByVacancyResponse.page:type_name -> common.v1.PageResponse
+	16, // [16:16] is the sub-list for method output_type
+	16, // [16:16] is the sub-list for method input_type
+	16, // [16:16] is the sub-list for extension type_name
+	16, // [16:16] is the sub-list for extension extendee
+	0,  // [0:16] is the sub-list for field type_name
 }
 
 func init() { file_models_analysis_model_proto_init() }
@@ -1154,7 +1432,7 @@ func file_models_analysis_model_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_models_analysis_model_proto_rawDesc), len(file_models_analysis_model_proto_rawDesc)),
 			NumEnums:      1,
-			NumMessages:   12,
+			NumMessages:   16,
 			NumExtensions: 0,
 			NumServices:   0,
 		},
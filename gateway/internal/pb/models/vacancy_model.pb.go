@@ -23,25 +23,43 @@ const (
 	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
 )
 
+// VacancyStatus is the lifecycle status. Allowed transitions:
+// draft → open ↔ paused → closed / filled, closed → open, any non-archived
+// status → archived, and archived → (status before archiving) via
+// RestoreVacancy. Closed, filled and archived vacancies accept no new
+// candidates.
 type VacancyStatus int32
 
 const (
 	VacancyStatus_VACANCY_STATUS_UNSPECIFIED VacancyStatus = 0
-	VacancyStatus_VACANCY_STATUS_ACTIVE      VacancyStatus = 1
-	VacancyStatus_VACANCY_STATUS_ARCHIVED    VacancyStatus = 2
+	// Was VACANCY_STATUS_ACTIVE; the wire value is unchanged.
+	VacancyStatus_VACANCY_STATUS_OPEN     VacancyStatus = 1
+	VacancyStatus_VACANCY_STATUS_ARCHIVED VacancyStatus = 2
+	VacancyStatus_VACANCY_STATUS_DRAFT    VacancyStatus = 3
+	VacancyStatus_VACANCY_STATUS_PAUSED   VacancyStatus = 4
+	VacancyStatus_VACANCY_STATUS_CLOSED   VacancyStatus = 5
+	VacancyStatus_VACANCY_STATUS_FILLED   VacancyStatus = 6
 )
 
 // Enum value maps for VacancyStatus.
 var (
 	VacancyStatus_name = map[int32]string{
 		0: "VACANCY_STATUS_UNSPECIFIED",
-		1: "VACANCY_STATUS_ACTIVE",
+		1: "VACANCY_STATUS_OPEN",
 		2: "VACANCY_STATUS_ARCHIVED",
+		3: "VACANCY_STATUS_DRAFT",
+		4: "VACANCY_STATUS_PAUSED",
+		5: "VACANCY_STATUS_CLOSED",
+		6: "VACANCY_STATUS_FILLED",
 	}
 	VacancyStatus_value = map[string]int32{
 		"VACANCY_STATUS_UNSPECIFIED": 0,
-		"VACANCY_STATUS_ACTIVE":      1,
+		"VACANCY_STATUS_OPEN":        1,
 		"VACANCY_STATUS_ARCHIVED":    2,
+		"VACANCY_STATUS_DRAFT":       3,
+		"VACANCY_STATUS_PAUSED":      4,
+		"VACANCY_STATUS_CLOSED":      5,
+		"VACANCY_STATUS_FILLED":      6,
 	}
 )
 
@@ -72,31 +90,4349 @@ func (VacancyStatus) EnumDescriptor() ([]byte, []int) {
 	return file_models_vacancy_model_proto_rawDescGZIP(), []int{0}
 }
 
+type RemotePolicy int32
+
+const (
+	RemotePolicy_REMOTE_POLICY_UNSPECIFIED RemotePolicy = 0
+	RemotePolicy_REMOTE_POLICY_ONSITE      RemotePolicy = 1
+	RemotePolicy_REMOTE_POLICY_HYBRID      RemotePolicy = 2
+	RemotePolicy_REMOTE_POLICY_REMOTE      RemotePolicy = 3
+)
+
+// Enum value maps for RemotePolicy.
+var (
+	RemotePolicy_name = map[int32]string{
+		0: "REMOTE_POLICY_UNSPECIFIED",
+		1: "REMOTE_POLICY_ONSITE",
+		2: "REMOTE_POLICY_HYBRID",
+		3: "REMOTE_POLICY_REMOTE",
+	}
+	RemotePolicy_value = map[string]int32{
+		"REMOTE_POLICY_UNSPECIFIED": 0,
+		"REMOTE_POLICY_ONSITE":      1,
+		"REMOTE_POLICY_HYBRID":      2,
+		"REMOTE_POLICY_REMOTE":      3,
+	}
+)
+
+func (x RemotePolicy) Enum() *RemotePolicy {
+	p := new(RemotePolicy)
+	*p = x
+	return p
+}
+
+func (x RemotePolicy) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (RemotePolicy) Descriptor() protoreflect.EnumDescriptor {
+	return file_models_vacancy_model_proto_enumTypes[1].Descriptor()
+}
+
+func (RemotePolicy) Type() protoreflect.EnumType {
+	return &file_models_vacancy_model_proto_enumTypes[1]
+}
+
+func (x RemotePolicy) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use RemotePolicy.Descriptor instead.
+func (RemotePolicy) EnumDescriptor() ([]byte, []int) {
+	return file_models_vacancy_model_proto_rawDescGZIP(), []int{1}
+}
+
+type EmploymentType int32
+
+const (
+	EmploymentType_EMPLOYMENT_TYPE_UNSPECIFIED EmploymentType = 0
+	EmploymentType_EMPLOYMENT_TYPE_FULL_TIME   EmploymentType = 1
+	EmploymentType_EMPLOYMENT_TYPE_PART_TIME   EmploymentType = 2
+	EmploymentType_EMPLOYMENT_TYPE_CONTRACT    EmploymentType = 3
+	EmploymentType_EMPLOYMENT_TYPE_INTERNSHIP  EmploymentType = 4
+	EmploymentType_EMPLOYMENT_TYPE_TEMPORARY   EmploymentType = 5
+)
+
+// Enum value maps for EmploymentType.
+var (
+	EmploymentType_name = map[int32]string{
+		0: "EMPLOYMENT_TYPE_UNSPECIFIED",
+		1: "EMPLOYMENT_TYPE_FULL_TIME",
+		2: "EMPLOYMENT_TYPE_PART_TIME",
+		3: "EMPLOYMENT_TYPE_CONTRACT",
+		4: "EMPLOYMENT_TYPE_INTERNSHIP",
+		5: "EMPLOYMENT_TYPE_TEMPORARY",
+	}
+	EmploymentType_value = map[string]int32{
+		"EMPLOYMENT_TYPE_UNSPECIFIED": 0,
+		"EMPLOYMENT_TYPE_FULL_TIME":   1,
+		"EMPLOYMENT_TYPE_PART_TIME":   2,
+		"EMPLOYMENT_TYPE_CONTRACT":    3,
+		"EMPLOYMENT_TYPE_INTERNSHIP":  4,
+		"EMPLOYMENT_TYPE_TEMPORARY":   5,
+	}
+)
+
+func (x EmploymentType) Enum() *EmploymentType {
+	p := new(EmploymentType)
+	*p = x
+	return p
+}
+
+func (x EmploymentType) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (EmploymentType) Descriptor() protoreflect.EnumDescriptor {
+	return file_models_vacancy_model_proto_enumTypes[2].Descriptor()
+}
+
+func (EmploymentType) Type() protoreflect.EnumType {
+	return &file_models_vacancy_model_proto_enumTypes[2]
+}
+
+func (x EmploymentType) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use EmploymentType.Descriptor instead.
+func (EmploymentType) EnumDescriptor() ([]byte, []int) {
+	return file_models_vacancy_model_proto_rawDescGZIP(), []int{2}
+}
+
+type Seniority int32
+
+const (
+	Seniority_SENIORITY_UNSPECIFIED Seniority = 0
+	Seniority_SENIORITY_INTERN      Seniority = 1
+	Seniority_SENIORITY_JUNIOR      Seniority = 2
+	Seniority_SENIORITY_MIDDLE      Seniority = 3
+	Seniority_SENIORITY_SENIOR      Seniority = 4
+	Seniority_SENIORITY_LEAD        Seniority = 5
+)
+
+// Enum value maps for Seniority.
+var (
+	Seniority_name = map[int32]string{
+		0: "SENIORITY_UNSPECIFIED",
+		1: "SENIORITY_INTERN",
+		2: "SENIORITY_JUNIOR",
+		3: "SENIORITY_MIDDLE",
+		4: "SENIORITY_SENIOR",
+		5: "SENIORITY_LEAD",
+	}
+	Seniority_value = map[string]int32{
+		"SENIORITY_UNSPECIFIED": 0,
+		"SENIORITY_INTERN":      1,
+		"SENIORITY_JUNIOR":      2,
+		"SENIORITY_MIDDLE":      3,
+		"SENIORITY_SENIOR":      4,
+		"SENIORITY_LEAD":        5,
+	}
+)
+
+func (x Seniority) Enum() *Seniority {
+	p := new(Seniority)
+	*p = x
+	return p
+}
+
+func (x Seniority) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (Seniority) Descriptor() protoreflect.EnumDescriptor {
+	return file_models_vacancy_model_proto_enumTypes[3].Descriptor()
+}
+
+func (Seniority) Type() protoreflect.EnumType {
+	return &file_models_vacancy_model_proto_enumTypes[3]
+}
+
+func (x Seniority) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use Seniority.Descriptor instead.
+func (Seniority) EnumDescriptor() ([]byte, []int) {
+	return file_models_vacancy_model_proto_rawDescGZIP(), []int{3}
+}
+
+// RoleSource says how Vacancy.role was decided: by the LLM classifier, by
+// the keyword fallback when the LLM was unavailable, or by hand.
+type RoleSource int32
+
+const (
+	RoleSource_ROLE_SOURCE_UNSPECIFIED RoleSource = 0
+	RoleSource_ROLE_SOURCE_LLM         RoleSource = 1
+	RoleSource_ROLE_SOURCE_KEYWORD     RoleSource = 2
+	RoleSource_ROLE_SOURCE_MANUAL      RoleSource = 3
+)
+
+// Enum value maps for RoleSource.
+var (
+	RoleSource_name = map[int32]string{
+		0: "ROLE_SOURCE_UNSPECIFIED",
+		1: "ROLE_SOURCE_LLM",
+		2: "ROLE_SOURCE_KEYWORD",
+		3: "ROLE_SOURCE_MANUAL",
+	}
+	RoleSource_value = map[string]int32{
+		"ROLE_SOURCE_UNSPECIFIED": 0,
+		"ROLE_SOURCE_LLM":         1,
+		"ROLE_SOURCE_KEYWORD":     2,
+		"ROLE_SOURCE_MANUAL":      3,
+	}
+)
+
+func (x RoleSource) Enum() *RoleSource {
+	p := new(RoleSource)
+	*p = x
+	return p
+}
+
+func (x RoleSource) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (RoleSource) Descriptor() protoreflect.EnumDescriptor {
+	return file_models_vacancy_model_proto_enumTypes[4].Descriptor()
+}
+
+func (RoleSource) Type() protoreflect.EnumType {
+	return &file_models_vacancy_model_proto_enumTypes[4]
+}
+
+func (x RoleSource) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use RoleSource.Descriptor instead.
+func (RoleSource) EnumDescriptor() ([]byte, []int) {
+	return file_models_vacancy_model_proto_rawDescGZIP(), []int{4}
+}
+
+// TotalMode is how ListVacancies computes page.total.
+type TotalMode int32
+
+const (
+	TotalMode_TOTAL_MODE_UNSPECIFIED TotalMode = 0
+	// EXACT runs a full COUNT.
+	TotalMode_TOTAL_MODE_EXACT TotalMode = 1
+	// ESTIMATED counts up to 10 000 matches; at the cap total is a lower
+	// bound.
+	TotalMode_TOTAL_MODE_ESTIMATED TotalMode = 2
+	// NONE skips counting; page.total is 0.
+	TotalMode_TOTAL_MODE_NONE TotalMode = 3
+)
+
+// Enum value maps for TotalMode.
+var (
+	TotalMode_name = map[int32]string{
+		0: "TOTAL_MODE_UNSPECIFIED",
+		1: "TOTAL_MODE_EXACT",
+		2: "TOTAL_MODE_ESTIMATED",
+		3: "TOTAL_MODE_NONE",
+	}
+	TotalMode_value = map[string]int32{
+		"TOTAL_MODE_UNSPECIFIED": 0,
+		"TOTAL_MODE_EXACT":       1,
+		"TOTAL_MODE_ESTIMATED":   2,
+		"TOTAL_MODE_NONE":        3,
+	}
+)
+
+func (x TotalMode) Enum() *TotalMode {
+	p := new(TotalMode)
+	*p = x
+	return p
+}
+
+func (x TotalMode) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (TotalMode) Descriptor() protoreflect.EnumDescriptor {
+	return file_models_vacancy_model_proto_enumTypes[5].Descriptor()
+}
+
+func (TotalMode) Type() protoreflect.EnumType {
+	return &file_models_vacancy_model_proto_enumTypes[5]
+}
+
+func (x TotalMode) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use TotalMode.Descriptor instead.
+func (TotalMode) EnumDescriptor() ([]byte, []int) {
+	return file_models_vacancy_model_proto_rawDescGZIP(), []int{5}
+}
+
+// TemplateScope controls who sees a template: PERSONAL — its owner only,
+// ORG — every user of the installation.
+type TemplateScope int32
+
+const (
+	TemplateScope_TEMPLATE_SCOPE_UNSPECIFIED TemplateScope = 0
+	TemplateScope_TEMPLATE_SCOPE_PERSONAL    TemplateScope = 1
+	TemplateScope_TEMPLATE_SCOPE_ORG         TemplateScope = 2
+)
+
+// Enum value maps for TemplateScope.
+var (
+	TemplateScope_name = map[int32]string{
+		0: "TEMPLATE_SCOPE_UNSPECIFIED",
+		1: "TEMPLATE_SCOPE_PERSONAL",
+		2: "TEMPLATE_SCOPE_ORG",
+	}
+	TemplateScope_value = map[string]int32{
+		"TEMPLATE_SCOPE_UNSPECIFIED": 0,
+		"TEMPLATE_SCOPE_PERSONAL":    1,
+		"TEMPLATE_SCOPE_ORG":         2,
+	}
+)
+
+func (x TemplateScope) Enum() *TemplateScope {
+	p := new(TemplateScope)
+	*p = x
+	return p
+}
+
+func (x TemplateScope) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (TemplateScope) Descriptor() protoreflect.EnumDescriptor {
+	return file_models_vacancy_model_proto_enumTypes[6].Descriptor()
+}
+
+func (TemplateScope) Type() protoreflect.EnumType {
+	return &file_models_vacancy_model_proto_enumTypes[6]
+}
+
+func (x TemplateScope) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use TemplateScope.Descriptor instead.
+func (TemplateScope) EnumDescriptor() ([]byte, []int) {
+	return file_models_vacancy_model_proto_rawDescGZIP(), []int{6}
+}
+
+// ImportFormat selects the ImportVacancies parser.
+type ImportFormat int32
+
+const (
+	ImportFormat_IMPORT_FORMAT_UNSPECIFIED ImportFormat = 0
+	// CSV with a header row: title (required), description, status, skills
+	// as "Go:0.8:must; PostgreSQL:0.5; Docker:nice". ',' or ';' delimited.
+	ImportFormat_IMPORT_FORMAT_CSV ImportFormat = 1
+	// One CreateVacancyRequest-shaped JSON object per line.
+	ImportFormat_IMPORT_FORMAT_JSONL ImportFormat = 2
+	// hh.ru vacancy JSON: one vacancy, an array or {"items": [...]}.
+	ImportFormat_IMPORT_FORMAT_HH ImportFormat = 3
+)
+
+// Enum value maps for ImportFormat.
+var (
+	ImportFormat_name = map[int32]string{
+		0: "IMPORT_FORMAT_UNSPECIFIED",
+		1: "IMPORT_FORMAT_CSV",
+		2: "IMPORT_FORMAT_JSONL",
+		3: "IMPORT_FORMAT_HH",
+	}
+	ImportFormat_value = map[string]int32{
+		"IMPORT_FORMAT_UNSPECIFIED": 0,
+		"IMPORT_FORMAT_CSV":         1,
+		"IMPORT_FORMAT_JSONL":       2,
+		"IMPORT_FORMAT_HH":          3,
+	}
+)
+
+func (x ImportFormat) Enum() *ImportFormat {
+	p := new(ImportFormat)
+	*p = x
+	return p
+}
+
+func (x ImportFormat) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (ImportFormat) Descriptor() protoreflect.EnumDescriptor {
+	return file_models_vacancy_model_proto_enumTypes[7].Descriptor()
+}
+
+func (ImportFormat) Type() protoreflect.EnumType {
+	return &file_models_vacancy_model_proto_enumTypes[7]
+}
+
+func (x ImportFormat) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use ImportFormat.Descriptor instead.
+func (ImportFormat) EnumDescriptor() ([]byte, []int) {
+	return file_models_vacancy_model_proto_rawDescGZIP(), []int{7}
+}
+
+// ImportMode decides what happens when some rows are invalid.
+type ImportMode int32
+
+const (
+	// Same as ALL_OR_NOTHING.
+	ImportMode_IMPORT_MODE_UNSPECIFIED ImportMode = 0
+	// Write nothing unless every row is valid; then write all rows in one
+	// transaction.
+	ImportMode_IMPORT_MODE_ALL_OR_NOTHING ImportMode = 1
+	// Write the valid rows, report the rest.
+	ImportMode_IMPORT_MODE_BEST_EFFORT ImportMode = 2
+)
+
+// Enum value maps for ImportMode.
+var (
+	ImportMode_name = map[int32]string{
+		0: "IMPORT_MODE_UNSPECIFIED",
+		1: "IMPORT_MODE_ALL_OR_NOTHING",
+		2: "IMPORT_MODE_BEST_EFFORT",
+	}
+	ImportMode_value = map[string]int32{
+		"IMPORT_MODE_UNSPECIFIED":    0,
+		"IMPORT_MODE_ALL_OR_NOTHING": 1,
+		"IMPORT_MODE_BEST_EFFORT":    2,
+	}
+)
+
+func (x ImportMode) Enum() *ImportMode {
+	p := new(ImportMode)
+	*p = x
+	return p
+}
+
+func (x ImportMode) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (ImportMode) Descriptor() protoreflect.EnumDescriptor {
+	return file_models_vacancy_model_proto_enumTypes[8].Descriptor()
+}
+
+func (ImportMode) Type() protoreflect.EnumType {
+	return &file_models_vacancy_model_proto_enumTypes[8]
+}
+
+func (x ImportMode) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use ImportMode.Descriptor instead.
+func (ImportMode) EnumDescriptor() ([]byte, []int) {
+	return file_models_vacancy_model_proto_rawDescGZIP(), []int{8}
+}
+
+// VacancyAttributes are the structured facts of an opening. Unspecified
+// enums, an empty location and zero salaries mean "not stated".
+type VacancyAttributes struct {
+	state        protoimpl.MessageState `protogen:"open.v1"`
+	Location     string                 `protobuf:"bytes,1,opt,name=location,proto3" json:"location,omitempty"`
+	RemotePolicy RemotePolicy           `protobuf:"varint,2,opt,name=remote_policy,json=remotePolicy,proto3,enum=vacancy.models.v1.RemotePolicy" json:"remote_policy,omitempty"`
+	// salary_min / salary_max are whole units of salary_currency; either may
+	// be 0 for an open-ended range. salary_currency is an ISO 4217 code and
+	// is required once a bound is set.
+	SalaryMin      uint64         `protobuf:"varint,3,opt,name=salary_min,json=salaryMin,proto3" json:"salary_min,omitempty"`
+	SalaryMax      uint64         `protobuf:"varint,4,opt,name=salary_max,json=salaryMax,proto3" json:"salary_max,omitempty"`
+	SalaryCurrency string         `protobuf:"bytes,5,opt,name=salary_currency,json=salaryCurrency,proto3" json:"salary_currency,omitempty"`
+	EmploymentType EmploymentType `protobuf:"varint,6,opt,name=employment_type,json=employmentType,proto3,enum=vacancy.models.v1.EmploymentType" json:"employment_type,omitempty"`
+	Seniority      Seniority      `protobuf:"varint,7,opt,name=seniority,proto3,enum=vacancy.models.v1.Seniority" json:"seniority,omitempty"`
+	// headcount is how many people the vacancy hires; 0 on input means 1.
+	Headcount     uint32 `protobuf:"varint,8,opt,name=headcount,proto3" json:"headcount,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *VacancyAttributes) Reset() {
+	*x = VacancyAttributes{}
+	mi := &file_models_vacancy_model_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *VacancyAttributes) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*VacancyAttributes) ProtoMessage() {}
+
+func (x *VacancyAttributes) ProtoReflect() protoreflect.Message {
+	mi := &file_models_vacancy_model_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use VacancyAttributes.ProtoReflect.Descriptor instead.
+func (*VacancyAttributes) Descriptor() ([]byte, []int) {
+	return file_models_vacancy_model_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *VacancyAttributes) GetLocation() string {
+	if x != nil {
+		return x.Location
+	}
+	return ""
+}
+
+func (x *VacancyAttributes) GetRemotePolicy() RemotePolicy {
+	if x != nil {
+		return x.RemotePolicy
+	}
+	return RemotePolicy_REMOTE_POLICY_UNSPECIFIED
+}
+
+func (x *VacancyAttributes) GetSalaryMin() uint64 {
+	if x != nil {
+		return x.SalaryMin
+	}
+	return 0
+}
+
+func (x *VacancyAttributes) GetSalaryMax() uint64 {
+	if x != nil {
+		return x.SalaryMax
+	}
+	return 0
+}
+
+func (x *VacancyAttributes) GetSalaryCurrency() string {
+	if x != nil {
+		return x.SalaryCurrency
+	}
+	return ""
+}
+
+func (x *VacancyAttributes) GetEmploymentType() EmploymentType {
+	if x != nil {
+		return x.EmploymentType
+	}
+	return EmploymentType_EMPLOYMENT_TYPE_UNSPECIFIED
+}
+
+func (x *VacancyAttributes) GetSeniority() Seniority {
+	if x != nil {
+		return x.Seniority
+	}
+	return Seniority_SENIORITY_UNSPECIFIED
+}
+
+func (x *VacancyAttributes) GetHeadcount() uint32 {
+	if x != nil {
+		return x.Headcount
+	}
+	return 0
+}
+
+// ScoringPolicy tunes how the analysis service scores candidates for the
+// vacancy. Scores are on a 0–100 scale. The defaults are 10 / 2 / 75 / 45
+// with the rejection rule off.
+type ScoringPolicy struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// must_have_penalty is subtracted per missed must-have skill,
+	// nice_to_have_bonus added per matched nice-to-have; both 0–100.
+	MustHavePenalty float32 `protobuf:"fixed32,1,opt,name=must_have_penalty,json=mustHavePenalty,proto3" json:"must_have_penalty,omitempty"`
+	NiceToHaveBonus float32 `protobuf:"fixed32,2,opt,name=nice_to_have_bonus,json=niceToHaveBonus,proto3" json:"nice_to_have_bonus,omitempty"`
+	// hire_threshold and maybe_threshold are the minimum scores for the
+	// "hire" and "maybe" recommendations: 0 < maybe <= hire <= 100.
+	HireThreshold  float32 `protobuf:"fixed32,3,opt,name=hire_threshold,json=hireThreshold,proto3" json:"hire_threshold,omitempty"`
+	MaybeThreshold float32 `protobuf:"fixed32,4,opt,name=maybe_threshold,json=maybeThreshold,proto3" json:"maybe_threshold,omitempty"`
+	// reject_on_missing_must_have makes any missed must-have skill a "no",
+	// whatever the score.
+	RejectOnMissingMustHave bool `protobuf:"varint,5,opt,name=reject_on_missing_must_have,json=rejectOnMissingMustHave,proto3" json:"reject_on_missing_must_have,omitempty"`
+	unknownFields           protoimpl.UnknownFields
+	sizeCache               protoimpl.SizeCache
+}
+
+func (x *ScoringPolicy) Reset() {
+	*x = ScoringPolicy{}
+	mi := &file_models_vacancy_model_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ScoringPolicy) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ScoringPolicy) ProtoMessage() {}
+
+func (x *ScoringPolicy) ProtoReflect() protoreflect.Message {
+	mi := &file_models_vacancy_model_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ScoringPolicy.ProtoReflect.Descriptor instead.
+func (*ScoringPolicy) Descriptor() ([]byte, []int) {
+	return file_models_vacancy_model_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *ScoringPolicy) GetMustHavePenalty() float32 {
+	if x != nil {
+		return x.MustHavePenalty
+	}
+	return 0
+}
+
+func (x *ScoringPolicy) GetNiceToHaveBonus() float32 {
+	if x != nil {
+		return x.NiceToHaveBonus
+	}
+	return 0
+}
+
+func (x *ScoringPolicy) GetHireThreshold() float32 {
+	if x != nil {
+		return x.HireThreshold
+	}
+	return 0
+}
+
+func (x *ScoringPolicy) GetMaybeThreshold() float32 {
+	if x != nil {
+		return x.MaybeThreshold
+	}
+	return 0
+}
+
+func (x *ScoringPolicy) GetRejectOnMissingMustHave() bool {
+	if x != nil {
+		return x.RejectOnMissingMustHave
+	}
+	return false
+}
+
 type SkillWeight struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Name          string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
-	Weight        float32                `protobuf:"fixed32,2,opt,name=weight,proto3" json:"weight,omitempty"`
-	MustHave      bool                   `protobuf:"varint,3,opt,name=must_have,json=mustHave,proto3" json:"must_have,omitempty"`
-	NiceToHave    bool                   `protobuf:"varint,4,opt,name=nice_to_have,json=niceToHave,proto3" json:"nice_to_have,omitempty"`
+	Name          string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Weight        float32                `protobuf:"fixed32,2,opt,name=weight,proto3" json:"weight,omitempty"`
+	MustHave      bool                   `protobuf:"varint,3,opt,name=must_have,json=mustHave,proto3" json:"must_have,omitempty"`
+	NiceToHave    bool                   `protobuf:"varint,4,opt,name=nice_to_have,json=niceToHave,proto3" json:"nice_to_have,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SkillWeight) Reset() {
+	*x = SkillWeight{}
+	mi := &file_models_vacancy_model_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SkillWeight) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SkillWeight) ProtoMessage() {}
+
+func (x *SkillWeight) ProtoReflect() protoreflect.Message {
+	mi := &file_models_vacancy_model_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SkillWeight.ProtoReflect.Descriptor instead.
+func (*SkillWeight) Descriptor() ([]byte, []int) {
+	return file_models_vacancy_model_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *SkillWeight) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *SkillWeight) GetWeight() float32 {
+	if x != nil {
+		return x.Weight
+	}
+	return 0
+}
+
+func (x *SkillWeight) GetMustHave() bool {
+	if x != nil {
+		return x.MustHave
+	}
+	return false
+}
+
+func (x *SkillWeight) GetNiceToHave() bool {
+	if x != nil {
+		return x.NiceToHave
+	}
+	return false
+}
+
+type Vacancy struct {
+	state       protoimpl.MessageState `protogen:"open.v1"`
+	Id          string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	OwnerUserId uint64                 `protobuf:"varint,2,opt,name=owner_user_id,json=ownerUserId,proto3" json:"owner_user_id,omitempty"`
+	Title       string                 `protobuf:"bytes,3,opt,name=title,proto3" json:"title,omitempty"`
+	Description string                 `protobuf:"bytes,4,opt,name=description,proto3" json:"description,omitempty"`
+	Skills      []*SkillWeight         `protobuf:"bytes,5,rep,name=skills,proto3" json:"skills,omitempty"`
+	Status      VacancyStatus          `protobuf:"varint,6,opt,name=status,proto3,enum=vacancy.models.v1.VacancyStatus" json:"status,omitempty"`
+	Version     uint32                 `protobuf:"varint,7,opt,name=version,proto3" json:"version,omitempty"`
+	CreatedAt   *timestamppb.Timestamp `protobuf:"bytes,8,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	UpdatedAt   *timestamppb.Timestamp `protobuf:"bytes,9,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	// role drives prompt selection in multiagent. Free-form string;
+	// multiagent looks up assets/prompts/<role>.txt and falls back to
+	// default.txt when no match is found.
+	Role string `protobuf:"bytes,10,opt,name=role,proto3" json:"role,omitempty"`
+	// is_public opts the vacancy into the unauthenticated feed; only open
+	// public vacancies are published. Toggled via SetVacancyVisibility.
+	IsPublic   bool               `protobuf:"varint,11,opt,name=is_public,json=isPublic,proto3" json:"is_public,omitempty"`
+	Attributes *VacancyAttributes `protobuf:"bytes,12,opt,name=attributes,proto3" json:"attributes,omitempty"`
+	// role_source and role_classified_at record how and when role was
+	// decided. Keyword roles are retried against the LLM in the background.
+	RoleSource       RoleSource             `protobuf:"varint,13,opt,name=role_source,json=roleSource,proto3,enum=vacancy.models.v1.RoleSource" json:"role_source,omitempty"`
+	RoleClassifiedAt *timestamppb.Timestamp `protobuf:"bytes,14,opt,name=role_classified_at,json=roleClassifiedAt,proto3" json:"role_classified_at,omitempty"`
+	// role_locked is set while a manually chosen role is pinned: updates keep
+	// it and the background reclassification skips the vacancy.
+	RoleLocked    bool           `protobuf:"varint,15,opt,name=role_locked,json=roleLocked,proto3" json:"role_locked,omitempty"`
+	ScoringPolicy *ScoringPolicy `protobuf:"bytes,16,opt,name=scoring_policy,json=scoringPolicy,proto3" json:"scoring_policy,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Vacancy) Reset() {
+	*x = Vacancy{}
+	mi := &file_models_vacancy_model_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Vacancy) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Vacancy) ProtoMessage() {}
+
+func (x *Vacancy) ProtoReflect() protoreflect.Message {
+	mi := &file_models_vacancy_model_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Vacancy.ProtoReflect.Descriptor instead.
+func (*Vacancy) Descriptor() ([]byte, []int) {
+	return file_models_vacancy_model_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *Vacancy) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *Vacancy) GetOwnerUserId() uint64 {
+	if x != nil {
+		return x.OwnerUserId
+	}
+	return 0
+}
+
+func (x *Vacancy) GetTitle() string {
+	if x != nil {
+		return x.Title
+	}
+	return ""
+}
+
+func (x *Vacancy) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+func (x *Vacancy) GetSkills() []*SkillWeight {
+	if x != nil {
+		return x.Skills
+	}
+	return nil
+}
+
+func (x *Vacancy) GetStatus() VacancyStatus {
+	if x != nil {
+		return x.Status
+	}
+	return VacancyStatus_VACANCY_STATUS_UNSPECIFIED
+}
+
+func (x *Vacancy) GetVersion() uint32 {
+	if x != nil {
+		return x.Version
+	}
+	return 0
+}
+
+func (x *Vacancy) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+func (x *Vacancy) GetUpdatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return nil
+}
+
+func (x *Vacancy) GetRole() string {
+	if x != nil {
+		return x.Role
+	}
+	return ""
+}
+
+func (x *Vacancy) GetIsPublic() bool {
+	if x != nil {
+		return x.IsPublic
+	}
+	return false
+}
+
+func (x *Vacancy) GetAttributes() *VacancyAttributes {
+	if x != nil {
+		return x.Attributes
+	}
+	return nil
+}
+
+func (x *Vacancy) GetRoleSource() RoleSource {
+	if x != nil {
+		return x.RoleSource
+	}
+	return RoleSource_ROLE_SOURCE_UNSPECIFIED
+}
+
+func (x *Vacancy) GetRoleClassifiedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.RoleClassifiedAt
+	}
+	return nil
+}
+
+func (x *Vacancy) GetRoleLocked() bool {
+	if x != nil {
+		return x.RoleLocked
+	}
+	return false
+}
+
+func (x *Vacancy) GetScoringPolicy() *ScoringPolicy {
+	if x != nil {
+		return x.ScoringPolicy
+	}
+	return nil
+}
+
+type CreateVacancyRequest struct {
+	state       protoimpl.MessageState `protogen:"open.v1"`
+	Title       string                 `protobuf:"bytes,1,opt,name=title,proto3" json:"title,omitempty"`
+	Description string                 `protobuf:"bytes,2,opt,name=description,proto3" json:"description,omitempty"`
+	Skills      []*SkillWeight         `protobuf:"bytes,3,rep,name=skills,proto3" json:"skills,omitempty"`
+	// role pins the vacancy to one of ListVacancyRoles instead of letting the
+	// classifier pick; INVALID_ARGUMENT for any other value. Empty classifies.
+	Role string `protobuf:"bytes,4,opt,name=role,proto3" json:"role,omitempty"`
+	// draft creates the vacancy in VACANCY_STATUS_DRAFT; otherwise it starts
+	// open.
+	Draft      bool               `protobuf:"varint,5,opt,name=draft,proto3" json:"draft,omitempty"`
+	Attributes *VacancyAttributes `protobuf:"bytes,6,opt,name=attributes,proto3" json:"attributes,omitempty"`
+	// scoring_policy is validated like every other field; omitted means the
+	// default policy.
+	ScoringPolicy *ScoringPolicy `protobuf:"bytes,7,opt,name=scoring_policy,json=scoringPolicy,proto3" json:"scoring_policy,omitempty"`
+	// force creates the vacancy even when open vacancies look like
+	// duplicates of it.
+	Force         bool `protobuf:"varint,8,opt,name=force,proto3" json:"force,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateVacancyRequest) Reset() {
+	*x = CreateVacancyRequest{}
+	mi := &file_models_vacancy_model_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateVacancyRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateVacancyRequest) ProtoMessage() {}
+
+func (x *CreateVacancyRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_models_vacancy_model_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateVacancyRequest.ProtoReflect.Descriptor instead.
+func (*CreateVacancyRequest) Descriptor() ([]byte, []int) {
+	return file_models_vacancy_model_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *CreateVacancyRequest) GetTitle() string {
+	if x != nil {
+		return x.Title
+	}
+	return ""
+}
+
+func (x *CreateVacancyRequest) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+func (x *CreateVacancyRequest) GetSkills() []*SkillWeight {
+	if x != nil {
+		return x.Skills
+	}
+	return nil
+}
+
+func (x *CreateVacancyRequest) GetRole() string {
+	if x != nil {
+		return x.Role
+	}
+	return ""
+}
+
+func (x *CreateVacancyRequest) GetDraft() bool {
+	if x != nil {
+		return x.Draft
+	}
+	return false
+}
+
+func (x *CreateVacancyRequest) GetAttributes() *VacancyAttributes {
+	if x != nil {
+		return x.Attributes
+	}
+	return nil
+}
+
+func (x *CreateVacancyRequest) GetScoringPolicy() *ScoringPolicy {
+	if x != nil {
+		return x.ScoringPolicy
+	}
+	return nil
+}
+
+func (x *CreateVacancyRequest) GetForce() bool {
+	if x != nil {
+		return x.Force
+	}
+	return false
+}
+
+// CreateVacancyResponse always carries the created vacancy. When open
+// vacancies look like duplicates and force was not set, nothing is created
+// and the call fails with ALREADY_EXISTS instead (reason
+// POSSIBLE_DUPLICATE) — see PossibleDuplicates.
+type CreateVacancyResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Vacancy       *Vacancy               `protobuf:"bytes,1,opt,name=vacancy,proto3" json:"vacancy,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateVacancyResponse) Reset() {
+	*x = CreateVacancyResponse{}
+	mi := &file_models_vacancy_model_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateVacancyResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateVacancyResponse) ProtoMessage() {}
+
+func (x *CreateVacancyResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_models_vacancy_model_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateVacancyResponse.ProtoReflect.Descriptor instead.
+func (*CreateVacancyResponse) Descriptor() ([]byte, []int) {
+	return file_models_vacancy_model_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *CreateVacancyResponse) GetVacancy() *Vacancy {
+	if x != nil {
+		return x.Vacancy
+	}
+	return nil
+}
+
+// PossibleDuplicates rides in the status details of a CreateVacancy that
+// failed with ALREADY_EXISTS / POSSIBLE_DUPLICATE, next to the ErrorInfo:
+// the open vacancies the caller can see that look like the one being
+// created, most similar first. Retry with force to create it anyway.
+type PossibleDuplicates struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Duplicates    []*VacancyDuplicate    `protobuf:"bytes,1,rep,name=duplicates,proto3" json:"duplicates,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PossibleDuplicates) Reset() {
+	*x = PossibleDuplicates{}
+	mi := &file_models_vacancy_model_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PossibleDuplicates) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PossibleDuplicates) ProtoMessage() {}
+
+func (x *PossibleDuplicates) ProtoReflect() protoreflect.Message {
+	mi := &file_models_vacancy_model_proto_msgTypes[6]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PossibleDuplicates.ProtoReflect.Descriptor instead.
+func (*PossibleDuplicates) Descriptor() ([]byte, []int) {
+	return file_models_vacancy_model_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *PossibleDuplicates) GetDuplicates() []*VacancyDuplicate {
+	if x != nil {
+		return x.Duplicates
+	}
+	return nil
+}
+
+// VacancyDuplicate is an open vacancy similar to the one being created.
+// similarity = 0.6 * title_similarity + 0.4 * skill_similarity, each in
+// [0, 1]; duplicates start at 0.7.
+type VacancyDuplicate struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	VacancyId       string                 `protobuf:"bytes,1,opt,name=vacancy_id,json=vacancyId,proto3" json:"vacancy_id,omitempty"`
+	Title           string                 `protobuf:"bytes,2,opt,name=title,proto3" json:"title,omitempty"`
+	OwnerUserId     uint64                 `protobuf:"varint,3,opt,name=owner_user_id,json=ownerUserId,proto3" json:"owner_user_id,omitempty"`
+	TitleSimilarity float32                `protobuf:"fixed32,4,opt,name=title_similarity,json=titleSimilarity,proto3" json:"title_similarity,omitempty"`
+	SkillSimilarity float32                `protobuf:"fixed32,5,opt,name=skill_similarity,json=skillSimilarity,proto3" json:"skill_similarity,omitempty"`
+	Similarity      float32                `protobuf:"fixed32,6,opt,name=similarity,proto3" json:"similarity,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *VacancyDuplicate) Reset() {
+	*x = VacancyDuplicate{}
+	mi := &file_models_vacancy_model_proto_msgTypes[7]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *VacancyDuplicate) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*VacancyDuplicate) ProtoMessage() {}
+
+func (x *VacancyDuplicate) ProtoReflect() protoreflect.Message {
+	mi := &file_models_vacancy_model_proto_msgTypes[7]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use VacancyDuplicate.ProtoReflect.Descriptor instead.
+func (*VacancyDuplicate) Descriptor() ([]byte, []int) {
+	return file_models_vacancy_model_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *VacancyDuplicate) GetVacancyId() string {
+	if x != nil {
+		return x.VacancyId
+	}
+	return ""
+}
+
+func (x *VacancyDuplicate) GetTitle() string {
+	if x != nil {
+		return x.Title
+	}
+	return ""
+}
+
+func (x *VacancyDuplicate) GetOwnerUserId() uint64 {
+	if x != nil {
+		return x.OwnerUserId
+	}
+	return 0
+}
+
+func (x *VacancyDuplicate) GetTitleSimilarity() float32 {
+	if x != nil {
+		return x.TitleSimilarity
+	}
+	return 0
+}
+
+func (x *VacancyDuplicate) GetSkillSimilarity() float32 {
+	if x != nil {
+		return x.SkillSimilarity
+	}
+	return 0
+}
+
+func (x *VacancyDuplicate) GetSimilarity() float32 {
+	if x != nil {
+		return x.Similarity
+	}
+	return 0
+}
+
+type GetVacancyRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	VacancyId     string                 `protobuf:"bytes,1,opt,name=vacancy_id,json=vacancyId,proto3" json:"vacancy_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetVacancyRequest) Reset() {
+	*x = GetVacancyRequest{}
+	mi := &file_models_vacancy_model_proto_msgTypes[8]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetVacancyRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetVacancyRequest) ProtoMessage() {}
+
+func (x *GetVacancyRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_models_vacancy_model_proto_msgTypes[8]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetVacancyRequest.ProtoReflect.Descriptor instead.
+func (*GetVacancyRequest) Descriptor() ([]byte, []int) {
+	return file_models_vacancy_model_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *GetVacancyRequest) GetVacancyId() string {
+	if x != nil {
+		return x.VacancyId
+	}
+	return ""
+}
+
+type ListVacanciesRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	Page  *common.PageRequest    `protobuf:"bytes,1,opt,name=page,proto3" json:"page,omitempty"`
+	// query is a full-text search over title and description (Russian and
+	// English word forms) in web-search syntax: words, "quoted phrases",
+	// -exclusions, or. With a query results are ordered by relevance,
+	// without one by created_at, newest first. Up to 200 characters.
+	Query string `protobuf:"bytes,2,opt,name=query,proto3" json:"query,omitempty"`
+	// Facet filters. Values within one facet are OR-ed, facets are AND-ed,
+	// and an empty facet does not filter.
+	//
+	// statuses keeps only vacancies in one of these statuses.
+	Statuses []VacancyStatus `protobuf:"varint,3,rep,packed,name=statuses,proto3,enum=vacancy.models.v1.VacancyStatus" json:"statuses,omitempty"`
+	// roles matches Vacancy.role.
+	Roles []string `protobuf:"bytes,4,rep,name=roles,proto3" json:"roles,omitempty"`
+	// skills matches skill names, case-insensitively.
+	Skills []string `protobuf:"bytes,5,rep,name=skills,proto3" json:"skills,omitempty"`
+	// created_from / created_to bound created_at as [from, to).
+	CreatedFrom *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=created_from,json=createdFrom,proto3" json:"created_from,omitempty"`
+	CreatedTo   *timestamppb.Timestamp `protobuf:"bytes,7,opt,name=created_to,json=createdTo,proto3" json:"created_to,omitempty"`
+	// page_token switches to keyset pagination: pass next_page_token from the
+	// previous response, keeping the other parameters unchanged. page.offset
+	// is ignored then. Unlike offset pages, keyset pages stay stable while
+	// vacancies are added and cost the same at any depth.
+	PageToken string `protobuf:"bytes,8,opt,name=page_token,json=pageToken,proto3" json:"page_token,omitempty"`
+	// total_mode picks how page.total is computed. Unspecified means EXACT
+	// without page_token and NONE with it.
+	TotalMode TotalMode `protobuf:"varint,9,opt,name=total_mode,json=totalMode,proto3,enum=vacancy.models.v1.TotalMode" json:"total_mode,omitempty"`
+	// locations matches the location, case-insensitively.
+	Locations       []string         `protobuf:"bytes,10,rep,name=locations,proto3" json:"locations,omitempty"`
+	RemotePolicies  []RemotePolicy   `protobuf:"varint,11,rep,packed,name=remote_policies,json=remotePolicies,proto3,enum=vacancy.models.v1.RemotePolicy" json:"remote_policies,omitempty"`
+	EmploymentTypes []EmploymentType `protobuf:"varint,12,rep,packed,name=employment_types,json=employmentTypes,proto3,enum=vacancy.models.v1.EmploymentType" json:"employment_types,omitempty"`
+	Seniorities     []Seniority      `protobuf:"varint,13,rep,packed,name=seniorities,proto3,enum=vacancy.models.v1.Seniority" json:"seniorities,omitempty"`
+	// salary_at_least keeps vacancies in salary_currency whose range reaches
+	// the amount (the upper bound, or the lower one when open-ended). It
+	// requires salary_currency; a currency alone filters by currency.
+	SalaryAtLeast  uint64 `protobuf:"varint,14,opt,name=salary_at_least,json=salaryAtLeast,proto3" json:"salary_at_least,omitempty"`
+	SalaryCurrency string `protobuf:"bytes,15,opt,name=salary_currency,json=salaryCurrency,proto3" json:"salary_currency,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *ListVacanciesRequest) Reset() {
+	*x = ListVacanciesRequest{}
+	mi := &file_models_vacancy_model_proto_msgTypes[9]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListVacanciesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListVacanciesRequest) ProtoMessage() {}
+
+func (x *ListVacanciesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_models_vacancy_model_proto_msgTypes[9]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListVacanciesRequest.ProtoReflect.Descriptor instead.
+func (*ListVacanciesRequest) Descriptor() ([]byte, []int) {
+	return file_models_vacancy_model_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *ListVacanciesRequest) GetPage() *common.PageRequest {
+	if x != nil {
+		return x.Page
+	}
+	return nil
+}
+
+func (x *ListVacanciesRequest) GetQuery() string {
+	if x != nil {
+		return x.Query
+	}
+	return ""
+}
+
+func (x *ListVacanciesRequest) GetStatuses() []VacancyStatus {
+	if x != nil {
+		return x.Statuses
+	}
+	return nil
+}
+
+func (x *ListVacanciesRequest) GetRoles() []string {
+	if x != nil {
+		return x.Roles
+	}
+	return nil
+}
+
+func (x *ListVacanciesRequest) GetSkills() []string {
+	if x != nil {
+		return x.Skills
+	}
+	return nil
+}
+
+func (x *ListVacanciesRequest) GetCreatedFrom() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedFrom
+	}
+	return nil
+}
+
+func (x *ListVacanciesRequest) GetCreatedTo() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedTo
+	}
+	return nil
+}
+
+func (x *ListVacanciesRequest) GetPageToken() string {
+	if x != nil {
+		return x.PageToken
+	}
+	return ""
+}
+
+func (x *ListVacanciesRequest) GetTotalMode() TotalMode {
+	if x != nil {
+		return x.TotalMode
+	}
+	return TotalMode_TOTAL_MODE_UNSPECIFIED
+}
+
+func (x *ListVacanciesRequest) GetLocations() []string {
+	if x != nil {
+		return x.Locations
+	}
+	return nil
+}
+
+func (x *ListVacanciesRequest) GetRemotePolicies() []RemotePolicy {
+	if x != nil {
+		return x.RemotePolicies
+	}
+	return nil
+}
+
+func (x *ListVacanciesRequest) GetEmploymentTypes() []EmploymentType {
+	if x != nil {
+		return x.EmploymentTypes
+	}
+	return nil
+}
+
+func (x *ListVacanciesRequest) GetSeniorities() []Seniority {
+	if x != nil {
+		return x.Seniorities
+	}
+	return nil
+}
+
+func (x *ListVacanciesRequest) GetSalaryAtLeast() uint64 {
+	if x != nil {
+		return x.SalaryAtLeast
+	}
+	return 0
+}
+
+func (x *ListVacanciesRequest) GetSalaryCurrency() string {
+	if x != nil {
+		return x.SalaryCurrency
+	}
+	return ""
+}
+
+// VacancyHighlight decorates a search hit. title and snippet are
+// HTML-escaped, with matched terms wrapped in <mark>…</mark>.
+type VacancyHighlight struct {
+	state     protoimpl.MessageState `protogen:"open.v1"`
+	VacancyId string                 `protobuf:"bytes,1,opt,name=vacancy_id,json=vacancyId,proto3" json:"vacancy_id,omitempty"`
+	Rank      float32                `protobuf:"fixed32,2,opt,name=rank,proto3" json:"rank,omitempty"`
+	Title     string                 `protobuf:"bytes,3,opt,name=title,proto3" json:"title,omitempty"`
+	// snippet holds the best-matching description fragments, joined by " … ".
+	Snippet       string `protobuf:"bytes,4,opt,name=snippet,proto3" json:"snippet,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *VacancyHighlight) Reset() {
+	*x = VacancyHighlight{}
+	mi := &file_models_vacancy_model_proto_msgTypes[10]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *VacancyHighlight) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*VacancyHighlight) ProtoMessage() {}
+
+func (x *VacancyHighlight) ProtoReflect() protoreflect.Message {
+	mi := &file_models_vacancy_model_proto_msgTypes[10]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use VacancyHighlight.ProtoReflect.Descriptor instead.
+func (*VacancyHighlight) Descriptor() ([]byte, []int) {
+	return file_models_vacancy_model_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *VacancyHighlight) GetVacancyId() string {
+	if x != nil {
+		return x.VacancyId
+	}
+	return ""
+}
+
+func (x *VacancyHighlight) GetRank() float32 {
+	if x != nil {
+		return x.Rank
+	}
+	return 0
+}
+
+func (x *VacancyHighlight) GetTitle() string {
+	if x != nil {
+		return x.Title
+	}
+	return ""
+}
+
+func (x *VacancyHighlight) GetSnippet() string {
+	if x != nil {
+		return x.Snippet
+	}
+	return ""
+}
+
+type ListVacanciesResponse struct {
+	state     protoimpl.MessageState `protogen:"open.v1"`
+	Vacancies []*Vacancy             `protobuf:"bytes,1,rep,name=vacancies,proto3" json:"vacancies,omitempty"`
+	Page      *common.PageResponse   `protobuf:"bytes,2,opt,name=page,proto3" json:"page,omitempty"`
+	// highlights has one entry per vacancy, in the same order, when the
+	// request had a query; empty otherwise.
+	Highlights []*VacancyHighlight `protobuf:"bytes,3,rep,name=highlights,proto3" json:"highlights,omitempty"`
+	// next_page_token fetches the next page in keyset mode; empty on the
+	// last page. Returned in offset mode too, so a client can switch after
+	// the first page.
+	NextPageToken string `protobuf:"bytes,4,opt,name=next_page_token,json=nextPageToken,proto3" json:"next_page_token,omitempty"`
+	// total_mode says what page.total holds.
+	TotalMode     TotalMode `protobuf:"varint,5,opt,name=total_mode,json=totalMode,proto3,enum=vacancy.models.v1.TotalMode" json:"total_mode,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListVacanciesResponse) Reset() {
+	*x = ListVacanciesResponse{}
+	mi := &file_models_vacancy_model_proto_msgTypes[11]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListVacanciesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListVacanciesResponse) ProtoMessage() {}
+
+func (x *ListVacanciesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_models_vacancy_model_proto_msgTypes[11]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListVacanciesResponse.ProtoReflect.Descriptor instead.
+func (*ListVacanciesResponse) Descriptor() ([]byte, []int) {
+	return file_models_vacancy_model_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *ListVacanciesResponse) GetVacancies() []*Vacancy {
+	if x != nil {
+		return x.Vacancies
+	}
+	return nil
+}
+
+func (x *ListVacanciesResponse) GetPage() *common.PageResponse {
+	if x != nil {
+		return x.Page
+	}
+	return nil
+}
+
+func (x *ListVacanciesResponse) GetHighlights() []*VacancyHighlight {
+	if x != nil {
+		return x.Highlights
+	}
+	return nil
+}
+
+func (x *ListVacanciesResponse) GetNextPageToken() string {
+	if x != nil {
+		return x.NextPageToken
+	}
+	return ""
+}
+
+func (x *ListVacanciesResponse) GetTotalMode() TotalMode {
+	if x != nil {
+		return x.TotalMode
+	}
+	return TotalMode_TOTAL_MODE_UNSPECIFIED
+}
+
+type UpdateVacancyRequest struct {
+	state       protoimpl.MessageState `protogen:"open.v1"`
+	VacancyId   string                 `protobuf:"bytes,1,opt,name=vacancy_id,json=vacancyId,proto3" json:"vacancy_id,omitempty"`
+	Title       string                 `protobuf:"bytes,2,opt,name=title,proto3" json:"title,omitempty"`
+	Description string                 `protobuf:"bytes,3,opt,name=description,proto3" json:"description,omitempty"`
+	Skills      []*SkillWeight         `protobuf:"bytes,4,rep,name=skills,proto3" json:"skills,omitempty"`
+	// role pins the vacancy to one of ListVacancyRoles, as on create. Empty
+	// keeps a pinned role and re-classifies an unpinned one.
+	Role string `protobuf:"bytes,5,opt,name=role,proto3" json:"role,omitempty"`
+	// expected_version makes the update conditional: it is applied only if
+	// the vacancy is still at this version, otherwise the call fails with
+	// ABORTED (HTTP 409) and ErrorInfo.metadata["current_version"]. Zero
+	// falls back to the If-Match header, and without either the update is
+	// unconditional.
+	ExpectedVersion uint32 `protobuf:"varint,6,opt,name=expected_version,json=expectedVersion,proto3" json:"expected_version,omitempty"`
+	// attributes replaces the structured attributes. Omitted keeps the
+	// stored ones, so older clients do not wipe them.
+	Attributes *VacancyAttributes `protobuf:"bytes,7,opt,name=attributes,proto3" json:"attributes,omitempty"`
+	// unlock_role releases a pinned role and re-classifies the vacancy.
+	// Cannot be combined with role.
+	UnlockRole bool `protobuf:"varint,8,opt,name=unlock_role,json=unlockRole,proto3" json:"unlock_role,omitempty"`
+	// scoring_policy replaces the scoring policy; omitted keeps the stored
+	// one. It applies to analyses run after the update.
+	ScoringPolicy *ScoringPolicy `protobuf:"bytes,9,opt,name=scoring_policy,json=scoringPolicy,proto3" json:"scoring_policy,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateVacancyRequest) Reset() {
+	*x = UpdateVacancyRequest{}
+	mi := &file_models_vacancy_model_proto_msgTypes[12]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateVacancyRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateVacancyRequest) ProtoMessage() {}
+
+func (x *UpdateVacancyRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_models_vacancy_model_proto_msgTypes[12]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateVacancyRequest.ProtoReflect.Descriptor instead.
+func (*UpdateVacancyRequest) Descriptor() ([]byte, []int) {
+	return file_models_vacancy_model_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *UpdateVacancyRequest) GetVacancyId() string {
+	if x != nil {
+		return x.VacancyId
+	}
+	return ""
+}
+
+func (x *UpdateVacancyRequest) GetTitle() string {
+	if x != nil {
+		return x.Title
+	}
+	return ""
+}
+
+func (x *UpdateVacancyRequest) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+func (x *UpdateVacancyRequest) GetSkills() []*SkillWeight {
+	if x != nil {
+		return x.Skills
+	}
+	return nil
+}
+
+func (x *UpdateVacancyRequest) GetRole() string {
+	if x != nil {
+		return x.Role
+	}
+	return ""
+}
+
+func (x *UpdateVacancyRequest) GetExpectedVersion() uint32 {
+	if x != nil {
+		return x.ExpectedVersion
+	}
+	return 0
+}
+
+func (x *UpdateVacancyRequest) GetAttributes() *VacancyAttributes {
+	if x != nil {
+		return x.Attributes
+	}
+	return nil
+}
+
+func (x *UpdateVacancyRequest) GetUnlockRole() bool {
+	if x != nil {
+		return x.UnlockRole
+	}
+	return false
+}
+
+func (x *UpdateVacancyRequest) GetScoringPolicy() *ScoringPolicy {
+	if x != nil {
+		return x.ScoringPolicy
+	}
+	return nil
+}
+
+type ArchiveVacancyRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	VacancyId     string                 `protobuf:"bytes,1,opt,name=vacancy_id,json=vacancyId,proto3" json:"vacancy_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ArchiveVacancyRequest) Reset() {
+	*x = ArchiveVacancyRequest{}
+	mi := &file_models_vacancy_model_proto_msgTypes[13]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ArchiveVacancyRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ArchiveVacancyRequest) ProtoMessage() {}
+
+func (x *ArchiveVacancyRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_models_vacancy_model_proto_msgTypes[13]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ArchiveVacancyRequest.ProtoReflect.Descriptor instead.
+func (*ArchiveVacancyRequest) Descriptor() ([]byte, []int) {
+	return file_models_vacancy_model_proto_rawDescGZIP(), []int{13}
+}
+
+func (x *ArchiveVacancyRequest) GetVacancyId() string {
+	if x != nil {
+		return x.VacancyId
+	}
+	return ""
+}
+
+type ArchiveVacancyResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Status        string                 `protobuf:"bytes,1,opt,name=status,proto3" json:"status,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ArchiveVacancyResponse) Reset() {
+	*x = ArchiveVacancyResponse{}
+	mi := &file_models_vacancy_model_proto_msgTypes[14]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ArchiveVacancyResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ArchiveVacancyResponse) ProtoMessage() {}
+
+func (x *ArchiveVacancyResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_models_vacancy_model_proto_msgTypes[14]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ArchiveVacancyResponse.ProtoReflect.Descriptor instead.
+func (*ArchiveVacancyResponse) Descriptor() ([]byte, []int) {
+	return file_models_vacancy_model_proto_rawDescGZIP(), []int{14}
+}
+
+func (x *ArchiveVacancyResponse) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+type DeleteVacancyRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	VacancyId     string                 `protobuf:"bytes,1,opt,name=vacancy_id,json=vacancyId,proto3" json:"vacancy_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteVacancyRequest) Reset() {
+	*x = DeleteVacancyRequest{}
+	mi := &file_models_vacancy_model_proto_msgTypes[15]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteVacancyRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteVacancyRequest) ProtoMessage() {}
+
+func (x *DeleteVacancyRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_models_vacancy_model_proto_msgTypes[15]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteVacancyRequest.ProtoReflect.Descriptor instead.
+func (*DeleteVacancyRequest) Descriptor() ([]byte, []int) {
+	return file_models_vacancy_model_proto_rawDescGZIP(), []int{15}
+}
+
+func (x *DeleteVacancyRequest) GetVacancyId() string {
+	if x != nil {
+		return x.VacancyId
+	}
+	return ""
+}
+
+type DeleteVacancyResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteVacancyResponse) Reset() {
+	*x = DeleteVacancyResponse{}
+	mi := &file_models_vacancy_model_proto_msgTypes[16]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteVacancyResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteVacancyResponse) ProtoMessage() {}
+
+func (x *DeleteVacancyResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_models_vacancy_model_proto_msgTypes[16]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteVacancyResponse.ProtoReflect.Descriptor instead.
+func (*DeleteVacancyResponse) Descriptor() ([]byte, []int) {
+	return file_models_vacancy_model_proto_rawDescGZIP(), []int{16}
+}
+
+type VacancyResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Vacancy       *Vacancy               `protobuf:"bytes,1,opt,name=vacancy,proto3" json:"vacancy,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *VacancyResponse) Reset() {
+	*x = VacancyResponse{}
+	mi := &file_models_vacancy_model_proto_msgTypes[17]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *VacancyResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*VacancyResponse) ProtoMessage() {}
+
+func (x *VacancyResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_models_vacancy_model_proto_msgTypes[17]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use VacancyResponse.ProtoReflect.Descriptor instead.
+func (*VacancyResponse) Descriptor() ([]byte, []int) {
+	return file_models_vacancy_model_proto_rawDescGZIP(), []int{17}
+}
+
+func (x *VacancyResponse) GetVacancy() *Vacancy {
+	if x != nil {
+		return x.Vacancy
+	}
+	return nil
+}
+
+// VacancyVersion is an immutable snapshot of a vacancy as of one version.
+// analyses.vacancy_version points here, so it answers "which skills was
+// this candidate scored against".
+type VacancyVersion struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	VacancyId     string                 `protobuf:"bytes,1,opt,name=vacancy_id,json=vacancyId,proto3" json:"vacancy_id,omitempty"`
+	Version       uint32                 `protobuf:"varint,2,opt,name=version,proto3" json:"version,omitempty"`
+	Title         string                 `protobuf:"bytes,3,opt,name=title,proto3" json:"title,omitempty"`
+	Description   string                 `protobuf:"bytes,4,opt,name=description,proto3" json:"description,omitempty"`
+	Role          string                 `protobuf:"bytes,5,opt,name=role,proto3" json:"role,omitempty"`
+	Skills        []*SkillWeight         `protobuf:"bytes,6,rep,name=skills,proto3" json:"skills,omitempty"`
+	CreatedBy     uint64                 `protobuf:"varint,7,opt,name=created_by,json=createdBy,proto3" json:"created_by,omitempty"`
+	CreatedAt     *timestamppb.Timestamp `protobuf:"bytes,8,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *VacancyVersion) Reset() {
+	*x = VacancyVersion{}
+	mi := &file_models_vacancy_model_proto_msgTypes[18]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *VacancyVersion) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*VacancyVersion) ProtoMessage() {}
+
+func (x *VacancyVersion) ProtoReflect() protoreflect.Message {
+	mi := &file_models_vacancy_model_proto_msgTypes[18]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use VacancyVersion.ProtoReflect.Descriptor instead.
+func (*VacancyVersion) Descriptor() ([]byte, []int) {
+	return file_models_vacancy_model_proto_rawDescGZIP(), []int{18}
+}
+
+func (x *VacancyVersion) GetVacancyId() string {
+	if x != nil {
+		return x.VacancyId
+	}
+	return ""
+}
+
+func (x *VacancyVersion) GetVersion() uint32 {
+	if x != nil {
+		return x.Version
+	}
+	return 0
+}
+
+func (x *VacancyVersion) GetTitle() string {
+	if x != nil {
+		return x.Title
+	}
+	return ""
+}
+
+func (x *VacancyVersion) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+func (x *VacancyVersion) GetRole() string {
+	if x != nil {
+		return x.Role
+	}
+	return ""
+}
+
+func (x *VacancyVersion) GetSkills() []*SkillWeight {
+	if x != nil {
+		return x.Skills
+	}
+	return nil
+}
+
+func (x *VacancyVersion) GetCreatedBy() uint64 {
+	if x != nil {
+		return x.CreatedBy
+	}
+	return 0
+}
+
+func (x *VacancyVersion) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+type ListVacancyVersionsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	VacancyId     string                 `protobuf:"bytes,1,opt,name=vacancy_id,json=vacancyId,proto3" json:"vacancy_id,omitempty"`
+	Page          *common.PageRequest    `protobuf:"bytes,2,opt,name=page,proto3" json:"page,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListVacancyVersionsRequest) Reset() {
+	*x = ListVacancyVersionsRequest{}
+	mi := &file_models_vacancy_model_proto_msgTypes[19]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListVacancyVersionsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListVacancyVersionsRequest) ProtoMessage() {}
+
+func (x *ListVacancyVersionsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_models_vacancy_model_proto_msgTypes[19]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListVacancyVersionsRequest.ProtoReflect.Descriptor instead.
+func (*ListVacancyVersionsRequest) Descriptor() ([]byte, []int) {
+	return file_models_vacancy_model_proto_rawDescGZIP(), []int{19}
+}
+
+func (x *ListVacancyVersionsRequest) GetVacancyId() string {
+	if x != nil {
+		return x.VacancyId
+	}
+	return ""
+}
+
+func (x *ListVacancyVersionsRequest) GetPage() *common.PageRequest {
+	if x != nil {
+		return x.Page
+	}
+	return nil
+}
+
+type ListVacancyVersionsResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Newest first.
+	Versions      []*VacancyVersion    `protobuf:"bytes,1,rep,name=versions,proto3" json:"versions,omitempty"`
+	Page          *common.PageResponse `protobuf:"bytes,2,opt,name=page,proto3" json:"page,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListVacancyVersionsResponse) Reset() {
+	*x = ListVacancyVersionsResponse{}
+	mi := &file_models_vacancy_model_proto_msgTypes[20]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListVacancyVersionsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListVacancyVersionsResponse) ProtoMessage() {}
+
+func (x *ListVacancyVersionsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_models_vacancy_model_proto_msgTypes[20]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListVacancyVersionsResponse.ProtoReflect.Descriptor instead.
+func (*ListVacancyVersionsResponse) Descriptor() ([]byte, []int) {
+	return file_models_vacancy_model_proto_rawDescGZIP(), []int{20}
+}
+
+func (x *ListVacancyVersionsResponse) GetVersions() []*VacancyVersion {
+	if x != nil {
+		return x.Versions
+	}
+	return nil
+}
+
+func (x *ListVacancyVersionsResponse) GetPage() *common.PageResponse {
+	if x != nil {
+		return x.Page
+	}
+	return nil
+}
+
+type GetVacancyVersionRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	VacancyId     string                 `protobuf:"bytes,1,opt,name=vacancy_id,json=vacancyId,proto3" json:"vacancy_id,omitempty"`
+	Version       uint32                 `protobuf:"varint,2,opt,name=version,proto3" json:"version,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetVacancyVersionRequest) Reset() {
+	*x = GetVacancyVersionRequest{}
+	mi := &file_models_vacancy_model_proto_msgTypes[21]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetVacancyVersionRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetVacancyVersionRequest) ProtoMessage() {}
+
+func (x *GetVacancyVersionRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_models_vacancy_model_proto_msgTypes[21]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetVacancyVersionRequest.ProtoReflect.Descriptor instead.
+func (*GetVacancyVersionRequest) Descriptor() ([]byte, []int) {
+	return file_models_vacancy_model_proto_rawDescGZIP(), []int{21}
+}
+
+func (x *GetVacancyVersionRequest) GetVacancyId() string {
+	if x != nil {
+		return x.VacancyId
+	}
+	return ""
+}
+
+func (x *GetVacancyVersionRequest) GetVersion() uint32 {
+	if x != nil {
+		return x.Version
+	}
+	return 0
+}
+
+type VacancyVersionResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Version       *VacancyVersion        `protobuf:"bytes,1,opt,name=version,proto3" json:"version,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *VacancyVersionResponse) Reset() {
+	*x = VacancyVersionResponse{}
+	mi := &file_models_vacancy_model_proto_msgTypes[22]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *VacancyVersionResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*VacancyVersionResponse) ProtoMessage() {}
+
+func (x *VacancyVersionResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_models_vacancy_model_proto_msgTypes[22]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use VacancyVersionResponse.ProtoReflect.Descriptor instead.
+func (*VacancyVersionResponse) Descriptor() ([]byte, []int) {
+	return file_models_vacancy_model_proto_rawDescGZIP(), []int{22}
+}
+
+func (x *VacancyVersionResponse) GetVersion() *VacancyVersion {
+	if x != nil {
+		return x.Version
+	}
+	return nil
+}
+
+type DiffVacancyVersionsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	VacancyId     string                 `protobuf:"bytes,1,opt,name=vacancy_id,json=vacancyId,proto3" json:"vacancy_id,omitempty"`
+	FromVersion   uint32                 `protobuf:"varint,2,opt,name=from_version,json=fromVersion,proto3" json:"from_version,omitempty"`
+	ToVersion     uint32                 `protobuf:"varint,3,opt,name=to_version,json=toVersion,proto3" json:"to_version,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DiffVacancyVersionsRequest) Reset() {
+	*x = DiffVacancyVersionsRequest{}
+	mi := &file_models_vacancy_model_proto_msgTypes[23]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DiffVacancyVersionsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DiffVacancyVersionsRequest) ProtoMessage() {}
+
+func (x *DiffVacancyVersionsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_models_vacancy_model_proto_msgTypes[23]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DiffVacancyVersionsRequest.ProtoReflect.Descriptor instead.
+func (*DiffVacancyVersionsRequest) Descriptor() ([]byte, []int) {
+	return file_models_vacancy_model_proto_rawDescGZIP(), []int{23}
+}
+
+func (x *DiffVacancyVersionsRequest) GetVacancyId() string {
+	if x != nil {
+		return x.VacancyId
+	}
+	return ""
+}
+
+func (x *DiffVacancyVersionsRequest) GetFromVersion() uint32 {
+	if x != nil {
+		return x.FromVersion
+	}
+	return 0
+}
+
+func (x *DiffVacancyVersionsRequest) GetToVersion() uint32 {
+	if x != nil {
+		return x.ToVersion
+	}
+	return 0
+}
+
+// SkillChange is a skill present in both versions whose weight or flags
+// differ. Skills are matched by case-insensitive name.
+type SkillChange struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Name          string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Before        *SkillWeight           `protobuf:"bytes,2,opt,name=before,proto3" json:"before,omitempty"`
+	After         *SkillWeight           `protobuf:"bytes,3,opt,name=after,proto3" json:"after,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SkillChange) Reset() {
+	*x = SkillChange{}
+	mi := &file_models_vacancy_model_proto_msgTypes[24]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SkillChange) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SkillChange) ProtoMessage() {}
+
+func (x *SkillChange) ProtoReflect() protoreflect.Message {
+	mi := &file_models_vacancy_model_proto_msgTypes[24]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SkillChange.ProtoReflect.Descriptor instead.
+func (*SkillChange) Descriptor() ([]byte, []int) {
+	return file_models_vacancy_model_proto_rawDescGZIP(), []int{24}
+}
+
+func (x *SkillChange) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *SkillChange) GetBefore() *SkillWeight {
+	if x != nil {
+		return x.Before
+	}
+	return nil
+}
+
+func (x *SkillChange) GetAfter() *SkillWeight {
+	if x != nil {
+		return x.After
+	}
+	return nil
+}
+
+type DiffVacancyVersionsResponse struct {
+	state              protoimpl.MessageState `protogen:"open.v1"`
+	VacancyId          string                 `protobuf:"bytes,1,opt,name=vacancy_id,json=vacancyId,proto3" json:"vacancy_id,omitempty"`
+	FromVersion        uint32                 `protobuf:"varint,2,opt,name=from_version,json=fromVersion,proto3" json:"from_version,omitempty"`
+	ToVersion          uint32                 `protobuf:"varint,3,opt,name=to_version,json=toVersion,proto3" json:"to_version,omitempty"`
+	TitleChanged       bool                   `protobuf:"varint,4,opt,name=title_changed,json=titleChanged,proto3" json:"title_changed,omitempty"`
+	DescriptionChanged bool                   `protobuf:"varint,5,opt,name=description_changed,json=descriptionChanged,proto3" json:"description_changed,omitempty"`
+	RoleChanged        bool                   `protobuf:"varint,6,opt,name=role_changed,json=roleChanged,proto3" json:"role_changed,omitempty"`
+	AddedSkills        []*SkillWeight         `protobuf:"bytes,7,rep,name=added_skills,json=addedSkills,proto3" json:"added_skills,omitempty"`
+	RemovedSkills      []*SkillWeight         `protobuf:"bytes,8,rep,name=removed_skills,json=removedSkills,proto3" json:"removed_skills,omitempty"`
+	ChangedSkills      []*SkillChange         `protobuf:"bytes,9,rep,name=changed_skills,json=changedSkills,proto3" json:"changed_skills,omitempty"`
+	unknownFields      protoimpl.UnknownFields
+	sizeCache          protoimpl.SizeCache
+}
+
+func (x *DiffVacancyVersionsResponse) Reset() {
+	*x = DiffVacancyVersionsResponse{}
+	mi := &file_models_vacancy_model_proto_msgTypes[25]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DiffVacancyVersionsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DiffVacancyVersionsResponse) ProtoMessage() {}
+
+func (x *DiffVacancyVersionsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_models_vacancy_model_proto_msgTypes[25]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DiffVacancyVersionsResponse.ProtoReflect.Descriptor instead.
+func (*DiffVacancyVersionsResponse) Descriptor() ([]byte, []int) {
+	return file_models_vacancy_model_proto_rawDescGZIP(), []int{25}
+}
+
+func (x *DiffVacancyVersionsResponse) GetVacancyId() string {
+	if x != nil {
+		return x.VacancyId
+	}
+	return ""
+}
+
+func (x *DiffVacancyVersionsResponse) GetFromVersion() uint32 {
+	if x != nil {
+		return x.FromVersion
+	}
+	return 0
+}
+
+func (x *DiffVacancyVersionsResponse) GetToVersion() uint32 {
+	if x != nil {
+		return x.ToVersion
+	}
+	return 0
+}
+
+func (x *DiffVacancyVersionsResponse) GetTitleChanged() bool {
+	if x != nil {
+		return x.TitleChanged
+	}
+	return false
+}
+
+func (x *DiffVacancyVersionsResponse) GetDescriptionChanged() bool {
+	if x != nil {
+		return x.DescriptionChanged
+	}
+	return false
+}
+
+func (x *DiffVacancyVersionsResponse) GetRoleChanged() bool {
+	if x != nil {
+		return x.RoleChanged
+	}
+	return false
+}
+
+func (x *DiffVacancyVersionsResponse) GetAddedSkills() []*SkillWeight {
+	if x != nil {
+		return x.AddedSkills
+	}
+	return nil
+}
+
+func (x *DiffVacancyVersionsResponse) GetRemovedSkills() []*SkillWeight {
+	if x != nil {
+		return x.RemovedSkills
+	}
+	return nil
+}
+
+func (x *DiffVacancyVersionsResponse) GetChangedSkills() []*SkillChange {
+	if x != nil {
+		return x.ChangedSkills
+	}
+	return nil
+}
+
+type TransitionVacancyRequest struct {
+	state     protoimpl.MessageState `protogen:"open.v1"`
+	VacancyId string                 `protobuf:"bytes,1,opt,name=vacancy_id,json=vacancyId,proto3" json:"vacancy_id,omitempty"`
+	ToStatus  VacancyStatus          `protobuf:"varint,2,opt,name=to_status,json=toStatus,proto3,enum=vacancy.models.v1.VacancyStatus" json:"to_status,omitempty"`
+	// reason is stored in the status history. Optional, up to 1000 characters.
+	Reason        string `protobuf:"bytes,3,opt,name=reason,proto3" json:"reason,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *TransitionVacancyRequest) Reset() {
+	*x = TransitionVacancyRequest{}
+	mi := &file_models_vacancy_model_proto_msgTypes[26]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TransitionVacancyRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TransitionVacancyRequest) ProtoMessage() {}
+
+func (x *TransitionVacancyRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_models_vacancy_model_proto_msgTypes[26]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TransitionVacancyRequest.ProtoReflect.Descriptor instead.
+func (*TransitionVacancyRequest) Descriptor() ([]byte, []int) {
+	return file_models_vacancy_model_proto_rawDescGZIP(), []int{26}
+}
+
+func (x *TransitionVacancyRequest) GetVacancyId() string {
+	if x != nil {
+		return x.VacancyId
+	}
+	return ""
+}
+
+func (x *TransitionVacancyRequest) GetToStatus() VacancyStatus {
+	if x != nil {
+		return x.ToStatus
+	}
+	return VacancyStatus_VACANCY_STATUS_UNSPECIFIED
+}
+
+func (x *TransitionVacancyRequest) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+// RestoreVacancyRequest brings an archived vacancy back to the status it had
+// before. For admins it also undoes DeleteVacancy within the grace period.
+type RestoreVacancyRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	VacancyId     string                 `protobuf:"bytes,1,opt,name=vacancy_id,json=vacancyId,proto3" json:"vacancy_id,omitempty"`
+	Reason        string                 `protobuf:"bytes,2,opt,name=reason,proto3" json:"reason,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RestoreVacancyRequest) Reset() {
+	*x = RestoreVacancyRequest{}
+	mi := &file_models_vacancy_model_proto_msgTypes[27]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RestoreVacancyRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RestoreVacancyRequest) ProtoMessage() {}
+
+func (x *RestoreVacancyRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_models_vacancy_model_proto_msgTypes[27]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RestoreVacancyRequest.ProtoReflect.Descriptor instead.
+func (*RestoreVacancyRequest) Descriptor() ([]byte, []int) {
+	return file_models_vacancy_model_proto_rawDescGZIP(), []int{27}
+}
+
+func (x *RestoreVacancyRequest) GetVacancyId() string {
+	if x != nil {
+		return x.VacancyId
+	}
+	return ""
+}
+
+func (x *RestoreVacancyRequest) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+// VacancyStatusChange is one entry of the status history. from_status is
+// UNSPECIFIED for the entry recorded when the vacancy was created.
+type VacancyStatusChange struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            uint64                 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	VacancyId     string                 `protobuf:"bytes,2,opt,name=vacancy_id,json=vacancyId,proto3" json:"vacancy_id,omitempty"`
+	FromStatus    VacancyStatus          `protobuf:"varint,3,opt,name=from_status,json=fromStatus,proto3,enum=vacancy.models.v1.VacancyStatus" json:"from_status,omitempty"`
+	ToStatus      VacancyStatus          `protobuf:"varint,4,opt,name=to_status,json=toStatus,proto3,enum=vacancy.models.v1.VacancyStatus" json:"to_status,omitempty"`
+	Reason        string                 `protobuf:"bytes,5,opt,name=reason,proto3" json:"reason,omitempty"`
+	ChangedBy     uint64                 `protobuf:"varint,6,opt,name=changed_by,json=changedBy,proto3" json:"changed_by,omitempty"`
+	ChangedAt     *timestamppb.Timestamp `protobuf:"bytes,7,opt,name=changed_at,json=changedAt,proto3" json:"changed_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *VacancyStatusChange) Reset() {
+	*x = VacancyStatusChange{}
+	mi := &file_models_vacancy_model_proto_msgTypes[28]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *VacancyStatusChange) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*VacancyStatusChange) ProtoMessage() {}
+
+func (x *VacancyStatusChange) ProtoReflect() protoreflect.Message {
+	mi := &file_models_vacancy_model_proto_msgTypes[28]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use VacancyStatusChange.ProtoReflect.Descriptor instead.
+func (*VacancyStatusChange) Descriptor() ([]byte, []int) {
+	return file_models_vacancy_model_proto_rawDescGZIP(), []int{28}
+}
+
+func (x *VacancyStatusChange) GetId() uint64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *VacancyStatusChange) GetVacancyId() string {
+	if x != nil {
+		return x.VacancyId
+	}
+	return ""
+}
+
+func (x *VacancyStatusChange) GetFromStatus() VacancyStatus {
+	if x != nil {
+		return x.FromStatus
+	}
+	return VacancyStatus_VACANCY_STATUS_UNSPECIFIED
+}
+
+func (x *VacancyStatusChange) GetToStatus() VacancyStatus {
+	if x != nil {
+		return x.ToStatus
+	}
+	return VacancyStatus_VACANCY_STATUS_UNSPECIFIED
+}
+
+func (x *VacancyStatusChange) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+func (x *VacancyStatusChange) GetChangedBy() uint64 {
+	if x != nil {
+		return x.ChangedBy
+	}
+	return 0
+}
+
+func (x *VacancyStatusChange) GetChangedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.ChangedAt
+	}
+	return nil
+}
+
+type ListVacancyStatusHistoryRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	VacancyId     string                 `protobuf:"bytes,1,opt,name=vacancy_id,json=vacancyId,proto3" json:"vacancy_id,omitempty"`
+	Page          *common.PageRequest    `protobuf:"bytes,2,opt,name=page,proto3" json:"page,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListVacancyStatusHistoryRequest) Reset() {
+	*x = ListVacancyStatusHistoryRequest{}
+	mi := &file_models_vacancy_model_proto_msgTypes[29]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListVacancyStatusHistoryRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListVacancyStatusHistoryRequest) ProtoMessage() {}
+
+func (x *ListVacancyStatusHistoryRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_models_vacancy_model_proto_msgTypes[29]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListVacancyStatusHistoryRequest.ProtoReflect.Descriptor instead.
+func (*ListVacancyStatusHistoryRequest) Descriptor() ([]byte, []int) {
+	return file_models_vacancy_model_proto_rawDescGZIP(), []int{29}
+}
+
+func (x *ListVacancyStatusHistoryRequest) GetVacancyId() string {
+	if x != nil {
+		return x.VacancyId
+	}
+	return ""
+}
+
+func (x *ListVacancyStatusHistoryRequest) GetPage() *common.PageRequest {
+	if x != nil {
+		return x.Page
+	}
+	return nil
+}
+
+type ListVacancyStatusHistoryResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Newest first.
+	Changes       []*VacancyStatusChange `protobuf:"bytes,1,rep,name=changes,proto3" json:"changes,omitempty"`
+	Page          *common.PageResponse   `protobuf:"bytes,2,opt,name=page,proto3" json:"page,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListVacancyStatusHistoryResponse) Reset() {
+	*x = ListVacancyStatusHistoryResponse{}
+	mi := &file_models_vacancy_model_proto_msgTypes[30]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListVacancyStatusHistoryResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListVacancyStatusHistoryResponse) ProtoMessage() {}
+
+func (x *ListVacancyStatusHistoryResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_models_vacancy_model_proto_msgTypes[30]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListVacancyStatusHistoryResponse.ProtoReflect.Descriptor instead.
+func (*ListVacancyStatusHistoryResponse) Descriptor() ([]byte, []int) {
+	return file_models_vacancy_model_proto_rawDescGZIP(), []int{30}
+}
+
+func (x *ListVacancyStatusHistoryResponse) GetChanges() []*VacancyStatusChange {
+	if x != nil {
+		return x.Changes
+	}
+	return nil
+}
+
+func (x *ListVacancyStatusHistoryResponse) GetPage() *common.PageResponse {
+	if x != nil {
+		return x.Page
+	}
+	return nil
+}
+
+// VacancyTemplate is a frozen copy of a vacancy's title, description, role
+// and skill matrix, reusable through CreateVacancyFromTemplate.
+type VacancyTemplate struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	Id              string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	OwnerUserId     uint64                 `protobuf:"varint,2,opt,name=owner_user_id,json=ownerUserId,proto3" json:"owner_user_id,omitempty"`
+	Scope           TemplateScope          `protobuf:"varint,3,opt,name=scope,proto3,enum=vacancy.models.v1.TemplateScope" json:"scope,omitempty"`
+	Name            string                 `protobuf:"bytes,4,opt,name=name,proto3" json:"name,omitempty"`
+	Title           string                 `protobuf:"bytes,5,opt,name=title,proto3" json:"title,omitempty"`
+	Description     string                 `protobuf:"bytes,6,opt,name=description,proto3" json:"description,omitempty"`
+	Role            string                 `protobuf:"bytes,7,opt,name=role,proto3" json:"role,omitempty"`
+	Skills          []*SkillWeight         `protobuf:"bytes,8,rep,name=skills,proto3" json:"skills,omitempty"`
+	SourceVacancyId string                 `protobuf:"bytes,9,opt,name=source_vacancy_id,json=sourceVacancyId,proto3" json:"source_vacancy_id,omitempty"`
+	CreatedAt       *timestamppb.Timestamp `protobuf:"bytes,10,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *VacancyTemplate) Reset() {
+	*x = VacancyTemplate{}
+	mi := &file_models_vacancy_model_proto_msgTypes[31]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *VacancyTemplate) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*VacancyTemplate) ProtoMessage() {}
+
+func (x *VacancyTemplate) ProtoReflect() protoreflect.Message {
+	mi := &file_models_vacancy_model_proto_msgTypes[31]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use VacancyTemplate.ProtoReflect.Descriptor instead.
+func (*VacancyTemplate) Descriptor() ([]byte, []int) {
+	return file_models_vacancy_model_proto_rawDescGZIP(), []int{31}
+}
+
+func (x *VacancyTemplate) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *VacancyTemplate) GetOwnerUserId() uint64 {
+	if x != nil {
+		return x.OwnerUserId
+	}
+	return 0
+}
+
+func (x *VacancyTemplate) GetScope() TemplateScope {
+	if x != nil {
+		return x.Scope
+	}
+	return TemplateScope_TEMPLATE_SCOPE_UNSPECIFIED
+}
+
+func (x *VacancyTemplate) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *VacancyTemplate) GetTitle() string {
+	if x != nil {
+		return x.Title
+	}
+	return ""
+}
+
+func (x *VacancyTemplate) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+func (x *VacancyTemplate) GetRole() string {
+	if x != nil {
+		return x.Role
+	}
+	return ""
+}
+
+func (x *VacancyTemplate) GetSkills() []*SkillWeight {
+	if x != nil {
+		return x.Skills
+	}
+	return nil
+}
+
+func (x *VacancyTemplate) GetSourceVacancyId() string {
+	if x != nil {
+		return x.SourceVacancyId
+	}
+	return ""
+}
+
+func (x *VacancyTemplate) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+type CreateTemplateRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// vacancy_id is the vacancy to save; the caller must be able to read it.
+	VacancyId string `protobuf:"bytes,1,opt,name=vacancy_id,json=vacancyId,proto3" json:"vacancy_id,omitempty"`
+	// name labels the template; defaults to the vacancy title.
+	Name string `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	// UNSPECIFIED means PERSONAL. ORG is admin-only.
+	Scope         TemplateScope `protobuf:"varint,3,opt,name=scope,proto3,enum=vacancy.models.v1.TemplateScope" json:"scope,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateTemplateRequest) Reset() {
+	*x = CreateTemplateRequest{}
+	mi := &file_models_vacancy_model_proto_msgTypes[32]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateTemplateRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateTemplateRequest) ProtoMessage() {}
+
+func (x *CreateTemplateRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_models_vacancy_model_proto_msgTypes[32]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateTemplateRequest.ProtoReflect.Descriptor instead.
+func (*CreateTemplateRequest) Descriptor() ([]byte, []int) {
+	return file_models_vacancy_model_proto_rawDescGZIP(), []int{32}
+}
+
+func (x *CreateTemplateRequest) GetVacancyId() string {
+	if x != nil {
+		return x.VacancyId
+	}
+	return ""
+}
+
+func (x *CreateTemplateRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *CreateTemplateRequest) GetScope() TemplateScope {
+	if x != nil {
+		return x.Scope
+	}
+	return TemplateScope_TEMPLATE_SCOPE_UNSPECIFIED
+}
+
+type VacancyTemplateResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Template      *VacancyTemplate       `protobuf:"bytes,1,opt,name=template,proto3" json:"template,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *VacancyTemplateResponse) Reset() {
+	*x = VacancyTemplateResponse{}
+	mi := &file_models_vacancy_model_proto_msgTypes[33]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *VacancyTemplateResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*VacancyTemplateResponse) ProtoMessage() {}
+
+func (x *VacancyTemplateResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_models_vacancy_model_proto_msgTypes[33]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use VacancyTemplateResponse.ProtoReflect.Descriptor instead.
+func (*VacancyTemplateResponse) Descriptor() ([]byte, []int) {
+	return file_models_vacancy_model_proto_rawDescGZIP(), []int{33}
+}
+
+func (x *VacancyTemplateResponse) GetTemplate() *VacancyTemplate {
+	if x != nil {
+		return x.Template
+	}
+	return nil
+}
+
+type ListTemplatesRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// UNSPECIFIED lists the caller's personal templates plus all org-wide
+	// ones.
+	Scope         TemplateScope       `protobuf:"varint,1,opt,name=scope,proto3,enum=vacancy.models.v1.TemplateScope" json:"scope,omitempty"`
+	Page          *common.PageRequest `protobuf:"bytes,2,opt,name=page,proto3" json:"page,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListTemplatesRequest) Reset() {
+	*x = ListTemplatesRequest{}
+	mi := &file_models_vacancy_model_proto_msgTypes[34]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListTemplatesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListTemplatesRequest) ProtoMessage() {}
+
+func (x *ListTemplatesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_models_vacancy_model_proto_msgTypes[34]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListTemplatesRequest.ProtoReflect.Descriptor instead.
+func (*ListTemplatesRequest) Descriptor() ([]byte, []int) {
+	return file_models_vacancy_model_proto_rawDescGZIP(), []int{34}
+}
+
+func (x *ListTemplatesRequest) GetScope() TemplateScope {
+	if x != nil {
+		return x.Scope
+	}
+	return TemplateScope_TEMPLATE_SCOPE_UNSPECIFIED
+}
+
+func (x *ListTemplatesRequest) GetPage() *common.PageRequest {
+	if x != nil {
+		return x.Page
+	}
+	return nil
+}
+
+type ListTemplatesResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Newest first.
+	Templates     []*VacancyTemplate   `protobuf:"bytes,1,rep,name=templates,proto3" json:"templates,omitempty"`
+	Page          *common.PageResponse `protobuf:"bytes,2,opt,name=page,proto3" json:"page,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListTemplatesResponse) Reset() {
+	*x = ListTemplatesResponse{}
+	mi := &file_models_vacancy_model_proto_msgTypes[35]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListTemplatesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListTemplatesResponse) ProtoMessage() {}
+
+func (x *ListTemplatesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_models_vacancy_model_proto_msgTypes[35]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListTemplatesResponse.ProtoReflect.Descriptor instead.
+func (*ListTemplatesResponse) Descriptor() ([]byte, []int) {
+	return file_models_vacancy_model_proto_rawDescGZIP(), []int{35}
+}
+
+func (x *ListTemplatesResponse) GetTemplates() []*VacancyTemplate {
+	if x != nil {
+		return x.Templates
+	}
+	return nil
+}
+
+func (x *ListTemplatesResponse) GetPage() *common.PageResponse {
+	if x != nil {
+		return x.Page
+	}
+	return nil
+}
+
+type CreateVacancyFromTemplateRequest struct {
+	state      protoimpl.MessageState `protogen:"open.v1"`
+	TemplateId string                 `protobuf:"bytes,1,opt,name=template_id,json=templateId,proto3" json:"template_id,omitempty"`
+	// title overrides the template title; empty keeps it.
+	Title         string `protobuf:"bytes,2,opt,name=title,proto3" json:"title,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateVacancyFromTemplateRequest) Reset() {
+	*x = CreateVacancyFromTemplateRequest{}
+	mi := &file_models_vacancy_model_proto_msgTypes[36]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateVacancyFromTemplateRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateVacancyFromTemplateRequest) ProtoMessage() {}
+
+func (x *CreateVacancyFromTemplateRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_models_vacancy_model_proto_msgTypes[36]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateVacancyFromTemplateRequest.ProtoReflect.Descriptor instead.
+func (*CreateVacancyFromTemplateRequest) Descriptor() ([]byte, []int) {
+	return file_models_vacancy_model_proto_rawDescGZIP(), []int{36}
+}
+
+func (x *CreateVacancyFromTemplateRequest) GetTemplateId() string {
+	if x != nil {
+		return x.TemplateId
+	}
+	return ""
+}
+
+func (x *CreateVacancyFromTemplateRequest) GetTitle() string {
+	if x != nil {
+		return x.Title
+	}
+	return ""
+}
+
+type DeleteTemplateRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	TemplateId    string                 `protobuf:"bytes,1,opt,name=template_id,json=templateId,proto3" json:"template_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteTemplateRequest) Reset() {
+	*x = DeleteTemplateRequest{}
+	mi := &file_models_vacancy_model_proto_msgTypes[37]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteTemplateRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteTemplateRequest) ProtoMessage() {}
+
+func (x *DeleteTemplateRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_models_vacancy_model_proto_msgTypes[37]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteTemplateRequest.ProtoReflect.Descriptor instead.
+func (*DeleteTemplateRequest) Descriptor() ([]byte, []int) {
+	return file_models_vacancy_model_proto_rawDescGZIP(), []int{37}
+}
+
+func (x *DeleteTemplateRequest) GetTemplateId() string {
+	if x != nil {
+		return x.TemplateId
+	}
+	return ""
+}
+
+type DeleteTemplateResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteTemplateResponse) Reset() {
+	*x = DeleteTemplateResponse{}
+	mi := &file_models_vacancy_model_proto_msgTypes[38]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteTemplateResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteTemplateResponse) ProtoMessage() {}
+
+func (x *DeleteTemplateResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_models_vacancy_model_proto_msgTypes[38]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteTemplateResponse.ProtoReflect.Descriptor instead.
+func (*DeleteTemplateResponse) Descriptor() ([]byte, []int) {
+	return file_models_vacancy_model_proto_rawDescGZIP(), []int{38}
+}
+
+type CloneVacancyRequest struct {
+	state     protoimpl.MessageState `protogen:"open.v1"`
+	VacancyId string                 `protobuf:"bytes,1,opt,name=vacancy_id,json=vacancyId,proto3" json:"vacancy_id,omitempty"`
+	// title overrides the copied title; empty keeps it.
+	Title         string `protobuf:"bytes,2,opt,name=title,proto3" json:"title,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CloneVacancyRequest) Reset() {
+	*x = CloneVacancyRequest{}
+	mi := &file_models_vacancy_model_proto_msgTypes[39]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CloneVacancyRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CloneVacancyRequest) ProtoMessage() {}
+
+func (x *CloneVacancyRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_models_vacancy_model_proto_msgTypes[39]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CloneVacancyRequest.ProtoReflect.Descriptor instead.
+func (*CloneVacancyRequest) Descriptor() ([]byte, []int) {
+	return file_models_vacancy_model_proto_rawDescGZIP(), []int{39}
+}
+
+func (x *CloneVacancyRequest) GetVacancyId() string {
+	if x != nil {
+		return x.VacancyId
+	}
+	return ""
+}
+
+func (x *CloneVacancyRequest) GetTitle() string {
+	if x != nil {
+		return x.Title
+	}
+	return ""
+}
+
+type AutocompleteSkillsRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// query is matched case-insensitively against every spelling of a skill:
+	// prefix matches first, then substring matches. 1..64 characters.
+	Query string `protobuf:"bytes,1,opt,name=query,proto3" json:"query,omitempty"`
+	// limit defaults to 10, capped at 50.
+	Limit         uint32 `protobuf:"varint,2,opt,name=limit,proto3" json:"limit,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AutocompleteSkillsRequest) Reset() {
+	*x = AutocompleteSkillsRequest{}
+	mi := &file_models_vacancy_model_proto_msgTypes[40]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AutocompleteSkillsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AutocompleteSkillsRequest) ProtoMessage() {}
+
+func (x *AutocompleteSkillsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_models_vacancy_model_proto_msgTypes[40]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AutocompleteSkillsRequest.ProtoReflect.Descriptor instead.
+func (*AutocompleteSkillsRequest) Descriptor() ([]byte, []int) {
+	return file_models_vacancy_model_proto_rawDescGZIP(), []int{40}
+}
+
+func (x *AutocompleteSkillsRequest) GetQuery() string {
+	if x != nil {
+		return x.Query
+	}
+	return ""
+}
+
+func (x *AutocompleteSkillsRequest) GetLimit() uint32 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+// SkillSuggestion is a canonical skill from the taxonomy. matched is the
+// spelling the query hit ("k8s" for Kubernetes); vacancies store name.
+type SkillSuggestion struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Name          string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	NameRu        string                 `protobuf:"bytes,2,opt,name=name_ru,json=nameRu,proto3" json:"name_ru,omitempty"`
+	Category      string                 `protobuf:"bytes,3,opt,name=category,proto3" json:"category,omitempty"`
+	Matched       string                 `protobuf:"bytes,4,opt,name=matched,proto3" json:"matched,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SkillSuggestion) Reset() {
+	*x = SkillSuggestion{}
+	mi := &file_models_vacancy_model_proto_msgTypes[41]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SkillSuggestion) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SkillSuggestion) ProtoMessage() {}
+
+func (x *SkillSuggestion) ProtoReflect() protoreflect.Message {
+	mi := &file_models_vacancy_model_proto_msgTypes[41]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SkillSuggestion.ProtoReflect.Descriptor instead.
+func (*SkillSuggestion) Descriptor() ([]byte, []int) {
+	return file_models_vacancy_model_proto_rawDescGZIP(), []int{41}
+}
+
+func (x *SkillSuggestion) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *SkillSuggestion) GetNameRu() string {
+	if x != nil {
+		return x.NameRu
+	}
+	return ""
+}
+
+func (x *SkillSuggestion) GetCategory() string {
+	if x != nil {
+		return x.Category
+	}
+	return ""
+}
+
+func (x *SkillSuggestion) GetMatched() string {
+	if x != nil {
+		return x.Matched
+	}
+	return ""
+}
+
+type AutocompleteSkillsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Skills        []*SkillSuggestion     `protobuf:"bytes,1,rep,name=skills,proto3" json:"skills,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AutocompleteSkillsResponse) Reset() {
+	*x = AutocompleteSkillsResponse{}
+	mi := &file_models_vacancy_model_proto_msgTypes[42]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AutocompleteSkillsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AutocompleteSkillsResponse) ProtoMessage() {}
+
+func (x *AutocompleteSkillsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_models_vacancy_model_proto_msgTypes[42]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AutocompleteSkillsResponse.ProtoReflect.Descriptor instead.
+func (*AutocompleteSkillsResponse) Descriptor() ([]byte, []int) {
+	return file_models_vacancy_model_proto_rawDescGZIP(), []int{42}
+}
+
+func (x *AutocompleteSkillsResponse) GetSkills() []*SkillSuggestion {
+	if x != nil {
+		return x.Skills
+	}
+	return nil
+}
+
+// SuggestSkillsRequest takes the editor's unsaved title and description;
+// at least one must be non-empty. Nothing is stored.
+type SuggestSkillsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Title         string                 `protobuf:"bytes,1,opt,name=title,proto3" json:"title,omitempty"`
+	Description   string                 `protobuf:"bytes,2,opt,name=description,proto3" json:"description,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SuggestSkillsRequest) Reset() {
+	*x = SuggestSkillsRequest{}
+	mi := &file_models_vacancy_model_proto_msgTypes[43]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SuggestSkillsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SuggestSkillsRequest) ProtoMessage() {}
+
+func (x *SuggestSkillsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_models_vacancy_model_proto_msgTypes[43]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SuggestSkillsRequest.ProtoReflect.Descriptor instead.
+func (*SuggestSkillsRequest) Descriptor() ([]byte, []int) {
+	return file_models_vacancy_model_proto_rawDescGZIP(), []int{43}
+}
+
+func (x *SuggestSkillsRequest) GetTitle() string {
+	if x != nil {
+		return x.Title
+	}
+	return ""
+}
+
+func (x *SuggestSkillsRequest) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+// SuggestSkillsResponse is a draft skill matrix that passes CreateVacancy
+// validation as-is. source is "llm" when multiagent produced it and
+// "keyword" when the taxonomy keyword scan stood in for an unavailable LLM.
+type SuggestSkillsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Skills        []*SkillWeight         `protobuf:"bytes,1,rep,name=skills,proto3" json:"skills,omitempty"`
+	Source        string                 `protobuf:"bytes,2,opt,name=source,proto3" json:"source,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SuggestSkillsResponse) Reset() {
+	*x = SuggestSkillsResponse{}
+	mi := &file_models_vacancy_model_proto_msgTypes[44]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SuggestSkillsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SuggestSkillsResponse) ProtoMessage() {}
+
+func (x *SuggestSkillsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_models_vacancy_model_proto_msgTypes[44]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SuggestSkillsResponse.ProtoReflect.Descriptor instead.
+func (*SuggestSkillsResponse) Descriptor() ([]byte, []int) {
+	return file_models_vacancy_model_proto_rawDescGZIP(), []int{44}
+}
+
+func (x *SuggestSkillsResponse) GetSkills() []*SkillWeight {
+	if x != nil {
+		return x.Skills
+	}
+	return nil
+}
+
+func (x *SuggestSkillsResponse) GetSource() string {
+	if x != nil {
+		return x.Source
+	}
+	return ""
+}
+
+// ImportVacanciesRequest carries the whole file (up to 3 MiB, 500 rows).
+// dry_run validates and resolves roles without writing anything.
+type ImportVacanciesRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Format        ImportFormat           `protobuf:"varint,1,opt,name=format,proto3,enum=vacancy.models.v1.ImportFormat" json:"format,omitempty"`
+	Data          []byte                 `protobuf:"bytes,2,opt,name=data,proto3" json:"data,omitempty"`
+	Mode          ImportMode             `protobuf:"varint,3,opt,name=mode,proto3,enum=vacancy.models.v1.ImportMode" json:"mode,omitempty"`
+	DryRun        bool                   `protobuf:"varint,4,opt,name=dry_run,json=dryRun,proto3" json:"dry_run,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ImportVacanciesRequest) Reset() {
+	*x = ImportVacanciesRequest{}
+	mi := &file_models_vacancy_model_proto_msgTypes[45]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ImportVacanciesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ImportVacanciesRequest) ProtoMessage() {}
+
+func (x *ImportVacanciesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_models_vacancy_model_proto_msgTypes[45]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ImportVacanciesRequest.ProtoReflect.Descriptor instead.
+func (*ImportVacanciesRequest) Descriptor() ([]byte, []int) {
+	return file_models_vacancy_model_proto_rawDescGZIP(), []int{45}
+}
+
+func (x *ImportVacanciesRequest) GetFormat() ImportFormat {
+	if x != nil {
+		return x.Format
+	}
+	return ImportFormat_IMPORT_FORMAT_UNSPECIFIED
+}
+
+func (x *ImportVacanciesRequest) GetData() []byte {
+	if x != nil {
+		return x.Data
+	}
+	return nil
+}
+
+func (x *ImportVacanciesRequest) GetMode() ImportMode {
+	if x != nil {
+		return x.Mode
+	}
+	return ImportMode_IMPORT_MODE_UNSPECIFIED
+}
+
+func (x *ImportVacanciesRequest) GetDryRun() bool {
+	if x != nil {
+		return x.DryRun
+	}
+	return false
+}
+
+// ImportRowResult is one source row: what would be (or was) stored and
+// why it was rejected. row is the spreadsheet row for CSV (header = 1), the
+// line for JSON-lines and the 1-based array index for hh.ru. vacancy is set
+// once the row is written; error is empty on success.
+type ImportRowResult struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Row           uint32                 `protobuf:"varint,1,opt,name=row,proto3" json:"row,omitempty"`
+	Title         string                 `protobuf:"bytes,2,opt,name=title,proto3" json:"title,omitempty"`
+	Status        VacancyStatus          `protobuf:"varint,3,opt,name=status,proto3,enum=vacancy.models.v1.VacancyStatus" json:"status,omitempty"`
+	Role          string                 `protobuf:"bytes,4,opt,name=role,proto3" json:"role,omitempty"`
+	Skills        []*SkillWeight         `protobuf:"bytes,5,rep,name=skills,proto3" json:"skills,omitempty"`
+	Vacancy       *Vacancy               `protobuf:"bytes,6,opt,name=vacancy,proto3" json:"vacancy,omitempty"`
+	Error         string                 `protobuf:"bytes,7,opt,name=error,proto3" json:"error,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ImportRowResult) Reset() {
+	*x = ImportRowResult{}
+	mi := &file_models_vacancy_model_proto_msgTypes[46]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ImportRowResult) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ImportRowResult) ProtoMessage() {}
+
+func (x *ImportRowResult) ProtoReflect() protoreflect.Message {
+	mi := &file_models_vacancy_model_proto_msgTypes[46]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ImportRowResult.ProtoReflect.Descriptor instead.
+func (*ImportRowResult) Descriptor() ([]byte, []int) {
+	return file_models_vacancy_model_proto_rawDescGZIP(), []int{46}
+}
+
+func (x *ImportRowResult) GetRow() uint32 {
+	if x != nil {
+		return x.Row
+	}
+	return 0
+}
+
+func (x *ImportRowResult) GetTitle() string {
+	if x != nil {
+		return x.Title
+	}
+	return ""
+}
+
+func (x *ImportRowResult) GetStatus() VacancyStatus {
+	if x != nil {
+		return x.Status
+	}
+	return VacancyStatus_VACANCY_STATUS_UNSPECIFIED
+}
+
+func (x *ImportRowResult) GetRole() string {
+	if x != nil {
+		return x.Role
+	}
+	return ""
+}
+
+func (x *ImportRowResult) GetSkills() []*SkillWeight {
+	if x != nil {
+		return x.Skills
+	}
+	return nil
+}
+
+func (x *ImportRowResult) GetVacancy() *Vacancy {
+	if x != nil {
+		return x.Vacancy
+	}
+	return nil
+}
+
+func (x *ImportRowResult) GetError() string {
+	if x != nil {
+		return x.Error
+	}
+	return ""
+}
+
+// ImportVacanciesResponse reports every row. committed is true when at
+// least one vacancy was written.
+type ImportVacanciesResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Rows          []*ImportRowResult     `protobuf:"bytes,1,rep,name=rows,proto3" json:"rows,omitempty"`
+	Created       uint32                 `protobuf:"varint,2,opt,name=created,proto3" json:"created,omitempty"`
+	Failed        uint32                 `protobuf:"varint,3,opt,name=failed,proto3" json:"failed,omitempty"`
+	Committed     bool                   `protobuf:"varint,4,opt,name=committed,proto3" json:"committed,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ImportVacanciesResponse) Reset() {
+	*x = ImportVacanciesResponse{}
+	mi := &file_models_vacancy_model_proto_msgTypes[47]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ImportVacanciesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ImportVacanciesResponse) ProtoMessage() {}
+
+func (x *ImportVacanciesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_models_vacancy_model_proto_msgTypes[47]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ImportVacanciesResponse.ProtoReflect.Descriptor instead.
+func (*ImportVacanciesResponse) Descriptor() ([]byte, []int) {
+	return file_models_vacancy_model_proto_rawDescGZIP(), []int{47}
+}
+
+func (x *ImportVacanciesResponse) GetRows() []*ImportRowResult {
+	if x != nil {
+		return x.Rows
+	}
+	return nil
+}
+
+func (x *ImportVacanciesResponse) GetCreated() uint32 {
+	if x != nil {
+		return x.Created
+	}
+	return 0
+}
+
+func (x *ImportVacanciesResponse) GetFailed() uint32 {
+	if x != nil {
+		return x.Failed
+	}
+	return 0
+}
+
+func (x *ImportVacanciesResponse) GetCommitted() bool {
+	if x != nil {
+		return x.Committed
+	}
+	return false
+}
+
+type SetVacancyVisibilityRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	VacancyId     string                 `protobuf:"bytes,1,opt,name=vacancy_id,json=vacancyId,proto3" json:"vacancy_id,omitempty"`
+	IsPublic      bool                   `protobuf:"varint,2,opt,name=is_public,json=isPublic,proto3" json:"is_public,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SetVacancyVisibilityRequest) Reset() {
+	*x = SetVacancyVisibilityRequest{}
+	mi := &file_models_vacancy_model_proto_msgTypes[48]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SetVacancyVisibilityRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetVacancyVisibilityRequest) ProtoMessage() {}
+
+func (x *SetVacancyVisibilityRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_models_vacancy_model_proto_msgTypes[48]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetVacancyVisibilityRequest.ProtoReflect.Descriptor instead.
+func (*SetVacancyVisibilityRequest) Descriptor() ([]byte, []int) {
+	return file_models_vacancy_model_proto_rawDescGZIP(), []int{48}
+}
+
+func (x *SetVacancyVisibilityRequest) GetVacancyId() string {
+	if x != nil {
+		return x.VacancyId
+	}
+	return ""
+}
+
+func (x *SetVacancyVisibilityRequest) GetIsPublic() bool {
+	if x != nil {
+		return x.IsPublic
+	}
+	return false
+}
+
+// GetJobPostingRequest addresses one published vacancy. Unpublished ids
+// answer NOT_FOUND exactly like missing ones.
+type GetJobPostingRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	VacancyId     string                 `protobuf:"bytes,1,opt,name=vacancy_id,json=vacancyId,proto3" json:"vacancy_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetJobPostingRequest) Reset() {
+	*x = GetJobPostingRequest{}
+	mi := &file_models_vacancy_model_proto_msgTypes[49]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetJobPostingRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetJobPostingRequest) ProtoMessage() {}
+
+func (x *GetJobPostingRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_models_vacancy_model_proto_msgTypes[49]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetJobPostingRequest.ProtoReflect.Descriptor instead.
+func (*GetJobPostingRequest) Descriptor() ([]byte, []int) {
+	return file_models_vacancy_model_proto_rawDescGZIP(), []int{49}
+}
+
+func (x *GetJobPostingRequest) GetVacancyId() string {
+	if x != nil {
+		return x.VacancyId
+	}
+	return ""
+}
+
+// GetVacancyFeedRequest selects the XML feed: owner_user_id 0 (the
+// /public/v1/feed.xml route) is the whole organisation.
+type GetVacancyFeedRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	OwnerUserId   uint64                 `protobuf:"varint,1,opt,name=owner_user_id,json=ownerUserId,proto3" json:"owner_user_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetVacancyFeedRequest) Reset() {
+	*x = GetVacancyFeedRequest{}
+	mi := &file_models_vacancy_model_proto_msgTypes[50]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetVacancyFeedRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetVacancyFeedRequest) ProtoMessage() {}
+
+func (x *GetVacancyFeedRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_models_vacancy_model_proto_msgTypes[50]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetVacancyFeedRequest.ProtoReflect.Descriptor instead.
+func (*GetVacancyFeedRequest) Descriptor() ([]byte, []int) {
+	return file_models_vacancy_model_proto_rawDescGZIP(), []int{50}
+}
+
+func (x *GetVacancyFeedRequest) GetOwnerUserId() uint64 {
+	if x != nil {
+		return x.OwnerUserId
+	}
+	return 0
+}
+
+// Collaborator is a per-vacancy grant. role is "viewer" (read the vacancy,
+// its candidates and analyses) or "editor" (also change them).
+type Collaborator struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	VacancyId     string                 `protobuf:"bytes,1,opt,name=vacancy_id,json=vacancyId,proto3" json:"vacancy_id,omitempty"`
+	UserId        uint64                 `protobuf:"varint,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Role          string                 `protobuf:"bytes,3,opt,name=role,proto3" json:"role,omitempty"`
+	AddedBy       uint64                 `protobuf:"varint,4,opt,name=added_by,json=addedBy,proto3" json:"added_by,omitempty"`
+	CreatedAt     *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Collaborator) Reset() {
+	*x = Collaborator{}
+	mi := &file_models_vacancy_model_proto_msgTypes[51]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Collaborator) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Collaborator) ProtoMessage() {}
+
+func (x *Collaborator) ProtoReflect() protoreflect.Message {
+	mi := &file_models_vacancy_model_proto_msgTypes[51]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Collaborator.ProtoReflect.Descriptor instead.
+func (*Collaborator) Descriptor() ([]byte, []int) {
+	return file_models_vacancy_model_proto_rawDescGZIP(), []int{51}
+}
+
+func (x *Collaborator) GetVacancyId() string {
+	if x != nil {
+		return x.VacancyId
+	}
+	return ""
+}
+
+func (x *Collaborator) GetUserId() uint64 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+func (x *Collaborator) GetRole() string {
+	if x != nil {
+		return x.Role
+	}
+	return ""
+}
+
+func (x *Collaborator) GetAddedBy() uint64 {
+	if x != nil {
+		return x.AddedBy
+	}
+	return 0
+}
+
+func (x *Collaborator) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+// AddCollaboratorRequest grants user_id access; re-adding an existing
+// collaborator changes the role.
+type AddCollaboratorRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	VacancyId     string                 `protobuf:"bytes,1,opt,name=vacancy_id,json=vacancyId,proto3" json:"vacancy_id,omitempty"`
+	UserId        uint64                 `protobuf:"varint,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Role          string                 `protobuf:"bytes,3,opt,name=role,proto3" json:"role,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AddCollaboratorRequest) Reset() {
+	*x = AddCollaboratorRequest{}
+	mi := &file_models_vacancy_model_proto_msgTypes[52]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AddCollaboratorRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AddCollaboratorRequest) ProtoMessage() {}
+
+func (x *AddCollaboratorRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_models_vacancy_model_proto_msgTypes[52]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AddCollaboratorRequest.ProtoReflect.Descriptor instead.
+func (*AddCollaboratorRequest) Descriptor() ([]byte, []int) {
+	return file_models_vacancy_model_proto_rawDescGZIP(), []int{52}
+}
+
+func (x *AddCollaboratorRequest) GetVacancyId() string {
+	if x != nil {
+		return x.VacancyId
+	}
+	return ""
+}
+
+func (x *AddCollaboratorRequest) GetUserId() uint64 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+func (x *AddCollaboratorRequest) GetRole() string {
+	if x != nil {
+		return x.Role
+	}
+	return ""
+}
+
+type CollaboratorResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Collaborator  *Collaborator          `protobuf:"bytes,1,opt,name=collaborator,proto3" json:"collaborator,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CollaboratorResponse) Reset() {
+	*x = CollaboratorResponse{}
+	mi := &file_models_vacancy_model_proto_msgTypes[53]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CollaboratorResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CollaboratorResponse) ProtoMessage() {}
+
+func (x *CollaboratorResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_models_vacancy_model_proto_msgTypes[53]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CollaboratorResponse.ProtoReflect.Descriptor instead.
+func (*CollaboratorResponse) Descriptor() ([]byte, []int) {
+	return file_models_vacancy_model_proto_rawDescGZIP(), []int{53}
+}
+
+func (x *CollaboratorResponse) GetCollaborator() *Collaborator {
+	if x != nil {
+		return x.Collaborator
+	}
+	return nil
+}
+
+type RemoveCollaboratorRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	VacancyId     string                 `protobuf:"bytes,1,opt,name=vacancy_id,json=vacancyId,proto3" json:"vacancy_id,omitempty"`
+	UserId        uint64                 `protobuf:"varint,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RemoveCollaboratorRequest) Reset() {
+	*x = RemoveCollaboratorRequest{}
+	mi := &file_models_vacancy_model_proto_msgTypes[54]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RemoveCollaboratorRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RemoveCollaboratorRequest) ProtoMessage() {}
+
+func (x *RemoveCollaboratorRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_models_vacancy_model_proto_msgTypes[54]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RemoveCollaboratorRequest.ProtoReflect.Descriptor instead.
+func (*RemoveCollaboratorRequest) Descriptor() ([]byte, []int) {
+	return file_models_vacancy_model_proto_rawDescGZIP(), []int{54}
+}
+
+func (x *RemoveCollaboratorRequest) GetVacancyId() string {
+	if x != nil {
+		return x.VacancyId
+	}
+	return ""
+}
+
+func (x *RemoveCollaboratorRequest) GetUserId() uint64 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+type RemoveCollaboratorResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RemoveCollaboratorResponse) Reset() {
+	*x = RemoveCollaboratorResponse{}
+	mi := &file_models_vacancy_model_proto_msgTypes[55]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RemoveCollaboratorResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RemoveCollaboratorResponse) ProtoMessage() {}
+
+func (x *RemoveCollaboratorResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_models_vacancy_model_proto_msgTypes[55]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RemoveCollaboratorResponse.ProtoReflect.Descriptor instead.
+func (*RemoveCollaboratorResponse) Descriptor() ([]byte, []int) {
+	return file_models_vacancy_model_proto_rawDescGZIP(), []int{55}
+}
+
+type ListCollaboratorsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	VacancyId     string                 `protobuf:"bytes,1,opt,name=vacancy_id,json=vacancyId,proto3" json:"vacancy_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListCollaboratorsRequest) Reset() {
+	*x = ListCollaboratorsRequest{}
+	mi := &file_models_vacancy_model_proto_msgTypes[56]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListCollaboratorsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListCollaboratorsRequest) ProtoMessage() {}
+
+func (x *ListCollaboratorsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_models_vacancy_model_proto_msgTypes[56]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListCollaboratorsRequest.ProtoReflect.Descriptor instead.
+func (*ListCollaboratorsRequest) Descriptor() ([]byte, []int) {
+	return file_models_vacancy_model_proto_rawDescGZIP(), []int{56}
+}
+
+func (x *ListCollaboratorsRequest) GetVacancyId() string {
+	if x != nil {
+		return x.VacancyId
+	}
+	return ""
+}
+
+type ListCollaboratorsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Collaborators []*Collaborator        `protobuf:"bytes,1,rep,name=collaborators,proto3" json:"collaborators,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListCollaboratorsResponse) Reset() {
+	*x = ListCollaboratorsResponse{}
+	mi := &file_models_vacancy_model_proto_msgTypes[57]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListCollaboratorsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListCollaboratorsResponse) ProtoMessage() {}
+
+func (x *ListCollaboratorsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_models_vacancy_model_proto_msgTypes[57]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListCollaboratorsResponse.ProtoReflect.Descriptor instead.
+func (*ListCollaboratorsResponse) Descriptor() ([]byte, []int) {
+	return file_models_vacancy_model_proto_rawDescGZIP(), []int{57}
+}
+
+func (x *ListCollaboratorsResponse) GetCollaborators() []*Collaborator {
+	if x != nil {
+		return x.Collaborators
+	}
+	return nil
+}
+
+// PipelineStage is one configurable stage of a vacancy's hiring pipeline.
+// key is a lowercase identifier (a-z, 0-9, _; starts with a letter, up to
+// 32 characters); name is the label, defaulting to the key.
+type PipelineStage struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Key           string                 `protobuf:"bytes,1,opt,name=key,proto3" json:"key,omitempty"`
+	Name          string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *SkillWeight) Reset() {
-	*x = SkillWeight{}
-	mi := &file_models_vacancy_model_proto_msgTypes[0]
+func (x *PipelineStage) Reset() {
+	*x = PipelineStage{}
+	mi := &file_models_vacancy_model_proto_msgTypes[58]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PipelineStage) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PipelineStage) ProtoMessage() {}
+
+func (x *PipelineStage) ProtoReflect() protoreflect.Message {
+	mi := &file_models_vacancy_model_proto_msgTypes[58]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PipelineStage.ProtoReflect.Descriptor instead.
+func (*PipelineStage) Descriptor() ([]byte, []int) {
+	return file_models_vacancy_model_proto_rawDescGZIP(), []int{58}
+}
+
+func (x *PipelineStage) GetKey() string {
+	if x != nil {
+		return x.Key
+	}
+	return ""
+}
+
+func (x *PipelineStage) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+// VacancyPipeline lists the active stages in order; the terminal stages
+// "hired" and "rejected" follow them implicitly. Candidates never moved are
+// in the first stage.
+type VacancyPipeline struct {
+	state     protoimpl.MessageState `protogen:"open.v1"`
+	VacancyId string                 `protobuf:"bytes,1,opt,name=vacancy_id,json=vacancyId,proto3" json:"vacancy_id,omitempty"`
+	Stages    []*PipelineStage       `protobuf:"bytes,2,rep,name=stages,proto3" json:"stages,omitempty"`
+	// headcount is the hiring target (the vacancy's headcount attribute);
+	// reaching it with hires moves the vacancy to filled.
+	Headcount uint32 `protobuf:"varint,3,opt,name=headcount,proto3" json:"headcount,omitempty"`
+	Hired     uint32 `protobuf:"varint,4,opt,name=hired,proto3" json:"hired,omitempty"`
+	// candidate_counts maps stage keys, terminal ones included, to the number
+	// of candidates currently there. Empty stages are absent.
+	CandidateCounts map[string]uint32 `protobuf:"bytes,5,rep,name=candidate_counts,json=candidateCounts,proto3" json:"candidate_counts,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"varint,2,opt,name=value"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *VacancyPipeline) Reset() {
+	*x = VacancyPipeline{}
+	mi := &file_models_vacancy_model_proto_msgTypes[59]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *SkillWeight) String() string {
+func (x *VacancyPipeline) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*SkillWeight) ProtoMessage() {}
+func (*VacancyPipeline) ProtoMessage() {}
 
-func (x *SkillWeight) ProtoReflect() protoreflect.Message {
-	mi := &file_models_vacancy_model_proto_msgTypes[0]
+func (x *VacancyPipeline) ProtoReflect() protoreflect.Message {
+	mi := &file_models_vacancy_model_proto_msgTypes[59]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -107,73 +4443,68 @@ func (x *SkillWeight) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use SkillWeight.ProtoReflect.Descriptor instead.
-func (*SkillWeight) Descriptor() ([]byte, []int) {
-	return file_models_vacancy_model_proto_rawDescGZIP(), []int{0}
+// Deprecated: Use VacancyPipeline.ProtoReflect.Descriptor instead.
+func (*VacancyPipeline) Descriptor() ([]byte, []int) {
+	return file_models_vacancy_model_proto_rawDescGZIP(), []int{59}
 }
 
-func (x *SkillWeight) GetName() string {
+func (x *VacancyPipeline) GetVacancyId() string {
 	if x != nil {
-		return x.Name
+		return x.VacancyId
 	}
 	return ""
 }
 
-func (x *SkillWeight) GetWeight() float32 {
+func (x *VacancyPipeline) GetStages() []*PipelineStage {
 	if x != nil {
-		return x.Weight
+		return x.Stages
+	}
+	return nil
+}
+
+func (x *VacancyPipeline) GetHeadcount() uint32 {
+	if x != nil {
+		return x.Headcount
 	}
 	return 0
 }
 
-func (x *SkillWeight) GetMustHave() bool {
+func (x *VacancyPipeline) GetHired() uint32 {
 	if x != nil {
-		return x.MustHave
+		return x.Hired
 	}
-	return false
+	return 0
 }
 
-func (x *SkillWeight) GetNiceToHave() bool {
+func (x *VacancyPipeline) GetCandidateCounts() map[string]uint32 {
 	if x != nil {
-		return x.NiceToHave
+		return x.CandidateCounts
 	}
-	return false
+	return nil
 }
 
-type Vacancy struct {
-	state       protoimpl.MessageState `protogen:"open.v1"`
-	Id          string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
-	OwnerUserId uint64                 `protobuf:"varint,2,opt,name=owner_user_id,json=ownerUserId,proto3" json:"owner_user_id,omitempty"`
-	Title       string                 `protobuf:"bytes,3,opt,name=title,proto3" json:"title,omitempty"`
-	Description string                 `protobuf:"bytes,4,opt,name=description,proto3" json:"description,omitempty"`
-	Skills      []*SkillWeight         `protobuf:"bytes,5,rep,name=skills,proto3" json:"skills,omitempty"`
-	Status      VacancyStatus          `protobuf:"varint,6,opt,name=status,proto3,enum=vacancy.models.v1.VacancyStatus" json:"status,omitempty"`
-	Version     uint32                 `protobuf:"varint,7,opt,name=version,proto3" json:"version,omitempty"`
-	CreatedAt   *timestamppb.Timestamp `protobuf:"bytes,8,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
-	UpdatedAt   *timestamppb.Timestamp `protobuf:"bytes,9,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
-	// role drives prompt selection in multiagent. Free-form string;
-	// multiagent looks up assets/prompts/<role>.txt and falls back to
-	// default.txt when no match is found.
-	Role          string `protobuf:"bytes,10,opt,name=role,proto3" json:"role,omitempty"`
+type GetVacancyPipelineRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	VacancyId     string                 `protobuf:"bytes,1,opt,name=vacancy_id,json=vacancyId,proto3" json:"vacancy_id,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *Vacancy) Reset() {
-	*x = Vacancy{}
-	mi := &file_models_vacancy_model_proto_msgTypes[1]
+func (x *GetVacancyPipelineRequest) Reset() {
+	*x = GetVacancyPipelineRequest{}
+	mi := &file_models_vacancy_model_proto_msgTypes[60]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *Vacancy) String() string {
+func (x *GetVacancyPipelineRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Vacancy) ProtoMessage() {}
+func (*GetVacancyPipelineRequest) ProtoMessage() {}
 
-func (x *Vacancy) ProtoReflect() protoreflect.Message {
-	mi := &file_models_vacancy_model_proto_msgTypes[1]
+func (x *GetVacancyPipelineRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_models_vacancy_model_proto_msgTypes[60]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -184,106 +4515,247 @@ func (x *Vacancy) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use Vacancy.ProtoReflect.Descriptor instead.
-func (*Vacancy) Descriptor() ([]byte, []int) {
-	return file_models_vacancy_model_proto_rawDescGZIP(), []int{1}
+// Deprecated: Use GetVacancyPipelineRequest.ProtoReflect.Descriptor instead.
+func (*GetVacancyPipelineRequest) Descriptor() ([]byte, []int) {
+	return file_models_vacancy_model_proto_rawDescGZIP(), []int{60}
 }
 
-func (x *Vacancy) GetId() string {
+func (x *GetVacancyPipelineRequest) GetVacancyId() string {
 	if x != nil {
-		return x.Id
+		return x.VacancyId
 	}
 	return ""
 }
 
-func (x *Vacancy) GetOwnerUserId() uint64 {
+// SetVacancyPipelineStagesRequest replaces the active stages (at most 20).
+// An empty list restores the default screening → interview → offer.
+// Dropping a stage with candidates in it fails with FAILED_PRECONDITION,
+// reason STAGE_IN_USE.
+type SetVacancyPipelineStagesRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	VacancyId     string                 `protobuf:"bytes,1,opt,name=vacancy_id,json=vacancyId,proto3" json:"vacancy_id,omitempty"`
+	Stages        []*PipelineStage       `protobuf:"bytes,2,rep,name=stages,proto3" json:"stages,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SetVacancyPipelineStagesRequest) Reset() {
+	*x = SetVacancyPipelineStagesRequest{}
+	mi := &file_models_vacancy_model_proto_msgTypes[61]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SetVacancyPipelineStagesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetVacancyPipelineStagesRequest) ProtoMessage() {}
+
+func (x *SetVacancyPipelineStagesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_models_vacancy_model_proto_msgTypes[61]
 	if x != nil {
-		return x.OwnerUserId
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return 0
+	return mi.MessageOf(x)
 }
 
-func (x *Vacancy) GetTitle() string {
+// Deprecated: Use SetVacancyPipelineStagesRequest.ProtoReflect.Descriptor instead.
+func (*SetVacancyPipelineStagesRequest) Descriptor() ([]byte, []int) {
+	return file_models_vacancy_model_proto_rawDescGZIP(), []int{61}
+}
+
+func (x *SetVacancyPipelineStagesRequest) GetVacancyId() string {
 	if x != nil {
-		return x.Title
+		return x.VacancyId
 	}
 	return ""
 }
 
-func (x *Vacancy) GetDescription() string {
+func (x *SetVacancyPipelineStagesRequest) GetStages() []*PipelineStage {
 	if x != nil {
-		return x.Description
+		return x.Stages
 	}
-	return ""
+	return nil
 }
 
-func (x *Vacancy) GetSkills() []*SkillWeight {
+type VacancyPipelineResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Pipeline      *VacancyPipeline       `protobuf:"bytes,1,opt,name=pipeline,proto3" json:"pipeline,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *VacancyPipelineResponse) Reset() {
+	*x = VacancyPipelineResponse{}
+	mi := &file_models_vacancy_model_proto_msgTypes[62]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *VacancyPipelineResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*VacancyPipelineResponse) ProtoMessage() {}
+
+func (x *VacancyPipelineResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_models_vacancy_model_proto_msgTypes[62]
 	if x != nil {
-		return x.Skills
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use VacancyPipelineResponse.ProtoReflect.Descriptor instead.
+func (*VacancyPipelineResponse) Descriptor() ([]byte, []int) {
+	return file_models_vacancy_model_proto_rawDescGZIP(), []int{62}
+}
+
+func (x *VacancyPipelineResponse) GetPipeline() *VacancyPipeline {
+	if x != nil {
+		return x.Pipeline
 	}
 	return nil
 }
 
-func (x *Vacancy) GetStatus() VacancyStatus {
+// CandidateStageChange is one move of a candidate through the pipeline.
+type CandidateStageChange struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            uint64                 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	VacancyId     string                 `protobuf:"bytes,2,opt,name=vacancy_id,json=vacancyId,proto3" json:"vacancy_id,omitempty"`
+	CandidateId   string                 `protobuf:"bytes,3,opt,name=candidate_id,json=candidateId,proto3" json:"candidate_id,omitempty"`
+	FromStage     string                 `protobuf:"bytes,4,opt,name=from_stage,json=fromStage,proto3" json:"from_stage,omitempty"`
+	ToStage       string                 `protobuf:"bytes,5,opt,name=to_stage,json=toStage,proto3" json:"to_stage,omitempty"`
+	Reason        string                 `protobuf:"bytes,6,opt,name=reason,proto3" json:"reason,omitempty"`
+	ChangedBy     uint64                 `protobuf:"varint,7,opt,name=changed_by,json=changedBy,proto3" json:"changed_by,omitempty"`
+	ChangedAt     *timestamppb.Timestamp `protobuf:"bytes,8,opt,name=changed_at,json=changedAt,proto3" json:"changed_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CandidateStageChange) Reset() {
+	*x = CandidateStageChange{}
+	mi := &file_models_vacancy_model_proto_msgTypes[63]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CandidateStageChange) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CandidateStageChange) ProtoMessage() {}
+
+func (x *CandidateStageChange) ProtoReflect() protoreflect.Message {
+	mi := &file_models_vacancy_model_proto_msgTypes[63]
 	if x != nil {
-		return x.Status
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return VacancyStatus_VACANCY_STATUS_UNSPECIFIED
+	return mi.MessageOf(x)
 }
 
-func (x *Vacancy) GetVersion() uint32 {
+// Deprecated: Use CandidateStageChange.ProtoReflect.Descriptor instead.
+func (*CandidateStageChange) Descriptor() ([]byte, []int) {
+	return file_models_vacancy_model_proto_rawDescGZIP(), []int{63}
+}
+
+func (x *CandidateStageChange) GetId() uint64 {
 	if x != nil {
-		return x.Version
+		return x.Id
 	}
 	return 0
 }
 
-func (x *Vacancy) GetCreatedAt() *timestamppb.Timestamp {
+func (x *CandidateStageChange) GetVacancyId() string {
 	if x != nil {
-		return x.CreatedAt
+		return x.VacancyId
 	}
-	return nil
+	return ""
 }
 
-func (x *Vacancy) GetUpdatedAt() *timestamppb.Timestamp {
+func (x *CandidateStageChange) GetCandidateId() string {
 	if x != nil {
-		return x.UpdatedAt
+		return x.CandidateId
 	}
-	return nil
+	return ""
 }
 
-func (x *Vacancy) GetRole() string {
+func (x *CandidateStageChange) GetFromStage() string {
 	if x != nil {
-		return x.Role
+		return x.FromStage
 	}
 	return ""
 }
 
-type CreateVacancyRequest struct {
+func (x *CandidateStageChange) GetToStage() string {
+	if x != nil {
+		return x.ToStage
+	}
+	return ""
+}
+
+func (x *CandidateStageChange) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+func (x *CandidateStageChange) GetChangedBy() uint64 {
+	if x != nil {
+		return x.ChangedBy
+	}
+	return 0
+}
+
+func (x *CandidateStageChange) GetChangedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.ChangedAt
+	}
+	return nil
+}
+
+// MoveCandidateStageRequest moves a candidate to stage: an active stage
+// key, "hired" or "rejected". reason is required for "rejected", optional
+// otherwise, up to 1000 characters.
+type MoveCandidateStageRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Title         string                 `protobuf:"bytes,1,opt,name=title,proto3" json:"title,omitempty"`
-	Description   string                 `protobuf:"bytes,2,opt,name=description,proto3" json:"description,omitempty"`
-	Skills        []*SkillWeight         `protobuf:"bytes,3,rep,name=skills,proto3" json:"skills,omitempty"`
-	Role          string                 `protobuf:"bytes,4,opt,name=role,proto3" json:"role,omitempty"`
+	VacancyId     string                 `protobuf:"bytes,1,opt,name=vacancy_id,json=vacancyId,proto3" json:"vacancy_id,omitempty"`
+	CandidateId   string                 `protobuf:"bytes,2,opt,name=candidate_id,json=candidateId,proto3" json:"candidate_id,omitempty"`
+	Stage         string                 `protobuf:"bytes,3,opt,name=stage,proto3" json:"stage,omitempty"`
+	Reason        string                 `protobuf:"bytes,4,opt,name=reason,proto3" json:"reason,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *CreateVacancyRequest) Reset() {
-	*x = CreateVacancyRequest{}
-	mi := &file_models_vacancy_model_proto_msgTypes[2]
+func (x *MoveCandidateStageRequest) Reset() {
+	*x = MoveCandidateStageRequest{}
+	mi := &file_models_vacancy_model_proto_msgTypes[64]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *CreateVacancyRequest) String() string {
+func (x *MoveCandidateStageRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CreateVacancyRequest) ProtoMessage() {}
+func (*MoveCandidateStageRequest) ProtoMessage() {}
 
-func (x *CreateVacancyRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_models_vacancy_model_proto_msgTypes[2]
+func (x *MoveCandidateStageRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_models_vacancy_model_proto_msgTypes[64]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -294,61 +4766,124 @@ func (x *CreateVacancyRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CreateVacancyRequest.ProtoReflect.Descriptor instead.
-func (*CreateVacancyRequest) Descriptor() ([]byte, []int) {
-	return file_models_vacancy_model_proto_rawDescGZIP(), []int{2}
+// Deprecated: Use MoveCandidateStageRequest.ProtoReflect.Descriptor instead.
+func (*MoveCandidateStageRequest) Descriptor() ([]byte, []int) {
+	return file_models_vacancy_model_proto_rawDescGZIP(), []int{64}
+}
+
+func (x *MoveCandidateStageRequest) GetVacancyId() string {
+	if x != nil {
+		return x.VacancyId
+	}
+	return ""
+}
+
+func (x *MoveCandidateStageRequest) GetCandidateId() string {
+	if x != nil {
+		return x.CandidateId
+	}
+	return ""
+}
+
+func (x *MoveCandidateStageRequest) GetStage() string {
+	if x != nil {
+		return x.Stage
+	}
+	return ""
+}
+
+func (x *MoveCandidateStageRequest) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+type MoveCandidateStageResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Change        *CandidateStageChange  `protobuf:"bytes,1,opt,name=change,proto3" json:"change,omitempty"`
+	VacancyStatus VacancyStatus          `protobuf:"varint,2,opt,name=vacancy_status,json=vacancyStatus,proto3,enum=vacancy.models.v1.VacancyStatus" json:"vacancy_status,omitempty"`
+	// vacancy_filled is set when this hire reached the headcount and moved
+	// the vacancy to filled.
+	VacancyFilled bool `protobuf:"varint,3,opt,name=vacancy_filled,json=vacancyFilled,proto3" json:"vacancy_filled,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *MoveCandidateStageResponse) Reset() {
+	*x = MoveCandidateStageResponse{}
+	mi := &file_models_vacancy_model_proto_msgTypes[65]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *MoveCandidateStageResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MoveCandidateStageResponse) ProtoMessage() {}
+
+func (x *MoveCandidateStageResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_models_vacancy_model_proto_msgTypes[65]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
 }
 
-func (x *CreateVacancyRequest) GetTitle() string {
-	if x != nil {
-		return x.Title
-	}
-	return ""
+// Deprecated: Use MoveCandidateStageResponse.ProtoReflect.Descriptor instead.
+func (*MoveCandidateStageResponse) Descriptor() ([]byte, []int) {
+	return file_models_vacancy_model_proto_rawDescGZIP(), []int{65}
 }
 
-func (x *CreateVacancyRequest) GetDescription() string {
+func (x *MoveCandidateStageResponse) GetChange() *CandidateStageChange {
 	if x != nil {
-		return x.Description
+		return x.Change
 	}
-	return ""
+	return nil
 }
 
-func (x *CreateVacancyRequest) GetSkills() []*SkillWeight {
+func (x *MoveCandidateStageResponse) GetVacancyStatus() VacancyStatus {
 	if x != nil {
-		return x.Skills
+		return x.VacancyStatus
 	}
-	return nil
+	return VacancyStatus_VACANCY_STATUS_UNSPECIFIED
 }
 
-func (x *CreateVacancyRequest) GetRole() string {
+func (x *MoveCandidateStageResponse) GetVacancyFilled() bool {
 	if x != nil {
-		return x.Role
+		return x.VacancyFilled
 	}
-	return ""
+	return false
 }
 
-type GetVacancyRequest struct {
+type ListCandidateStageHistoryRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	VacancyId     string                 `protobuf:"bytes,1,opt,name=vacancy_id,json=vacancyId,proto3" json:"vacancy_id,omitempty"`
+	CandidateId   string                 `protobuf:"bytes,2,opt,name=candidate_id,json=candidateId,proto3" json:"candidate_id,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetVacancyRequest) Reset() {
-	*x = GetVacancyRequest{}
-	mi := &file_models_vacancy_model_proto_msgTypes[3]
+func (x *ListCandidateStageHistoryRequest) Reset() {
+	*x = ListCandidateStageHistoryRequest{}
+	mi := &file_models_vacancy_model_proto_msgTypes[66]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetVacancyRequest) String() string {
+func (x *ListCandidateStageHistoryRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetVacancyRequest) ProtoMessage() {}
+func (*ListCandidateStageHistoryRequest) ProtoMessage() {}
 
-func (x *GetVacancyRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_models_vacancy_model_proto_msgTypes[3]
+func (x *ListCandidateStageHistoryRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_models_vacancy_model_proto_msgTypes[66]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -359,41 +4894,48 @@ func (x *GetVacancyRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetVacancyRequest.ProtoReflect.Descriptor instead.
-func (*GetVacancyRequest) Descriptor() ([]byte, []int) {
-	return file_models_vacancy_model_proto_rawDescGZIP(), []int{3}
+// Deprecated: Use ListCandidateStageHistoryRequest.ProtoReflect.Descriptor instead.
+func (*ListCandidateStageHistoryRequest) Descriptor() ([]byte, []int) {
+	return file_models_vacancy_model_proto_rawDescGZIP(), []int{66}
 }
 
-func (x *GetVacancyRequest) GetVacancyId() string {
+func (x *ListCandidateStageHistoryRequest) GetVacancyId() string {
 	if x != nil {
 		return x.VacancyId
 	}
 	return ""
 }
 
-type ListVacanciesRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Page          *common.PageRequest    `protobuf:"bytes,1,opt,name=page,proto3" json:"page,omitempty"`
-	Query         string                 `protobuf:"bytes,2,opt,name=query,proto3" json:"query,omitempty"`
+func (x *ListCandidateStageHistoryRequest) GetCandidateId() string {
+	if x != nil {
+		return x.CandidateId
+	}
+	return ""
+}
+
+type ListCandidateStageHistoryResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Newest first.
+	Changes       []*CandidateStageChange `protobuf:"bytes,1,rep,name=changes,proto3" json:"changes,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *ListVacanciesRequest) Reset() {
-	*x = ListVacanciesRequest{}
-	mi := &file_models_vacancy_model_proto_msgTypes[4]
+func (x *ListCandidateStageHistoryResponse) Reset() {
+	*x = ListCandidateStageHistoryResponse{}
+	mi := &file_models_vacancy_model_proto_msgTypes[67]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ListVacanciesRequest) String() string {
+func (x *ListCandidateStageHistoryResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ListVacanciesRequest) ProtoMessage() {}
+func (*ListCandidateStageHistoryResponse) ProtoMessage() {}
 
-func (x *ListVacanciesRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_models_vacancy_model_proto_msgTypes[4]
+func (x *ListCandidateStageHistoryResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_models_vacancy_model_proto_msgTypes[67]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -404,48 +4946,47 @@ func (x *ListVacanciesRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ListVacanciesRequest.ProtoReflect.Descriptor instead.
-func (*ListVacanciesRequest) Descriptor() ([]byte, []int) {
-	return file_models_vacancy_model_proto_rawDescGZIP(), []int{4}
+// Deprecated: Use ListCandidateStageHistoryResponse.ProtoReflect.Descriptor instead.
+func (*ListCandidateStageHistoryResponse) Descriptor() ([]byte, []int) {
+	return file_models_vacancy_model_proto_rawDescGZIP(), []int{67}
 }
 
-func (x *ListVacanciesRequest) GetPage() *common.PageRequest {
+func (x *ListCandidateStageHistoryResponse) GetChanges() []*CandidateStageChange {
 	if x != nil {
-		return x.Page
+		return x.Changes
 	}
 	return nil
 }
 
-func (x *ListVacanciesRequest) GetQuery() string {
-	if x != nil {
-		return x.Query
-	}
-	return ""
-}
-
-type ListVacanciesResponse struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Vacancies     []*Vacancy             `protobuf:"bytes,1,rep,name=vacancies,proto3" json:"vacancies,omitempty"`
-	Page          *common.PageResponse   `protobuf:"bytes,2,opt,name=page,proto3" json:"page,omitempty"`
+// ReclassifyVacancyRolesRequest re-runs the LLM role classifier over
+// vacancies whose role came from the given sources. Admin only.
+type ReclassifyVacancyRolesRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// sources selects vacancies by role source; empty means
+	// ROLE_SOURCE_KEYWORD. ROLE_SOURCE_MANUAL is rejected.
+	Sources []RoleSource `protobuf:"varint,1,rep,packed,name=sources,proto3,enum=vacancy.models.v1.RoleSource" json:"sources,omitempty"`
+	// limit caps the vacancies classified by this call: 100 by default,
+	// at most 500. Call again for the rest.
+	Limit         uint32 `protobuf:"varint,2,opt,name=limit,proto3" json:"limit,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *ListVacanciesResponse) Reset() {
-	*x = ListVacanciesResponse{}
-	mi := &file_models_vacancy_model_proto_msgTypes[5]
+func (x *ReclassifyVacancyRolesRequest) Reset() {
+	*x = ReclassifyVacancyRolesRequest{}
+	mi := &file_models_vacancy_model_proto_msgTypes[68]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ListVacanciesResponse) String() string {
+func (x *ReclassifyVacancyRolesRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ListVacanciesResponse) ProtoMessage() {}
+func (*ReclassifyVacancyRolesRequest) ProtoMessage() {}
 
-func (x *ListVacanciesResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_models_vacancy_model_proto_msgTypes[5]
+func (x *ReclassifyVacancyRolesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_models_vacancy_model_proto_msgTypes[68]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -456,51 +4997,56 @@ func (x *ListVacanciesResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ListVacanciesResponse.ProtoReflect.Descriptor instead.
-func (*ListVacanciesResponse) Descriptor() ([]byte, []int) {
-	return file_models_vacancy_model_proto_rawDescGZIP(), []int{5}
+// Deprecated: Use ReclassifyVacancyRolesRequest.ProtoReflect.Descriptor instead.
+func (*ReclassifyVacancyRolesRequest) Descriptor() ([]byte, []int) {
+	return file_models_vacancy_model_proto_rawDescGZIP(), []int{68}
 }
 
-func (x *ListVacanciesResponse) GetVacancies() []*Vacancy {
+func (x *ReclassifyVacancyRolesRequest) GetSources() []RoleSource {
 	if x != nil {
-		return x.Vacancies
+		return x.Sources
 	}
 	return nil
 }
 
-func (x *ListVacanciesResponse) GetPage() *common.PageResponse {
+func (x *ReclassifyVacancyRolesRequest) GetLimit() uint32 {
 	if x != nil {
-		return x.Page
+		return x.Limit
 	}
-	return nil
+	return 0
 }
 
-type UpdateVacancyRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	VacancyId     string                 `protobuf:"bytes,1,opt,name=vacancy_id,json=vacancyId,proto3" json:"vacancy_id,omitempty"`
-	Title         string                 `protobuf:"bytes,2,opt,name=title,proto3" json:"title,omitempty"`
-	Description   string                 `protobuf:"bytes,3,opt,name=description,proto3" json:"description,omitempty"`
-	Skills        []*SkillWeight         `protobuf:"bytes,4,rep,name=skills,proto3" json:"skills,omitempty"`
-	Role          string                 `protobuf:"bytes,5,opt,name=role,proto3" json:"role,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+type ReclassifyVacancyRolesResponse struct {
+	state   protoimpl.MessageState `protogen:"open.v1"`
+	Scanned uint32                 `protobuf:"varint,1,opt,name=scanned,proto3" json:"scanned,omitempty"`
+	// reclassified vacancies now carry an LLM role; changed is the subset
+	// whose role value differs from before.
+	Reclassified uint32 `protobuf:"varint,2,opt,name=reclassified,proto3" json:"reclassified,omitempty"`
+	Changed      uint32 `protobuf:"varint,3,opt,name=changed,proto3" json:"changed,omitempty"`
+	// skipped vacancies were edited during the run (the edit classified
+	// them).
+	Skipped uint32 `protobuf:"varint,4,opt,name=skipped,proto3" json:"skipped,omitempty"`
+	// llm_unavailable is set when the run stopped at a classifier failure.
+	LlmUnavailable bool `protobuf:"varint,5,opt,name=llm_unavailable,json=llmUnavailable,proto3" json:"llm_unavailable,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
 }
 
-func (x *UpdateVacancyRequest) Reset() {
-	*x = UpdateVacancyRequest{}
-	mi := &file_models_vacancy_model_proto_msgTypes[6]
+func (x *ReclassifyVacancyRolesResponse) Reset() {
+	*x = ReclassifyVacancyRolesResponse{}
+	mi := &file_models_vacancy_model_proto_msgTypes[69]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *UpdateVacancyRequest) String() string {
+func (x *ReclassifyVacancyRolesResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*UpdateVacancyRequest) ProtoMessage() {}
+func (*ReclassifyVacancyRolesResponse) ProtoMessage() {}
 
-func (x *UpdateVacancyRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_models_vacancy_model_proto_msgTypes[6]
+func (x *ReclassifyVacancyRolesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_models_vacancy_model_proto_msgTypes[69]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -511,68 +5057,67 @@ func (x *UpdateVacancyRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use UpdateVacancyRequest.ProtoReflect.Descriptor instead.
-func (*UpdateVacancyRequest) Descriptor() ([]byte, []int) {
-	return file_models_vacancy_model_proto_rawDescGZIP(), []int{6}
+// Deprecated: Use ReclassifyVacancyRolesResponse.ProtoReflect.Descriptor instead.
+func (*ReclassifyVacancyRolesResponse) Descriptor() ([]byte, []int) {
+	return file_models_vacancy_model_proto_rawDescGZIP(), []int{69}
 }
 
-func (x *UpdateVacancyRequest) GetVacancyId() string {
+func (x *ReclassifyVacancyRolesResponse) GetScanned() uint32 {
 	if x != nil {
-		return x.VacancyId
+		return x.Scanned
 	}
-	return ""
+	return 0
 }
 
-func (x *UpdateVacancyRequest) GetTitle() string {
+func (x *ReclassifyVacancyRolesResponse) GetReclassified() uint32 {
 	if x != nil {
-		return x.Title
+		return x.Reclassified
 	}
-	return ""
+	return 0
 }
 
-func (x *UpdateVacancyRequest) GetDescription() string {
+func (x *ReclassifyVacancyRolesResponse) GetChanged() uint32 {
 	if x != nil {
-		return x.Description
+		return x.Changed
 	}
-	return ""
+	return 0
 }
 
-func (x *UpdateVacancyRequest) GetSkills() []*SkillWeight {
+func (x *ReclassifyVacancyRolesResponse) GetSkipped() uint32 {
 	if x != nil {
-		return x.Skills
+		return x.Skipped
 	}
-	return nil
+	return 0
 }
 
-func (x *UpdateVacancyRequest) GetRole() string {
+func (x *ReclassifyVacancyRolesResponse) GetLlmUnavailable() bool {
 	if x != nil {
-		return x.Role
+		return x.LlmUnavailable
 	}
-	return ""
+	return false
 }
 
-type ArchiveVacancyRequest struct {
+type ListVacancyRolesRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	VacancyId     string                 `protobuf:"bytes,1,opt,name=vacancy_id,json=vacancyId,proto3" json:"vacancy_id,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *ArchiveVacancyRequest) Reset() {
-	*x = ArchiveVacancyRequest{}
-	mi := &file_models_vacancy_model_proto_msgTypes[7]
+func (x *ListVacancyRolesRequest) Reset() {
+	*x = ListVacancyRolesRequest{}
+	mi := &file_models_vacancy_model_proto_msgTypes[70]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ArchiveVacancyRequest) String() string {
+func (x *ListVacancyRolesRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ArchiveVacancyRequest) ProtoMessage() {}
+func (*ListVacancyRolesRequest) ProtoMessage() {}
 
-func (x *ArchiveVacancyRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_models_vacancy_model_proto_msgTypes[7]
+func (x *ListVacancyRolesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_models_vacancy_model_proto_msgTypes[70]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -583,40 +5128,82 @@ func (x *ArchiveVacancyRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ArchiveVacancyRequest.ProtoReflect.Descriptor instead.
-func (*ArchiveVacancyRequest) Descriptor() ([]byte, []int) {
-	return file_models_vacancy_model_proto_rawDescGZIP(), []int{7}
+// Deprecated: Use ListVacancyRolesRequest.ProtoReflect.Descriptor instead.
+func (*ListVacancyRolesRequest) Descriptor() ([]byte, []int) {
+	return file_models_vacancy_model_proto_rawDescGZIP(), []int{70}
 }
 
-func (x *ArchiveVacancyRequest) GetVacancyId() string {
+// ListVacancyRolesResponse lists the roles a vacancy can be pinned to:
+// multiagent's prompt roles, sorted, then "default".
+type ListVacancyRolesResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Roles         []string               `protobuf:"bytes,1,rep,name=roles,proto3" json:"roles,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListVacancyRolesResponse) Reset() {
+	*x = ListVacancyRolesResponse{}
+	mi := &file_models_vacancy_model_proto_msgTypes[71]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListVacancyRolesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListVacancyRolesResponse) ProtoMessage() {}
+
+func (x *ListVacancyRolesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_models_vacancy_model_proto_msgTypes[71]
 	if x != nil {
-		return x.VacancyId
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return ""
+	return mi.MessageOf(x)
 }
 
-type ArchiveVacancyResponse struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Status        string                 `protobuf:"bytes,1,opt,name=status,proto3" json:"status,omitempty"`
+// Deprecated: Use ListVacancyRolesResponse.ProtoReflect.Descriptor instead.
+func (*ListVacancyRolesResponse) Descriptor() ([]byte, []int) {
+	return file_models_vacancy_model_proto_rawDescGZIP(), []int{71}
+}
+
+func (x *ListVacancyRolesResponse) GetRoles() []string {
+	if x != nil {
+		return x.Roles
+	}
+	return nil
+}
+
+type TransferVacancyOwnershipRequest struct {
+	state      protoimpl.MessageState `protogen:"open.v1"`
+	FromUserId uint64                 `protobuf:"varint,1,opt,name=from_user_id,json=fromUserId,proto3" json:"from_user_id,omitempty"`
+	ToUserId   uint64                 `protobuf:"varint,2,opt,name=to_user_id,json=toUserId,proto3" json:"to_user_id,omitempty"`
+	// limit caps the batch: 200 when zero, at most 1000.
+	Limit         uint32 `protobuf:"varint,3,opt,name=limit,proto3" json:"limit,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *ArchiveVacancyResponse) Reset() {
-	*x = ArchiveVacancyResponse{}
-	mi := &file_models_vacancy_model_proto_msgTypes[8]
+func (x *TransferVacancyOwnershipRequest) Reset() {
+	*x = TransferVacancyOwnershipRequest{}
+	mi := &file_models_vacancy_model_proto_msgTypes[72]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ArchiveVacancyResponse) String() string {
+func (x *TransferVacancyOwnershipRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ArchiveVacancyResponse) ProtoMessage() {}
+func (*TransferVacancyOwnershipRequest) ProtoMessage() {}
 
-func (x *ArchiveVacancyResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_models_vacancy_model_proto_msgTypes[8]
+func (x *TransferVacancyOwnershipRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_models_vacancy_model_proto_msgTypes[72]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -627,40 +5214,56 @@ func (x *ArchiveVacancyResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ArchiveVacancyResponse.ProtoReflect.Descriptor instead.
-func (*ArchiveVacancyResponse) Descriptor() ([]byte, []int) {
-	return file_models_vacancy_model_proto_rawDescGZIP(), []int{8}
+// Deprecated: Use TransferVacancyOwnershipRequest.ProtoReflect.Descriptor instead.
+func (*TransferVacancyOwnershipRequest) Descriptor() ([]byte, []int) {
+	return file_models_vacancy_model_proto_rawDescGZIP(), []int{72}
 }
 
-func (x *ArchiveVacancyResponse) GetStatus() string {
+func (x *TransferVacancyOwnershipRequest) GetFromUserId() uint64 {
 	if x != nil {
-		return x.Status
+		return x.FromUserId
 	}
-	return ""
+	return 0
 }
 
-type VacancyResponse struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Vacancy       *Vacancy               `protobuf:"bytes,1,opt,name=vacancy,proto3" json:"vacancy,omitempty"`
+func (x *TransferVacancyOwnershipRequest) GetToUserId() uint64 {
+	if x != nil {
+		return x.ToUserId
+	}
+	return 0
+}
+
+func (x *TransferVacancyOwnershipRequest) GetLimit() uint32 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+type TransferVacancyOwnershipResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// transferred is how many vacancies this batch moved; zero means none
+	// are left.
+	Transferred   uint32 `protobuf:"varint,1,opt,name=transferred,proto3" json:"transferred,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *VacancyResponse) Reset() {
-	*x = VacancyResponse{}
-	mi := &file_models_vacancy_model_proto_msgTypes[9]
+func (x *TransferVacancyOwnershipResponse) Reset() {
+	*x = TransferVacancyOwnershipResponse{}
+	mi := &file_models_vacancy_model_proto_msgTypes[73]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *VacancyResponse) String() string {
+func (x *TransferVacancyOwnershipResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*VacancyResponse) ProtoMessage() {}
+func (*TransferVacancyOwnershipResponse) ProtoMessage() {}
 
-func (x *VacancyResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_models_vacancy_model_proto_msgTypes[9]
+func (x *TransferVacancyOwnershipResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_models_vacancy_model_proto_msgTypes[73]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -671,29 +5274,46 @@ func (x *VacancyResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use VacancyResponse.ProtoReflect.Descriptor instead.
-func (*VacancyResponse) Descriptor() ([]byte, []int) {
-	return file_models_vacancy_model_proto_rawDescGZIP(), []int{9}
+// Deprecated: Use TransferVacancyOwnershipResponse.ProtoReflect.Descriptor instead.
+func (*TransferVacancyOwnershipResponse) Descriptor() ([]byte, []int) {
+	return file_models_vacancy_model_proto_rawDescGZIP(), []int{73}
 }
 
-func (x *VacancyResponse) GetVacancy() *Vacancy {
+func (x *TransferVacancyOwnershipResponse) GetTransferred() uint32 {
 	if x != nil {
-		return x.Vacancy
+		return x.Transferred
 	}
-	return nil
+	return 0
 }
 
 var File_models_vacancy_model_proto protoreflect.FileDescriptor
 
 const file_models_vacancy_model_proto_rawDesc = "" +
 	"\n" +
-	"\x1amodels/vacancy_model.proto\x12\x11vacancy.models.v1\x1a\x13common/common.proto\x1a\x1fgoogle/protobuf/timestamp.proto\"x\n" +
+	"\x1amodels/vacancy_model.proto\x12\x11vacancy.models.v1\x1a\x13common/common.proto\x1a\x1fgoogle/protobuf/timestamp.proto\"\x82\x03\n" +
+	"\x11VacancyAttributes\x12\x1a\n" +
+	"\blocation\x18\x01 \x01(\tR\blocation\x12D\n" +
+	"\rremote_policy\x18\x02 \x01(\x0e2\x1f.vacancy.models.v1.RemotePolicyR\fremotePolicy\x12\x1d\n" +
+	"\n" +
+	"salary_min\x18\x03 \x01(\x04R\tsalaryMin\x12\x1d\n" +
+	"\n" +
+	"salary_max\x18\x04 \x01(\x04R\tsalaryMax\x12'\n" +
+	"\x0fsalary_currency\x18\x05 \x01(\tR\x0esalaryCurrency\x12J\n" +
+	"\x0femployment_type\x18\x06 \x01(\x0e2!.vacancy.models.v1.EmploymentTypeR\x0eemploymentType\x12:\n" +
+	"\tseniority\x18\a \x01(\x0e2\x1c.vacancy.models.v1.SeniorityR\tseniority\x12\x1c\n" +
+	"\theadcount\x18\b \x01(\rR\theadcount\"\xf6\x01\n" +
+	"\rScoringPolicy\x12*\n" +
+	"\x11must_have_penalty\x18\x01 \x01(\x02R\x0fmustHavePenalty\x12+\n" +
+	"\x12nice_to_have_bonus\x18\x02 \x01(\x02R\x0fniceToHaveBonus\x12%\n" +
+	"\x0ehire_threshold\x18\x03 \x01(\x02R\rhireThreshold\x12'\n" +
+	"\x0fmaybe_threshold\x18\x04 \x01(\x02R\x0emaybeThreshold\x12<\n" +
+	"\x1breject_on_missing_must_have\x18\x05 \x01(\bR\x17rejectOnMissingMustHave\"x\n" +
 	"\vSkillWeight\x12\x12\n" +
 	"\x04name\x18\x01 \x01(\tR\x04name\x12\x16\n" +
 	"\x06weight\x18\x02 \x01(\x02R\x06weight\x12\x1b\n" +
 	"\tmust_have\x18\x03 \x01(\bR\bmustHave\x12 \n" +
 	"\fnice_to_have\x18\x04 \x01(\bR\n" +
-	"niceToHave\"\x8b\x03\n" +
+	"niceToHave\"\xe2\x05\n" +
 	"\aVacancy\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\tR\x02id\x12\"\n" +
 	"\rowner_user_id\x18\x02 \x01(\x04R\vownerUserId\x12\x14\n" +
@@ -707,39 +5327,411 @@ const file_models_vacancy_model_proto_rawDesc = "" +
 	"\n" +
 	"updated_at\x18\t \x01(\v2\x1a.google.protobuf.TimestampR\tupdatedAt\x12\x12\n" +
 	"\x04role\x18\n" +
-	" \x01(\tR\x04role\"\x9a\x01\n" +
+	" \x01(\tR\x04role\x12\x1b\n" +
+	"\tis_public\x18\v \x01(\bR\bisPublic\x12D\n" +
+	"\n" +
+	"attributes\x18\f \x01(\v2$.vacancy.models.v1.VacancyAttributesR\n" +
+	"attributes\x12>\n" +
+	"\vrole_source\x18\r \x01(\x0e2\x1d.vacancy.models.v1.RoleSourceR\n" +
+	"roleSource\x12H\n" +
+	"\x12role_classified_at\x18\x0e \x01(\v2\x1a.google.protobuf.TimestampR\x10roleClassifiedAt\x12\x1f\n" +
+	"\vrole_locked\x18\x0f \x01(\bR\n" +
+	"roleLocked\x12G\n" +
+	"\x0escoring_policy\x18\x10 \x01(\v2 .vacancy.models.v1.ScoringPolicyR\rscoringPolicy\"\xd5\x02\n" +
 	"\x14CreateVacancyRequest\x12\x14\n" +
 	"\x05title\x18\x01 \x01(\tR\x05title\x12 \n" +
 	"\vdescription\x18\x02 \x01(\tR\vdescription\x126\n" +
 	"\x06skills\x18\x03 \x03(\v2\x1e.vacancy.models.v1.SkillWeightR\x06skills\x12\x12\n" +
-	"\x04role\x18\x04 \x01(\tR\x04role\"2\n" +
+	"\x04role\x18\x04 \x01(\tR\x04role\x12\x14\n" +
+	"\x05draft\x18\x05 \x01(\bR\x05draft\x12D\n" +
+	"\n" +
+	"attributes\x18\x06 \x01(\v2$.vacancy.models.v1.VacancyAttributesR\n" +
+	"attributes\x12G\n" +
+	"\x0escoring_policy\x18\a \x01(\v2 .vacancy.models.v1.ScoringPolicyR\rscoringPolicy\x12\x14\n" +
+	"\x05force\x18\b \x01(\bR\x05force\"h\n" +
+	"\x15CreateVacancyResponse\x124\n" +
+	"\avacancy\x18\x01 \x01(\v2\x1a.vacancy.models.v1.VacancyR\avacancyJ\x04\b\x02\x10\x03R\x13possible_duplicates\"Y\n" +
+	"\x12PossibleDuplicates\x12C\n" +
+	"\n" +
+	"duplicates\x18\x01 \x03(\v2#.vacancy.models.v1.VacancyDuplicateR\n" +
+	"duplicates\"\xe1\x01\n" +
+	"\x10VacancyDuplicate\x12\x1d\n" +
+	"\n" +
+	"vacancy_id\x18\x01 \x01(\tR\tvacancyId\x12\x14\n" +
+	"\x05title\x18\x02 \x01(\tR\x05title\x12\"\n" +
+	"\rowner_user_id\x18\x03 \x01(\x04R\vownerUserId\x12)\n" +
+	"\x10title_similarity\x18\x04 \x01(\x02R\x0ftitleSimilarity\x12)\n" +
+	"\x10skill_similarity\x18\x05 \x01(\x02R\x0fskillSimilarity\x12\x1e\n" +
+	"\n" +
+	"similarity\x18\x06 \x01(\x02R\n" +
+	"similarity\"2\n" +
 	"\x11GetVacancyRequest\x12\x1d\n" +
 	"\n" +
-	"vacancy_id\x18\x01 \x01(\tR\tvacancyId\"X\n" +
+	"vacancy_id\x18\x01 \x01(\tR\tvacancyId\"\xe1\x05\n" +
 	"\x14ListVacanciesRequest\x12*\n" +
 	"\x04page\x18\x01 \x01(\v2\x16.common.v1.PageRequestR\x04page\x12\x14\n" +
-	"\x05query\x18\x02 \x01(\tR\x05query\"~\n" +
+	"\x05query\x18\x02 \x01(\tR\x05query\x12<\n" +
+	"\bstatuses\x18\x03 \x03(\x0e2 .vacancy.models.v1.VacancyStatusR\bstatuses\x12\x14\n" +
+	"\x05roles\x18\x04 \x03(\tR\x05roles\x12\x16\n" +
+	"\x06skills\x18\x05 \x03(\tR\x06skills\x12=\n" +
+	"\fcreated_from\x18\x06 \x01(\v2\x1a.google.protobuf.TimestampR\vcreatedFrom\x129\n" +
+	"\n" +
+	"created_to\x18\a \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedTo\x12\x1d\n" +
+	"\n" +
+	"page_token\x18\b \x01(\tR\tpageToken\x12;\n" +
+	"\n" +
+	"total_mode\x18\t \x01(\x0e2\x1c.vacancy.models.v1.TotalModeR\ttotalMode\x12\x1c\n" +
+	"\tlocations\x18\n" +
+	" \x03(\tR\tlocations\x12H\n" +
+	"\x0fremote_policies\x18\v \x03(\x0e2\x1f.vacancy.models.v1.RemotePolicyR\x0eremotePolicies\x12L\n" +
+	"\x10employment_types\x18\f \x03(\x0e2!.vacancy.models.v1.EmploymentTypeR\x0femploymentTypes\x12>\n" +
+	"\vseniorities\x18\r \x03(\x0e2\x1c.vacancy.models.v1.SeniorityR\vseniorities\x12&\n" +
+	"\x0fsalary_at_least\x18\x0e \x01(\x04R\rsalaryAtLeast\x12'\n" +
+	"\x0fsalary_currency\x18\x0f \x01(\tR\x0esalaryCurrency\"u\n" +
+	"\x10VacancyHighlight\x12\x1d\n" +
+	"\n" +
+	"vacancy_id\x18\x01 \x01(\tR\tvacancyId\x12\x12\n" +
+	"\x04rank\x18\x02 \x01(\x02R\x04rank\x12\x14\n" +
+	"\x05title\x18\x03 \x01(\tR\x05title\x12\x18\n" +
+	"\asnippet\x18\x04 \x01(\tR\asnippet\"\xa8\x02\n" +
 	"\x15ListVacanciesResponse\x128\n" +
 	"\tvacancies\x18\x01 \x03(\v2\x1a.vacancy.models.v1.VacancyR\tvacancies\x12+\n" +
-	"\x04page\x18\x02 \x01(\v2\x17.common.v1.PageResponseR\x04page\"\xb9\x01\n" +
+	"\x04page\x18\x02 \x01(\v2\x17.common.v1.PageResponseR\x04page\x12C\n" +
+	"\n" +
+	"highlights\x18\x03 \x03(\v2#.vacancy.models.v1.VacancyHighlightR\n" +
+	"highlights\x12&\n" +
+	"\x0fnext_page_token\x18\x04 \x01(\tR\rnextPageToken\x12;\n" +
+	"\n" +
+	"total_mode\x18\x05 \x01(\x0e2\x1c.vacancy.models.v1.TotalModeR\ttotalMode\"\x94\x03\n" +
 	"\x14UpdateVacancyRequest\x12\x1d\n" +
 	"\n" +
 	"vacancy_id\x18\x01 \x01(\tR\tvacancyId\x12\x14\n" +
 	"\x05title\x18\x02 \x01(\tR\x05title\x12 \n" +
 	"\vdescription\x18\x03 \x01(\tR\vdescription\x126\n" +
 	"\x06skills\x18\x04 \x03(\v2\x1e.vacancy.models.v1.SkillWeightR\x06skills\x12\x12\n" +
-	"\x04role\x18\x05 \x01(\tR\x04role\"6\n" +
+	"\x04role\x18\x05 \x01(\tR\x04role\x12)\n" +
+	"\x10expected_version\x18\x06 \x01(\rR\x0fexpectedVersion\x12D\n" +
+	"\n" +
+	"attributes\x18\a \x01(\v2$.vacancy.models.v1.VacancyAttributesR\n" +
+	"attributes\x12\x1f\n" +
+	"\vunlock_role\x18\b \x01(\bR\n" +
+	"unlockRole\x12G\n" +
+	"\x0escoring_policy\x18\t \x01(\v2 .vacancy.models.v1.ScoringPolicyR\rscoringPolicy\"6\n" +
 	"\x15ArchiveVacancyRequest\x12\x1d\n" +
 	"\n" +
 	"vacancy_id\x18\x01 \x01(\tR\tvacancyId\"0\n" +
 	"\x16ArchiveVacancyResponse\x12\x16\n" +
-	"\x06status\x18\x01 \x01(\tR\x06status\"G\n" +
+	"\x06status\x18\x01 \x01(\tR\x06status\"5\n" +
+	"\x14DeleteVacancyRequest\x12\x1d\n" +
+	"\n" +
+	"vacancy_id\x18\x01 \x01(\tR\tvacancyId\"\x17\n" +
+	"\x15DeleteVacancyResponse\"G\n" +
 	"\x0fVacancyResponse\x124\n" +
-	"\avacancy\x18\x01 \x01(\v2\x1a.vacancy.models.v1.VacancyR\avacancy*g\n" +
+	"\avacancy\x18\x01 \x01(\v2\x1a.vacancy.models.v1.VacancyR\avacancy\"\xa7\x02\n" +
+	"\x0eVacancyVersion\x12\x1d\n" +
+	"\n" +
+	"vacancy_id\x18\x01 \x01(\tR\tvacancyId\x12\x18\n" +
+	"\aversion\x18\x02 \x01(\rR\aversion\x12\x14\n" +
+	"\x05title\x18\x03 \x01(\tR\x05title\x12 \n" +
+	"\vdescription\x18\x04 \x01(\tR\vdescription\x12\x12\n" +
+	"\x04role\x18\x05 \x01(\tR\x04role\x126\n" +
+	"\x06skills\x18\x06 \x03(\v2\x1e.vacancy.models.v1.SkillWeightR\x06skills\x12\x1d\n" +
+	"\n" +
+	"created_by\x18\a \x01(\x04R\tcreatedBy\x129\n" +
+	"\n" +
+	"created_at\x18\b \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\"g\n" +
+	"\x1aListVacancyVersionsRequest\x12\x1d\n" +
+	"\n" +
+	"vacancy_id\x18\x01 \x01(\tR\tvacancyId\x12*\n" +
+	"\x04page\x18\x02 \x01(\v2\x16.common.v1.PageRequestR\x04page\"\x89\x01\n" +
+	"\x1bListVacancyVersionsResponse\x12=\n" +
+	"\bversions\x18\x01 \x03(\v2!.vacancy.models.v1.VacancyVersionR\bversions\x12+\n" +
+	"\x04page\x18\x02 \x01(\v2\x17.common.v1.PageResponseR\x04page\"S\n" +
+	"\x18GetVacancyVersionRequest\x12\x1d\n" +
+	"\n" +
+	"vacancy_id\x18\x01 \x01(\tR\tvacancyId\x12\x18\n" +
+	"\aversion\x18\x02 \x01(\rR\aversion\"U\n" +
+	"\x16VacancyVersionResponse\x12;\n" +
+	"\aversion\x18\x01 \x01(\v2!.vacancy.models.v1.VacancyVersionR\aversion\"}\n" +
+	"\x1aDiffVacancyVersionsRequest\x12\x1d\n" +
+	"\n" +
+	"vacancy_id\x18\x01 \x01(\tR\tvacancyId\x12!\n" +
+	"\ffrom_version\x18\x02 \x01(\rR\vfromVersion\x12\x1d\n" +
+	"\n" +
+	"to_version\x18\x03 \x01(\rR\ttoVersion\"\x8f\x01\n" +
+	"\vSkillChange\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\x126\n" +
+	"\x06before\x18\x02 \x01(\v2\x1e.vacancy.models.v1.SkillWeightR\x06before\x124\n" +
+	"\x05after\x18\x03 \x01(\v2\x1e.vacancy.models.v1.SkillWeightR\x05after\"\xc8\x03\n" +
+	"\x1bDiffVacancyVersionsResponse\x12\x1d\n" +
+	"\n" +
+	"vacancy_id\x18\x01 \x01(\tR\tvacancyId\x12!\n" +
+	"\ffrom_version\x18\x02 \x01(\rR\vfromVersion\x12\x1d\n" +
+	"\n" +
+	"to_version\x18\x03 \x01(\rR\ttoVersion\x12#\n" +
+	"\rtitle_changed\x18\x04 \x01(\bR\ftitleChanged\x12/\n" +
+	"\x13description_changed\x18\x05 \x01(\bR\x12descriptionChanged\x12!\n" +
+	"\frole_changed\x18\x06 \x01(\bR\vroleChanged\x12A\n" +
+	"\fadded_skills\x18\a \x03(\v2\x1e.vacancy.models.v1.SkillWeightR\vaddedSkills\x12E\n" +
+	"\x0eremoved_skills\x18\b \x03(\v2\x1e.vacancy.models.v1.SkillWeightR\rremovedSkills\x12E\n" +
+	"\x0echanged_skills\x18\t \x03(\v2\x1e.vacancy.models.v1.SkillChangeR\rchangedSkills\"\x90\x01\n" +
+	"\x18TransitionVacancyRequest\x12\x1d\n" +
+	"\n" +
+	"vacancy_id\x18\x01 \x01(\tR\tvacancyId\x12=\n" +
+	"\tto_status\x18\x02 \x01(\x0e2 .vacancy.models.v1.VacancyStatusR\btoStatus\x12\x16\n" +
+	"\x06reason\x18\x03 \x01(\tR\x06reason\"N\n" +
+	"\x15RestoreVacancyRequest\x12\x1d\n" +
+	"\n" +
+	"vacancy_id\x18\x01 \x01(\tR\tvacancyId\x12\x16\n" +
+	"\x06reason\x18\x02 \x01(\tR\x06reason\"\xb8\x02\n" +
+	"\x13VacancyStatusChange\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x04R\x02id\x12\x1d\n" +
+	"\n" +
+	"vacancy_id\x18\x02 \x01(\tR\tvacancyId\x12A\n" +
+	"\vfrom_status\x18\x03 \x01(\x0e2 .vacancy.models.v1.VacancyStatusR\n" +
+	"fromStatus\x12=\n" +
+	"\tto_status\x18\x04 \x01(\x0e2 .vacancy.models.v1.VacancyStatusR\btoStatus\x12\x16\n" +
+	"\x06reason\x18\x05 \x01(\tR\x06reason\x12\x1d\n" +
+	"\n" +
+	"changed_by\x18\x06 \x01(\x04R\tchangedBy\x129\n" +
+	"\n" +
+	"changed_at\x18\a \x01(\v2\x1a.google.protobuf.TimestampR\tchangedAt\"l\n" +
+	"\x1fListVacancyStatusHistoryRequest\x12\x1d\n" +
+	"\n" +
+	"vacancy_id\x18\x01 \x01(\tR\tvacancyId\x12*\n" +
+	"\x04page\x18\x02 \x01(\v2\x16.common.v1.PageRequestR\x04page\"\x91\x01\n" +
+	" ListVacancyStatusHistoryResponse\x12@\n" +
+	"\achanges\x18\x01 \x03(\v2&.vacancy.models.v1.VacancyStatusChangeR\achanges\x12+\n" +
+	"\x04page\x18\x02 \x01(\v2\x17.common.v1.PageResponseR\x04page\"\xfc\x02\n" +
+	"\x0fVacancyTemplate\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\"\n" +
+	"\rowner_user_id\x18\x02 \x01(\x04R\vownerUserId\x126\n" +
+	"\x05scope\x18\x03 \x01(\x0e2 .vacancy.models.v1.TemplateScopeR\x05scope\x12\x12\n" +
+	"\x04name\x18\x04 \x01(\tR\x04name\x12\x14\n" +
+	"\x05title\x18\x05 \x01(\tR\x05title\x12 \n" +
+	"\vdescription\x18\x06 \x01(\tR\vdescription\x12\x12\n" +
+	"\x04role\x18\a \x01(\tR\x04role\x126\n" +
+	"\x06skills\x18\b \x03(\v2\x1e.vacancy.models.v1.SkillWeightR\x06skills\x12*\n" +
+	"\x11source_vacancy_id\x18\t \x01(\tR\x0fsourceVacancyId\x129\n" +
+	"\n" +
+	"created_at\x18\n" +
+	" \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\"\x82\x01\n" +
+	"\x15CreateTemplateRequest\x12\x1d\n" +
+	"\n" +
+	"vacancy_id\x18\x01 \x01(\tR\tvacancyId\x12\x12\n" +
+	"\x04name\x18\x02 \x01(\tR\x04name\x126\n" +
+	"\x05scope\x18\x03 \x01(\x0e2 .vacancy.models.v1.TemplateScopeR\x05scope\"Y\n" +
+	"\x17VacancyTemplateResponse\x12>\n" +
+	"\btemplate\x18\x01 \x01(\v2\".vacancy.models.v1.VacancyTemplateR\btemplate\"z\n" +
+	"\x14ListTemplatesRequest\x126\n" +
+	"\x05scope\x18\x01 \x01(\x0e2 .vacancy.models.v1.TemplateScopeR\x05scope\x12*\n" +
+	"\x04page\x18\x02 \x01(\v2\x16.common.v1.PageRequestR\x04page\"\x86\x01\n" +
+	"\x15ListTemplatesResponse\x12@\n" +
+	"\ttemplates\x18\x01 \x03(\v2\".vacancy.models.v1.VacancyTemplateR\ttemplates\x12+\n" +
+	"\x04page\x18\x02 \x01(\v2\x17.common.v1.PageResponseR\x04page\"Y\n" +
+	" CreateVacancyFromTemplateRequest\x12\x1f\n" +
+	"\vtemplate_id\x18\x01 \x01(\tR\n" +
+	"templateId\x12\x14\n" +
+	"\x05title\x18\x02 \x01(\tR\x05title\"8\n" +
+	"\x15DeleteTemplateRequest\x12\x1f\n" +
+	"\vtemplate_id\x18\x01 \x01(\tR\n" +
+	"templateId\"\x18\n" +
+	"\x16DeleteTemplateResponse\"J\n" +
+	"\x13CloneVacancyRequest\x12\x1d\n" +
+	"\n" +
+	"vacancy_id\x18\x01 \x01(\tR\tvacancyId\x12\x14\n" +
+	"\x05title\x18\x02 \x01(\tR\x05title\"G\n" +
+	"\x19AutocompleteSkillsRequest\x12\x14\n" +
+	"\x05query\x18\x01 \x01(\tR\x05query\x12\x14\n" +
+	"\x05limit\x18\x02 \x01(\rR\x05limit\"t\n" +
+	"\x0fSkillSuggestion\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\x12\x17\n" +
+	"\aname_ru\x18\x02 \x01(\tR\x06nameRu\x12\x1a\n" +
+	"\bcategory\x18\x03 \x01(\tR\bcategory\x12\x18\n" +
+	"\amatched\x18\x04 \x01(\tR\amatched\"X\n" +
+	"\x1aAutocompleteSkillsResponse\x12:\n" +
+	"\x06skills\x18\x01 \x03(\v2\".vacancy.models.v1.SkillSuggestionR\x06skills\"N\n" +
+	"\x14SuggestSkillsRequest\x12\x14\n" +
+	"\x05title\x18\x01 \x01(\tR\x05title\x12 \n" +
+	"\vdescription\x18\x02 \x01(\tR\vdescription\"g\n" +
+	"\x15SuggestSkillsResponse\x126\n" +
+	"\x06skills\x18\x01 \x03(\v2\x1e.vacancy.models.v1.SkillWeightR\x06skills\x12\x16\n" +
+	"\x06source\x18\x02 \x01(\tR\x06source\"\xb1\x01\n" +
+	"\x16ImportVacanciesRequest\x127\n" +
+	"\x06format\x18\x01 \x01(\x0e2\x1f.vacancy.models.v1.ImportFormatR\x06format\x12\x12\n" +
+	"\x04data\x18\x02 \x01(\fR\x04data\x121\n" +
+	"\x04mode\x18\x03 \x01(\x0e2\x1d.vacancy.models.v1.ImportModeR\x04mode\x12\x17\n" +
+	"\adry_run\x18\x04 \x01(\bR\x06dryRun\"\x8b\x02\n" +
+	"\x0fImportRowResult\x12\x10\n" +
+	"\x03row\x18\x01 \x01(\rR\x03row\x12\x14\n" +
+	"\x05title\x18\x02 \x01(\tR\x05title\x128\n" +
+	"\x06status\x18\x03 \x01(\x0e2 .vacancy.models.v1.VacancyStatusR\x06status\x12\x12\n" +
+	"\x04role\x18\x04 \x01(\tR\x04role\x126\n" +
+	"\x06skills\x18\x05 \x03(\v2\x1e.vacancy.models.v1.SkillWeightR\x06skills\x124\n" +
+	"\avacancy\x18\x06 \x01(\v2\x1a.vacancy.models.v1.VacancyR\avacancy\x12\x14\n" +
+	"\x05error\x18\a \x01(\tR\x05error\"\xa1\x01\n" +
+	"\x17ImportVacanciesResponse\x126\n" +
+	"\x04rows\x18\x01 \x03(\v2\".vacancy.models.v1.ImportRowResultR\x04rows\x12\x18\n" +
+	"\acreated\x18\x02 \x01(\rR\acreated\x12\x16\n" +
+	"\x06failed\x18\x03 \x01(\rR\x06failed\x12\x1c\n" +
+	"\tcommitted\x18\x04 \x01(\bR\tcommitted\"Y\n" +
+	"\x1bSetVacancyVisibilityRequest\x12\x1d\n" +
+	"\n" +
+	"vacancy_id\x18\x01 \x01(\tR\tvacancyId\x12\x1b\n" +
+	"\tis_public\x18\x02 \x01(\bR\bisPublic\"5\n" +
+	"\x14GetJobPostingRequest\x12\x1d\n" +
+	"\n" +
+	"vacancy_id\x18\x01 \x01(\tR\tvacancyId\";\n" +
+	"\x15GetVacancyFeedRequest\x12\"\n" +
+	"\rowner_user_id\x18\x01 \x01(\x04R\vownerUserId\"\xb0\x01\n" +
+	"\fCollaborator\x12\x1d\n" +
+	"\n" +
+	"vacancy_id\x18\x01 \x01(\tR\tvacancyId\x12\x17\n" +
+	"\auser_id\x18\x02 \x01(\x04R\x06userId\x12\x12\n" +
+	"\x04role\x18\x03 \x01(\tR\x04role\x12\x19\n" +
+	"\badded_by\x18\x04 \x01(\x04R\aaddedBy\x129\n" +
+	"\n" +
+	"created_at\x18\x05 \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\"d\n" +
+	"\x16AddCollaboratorRequest\x12\x1d\n" +
+	"\n" +
+	"vacancy_id\x18\x01 \x01(\tR\tvacancyId\x12\x17\n" +
+	"\auser_id\x18\x02 \x01(\x04R\x06userId\x12\x12\n" +
+	"\x04role\x18\x03 \x01(\tR\x04role\"[\n" +
+	"\x14CollaboratorResponse\x12C\n" +
+	"\fcollaborator\x18\x01 \x01(\v2\x1f.vacancy.models.v1.CollaboratorR\fcollaborator\"S\n" +
+	"\x19RemoveCollaboratorRequest\x12\x1d\n" +
+	"\n" +
+	"vacancy_id\x18\x01 \x01(\tR\tvacancyId\x12\x17\n" +
+	"\auser_id\x18\x02 \x01(\x04R\x06userId\"\x1c\n" +
+	"\x1aRemoveCollaboratorResponse\"9\n" +
+	"\x18ListCollaboratorsRequest\x12\x1d\n" +
+	"\n" +
+	"vacancy_id\x18\x01 \x01(\tR\tvacancyId\"b\n" +
+	"\x19ListCollaboratorsResponse\x12E\n" +
+	"\rcollaborators\x18\x01 \x03(\v2\x1f.vacancy.models.v1.CollaboratorR\rcollaborators\"5\n" +
+	"\rPipelineStage\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x12\n" +
+	"\x04name\x18\x02 \x01(\tR\x04name\"\xc6\x02\n" +
+	"\x0fVacancyPipeline\x12\x1d\n" +
+	"\n" +
+	"vacancy_id\x18\x01 \x01(\tR\tvacancyId\x128\n" +
+	"\x06stages\x18\x02 \x03(\v2 .vacancy.models.v1.PipelineStageR\x06stages\x12\x1c\n" +
+	"\theadcount\x18\x03 \x01(\rR\theadcount\x12\x14\n" +
+	"\x05hired\x18\x04 \x01(\rR\x05hired\x12b\n" +
+	"\x10candidate_counts\x18\x05 \x03(\v27.vacancy.models.v1.VacancyPipeline.CandidateCountsEntryR\x0fcandidateCounts\x1aB\n" +
+	"\x14CandidateCountsEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\rR\x05value:\x028\x01\":\n" +
+	"\x19GetVacancyPipelineRequest\x12\x1d\n" +
+	"\n" +
+	"vacancy_id\x18\x01 \x01(\tR\tvacancyId\"z\n" +
+	"\x1fSetVacancyPipelineStagesRequest\x12\x1d\n" +
+	"\n" +
+	"vacancy_id\x18\x01 \x01(\tR\tvacancyId\x128\n" +
+	"\x06stages\x18\x02 \x03(\v2 .vacancy.models.v1.PipelineStageR\x06stages\"Y\n" +
+	"\x17VacancyPipelineResponse\x12>\n" +
+	"\bpipeline\x18\x01 \x01(\v2\".vacancy.models.v1.VacancyPipelineR\bpipeline\"\x94\x02\n" +
+	"\x14CandidateStageChange\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x04R\x02id\x12\x1d\n" +
+	"\n" +
+	"vacancy_id\x18\x02 \x01(\tR\tvacancyId\x12!\n" +
+	"\fcandidate_id\x18\x03 \x01(\tR\vcandidateId\x12\x1d\n" +
+	"\n" +
+	"from_stage\x18\x04 \x01(\tR\tfromStage\x12\x19\n" +
+	"\bto_stage\x18\x05 \x01(\tR\atoStage\x12\x16\n" +
+	"\x06reason\x18\x06 \x01(\tR\x06reason\x12\x1d\n" +
+	"\n" +
+	"changed_by\x18\a \x01(\x04R\tchangedBy\x129\n" +
+	"\n" +
+	"changed_at\x18\b \x01(\v2\x1a.google.protobuf.TimestampR\tchangedAt\"\x8b\x01\n" +
+	"\x19MoveCandidateStageRequest\x12\x1d\n" +
+	"\n" +
+	"vacancy_id\x18\x01 \x01(\tR\tvacancyId\x12!\n" +
+	"\fcandidate_id\x18\x02 \x01(\tR\vcandidateId\x12\x14\n" +
+	"\x05stage\x18\x03 \x01(\tR\x05stage\x12\x16\n" +
+	"\x06reason\x18\x04 \x01(\tR\x06reason\"\xcd\x01\n" +
+	"\x1aMoveCandidateStageResponse\x12?\n" +
+	"\x06change\x18\x01 \x01(\v2'.vacancy.models.v1.CandidateStageChangeR\x06change\x12G\n" +
+	"\x0evacancy_status\x18\x02 \x01(\x0e2 .vacancy.models.v1.VacancyStatusR\rvacancyStatus\x12%\n" +
+	"\x0evacancy_filled\x18\x03 \x01(\bR\rvacancyFilled\"d\n" +
+	" ListCandidateStageHistoryRequest\x12\x1d\n" +
+	"\n" +
+	"vacancy_id\x18\x01 \x01(\tR\tvacancyId\x12!\n" +
+	"\fcandidate_id\x18\x02 \x01(\tR\vcandidateId\"f\n" +
+	"!ListCandidateStageHistoryResponse\x12A\n" +
+	"\achanges\x18\x01 \x03(\v2'.vacancy.models.v1.CandidateStageChangeR\achanges\"n\n" +
+	"\x1dReclassifyVacancyRolesRequest\x127\n" +
+	"\asources\x18\x01 \x03(\x0e2\x1d.vacancy.models.v1.RoleSourceR\asources\x12\x14\n" +
+	"\x05limit\x18\x02 \x01(\rR\x05limit\"\xbb\x01\n" +
+	"\x1eReclassifyVacancyRolesResponse\x12\x18\n" +
+	"\ascanned\x18\x01 \x01(\rR\ascanned\x12\"\n" +
+	"\freclassified\x18\x02 \x01(\rR\freclassified\x12\x18\n" +
+	"\achanged\x18\x03 \x01(\rR\achanged\x12\x18\n" +
+	"\askipped\x18\x04 \x01(\rR\askipped\x12'\n" +
+	"\x0fllm_unavailable\x18\x05 \x01(\bR\x0ellmUnavailable\"\x19\n" +
+	"\x17ListVacancyRolesRequest\"0\n" +
+	"\x18ListVacancyRolesResponse\x12\x14\n" +
+	"\x05roles\x18\x01 \x03(\tR\x05roles\"w\n" +
+	"\x1fTransferVacancyOwnershipRequest\x12 \n" +
+	"\ffrom_user_id\x18\x01 \x01(\x04R\n" +
+	"fromUserId\x12\x1c\n" +
+	"\n" +
+	"to_user_id\x18\x02 \x01(\x04R\btoUserId\x12\x14\n" +
+	"\x05limit\x18\x03 \x01(\rR\x05limit\"D\n" +
+	" TransferVacancyOwnershipResponse\x12 \n" +
+	"\vtransferred\x18\x01 \x01(\rR\vtransferred*\xd0\x01\n" +
 	"\rVacancyStatus\x12\x1e\n" +
-	"\x1aVACANCY_STATUS_UNSPECIFIED\x10\x00\x12\x19\n" +
-	"\x15VACANCY_STATUS_ACTIVE\x10\x01\x12\x1b\n" +
-	"\x17VACANCY_STATUS_ARCHIVED\x10\x02B5Z3github.com/artem13815/hr/gateway/internal/pb/modelsb\x06proto3"
+	"\x1aVACANCY_STATUS_UNSPECIFIED\x10\x00\x12\x17\n" +
+	"\x13VACANCY_STATUS_OPEN\x10\x01\x12\x1b\n" +
+	"\x17VACANCY_STATUS_ARCHIVED\x10\x02\x12\x18\n" +
+	"\x14VACANCY_STATUS_DRAFT\x10\x03\x12\x19\n" +
+	"\x15VACANCY_STATUS_PAUSED\x10\x04\x12\x19\n" +
+	"\x15VACANCY_STATUS_CLOSED\x10\x05\x12\x19\n" +
+	"\x15VACANCY_STATUS_FILLED\x10\x06*{\n" +
+	"\fRemotePolicy\x12\x1d\n" +
+	"\x19REMOTE_POLICY_UNSPECIFIED\x10\x00\x12\x18\n" +
+	"\x14REMOTE_POLICY_ONSITE\x10\x01\x12\x18\n" +
+	"\x14REMOTE_POLICY_HYBRID\x10\x02\x12\x18\n" +
+	"\x14REMOTE_POLICY_REMOTE\x10\x03*\xcc\x01\n" +
+	"\x0eEmploymentType\x12\x1f\n" +
+	"\x1bEMPLOYMENT_TYPE_UNSPECIFIED\x10\x00\x12\x1d\n" +
+	"\x19EMPLOYMENT_TYPE_FULL_TIME\x10\x01\x12\x1d\n" +
+	"\x19EMPLOYMENT_TYPE_PART_TIME\x10\x02\x12\x1c\n" +
+	"\x18EMPLOYMENT_TYPE_CONTRACT\x10\x03\x12\x1e\n" +
+	"\x1aEMPLOYMENT_TYPE_INTERNSHIP\x10\x04\x12\x1d\n" +
+	"\x19EMPLOYMENT_TYPE_TEMPORARY\x10\x05*\x92\x01\n" +
+	"\tSeniority\x12\x19\n" +
+	"\x15SENIORITY_UNSPECIFIED\x10\x00\x12\x14\n" +
+	"\x10SENIORITY_INTERN\x10\x01\x12\x14\n" +
+	"\x10SENIORITY_JUNIOR\x10\x02\x12\x14\n" +
+	"\x10SENIORITY_MIDDLE\x10\x03\x12\x14\n" +
+	"\x10SENIORITY_SENIOR\x10\x04\x12\x12\n" +
+	"\x0eSENIORITY_LEAD\x10\x05*o\n" +
+	"\n" +
+	"RoleSource\x12\x1b\n" +
+	"\x17ROLE_SOURCE_UNSPECIFIED\x10\x00\x12\x13\n" +
+	"\x0fROLE_SOURCE_LLM\x10\x01\x12\x17\n" +
+	"\x13ROLE_SOURCE_KEYWORD\x10\x02\x12\x16\n" +
+	"\x12ROLE_SOURCE_MANUAL\x10\x03*l\n" +
+	"\tTotalMode\x12\x1a\n" +
+	"\x16TOTAL_MODE_UNSPECIFIED\x10\x00\x12\x14\n" +
+	"\x10TOTAL_MODE_EXACT\x10\x01\x12\x18\n" +
+	"\x14TOTAL_MODE_ESTIMATED\x10\x02\x12\x13\n" +
+	"\x0fTOTAL_MODE_NONE\x10\x03*d\n" +
+	"\rTemplateScope\x12\x1e\n" +
+	"\x1aTEMPLATE_SCOPE_UNSPECIFIED\x10\x00\x12\x1b\n" +
+	"\x17TEMPLATE_SCOPE_PERSONAL\x10\x01\x12\x16\n" +
+	"\x12TEMPLATE_SCOPE_ORG\x10\x02*s\n" +
+	"\fImportFormat\x12\x1d\n" +
+	"\x19IMPORT_FORMAT_UNSPECIFIED\x10\x00\x12\x15\n" +
+	"\x11IMPORT_FORMAT_CSV\x10\x01\x12\x17\n" +
+	"\x13IMPORT_FORMAT_JSONL\x10\x02\x12\x14\n" +
+	"\x10IMPORT_FORMAT_HH\x10\x03*f\n" +
+	"\n" +
+	"ImportMode\x12\x1b\n" +
+	"\x17IMPORT_MODE_UNSPECIFIED\x10\x00\x12\x1e\n" +
+	"\x1aIMPORT_MODE_ALL_OR_NOTHING\x10\x01\x12\x1b\n" +
+	"\x17IMPORT_MODE_BEST_EFFORT\x10\x02B5Z3github.com/artem13815/hr/gateway/internal/pb/modelsb\x06proto3"
 
 var (
 	file_models_vacancy_model_proto_rawDescOnce sync.Once
@@ -753,40 +5745,182 @@ func file_models_vacancy_model_proto_rawDescGZIP() []byte {
 	return file_models_vacancy_model_proto_rawDescData
 }
 
-var file_models_vacancy_model_proto_enumTypes = make([]protoimpl.EnumInfo, 1)
-var file_models_vacancy_model_proto_msgTypes = make([]protoimpl.MessageInfo, 10)
+var file_models_vacancy_model_proto_enumTypes = make([]protoimpl.EnumInfo, 9)
+var file_models_vacancy_model_proto_msgTypes = make([]protoimpl.MessageInfo, 75)
 var file_models_vacancy_model_proto_goTypes = []any{
-	(VacancyStatus)(0),             // 0: vacancy.models.v1.VacancyStatus
-	(*SkillWeight)(nil),            // 1: vacancy.models.v1.SkillWeight
-	(*Vacancy)(nil),                // 2: vacancy.models.v1.Vacancy
-	(*CreateVacancyRequest)(nil),   // 3: vacancy.models.v1.CreateVacancyRequest
-	(*GetVacancyRequest)(nil),      // 4: vacancy.models.v1.GetVacancyRequest
-	(*ListVacanciesRequest)(nil),   // 5: vacancy.models.v1.ListVacanciesRequest
-	(*ListVacanciesResponse)(nil),  // 6: vacancy.models.v1.ListVacanciesResponse
-	(*UpdateVacancyRequest)(nil),   // 7: vacancy.models.v1.UpdateVacancyRequest
-	(*ArchiveVacancyRequest)(nil),  // 8: vacancy.models.v1.ArchiveVacancyRequest
-	(*ArchiveVacancyResponse)(nil), // 9: vacancy.models.v1.ArchiveVacancyResponse
-	(*VacancyResponse)(nil),        // 10: vacancy.models.v1.VacancyResponse
-	(*timestamppb.Timestamp)(nil),  // 11: google.protobuf.Timestamp
-	(*common.PageRequest)(nil),     // 12: common.v1.PageRequest
-	(*common.PageResponse)(nil),    // 13: common.v1.PageResponse
+	(VacancyStatus)(0),                        // 0: vacancy.models.v1.VacancyStatus
+	(RemotePolicy)(0),                         // 1: vacancy.models.v1.RemotePolicy
+	(EmploymentType)(0),                       // 2: vacancy.models.v1.EmploymentType
+	(Seniority)(0),                            // 3: vacancy.models.v1.Seniority
+	(RoleSource)(0),                           // 4: vacancy.models.v1.RoleSource
+	(TotalMode)(0),                            // 5: vacancy.models.v1.TotalMode
+	(TemplateScope)(0),                        // 6: vacancy.models.v1.TemplateScope
+	(ImportFormat)(0),                         // 7: vacancy.models.v1.ImportFormat
+	(ImportMode)(0),                           // 8: vacancy.models.v1.ImportMode
+	(*VacancyAttributes)(nil),                 // 9: vacancy.models.v1.VacancyAttributes
+	(*ScoringPolicy)(nil),                     // 10: vacancy.models.v1.ScoringPolicy
+	(*SkillWeight)(nil),                       // 11: vacancy.models.v1.SkillWeight
+	(*Vacancy)(nil),                           // 12: vacancy.models.v1.Vacancy
+	(*CreateVacancyRequest)(nil),              // 13: vacancy.models.v1.CreateVacancyRequest
+	(*CreateVacancyResponse)(nil),             // 14: vacancy.models.v1.CreateVacancyResponse
+	(*PossibleDuplicates)(nil),                // 15: vacancy.models.v1.PossibleDuplicates
+	(*VacancyDuplicate)(nil),                  // 16: vacancy.models.v1.VacancyDuplicate
+	(*GetVacancyRequest)(nil),                 // 17: vacancy.models.v1.GetVacancyRequest
+	(*ListVacanciesRequest)(nil),              // 18: vacancy.models.v1.ListVacanciesRequest
+	(*VacancyHighlight)(nil),                  // 19: vacancy.models.v1.VacancyHighlight
+	(*ListVacanciesResponse)(nil),             // 20: vacancy.models.v1.ListVacanciesResponse
+	(*UpdateVacancyRequest)(nil),              // 21: vacancy.models.v1.UpdateVacancyRequest
+	(*ArchiveVacancyRequest)(nil),             // 22: vacancy.models.v1.ArchiveVacancyRequest
+	(*ArchiveVacancyResponse)(nil),            // 23: vacancy.models.v1.ArchiveVacancyResponse
+	(*DeleteVacancyRequest)(nil),              // 24: vacancy.models.v1.DeleteVacancyRequest
+	(*DeleteVacancyResponse)(nil),             // 25: vacancy.models.v1.DeleteVacancyResponse
+	(*VacancyResponse)(nil),                   // 26: vacancy.models.v1.VacancyResponse
+	(*VacancyVersion)(nil),                    // 27: vacancy.models.v1.VacancyVersion
+	(*ListVacancyVersionsRequest)(nil),        // 28: vacancy.models.v1.ListVacancyVersionsRequest
+	(*ListVacancyVersionsResponse)(nil),       // 29: vacancy.models.v1.ListVacancyVersionsResponse
+	(*GetVacancyVersionRequest)(nil),          // 30: vacancy.models.v1.GetVacancyVersionRequest
+	(*VacancyVersionResponse)(nil),            // 31: vacancy.models.v1.VacancyVersionResponse
+	(*DiffVacancyVersionsRequest)(nil),        // 32: vacancy.models.v1.DiffVacancyVersionsRequest
+	(*SkillChange)(nil),                       // 33: vacancy.models.v1.SkillChange
+	(*DiffVacancyVersionsResponse)(nil),       // 34: vacancy.models.v1.DiffVacancyVersionsResponse
+	(*TransitionVacancyRequest)(nil),          // 35: vacancy.models.v1.TransitionVacancyRequest
+	(*RestoreVacancyRequest)(nil),             // 36: vacancy.models.v1.RestoreVacancyRequest
+	(*VacancyStatusChange)(nil),               // 37: vacancy.models.v1.VacancyStatusChange
+	(*ListVacancyStatusHistoryRequest)(nil),   // 38: vacancy.models.v1.ListVacancyStatusHistoryRequest
+	(*ListVacancyStatusHistoryResponse)(nil),  // 39: vacancy.models.v1.ListVacancyStatusHistoryResponse
+	(*VacancyTemplate)(nil),                   // 40: vacancy.models.v1.VacancyTemplate
+	(*CreateTemplateRequest)(nil),             // 41: vacancy.models.v1.CreateTemplateRequest
+	(*VacancyTemplateResponse)(nil),           // 42: vacancy.models.v1.VacancyTemplateResponse
+	(*ListTemplatesRequest)(nil),              // 43: vacancy.models.v1.ListTemplatesRequest
+	(*ListTemplatesResponse)(nil),             // 44: vacancy.models.v1.ListTemplatesResponse
+	(*CreateVacancyFromTemplateRequest)(nil),  // 45: vacancy.models.v1.CreateVacancyFromTemplateRequest
+	(*DeleteTemplateRequest)(nil),             // 46: vacancy.models.v1.DeleteTemplateRequest
+	(*DeleteTemplateResponse)(nil),            // 47: vacancy.models.v1.DeleteTemplateResponse
+	(*CloneVacancyRequest)(nil),               // 48: vacancy.models.v1.CloneVacancyRequest
+	(*AutocompleteSkillsRequest)(nil),         // 49: vacancy.models.v1.AutocompleteSkillsRequest
+	(*SkillSuggestion)(nil),                   // 50: vacancy.models.v1.SkillSuggestion
+	(*AutocompleteSkillsResponse)(nil),        // 51: vacancy.models.v1.AutocompleteSkillsResponse
+	(*SuggestSkillsRequest)(nil),              // 52: vacancy.models.v1.SuggestSkillsRequest
+	(*SuggestSkillsResponse)(nil),             // 53: vacancy.models.v1.SuggestSkillsResponse
+	(*ImportVacanciesRequest)(nil),            // 54: vacancy.models.v1.ImportVacanciesRequest
+	(*ImportRowResult)(nil),                   // 55: vacancy.models.v1.ImportRowResult
+	(*ImportVacanciesResponse)(nil),           // 56: vacancy.models.v1.ImportVacanciesResponse
+	(*SetVacancyVisibilityRequest)(nil),       // 57: vacancy.models.v1.SetVacancyVisibilityRequest
+	(*GetJobPostingRequest)(nil),              // 58: vacancy.models.v1.GetJobPostingRequest
+	(*GetVacancyFeedRequest)(nil),             // 59: vacancy.models.v1.GetVacancyFeedRequest
+	(*Collaborator)(nil),                      // 60: vacancy.models.v1.Collaborator
+	(*AddCollaboratorRequest)(nil),            // 61: vacancy.models.v1.AddCollaboratorRequest
+	(*CollaboratorResponse)(nil),              // 62: vacancy.models.v1.CollaboratorResponse
+	(*RemoveCollaboratorRequest)(nil),         // 63: vacancy.models.v1.RemoveCollaboratorRequest
+	(*RemoveCollaboratorResponse)(nil),        // 64: vacancy.models.v1.RemoveCollaboratorResponse
+	(*ListCollaboratorsRequest)(nil),          // 65: vacancy.models.v1.ListCollaboratorsRequest
+	(*ListCollaboratorsResponse)(nil),         // 66: vacancy.models.v1.ListCollaboratorsResponse
+	(*PipelineStage)(nil),                     // 67: vacancy.models.v1.PipelineStage
+	(*VacancyPipeline)(nil),                   // 68: vacancy.models.v1.VacancyPipeline
+	(*GetVacancyPipelineRequest)(nil),         // 69: vacancy.models.v1.GetVacancyPipelineRequest
+	(*SetVacancyPipelineStagesRequest)(nil),   // 70: vacancy.models.v1.SetVacancyPipelineStagesRequest
+	(*VacancyPipelineResponse)(nil),           // 71: vacancy.models.v1.VacancyPipelineResponse
+	(*CandidateStageChange)(nil),              // 72: vacancy.models.v1.CandidateStageChange
+	(*MoveCandidateStageRequest)(nil),         // 73: vacancy.models.v1.MoveCandidateStageRequest
+	(*MoveCandidateStageResponse)(nil),        // 74: vacancy.models.v1.MoveCandidateStageResponse
+	(*ListCandidateStageHistoryRequest)(nil),  // 75: vacancy.models.v1.ListCandidateStageHistoryRequest
+	(*ListCandidateStageHistoryResponse)(nil), // 76: vacancy.models.v1.ListCandidateStageHistoryResponse
+	(*ReclassifyVacancyRolesRequest)(nil),     // 77: vacancy.models.v1.ReclassifyVacancyRolesRequest
+	(*ReclassifyVacancyRolesResponse)(nil),    // 78: vacancy.models.v1.ReclassifyVacancyRolesResponse
+	(*ListVacancyRolesRequest)(nil),           // 79: vacancy.models.v1.ListVacancyRolesRequest
+	(*ListVacancyRolesResponse)(nil),          // 80: vacancy.models.v1.ListVacancyRolesResponse
+	(*TransferVacancyOwnershipRequest)(nil),   // 81: vacancy.models.v1.TransferVacancyOwnershipRequest
+	(*TransferVacancyOwnershipResponse)(nil),  // 82: vacancy.models.v1.TransferVacancyOwnershipResponse
+	nil,                                       // 83: vacancy.models.v1.VacancyPipeline.CandidateCountsEntry
+	(*timestamppb.Timestamp)(nil),             // 84: google.protobuf.Timestamp
+	(*common.PageRequest)(nil),                // 85: common.v1.PageRequest
+	(*common.PageResponse)(nil),               // 86: common.v1.PageResponse
 }
 var file_models_vacancy_model_proto_depIdxs = []int32{
-	1,  // 0: vacancy.models.v1.Vacancy.skills:type_name -> vacancy.models.v1.SkillWeight
-	0,  // 1: vacancy.models.v1.Vacancy.status:type_name -> vacancy.models.v1.VacancyStatus
-	11, // 2: vacancy.models.v1.Vacancy.created_at:type_name -> google.protobuf.Timestamp
-	11, // 3: vacancy.models.v1.Vacancy.updated_at:type_name -> google.protobuf.Timestamp
-	1,  // 4: vacancy.models.v1.CreateVacancyRequest.skills:type_name -> vacancy.models.v1.SkillWeight
-	12, // 5: vacancy.models.v1.ListVacanciesRequest.page:type_name -> common.v1.PageRequest
-	2,  // 6: vacancy.models.v1.ListVacanciesResponse.vacancies:type_name -> vacancy.models.v1.Vacancy
-	13, // 7: vacancy.models.v1.ListVacanciesResponse.page:type_name -> common.v1.PageResponse
-	1,  // 8: vacancy.models.v1.UpdateVacancyRequest.skills:type_name -> vacancy.models.v1.SkillWeight
-	2,  // 9: vacancy.models.v1.VacancyResponse.vacancy:type_name -> vacancy.models.v1.Vacancy
-	10, // [10:10] is the sub-list for method output_type
-	10, // [10:10] is the sub-list for method input_type
-	10, // [10:10] is the sub-list for extension type_name
-	10, // [10:10] is the sub-list for extension extendee
-	0,  // [0:10] is the sub-list for field type_name
+	1,  // 0: vacancy.models.v1.VacancyAttributes.remote_policy:type_name -> vacancy.models.v1.RemotePolicy
+	2,  // 1: vacancy.models.v1.VacancyAttributes.employment_type:type_name -> vacancy.models.v1.EmploymentType
+	3,  // 2: vacancy.models.v1.VacancyAttributes.seniority:type_name -> vacancy.models.v1.Seniority
+	11, // 3: vacancy.models.v1.Vacancy.skills:type_name -> vacancy.models.v1.SkillWeight
+	0,  // 4: vacancy.models.v1.Vacancy.status:type_name -> vacancy.models.v1.VacancyStatus
+	84, // 5: vacancy.models.v1.Vacancy.created_at:type_name -> google.protobuf.Timestamp
+	84, // 6: vacancy.models.v1.Vacancy.updated_at:type_name -> google.protobuf.Timestamp
+	9,  // 7: vacancy.models.v1.Vacancy.attributes:type_name -> vacancy.models.v1.VacancyAttributes
+	4,  // 8: vacancy.models.v1.Vacancy.role_source:type_name -> vacancy.models.v1.RoleSource
+	84, // 9: vacancy.models.v1.Vacancy.role_classified_at:type_name -> google.protobuf.Timestamp
+	10, // 10: vacancy.models.v1.Vacancy.scoring_policy:type_name -> vacancy.models.v1.ScoringPolicy
+	11, // 11: vacancy.models.v1.CreateVacancyRequest.skills:type_name -> vacancy.models.v1.SkillWeight
+	9,  // 12: vacancy.models.v1.CreateVacancyRequest.attributes:type_name -> vacancy.models.v1.VacancyAttributes
+	10, // 13: vacancy.models.v1.CreateVacancyRequest.scoring_policy:type_name -> vacancy.models.v1.ScoringPolicy
+	12, // 14: vacancy.models.v1.CreateVacancyResponse.vacancy:type_name -> vacancy.models.v1.Vacancy
+	16, // 15: vacancy.models.v1.PossibleDuplicates.duplicates:type_name -> vacancy.models.v1.VacancyDuplicate
+	85, // 16: vacancy.models.v1.ListVacanciesRequest.page:type_name -> common.v1.PageRequest
+	0,  // 17: vacancy.models.v1.ListVacanciesRequest.statuses:type_name -> vacancy.models.v1.VacancyStatus
+	84, // 18: vacancy.models.v1.ListVacanciesRequest.created_from:type_name -> google.protobuf.Timestamp
+	84, // 19: vacancy.models.v1.ListVacanciesRequest.created_to:type_name -> google.protobuf.Timestamp
+	5,  // 20: vacancy.models.v1.ListVacanciesRequest.total_mode:type_name -> vacancy.models.v1.TotalMode
+	1,  // 21: vacancy.models.v1.ListVacanciesRequest.remote_policies:type_name -> vacancy.models.v1.RemotePolicy
+	2,  // 22: vacancy.models.v1.ListVacanciesRequest.employment_types:type_name -> vacancy.models.v1.EmploymentType
+	3,  // 23: vacancy.models.v1.ListVacanciesRequest.seniorities:type_name -> vacancy.models.v1.Seniority
+	12, // 24: vacancy.models.v1.ListVacanciesResponse.vacancies:type_name -> vacancy.models.v1.Vacancy
+	86, // 25: vacancy.models.v1.ListVacanciesResponse.page:type_name -> common.v1.PageResponse
+	19, // 26: vacancy.models.v1.ListVacanciesResponse.highlights:type_name -> vacancy.models.v1.VacancyHighlight
+	5,  // 27: vacancy.models.v1.ListVacanciesResponse.total_mode:type_name -> vacancy.models.v1.TotalMode
+	11, // 28: vacancy.models.v1.UpdateVacancyRequest.skills:type_name -> vacancy.models.v1.SkillWeight
+	9,  // 29: vacancy.models.v1.UpdateVacancyRequest.attributes:type_name -> vacancy.models.v1.VacancyAttributes
+	10, // 30: vacancy.models.v1.UpdateVacancyRequest.scoring_policy:type_name -> vacancy.models.v1.ScoringPolicy
+	12, // 31: vacancy.models.v1.VacancyResponse.vacancy:type_name -> vacancy.models.v1.Vacancy
+	11, // 32: vacancy.models.v1.VacancyVersion.skills:type_name -> vacancy.models.v1.SkillWeight
+	84, // 33: vacancy.models.v1.VacancyVersion.created_at:type_name -> google.protobuf.Timestamp
+	85, // 34: vacancy.models.v1.ListVacancyVersionsRequest.page:type_name -> common.v1.PageRequest
+	27, // 35: vacancy.models.v1.ListVacancyVersionsResponse.versions:type_name -> vacancy.models.v1.VacancyVersion
+	86, // 36: vacancy.models.v1.ListVacancyVersionsResponse.page:type_name -> common.v1.PageResponse
+	27, // 37: vacancy.models.v1.VacancyVersionResponse.version:type_name -> vacancy.models.v1.VacancyVersion
+	11, // 38: vacancy.models.v1.SkillChange.before:type_name -> vacancy.models.v1.SkillWeight
+	11, // 39: vacancy.models.v1.SkillChange.after:type_name -> vacancy.models.v1.SkillWeight
+	11, // 40: vacancy.models.v1.DiffVacancyVersionsResponse.added_skills:type_name -> vacancy.models.v1.SkillWeight
+	11, // 41: vacancy.models.v1.DiffVacancyVersionsResponse.removed_skills:type_name -> vacancy.models.v1.SkillWeight
+	33, // 42: vacancy.models.v1.DiffVacancyVersionsResponse.changed_skills:type_name -> vacancy.models.v1.SkillChange
+	0,  // 43: vacancy.models.v1.TransitionVacancyRequest.to_status:type_name -> vacancy.models.v1.VacancyStatus
+	0,  // 44: vacancy.models.v1.VacancyStatusChange.from_status:type_name -> vacancy.models.v1.VacancyStatus
+	0,  // 45: vacancy.models.v1.VacancyStatusChange.to_status:type_name -> vacancy.models.v1.VacancyStatus
+	84, // 46: vacancy.models.v1.VacancyStatusChange.changed_at:type_name -> google.protobuf.Timestamp
+	85, // 47: vacancy.models.v1.ListVacancyStatusHistoryRequest.page:type_name -> common.v1.PageRequest
+	37, // 48: vacancy.models.v1.ListVacancyStatusHistoryResponse.changes:type_name -> vacancy.models.v1.VacancyStatusChange
+	86, // 49: vacancy.models.v1.ListVacancyStatusHistoryResponse.page:type_name -> common.v1.PageResponse
+	6,  // 50: vacancy.models.v1.VacancyTemplate.scope:type_name -> vacancy.models.v1.TemplateScope
+	11, // 51: vacancy.models.v1.VacancyTemplate.skills:type_name -> vacancy.models.v1.SkillWeight
+	84, // 52: vacancy.models.v1.VacancyTemplate.created_at:type_name -> google.protobuf.Timestamp
+	6,  // 53: vacancy.models.v1.CreateTemplateRequest.scope:type_name -> vacancy.models.v1.TemplateScope
+	40, // 54: vacancy.models.v1.VacancyTemplateResponse.template:type_name -> vacancy.models.v1.VacancyTemplate
+	6,  // 55: vacancy.models.v1.ListTemplatesRequest.scope:type_name -> vacancy.models.v1.TemplateScope
+	85, // 56: vacancy.models.v1.ListTemplatesRequest.page:type_name -> common.v1.PageRequest
+	40, // 57: vacancy.models.v1.ListTemplatesResponse.templates:type_name -> vacancy.models.v1.VacancyTemplate
+	86, // 58: vacancy.models.v1.ListTemplatesResponse.page:type_name -> common.v1.PageResponse
+	50, // 59: vacancy.models.v1.AutocompleteSkillsResponse.skills:type_name -> vacancy.models.v1.SkillSuggestion
+	11, // 60: vacancy.models.v1.SuggestSkillsResponse.skills:type_name -> vacancy.models.v1.SkillWeight
+	7,  // 61: vacancy.models.v1.ImportVacanciesRequest.format:type_name -> vacancy.models.v1.ImportFormat
+	8,  // 62: vacancy.models.v1.ImportVacanciesRequest.mode:type_name -> vacancy.models.v1.ImportMode
+	0,  // 63: vacancy.models.v1.ImportRowResult.status:type_name -> vacancy.models.v1.VacancyStatus
+	11, // 64: vacancy.models.v1.ImportRowResult.skills:type_name -> vacancy.models.v1.SkillWeight
+	12, // 65: vacancy.models.v1.ImportRowResult.vacancy:type_name -> vacancy.models.v1.Vacancy
+	55, // 66: vacancy.models.v1.ImportVacanciesResponse.rows:type_name -> vacancy.models.v1.ImportRowResult
+	84, // 67: vacancy.models.v1.Collaborator.created_at:type_name -> google.protobuf.Timestamp
+	60, // 68: vacancy.models.v1.CollaboratorResponse.collaborator:type_name -> vacancy.models.v1.Collaborator
+	60, // 69: vacancy.models.v1.ListCollaboratorsResponse.collaborators:type_name -> vacancy.models.v1.Collaborator
+	67, // 70: vacancy.models.v1.VacancyPipeline.stages:type_name -> vacancy.models.v1.PipelineStage
+	83, // 71: vacancy.models.v1.VacancyPipeline.candidate_counts:type_name -> vacancy.models.v1.VacancyPipeline.CandidateCountsEntry
+	67, // 72: vacancy.models.v1.SetVacancyPipelineStagesRequest.stages:type_name -> vacancy.models.v1.PipelineStage
+	68, // 73: vacancy.models.v1.VacancyPipelineResponse.pipeline:type_name -> vacancy.models.v1.VacancyPipeline
+	84, // 74: vacancy.models.v1.CandidateStageChange.changed_at:type_name -> google.protobuf.Timestamp
+	72, // 75: vacancy.models.v1.MoveCandidateStageResponse.change:type_name -> vacancy.models.v1.CandidateStageChange
+	0,  // 76: vacancy.models.v1.MoveCandidateStageResponse.vacancy_status:type_name -> vacancy.models.v1.VacancyStatus
+	72, // 77: vacancy.models.v1.ListCandidateStageHistoryResponse.changes:type_name -> vacancy.models.v1.CandidateStageChange
+	4,  // 78: vacancy.models.v1.ReclassifyVacancyRolesRequest.sources:type_name -> vacancy.models.v1.RoleSource
+	79, // [79:79] is the sub-list for method output_type
+	79, // [79:79] is the sub-list for method input_type
+	79, // [79:79] is the sub-list for extension type_name
+	79, // [79:79] is the sub-list for extension extendee
+	0,  // [0:79] is the sub-list for field type_name
 }
 
 func init() { file_models_vacancy_model_proto_init() }
@@ -799,8 +5933,8 @@ func file_models_vacancy_model_proto_init() {
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_models_vacancy_model_proto_rawDesc), len(file_models_vacancy_model_proto_rawDesc)),
-			NumEnums:      1,
-			NumMessages:   10,
+			NumEnums:      9,
+			NumMessages:   75,
 			NumExtensions: 0,
 			NumServices:   0,
 		},
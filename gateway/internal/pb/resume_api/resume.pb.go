@@ -27,7 +27,7 @@ var File_resume_api_resume_proto protoreflect.FileDescriptor
 
 const file_resume_api_resume_proto_rawDesc = "" +
 	"\n" +
-	"\x17resume_api/resume.proto\x12\x11resume.service.v1\x1a\x1cgoogle/api/annotations.proto\x1a\x19models/resume_model.proto\x1a.protoc-gen-openapiv2/options/annotations.proto2\xeb\v\n" +
+	"\x17resume_api/resume.proto\x12\x11resume.service.v1\x1a\x1cgoogle/api/annotations.proto\x1a\x19models/resume_model.proto\x1a.protoc-gen-openapiv2/options/annotations.proto2\xed\x0f\n" +
 	"\rResumeService\x12\xab\x01\n" +
 	"\x0fCreateCandidate\x12(.resume.models.v1.CreateCandidateRequest\x1a#.resume.models.v1.CandidateResponse\"I\x92A\x12b\x10\n" +
 	"\x0e\n" +
@@ -48,10 +48,17 @@ const file_resume_api_resume_proto_rawDesc = "" +
 	"\x11IngestResumeBatch\x12*.resume.models.v1.BatchIngestResumeRequest\x1a+.resume.models.v1.BatchIngestResumeResponse\"L\x92A\x12b\x10\n" +
 	"\x0e\n" +
 	"\n" +
-	"BearerAuth\x12\x00\x82\xd3\xe4\x93\x021:\x01*\",/api/v1/vacancies/{vacancy_id}/resumes/batch\x12v\n" +
+	"BearerAuth\x12\x00\x82\xd3\xe4\x93\x021:\x01*\",/api/v1/vacancies/{vacancy_id}/resumes/batch\x12c\n" +
+	"\x0eApplyToVacancy\x12'.resume.models.v1.ApplyToVacancyRequest\x1a(.resume.models.v1.ApplyToVacancyResponse\x12{\n" +
+	"\x16PurgeVacancyCandidates\x12/.resume.models.v1.PurgeVacancyCandidatesRequest\x1a0.resume.models.v1.PurgeVacancyCandidatesResponse\x12\x87\x01\n" +
+	"\x1aTransferCandidateOwnership\x123.resume.models.v1.TransferCandidateOwnershipRequest\x1a4.resume.models.v1.TransferCandidateOwnershipResponse\x12v\n" +
 	"\fUploadResume\x12%.resume.models.v1.UploadResumeRequest\x1a&.resume.models.v1.UploadResumeResponse\"\x15\x92A\x12b\x10\n" +
 	"\x0e\n" +
 	"\n" +
+	"BearerAuth\x12\x00(\x01\x12\x93\x01\n" +
+	"\x19UploadCandidateFromResume\x122.resume.models.v1.UploadCandidateFromResumeRequest\x1a).resume.models.v1.CandidateResumeResponse\"\x15\x92A\x12b\x10\n" +
+	"\x0e\n" +
+	"\n" +
 	"BearerAuth\x12\x00(\x01\x12\x8b\x01\n" +
 	"\tGetResume\x12\".resume.models.v1.GetResumeRequest\x1a .resume.models.v1.ResumeResponse\"8\x92A\x12b\x10\n" +
 	"\x0e\n" +
@@ -71,21 +78,28 @@ const file_resume_api_resume_proto_rawDesc = "" +
 	"BearerAuth\x12=\b\x02\x12(JWT Bearer token. Format: Bearer <token>\x1a\rAuthorization \x02Z7github.com/artem13815/hr/gateway/internal/pb/resume_apib\x06proto3"
 
 var file_resume_api_resume_proto_goTypes = []any{
-	(*models.CreateCandidateRequest)(nil),           // 0: resume.models.v1.CreateCandidateRequest
-	(*models.GetCandidateRequest)(nil),              // 1: resume.models.v1.GetCandidateRequest
-	(*models.CreateCandidateFromResumeRequest)(nil), // 2: resume.models.v1.CreateCandidateFromResumeRequest
-	(*models.BatchIngestResumeRequest)(nil),         // 3: resume.models.v1.BatchIngestResumeRequest
-	(*models.UploadResumeRequest)(nil),              // 4: resume.models.v1.UploadResumeRequest
-	(*models.GetResumeRequest)(nil),                 // 5: resume.models.v1.GetResumeRequest
-	(*models.DownloadResumeRequest)(nil),            // 6: resume.models.v1.DownloadResumeRequest
-	(*models.DeleteCandidateRequest)(nil),           // 7: resume.models.v1.DeleteCandidateRequest
-	(*models.CandidateResponse)(nil),                // 8: resume.models.v1.CandidateResponse
-	(*models.CandidateResumeResponse)(nil),          // 9: resume.models.v1.CandidateResumeResponse
-	(*models.BatchIngestResumeResponse)(nil),        // 10: resume.models.v1.BatchIngestResumeResponse
-	(*models.UploadResumeResponse)(nil),             // 11: resume.models.v1.UploadResumeResponse
-	(*models.ResumeResponse)(nil),                   // 12: resume.models.v1.ResumeResponse
-	(*models.DownloadResumeResponse)(nil),           // 13: resume.models.v1.DownloadResumeResponse
-	(*models.DeleteCandidateResponse)(nil),          // 14: resume.models.v1.DeleteCandidateResponse
+	(*models.CreateCandidateRequest)(nil),             // 0: resume.models.v1.CreateCandidateRequest
+	(*models.GetCandidateRequest)(nil),                // 1: resume.models.v1.GetCandidateRequest
+	(*models.CreateCandidateFromResumeRequest)(nil),   // 2: resume.models.v1.CreateCandidateFromResumeRequest
+	(*models.BatchIngestResumeRequest)(nil),           // 3: resume.models.v1.BatchIngestResumeRequest
+	(*models.ApplyToVacancyRequest)(nil),              // 4: resume.models.v1.ApplyToVacancyRequest
+	(*models.PurgeVacancyCandidatesRequest)(nil),      // 5: resume.models.v1.PurgeVacancyCandidatesRequest
+	(*models.TransferCandidateOwnershipRequest)(nil),  // 6: resume.models.v1.TransferCandidateOwnershipRequest
+	(*models.UploadResumeRequest)(nil),                // 7: resume.models.v1.UploadResumeRequest
+	(*models.UploadCandidateFromResumeRequest)(nil),   // 8: resume.models.v1.UploadCandidateFromResumeRequest
+	(*models.GetResumeRequest)(nil),                   // 9: resume.models.v1.GetResumeRequest
+	(*models.DownloadResumeRequest)(nil),              // 10: resume.models.v1.DownloadResumeRequest
+	(*models.DeleteCandidateRequest)(nil),             // 11: resume.models.v1.DeleteCandidateRequest
+	(*models.CandidateResponse)(nil),                  // 12: resume.models.v1.CandidateResponse
+	(*models.CandidateResumeResponse)(nil),            // 13: resume.models.v1.CandidateResumeResponse
+	(*models.BatchIngestResumeResponse)(nil),          // 14: resume.models.v1.BatchIngestResumeResponse
+	(*models.ApplyToVacancyResponse)(nil),             // 15: resume.models.v1.ApplyToVacancyResponse
+	(*models.PurgeVacancyCandidatesResponse)(nil),     // 16: resume.models.v1.PurgeVacancyCandidatesResponse
+	(*models.TransferCandidateOwnershipResponse)(nil), // 17: resume.models.v1.TransferCandidateOwnershipResponse
+	(*models.UploadResumeResponse)(nil),               // 18: resume.models.v1.UploadResumeResponse
+	(*models.ResumeResponse)(nil),                     // 19: resume.models.v1.ResumeResponse
+	(*models.DownloadResumeResponse)(nil),             // 20: resume.models.v1.DownloadResumeResponse
+	(*models.DeleteCandidateResponse)(nil),            // 21: resume.models.v1.DeleteCandidateResponse
 }
 var file_resume_api_resume_proto_depIdxs = []int32{
 	0,  // 0: resume.service.v1.ResumeService.CreateCandidate:input_type -> resume.models.v1.CreateCandidateRequest
@@ -93,21 +107,29 @@ var file_resume_api_resume_proto_depIdxs = []int32{
 	2,  // 2: resume.service.v1.ResumeService.CreateCandidateFromResume:input_type -> resume.models.v1.CreateCandidateFromResumeRequest
 	2,  // 3: resume.service.v1.ResumeService.IngestResume:input_type -> resume.models.v1.CreateCandidateFromResumeRequest
 	3,  // 4: resume.service.v1.ResumeService.IngestResumeBatch:input_type -> resume.models.v1.BatchIngestResumeRequest
-	4,  // 5: resume.service.v1.ResumeService.UploadResume:input_type -> resume.models.v1.UploadResumeRequest
-	5,  // 6: resume.service.v1.ResumeService.GetResume:input_type -> resume.models.v1.GetResumeRequest
-	6,  // 7: resume.service.v1.ResumeService.DownloadResume:input_type -> resume.models.v1.DownloadResumeRequest
-	7,  // 8: resume.service.v1.ResumeService.DeleteCandidate:input_type -> resume.models.v1.DeleteCandidateRequest
-	8,  // 9: resume.service.v1.ResumeService.CreateCandidate:output_type -> resume.models.v1.CandidateResponse
-	8,  // 10: resume.service.v1.ResumeService.GetCandidate:output_type -> resume.models.v1.CandidateResponse
-	9,  // 11: resume.service.v1.ResumeService.CreateCandidateFromResume:output_type -> resume.models.v1.CandidateResumeResponse
-	9,  // 12: resume.service.v1.ResumeService.IngestResume:output_type -> resume.models.v1.CandidateResumeResponse
-	10, // 13: resume.service.v1.ResumeService.IngestResumeBatch:output_type -> resume.models.v1.BatchIngestResumeResponse
-	11, // 14: resume.service.v1.ResumeService.UploadResume:output_type -> resume.models.v1.UploadResumeResponse
-	12, // 15: resume.service.v1.ResumeService.GetResume:output_type -> resume.models.v1.ResumeResponse
-	13, // 16: resume.service.v1.ResumeService.DownloadResume:output_type -> resume.models.v1.DownloadResumeResponse
-	14, // 17: resume.service.v1.ResumeService.DeleteCandidate:output_type -> resume.models.v1.DeleteCandidateResponse
-	9,  // [9:18] is the sub-list for method output_type
-	0,  // [0:9] is the sub-list for method input_type
+	4,  // 5: resume.service.v1.ResumeService.ApplyToVacancy:input_type -> resume.models.v1.ApplyToVacancyRequest
+	5,  // 6: resume.service.v1.ResumeService.PurgeVacancyCandidates:input_type -> resume.models.v1.PurgeVacancyCandidatesRequest
+	6,  // 7: resume.service.v1.ResumeService.TransferCandidateOwnership:input_type -> resume.models.v1.TransferCandidateOwnershipRequest
+	7,  // 8: resume.service.v1.ResumeService.UploadResume:input_type -> resume.models.v1.UploadResumeRequest
+	8,  // 9: resume.service.v1.ResumeService.UploadCandidateFromResume:input_type -> resume.models.v1.UploadCandidateFromResumeRequest
+	9,  // 10: resume.service.v1.ResumeService.GetResume:input_type -> resume.models.v1.GetResumeRequest
+	10, // 11: resume.service.v1.ResumeService.DownloadResume:input_type -> resume.models.v1.DownloadResumeRequest
+	11, // 12: resume.service.v1.ResumeService.DeleteCandidate:input_type -> resume.models.v1.DeleteCandidateRequest
+	12, // 13: resume.service.v1.ResumeService.CreateCandidate:output_type -> resume.models.v1.CandidateResponse
+	12, // 14: resume.service.v1.ResumeService.GetCandidate:output_type -> resume.models.v1.CandidateResponse
+	13, // 15: resume.service.v1.ResumeService.CreateCandidateFromResume:output_type -> resume.models.v1.CandidateResumeResponse
+	13, // 16: resume.service.v1.ResumeService.IngestResume:output_type -> resume.models.v1.CandidateResumeResponse
+	14, // 17: resume.service.v1.ResumeService.IngestResumeBatch:output_type -> resume.models.v1.BatchIngestResumeResponse
+	15, // 18: resume.service.v1.ResumeService.ApplyToVacancy:output_type -> resume.models.v1.ApplyToVacancyResponse
+	16, // 19: resume.service.v1.ResumeService.PurgeVacancyCandidates:output_type -> resume.models.v1.PurgeVacancyCandidatesResponse
+	17, // 20: resume.service.v1.ResumeService.TransferCandidateOwnership:output_type -> resume.models.v1.TransferCandidateOwnershipResponse
+	18, // 21: resume.service.v1.ResumeService.UploadResume:output_type -> resume.models.v1.UploadResumeResponse
+	13, // 22: resume.service.v1.ResumeService.UploadCandidateFromResume:output_type -> resume.models.v1.CandidateResumeResponse
+	19, // 23: resume.service.v1.ResumeService.GetResume:output_type -> resume.models.v1.ResumeResponse
+	20, // 24: resume.service.v1.ResumeService.DownloadResume:output_type -> resume.models.v1.DownloadResumeResponse
+	21, // 25: resume.service.v1.ResumeService.DeleteCandidate:output_type -> resume.models.v1.DeleteCandidateResponse
+	13, // [13:26] is the sub-list for method output_type
+	0,  // [0:13] is the sub-list for method input_type
 	0,  // [0:0] is the sub-list for extension type_name
 	0,  // [0:0] is the sub-list for extension extendee
 	0,  // [0:0] is the sub-list for field type_name
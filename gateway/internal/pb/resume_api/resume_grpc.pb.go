@@ -20,15 +20,19 @@ import (
 const _ = grpc.SupportPackageIsVersion9
 
 const (
-	ResumeService_CreateCandidate_FullMethodName           = "/resume.service.v1.ResumeService/CreateCandidate"
-	ResumeService_GetCandidate_FullMethodName              = "/resume.service.v1.ResumeService/GetCandidate"
-	ResumeService_CreateCandidateFromResume_FullMethodName = "/resume.service.v1.ResumeService/CreateCandidateFromResume"
-	ResumeService_IngestResume_FullMethodName              = "/resume.service.v1.ResumeService/IngestResume"
-	ResumeService_IngestResumeBatch_FullMethodName         = "/resume.service.v1.ResumeService/IngestResumeBatch"
-	ResumeService_UploadResume_FullMethodName              = "/resume.service.v1.ResumeService/UploadResume"
-	ResumeService_GetResume_FullMethodName                 = "/resume.service.v1.ResumeService/GetResume"
-	ResumeService_DownloadResume_FullMethodName            = "/resume.service.v1.ResumeService/DownloadResume"
-	ResumeService_DeleteCandidate_FullMethodName           = "/resume.service.v1.ResumeService/DeleteCandidate"
+	ResumeService_CreateCandidate_FullMethodName            = "/resume.service.v1.ResumeService/CreateCandidate"
+	ResumeService_GetCandidate_FullMethodName               = "/resume.service.v1.ResumeService/GetCandidate"
+	ResumeService_CreateCandidateFromResume_FullMethodName  = "/resume.service.v1.ResumeService/CreateCandidateFromResume"
+	ResumeService_IngestResume_FullMethodName               = "/resume.service.v1.ResumeService/IngestResume"
+	ResumeService_IngestResumeBatch_FullMethodName          = "/resume.service.v1.ResumeService/IngestResumeBatch"
+	ResumeService_ApplyToVacancy_FullMethodName             = "/resume.service.v1.ResumeService/ApplyToVacancy"
+	ResumeService_PurgeVacancyCandidates_FullMethodName     = "/resume.service.v1.ResumeService/PurgeVacancyCandidates"
+	ResumeService_TransferCandidateOwnership_FullMethodName = "/resume.service.v1.ResumeService/TransferCandidateOwnership"
+	ResumeService_UploadResume_FullMethodName               = "/resume.service.v1.ResumeService/UploadResume"
+	ResumeService_UploadCandidateFromResume_FullMethodName  = "/resume.service.v1.ResumeService/UploadCandidateFromResume"
+	ResumeService_GetResume_FullMethodName                  = "/resume.service.v1.ResumeService/GetResume"
+	ResumeService_DownloadResume_FullMethodName             = "/resume.service.v1.ResumeService/DownloadResume"
+	ResumeService_DeleteCandidate_FullMethodName            = "/resume.service.v1.ResumeService/DeleteCandidate"
 )
 
 // ResumeServiceClient is the client API for ResumeService service.
@@ -40,7 +44,32 @@ type ResumeServiceClient interface {
 	CreateCandidateFromResume(ctx context.Context, in *models.CreateCandidateFromResumeRequest, opts ...grpc.CallOption) (*models.CandidateResumeResponse, error)
 	IngestResume(ctx context.Context, in *models.CreateCandidateFromResumeRequest, opts ...grpc.CallOption) (*models.CandidateResumeResponse, error)
 	IngestResumeBatch(ctx context.Context, in *models.BatchIngestResumeRequest, opts ...grpc.CallOption) (*models.BatchIngestResumeResponse, error)
+	// ApplyToVacancy is the public apply flow: no bearer, rate-limited per
+	// client IP and email. No HTTP binding — the gateway serves the multipart
+	// form at POST /public/v1/vacancies/{vacancy_id}/apply and calls it.
+	ApplyToVacancy(ctx context.Context, in *models.ApplyToVacancyRequest, opts ...grpc.CallOption) (*models.ApplyToVacancyResponse, error)
+	// PurgeVacancyCandidates is called by the vacancy service's retention job
+	// once a soft-deleted vacancy's grace period is over: it hard-deletes the
+	// vacancy's candidates and their resumes, and has the analysis service
+	// delete their analyses. Internal — no bearer, no HTTP binding — and
+	// refused unless the vacancy is already being purged.
+	PurgeVacancyCandidates(ctx context.Context, in *models.PurgeVacancyCandidatesRequest, opts ...grpc.CallOption) (*models.PurgeVacancyCandidatesResponse, error)
+	// TransferCandidateOwnership moves one batch of the candidates a user
+	// added to another user. Admin only. No HTTP binding: the admin service's
+	// TransferOwnership calls it in a loop with the admin's bearer until a
+	// batch comes back short.
+	TransferCandidateOwnership(ctx context.Context, in *models.TransferCandidateOwnershipRequest, opts ...grpc.CallOption) (*models.TransferCandidateOwnershipResponse, error)
+	// UploadResume streams a resume for an existing candidate: meta first,
+	// then the file in chunks. No HTTP binding — the gateway serves multipart
+	// uploads at POST /api/v1/candidates/{candidate_id}/resume/upload and
+	// streams the file part into it.
 	UploadResume(ctx context.Context, opts ...grpc.CallOption) (grpc.ClientStreamingClient[models.UploadResumeRequest, models.UploadResumeResponse], error)
+	// UploadCandidateFromResume is the streaming twin of
+	// CreateCandidateFromResume: meta first, then the file in chunks. No HTTP
+	// binding — the gateway serves multipart uploads at
+	// POST /api/v1/vacancies/{vacancy_id}/candidates/from-resume/upload and
+	// streams the file part into it.
+	UploadCandidateFromResume(ctx context.Context, opts ...grpc.CallOption) (grpc.ClientStreamingClient[models.UploadCandidateFromResumeRequest, models.CandidateResumeResponse], error)
 	GetResume(ctx context.Context, in *models.GetResumeRequest, opts ...grpc.CallOption) (*models.ResumeResponse, error)
 	DownloadResume(ctx context.Context, in *models.DownloadResumeRequest, opts ...grpc.CallOption) (*models.DownloadResumeResponse, error)
 	DeleteCandidate(ctx context.Context, in *models.DeleteCandidateRequest, opts ...grpc.CallOption) (*models.DeleteCandidateResponse, error)
@@ -104,6 +133,36 @@ func (c *resumeServiceClient) IngestResumeBatch(ctx context.Context, in *models.
 	return out, nil
 }
 
+func (c *resumeServiceClient) ApplyToVacancy(ctx context.Context, in *models.ApplyToVacancyRequest, opts ...grpc.CallOption) (*models.ApplyToVacancyResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(models.ApplyToVacancyResponse)
+	err := c.cc.Invoke(ctx, ResumeService_ApplyToVacancy_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *resumeServiceClient) PurgeVacancyCandidates(ctx context.Context, in *models.PurgeVacancyCandidatesRequest, opts ...grpc.CallOption) (*models.PurgeVacancyCandidatesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(models.PurgeVacancyCandidatesResponse)
+	err := c.cc.Invoke(ctx, ResumeService_PurgeVacancyCandidates_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *resumeServiceClient) TransferCandidateOwnership(ctx context.Context, in *models.TransferCandidateOwnershipRequest, opts ...grpc.CallOption) (*models.TransferCandidateOwnershipResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(models.TransferCandidateOwnershipResponse)
+	err := c.cc.Invoke(ctx, ResumeService_TransferCandidateOwnership_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *resumeServiceClient) UploadResume(ctx context.Context, opts ...grpc.CallOption) (grpc.ClientStreamingClient[models.UploadResumeRequest, models.UploadResumeResponse], error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	stream, err := c.cc.NewStream(ctx, &ResumeService_ServiceDesc.Streams[0], ResumeService_UploadResume_FullMethodName, cOpts...)
@@ -117,6 +176,19 @@ func (c *resumeServiceClient) UploadResume(ctx context.Context, opts ...grpc.Cal
 // This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
 type ResumeService_UploadResumeClient = grpc.ClientStreamingClient[models.UploadResumeRequest, models.UploadResumeResponse]
 
+func (c *resumeServiceClient) UploadCandidateFromResume(ctx context.Context, opts ...grpc.CallOption) (grpc.ClientStreamingClient[models.UploadCandidateFromResumeRequest, models.CandidateResumeResponse], error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	stream, err := c.cc.NewStream(ctx, &ResumeService_ServiceDesc.Streams[1], ResumeService_UploadCandidateFromResume_FullMethodName, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &grpc.GenericClientStream[models.UploadCandidateFromResumeRequest, models.CandidateResumeResponse]{ClientStream: stream}
+	return x, nil
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type ResumeService_UploadCandidateFromResumeClient = grpc.ClientStreamingClient[models.UploadCandidateFromResumeRequest, models.CandidateResumeResponse]
+
 func (c *resumeServiceClient) GetResume(ctx context.Context, in *models.GetResumeRequest, opts ...grpc.CallOption) (*models.ResumeResponse, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(models.ResumeResponse)
@@ -156,7 +228,32 @@ type ResumeServiceServer interface {
 	CreateCandidateFromResume(context.Context, *models.CreateCandidateFromResumeRequest) (*models.CandidateResumeResponse, error)
 	IngestResume(context.Context, *models.CreateCandidateFromResumeRequest) (*models.CandidateResumeResponse, error)
 	IngestResumeBatch(context.Context, *models.BatchIngestResumeRequest) (*models.BatchIngestResumeResponse, error)
+	// ApplyToVacancy is the public apply flow: no bearer, rate-limited per
+	// client IP and email. No HTTP binding — the gateway serves the multipart
+	// form at POST /public/v1/vacancies/{vacancy_id}/apply and calls it.
+	ApplyToVacancy(context.Context, *models.ApplyToVacancyRequest) (*models.ApplyToVacancyResponse, error)
+	// PurgeVacancyCandidates is called by the vacancy service's retention job
+	// once a soft-deleted vacancy's grace period is over: it hard-deletes the
+	// vacancy's candidates and their resumes, and has the analysis service
+	// delete their analyses. Internal — no bearer, no HTTP binding — and
+	// refused unless the vacancy is already being purged.
+	PurgeVacancyCandidates(context.Context, *models.PurgeVacancyCandidatesRequest) (*models.PurgeVacancyCandidatesResponse, error)
+	// TransferCandidateOwnership moves one batch of the candidates a user
+	// added to another user. Admin only. No HTTP binding: the admin service's
+	// TransferOwnership calls it in a loop with the admin's bearer until a
+	// batch comes back short.
+	TransferCandidateOwnership(context.Context, *models.TransferCandidateOwnershipRequest) (*models.TransferCandidateOwnershipResponse, error)
+	// UploadResume streams a resume for an existing candidate: meta first,
+	// then the file in chunks. No HTTP binding — the gateway serves multipart
+	// uploads at POST /api/v1/candidates/{candidate_id}/resume/upload and
+	// streams the file part into it.
 	UploadResume(grpc.ClientStreamingServer[models.UploadResumeRequest, models.UploadResumeResponse]) error
+	// UploadCandidateFromResume is the streaming twin of
+	// CreateCandidateFromResume: meta first, then the file in chunks. No HTTP
+	// binding — the gateway serves multipart uploads at
+	// POST /api/v1/vacancies/{vacancy_id}/candidates/from-resume/upload and
+	// streams the file part into it.
+	UploadCandidateFromResume(grpc.ClientStreamingServer[models.UploadCandidateFromResumeRequest, models.CandidateResumeResponse]) error
 	GetResume(context.Context, *models.GetResumeRequest) (*models.ResumeResponse, error)
 	DownloadResume(context.Context, *models.DownloadResumeRequest) (*models.DownloadResumeResponse, error)
 	DeleteCandidate(context.Context, *models.DeleteCandidateRequest) (*models.DeleteCandidateResponse, error)
@@ -185,9 +282,21 @@ func (UnimplementedResumeServiceServer) IngestResume(context.Context, *models.Cr
 func (UnimplementedResumeServiceServer) IngestResumeBatch(context.Context, *models.BatchIngestResumeRequest) (*models.BatchIngestResumeResponse, error) {
 	return nil, status.Error(codes.Unimplemented, "method IngestResumeBatch not implemented")
 }
+func (UnimplementedResumeServiceServer) ApplyToVacancy(context.Context, *models.ApplyToVacancyRequest) (*models.ApplyToVacancyResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method ApplyToVacancy not implemented")
+}
+func (UnimplementedResumeServiceServer) PurgeVacancyCandidates(context.Context, *models.PurgeVacancyCandidatesRequest) (*models.PurgeVacancyCandidatesResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method PurgeVacancyCandidates not implemented")
+}
+func (UnimplementedResumeServiceServer) TransferCandidateOwnership(context.Context, *models.TransferCandidateOwnershipRequest) (*models.TransferCandidateOwnershipResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method TransferCandidateOwnership not implemented")
+}
 func (UnimplementedResumeServiceServer) UploadResume(grpc.ClientStreamingServer[models.UploadResumeRequest, models.UploadResumeResponse]) error {
 	return status.Error(codes.Unimplemented, "method UploadResume not implemented")
 }
+func (UnimplementedResumeServiceServer) UploadCandidateFromResume(grpc.ClientStreamingServer[models.UploadCandidateFromResumeRequest, models.CandidateResumeResponse]) error {
+	return status.Error(codes.Unimplemented, "method UploadCandidateFromResume not implemented")
+}
 func (UnimplementedResumeServiceServer) GetResume(context.Context, *models.GetResumeRequest) (*models.ResumeResponse, error) {
 	return nil, status.Error(codes.Unimplemented, "method GetResume not implemented")
 }
@@ -308,6 +417,60 @@ func _ResumeService_IngestResumeBatch_Handler(srv interface{}, ctx context.Conte
 	return interceptor(ctx, in, info, handler)
 }
 
+func _ResumeService_ApplyToVacancy_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(models.ApplyToVacancyRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ResumeServiceServer).ApplyToVacancy(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ResumeService_ApplyToVacancy_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ResumeServiceServer).ApplyToVacancy(ctx, req.(*models.ApplyToVacancyRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ResumeService_PurgeVacancyCandidates_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(models.PurgeVacancyCandidatesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ResumeServiceServer).PurgeVacancyCandidates(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ResumeService_PurgeVacancyCandidates_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ResumeServiceServer).PurgeVacancyCandidates(ctx, req.(*models.PurgeVacancyCandidatesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ResumeService_TransferCandidateOwnership_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(models.TransferCandidateOwnershipRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ResumeServiceServer).TransferCandidateOwnership(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ResumeService_TransferCandidateOwnership_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ResumeServiceServer).TransferCandidateOwnership(ctx, req.(*models.TransferCandidateOwnershipRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _ResumeService_UploadResume_Handler(srv interface{}, stream grpc.ServerStream) error {
 	return srv.(ResumeServiceServer).UploadResume(&grpc.GenericServerStream[models.UploadResumeRequest, models.UploadResumeResponse]{ServerStream: stream})
 }
@@ -315,6 +478,13 @@ func _ResumeService_UploadResume_Handler(srv interface{}, stream grpc.ServerStre
 // This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
 type ResumeService_UploadResumeServer = grpc.ClientStreamingServer[models.UploadResumeRequest, models.UploadResumeResponse]
 
+func _ResumeService_UploadCandidateFromResume_Handler(srv interface{}, stream grpc.ServerStream) error {
+	return srv.(ResumeServiceServer).UploadCandidateFromResume(&grpc.GenericServerStream[models.UploadCandidateFromResumeRequest, models.CandidateResumeResponse]{ServerStream: stream})
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type ResumeService_UploadCandidateFromResumeServer = grpc.ClientStreamingServer[models.UploadCandidateFromResumeRequest, models.CandidateResumeResponse]
+
 func _ResumeService_GetResume_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(models.GetResumeRequest)
 	if err := dec(in); err != nil {
@@ -396,6 +566,18 @@ var ResumeService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "IngestResumeBatch",
 			Handler:    _ResumeService_IngestResumeBatch_Handler,
 		},
+		{
+			MethodName: "ApplyToVacancy",
+			Handler:    _ResumeService_ApplyToVacancy_Handler,
+		},
+		{
+			MethodName: "PurgeVacancyCandidates",
+			Handler:    _ResumeService_PurgeVacancyCandidates_Handler,
+		},
+		{
+			MethodName: "TransferCandidateOwnership",
+			Handler:    _ResumeService_TransferCandidateOwnership_Handler,
+		},
 		{
 			MethodName: "GetResume",
 			Handler:    _ResumeService_GetResume_Handler,
@@ -415,6 +597,11 @@ var ResumeService_ServiceDesc = grpc.ServiceDesc{
 			Handler:       _ResumeService_UploadResume_Handler,
 			ClientStreams: true,
 		},
+		{
+			StreamName:    "UploadCandidateFromResume",
+			Handler:       _ResumeService_UploadCandidateFromResume_Handler,
+			ClientStreams: true,
+		},
 	},
 	Metadata: "resume_api/resume.proto",
 }
@@ -10,6 +10,7 @@ import (
 	models "github.com/artem13815/hr/gateway/internal/pb/models"
 	_ "github.com/grpc-ecosystem/grpc-gateway/v2/protoc-gen-openapiv2/options"
 	_ "google.golang.org/genproto/googleapis/api/annotations"
+	httpbody "google.golang.org/genproto/googleapis/api/httpbody"
 	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
 	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
 	reflect "reflect"
@@ -27,12 +28,16 @@ var File_vacancy_api_vacancy_proto protoreflect.FileDescriptor
 
 const file_vacancy_api_vacancy_proto_rawDesc = "" +
 	"\n" +
-	"\x19vacancy_api/vacancy.proto\x12\x12vacancy.service.v1\x1a\x1cgoogle/api/annotations.proto\x1a\x1amodels/vacancy_model.proto\x1a.protoc-gen-openapiv2/options/annotations.proto2\x9c\x06\n" +
-	"\x0eVacancyService\x12\x8f\x01\n" +
-	"\rCreateVacancy\x12'.vacancy.models.v1.CreateVacancyRequest\x1a\".vacancy.models.v1.VacancyResponse\"1\x92A\x12b\x10\n" +
+	"\x19vacancy_api/vacancy.proto\x12\x12vacancy.service.v1\x1a\x1cgoogle/api/annotations.proto\x1a\x19google/api/httpbody.proto\x1a\x1amodels/vacancy_model.proto\x1a.protoc-gen-openapiv2/options/annotations.proto2\xd8-\n" +
+	"\x0eVacancyService\x12\x95\x01\n" +
+	"\rCreateVacancy\x12'.vacancy.models.v1.CreateVacancyRequest\x1a(.vacancy.models.v1.CreateVacancyResponse\"1\x92A\x12b\x10\n" +
 	"\x0e\n" +
 	"\n" +
-	"BearerAuth\x12\x00\x82\xd3\xe4\x93\x02\x16:\x01*\"\x11/api/v1/vacancies\x12\x93\x01\n" +
+	"BearerAuth\x12\x00\x82\xd3\xe4\x93\x02\x16:\x01*\"\x11/api/v1/vacancies\x12\xa2\x01\n" +
+	"\x0fImportVacancies\x12).vacancy.models.v1.ImportVacanciesRequest\x1a*.vacancy.models.v1.ImportVacanciesResponse\"8\x92A\x12b\x10\n" +
+	"\x0e\n" +
+	"\n" +
+	"BearerAuth\x12\x00\x82\xd3\xe4\x93\x02\x1d:\x01*\"\x18/api/v1/vacancies/import\x12\x93\x01\n" +
 	"\n" +
 	"GetVacancy\x12$.vacancy.models.v1.GetVacancyRequest\x1a\".vacancy.models.v1.VacancyResponse\";\x92A\x12b\x10\n" +
 	"\x0e\n" +
@@ -49,38 +54,243 @@ const file_vacancy_api_vacancy_proto_rawDesc = "" +
 	"\x0eArchiveVacancy\x12(.vacancy.models.v1.ArchiveVacancyRequest\x1a).vacancy.models.v1.ArchiveVacancyResponse\"F\x92A\x12b\x10\n" +
 	"\x0e\n" +
 	"\n" +
-	"BearerAuth\x12\x00\x82\xd3\xe4\x93\x02+:\x01*\"&/api/v1/vacancies/{vacancy_id}/archiveB\xc7\x01\x92A\x89\x01\x128\n" +
+	"BearerAuth\x12\x00\x82\xd3\xe4\x93\x02+:\x01*\"&/api/v1/vacancies/{vacancy_id}/archive\x12\x9f\x01\n" +
+	"\rDeleteVacancy\x12'.vacancy.models.v1.DeleteVacancyRequest\x1a(.vacancy.models.v1.DeleteVacancyResponse\";\x92A\x12b\x10\n" +
+	"\x0e\n" +
+	"\n" +
+	"BearerAuth\x12\x00\x82\xd3\xe4\x93\x02 *\x1e/api/v1/vacancies/{vacancy_id}\x12\xba\x01\n" +
+	"\x13ListVacancyVersions\x12-.vacancy.models.v1.ListVacancyVersionsRequest\x1a..vacancy.models.v1.ListVacancyVersionsResponse\"D\x92A\x12b\x10\n" +
+	"\x0e\n" +
+	"\n" +
+	"BearerAuth\x12\x00\x82\xd3\xe4\x93\x02)\x12'/api/v1/vacancies/{vacancy_id}/versions\x12\xbb\x01\n" +
+	"\x11GetVacancyVersion\x12+.vacancy.models.v1.GetVacancyVersionRequest\x1a).vacancy.models.v1.VacancyVersionResponse\"N\x92A\x12b\x10\n" +
+	"\x0e\n" +
+	"\n" +
+	"BearerAuth\x12\x00\x82\xd3\xe4\x93\x023\x121/api/v1/vacancies/{vacancy_id}/versions/{version}\x12\xbe\x01\n" +
+	"\x13DiffVacancyVersions\x12-.vacancy.models.v1.DiffVacancyVersionsRequest\x1a..vacancy.models.v1.DiffVacancyVersionsResponse\"H\x92A\x12b\x10\n" +
+	"\x0e\n" +
+	"\n" +
+	"BearerAuth\x12\x00\x82\xd3\xe4\x93\x02-\x12+/api/v1/vacancies/{vacancy_id}/version-diff\x12\xaf\x01\n" +
+	"\x11TransitionVacancy\x12+.vacancy.models.v1.TransitionVacancyRequest\x1a\".vacancy.models.v1.VacancyResponse\"I\x92A\x12b\x10\n" +
+	"\x0e\n" +
+	"\n" +
+	"BearerAuth\x12\x00\x82\xd3\xe4\x93\x02.:\x01*\")/api/v1/vacancies/{vacancy_id}/transition\x12\xa6\x01\n" +
+	"\x0eRestoreVacancy\x12(.vacancy.models.v1.RestoreVacancyRequest\x1a\".vacancy.models.v1.VacancyResponse\"F\x92A\x12b\x10\n" +
+	"\x0e\n" +
+	"\n" +
+	"BearerAuth\x12\x00\x82\xd3\xe4\x93\x02+:\x01*\"&/api/v1/vacancies/{vacancy_id}/restore\x12\xcf\x01\n" +
+	"\x18ListVacancyStatusHistory\x122.vacancy.models.v1.ListVacancyStatusHistoryRequest\x1a3.vacancy.models.v1.ListVacancyStatusHistoryResponse\"J\x92A\x12b\x10\n" +
+	"\x0e\n" +
+	"\n" +
+	"BearerAuth\x12\x00\x82\xd3\xe4\x93\x02/\x12-/api/v1/vacancies/{vacancy_id}/status-history\x12\xa0\x01\n" +
+	"\fCloneVacancy\x12&.vacancy.models.v1.CloneVacancyRequest\x1a\".vacancy.models.v1.VacancyResponse\"D\x92A\x12b\x10\n" +
+	"\x0e\n" +
+	"\n" +
+	"BearerAuth\x12\x00\x82\xd3\xe4\x93\x02):\x01*\"$/api/v1/vacancies/{vacancy_id}/clone\x12\xaf\x01\n" +
+	"\x0eCreateTemplate\x12(.vacancy.models.v1.CreateTemplateRequest\x1a*.vacancy.models.v1.VacancyTemplateResponse\"G\x92A\x12b\x10\n" +
+	"\x0e\n" +
+	"\n" +
+	"BearerAuth\x12\x00\x82\xd3\xe4\x93\x02,:\x01*\"'/api/v1/vacancies/{vacancy_id}/template\x12\x9a\x01\n" +
+	"\rListTemplates\x12'.vacancy.models.v1.ListTemplatesRequest\x1a(.vacancy.models.v1.ListTemplatesResponse\"6\x92A\x12b\x10\n" +
+	"\x0e\n" +
+	"\n" +
+	"BearerAuth\x12\x00\x82\xd3\xe4\x93\x02\x1b\x12\x19/api/v1/vacancy-templates\x12\xc7\x01\n" +
+	"\x19CreateVacancyFromTemplate\x123.vacancy.models.v1.CreateVacancyFromTemplateRequest\x1a\".vacancy.models.v1.VacancyResponse\"Q\x92A\x12b\x10\n" +
+	"\x0e\n" +
+	"\n" +
+	"BearerAuth\x12\x00\x82\xd3\xe4\x93\x026:\x01*\"1/api/v1/vacancy-templates/{template_id}/vacancies\x12\xab\x01\n" +
+	"\x0eDeleteTemplate\x12(.vacancy.models.v1.DeleteTemplateRequest\x1a).vacancy.models.v1.DeleteTemplateResponse\"D\x92A\x12b\x10\n" +
+	"\x0e\n" +
+	"\n" +
+	"BearerAuth\x12\x00\x82\xd3\xe4\x93\x02)*'/api/v1/vacancy-templates/{template_id}\x12\xb5\x01\n" +
+	"\x14SetVacancyVisibility\x12..vacancy.models.v1.SetVacancyVisibilityRequest\x1a\".vacancy.models.v1.VacancyResponse\"I\x92A\x12b\x10\n" +
+	"\x0e\n" +
+	"\n" +
+	"BearerAuth\x12\x00\x82\xd3\xe4\x93\x02.:\x01*\")/api/v1/vacancies/{vacancy_id}/visibility\x12\xb3\x01\n" +
+	"\x0fAddCollaborator\x12).vacancy.models.v1.AddCollaboratorRequest\x1a'.vacancy.models.v1.CollaboratorResponse\"L\x92A\x12b\x10\n" +
+	"\x0e\n" +
+	"\n" +
+	"BearerAuth\x12\x00\x82\xd3\xe4\x93\x021:\x01*\",/api/v1/vacancies/{vacancy_id}/collaborators\x12\xc6\x01\n" +
+	"\x12RemoveCollaborator\x12,.vacancy.models.v1.RemoveCollaboratorRequest\x1a-.vacancy.models.v1.RemoveCollaboratorResponse\"S\x92A\x12b\x10\n" +
+	"\x0e\n" +
+	"\n" +
+	"BearerAuth\x12\x00\x82\xd3\xe4\x93\x028*6/api/v1/vacancies/{vacancy_id}/collaborators/{user_id}\x12\xb9\x01\n" +
+	"\x11ListCollaborators\x12+.vacancy.models.v1.ListCollaboratorsRequest\x1a,.vacancy.models.v1.ListCollaboratorsResponse\"I\x92A\x12b\x10\n" +
+	"\x0e\n" +
+	"\n" +
+	"BearerAuth\x12\x00\x82\xd3\xe4\x93\x02.\x12,/api/v1/vacancies/{vacancy_id}/collaborators\x12\x83\x01\n" +
+	"\x18TransferVacancyOwnership\x122.vacancy.models.v1.TransferVacancyOwnershipRequest\x1a3.vacancy.models.v1.TransferVacancyOwnershipResponse\x12\xb4\x01\n" +
+	"\x12GetVacancyPipeline\x12,.vacancy.models.v1.GetVacancyPipelineRequest\x1a*.vacancy.models.v1.VacancyPipelineResponse\"D\x92A\x12b\x10\n" +
+	"\x0e\n" +
+	"\n" +
+	"BearerAuth\x12\x00\x82\xd3\xe4\x93\x02)\x12'/api/v1/vacancies/{vacancy_id}/pipeline\x12\xca\x01\n" +
+	"\x18SetVacancyPipelineStages\x122.vacancy.models.v1.SetVacancyPipelineStagesRequest\x1a*.vacancy.models.v1.VacancyPipelineResponse\"N\x92A\x12b\x10\n" +
+	"\x0e\n" +
+	"\n" +
+	"BearerAuth\x12\x00\x82\xd3\xe4\x93\x023:\x01*\x1a./api/v1/vacancies/{vacancy_id}/pipeline/stages\x12\xd1\x01\n" +
+	"\x12MoveCandidateStage\x12,.vacancy.models.v1.MoveCandidateStageRequest\x1a-.vacancy.models.v1.MoveCandidateStageResponse\"^\x92A\x12b\x10\n" +
+	"\x0e\n" +
+	"\n" +
+	"BearerAuth\x12\x00\x82\xd3\xe4\x93\x02C:\x01*\">/api/v1/vacancies/{vacancy_id}/candidates/{candidate_id}/stage\x12\xeb\x01\n" +
+	"\x19ListCandidateStageHistory\x123.vacancy.models.v1.ListCandidateStageHistoryRequest\x1a4.vacancy.models.v1.ListCandidateStageHistoryResponse\"c\x92A\x12b\x10\n" +
+	"\x0e\n" +
+	"\n" +
+	"BearerAuth\x12\x00\x82\xd3\xe4\x93\x02H\x12F/api/v1/vacancies/{vacancy_id}/candidates/{candidate_id}/stage-history\x12\x85\x01\n" +
+	"\rGetJobPosting\x12'.vacancy.models.v1.GetJobPostingRequest\x1a\x14.google.api.HttpBody\"5\x82\xd3\xe4\x93\x02/\x12-/public/v1/vacancies/{vacancy_id}/job-posting\x12\x9b\x01\n" +
+	"\x0eGetVacancyFeed\x12(.vacancy.models.v1.GetVacancyFeedRequest\x1a\x14.google.api.HttpBody\"I\x82\xd3\xe4\x93\x02CZ,\x12*/public/v1/owners/{owner_user_id}/feed.xml\x12\x13/public/v1/feed.xml\x12\xab\x01\n" +
+	"\x12AutocompleteSkills\x12,.vacancy.models.v1.AutocompleteSkillsRequest\x1a-.vacancy.models.v1.AutocompleteSkillsResponse\"8\x92A\x12b\x10\n" +
+	"\x0e\n" +
+	"\n" +
+	"BearerAuth\x12\x00\x82\xd3\xe4\x93\x02\x1d\x12\x1b/api/v1/skills/autocomplete\x12\x9a\x01\n" +
+	"\rSuggestSkills\x12'.vacancy.models.v1.SuggestSkillsRequest\x1a(.vacancy.models.v1.SuggestSkillsResponse\"6\x92A\x12b\x10\n" +
+	"\x0e\n" +
+	"\n" +
+	"BearerAuth\x12\x00\x82\xd3\xe4\x93\x02\x1b:\x01*\"\x16/api/v1/skills/suggest\x12\x9f\x01\n" +
+	"\x10ListVacancyRoles\x12*.vacancy.models.v1.ListVacancyRolesRequest\x1a+.vacancy.models.v1.ListVacancyRolesResponse\"2\x92A\x12b\x10\n" +
+	"\x0e\n" +
+	"\n" +
+	"BearerAuth\x12\x00\x82\xd3\xe4\x93\x02\x17\x12\x15/api/v1/vacancy-roles\x12\xc7\x01\n" +
+	"\x16ReclassifyVacancyRoles\x120.vacancy.models.v1.ReclassifyVacancyRolesRequest\x1a1.vacancy.models.v1.ReclassifyVacancyRolesResponse\"H\x92A\x12b\x10\n" +
+	"\x0e\n" +
+	"\n" +
+	"BearerAuth\x12\x00\x82\xd3\xe4\x93\x02-:\x01*\"(/api/v1/admin/vacancies/reclassify-rolesB\xc7\x01\x92A\x89\x01\x128\n" +
 	"\x13Vacancy Service API\x12\x1aVacancy management service2\x051.0.0ZM\n" +
 	"K\n" +
 	"\n" +
 	"BearerAuth\x12=\b\x02\x12(JWT Bearer token. Format: Bearer <token>\x1a\rAuthorization \x02Z8github.com/artem13815/hr/gateway/internal/pb/vacancy_apib\x06proto3"
 
 var file_vacancy_api_vacancy_proto_goTypes = []any{
-	(*models.CreateVacancyRequest)(nil),   // 0: vacancy.models.v1.CreateVacancyRequest
-	(*models.GetVacancyRequest)(nil),      // 1: vacancy.models.v1.GetVacancyRequest
-	(*models.ListVacanciesRequest)(nil),   // 2: vacancy.models.v1.ListVacanciesRequest
-	(*models.UpdateVacancyRequest)(nil),   // 3: vacancy.models.v1.UpdateVacancyRequest
-	(*models.ArchiveVacancyRequest)(nil),  // 4: vacancy.models.v1.ArchiveVacancyRequest
-	(*models.VacancyResponse)(nil),        // 5: vacancy.models.v1.VacancyResponse
-	(*models.ListVacanciesResponse)(nil),  // 6: vacancy.models.v1.ListVacanciesResponse
-	(*models.ArchiveVacancyResponse)(nil), // 7: vacancy.models.v1.ArchiveVacancyResponse
+	(*models.CreateVacancyRequest)(nil),              // 0: vacancy.models.v1.CreateVacancyRequest
+	(*models.ImportVacanciesRequest)(nil),            // 1: vacancy.models.v1.ImportVacanciesRequest
+	(*models.GetVacancyRequest)(nil),                 // 2: vacancy.models.v1.GetVacancyRequest
+	(*models.ListVacanciesRequest)(nil),              // 3: vacancy.models.v1.ListVacanciesRequest
+	(*models.UpdateVacancyRequest)(nil),              // 4: vacancy.models.v1.UpdateVacancyRequest
+	(*models.ArchiveVacancyRequest)(nil),             // 5: vacancy.models.v1.ArchiveVacancyRequest
+	(*models.DeleteVacancyRequest)(nil),              // 6: vacancy.models.v1.DeleteVacancyRequest
+	(*models.ListVacancyVersionsRequest)(nil),        // 7: vacancy.models.v1.ListVacancyVersionsRequest
+	(*models.GetVacancyVersionRequest)(nil),          // 8: vacancy.models.v1.GetVacancyVersionRequest
+	(*models.DiffVacancyVersionsRequest)(nil),        // 9: vacancy.models.v1.DiffVacancyVersionsRequest
+	(*models.TransitionVacancyRequest)(nil),          // 10: vacancy.models.v1.TransitionVacancyRequest
+	(*models.RestoreVacancyRequest)(nil),             // 11: vacancy.models.v1.RestoreVacancyRequest
+	(*models.ListVacancyStatusHistoryRequest)(nil),   // 12: vacancy.models.v1.ListVacancyStatusHistoryRequest
+	(*models.CloneVacancyRequest)(nil),               // 13: vacancy.models.v1.CloneVacancyRequest
+	(*models.CreateTemplateRequest)(nil),             // 14: vacancy.models.v1.CreateTemplateRequest
+	(*models.ListTemplatesRequest)(nil),              // 15: vacancy.models.v1.ListTemplatesRequest
+	(*models.CreateVacancyFromTemplateRequest)(nil),  // 16: vacancy.models.v1.CreateVacancyFromTemplateRequest
+	(*models.DeleteTemplateRequest)(nil),             // 17: vacancy.models.v1.DeleteTemplateRequest
+	(*models.SetVacancyVisibilityRequest)(nil),       // 18: vacancy.models.v1.SetVacancyVisibilityRequest
+	(*models.AddCollaboratorRequest)(nil),            // 19: vacancy.models.v1.AddCollaboratorRequest
+	(*models.RemoveCollaboratorRequest)(nil),         // 20: vacancy.models.v1.RemoveCollaboratorRequest
+	(*models.ListCollaboratorsRequest)(nil),          // 21: vacancy.models.v1.ListCollaboratorsRequest
+	(*models.TransferVacancyOwnershipRequest)(nil),   // 22: vacancy.models.v1.TransferVacancyOwnershipRequest
+	(*models.GetVacancyPipelineRequest)(nil),         // 23: vacancy.models.v1.GetVacancyPipelineRequest
+	(*models.SetVacancyPipelineStagesRequest)(nil),   // 24: vacancy.models.v1.SetVacancyPipelineStagesRequest
+	(*models.MoveCandidateStageRequest)(nil),         // 25: vacancy.models.v1.MoveCandidateStageRequest
+	(*models.ListCandidateStageHistoryRequest)(nil),  // 26: vacancy.models.v1.ListCandidateStageHistoryRequest
+	(*models.GetJobPostingRequest)(nil),              // 27: vacancy.models.v1.GetJobPostingRequest
+	(*models.GetVacancyFeedRequest)(nil),             // 28: vacancy.models.v1.GetVacancyFeedRequest
+	(*models.AutocompleteSkillsRequest)(nil),         // 29: vacancy.models.v1.AutocompleteSkillsRequest
+	(*models.SuggestSkillsRequest)(nil),              // 30: vacancy.models.v1.SuggestSkillsRequest
+	(*models.ListVacancyRolesRequest)(nil),           // 31: vacancy.models.v1.ListVacancyRolesRequest
+	(*models.ReclassifyVacancyRolesRequest)(nil),     // 32: vacancy.models.v1.ReclassifyVacancyRolesRequest
+	(*models.CreateVacancyResponse)(nil),             // 33: vacancy.models.v1.CreateVacancyResponse
+	(*models.ImportVacanciesResponse)(nil),           // 34: vacancy.models.v1.ImportVacanciesResponse
+	(*models.VacancyResponse)(nil),                   // 35: vacancy.models.v1.VacancyResponse
+	(*models.ListVacanciesResponse)(nil),             // 36: vacancy.models.v1.ListVacanciesResponse
+	(*models.ArchiveVacancyResponse)(nil),            // 37: vacancy.models.v1.ArchiveVacancyResponse
+	(*models.DeleteVacancyResponse)(nil),             // 38: vacancy.models.v1.DeleteVacancyResponse
+	(*models.ListVacancyVersionsResponse)(nil),       // 39: vacancy.models.v1.ListVacancyVersionsResponse
+	(*models.VacancyVersionResponse)(nil),            // 40: vacancy.models.v1.VacancyVersionResponse
+	(*models.DiffVacancyVersionsResponse)(nil),       // 41: vacancy.models.v1.DiffVacancyVersionsResponse
+	(*models.ListVacancyStatusHistoryResponse)(nil),  // 42: vacancy.models.v1.ListVacancyStatusHistoryResponse
+	(*models.VacancyTemplateResponse)(nil),           // 43: vacancy.models.v1.VacancyTemplateResponse
+	(*models.ListTemplatesResponse)(nil),             // 44: vacancy.models.v1.ListTemplatesResponse
+	(*models.DeleteTemplateResponse)(nil),            // 45: vacancy.models.v1.DeleteTemplateResponse
+	(*models.CollaboratorResponse)(nil),              // 46: vacancy.models.v1.CollaboratorResponse
+	(*models.RemoveCollaboratorResponse)(nil),        // 47: vacancy.models.v1.RemoveCollaboratorResponse
+	(*models.ListCollaboratorsResponse)(nil),         // 48: vacancy.models.v1.ListCollaboratorsResponse
+	(*models.TransferVacancyOwnershipResponse)(nil),  // 49: vacancy.models.v1.TransferVacancyOwnershipResponse
+	(*models.VacancyPipelineResponse)(nil),           // 50: vacancy.models.v1.VacancyPipelineResponse
+	(*models.MoveCandidateStageResponse)(nil),        // 51: vacancy.models.v1.MoveCandidateStageResponse
+	(*models.ListCandidateStageHistoryResponse)(nil), // 52: vacancy.models.v1.ListCandidateStageHistoryResponse
+	(*httpbody.HttpBody)(nil),                        // 53: google.api.HttpBody
+	(*models.AutocompleteSkillsResponse)(nil),        // 54: vacancy.models.v1.AutocompleteSkillsResponse
+	(*models.SuggestSkillsResponse)(nil),             // 55: vacancy.models.v1.SuggestSkillsResponse
+	(*models.ListVacancyRolesResponse)(nil),          // 56: vacancy.models.v1.ListVacancyRolesResponse
+	(*models.ReclassifyVacancyRolesResponse)(nil),    // 57: vacancy.models.v1.ReclassifyVacancyRolesResponse
 }
 var file_vacancy_api_vacancy_proto_depIdxs = []int32{
-	0, // 0: vacancy.service.v1.VacancyService.CreateVacancy:input_type -> vacancy.models.v1.CreateVacancyRequest
-	1, // 1: vacancy.service.v1.VacancyService.GetVacancy:input_type -> vacancy.models.v1.GetVacancyRequest
-	2, // 2: vacancy.service.v1.VacancyService.ListVacancies:input_type -> vacancy.models.v1.ListVacanciesRequest
-	3, // 3: vacancy.service.v1.VacancyService.UpdateVacancy:input_type -> vacancy.models.v1.UpdateVacancyRequest
-	4, // 4: vacancy.service.v1.VacancyService.ArchiveVacancy:input_type -> vacancy.models.v1.ArchiveVacancyRequest
-	5, // 5: vacancy.service.v1.VacancyService.CreateVacancy:output_type -> vacancy.models.v1.VacancyResponse
-	5, // 6: vacancy.service.v1.VacancyService.GetVacancy:output_type -> vacancy.models.v1.VacancyResponse
-	6, // 7: vacancy.service.v1.VacancyService.ListVacancies:output_type -> vacancy.models.v1.ListVacanciesResponse
-	5, // 8: vacancy.service.v1.VacancyService.UpdateVacancy:output_type -> vacancy.models.v1.VacancyResponse
-	7, // 9: vacancy.service.v1.VacancyService.ArchiveVacancy:output_type -> vacancy.models.v1.ArchiveVacancyResponse
-	5, // [5:10] is the sub-list for method output_type
-	0, // [0:5] is the sub-list for method input_type
-	0, // [0:0] is the sub-list for extension type_name
-	0, // [0:0] is the sub-list for extension extendee
-	0, // [0:0] is the sub-list for field type_name
+	0,  // 0: vacancy.service.v1.VacancyService.CreateVacancy:input_type -> vacancy.models.v1.CreateVacancyRequest
+	1,  // 1: vacancy.service.v1.VacancyService.ImportVacancies:input_type -> vacancy.models.v1.ImportVacanciesRequest
+	2,  // 2: vacancy.service.v1.VacancyService.GetVacancy:input_type -> vacancy.models.v1.GetVacancyRequest
+	3,  // 3: vacancy.service.v1.VacancyService.ListVacancies:input_type -> vacancy.models.v1.ListVacanciesRequest
+	4,  // 4: vacancy.service.v1.VacancyService.UpdateVacancy:input_type -> vacancy.models.v1.UpdateVacancyRequest
+	5,  // 5: vacancy.service.v1.VacancyService.ArchiveVacancy:input_type -> vacancy.models.v1.ArchiveVacancyRequest
+	6,  // 6: vacancy.service.v1.VacancyService.DeleteVacancy:input_type -> vacancy.models.v1.DeleteVacancyRequest
+	7,  // 7: vacancy.service.v1.VacancyService.ListVacancyVersions:input_type -> vacancy.models.v1.ListVacancyVersionsRequest
+	8,  // 8: vacancy.service.v1.VacancyService.GetVacancyVersion:input_type -> vacancy.models.v1.GetVacancyVersionRequest
+	9,  // 9: vacancy.service.v1.VacancyService.DiffVacancyVersions:input_type -> vacancy.models.v1.DiffVacancyVersionsRequest
+	10, // 10: vacancy.service.v1.VacancyService.TransitionVacancy:input_type -> vacancy.models.v1.TransitionVacancyRequest
+	11, // 11: vacancy.service.v1.VacancyService.RestoreVacancy:input_type -> vacancy.models.v1.RestoreVacancyRequest
+	12, // 12: vacancy.service.v1.VacancyService.ListVacancyStatusHistory:input_type -> vacancy.models.v1.ListVacancyStatusHistoryRequest
+	13, // 13: vacancy.service.v1.VacancyService.CloneVacancy:input_type -> vacancy.models.v1.CloneVacancyRequest
+	14, // 14: vacancy.service.v1.VacancyService.CreateTemplate:input_type -> vacancy.models.v1.CreateTemplateRequest
+	15, // 15: vacancy.service.v1.VacancyService.ListTemplates:input_type -> vacancy.models.v1.ListTemplatesRequest
+	16, // 16: vacancy.service.v1.VacancyService.CreateVacancyFromTemplate:input_type -> vacancy.models.v1.CreateVacancyFromTemplateRequest
+	17, // 17: vacancy.service.v1.VacancyService.DeleteTemplate:input_type -> vacancy.models.v1.DeleteTemplateRequest
+	18, // 18: vacancy.service.v1.VacancyService.SetVacancyVisibility:input_type -> vacancy.models.v1.SetVacancyVisibilityRequest
+	19, // 19: vacancy.service.v1.VacancyService.AddCollaborator:input_type -> vacancy.models.v1.AddCollaboratorRequest
+	20, // 20: vacancy.service.v1.VacancyService.RemoveCollaborator:input_type -> vacancy.models.v1.RemoveCollaboratorRequest
+	21, // 21: vacancy.service.v1.VacancyService.ListCollaborators:input_type -> vacancy.models.v1.ListCollaboratorsRequest
+	22, // 22: vacancy.service.v1.VacancyService.TransferVacancyOwnership:input_type -> vacancy.models.v1.TransferVacancyOwnershipRequest
+	23, // 23: vacancy.service.v1.VacancyService.GetVacancyPipeline:input_type -> vacancy.models.v1.GetVacancyPipelineRequest
+	24, // 24: vacancy.service.v1.VacancyService.SetVacancyPipelineStages:input_type -> vacancy.models.v1.SetVacancyPipelineStagesRequest
+	25, // 25: vacancy.service.v1.VacancyService.MoveCandidateStage:input_type -> vacancy.models.v1.MoveCandidateStageRequest
+	26, // 26: vacancy.service.v1.VacancyService.ListCandidateStageHistory:input_type -> vacancy.models.v1.ListCandidateStageHistoryRequest
+	27, // 27: vacancy.service.v1.VacancyService.GetJobPosting:input_type -> vacancy.models.v1.GetJobPostingRequest
+	28, // 28: vacancy.service.v1.VacancyService.GetVacancyFeed:input_type -> vacancy.models.v1.GetVacancyFeedRequest
+	29, // 29: vacancy.service.v1.VacancyService.AutocompleteSkills:input_type -> vacancy.models.v1.AutocompleteSkillsRequest
+	30, // 30: vacancy.service.v1.VacancyService.SuggestSkills:input_type -> vacancy.models.v1.SuggestSkillsRequest
+	31, // 31: vacancy.service.v1.VacancyService.ListVacancyRoles:input_type -> vacancy.models.v1.ListVacancyRolesRequest
+	32, // 32: vacancy.service.v1.VacancyService.ReclassifyVacancyRoles:input_type -> vacancy.models.v1.ReclassifyVacancyRolesRequest
+	33, // 33: vacancy.service.v1.VacancyService.CreateVacancy:output_type -> vacancy.models.v1.CreateVacancyResponse
+	34, // 34: vacancy.service.v1.VacancyService.ImportVacancies:output_type -> vacancy.models.v1.ImportVacanciesResponse
+	35, // 35: vacancy.service.v1.VacancyService.GetVacancy:output_type -> vacancy.models.v1.VacancyResponse
+	36, // 36: vacancy.service.v1.VacancyService.ListVacancies:output_type -> vacancy.models.v1.ListVacanciesResponse
+	35, // 37: vacancy.service.v1.VacancyService.UpdateVacancy:output_type -> vacancy.models.v1.VacancyResponse
+	37, // 38: vacancy.service.v1.VacancyService.ArchiveVacancy:output_type -> vacancy.models.v1.ArchiveVacancyResponse
+	38, // 39: vacancy.service.v1.VacancyService.DeleteVacancy:output_type -> vacancy.models.v1.DeleteVacancyResponse
+	39, // 40: vacancy.service.v1.VacancyService.ListVacancyVersions:output_type -> vacancy.models.v1.ListVacancyVersionsResponse
+	40, // 41: vacancy.service.v1.VacancyService.GetVacancyVersion:output_type -> vacancy.models.v1.VacancyVersionResponse
+	41, // 42: vacancy.service.v1.VacancyService.DiffVacancyVersions:output_type -> vacancy.models.v1.DiffVacancyVersionsResponse
+	35, // 43: vacancy.service.v1.VacancyService.TransitionVacancy:output_type -> vacancy.models.v1.VacancyResponse
+	35, // 44: vacancy.service.v1.VacancyService.RestoreVacancy:output_type -> vacancy.models.v1.VacancyResponse
+	42, // 45: vacancy.service.v1.VacancyService.ListVacancyStatusHistory:output_type -> vacancy.models.v1.ListVacancyStatusHistoryResponse
+	35, // 46: vacancy.service.v1.VacancyService.CloneVacancy:output_type -> vacancy.models.v1.VacancyResponse
+	43, // 47: vacancy.service.v1.VacancyService.CreateTemplate:output_type -> vacancy.models.v1.VacancyTemplateResponse
+	44, // 48: vacancy.service.v1.VacancyService.ListTemplates:output_type -> vacancy.models.v1.ListTemplatesResponse
+	35, // 49: vacancy.service.v1.VacancyService.CreateVacancyFromTemplate:output_type -> vacancy.models.v1.VacancyResponse
+	45, // 50: vacancy.service.v1.VacancyService.DeleteTemplate:output_type -> vacancy.models.v1.DeleteTemplateResponse
+	35, // 51: vacancy.service.v1.VacancyService.SetVacancyVisibility:output_type -> vacancy.models.v1.VacancyResponse
+	46, // 52: vacancy.service.v1.VacancyService.AddCollaborator:output_type -> vacancy.models.v1.CollaboratorResponse
+	47, // 53: vacancy.service.v1.VacancyService.RemoveCollaborator:output_type -> vacancy.models.v1.RemoveCollaboratorResponse
+	48, // 54: vacancy.service.v1.VacancyService.ListCollaborators:output_type -> vacancy.models.v1.ListCollaboratorsResponse
+	49, // 55: vacancy.service.v1.VacancyService.TransferVacancyOwnership:output_type -> vacancy.models.v1.TransferVacancyOwnershipResponse
+	50, // 56: vacancy.service.v1.VacancyService.GetVacancyPipeline:output_type -> vacancy.models.v1.VacancyPipelineResponse
+	50, // 57: vacancy.service.v1.VacancyService.SetVacancyPipelineStages:output_type -> vacancy.models.v1.VacancyPipelineResponse
+	51, // 58: vacancy.service.v1.VacancyService.MoveCandidateStage:output_type -> vacancy.models.v1.MoveCandidateStageResponse
+	52, // 59: vacancy.service.v1.VacancyService.ListCandidateStageHistory:output_type -> vacancy.models.v1.ListCandidateStageHistoryResponse
+	53, // 60: vacancy.service.v1.VacancyService.GetJobPosting:output_type -> google.api.HttpBody
+	53, // 61: vacancy.service.v1.VacancyService.GetVacancyFeed:output_type -> google.api.HttpBody
+	54, // 62: vacancy.service.v1.VacancyService.AutocompleteSkills:output_type -> vacancy.models.v1.AutocompleteSkillsResponse
+	55, // 63: vacancy.service.v1.VacancyService.SuggestSkills:output_type -> vacancy.models.v1.SuggestSkillsResponse
+	56, // 64: vacancy.service.v1.VacancyService.ListVacancyRoles:output_type -> vacancy.models.v1.ListVacancyRolesResponse
+	57, // 65: vacancy.service.v1.VacancyService.ReclassifyVacancyRoles:output_type -> vacancy.models.v1.ReclassifyVacancyRolesResponse
+	33, // [33:66] is the sub-list for method output_type
+	0,  // [0:33] is the sub-list for method input_type
+	0,  // [0:0] is the sub-list for extension type_name
+	0,  // [0:0] is the sub-list for extension extendee
+	0,  // [0:0] is the sub-list for field type_name
 }
 
 func init() { file_vacancy_api_vacancy_proto_init() }
@@ -0,0 +1,113 @@
+package http
+
+import (
+	"context"
+	"errors"
+	"io"
+	"net/http"
+	"strings"
+
+	"github.com/grpc-ecosystem/grpc-gateway/v2/runtime"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+
+	pb_models "github.com/artem13815/hr/gateway/internal/pb/models"
+)
+
+const (
+	// maxResumeFileBytes mirrors resume's usecase.MaxResumeSizeBytes; the
+	// gateway checks it first so an oversized upload is cut off at the edge
+	// instead of being buffered and shipped to the backend.
+	maxResumeFileBytes = 10 << 20
+	// maxResumeFormBytes leaves room for the text fields and multipart
+	// framing on top of the file.
+	maxResumeFormBytes = maxResumeFileBytes + 1<<20
+	// applyFormMemoryBytes is how much of the form ParseMultipartForm keeps
+	// in memory; larger file parts spill to a temp file.
+	applyFormMemoryBytes = 1 << 20
+)
+
+// applySubmitter is the slice of resume_api.ResumeServiceClient the apply
+// handler needs.
+type applySubmitter interface {
+	ApplyToVacancy(ctx context.Context, in *pb_models.ApplyToVacancyRequest, opts ...grpc.CallOption) (*pb_models.ApplyToVacancyResponse, error)
+}
+
+// ApplyHandler serves POST /public/v1/vacancies/{vacancy_id}/apply, the
+// candidate-facing apply form. grpc-gateway cannot bind multipart bodies,
+// so the form is decoded here and forwarded to resume.ApplyToVacancy.
+// Fields: full_name, email, consent (true/on/1/yes) and the file in
+// `resume`. No bearer is required; resume rate-limits by the X-Client-IP
+// that WithClientIP sets. Errors go through the mux's error handler so
+// the body has the same shape as every other route.
+func ApplyHandler(client applySubmitter, mux *runtime.ServeMux) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		ctx := r.Context()
+		_, outbound := runtime.MarshalerForRequest(mux, r)
+		fail := func(err error) { runtime.HTTPError(ctx, mux, outbound, w, r, err) }
+
+		r.Body = http.MaxBytesReader(w, r.Body, maxResumeFormBytes)
+		if err := r.ParseMultipartForm(applyFormMemoryBytes); err != nil {
+			if _, ok := errors.AsType[*http.MaxBytesError](err); ok {
+				fail(status.Error(codes.InvalidArgument, "Resume file is too large."))
+				return
+			}
+			fail(status.Error(codes.InvalidArgument, "Expected a multipart/form-data body."))
+			return
+		}
+		defer func() { _ = r.MultipartForm.RemoveAll() }()
+
+		file, header, err := r.FormFile("resume")
+		if err != nil {
+			fail(status.Error(codes.InvalidArgument, "Resume file is required."))
+			return
+		}
+		defer file.Close()
+		if header.Size > maxResumeFileBytes {
+			fail(status.Error(codes.InvalidArgument, "Resume file is too large."))
+			return
+		}
+		data, err := io.ReadAll(file)
+		if err != nil {
+			fail(status.Error(codes.InvalidArgument, "Could not read resume file."))
+			return
+		}
+
+		if ip := r.Header.Get("X-Client-IP"); ip != "" {
+			ctx = metadata.AppendToOutgoingContext(ctx, "x-client-ip", ip)
+		}
+		resp, err := client.ApplyToVacancy(ctx, &pb_models.ApplyToVacancyRequest{
+			VacancyId: r.PathValue("vacancy_id"),
+			FullName:  r.FormValue("full_name"),
+			Email:     r.FormValue("email"),
+			Consent:   formBool(r.FormValue("consent")),
+			FileName:  header.Filename,
+			FileData:  data,
+		})
+		if err != nil {
+			fail(err)
+			return
+		}
+
+		body, err := outbound.Marshal(resp)
+		if err != nil {
+			fail(status.Error(codes.Internal, "Internal error."))
+			return
+		}
+		w.Header().Set("Content-Type", outbound.ContentType(resp))
+		w.WriteHeader(http.StatusCreated)
+		_, _ = w.Write(body)
+	})
+}
+
+// formBool reads an HTML checkbox: browsers send "on" for a checked box
+// without a value attribute, scripted clients tend to send "true" or "1".
+func formBool(v string) bool {
+	switch strings.ToLower(strings.TrimSpace(v)) {
+	case "on", "true", "1", "yes":
+		return true
+	}
+	return false
+}
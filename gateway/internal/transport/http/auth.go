@@ -54,10 +54,19 @@ func WithAuthContext(authClient auth_api.AuthServiceClient, next http.Handler) h
 // requiresAuth lists the paths that need a valid bearer at the edge.
 // Auth-issuing endpoints (/login, /register, /refresh) MUST stay public —
 // listing them here would create a chicken-and-egg problem.
+//
+// /public/v1/* (vacancy JobPosting and job-board feeds) is deliberately
+// absent: it is served to crawlers and only exposes published vacancies.
 func requiresAuth(method, path string) bool {
 	switch {
 	case strings.HasPrefix(path, "/api/v1/vacancies"):
 		return true
+	case strings.HasPrefix(path, "/api/v1/vacancy-templates"):
+		return true
+	case strings.HasPrefix(path, "/api/v1/vacancy-roles"):
+		return true
+	case strings.HasPrefix(path, "/api/v1/skills"):
+		return true
 	case strings.HasPrefix(path, "/api/v1/candidates"):
 		return true
 	case strings.HasPrefix(path, "/api/v1/resumes"):
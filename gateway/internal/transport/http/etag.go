@@ -0,0 +1,34 @@
+package http
+
+import (
+	"context"
+	"net/http"
+	"strconv"
+
+	"google.golang.org/protobuf/proto"
+
+	pb_models "github.com/artem13815/hr/gateway/internal/pb/models"
+)
+
+// ForwardVacancyETag is a grpc-gateway ForwardResponseOption that stamps
+// vacancy responses — CreateVacancy's included — with an ETag built from
+// the vacancy version. Clients
+// send it back as If-Match on PATCH; IncomingHeaderMatcher forwards that to
+// the vacancy service, which rejects stale writes with ABORTED (HTTP 409).
+//
+// The tag is the bare version number, quoted as RFC 9110 requires. Weak
+// validators (W/"3") are accepted on the way in but never emitted.
+func ForwardVacancyETag(_ context.Context, w http.ResponseWriter, resp proto.Message) error {
+	var vacancy *pb_models.Vacancy
+	switch r := resp.(type) {
+	case *pb_models.VacancyResponse:
+		vacancy = r.GetVacancy()
+	case *pb_models.CreateVacancyResponse:
+		vacancy = r.GetVacancy()
+	}
+	if vacancy == nil {
+		return nil
+	}
+	w.Header().Set("ETag", strconv.Quote(strconv.FormatUint(uint64(vacancy.GetVersion()), 10)))
+	return nil
+}
@@ -0,0 +1,48 @@
+package http
+
+import (
+	"net/http/httptest"
+	"testing"
+
+	"google.golang.org/protobuf/proto"
+
+	pb_models "github.com/artem13815/hr/gateway/internal/pb/models"
+)
+
+func TestForwardVacancyETag(t *testing.T) {
+	tests := []struct {
+		name string
+		resp proto.Message
+		want string
+	}{
+		{
+			name: "vacancy response",
+			resp: &pb_models.VacancyResponse{Vacancy: &pb_models.Vacancy{Version: 3}},
+			want: `"3"`,
+		},
+		{
+			name: "create response",
+			resp: &pb_models.CreateVacancyResponse{Vacancy: &pb_models.Vacancy{Version: 1}},
+			want: `"1"`,
+		},
+		{
+			name: "create response without vacancy",
+			resp: &pb_models.CreateVacancyResponse{},
+		},
+		{
+			name: "other message",
+			resp: &pb_models.ListVacanciesResponse{},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			w := httptest.NewRecorder()
+			if err := ForwardVacancyETag(t.Context(), w, tt.resp); err != nil {
+				t.Fatalf("ForwardVacancyETag: %v", err)
+			}
+			if got := w.Header().Get("ETag"); got != tt.want {
+				t.Errorf("ETag = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
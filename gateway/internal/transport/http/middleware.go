@@ -12,8 +12,10 @@ import (
 
 // IncomingHeaderMatcher tells grpc-gateway which incoming HTTP headers to
 // propagate as gRPC metadata. We allow `authorization` (bearer token —
-// downstream services validate it themselves) and `x-client-ip`
-// (downstream rate-limit bucketing). Everything else falls back to
+// downstream services validate it themselves), `x-client-ip`
+// (downstream rate-limit bucketing) and `if-match` (optimistic-concurrency
+// precondition for vacancy updates; the default matcher would rename it to
+// `grpcgateway-if-match`). Everything else falls back to
 // grpc-gateway's default matcher (which keeps `x-*` and a few standard
 // headers).
 //
@@ -24,13 +26,26 @@ import (
 // would be misleading at best and a spoofing vector at worst.
 func IncomingHeaderMatcher(key string) (string, bool) {
 	switch strings.ToLower(key) {
-	case "authorization", "x-client-ip":
+	case "authorization", "x-client-ip", "if-match":
 		return key, true
 	default:
 		return runtime.DefaultHeaderMatcher(key)
 	}
 }
 
+// OutgoingHeaderMatcher maps gRPC response header metadata onto HTTP
+// headers. `cache-control` is set by the public vacancy feed handlers and
+// must reach crawlers and CDNs verbatim; the default matcher would rename it
+// to `Grpc-Metadata-Cache-Control`, which nobody honours.
+func OutgoingHeaderMatcher(key string) (string, bool) {
+	switch strings.ToLower(key) {
+	case "cache-control":
+		return "Cache-Control", true
+	default:
+		return runtime.MetadataHeaderPrefix + key, true
+	}
+}
+
 // WithCORS handles CORS preflight (OPTIONS) and decorates real responses
 // with Access-Control-Allow-* headers when the request Origin matches the
 // allowlist. allowedOrigins=["*"] enables a permissive mode that echoes
@@ -60,7 +75,8 @@ func WithCORS(allowedOrigins []string) func(http.Handler) http.Handler {
 					}
 					w.Header().Add("Vary", "Origin")
 					w.Header().Set("Access-Control-Allow-Methods", "GET, POST, PUT, PATCH, DELETE, OPTIONS")
-					w.Header().Set("Access-Control-Allow-Headers", "Authorization, Content-Type, X-Client-IP, X-Requested-With")
+					w.Header().Set("Access-Control-Allow-Headers", "Authorization, Content-Type, If-Match, X-Client-IP, X-Requested-With")
+					w.Header().Set("Access-Control-Expose-Headers", "ETag")
 					w.Header().Set("Access-Control-Max-Age", "600")
 				}
 			}
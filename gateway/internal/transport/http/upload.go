@@ -0,0 +1,209 @@
+package http
+
+import (
+	"context"
+	"errors"
+	"io"
+	"mime/multipart"
+	"net/http"
+	"time"
+
+	"github.com/grpc-ecosystem/grpc-gateway/v2/runtime"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+
+	pb_models "github.com/artem13815/hr/gateway/internal/pb/models"
+)
+
+const (
+	// uploadChunkBytes is the payload of one chunk message: the file never
+	// sits in gateway memory as a whole.
+	uploadChunkBytes = 64 << 10
+	// uploadTimeout replaces the server-wide read and write deadlines for a
+	// streamed upload; 10 MB from a slow uplink does not fit in 10 s.
+	uploadTimeout = 2 * time.Minute
+)
+
+// resumeUploader is the slice of resume_api.ResumeServiceClient the upload
+// handlers need.
+type resumeUploader interface {
+	UploadResume(ctx context.Context, opts ...grpc.CallOption) (grpc.ClientStreamingClient[pb_models.UploadResumeRequest, pb_models.UploadResumeResponse], error)
+	UploadCandidateFromResume(ctx context.Context, opts ...grpc.CallOption) (grpc.ClientStreamingClient[pb_models.UploadCandidateFromResumeRequest, pb_models.CandidateResumeResponse], error)
+}
+
+// UploadResumeHandler serves POST /api/v1/candidates/{candidate_id}/resume/upload,
+// the multipart counterpart of the UploadResume stream, which has no HTTP
+// binding. The file goes in the `resume` part and is piped into the stream
+// chunk by chunk as it arrives. Mount it behind WithAuthContext: the bearer
+// is forwarded to resume, which checks candidate ownership.
+func UploadResumeHandler(client resumeUploader, mux *runtime.ServeMux) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		_, outbound := runtime.MarshalerForRequest(mux, r)
+		fail := func(err error) { runtime.HTTPError(r.Context(), mux, outbound, w, r, err) }
+
+		part, err := resumePart(w, r)
+		if err != nil {
+			fail(err)
+			return
+		}
+		defer part.Close()
+
+		ctx, cancel := context.WithCancel(outgoingUploadContext(r))
+		defer cancel()
+		stream, err := client.UploadResume(ctx)
+		if err != nil {
+			fail(err)
+			return
+		}
+		resp, err := pipeUpload(stream, part,
+			&pb_models.UploadResumeRequest{Payload: &pb_models.UploadResumeRequest_Meta{Meta: &pb_models.UploadResumeMeta{
+				CandidateId: r.PathValue("candidate_id"),
+				FileName:    part.FileName(),
+			}}},
+			func(data []byte) *pb_models.UploadResumeRequest {
+				return &pb_models.UploadResumeRequest{Payload: &pb_models.UploadResumeRequest_Chunk{Chunk: &pb_models.UploadResumeChunk{Data: data}}}
+			},
+		)
+		if err != nil {
+			fail(err)
+			return
+		}
+		writeUploadResponse(w, outbound, resp, fail)
+	})
+}
+
+// UploadCandidateFromResumeHandler serves
+// POST /api/v1/vacancies/{vacancy_id}/candidates/from-resume/upload: the
+// same as the JSON from-resume route, minus base64 — the `resume` part is
+// streamed into UploadCandidateFromResume and the part's file name helps
+// format detection. Mount it behind WithAuthContext.
+func UploadCandidateFromResumeHandler(client resumeUploader, mux *runtime.ServeMux) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		_, outbound := runtime.MarshalerForRequest(mux, r)
+		fail := func(err error) { runtime.HTTPError(r.Context(), mux, outbound, w, r, err) }
+
+		part, err := resumePart(w, r)
+		if err != nil {
+			fail(err)
+			return
+		}
+		defer part.Close()
+
+		ctx, cancel := context.WithCancel(outgoingUploadContext(r))
+		defer cancel()
+		stream, err := client.UploadCandidateFromResume(ctx)
+		if err != nil {
+			fail(err)
+			return
+		}
+		resp, err := pipeUpload(stream, part,
+			&pb_models.UploadCandidateFromResumeRequest{Payload: &pb_models.UploadCandidateFromResumeRequest_Meta{Meta: &pb_models.UploadCandidateFromResumeMeta{
+				VacancyId: r.PathValue("vacancy_id"),
+				FileName:  part.FileName(),
+			}}},
+			func(data []byte) *pb_models.UploadCandidateFromResumeRequest {
+				return &pb_models.UploadCandidateFromResumeRequest{Payload: &pb_models.UploadCandidateFromResumeRequest_Chunk{Chunk: &pb_models.UploadResumeChunk{Data: data}}}
+			},
+		)
+		if err != nil {
+			fail(err)
+			return
+		}
+		writeUploadResponse(w, outbound, resp, fail)
+	})
+}
+
+// resumePart extends the connection deadlines for the upload and walks the
+// multipart body up to the `resume` file part without buffering anything.
+// Parts before it are skipped; the handlers take everything else from the
+// path.
+func resumePart(w http.ResponseWriter, r *http.Request) (*multipart.Part, error) {
+	rc := http.NewResponseController(w)
+	_ = rc.SetReadDeadline(time.Now().Add(uploadTimeout))
+	_ = rc.SetWriteDeadline(time.Now().Add(uploadTimeout))
+
+	r.Body = http.MaxBytesReader(w, r.Body, maxResumeFormBytes)
+	mr, err := r.MultipartReader()
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, "Expected a multipart/form-data body.")
+	}
+	for {
+		part, err := mr.NextPart()
+		if errors.Is(err, io.EOF) {
+			return nil, status.Error(codes.InvalidArgument, "Resume file is required.")
+		}
+		if err != nil {
+			if _, ok := errors.AsType[*http.MaxBytesError](err); ok {
+				return nil, status.Error(codes.InvalidArgument, "Resume file is too large.")
+			}
+			return nil, status.Error(codes.InvalidArgument, "Expected a multipart/form-data body.")
+		}
+		if part.FormName() == "resume" && part.FileName() != "" {
+			return part, nil
+		}
+	}
+}
+
+// outgoingUploadContext forwards what grpc-gateway would have forwarded
+// for a generated route: the bearer and the client IP.
+func outgoingUploadContext(r *http.Request) context.Context {
+	ctx := r.Context()
+	if auth := r.Header.Get("Authorization"); auth != "" {
+		ctx = metadata.AppendToOutgoingContext(ctx, "authorization", auth)
+	}
+	if ip := r.Header.Get("X-Client-IP"); ip != "" {
+		ctx = metadata.AppendToOutgoingContext(ctx, "x-client-ip", ip)
+	}
+	return ctx
+}
+
+// pipeUpload sends meta, then the file in uploadChunkBytes chunks, and
+// returns the backend's response. maxResumeFileBytes is enforced while
+// reading, so an oversized file is cut off mid-stream; the caller cancels
+// the stream context on any error. A failed Send only ends the copy — the
+// backend's actual status comes back from CloseAndRecv.
+func pipeUpload[Req, Resp any](stream grpc.ClientStreamingClient[Req, Resp], file io.Reader, meta *Req, chunk func(data []byte) *Req) (*Resp, error) {
+	if err := stream.Send(meta); err != nil {
+		return stream.CloseAndRecv()
+	}
+
+	buf := make([]byte, uploadChunkBytes)
+	total := 0
+	for {
+		n, err := io.ReadFull(file, buf)
+		if n > 0 {
+			total += n
+			if total > maxResumeFileBytes {
+				return nil, status.Error(codes.InvalidArgument, "Resume file is too large.")
+			}
+			// Send marshals the message before returning, so buf can be
+			// reused for the next read.
+			if sendErr := stream.Send(chunk(buf[:n])); sendErr != nil {
+				return stream.CloseAndRecv()
+			}
+		}
+		if errors.Is(err, io.EOF) || errors.Is(err, io.ErrUnexpectedEOF) {
+			break
+		}
+		if err != nil {
+			if _, ok := errors.AsType[*http.MaxBytesError](err); ok {
+				return nil, status.Error(codes.InvalidArgument, "Resume file is too large.")
+			}
+			return nil, status.Error(codes.InvalidArgument, "Could not read resume file.")
+		}
+	}
+	return stream.CloseAndRecv()
+}
+
+func writeUploadResponse(w http.ResponseWriter, outbound runtime.Marshaler, resp any, fail func(error)) {
+	body, err := outbound.Marshal(resp)
+	if err != nil {
+		fail(status.Error(codes.Internal, "Internal error."))
+		return
+	}
+	w.Header().Set("Content-Type", outbound.ContentType(resp))
+	w.WriteHeader(http.StatusOK)
+	_, _ = w.Write(body)
+}
@@ -37,6 +37,13 @@ type DecisionRequest struct {
 	VacancyMustHave   []string
 	VacancyNiceToHave []string
 	ResumeText        string
+	// Vacancy seniority level and offered salary range, forwarded so the
+	// model can judge over- / under-qualification and expectation fit.
+	// Empty / zero when the vacancy does not state them.
+	VacancySeniority      string
+	VacancySalaryMin      uint64
+	VacancySalaryMax      uint64
+	VacancySalaryCurrency string
 }
 
 // DecisionResponse is the structured HR decision returned to analysis. The
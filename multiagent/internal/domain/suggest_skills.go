@@ -0,0 +1,22 @@
+package domain
+
+// SkillSuggestRequest is the vacancy text the LLM extracts a skill matrix
+// from. MaxSkills is already defaulted and capped by the usecase.
+type SkillSuggestRequest struct {
+	Title       string
+	Description string
+	MaxSkills   int
+}
+
+// SuggestedSkill mirrors vacancy's SkillWeight. The usecase guarantees a
+// non-empty name, a weight in [0, 1] and at most one of the two flags.
+type SuggestedSkill struct {
+	Name       string
+	Weight     float32
+	MustHave   bool
+	NiceToHave bool
+}
+
+type SkillSuggestResponse struct {
+	Skills []SuggestedSkill
+}
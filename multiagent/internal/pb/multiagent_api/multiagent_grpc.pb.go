@@ -21,6 +21,8 @@ const _ = grpc.SupportPackageIsVersion9
 const (
 	MultiAgentService_GenerateDecision_FullMethodName = "/multiagent.service.v1.MultiAgentService/GenerateDecision"
 	MultiAgentService_ClassifyRole_FullMethodName     = "/multiagent.service.v1.MultiAgentService/ClassifyRole"
+	MultiAgentService_SuggestSkills_FullMethodName    = "/multiagent.service.v1.MultiAgentService/SuggestSkills"
+	MultiAgentService_ListRoles_FullMethodName        = "/multiagent.service.v1.MultiAgentService/ListRoles"
 )
 
 // MultiAgentServiceClient is the client API for MultiAgentService service.
@@ -34,6 +36,15 @@ type MultiAgentServiceClient interface {
 	// this on Create/Update so the role downstream HR decisions key off is
 	// chosen by the same model that writes them, instead of a keyword table.
 	ClassifyRole(ctx context.Context, in *ClassifyRoleRequest, opts ...grpc.CallOption) (*ClassifyRoleResponse, error)
+	// SuggestSkills extracts a draft skill matrix — must-have and
+	// nice-to-have skills with suggested weights — from a vacancy title and
+	// description. The vacancy service calls it from its own SuggestSkills
+	// RPC and falls back to a keyword scan when this returns Unavailable.
+	SuggestSkills(ctx context.Context, in *SuggestSkillsRequest, opts ...grpc.CallOption) (*SuggestSkillsResponse, error)
+	// ListRoles returns the role vocabulary ClassifyRole answers from: every
+	// registered prompt template plus "default". The vacancy service checks
+	// manually pinned roles against it.
+	ListRoles(ctx context.Context, in *ListRolesRequest, opts ...grpc.CallOption) (*ListRolesResponse, error)
 }
 
 type multiAgentServiceClient struct {
@@ -64,6 +75,26 @@ func (c *multiAgentServiceClient) ClassifyRole(ctx context.Context, in *Classify
 	return out, nil
 }
 
+func (c *multiAgentServiceClient) SuggestSkills(ctx context.Context, in *SuggestSkillsRequest, opts ...grpc.CallOption) (*SuggestSkillsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SuggestSkillsResponse)
+	err := c.cc.Invoke(ctx, MultiAgentService_SuggestSkills_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *multiAgentServiceClient) ListRoles(ctx context.Context, in *ListRolesRequest, opts ...grpc.CallOption) (*ListRolesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListRolesResponse)
+	err := c.cc.Invoke(ctx, MultiAgentService_ListRoles_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // MultiAgentServiceServer is the server API for MultiAgentService service.
 // All implementations must embed UnimplementedMultiAgentServiceServer
 // for forward compatibility.
@@ -75,6 +106,15 @@ type MultiAgentServiceServer interface {
 	// this on Create/Update so the role downstream HR decisions key off is
 	// chosen by the same model that writes them, instead of a keyword table.
 	ClassifyRole(context.Context, *ClassifyRoleRequest) (*ClassifyRoleResponse, error)
+	// SuggestSkills extracts a draft skill matrix — must-have and
+	// nice-to-have skills with suggested weights — from a vacancy title and
+	// description. The vacancy service calls it from its own SuggestSkills
+	// RPC and falls back to a keyword scan when this returns Unavailable.
+	SuggestSkills(context.Context, *SuggestSkillsRequest) (*SuggestSkillsResponse, error)
+	// ListRoles returns the role vocabulary ClassifyRole answers from: every
+	// registered prompt template plus "default". The vacancy service checks
+	// manually pinned roles against it.
+	ListRoles(context.Context, *ListRolesRequest) (*ListRolesResponse, error)
 	mustEmbedUnimplementedMultiAgentServiceServer()
 }
 
@@ -91,6 +131,12 @@ func (UnimplementedMultiAgentServiceServer) GenerateDecision(context.Context, *G
 func (UnimplementedMultiAgentServiceServer) ClassifyRole(context.Context, *ClassifyRoleRequest) (*ClassifyRoleResponse, error) {
 	return nil, status.Error(codes.Unimplemented, "method ClassifyRole not implemented")
 }
+func (UnimplementedMultiAgentServiceServer) SuggestSkills(context.Context, *SuggestSkillsRequest) (*SuggestSkillsResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method SuggestSkills not implemented")
+}
+func (UnimplementedMultiAgentServiceServer) ListRoles(context.Context, *ListRolesRequest) (*ListRolesResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method ListRoles not implemented")
+}
 func (UnimplementedMultiAgentServiceServer) mustEmbedUnimplementedMultiAgentServiceServer() {}
 func (UnimplementedMultiAgentServiceServer) testEmbeddedByValue()                           {}
 
@@ -148,6 +194,42 @@ func _MultiAgentService_ClassifyRole_Handler(srv interface{}, ctx context.Contex
 	return interceptor(ctx, in, info, handler)
 }
 
+func _MultiAgentService_SuggestSkills_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SuggestSkillsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MultiAgentServiceServer).SuggestSkills(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MultiAgentService_SuggestSkills_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MultiAgentServiceServer).SuggestSkills(ctx, req.(*SuggestSkillsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MultiAgentService_ListRoles_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListRolesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MultiAgentServiceServer).ListRoles(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MultiAgentService_ListRoles_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MultiAgentServiceServer).ListRoles(ctx, req.(*ListRolesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // MultiAgentService_ServiceDesc is the grpc.ServiceDesc for MultiAgentService service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -163,6 +245,14 @@ var MultiAgentService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "ClassifyRole",
 			Handler:    _MultiAgentService_ClassifyRole_Handler,
 		},
+		{
+			MethodName: "SuggestSkills",
+			Handler:    _MultiAgentService_SuggestSkills_Handler,
+		},
+		{
+			MethodName: "ListRoles",
+			Handler:    _MultiAgentService_ListRoles_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "multiagent_api/multiagent.proto",
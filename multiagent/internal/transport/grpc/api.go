@@ -19,6 +19,8 @@ import (
 type service interface {
 	GenerateDecision(ctx context.Context, req domain.DecisionRequest) (*domain.DecisionResponse, error)
 	ClassifyRole(ctx context.Context, req domain.RoleClassifyRequest) (*domain.RoleClassifyResponse, error)
+	SuggestSkills(ctx context.Context, req domain.SkillSuggestRequest) (*domain.SkillSuggestResponse, error)
+	ListRoles(ctx context.Context) []string
 }
 
 type MultiAgentServiceAPI struct {
@@ -25,6 +25,11 @@ func pbToDomainRequest(req *pb.GenerateDecisionRequest) domain.DecisionRequest {
 		VacancyMustHave:   req.GetVacancyMustHave(),
 		VacancyNiceToHave: req.GetVacancyNiceToHave(),
 		ResumeText:        req.GetResumeText(),
+
+		VacancySeniority:      req.GetVacancySeniority(),
+		VacancySalaryMin:      req.GetVacancySalaryMin(),
+		VacancySalaryMax:      req.GetVacancySalaryMax(),
+		VacancySalaryCurrency: req.GetVacancySalaryCurrency(),
 	}
 }
 
@@ -74,3 +79,30 @@ func domainToPBClassifyResponse(resp *domain.RoleClassifyResponse) *pb.ClassifyR
 	}
 	return &pb.ClassifyRoleResponse{Role: resp.Role}
 }
+
+func pbToDomainSuggestRequest(req *pb.SuggestSkillsRequest) domain.SkillSuggestRequest {
+	if req == nil {
+		return domain.SkillSuggestRequest{}
+	}
+	return domain.SkillSuggestRequest{
+		Title:       req.GetTitle(),
+		Description: req.GetDescription(),
+		MaxSkills:   int(req.GetMaxSkills()),
+	}
+}
+
+func domainToPBSuggestResponse(resp *domain.SkillSuggestResponse) *pb.SuggestSkillsResponse {
+	if resp == nil {
+		return &pb.SuggestSkillsResponse{}
+	}
+	skills := make([]*pb.SuggestedSkill, 0, len(resp.Skills))
+	for _, s := range resp.Skills {
+		skills = append(skills, &pb.SuggestedSkill{
+			Name:       s.Name,
+			Weight:     s.Weight,
+			MustHave:   s.MustHave,
+			NiceToHave: s.NiceToHave,
+		})
+	}
+	return &pb.SuggestSkillsResponse{Skills: skills}
+}
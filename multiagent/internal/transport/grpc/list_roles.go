@@ -0,0 +1,13 @@
+package grpc
+
+import (
+	"context"
+
+	pb "github.com/artem13815/hr/multiagent/internal/pb/multiagent_api"
+)
+
+// ListRoles exposes the classifier vocabulary. It reads the embedded prompt
+// set only, so it cannot fail.
+func (a *MultiAgentServiceAPI) ListRoles(ctx context.Context, _ *pb.ListRolesRequest) (*pb.ListRolesResponse, error) {
+	return &pb.ListRolesResponse{Roles: a.svc.ListRoles(ctx)}, nil
+}
@@ -0,0 +1,34 @@
+package grpc
+
+import (
+	"context"
+	"errors"
+	"log/slog"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	pb "github.com/artem13815/hr/multiagent/internal/pb/multiagent_api"
+	"github.com/artem13815/hr/multiagent/internal/usecase"
+)
+
+// SuggestSkills drafts a vacancy skill matrix via the LLM. Error mapping is
+// the same as ClassifyRole: Unavailable is the signal vacancy's adapter
+// keys its keyword fallback off.
+func (a *MultiAgentServiceAPI) SuggestSkills(ctx context.Context, req *pb.SuggestSkillsRequest) (*pb.SuggestSkillsResponse, error) {
+	resp, err := a.svc.SuggestSkills(ctx, pbToDomainSuggestRequest(req))
+	if err != nil {
+		slog.WarnContext(ctx, "SuggestSkills failed", "err", err)
+		switch {
+		case errors.Is(err, usecase.ErrInvalidArgument):
+			return nil, status.Error(codes.InvalidArgument, "Empty suggest request.")
+		case errors.Is(err, usecase.ErrLLMUnavailable):
+			return nil, status.Error(codes.Unavailable, "LLM unavailable.")
+		case errors.Is(err, usecase.ErrLLMInvalidResponse):
+			return nil, status.Error(codes.Internal, "LLM returned invalid response.")
+		default:
+			return nil, status.Error(codes.Internal, "Internal error.")
+		}
+	}
+	return domainToPBSuggestResponse(resp), nil
+}
@@ -3,6 +3,7 @@ package usecase
 import (
 	"context"
 	"errors"
+	"slices"
 	"strings"
 	"testing"
 
@@ -170,4 +171,15 @@ func (s *ClassifyRoleSuite) TestLLMSentinelPassesThrough() {
 	assert.Assert(t, resp == nil)
 }
 
+// TestListRolesAppendsDefault: the vocabulary exposed to callers is the
+// classifier's — the prompt roles plus "default", which ClassifyRole may
+// also return.
+func (s *ClassifyRoleSuite) TestListRolesAppendsDefault() {
+	t := s.T()
+	s.prompts.ListRolesMock.Return(stableRoles)
+
+	roles := s.svc.ListRoles(t.Context())
+	assert.DeepEqual(t, roles, append(slices.Clone(stableRoles), "default"))
+}
+
 func TestClassifyRoleSuite(t *testing.T) { suite.Run(t, new(ClassifyRoleSuite)) }
@@ -118,6 +118,37 @@ func (s *GenerateDecisionSuite) TestEmptyRoleStillSelectsPrompt() {
 	assert.NilError(t, err)
 }
 
+// TestVacancyAttributesForwarded: seniority and the salary range reach the
+// model input, with the directive explaining them, when the vacancy states
+// them and stay out of both otherwise.
+func (s *GenerateDecisionSuite) TestVacancyAttributesForwarded() {
+	t := s.T()
+	withAttrs := validRequest()
+	withAttrs.VacancySeniority = "senior"
+	withAttrs.VacancySalaryMin = 300000
+	withAttrs.VacancySalaryCurrency = "RUB"
+
+	var inputs, instructions []string
+	s.prompts.GetMock.Return("PROGRAMMER PROMPT")
+	s.llm.CompleteMock.Inspect(func(_ context.Context, req domain.CompletionRequest) {
+		inputs = append(inputs, req.Input)
+		instructions = append(instructions, req.Instructions)
+	}).Return(validLLMResponse, nil)
+	s.storage.StoreDecisionMock.Return(nil)
+
+	for _, in := range []domain.DecisionRequest{withAttrs, validRequest()} {
+		_, err := s.svc.GenerateDecision(t.Context(), in)
+		assert.NilError(t, err)
+	}
+	assert.Equal(t, len(inputs), 2)
+	assert.Assert(t, strings.Contains(inputs[0], `"seniority":"senior"`), inputs[0])
+	assert.Assert(t, strings.Contains(inputs[0], `"salary":{"currency":"RUB","min":300000}`), inputs[0])
+	assert.Assert(t, !strings.Contains(inputs[1], `"seniority"`), inputs[1])
+	assert.Assert(t, !strings.Contains(inputs[1], `"salary"`), inputs[1])
+	assert.Assert(t, strings.HasSuffix(instructions[0], attributesDirective))
+	assert.Assert(t, strings.HasSuffix(instructions[1], languageDirective))
+}
+
 // TestLLMErrorPropagatesAndStorageNotCalled covers the soft-failure
 // contract: LLM error short-circuits the pipeline; storage MUST NOT
 // receive the call (no audit row for a non-decision). minimock NOT
@@ -0,0 +1,11 @@
+package usecase
+
+import "context"
+
+// ListRoles returns the closed vocabulary ClassifyRole answers from: the
+// registered prompt roles, sorted, followed by "default". Callers that let
+// users pick a role by hand validate against it, so a pinned role is always
+// one the classifier could have chosen.
+func (s *MultiAgentService) ListRoles(_ context.Context) []string {
+	return append(s.prompts.ListRoles(), defaultRole)
+}
@@ -23,6 +23,14 @@ const languageDirective = `
 - Поле hr_recommendation — это enum, оставь одно из значений как есть: "hire", "maybe", "no". Не переводи.
 - Если в резюме встречаются английские формулировки — пересказывай их по-русски, не цитируй дословно.`
 
+// attributesDirective is appended only when the vacancy states a
+// seniority level or salary, so role prompts need no per-field wording.
+const attributesDirective = `
+
+Уровень и зарплата вакансии:
+- vacancy.seniority — требуемый уровень (intern/junior/middle/senior/lead). Сравни с опытом и ролью кандидата в резюме; заметное несоответствие в любую сторону отрази в hr_rationale и учти в решении.
+- vacancy.salary — вилка вакансии (min/max, currency). Если в резюме указаны зарплатные ожидания, сравни их с вилкой и отметь расхождение в hr_rationale; если ожиданий нет — не выдумывай их.`
+
 type DecisionStorage interface {
 	StoreDecision(ctx context.Context, req domain.DecisionRequest, resp *domain.DecisionResponse) error
 }
@@ -45,6 +53,9 @@ func (s *MultiAgentService) GenerateDecision(ctx context.Context, req domain.Dec
 	}
 
 	instructions := s.prompts.Get(req.Role) + languageDirective
+	if hasVacancyAttributes(req) {
+		instructions += attributesDirective
+	}
 	input := buildInput(req)
 
 	completion, err := s.llm.Complete(ctx, domain.CompletionRequest{
@@ -70,6 +81,10 @@ func (s *MultiAgentService) GenerateDecision(ctx context.Context, req domain.Dec
 	return resp, nil
 }
 
+func hasVacancyAttributes(req domain.DecisionRequest) bool {
+	return req.VacancySeniority != "" || req.VacancySalaryMin > 0 || req.VacancySalaryMax > 0
+}
+
 func isEmptyRequest(req domain.DecisionRequest) bool {
 	return req.Model == "" &&
 		req.MatchScore == 0 &&
@@ -79,12 +94,28 @@ func isEmptyRequest(req domain.DecisionRequest) bool {
 }
 
 func buildInput(req domain.DecisionRequest) string {
+	vacancy := map[string]any{
+		"role":         req.Role,
+		"must_have":    req.VacancyMustHave,
+		"nice_to_have": req.VacancyNiceToHave,
+	}
+	// Seniority and salary are only sent when the vacancy states them, so
+	// prompts for vacancies without them see exactly the old input.
+	if req.VacancySeniority != "" {
+		vacancy["seniority"] = req.VacancySeniority
+	}
+	if req.VacancySalaryMin > 0 || req.VacancySalaryMax > 0 {
+		salary := map[string]any{"currency": req.VacancySalaryCurrency}
+		if req.VacancySalaryMin > 0 {
+			salary["min"] = req.VacancySalaryMin
+		}
+		if req.VacancySalaryMax > 0 {
+			salary["max"] = req.VacancySalaryMax
+		}
+		vacancy["salary"] = salary
+	}
 	payload := map[string]any{
-		"vacancy": map[string]any{
-			"role":         req.Role,
-			"must_have":    req.VacancyMustHave,
-			"nice_to_have": req.VacancyNiceToHave,
-		},
+		"vacancy": vacancy,
 		"candidate": map[string]any{
 			"skills":      req.CandidateSkills,
 			"summary":     req.CandidateSummary,
@@ -0,0 +1,72 @@
+package usecase
+
+import (
+	"cmp"
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/artem13815/hr/multiagent/internal/domain"
+)
+
+// suggestTemperature is low but not zero: the task is extraction, yet a
+// little freedom helps the model phrase skills the way recruiters do.
+const suggestTemperature = 0.1
+
+// suggestMaxTokens fits ~25 skills of JSON; longer answers are truncated
+// and rejected by the parser.
+const suggestMaxTokens = 800
+
+// Skill count bounds. The default keeps the matrix readable in the editor;
+// the cap keeps a pathological description from producing a wall of chips.
+const (
+	defaultSuggestedSkills = 15
+	maxSuggestedSkills     = 25
+)
+
+const suggestInstructions = `Ты помогаешь рекрутеру составить матрицу навыков вакансии. На вход — заголовок и описание вакансии на русском или английском языке.
+
+Выпиши конкретные профессиональные навыки, технологии, инструменты и знания, которые требует вакансия. Не выдумывай навыки, которых нет в тексте. Для каждого навыка укажи:
+- name — короткое общепринятое название (например "Go", "PostgreSQL", "МСФО", "Электробезопасность");
+- weight — важность от 0 до 1;
+- must_have — true, если навык обязателен;
+- nice_to_have — true, если навык желателен ("будет плюсом", "nice to have"). must_have и nice_to_have не могут быть true одновременно.
+
+Самые важные навыки — первыми. Не больше {{MAX}} навыков.
+
+Ответ строго в формате JSON, одной строкой, без markdown-обёртки и без пояснений:
+{"skills": [{"name": "...", "weight": 0.8, "must_have": true, "nice_to_have": false}]}
+
+Никакого текста до или после JSON.`
+
+// SuggestSkills asks the LLM for a draft skill matrix. Returns
+// ErrInvalidArgument if both fields are empty, ErrLLMUnavailable if the
+// provider call fails and ErrLLMInvalidResponse if the answer does not
+// parse or contains no usable skill. Individual malformed entries are
+// dropped rather than failing the whole answer — see parseSkillSuggestions.
+func (s *MultiAgentService) SuggestSkills(ctx context.Context, req domain.SkillSuggestRequest) (*domain.SkillSuggestResponse, error) {
+	if strings.TrimSpace(req.Title) == "" && strings.TrimSpace(req.Description) == "" {
+		return nil, ErrInvalidArgument
+	}
+	req.MaxSkills = min(cmp.Or(max(req.MaxSkills, 0), defaultSuggestedSkills), maxSuggestedSkills)
+
+	completion, err := s.llm.Complete(ctx, domain.CompletionRequest{
+		Instructions:    strings.Replace(suggestInstructions, "{{MAX}}", fmt.Sprint(req.MaxSkills), 1),
+		Input:           fmt.Sprintf("Заголовок: %s\n\nОписание: %s", req.Title, req.Description),
+		Temperature:     suggestTemperature,
+		MaxOutputTokens: suggestMaxTokens,
+	})
+	if err != nil {
+		if errors.Is(err, ErrLLMUnavailable) {
+			return nil, err
+		}
+		return nil, fmt.Errorf("%w: %v", ErrLLMUnavailable, err)
+	}
+
+	skills, err := parseSkillSuggestions(completion, req.MaxSkills)
+	if err != nil {
+		return nil, err
+	}
+	return &domain.SkillSuggestResponse{Skills: skills}, nil
+}
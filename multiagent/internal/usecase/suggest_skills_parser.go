@@ -0,0 +1,65 @@
+package usecase
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+	"unicode/utf8"
+
+	"github.com/artem13815/hr/multiagent/internal/domain"
+)
+
+// maxSuggestedNameRunes matches vacancy_skills.name.
+const maxSuggestedNameRunes = 64
+
+// skillSuggestions is the wire-shape of the suggester's JSON output.
+type skillSuggestions struct {
+	Skills []struct {
+		Name       string  `json:"name"`
+		Weight     float32 `json:"weight"`
+		MustHave   bool    `json:"must_have"`
+		NiceToHave bool    `json:"nice_to_have"`
+	} `json:"skills"`
+}
+
+// parseSkillSuggestions turns the model's text into at most limit skills.
+// Entries with an empty or over-long name, a weight outside [0, 1] or a
+// case-insensitive duplicate name are dropped; an entry flagged both
+// must-have and nice-to-have keeps must-have. Returns ErrLLMInvalidResponse
+// when the JSON is malformed or nothing survives.
+func parseSkillSuggestions(completion string, limit int) ([]domain.SuggestedSkill, error) {
+	var d skillSuggestions
+	if err := json.Unmarshal([]byte(stripJSONFences(completion)), &d); err != nil {
+		return nil, fmt.Errorf("%w: suggest unmarshal: %v", ErrLLMInvalidResponse, err)
+	}
+
+	out := make([]domain.SuggestedSkill, 0, min(len(d.Skills), limit))
+	seen := make(map[string]struct{}, len(d.Skills))
+	for _, sk := range d.Skills {
+		if len(out) == limit {
+			break
+		}
+		name := strings.TrimSpace(sk.Name)
+		if name == "" || utf8.RuneCountInString(name) > maxSuggestedNameRunes {
+			continue
+		}
+		if sk.Weight < 0 || sk.Weight > 1 {
+			continue
+		}
+		key := strings.ToLower(name)
+		if _, dup := seen[key]; dup {
+			continue
+		}
+		seen[key] = struct{}{}
+		out = append(out, domain.SuggestedSkill{
+			Name:       name,
+			Weight:     sk.Weight,
+			MustHave:   sk.MustHave,
+			NiceToHave: sk.NiceToHave && !sk.MustHave,
+		})
+	}
+	if len(out) == 0 {
+		return nil, fmt.Errorf("%w: no usable skills", ErrLLMInvalidResponse)
+	}
+	return out, nil
+}
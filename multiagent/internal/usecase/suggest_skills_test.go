@@ -0,0 +1,106 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+	"gotest.tools/v3/assert"
+
+	"github.com/artem13815/hr/multiagent/internal/domain"
+)
+
+type SuggestSkillsSuite struct{ baseSuite }
+
+func validSuggestRequest() domain.SkillSuggestRequest {
+	return domain.SkillSuggestRequest{
+		Title:       "Go backend developer",
+		Description: "Требования: Go, PostgreSQL. Будет плюсом: Kubernetes.",
+	}
+}
+
+func (s *SuggestSkillsSuite) TestSuccess() {
+	t := s.T()
+	s.llm.CompleteMock.Inspect(func(_ context.Context, req domain.CompletionRequest) {
+		assert.Assert(t, strings.Contains(req.Instructions, "Не больше 15 навыков"),
+			"default cap must be rendered into the prompt, got: %s", req.Instructions)
+		assert.Equal(t, req.Temperature, float32(suggestTemperature))
+		assert.Equal(t, req.MaxOutputTokens, suggestMaxTokens)
+		assert.Assert(t, strings.Contains(req.Input, "Go backend developer"))
+	}).Return(`{"skills": [
+		{"name": "Go", "weight": 0.9, "must_have": true},
+		{"name": " PostgreSQL ", "weight": 0.7, "must_have": true},
+		{"name": "Kubernetes", "weight": 0.3, "nice_to_have": true}
+	]}`, nil)
+
+	resp, err := s.svc.SuggestSkills(t.Context(), validSuggestRequest())
+	assert.NilError(t, err)
+	assert.DeepEqual(t, resp.Skills, []domain.SuggestedSkill{
+		{Name: "Go", Weight: 0.9, MustHave: true},
+		{Name: "PostgreSQL", Weight: 0.7, MustHave: true},
+		{Name: "Kubernetes", Weight: 0.3, NiceToHave: true},
+	})
+}
+
+// TestDropsInvalidEntries: out-of-range weights, blank names and duplicates
+// are dropped one by one; both flags set keeps must-have; the cap applies
+// to what survives.
+func (s *SuggestSkillsSuite) TestDropsInvalidEntries() {
+	t := s.T()
+	s.llm.CompleteMock.Return("```json\n"+`{"skills": [
+		{"name": "Go", "weight": 1.5, "must_have": true},
+		{"name": "", "weight": 0.5},
+		{"name": "Docker", "weight": -0.1},
+		{"name": "PostgreSQL", "weight": 0.6, "must_have": true, "nice_to_have": true},
+		{"name": "postgresql", "weight": 0.4},
+		{"name": "Redis", "weight": 0.2, "nice_to_have": true},
+		{"name": "Kafka", "weight": 0.2}
+	]}`+"\n```", nil)
+
+	req := validSuggestRequest()
+	req.MaxSkills = 2
+	resp, err := s.svc.SuggestSkills(t.Context(), req)
+	assert.NilError(t, err)
+	assert.DeepEqual(t, resp.Skills, []domain.SuggestedSkill{
+		{Name: "PostgreSQL", Weight: 0.6, MustHave: true},
+		{Name: "Redis", Weight: 0.2, NiceToHave: true},
+	})
+}
+
+func (s *SuggestSkillsSuite) TestNothingUsableRejected() {
+	t := s.T()
+	s.llm.CompleteMock.Return(`{"skills": [{"name": "Go", "weight": 7}]}`, nil)
+
+	resp, err := s.svc.SuggestSkills(t.Context(), validSuggestRequest())
+	assert.ErrorIs(t, err, ErrLLMInvalidResponse)
+	assert.Assert(t, resp == nil)
+}
+
+func (s *SuggestSkillsSuite) TestMalformedJSONRejected() {
+	t := s.T()
+	s.llm.CompleteMock.Return("Go, PostgreSQL, Kubernetes", nil)
+
+	resp, err := s.svc.SuggestSkills(t.Context(), validSuggestRequest())
+	assert.ErrorIs(t, err, ErrLLMInvalidResponse)
+	assert.Assert(t, resp == nil)
+}
+
+func (s *SuggestSkillsSuite) TestEmptyInputRejected() {
+	t := s.T()
+	resp, err := s.svc.SuggestSkills(t.Context(), domain.SkillSuggestRequest{Title: " ", Description: "\n"})
+	assert.ErrorIs(t, err, ErrInvalidArgument)
+	assert.Assert(t, resp == nil)
+}
+
+func (s *SuggestSkillsSuite) TestLLMErrorWrappedAsUnavailable() {
+	t := s.T()
+	s.llm.CompleteMock.Return("", errors.New("yandex: 429"))
+
+	resp, err := s.svc.SuggestSkills(t.Context(), validSuggestRequest())
+	assert.ErrorIs(t, err, ErrLLMUnavailable)
+	assert.Assert(t, resp == nil)
+}
+
+func TestSuggestSkillsSuite(t *testing.T) { suite.Run(t, new(SuggestSkillsSuite)) }
@@ -8,6 +8,7 @@ import (
 
 	"github.com/artem13815/hr/resume/config"
 	"github.com/artem13815/hr/resume/internal/bootstrap"
+	"github.com/artem13815/hr/resume/internal/infrastructure/analysis_client"
 	"github.com/artem13815/hr/resume/internal/infrastructure/auth_client"
 )
 
@@ -30,18 +31,41 @@ func run() error {
 	if err != nil {
 		return err
 	}
-	service := bootstrap.InitResumeService(storage)
-	api := bootstrap.InitResumeServiceAPI(service)
+
+	blobs, err := bootstrap.InitBlobStore(cfg)
+	if err != nil {
+		storage.Close()
+		return err
+	}
+
+	analysisClient, analysisCleanup, err := analysis_client.New(cfg)
+	if err != nil {
+		storage.Close()
+		return err
+	}
+
+	rdb := bootstrap.InitRedis(cfg)
+	redisCleanup := func() {
+		if err := rdb.Close(); err != nil {
+			slog.Warn("close redis client", "err", err)
+		}
+	}
+	applyIPLimiter, applyMailLimiter := bootstrap.InitApplyRateLimiters(rdb, cfg)
+
+	service := bootstrap.InitResumeService(storage, blobs, analysisClient, analysisClient)
+	api := bootstrap.InitResumeServiceAPI(service, applyIPLimiter, applyMailLimiter)
 
 	authClient, authCleanup, err := auth_client.New(cfg)
 	if err != nil {
+		redisCleanup()
+		analysisCleanup()
 		storage.Close()
 		return err
 	}
 
-	// Hooks run LIFO during shutdown — close the auth conn before the
+	// Hooks run LIFO during shutdown — close the client conns before the
 	// pgxpool, mirroring construction order.
-	return bootstrap.AppRun(api, authClient, cfg, storage.Close, authCleanup)
+	return bootstrap.AppRun(api, authClient, cfg, storage.Close, analysisCleanup, redisCleanup, authCleanup)
 }
 
 func defaultConfigPathByEnv(appEnv string) string {
@@ -0,0 +1,125 @@
+// Command `blobs` is the operational CLI for resume file storage. Resumes
+// uploaded before the blob store keep their bytes in resumes.file_data;
+// `migrate` copies them to the configured blob store and clears the column,
+// shrinking the shared `hr` database and its backups.
+//
+// Usage:
+//
+//	blobs migrate [--batch=50]   move legacy BYTEA files to the blob store
+//
+// Reuses resume's config and bootstrap, so it writes to the same blob store
+// as the running service. Safe to interrupt and rerun, and to run while the
+// service is up:
+//
+//	docker exec hr-resume blobs migrate
+//
+// (or `make resume-migrate-blobs` from the repo root.)
+package main
+
+import (
+	"cmp"
+	"context"
+	"flag"
+	"fmt"
+	"log/slog"
+	"os"
+	"os/signal"
+	"syscall"
+
+	"github.com/artem13815/hr/resume/config"
+	"github.com/artem13815/hr/resume/internal/bootstrap"
+	"github.com/artem13815/hr/resume/internal/usecase"
+)
+
+func main() {
+	if len(os.Args) < 2 {
+		usage()
+		os.Exit(2)
+	}
+
+	sub := os.Args[1]
+	args := os.Args[2:]
+
+	var err error
+	switch sub {
+	case "migrate":
+		err = migrate(args)
+	case "-h", "--help", "help":
+		usage()
+		return
+	default:
+		usage()
+		os.Exit(2)
+	}
+
+	if err != nil {
+		slog.Error(sub+" failed", "err", err)
+		os.Exit(1)
+	}
+}
+
+func usage() {
+	fmt.Fprintln(os.Stderr, `resume blobs CLI — manage where resume files are stored.
+
+Subcommands:
+  migrate [--batch=50]   move files still kept in resumes.file_data to the
+                         configured blob store and clear the column
+
+Reads config the same way resume-service does:
+  configPath env > APP_ENV=prod → config.docker.prod.yaml > dev fallback.
+
+Run inside the running resume container:
+  docker exec hr-resume blobs migrate
+
+To see how many files are left:
+  docker exec hr-postgres psql -U admin -d hr -c \
+    "SELECT count(*) FROM resumes WHERE file_data IS NOT NULL;"`)
+}
+
+func migrate(args []string) error {
+	fs := flag.NewFlagSet("migrate", flag.ContinueOnError)
+	batch := fs.Int("batch", usecase.DefaultMigrateBlobsBatch, "resumes read per round trip")
+	if err := fs.Parse(args); err != nil {
+		return err
+	}
+
+	configPath := cmp.Or(os.Getenv("configPath"), defaultConfigPathByEnv(os.Getenv("APP_ENV")))
+	cfg, err := config.LoadConfig(configPath)
+	if err != nil {
+		return fmt.Errorf("load config %q: %w", configPath, err)
+	}
+	storage, err := bootstrap.InitPGStorage(cfg)
+	if err != nil {
+		return err
+	}
+	defer storage.Close()
+	blobs, err := bootstrap.InitBlobStore(cfg)
+	if err != nil {
+		return err
+	}
+	// The migration never starts or purges an analysis.
+	service := bootstrap.InitResumeService(storage, blobs, nil, nil)
+
+	// Ctrl-C stops between files; a rerun picks up from there.
+	ctx, stop := signal.NotifyContext(context.Background(), os.Interrupt, syscall.SIGTERM)
+	defer stop()
+
+	res, err := service.MigrateLegacyBlobs(ctx, *batch)
+	if res != nil {
+		fmt.Printf("moved %d files (%d bytes), skipped %d\n", res.Moved, res.Bytes, res.Skipped)
+	}
+	if err != nil {
+		return err
+	}
+	fmt.Println("✓ no files left in resumes.file_data")
+	return nil
+}
+
+func defaultConfigPathByEnv(env string) string {
+	switch env {
+	case "prod", "production":
+		return "./config.docker.prod.yaml"
+	default:
+		return "./config.docker.dev.yaml"
+	}
+}
@@ -8,9 +8,63 @@ import (
 )
 
 type Config struct {
-	Database DatabaseConfig `yaml:"database"`
-	Server   ServerConfig   `yaml:"server"`
-	Auth     AuthConfig     `yaml:"auth"`
+	Database    DatabaseConfig    `yaml:"database"`
+	Server      ServerConfig      `yaml:"server"`
+	Auth        AuthConfig        `yaml:"auth"`
+	Analysis    AnalysisConfig    `yaml:"analysis"`
+	Redis       RedisConfig       `yaml:"redis"`
+	PublicApply PublicApplyConfig `yaml:"public_apply"`
+	BlobStore   BlobStoreConfig   `yaml:"blob_store"`
+}
+
+// Blob store drivers.
+const (
+	BlobDriverFS = "fs"
+	BlobDriverS3 = "s3"
+)
+
+// BlobStoreConfig picks where resume files are kept: "fs" writes them
+// under FS.Root, "s3" to an S3-compatible bucket (MinIO in
+// docker-compose).
+type BlobStoreConfig struct {
+	Driver string       `yaml:"driver"`
+	FS     BlobFSConfig `yaml:"fs"`
+	S3     BlobS3Config `yaml:"s3"`
+}
+
+type BlobFSConfig struct {
+	Root string `yaml:"root"`
+}
+
+type BlobS3Config struct {
+	Endpoint  string `yaml:"endpoint"`
+	Region    string `yaml:"region"`
+	Bucket    string `yaml:"bucket"`
+	AccessKey string `yaml:"access_key"`
+	SecretKey string `yaml:"secret_key"`
+	UseSSL    bool   `yaml:"use_ssl"`
+}
+
+// AnalysisConfig points at the analysis gRPC service. Resume calls
+// StartApplicationAnalysis after storing a public application.
+type AnalysisConfig struct {
+	GRPCAddr string `yaml:"grpc_addr"`
+}
+
+// RedisConfig backs the public apply rate limiter (same fixed-window
+// limiter as auth uses for login/register).
+type RedisConfig struct {
+	Host     string `yaml:"host"`
+	Port     int    `yaml:"port"`
+	Password string `yaml:"password"`
+	DB       int    `yaml:"db"`
+}
+
+// PublicApplyConfig caps unauthenticated applications per hour. 0 disables
+// the corresponding bucket.
+type PublicApplyConfig struct {
+	RateLimitPerIPPerHour    int `yaml:"rate_limit_per_ip_per_hour"`
+	RateLimitPerEmailPerHour int `yaml:"rate_limit_per_email_per_hour"`
 }
 
 // AuthConfig points at the auth gRPC service. Resume calls
@@ -55,6 +109,12 @@ func (t TLSConfig) Enabled() bool {
 	return t.CertFile != "" && t.KeyFile != ""
 }
 
+const (
+	envRedisPassword = "RESUME_REDIS_PASSWORD"
+	envS3AccessKey   = "RESUME_S3_ACCESS_KEY"
+	envS3SecretKey   = "RESUME_S3_SECRET_KEY"
+)
+
 func LoadConfig(filename string) (*Config, error) {
 	data, err := os.ReadFile(filename)
 	if err != nil {
@@ -66,6 +126,16 @@ func LoadConfig(filename string) (*Config, error) {
 		return nil, fmt.Errorf("failed to unmarshal YAML: %w", err)
 	}
 
+	if v := os.Getenv(envRedisPassword); v != "" {
+		cfg.Redis.Password = v
+	}
+	if v := os.Getenv(envS3AccessKey); v != "" {
+		cfg.BlobStore.S3.AccessKey = v
+	}
+	if v := os.Getenv(envS3SecretKey); v != "" {
+		cfg.BlobStore.S3.SecretKey = v
+	}
+
 	if err := cfg.validate(); err != nil {
 		return nil, fmt.Errorf("invalid config %s: %w", filename, err)
 	}
@@ -82,6 +152,10 @@ func (c *Config) validate() error {
 		return fmt.Errorf("server.grpc_addr is required")
 	case c.Auth.GRPCAddr == "":
 		return fmt.Errorf("auth.grpc_addr is required")
+	case c.Analysis.GRPCAddr == "":
+		return fmt.Errorf("analysis.grpc_addr is required")
+	case c.Redis.Host == "":
+		return fmt.Errorf("redis.host is required")
 	case c.Database.Host == "":
 		return fmt.Errorf("database.host is required")
 	case c.Database.Port == 0:
@@ -89,5 +163,18 @@ func (c *Config) validate() error {
 	case c.Database.DBName == "":
 		return fmt.Errorf("database.name is required")
 	}
+
+	switch c.BlobStore.Driver {
+	case BlobDriverFS:
+		if c.BlobStore.FS.Root == "" {
+			return fmt.Errorf("blob_store.fs.root is required")
+		}
+	case BlobDriverS3:
+		if c.BlobStore.S3.Endpoint == "" || c.BlobStore.S3.Bucket == "" {
+			return fmt.Errorf("blob_store.s3.endpoint and blob_store.s3.bucket are required")
+		}
+	default:
+		return fmt.Errorf("blob_store.driver must be %q or %q", BlobDriverFS, BlobDriverS3)
+	}
 	return nil
 }
@@ -0,0 +1,46 @@
+package bootstrap
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/artem13815/hr/resume/config"
+	"github.com/artem13815/hr/resume/internal/infrastructure/blobstore"
+	"github.com/artem13815/hr/resume/internal/usecase"
+)
+
+// blobInitTimeout bounds the bucket check of the S3 adapter, so an
+// unreachable endpoint fails the boot instead of hanging it.
+const blobInitTimeout = 30 * time.Second
+
+// InitBlobStore builds the adapter cfg.BlobStore.Driver names; the driver
+// is validated by config.LoadConfig. The S3 adapter checks the bucket at
+// boot.
+func InitBlobStore(cfg *config.Config) (usecase.BlobStore, error) {
+	switch cfg.BlobStore.Driver {
+	case config.BlobDriverS3:
+		ctx, cancel := context.WithTimeout(context.Background(), blobInitTimeout)
+		defer cancel()
+
+		s3 := cfg.BlobStore.S3
+		store, err := blobstore.NewS3(ctx, blobstore.S3Options{
+			Endpoint:  s3.Endpoint,
+			Region:    s3.Region,
+			Bucket:    s3.Bucket,
+			AccessKey: s3.AccessKey,
+			SecretKey: s3.SecretKey,
+			UseSSL:    s3.UseSSL,
+		})
+		if err != nil {
+			return nil, fmt.Errorf("init blob store: %w", err)
+		}
+		return store, nil
+	default:
+		store, err := blobstore.NewLocal(cfg.BlobStore.FS.Root)
+		if err != nil {
+			return nil, fmt.Errorf("init blob store: %w", err)
+		}
+		return store, nil
+	}
+}
@@ -0,0 +1,25 @@
+package bootstrap
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/redis/go-redis/v9"
+
+	"github.com/artem13815/hr/resume/config"
+	"github.com/artem13815/hr/resume/internal/infrastructure/rate_limit"
+)
+
+func InitRedis(cfg *config.Config) *redis.Client {
+	return redis.NewClient(&redis.Options{
+		Addr:     fmt.Sprintf("%s:%d", cfg.Redis.Host, cfg.Redis.Port),
+		Password: cfg.Redis.Password,
+		DB:       cfg.Redis.DB,
+	})
+}
+
+func InitApplyRateLimiters(rdb *redis.Client, cfg *config.Config) (ipLimiter, emailLimiter *rate_limit.Limiter) {
+	window := time.Hour
+	return rate_limit.New(rdb, "apply_ip", cfg.PublicApply.RateLimitPerIPPerHour, window),
+		rate_limit.New(rdb, "apply_email", cfg.PublicApply.RateLimitPerEmailPerHour, window)
+}
@@ -5,6 +5,6 @@ import (
 	"github.com/artem13815/hr/resume/internal/usecase"
 )
 
-func InitResumeServiceAPI(service *usecase.ResumeService) *transport_grpc.ResumeServiceAPI {
-	return transport_grpc.NewResumeServiceAPI(service)
+func InitResumeServiceAPI(service *usecase.ResumeService, applyIPLimiter, applyMailLimiter transport_grpc.RateLimiter) *transport_grpc.ResumeServiceAPI {
+	return transport_grpc.NewResumeServiceAPI(service, applyIPLimiter, applyMailLimiter)
 }
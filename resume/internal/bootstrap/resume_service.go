@@ -7,6 +7,6 @@ import (
 	"github.com/artem13815/hr/resume/internal/usecase"
 )
 
-func InitResumeService(storage *persistence.ResumeStorage) *usecase.ResumeService {
-	return usecase.NewResumeService(storage, extractor.New(), profile.New())
+func InitResumeService(storage *persistence.ResumeStorage, blobs usecase.BlobStore, analyzer usecase.ApplicationAnalyzer, analyses usecase.AnalysisPurger) *usecase.ResumeService {
+	return usecase.NewResumeService(storage, blobs, extractor.New(), profile.New(), analyzer, analyses)
 }
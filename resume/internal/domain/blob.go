@@ -0,0 +1,16 @@
+package domain
+
+// LegacyResumeBlob is a resume whose file bytes still live in
+// resumes.file_data, written before files moved to the blob store.
+type LegacyResumeBlob struct {
+	ResumeID string
+	Data     []byte
+}
+
+// MigrateBlobsResult counts what MigrateLegacyBlobs did. Skipped resumes
+// were deleted or migrated by someone else between the read and the move.
+type MigrateBlobsResult struct {
+	Moved   uint32
+	Skipped uint32
+	Bytes   uint64
+}
@@ -21,6 +21,14 @@ func SourceFor(vacancyID string) string {
 	return "resume_auto"
 }
 
+// SourcePublicApply marks candidates who applied themselves through the
+// public apply form rather than being added by HR.
+const SourcePublicApply = "public_apply"
+
+// ClosedVacancyStatuses are the vacancies.status values (owned by the
+// vacancy service) for which no new candidates may be created.
+var ClosedVacancyStatuses = []string{"closed", "filled", "archived"}
+
 // BelongsTo reports whether this candidate is owned by userID, or whether
 // the caller has admin privileges. Encapsulates the access-control rule so
 // transport / use case never need to inspect OwnerUserID + role separately.
@@ -22,7 +22,10 @@ type Resume struct {
 	FileSizeBytes uint64
 	StoragePath   string
 	ExtractedText string
-	CreatedAt     time.Time
+	// ExtractionMethod is how ExtractedText was obtained; empty for
+	// resumes stored before it was recorded.
+	ExtractionMethod ExtractionMethod
+	CreatedAt        time.Time
 }
 
 type CreateCandidateInput struct {
@@ -33,6 +36,9 @@ type CreateCandidateInput struct {
 	Phone         string
 	Source        string
 	Comment       string
+	// ConsentedAt is when the candidate agreed to personal-data processing.
+	// Set only for public applications; nil for candidates HR adds.
+	ConsentedAt *time.Time
 }
 
 type GetCandidateInput struct {
@@ -48,7 +54,12 @@ type UploadResumeInput struct {
 	FileName      string
 	FileType      string
 	ExtractedText string
-	Data          []byte
+	// ExtractionMethod is how ExtractedText was obtained.
+	ExtractionMethod ExtractionMethod
+	Data             []byte
+	// StoragePath is the blob store key Data was written under; storage
+	// keeps only the key and the size.
+	StoragePath string
 }
 
 type GetResumeInput struct {
@@ -70,10 +81,15 @@ type DownloadResumeInput struct {
 // bits needed to write a file response (filename + content-type + bytes).
 // Distinct from Resume so callers can't accidentally surface the full
 // extracted text alongside the blob.
+//
+// Storage fills Data only for legacy rows that still keep the bytes in
+// resumes.file_data; otherwise Data is nil and StoragePath is the blob
+// store key the usecase reads the bytes from.
 type ResumeFile struct {
-	FileName string
-	FileType string
-	Data     []byte
+	FileName    string
+	FileType    string
+	Data        []byte
+	StoragePath string
 }
 
 // DeleteCandidateInput drives the destructive remove path. The DB schema
@@ -86,9 +102,34 @@ type DeleteCandidateInput struct {
 	CandidateID   string
 }
 
+// TransferCandidateOwnershipInput moves up to Limit candidates added by
+// FromUserID to ToUserID. Admin only: it is one batch of the admin
+// service's TransferOwnership.
+type TransferCandidateOwnershipInput struct {
+	IsAdmin    bool
+	FromUserID uint64
+	ToUserID   uint64
+	Limit      uint32
+}
+
+// PurgeVacancyCandidatesResult counts what PurgeVacancyCandidates removed.
+// BlobPaths are the blob store keys of the removed resumes, for the usecase
+// to delete; they are not reported to the caller. Analyses is filled in by
+// the usecase from the analysis service.
+type PurgeVacancyCandidatesResult struct {
+	Candidates uint32
+	Resumes    uint32
+	Analyses   uint32
+	BlobPaths  []string
+}
+
+// CreateCandidateFromResumeInput is a resume to build a candidate from.
+// FileName is optional and only helps format detection; the JSON route
+// does not carry one.
 type CreateCandidateFromResumeInput struct {
 	RequestUserID uint64
 	VacancyID     string
+	FileName      string
 	FileData      []byte
 }
 
@@ -99,7 +140,23 @@ type NewResumeData struct {
 	FileName      string
 	FileType      string
 	ExtractedText string
-	Data          []byte
+	// ExtractionMethod is how ExtractedText was obtained.
+	ExtractionMethod ExtractionMethod
+	Data             []byte
+	// StoragePath is the blob store key Data was written under.
+	StoragePath string
+}
+
+// ApplyToVacancyInput is a public application: the candidate fills in the
+// form on the career site themselves, so there is no request user. The
+// candidate lands with the owner of the (public, open) vacancy.
+type ApplyToVacancyInput struct {
+	VacancyID string
+	FullName  string
+	Email     string
+	Consent   bool
+	FileName  string
+	FileData  []byte
 }
 
 type CandidateResumeResult struct {
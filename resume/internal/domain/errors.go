@@ -8,3 +8,7 @@ import "errors"
 // anti-pattern). Service layer maps it onto its own ErrNotFound when it
 // needs to expose a service-level error contract.
 var ErrNotFound = errors.New("entity not found")
+
+// ErrVacancyClosed is returned when a candidate is added to a vacancy that no
+// longer accepts candidates (see ClosedVacancyStatuses).
+var ErrVacancyClosed = errors.New("vacancy is not accepting candidates")
@@ -0,0 +1,31 @@
+package domain
+
+// ExtractionMethod records which extractor produced a resume's text, so a
+// poor score can be traced back to a poor extraction — OCR of a scan or the
+// fallback PDF parser — rather than to the candidate.
+type ExtractionMethod string
+
+const (
+	// ExtractionMethodUnknown marks resumes stored before the method was
+	// recorded.
+	ExtractionMethodUnknown ExtractionMethod = ""
+	// ExtractionMethodParser is an in-process parser: TXT, DOCX, RTF, ODT,
+	// HTML, Markdown.
+	ExtractionMethodParser    ExtractionMethod = "parser"
+	ExtractionMethodPdftotext ExtractionMethod = "pdftotext"
+	// ExtractionMethodPDFParser is the in-process PDF fallback used when
+	// pdftotext is missing or fails.
+	ExtractionMethodPDFParser ExtractionMethod = "pdf_parser"
+	// ExtractionMethodOCR is pdftoppm + tesseract over a PDF whose text
+	// layer is empty or too sparse.
+	ExtractionMethodOCR      ExtractionMethod = "ocr"
+	ExtractionMethodAntiword ExtractionMethod = "antiword"
+	ExtractionMethodCatdoc   ExtractionMethod = "catdoc"
+)
+
+// TextExtraction is the extracted text of a resume file and how it was
+// obtained.
+type TextExtraction struct {
+	Text   string
+	Method ExtractionMethod
+}
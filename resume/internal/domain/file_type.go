@@ -13,6 +13,11 @@ const (
 	FileTypePDF     FileType = "pdf"
 	FileTypeDOCX    FileType = "docx"
 	FileTypeTXT     FileType = "txt"
+	FileTypeRTF     FileType = "rtf"
+	FileTypeODT     FileType = "odt"
+	FileTypeDOC     FileType = "doc"
+	FileTypeHTML    FileType = "html"
+	FileTypeMD      FileType = "md"
 )
 
 // ParseFileType normalises caller input (case, whitespace) and rejects values
@@ -27,6 +32,16 @@ func ParseFileType(s string) (FileType, bool) {
 		return FileTypeDOCX, true
 	case FileTypeTXT:
 		return FileTypeTXT, true
+	case FileTypeRTF:
+		return FileTypeRTF, true
+	case FileTypeODT:
+		return FileTypeODT, true
+	case FileTypeDOC:
+		return FileTypeDOC, true
+	case FileTypeHTML:
+		return FileTypeHTML, true
+	case FileTypeMD:
+		return FileTypeMD, true
 	default:
 		return FileTypeUnknown, false
 	}
@@ -0,0 +1,71 @@
+package analysis_client
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"time"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials/insecure"
+
+	"github.com/artem13815/hr/resume/config"
+	"github.com/artem13815/hr/resume/internal/pb/analysis_api"
+)
+
+// startTimeout bounds one StartApplicationAnalysis call. Analysis runs the
+// LLM fan-out inline (its own multiagent timeout is 45s), so this sits
+// above that with room for scoring and the database writes.
+const startTimeout = 60 * time.Second
+
+// Client is the usecase.ApplicationAnalyzer and usecase.AnalysisPurger
+// adapter.
+type Client struct {
+	client analysis_api.AnalysisServiceClient
+}
+
+// New dials the analysis service. Same lifetime rules as auth_client.New:
+// one long-lived conn, closed by the returned cleanup during shutdown.
+//
+// cfg.Analysis.GRPCAddr is already validated by config.LoadConfig.
+func New(cfg *config.Config) (*Client, func(), error) {
+	conn, err := grpc.NewClient(cfg.Analysis.GRPCAddr, grpc.WithTransportCredentials(insecure.NewCredentials()))
+	if err != nil {
+		return nil, nil, fmt.Errorf("dial analysis service: %w", err)
+	}
+	cleanup := func() {
+		if err := conn.Close(); err != nil {
+			slog.Warn("close analysis client conn", "err", err)
+		}
+	}
+	return &Client{client: analysis_api.NewAnalysisServiceClient(conn)}, cleanup, nil
+}
+
+// StartApplicationAnalysis fires the RPC in the background: the applicant's
+// request has already succeeded and must not wait for scoring. The call is
+// detached from the request context so it survives the response being
+// written. A failure only costs the automatic start — HR can still run the
+// analysis by hand — so it is logged, not retried.
+func (c *Client) StartApplicationAnalysis(ctx context.Context, resumeID string) {
+	callCtx, cancel := context.WithTimeout(context.WithoutCancel(ctx), startTimeout)
+	go func() {
+		defer cancel()
+		res, err := c.client.StartApplicationAnalysis(callCtx, &analysis_api.StartApplicationAnalysisRequest{ResumeId: resumeID})
+		if err != nil {
+			slog.Warn("start application analysis", "resume_id", resumeID, "err", err)
+			return
+		}
+		slog.Info("application analysis started", "resume_id", resumeID, "analysis_id", res.GetAnalysisId())
+	}()
+}
+
+// PurgeVacancyAnalyses deletes the analyses of a vacancy being purged.
+// Synchronous, on the caller's context: the retention job that drives it
+// retries on error.
+func (c *Client) PurgeVacancyAnalyses(ctx context.Context, vacancyID string) (uint32, error) {
+	res, err := c.client.PurgeVacancyAnalyses(ctx, &analysis_api.PurgeVacancyAnalysesRequest{VacancyId: vacancyID})
+	if err != nil {
+		return 0, err
+	}
+	return res.GetAnalyses(), nil
+}
@@ -0,0 +1,27 @@
+// Package blobstore implements usecase.BlobStore: resume file bytes live on
+// a local filesystem (LocalStore) or in an S3-compatible bucket (S3Store)
+// instead of resumes.file_data. Keys are generated here, so both adapters
+// lay files out the same way and a bucket can be seeded from a directory.
+package blobstore
+
+import (
+	"crypto/rand"
+	"encoding/hex"
+	"fmt"
+	"path"
+)
+
+// keyPrefix namespaces resume files in a bucket or directory shared with
+// other data.
+const keyPrefix = "resumes"
+
+// newKey returns resumes/<xx>/<32 hex chars>. The two-character fan-out
+// keeps any one directory of LocalStore small.
+func newKey() (string, error) {
+	b := make([]byte, 16)
+	if _, err := rand.Read(b); err != nil {
+		return "", fmt.Errorf("generate blob key: %w", err)
+	}
+	id := hex.EncodeToString(b)
+	return path.Join(keyPrefix, id[:2], id), nil
+}
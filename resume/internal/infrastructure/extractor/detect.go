@@ -4,46 +4,88 @@ import (
 	"archive/zip"
 	"bytes"
 	"errors"
+	"io"
 	"path/filepath"
 	"strings"
 	"unicode/utf8"
 )
 
+// fileTypeByExt maps the extensions candidates actually send onto file
+// types. The extension is only a hint: it settles ambiguous content (a
+// Markdown file is valid text) but never overrides a magic-byte mismatch.
+var fileTypeByExt = map[string]string{
+	"pdf":      "pdf",
+	"docx":     "docx",
+	"txt":      "txt",
+	"rtf":      "rtf",
+	"odt":      "odt",
+	"doc":      "doc",
+	"html":     "html",
+	"htm":      "html",
+	"md":       "md",
+	"markdown": "md",
+}
+
+// oleMagic opens every OLE2 compound file: legacy .doc, but also .xls and
+// .ppt — those are caught later, when the converter finds no Word stream.
+var oleMagic = []byte{0xD0, 0xCF, 0x11, 0xE0, 0xA1, 0xB1, 0x1A, 0xE1}
+
+const odtMimeType = "application/vnd.oasis.opendocument.text"
+
 func DetectFileType(fileName string, data []byte) (string, error) {
 	if len(data) == 0 {
 		return "", errors.New("empty file")
 	}
 
 	ext := strings.ToLower(strings.TrimPrefix(filepath.Ext(strings.TrimSpace(fileName)), "."))
-	if ext == "pdf" && isPDF(data) {
-		return "pdf", nil
-	}
-	if ext == "docx" && isDOCX(data) {
-		return "docx", nil
-	}
-	if ext == "txt" && isLikelyText(data) {
-		return "txt", nil
+	if byExt, ok := fileTypeByExt[ext]; ok && matchesFileType(byExt, data) {
+		return byExt, nil
 	}
 
-	if isPDF(data) {
+	switch {
+	case isPDF(data):
 		return "pdf", nil
-	}
-	if isDOCX(data) {
+	case isRTF(data):
+		return "rtf", nil
+	case isOLE(data):
+		return "doc", nil
+	case isDOCX(data):
 		return "docx", nil
-	}
-	if isLikelyText(data) {
+	case isODT(data):
+		return "odt", nil
+	case isHTML(data):
+		return "html", nil
+	case isLikelyText(data):
 		return "txt", nil
 	}
 
 	return "", errors.New("unsupported file format")
 }
 
+func matchesFileType(fileType string, data []byte) bool {
+	switch fileType {
+	case "pdf":
+		return isPDF(data)
+	case "docx":
+		return isDOCX(data)
+	case "rtf":
+		return isRTF(data)
+	case "odt":
+		return isODT(data)
+	case "doc":
+		return isOLE(data)
+	case "txt", "html", "md":
+		return isLikelyText(data)
+	}
+	return false
+}
+
 func BuildFileName(fileName, fileType string) string {
 	base := strings.TrimSpace(fileName)
 	if base == "" {
 		return "resume." + fileType
 	}
-	if strings.TrimPrefix(strings.ToLower(filepath.Ext(base)), ".") != fileType {
+	if fileTypeByExt[strings.TrimPrefix(strings.ToLower(filepath.Ext(base)), ".")] != fileType {
 		return strings.TrimSuffix(base, filepath.Ext(base)) + "." + fileType
 	}
 	return base
@@ -53,6 +95,14 @@ func isPDF(data []byte) bool {
 	return len(data) >= 5 && bytes.Equal(data[:5], []byte("%PDF-"))
 }
 
+func isRTF(data []byte) bool {
+	return bytes.HasPrefix(data, []byte(`{\rtf`))
+}
+
+func isOLE(data []byte) bool {
+	return bytes.HasPrefix(data, oleMagic)
+}
+
 func isDOCX(data []byte) bool {
 	zr, err := zip.NewReader(bytes.NewReader(data), int64(len(data)))
 	if err != nil {
@@ -66,6 +116,40 @@ func isDOCX(data []byte) bool {
 	return false
 }
 
+// isODT checks the `mimetype` entry every OpenDocument package starts with;
+// .ods and .odp share the container but not the mime type.
+func isODT(data []byte) bool {
+	zr, err := zip.NewReader(bytes.NewReader(data), int64(len(data)))
+	if err != nil {
+		return false
+	}
+	for _, f := range zr.File {
+		if f.Name != "mimetype" {
+			continue
+		}
+		rc, err := f.Open()
+		if err != nil {
+			return false
+		}
+		defer rc.Close()
+		mime, err := io.ReadAll(io.LimitReader(rc, int64(len(odtMimeType))+1))
+		return err == nil && strings.TrimSpace(string(mime)) == odtMimeType
+	}
+	return false
+}
+
+// isHTML sniffs the opening of the document only; an HTML fragment without
+// <html> or a doctype needs the .html extension to be recognised.
+func isHTML(data []byte) bool {
+	if !isLikelyText(data) {
+		return false
+	}
+	head := bytes.TrimPrefix(data, []byte("\xEF\xBB\xBF"))
+	head = bytes.TrimLeft(head[:min(len(head), 512)], " \t\r\n")
+	head = bytes.ToLower(head)
+	return bytes.HasPrefix(head, []byte("<!doctype html")) || bytes.HasPrefix(head, []byte("<html"))
+}
+
 func isLikelyText(data []byte) bool {
 	if !utf8.Valid(data) {
 		return false
@@ -0,0 +1,50 @@
+package extractor
+
+import (
+	"errors"
+	"fmt"
+	"log/slog"
+	"os"
+
+	"github.com/artem13815/hr/resume/internal/domain"
+)
+
+// extractDOCText converts legacy Word 97-2003 files with `antiword` and
+// falls back to `catdoc` (both in the Dockerfile) — antiword keeps the
+// paragraph layout better, catdoc copes with more of the odd files Word 6
+// and WordPad produce. Same converterTimeout and output cap as pdftotext.
+//
+// Neither tool reads stdin reliably, so the file goes through a temp file
+// that is removed before returning.
+func extractDOCText(data []byte) (domain.TextExtraction, error) {
+	f, err := os.CreateTemp("", "resume-*.doc")
+	if err != nil {
+		return domain.TextExtraction{}, fmt.Errorf("doc temp file: %w", err)
+	}
+	defer func() { _ = os.Remove(f.Name()) }()
+	if _, err := f.Write(data); err != nil {
+		_ = f.Close()
+		return domain.TextExtraction{}, fmt.Errorf("doc temp file: %w", err)
+	}
+	if err := f.Close(); err != nil {
+		return domain.TextExtraction{}, fmt.Errorf("doc temp file: %w", err)
+	}
+
+	// -w 0 disables antiword's line wrapping; -m UTF-8.txt picks the
+	// output mapping, the default is Latin-1.
+	text, err := runConverter(nil, "antiword", "-m", "UTF-8.txt", "-w", "0", f.Name())
+	if err == nil {
+		return domain.TextExtraction{Text: text, Method: domain.ExtractionMethodAntiword}, nil
+	}
+	if errors.Is(err, errExtractedTooLarge) {
+		return domain.TextExtraction{}, err
+	}
+	slog.Warn("antiword failed, falling back to catdoc", "err", err.Error())
+
+	// -w disables catdoc's wrapping, -d utf-8 the output charset.
+	text, err = runConverter(nil, "catdoc", "-w", "-d", "utf-8", f.Name())
+	if err != nil {
+		return domain.TextExtraction{}, err
+	}
+	return domain.TextExtraction{Text: text, Method: domain.ExtractionMethodCatdoc}, nil
+}
@@ -14,6 +14,8 @@ import (
 	"time"
 
 	pdf "github.com/ledongthuc/pdf"
+
+	"github.com/artem13815/hr/resume/internal/domain"
 )
 
 const docxDocumentXMLPath = "word/document.xml"
@@ -35,7 +37,7 @@ func (Extractor) DetectFileType(fileName string, data []byte) (string, error) {
 	return DetectFileType(fileName, data)
 }
 
-func (Extractor) ExtractText(fileType string, data []byte) (string, error) {
+func (Extractor) ExtractText(fileType string, data []byte) (domain.TextExtraction, error) {
 	return ExtractText(fileType, data)
 }
 
@@ -43,25 +45,70 @@ func (Extractor) BuildFileName(fileName, fileType string) string {
 	return BuildFileName(fileName, fileType)
 }
 
-func ExtractText(fileType string, data []byte) (string, error) {
+// ExtractText returns the text of a file of a detected type together with
+// the method that produced it. PDF and DOC go through external tools with
+// fallbacks; everything else has an in-process parser.
+func ExtractText(fileType string, data []byte) (domain.TextExtraction, error) {
+	var parse func([]byte) (string, error)
 	switch strings.ToLower(strings.TrimSpace(fileType)) {
-	case "txt":
-		return strings.TrimSpace(string(data)), nil
-	case "docx":
-		return extractDOCXText(data)
 	case "pdf":
 		return extractPDFText(data)
+	case "doc":
+		return extractDOCText(data)
+	case "txt":
+		parse = func(data []byte) (string, error) { return strings.TrimSpace(string(data)), nil }
+	case "docx":
+		parse = extractDOCXText
+	case "rtf":
+		parse = extractRTFText
+	case "odt":
+		parse = extractODTText
+	case "html":
+		parse = extractHTMLText
+	case "md":
+		parse = extractMarkdownText
 	default:
-		return "", fmt.Errorf("unsupported file type: %s", fileType)
+		return domain.TextExtraction{}, fmt.Errorf("unsupported file type: %s", fileType)
 	}
+
+	text, err := parse(data)
+	if err != nil {
+		return domain.TextExtraction{}, err
+	}
+	return domain.TextExtraction{Text: text, Method: domain.ExtractionMethodParser}, nil
 }
 
-// pdftotextTimeout caps a single extraction. Generous: a 50-page CV
-// takes ~200ms; we allow 30s as the worst plausible case before the
-// process is forcibly killed.
-const pdftotextTimeout = 30 * time.Second
+// converterTimeout caps a single run of an external converter (pdftotext,
+// antiword, catdoc); OCR has its own budget, ocrTimeout. Generous: a 50-page CV takes ~200ms; we allow 30s as
+// the worst plausible case before the process is forcibly killed.
+const converterTimeout = 30 * time.Second
+
+// extractPDFText reads the text layer and falls back to OCR when that layer
+// is missing or too sparse to be the real content — a scanned résumé, or a
+// scan with a one-line header typed over it. OCR wins only if it recovers
+// more letters than the layer had; when OCR is unavailable or fails, the
+// layer is returned as before.
+func extractPDFText(data []byte) (domain.TextExtraction, error) {
+	layer, layerErr := extractPDFTextLayer(data)
+	if layerErr == nil && !sparseText(layer.Text, pdfPageCount(data)) {
+		return layer, nil
+	}
+
+	text, err := extractViaOCR(data)
+	switch {
+	case err != nil:
+		if layerErr != nil {
+			return domain.TextExtraction{}, layerErr
+		}
+		slog.Warn("ocr failed, keeping the sparse pdf text layer", "err", err.Error())
+		return layer, nil
+	case layerErr == nil && letterCount(text) <= letterCount(layer.Text):
+		return layer, nil
+	}
+	return domain.TextExtraction{Text: text, Method: domain.ExtractionMethodOCR}, nil
+}
 
-// extractPDFText prefers the external `pdftotext` binary (poppler-utils)
+// extractPDFTextLayer prefers the external `pdftotext` binary (poppler-utils)
 // because the in-process ledongthuc/pdf cannot recover word boundaries on
 // PDFs that lack positional metadata — common in Cyrillic résumés where
 // the result is gibberish like "ЭдуардКурочкинGo-разработчик".
@@ -70,48 +117,78 @@ const pdftotextTimeout = 30 * time.Second
 // so dev environments without poppler installed still produce something.
 // The fallback is best-effort and may return joined-words; the primary
 // path is the source of truth.
-func extractPDFText(data []byte) (string, error) {
+func extractPDFTextLayer(data []byte) (domain.TextExtraction, error) {
 	if text, err := extractViaPdftotext(data); err == nil {
-		return text, nil
+		return domain.TextExtraction{Text: text, Method: domain.ExtractionMethodPdftotext}, nil
 	} else {
 		slog.Warn("pdftotext failed, falling back to in-process pdf parser",
 			"err", err.Error())
 	}
-	return extractViaLedongthuc(data)
+	text, err := extractViaLedongthuc(data)
+	if err != nil {
+		return domain.TextExtraction{}, err
+	}
+	return domain.TextExtraction{Text: text, Method: domain.ExtractionMethodPDFParser}, nil
 }
 
 // extractViaPdftotext shells out to `pdftotext - -` (stdin → stdout).
 // The `-layout` flag preserves visual columns for tabular résumés;
 // `-enc UTF-8` forces UTF-8 output regardless of the PDF's encoding.
+// `-` for input + `-` for output → stream both via stdin/stdout, no
+// temp files. -nopgbrk drops the form-feed page separators that would
+// otherwise pollute the heuristic year/skill extractors downstream.
 func extractViaPdftotext(data []byte) (string, error) {
-	ctx, cancel := context.WithTimeout(context.Background(), pdftotextTimeout)
+	return runConverter(bytes.NewReader(data), "pdftotext",
+		"-layout", "-nopgbrk", "-enc", "UTF-8", "-", "-")
+}
+
+// runConverter runs an external converter under converterTimeout and
+// returns its trimmed stdout. Output past MaxExtractedTextBytes fails the
+// write, which kills the process instead of buffering it all.
+func runConverter(stdin io.Reader, name string, args ...string) (string, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), converterTimeout)
 	defer cancel()
+	return runConverterContext(ctx, stdin, name, args...)
+}
 
-	// `-` for input + `-` for output → stream both via stdin/stdout, no
-	// temp files. -nopgbrk drops the form-feed page separators that would
-	// otherwise pollute the heuristic year/skill extractors downstream.
-	cmd := exec.CommandContext(ctx, "pdftotext",
-		"-layout", "-nopgbrk", "-enc", "UTF-8", "-", "-")
-	cmd.Stdin = bytes.NewReader(data)
+// runConverterContext is runConverter under the caller's deadline.
+func runConverterContext(ctx context.Context, stdin io.Reader, name string, args ...string) (string, error) {
+	cmd := exec.CommandContext(ctx, name, args...)
+	cmd.Stdin = stdin
 
-	var stdout bytes.Buffer
+	stdout := &limitedBuffer{limit: MaxExtractedTextBytes}
 	var stderr bytes.Buffer
-	cmd.Stdout = &stdout
+	cmd.Stdout = stdout
 	cmd.Stderr = &stderr
 	if err := cmd.Run(); err != nil {
-		return "", fmt.Errorf("pdftotext: %w (stderr=%q)", err, stderr.String())
+		if stdout.exceeded {
+			return "", errExtractedTooLarge
+		}
+		return "", fmt.Errorf("%s: %w (stderr=%q)", name, err, stderr.String())
 	}
 
-	if stdout.Len() > MaxExtractedTextBytes {
-		return "", errExtractedTooLarge
-	}
 	out := strings.TrimSpace(stdout.String())
 	if out == "" {
-		return "", errors.New("pdftotext: empty output")
+		return "", fmt.Errorf("%s: empty output", name)
 	}
 	return out, nil
 }
 
+// limitedBuffer is a bytes.Buffer that refuses to grow past limit.
+type limitedBuffer struct {
+	bytes.Buffer
+	limit    int
+	exceeded bool
+}
+
+func (b *limitedBuffer) Write(p []byte) (int, error) {
+	if b.Len()+len(p) > b.limit {
+		b.exceeded = true
+		return 0, errExtractedTooLarge
+	}
+	return b.Buffer.Write(p)
+}
+
 // extractViaLedongthuc is the fallback path used only when pdftotext is
 // unavailable. Returns whatever GetPlainText produces — often missing
 // spaces, but better than nothing while ops install poppler-utils.
@@ -134,37 +211,50 @@ func extractViaLedongthuc(data []byte) (string, error) {
 	return strings.TrimSpace(string(textBytes)), nil
 }
 
-func extractDOCXText(data []byte) (string, error) {
+// openZipEntry opens one entry of a zip-based document (DOCX, ODT) within
+// the MaxExtractedTextBytes budget. The returned reader is capped as well.
+func openZipEntry(data []byte, name string) (io.ReadCloser, error) {
 	zr, err := zip.NewReader(bytes.NewReader(data), int64(len(data)))
 	if err != nil {
-		return "", err
+		return nil, err
 	}
 
-	var documentFile *zip.File
+	var entry *zip.File
 	for _, f := range zr.File {
-		if f.Name == docxDocumentXMLPath {
-			documentFile = f
+		if f.Name == name {
+			entry = f
 			break
 		}
 	}
-	if documentFile == nil {
-		return "", errors.New("invalid docx: missing word/document.xml")
+	if entry == nil {
+		return nil, fmt.Errorf("invalid document: missing %s", name)
 	}
 
 	// Reject zip-bombs up front: refuse if the declared uncompressed size
 	// already exceeds our budget. Defense in depth follows via LimitReader below
 	// in case the header lies.
-	if documentFile.UncompressedSize64 > MaxExtractedTextBytes {
-		return "", errExtractedTooLarge
+	if entry.UncompressedSize64 > MaxExtractedTextBytes {
+		return nil, errExtractedTooLarge
 	}
 
-	rc, err := documentFile.Open()
+	rc, err := entry.Open()
+	if err != nil {
+		return nil, err
+	}
+	return struct {
+		io.Reader
+		io.Closer
+	}{io.LimitReader(rc, MaxExtractedTextBytes+1), rc}, nil
+}
+
+func extractDOCXText(data []byte) (string, error) {
+	rc, err := openZipEntry(data, docxDocumentXMLPath)
 	if err != nil {
 		return "", err
 	}
 	defer rc.Close()
 
-	decoder := xml.NewDecoder(io.LimitReader(rc, MaxExtractedTextBytes+1))
+	decoder := xml.NewDecoder(rc)
 	var b strings.Builder
 	needsSpace := false
 
@@ -207,3 +297,24 @@ func extractDOCXText(data []byte) (string, error) {
 
 	return strings.TrimSpace(b.String()), nil
 }
+
+// normalizeLines tidies the output of the markup strippers (RTF, HTML,
+// Markdown): trailing blanks go, runs of empty lines shrink to one.
+func normalizeLines(s string) string {
+	lines := strings.Split(s, "\n")
+	out := lines[:0]
+	blank := false
+	for _, line := range lines {
+		line = strings.TrimRight(line, " \t\r")
+		if line == "" {
+			if blank {
+				continue
+			}
+			blank = true
+		} else {
+			blank = false
+		}
+		out = append(out, line)
+	}
+	return strings.TrimSpace(strings.Join(out, "\n"))
+}
@@ -0,0 +1,111 @@
+package extractor
+
+import (
+	"bytes"
+	"errors"
+	"io"
+	"strings"
+
+	"golang.org/x/net/html"
+	"golang.org/x/net/html/atom"
+)
+
+// htmlSkipped elements contribute no visible text.
+var htmlSkipped = map[atom.Atom]bool{
+	atom.Head: true, atom.Script: true, atom.Style: true, atom.Noscript: true,
+	atom.Template: true, atom.Svg: true, atom.Iframe: true,
+}
+
+// htmlBlocks break the line before and after themselves.
+var htmlBlocks = map[atom.Atom]bool{
+	atom.P: true, atom.Div: true, atom.Br: true, atom.Hr: true, atom.Li: true,
+	atom.Ul: true, atom.Ol: true, atom.Dl: true, atom.Dt: true, atom.Dd: true,
+	atom.Tr: true, atom.Table: true, atom.H1: true, atom.H2: true, atom.H3: true,
+	atom.H4: true, atom.H5: true, atom.H6: true, atom.Section: true,
+	atom.Article: true, atom.Header: true, atom.Footer: true, atom.Main: true,
+	atom.Nav: true, atom.Aside: true, atom.Blockquote: true, atom.Pre: true,
+	atom.Address: true, atom.Figure: true, atom.Figcaption: true, atom.Form: true,
+}
+
+// extractHTMLText strips markup the way a browser would lay the text out:
+// block elements break lines, table cells are tab-separated, runs of
+// whitespace collapse, entities are decoded and scripts, styles and <head>
+// are dropped. Résumés exported from hh.ru and job-board "save as HTML" are
+// the usual source.
+func extractHTMLText(data []byte) (string, error) {
+	z := html.NewTokenizer(bytes.NewReader(data))
+	var b strings.Builder
+	// skipDepth counts open elements from htmlSkipped.
+	skipDepth := 0
+	lastSpace := true
+
+	atLineStart := func() bool {
+		return b.Len() == 0 || strings.HasSuffix(b.String(), "\n")
+	}
+	newline := func() {
+		if !atLineStart() {
+			b.WriteString("\n")
+		}
+		lastSpace = true
+	}
+
+	for {
+		tt := z.Next()
+		switch tt {
+		case html.ErrorToken:
+			if errors.Is(z.Err(), io.EOF) {
+				return normalizeLines(b.String()), nil
+			}
+			return "", z.Err()
+		case html.StartTagToken, html.SelfClosingTagToken:
+			name, _ := z.TagName()
+			a := atom.Lookup(name)
+			if htmlSkipped[a] {
+				if tt == html.StartTagToken {
+					skipDepth++
+				}
+				continue
+			}
+			if skipDepth > 0 {
+				continue
+			}
+			switch {
+			case htmlBlocks[a]:
+				newline()
+			case (a == atom.Td || a == atom.Th) && !atLineStart():
+				b.WriteString("\t")
+				lastSpace = true
+			}
+		case html.EndTagToken:
+			name, _ := z.TagName()
+			a := atom.Lookup(name)
+			if htmlSkipped[a] {
+				if skipDepth > 0 {
+					skipDepth--
+				}
+				continue
+			}
+			if skipDepth == 0 && htmlBlocks[a] {
+				newline()
+			}
+		case html.TextToken:
+			if skipDepth > 0 {
+				continue
+			}
+			for _, r := range string(z.Text()) {
+				if r == ' ' || r == '\t' || r == '\n' || r == '\r' || r == '\u00a0' {
+					if !lastSpace {
+						b.WriteByte(' ')
+						lastSpace = true
+					}
+					continue
+				}
+				b.WriteRune(r)
+				lastSpace = false
+			}
+		}
+		if b.Len() > MaxExtractedTextBytes {
+			return "", errExtractedTooLarge
+		}
+	}
+}
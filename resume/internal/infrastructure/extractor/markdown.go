@@ -0,0 +1,74 @@
+package extractor
+
+import (
+	"regexp"
+	"strings"
+)
+
+var (
+	mdFence      = regexp.MustCompile("^\\s*(```|~~~)")
+	mdHeading    = regexp.MustCompile(`^\s{0,3}#{1,6}\s+`)
+	mdQuote      = regexp.MustCompile(`^\s{0,3}(>\s?)+`)
+	mdListMarker = regexp.MustCompile(`^(\s*)([-*+]|\d{1,9}[.)])\s+`)
+	mdRule       = regexp.MustCompile(`^\s{0,3}(?:(?:-\s*){3,}|(?:\*\s*){3,}|(?:_\s*){3,}|(?:=\s*){3,})$`)
+	mdTableRule  = regexp.MustCompile(`^\s*\|?(\s*:?-+:?\s*\|)+\s*:?-*:?\s*$`)
+	mdImage      = regexp.MustCompile(`!\[([^\]]*)\]\([^)]*\)`)
+	mdLink       = regexp.MustCompile(`\[([^\]]+)\]\([^)]*\)`)
+	mdAutolink   = regexp.MustCompile(`<((?:https?|mailto):[^>\s]+)>`)
+	mdInlineCode = regexp.MustCompile("`([^`]*)`")
+	mdStrong     = regexp.MustCompile(`(\*\*|__|~~)(.+?)(\*\*|__|~~)`)
+	mdEmphasis   = regexp.MustCompile(`(^|[^\w*])[*_]([^*_\s][^*_]*?)[*_]([^\w*]|$)`)
+	mdInlineHTML = regexp.MustCompile(`</?[a-zA-Z][^>]*>`)
+)
+
+// extractMarkdownText drops Markdown syntax and keeps the words: headings,
+// quotes and list markers lose their prefixes, links and images keep their
+// text, emphasis and inline code lose their delimiters, table pipes become
+// tabs. Code inside fences is kept as is. Intraword underscores
+// (snake_case) are left alone.
+func extractMarkdownText(data []byte) (string, error) {
+	lines := strings.Split(strings.ReplaceAll(string(data), "\r\n", "\n"), "\n")
+	var b strings.Builder
+	inFence := false
+
+	for _, line := range lines {
+		if b.Len() > MaxExtractedTextBytes {
+			return "", errExtractedTooLarge
+		}
+		if mdFence.MatchString(line) {
+			inFence = !inFence
+			continue
+		}
+		if inFence {
+			b.WriteString(line)
+			b.WriteString("\n")
+			continue
+		}
+		if mdRule.MatchString(line) || mdTableRule.MatchString(line) {
+			continue
+		}
+
+		line = mdHeading.ReplaceAllString(line, "")
+		line = mdQuote.ReplaceAllString(line, "")
+		line = mdListMarker.ReplaceAllString(line, "$1")
+		line = mdImage.ReplaceAllString(line, "$1")
+		line = mdLink.ReplaceAllString(line, "$1")
+		line = mdAutolink.ReplaceAllString(line, "$1")
+		line = mdInlineCode.ReplaceAllString(line, "$1")
+		line = mdStrong.ReplaceAllString(line, "$2")
+		line = mdEmphasis.ReplaceAllString(line, "$1$2$3")
+		line = mdInlineHTML.ReplaceAllString(line, "")
+		if trimmed := strings.TrimSpace(line); strings.HasPrefix(trimmed, "|") {
+			cells := strings.Split(strings.Trim(trimmed, "|"), "|")
+			for i := range cells {
+				cells[i] = strings.TrimSpace(cells[i])
+			}
+			line = strings.Join(cells, "\t")
+		}
+
+		b.WriteString(line)
+		b.WriteString("\n")
+	}
+
+	return normalizeLines(b.String()), nil
+}
@@ -0,0 +1,146 @@
+package extractor
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+	"unicode"
+
+	pdf "github.com/ledongthuc/pdf"
+)
+
+const (
+	// ocrMaxPages is how many leading pages get rasterised: a résumé's
+	// substance is on its first pages, and every page costs seconds.
+	ocrMaxPages = 5
+	// ocrTimeout is the budget for the whole OCR stage — rasterising and
+	// every tesseract run. Pages finished by then are kept.
+	ocrTimeout = 2 * time.Minute
+	// ocrDPI is the resolution tesseract is tuned for; lower loses small
+	// print, higher mostly costs time.
+	ocrDPI = 300
+	// ocrLanguages are the tesseract models (tesseract-ocr-data-rus / -eng).
+	ocrLanguages = "rus+eng"
+	// ocrMinLettersPerPage is the text layer density below which a PDF
+	// counts as scanned. A typed résumé page has well over a thousand
+	// letters; a scan with a typed header, a few dozen.
+	ocrMinLettersPerPage = 100
+)
+
+// sparseText reports whether a PDF text layer is too thin to be the
+// document's real content.
+func sparseText(text string, pages int) bool {
+	return letterCount(text) < ocrMinLettersPerPage*max(pages, 1)
+}
+
+func letterCount(text string) int {
+	n := 0
+	for _, r := range text {
+		if unicode.IsLetter(r) {
+			n++
+		}
+	}
+	return n
+}
+
+// pdfPageCount is best effort: 1 when the in-process parser cannot read
+// the file (it panics on some malformed PDFs).
+func pdfPageCount(data []byte) (pages int) {
+	defer func() {
+		if recover() != nil {
+			pages = 1
+		}
+	}()
+	reader, err := pdf.NewReader(bytes.NewReader(data), int64(len(data)))
+	if err != nil {
+		return 1
+	}
+	return max(reader.NumPage(), 1)
+}
+
+// extractViaOCR rasterises the first ocrMaxPages pages with `pdftoppm`
+// (poppler-utils) and runs `tesseract` over each, all within ocrTimeout.
+// Both tools want files, so the work happens in a temp dir removed before
+// returning. A page that comes out empty or fails is skipped; running out
+// of time keeps the pages already recognised.
+func extractViaOCR(data []byte) (string, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), ocrTimeout)
+	defer cancel()
+
+	dir, err := os.MkdirTemp("", "resume-ocr-*")
+	if err != nil {
+		return "", fmt.Errorf("ocr temp dir: %w", err)
+	}
+	defer func() { _ = os.RemoveAll(dir) }()
+
+	pdfPath := filepath.Join(dir, "resume.pdf")
+	if err := os.WriteFile(pdfPath, data, 0o600); err != nil {
+		return "", fmt.Errorf("ocr temp file: %w", err)
+	}
+
+	// -gray keeps the images small; tesseract binarises them anyway.
+	cmd := exec.CommandContext(ctx, "pdftoppm",
+		"-r", strconv.Itoa(ocrDPI), "-gray", "-png",
+		"-f", "1", "-l", strconv.Itoa(ocrMaxPages),
+		pdfPath, filepath.Join(dir, "page"))
+	var stderr bytes.Buffer
+	cmd.Stderr = &stderr
+	if err := cmd.Run(); err != nil {
+		return "", fmt.Errorf("pdftoppm: %w (stderr=%q)", err, stderr.String())
+	}
+
+	// pdftoppm zero-pads page numbers to the same width within one run,
+	// so lexical order is page order.
+	images, err := filepath.Glob(filepath.Join(dir, "page-*.png"))
+	if err != nil {
+		return "", err
+	}
+	if len(images) == 0 {
+		return "", errors.New("pdftoppm: no pages rendered")
+	}
+	slices.Sort(images)
+
+	var b strings.Builder
+	var lastErr error
+	done := 0
+	for _, image := range images {
+		text, err := runConverterContext(ctx, nil, "tesseract", image, "stdout", "-l", ocrLanguages)
+		if err != nil {
+			if errors.Is(err, errExtractedTooLarge) {
+				return "", err
+			}
+			if ctx.Err() != nil {
+				slog.Warn("ocr time limit reached", "pages_done", done, "pages", len(images))
+				lastErr = ctx.Err()
+				break
+			}
+			lastErr = err
+			continue
+		}
+		if b.Len() > 0 {
+			b.WriteString("\n\n")
+		}
+		b.WriteString(text)
+		done++
+		if b.Len() > MaxExtractedTextBytes {
+			return "", errExtractedTooLarge
+		}
+	}
+
+	if b.Len() == 0 {
+		if lastErr == nil {
+			lastErr = errors.New("tesseract: empty output")
+		}
+		return "", lastErr
+	}
+	return b.String(), nil
+}
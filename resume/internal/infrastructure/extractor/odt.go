@@ -0,0 +1,102 @@
+package extractor
+
+import (
+	"encoding/xml"
+	"errors"
+	"io"
+	"strconv"
+	"strings"
+)
+
+const odtContentXMLPath = "content.xml"
+
+// extractODTText walks content.xml of an OpenDocument text. Unlike DOCX
+// runs, ODF spans split words freely ("Ива<span>нов</span>"), so character
+// data is kept verbatim and only whitespace runs are collapsed, as the ODF
+// spec does; explicit spacing comes from <text:s>, <text:tab> and
+// <text:line-break>.
+func extractODTText(data []byte) (string, error) {
+	rc, err := openZipEntry(data, odtContentXMLPath)
+	if err != nil {
+		return "", err
+	}
+	defer rc.Close()
+
+	decoder := xml.NewDecoder(rc)
+	var b strings.Builder
+	// depth counts open <text:p>/<text:h>; text outside them is styling
+	// and metadata.
+	depth := 0
+	lastSpace := true
+
+	for {
+		tok, err := decoder.Token()
+		if errors.Is(err, io.EOF) {
+			break
+		}
+		if err != nil {
+			return "", err
+		}
+
+		switch t := tok.(type) {
+		case xml.StartElement:
+			switch t.Name.Local {
+			case "p", "h":
+				if depth == 0 && b.Len() > 0 {
+					b.WriteString("\n")
+					lastSpace = true
+				}
+				depth++
+			case "tab":
+				b.WriteString("\t")
+				lastSpace = true
+			case "line-break":
+				b.WriteString("\n")
+				lastSpace = true
+			case "s":
+				b.WriteString(strings.Repeat(" ", odtSpaceCount(t)))
+				lastSpace = true
+			}
+		case xml.EndElement:
+			if (t.Name.Local == "p" || t.Name.Local == "h") && depth > 0 {
+				depth--
+			}
+		case xml.CharData:
+			if depth == 0 {
+				continue
+			}
+			for _, r := range string(t) {
+				if r == ' ' || r == '\t' || r == '\n' || r == '\r' {
+					if !lastSpace {
+						b.WriteByte(' ')
+						lastSpace = true
+					}
+					continue
+				}
+				b.WriteRune(r)
+				lastSpace = false
+			}
+		}
+		if b.Len() > MaxExtractedTextBytes {
+			return "", errExtractedTooLarge
+		}
+	}
+
+	return strings.TrimSpace(b.String()), nil
+}
+
+// odtSpaceCount reads text:c of <text:s>, the number of spaces it stands
+// for. Capped so a hostile document cannot blow up the output with one tag.
+func odtSpaceCount(el xml.StartElement) int {
+	for _, a := range el.Attr {
+		if a.Name.Local != "c" {
+			continue
+		}
+		n, err := strconv.Atoi(a.Value)
+		if err != nil || n < 1 {
+			return 1
+		}
+		return min(n, 64)
+	}
+	return 1
+}
@@ -0,0 +1,199 @@
+package extractor
+
+import (
+	"errors"
+	"strconv"
+	"strings"
+
+	"golang.org/x/text/encoding/charmap"
+)
+
+// rtfSkipDestinations are destinations that carry no body text. Their whole
+// group is dropped, as is any `{\*\...}` group the reader does not know.
+var rtfSkipDestinations = map[string]bool{
+	"fonttbl": true, "colortbl": true, "stylesheet": true, "info": true,
+	"pict": true, "object": true, "fldinst": true, "listtable": true,
+	"listoverridetable": true, "revtbl": true, "rsidtbl": true,
+	"header": true, "headerl": true, "headerr": true, "headerf": true,
+	"footer": true, "footerl": true, "footerr": true, "footerf": true,
+	"footnote": true, "xmlnstbl": true, "themedata": true,
+	"colorschememapping": true, "latentstyles": true, "datastore": true,
+	"generator": true, "bkmkstart": true, "bkmkend": true,
+}
+
+// rtfSymbols are control words that stand for a single character.
+var rtfSymbols = map[string]string{
+	"par": "\n", "line": "\n", "sect": "\n", "page": "\n", "row": "\n",
+	"tab": "\t", "cell": "\t",
+	"emdash": "—", "endash": "–", "bullet": "•",
+	"lquote": "‘", "rquote": "’", "ldblquote": "“", "rdblquote": "”",
+}
+
+// rtfCodePages maps \ansicpg values onto decoders for \'hh escapes and raw
+// 8-bit bytes. Anything else falls back to Windows-1252, the RTF default.
+var rtfCodePages = map[int]*charmap.Charmap{
+	866:   charmap.CodePage866,
+	874:   charmap.Windows874,
+	1250:  charmap.Windows1250,
+	1251:  charmap.Windows1251,
+	1252:  charmap.Windows1252,
+	1253:  charmap.Windows1253,
+	1254:  charmap.Windows1254,
+	1255:  charmap.Windows1255,
+	1256:  charmap.Windows1256,
+	1257:  charmap.Windows1257,
+	1258:  charmap.Windows1258,
+	10000: charmap.Macintosh,
+}
+
+type rtfGroup struct {
+	skip bool
+	// uc is how many fallback characters follow each \uN (\ucN).
+	uc int
+}
+
+// extractRTFText is a minimal RTF reader: it follows groups and control
+// words, drops destinations without body text, and decodes \'hh bytes with
+// the \ansicpg code page (Russian résumés are usually \ansicpg1251) and
+// \uN code points with their \ucN fallbacks skipped. Per-font charsets
+// (\fcharset) are not tracked.
+func extractRTFText(data []byte) (string, error) {
+	var b strings.Builder
+	codePage := charmap.Windows1252
+	stack := []rtfGroup{{uc: 1}}
+	// pendingSkip counts \uN fallback characters still to drop.
+	pendingSkip := 0
+
+	emit := func(s string) {
+		if stack[len(stack)-1].skip {
+			return
+		}
+		b.WriteString(s)
+	}
+	emitByte := func(c byte) {
+		if pendingSkip > 0 {
+			pendingSkip--
+			return
+		}
+		if c < 0x80 {
+			emit(string(rune(c)))
+			return
+		}
+		emit(string(codePage.DecodeByte(c)))
+	}
+
+	for i := 0; i < len(data); i++ {
+		if b.Len() > MaxExtractedTextBytes {
+			return "", errExtractedTooLarge
+		}
+		c := data[i]
+		switch c {
+		case '{':
+			stack = append(stack, stack[len(stack)-1])
+			continue
+		case '}':
+			if len(stack) > 1 {
+				stack = stack[:len(stack)-1]
+			}
+			pendingSkip = 0
+			continue
+		case '\r', '\n':
+			continue
+		case '\\':
+		default:
+			emitByte(c)
+			continue
+		}
+
+		// Control sequence.
+		i++
+		if i >= len(data) {
+			break
+		}
+		c = data[i]
+		switch {
+		case c == '\'':
+			if i+2 < len(data) {
+				if v, err := strconv.ParseUint(string(data[i+1:i+3]), 16, 8); err == nil {
+					emitByte(byte(v))
+				}
+			}
+			i += 2
+			continue
+		case c == '*':
+			stack[len(stack)-1].skip = true
+			continue
+		case c == '\\' || c == '{' || c == '}':
+			emitByte(c)
+			continue
+		case c == '~':
+			emit(" ")
+			continue
+		case c == '_':
+			emit("-")
+			continue
+		case c == '\r' || c == '\n':
+			emit("\n")
+			continue
+		case !isASCIILetter(c):
+			// \- (optional hyphen), \| and the like: nothing to print.
+			continue
+		}
+
+		start := i
+		for i < len(data) && isASCIILetter(data[i]) {
+			i++
+		}
+		word := string(data[start:i])
+		paramStart := i
+		if i < len(data) && data[i] == '-' {
+			i++
+		}
+		for i < len(data) && data[i] >= '0' && data[i] <= '9' {
+			i++
+		}
+		param, hasParam := 0, i > paramStart
+		var paramErr error
+		if hasParam {
+			param, paramErr = strconv.Atoi(string(data[paramStart:i]))
+		}
+		// A single space delimits the control word and is not text.
+		if i >= len(data) || data[i] != ' ' {
+			i--
+		}
+
+		switch {
+		case rtfSkipDestinations[word]:
+			stack[len(stack)-1].skip = true
+		case word == "ansicpg":
+			if cm, ok := rtfCodePages[param]; ok {
+				codePage = cm
+			}
+		case word == "uc" && hasParam:
+			stack[len(stack)-1].uc = max(param, 0)
+		case word == "u" && hasParam:
+			if param < 0 {
+				param += 1 << 16
+			}
+			emit(string(rune(param)))
+			pendingSkip = stack[len(stack)-1].uc
+		case word == "bin" && hasParam:
+			// Raw binary payload: skip it whole. The length is the only
+			// thing steering the cursor, so a bogus one fails the file.
+			if paramErr != nil || param < 0 {
+				return "", errors.New("invalid rtf: bad \\bin length")
+			}
+			i += min(param, len(data)-i)
+		default:
+			if s, ok := rtfSymbols[word]; ok {
+				emit(s)
+			}
+		}
+	}
+
+	return normalizeLines(b.String()), nil
+}
+
+func isASCIILetter(c byte) bool {
+	return (c >= 'a' && c <= 'z') || (c >= 'A' && c <= 'Z')
+}
@@ -0,0 +1,29 @@
+package persistence
+
+// liveVacancy holds unless the candidate's vacancy is soft-deleted.
+const liveVacancy = `NOT EXISTS (SELECT 1 FROM vacancies dv
+                WHERE dv.id = c.vacancy_id AND dv.deleted_at IS NOT NULL)`
+
+// candidateViewAccess renders the read-access predicate for the candidate
+// row aliased c: admins, whoever added the candidate, the owner of the
+// candidate's vacancy and every collaborator on it. The vacancy tables
+// belong to the vacancy service; both live in the shared hr database.
+// Candidates of a soft-deleted vacancy fail it for everyone, admins
+// included, until the vacancy is restored or purged.
+func candidateViewAccess(isAdmin, userID string) string {
+	return `(` + liveVacancy + ` AND (` + isAdmin + ` OR c.owner_user_id = ` + userID + `
+    OR EXISTS (SELECT 1 FROM vacancies v WHERE v.id = c.vacancy_id AND v.owner_user_id = ` + userID + `)
+    OR EXISTS (SELECT 1 FROM vacancy_collaborators vc
+               WHERE vc.vacancy_id = c.vacancy_id AND vc.user_id = ` + userID + `)))`
+}
+
+// candidateEditAccess is candidateViewAccess with the collaborator branch
+// narrowed to editors. Viewers fail it, so their writes surface as
+// not-found.
+func candidateEditAccess(isAdmin, userID string) string {
+	return `(` + liveVacancy + ` AND (` + isAdmin + ` OR c.owner_user_id = ` + userID + `
+    OR EXISTS (SELECT 1 FROM vacancies v WHERE v.id = c.vacancy_id AND v.owner_user_id = ` + userID + `)
+    OR EXISTS (SELECT 1 FROM vacancy_collaborators vc
+               WHERE vc.vacancy_id = c.vacancy_id AND vc.user_id = ` + userID + `
+                 AND vc.role = 'editor')))`
+}
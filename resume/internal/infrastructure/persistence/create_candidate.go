@@ -2,10 +2,17 @@ package persistence
 
 import (
 	"context"
+	"errors"
+
+	"github.com/jackc/pgx/v5"
 
 	"github.com/artem13815/hr/resume/internal/domain"
 )
 
+// CreateCandidate inserts the candidate unless the target vacancy is closed,
+// filled, archived or deleted. The guard is part of the INSERT itself, so there is no
+// separate status read to go stale. Vacancies missing from the table do not
+// block the insert, matching the behaviour before lifecycle statuses existed.
 func (s *ResumeStorage) CreateCandidate(ctx context.Context, in domain.CreateCandidateInput) (*domain.Candidate, error) {
 	id, err := newID()
 	if err != nil {
@@ -15,9 +22,10 @@ func (s *ResumeStorage) CreateCandidate(ctx context.Context, in domain.CreateCan
 	var candidate domain.Candidate
 	err = s.db.QueryRow(ctx, `
 INSERT INTO candidates (id, vacancy_id, owner_user_id, full_name, email, phone, source, comment)
-VALUES ($1, $2, $3, $4, $5, $6, $7, $8)
+SELECT $1::text, $2::text, $3::bigint, $4::text, $5::text, $6::text, $7::text, $8::text
+WHERE NOT EXISTS (SELECT 1 FROM vacancies WHERE id = $2 AND (status = ANY($9::text[]) OR deleted_at IS NOT NULL))
 RETURNING id, vacancy_id, owner_user_id, full_name, email, phone, source, comment, created_at
-`, id, in.VacancyID, in.RequestUserID, in.FullName, in.Email, in.Phone, in.Source, in.Comment).Scan(
+`, id, in.VacancyID, in.RequestUserID, in.FullName, in.Email, in.Phone, in.Source, in.Comment, domain.ClosedVacancyStatuses).Scan(
 		&candidate.ID,
 		&candidate.VacancyID,
 		&candidate.OwnerUserID,
@@ -29,6 +37,9 @@ RETURNING id, vacancy_id, owner_user_id, full_name, email, phone, source, commen
 		&candidate.CreatedAt,
 	)
 	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, domain.ErrVacancyClosed
+		}
 		return nil, err
 	}
 
@@ -12,7 +12,8 @@ import (
 
 // CreateCandidateWithResume runs both INSERTs in one transaction so a failure
 // on the resume side cannot leave a candidate row orphaned. The deferred
-// Rollback is a no-op after a successful Commit.
+// Rollback is a no-op after a successful Commit. The resume bytes are
+// already in the blob store under resumeIn.StoragePath.
 func (s *ResumeStorage) CreateCandidateWithResume(
 	ctx context.Context,
 	candidateIn domain.CreateCandidateInput,
@@ -39,8 +40,9 @@ func (s *ResumeStorage) CreateCandidateWithResume(
 
 	var candidate domain.Candidate
 	err = tx.QueryRow(ctx, `
-INSERT INTO candidates (id, vacancy_id, owner_user_id, full_name, email, phone, source, comment)
-VALUES ($1, $2, $3, $4, $5, $6, $7, $8)
+INSERT INTO candidates (id, vacancy_id, owner_user_id, full_name, email, phone, source, comment, consented_at)
+SELECT $1::text, $2::text, $3::bigint, $4::text, $5::text, $6::text, $7::text, $8::text, $10::timestamptz
+WHERE NOT EXISTS (SELECT 1 FROM vacancies WHERE id = $2 AND (status = ANY($9::text[]) OR deleted_at IS NOT NULL))
 RETURNING id, vacancy_id, owner_user_id, full_name, email, phone, source, comment, created_at
 `,
 		candidateID,
@@ -51,6 +53,8 @@ RETURNING id, vacancy_id, owner_user_id, full_name, email, phone, source, commen
 		candidateIn.Phone,
 		candidateIn.Source,
 		candidateIn.Comment,
+		domain.ClosedVacancyStatuses,
+		candidateIn.ConsentedAt,
 	).Scan(
 		&candidate.ID,
 		&candidate.VacancyID,
@@ -63,6 +67,11 @@ RETURNING id, vacancy_id, owner_user_id, full_name, email, phone, source, commen
 		&candidate.CreatedAt,
 	)
 	if err != nil {
+		// The INSERT is guarded like CreateCandidate's: no row back means
+		// the vacancy is closed for new candidates.
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, nil, domain.ErrVacancyClosed
+		}
 		return nil, nil, fmt.Errorf("insert candidate: %w", err)
 	}
 
@@ -70,22 +79,21 @@ RETURNING id, vacancy_id, owner_user_id, full_name, email, phone, source, commen
 	if err != nil {
 		return nil, nil, err
 	}
-	storagePath := fmt.Sprintf("db://resumes/%s", resumeID)
 
 	var resume domain.Resume
 	err = tx.QueryRow(ctx, `
-INSERT INTO resumes (id, candidate_id, file_name, file_type, file_size_bytes, storage_path, extracted_text, file_data)
+INSERT INTO resumes (id, candidate_id, file_name, file_type, file_size_bytes, storage_path, extracted_text, extraction_method)
 VALUES ($1, $2, $3, $4, $5, $6, $7, $8)
-RETURNING id, candidate_id, file_name, file_type, file_size_bytes, storage_path, extracted_text, created_at
+RETURNING id, candidate_id, file_name, file_type, file_size_bytes, storage_path, extracted_text, extraction_method, created_at
 `,
 		resumeID,
 		candidate.ID,
 		resumeIn.FileName,
 		resumeIn.FileType,
 		len(resumeIn.Data),
-		storagePath,
+		resumeIn.StoragePath,
 		resumeIn.ExtractedText,
-		resumeIn.Data,
+		resumeIn.ExtractionMethod,
 	).Scan(
 		&resume.ID,
 		&resume.CandidateID,
@@ -94,6 +102,7 @@ RETURNING id, candidate_id, file_name, file_type, file_size_bytes, storage_path,
 		&resume.FileSizeBytes,
 		&resume.StoragePath,
 		&resume.ExtractedText,
+		&resume.ExtractionMethod,
 		&resume.CreatedAt,
 	)
 	if err != nil {
@@ -7,24 +7,38 @@ import (
 )
 
 // DeleteCandidate removes a candidate row. The resumes FK has ON DELETE
-// CASCADE, so dependent resume rows disappear in the same statement.
-// Returns ErrNotFound when no row matched (either missing or owned by a
-// different user).
+// CASCADE, so dependent resume rows disappear in the same statement; the
+// blob store keys of those resumes are returned for the usecase to delete
+// (the CTE reads them from the snapshot before the delete). Legacy rows
+// keep their bytes in file_data and have no blob.
+// Returns ErrNotFound when no row matched (either missing or not editable
+// by the caller — vacancy viewers cannot delete).
 func (s *ResumeStorage) DeleteCandidate(
 	ctx context.Context,
 	candidateID string,
 	requestUserID uint64,
 	isAdmin bool,
-) error {
-	tag, err := s.db.Exec(ctx, `
-DELETE FROM candidates
-WHERE id = $1 AND ($2 OR owner_user_id = $3)
-`, candidateID, isAdmin, requestUserID)
+) ([]string, error) {
+	var (
+		deleted   bool
+		blobPaths []string
+	)
+	err := s.db.QueryRow(ctx, `
+WITH blobs AS (
+    SELECT storage_path FROM resumes WHERE candidate_id = $1 AND file_data IS NULL
+), deleted AS (
+    DELETE FROM candidates c
+    WHERE c.id = $1 AND `+candidateEditAccess("$2", "$3")+`
+    RETURNING c.id
+)
+SELECT EXISTS (SELECT 1 FROM deleted),
+       COALESCE((SELECT array_agg(storage_path) FROM blobs), '{}')
+`, candidateID, isAdmin, requestUserID).Scan(&deleted, &blobPaths)
 	if err != nil {
-		return err
+		return nil, err
 	}
-	if tag.RowsAffected() == 0 {
-		return domain.ErrNotFound
+	if !deleted {
+		return nil, domain.ErrNotFound
 	}
-	return nil
+	return blobPaths, nil
 }
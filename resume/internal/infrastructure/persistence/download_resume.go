@@ -9,9 +9,11 @@ import (
 )
 
 // DownloadResume fetches the binary payload + minimal metadata for a single
-// resume. Authorization mirrors GetResume — only the owner (or admin) can
-// pull the file bytes. We deliberately keep this separate from GetResume so
+// resume. Authorization mirrors GetResume — the candidate's owner, the
+// vacancy's owner and collaborators (or an admin) can pull the file bytes. We deliberately keep this separate from GetResume so
 // the lighter listing path doesn't drag MB-sized BYTEA columns through pgx.
+// Only legacy rows still carry file_data; for the rest Data stays nil and
+// the usecase reads StoragePath from the blob store.
 func (s *ResumeStorage) DownloadResume(
 	ctx context.Context,
 	resumeID string,
@@ -20,14 +22,15 @@ func (s *ResumeStorage) DownloadResume(
 ) (*domain.ResumeFile, error) {
 	var file domain.ResumeFile
 	err := s.db.QueryRow(ctx, `
-SELECT r.file_name, r.file_type, r.file_data
+SELECT r.file_name, r.file_type, r.file_data, r.storage_path
 FROM resumes r
 JOIN candidates c ON c.id = r.candidate_id
-WHERE r.id = $1 AND ($2 OR c.owner_user_id = $3)
+WHERE r.id = $1 AND `+candidateViewAccess("$2", "$3")+`
 `, resumeID, isAdmin, requestUserID).Scan(
 		&file.FileName,
 		&file.FileType,
 		&file.Data,
+		&file.StoragePath,
 	)
 	if err != nil {
 		if errors.Is(err, pgx.ErrNoRows) {
@@ -12,8 +12,8 @@ func (s *ResumeStorage) GetCandidate(ctx context.Context, candidateID string, re
 	var candidate domain.Candidate
 	err := s.db.QueryRow(ctx, `
 SELECT id, vacancy_id, owner_user_id, full_name, email, phone, source, comment, created_at
-FROM candidates
-WHERE id = $1 AND ($2 OR owner_user_id = $3)
+FROM candidates c
+WHERE c.id = $1 AND `+candidateViewAccess("$2", "$3")+`
 `, candidateID, isAdmin, requestUserID).Scan(
 		&candidate.ID,
 		&candidate.VacancyID,
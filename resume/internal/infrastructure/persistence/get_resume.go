@@ -11,10 +11,10 @@ import (
 func (s *ResumeStorage) GetResume(ctx context.Context, resumeID string, requestUserID uint64, isAdmin bool) (*domain.Resume, error) {
 	var resume domain.Resume
 	err := s.db.QueryRow(ctx, `
-SELECT r.id, r.candidate_id, r.file_name, r.file_type, r.file_size_bytes, r.storage_path, r.extracted_text, r.created_at
+SELECT r.id, r.candidate_id, r.file_name, r.file_type, r.file_size_bytes, r.storage_path, r.extracted_text, r.extraction_method, r.created_at
 FROM resumes r
 JOIN candidates c ON c.id = r.candidate_id
-WHERE r.id = $1 AND ($2 OR c.owner_user_id = $3)
+WHERE r.id = $1 AND `+candidateViewAccess("$2", "$3")+`
 `, resumeID, isAdmin, requestUserID).Scan(
 		&resume.ID,
 		&resume.CandidateID,
@@ -23,6 +23,7 @@ WHERE r.id = $1 AND ($2 OR c.owner_user_id = $3)
 		&resume.FileSizeBytes,
 		&resume.StoragePath,
 		&resume.ExtractedText,
+		&resume.ExtractionMethod,
 		&resume.CreatedAt,
 	)
 	if err != nil {
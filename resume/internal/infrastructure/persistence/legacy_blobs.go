@@ -0,0 +1,49 @@
+package persistence
+
+import (
+	"context"
+
+	"github.com/artem13815/hr/resume/internal/domain"
+)
+
+// ListLegacyBlobs returns up to limit resumes that still keep their bytes
+// in file_data. Moved rows drop out, so repeated calls walk the backlog.
+func (s *ResumeStorage) ListLegacyBlobs(ctx context.Context, limit int) ([]domain.LegacyResumeBlob, error) {
+	rows, err := s.db.Query(ctx, `
+SELECT id, file_data
+FROM resumes
+WHERE file_data IS NOT NULL
+ORDER BY id
+LIMIT $1
+`, limit)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var out []domain.LegacyResumeBlob
+	for rows.Next() {
+		var b domain.LegacyResumeBlob
+		if err := rows.Scan(&b.ResumeID, &b.Data); err != nil {
+			return nil, err
+		}
+		out = append(out, b)
+	}
+	return out, rows.Err()
+}
+
+// MoveBlobOut points a legacy resume at the blob store key its bytes were
+// copied to and clears file_data. False means the resume is gone or was
+// already moved.
+func (s *ResumeStorage) MoveBlobOut(ctx context.Context, resumeID, storagePath string) (bool, error) {
+	tag, err := s.db.Exec(ctx, `
+UPDATE resumes
+SET storage_path = $2,
+    file_data = NULL
+WHERE id = $1 AND file_data IS NOT NULL
+`, resumeID, storagePath)
+	if err != nil {
+		return false, err
+	}
+	return tag.RowsAffected() == 1, nil
+}
@@ -0,0 +1,11 @@
+-- +goose Up
+-- Public applicants tick a personal-data consent box; the moment they did is
+-- kept with the candidate. NULL for candidates added by HR.
+-- +goose StatementBegin
+ALTER TABLE candidates ADD COLUMN IF NOT EXISTS consented_at TIMESTAMPTZ;
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+ALTER TABLE candidates DROP COLUMN IF EXISTS consented_at;
+-- +goose StatementEnd
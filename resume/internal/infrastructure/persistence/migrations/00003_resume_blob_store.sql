@@ -0,0 +1,14 @@
+-- +goose Up
+-- Resume files move to the blob store: new rows keep only the key in
+-- storage_path and leave file_data NULL. Rows written before keep their
+-- bytes (and their db://resumes/<id> storage_path) until the `blobs
+-- migrate` command moves them out.
+-- +goose StatementBegin
+ALTER TABLE resumes ALTER COLUMN file_data DROP NOT NULL;
+-- +goose StatementEnd
+
+-- +goose Down
+-- Fails while any resume lives in the blob store only.
+-- +goose StatementBegin
+ALTER TABLE resumes ALTER COLUMN file_data SET NOT NULL;
+-- +goose StatementEnd
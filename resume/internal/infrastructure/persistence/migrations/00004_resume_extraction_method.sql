@@ -0,0 +1,11 @@
+-- +goose Up
+-- Which extractor produced extracted_text (parser, pdftotext, pdf_parser,
+-- ocr, antiword, catdoc). Rows written before stay '' — unknown.
+-- +goose StatementBegin
+ALTER TABLE resumes ADD COLUMN IF NOT EXISTS extraction_method VARCHAR(16) NOT NULL DEFAULT '';
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+ALTER TABLE resumes DROP COLUMN IF EXISTS extraction_method;
+-- +goose StatementEnd
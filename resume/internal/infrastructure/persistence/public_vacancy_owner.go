@@ -0,0 +1,29 @@
+package persistence
+
+import (
+	"context"
+	"errors"
+
+	"github.com/artem13815/hr/resume/internal/domain"
+	"github.com/jackc/pgx/v5"
+)
+
+// PublicVacancyOwner reads the vacancies table owned by the vacancy service:
+// only a vacancy published to the public feed (is_public), open and not
+// deleted takes applications. Anything else is reported as not found so the
+// public form cannot probe for private vacancy IDs.
+func (s *ResumeStorage) PublicVacancyOwner(ctx context.Context, vacancyID string) (uint64, error) {
+	var ownerUserID uint64
+	err := s.db.QueryRow(ctx, `
+SELECT owner_user_id
+FROM vacancies
+WHERE id = $1 AND is_public AND status = 'open' AND deleted_at IS NULL
+`, vacancyID).Scan(&ownerUserID)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return 0, domain.ErrNotFound
+		}
+		return 0, err
+	}
+	return ownerUserID, nil
+}
@@ -0,0 +1,64 @@
+package persistence
+
+import (
+	"context"
+	"errors"
+
+	"github.com/jackc/pgx/v5"
+
+	"github.com/artem13815/hr/resume/internal/domain"
+)
+
+// PurgeVacancyCandidates deletes the candidates of a vacancy the vacancy
+// service has started purging (vacancies.purge_started_at is set); resumes
+// go with them through the FK cascade, legacy file bytes included; the blob
+// store keys of the rest are returned for the usecase to delete. The vacancy row
+// is locked so the check and the delete agree. A vacancy already gone —
+// purged by an earlier call the caller did not hear back from — usually has
+// nothing left and counts zero; any other vacancy is domain.ErrNotFound.
+func (s *ResumeStorage) PurgeVacancyCandidates(ctx context.Context, vacancyID string) (*domain.PurgeVacancyCandidatesResult, error) {
+	tx, err := s.db.Begin(ctx)
+	if err != nil {
+		return nil, err
+	}
+	defer tx.Rollback(ctx)
+
+	var purging bool
+	err = tx.QueryRow(ctx, `
+SELECT purge_started_at IS NOT NULL
+FROM vacancies
+WHERE id = $1
+FOR UPDATE
+`, vacancyID).Scan(&purging)
+	switch {
+	case errors.Is(err, pgx.ErrNoRows):
+		// Already purged; sweep whatever is left below.
+	case err != nil:
+		return nil, err
+	case !purging:
+		return nil, domain.ErrNotFound
+	}
+
+	var res domain.PurgeVacancyCandidatesResult
+	err = tx.QueryRow(ctx, `
+SELECT COUNT(*),
+       COALESCE(array_agg(r.storage_path) FILTER (WHERE r.file_data IS NULL), '{}')
+FROM resumes r
+JOIN candidates c ON c.id = r.candidate_id
+WHERE c.vacancy_id = $1
+`, vacancyID).Scan(&res.Resumes, &res.BlobPaths)
+	if err != nil {
+		return nil, err
+	}
+
+	tag, err := tx.Exec(ctx, `DELETE FROM candidates WHERE vacancy_id = $1`, vacancyID)
+	if err != nil {
+		return nil, err
+	}
+	res.Candidates = uint32(tag.RowsAffected())
+
+	if err := tx.Commit(ctx); err != nil {
+		return nil, err
+	}
+	return &res, nil
+}
@@ -0,0 +1,28 @@
+package persistence
+
+import (
+	"context"
+
+	"github.com/artem13815/hr/resume/internal/domain"
+)
+
+// TransferCandidateOwnership moves one batch of candidates to the new owner
+// in a single statement. Resumes carry no owner of their own and follow the
+// candidate.
+func (s *ResumeStorage) TransferCandidateOwnership(ctx context.Context, in domain.TransferCandidateOwnershipInput) (uint32, error) {
+	tag, err := s.db.Exec(ctx, `
+UPDATE candidates
+SET owner_user_id = $2
+WHERE id IN (
+    SELECT id FROM candidates
+    WHERE owner_user_id = $1
+    ORDER BY id
+    LIMIT $3
+    FOR UPDATE
+)
+`, in.FromUserID, in.ToUserID, in.Limit)
+	if err != nil {
+		return 0, err
+	}
+	return uint32(tag.RowsAffected()), nil
+}
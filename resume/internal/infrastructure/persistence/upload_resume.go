@@ -2,13 +2,25 @@ package persistence
 
 import (
 	"context"
-	"fmt"
+	"errors"
 
 	"github.com/artem13815/hr/resume/internal/domain"
+	"github.com/jackc/pgx/v5"
 )
 
+// UploadResume records a resume whose bytes the usecase has already written
+// to the blob store under in.StoragePath; file_data stays NULL.
 func (s *ResumeStorage) UploadResume(ctx context.Context, in domain.UploadResumeInput) (*domain.Resume, error) {
-	if _, err := s.GetCandidate(ctx, in.CandidateID, in.RequestUserID, in.IsAdmin); err != nil {
+	var editable int
+	err := s.db.QueryRow(ctx, `
+SELECT 1
+FROM candidates c
+WHERE c.id = $1 AND `+candidateEditAccess("$2", "$3")+`
+`, in.CandidateID, in.IsAdmin, in.RequestUserID).Scan(&editable)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, domain.ErrNotFound
+		}
 		return nil, err
 	}
 
@@ -17,14 +29,12 @@ func (s *ResumeStorage) UploadResume(ctx context.Context, in domain.UploadResume
 		return nil, err
 	}
 
-	storagePath := fmt.Sprintf("db://resumes/%s", id)
-
 	var resume domain.Resume
 	err = s.db.QueryRow(ctx, `
-INSERT INTO resumes (id, candidate_id, file_name, file_type, file_size_bytes, storage_path, extracted_text, file_data)
+INSERT INTO resumes (id, candidate_id, file_name, file_type, file_size_bytes, storage_path, extracted_text, extraction_method)
 VALUES ($1, $2, $3, $4, $5, $6, $7, $8)
-RETURNING id, candidate_id, file_name, file_type, file_size_bytes, storage_path, extracted_text, created_at
-`, id, in.CandidateID, in.FileName, in.FileType, len(in.Data), storagePath, in.ExtractedText, in.Data).Scan(
+RETURNING id, candidate_id, file_name, file_type, file_size_bytes, storage_path, extracted_text, extraction_method, created_at
+`, id, in.CandidateID, in.FileName, in.FileType, len(in.Data), in.StoragePath, in.ExtractedText, in.ExtractionMethod).Scan(
 		&resume.ID,
 		&resume.CandidateID,
 		&resume.FileName,
@@ -32,6 +42,7 @@ RETURNING id, candidate_id, file_name, file_type, file_size_bytes, storage_path,
 		&resume.FileSizeBytes,
 		&resume.StoragePath,
 		&resume.ExtractedText,
+		&resume.ExtractionMethod,
 		&resume.CreatedAt,
 	)
 	if err != nil {
@@ -0,0 +1,70 @@
+// Package rate_limit is the Redis-backed rate limiter behind the public
+// apply buckets. It follows auth's limiter (same fixed window, same Lua
+// script, same fail-closed policy) but keeps only what resume needs: the
+// services are separate Go modules with no shared library, and the keys
+// resume passes — a client IP and an email hash — need no normalising.
+package rate_limit
+
+import (
+	"context"
+	"log/slog"
+	"time"
+
+	"github.com/redis/go-redis/v9"
+)
+
+// redisTimeout caps one check so a degraded Redis cannot stall the RPC.
+const redisTimeout = 200 * time.Millisecond
+
+// incrExpireScript bumps the counter and starts the window on the first hit
+// in one atomic step, so concurrent requests cannot pass the limit. A key
+// left without a TTL gets one on the next hit.
+var incrExpireScript = redis.NewScript(`
+local current = redis.call("INCR", KEYS[1])
+if current == 1 or redis.call("TTL", KEYS[1]) < 0 then
+  redis.call("EXPIRE", KEYS[1], ARGV[1])
+end
+return current
+`)
+
+// Limiter is a fixed-window counter keyed by `rl:kind:key`.
+type Limiter struct {
+	rdb    *redis.Client
+	kind   string
+	limit  int64
+	window time.Duration
+}
+
+// New returns a limiter allowing limitPerWindow hits per key and window; 0
+// turns it off.
+func New(rdb *redis.Client, kind string, limitPerWindow int, window time.Duration) *Limiter {
+	return &Limiter{
+		rdb:    rdb,
+		kind:   kind,
+		limit:  int64(limitPerWindow),
+		window: window,
+	}
+}
+
+// Allow counts one hit for key and reports whether it is within the limit.
+// A Redis error denies the request.
+func (l *Limiter) Allow(ctx context.Context, key string) bool {
+	if l.limit <= 0 {
+		return true
+	}
+	// Detach from the caller's cancellation but keep our own budget.
+	execCtx, cancel := context.WithTimeout(context.WithoutCancel(ctx), redisTimeout)
+	defer cancel()
+
+	redisKey := "rl:" + l.kind + ":" + key
+	n, err := incrExpireScript.Run(execCtx, l.rdb, []string{redisKey}, int64(l.window.Seconds())).Int64()
+	if err != nil {
+		slog.Warn("rate limit redis error", "err", err, "key", redisKey)
+		return false
+	}
+	if n > l.limit {
+		slog.Info("rate limit exceeded", "key", redisKey, "count", n, "limit", l.limit)
+		return false
+	}
+	return true
+}
@@ -0,0 +1,291 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.11
+// 	protoc        v7.34.1
+// source: analysis_api/analysis.proto
+
+// Narrow contract: resume only needs StartApplicationAnalysis and
+// PurgeVacancyAnalyses from the analysis service. The package and service
+// names match analysis's full FQDN
+// (analysis.service.v1.AnalysisService/StartApplicationAnalysis) so the
+// gRPC wire call reaches the same handler — message type names are local and
+// do not affect the wire format. Field tags MUST stay in sync with
+// analysis_model.proto: StartApplicationAnalysisRequest,
+// StartAnalysisResponse (status is sent as an enum; read here as int32),
+// PurgeVacancyAnalysesRequest and PurgeVacancyAnalysesResponse.
+
+package analysis_api
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type StartApplicationAnalysisRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ResumeId      string                 `protobuf:"bytes,1,opt,name=resume_id,json=resumeId,proto3" json:"resume_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *StartApplicationAnalysisRequest) Reset() {
+	*x = StartApplicationAnalysisRequest{}
+	mi := &file_analysis_api_analysis_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *StartApplicationAnalysisRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StartApplicationAnalysisRequest) ProtoMessage() {}
+
+func (x *StartApplicationAnalysisRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_analysis_api_analysis_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StartApplicationAnalysisRequest.ProtoReflect.Descriptor instead.
+func (*StartApplicationAnalysisRequest) Descriptor() ([]byte, []int) {
+	return file_analysis_api_analysis_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *StartApplicationAnalysisRequest) GetResumeId() string {
+	if x != nil {
+		return x.ResumeId
+	}
+	return ""
+}
+
+type StartApplicationAnalysisResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	AnalysisId    string                 `protobuf:"bytes,1,opt,name=analysis_id,json=analysisId,proto3" json:"analysis_id,omitempty"`
+	Status        int32                  `protobuf:"varint,2,opt,name=status,proto3" json:"status,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *StartApplicationAnalysisResponse) Reset() {
+	*x = StartApplicationAnalysisResponse{}
+	mi := &file_analysis_api_analysis_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *StartApplicationAnalysisResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StartApplicationAnalysisResponse) ProtoMessage() {}
+
+func (x *StartApplicationAnalysisResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_analysis_api_analysis_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StartApplicationAnalysisResponse.ProtoReflect.Descriptor instead.
+func (*StartApplicationAnalysisResponse) Descriptor() ([]byte, []int) {
+	return file_analysis_api_analysis_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *StartApplicationAnalysisResponse) GetAnalysisId() string {
+	if x != nil {
+		return x.AnalysisId
+	}
+	return ""
+}
+
+func (x *StartApplicationAnalysisResponse) GetStatus() int32 {
+	if x != nil {
+		return x.Status
+	}
+	return 0
+}
+
+type PurgeVacancyAnalysesRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	VacancyId     string                 `protobuf:"bytes,1,opt,name=vacancy_id,json=vacancyId,proto3" json:"vacancy_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PurgeVacancyAnalysesRequest) Reset() {
+	*x = PurgeVacancyAnalysesRequest{}
+	mi := &file_analysis_api_analysis_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PurgeVacancyAnalysesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PurgeVacancyAnalysesRequest) ProtoMessage() {}
+
+func (x *PurgeVacancyAnalysesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_analysis_api_analysis_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PurgeVacancyAnalysesRequest.ProtoReflect.Descriptor instead.
+func (*PurgeVacancyAnalysesRequest) Descriptor() ([]byte, []int) {
+	return file_analysis_api_analysis_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *PurgeVacancyAnalysesRequest) GetVacancyId() string {
+	if x != nil {
+		return x.VacancyId
+	}
+	return ""
+}
+
+type PurgeVacancyAnalysesResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Analyses      uint32                 `protobuf:"varint,1,opt,name=analyses,proto3" json:"analyses,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PurgeVacancyAnalysesResponse) Reset() {
+	*x = PurgeVacancyAnalysesResponse{}
+	mi := &file_analysis_api_analysis_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PurgeVacancyAnalysesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PurgeVacancyAnalysesResponse) ProtoMessage() {}
+
+func (x *PurgeVacancyAnalysesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_analysis_api_analysis_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PurgeVacancyAnalysesResponse.ProtoReflect.Descriptor instead.
+func (*PurgeVacancyAnalysesResponse) Descriptor() ([]byte, []int) {
+	return file_analysis_api_analysis_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *PurgeVacancyAnalysesResponse) GetAnalyses() uint32 {
+	if x != nil {
+		return x.Analyses
+	}
+	return 0
+}
+
+var File_analysis_api_analysis_proto protoreflect.FileDescriptor
+
+const file_analysis_api_analysis_proto_rawDesc = "" +
+	"\n" +
+	"\x1banalysis_api/analysis.proto\x12\x13analysis.service.v1\">\n" +
+	"\x1fStartApplicationAnalysisRequest\x12\x1b\n" +
+	"\tresume_id\x18\x01 \x01(\tR\bresumeId\"[\n" +
+	" StartApplicationAnalysisResponse\x12\x1f\n" +
+	"\vanalysis_id\x18\x01 \x01(\tR\n" +
+	"analysisId\x12\x16\n" +
+	"\x06status\x18\x02 \x01(\x05R\x06status\"<\n" +
+	"\x1bPurgeVacancyAnalysesRequest\x12\x1d\n" +
+	"\n" +
+	"vacancy_id\x18\x01 \x01(\tR\tvacancyId\":\n" +
+	"\x1cPurgeVacancyAnalysesResponse\x12\x1a\n" +
+	"\banalyses\x18\x01 \x01(\rR\banalyses2\x9c\x02\n" +
+	"\x0fAnalysisService\x12\x89\x01\n" +
+	"\x18StartApplicationAnalysis\x124.analysis.service.v1.StartApplicationAnalysisRequest\x1a5.analysis.service.v1.StartApplicationAnalysisResponse\"\x00\x12}\n" +
+	"\x14PurgeVacancyAnalyses\x120.analysis.service.v1.PurgeVacancyAnalysesRequest\x1a1.analysis.service.v1.PurgeVacancyAnalysesResponse\"\x00B:Z8github.com/artem13815/hr/resume/internal/pb/analysis_apib\x06proto3"
+
+var (
+	file_analysis_api_analysis_proto_rawDescOnce sync.Once
+	file_analysis_api_analysis_proto_rawDescData []byte
+)
+
+func file_analysis_api_analysis_proto_rawDescGZIP() []byte {
+	file_analysis_api_analysis_proto_rawDescOnce.Do(func() {
+		file_analysis_api_analysis_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_analysis_api_analysis_proto_rawDesc), len(file_analysis_api_analysis_proto_rawDesc)))
+	})
+	return file_analysis_api_analysis_proto_rawDescData
+}
+
+var file_analysis_api_analysis_proto_msgTypes = make([]protoimpl.MessageInfo, 4)
+var file_analysis_api_analysis_proto_goTypes = []any{
+	(*StartApplicationAnalysisRequest)(nil),  // 0: analysis.service.v1.StartApplicationAnalysisRequest
+	(*StartApplicationAnalysisResponse)(nil), // 1: analysis.service.v1.StartApplicationAnalysisResponse
+	(*PurgeVacancyAnalysesRequest)(nil),      // 2: analysis.service.v1.PurgeVacancyAnalysesRequest
+	(*PurgeVacancyAnalysesResponse)(nil),     // 3: analysis.service.v1.PurgeVacancyAnalysesResponse
+}
+var file_analysis_api_analysis_proto_depIdxs = []int32{
+	0, // 0: analysis.service.v1.AnalysisService.StartApplicationAnalysis:input_type -> analysis.service.v1.StartApplicationAnalysisRequest
+	2, // 1: analysis.service.v1.AnalysisService.PurgeVacancyAnalyses:input_type -> analysis.service.v1.PurgeVacancyAnalysesRequest
+	1, // 2: analysis.service.v1.AnalysisService.StartApplicationAnalysis:output_type -> analysis.service.v1.StartApplicationAnalysisResponse
+	3, // 3: analysis.service.v1.AnalysisService.PurgeVacancyAnalyses:output_type -> analysis.service.v1.PurgeVacancyAnalysesResponse
+	2, // [2:4] is the sub-list for method output_type
+	0, // [0:2] is the sub-list for method input_type
+	0, // [0:0] is the sub-list for extension type_name
+	0, // [0:0] is the sub-list for extension extendee
+	0, // [0:0] is the sub-list for field type_name
+}
+
+func init() { file_analysis_api_analysis_proto_init() }
+func file_analysis_api_analysis_proto_init() {
+	if File_analysis_api_analysis_proto != nil {
+		return
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_analysis_api_analysis_proto_rawDesc), len(file_analysis_api_analysis_proto_rawDesc)),
+			NumEnums:      0,
+			NumMessages:   4,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_analysis_api_analysis_proto_goTypes,
+		DependencyIndexes: file_analysis_api_analysis_proto_depIdxs,
+		MessageInfos:      file_analysis_api_analysis_proto_msgTypes,
+	}.Build()
+	File_analysis_api_analysis_proto = out.File
+	file_analysis_api_analysis_proto_goTypes = nil
+	file_analysis_api_analysis_proto_depIdxs = nil
+}
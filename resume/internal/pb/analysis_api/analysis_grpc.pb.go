@@ -0,0 +1,169 @@
+// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
+// versions:
+// - protoc-gen-go-grpc v1.6.0
+// - protoc             v7.34.1
+// source: analysis_api/analysis.proto
+
+// Narrow contract: resume only needs StartApplicationAnalysis and
+// PurgeVacancyAnalyses from the analysis service. The package and service
+// names match analysis's full FQDN
+// (analysis.service.v1.AnalysisService/StartApplicationAnalysis) so the
+// gRPC wire call reaches the same handler — message type names are local and
+// do not affect the wire format. Field tags MUST stay in sync with
+// analysis_model.proto: StartApplicationAnalysisRequest,
+// StartAnalysisResponse (status is sent as an enum; read here as int32),
+// PurgeVacancyAnalysesRequest and PurgeVacancyAnalysesResponse.
+
+package analysis_api
+
+import (
+	context "context"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+// Requires gRPC-Go v1.64.0 or later.
+const _ = grpc.SupportPackageIsVersion9
+
+const (
+	AnalysisService_StartApplicationAnalysis_FullMethodName = "/analysis.service.v1.AnalysisService/StartApplicationAnalysis"
+	AnalysisService_PurgeVacancyAnalyses_FullMethodName     = "/analysis.service.v1.AnalysisService/PurgeVacancyAnalyses"
+)
+
+// AnalysisServiceClient is the client API for AnalysisService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type AnalysisServiceClient interface {
+	StartApplicationAnalysis(ctx context.Context, in *StartApplicationAnalysisRequest, opts ...grpc.CallOption) (*StartApplicationAnalysisResponse, error)
+	PurgeVacancyAnalyses(ctx context.Context, in *PurgeVacancyAnalysesRequest, opts ...grpc.CallOption) (*PurgeVacancyAnalysesResponse, error)
+}
+
+type analysisServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewAnalysisServiceClient(cc grpc.ClientConnInterface) AnalysisServiceClient {
+	return &analysisServiceClient{cc}
+}
+
+func (c *analysisServiceClient) StartApplicationAnalysis(ctx context.Context, in *StartApplicationAnalysisRequest, opts ...grpc.CallOption) (*StartApplicationAnalysisResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(StartApplicationAnalysisResponse)
+	err := c.cc.Invoke(ctx, AnalysisService_StartApplicationAnalysis_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *analysisServiceClient) PurgeVacancyAnalyses(ctx context.Context, in *PurgeVacancyAnalysesRequest, opts ...grpc.CallOption) (*PurgeVacancyAnalysesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(PurgeVacancyAnalysesResponse)
+	err := c.cc.Invoke(ctx, AnalysisService_PurgeVacancyAnalyses_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// AnalysisServiceServer is the server API for AnalysisService service.
+// All implementations must embed UnimplementedAnalysisServiceServer
+// for forward compatibility.
+type AnalysisServiceServer interface {
+	StartApplicationAnalysis(context.Context, *StartApplicationAnalysisRequest) (*StartApplicationAnalysisResponse, error)
+	PurgeVacancyAnalyses(context.Context, *PurgeVacancyAnalysesRequest) (*PurgeVacancyAnalysesResponse, error)
+	mustEmbedUnimplementedAnalysisServiceServer()
+}
+
+// UnimplementedAnalysisServiceServer must be embedded to have
+// forward compatible implementations.
+//
+// NOTE: this should be embedded by value instead of pointer to avoid a nil
+// pointer dereference when methods are called.
+type UnimplementedAnalysisServiceServer struct{}
+
+func (UnimplementedAnalysisServiceServer) StartApplicationAnalysis(context.Context, *StartApplicationAnalysisRequest) (*StartApplicationAnalysisResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method StartApplicationAnalysis not implemented")
+}
+func (UnimplementedAnalysisServiceServer) PurgeVacancyAnalyses(context.Context, *PurgeVacancyAnalysesRequest) (*PurgeVacancyAnalysesResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method PurgeVacancyAnalyses not implemented")
+}
+func (UnimplementedAnalysisServiceServer) mustEmbedUnimplementedAnalysisServiceServer() {}
+func (UnimplementedAnalysisServiceServer) testEmbeddedByValue()                         {}
+
+// UnsafeAnalysisServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to AnalysisServiceServer will
+// result in compilation errors.
+type UnsafeAnalysisServiceServer interface {
+	mustEmbedUnimplementedAnalysisServiceServer()
+}
+
+func RegisterAnalysisServiceServer(s grpc.ServiceRegistrar, srv AnalysisServiceServer) {
+	// If the following call panics, it indicates UnimplementedAnalysisServiceServer was
+	// embedded by pointer and is nil.  This will cause panics if an
+	// unimplemented method is ever invoked, so we test this at initialization
+	// time to prevent it from happening at runtime later due to I/O.
+	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
+		t.testEmbeddedByValue()
+	}
+	s.RegisterService(&AnalysisService_ServiceDesc, srv)
+}
+
+func _AnalysisService_StartApplicationAnalysis_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(StartApplicationAnalysisRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AnalysisServiceServer).StartApplicationAnalysis(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AnalysisService_StartApplicationAnalysis_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AnalysisServiceServer).StartApplicationAnalysis(ctx, req.(*StartApplicationAnalysisRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AnalysisService_PurgeVacancyAnalyses_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PurgeVacancyAnalysesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AnalysisServiceServer).PurgeVacancyAnalyses(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AnalysisService_PurgeVacancyAnalyses_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AnalysisServiceServer).PurgeVacancyAnalyses(ctx, req.(*PurgeVacancyAnalysesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// AnalysisService_ServiceDesc is the grpc.ServiceDesc for AnalysisService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var AnalysisService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "analysis.service.v1.AnalysisService",
+	HandlerType: (*AnalysisServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "StartApplicationAnalysis",
+			Handler:    _AnalysisService_StartApplicationAnalysis_Handler,
+		},
+		{
+			MethodName: "PurgeVacancyAnalyses",
+			Handler:    _AnalysisService_PurgeVacancyAnalyses_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "analysis_api/analysis.proto",
+}
@@ -132,8 +132,11 @@ type Resume struct {
 	StoragePath   string                 `protobuf:"bytes,6,opt,name=storage_path,json=storagePath,proto3" json:"storage_path,omitempty"`
 	ExtractedText string                 `protobuf:"bytes,7,opt,name=extracted_text,json=extractedText,proto3" json:"extracted_text,omitempty"`
 	CreatedAt     *timestamppb.Timestamp `protobuf:"bytes,8,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+	// How extracted_text was obtained: parser, pdftotext, pdf_parser, ocr,
+	// antiword or catdoc. Empty for resumes stored before it was recorded.
+	ExtractionMethod string `protobuf:"bytes,9,opt,name=extraction_method,json=extractionMethod,proto3" json:"extraction_method,omitempty"`
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
 }
 
 func (x *Resume) Reset() {
@@ -222,6 +225,13 @@ func (x *Resume) GetCreatedAt() *timestamppb.Timestamp {
 	return nil
 }
 
+func (x *Resume) GetExtractionMethod() string {
+	if x != nil {
+		return x.ExtractionMethod
+	}
+	return ""
+}
+
 type CreateCandidateRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	VacancyId     string                 `protobuf:"bytes,1,opt,name=vacancy_id,json=vacancyId,proto3" json:"vacancy_id,omitempty"`
@@ -498,6 +508,138 @@ func (x *CreateCandidateFromResumeRequest) GetFileData() []byte {
 	return nil
 }
 
+// ApplyToVacancyRequest is a public application. The gateway builds it
+// from the multipart apply form; file_name only helps format detection.
+type ApplyToVacancyRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	VacancyId     string                 `protobuf:"bytes,1,opt,name=vacancy_id,json=vacancyId,proto3" json:"vacancy_id,omitempty"`
+	FullName      string                 `protobuf:"bytes,2,opt,name=full_name,json=fullName,proto3" json:"full_name,omitempty"`
+	Email         string                 `protobuf:"bytes,3,opt,name=email,proto3" json:"email,omitempty"`
+	Consent       bool                   `protobuf:"varint,4,opt,name=consent,proto3" json:"consent,omitempty"`
+	FileName      string                 `protobuf:"bytes,5,opt,name=file_name,json=fileName,proto3" json:"file_name,omitempty"`
+	FileData      []byte                 `protobuf:"bytes,6,opt,name=file_data,json=fileData,proto3" json:"file_data,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ApplyToVacancyRequest) Reset() {
+	*x = ApplyToVacancyRequest{}
+	mi := &file_models_resume_model_proto_msgTypes[7]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ApplyToVacancyRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ApplyToVacancyRequest) ProtoMessage() {}
+
+func (x *ApplyToVacancyRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_models_resume_model_proto_msgTypes[7]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ApplyToVacancyRequest.ProtoReflect.Descriptor instead.
+func (*ApplyToVacancyRequest) Descriptor() ([]byte, []int) {
+	return file_models_resume_model_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *ApplyToVacancyRequest) GetVacancyId() string {
+	if x != nil {
+		return x.VacancyId
+	}
+	return ""
+}
+
+func (x *ApplyToVacancyRequest) GetFullName() string {
+	if x != nil {
+		return x.FullName
+	}
+	return ""
+}
+
+func (x *ApplyToVacancyRequest) GetEmail() string {
+	if x != nil {
+		return x.Email
+	}
+	return ""
+}
+
+func (x *ApplyToVacancyRequest) GetConsent() bool {
+	if x != nil {
+		return x.Consent
+	}
+	return false
+}
+
+func (x *ApplyToVacancyRequest) GetFileName() string {
+	if x != nil {
+		return x.FileName
+	}
+	return ""
+}
+
+func (x *ApplyToVacancyRequest) GetFileData() []byte {
+	if x != nil {
+		return x.FileData
+	}
+	return nil
+}
+
+// ApplyToVacancyResponse deliberately carries only an opaque reference:
+// the applicant gets no view of internal candidate data.
+type ApplyToVacancyResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ApplicationId string                 `protobuf:"bytes,1,opt,name=application_id,json=applicationId,proto3" json:"application_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ApplyToVacancyResponse) Reset() {
+	*x = ApplyToVacancyResponse{}
+	mi := &file_models_resume_model_proto_msgTypes[8]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ApplyToVacancyResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ApplyToVacancyResponse) ProtoMessage() {}
+
+func (x *ApplyToVacancyResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_models_resume_model_proto_msgTypes[8]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ApplyToVacancyResponse.ProtoReflect.Descriptor instead.
+func (*ApplyToVacancyResponse) Descriptor() ([]byte, []int) {
+	return file_models_resume_model_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *ApplyToVacancyResponse) GetApplicationId() string {
+	if x != nil {
+		return x.ApplicationId
+	}
+	return ""
+}
+
 type ResumeIntakeFile struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	ExternalId    string                 `protobuf:"bytes,1,opt,name=external_id,json=externalId,proto3" json:"external_id,omitempty"`
@@ -508,7 +650,7 @@ type ResumeIntakeFile struct {
 
 func (x *ResumeIntakeFile) Reset() {
 	*x = ResumeIntakeFile{}
-	mi := &file_models_resume_model_proto_msgTypes[7]
+	mi := &file_models_resume_model_proto_msgTypes[9]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -520,7 +662,7 @@ func (x *ResumeIntakeFile) String() string {
 func (*ResumeIntakeFile) ProtoMessage() {}
 
 func (x *ResumeIntakeFile) ProtoReflect() protoreflect.Message {
-	mi := &file_models_resume_model_proto_msgTypes[7]
+	mi := &file_models_resume_model_proto_msgTypes[9]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -533,7 +675,7 @@ func (x *ResumeIntakeFile) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ResumeIntakeFile.ProtoReflect.Descriptor instead.
 func (*ResumeIntakeFile) Descriptor() ([]byte, []int) {
-	return file_models_resume_model_proto_rawDescGZIP(), []int{7}
+	return file_models_resume_model_proto_rawDescGZIP(), []int{9}
 }
 
 func (x *ResumeIntakeFile) GetExternalId() string {
@@ -560,7 +702,7 @@ type BatchIngestResumeRequest struct {
 
 func (x *BatchIngestResumeRequest) Reset() {
 	*x = BatchIngestResumeRequest{}
-	mi := &file_models_resume_model_proto_msgTypes[8]
+	mi := &file_models_resume_model_proto_msgTypes[10]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -572,7 +714,7 @@ func (x *BatchIngestResumeRequest) String() string {
 func (*BatchIngestResumeRequest) ProtoMessage() {}
 
 func (x *BatchIngestResumeRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_models_resume_model_proto_msgTypes[8]
+	mi := &file_models_resume_model_proto_msgTypes[10]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -585,7 +727,7 @@ func (x *BatchIngestResumeRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use BatchIngestResumeRequest.ProtoReflect.Descriptor instead.
 func (*BatchIngestResumeRequest) Descriptor() ([]byte, []int) {
-	return file_models_resume_model_proto_rawDescGZIP(), []int{8}
+	return file_models_resume_model_proto_rawDescGZIP(), []int{10}
 }
 
 func (x *BatchIngestResumeRequest) GetFiles() []*ResumeIntakeFile {
@@ -614,7 +756,7 @@ type BatchIngestResumeItemResult struct {
 
 func (x *BatchIngestResumeItemResult) Reset() {
 	*x = BatchIngestResumeItemResult{}
-	mi := &file_models_resume_model_proto_msgTypes[9]
+	mi := &file_models_resume_model_proto_msgTypes[11]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -626,7 +768,7 @@ func (x *BatchIngestResumeItemResult) String() string {
 func (*BatchIngestResumeItemResult) ProtoMessage() {}
 
 func (x *BatchIngestResumeItemResult) ProtoReflect() protoreflect.Message {
-	mi := &file_models_resume_model_proto_msgTypes[9]
+	mi := &file_models_resume_model_proto_msgTypes[11]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -639,7 +781,7 @@ func (x *BatchIngestResumeItemResult) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use BatchIngestResumeItemResult.ProtoReflect.Descriptor instead.
 func (*BatchIngestResumeItemResult) Descriptor() ([]byte, []int) {
-	return file_models_resume_model_proto_rawDescGZIP(), []int{9}
+	return file_models_resume_model_proto_rawDescGZIP(), []int{11}
 }
 
 func (x *BatchIngestResumeItemResult) GetExternalId() string {
@@ -679,7 +821,7 @@ type BatchIngestResumeResponse struct {
 
 func (x *BatchIngestResumeResponse) Reset() {
 	*x = BatchIngestResumeResponse{}
-	mi := &file_models_resume_model_proto_msgTypes[10]
+	mi := &file_models_resume_model_proto_msgTypes[12]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -691,7 +833,7 @@ func (x *BatchIngestResumeResponse) String() string {
 func (*BatchIngestResumeResponse) ProtoMessage() {}
 
 func (x *BatchIngestResumeResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_models_resume_model_proto_msgTypes[10]
+	mi := &file_models_resume_model_proto_msgTypes[12]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -704,7 +846,7 @@ func (x *BatchIngestResumeResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use BatchIngestResumeResponse.ProtoReflect.Descriptor instead.
 func (*BatchIngestResumeResponse) Descriptor() ([]byte, []int) {
-	return file_models_resume_model_proto_rawDescGZIP(), []int{10}
+	return file_models_resume_model_proto_rawDescGZIP(), []int{12}
 }
 
 func (x *BatchIngestResumeResponse) GetResults() []*BatchIngestResumeItemResult {
@@ -725,7 +867,7 @@ type UploadResumeMeta struct {
 
 func (x *UploadResumeMeta) Reset() {
 	*x = UploadResumeMeta{}
-	mi := &file_models_resume_model_proto_msgTypes[11]
+	mi := &file_models_resume_model_proto_msgTypes[13]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -737,7 +879,7 @@ func (x *UploadResumeMeta) String() string {
 func (*UploadResumeMeta) ProtoMessage() {}
 
 func (x *UploadResumeMeta) ProtoReflect() protoreflect.Message {
-	mi := &file_models_resume_model_proto_msgTypes[11]
+	mi := &file_models_resume_model_proto_msgTypes[13]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -750,7 +892,7 @@ func (x *UploadResumeMeta) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use UploadResumeMeta.ProtoReflect.Descriptor instead.
 func (*UploadResumeMeta) Descriptor() ([]byte, []int) {
-	return file_models_resume_model_proto_rawDescGZIP(), []int{11}
+	return file_models_resume_model_proto_rawDescGZIP(), []int{13}
 }
 
 func (x *UploadResumeMeta) GetCandidateId() string {
@@ -783,7 +925,7 @@ type UploadResumeChunk struct {
 
 func (x *UploadResumeChunk) Reset() {
 	*x = UploadResumeChunk{}
-	mi := &file_models_resume_model_proto_msgTypes[12]
+	mi := &file_models_resume_model_proto_msgTypes[14]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -795,7 +937,7 @@ func (x *UploadResumeChunk) String() string {
 func (*UploadResumeChunk) ProtoMessage() {}
 
 func (x *UploadResumeChunk) ProtoReflect() protoreflect.Message {
-	mi := &file_models_resume_model_proto_msgTypes[12]
+	mi := &file_models_resume_model_proto_msgTypes[14]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -808,7 +950,7 @@ func (x *UploadResumeChunk) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use UploadResumeChunk.ProtoReflect.Descriptor instead.
 func (*UploadResumeChunk) Descriptor() ([]byte, []int) {
-	return file_models_resume_model_proto_rawDescGZIP(), []int{12}
+	return file_models_resume_model_proto_rawDescGZIP(), []int{14}
 }
 
 func (x *UploadResumeChunk) GetData() []byte {
@@ -831,7 +973,7 @@ type UploadResumeRequest struct {
 
 func (x *UploadResumeRequest) Reset() {
 	*x = UploadResumeRequest{}
-	mi := &file_models_resume_model_proto_msgTypes[13]
+	mi := &file_models_resume_model_proto_msgTypes[15]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -843,7 +985,7 @@ func (x *UploadResumeRequest) String() string {
 func (*UploadResumeRequest) ProtoMessage() {}
 
 func (x *UploadResumeRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_models_resume_model_proto_msgTypes[13]
+	mi := &file_models_resume_model_proto_msgTypes[15]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -856,7 +998,7 @@ func (x *UploadResumeRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use UploadResumeRequest.ProtoReflect.Descriptor instead.
 func (*UploadResumeRequest) Descriptor() ([]byte, []int) {
-	return file_models_resume_model_proto_rawDescGZIP(), []int{13}
+	return file_models_resume_model_proto_rawDescGZIP(), []int{15}
 }
 
 func (x *UploadResumeRequest) GetPayload() isUploadResumeRequest_Payload {
@@ -909,7 +1051,7 @@ type UploadResumeResponse struct {
 
 func (x *UploadResumeResponse) Reset() {
 	*x = UploadResumeResponse{}
-	mi := &file_models_resume_model_proto_msgTypes[14]
+	mi := &file_models_resume_model_proto_msgTypes[16]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -921,7 +1063,7 @@ func (x *UploadResumeResponse) String() string {
 func (*UploadResumeResponse) ProtoMessage() {}
 
 func (x *UploadResumeResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_models_resume_model_proto_msgTypes[14]
+	mi := &file_models_resume_model_proto_msgTypes[16]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -934,7 +1076,7 @@ func (x *UploadResumeResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use UploadResumeResponse.ProtoReflect.Descriptor instead.
 func (*UploadResumeResponse) Descriptor() ([]byte, []int) {
-	return file_models_resume_model_proto_rawDescGZIP(), []int{14}
+	return file_models_resume_model_proto_rawDescGZIP(), []int{16}
 }
 
 func (x *UploadResumeResponse) GetResume() *Resume {
@@ -944,6 +1086,142 @@ func (x *UploadResumeResponse) GetResume() *Resume {
 	return nil
 }
 
+// UploadCandidateFromResumeMeta opens an UploadCandidateFromResume stream;
+// file_name only helps format detection.
+type UploadCandidateFromResumeMeta struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	VacancyId     string                 `protobuf:"bytes,1,opt,name=vacancy_id,json=vacancyId,proto3" json:"vacancy_id,omitempty"`
+	FileName      string                 `protobuf:"bytes,2,opt,name=file_name,json=fileName,proto3" json:"file_name,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UploadCandidateFromResumeMeta) Reset() {
+	*x = UploadCandidateFromResumeMeta{}
+	mi := &file_models_resume_model_proto_msgTypes[17]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UploadCandidateFromResumeMeta) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UploadCandidateFromResumeMeta) ProtoMessage() {}
+
+func (x *UploadCandidateFromResumeMeta) ProtoReflect() protoreflect.Message {
+	mi := &file_models_resume_model_proto_msgTypes[17]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UploadCandidateFromResumeMeta.ProtoReflect.Descriptor instead.
+func (*UploadCandidateFromResumeMeta) Descriptor() ([]byte, []int) {
+	return file_models_resume_model_proto_rawDescGZIP(), []int{17}
+}
+
+func (x *UploadCandidateFromResumeMeta) GetVacancyId() string {
+	if x != nil {
+		return x.VacancyId
+	}
+	return ""
+}
+
+func (x *UploadCandidateFromResumeMeta) GetFileName() string {
+	if x != nil {
+		return x.FileName
+	}
+	return ""
+}
+
+type UploadCandidateFromResumeRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Types that are valid to be assigned to Payload:
+	//
+	//	*UploadCandidateFromResumeRequest_Meta
+	//	*UploadCandidateFromResumeRequest_Chunk
+	Payload       isUploadCandidateFromResumeRequest_Payload `protobuf_oneof:"payload"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UploadCandidateFromResumeRequest) Reset() {
+	*x = UploadCandidateFromResumeRequest{}
+	mi := &file_models_resume_model_proto_msgTypes[18]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UploadCandidateFromResumeRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UploadCandidateFromResumeRequest) ProtoMessage() {}
+
+func (x *UploadCandidateFromResumeRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_models_resume_model_proto_msgTypes[18]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UploadCandidateFromResumeRequest.ProtoReflect.Descriptor instead.
+func (*UploadCandidateFromResumeRequest) Descriptor() ([]byte, []int) {
+	return file_models_resume_model_proto_rawDescGZIP(), []int{18}
+}
+
+func (x *UploadCandidateFromResumeRequest) GetPayload() isUploadCandidateFromResumeRequest_Payload {
+	if x != nil {
+		return x.Payload
+	}
+	return nil
+}
+
+func (x *UploadCandidateFromResumeRequest) GetMeta() *UploadCandidateFromResumeMeta {
+	if x != nil {
+		if x, ok := x.Payload.(*UploadCandidateFromResumeRequest_Meta); ok {
+			return x.Meta
+		}
+	}
+	return nil
+}
+
+func (x *UploadCandidateFromResumeRequest) GetChunk() *UploadResumeChunk {
+	if x != nil {
+		if x, ok := x.Payload.(*UploadCandidateFromResumeRequest_Chunk); ok {
+			return x.Chunk
+		}
+	}
+	return nil
+}
+
+type isUploadCandidateFromResumeRequest_Payload interface {
+	isUploadCandidateFromResumeRequest_Payload()
+}
+
+type UploadCandidateFromResumeRequest_Meta struct {
+	Meta *UploadCandidateFromResumeMeta `protobuf:"bytes,1,opt,name=meta,proto3,oneof"`
+}
+
+type UploadCandidateFromResumeRequest_Chunk struct {
+	Chunk *UploadResumeChunk `protobuf:"bytes,2,opt,name=chunk,proto3,oneof"`
+}
+
+func (*UploadCandidateFromResumeRequest_Meta) isUploadCandidateFromResumeRequest_Payload() {}
+
+func (*UploadCandidateFromResumeRequest_Chunk) isUploadCandidateFromResumeRequest_Payload() {}
+
 type GetResumeRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	ResumeId      string                 `protobuf:"bytes,1,opt,name=resume_id,json=resumeId,proto3" json:"resume_id,omitempty"`
@@ -953,7 +1231,7 @@ type GetResumeRequest struct {
 
 func (x *GetResumeRequest) Reset() {
 	*x = GetResumeRequest{}
-	mi := &file_models_resume_model_proto_msgTypes[15]
+	mi := &file_models_resume_model_proto_msgTypes[19]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -965,7 +1243,7 @@ func (x *GetResumeRequest) String() string {
 func (*GetResumeRequest) ProtoMessage() {}
 
 func (x *GetResumeRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_models_resume_model_proto_msgTypes[15]
+	mi := &file_models_resume_model_proto_msgTypes[19]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -978,7 +1256,7 @@ func (x *GetResumeRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetResumeRequest.ProtoReflect.Descriptor instead.
 func (*GetResumeRequest) Descriptor() ([]byte, []int) {
-	return file_models_resume_model_proto_rawDescGZIP(), []int{15}
+	return file_models_resume_model_proto_rawDescGZIP(), []int{19}
 }
 
 func (x *GetResumeRequest) GetResumeId() string {
@@ -997,7 +1275,7 @@ type ResumeResponse struct {
 
 func (x *ResumeResponse) Reset() {
 	*x = ResumeResponse{}
-	mi := &file_models_resume_model_proto_msgTypes[16]
+	mi := &file_models_resume_model_proto_msgTypes[20]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1009,7 +1287,7 @@ func (x *ResumeResponse) String() string {
 func (*ResumeResponse) ProtoMessage() {}
 
 func (x *ResumeResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_models_resume_model_proto_msgTypes[16]
+	mi := &file_models_resume_model_proto_msgTypes[20]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1022,7 +1300,7 @@ func (x *ResumeResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ResumeResponse.ProtoReflect.Descriptor instead.
 func (*ResumeResponse) Descriptor() ([]byte, []int) {
-	return file_models_resume_model_proto_rawDescGZIP(), []int{16}
+	return file_models_resume_model_proto_rawDescGZIP(), []int{20}
 }
 
 func (x *ResumeResponse) GetResume() *Resume {
@@ -1041,7 +1319,7 @@ type DownloadResumeRequest struct {
 
 func (x *DownloadResumeRequest) Reset() {
 	*x = DownloadResumeRequest{}
-	mi := &file_models_resume_model_proto_msgTypes[17]
+	mi := &file_models_resume_model_proto_msgTypes[21]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1053,7 +1331,7 @@ func (x *DownloadResumeRequest) String() string {
 func (*DownloadResumeRequest) ProtoMessage() {}
 
 func (x *DownloadResumeRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_models_resume_model_proto_msgTypes[17]
+	mi := &file_models_resume_model_proto_msgTypes[21]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1066,7 +1344,7 @@ func (x *DownloadResumeRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use DownloadResumeRequest.ProtoReflect.Descriptor instead.
 func (*DownloadResumeRequest) Descriptor() ([]byte, []int) {
-	return file_models_resume_model_proto_rawDescGZIP(), []int{17}
+	return file_models_resume_model_proto_rawDescGZIP(), []int{21}
 }
 
 func (x *DownloadResumeRequest) GetResumeId() string {
@@ -1087,7 +1365,7 @@ type DownloadResumeResponse struct {
 
 func (x *DownloadResumeResponse) Reset() {
 	*x = DownloadResumeResponse{}
-	mi := &file_models_resume_model_proto_msgTypes[18]
+	mi := &file_models_resume_model_proto_msgTypes[22]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1099,7 +1377,7 @@ func (x *DownloadResumeResponse) String() string {
 func (*DownloadResumeResponse) ProtoMessage() {}
 
 func (x *DownloadResumeResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_models_resume_model_proto_msgTypes[18]
+	mi := &file_models_resume_model_proto_msgTypes[22]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1112,7 +1390,7 @@ func (x *DownloadResumeResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use DownloadResumeResponse.ProtoReflect.Descriptor instead.
 func (*DownloadResumeResponse) Descriptor() ([]byte, []int) {
-	return file_models_resume_model_proto_rawDescGZIP(), []int{18}
+	return file_models_resume_model_proto_rawDescGZIP(), []int{22}
 }
 
 func (x *DownloadResumeResponse) GetFileData() []byte {
@@ -1145,7 +1423,7 @@ type DeleteCandidateRequest struct {
 
 func (x *DeleteCandidateRequest) Reset() {
 	*x = DeleteCandidateRequest{}
-	mi := &file_models_resume_model_proto_msgTypes[19]
+	mi := &file_models_resume_model_proto_msgTypes[23]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1157,7 +1435,7 @@ func (x *DeleteCandidateRequest) String() string {
 func (*DeleteCandidateRequest) ProtoMessage() {}
 
 func (x *DeleteCandidateRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_models_resume_model_proto_msgTypes[19]
+	mi := &file_models_resume_model_proto_msgTypes[23]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1170,7 +1448,7 @@ func (x *DeleteCandidateRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use DeleteCandidateRequest.ProtoReflect.Descriptor instead.
 func (*DeleteCandidateRequest) Descriptor() ([]byte, []int) {
-	return file_models_resume_model_proto_rawDescGZIP(), []int{19}
+	return file_models_resume_model_proto_rawDescGZIP(), []int{23}
 }
 
 func (x *DeleteCandidateRequest) GetCandidateId() string {
@@ -1188,7 +1466,7 @@ type DeleteCandidateResponse struct {
 
 func (x *DeleteCandidateResponse) Reset() {
 	*x = DeleteCandidateResponse{}
-	mi := &file_models_resume_model_proto_msgTypes[20]
+	mi := &file_models_resume_model_proto_msgTypes[24]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1200,7 +1478,7 @@ func (x *DeleteCandidateResponse) String() string {
 func (*DeleteCandidateResponse) ProtoMessage() {}
 
 func (x *DeleteCandidateResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_models_resume_model_proto_msgTypes[20]
+	mi := &file_models_resume_model_proto_msgTypes[24]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1213,7 +1491,221 @@ func (x *DeleteCandidateResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use DeleteCandidateResponse.ProtoReflect.Descriptor instead.
 func (*DeleteCandidateResponse) Descriptor() ([]byte, []int) {
-	return file_models_resume_model_proto_rawDescGZIP(), []int{20}
+	return file_models_resume_model_proto_rawDescGZIP(), []int{24}
+}
+
+type PurgeVacancyCandidatesRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	VacancyId     string                 `protobuf:"bytes,1,opt,name=vacancy_id,json=vacancyId,proto3" json:"vacancy_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PurgeVacancyCandidatesRequest) Reset() {
+	*x = PurgeVacancyCandidatesRequest{}
+	mi := &file_models_resume_model_proto_msgTypes[25]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PurgeVacancyCandidatesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PurgeVacancyCandidatesRequest) ProtoMessage() {}
+
+func (x *PurgeVacancyCandidatesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_models_resume_model_proto_msgTypes[25]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PurgeVacancyCandidatesRequest.ProtoReflect.Descriptor instead.
+func (*PurgeVacancyCandidatesRequest) Descriptor() ([]byte, []int) {
+	return file_models_resume_model_proto_rawDescGZIP(), []int{25}
+}
+
+func (x *PurgeVacancyCandidatesRequest) GetVacancyId() string {
+	if x != nil {
+		return x.VacancyId
+	}
+	return ""
+}
+
+// PurgeVacancyCandidatesResponse counts the rows removed, analyses by the
+// analysis service included. All are zero when an earlier call already
+// purged the vacancy.
+type PurgeVacancyCandidatesResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Candidates    uint32                 `protobuf:"varint,1,opt,name=candidates,proto3" json:"candidates,omitempty"`
+	Resumes       uint32                 `protobuf:"varint,2,opt,name=resumes,proto3" json:"resumes,omitempty"`
+	Analyses      uint32                 `protobuf:"varint,3,opt,name=analyses,proto3" json:"analyses,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PurgeVacancyCandidatesResponse) Reset() {
+	*x = PurgeVacancyCandidatesResponse{}
+	mi := &file_models_resume_model_proto_msgTypes[26]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PurgeVacancyCandidatesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PurgeVacancyCandidatesResponse) ProtoMessage() {}
+
+func (x *PurgeVacancyCandidatesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_models_resume_model_proto_msgTypes[26]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PurgeVacancyCandidatesResponse.ProtoReflect.Descriptor instead.
+func (*PurgeVacancyCandidatesResponse) Descriptor() ([]byte, []int) {
+	return file_models_resume_model_proto_rawDescGZIP(), []int{26}
+}
+
+func (x *PurgeVacancyCandidatesResponse) GetCandidates() uint32 {
+	if x != nil {
+		return x.Candidates
+	}
+	return 0
+}
+
+func (x *PurgeVacancyCandidatesResponse) GetResumes() uint32 {
+	if x != nil {
+		return x.Resumes
+	}
+	return 0
+}
+
+func (x *PurgeVacancyCandidatesResponse) GetAnalyses() uint32 {
+	if x != nil {
+		return x.Analyses
+	}
+	return 0
+}
+
+type TransferCandidateOwnershipRequest struct {
+	state      protoimpl.MessageState `protogen:"open.v1"`
+	FromUserId uint64                 `protobuf:"varint,1,opt,name=from_user_id,json=fromUserId,proto3" json:"from_user_id,omitempty"`
+	ToUserId   uint64                 `protobuf:"varint,2,opt,name=to_user_id,json=toUserId,proto3" json:"to_user_id,omitempty"`
+	// limit caps the batch: 200 when zero, at most 1000.
+	Limit         uint32 `protobuf:"varint,3,opt,name=limit,proto3" json:"limit,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *TransferCandidateOwnershipRequest) Reset() {
+	*x = TransferCandidateOwnershipRequest{}
+	mi := &file_models_resume_model_proto_msgTypes[27]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TransferCandidateOwnershipRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TransferCandidateOwnershipRequest) ProtoMessage() {}
+
+func (x *TransferCandidateOwnershipRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_models_resume_model_proto_msgTypes[27]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TransferCandidateOwnershipRequest.ProtoReflect.Descriptor instead.
+func (*TransferCandidateOwnershipRequest) Descriptor() ([]byte, []int) {
+	return file_models_resume_model_proto_rawDescGZIP(), []int{27}
+}
+
+func (x *TransferCandidateOwnershipRequest) GetFromUserId() uint64 {
+	if x != nil {
+		return x.FromUserId
+	}
+	return 0
+}
+
+func (x *TransferCandidateOwnershipRequest) GetToUserId() uint64 {
+	if x != nil {
+		return x.ToUserId
+	}
+	return 0
+}
+
+func (x *TransferCandidateOwnershipRequest) GetLimit() uint32 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+type TransferCandidateOwnershipResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// transferred is how many candidates this batch moved; zero means none
+	// are left.
+	Transferred   uint32 `protobuf:"varint,1,opt,name=transferred,proto3" json:"transferred,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *TransferCandidateOwnershipResponse) Reset() {
+	*x = TransferCandidateOwnershipResponse{}
+	mi := &file_models_resume_model_proto_msgTypes[28]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TransferCandidateOwnershipResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TransferCandidateOwnershipResponse) ProtoMessage() {}
+
+func (x *TransferCandidateOwnershipResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_models_resume_model_proto_msgTypes[28]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TransferCandidateOwnershipResponse.ProtoReflect.Descriptor instead.
+func (*TransferCandidateOwnershipResponse) Descriptor() ([]byte, []int) {
+	return file_models_resume_model_proto_rawDescGZIP(), []int{28}
+}
+
+func (x *TransferCandidateOwnershipResponse) GetTransferred() uint32 {
+	if x != nil {
+		return x.Transferred
+	}
+	return 0
 }
 
 var File_models_resume_model_proto protoreflect.FileDescriptor
@@ -1231,7 +1723,7 @@ const file_models_resume_model_proto_rawDesc = "" +
 	"\x06source\x18\x06 \x01(\tR\x06source\x12\x18\n" +
 	"\acomment\x18\a \x01(\tR\acomment\x129\n" +
 	"\n" +
-	"created_at\x18\b \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\"\xa2\x02\n" +
+	"created_at\x18\b \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\"\xcf\x02\n" +
 	"\x06Resume\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\tR\x02id\x12!\n" +
 	"\fcandidate_id\x18\x02 \x01(\tR\vcandidateId\x12\x1b\n" +
@@ -1241,7 +1733,8 @@ const file_models_resume_model_proto_rawDesc = "" +
 	"\fstorage_path\x18\x06 \x01(\tR\vstoragePath\x12%\n" +
 	"\x0eextracted_text\x18\a \x01(\tR\rextractedText\x129\n" +
 	"\n" +
-	"created_at\x18\b \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\"\xb2\x01\n" +
+	"created_at\x18\b \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\x12+\n" +
+	"\x11extraction_method\x18\t \x01(\tR\x10extractionMethod\"\xb2\x01\n" +
 	"\x16CreateCandidateRequest\x12\x1d\n" +
 	"\n" +
 	"vacancy_id\x18\x01 \x01(\tR\tvacancyId\x12\x1b\n" +
@@ -1260,7 +1753,17 @@ const file_models_resume_model_proto_rawDesc = "" +
 	" CreateCandidateFromResumeRequest\x12\x1d\n" +
 	"\n" +
 	"vacancy_id\x18\x01 \x01(\tR\tvacancyId\x12\x1b\n" +
-	"\tfile_data\x18\x02 \x01(\fR\bfileData\"P\n" +
+	"\tfile_data\x18\x02 \x01(\fR\bfileData\"\xbd\x01\n" +
+	"\x15ApplyToVacancyRequest\x12\x1d\n" +
+	"\n" +
+	"vacancy_id\x18\x01 \x01(\tR\tvacancyId\x12\x1b\n" +
+	"\tfull_name\x18\x02 \x01(\tR\bfullName\x12\x14\n" +
+	"\x05email\x18\x03 \x01(\tR\x05email\x12\x18\n" +
+	"\aconsent\x18\x04 \x01(\bR\aconsent\x12\x1b\n" +
+	"\tfile_name\x18\x05 \x01(\tR\bfileName\x12\x1b\n" +
+	"\tfile_data\x18\x06 \x01(\fR\bfileData\"?\n" +
+	"\x16ApplyToVacancyResponse\x12%\n" +
+	"\x0eapplication_id\x18\x01 \x01(\tR\rapplicationId\"P\n" +
 	"\x10ResumeIntakeFile\x12\x1f\n" +
 	"\vexternal_id\x18\x01 \x01(\tR\n" +
 	"externalId\x12\x1b\n" +
@@ -1288,7 +1791,15 @@ const file_models_resume_model_proto_rawDesc = "" +
 	"\x05chunk\x18\x02 \x01(\v2#.resume.models.v1.UploadResumeChunkH\x00R\x05chunkB\t\n" +
 	"\apayload\"H\n" +
 	"\x14UploadResumeResponse\x120\n" +
-	"\x06resume\x18\x01 \x01(\v2\x18.resume.models.v1.ResumeR\x06resume\"/\n" +
+	"\x06resume\x18\x01 \x01(\v2\x18.resume.models.v1.ResumeR\x06resume\"[\n" +
+	"\x1dUploadCandidateFromResumeMeta\x12\x1d\n" +
+	"\n" +
+	"vacancy_id\x18\x01 \x01(\tR\tvacancyId\x12\x1b\n" +
+	"\tfile_name\x18\x02 \x01(\tR\bfileName\"\xb1\x01\n" +
+	" UploadCandidateFromResumeRequest\x12E\n" +
+	"\x04meta\x18\x01 \x01(\v2/.resume.models.v1.UploadCandidateFromResumeMetaH\x00R\x04meta\x12;\n" +
+	"\x05chunk\x18\x02 \x01(\v2#.resume.models.v1.UploadResumeChunkH\x00R\x05chunkB\t\n" +
+	"\apayload\"/\n" +
 	"\x10GetResumeRequest\x12\x1b\n" +
 	"\tresume_id\x18\x01 \x01(\tR\bresumeId\"B\n" +
 	"\x0eResumeResponse\x120\n" +
@@ -1301,7 +1812,24 @@ const file_models_resume_model_proto_rawDesc = "" +
 	"\tfile_type\x18\x03 \x01(\tR\bfileType\";\n" +
 	"\x16DeleteCandidateRequest\x12!\n" +
 	"\fcandidate_id\x18\x01 \x01(\tR\vcandidateId\"\x19\n" +
-	"\x17DeleteCandidateResponseB4Z2github.com/artem13815/hr/resume/internal/pb/modelsb\x06proto3"
+	"\x17DeleteCandidateResponse\">\n" +
+	"\x1dPurgeVacancyCandidatesRequest\x12\x1d\n" +
+	"\n" +
+	"vacancy_id\x18\x01 \x01(\tR\tvacancyId\"v\n" +
+	"\x1ePurgeVacancyCandidatesResponse\x12\x1e\n" +
+	"\n" +
+	"candidates\x18\x01 \x01(\rR\n" +
+	"candidates\x12\x18\n" +
+	"\aresumes\x18\x02 \x01(\rR\aresumes\x12\x1a\n" +
+	"\banalyses\x18\x03 \x01(\rR\banalyses\"y\n" +
+	"!TransferCandidateOwnershipRequest\x12 \n" +
+	"\ffrom_user_id\x18\x01 \x01(\x04R\n" +
+	"fromUserId\x12\x1c\n" +
+	"\n" +
+	"to_user_id\x18\x02 \x01(\x04R\btoUserId\x12\x14\n" +
+	"\x05limit\x18\x03 \x01(\rR\x05limit\"F\n" +
+	"\"TransferCandidateOwnershipResponse\x12 \n" +
+	"\vtransferred\x18\x01 \x01(\rR\vtransferredB4Z2github.com/artem13815/hr/resume/internal/pb/modelsb\x06proto3"
 
 var (
 	file_models_resume_model_proto_rawDescOnce sync.Once
@@ -1315,50 +1843,60 @@ func file_models_resume_model_proto_rawDescGZIP() []byte {
 	return file_models_resume_model_proto_rawDescData
 }
 
-var file_models_resume_model_proto_msgTypes = make([]protoimpl.MessageInfo, 21)
+var file_models_resume_model_proto_msgTypes = make([]protoimpl.MessageInfo, 29)
 var file_models_resume_model_proto_goTypes = []any{
-	(*Candidate)(nil),                        // 0: resume.models.v1.Candidate
-	(*Resume)(nil),                           // 1: resume.models.v1.Resume
-	(*CreateCandidateRequest)(nil),           // 2: resume.models.v1.CreateCandidateRequest
-	(*GetCandidateRequest)(nil),              // 3: resume.models.v1.GetCandidateRequest
-	(*CandidateResponse)(nil),                // 4: resume.models.v1.CandidateResponse
-	(*CandidateResumeResponse)(nil),          // 5: resume.models.v1.CandidateResumeResponse
-	(*CreateCandidateFromResumeRequest)(nil), // 6: resume.models.v1.CreateCandidateFromResumeRequest
-	(*ResumeIntakeFile)(nil),                 // 7: resume.models.v1.ResumeIntakeFile
-	(*BatchIngestResumeRequest)(nil),         // 8: resume.models.v1.BatchIngestResumeRequest
-	(*BatchIngestResumeItemResult)(nil),      // 9: resume.models.v1.BatchIngestResumeItemResult
-	(*BatchIngestResumeResponse)(nil),        // 10: resume.models.v1.BatchIngestResumeResponse
-	(*UploadResumeMeta)(nil),                 // 11: resume.models.v1.UploadResumeMeta
-	(*UploadResumeChunk)(nil),                // 12: resume.models.v1.UploadResumeChunk
-	(*UploadResumeRequest)(nil),              // 13: resume.models.v1.UploadResumeRequest
-	(*UploadResumeResponse)(nil),             // 14: resume.models.v1.UploadResumeResponse
-	(*GetResumeRequest)(nil),                 // 15: resume.models.v1.GetResumeRequest
-	(*ResumeResponse)(nil),                   // 16: resume.models.v1.ResumeResponse
-	(*DownloadResumeRequest)(nil),            // 17: resume.models.v1.DownloadResumeRequest
-	(*DownloadResumeResponse)(nil),           // 18: resume.models.v1.DownloadResumeResponse
-	(*DeleteCandidateRequest)(nil),           // 19: resume.models.v1.DeleteCandidateRequest
-	(*DeleteCandidateResponse)(nil),          // 20: resume.models.v1.DeleteCandidateResponse
-	(*timestamppb.Timestamp)(nil),            // 21: google.protobuf.Timestamp
+	(*Candidate)(nil),                          // 0: resume.models.v1.Candidate
+	(*Resume)(nil),                             // 1: resume.models.v1.Resume
+	(*CreateCandidateRequest)(nil),             // 2: resume.models.v1.CreateCandidateRequest
+	(*GetCandidateRequest)(nil),                // 3: resume.models.v1.GetCandidateRequest
+	(*CandidateResponse)(nil),                  // 4: resume.models.v1.CandidateResponse
+	(*CandidateResumeResponse)(nil),            // 5: resume.models.v1.CandidateResumeResponse
+	(*CreateCandidateFromResumeRequest)(nil),   // 6: resume.models.v1.CreateCandidateFromResumeRequest
+	(*ApplyToVacancyRequest)(nil),              // 7: resume.models.v1.ApplyToVacancyRequest
+	(*ApplyToVacancyResponse)(nil),             // 8: resume.models.v1.ApplyToVacancyResponse
+	(*ResumeIntakeFile)(nil),                   // 9: resume.models.v1.ResumeIntakeFile
+	(*BatchIngestResumeRequest)(nil),           // 10: resume.models.v1.BatchIngestResumeRequest
+	(*BatchIngestResumeItemResult)(nil),        // 11: resume.models.v1.BatchIngestResumeItemResult
+	(*BatchIngestResumeResponse)(nil),          // 12: resume.models.v1.BatchIngestResumeResponse
+	(*UploadResumeMeta)(nil),                   // 13: resume.models.v1.UploadResumeMeta
+	(*UploadResumeChunk)(nil),                  // 14: resume.models.v1.UploadResumeChunk
+	(*UploadResumeRequest)(nil),                // 15: resume.models.v1.UploadResumeRequest
+	(*UploadResumeResponse)(nil),               // 16: resume.models.v1.UploadResumeResponse
+	(*UploadCandidateFromResumeMeta)(nil),      // 17: resume.models.v1.UploadCandidateFromResumeMeta
+	(*UploadCandidateFromResumeRequest)(nil),   // 18: resume.models.v1.UploadCandidateFromResumeRequest
+	(*GetResumeRequest)(nil),                   // 19: resume.models.v1.GetResumeRequest
+	(*ResumeResponse)(nil),                     // 20: resume.models.v1.ResumeResponse
+	(*DownloadResumeRequest)(nil),              // 21: resume.models.v1.DownloadResumeRequest
+	(*DownloadResumeResponse)(nil),             // 22: resume.models.v1.DownloadResumeResponse
+	(*DeleteCandidateRequest)(nil),             // 23: resume.models.v1.DeleteCandidateRequest
+	(*DeleteCandidateResponse)(nil),            // 24: resume.models.v1.DeleteCandidateResponse
+	(*PurgeVacancyCandidatesRequest)(nil),      // 25: resume.models.v1.PurgeVacancyCandidatesRequest
+	(*PurgeVacancyCandidatesResponse)(nil),     // 26: resume.models.v1.PurgeVacancyCandidatesResponse
+	(*TransferCandidateOwnershipRequest)(nil),  // 27: resume.models.v1.TransferCandidateOwnershipRequest
+	(*TransferCandidateOwnershipResponse)(nil), // 28: resume.models.v1.TransferCandidateOwnershipResponse
+	(*timestamppb.Timestamp)(nil),              // 29: google.protobuf.Timestamp
 }
 var file_models_resume_model_proto_depIdxs = []int32{
-	21, // 0: resume.models.v1.Candidate.created_at:type_name -> google.protobuf.Timestamp
-	21, // 1: resume.models.v1.Resume.created_at:type_name -> google.protobuf.Timestamp
+	29, // 0: resume.models.v1.Candidate.created_at:type_name -> google.protobuf.Timestamp
+	29, // 1: resume.models.v1.Resume.created_at:type_name -> google.protobuf.Timestamp
 	0,  // 2: resume.models.v1.CandidateResponse.candidate:type_name -> resume.models.v1.Candidate
 	0,  // 3: resume.models.v1.CandidateResumeResponse.candidate:type_name -> resume.models.v1.Candidate
 	1,  // 4: resume.models.v1.CandidateResumeResponse.resume:type_name -> resume.models.v1.Resume
-	7,  // 5: resume.models.v1.BatchIngestResumeRequest.files:type_name -> resume.models.v1.ResumeIntakeFile
+	9,  // 5: resume.models.v1.BatchIngestResumeRequest.files:type_name -> resume.models.v1.ResumeIntakeFile
 	0,  // 6: resume.models.v1.BatchIngestResumeItemResult.candidate:type_name -> resume.models.v1.Candidate
 	1,  // 7: resume.models.v1.BatchIngestResumeItemResult.resume:type_name -> resume.models.v1.Resume
-	9,  // 8: resume.models.v1.BatchIngestResumeResponse.results:type_name -> resume.models.v1.BatchIngestResumeItemResult
-	11, // 9: resume.models.v1.UploadResumeRequest.meta:type_name -> resume.models.v1.UploadResumeMeta
-	12, // 10: resume.models.v1.UploadResumeRequest.chunk:type_name -> resume.models.v1.UploadResumeChunk
+	11, // 8: resume.models.v1.BatchIngestResumeResponse.results:type_name -> resume.models.v1.BatchIngestResumeItemResult
+	13, // 9: resume.models.v1.UploadResumeRequest.meta:type_name -> resume.models.v1.UploadResumeMeta
+	14, // 10: resume.models.v1.UploadResumeRequest.chunk:type_name -> resume.models.v1.UploadResumeChunk
 	1,  // 11: resume.models.v1.UploadResumeResponse.resume:type_name -> resume.models.v1.Resume
-	1,  // 12: resume.models.v1.ResumeResponse.resume:type_name -> resume.models.v1.Resume
-	13, // [13:13] is the sub-list for method output_type
-	13, // [13:13] is the sub-list for method input_type
-	13, // [13:13] is the sub-list for extension type_name
-	13, // [13:13] is the sub-list for extension extendee
-	0,  // [0:13] is the sub-list for field type_name
+	17, // 12: resume.models.v1.UploadCandidateFromResumeRequest.meta:type_name -> resume.models.v1.UploadCandidateFromResumeMeta
+	14, // 13: resume.models.v1.UploadCandidateFromResumeRequest.chunk:type_name -> resume.models.v1.UploadResumeChunk
+	1,  // 14: resume.models.v1.ResumeResponse.resume:type_name -> resume.models.v1.Resume
+	15, // [15:15] is the sub-list for method output_type
+	15, // [15:15] is the sub-list for method input_type
+	15, // [15:15] is the sub-list for extension type_name
+	15, // [15:15] is the sub-list for extension extendee
+	0,  // [0:15] is the sub-list for field type_name
 }
 
 func init() { file_models_resume_model_proto_init() }
@@ -1366,17 +1904,21 @@ func file_models_resume_model_proto_init() {
 	if File_models_resume_model_proto != nil {
 		return
 	}
-	file_models_resume_model_proto_msgTypes[13].OneofWrappers = []any{
+	file_models_resume_model_proto_msgTypes[15].OneofWrappers = []any{
 		(*UploadResumeRequest_Meta)(nil),
 		(*UploadResumeRequest_Chunk)(nil),
 	}
+	file_models_resume_model_proto_msgTypes[18].OneofWrappers = []any{
+		(*UploadCandidateFromResumeRequest_Meta)(nil),
+		(*UploadCandidateFromResumeRequest_Chunk)(nil),
+	}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_models_resume_model_proto_rawDesc), len(file_models_resume_model_proto_rawDesc)),
 			NumEnums:      0,
-			NumMessages:   21,
+			NumMessages:   29,
 			NumExtensions: 0,
 			NumServices:   0,
 		},
@@ -0,0 +1,49 @@
+package grpc
+
+import (
+	"context"
+	"errors"
+	"log/slog"
+
+	"github.com/artem13815/hr/resume/internal/domain"
+	pb_models "github.com/artem13815/hr/resume/internal/pb/models"
+	"github.com/artem13815/hr/resume/internal/usecase"
+	"google.golang.org/grpc/codes"
+)
+
+// ApplyToVacancy is public (see middleware.publicMethods): there is no
+// caller identity, so abuse is held back by the IP and email buckets.
+func (a *ResumeServiceAPI) ApplyToVacancy(ctx context.Context, req *pb_models.ApplyToVacancyRequest) (*pb_models.ApplyToVacancyResponse, error) {
+	ip := clientIP(ctx)
+	emailKey := emailRateKey(req.GetEmail())
+	if !a.applyIPLimiter.Allow(ctx, "ip:"+ip) || !a.applyMailLimiter.Allow(ctx, "email:"+emailKey) {
+		slog.InfoContext(ctx, "public apply rate limited", "ip", ip, "email_hash", emailKey)
+		return nil, newError(codes.ResourceExhausted, ErrCodeRateLimitExceeded, "Too many applications. Please try again later.")
+	}
+
+	res, err := a.resumeService.ApplyToVacancy(ctx, domain.ApplyToVacancyInput{
+		VacancyID: req.GetVacancyId(),
+		FullName:  req.GetFullName(),
+		Email:     req.GetEmail(),
+		Consent:   req.GetConsent(),
+		FileName:  req.GetFileName(),
+		FileData:  req.GetFileData(),
+	})
+	if err != nil {
+		switch {
+		case errors.Is(err, usecase.ErrConsentRequired):
+			return nil, newError(codes.InvalidArgument, ErrCodeConsentRequired, "Consent to personal data processing is required.")
+		case errors.Is(err, usecase.ErrInvalidArgument):
+			return nil, newError(codes.InvalidArgument, ErrCodeInvalidInput, "Invalid application.")
+		case errors.Is(err, usecase.ErrNotFound):
+			return nil, newError(codes.NotFound, ErrCodeNotFound, "Vacancy not found.")
+		case errors.Is(err, usecase.ErrVacancyClosed):
+			return nil, newError(codes.FailedPrecondition, ErrCodeVacancyClosed, "Vacancy is not accepting candidates.")
+		default:
+			return nil, newError(codes.Internal, ErrCodeInternal, "Internal error.")
+		}
+	}
+
+	slog.InfoContext(ctx, "public application stored", "candidate_id", res.Candidate.ID, "vacancy_id", res.Candidate.VacancyID)
+	return &pb_models.ApplyToVacancyResponse{ApplicationId: res.Candidate.ID}, nil
+}
@@ -0,0 +1,55 @@
+package grpc
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"net"
+	"strings"
+
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/peer"
+)
+
+// Metadata header the gateway sets to forward the real client IP. Without
+// it, peer.FromContext only sees the gateway container's address, which
+// would collapse all public traffic into a single rate-limit bucket.
+const mdClientIP = "x-client-ip"
+
+func clientIP(ctx context.Context) string {
+	if md, ok := metadata.FromIncomingContext(ctx); ok {
+		if forwarded := md.Get(mdClientIP); len(forwarded) > 0 {
+			if ip := strings.TrimSpace(forwarded[0]); ip != "" {
+				return ip
+			}
+		}
+	}
+	if ip := peerIP(ctx); ip != "" {
+		return ip
+	}
+	return "unknown"
+}
+
+func peerIP(ctx context.Context) string {
+	p, ok := peer.FromContext(ctx)
+	if !ok || p.Addr == nil {
+		return ""
+	}
+	addr := p.Addr.String()
+	host, _, err := net.SplitHostPort(addr)
+	if err != nil {
+		return strings.Trim(addr, "[]")
+	}
+	return strings.Trim(host, "[]")
+}
+
+// emailRateKey hashes the address so raw applicant emails never end up in
+// Redis keys or logs.
+func emailRateKey(email string) string {
+	normalized := strings.ToLower(strings.TrimSpace(email))
+	if normalized == "" {
+		return "empty"
+	}
+	sum := sha256.Sum256([]byte(normalized))
+	return hex.EncodeToString(sum[:8])
+}
@@ -30,6 +30,8 @@ func (a *ResumeServiceAPI) CreateCandidate(ctx context.Context, req *pb_models.C
 		switch {
 		case errors.Is(err, usecase.ErrInvalidArgument):
 			return nil, newError(codes.InvalidArgument, ErrCodeInvalidInput, "Invalid candidate payload.")
+		case errors.Is(err, usecase.ErrVacancyClosed):
+			return nil, newError(codes.FailedPrecondition, ErrCodeVacancyClosed, "Vacancy is not accepting candidates.")
 		default:
 			return nil, newError(codes.Internal, ErrCodeInternal, "Internal error.")
 		}
@@ -32,6 +32,8 @@ func (a *ResumeServiceAPI) CreateCandidateFromResume(ctx context.Context, req *p
 		switch {
 		case errors.Is(err, usecase.ErrInvalidArgument):
 			return nil, newError(codes.InvalidArgument, ErrCodeInvalidInput, "Invalid resume payload.")
+		case errors.Is(err, usecase.ErrVacancyClosed):
+			return nil, newError(codes.FailedPrecondition, ErrCodeVacancyClosed, "Vacancy is not accepting candidates.")
 		default:
 			return nil, newError(codes.Internal, ErrCodeInternal, "Internal error.")
 		}
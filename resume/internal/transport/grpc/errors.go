@@ -11,6 +11,18 @@ const (
 	ErrCodeUnauthorized = "UNAUTHORIZED"
 	ErrCodeNotFound     = "NOT_FOUND"
 	ErrCodeInternal     = "INTERNAL_ERROR"
+	// ErrCodeVacancyClosed accompanies codes.FailedPrecondition when the
+	// target vacancy is closed, filled or archived.
+	ErrCodeVacancyClosed = "VACANCY_CLOSED"
+	// ErrCodeConsentRequired rejects a public application without the
+	// personal-data consent.
+	ErrCodeConsentRequired = "CONSENT_REQUIRED"
+	// ErrCodeRateLimitExceeded accompanies codes.ResourceExhausted on the
+	// public apply flow.
+	ErrCodeRateLimitExceeded = "RATE_LIMIT_EXCEEDED"
+	// ErrCodeForbidden accompanies codes.PermissionDenied on admin-only
+	// RPCs.
+	ErrCodeForbidden = "FORBIDDEN"
 
 	// errorDomain identifies which service surface produced the error. Clients
 	// that aggregate errors from multiple services use Domain to disambiguate
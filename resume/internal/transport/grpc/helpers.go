@@ -21,13 +21,14 @@ func toPBCandidate(c domain.Candidate) *pb_models.Candidate {
 
 func toPBResume(r domain.Resume) *pb_models.Resume {
 	return &pb_models.Resume{
-		Id:            r.ID,
-		CandidateId:   r.CandidateID,
-		FileName:      r.FileName,
-		FileType:      r.FileType,
-		FileSizeBytes: r.FileSizeBytes,
-		StoragePath:   r.StoragePath,
-		ExtractedText: r.ExtractedText,
-		CreatedAt:     timestamppb.New(r.CreatedAt),
+		Id:               r.ID,
+		CandidateId:      r.CandidateID,
+		FileName:         r.FileName,
+		FileType:         r.FileType,
+		FileSizeBytes:    r.FileSizeBytes,
+		StoragePath:      r.StoragePath,
+		ExtractedText:    r.ExtractedText,
+		CreatedAt:        timestamppb.New(r.CreatedAt),
+		ExtractionMethod: string(r.ExtractionMethod),
 	}
 }
@@ -26,6 +26,8 @@ func (a *ResumeServiceAPI) IngestResume(ctx context.Context, req *pb_models.Crea
 		switch {
 		case errors.Is(err, usecase.ErrInvalidArgument):
 			return nil, newError(codes.InvalidArgument, ErrCodeInvalidInput, "Invalid resume payload.")
+		case errors.Is(err, usecase.ErrVacancyClosed):
+			return nil, newError(codes.FailedPrecondition, ErrCodeVacancyClosed, "Vacancy is not accepting candidates.")
 		default:
 			return nil, newError(codes.Internal, ErrCodeInternal, "Internal error.")
 		}
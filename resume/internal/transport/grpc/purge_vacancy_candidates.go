@@ -0,0 +1,32 @@
+package grpc
+
+import (
+	"context"
+	"errors"
+
+	pb_models "github.com/artem13815/hr/resume/internal/pb/models"
+	"github.com/artem13815/hr/resume/internal/usecase"
+	"google.golang.org/grpc/codes"
+)
+
+// PurgeVacancyCandidates is internal (see middleware.internalMethods): the
+// caller is the vacancy service, not a user.
+func (a *ResumeServiceAPI) PurgeVacancyCandidates(ctx context.Context, req *pb_models.PurgeVacancyCandidatesRequest) (*pb_models.PurgeVacancyCandidatesResponse, error) {
+	res, err := a.resumeService.PurgeVacancyCandidates(ctx, req.GetVacancyId())
+	if err != nil {
+		switch {
+		case errors.Is(err, usecase.ErrInvalidArgument):
+			return nil, newError(codes.InvalidArgument, ErrCodeInvalidInput, "Invalid vacancy id.")
+		case errors.Is(err, usecase.ErrNotFound):
+			return nil, newError(codes.NotFound, ErrCodeNotFound, "Vacancy is not being purged.")
+		default:
+			return nil, newError(codes.Internal, ErrCodeInternal, "Internal error.")
+		}
+	}
+
+	return &pb_models.PurgeVacancyCandidatesResponse{
+		Candidates: res.Candidates,
+		Resumes:    res.Resumes,
+		Analyses:   res.Analyses,
+	}, nil
+}
@@ -16,15 +16,37 @@ type resumeService interface {
 	GetResume(ctx context.Context, in domain.GetResumeInput) (*domain.Resume, error)
 	DownloadResume(ctx context.Context, in domain.DownloadResumeInput) (*domain.ResumeFile, error)
 	DeleteCandidate(ctx context.Context, in domain.DeleteCandidateInput) error
+	PurgeVacancyCandidates(ctx context.Context, vacancyID string) (*domain.PurgeVacancyCandidatesResult, error)
+	TransferCandidateOwnership(ctx context.Context, in domain.TransferCandidateOwnershipInput) (uint32, error)
+	ApplyToVacancy(ctx context.Context, in domain.ApplyToVacancyInput) (*domain.CandidateResumeResult, error)
+}
+
+// RateLimiter is the consumer-side interface; the concrete implementation
+// (rate_limit.Limiter) lives in infrastructure/rate_limit and is wired by
+// bootstrap.
+type RateLimiter interface {
+	Allow(ctx context.Context, key string) bool
 }
 
 type ResumeServiceAPI struct {
 	resume_api.UnimplementedResumeServiceServer
-	resumeService resumeService
+	resumeService    resumeService
+	applyIPLimiter   RateLimiter
+	applyMailLimiter RateLimiter
 }
 
-func NewResumeServiceAPI(service resumeService) *ResumeServiceAPI {
-	return &ResumeServiceAPI{resumeService: service}
+// NewResumeServiceAPI requires non-nil limiters: bootstrap always passes the
+// Redis-backed ones, so nil is a wiring bug and fails fast here instead of
+// on the first public application.
+func NewResumeServiceAPI(service resumeService, applyIPLimiter, applyMailLimiter RateLimiter) *ResumeServiceAPI {
+	if applyIPLimiter == nil || applyMailLimiter == nil {
+		panic("resume_service_api: apply rate limiters must be non-nil")
+	}
+	return &ResumeServiceAPI{
+		resumeService:    service,
+		applyIPLimiter:   applyIPLimiter,
+		applyMailLimiter: applyMailLimiter,
+	}
 }
 
 var _ resume_api.ResumeServiceServer = (*ResumeServiceAPI)(nil)
@@ -0,0 +1,38 @@
+package grpc
+
+import (
+	"context"
+	"errors"
+
+	"github.com/artem13815/hr/resume/internal/domain"
+	pb_models "github.com/artem13815/hr/resume/internal/pb/models"
+	"github.com/artem13815/hr/resume/internal/transport/middleware"
+	"github.com/artem13815/hr/resume/internal/usecase"
+	"google.golang.org/grpc/codes"
+)
+
+func (a *ResumeServiceAPI) TransferCandidateOwnership(ctx context.Context, req *pb_models.TransferCandidateOwnershipRequest) (*pb_models.TransferCandidateOwnershipResponse, error) {
+	userCtx, ok := middleware.Get(ctx)
+	if !ok {
+		return nil, newError(codes.Unauthenticated, ErrCodeUnauthorized, "Authentication required.")
+	}
+
+	moved, err := a.resumeService.TransferCandidateOwnership(ctx, domain.TransferCandidateOwnershipInput{
+		IsAdmin:    userCtx.IsAdmin,
+		FromUserID: req.GetFromUserId(),
+		ToUserID:   req.GetToUserId(),
+		Limit:      req.GetLimit(),
+	})
+	if err != nil {
+		switch {
+		case errors.Is(err, usecase.ErrForbidden):
+			return nil, newError(codes.PermissionDenied, ErrCodeForbidden, "Only admins can transfer candidate ownership.")
+		case errors.Is(err, usecase.ErrInvalidArgument):
+			return nil, newError(codes.InvalidArgument, ErrCodeInvalidInput, "Two different user ids are required; limit must not exceed 1000.")
+		default:
+			return nil, newError(codes.Internal, ErrCodeInternal, "Internal error.")
+		}
+	}
+
+	return &pb_models.TransferCandidateOwnershipResponse{Transferred: moved}, nil
+}
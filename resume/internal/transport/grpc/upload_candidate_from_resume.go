@@ -0,0 +1,49 @@
+package grpc
+
+import (
+	"errors"
+
+	"github.com/artem13815/hr/resume/internal/domain"
+	pb_models "github.com/artem13815/hr/resume/internal/pb/models"
+	"github.com/artem13815/hr/resume/internal/pb/resume_api"
+	"github.com/artem13815/hr/resume/internal/transport/middleware"
+	"github.com/artem13815/hr/resume/internal/usecase"
+	"google.golang.org/grpc/codes"
+)
+
+func (a *ResumeServiceAPI) UploadCandidateFromResume(stream resume_api.ResumeService_UploadCandidateFromResumeServer) error {
+	userCtx, ok := middleware.Get(stream.Context())
+	if !ok {
+		return newError(codes.Unauthenticated, ErrCodeUnauthorized, "Authentication required.")
+	}
+
+	meta, data, err := recvUpload(stream.Recv)
+	if err != nil {
+		return err
+	}
+	if len(data) == 0 {
+		return newError(codes.InvalidArgument, ErrCodeInvalidInput, "Resume file size out of range.")
+	}
+
+	res, err := a.resumeService.CreateCandidateFromResume(stream.Context(), domain.CreateCandidateFromResumeInput{
+		RequestUserID: userCtx.UserID,
+		VacancyID:     meta.GetVacancyId(),
+		FileName:      meta.GetFileName(),
+		FileData:      data,
+	})
+	if err != nil {
+		switch {
+		case errors.Is(err, usecase.ErrInvalidArgument):
+			return newError(codes.InvalidArgument, ErrCodeInvalidInput, "Invalid resume payload.")
+		case errors.Is(err, usecase.ErrVacancyClosed):
+			return newError(codes.FailedPrecondition, ErrCodeVacancyClosed, "Vacancy is not accepting candidates.")
+		default:
+			return newError(codes.Internal, ErrCodeInternal, "Internal error.")
+		}
+	}
+
+	return stream.SendAndClose(&pb_models.CandidateResumeResponse{
+		Candidate: toPBCandidate(*res.Candidate),
+		Resume:    toPBResume(*res.Resume),
+	})
+}
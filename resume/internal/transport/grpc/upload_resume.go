@@ -1,9 +1,7 @@
 package grpc
 
 import (
-	"bytes"
 	"errors"
-	"io"
 
 	"github.com/artem13815/hr/resume/internal/domain"
 	pb_models "github.com/artem13815/hr/resume/internal/pb/models"
@@ -19,41 +17,9 @@ func (a *ResumeServiceAPI) UploadResume(stream resume_api.ResumeService_UploadRe
 		return newError(codes.Unauthenticated, ErrCodeUnauthorized, "Authentication required.")
 	}
 
-	var meta *pb_models.UploadResumeMeta
-	buf := bytes.NewBuffer(nil)
-	buf.Grow(64 * 1024)
-
-	for {
-		req, recvErr := stream.Recv()
-		if errors.Is(recvErr, io.EOF) {
-			break
-		}
-		if recvErr != nil {
-			return newError(codes.InvalidArgument, ErrCodeInvalidInput, "Invalid stream payload.")
-		}
-
-		if m := req.GetMeta(); m != nil {
-			if meta != nil {
-				return newError(codes.InvalidArgument, ErrCodeInvalidInput, "Metadata already provided.")
-			}
-			meta = m
-			continue
-		}
-
-		if c := req.GetChunk(); c != nil {
-			if meta == nil {
-				return newError(codes.InvalidArgument, ErrCodeInvalidInput, "Metadata must be sent first.")
-			}
-			data := c.GetData()
-			if buf.Len()+len(data) > usecase.MaxResumeSizeBytes {
-				return newError(codes.InvalidArgument, ErrCodeInvalidInput, "Resume exceeds maximum size.")
-			}
-			_, _ = buf.Write(data)
-		}
-	}
-
-	if meta == nil {
-		return newError(codes.InvalidArgument, ErrCodeInvalidInput, "Missing metadata.")
+	meta, data, err := recvUpload(stream.Recv)
+	if err != nil {
+		return err
 	}
 
 	resume, err := a.resumeService.UploadResume(stream.Context(), domain.UploadResumeInput{
@@ -62,7 +28,7 @@ func (a *ResumeServiceAPI) UploadResume(stream resume_api.ResumeService_UploadRe
 		CandidateID:   meta.GetCandidateId(),
 		FileName:      meta.GetFileName(),
 		FileType:      meta.GetFileType(),
-		Data:          buf.Bytes(),
+		Data:          data,
 	})
 	if err != nil {
 		switch {
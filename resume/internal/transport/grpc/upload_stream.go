@@ -0,0 +1,62 @@
+package grpc
+
+import (
+	"bytes"
+	"errors"
+	"io"
+
+	pb_models "github.com/artem13815/hr/resume/internal/pb/models"
+	"github.com/artem13815/hr/resume/internal/usecase"
+	"google.golang.org/grpc/codes"
+)
+
+// uploadRequest is a client-streaming upload message: one meta first, then
+// file chunks.
+type uploadRequest[M comparable] interface {
+	GetMeta() M
+	GetChunk() *pb_models.UploadResumeChunk
+}
+
+// recvUpload drains an upload stream until EOF and returns its meta and the
+// file bytes, capped at usecase.MaxResumeSizeBytes. Protocol violations
+// come back as ready-to-return InvalidArgument errors. Pass the stream's
+// Recv method.
+func recvUpload[M comparable, R uploadRequest[M]](recv func() (R, error)) (M, []byte, error) {
+	var meta, none M
+	buf := bytes.NewBuffer(nil)
+	buf.Grow(64 * 1024)
+
+	for {
+		req, recvErr := recv()
+		if errors.Is(recvErr, io.EOF) {
+			break
+		}
+		if recvErr != nil {
+			return none, nil, newError(codes.InvalidArgument, ErrCodeInvalidInput, "Invalid stream payload.")
+		}
+
+		if m := req.GetMeta(); m != none {
+			if meta != none {
+				return none, nil, newError(codes.InvalidArgument, ErrCodeInvalidInput, "Metadata already provided.")
+			}
+			meta = m
+			continue
+		}
+
+		if c := req.GetChunk(); c != nil {
+			if meta == none {
+				return none, nil, newError(codes.InvalidArgument, ErrCodeInvalidInput, "Metadata must be sent first.")
+			}
+			data := c.GetData()
+			if buf.Len()+len(data) > usecase.MaxResumeSizeBytes {
+				return none, nil, newError(codes.InvalidArgument, ErrCodeInvalidInput, "Resume exceeds maximum size.")
+			}
+			_, _ = buf.Write(data)
+		}
+	}
+
+	if meta == none {
+		return none, nil, newError(codes.InvalidArgument, ErrCodeInvalidInput, "Missing metadata.")
+	}
+	return meta, buf.Bytes(), nil
+}
@@ -11,6 +11,7 @@ import (
 	"google.golang.org/grpc/status"
 
 	"github.com/artem13815/hr/resume/internal/pb/auth_api"
+	"github.com/artem13815/hr/resume/internal/pb/resume_api"
 )
 
 // authValidator is the narrow surface of auth_api.AuthServiceClient that the
@@ -20,13 +21,35 @@ type authValidator interface {
 	ValidateAccessToken(ctx context.Context, in *auth_api.ValidateAccessTokenRequest, opts ...grpc.CallOption) (*auth_api.ValidateAccessTokenResponse, error)
 }
 
+// publicMethods are served without a bearer: the candidate-facing apply
+// form. Their handlers never call Get; the usecase only accepts vacancies
+// published to the public feed.
+var publicMethods = map[string]struct{}{
+	resume_api.ResumeService_ApplyToVacancy_FullMethodName: {},
+}
+
+// internalMethods are called service-to-service without a user bearer and
+// have no HTTP binding, so the gateway never exposes them. Their handlers
+// never call Get; the storage refuses anything the caller has not already
+// committed to on its side.
+var internalMethods = map[string]struct{}{
+	resume_api.ResumeService_PurgeVacancyCandidates_FullMethodName: {},
+}
+
 // UnaryAuthInterceptor validates the JWT on every RPC by calling the auth
 // service. The resulting identity is attached to the context — handlers MUST
 // read it via Get, never from the raw gRPC metadata, otherwise an attacker
 // on the docker network could impersonate any user just by setting
-// x-user-id.
+// x-user-id. publicMethods and internalMethods pass through
+// unauthenticated.
 func UnaryAuthInterceptor(authClient authValidator) grpc.UnaryServerInterceptor {
 	return func(ctx context.Context, req any, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (any, error) {
+		if _, ok := publicMethods[info.FullMethod]; ok {
+			return handler(ctx, req)
+		}
+		if _, ok := internalMethods[info.FullMethod]; ok {
+			return handler(ctx, req)
+		}
 		uc, err := authenticate(ctx, authClient)
 		if err != nil {
 			return nil, err
@@ -0,0 +1,105 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"net/mail"
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	"github.com/artem13815/hr/resume/internal/domain"
+)
+
+const (
+	// maxApplicantNameRunes mirrors candidates.full_name VARCHAR(255).
+	maxApplicantNameRunes = 255
+	// maxApplicantEmailLen is the RFC 5321 path limit; the column allows 255.
+	maxApplicantEmailLen = 254
+)
+
+// ApplyToVacancy stores a public application. Unlike
+// CreateCandidateFromResume, name and email come from the form and are
+// trusted over whatever the profile extractor would find; only the phone is
+// taken from the resume. The candidate is owned by the vacancy owner, so it
+// shows up in their pipeline like any candidate HR added, and the analysis
+// is started straight away.
+func (s *ResumeService) ApplyToVacancy(ctx context.Context, in domain.ApplyToVacancyInput) (*domain.CandidateResumeResult, error) {
+	vacancyID := strings.TrimSpace(in.VacancyID)
+	fullName := strings.TrimSpace(in.FullName)
+	email := strings.TrimSpace(in.Email)
+	if vacancyID == "" || fullName == "" || utf8.RuneCountInString(fullName) > maxApplicantNameRunes || !validEmail(email) {
+		return nil, ErrInvalidArgument
+	}
+	if !in.Consent {
+		return nil, ErrConsentRequired
+	}
+	if len(in.FileData) == 0 || len(in.FileData) > MaxResumeSizeBytes {
+		return nil, ErrInvalidArgument
+	}
+
+	ownerUserID, err := s.storage.PublicVacancyOwner(ctx, vacancyID)
+	if err != nil {
+		if errors.Is(err, domain.ErrNotFound) {
+			return nil, ErrNotFound
+		}
+		return nil, err
+	}
+
+	fileType, err := s.extractor.DetectFileType(in.FileName, in.FileData)
+	if err != nil {
+		return nil, ErrInvalidArgument
+	}
+	fileName := s.extractor.BuildFileName(in.FileName, fileType)
+
+	extraction, err := s.extractor.ExtractText(fileType, in.FileData)
+	if err != nil {
+		return nil, ErrInvalidArgument
+	}
+
+	profile := s.profile.ExtractCandidateProfile(extraction.Text, fileName)
+	consentedAt := time.Now().UTC()
+
+	storagePath, err := s.blobs.Put(ctx, in.FileData)
+	if err != nil {
+		return nil, err
+	}
+
+	candidate, resume, err := s.storage.CreateCandidateWithResume(ctx,
+		domain.CreateCandidateInput{
+			RequestUserID: ownerUserID,
+			VacancyID:     vacancyID,
+			FullName:      fullName,
+			Email:         email,
+			Phone:         profile.Phone,
+			Source:        domain.SourcePublicApply,
+			ConsentedAt:   &consentedAt,
+		},
+		domain.NewResumeData{
+			FileName:         fileName,
+			FileType:         fileType,
+			ExtractedText:    extraction.Text,
+			ExtractionMethod: extraction.Method,
+			Data:             in.FileData,
+			StoragePath:      storagePath,
+		},
+	)
+	if err != nil {
+		s.discardBlobs(ctx, storagePath)
+		return nil, err
+	}
+
+	s.analyzer.StartApplicationAnalysis(ctx, resume.ID)
+
+	return &domain.CandidateResumeResult{Candidate: candidate, Resume: resume}, nil
+}
+
+// validEmail accepts a bare address only: "Jane <jane@example.com>" parses
+// but is not what the form field should carry.
+func validEmail(email string) bool {
+	if email == "" || len(email) > maxApplicantEmailLen {
+		return false
+	}
+	addr, err := mail.ParseAddress(email)
+	return err == nil && addr.Address == email
+}
@@ -0,0 +1,134 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+	"gotest.tools/v3/assert"
+
+	"github.com/artem13815/hr/resume/internal/domain"
+)
+
+type ApplyToVacancySuite struct{ baseSuite }
+
+func validApplication() domain.ApplyToVacancyInput {
+	return domain.ApplyToVacancyInput{
+		VacancyID: " vac-1 ",
+		FullName:  " Иван Петров ",
+		Email:     "ivan@example.com",
+		Consent:   true,
+		FileName:  "cv.txt",
+		FileData:  resumeText,
+	}
+}
+
+// TestSuccess: the candidate goes to the vacancy owner with the form's name
+// and email (not the ones found in the resume), the consent time is
+// recorded and the analysis is started for the new resume.
+func (s *ApplyToVacancySuite) TestSuccess() {
+	t := s.T()
+	ctx := t.Context()
+
+	wantCandidate := &domain.Candidate{ID: "c-1"}
+	wantResume := &domain.Resume{ID: "r-1", CandidateID: "c-1"}
+
+	s.storage.PublicVacancyOwnerMock.Expect(ctx, "vac-1").Return(42, nil)
+	s.blobs.PutMock.Return(testBlobKey, nil)
+	s.storage.CreateCandidateWithResumeMock.Set(func(_ context.Context, candidateIn domain.CreateCandidateInput, resumeIn domain.NewResumeData) (*domain.Candidate, *domain.Resume, error) {
+		assert.Equal(t, candidateIn.RequestUserID, uint64(42))
+		assert.Equal(t, candidateIn.VacancyID, "vac-1")
+		assert.Equal(t, candidateIn.FullName, "Иван Петров")
+		assert.Equal(t, candidateIn.Email, "ivan@example.com")
+		assert.Equal(t, candidateIn.Phone, "+15555551234")
+		assert.Equal(t, candidateIn.Source, domain.SourcePublicApply)
+		assert.Assert(t, candidateIn.ConsentedAt != nil)
+		assert.Equal(t, resumeIn.FileName, "cv.txt")
+		assert.Equal(t, resumeIn.FileType, "txt")
+		assert.Equal(t, resumeIn.StoragePath, testBlobKey)
+		return wantCandidate, wantResume, nil
+	})
+	s.analyzer.StartApplicationAnalysisMock.Expect(ctx, "r-1").Return()
+
+	got, err := s.svc.ApplyToVacancy(ctx, validApplication())
+	assert.NilError(t, err)
+	assert.Equal(t, got.Candidate, wantCandidate)
+	assert.Equal(t, got.Resume, wantResume)
+}
+
+func (s *ApplyToVacancySuite) TestInvalidInput() {
+	cases := map[string]func(*domain.ApplyToVacancyInput){
+		"no vacancy":     func(in *domain.ApplyToVacancyInput) { in.VacancyID = " " },
+		"no name":        func(in *domain.ApplyToVacancyInput) { in.FullName = "" },
+		"long name":      func(in *domain.ApplyToVacancyInput) { in.FullName = strings.Repeat("я", 256) },
+		"no email":       func(in *domain.ApplyToVacancyInput) { in.Email = "" },
+		"bad email":      func(in *domain.ApplyToVacancyInput) { in.Email = "ivan" },
+		"display name":   func(in *domain.ApplyToVacancyInput) { in.Email = "Ivan <ivan@example.com>" },
+		"empty file":     func(in *domain.ApplyToVacancyInput) { in.FileData = nil },
+		"oversized file": func(in *domain.ApplyToVacancyInput) { in.FileData = make([]byte, MaxResumeSizeBytes+1) },
+	}
+	for name, mutate := range cases {
+		s.Run(name, func() {
+			in := validApplication()
+			mutate(&in)
+			got, err := s.svc.ApplyToVacancy(s.T().Context(), in)
+			assert.ErrorIs(s.T(), err, ErrInvalidArgument)
+			assert.Assert(s.T(), got == nil)
+		})
+	}
+}
+
+func (s *ApplyToVacancySuite) TestConsentRequired() {
+	t := s.T()
+	in := validApplication()
+	in.Consent = false
+
+	_, err := s.svc.ApplyToVacancy(t.Context(), in)
+	assert.ErrorIs(t, err, ErrConsentRequired)
+}
+
+// TestVacancyNotPublic: private, draft or closed vacancies all look like a
+// missing one to the public form.
+func (s *ApplyToVacancySuite) TestVacancyNotPublic() {
+	t := s.T()
+	s.storage.PublicVacancyOwnerMock.Return(0, domain.ErrNotFound)
+
+	_, err := s.svc.ApplyToVacancy(t.Context(), validApplication())
+	assert.ErrorIs(t, err, ErrNotFound)
+}
+
+func (s *ApplyToVacancySuite) TestVacancyLookupError() {
+	t := s.T()
+	dbErr := errors.New("db down")
+	s.storage.PublicVacancyOwnerMock.Return(0, dbErr)
+
+	_, err := s.svc.ApplyToVacancy(t.Context(), validApplication())
+	assert.ErrorIs(t, err, dbErr)
+}
+
+func (s *ApplyToVacancySuite) TestUnsupportedFile() {
+	t := s.T()
+	in := validApplication()
+	in.FileName = "cv.exe"
+	in.FileData = []byte{0x4d, 0x5a, 0x90, 0x00, 0x03}
+	s.storage.PublicVacancyOwnerMock.Return(42, nil)
+
+	_, err := s.svc.ApplyToVacancy(t.Context(), in)
+	assert.ErrorIs(t, err, ErrInvalidArgument)
+}
+
+// TestStorageError: nothing was stored, so no analysis is started.
+func (s *ApplyToVacancySuite) TestStorageError() {
+	t := s.T()
+	s.storage.PublicVacancyOwnerMock.Return(42, nil)
+	s.blobs.PutMock.Return(testBlobKey, nil)
+	s.blobs.DeleteMock.ExpectKeyParam2(testBlobKey).Return(nil)
+	s.storage.CreateCandidateWithResumeMock.Return(nil, nil, ErrVacancyClosed)
+
+	_, err := s.svc.ApplyToVacancy(t.Context(), validApplication())
+	assert.ErrorIs(t, err, ErrVacancyClosed)
+}
+
+func TestApplyToVacancySuite(t *testing.T) { suite.Run(t, new(ApplyToVacancySuite)) }
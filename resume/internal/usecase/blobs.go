@@ -0,0 +1,19 @@
+package usecase
+
+import (
+	"context"
+	"log/slog"
+)
+
+// discardBlobs deletes blobs whose resume rows were never written or are
+// gone. Best effort: a blob left behind is unreachable garbage, not lost
+// data, so failures are logged and the caller's result stands. Runs past
+// the caller's cancellation like any cleanup.
+func (s *ResumeService) discardBlobs(ctx context.Context, keys ...string) {
+	ctx = context.WithoutCancel(ctx)
+	for _, key := range keys {
+		if err := s.blobs.Delete(ctx, key); err != nil {
+			slog.WarnContext(ctx, "delete resume blob", "key", key, "err", err)
+		}
+	}
+}
@@ -16,23 +16,28 @@ func (s *ResumeService) CreateCandidateFromResume(ctx context.Context, in domain
 		return nil, ErrInvalidArgument
 	}
 
-	fileType, err := s.extractor.DetectFileType("", in.FileData)
+	fileType, err := s.extractor.DetectFileType(in.FileName, in.FileData)
 	if err != nil {
 		return nil, ErrInvalidArgument
 	}
-	fileName := s.extractor.BuildFileName("", fileType)
+	fileName := s.extractor.BuildFileName(in.FileName, fileType)
 
-	extractedText, err := s.extractor.ExtractText(fileType, in.FileData)
+	extraction, err := s.extractor.ExtractText(fileType, in.FileData)
 	if err != nil {
 		return nil, ErrInvalidArgument
 	}
 
-	profile := s.profile.ExtractCandidateProfile(extractedText, fileName)
+	profile := s.profile.ExtractCandidateProfile(extraction.Text, fileName)
 	vacancyID := strings.TrimSpace(in.VacancyID)
 
+	storagePath, err := s.blobs.Put(ctx, in.FileData)
+	if err != nil {
+		return nil, err
+	}
+
 	// Single transaction in storage: the candidate INSERT and resume INSERT
 	// commit together or roll back together — no orphaned candidates if the
-	// resume insert fails.
+	// resume insert fails. The blob is discarded when either fails.
 	candidate, resume, err := s.storage.CreateCandidateWithResume(ctx,
 		domain.CreateCandidateInput{
 			RequestUserID: in.RequestUserID,
@@ -43,13 +48,16 @@ func (s *ResumeService) CreateCandidateFromResume(ctx context.Context, in domain
 			Source:        domain.SourceFor(vacancyID),
 		},
 		domain.NewResumeData{
-			FileName:      fileName,
-			FileType:      fileType,
-			ExtractedText: extractedText,
-			Data:          in.FileData,
+			FileName:         fileName,
+			FileType:         fileType,
+			ExtractedText:    extraction.Text,
+			ExtractionMethod: extraction.Method,
+			Data:             in.FileData,
+			StoragePath:      storagePath,
 		},
 	)
 	if err != nil {
+		s.discardBlobs(ctx, storagePath)
 		return nil, err
 	}
 
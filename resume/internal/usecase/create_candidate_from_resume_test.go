@@ -9,6 +9,8 @@ import (
 	"gotest.tools/v3/assert"
 
 	"github.com/artem13815/hr/resume/internal/domain"
+	"github.com/artem13815/hr/resume/internal/infrastructure/profile"
+	"github.com/artem13815/hr/resume/internal/usecase/mocks"
 )
 
 type CreateCandidateFromResumeSuite struct{ baseSuite }
@@ -28,6 +30,7 @@ func (s *CreateCandidateFromResumeSuite) TestSuccessNoVacancy() {
 	wantCandidate := &domain.Candidate{ID: "c-1", FullName: "Jane Doe"}
 	wantResume := &domain.Resume{ID: "r-1", CandidateID: "c-1"}
 
+	s.blobs.PutMock.Return(testBlobKey, nil)
 	s.storage.CreateCandidateWithResumeMock.Set(func(_ context.Context, candidateIn domain.CreateCandidateInput, resumeIn domain.NewResumeData) (*domain.Candidate, *domain.Resume, error) {
 		assert.Equal(t, candidateIn.RequestUserID, uint64(7))
 		assert.Equal(t, candidateIn.VacancyID, "")
@@ -38,6 +41,8 @@ func (s *CreateCandidateFromResumeSuite) TestSuccessNoVacancy() {
 		assert.Equal(t, candidateIn.FullName, "Jane Doe")
 		assert.Equal(t, resumeIn.FileType, "txt")
 		assert.Assert(t, resumeIn.ExtractedText != "")
+		assert.Equal(t, resumeIn.ExtractionMethod, domain.ExtractionMethodParser)
+		assert.Equal(t, resumeIn.StoragePath, testBlobKey)
 		return wantCandidate, wantResume, nil
 	})
 
@@ -54,6 +59,7 @@ func (s *CreateCandidateFromResumeSuite) TestSuccessWithVacancy() {
 	t := s.T()
 	ctx := t.Context()
 
+	s.blobs.PutMock.Return(testBlobKey, nil)
 	s.storage.CreateCandidateWithResumeMock.Set(func(_ context.Context, candidateIn domain.CreateCandidateInput, _ domain.NewResumeData) (*domain.Candidate, *domain.Resume, error) {
 		assert.Equal(t, candidateIn.VacancyID, "vac-42")
 		// Source flips to resume_auto when a vacancy is targeted.
@@ -69,6 +75,58 @@ func (s *CreateCandidateFromResumeSuite) TestSuccessWithVacancy() {
 	assert.NilError(t, err)
 }
 
+func (s *CreateCandidateFromResumeSuite) TestKeepsFileName() {
+	t := s.T()
+	ctx := t.Context()
+
+	s.blobs.PutMock.Return(testBlobKey, nil)
+	s.storage.CreateCandidateWithResumeMock.Set(func(_ context.Context, _ domain.CreateCandidateInput, resumeIn domain.NewResumeData) (*domain.Candidate, *domain.Resume, error) {
+		assert.Equal(t, resumeIn.FileName, "jane-doe.txt")
+		assert.Equal(t, resumeIn.FileType, "txt")
+		return &domain.Candidate{ID: "c-1"}, &domain.Resume{ID: "r-1"}, nil
+	})
+
+	_, err := s.svc.CreateCandidateFromResume(ctx, domain.CreateCandidateFromResumeInput{
+		RequestUserID: 7,
+		FileName:      "jane-doe.txt",
+		FileData:      resumeText,
+	})
+	assert.NilError(t, err)
+}
+
+func (s *CreateCandidateFromResumeSuite) TestStoresExtractionMethod() {
+	t := s.T()
+	ctx := t.Context()
+
+	// A scanned PDF: the extractor fell back to OCR, and the resume row has
+	// to say so. The OCR pipeline itself needs poppler and tesseract.
+	scan := []byte("%PDF-1.4 scanned")
+	extractorMock := mocks.NewTextExtractorMock(t)
+	extractorMock.DetectFileTypeMock.Expect("scan.pdf", scan).Return("pdf", nil)
+	extractorMock.BuildFileNameMock.Expect("scan.pdf", "pdf").Return("scan.pdf")
+	extractorMock.ExtractTextMock.Expect("pdf", scan).Return(domain.TextExtraction{
+		Text:   "Jane Doe\nEmail: jane@example.com",
+		Method: domain.ExtractionMethodOCR,
+	}, nil)
+	svc := NewResumeService(s.storage, s.blobs, extractorMock, profile.New(), s.analyzer, s.analyses)
+
+	s.blobs.PutMock.Return(testBlobKey, nil)
+	s.storage.CreateCandidateWithResumeMock.Set(func(_ context.Context, candidateIn domain.CreateCandidateInput, resumeIn domain.NewResumeData) (*domain.Candidate, *domain.Resume, error) {
+		assert.Equal(t, candidateIn.Email, "jane@example.com")
+		assert.Equal(t, resumeIn.ExtractedText, "Jane Doe\nEmail: jane@example.com")
+		assert.Equal(t, resumeIn.ExtractionMethod, domain.ExtractionMethodOCR)
+		return &domain.Candidate{ID: "c-1"}, &domain.Resume{ID: "r-1", ExtractionMethod: domain.ExtractionMethodOCR}, nil
+	})
+
+	got, err := svc.CreateCandidateFromResume(ctx, domain.CreateCandidateFromResumeInput{
+		RequestUserID: 7,
+		FileName:      "scan.pdf",
+		FileData:      scan,
+	})
+	assert.NilError(t, err)
+	assert.Equal(t, got.Resume.ExtractionMethod, domain.ExtractionMethodOCR)
+}
+
 func (s *CreateCandidateFromResumeSuite) TestInvalidArgumentZeroUser() {
 	t := s.T()
 	got, err := s.svc.CreateCandidateFromResume(t.Context(), domain.CreateCandidateFromResumeInput{FileData: resumeText})
@@ -107,11 +165,32 @@ func (s *CreateCandidateFromResumeSuite) TestInvalidArgumentUnknownFileType() {
 	assert.Assert(t, got == nil)
 }
 
+func (s *CreateCandidateFromResumeSuite) TestVacancyClosed() {
+	t := s.T()
+	ctx := t.Context()
+
+	s.blobs.PutMock.Return(testBlobKey, nil)
+	s.blobs.DeleteMock.ExpectKeyParam2(testBlobKey).Return(nil)
+	s.storage.CreateCandidateWithResumeMock.Set(func(_ context.Context, _ domain.CreateCandidateInput, _ domain.NewResumeData) (*domain.Candidate, *domain.Resume, error) {
+		return nil, nil, domain.ErrVacancyClosed
+	})
+
+	got, err := s.svc.CreateCandidateFromResume(ctx, domain.CreateCandidateFromResumeInput{
+		RequestUserID: 1,
+		VacancyID:     "vac-closed",
+		FileData:      resumeText,
+	})
+	assert.ErrorIs(t, err, ErrVacancyClosed)
+	assert.Assert(t, got == nil)
+}
+
 func (s *CreateCandidateFromResumeSuite) TestStorageError() {
 	t := s.T()
 	ctx := t.Context()
 	storageErr := errors.New("pgx: tx aborted")
 
+	s.blobs.PutMock.Return(testBlobKey, nil)
+	s.blobs.DeleteMock.ExpectKeyParam2(testBlobKey).Return(nil)
 	s.storage.CreateCandidateWithResumeMock.Set(func(_ context.Context, _ domain.CreateCandidateInput, _ domain.NewResumeData) (*domain.Candidate, *domain.Resume, error) {
 		return nil, nil, storageErr
 	})
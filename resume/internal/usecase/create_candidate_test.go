@@ -58,6 +58,20 @@ func (s *CreateCandidateSuite) TestInvalidArgumentEmptyName() {
 	assert.Assert(t, got == nil)
 }
 
+// TestVacancyClosed: storage refuses candidates for closed / filled /
+// archived vacancies and the sentinel reaches the caller unchanged.
+func (s *CreateCandidateSuite) TestVacancyClosed() {
+	t := s.T()
+	ctx := t.Context()
+	in := domain.CreateCandidateInput{RequestUserID: 1, VacancyID: "vac-1", FullName: "Jane"}
+
+	s.storage.CreateCandidateMock.Expect(ctx, in).Return(nil, domain.ErrVacancyClosed)
+
+	got, err := s.svc.CreateCandidate(ctx, in)
+	assert.ErrorIs(t, err, ErrVacancyClosed)
+	assert.Assert(t, got == nil)
+}
+
 func (s *CreateCandidateSuite) TestStorageError() {
 	t := s.T()
 	ctx := t.Context()
@@ -9,19 +9,22 @@ import (
 )
 
 // DeleteCandidate removes a candidate together with its resume(s) via the
-// FK cascade. Authorization mirrors GetCandidate: bearer must own the row
-// (admin overrides). Analysis rows in the analysis service are not touched
-// here — that service treats orphaned analyses as historical data.
+// FK cascade, then deletes their files from the blob store. Authorization
+// mirrors GetCandidate: bearer must own the row (admin overrides). Analysis
+// rows in the analysis service are not touched here — that service treats
+// orphaned analyses as historical data.
 func (s *ResumeService) DeleteCandidate(ctx context.Context, in domain.DeleteCandidateInput) error {
 	if in.RequestUserID == 0 || strings.TrimSpace(in.CandidateID) == "" {
 		return ErrInvalidArgument
 	}
 
-	if err := s.storage.DeleteCandidate(ctx, in.CandidateID, in.RequestUserID, in.IsAdmin); err != nil {
+	blobPaths, err := s.storage.DeleteCandidate(ctx, in.CandidateID, in.RequestUserID, in.IsAdmin)
+	if err != nil {
 		if errors.Is(err, domain.ErrNotFound) {
 			return ErrNotFound
 		}
 		return err
 	}
+	s.discardBlobs(ctx, blobPaths...)
 	return nil
 }
@@ -11,6 +11,8 @@ import (
 // DownloadResume returns the original file bytes + filename + MIME type for
 // a stored resume. Same authz contract as GetResume: bearer must own the
 // candidate (or be admin). Storage handles the JOIN against candidates.
+// Legacy resumes come with their bytes from Postgres; the rest are read
+// from the blob store.
 func (s *ResumeService) DownloadResume(ctx context.Context, in domain.DownloadResumeInput) (*domain.ResumeFile, error) {
 	if in.RequestUserID == 0 || strings.TrimSpace(in.ResumeID) == "" {
 		return nil, ErrInvalidArgument
@@ -23,6 +25,12 @@ func (s *ResumeService) DownloadResume(ctx context.Context, in domain.DownloadRe
 		}
 		return nil, err
 	}
+	if file.Data == nil {
+		file.Data, err = s.blobs.Get(ctx, file.StoragePath)
+		if err != nil {
+			return nil, err
+		}
+	}
 
 	return file, nil
 }
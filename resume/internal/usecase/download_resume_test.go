@@ -0,0 +1,76 @@
+package usecase
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+	"gotest.tools/v3/assert"
+
+	"github.com/artem13815/hr/resume/internal/domain"
+)
+
+type DownloadResumeSuite struct{ baseSuite }
+
+func downloadInput() domain.DownloadResumeInput {
+	return domain.DownloadResumeInput{RequestUserID: 7, ResumeID: "r-1"}
+}
+
+func (s *DownloadResumeSuite) TestReadsBlobStore() {
+	t := s.T()
+	ctx := t.Context()
+
+	s.storage.DownloadResumeMock.Expect(ctx, "r-1", uint64(7), false).Return(&domain.ResumeFile{
+		FileName:    "cv.pdf",
+		FileType:    "pdf",
+		StoragePath: testBlobKey,
+	}, nil)
+	s.blobs.GetMock.Expect(ctx, testBlobKey).Return([]byte("%PDF-1.7"), nil)
+
+	got, err := s.svc.DownloadResume(ctx, downloadInput())
+	assert.NilError(t, err)
+	assert.DeepEqual(t, got, &domain.ResumeFile{
+		FileName:    "cv.pdf",
+		FileType:    "pdf",
+		Data:        []byte("%PDF-1.7"),
+		StoragePath: testBlobKey,
+	})
+}
+
+// TestLegacyBytes: a resume stored before the blob store comes with its
+// bytes from Postgres; the blob store is not asked.
+func (s *DownloadResumeSuite) TestLegacyBytes() {
+	t := s.T()
+	ctx := t.Context()
+	want := &domain.ResumeFile{FileName: "cv.txt", FileType: "txt", Data: []byte("text"), StoragePath: "db://resumes/r-1"}
+
+	s.storage.DownloadResumeMock.Expect(ctx, "r-1", uint64(7), false).Return(want, nil)
+
+	got, err := s.svc.DownloadResume(ctx, downloadInput())
+	assert.NilError(t, err)
+	assert.DeepEqual(t, got, want)
+}
+
+func (s *DownloadResumeSuite) TestBlobStoreError() {
+	t := s.T()
+	ctx := t.Context()
+	blobErr := errors.New("s3: no such key")
+
+	s.storage.DownloadResumeMock.Return(&domain.ResumeFile{StoragePath: testBlobKey}, nil)
+	s.blobs.GetMock.Return(nil, blobErr)
+
+	got, err := s.svc.DownloadResume(ctx, downloadInput())
+	assert.ErrorIs(t, err, blobErr)
+	assert.Assert(t, got == nil)
+}
+
+func (s *DownloadResumeSuite) TestNotFound() {
+	t := s.T()
+	s.storage.DownloadResumeMock.Return(nil, domain.ErrNotFound)
+
+	got, err := s.svc.DownloadResume(t.Context(), downloadInput())
+	assert.ErrorIs(t, err, ErrNotFound)
+	assert.Assert(t, got == nil)
+}
+
+func TestDownloadResumeSuite(t *testing.T) { suite.Run(t, new(DownloadResumeSuite)) }
@@ -1,8 +1,20 @@
 package usecase
 
-import "errors"
+import (
+	"errors"
+
+	"github.com/artem13815/hr/resume/internal/domain"
+)
 
 var (
 	ErrInvalidArgument = errors.New("invalid argument")
 	ErrNotFound        = errors.New("not found")
+	// ErrVacancyClosed aliases the domain sentinel storage returns when the
+	// target vacancy is closed, filled or archived.
+	ErrVacancyClosed = domain.ErrVacancyClosed
+	// ErrConsentRequired rejects a public application submitted without the
+	// personal-data processing consent.
+	ErrConsentRequired = errors.New("consent to personal data processing is required")
+	// ErrForbidden rejects admin-only operations for everyone else.
+	ErrForbidden = errors.New("forbidden")
 )
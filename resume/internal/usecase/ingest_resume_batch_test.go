@@ -18,6 +18,7 @@ func (s *IngestResumeBatchSuite) TestSuccessAllFilesProcessed() {
 
 	// Each successful file results in one storage call returning a candidate.
 	calls := 0
+	s.blobs.PutMock.Return(testBlobKey, nil)
 	s.storage.CreateCandidateWithResumeMock.Set(func(_ context.Context, _ domain.CreateCandidateInput, _ domain.NewResumeData) (*domain.Candidate, *domain.Resume, error) {
 		calls++
 		return &domain.Candidate{ID: "c"}, &domain.Resume{ID: "r"}, nil
@@ -58,6 +59,7 @@ func (s *IngestResumeBatchSuite) TestPartialFailureRecorded() {
 		},
 	}
 
+	s.blobs.PutMock.Return(testBlobKey, nil)
 	s.storage.CreateCandidateWithResumeMock.Set(func(_ context.Context, _ domain.CreateCandidateInput, _ domain.NewResumeData) (*domain.Candidate, *domain.Resume, error) {
 		return &domain.Candidate{ID: "c"}, &domain.Resume{ID: "r"}, nil
 	})
@@ -80,6 +82,7 @@ func (s *IngestResumeBatchSuite) TestVacancyIDPropagatedToEveryItem() {
 	ctx := t.Context()
 
 	var seen []string
+	s.blobs.PutMock.Return(testBlobKey, nil)
 	s.storage.CreateCandidateWithResumeMock.Set(func(_ context.Context, in domain.CreateCandidateInput, _ domain.NewResumeData) (*domain.Candidate, *domain.Resume, error) {
 		seen = append(seen, in.VacancyID)
 		return &domain.Candidate{ID: "c"}, &domain.Resume{ID: "r"}, nil
@@ -104,6 +107,7 @@ func (s *IngestResumeBatchSuite) TestExternalIDFallback() {
 	t := s.T()
 	ctx := t.Context()
 
+	s.blobs.PutMock.Return(testBlobKey, nil)
 	s.storage.CreateCandidateWithResumeMock.Set(func(_ context.Context, _ domain.CreateCandidateInput, _ domain.NewResumeData) (*domain.Candidate, *domain.Resume, error) {
 		return &domain.Candidate{ID: "c"}, &domain.Resume{ID: "r"}, nil
 	})
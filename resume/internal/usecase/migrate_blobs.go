@@ -0,0 +1,53 @@
+package usecase
+
+import (
+	"context"
+
+	"github.com/artem13815/hr/resume/internal/domain"
+)
+
+// DefaultMigrateBlobsBatch is how many legacy resumes MigrateLegacyBlobs
+// reads per round trip; each carries up to MaxResumeSizeBytes.
+const DefaultMigrateBlobsBatch = 50
+
+// MigrateLegacyBlobs moves the file bytes of resumes written before the blob
+// store out of resumes.file_data: each file is written to the blob store,
+// then the row is pointed at the key and file_data cleared. Safe to stop and
+// rerun — moved rows are not read again — and to run next to the service:
+// a resume deleted or moved meanwhile is skipped and its fresh copy
+// discarded. The result counts what was done even when an error stops the
+// run.
+func (s *ResumeService) MigrateLegacyBlobs(ctx context.Context, batchSize int) (*domain.MigrateBlobsResult, error) {
+	if batchSize <= 0 {
+		return nil, ErrInvalidArgument
+	}
+
+	var res domain.MigrateBlobsResult
+	for {
+		batch, err := s.storage.ListLegacyBlobs(ctx, batchSize)
+		if err != nil {
+			return &res, err
+		}
+		for _, blob := range batch {
+			key, err := s.blobs.Put(ctx, blob.Data)
+			if err != nil {
+				return &res, err
+			}
+			moved, err := s.storage.MoveBlobOut(ctx, blob.ResumeID, key)
+			if err != nil {
+				s.discardBlobs(ctx, key)
+				return &res, err
+			}
+			if !moved {
+				s.discardBlobs(ctx, key)
+				res.Skipped++
+				continue
+			}
+			res.Moved++
+			res.Bytes += uint64(len(blob.Data))
+		}
+		if len(batch) < batchSize {
+			return &res, nil
+		}
+	}
+}
@@ -0,0 +1,104 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+	"gotest.tools/v3/assert"
+
+	"github.com/artem13815/hr/resume/internal/domain"
+)
+
+type MigrateLegacyBlobsSuite struct{ baseSuite }
+
+// TestWalksBacklog: batches are read until one comes back short; a resume
+// that vanished between the read and the move is skipped and its copy
+// discarded.
+func (s *MigrateLegacyBlobsSuite) TestWalksBacklog() {
+	t := s.T()
+	ctx := t.Context()
+
+	batches := [][]domain.LegacyResumeBlob{
+		{{ResumeID: "r-1", Data: []byte("one")}, {ResumeID: "r-2", Data: []byte("two")}},
+		{{ResumeID: "r-3", Data: []byte("three")}},
+	}
+	s.storage.ListLegacyBlobsMock.Set(func(_ context.Context, limit int) ([]domain.LegacyResumeBlob, error) {
+		assert.Equal(t, limit, 2)
+		batch := batches[0]
+		batches = batches[1:]
+		return batch, nil
+	})
+	s.blobs.PutMock.Set(func(_ context.Context, data []byte) (string, error) {
+		return "resumes/" + string(data), nil
+	})
+	s.storage.MoveBlobOutMock.Set(func(_ context.Context, resumeID, storagePath string) (bool, error) {
+		return resumeID != "r-2", nil
+	})
+	s.blobs.DeleteMock.ExpectKeyParam2("resumes/two").Return(nil)
+
+	got, err := s.svc.MigrateLegacyBlobs(ctx, 2)
+	assert.NilError(t, err)
+	assert.DeepEqual(t, got, &domain.MigrateBlobsResult{Moved: 2, Skipped: 1, Bytes: 8})
+}
+
+// TestMoveErrorStops: the copy of the file that could not be recorded is
+// discarded and the progress so far reported.
+func (s *MigrateLegacyBlobsSuite) TestMoveErrorStops() {
+	t := s.T()
+	ctx := t.Context()
+	dbErr := errors.New("pgx: connection reset")
+
+	s.storage.ListLegacyBlobsMock.Return([]domain.LegacyResumeBlob{
+		{ResumeID: "r-1", Data: []byte("one")},
+		{ResumeID: "r-2", Data: []byte("two")},
+	}, nil)
+	s.blobs.PutMock.Set(func(_ context.Context, data []byte) (string, error) {
+		return "resumes/" + string(data), nil
+	})
+	s.storage.MoveBlobOutMock.Set(func(_ context.Context, resumeID, _ string) (bool, error) {
+		if resumeID == "r-2" {
+			return false, dbErr
+		}
+		return true, nil
+	})
+	s.blobs.DeleteMock.ExpectKeyParam2("resumes/two").Return(nil)
+
+	got, err := s.svc.MigrateLegacyBlobs(ctx, 10)
+	assert.ErrorIs(t, err, dbErr)
+	assert.DeepEqual(t, got, &domain.MigrateBlobsResult{Moved: 1, Bytes: 3})
+}
+
+func (s *MigrateLegacyBlobsSuite) TestPutErrorStops() {
+	t := s.T()
+	ctx := t.Context()
+	blobErr := errors.New("s3: access denied")
+
+	s.storage.ListLegacyBlobsMock.Return([]domain.LegacyResumeBlob{{ResumeID: "r-1", Data: []byte("one")}}, nil)
+	s.blobs.PutMock.Return("", blobErr)
+
+	got, err := s.svc.MigrateLegacyBlobs(ctx, 10)
+	assert.ErrorIs(t, err, blobErr)
+	assert.DeepEqual(t, got, &domain.MigrateBlobsResult{})
+}
+
+func (s *MigrateLegacyBlobsSuite) TestNothingLeft() {
+	t := s.T()
+	ctx := t.Context()
+
+	s.storage.ListLegacyBlobsMock.Expect(ctx, 10).Return(nil, nil)
+
+	got, err := s.svc.MigrateLegacyBlobs(ctx, 10)
+	assert.NilError(t, err)
+	assert.DeepEqual(t, got, &domain.MigrateBlobsResult{})
+}
+
+func (s *MigrateLegacyBlobsSuite) TestInvalidBatch() {
+	t := s.T()
+	got, err := s.svc.MigrateLegacyBlobs(t.Context(), 0)
+	assert.ErrorIs(t, err, ErrInvalidArgument)
+	assert.Assert(t, got == nil)
+}
+
+func TestMigrateLegacyBlobsSuite(t *testing.T) { suite.Run(t, new(MigrateLegacyBlobsSuite)) }
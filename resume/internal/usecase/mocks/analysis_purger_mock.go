@@ -0,0 +1,415 @@
+// Code generated by http://github.com/gojuno/minimock (v3.4.7). DO NOT EDIT.
+
+package mocks
+
+import (
+	"context"
+	"sync"
+	mm_atomic "sync/atomic"
+	mm_time "time"
+
+	"github.com/gojuno/minimock/v3"
+)
+
+// AnalysisPurgerMock implements mm_usecase.AnalysisPurger
+type AnalysisPurgerMock struct {
+	t          minimock.Tester
+	finishOnce sync.Once
+
+	funcPurgeVacancyAnalyses          func(ctx context.Context, vacancyID string) (u1 uint32, err error)
+	funcPurgeVacancyAnalysesOrigin    string
+	inspectFuncPurgeVacancyAnalyses   func(ctx context.Context, vacancyID string)
+	afterPurgeVacancyAnalysesCounter  uint64
+	beforePurgeVacancyAnalysesCounter uint64
+	PurgeVacancyAnalysesMock          mAnalysisPurgerMockPurgeVacancyAnalyses
+}
+
+// NewAnalysisPurgerMock returns a mock for mm_usecase.AnalysisPurger
+func NewAnalysisPurgerMock(t minimock.Tester) *AnalysisPurgerMock {
+	m := &AnalysisPurgerMock{t: t}
+
+	if controller, ok := t.(minimock.MockController); ok {
+		controller.RegisterMocker(m)
+	}
+
+	m.PurgeVacancyAnalysesMock = mAnalysisPurgerMockPurgeVacancyAnalyses{mock: m}
+	m.PurgeVacancyAnalysesMock.callArgs = []*AnalysisPurgerMockPurgeVacancyAnalysesParams{}
+
+	t.Cleanup(m.MinimockFinish)
+
+	return m
+}
+
+type mAnalysisPurgerMockPurgeVacancyAnalyses struct {
+	optional           bool
+	mock               *AnalysisPurgerMock
+	defaultExpectation *AnalysisPurgerMockPurgeVacancyAnalysesExpectation
+	expectations       []*AnalysisPurgerMockPurgeVacancyAnalysesExpectation
+
+	callArgs []*AnalysisPurgerMockPurgeVacancyAnalysesParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// AnalysisPurgerMockPurgeVacancyAnalysesExpectation specifies expectation struct of the AnalysisPurger.PurgeVacancyAnalyses
+type AnalysisPurgerMockPurgeVacancyAnalysesExpectation struct {
+	mock               *AnalysisPurgerMock
+	params             *AnalysisPurgerMockPurgeVacancyAnalysesParams
+	paramPtrs          *AnalysisPurgerMockPurgeVacancyAnalysesParamPtrs
+	expectationOrigins AnalysisPurgerMockPurgeVacancyAnalysesExpectationOrigins
+	results            *AnalysisPurgerMockPurgeVacancyAnalysesResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// AnalysisPurgerMockPurgeVacancyAnalysesParams contains parameters of the AnalysisPurger.PurgeVacancyAnalyses
+type AnalysisPurgerMockPurgeVacancyAnalysesParams struct {
+	ctx       context.Context
+	vacancyID string
+}
+
+// AnalysisPurgerMockPurgeVacancyAnalysesParamPtrs contains pointers to parameters of the AnalysisPurger.PurgeVacancyAnalyses
+type AnalysisPurgerMockPurgeVacancyAnalysesParamPtrs struct {
+	ctx       *context.Context
+	vacancyID *string
+}
+
+// AnalysisPurgerMockPurgeVacancyAnalysesResults contains results of the AnalysisPurger.PurgeVacancyAnalyses
+type AnalysisPurgerMockPurgeVacancyAnalysesResults struct {
+	u1  uint32
+	err error
+}
+
+// AnalysisPurgerMockPurgeVacancyAnalysesOrigins contains origins of expectations of the AnalysisPurger.PurgeVacancyAnalyses
+type AnalysisPurgerMockPurgeVacancyAnalysesExpectationOrigins struct {
+	origin          string
+	originCtx       string
+	originVacancyID string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmPurgeVacancyAnalyses *mAnalysisPurgerMockPurgeVacancyAnalyses) Optional() *mAnalysisPurgerMockPurgeVacancyAnalyses {
+	mmPurgeVacancyAnalyses.optional = true
+	return mmPurgeVacancyAnalyses
+}
+
+// Expect sets up expected params for AnalysisPurger.PurgeVacancyAnalyses
+func (mmPurgeVacancyAnalyses *mAnalysisPurgerMockPurgeVacancyAnalyses) Expect(ctx context.Context, vacancyID string) *mAnalysisPurgerMockPurgeVacancyAnalyses {
+	if mmPurgeVacancyAnalyses.mock.funcPurgeVacancyAnalyses != nil {
+		mmPurgeVacancyAnalyses.mock.t.Fatalf("AnalysisPurgerMock.PurgeVacancyAnalyses mock is already set by Set")
+	}
+
+	if mmPurgeVacancyAnalyses.defaultExpectation == nil {
+		mmPurgeVacancyAnalyses.defaultExpectation = &AnalysisPurgerMockPurgeVacancyAnalysesExpectation{}
+	}
+
+	if mmPurgeVacancyAnalyses.defaultExpectation.paramPtrs != nil {
+		mmPurgeVacancyAnalyses.mock.t.Fatalf("AnalysisPurgerMock.PurgeVacancyAnalyses mock is already set by ExpectParams functions")
+	}
+
+	mmPurgeVacancyAnalyses.defaultExpectation.params = &AnalysisPurgerMockPurgeVacancyAnalysesParams{ctx, vacancyID}
+	mmPurgeVacancyAnalyses.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmPurgeVacancyAnalyses.expectations {
+		if minimock.Equal(e.params, mmPurgeVacancyAnalyses.defaultExpectation.params) {
+			mmPurgeVacancyAnalyses.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmPurgeVacancyAnalyses.defaultExpectation.params)
+		}
+	}
+
+	return mmPurgeVacancyAnalyses
+}
+
+// ExpectCtxParam1 sets up expected param ctx for AnalysisPurger.PurgeVacancyAnalyses
+func (mmPurgeVacancyAnalyses *mAnalysisPurgerMockPurgeVacancyAnalyses) ExpectCtxParam1(ctx context.Context) *mAnalysisPurgerMockPurgeVacancyAnalyses {
+	if mmPurgeVacancyAnalyses.mock.funcPurgeVacancyAnalyses != nil {
+		mmPurgeVacancyAnalyses.mock.t.Fatalf("AnalysisPurgerMock.PurgeVacancyAnalyses mock is already set by Set")
+	}
+
+	if mmPurgeVacancyAnalyses.defaultExpectation == nil {
+		mmPurgeVacancyAnalyses.defaultExpectation = &AnalysisPurgerMockPurgeVacancyAnalysesExpectation{}
+	}
+
+	if mmPurgeVacancyAnalyses.defaultExpectation.params != nil {
+		mmPurgeVacancyAnalyses.mock.t.Fatalf("AnalysisPurgerMock.PurgeVacancyAnalyses mock is already set by Expect")
+	}
+
+	if mmPurgeVacancyAnalyses.defaultExpectation.paramPtrs == nil {
+		mmPurgeVacancyAnalyses.defaultExpectation.paramPtrs = &AnalysisPurgerMockPurgeVacancyAnalysesParamPtrs{}
+	}
+	mmPurgeVacancyAnalyses.defaultExpectation.paramPtrs.ctx = &ctx
+	mmPurgeVacancyAnalyses.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmPurgeVacancyAnalyses
+}
+
+// ExpectVacancyIDParam2 sets up expected param vacancyID for AnalysisPurger.PurgeVacancyAnalyses
+func (mmPurgeVacancyAnalyses *mAnalysisPurgerMockPurgeVacancyAnalyses) ExpectVacancyIDParam2(vacancyID string) *mAnalysisPurgerMockPurgeVacancyAnalyses {
+	if mmPurgeVacancyAnalyses.mock.funcPurgeVacancyAnalyses != nil {
+		mmPurgeVacancyAnalyses.mock.t.Fatalf("AnalysisPurgerMock.PurgeVacancyAnalyses mock is already set by Set")
+	}
+
+	if mmPurgeVacancyAnalyses.defaultExpectation == nil {
+		mmPurgeVacancyAnalyses.defaultExpectation = &AnalysisPurgerMockPurgeVacancyAnalysesExpectation{}
+	}
+
+	if mmPurgeVacancyAnalyses.defaultExpectation.params != nil {
+		mmPurgeVacancyAnalyses.mock.t.Fatalf("AnalysisPurgerMock.PurgeVacancyAnalyses mock is already set by Expect")
+	}
+
+	if mmPurgeVacancyAnalyses.defaultExpectation.paramPtrs == nil {
+		mmPurgeVacancyAnalyses.defaultExpectation.paramPtrs = &AnalysisPurgerMockPurgeVacancyAnalysesParamPtrs{}
+	}
+	mmPurgeVacancyAnalyses.defaultExpectation.paramPtrs.vacancyID = &vacancyID
+	mmPurgeVacancyAnalyses.defaultExpectation.expectationOrigins.originVacancyID = minimock.CallerInfo(1)
+
+	return mmPurgeVacancyAnalyses
+}
+
+// Inspect accepts an inspector function that has same arguments as the AnalysisPurger.PurgeVacancyAnalyses
+func (mmPurgeVacancyAnalyses *mAnalysisPurgerMockPurgeVacancyAnalyses) Inspect(f func(ctx context.Context, vacancyID string)) *mAnalysisPurgerMockPurgeVacancyAnalyses {
+	if mmPurgeVacancyAnalyses.mock.inspectFuncPurgeVacancyAnalyses != nil {
+		mmPurgeVacancyAnalyses.mock.t.Fatalf("Inspect function is already set for AnalysisPurgerMock.PurgeVacancyAnalyses")
+	}
+
+	mmPurgeVacancyAnalyses.mock.inspectFuncPurgeVacancyAnalyses = f
+
+	return mmPurgeVacancyAnalyses
+}
+
+// Return sets up results that will be returned by AnalysisPurger.PurgeVacancyAnalyses
+func (mmPurgeVacancyAnalyses *mAnalysisPurgerMockPurgeVacancyAnalyses) Return(u1 uint32, err error) *AnalysisPurgerMock {
+	if mmPurgeVacancyAnalyses.mock.funcPurgeVacancyAnalyses != nil {
+		mmPurgeVacancyAnalyses.mock.t.Fatalf("AnalysisPurgerMock.PurgeVacancyAnalyses mock is already set by Set")
+	}
+
+	if mmPurgeVacancyAnalyses.defaultExpectation == nil {
+		mmPurgeVacancyAnalyses.defaultExpectation = &AnalysisPurgerMockPurgeVacancyAnalysesExpectation{mock: mmPurgeVacancyAnalyses.mock}
+	}
+	mmPurgeVacancyAnalyses.defaultExpectation.results = &AnalysisPurgerMockPurgeVacancyAnalysesResults{u1, err}
+	mmPurgeVacancyAnalyses.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmPurgeVacancyAnalyses.mock
+}
+
+// Set uses given function f to mock the AnalysisPurger.PurgeVacancyAnalyses method
+func (mmPurgeVacancyAnalyses *mAnalysisPurgerMockPurgeVacancyAnalyses) Set(f func(ctx context.Context, vacancyID string) (u1 uint32, err error)) *AnalysisPurgerMock {
+	if mmPurgeVacancyAnalyses.defaultExpectation != nil {
+		mmPurgeVacancyAnalyses.mock.t.Fatalf("Default expectation is already set for the AnalysisPurger.PurgeVacancyAnalyses method")
+	}
+
+	if len(mmPurgeVacancyAnalyses.expectations) > 0 {
+		mmPurgeVacancyAnalyses.mock.t.Fatalf("Some expectations are already set for the AnalysisPurger.PurgeVacancyAnalyses method")
+	}
+
+	mmPurgeVacancyAnalyses.mock.funcPurgeVacancyAnalyses = f
+	mmPurgeVacancyAnalyses.mock.funcPurgeVacancyAnalysesOrigin = minimock.CallerInfo(1)
+	return mmPurgeVacancyAnalyses.mock
+}
+
+// When sets expectation for the AnalysisPurger.PurgeVacancyAnalyses which will trigger the result defined by the following
+// Then helper
+func (mmPurgeVacancyAnalyses *mAnalysisPurgerMockPurgeVacancyAnalyses) When(ctx context.Context, vacancyID string) *AnalysisPurgerMockPurgeVacancyAnalysesExpectation {
+	if mmPurgeVacancyAnalyses.mock.funcPurgeVacancyAnalyses != nil {
+		mmPurgeVacancyAnalyses.mock.t.Fatalf("AnalysisPurgerMock.PurgeVacancyAnalyses mock is already set by Set")
+	}
+
+	expectation := &AnalysisPurgerMockPurgeVacancyAnalysesExpectation{
+		mock:               mmPurgeVacancyAnalyses.mock,
+		params:             &AnalysisPurgerMockPurgeVacancyAnalysesParams{ctx, vacancyID},
+		expectationOrigins: AnalysisPurgerMockPurgeVacancyAnalysesExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmPurgeVacancyAnalyses.expectations = append(mmPurgeVacancyAnalyses.expectations, expectation)
+	return expectation
+}
+
+// Then sets up AnalysisPurger.PurgeVacancyAnalyses return parameters for the expectation previously defined by the When method
+func (e *AnalysisPurgerMockPurgeVacancyAnalysesExpectation) Then(u1 uint32, err error) *AnalysisPurgerMock {
+	e.results = &AnalysisPurgerMockPurgeVacancyAnalysesResults{u1, err}
+	return e.mock
+}
+
+// Times sets number of times AnalysisPurger.PurgeVacancyAnalyses should be invoked
+func (mmPurgeVacancyAnalyses *mAnalysisPurgerMockPurgeVacancyAnalyses) Times(n uint64) *mAnalysisPurgerMockPurgeVacancyAnalyses {
+	if n == 0 {
+		mmPurgeVacancyAnalyses.mock.t.Fatalf("Times of AnalysisPurgerMock.PurgeVacancyAnalyses mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmPurgeVacancyAnalyses.expectedInvocations, n)
+	mmPurgeVacancyAnalyses.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmPurgeVacancyAnalyses
+}
+
+func (mmPurgeVacancyAnalyses *mAnalysisPurgerMockPurgeVacancyAnalyses) invocationsDone() bool {
+	if len(mmPurgeVacancyAnalyses.expectations) == 0 && mmPurgeVacancyAnalyses.defaultExpectation == nil && mmPurgeVacancyAnalyses.mock.funcPurgeVacancyAnalyses == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmPurgeVacancyAnalyses.mock.afterPurgeVacancyAnalysesCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmPurgeVacancyAnalyses.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// PurgeVacancyAnalyses implements mm_usecase.AnalysisPurger
+func (mmPurgeVacancyAnalyses *AnalysisPurgerMock) PurgeVacancyAnalyses(ctx context.Context, vacancyID string) (u1 uint32, err error) {
+	mm_atomic.AddUint64(&mmPurgeVacancyAnalyses.beforePurgeVacancyAnalysesCounter, 1)
+	defer mm_atomic.AddUint64(&mmPurgeVacancyAnalyses.afterPurgeVacancyAnalysesCounter, 1)
+
+	mmPurgeVacancyAnalyses.t.Helper()
+
+	if mmPurgeVacancyAnalyses.inspectFuncPurgeVacancyAnalyses != nil {
+		mmPurgeVacancyAnalyses.inspectFuncPurgeVacancyAnalyses(ctx, vacancyID)
+	}
+
+	mm_params := AnalysisPurgerMockPurgeVacancyAnalysesParams{ctx, vacancyID}
+
+	// Record call args
+	mmPurgeVacancyAnalyses.PurgeVacancyAnalysesMock.mutex.Lock()
+	mmPurgeVacancyAnalyses.PurgeVacancyAnalysesMock.callArgs = append(mmPurgeVacancyAnalyses.PurgeVacancyAnalysesMock.callArgs, &mm_params)
+	mmPurgeVacancyAnalyses.PurgeVacancyAnalysesMock.mutex.Unlock()
+
+	for _, e := range mmPurgeVacancyAnalyses.PurgeVacancyAnalysesMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.u1, e.results.err
+		}
+	}
+
+	if mmPurgeVacancyAnalyses.PurgeVacancyAnalysesMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmPurgeVacancyAnalyses.PurgeVacancyAnalysesMock.defaultExpectation.Counter, 1)
+		mm_want := mmPurgeVacancyAnalyses.PurgeVacancyAnalysesMock.defaultExpectation.params
+		mm_want_ptrs := mmPurgeVacancyAnalyses.PurgeVacancyAnalysesMock.defaultExpectation.paramPtrs
+
+		mm_got := AnalysisPurgerMockPurgeVacancyAnalysesParams{ctx, vacancyID}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmPurgeVacancyAnalyses.t.Errorf("AnalysisPurgerMock.PurgeVacancyAnalyses got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmPurgeVacancyAnalyses.PurgeVacancyAnalysesMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.vacancyID != nil && !minimock.Equal(*mm_want_ptrs.vacancyID, mm_got.vacancyID) {
+				mmPurgeVacancyAnalyses.t.Errorf("AnalysisPurgerMock.PurgeVacancyAnalyses got unexpected parameter vacancyID, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmPurgeVacancyAnalyses.PurgeVacancyAnalysesMock.defaultExpectation.expectationOrigins.originVacancyID, *mm_want_ptrs.vacancyID, mm_got.vacancyID, minimock.Diff(*mm_want_ptrs.vacancyID, mm_got.vacancyID))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmPurgeVacancyAnalyses.t.Errorf("AnalysisPurgerMock.PurgeVacancyAnalyses got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmPurgeVacancyAnalyses.PurgeVacancyAnalysesMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmPurgeVacancyAnalyses.PurgeVacancyAnalysesMock.defaultExpectation.results
+		if mm_results == nil {
+			mmPurgeVacancyAnalyses.t.Fatal("No results are set for the AnalysisPurgerMock.PurgeVacancyAnalyses")
+		}
+		return (*mm_results).u1, (*mm_results).err
+	}
+	if mmPurgeVacancyAnalyses.funcPurgeVacancyAnalyses != nil {
+		return mmPurgeVacancyAnalyses.funcPurgeVacancyAnalyses(ctx, vacancyID)
+	}
+	mmPurgeVacancyAnalyses.t.Fatalf("Unexpected call to AnalysisPurgerMock.PurgeVacancyAnalyses. %v %v", ctx, vacancyID)
+	return
+}
+
+// PurgeVacancyAnalysesAfterCounter returns a count of finished AnalysisPurgerMock.PurgeVacancyAnalyses invocations
+func (mmPurgeVacancyAnalyses *AnalysisPurgerMock) PurgeVacancyAnalysesAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmPurgeVacancyAnalyses.afterPurgeVacancyAnalysesCounter)
+}
+
+// PurgeVacancyAnalysesBeforeCounter returns a count of AnalysisPurgerMock.PurgeVacancyAnalyses invocations
+func (mmPurgeVacancyAnalyses *AnalysisPurgerMock) PurgeVacancyAnalysesBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmPurgeVacancyAnalyses.beforePurgeVacancyAnalysesCounter)
+}
+
+// Calls returns a list of arguments used in each call to AnalysisPurgerMock.PurgeVacancyAnalyses.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmPurgeVacancyAnalyses *mAnalysisPurgerMockPurgeVacancyAnalyses) Calls() []*AnalysisPurgerMockPurgeVacancyAnalysesParams {
+	mmPurgeVacancyAnalyses.mutex.RLock()
+
+	argCopy := make([]*AnalysisPurgerMockPurgeVacancyAnalysesParams, len(mmPurgeVacancyAnalyses.callArgs))
+	copy(argCopy, mmPurgeVacancyAnalyses.callArgs)
+
+	mmPurgeVacancyAnalyses.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockPurgeVacancyAnalysesDone returns true if the count of the PurgeVacancyAnalyses invocations corresponds
+// the number of defined expectations
+func (m *AnalysisPurgerMock) MinimockPurgeVacancyAnalysesDone() bool {
+	if m.PurgeVacancyAnalysesMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.PurgeVacancyAnalysesMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.PurgeVacancyAnalysesMock.invocationsDone()
+}
+
+// MinimockPurgeVacancyAnalysesInspect logs each unmet expectation
+func (m *AnalysisPurgerMock) MinimockPurgeVacancyAnalysesInspect() {
+	for _, e := range m.PurgeVacancyAnalysesMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to AnalysisPurgerMock.PurgeVacancyAnalyses at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterPurgeVacancyAnalysesCounter := mm_atomic.LoadUint64(&m.afterPurgeVacancyAnalysesCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.PurgeVacancyAnalysesMock.defaultExpectation != nil && afterPurgeVacancyAnalysesCounter < 1 {
+		if m.PurgeVacancyAnalysesMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to AnalysisPurgerMock.PurgeVacancyAnalyses at\n%s", m.PurgeVacancyAnalysesMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to AnalysisPurgerMock.PurgeVacancyAnalyses at\n%s with params: %#v", m.PurgeVacancyAnalysesMock.defaultExpectation.expectationOrigins.origin, *m.PurgeVacancyAnalysesMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcPurgeVacancyAnalyses != nil && afterPurgeVacancyAnalysesCounter < 1 {
+		m.t.Errorf("Expected call to AnalysisPurgerMock.PurgeVacancyAnalyses at\n%s", m.funcPurgeVacancyAnalysesOrigin)
+	}
+
+	if !m.PurgeVacancyAnalysesMock.invocationsDone() && afterPurgeVacancyAnalysesCounter > 0 {
+		m.t.Errorf("Expected %d calls to AnalysisPurgerMock.PurgeVacancyAnalyses at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.PurgeVacancyAnalysesMock.expectedInvocations), m.PurgeVacancyAnalysesMock.expectedInvocationsOrigin, afterPurgeVacancyAnalysesCounter)
+	}
+}
+
+// MinimockFinish checks that all mocked methods have been called the expected number of times
+func (m *AnalysisPurgerMock) MinimockFinish() {
+	m.finishOnce.Do(func() {
+		if !m.minimockDone() {
+			m.MinimockPurgeVacancyAnalysesInspect()
+		}
+	})
+}
+
+// MinimockWait waits for all mocked methods to be called the expected number of times
+func (m *AnalysisPurgerMock) MinimockWait(timeout mm_time.Duration) {
+	timeoutCh := mm_time.After(timeout)
+	for {
+		if m.minimockDone() {
+			return
+		}
+		select {
+		case <-timeoutCh:
+			m.MinimockFinish()
+			return
+		case <-mm_time.After(10 * mm_time.Millisecond):
+		}
+	}
+}
+
+func (m *AnalysisPurgerMock) minimockDone() bool {
+	done := true
+	return done &&
+		m.MinimockPurgeVacancyAnalysesDone()
+}
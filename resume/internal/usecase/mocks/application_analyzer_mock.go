@@ -0,0 +1,407 @@
+// Code generated by http://github.com/gojuno/minimock (v3.4.7). DO NOT EDIT.
+
+package mocks
+
+import (
+	"context"
+	"sync"
+	mm_atomic "sync/atomic"
+	mm_time "time"
+
+	"github.com/gojuno/minimock/v3"
+)
+
+// ApplicationAnalyzerMock implements mm_usecase.ApplicationAnalyzer
+type ApplicationAnalyzerMock struct {
+	t          minimock.Tester
+	finishOnce sync.Once
+
+	funcStartApplicationAnalysis          func(ctx context.Context, resumeID string)
+	funcStartApplicationAnalysisOrigin    string
+	inspectFuncStartApplicationAnalysis   func(ctx context.Context, resumeID string)
+	afterStartApplicationAnalysisCounter  uint64
+	beforeStartApplicationAnalysisCounter uint64
+	StartApplicationAnalysisMock          mApplicationAnalyzerMockStartApplicationAnalysis
+}
+
+// NewApplicationAnalyzerMock returns a mock for mm_usecase.ApplicationAnalyzer
+func NewApplicationAnalyzerMock(t minimock.Tester) *ApplicationAnalyzerMock {
+	m := &ApplicationAnalyzerMock{t: t}
+
+	if controller, ok := t.(minimock.MockController); ok {
+		controller.RegisterMocker(m)
+	}
+
+	m.StartApplicationAnalysisMock = mApplicationAnalyzerMockStartApplicationAnalysis{mock: m}
+	m.StartApplicationAnalysisMock.callArgs = []*ApplicationAnalyzerMockStartApplicationAnalysisParams{}
+
+	t.Cleanup(m.MinimockFinish)
+
+	return m
+}
+
+type mApplicationAnalyzerMockStartApplicationAnalysis struct {
+	optional           bool
+	mock               *ApplicationAnalyzerMock
+	defaultExpectation *ApplicationAnalyzerMockStartApplicationAnalysisExpectation
+	expectations       []*ApplicationAnalyzerMockStartApplicationAnalysisExpectation
+
+	callArgs []*ApplicationAnalyzerMockStartApplicationAnalysisParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// ApplicationAnalyzerMockStartApplicationAnalysisExpectation specifies expectation struct of the ApplicationAnalyzer.StartApplicationAnalysis
+type ApplicationAnalyzerMockStartApplicationAnalysisExpectation struct {
+	mock               *ApplicationAnalyzerMock
+	params             *ApplicationAnalyzerMockStartApplicationAnalysisParams
+	paramPtrs          *ApplicationAnalyzerMockStartApplicationAnalysisParamPtrs
+	expectationOrigins ApplicationAnalyzerMockStartApplicationAnalysisExpectationOrigins
+
+	returnOrigin string
+	Counter      uint64
+}
+
+// ApplicationAnalyzerMockStartApplicationAnalysisParams contains parameters of the ApplicationAnalyzer.StartApplicationAnalysis
+type ApplicationAnalyzerMockStartApplicationAnalysisParams struct {
+	ctx      context.Context
+	resumeID string
+}
+
+// ApplicationAnalyzerMockStartApplicationAnalysisParamPtrs contains pointers to parameters of the ApplicationAnalyzer.StartApplicationAnalysis
+type ApplicationAnalyzerMockStartApplicationAnalysisParamPtrs struct {
+	ctx      *context.Context
+	resumeID *string
+}
+
+// ApplicationAnalyzerMockStartApplicationAnalysisOrigins contains origins of expectations of the ApplicationAnalyzer.StartApplicationAnalysis
+type ApplicationAnalyzerMockStartApplicationAnalysisExpectationOrigins struct {
+	origin         string
+	originCtx      string
+	originResumeID string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmStartApplicationAnalysis *mApplicationAnalyzerMockStartApplicationAnalysis) Optional() *mApplicationAnalyzerMockStartApplicationAnalysis {
+	mmStartApplicationAnalysis.optional = true
+	return mmStartApplicationAnalysis
+}
+
+// Expect sets up expected params for ApplicationAnalyzer.StartApplicationAnalysis
+func (mmStartApplicationAnalysis *mApplicationAnalyzerMockStartApplicationAnalysis) Expect(ctx context.Context, resumeID string) *mApplicationAnalyzerMockStartApplicationAnalysis {
+	if mmStartApplicationAnalysis.mock.funcStartApplicationAnalysis != nil {
+		mmStartApplicationAnalysis.mock.t.Fatalf("ApplicationAnalyzerMock.StartApplicationAnalysis mock is already set by Set")
+	}
+
+	if mmStartApplicationAnalysis.defaultExpectation == nil {
+		mmStartApplicationAnalysis.defaultExpectation = &ApplicationAnalyzerMockStartApplicationAnalysisExpectation{}
+	}
+
+	if mmStartApplicationAnalysis.defaultExpectation.paramPtrs != nil {
+		mmStartApplicationAnalysis.mock.t.Fatalf("ApplicationAnalyzerMock.StartApplicationAnalysis mock is already set by ExpectParams functions")
+	}
+
+	mmStartApplicationAnalysis.defaultExpectation.params = &ApplicationAnalyzerMockStartApplicationAnalysisParams{ctx, resumeID}
+	mmStartApplicationAnalysis.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmStartApplicationAnalysis.expectations {
+		if minimock.Equal(e.params, mmStartApplicationAnalysis.defaultExpectation.params) {
+			mmStartApplicationAnalysis.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmStartApplicationAnalysis.defaultExpectation.params)
+		}
+	}
+
+	return mmStartApplicationAnalysis
+}
+
+// ExpectCtxParam1 sets up expected param ctx for ApplicationAnalyzer.StartApplicationAnalysis
+func (mmStartApplicationAnalysis *mApplicationAnalyzerMockStartApplicationAnalysis) ExpectCtxParam1(ctx context.Context) *mApplicationAnalyzerMockStartApplicationAnalysis {
+	if mmStartApplicationAnalysis.mock.funcStartApplicationAnalysis != nil {
+		mmStartApplicationAnalysis.mock.t.Fatalf("ApplicationAnalyzerMock.StartApplicationAnalysis mock is already set by Set")
+	}
+
+	if mmStartApplicationAnalysis.defaultExpectation == nil {
+		mmStartApplicationAnalysis.defaultExpectation = &ApplicationAnalyzerMockStartApplicationAnalysisExpectation{}
+	}
+
+	if mmStartApplicationAnalysis.defaultExpectation.params != nil {
+		mmStartApplicationAnalysis.mock.t.Fatalf("ApplicationAnalyzerMock.StartApplicationAnalysis mock is already set by Expect")
+	}
+
+	if mmStartApplicationAnalysis.defaultExpectation.paramPtrs == nil {
+		mmStartApplicationAnalysis.defaultExpectation.paramPtrs = &ApplicationAnalyzerMockStartApplicationAnalysisParamPtrs{}
+	}
+	mmStartApplicationAnalysis.defaultExpectation.paramPtrs.ctx = &ctx
+	mmStartApplicationAnalysis.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmStartApplicationAnalysis
+}
+
+// ExpectResumeIDParam2 sets up expected param resumeID for ApplicationAnalyzer.StartApplicationAnalysis
+func (mmStartApplicationAnalysis *mApplicationAnalyzerMockStartApplicationAnalysis) ExpectResumeIDParam2(resumeID string) *mApplicationAnalyzerMockStartApplicationAnalysis {
+	if mmStartApplicationAnalysis.mock.funcStartApplicationAnalysis != nil {
+		mmStartApplicationAnalysis.mock.t.Fatalf("ApplicationAnalyzerMock.StartApplicationAnalysis mock is already set by Set")
+	}
+
+	if mmStartApplicationAnalysis.defaultExpectation == nil {
+		mmStartApplicationAnalysis.defaultExpectation = &ApplicationAnalyzerMockStartApplicationAnalysisExpectation{}
+	}
+
+	if mmStartApplicationAnalysis.defaultExpectation.params != nil {
+		mmStartApplicationAnalysis.mock.t.Fatalf("ApplicationAnalyzerMock.StartApplicationAnalysis mock is already set by Expect")
+	}
+
+	if mmStartApplicationAnalysis.defaultExpectation.paramPtrs == nil {
+		mmStartApplicationAnalysis.defaultExpectation.paramPtrs = &ApplicationAnalyzerMockStartApplicationAnalysisParamPtrs{}
+	}
+	mmStartApplicationAnalysis.defaultExpectation.paramPtrs.resumeID = &resumeID
+	mmStartApplicationAnalysis.defaultExpectation.expectationOrigins.originResumeID = minimock.CallerInfo(1)
+
+	return mmStartApplicationAnalysis
+}
+
+// Inspect accepts an inspector function that has same arguments as the ApplicationAnalyzer.StartApplicationAnalysis
+func (mmStartApplicationAnalysis *mApplicationAnalyzerMockStartApplicationAnalysis) Inspect(f func(ctx context.Context, resumeID string)) *mApplicationAnalyzerMockStartApplicationAnalysis {
+	if mmStartApplicationAnalysis.mock.inspectFuncStartApplicationAnalysis != nil {
+		mmStartApplicationAnalysis.mock.t.Fatalf("Inspect function is already set for ApplicationAnalyzerMock.StartApplicationAnalysis")
+	}
+
+	mmStartApplicationAnalysis.mock.inspectFuncStartApplicationAnalysis = f
+
+	return mmStartApplicationAnalysis
+}
+
+// Return sets up results that will be returned by ApplicationAnalyzer.StartApplicationAnalysis
+func (mmStartApplicationAnalysis *mApplicationAnalyzerMockStartApplicationAnalysis) Return() *ApplicationAnalyzerMock {
+	if mmStartApplicationAnalysis.mock.funcStartApplicationAnalysis != nil {
+		mmStartApplicationAnalysis.mock.t.Fatalf("ApplicationAnalyzerMock.StartApplicationAnalysis mock is already set by Set")
+	}
+
+	if mmStartApplicationAnalysis.defaultExpectation == nil {
+		mmStartApplicationAnalysis.defaultExpectation = &ApplicationAnalyzerMockStartApplicationAnalysisExpectation{mock: mmStartApplicationAnalysis.mock}
+	}
+
+	mmStartApplicationAnalysis.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmStartApplicationAnalysis.mock
+}
+
+// Set uses given function f to mock the ApplicationAnalyzer.StartApplicationAnalysis method
+func (mmStartApplicationAnalysis *mApplicationAnalyzerMockStartApplicationAnalysis) Set(f func(ctx context.Context, resumeID string)) *ApplicationAnalyzerMock {
+	if mmStartApplicationAnalysis.defaultExpectation != nil {
+		mmStartApplicationAnalysis.mock.t.Fatalf("Default expectation is already set for the ApplicationAnalyzer.StartApplicationAnalysis method")
+	}
+
+	if len(mmStartApplicationAnalysis.expectations) > 0 {
+		mmStartApplicationAnalysis.mock.t.Fatalf("Some expectations are already set for the ApplicationAnalyzer.StartApplicationAnalysis method")
+	}
+
+	mmStartApplicationAnalysis.mock.funcStartApplicationAnalysis = f
+	mmStartApplicationAnalysis.mock.funcStartApplicationAnalysisOrigin = minimock.CallerInfo(1)
+	return mmStartApplicationAnalysis.mock
+}
+
+// When sets expectation for the ApplicationAnalyzer.StartApplicationAnalysis which will trigger the result defined by the following
+// Then helper
+func (mmStartApplicationAnalysis *mApplicationAnalyzerMockStartApplicationAnalysis) When(ctx context.Context, resumeID string) *ApplicationAnalyzerMockStartApplicationAnalysisExpectation {
+	if mmStartApplicationAnalysis.mock.funcStartApplicationAnalysis != nil {
+		mmStartApplicationAnalysis.mock.t.Fatalf("ApplicationAnalyzerMock.StartApplicationAnalysis mock is already set by Set")
+	}
+
+	expectation := &ApplicationAnalyzerMockStartApplicationAnalysisExpectation{
+		mock:               mmStartApplicationAnalysis.mock,
+		params:             &ApplicationAnalyzerMockStartApplicationAnalysisParams{ctx, resumeID},
+		expectationOrigins: ApplicationAnalyzerMockStartApplicationAnalysisExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmStartApplicationAnalysis.expectations = append(mmStartApplicationAnalysis.expectations, expectation)
+	return expectation
+}
+
+// Then sets up ApplicationAnalyzer.StartApplicationAnalysis return parameters for the expectation previously defined by the When method
+
+func (e *ApplicationAnalyzerMockStartApplicationAnalysisExpectation) Then() *ApplicationAnalyzerMock {
+	return e.mock
+}
+
+// Times sets number of times ApplicationAnalyzer.StartApplicationAnalysis should be invoked
+func (mmStartApplicationAnalysis *mApplicationAnalyzerMockStartApplicationAnalysis) Times(n uint64) *mApplicationAnalyzerMockStartApplicationAnalysis {
+	if n == 0 {
+		mmStartApplicationAnalysis.mock.t.Fatalf("Times of ApplicationAnalyzerMock.StartApplicationAnalysis mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmStartApplicationAnalysis.expectedInvocations, n)
+	mmStartApplicationAnalysis.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmStartApplicationAnalysis
+}
+
+func (mmStartApplicationAnalysis *mApplicationAnalyzerMockStartApplicationAnalysis) invocationsDone() bool {
+	if len(mmStartApplicationAnalysis.expectations) == 0 && mmStartApplicationAnalysis.defaultExpectation == nil && mmStartApplicationAnalysis.mock.funcStartApplicationAnalysis == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmStartApplicationAnalysis.mock.afterStartApplicationAnalysisCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmStartApplicationAnalysis.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// StartApplicationAnalysis implements mm_usecase.ApplicationAnalyzer
+func (mmStartApplicationAnalysis *ApplicationAnalyzerMock) StartApplicationAnalysis(ctx context.Context, resumeID string) {
+	mm_atomic.AddUint64(&mmStartApplicationAnalysis.beforeStartApplicationAnalysisCounter, 1)
+	defer mm_atomic.AddUint64(&mmStartApplicationAnalysis.afterStartApplicationAnalysisCounter, 1)
+
+	mmStartApplicationAnalysis.t.Helper()
+
+	if mmStartApplicationAnalysis.inspectFuncStartApplicationAnalysis != nil {
+		mmStartApplicationAnalysis.inspectFuncStartApplicationAnalysis(ctx, resumeID)
+	}
+
+	mm_params := ApplicationAnalyzerMockStartApplicationAnalysisParams{ctx, resumeID}
+
+	// Record call args
+	mmStartApplicationAnalysis.StartApplicationAnalysisMock.mutex.Lock()
+	mmStartApplicationAnalysis.StartApplicationAnalysisMock.callArgs = append(mmStartApplicationAnalysis.StartApplicationAnalysisMock.callArgs, &mm_params)
+	mmStartApplicationAnalysis.StartApplicationAnalysisMock.mutex.Unlock()
+
+	for _, e := range mmStartApplicationAnalysis.StartApplicationAnalysisMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return
+		}
+	}
+
+	if mmStartApplicationAnalysis.StartApplicationAnalysisMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmStartApplicationAnalysis.StartApplicationAnalysisMock.defaultExpectation.Counter, 1)
+		mm_want := mmStartApplicationAnalysis.StartApplicationAnalysisMock.defaultExpectation.params
+		mm_want_ptrs := mmStartApplicationAnalysis.StartApplicationAnalysisMock.defaultExpectation.paramPtrs
+
+		mm_got := ApplicationAnalyzerMockStartApplicationAnalysisParams{ctx, resumeID}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmStartApplicationAnalysis.t.Errorf("ApplicationAnalyzerMock.StartApplicationAnalysis got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmStartApplicationAnalysis.StartApplicationAnalysisMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.resumeID != nil && !minimock.Equal(*mm_want_ptrs.resumeID, mm_got.resumeID) {
+				mmStartApplicationAnalysis.t.Errorf("ApplicationAnalyzerMock.StartApplicationAnalysis got unexpected parameter resumeID, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmStartApplicationAnalysis.StartApplicationAnalysisMock.defaultExpectation.expectationOrigins.originResumeID, *mm_want_ptrs.resumeID, mm_got.resumeID, minimock.Diff(*mm_want_ptrs.resumeID, mm_got.resumeID))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmStartApplicationAnalysis.t.Errorf("ApplicationAnalyzerMock.StartApplicationAnalysis got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmStartApplicationAnalysis.StartApplicationAnalysisMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		return
+
+	}
+	if mmStartApplicationAnalysis.funcStartApplicationAnalysis != nil {
+		mmStartApplicationAnalysis.funcStartApplicationAnalysis(ctx, resumeID)
+		return
+	}
+	mmStartApplicationAnalysis.t.Fatalf("Unexpected call to ApplicationAnalyzerMock.StartApplicationAnalysis. %v %v", ctx, resumeID)
+
+}
+
+// StartApplicationAnalysisAfterCounter returns a count of finished ApplicationAnalyzerMock.StartApplicationAnalysis invocations
+func (mmStartApplicationAnalysis *ApplicationAnalyzerMock) StartApplicationAnalysisAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmStartApplicationAnalysis.afterStartApplicationAnalysisCounter)
+}
+
+// StartApplicationAnalysisBeforeCounter returns a count of ApplicationAnalyzerMock.StartApplicationAnalysis invocations
+func (mmStartApplicationAnalysis *ApplicationAnalyzerMock) StartApplicationAnalysisBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmStartApplicationAnalysis.beforeStartApplicationAnalysisCounter)
+}
+
+// Calls returns a list of arguments used in each call to ApplicationAnalyzerMock.StartApplicationAnalysis.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmStartApplicationAnalysis *mApplicationAnalyzerMockStartApplicationAnalysis) Calls() []*ApplicationAnalyzerMockStartApplicationAnalysisParams {
+	mmStartApplicationAnalysis.mutex.RLock()
+
+	argCopy := make([]*ApplicationAnalyzerMockStartApplicationAnalysisParams, len(mmStartApplicationAnalysis.callArgs))
+	copy(argCopy, mmStartApplicationAnalysis.callArgs)
+
+	mmStartApplicationAnalysis.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockStartApplicationAnalysisDone returns true if the count of the StartApplicationAnalysis invocations corresponds
+// the number of defined expectations
+func (m *ApplicationAnalyzerMock) MinimockStartApplicationAnalysisDone() bool {
+	if m.StartApplicationAnalysisMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.StartApplicationAnalysisMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.StartApplicationAnalysisMock.invocationsDone()
+}
+
+// MinimockStartApplicationAnalysisInspect logs each unmet expectation
+func (m *ApplicationAnalyzerMock) MinimockStartApplicationAnalysisInspect() {
+	for _, e := range m.StartApplicationAnalysisMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to ApplicationAnalyzerMock.StartApplicationAnalysis at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterStartApplicationAnalysisCounter := mm_atomic.LoadUint64(&m.afterStartApplicationAnalysisCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.StartApplicationAnalysisMock.defaultExpectation != nil && afterStartApplicationAnalysisCounter < 1 {
+		if m.StartApplicationAnalysisMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to ApplicationAnalyzerMock.StartApplicationAnalysis at\n%s", m.StartApplicationAnalysisMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to ApplicationAnalyzerMock.StartApplicationAnalysis at\n%s with params: %#v", m.StartApplicationAnalysisMock.defaultExpectation.expectationOrigins.origin, *m.StartApplicationAnalysisMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcStartApplicationAnalysis != nil && afterStartApplicationAnalysisCounter < 1 {
+		m.t.Errorf("Expected call to ApplicationAnalyzerMock.StartApplicationAnalysis at\n%s", m.funcStartApplicationAnalysisOrigin)
+	}
+
+	if !m.StartApplicationAnalysisMock.invocationsDone() && afterStartApplicationAnalysisCounter > 0 {
+		m.t.Errorf("Expected %d calls to ApplicationAnalyzerMock.StartApplicationAnalysis at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.StartApplicationAnalysisMock.expectedInvocations), m.StartApplicationAnalysisMock.expectedInvocationsOrigin, afterStartApplicationAnalysisCounter)
+	}
+}
+
+// MinimockFinish checks that all mocked methods have been called the expected number of times
+func (m *ApplicationAnalyzerMock) MinimockFinish() {
+	m.finishOnce.Do(func() {
+		if !m.minimockDone() {
+			m.MinimockStartApplicationAnalysisInspect()
+		}
+	})
+}
+
+// MinimockWait waits for all mocked methods to be called the expected number of times
+func (m *ApplicationAnalyzerMock) MinimockWait(timeout mm_time.Duration) {
+	timeoutCh := mm_time.After(timeout)
+	for {
+		if m.minimockDone() {
+			return
+		}
+		select {
+		case <-timeoutCh:
+			m.MinimockFinish()
+			return
+		case <-mm_time.After(10 * mm_time.Millisecond):
+		}
+	}
+}
+
+func (m *ApplicationAnalyzerMock) minimockDone() bool {
+	done := true
+	return done &&
+		m.MinimockStartApplicationAnalysisDone()
+}
@@ -31,7 +31,7 @@ type ResumeStorageMock struct {
 	beforeCreateCandidateWithResumeCounter uint64
 	CreateCandidateWithResumeMock          mResumeStorageMockCreateCandidateWithResume
 
-	funcDeleteCandidate          func(ctx context.Context, candidateID string, requestUserID uint64, isAdmin bool) (err error)
+	funcDeleteCandidate          func(ctx context.Context, candidateID string, requestUserID uint64, isAdmin bool) (sa1 []string, err error)
 	funcDeleteCandidateOrigin    string
 	inspectFuncDeleteCandidate   func(ctx context.Context, candidateID string, requestUserID uint64, isAdmin bool)
 	afterDeleteCandidateCounter  uint64
@@ -59,6 +59,41 @@ type ResumeStorageMock struct {
 	beforeGetResumeCounter uint64
 	GetResumeMock          mResumeStorageMockGetResume
 
+	funcListLegacyBlobs          func(ctx context.Context, limit int) (la1 []domain.LegacyResumeBlob, err error)
+	funcListLegacyBlobsOrigin    string
+	inspectFuncListLegacyBlobs   func(ctx context.Context, limit int)
+	afterListLegacyBlobsCounter  uint64
+	beforeListLegacyBlobsCounter uint64
+	ListLegacyBlobsMock          mResumeStorageMockListLegacyBlobs
+
+	funcMoveBlobOut          func(ctx context.Context, resumeID string, storagePath string) (b1 bool, err error)
+	funcMoveBlobOutOrigin    string
+	inspectFuncMoveBlobOut   func(ctx context.Context, resumeID string, storagePath string)
+	afterMoveBlobOutCounter  uint64
+	beforeMoveBlobOutCounter uint64
+	MoveBlobOutMock          mResumeStorageMockMoveBlobOut
+
+	funcPublicVacancyOwner          func(ctx context.Context, vacancyID string) (u1 uint64, err error)
+	funcPublicVacancyOwnerOrigin    string
+	inspectFuncPublicVacancyOwner   func(ctx context.Context, vacancyID string)
+	afterPublicVacancyOwnerCounter  uint64
+	beforePublicVacancyOwnerCounter uint64
+	PublicVacancyOwnerMock          mResumeStorageMockPublicVacancyOwner
+
+	funcPurgeVacancyCandidates          func(ctx context.Context, vacancyID string) (pp1 *domain.PurgeVacancyCandidatesResult, err error)
+	funcPurgeVacancyCandidatesOrigin    string
+	inspectFuncPurgeVacancyCandidates   func(ctx context.Context, vacancyID string)
+	afterPurgeVacancyCandidatesCounter  uint64
+	beforePurgeVacancyCandidatesCounter uint64
+	PurgeVacancyCandidatesMock          mResumeStorageMockPurgeVacancyCandidates
+
+	funcTransferCandidateOwnership          func(ctx context.Context, in domain.TransferCandidateOwnershipInput) (u1 uint32, err error)
+	funcTransferCandidateOwnershipOrigin    string
+	inspectFuncTransferCandidateOwnership   func(ctx context.Context, in domain.TransferCandidateOwnershipInput)
+	afterTransferCandidateOwnershipCounter  uint64
+	beforeTransferCandidateOwnershipCounter uint64
+	TransferCandidateOwnershipMock          mResumeStorageMockTransferCandidateOwnership
+
 	funcUploadResume          func(ctx context.Context, in domain.UploadResumeInput) (rp1 *domain.Resume, err error)
 	funcUploadResumeOrigin    string
 	inspectFuncUploadResume   func(ctx context.Context, in domain.UploadResumeInput)
@@ -93,6 +128,21 @@ func NewResumeStorageMock(t minimock.Tester) *ResumeStorageMock {
 	m.GetResumeMock = mResumeStorageMockGetResume{mock: m}
 	m.GetResumeMock.callArgs = []*ResumeStorageMockGetResumeParams{}
 
+	m.ListLegacyBlobsMock = mResumeStorageMockListLegacyBlobs{mock: m}
+	m.ListLegacyBlobsMock.callArgs = []*ResumeStorageMockListLegacyBlobsParams{}
+
+	m.MoveBlobOutMock = mResumeStorageMockMoveBlobOut{mock: m}
+	m.MoveBlobOutMock.callArgs = []*ResumeStorageMockMoveBlobOutParams{}
+
+	m.PublicVacancyOwnerMock = mResumeStorageMockPublicVacancyOwner{mock: m}
+	m.PublicVacancyOwnerMock.callArgs = []*ResumeStorageMockPublicVacancyOwnerParams{}
+
+	m.PurgeVacancyCandidatesMock = mResumeStorageMockPurgeVacancyCandidates{mock: m}
+	m.PurgeVacancyCandidatesMock.callArgs = []*ResumeStorageMockPurgeVacancyCandidatesParams{}
+
+	m.TransferCandidateOwnershipMock = mResumeStorageMockTransferCandidateOwnership{mock: m}
+	m.TransferCandidateOwnershipMock.callArgs = []*ResumeStorageMockTransferCandidateOwnershipParams{}
+
 	m.UploadResumeMock = mResumeStorageMockUploadResume{mock: m}
 	m.UploadResumeMock.callArgs = []*ResumeStorageMockUploadResumeParams{}
 
@@ -861,6 +911,7 @@ type ResumeStorageMockDeleteCandidateParamPtrs struct {
 
 // ResumeStorageMockDeleteCandidateResults contains results of the ResumeStorage.DeleteCandidate
 type ResumeStorageMockDeleteCandidateResults struct {
+	sa1 []string
 	err error
 }
 
@@ -1012,7 +1063,7 @@ func (mmDeleteCandidate *mResumeStorageMockDeleteCandidate) Inspect(f func(ctx c
 }
 
 // Return sets up results that will be returned by ResumeStorage.DeleteCandidate
-func (mmDeleteCandidate *mResumeStorageMockDeleteCandidate) Return(err error) *ResumeStorageMock {
+func (mmDeleteCandidate *mResumeStorageMockDeleteCandidate) Return(sa1 []string, err error) *ResumeStorageMock {
 	if mmDeleteCandidate.mock.funcDeleteCandidate != nil {
 		mmDeleteCandidate.mock.t.Fatalf("ResumeStorageMock.DeleteCandidate mock is already set by Set")
 	}
@@ -1020,13 +1071,13 @@ func (mmDeleteCandidate *mResumeStorageMockDeleteCandidate) Return(err error) *R
 	if mmDeleteCandidate.defaultExpectation == nil {
 		mmDeleteCandidate.defaultExpectation = &ResumeStorageMockDeleteCandidateExpectation{mock: mmDeleteCandidate.mock}
 	}
-	mmDeleteCandidate.defaultExpectation.results = &ResumeStorageMockDeleteCandidateResults{err}
+	mmDeleteCandidate.defaultExpectation.results = &ResumeStorageMockDeleteCandidateResults{sa1, err}
 	mmDeleteCandidate.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
 	return mmDeleteCandidate.mock
 }
 
 // Set uses given function f to mock the ResumeStorage.DeleteCandidate method
-func (mmDeleteCandidate *mResumeStorageMockDeleteCandidate) Set(f func(ctx context.Context, candidateID string, requestUserID uint64, isAdmin bool) (err error)) *ResumeStorageMock {
+func (mmDeleteCandidate *mResumeStorageMockDeleteCandidate) Set(f func(ctx context.Context, candidateID string, requestUserID uint64, isAdmin bool) (sa1 []string, err error)) *ResumeStorageMock {
 	if mmDeleteCandidate.defaultExpectation != nil {
 		mmDeleteCandidate.mock.t.Fatalf("Default expectation is already set for the ResumeStorage.DeleteCandidate method")
 	}
@@ -1057,8 +1108,8 @@ func (mmDeleteCandidate *mResumeStorageMockDeleteCandidate) When(ctx context.Con
 }
 
 // Then sets up ResumeStorage.DeleteCandidate return parameters for the expectation previously defined by the When method
-func (e *ResumeStorageMockDeleteCandidateExpectation) Then(err error) *ResumeStorageMock {
-	e.results = &ResumeStorageMockDeleteCandidateResults{err}
+func (e *ResumeStorageMockDeleteCandidateExpectation) Then(sa1 []string, err error) *ResumeStorageMock {
+	e.results = &ResumeStorageMockDeleteCandidateResults{sa1, err}
 	return e.mock
 }
 
@@ -1084,7 +1135,7 @@ func (mmDeleteCandidate *mResumeStorageMockDeleteCandidate) invocationsDone() bo
 }
 
 // DeleteCandidate implements mm_usecase.ResumeStorage
-func (mmDeleteCandidate *ResumeStorageMock) DeleteCandidate(ctx context.Context, candidateID string, requestUserID uint64, isAdmin bool) (err error) {
+func (mmDeleteCandidate *ResumeStorageMock) DeleteCandidate(ctx context.Context, candidateID string, requestUserID uint64, isAdmin bool) (sa1 []string, err error) {
 	mm_atomic.AddUint64(&mmDeleteCandidate.beforeDeleteCandidateCounter, 1)
 	defer mm_atomic.AddUint64(&mmDeleteCandidate.afterDeleteCandidateCounter, 1)
 
@@ -1104,7 +1155,7 @@ func (mmDeleteCandidate *ResumeStorageMock) DeleteCandidate(ctx context.Context,
 	for _, e := range mmDeleteCandidate.DeleteCandidateMock.expectations {
 		if minimock.Equal(*e.params, mm_params) {
 			mm_atomic.AddUint64(&e.Counter, 1)
-			return e.results.err
+			return e.results.sa1, e.results.err
 		}
 	}
 
@@ -1146,7 +1197,7 @@ func (mmDeleteCandidate *ResumeStorageMock) DeleteCandidate(ctx context.Context,
 		if mm_results == nil {
 			mmDeleteCandidate.t.Fatal("No results are set for the ResumeStorageMock.DeleteCandidate")
 		}
-		return (*mm_results).err
+		return (*mm_results).sa1, (*mm_results).err
 	}
 	if mmDeleteCandidate.funcDeleteCandidate != nil {
 		return mmDeleteCandidate.funcDeleteCandidate(ctx, candidateID, requestUserID, isAdmin)
@@ -2438,53 +2489,53 @@ func (m *ResumeStorageMock) MinimockGetResumeInspect() {
 	}
 }
 
-type mResumeStorageMockUploadResume struct {
+type mResumeStorageMockListLegacyBlobs struct {
 	optional           bool
 	mock               *ResumeStorageMock
-	defaultExpectation *ResumeStorageMockUploadResumeExpectation
-	expectations       []*ResumeStorageMockUploadResumeExpectation
+	defaultExpectation *ResumeStorageMockListLegacyBlobsExpectation
+	expectations       []*ResumeStorageMockListLegacyBlobsExpectation
 
-	callArgs []*ResumeStorageMockUploadResumeParams
+	callArgs []*ResumeStorageMockListLegacyBlobsParams
 	mutex    sync.RWMutex
 
 	expectedInvocations       uint64
 	expectedInvocationsOrigin string
 }
 
-// ResumeStorageMockUploadResumeExpectation specifies expectation struct of the ResumeStorage.UploadResume
-type ResumeStorageMockUploadResumeExpectation struct {
+// ResumeStorageMockListLegacyBlobsExpectation specifies expectation struct of the ResumeStorage.ListLegacyBlobs
+type ResumeStorageMockListLegacyBlobsExpectation struct {
 	mock               *ResumeStorageMock
-	params             *ResumeStorageMockUploadResumeParams
-	paramPtrs          *ResumeStorageMockUploadResumeParamPtrs
-	expectationOrigins ResumeStorageMockUploadResumeExpectationOrigins
-	results            *ResumeStorageMockUploadResumeResults
+	params             *ResumeStorageMockListLegacyBlobsParams
+	paramPtrs          *ResumeStorageMockListLegacyBlobsParamPtrs
+	expectationOrigins ResumeStorageMockListLegacyBlobsExpectationOrigins
+	results            *ResumeStorageMockListLegacyBlobsResults
 	returnOrigin       string
 	Counter            uint64
 }
 
-// ResumeStorageMockUploadResumeParams contains parameters of the ResumeStorage.UploadResume
-type ResumeStorageMockUploadResumeParams struct {
-	ctx context.Context
-	in  domain.UploadResumeInput
+// ResumeStorageMockListLegacyBlobsParams contains parameters of the ResumeStorage.ListLegacyBlobs
+type ResumeStorageMockListLegacyBlobsParams struct {
+	ctx   context.Context
+	limit int
 }
 
-// ResumeStorageMockUploadResumeParamPtrs contains pointers to parameters of the ResumeStorage.UploadResume
-type ResumeStorageMockUploadResumeParamPtrs struct {
-	ctx *context.Context
-	in  *domain.UploadResumeInput
+// ResumeStorageMockListLegacyBlobsParamPtrs contains pointers to parameters of the ResumeStorage.ListLegacyBlobs
+type ResumeStorageMockListLegacyBlobsParamPtrs struct {
+	ctx   *context.Context
+	limit *int
 }
 
-// ResumeStorageMockUploadResumeResults contains results of the ResumeStorage.UploadResume
-type ResumeStorageMockUploadResumeResults struct {
-	rp1 *domain.Resume
+// ResumeStorageMockListLegacyBlobsResults contains results of the ResumeStorage.ListLegacyBlobs
+type ResumeStorageMockListLegacyBlobsResults struct {
+	la1 []domain.LegacyResumeBlob
 	err error
 }
 
-// ResumeStorageMockUploadResumeOrigins contains origins of expectations of the ResumeStorage.UploadResume
-type ResumeStorageMockUploadResumeExpectationOrigins struct {
-	origin    string
-	originCtx string
-	originIn  string
+// ResumeStorageMockListLegacyBlobsOrigins contains origins of expectations of the ResumeStorage.ListLegacyBlobs
+type ResumeStorageMockListLegacyBlobsExpectationOrigins struct {
+	origin      string
+	originCtx   string
+	originLimit string
 }
 
 // Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
@@ -2492,310 +2543,2066 @@ type ResumeStorageMockUploadResumeExpectationOrigins struct {
 // Optional() makes method check to work in '0 or more' mode.
 // It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
 // catch the problems when the expected method call is totally skipped during test run.
-func (mmUploadResume *mResumeStorageMockUploadResume) Optional() *mResumeStorageMockUploadResume {
-	mmUploadResume.optional = true
-	return mmUploadResume
+func (mmListLegacyBlobs *mResumeStorageMockListLegacyBlobs) Optional() *mResumeStorageMockListLegacyBlobs {
+	mmListLegacyBlobs.optional = true
+	return mmListLegacyBlobs
 }
 
-// Expect sets up expected params for ResumeStorage.UploadResume
-func (mmUploadResume *mResumeStorageMockUploadResume) Expect(ctx context.Context, in domain.UploadResumeInput) *mResumeStorageMockUploadResume {
-	if mmUploadResume.mock.funcUploadResume != nil {
-		mmUploadResume.mock.t.Fatalf("ResumeStorageMock.UploadResume mock is already set by Set")
+// Expect sets up expected params for ResumeStorage.ListLegacyBlobs
+func (mmListLegacyBlobs *mResumeStorageMockListLegacyBlobs) Expect(ctx context.Context, limit int) *mResumeStorageMockListLegacyBlobs {
+	if mmListLegacyBlobs.mock.funcListLegacyBlobs != nil {
+		mmListLegacyBlobs.mock.t.Fatalf("ResumeStorageMock.ListLegacyBlobs mock is already set by Set")
 	}
 
-	if mmUploadResume.defaultExpectation == nil {
-		mmUploadResume.defaultExpectation = &ResumeStorageMockUploadResumeExpectation{}
+	if mmListLegacyBlobs.defaultExpectation == nil {
+		mmListLegacyBlobs.defaultExpectation = &ResumeStorageMockListLegacyBlobsExpectation{}
 	}
 
-	if mmUploadResume.defaultExpectation.paramPtrs != nil {
-		mmUploadResume.mock.t.Fatalf("ResumeStorageMock.UploadResume mock is already set by ExpectParams functions")
+	if mmListLegacyBlobs.defaultExpectation.paramPtrs != nil {
+		mmListLegacyBlobs.mock.t.Fatalf("ResumeStorageMock.ListLegacyBlobs mock is already set by ExpectParams functions")
 	}
 
-	mmUploadResume.defaultExpectation.params = &ResumeStorageMockUploadResumeParams{ctx, in}
-	mmUploadResume.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
-	for _, e := range mmUploadResume.expectations {
-		if minimock.Equal(e.params, mmUploadResume.defaultExpectation.params) {
-			mmUploadResume.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmUploadResume.defaultExpectation.params)
+	mmListLegacyBlobs.defaultExpectation.params = &ResumeStorageMockListLegacyBlobsParams{ctx, limit}
+	mmListLegacyBlobs.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmListLegacyBlobs.expectations {
+		if minimock.Equal(e.params, mmListLegacyBlobs.defaultExpectation.params) {
+			mmListLegacyBlobs.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmListLegacyBlobs.defaultExpectation.params)
 		}
 	}
 
-	return mmUploadResume
+	return mmListLegacyBlobs
 }
 
-// ExpectCtxParam1 sets up expected param ctx for ResumeStorage.UploadResume
-func (mmUploadResume *mResumeStorageMockUploadResume) ExpectCtxParam1(ctx context.Context) *mResumeStorageMockUploadResume {
-	if mmUploadResume.mock.funcUploadResume != nil {
-		mmUploadResume.mock.t.Fatalf("ResumeStorageMock.UploadResume mock is already set by Set")
+// ExpectCtxParam1 sets up expected param ctx for ResumeStorage.ListLegacyBlobs
+func (mmListLegacyBlobs *mResumeStorageMockListLegacyBlobs) ExpectCtxParam1(ctx context.Context) *mResumeStorageMockListLegacyBlobs {
+	if mmListLegacyBlobs.mock.funcListLegacyBlobs != nil {
+		mmListLegacyBlobs.mock.t.Fatalf("ResumeStorageMock.ListLegacyBlobs mock is already set by Set")
 	}
 
-	if mmUploadResume.defaultExpectation == nil {
-		mmUploadResume.defaultExpectation = &ResumeStorageMockUploadResumeExpectation{}
+	if mmListLegacyBlobs.defaultExpectation == nil {
+		mmListLegacyBlobs.defaultExpectation = &ResumeStorageMockListLegacyBlobsExpectation{}
 	}
 
-	if mmUploadResume.defaultExpectation.params != nil {
-		mmUploadResume.mock.t.Fatalf("ResumeStorageMock.UploadResume mock is already set by Expect")
+	if mmListLegacyBlobs.defaultExpectation.params != nil {
+		mmListLegacyBlobs.mock.t.Fatalf("ResumeStorageMock.ListLegacyBlobs mock is already set by Expect")
 	}
 
-	if mmUploadResume.defaultExpectation.paramPtrs == nil {
-		mmUploadResume.defaultExpectation.paramPtrs = &ResumeStorageMockUploadResumeParamPtrs{}
+	if mmListLegacyBlobs.defaultExpectation.paramPtrs == nil {
+		mmListLegacyBlobs.defaultExpectation.paramPtrs = &ResumeStorageMockListLegacyBlobsParamPtrs{}
 	}
-	mmUploadResume.defaultExpectation.paramPtrs.ctx = &ctx
-	mmUploadResume.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+	mmListLegacyBlobs.defaultExpectation.paramPtrs.ctx = &ctx
+	mmListLegacyBlobs.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
 
-	return mmUploadResume
+	return mmListLegacyBlobs
 }
 
-// ExpectInParam2 sets up expected param in for ResumeStorage.UploadResume
-func (mmUploadResume *mResumeStorageMockUploadResume) ExpectInParam2(in domain.UploadResumeInput) *mResumeStorageMockUploadResume {
-	if mmUploadResume.mock.funcUploadResume != nil {
-		mmUploadResume.mock.t.Fatalf("ResumeStorageMock.UploadResume mock is already set by Set")
+// ExpectLimitParam2 sets up expected param limit for ResumeStorage.ListLegacyBlobs
+func (mmListLegacyBlobs *mResumeStorageMockListLegacyBlobs) ExpectLimitParam2(limit int) *mResumeStorageMockListLegacyBlobs {
+	if mmListLegacyBlobs.mock.funcListLegacyBlobs != nil {
+		mmListLegacyBlobs.mock.t.Fatalf("ResumeStorageMock.ListLegacyBlobs mock is already set by Set")
 	}
 
-	if mmUploadResume.defaultExpectation == nil {
-		mmUploadResume.defaultExpectation = &ResumeStorageMockUploadResumeExpectation{}
+	if mmListLegacyBlobs.defaultExpectation == nil {
+		mmListLegacyBlobs.defaultExpectation = &ResumeStorageMockListLegacyBlobsExpectation{}
 	}
 
-	if mmUploadResume.defaultExpectation.params != nil {
-		mmUploadResume.mock.t.Fatalf("ResumeStorageMock.UploadResume mock is already set by Expect")
+	if mmListLegacyBlobs.defaultExpectation.params != nil {
+		mmListLegacyBlobs.mock.t.Fatalf("ResumeStorageMock.ListLegacyBlobs mock is already set by Expect")
 	}
 
-	if mmUploadResume.defaultExpectation.paramPtrs == nil {
-		mmUploadResume.defaultExpectation.paramPtrs = &ResumeStorageMockUploadResumeParamPtrs{}
+	if mmListLegacyBlobs.defaultExpectation.paramPtrs == nil {
+		mmListLegacyBlobs.defaultExpectation.paramPtrs = &ResumeStorageMockListLegacyBlobsParamPtrs{}
 	}
-	mmUploadResume.defaultExpectation.paramPtrs.in = &in
-	mmUploadResume.defaultExpectation.expectationOrigins.originIn = minimock.CallerInfo(1)
+	mmListLegacyBlobs.defaultExpectation.paramPtrs.limit = &limit
+	mmListLegacyBlobs.defaultExpectation.expectationOrigins.originLimit = minimock.CallerInfo(1)
 
-	return mmUploadResume
+	return mmListLegacyBlobs
 }
 
-// Inspect accepts an inspector function that has same arguments as the ResumeStorage.UploadResume
-func (mmUploadResume *mResumeStorageMockUploadResume) Inspect(f func(ctx context.Context, in domain.UploadResumeInput)) *mResumeStorageMockUploadResume {
-	if mmUploadResume.mock.inspectFuncUploadResume != nil {
-		mmUploadResume.mock.t.Fatalf("Inspect function is already set for ResumeStorageMock.UploadResume")
+// Inspect accepts an inspector function that has same arguments as the ResumeStorage.ListLegacyBlobs
+func (mmListLegacyBlobs *mResumeStorageMockListLegacyBlobs) Inspect(f func(ctx context.Context, limit int)) *mResumeStorageMockListLegacyBlobs {
+	if mmListLegacyBlobs.mock.inspectFuncListLegacyBlobs != nil {
+		mmListLegacyBlobs.mock.t.Fatalf("Inspect function is already set for ResumeStorageMock.ListLegacyBlobs")
 	}
 
-	mmUploadResume.mock.inspectFuncUploadResume = f
+	mmListLegacyBlobs.mock.inspectFuncListLegacyBlobs = f
 
-	return mmUploadResume
+	return mmListLegacyBlobs
 }
 
-// Return sets up results that will be returned by ResumeStorage.UploadResume
-func (mmUploadResume *mResumeStorageMockUploadResume) Return(rp1 *domain.Resume, err error) *ResumeStorageMock {
-	if mmUploadResume.mock.funcUploadResume != nil {
-		mmUploadResume.mock.t.Fatalf("ResumeStorageMock.UploadResume mock is already set by Set")
+// Return sets up results that will be returned by ResumeStorage.ListLegacyBlobs
+func (mmListLegacyBlobs *mResumeStorageMockListLegacyBlobs) Return(la1 []domain.LegacyResumeBlob, err error) *ResumeStorageMock {
+	if mmListLegacyBlobs.mock.funcListLegacyBlobs != nil {
+		mmListLegacyBlobs.mock.t.Fatalf("ResumeStorageMock.ListLegacyBlobs mock is already set by Set")
 	}
 
-	if mmUploadResume.defaultExpectation == nil {
-		mmUploadResume.defaultExpectation = &ResumeStorageMockUploadResumeExpectation{mock: mmUploadResume.mock}
+	if mmListLegacyBlobs.defaultExpectation == nil {
+		mmListLegacyBlobs.defaultExpectation = &ResumeStorageMockListLegacyBlobsExpectation{mock: mmListLegacyBlobs.mock}
 	}
-	mmUploadResume.defaultExpectation.results = &ResumeStorageMockUploadResumeResults{rp1, err}
-	mmUploadResume.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
-	return mmUploadResume.mock
+	mmListLegacyBlobs.defaultExpectation.results = &ResumeStorageMockListLegacyBlobsResults{la1, err}
+	mmListLegacyBlobs.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmListLegacyBlobs.mock
 }
 
-// Set uses given function f to mock the ResumeStorage.UploadResume method
-func (mmUploadResume *mResumeStorageMockUploadResume) Set(f func(ctx context.Context, in domain.UploadResumeInput) (rp1 *domain.Resume, err error)) *ResumeStorageMock {
-	if mmUploadResume.defaultExpectation != nil {
-		mmUploadResume.mock.t.Fatalf("Default expectation is already set for the ResumeStorage.UploadResume method")
+// Set uses given function f to mock the ResumeStorage.ListLegacyBlobs method
+func (mmListLegacyBlobs *mResumeStorageMockListLegacyBlobs) Set(f func(ctx context.Context, limit int) (la1 []domain.LegacyResumeBlob, err error)) *ResumeStorageMock {
+	if mmListLegacyBlobs.defaultExpectation != nil {
+		mmListLegacyBlobs.mock.t.Fatalf("Default expectation is already set for the ResumeStorage.ListLegacyBlobs method")
 	}
 
-	if len(mmUploadResume.expectations) > 0 {
-		mmUploadResume.mock.t.Fatalf("Some expectations are already set for the ResumeStorage.UploadResume method")
+	if len(mmListLegacyBlobs.expectations) > 0 {
+		mmListLegacyBlobs.mock.t.Fatalf("Some expectations are already set for the ResumeStorage.ListLegacyBlobs method")
 	}
 
-	mmUploadResume.mock.funcUploadResume = f
-	mmUploadResume.mock.funcUploadResumeOrigin = minimock.CallerInfo(1)
-	return mmUploadResume.mock
+	mmListLegacyBlobs.mock.funcListLegacyBlobs = f
+	mmListLegacyBlobs.mock.funcListLegacyBlobsOrigin = minimock.CallerInfo(1)
+	return mmListLegacyBlobs.mock
 }
 
-// When sets expectation for the ResumeStorage.UploadResume which will trigger the result defined by the following
+// When sets expectation for the ResumeStorage.ListLegacyBlobs which will trigger the result defined by the following
 // Then helper
-func (mmUploadResume *mResumeStorageMockUploadResume) When(ctx context.Context, in domain.UploadResumeInput) *ResumeStorageMockUploadResumeExpectation {
-	if mmUploadResume.mock.funcUploadResume != nil {
-		mmUploadResume.mock.t.Fatalf("ResumeStorageMock.UploadResume mock is already set by Set")
+func (mmListLegacyBlobs *mResumeStorageMockListLegacyBlobs) When(ctx context.Context, limit int) *ResumeStorageMockListLegacyBlobsExpectation {
+	if mmListLegacyBlobs.mock.funcListLegacyBlobs != nil {
+		mmListLegacyBlobs.mock.t.Fatalf("ResumeStorageMock.ListLegacyBlobs mock is already set by Set")
 	}
 
-	expectation := &ResumeStorageMockUploadResumeExpectation{
-		mock:               mmUploadResume.mock,
-		params:             &ResumeStorageMockUploadResumeParams{ctx, in},
-		expectationOrigins: ResumeStorageMockUploadResumeExpectationOrigins{origin: minimock.CallerInfo(1)},
+	expectation := &ResumeStorageMockListLegacyBlobsExpectation{
+		mock:               mmListLegacyBlobs.mock,
+		params:             &ResumeStorageMockListLegacyBlobsParams{ctx, limit},
+		expectationOrigins: ResumeStorageMockListLegacyBlobsExpectationOrigins{origin: minimock.CallerInfo(1)},
 	}
-	mmUploadResume.expectations = append(mmUploadResume.expectations, expectation)
+	mmListLegacyBlobs.expectations = append(mmListLegacyBlobs.expectations, expectation)
 	return expectation
 }
 
-// Then sets up ResumeStorage.UploadResume return parameters for the expectation previously defined by the When method
-func (e *ResumeStorageMockUploadResumeExpectation) Then(rp1 *domain.Resume, err error) *ResumeStorageMock {
-	e.results = &ResumeStorageMockUploadResumeResults{rp1, err}
+// Then sets up ResumeStorage.ListLegacyBlobs return parameters for the expectation previously defined by the When method
+func (e *ResumeStorageMockListLegacyBlobsExpectation) Then(la1 []domain.LegacyResumeBlob, err error) *ResumeStorageMock {
+	e.results = &ResumeStorageMockListLegacyBlobsResults{la1, err}
 	return e.mock
 }
 
-// Times sets number of times ResumeStorage.UploadResume should be invoked
-func (mmUploadResume *mResumeStorageMockUploadResume) Times(n uint64) *mResumeStorageMockUploadResume {
+// Times sets number of times ResumeStorage.ListLegacyBlobs should be invoked
+func (mmListLegacyBlobs *mResumeStorageMockListLegacyBlobs) Times(n uint64) *mResumeStorageMockListLegacyBlobs {
 	if n == 0 {
-		mmUploadResume.mock.t.Fatalf("Times of ResumeStorageMock.UploadResume mock can not be zero")
+		mmListLegacyBlobs.mock.t.Fatalf("Times of ResumeStorageMock.ListLegacyBlobs mock can not be zero")
 	}
-	mm_atomic.StoreUint64(&mmUploadResume.expectedInvocations, n)
-	mmUploadResume.expectedInvocationsOrigin = minimock.CallerInfo(1)
-	return mmUploadResume
+	mm_atomic.StoreUint64(&mmListLegacyBlobs.expectedInvocations, n)
+	mmListLegacyBlobs.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmListLegacyBlobs
 }
 
-func (mmUploadResume *mResumeStorageMockUploadResume) invocationsDone() bool {
-	if len(mmUploadResume.expectations) == 0 && mmUploadResume.defaultExpectation == nil && mmUploadResume.mock.funcUploadResume == nil {
+func (mmListLegacyBlobs *mResumeStorageMockListLegacyBlobs) invocationsDone() bool {
+	if len(mmListLegacyBlobs.expectations) == 0 && mmListLegacyBlobs.defaultExpectation == nil && mmListLegacyBlobs.mock.funcListLegacyBlobs == nil {
 		return true
 	}
 
-	totalInvocations := mm_atomic.LoadUint64(&mmUploadResume.mock.afterUploadResumeCounter)
-	expectedInvocations := mm_atomic.LoadUint64(&mmUploadResume.expectedInvocations)
+	totalInvocations := mm_atomic.LoadUint64(&mmListLegacyBlobs.mock.afterListLegacyBlobsCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmListLegacyBlobs.expectedInvocations)
 
 	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
 }
 
-// UploadResume implements mm_usecase.ResumeStorage
-func (mmUploadResume *ResumeStorageMock) UploadResume(ctx context.Context, in domain.UploadResumeInput) (rp1 *domain.Resume, err error) {
-	mm_atomic.AddUint64(&mmUploadResume.beforeUploadResumeCounter, 1)
-	defer mm_atomic.AddUint64(&mmUploadResume.afterUploadResumeCounter, 1)
+// ListLegacyBlobs implements mm_usecase.ResumeStorage
+func (mmListLegacyBlobs *ResumeStorageMock) ListLegacyBlobs(ctx context.Context, limit int) (la1 []domain.LegacyResumeBlob, err error) {
+	mm_atomic.AddUint64(&mmListLegacyBlobs.beforeListLegacyBlobsCounter, 1)
+	defer mm_atomic.AddUint64(&mmListLegacyBlobs.afterListLegacyBlobsCounter, 1)
 
-	mmUploadResume.t.Helper()
+	mmListLegacyBlobs.t.Helper()
 
-	if mmUploadResume.inspectFuncUploadResume != nil {
-		mmUploadResume.inspectFuncUploadResume(ctx, in)
+	if mmListLegacyBlobs.inspectFuncListLegacyBlobs != nil {
+		mmListLegacyBlobs.inspectFuncListLegacyBlobs(ctx, limit)
 	}
 
-	mm_params := ResumeStorageMockUploadResumeParams{ctx, in}
+	mm_params := ResumeStorageMockListLegacyBlobsParams{ctx, limit}
 
 	// Record call args
-	mmUploadResume.UploadResumeMock.mutex.Lock()
-	mmUploadResume.UploadResumeMock.callArgs = append(mmUploadResume.UploadResumeMock.callArgs, &mm_params)
-	mmUploadResume.UploadResumeMock.mutex.Unlock()
+	mmListLegacyBlobs.ListLegacyBlobsMock.mutex.Lock()
+	mmListLegacyBlobs.ListLegacyBlobsMock.callArgs = append(mmListLegacyBlobs.ListLegacyBlobsMock.callArgs, &mm_params)
+	mmListLegacyBlobs.ListLegacyBlobsMock.mutex.Unlock()
 
-	for _, e := range mmUploadResume.UploadResumeMock.expectations {
+	for _, e := range mmListLegacyBlobs.ListLegacyBlobsMock.expectations {
 		if minimock.Equal(*e.params, mm_params) {
 			mm_atomic.AddUint64(&e.Counter, 1)
-			return e.results.rp1, e.results.err
+			return e.results.la1, e.results.err
 		}
 	}
 
-	if mmUploadResume.UploadResumeMock.defaultExpectation != nil {
-		mm_atomic.AddUint64(&mmUploadResume.UploadResumeMock.defaultExpectation.Counter, 1)
-		mm_want := mmUploadResume.UploadResumeMock.defaultExpectation.params
-		mm_want_ptrs := mmUploadResume.UploadResumeMock.defaultExpectation.paramPtrs
+	if mmListLegacyBlobs.ListLegacyBlobsMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmListLegacyBlobs.ListLegacyBlobsMock.defaultExpectation.Counter, 1)
+		mm_want := mmListLegacyBlobs.ListLegacyBlobsMock.defaultExpectation.params
+		mm_want_ptrs := mmListLegacyBlobs.ListLegacyBlobsMock.defaultExpectation.paramPtrs
 
-		mm_got := ResumeStorageMockUploadResumeParams{ctx, in}
+		mm_got := ResumeStorageMockListLegacyBlobsParams{ctx, limit}
 
 		if mm_want_ptrs != nil {
 
 			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
-				mmUploadResume.t.Errorf("ResumeStorageMock.UploadResume got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
-					mmUploadResume.UploadResumeMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+				mmListLegacyBlobs.t.Errorf("ResumeStorageMock.ListLegacyBlobs got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmListLegacyBlobs.ListLegacyBlobsMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
 			}
 
-			if mm_want_ptrs.in != nil && !minimock.Equal(*mm_want_ptrs.in, mm_got.in) {
-				mmUploadResume.t.Errorf("ResumeStorageMock.UploadResume got unexpected parameter in, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
-					mmUploadResume.UploadResumeMock.defaultExpectation.expectationOrigins.originIn, *mm_want_ptrs.in, mm_got.in, minimock.Diff(*mm_want_ptrs.in, mm_got.in))
+			if mm_want_ptrs.limit != nil && !minimock.Equal(*mm_want_ptrs.limit, mm_got.limit) {
+				mmListLegacyBlobs.t.Errorf("ResumeStorageMock.ListLegacyBlobs got unexpected parameter limit, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmListLegacyBlobs.ListLegacyBlobsMock.defaultExpectation.expectationOrigins.originLimit, *mm_want_ptrs.limit, mm_got.limit, minimock.Diff(*mm_want_ptrs.limit, mm_got.limit))
 			}
 
 		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
-			mmUploadResume.t.Errorf("ResumeStorageMock.UploadResume got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
-				mmUploadResume.UploadResumeMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+			mmListLegacyBlobs.t.Errorf("ResumeStorageMock.ListLegacyBlobs got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmListLegacyBlobs.ListLegacyBlobsMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
 		}
 
-		mm_results := mmUploadResume.UploadResumeMock.defaultExpectation.results
+		mm_results := mmListLegacyBlobs.ListLegacyBlobsMock.defaultExpectation.results
 		if mm_results == nil {
-			mmUploadResume.t.Fatal("No results are set for the ResumeStorageMock.UploadResume")
+			mmListLegacyBlobs.t.Fatal("No results are set for the ResumeStorageMock.ListLegacyBlobs")
 		}
-		return (*mm_results).rp1, (*mm_results).err
+		return (*mm_results).la1, (*mm_results).err
 	}
-	if mmUploadResume.funcUploadResume != nil {
-		return mmUploadResume.funcUploadResume(ctx, in)
+	if mmListLegacyBlobs.funcListLegacyBlobs != nil {
+		return mmListLegacyBlobs.funcListLegacyBlobs(ctx, limit)
 	}
-	mmUploadResume.t.Fatalf("Unexpected call to ResumeStorageMock.UploadResume. %v %v", ctx, in)
+	mmListLegacyBlobs.t.Fatalf("Unexpected call to ResumeStorageMock.ListLegacyBlobs. %v %v", ctx, limit)
 	return
 }
 
-// UploadResumeAfterCounter returns a count of finished ResumeStorageMock.UploadResume invocations
-func (mmUploadResume *ResumeStorageMock) UploadResumeAfterCounter() uint64 {
-	return mm_atomic.LoadUint64(&mmUploadResume.afterUploadResumeCounter)
+// ListLegacyBlobsAfterCounter returns a count of finished ResumeStorageMock.ListLegacyBlobs invocations
+func (mmListLegacyBlobs *ResumeStorageMock) ListLegacyBlobsAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmListLegacyBlobs.afterListLegacyBlobsCounter)
 }
 
-// UploadResumeBeforeCounter returns a count of ResumeStorageMock.UploadResume invocations
-func (mmUploadResume *ResumeStorageMock) UploadResumeBeforeCounter() uint64 {
-	return mm_atomic.LoadUint64(&mmUploadResume.beforeUploadResumeCounter)
+// ListLegacyBlobsBeforeCounter returns a count of ResumeStorageMock.ListLegacyBlobs invocations
+func (mmListLegacyBlobs *ResumeStorageMock) ListLegacyBlobsBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmListLegacyBlobs.beforeListLegacyBlobsCounter)
 }
 
-// Calls returns a list of arguments used in each call to ResumeStorageMock.UploadResume.
+// Calls returns a list of arguments used in each call to ResumeStorageMock.ListLegacyBlobs.
 // The list is in the same order as the calls were made (i.e. recent calls have a higher index)
-func (mmUploadResume *mResumeStorageMockUploadResume) Calls() []*ResumeStorageMockUploadResumeParams {
-	mmUploadResume.mutex.RLock()
+func (mmListLegacyBlobs *mResumeStorageMockListLegacyBlobs) Calls() []*ResumeStorageMockListLegacyBlobsParams {
+	mmListLegacyBlobs.mutex.RLock()
 
-	argCopy := make([]*ResumeStorageMockUploadResumeParams, len(mmUploadResume.callArgs))
-	copy(argCopy, mmUploadResume.callArgs)
+	argCopy := make([]*ResumeStorageMockListLegacyBlobsParams, len(mmListLegacyBlobs.callArgs))
+	copy(argCopy, mmListLegacyBlobs.callArgs)
 
-	mmUploadResume.mutex.RUnlock()
+	mmListLegacyBlobs.mutex.RUnlock()
 
 	return argCopy
 }
 
-// MinimockUploadResumeDone returns true if the count of the UploadResume invocations corresponds
+// MinimockListLegacyBlobsDone returns true if the count of the ListLegacyBlobs invocations corresponds
 // the number of defined expectations
-func (m *ResumeStorageMock) MinimockUploadResumeDone() bool {
-	if m.UploadResumeMock.optional {
+func (m *ResumeStorageMock) MinimockListLegacyBlobsDone() bool {
+	if m.ListLegacyBlobsMock.optional {
 		// Optional methods provide '0 or more' call count restriction.
 		return true
 	}
 
-	for _, e := range m.UploadResumeMock.expectations {
+	for _, e := range m.ListLegacyBlobsMock.expectations {
 		if mm_atomic.LoadUint64(&e.Counter) < 1 {
 			return false
 		}
 	}
 
-	return m.UploadResumeMock.invocationsDone()
+	return m.ListLegacyBlobsMock.invocationsDone()
 }
 
-// MinimockUploadResumeInspect logs each unmet expectation
-func (m *ResumeStorageMock) MinimockUploadResumeInspect() {
-	for _, e := range m.UploadResumeMock.expectations {
+// MinimockListLegacyBlobsInspect logs each unmet expectation
+func (m *ResumeStorageMock) MinimockListLegacyBlobsInspect() {
+	for _, e := range m.ListLegacyBlobsMock.expectations {
 		if mm_atomic.LoadUint64(&e.Counter) < 1 {
-			m.t.Errorf("Expected call to ResumeStorageMock.UploadResume at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+			m.t.Errorf("Expected call to ResumeStorageMock.ListLegacyBlobs at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
 		}
 	}
 
-	afterUploadResumeCounter := mm_atomic.LoadUint64(&m.afterUploadResumeCounter)
+	afterListLegacyBlobsCounter := mm_atomic.LoadUint64(&m.afterListLegacyBlobsCounter)
 	// if default expectation was set then invocations count should be greater than zero
-	if m.UploadResumeMock.defaultExpectation != nil && afterUploadResumeCounter < 1 {
-		if m.UploadResumeMock.defaultExpectation.params == nil {
-			m.t.Errorf("Expected call to ResumeStorageMock.UploadResume at\n%s", m.UploadResumeMock.defaultExpectation.returnOrigin)
+	if m.ListLegacyBlobsMock.defaultExpectation != nil && afterListLegacyBlobsCounter < 1 {
+		if m.ListLegacyBlobsMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to ResumeStorageMock.ListLegacyBlobs at\n%s", m.ListLegacyBlobsMock.defaultExpectation.returnOrigin)
 		} else {
-			m.t.Errorf("Expected call to ResumeStorageMock.UploadResume at\n%s with params: %#v", m.UploadResumeMock.defaultExpectation.expectationOrigins.origin, *m.UploadResumeMock.defaultExpectation.params)
+			m.t.Errorf("Expected call to ResumeStorageMock.ListLegacyBlobs at\n%s with params: %#v", m.ListLegacyBlobsMock.defaultExpectation.expectationOrigins.origin, *m.ListLegacyBlobsMock.defaultExpectation.params)
 		}
 	}
 	// if func was set then invocations count should be greater than zero
-	if m.funcUploadResume != nil && afterUploadResumeCounter < 1 {
-		m.t.Errorf("Expected call to ResumeStorageMock.UploadResume at\n%s", m.funcUploadResumeOrigin)
+	if m.funcListLegacyBlobs != nil && afterListLegacyBlobsCounter < 1 {
+		m.t.Errorf("Expected call to ResumeStorageMock.ListLegacyBlobs at\n%s", m.funcListLegacyBlobsOrigin)
 	}
 
-	if !m.UploadResumeMock.invocationsDone() && afterUploadResumeCounter > 0 {
-		m.t.Errorf("Expected %d calls to ResumeStorageMock.UploadResume at\n%s but found %d calls",
-			mm_atomic.LoadUint64(&m.UploadResumeMock.expectedInvocations), m.UploadResumeMock.expectedInvocationsOrigin, afterUploadResumeCounter)
+	if !m.ListLegacyBlobsMock.invocationsDone() && afterListLegacyBlobsCounter > 0 {
+		m.t.Errorf("Expected %d calls to ResumeStorageMock.ListLegacyBlobs at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.ListLegacyBlobsMock.expectedInvocations), m.ListLegacyBlobsMock.expectedInvocationsOrigin, afterListLegacyBlobsCounter)
 	}
 }
 
-// MinimockFinish checks that all mocked methods have been called the expected number of times
-func (m *ResumeStorageMock) MinimockFinish() {
-	m.finishOnce.Do(func() {
-		if !m.minimockDone() {
-			m.MinimockCreateCandidateInspect()
+type mResumeStorageMockMoveBlobOut struct {
+	optional           bool
+	mock               *ResumeStorageMock
+	defaultExpectation *ResumeStorageMockMoveBlobOutExpectation
+	expectations       []*ResumeStorageMockMoveBlobOutExpectation
 
-			m.MinimockCreateCandidateWithResumeInspect()
+	callArgs []*ResumeStorageMockMoveBlobOutParams
+	mutex    sync.RWMutex
 
-			m.MinimockDeleteCandidateInspect()
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
 
-			m.MinimockDownloadResumeInspect()
+// ResumeStorageMockMoveBlobOutExpectation specifies expectation struct of the ResumeStorage.MoveBlobOut
+type ResumeStorageMockMoveBlobOutExpectation struct {
+	mock               *ResumeStorageMock
+	params             *ResumeStorageMockMoveBlobOutParams
+	paramPtrs          *ResumeStorageMockMoveBlobOutParamPtrs
+	expectationOrigins ResumeStorageMockMoveBlobOutExpectationOrigins
+	results            *ResumeStorageMockMoveBlobOutResults
+	returnOrigin       string
+	Counter            uint64
+}
 
-			m.MinimockGetCandidateInspect()
+// ResumeStorageMockMoveBlobOutParams contains parameters of the ResumeStorage.MoveBlobOut
+type ResumeStorageMockMoveBlobOutParams struct {
+	ctx         context.Context
+	resumeID    string
+	storagePath string
+}
 
-			m.MinimockGetResumeInspect()
+// ResumeStorageMockMoveBlobOutParamPtrs contains pointers to parameters of the ResumeStorage.MoveBlobOut
+type ResumeStorageMockMoveBlobOutParamPtrs struct {
+	ctx         *context.Context
+	resumeID    *string
+	storagePath *string
+}
+
+// ResumeStorageMockMoveBlobOutResults contains results of the ResumeStorage.MoveBlobOut
+type ResumeStorageMockMoveBlobOutResults struct {
+	b1  bool
+	err error
+}
+
+// ResumeStorageMockMoveBlobOutOrigins contains origins of expectations of the ResumeStorage.MoveBlobOut
+type ResumeStorageMockMoveBlobOutExpectationOrigins struct {
+	origin            string
+	originCtx         string
+	originResumeID    string
+	originStoragePath string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmMoveBlobOut *mResumeStorageMockMoveBlobOut) Optional() *mResumeStorageMockMoveBlobOut {
+	mmMoveBlobOut.optional = true
+	return mmMoveBlobOut
+}
+
+// Expect sets up expected params for ResumeStorage.MoveBlobOut
+func (mmMoveBlobOut *mResumeStorageMockMoveBlobOut) Expect(ctx context.Context, resumeID string, storagePath string) *mResumeStorageMockMoveBlobOut {
+	if mmMoveBlobOut.mock.funcMoveBlobOut != nil {
+		mmMoveBlobOut.mock.t.Fatalf("ResumeStorageMock.MoveBlobOut mock is already set by Set")
+	}
+
+	if mmMoveBlobOut.defaultExpectation == nil {
+		mmMoveBlobOut.defaultExpectation = &ResumeStorageMockMoveBlobOutExpectation{}
+	}
+
+	if mmMoveBlobOut.defaultExpectation.paramPtrs != nil {
+		mmMoveBlobOut.mock.t.Fatalf("ResumeStorageMock.MoveBlobOut mock is already set by ExpectParams functions")
+	}
+
+	mmMoveBlobOut.defaultExpectation.params = &ResumeStorageMockMoveBlobOutParams{ctx, resumeID, storagePath}
+	mmMoveBlobOut.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmMoveBlobOut.expectations {
+		if minimock.Equal(e.params, mmMoveBlobOut.defaultExpectation.params) {
+			mmMoveBlobOut.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmMoveBlobOut.defaultExpectation.params)
+		}
+	}
+
+	return mmMoveBlobOut
+}
+
+// ExpectCtxParam1 sets up expected param ctx for ResumeStorage.MoveBlobOut
+func (mmMoveBlobOut *mResumeStorageMockMoveBlobOut) ExpectCtxParam1(ctx context.Context) *mResumeStorageMockMoveBlobOut {
+	if mmMoveBlobOut.mock.funcMoveBlobOut != nil {
+		mmMoveBlobOut.mock.t.Fatalf("ResumeStorageMock.MoveBlobOut mock is already set by Set")
+	}
+
+	if mmMoveBlobOut.defaultExpectation == nil {
+		mmMoveBlobOut.defaultExpectation = &ResumeStorageMockMoveBlobOutExpectation{}
+	}
+
+	if mmMoveBlobOut.defaultExpectation.params != nil {
+		mmMoveBlobOut.mock.t.Fatalf("ResumeStorageMock.MoveBlobOut mock is already set by Expect")
+	}
+
+	if mmMoveBlobOut.defaultExpectation.paramPtrs == nil {
+		mmMoveBlobOut.defaultExpectation.paramPtrs = &ResumeStorageMockMoveBlobOutParamPtrs{}
+	}
+	mmMoveBlobOut.defaultExpectation.paramPtrs.ctx = &ctx
+	mmMoveBlobOut.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmMoveBlobOut
+}
+
+// ExpectResumeIDParam2 sets up expected param resumeID for ResumeStorage.MoveBlobOut
+func (mmMoveBlobOut *mResumeStorageMockMoveBlobOut) ExpectResumeIDParam2(resumeID string) *mResumeStorageMockMoveBlobOut {
+	if mmMoveBlobOut.mock.funcMoveBlobOut != nil {
+		mmMoveBlobOut.mock.t.Fatalf("ResumeStorageMock.MoveBlobOut mock is already set by Set")
+	}
+
+	if mmMoveBlobOut.defaultExpectation == nil {
+		mmMoveBlobOut.defaultExpectation = &ResumeStorageMockMoveBlobOutExpectation{}
+	}
+
+	if mmMoveBlobOut.defaultExpectation.params != nil {
+		mmMoveBlobOut.mock.t.Fatalf("ResumeStorageMock.MoveBlobOut mock is already set by Expect")
+	}
+
+	if mmMoveBlobOut.defaultExpectation.paramPtrs == nil {
+		mmMoveBlobOut.defaultExpectation.paramPtrs = &ResumeStorageMockMoveBlobOutParamPtrs{}
+	}
+	mmMoveBlobOut.defaultExpectation.paramPtrs.resumeID = &resumeID
+	mmMoveBlobOut.defaultExpectation.expectationOrigins.originResumeID = minimock.CallerInfo(1)
+
+	return mmMoveBlobOut
+}
+
+// ExpectStoragePathParam3 sets up expected param storagePath for ResumeStorage.MoveBlobOut
+func (mmMoveBlobOut *mResumeStorageMockMoveBlobOut) ExpectStoragePathParam3(storagePath string) *mResumeStorageMockMoveBlobOut {
+	if mmMoveBlobOut.mock.funcMoveBlobOut != nil {
+		mmMoveBlobOut.mock.t.Fatalf("ResumeStorageMock.MoveBlobOut mock is already set by Set")
+	}
+
+	if mmMoveBlobOut.defaultExpectation == nil {
+		mmMoveBlobOut.defaultExpectation = &ResumeStorageMockMoveBlobOutExpectation{}
+	}
+
+	if mmMoveBlobOut.defaultExpectation.params != nil {
+		mmMoveBlobOut.mock.t.Fatalf("ResumeStorageMock.MoveBlobOut mock is already set by Expect")
+	}
+
+	if mmMoveBlobOut.defaultExpectation.paramPtrs == nil {
+		mmMoveBlobOut.defaultExpectation.paramPtrs = &ResumeStorageMockMoveBlobOutParamPtrs{}
+	}
+	mmMoveBlobOut.defaultExpectation.paramPtrs.storagePath = &storagePath
+	mmMoveBlobOut.defaultExpectation.expectationOrigins.originStoragePath = minimock.CallerInfo(1)
+
+	return mmMoveBlobOut
+}
+
+// Inspect accepts an inspector function that has same arguments as the ResumeStorage.MoveBlobOut
+func (mmMoveBlobOut *mResumeStorageMockMoveBlobOut) Inspect(f func(ctx context.Context, resumeID string, storagePath string)) *mResumeStorageMockMoveBlobOut {
+	if mmMoveBlobOut.mock.inspectFuncMoveBlobOut != nil {
+		mmMoveBlobOut.mock.t.Fatalf("Inspect function is already set for ResumeStorageMock.MoveBlobOut")
+	}
+
+	mmMoveBlobOut.mock.inspectFuncMoveBlobOut = f
+
+	return mmMoveBlobOut
+}
+
+// Return sets up results that will be returned by ResumeStorage.MoveBlobOut
+func (mmMoveBlobOut *mResumeStorageMockMoveBlobOut) Return(b1 bool, err error) *ResumeStorageMock {
+	if mmMoveBlobOut.mock.funcMoveBlobOut != nil {
+		mmMoveBlobOut.mock.t.Fatalf("ResumeStorageMock.MoveBlobOut mock is already set by Set")
+	}
+
+	if mmMoveBlobOut.defaultExpectation == nil {
+		mmMoveBlobOut.defaultExpectation = &ResumeStorageMockMoveBlobOutExpectation{mock: mmMoveBlobOut.mock}
+	}
+	mmMoveBlobOut.defaultExpectation.results = &ResumeStorageMockMoveBlobOutResults{b1, err}
+	mmMoveBlobOut.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmMoveBlobOut.mock
+}
+
+// Set uses given function f to mock the ResumeStorage.MoveBlobOut method
+func (mmMoveBlobOut *mResumeStorageMockMoveBlobOut) Set(f func(ctx context.Context, resumeID string, storagePath string) (b1 bool, err error)) *ResumeStorageMock {
+	if mmMoveBlobOut.defaultExpectation != nil {
+		mmMoveBlobOut.mock.t.Fatalf("Default expectation is already set for the ResumeStorage.MoveBlobOut method")
+	}
+
+	if len(mmMoveBlobOut.expectations) > 0 {
+		mmMoveBlobOut.mock.t.Fatalf("Some expectations are already set for the ResumeStorage.MoveBlobOut method")
+	}
+
+	mmMoveBlobOut.mock.funcMoveBlobOut = f
+	mmMoveBlobOut.mock.funcMoveBlobOutOrigin = minimock.CallerInfo(1)
+	return mmMoveBlobOut.mock
+}
+
+// When sets expectation for the ResumeStorage.MoveBlobOut which will trigger the result defined by the following
+// Then helper
+func (mmMoveBlobOut *mResumeStorageMockMoveBlobOut) When(ctx context.Context, resumeID string, storagePath string) *ResumeStorageMockMoveBlobOutExpectation {
+	if mmMoveBlobOut.mock.funcMoveBlobOut != nil {
+		mmMoveBlobOut.mock.t.Fatalf("ResumeStorageMock.MoveBlobOut mock is already set by Set")
+	}
+
+	expectation := &ResumeStorageMockMoveBlobOutExpectation{
+		mock:               mmMoveBlobOut.mock,
+		params:             &ResumeStorageMockMoveBlobOutParams{ctx, resumeID, storagePath},
+		expectationOrigins: ResumeStorageMockMoveBlobOutExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmMoveBlobOut.expectations = append(mmMoveBlobOut.expectations, expectation)
+	return expectation
+}
+
+// Then sets up ResumeStorage.MoveBlobOut return parameters for the expectation previously defined by the When method
+func (e *ResumeStorageMockMoveBlobOutExpectation) Then(b1 bool, err error) *ResumeStorageMock {
+	e.results = &ResumeStorageMockMoveBlobOutResults{b1, err}
+	return e.mock
+}
+
+// Times sets number of times ResumeStorage.MoveBlobOut should be invoked
+func (mmMoveBlobOut *mResumeStorageMockMoveBlobOut) Times(n uint64) *mResumeStorageMockMoveBlobOut {
+	if n == 0 {
+		mmMoveBlobOut.mock.t.Fatalf("Times of ResumeStorageMock.MoveBlobOut mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmMoveBlobOut.expectedInvocations, n)
+	mmMoveBlobOut.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmMoveBlobOut
+}
+
+func (mmMoveBlobOut *mResumeStorageMockMoveBlobOut) invocationsDone() bool {
+	if len(mmMoveBlobOut.expectations) == 0 && mmMoveBlobOut.defaultExpectation == nil && mmMoveBlobOut.mock.funcMoveBlobOut == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmMoveBlobOut.mock.afterMoveBlobOutCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmMoveBlobOut.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// MoveBlobOut implements mm_usecase.ResumeStorage
+func (mmMoveBlobOut *ResumeStorageMock) MoveBlobOut(ctx context.Context, resumeID string, storagePath string) (b1 bool, err error) {
+	mm_atomic.AddUint64(&mmMoveBlobOut.beforeMoveBlobOutCounter, 1)
+	defer mm_atomic.AddUint64(&mmMoveBlobOut.afterMoveBlobOutCounter, 1)
+
+	mmMoveBlobOut.t.Helper()
+
+	if mmMoveBlobOut.inspectFuncMoveBlobOut != nil {
+		mmMoveBlobOut.inspectFuncMoveBlobOut(ctx, resumeID, storagePath)
+	}
+
+	mm_params := ResumeStorageMockMoveBlobOutParams{ctx, resumeID, storagePath}
+
+	// Record call args
+	mmMoveBlobOut.MoveBlobOutMock.mutex.Lock()
+	mmMoveBlobOut.MoveBlobOutMock.callArgs = append(mmMoveBlobOut.MoveBlobOutMock.callArgs, &mm_params)
+	mmMoveBlobOut.MoveBlobOutMock.mutex.Unlock()
+
+	for _, e := range mmMoveBlobOut.MoveBlobOutMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.b1, e.results.err
+		}
+	}
+
+	if mmMoveBlobOut.MoveBlobOutMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmMoveBlobOut.MoveBlobOutMock.defaultExpectation.Counter, 1)
+		mm_want := mmMoveBlobOut.MoveBlobOutMock.defaultExpectation.params
+		mm_want_ptrs := mmMoveBlobOut.MoveBlobOutMock.defaultExpectation.paramPtrs
+
+		mm_got := ResumeStorageMockMoveBlobOutParams{ctx, resumeID, storagePath}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmMoveBlobOut.t.Errorf("ResumeStorageMock.MoveBlobOut got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmMoveBlobOut.MoveBlobOutMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.resumeID != nil && !minimock.Equal(*mm_want_ptrs.resumeID, mm_got.resumeID) {
+				mmMoveBlobOut.t.Errorf("ResumeStorageMock.MoveBlobOut got unexpected parameter resumeID, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmMoveBlobOut.MoveBlobOutMock.defaultExpectation.expectationOrigins.originResumeID, *mm_want_ptrs.resumeID, mm_got.resumeID, minimock.Diff(*mm_want_ptrs.resumeID, mm_got.resumeID))
+			}
+
+			if mm_want_ptrs.storagePath != nil && !minimock.Equal(*mm_want_ptrs.storagePath, mm_got.storagePath) {
+				mmMoveBlobOut.t.Errorf("ResumeStorageMock.MoveBlobOut got unexpected parameter storagePath, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmMoveBlobOut.MoveBlobOutMock.defaultExpectation.expectationOrigins.originStoragePath, *mm_want_ptrs.storagePath, mm_got.storagePath, minimock.Diff(*mm_want_ptrs.storagePath, mm_got.storagePath))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmMoveBlobOut.t.Errorf("ResumeStorageMock.MoveBlobOut got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmMoveBlobOut.MoveBlobOutMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmMoveBlobOut.MoveBlobOutMock.defaultExpectation.results
+		if mm_results == nil {
+			mmMoveBlobOut.t.Fatal("No results are set for the ResumeStorageMock.MoveBlobOut")
+		}
+		return (*mm_results).b1, (*mm_results).err
+	}
+	if mmMoveBlobOut.funcMoveBlobOut != nil {
+		return mmMoveBlobOut.funcMoveBlobOut(ctx, resumeID, storagePath)
+	}
+	mmMoveBlobOut.t.Fatalf("Unexpected call to ResumeStorageMock.MoveBlobOut. %v %v %v", ctx, resumeID, storagePath)
+	return
+}
+
+// MoveBlobOutAfterCounter returns a count of finished ResumeStorageMock.MoveBlobOut invocations
+func (mmMoveBlobOut *ResumeStorageMock) MoveBlobOutAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmMoveBlobOut.afterMoveBlobOutCounter)
+}
+
+// MoveBlobOutBeforeCounter returns a count of ResumeStorageMock.MoveBlobOut invocations
+func (mmMoveBlobOut *ResumeStorageMock) MoveBlobOutBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmMoveBlobOut.beforeMoveBlobOutCounter)
+}
+
+// Calls returns a list of arguments used in each call to ResumeStorageMock.MoveBlobOut.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmMoveBlobOut *mResumeStorageMockMoveBlobOut) Calls() []*ResumeStorageMockMoveBlobOutParams {
+	mmMoveBlobOut.mutex.RLock()
+
+	argCopy := make([]*ResumeStorageMockMoveBlobOutParams, len(mmMoveBlobOut.callArgs))
+	copy(argCopy, mmMoveBlobOut.callArgs)
+
+	mmMoveBlobOut.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockMoveBlobOutDone returns true if the count of the MoveBlobOut invocations corresponds
+// the number of defined expectations
+func (m *ResumeStorageMock) MinimockMoveBlobOutDone() bool {
+	if m.MoveBlobOutMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.MoveBlobOutMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.MoveBlobOutMock.invocationsDone()
+}
+
+// MinimockMoveBlobOutInspect logs each unmet expectation
+func (m *ResumeStorageMock) MinimockMoveBlobOutInspect() {
+	for _, e := range m.MoveBlobOutMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to ResumeStorageMock.MoveBlobOut at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterMoveBlobOutCounter := mm_atomic.LoadUint64(&m.afterMoveBlobOutCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.MoveBlobOutMock.defaultExpectation != nil && afterMoveBlobOutCounter < 1 {
+		if m.MoveBlobOutMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to ResumeStorageMock.MoveBlobOut at\n%s", m.MoveBlobOutMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to ResumeStorageMock.MoveBlobOut at\n%s with params: %#v", m.MoveBlobOutMock.defaultExpectation.expectationOrigins.origin, *m.MoveBlobOutMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcMoveBlobOut != nil && afterMoveBlobOutCounter < 1 {
+		m.t.Errorf("Expected call to ResumeStorageMock.MoveBlobOut at\n%s", m.funcMoveBlobOutOrigin)
+	}
+
+	if !m.MoveBlobOutMock.invocationsDone() && afterMoveBlobOutCounter > 0 {
+		m.t.Errorf("Expected %d calls to ResumeStorageMock.MoveBlobOut at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.MoveBlobOutMock.expectedInvocations), m.MoveBlobOutMock.expectedInvocationsOrigin, afterMoveBlobOutCounter)
+	}
+}
+
+type mResumeStorageMockPublicVacancyOwner struct {
+	optional           bool
+	mock               *ResumeStorageMock
+	defaultExpectation *ResumeStorageMockPublicVacancyOwnerExpectation
+	expectations       []*ResumeStorageMockPublicVacancyOwnerExpectation
+
+	callArgs []*ResumeStorageMockPublicVacancyOwnerParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// ResumeStorageMockPublicVacancyOwnerExpectation specifies expectation struct of the ResumeStorage.PublicVacancyOwner
+type ResumeStorageMockPublicVacancyOwnerExpectation struct {
+	mock               *ResumeStorageMock
+	params             *ResumeStorageMockPublicVacancyOwnerParams
+	paramPtrs          *ResumeStorageMockPublicVacancyOwnerParamPtrs
+	expectationOrigins ResumeStorageMockPublicVacancyOwnerExpectationOrigins
+	results            *ResumeStorageMockPublicVacancyOwnerResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// ResumeStorageMockPublicVacancyOwnerParams contains parameters of the ResumeStorage.PublicVacancyOwner
+type ResumeStorageMockPublicVacancyOwnerParams struct {
+	ctx       context.Context
+	vacancyID string
+}
+
+// ResumeStorageMockPublicVacancyOwnerParamPtrs contains pointers to parameters of the ResumeStorage.PublicVacancyOwner
+type ResumeStorageMockPublicVacancyOwnerParamPtrs struct {
+	ctx       *context.Context
+	vacancyID *string
+}
+
+// ResumeStorageMockPublicVacancyOwnerResults contains results of the ResumeStorage.PublicVacancyOwner
+type ResumeStorageMockPublicVacancyOwnerResults struct {
+	u1  uint64
+	err error
+}
+
+// ResumeStorageMockPublicVacancyOwnerOrigins contains origins of expectations of the ResumeStorage.PublicVacancyOwner
+type ResumeStorageMockPublicVacancyOwnerExpectationOrigins struct {
+	origin          string
+	originCtx       string
+	originVacancyID string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmPublicVacancyOwner *mResumeStorageMockPublicVacancyOwner) Optional() *mResumeStorageMockPublicVacancyOwner {
+	mmPublicVacancyOwner.optional = true
+	return mmPublicVacancyOwner
+}
+
+// Expect sets up expected params for ResumeStorage.PublicVacancyOwner
+func (mmPublicVacancyOwner *mResumeStorageMockPublicVacancyOwner) Expect(ctx context.Context, vacancyID string) *mResumeStorageMockPublicVacancyOwner {
+	if mmPublicVacancyOwner.mock.funcPublicVacancyOwner != nil {
+		mmPublicVacancyOwner.mock.t.Fatalf("ResumeStorageMock.PublicVacancyOwner mock is already set by Set")
+	}
+
+	if mmPublicVacancyOwner.defaultExpectation == nil {
+		mmPublicVacancyOwner.defaultExpectation = &ResumeStorageMockPublicVacancyOwnerExpectation{}
+	}
+
+	if mmPublicVacancyOwner.defaultExpectation.paramPtrs != nil {
+		mmPublicVacancyOwner.mock.t.Fatalf("ResumeStorageMock.PublicVacancyOwner mock is already set by ExpectParams functions")
+	}
+
+	mmPublicVacancyOwner.defaultExpectation.params = &ResumeStorageMockPublicVacancyOwnerParams{ctx, vacancyID}
+	mmPublicVacancyOwner.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmPublicVacancyOwner.expectations {
+		if minimock.Equal(e.params, mmPublicVacancyOwner.defaultExpectation.params) {
+			mmPublicVacancyOwner.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmPublicVacancyOwner.defaultExpectation.params)
+		}
+	}
+
+	return mmPublicVacancyOwner
+}
+
+// ExpectCtxParam1 sets up expected param ctx for ResumeStorage.PublicVacancyOwner
+func (mmPublicVacancyOwner *mResumeStorageMockPublicVacancyOwner) ExpectCtxParam1(ctx context.Context) *mResumeStorageMockPublicVacancyOwner {
+	if mmPublicVacancyOwner.mock.funcPublicVacancyOwner != nil {
+		mmPublicVacancyOwner.mock.t.Fatalf("ResumeStorageMock.PublicVacancyOwner mock is already set by Set")
+	}
+
+	if mmPublicVacancyOwner.defaultExpectation == nil {
+		mmPublicVacancyOwner.defaultExpectation = &ResumeStorageMockPublicVacancyOwnerExpectation{}
+	}
+
+	if mmPublicVacancyOwner.defaultExpectation.params != nil {
+		mmPublicVacancyOwner.mock.t.Fatalf("ResumeStorageMock.PublicVacancyOwner mock is already set by Expect")
+	}
+
+	if mmPublicVacancyOwner.defaultExpectation.paramPtrs == nil {
+		mmPublicVacancyOwner.defaultExpectation.paramPtrs = &ResumeStorageMockPublicVacancyOwnerParamPtrs{}
+	}
+	mmPublicVacancyOwner.defaultExpectation.paramPtrs.ctx = &ctx
+	mmPublicVacancyOwner.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmPublicVacancyOwner
+}
+
+// ExpectVacancyIDParam2 sets up expected param vacancyID for ResumeStorage.PublicVacancyOwner
+func (mmPublicVacancyOwner *mResumeStorageMockPublicVacancyOwner) ExpectVacancyIDParam2(vacancyID string) *mResumeStorageMockPublicVacancyOwner {
+	if mmPublicVacancyOwner.mock.funcPublicVacancyOwner != nil {
+		mmPublicVacancyOwner.mock.t.Fatalf("ResumeStorageMock.PublicVacancyOwner mock is already set by Set")
+	}
+
+	if mmPublicVacancyOwner.defaultExpectation == nil {
+		mmPublicVacancyOwner.defaultExpectation = &ResumeStorageMockPublicVacancyOwnerExpectation{}
+	}
+
+	if mmPublicVacancyOwner.defaultExpectation.params != nil {
+		mmPublicVacancyOwner.mock.t.Fatalf("ResumeStorageMock.PublicVacancyOwner mock is already set by Expect")
+	}
+
+	if mmPublicVacancyOwner.defaultExpectation.paramPtrs == nil {
+		mmPublicVacancyOwner.defaultExpectation.paramPtrs = &ResumeStorageMockPublicVacancyOwnerParamPtrs{}
+	}
+	mmPublicVacancyOwner.defaultExpectation.paramPtrs.vacancyID = &vacancyID
+	mmPublicVacancyOwner.defaultExpectation.expectationOrigins.originVacancyID = minimock.CallerInfo(1)
+
+	return mmPublicVacancyOwner
+}
+
+// Inspect accepts an inspector function that has same arguments as the ResumeStorage.PublicVacancyOwner
+func (mmPublicVacancyOwner *mResumeStorageMockPublicVacancyOwner) Inspect(f func(ctx context.Context, vacancyID string)) *mResumeStorageMockPublicVacancyOwner {
+	if mmPublicVacancyOwner.mock.inspectFuncPublicVacancyOwner != nil {
+		mmPublicVacancyOwner.mock.t.Fatalf("Inspect function is already set for ResumeStorageMock.PublicVacancyOwner")
+	}
+
+	mmPublicVacancyOwner.mock.inspectFuncPublicVacancyOwner = f
+
+	return mmPublicVacancyOwner
+}
+
+// Return sets up results that will be returned by ResumeStorage.PublicVacancyOwner
+func (mmPublicVacancyOwner *mResumeStorageMockPublicVacancyOwner) Return(u1 uint64, err error) *ResumeStorageMock {
+	if mmPublicVacancyOwner.mock.funcPublicVacancyOwner != nil {
+		mmPublicVacancyOwner.mock.t.Fatalf("ResumeStorageMock.PublicVacancyOwner mock is already set by Set")
+	}
+
+	if mmPublicVacancyOwner.defaultExpectation == nil {
+		mmPublicVacancyOwner.defaultExpectation = &ResumeStorageMockPublicVacancyOwnerExpectation{mock: mmPublicVacancyOwner.mock}
+	}
+	mmPublicVacancyOwner.defaultExpectation.results = &ResumeStorageMockPublicVacancyOwnerResults{u1, err}
+	mmPublicVacancyOwner.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmPublicVacancyOwner.mock
+}
+
+// Set uses given function f to mock the ResumeStorage.PublicVacancyOwner method
+func (mmPublicVacancyOwner *mResumeStorageMockPublicVacancyOwner) Set(f func(ctx context.Context, vacancyID string) (u1 uint64, err error)) *ResumeStorageMock {
+	if mmPublicVacancyOwner.defaultExpectation != nil {
+		mmPublicVacancyOwner.mock.t.Fatalf("Default expectation is already set for the ResumeStorage.PublicVacancyOwner method")
+	}
+
+	if len(mmPublicVacancyOwner.expectations) > 0 {
+		mmPublicVacancyOwner.mock.t.Fatalf("Some expectations are already set for the ResumeStorage.PublicVacancyOwner method")
+	}
+
+	mmPublicVacancyOwner.mock.funcPublicVacancyOwner = f
+	mmPublicVacancyOwner.mock.funcPublicVacancyOwnerOrigin = minimock.CallerInfo(1)
+	return mmPublicVacancyOwner.mock
+}
+
+// When sets expectation for the ResumeStorage.PublicVacancyOwner which will trigger the result defined by the following
+// Then helper
+func (mmPublicVacancyOwner *mResumeStorageMockPublicVacancyOwner) When(ctx context.Context, vacancyID string) *ResumeStorageMockPublicVacancyOwnerExpectation {
+	if mmPublicVacancyOwner.mock.funcPublicVacancyOwner != nil {
+		mmPublicVacancyOwner.mock.t.Fatalf("ResumeStorageMock.PublicVacancyOwner mock is already set by Set")
+	}
+
+	expectation := &ResumeStorageMockPublicVacancyOwnerExpectation{
+		mock:               mmPublicVacancyOwner.mock,
+		params:             &ResumeStorageMockPublicVacancyOwnerParams{ctx, vacancyID},
+		expectationOrigins: ResumeStorageMockPublicVacancyOwnerExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmPublicVacancyOwner.expectations = append(mmPublicVacancyOwner.expectations, expectation)
+	return expectation
+}
+
+// Then sets up ResumeStorage.PublicVacancyOwner return parameters for the expectation previously defined by the When method
+func (e *ResumeStorageMockPublicVacancyOwnerExpectation) Then(u1 uint64, err error) *ResumeStorageMock {
+	e.results = &ResumeStorageMockPublicVacancyOwnerResults{u1, err}
+	return e.mock
+}
+
+// Times sets number of times ResumeStorage.PublicVacancyOwner should be invoked
+func (mmPublicVacancyOwner *mResumeStorageMockPublicVacancyOwner) Times(n uint64) *mResumeStorageMockPublicVacancyOwner {
+	if n == 0 {
+		mmPublicVacancyOwner.mock.t.Fatalf("Times of ResumeStorageMock.PublicVacancyOwner mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmPublicVacancyOwner.expectedInvocations, n)
+	mmPublicVacancyOwner.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmPublicVacancyOwner
+}
+
+func (mmPublicVacancyOwner *mResumeStorageMockPublicVacancyOwner) invocationsDone() bool {
+	if len(mmPublicVacancyOwner.expectations) == 0 && mmPublicVacancyOwner.defaultExpectation == nil && mmPublicVacancyOwner.mock.funcPublicVacancyOwner == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmPublicVacancyOwner.mock.afterPublicVacancyOwnerCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmPublicVacancyOwner.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// PublicVacancyOwner implements mm_usecase.ResumeStorage
+func (mmPublicVacancyOwner *ResumeStorageMock) PublicVacancyOwner(ctx context.Context, vacancyID string) (u1 uint64, err error) {
+	mm_atomic.AddUint64(&mmPublicVacancyOwner.beforePublicVacancyOwnerCounter, 1)
+	defer mm_atomic.AddUint64(&mmPublicVacancyOwner.afterPublicVacancyOwnerCounter, 1)
+
+	mmPublicVacancyOwner.t.Helper()
+
+	if mmPublicVacancyOwner.inspectFuncPublicVacancyOwner != nil {
+		mmPublicVacancyOwner.inspectFuncPublicVacancyOwner(ctx, vacancyID)
+	}
+
+	mm_params := ResumeStorageMockPublicVacancyOwnerParams{ctx, vacancyID}
+
+	// Record call args
+	mmPublicVacancyOwner.PublicVacancyOwnerMock.mutex.Lock()
+	mmPublicVacancyOwner.PublicVacancyOwnerMock.callArgs = append(mmPublicVacancyOwner.PublicVacancyOwnerMock.callArgs, &mm_params)
+	mmPublicVacancyOwner.PublicVacancyOwnerMock.mutex.Unlock()
+
+	for _, e := range mmPublicVacancyOwner.PublicVacancyOwnerMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.u1, e.results.err
+		}
+	}
+
+	if mmPublicVacancyOwner.PublicVacancyOwnerMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmPublicVacancyOwner.PublicVacancyOwnerMock.defaultExpectation.Counter, 1)
+		mm_want := mmPublicVacancyOwner.PublicVacancyOwnerMock.defaultExpectation.params
+		mm_want_ptrs := mmPublicVacancyOwner.PublicVacancyOwnerMock.defaultExpectation.paramPtrs
+
+		mm_got := ResumeStorageMockPublicVacancyOwnerParams{ctx, vacancyID}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmPublicVacancyOwner.t.Errorf("ResumeStorageMock.PublicVacancyOwner got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmPublicVacancyOwner.PublicVacancyOwnerMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.vacancyID != nil && !minimock.Equal(*mm_want_ptrs.vacancyID, mm_got.vacancyID) {
+				mmPublicVacancyOwner.t.Errorf("ResumeStorageMock.PublicVacancyOwner got unexpected parameter vacancyID, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmPublicVacancyOwner.PublicVacancyOwnerMock.defaultExpectation.expectationOrigins.originVacancyID, *mm_want_ptrs.vacancyID, mm_got.vacancyID, minimock.Diff(*mm_want_ptrs.vacancyID, mm_got.vacancyID))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmPublicVacancyOwner.t.Errorf("ResumeStorageMock.PublicVacancyOwner got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmPublicVacancyOwner.PublicVacancyOwnerMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmPublicVacancyOwner.PublicVacancyOwnerMock.defaultExpectation.results
+		if mm_results == nil {
+			mmPublicVacancyOwner.t.Fatal("No results are set for the ResumeStorageMock.PublicVacancyOwner")
+		}
+		return (*mm_results).u1, (*mm_results).err
+	}
+	if mmPublicVacancyOwner.funcPublicVacancyOwner != nil {
+		return mmPublicVacancyOwner.funcPublicVacancyOwner(ctx, vacancyID)
+	}
+	mmPublicVacancyOwner.t.Fatalf("Unexpected call to ResumeStorageMock.PublicVacancyOwner. %v %v", ctx, vacancyID)
+	return
+}
+
+// PublicVacancyOwnerAfterCounter returns a count of finished ResumeStorageMock.PublicVacancyOwner invocations
+func (mmPublicVacancyOwner *ResumeStorageMock) PublicVacancyOwnerAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmPublicVacancyOwner.afterPublicVacancyOwnerCounter)
+}
+
+// PublicVacancyOwnerBeforeCounter returns a count of ResumeStorageMock.PublicVacancyOwner invocations
+func (mmPublicVacancyOwner *ResumeStorageMock) PublicVacancyOwnerBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmPublicVacancyOwner.beforePublicVacancyOwnerCounter)
+}
+
+// Calls returns a list of arguments used in each call to ResumeStorageMock.PublicVacancyOwner.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmPublicVacancyOwner *mResumeStorageMockPublicVacancyOwner) Calls() []*ResumeStorageMockPublicVacancyOwnerParams {
+	mmPublicVacancyOwner.mutex.RLock()
+
+	argCopy := make([]*ResumeStorageMockPublicVacancyOwnerParams, len(mmPublicVacancyOwner.callArgs))
+	copy(argCopy, mmPublicVacancyOwner.callArgs)
+
+	mmPublicVacancyOwner.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockPublicVacancyOwnerDone returns true if the count of the PublicVacancyOwner invocations corresponds
+// the number of defined expectations
+func (m *ResumeStorageMock) MinimockPublicVacancyOwnerDone() bool {
+	if m.PublicVacancyOwnerMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.PublicVacancyOwnerMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.PublicVacancyOwnerMock.invocationsDone()
+}
+
+// MinimockPublicVacancyOwnerInspect logs each unmet expectation
+func (m *ResumeStorageMock) MinimockPublicVacancyOwnerInspect() {
+	for _, e := range m.PublicVacancyOwnerMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to ResumeStorageMock.PublicVacancyOwner at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterPublicVacancyOwnerCounter := mm_atomic.LoadUint64(&m.afterPublicVacancyOwnerCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.PublicVacancyOwnerMock.defaultExpectation != nil && afterPublicVacancyOwnerCounter < 1 {
+		if m.PublicVacancyOwnerMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to ResumeStorageMock.PublicVacancyOwner at\n%s", m.PublicVacancyOwnerMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to ResumeStorageMock.PublicVacancyOwner at\n%s with params: %#v", m.PublicVacancyOwnerMock.defaultExpectation.expectationOrigins.origin, *m.PublicVacancyOwnerMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcPublicVacancyOwner != nil && afterPublicVacancyOwnerCounter < 1 {
+		m.t.Errorf("Expected call to ResumeStorageMock.PublicVacancyOwner at\n%s", m.funcPublicVacancyOwnerOrigin)
+	}
+
+	if !m.PublicVacancyOwnerMock.invocationsDone() && afterPublicVacancyOwnerCounter > 0 {
+		m.t.Errorf("Expected %d calls to ResumeStorageMock.PublicVacancyOwner at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.PublicVacancyOwnerMock.expectedInvocations), m.PublicVacancyOwnerMock.expectedInvocationsOrigin, afterPublicVacancyOwnerCounter)
+	}
+}
+
+type mResumeStorageMockPurgeVacancyCandidates struct {
+	optional           bool
+	mock               *ResumeStorageMock
+	defaultExpectation *ResumeStorageMockPurgeVacancyCandidatesExpectation
+	expectations       []*ResumeStorageMockPurgeVacancyCandidatesExpectation
+
+	callArgs []*ResumeStorageMockPurgeVacancyCandidatesParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// ResumeStorageMockPurgeVacancyCandidatesExpectation specifies expectation struct of the ResumeStorage.PurgeVacancyCandidates
+type ResumeStorageMockPurgeVacancyCandidatesExpectation struct {
+	mock               *ResumeStorageMock
+	params             *ResumeStorageMockPurgeVacancyCandidatesParams
+	paramPtrs          *ResumeStorageMockPurgeVacancyCandidatesParamPtrs
+	expectationOrigins ResumeStorageMockPurgeVacancyCandidatesExpectationOrigins
+	results            *ResumeStorageMockPurgeVacancyCandidatesResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// ResumeStorageMockPurgeVacancyCandidatesParams contains parameters of the ResumeStorage.PurgeVacancyCandidates
+type ResumeStorageMockPurgeVacancyCandidatesParams struct {
+	ctx       context.Context
+	vacancyID string
+}
+
+// ResumeStorageMockPurgeVacancyCandidatesParamPtrs contains pointers to parameters of the ResumeStorage.PurgeVacancyCandidates
+type ResumeStorageMockPurgeVacancyCandidatesParamPtrs struct {
+	ctx       *context.Context
+	vacancyID *string
+}
+
+// ResumeStorageMockPurgeVacancyCandidatesResults contains results of the ResumeStorage.PurgeVacancyCandidates
+type ResumeStorageMockPurgeVacancyCandidatesResults struct {
+	pp1 *domain.PurgeVacancyCandidatesResult
+	err error
+}
+
+// ResumeStorageMockPurgeVacancyCandidatesOrigins contains origins of expectations of the ResumeStorage.PurgeVacancyCandidates
+type ResumeStorageMockPurgeVacancyCandidatesExpectationOrigins struct {
+	origin          string
+	originCtx       string
+	originVacancyID string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmPurgeVacancyCandidates *mResumeStorageMockPurgeVacancyCandidates) Optional() *mResumeStorageMockPurgeVacancyCandidates {
+	mmPurgeVacancyCandidates.optional = true
+	return mmPurgeVacancyCandidates
+}
+
+// Expect sets up expected params for ResumeStorage.PurgeVacancyCandidates
+func (mmPurgeVacancyCandidates *mResumeStorageMockPurgeVacancyCandidates) Expect(ctx context.Context, vacancyID string) *mResumeStorageMockPurgeVacancyCandidates {
+	if mmPurgeVacancyCandidates.mock.funcPurgeVacancyCandidates != nil {
+		mmPurgeVacancyCandidates.mock.t.Fatalf("ResumeStorageMock.PurgeVacancyCandidates mock is already set by Set")
+	}
+
+	if mmPurgeVacancyCandidates.defaultExpectation == nil {
+		mmPurgeVacancyCandidates.defaultExpectation = &ResumeStorageMockPurgeVacancyCandidatesExpectation{}
+	}
+
+	if mmPurgeVacancyCandidates.defaultExpectation.paramPtrs != nil {
+		mmPurgeVacancyCandidates.mock.t.Fatalf("ResumeStorageMock.PurgeVacancyCandidates mock is already set by ExpectParams functions")
+	}
+
+	mmPurgeVacancyCandidates.defaultExpectation.params = &ResumeStorageMockPurgeVacancyCandidatesParams{ctx, vacancyID}
+	mmPurgeVacancyCandidates.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmPurgeVacancyCandidates.expectations {
+		if minimock.Equal(e.params, mmPurgeVacancyCandidates.defaultExpectation.params) {
+			mmPurgeVacancyCandidates.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmPurgeVacancyCandidates.defaultExpectation.params)
+		}
+	}
+
+	return mmPurgeVacancyCandidates
+}
+
+// ExpectCtxParam1 sets up expected param ctx for ResumeStorage.PurgeVacancyCandidates
+func (mmPurgeVacancyCandidates *mResumeStorageMockPurgeVacancyCandidates) ExpectCtxParam1(ctx context.Context) *mResumeStorageMockPurgeVacancyCandidates {
+	if mmPurgeVacancyCandidates.mock.funcPurgeVacancyCandidates != nil {
+		mmPurgeVacancyCandidates.mock.t.Fatalf("ResumeStorageMock.PurgeVacancyCandidates mock is already set by Set")
+	}
+
+	if mmPurgeVacancyCandidates.defaultExpectation == nil {
+		mmPurgeVacancyCandidates.defaultExpectation = &ResumeStorageMockPurgeVacancyCandidatesExpectation{}
+	}
+
+	if mmPurgeVacancyCandidates.defaultExpectation.params != nil {
+		mmPurgeVacancyCandidates.mock.t.Fatalf("ResumeStorageMock.PurgeVacancyCandidates mock is already set by Expect")
+	}
+
+	if mmPurgeVacancyCandidates.defaultExpectation.paramPtrs == nil {
+		mmPurgeVacancyCandidates.defaultExpectation.paramPtrs = &ResumeStorageMockPurgeVacancyCandidatesParamPtrs{}
+	}
+	mmPurgeVacancyCandidates.defaultExpectation.paramPtrs.ctx = &ctx
+	mmPurgeVacancyCandidates.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmPurgeVacancyCandidates
+}
+
+// ExpectVacancyIDParam2 sets up expected param vacancyID for ResumeStorage.PurgeVacancyCandidates
+func (mmPurgeVacancyCandidates *mResumeStorageMockPurgeVacancyCandidates) ExpectVacancyIDParam2(vacancyID string) *mResumeStorageMockPurgeVacancyCandidates {
+	if mmPurgeVacancyCandidates.mock.funcPurgeVacancyCandidates != nil {
+		mmPurgeVacancyCandidates.mock.t.Fatalf("ResumeStorageMock.PurgeVacancyCandidates mock is already set by Set")
+	}
+
+	if mmPurgeVacancyCandidates.defaultExpectation == nil {
+		mmPurgeVacancyCandidates.defaultExpectation = &ResumeStorageMockPurgeVacancyCandidatesExpectation{}
+	}
+
+	if mmPurgeVacancyCandidates.defaultExpectation.params != nil {
+		mmPurgeVacancyCandidates.mock.t.Fatalf("ResumeStorageMock.PurgeVacancyCandidates mock is already set by Expect")
+	}
+
+	if mmPurgeVacancyCandidates.defaultExpectation.paramPtrs == nil {
+		mmPurgeVacancyCandidates.defaultExpectation.paramPtrs = &ResumeStorageMockPurgeVacancyCandidatesParamPtrs{}
+	}
+	mmPurgeVacancyCandidates.defaultExpectation.paramPtrs.vacancyID = &vacancyID
+	mmPurgeVacancyCandidates.defaultExpectation.expectationOrigins.originVacancyID = minimock.CallerInfo(1)
+
+	return mmPurgeVacancyCandidates
+}
+
+// Inspect accepts an inspector function that has same arguments as the ResumeStorage.PurgeVacancyCandidates
+func (mmPurgeVacancyCandidates *mResumeStorageMockPurgeVacancyCandidates) Inspect(f func(ctx context.Context, vacancyID string)) *mResumeStorageMockPurgeVacancyCandidates {
+	if mmPurgeVacancyCandidates.mock.inspectFuncPurgeVacancyCandidates != nil {
+		mmPurgeVacancyCandidates.mock.t.Fatalf("Inspect function is already set for ResumeStorageMock.PurgeVacancyCandidates")
+	}
+
+	mmPurgeVacancyCandidates.mock.inspectFuncPurgeVacancyCandidates = f
+
+	return mmPurgeVacancyCandidates
+}
+
+// Return sets up results that will be returned by ResumeStorage.PurgeVacancyCandidates
+func (mmPurgeVacancyCandidates *mResumeStorageMockPurgeVacancyCandidates) Return(pp1 *domain.PurgeVacancyCandidatesResult, err error) *ResumeStorageMock {
+	if mmPurgeVacancyCandidates.mock.funcPurgeVacancyCandidates != nil {
+		mmPurgeVacancyCandidates.mock.t.Fatalf("ResumeStorageMock.PurgeVacancyCandidates mock is already set by Set")
+	}
+
+	if mmPurgeVacancyCandidates.defaultExpectation == nil {
+		mmPurgeVacancyCandidates.defaultExpectation = &ResumeStorageMockPurgeVacancyCandidatesExpectation{mock: mmPurgeVacancyCandidates.mock}
+	}
+	mmPurgeVacancyCandidates.defaultExpectation.results = &ResumeStorageMockPurgeVacancyCandidatesResults{pp1, err}
+	mmPurgeVacancyCandidates.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmPurgeVacancyCandidates.mock
+}
+
+// Set uses given function f to mock the ResumeStorage.PurgeVacancyCandidates method
+func (mmPurgeVacancyCandidates *mResumeStorageMockPurgeVacancyCandidates) Set(f func(ctx context.Context, vacancyID string) (pp1 *domain.PurgeVacancyCandidatesResult, err error)) *ResumeStorageMock {
+	if mmPurgeVacancyCandidates.defaultExpectation != nil {
+		mmPurgeVacancyCandidates.mock.t.Fatalf("Default expectation is already set for the ResumeStorage.PurgeVacancyCandidates method")
+	}
+
+	if len(mmPurgeVacancyCandidates.expectations) > 0 {
+		mmPurgeVacancyCandidates.mock.t.Fatalf("Some expectations are already set for the ResumeStorage.PurgeVacancyCandidates method")
+	}
+
+	mmPurgeVacancyCandidates.mock.funcPurgeVacancyCandidates = f
+	mmPurgeVacancyCandidates.mock.funcPurgeVacancyCandidatesOrigin = minimock.CallerInfo(1)
+	return mmPurgeVacancyCandidates.mock
+}
+
+// When sets expectation for the ResumeStorage.PurgeVacancyCandidates which will trigger the result defined by the following
+// Then helper
+func (mmPurgeVacancyCandidates *mResumeStorageMockPurgeVacancyCandidates) When(ctx context.Context, vacancyID string) *ResumeStorageMockPurgeVacancyCandidatesExpectation {
+	if mmPurgeVacancyCandidates.mock.funcPurgeVacancyCandidates != nil {
+		mmPurgeVacancyCandidates.mock.t.Fatalf("ResumeStorageMock.PurgeVacancyCandidates mock is already set by Set")
+	}
+
+	expectation := &ResumeStorageMockPurgeVacancyCandidatesExpectation{
+		mock:               mmPurgeVacancyCandidates.mock,
+		params:             &ResumeStorageMockPurgeVacancyCandidatesParams{ctx, vacancyID},
+		expectationOrigins: ResumeStorageMockPurgeVacancyCandidatesExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmPurgeVacancyCandidates.expectations = append(mmPurgeVacancyCandidates.expectations, expectation)
+	return expectation
+}
+
+// Then sets up ResumeStorage.PurgeVacancyCandidates return parameters for the expectation previously defined by the When method
+func (e *ResumeStorageMockPurgeVacancyCandidatesExpectation) Then(pp1 *domain.PurgeVacancyCandidatesResult, err error) *ResumeStorageMock {
+	e.results = &ResumeStorageMockPurgeVacancyCandidatesResults{pp1, err}
+	return e.mock
+}
+
+// Times sets number of times ResumeStorage.PurgeVacancyCandidates should be invoked
+func (mmPurgeVacancyCandidates *mResumeStorageMockPurgeVacancyCandidates) Times(n uint64) *mResumeStorageMockPurgeVacancyCandidates {
+	if n == 0 {
+		mmPurgeVacancyCandidates.mock.t.Fatalf("Times of ResumeStorageMock.PurgeVacancyCandidates mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmPurgeVacancyCandidates.expectedInvocations, n)
+	mmPurgeVacancyCandidates.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmPurgeVacancyCandidates
+}
+
+func (mmPurgeVacancyCandidates *mResumeStorageMockPurgeVacancyCandidates) invocationsDone() bool {
+	if len(mmPurgeVacancyCandidates.expectations) == 0 && mmPurgeVacancyCandidates.defaultExpectation == nil && mmPurgeVacancyCandidates.mock.funcPurgeVacancyCandidates == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmPurgeVacancyCandidates.mock.afterPurgeVacancyCandidatesCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmPurgeVacancyCandidates.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// PurgeVacancyCandidates implements mm_usecase.ResumeStorage
+func (mmPurgeVacancyCandidates *ResumeStorageMock) PurgeVacancyCandidates(ctx context.Context, vacancyID string) (pp1 *domain.PurgeVacancyCandidatesResult, err error) {
+	mm_atomic.AddUint64(&mmPurgeVacancyCandidates.beforePurgeVacancyCandidatesCounter, 1)
+	defer mm_atomic.AddUint64(&mmPurgeVacancyCandidates.afterPurgeVacancyCandidatesCounter, 1)
+
+	mmPurgeVacancyCandidates.t.Helper()
+
+	if mmPurgeVacancyCandidates.inspectFuncPurgeVacancyCandidates != nil {
+		mmPurgeVacancyCandidates.inspectFuncPurgeVacancyCandidates(ctx, vacancyID)
+	}
+
+	mm_params := ResumeStorageMockPurgeVacancyCandidatesParams{ctx, vacancyID}
+
+	// Record call args
+	mmPurgeVacancyCandidates.PurgeVacancyCandidatesMock.mutex.Lock()
+	mmPurgeVacancyCandidates.PurgeVacancyCandidatesMock.callArgs = append(mmPurgeVacancyCandidates.PurgeVacancyCandidatesMock.callArgs, &mm_params)
+	mmPurgeVacancyCandidates.PurgeVacancyCandidatesMock.mutex.Unlock()
+
+	for _, e := range mmPurgeVacancyCandidates.PurgeVacancyCandidatesMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.pp1, e.results.err
+		}
+	}
+
+	if mmPurgeVacancyCandidates.PurgeVacancyCandidatesMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmPurgeVacancyCandidates.PurgeVacancyCandidatesMock.defaultExpectation.Counter, 1)
+		mm_want := mmPurgeVacancyCandidates.PurgeVacancyCandidatesMock.defaultExpectation.params
+		mm_want_ptrs := mmPurgeVacancyCandidates.PurgeVacancyCandidatesMock.defaultExpectation.paramPtrs
+
+		mm_got := ResumeStorageMockPurgeVacancyCandidatesParams{ctx, vacancyID}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmPurgeVacancyCandidates.t.Errorf("ResumeStorageMock.PurgeVacancyCandidates got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmPurgeVacancyCandidates.PurgeVacancyCandidatesMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.vacancyID != nil && !minimock.Equal(*mm_want_ptrs.vacancyID, mm_got.vacancyID) {
+				mmPurgeVacancyCandidates.t.Errorf("ResumeStorageMock.PurgeVacancyCandidates got unexpected parameter vacancyID, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmPurgeVacancyCandidates.PurgeVacancyCandidatesMock.defaultExpectation.expectationOrigins.originVacancyID, *mm_want_ptrs.vacancyID, mm_got.vacancyID, minimock.Diff(*mm_want_ptrs.vacancyID, mm_got.vacancyID))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmPurgeVacancyCandidates.t.Errorf("ResumeStorageMock.PurgeVacancyCandidates got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmPurgeVacancyCandidates.PurgeVacancyCandidatesMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmPurgeVacancyCandidates.PurgeVacancyCandidatesMock.defaultExpectation.results
+		if mm_results == nil {
+			mmPurgeVacancyCandidates.t.Fatal("No results are set for the ResumeStorageMock.PurgeVacancyCandidates")
+		}
+		return (*mm_results).pp1, (*mm_results).err
+	}
+	if mmPurgeVacancyCandidates.funcPurgeVacancyCandidates != nil {
+		return mmPurgeVacancyCandidates.funcPurgeVacancyCandidates(ctx, vacancyID)
+	}
+	mmPurgeVacancyCandidates.t.Fatalf("Unexpected call to ResumeStorageMock.PurgeVacancyCandidates. %v %v", ctx, vacancyID)
+	return
+}
+
+// PurgeVacancyCandidatesAfterCounter returns a count of finished ResumeStorageMock.PurgeVacancyCandidates invocations
+func (mmPurgeVacancyCandidates *ResumeStorageMock) PurgeVacancyCandidatesAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmPurgeVacancyCandidates.afterPurgeVacancyCandidatesCounter)
+}
+
+// PurgeVacancyCandidatesBeforeCounter returns a count of ResumeStorageMock.PurgeVacancyCandidates invocations
+func (mmPurgeVacancyCandidates *ResumeStorageMock) PurgeVacancyCandidatesBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmPurgeVacancyCandidates.beforePurgeVacancyCandidatesCounter)
+}
+
+// Calls returns a list of arguments used in each call to ResumeStorageMock.PurgeVacancyCandidates.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmPurgeVacancyCandidates *mResumeStorageMockPurgeVacancyCandidates) Calls() []*ResumeStorageMockPurgeVacancyCandidatesParams {
+	mmPurgeVacancyCandidates.mutex.RLock()
+
+	argCopy := make([]*ResumeStorageMockPurgeVacancyCandidatesParams, len(mmPurgeVacancyCandidates.callArgs))
+	copy(argCopy, mmPurgeVacancyCandidates.callArgs)
+
+	mmPurgeVacancyCandidates.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockPurgeVacancyCandidatesDone returns true if the count of the PurgeVacancyCandidates invocations corresponds
+// the number of defined expectations
+func (m *ResumeStorageMock) MinimockPurgeVacancyCandidatesDone() bool {
+	if m.PurgeVacancyCandidatesMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.PurgeVacancyCandidatesMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.PurgeVacancyCandidatesMock.invocationsDone()
+}
+
+// MinimockPurgeVacancyCandidatesInspect logs each unmet expectation
+func (m *ResumeStorageMock) MinimockPurgeVacancyCandidatesInspect() {
+	for _, e := range m.PurgeVacancyCandidatesMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to ResumeStorageMock.PurgeVacancyCandidates at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterPurgeVacancyCandidatesCounter := mm_atomic.LoadUint64(&m.afterPurgeVacancyCandidatesCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.PurgeVacancyCandidatesMock.defaultExpectation != nil && afterPurgeVacancyCandidatesCounter < 1 {
+		if m.PurgeVacancyCandidatesMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to ResumeStorageMock.PurgeVacancyCandidates at\n%s", m.PurgeVacancyCandidatesMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to ResumeStorageMock.PurgeVacancyCandidates at\n%s with params: %#v", m.PurgeVacancyCandidatesMock.defaultExpectation.expectationOrigins.origin, *m.PurgeVacancyCandidatesMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcPurgeVacancyCandidates != nil && afterPurgeVacancyCandidatesCounter < 1 {
+		m.t.Errorf("Expected call to ResumeStorageMock.PurgeVacancyCandidates at\n%s", m.funcPurgeVacancyCandidatesOrigin)
+	}
+
+	if !m.PurgeVacancyCandidatesMock.invocationsDone() && afterPurgeVacancyCandidatesCounter > 0 {
+		m.t.Errorf("Expected %d calls to ResumeStorageMock.PurgeVacancyCandidates at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.PurgeVacancyCandidatesMock.expectedInvocations), m.PurgeVacancyCandidatesMock.expectedInvocationsOrigin, afterPurgeVacancyCandidatesCounter)
+	}
+}
+
+type mResumeStorageMockTransferCandidateOwnership struct {
+	optional           bool
+	mock               *ResumeStorageMock
+	defaultExpectation *ResumeStorageMockTransferCandidateOwnershipExpectation
+	expectations       []*ResumeStorageMockTransferCandidateOwnershipExpectation
+
+	callArgs []*ResumeStorageMockTransferCandidateOwnershipParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// ResumeStorageMockTransferCandidateOwnershipExpectation specifies expectation struct of the ResumeStorage.TransferCandidateOwnership
+type ResumeStorageMockTransferCandidateOwnershipExpectation struct {
+	mock               *ResumeStorageMock
+	params             *ResumeStorageMockTransferCandidateOwnershipParams
+	paramPtrs          *ResumeStorageMockTransferCandidateOwnershipParamPtrs
+	expectationOrigins ResumeStorageMockTransferCandidateOwnershipExpectationOrigins
+	results            *ResumeStorageMockTransferCandidateOwnershipResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// ResumeStorageMockTransferCandidateOwnershipParams contains parameters of the ResumeStorage.TransferCandidateOwnership
+type ResumeStorageMockTransferCandidateOwnershipParams struct {
+	ctx context.Context
+	in  domain.TransferCandidateOwnershipInput
+}
+
+// ResumeStorageMockTransferCandidateOwnershipParamPtrs contains pointers to parameters of the ResumeStorage.TransferCandidateOwnership
+type ResumeStorageMockTransferCandidateOwnershipParamPtrs struct {
+	ctx *context.Context
+	in  *domain.TransferCandidateOwnershipInput
+}
+
+// ResumeStorageMockTransferCandidateOwnershipResults contains results of the ResumeStorage.TransferCandidateOwnership
+type ResumeStorageMockTransferCandidateOwnershipResults struct {
+	u1  uint32
+	err error
+}
+
+// ResumeStorageMockTransferCandidateOwnershipOrigins contains origins of expectations of the ResumeStorage.TransferCandidateOwnership
+type ResumeStorageMockTransferCandidateOwnershipExpectationOrigins struct {
+	origin    string
+	originCtx string
+	originIn  string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmTransferCandidateOwnership *mResumeStorageMockTransferCandidateOwnership) Optional() *mResumeStorageMockTransferCandidateOwnership {
+	mmTransferCandidateOwnership.optional = true
+	return mmTransferCandidateOwnership
+}
+
+// Expect sets up expected params for ResumeStorage.TransferCandidateOwnership
+func (mmTransferCandidateOwnership *mResumeStorageMockTransferCandidateOwnership) Expect(ctx context.Context, in domain.TransferCandidateOwnershipInput) *mResumeStorageMockTransferCandidateOwnership {
+	if mmTransferCandidateOwnership.mock.funcTransferCandidateOwnership != nil {
+		mmTransferCandidateOwnership.mock.t.Fatalf("ResumeStorageMock.TransferCandidateOwnership mock is already set by Set")
+	}
+
+	if mmTransferCandidateOwnership.defaultExpectation == nil {
+		mmTransferCandidateOwnership.defaultExpectation = &ResumeStorageMockTransferCandidateOwnershipExpectation{}
+	}
+
+	if mmTransferCandidateOwnership.defaultExpectation.paramPtrs != nil {
+		mmTransferCandidateOwnership.mock.t.Fatalf("ResumeStorageMock.TransferCandidateOwnership mock is already set by ExpectParams functions")
+	}
+
+	mmTransferCandidateOwnership.defaultExpectation.params = &ResumeStorageMockTransferCandidateOwnershipParams{ctx, in}
+	mmTransferCandidateOwnership.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmTransferCandidateOwnership.expectations {
+		if minimock.Equal(e.params, mmTransferCandidateOwnership.defaultExpectation.params) {
+			mmTransferCandidateOwnership.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmTransferCandidateOwnership.defaultExpectation.params)
+		}
+	}
+
+	return mmTransferCandidateOwnership
+}
+
+// ExpectCtxParam1 sets up expected param ctx for ResumeStorage.TransferCandidateOwnership
+func (mmTransferCandidateOwnership *mResumeStorageMockTransferCandidateOwnership) ExpectCtxParam1(ctx context.Context) *mResumeStorageMockTransferCandidateOwnership {
+	if mmTransferCandidateOwnership.mock.funcTransferCandidateOwnership != nil {
+		mmTransferCandidateOwnership.mock.t.Fatalf("ResumeStorageMock.TransferCandidateOwnership mock is already set by Set")
+	}
+
+	if mmTransferCandidateOwnership.defaultExpectation == nil {
+		mmTransferCandidateOwnership.defaultExpectation = &ResumeStorageMockTransferCandidateOwnershipExpectation{}
+	}
+
+	if mmTransferCandidateOwnership.defaultExpectation.params != nil {
+		mmTransferCandidateOwnership.mock.t.Fatalf("ResumeStorageMock.TransferCandidateOwnership mock is already set by Expect")
+	}
+
+	if mmTransferCandidateOwnership.defaultExpectation.paramPtrs == nil {
+		mmTransferCandidateOwnership.defaultExpectation.paramPtrs = &ResumeStorageMockTransferCandidateOwnershipParamPtrs{}
+	}
+	mmTransferCandidateOwnership.defaultExpectation.paramPtrs.ctx = &ctx
+	mmTransferCandidateOwnership.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmTransferCandidateOwnership
+}
+
+// ExpectInParam2 sets up expected param in for ResumeStorage.TransferCandidateOwnership
+func (mmTransferCandidateOwnership *mResumeStorageMockTransferCandidateOwnership) ExpectInParam2(in domain.TransferCandidateOwnershipInput) *mResumeStorageMockTransferCandidateOwnership {
+	if mmTransferCandidateOwnership.mock.funcTransferCandidateOwnership != nil {
+		mmTransferCandidateOwnership.mock.t.Fatalf("ResumeStorageMock.TransferCandidateOwnership mock is already set by Set")
+	}
+
+	if mmTransferCandidateOwnership.defaultExpectation == nil {
+		mmTransferCandidateOwnership.defaultExpectation = &ResumeStorageMockTransferCandidateOwnershipExpectation{}
+	}
+
+	if mmTransferCandidateOwnership.defaultExpectation.params != nil {
+		mmTransferCandidateOwnership.mock.t.Fatalf("ResumeStorageMock.TransferCandidateOwnership mock is already set by Expect")
+	}
+
+	if mmTransferCandidateOwnership.defaultExpectation.paramPtrs == nil {
+		mmTransferCandidateOwnership.defaultExpectation.paramPtrs = &ResumeStorageMockTransferCandidateOwnershipParamPtrs{}
+	}
+	mmTransferCandidateOwnership.defaultExpectation.paramPtrs.in = &in
+	mmTransferCandidateOwnership.defaultExpectation.expectationOrigins.originIn = minimock.CallerInfo(1)
+
+	return mmTransferCandidateOwnership
+}
+
+// Inspect accepts an inspector function that has same arguments as the ResumeStorage.TransferCandidateOwnership
+func (mmTransferCandidateOwnership *mResumeStorageMockTransferCandidateOwnership) Inspect(f func(ctx context.Context, in domain.TransferCandidateOwnershipInput)) *mResumeStorageMockTransferCandidateOwnership {
+	if mmTransferCandidateOwnership.mock.inspectFuncTransferCandidateOwnership != nil {
+		mmTransferCandidateOwnership.mock.t.Fatalf("Inspect function is already set for ResumeStorageMock.TransferCandidateOwnership")
+	}
+
+	mmTransferCandidateOwnership.mock.inspectFuncTransferCandidateOwnership = f
+
+	return mmTransferCandidateOwnership
+}
+
+// Return sets up results that will be returned by ResumeStorage.TransferCandidateOwnership
+func (mmTransferCandidateOwnership *mResumeStorageMockTransferCandidateOwnership) Return(u1 uint32, err error) *ResumeStorageMock {
+	if mmTransferCandidateOwnership.mock.funcTransferCandidateOwnership != nil {
+		mmTransferCandidateOwnership.mock.t.Fatalf("ResumeStorageMock.TransferCandidateOwnership mock is already set by Set")
+	}
+
+	if mmTransferCandidateOwnership.defaultExpectation == nil {
+		mmTransferCandidateOwnership.defaultExpectation = &ResumeStorageMockTransferCandidateOwnershipExpectation{mock: mmTransferCandidateOwnership.mock}
+	}
+	mmTransferCandidateOwnership.defaultExpectation.results = &ResumeStorageMockTransferCandidateOwnershipResults{u1, err}
+	mmTransferCandidateOwnership.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmTransferCandidateOwnership.mock
+}
+
+// Set uses given function f to mock the ResumeStorage.TransferCandidateOwnership method
+func (mmTransferCandidateOwnership *mResumeStorageMockTransferCandidateOwnership) Set(f func(ctx context.Context, in domain.TransferCandidateOwnershipInput) (u1 uint32, err error)) *ResumeStorageMock {
+	if mmTransferCandidateOwnership.defaultExpectation != nil {
+		mmTransferCandidateOwnership.mock.t.Fatalf("Default expectation is already set for the ResumeStorage.TransferCandidateOwnership method")
+	}
+
+	if len(mmTransferCandidateOwnership.expectations) > 0 {
+		mmTransferCandidateOwnership.mock.t.Fatalf("Some expectations are already set for the ResumeStorage.TransferCandidateOwnership method")
+	}
+
+	mmTransferCandidateOwnership.mock.funcTransferCandidateOwnership = f
+	mmTransferCandidateOwnership.mock.funcTransferCandidateOwnershipOrigin = minimock.CallerInfo(1)
+	return mmTransferCandidateOwnership.mock
+}
+
+// When sets expectation for the ResumeStorage.TransferCandidateOwnership which will trigger the result defined by the following
+// Then helper
+func (mmTransferCandidateOwnership *mResumeStorageMockTransferCandidateOwnership) When(ctx context.Context, in domain.TransferCandidateOwnershipInput) *ResumeStorageMockTransferCandidateOwnershipExpectation {
+	if mmTransferCandidateOwnership.mock.funcTransferCandidateOwnership != nil {
+		mmTransferCandidateOwnership.mock.t.Fatalf("ResumeStorageMock.TransferCandidateOwnership mock is already set by Set")
+	}
+
+	expectation := &ResumeStorageMockTransferCandidateOwnershipExpectation{
+		mock:               mmTransferCandidateOwnership.mock,
+		params:             &ResumeStorageMockTransferCandidateOwnershipParams{ctx, in},
+		expectationOrigins: ResumeStorageMockTransferCandidateOwnershipExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmTransferCandidateOwnership.expectations = append(mmTransferCandidateOwnership.expectations, expectation)
+	return expectation
+}
+
+// Then sets up ResumeStorage.TransferCandidateOwnership return parameters for the expectation previously defined by the When method
+func (e *ResumeStorageMockTransferCandidateOwnershipExpectation) Then(u1 uint32, err error) *ResumeStorageMock {
+	e.results = &ResumeStorageMockTransferCandidateOwnershipResults{u1, err}
+	return e.mock
+}
+
+// Times sets number of times ResumeStorage.TransferCandidateOwnership should be invoked
+func (mmTransferCandidateOwnership *mResumeStorageMockTransferCandidateOwnership) Times(n uint64) *mResumeStorageMockTransferCandidateOwnership {
+	if n == 0 {
+		mmTransferCandidateOwnership.mock.t.Fatalf("Times of ResumeStorageMock.TransferCandidateOwnership mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmTransferCandidateOwnership.expectedInvocations, n)
+	mmTransferCandidateOwnership.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmTransferCandidateOwnership
+}
+
+func (mmTransferCandidateOwnership *mResumeStorageMockTransferCandidateOwnership) invocationsDone() bool {
+	if len(mmTransferCandidateOwnership.expectations) == 0 && mmTransferCandidateOwnership.defaultExpectation == nil && mmTransferCandidateOwnership.mock.funcTransferCandidateOwnership == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmTransferCandidateOwnership.mock.afterTransferCandidateOwnershipCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmTransferCandidateOwnership.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// TransferCandidateOwnership implements mm_usecase.ResumeStorage
+func (mmTransferCandidateOwnership *ResumeStorageMock) TransferCandidateOwnership(ctx context.Context, in domain.TransferCandidateOwnershipInput) (u1 uint32, err error) {
+	mm_atomic.AddUint64(&mmTransferCandidateOwnership.beforeTransferCandidateOwnershipCounter, 1)
+	defer mm_atomic.AddUint64(&mmTransferCandidateOwnership.afterTransferCandidateOwnershipCounter, 1)
+
+	mmTransferCandidateOwnership.t.Helper()
+
+	if mmTransferCandidateOwnership.inspectFuncTransferCandidateOwnership != nil {
+		mmTransferCandidateOwnership.inspectFuncTransferCandidateOwnership(ctx, in)
+	}
+
+	mm_params := ResumeStorageMockTransferCandidateOwnershipParams{ctx, in}
+
+	// Record call args
+	mmTransferCandidateOwnership.TransferCandidateOwnershipMock.mutex.Lock()
+	mmTransferCandidateOwnership.TransferCandidateOwnershipMock.callArgs = append(mmTransferCandidateOwnership.TransferCandidateOwnershipMock.callArgs, &mm_params)
+	mmTransferCandidateOwnership.TransferCandidateOwnershipMock.mutex.Unlock()
+
+	for _, e := range mmTransferCandidateOwnership.TransferCandidateOwnershipMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.u1, e.results.err
+		}
+	}
+
+	if mmTransferCandidateOwnership.TransferCandidateOwnershipMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmTransferCandidateOwnership.TransferCandidateOwnershipMock.defaultExpectation.Counter, 1)
+		mm_want := mmTransferCandidateOwnership.TransferCandidateOwnershipMock.defaultExpectation.params
+		mm_want_ptrs := mmTransferCandidateOwnership.TransferCandidateOwnershipMock.defaultExpectation.paramPtrs
+
+		mm_got := ResumeStorageMockTransferCandidateOwnershipParams{ctx, in}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmTransferCandidateOwnership.t.Errorf("ResumeStorageMock.TransferCandidateOwnership got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmTransferCandidateOwnership.TransferCandidateOwnershipMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.in != nil && !minimock.Equal(*mm_want_ptrs.in, mm_got.in) {
+				mmTransferCandidateOwnership.t.Errorf("ResumeStorageMock.TransferCandidateOwnership got unexpected parameter in, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmTransferCandidateOwnership.TransferCandidateOwnershipMock.defaultExpectation.expectationOrigins.originIn, *mm_want_ptrs.in, mm_got.in, minimock.Diff(*mm_want_ptrs.in, mm_got.in))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmTransferCandidateOwnership.t.Errorf("ResumeStorageMock.TransferCandidateOwnership got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmTransferCandidateOwnership.TransferCandidateOwnershipMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmTransferCandidateOwnership.TransferCandidateOwnershipMock.defaultExpectation.results
+		if mm_results == nil {
+			mmTransferCandidateOwnership.t.Fatal("No results are set for the ResumeStorageMock.TransferCandidateOwnership")
+		}
+		return (*mm_results).u1, (*mm_results).err
+	}
+	if mmTransferCandidateOwnership.funcTransferCandidateOwnership != nil {
+		return mmTransferCandidateOwnership.funcTransferCandidateOwnership(ctx, in)
+	}
+	mmTransferCandidateOwnership.t.Fatalf("Unexpected call to ResumeStorageMock.TransferCandidateOwnership. %v %v", ctx, in)
+	return
+}
+
+// TransferCandidateOwnershipAfterCounter returns a count of finished ResumeStorageMock.TransferCandidateOwnership invocations
+func (mmTransferCandidateOwnership *ResumeStorageMock) TransferCandidateOwnershipAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmTransferCandidateOwnership.afterTransferCandidateOwnershipCounter)
+}
+
+// TransferCandidateOwnershipBeforeCounter returns a count of ResumeStorageMock.TransferCandidateOwnership invocations
+func (mmTransferCandidateOwnership *ResumeStorageMock) TransferCandidateOwnershipBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmTransferCandidateOwnership.beforeTransferCandidateOwnershipCounter)
+}
+
+// Calls returns a list of arguments used in each call to ResumeStorageMock.TransferCandidateOwnership.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmTransferCandidateOwnership *mResumeStorageMockTransferCandidateOwnership) Calls() []*ResumeStorageMockTransferCandidateOwnershipParams {
+	mmTransferCandidateOwnership.mutex.RLock()
+
+	argCopy := make([]*ResumeStorageMockTransferCandidateOwnershipParams, len(mmTransferCandidateOwnership.callArgs))
+	copy(argCopy, mmTransferCandidateOwnership.callArgs)
+
+	mmTransferCandidateOwnership.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockTransferCandidateOwnershipDone returns true if the count of the TransferCandidateOwnership invocations corresponds
+// the number of defined expectations
+func (m *ResumeStorageMock) MinimockTransferCandidateOwnershipDone() bool {
+	if m.TransferCandidateOwnershipMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.TransferCandidateOwnershipMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.TransferCandidateOwnershipMock.invocationsDone()
+}
+
+// MinimockTransferCandidateOwnershipInspect logs each unmet expectation
+func (m *ResumeStorageMock) MinimockTransferCandidateOwnershipInspect() {
+	for _, e := range m.TransferCandidateOwnershipMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to ResumeStorageMock.TransferCandidateOwnership at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterTransferCandidateOwnershipCounter := mm_atomic.LoadUint64(&m.afterTransferCandidateOwnershipCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.TransferCandidateOwnershipMock.defaultExpectation != nil && afterTransferCandidateOwnershipCounter < 1 {
+		if m.TransferCandidateOwnershipMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to ResumeStorageMock.TransferCandidateOwnership at\n%s", m.TransferCandidateOwnershipMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to ResumeStorageMock.TransferCandidateOwnership at\n%s with params: %#v", m.TransferCandidateOwnershipMock.defaultExpectation.expectationOrigins.origin, *m.TransferCandidateOwnershipMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcTransferCandidateOwnership != nil && afterTransferCandidateOwnershipCounter < 1 {
+		m.t.Errorf("Expected call to ResumeStorageMock.TransferCandidateOwnership at\n%s", m.funcTransferCandidateOwnershipOrigin)
+	}
+
+	if !m.TransferCandidateOwnershipMock.invocationsDone() && afterTransferCandidateOwnershipCounter > 0 {
+		m.t.Errorf("Expected %d calls to ResumeStorageMock.TransferCandidateOwnership at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.TransferCandidateOwnershipMock.expectedInvocations), m.TransferCandidateOwnershipMock.expectedInvocationsOrigin, afterTransferCandidateOwnershipCounter)
+	}
+}
+
+type mResumeStorageMockUploadResume struct {
+	optional           bool
+	mock               *ResumeStorageMock
+	defaultExpectation *ResumeStorageMockUploadResumeExpectation
+	expectations       []*ResumeStorageMockUploadResumeExpectation
+
+	callArgs []*ResumeStorageMockUploadResumeParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// ResumeStorageMockUploadResumeExpectation specifies expectation struct of the ResumeStorage.UploadResume
+type ResumeStorageMockUploadResumeExpectation struct {
+	mock               *ResumeStorageMock
+	params             *ResumeStorageMockUploadResumeParams
+	paramPtrs          *ResumeStorageMockUploadResumeParamPtrs
+	expectationOrigins ResumeStorageMockUploadResumeExpectationOrigins
+	results            *ResumeStorageMockUploadResumeResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// ResumeStorageMockUploadResumeParams contains parameters of the ResumeStorage.UploadResume
+type ResumeStorageMockUploadResumeParams struct {
+	ctx context.Context
+	in  domain.UploadResumeInput
+}
+
+// ResumeStorageMockUploadResumeParamPtrs contains pointers to parameters of the ResumeStorage.UploadResume
+type ResumeStorageMockUploadResumeParamPtrs struct {
+	ctx *context.Context
+	in  *domain.UploadResumeInput
+}
+
+// ResumeStorageMockUploadResumeResults contains results of the ResumeStorage.UploadResume
+type ResumeStorageMockUploadResumeResults struct {
+	rp1 *domain.Resume
+	err error
+}
+
+// ResumeStorageMockUploadResumeOrigins contains origins of expectations of the ResumeStorage.UploadResume
+type ResumeStorageMockUploadResumeExpectationOrigins struct {
+	origin    string
+	originCtx string
+	originIn  string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmUploadResume *mResumeStorageMockUploadResume) Optional() *mResumeStorageMockUploadResume {
+	mmUploadResume.optional = true
+	return mmUploadResume
+}
+
+// Expect sets up expected params for ResumeStorage.UploadResume
+func (mmUploadResume *mResumeStorageMockUploadResume) Expect(ctx context.Context, in domain.UploadResumeInput) *mResumeStorageMockUploadResume {
+	if mmUploadResume.mock.funcUploadResume != nil {
+		mmUploadResume.mock.t.Fatalf("ResumeStorageMock.UploadResume mock is already set by Set")
+	}
+
+	if mmUploadResume.defaultExpectation == nil {
+		mmUploadResume.defaultExpectation = &ResumeStorageMockUploadResumeExpectation{}
+	}
+
+	if mmUploadResume.defaultExpectation.paramPtrs != nil {
+		mmUploadResume.mock.t.Fatalf("ResumeStorageMock.UploadResume mock is already set by ExpectParams functions")
+	}
+
+	mmUploadResume.defaultExpectation.params = &ResumeStorageMockUploadResumeParams{ctx, in}
+	mmUploadResume.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmUploadResume.expectations {
+		if minimock.Equal(e.params, mmUploadResume.defaultExpectation.params) {
+			mmUploadResume.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmUploadResume.defaultExpectation.params)
+		}
+	}
+
+	return mmUploadResume
+}
+
+// ExpectCtxParam1 sets up expected param ctx for ResumeStorage.UploadResume
+func (mmUploadResume *mResumeStorageMockUploadResume) ExpectCtxParam1(ctx context.Context) *mResumeStorageMockUploadResume {
+	if mmUploadResume.mock.funcUploadResume != nil {
+		mmUploadResume.mock.t.Fatalf("ResumeStorageMock.UploadResume mock is already set by Set")
+	}
+
+	if mmUploadResume.defaultExpectation == nil {
+		mmUploadResume.defaultExpectation = &ResumeStorageMockUploadResumeExpectation{}
+	}
+
+	if mmUploadResume.defaultExpectation.params != nil {
+		mmUploadResume.mock.t.Fatalf("ResumeStorageMock.UploadResume mock is already set by Expect")
+	}
+
+	if mmUploadResume.defaultExpectation.paramPtrs == nil {
+		mmUploadResume.defaultExpectation.paramPtrs = &ResumeStorageMockUploadResumeParamPtrs{}
+	}
+	mmUploadResume.defaultExpectation.paramPtrs.ctx = &ctx
+	mmUploadResume.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmUploadResume
+}
+
+// ExpectInParam2 sets up expected param in for ResumeStorage.UploadResume
+func (mmUploadResume *mResumeStorageMockUploadResume) ExpectInParam2(in domain.UploadResumeInput) *mResumeStorageMockUploadResume {
+	if mmUploadResume.mock.funcUploadResume != nil {
+		mmUploadResume.mock.t.Fatalf("ResumeStorageMock.UploadResume mock is already set by Set")
+	}
+
+	if mmUploadResume.defaultExpectation == nil {
+		mmUploadResume.defaultExpectation = &ResumeStorageMockUploadResumeExpectation{}
+	}
+
+	if mmUploadResume.defaultExpectation.params != nil {
+		mmUploadResume.mock.t.Fatalf("ResumeStorageMock.UploadResume mock is already set by Expect")
+	}
+
+	if mmUploadResume.defaultExpectation.paramPtrs == nil {
+		mmUploadResume.defaultExpectation.paramPtrs = &ResumeStorageMockUploadResumeParamPtrs{}
+	}
+	mmUploadResume.defaultExpectation.paramPtrs.in = &in
+	mmUploadResume.defaultExpectation.expectationOrigins.originIn = minimock.CallerInfo(1)
+
+	return mmUploadResume
+}
+
+// Inspect accepts an inspector function that has same arguments as the ResumeStorage.UploadResume
+func (mmUploadResume *mResumeStorageMockUploadResume) Inspect(f func(ctx context.Context, in domain.UploadResumeInput)) *mResumeStorageMockUploadResume {
+	if mmUploadResume.mock.inspectFuncUploadResume != nil {
+		mmUploadResume.mock.t.Fatalf("Inspect function is already set for ResumeStorageMock.UploadResume")
+	}
+
+	mmUploadResume.mock.inspectFuncUploadResume = f
+
+	return mmUploadResume
+}
+
+// Return sets up results that will be returned by ResumeStorage.UploadResume
+func (mmUploadResume *mResumeStorageMockUploadResume) Return(rp1 *domain.Resume, err error) *ResumeStorageMock {
+	if mmUploadResume.mock.funcUploadResume != nil {
+		mmUploadResume.mock.t.Fatalf("ResumeStorageMock.UploadResume mock is already set by Set")
+	}
+
+	if mmUploadResume.defaultExpectation == nil {
+		mmUploadResume.defaultExpectation = &ResumeStorageMockUploadResumeExpectation{mock: mmUploadResume.mock}
+	}
+	mmUploadResume.defaultExpectation.results = &ResumeStorageMockUploadResumeResults{rp1, err}
+	mmUploadResume.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmUploadResume.mock
+}
+
+// Set uses given function f to mock the ResumeStorage.UploadResume method
+func (mmUploadResume *mResumeStorageMockUploadResume) Set(f func(ctx context.Context, in domain.UploadResumeInput) (rp1 *domain.Resume, err error)) *ResumeStorageMock {
+	if mmUploadResume.defaultExpectation != nil {
+		mmUploadResume.mock.t.Fatalf("Default expectation is already set for the ResumeStorage.UploadResume method")
+	}
+
+	if len(mmUploadResume.expectations) > 0 {
+		mmUploadResume.mock.t.Fatalf("Some expectations are already set for the ResumeStorage.UploadResume method")
+	}
+
+	mmUploadResume.mock.funcUploadResume = f
+	mmUploadResume.mock.funcUploadResumeOrigin = minimock.CallerInfo(1)
+	return mmUploadResume.mock
+}
+
+// When sets expectation for the ResumeStorage.UploadResume which will trigger the result defined by the following
+// Then helper
+func (mmUploadResume *mResumeStorageMockUploadResume) When(ctx context.Context, in domain.UploadResumeInput) *ResumeStorageMockUploadResumeExpectation {
+	if mmUploadResume.mock.funcUploadResume != nil {
+		mmUploadResume.mock.t.Fatalf("ResumeStorageMock.UploadResume mock is already set by Set")
+	}
+
+	expectation := &ResumeStorageMockUploadResumeExpectation{
+		mock:               mmUploadResume.mock,
+		params:             &ResumeStorageMockUploadResumeParams{ctx, in},
+		expectationOrigins: ResumeStorageMockUploadResumeExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmUploadResume.expectations = append(mmUploadResume.expectations, expectation)
+	return expectation
+}
+
+// Then sets up ResumeStorage.UploadResume return parameters for the expectation previously defined by the When method
+func (e *ResumeStorageMockUploadResumeExpectation) Then(rp1 *domain.Resume, err error) *ResumeStorageMock {
+	e.results = &ResumeStorageMockUploadResumeResults{rp1, err}
+	return e.mock
+}
+
+// Times sets number of times ResumeStorage.UploadResume should be invoked
+func (mmUploadResume *mResumeStorageMockUploadResume) Times(n uint64) *mResumeStorageMockUploadResume {
+	if n == 0 {
+		mmUploadResume.mock.t.Fatalf("Times of ResumeStorageMock.UploadResume mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmUploadResume.expectedInvocations, n)
+	mmUploadResume.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmUploadResume
+}
+
+func (mmUploadResume *mResumeStorageMockUploadResume) invocationsDone() bool {
+	if len(mmUploadResume.expectations) == 0 && mmUploadResume.defaultExpectation == nil && mmUploadResume.mock.funcUploadResume == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmUploadResume.mock.afterUploadResumeCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmUploadResume.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// UploadResume implements mm_usecase.ResumeStorage
+func (mmUploadResume *ResumeStorageMock) UploadResume(ctx context.Context, in domain.UploadResumeInput) (rp1 *domain.Resume, err error) {
+	mm_atomic.AddUint64(&mmUploadResume.beforeUploadResumeCounter, 1)
+	defer mm_atomic.AddUint64(&mmUploadResume.afterUploadResumeCounter, 1)
+
+	mmUploadResume.t.Helper()
+
+	if mmUploadResume.inspectFuncUploadResume != nil {
+		mmUploadResume.inspectFuncUploadResume(ctx, in)
+	}
+
+	mm_params := ResumeStorageMockUploadResumeParams{ctx, in}
+
+	// Record call args
+	mmUploadResume.UploadResumeMock.mutex.Lock()
+	mmUploadResume.UploadResumeMock.callArgs = append(mmUploadResume.UploadResumeMock.callArgs, &mm_params)
+	mmUploadResume.UploadResumeMock.mutex.Unlock()
+
+	for _, e := range mmUploadResume.UploadResumeMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.rp1, e.results.err
+		}
+	}
+
+	if mmUploadResume.UploadResumeMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmUploadResume.UploadResumeMock.defaultExpectation.Counter, 1)
+		mm_want := mmUploadResume.UploadResumeMock.defaultExpectation.params
+		mm_want_ptrs := mmUploadResume.UploadResumeMock.defaultExpectation.paramPtrs
+
+		mm_got := ResumeStorageMockUploadResumeParams{ctx, in}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmUploadResume.t.Errorf("ResumeStorageMock.UploadResume got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmUploadResume.UploadResumeMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.in != nil && !minimock.Equal(*mm_want_ptrs.in, mm_got.in) {
+				mmUploadResume.t.Errorf("ResumeStorageMock.UploadResume got unexpected parameter in, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmUploadResume.UploadResumeMock.defaultExpectation.expectationOrigins.originIn, *mm_want_ptrs.in, mm_got.in, minimock.Diff(*mm_want_ptrs.in, mm_got.in))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmUploadResume.t.Errorf("ResumeStorageMock.UploadResume got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmUploadResume.UploadResumeMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmUploadResume.UploadResumeMock.defaultExpectation.results
+		if mm_results == nil {
+			mmUploadResume.t.Fatal("No results are set for the ResumeStorageMock.UploadResume")
+		}
+		return (*mm_results).rp1, (*mm_results).err
+	}
+	if mmUploadResume.funcUploadResume != nil {
+		return mmUploadResume.funcUploadResume(ctx, in)
+	}
+	mmUploadResume.t.Fatalf("Unexpected call to ResumeStorageMock.UploadResume. %v %v", ctx, in)
+	return
+}
+
+// UploadResumeAfterCounter returns a count of finished ResumeStorageMock.UploadResume invocations
+func (mmUploadResume *ResumeStorageMock) UploadResumeAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmUploadResume.afterUploadResumeCounter)
+}
+
+// UploadResumeBeforeCounter returns a count of ResumeStorageMock.UploadResume invocations
+func (mmUploadResume *ResumeStorageMock) UploadResumeBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmUploadResume.beforeUploadResumeCounter)
+}
+
+// Calls returns a list of arguments used in each call to ResumeStorageMock.UploadResume.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmUploadResume *mResumeStorageMockUploadResume) Calls() []*ResumeStorageMockUploadResumeParams {
+	mmUploadResume.mutex.RLock()
+
+	argCopy := make([]*ResumeStorageMockUploadResumeParams, len(mmUploadResume.callArgs))
+	copy(argCopy, mmUploadResume.callArgs)
+
+	mmUploadResume.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockUploadResumeDone returns true if the count of the UploadResume invocations corresponds
+// the number of defined expectations
+func (m *ResumeStorageMock) MinimockUploadResumeDone() bool {
+	if m.UploadResumeMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.UploadResumeMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.UploadResumeMock.invocationsDone()
+}
+
+// MinimockUploadResumeInspect logs each unmet expectation
+func (m *ResumeStorageMock) MinimockUploadResumeInspect() {
+	for _, e := range m.UploadResumeMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to ResumeStorageMock.UploadResume at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterUploadResumeCounter := mm_atomic.LoadUint64(&m.afterUploadResumeCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.UploadResumeMock.defaultExpectation != nil && afterUploadResumeCounter < 1 {
+		if m.UploadResumeMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to ResumeStorageMock.UploadResume at\n%s", m.UploadResumeMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to ResumeStorageMock.UploadResume at\n%s with params: %#v", m.UploadResumeMock.defaultExpectation.expectationOrigins.origin, *m.UploadResumeMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcUploadResume != nil && afterUploadResumeCounter < 1 {
+		m.t.Errorf("Expected call to ResumeStorageMock.UploadResume at\n%s", m.funcUploadResumeOrigin)
+	}
+
+	if !m.UploadResumeMock.invocationsDone() && afterUploadResumeCounter > 0 {
+		m.t.Errorf("Expected %d calls to ResumeStorageMock.UploadResume at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.UploadResumeMock.expectedInvocations), m.UploadResumeMock.expectedInvocationsOrigin, afterUploadResumeCounter)
+	}
+}
+
+// MinimockFinish checks that all mocked methods have been called the expected number of times
+func (m *ResumeStorageMock) MinimockFinish() {
+	m.finishOnce.Do(func() {
+		if !m.minimockDone() {
+			m.MinimockCreateCandidateInspect()
+
+			m.MinimockCreateCandidateWithResumeInspect()
+
+			m.MinimockDeleteCandidateInspect()
+
+			m.MinimockDownloadResumeInspect()
+
+			m.MinimockGetCandidateInspect()
+
+			m.MinimockGetResumeInspect()
+
+			m.MinimockListLegacyBlobsInspect()
+
+			m.MinimockMoveBlobOutInspect()
+
+			m.MinimockPublicVacancyOwnerInspect()
+
+			m.MinimockPurgeVacancyCandidatesInspect()
+
+			m.MinimockTransferCandidateOwnershipInspect()
 
 			m.MinimockUploadResumeInspect()
 		}
@@ -2827,5 +4634,10 @@ func (m *ResumeStorageMock) minimockDone() bool {
 		m.MinimockDownloadResumeDone() &&
 		m.MinimockGetCandidateDone() &&
 		m.MinimockGetResumeDone() &&
+		m.MinimockListLegacyBlobsDone() &&
+		m.MinimockMoveBlobOutDone() &&
+		m.MinimockPublicVacancyOwnerDone() &&
+		m.MinimockPurgeVacancyCandidatesDone() &&
+		m.MinimockTransferCandidateOwnershipDone() &&
 		m.MinimockUploadResumeDone()
 }
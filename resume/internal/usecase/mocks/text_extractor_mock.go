@@ -7,6 +7,7 @@ import (
 	mm_atomic "sync/atomic"
 	mm_time "time"
 
+	"github.com/artem13815/hr/resume/internal/domain"
 	"github.com/gojuno/minimock/v3"
 )
 
@@ -29,7 +30,7 @@ type TextExtractorMock struct {
 	beforeDetectFileTypeCounter uint64
 	DetectFileTypeMock          mTextExtractorMockDetectFileType
 
-	funcExtractText          func(fileType string, data []byte) (s1 string, err error)
+	funcExtractText          func(fileType string, data []byte) (t1 domain.TextExtraction, err error)
 	funcExtractTextOrigin    string
 	inspectFuncExtractText   func(fileType string, data []byte)
 	afterExtractTextCounter  uint64
@@ -782,7 +783,7 @@ type TextExtractorMockExtractTextParamPtrs struct {
 
 // TextExtractorMockExtractTextResults contains results of the TextExtractor.ExtractText
 type TextExtractorMockExtractTextResults struct {
-	s1  string
+	t1  domain.TextExtraction
 	err error
 }
 
@@ -886,7 +887,7 @@ func (mmExtractText *mTextExtractorMockExtractText) Inspect(f func(fileType stri
 }
 
 // Return sets up results that will be returned by TextExtractor.ExtractText
-func (mmExtractText *mTextExtractorMockExtractText) Return(s1 string, err error) *TextExtractorMock {
+func (mmExtractText *mTextExtractorMockExtractText) Return(t1 domain.TextExtraction, err error) *TextExtractorMock {
 	if mmExtractText.mock.funcExtractText != nil {
 		mmExtractText.mock.t.Fatalf("TextExtractorMock.ExtractText mock is already set by Set")
 	}
@@ -894,13 +895,13 @@ func (mmExtractText *mTextExtractorMockExtractText) Return(s1 string, err error)
 	if mmExtractText.defaultExpectation == nil {
 		mmExtractText.defaultExpectation = &TextExtractorMockExtractTextExpectation{mock: mmExtractText.mock}
 	}
-	mmExtractText.defaultExpectation.results = &TextExtractorMockExtractTextResults{s1, err}
+	mmExtractText.defaultExpectation.results = &TextExtractorMockExtractTextResults{t1, err}
 	mmExtractText.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
 	return mmExtractText.mock
 }
 
 // Set uses given function f to mock the TextExtractor.ExtractText method
-func (mmExtractText *mTextExtractorMockExtractText) Set(f func(fileType string, data []byte) (s1 string, err error)) *TextExtractorMock {
+func (mmExtractText *mTextExtractorMockExtractText) Set(f func(fileType string, data []byte) (t1 domain.TextExtraction, err error)) *TextExtractorMock {
 	if mmExtractText.defaultExpectation != nil {
 		mmExtractText.mock.t.Fatalf("Default expectation is already set for the TextExtractor.ExtractText method")
 	}
@@ -931,8 +932,8 @@ func (mmExtractText *mTextExtractorMockExtractText) When(fileType string, data [
 }
 
 // Then sets up TextExtractor.ExtractText return parameters for the expectation previously defined by the When method
-func (e *TextExtractorMockExtractTextExpectation) Then(s1 string, err error) *TextExtractorMock {
-	e.results = &TextExtractorMockExtractTextResults{s1, err}
+func (e *TextExtractorMockExtractTextExpectation) Then(t1 domain.TextExtraction, err error) *TextExtractorMock {
+	e.results = &TextExtractorMockExtractTextResults{t1, err}
 	return e.mock
 }
 
@@ -958,7 +959,7 @@ func (mmExtractText *mTextExtractorMockExtractText) invocationsDone() bool {
 }
 
 // ExtractText implements mm_usecase.TextExtractor
-func (mmExtractText *TextExtractorMock) ExtractText(fileType string, data []byte) (s1 string, err error) {
+func (mmExtractText *TextExtractorMock) ExtractText(fileType string, data []byte) (t1 domain.TextExtraction, err error) {
 	mm_atomic.AddUint64(&mmExtractText.beforeExtractTextCounter, 1)
 	defer mm_atomic.AddUint64(&mmExtractText.afterExtractTextCounter, 1)
 
@@ -978,7 +979,7 @@ func (mmExtractText *TextExtractorMock) ExtractText(fileType string, data []byte
 	for _, e := range mmExtractText.ExtractTextMock.expectations {
 		if minimock.Equal(*e.params, mm_params) {
 			mm_atomic.AddUint64(&e.Counter, 1)
-			return e.results.s1, e.results.err
+			return e.results.t1, e.results.err
 		}
 	}
 
@@ -1010,7 +1011,7 @@ func (mmExtractText *TextExtractorMock) ExtractText(fileType string, data []byte
 		if mm_results == nil {
 			mmExtractText.t.Fatal("No results are set for the TextExtractorMock.ExtractText")
 		}
-		return (*mm_results).s1, (*mm_results).err
+		return (*mm_results).t1, (*mm_results).err
 	}
 	if mmExtractText.funcExtractText != nil {
 		return mmExtractText.funcExtractText(fileType, data)
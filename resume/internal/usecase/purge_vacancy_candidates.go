@@ -0,0 +1,43 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/artem13815/hr/resume/internal/domain"
+)
+
+// PurgeVacancyCandidates is the resume side of the vacancy retention job:
+// it hard-deletes every candidate of a soft-deleted vacancy whose grace
+// period is over, resumes and their blob store files included, then has
+// the analysis service delete the vacancy's analyses, which hold a
+// profile and summary derived from those resumes. There is no user to
+// authorise — the RPC is internal — so the storage only acts on vacancies
+// the vacancy service has already marked for purge; anything else is
+// ErrNotFound. Repeating the call is safe: a failed analysis purge fails
+// the call, and the retention job retries the whole step on its next run.
+func (s *ResumeService) PurgeVacancyCandidates(ctx context.Context, vacancyID string) (*domain.PurgeVacancyCandidatesResult, error) {
+	if strings.TrimSpace(vacancyID) == "" {
+		return nil, ErrInvalidArgument
+	}
+
+	res, err := s.storage.PurgeVacancyCandidates(ctx, vacancyID)
+	if err != nil {
+		if errors.Is(err, domain.ErrNotFound) {
+			return nil, ErrNotFound
+		}
+		return nil, err
+	}
+	// The rows are gone, so this is the only chance to drop the files.
+	s.discardBlobs(ctx, res.BlobPaths...)
+
+	// Candidates go first so no analysis can start on one of them after
+	// the sweep.
+	res.Analyses, err = s.analyses.PurgeVacancyAnalyses(ctx, vacancyID)
+	if err != nil {
+		return nil, fmt.Errorf("purge vacancy analyses: %w", err)
+	}
+	return res, nil
+}
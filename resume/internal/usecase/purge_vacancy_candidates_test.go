@@ -0,0 +1,99 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+	"gotest.tools/v3/assert"
+
+	"github.com/artem13815/hr/resume/internal/domain"
+)
+
+type PurgeVacancyCandidatesSuite struct{ baseSuite }
+
+func (s *PurgeVacancyCandidatesSuite) TestSuccess() {
+	t := s.T()
+	ctx := t.Context()
+	s.storage.PurgeVacancyCandidatesMock.Expect(ctx, "v-1").Return(&domain.PurgeVacancyCandidatesResult{Candidates: 3, Resumes: 2}, nil)
+	s.analyses.PurgeVacancyAnalysesMock.Expect(ctx, "v-1").Return(4, nil)
+
+	got, err := s.svc.PurgeVacancyCandidates(ctx, "v-1")
+	assert.NilError(t, err)
+	assert.DeepEqual(t, got, &domain.PurgeVacancyCandidatesResult{Candidates: 3, Resumes: 2, Analyses: 4})
+}
+
+// TestDeletesBlobs: files of purged resumes go from the blob store too; a
+// failed delete only leaves garbage behind and does not fail the purge.
+func (s *PurgeVacancyCandidatesSuite) TestDeletesBlobs() {
+	t := s.T()
+	ctx := t.Context()
+	want := &domain.PurgeVacancyCandidatesResult{Candidates: 2, Resumes: 2, BlobPaths: []string{"resumes/aa/1", "resumes/bb/2"}}
+
+	s.storage.PurgeVacancyCandidatesMock.Expect(ctx, "v-1").Return(want, nil)
+	var deleted []string
+	s.blobs.DeleteMock.Set(func(_ context.Context, key string) error {
+		deleted = append(deleted, key)
+		return errors.New("s3: timeout")
+	})
+	s.analyses.PurgeVacancyAnalysesMock.Return(0, nil)
+
+	got, err := s.svc.PurgeVacancyCandidates(ctx, "v-1")
+	assert.NilError(t, err)
+	assert.DeepEqual(t, got, want)
+	assert.DeepEqual(t, deleted, want.BlobPaths)
+}
+
+// TestAnalysisPurgeFails: the candidates are gone, but the call fails so
+// the retention job keeps the vacancy and retries; the files still go now,
+// since nothing will list them again.
+func (s *PurgeVacancyCandidatesSuite) TestAnalysisPurgeFails() {
+	t := s.T()
+	ctx := t.Context()
+	rpcErr := errors.New("analysis: unavailable")
+
+	s.storage.PurgeVacancyCandidatesMock.Expect(ctx, "v-1").Return(&domain.PurgeVacancyCandidatesResult{Candidates: 1, Resumes: 1, BlobPaths: []string{"resumes/aa/1"}}, nil)
+	s.blobs.DeleteMock.Return(nil)
+	s.analyses.PurgeVacancyAnalysesMock.Expect(ctx, "v-1").Return(0, rpcErr)
+
+	got, err := s.svc.PurgeVacancyCandidates(ctx, "v-1")
+	assert.ErrorIs(t, err, rpcErr)
+	assert.Assert(t, got == nil)
+}
+
+// TestNotBeingPurged: a vacancy the retention job has not marked keeps its
+// candidates.
+func (s *PurgeVacancyCandidatesSuite) TestNotBeingPurged() {
+	t := s.T()
+	ctx := t.Context()
+
+	s.storage.PurgeVacancyCandidatesMock.Expect(ctx, "v-1").Return(nil, domain.ErrNotFound)
+
+	got, err := s.svc.PurgeVacancyCandidates(ctx, "v-1")
+	assert.ErrorIs(t, err, ErrNotFound)
+	assert.Assert(t, got == nil)
+}
+
+func (s *PurgeVacancyCandidatesSuite) TestInvalidArgument() {
+	t := s.T()
+	got, err := s.svc.PurgeVacancyCandidates(t.Context(), "  ")
+	assert.ErrorIs(t, err, ErrInvalidArgument)
+	assert.Assert(t, got == nil)
+}
+
+func (s *PurgeVacancyCandidatesSuite) TestStorageError() {
+	t := s.T()
+	ctx := t.Context()
+	storageErr := errors.New("pgx: connection refused")
+
+	s.storage.PurgeVacancyCandidatesMock.Expect(ctx, "v-1").Return(nil, storageErr)
+
+	got, err := s.svc.PurgeVacancyCandidates(ctx, "v-1")
+	assert.ErrorIs(t, err, storageErr)
+	assert.Assert(t, got == nil)
+}
+
+func TestPurgeVacancyCandidatesSuite(t *testing.T) {
+	suite.Run(t, new(PurgeVacancyCandidatesSuite))
+}
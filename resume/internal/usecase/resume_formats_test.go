@@ -0,0 +1,143 @@
+package usecase
+
+import (
+	"archive/zip"
+	"bytes"
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+	"gotest.tools/v3/assert"
+
+	"github.com/artem13815/hr/resume/internal/domain"
+)
+
+// ResumeFormatsSuite runs the formats beyond PDF/DOCX/TXT through the real
+// extractor and profile adapters. Legacy .doc needs antiword/catdoc on the
+// host and is left to the container.
+type ResumeFormatsSuite struct{ baseSuite }
+
+// create uploads one file and returns what reached storage.
+func (s *ResumeFormatsSuite) create(fileName string, data []byte) (domain.CreateCandidateInput, domain.NewResumeData) {
+	t := s.T()
+	var gotCandidate domain.CreateCandidateInput
+	var gotResume domain.NewResumeData
+
+	s.blobs.PutMock.Return(testBlobKey, nil)
+	s.storage.CreateCandidateWithResumeMock.Set(func(_ context.Context, candidateIn domain.CreateCandidateInput, resumeIn domain.NewResumeData) (*domain.Candidate, *domain.Resume, error) {
+		gotCandidate, gotResume = candidateIn, resumeIn
+		return &domain.Candidate{ID: "c-1"}, &domain.Resume{ID: "r-1"}, nil
+	})
+
+	_, err := s.svc.CreateCandidateFromResume(t.Context(), domain.CreateCandidateFromResumeInput{
+		RequestUserID: 7,
+		FileName:      fileName,
+		FileData:      data,
+	})
+	assert.NilError(t, err)
+	return gotCandidate, gotResume
+}
+
+func (s *ResumeFormatsSuite) TestRTF() {
+	t := s.T()
+	// Word-style RTF: cp1251 escapes for the name, \uN for the rest.
+	rtf := []byte(`{\rtf1\ansi\ansicpg1251{\fonttbl{\f0 Arial;}}{\*\generator Riched20;}\f0 \'c8\'e2\'e0\'ed \'cf\'e5\'f2\'f0\'ee\'e2\par Email: ivan@example.com\par \uc1\u1056?\u1072?\u1079?\u1088?\u1072?\u1073?\u1086?\u1090?\u1095?\u1080?\u1082? Go\par}`)
+
+	candidate, resume := s.create("", rtf)
+	assert.Equal(t, resume.FileType, "rtf")
+	assert.Equal(t, resume.ExtractionMethod, domain.ExtractionMethodParser)
+	assert.Equal(t, resume.FileName, "resume.rtf")
+	assert.Equal(t, resume.ExtractedText, "Иван Петров\nEmail: ivan@example.com\nРазработчик Go")
+	assert.Equal(t, candidate.Email, "ivan@example.com")
+}
+
+func (s *ResumeFormatsSuite) TestRTFBinaryPayload() {
+	t := s.T()
+	// \bin skips its payload; a length past the end just ends the text.
+	_, resume := s.create("", []byte(`{\rtf1\ansi Ivan{\bin3 xyz} Petrov\bin100 tail}`))
+	assert.Equal(t, resume.ExtractedText, "Ivan Petrov")
+}
+
+func (s *ResumeFormatsSuite) TestMalformedRTF() {
+	t := s.T()
+	for _, data := range []string{
+		`{\rtf1\ansi hello\bin99999999999999999999 x}`,
+		`{\rtf1\ansi hello\bin-5 x}`,
+	} {
+		_, err := s.svc.CreateCandidateFromResume(t.Context(), domain.CreateCandidateFromResumeInput{
+			RequestUserID: 7,
+			FileData:      []byte(data),
+		})
+		assert.ErrorIs(t, err, ErrInvalidArgument, data)
+	}
+}
+
+func (s *ResumeFormatsSuite) TestODT() {
+	t := s.T()
+	var buf bytes.Buffer
+	zw := zip.NewWriter(&buf)
+	for name, body := range map[string]string{
+		"mimetype": "application/vnd.oasis.opendocument.text",
+		"content.xml": `<office:document-content xmlns:office="urn:oasis:names:tc:opendocument:xmlns:office:1.0" xmlns:text="urn:oasis:names:tc:opendocument:xmlns:text:1.0">` +
+			`<office:body><office:text>` +
+			`<text:h>Иван Пет<text:span>ров</text:span></text:h>` +
+			`<text:p>Email:<text:s/>ivan@example.com</text:p>` +
+			`</office:text></office:body></office:document-content>`,
+	} {
+		w, err := zw.Create(name)
+		assert.NilError(t, err)
+		_, err = w.Write([]byte(body))
+		assert.NilError(t, err)
+	}
+	assert.NilError(t, zw.Close())
+
+	candidate, resume := s.create("cv.odt", buf.Bytes())
+	assert.Equal(t, resume.FileType, "odt")
+	assert.Equal(t, resume.ExtractionMethod, domain.ExtractionMethodParser)
+	assert.Equal(t, resume.FileName, "cv.odt")
+	assert.Equal(t, resume.ExtractedText, "Иван Петров\nEmail: ivan@example.com")
+	assert.Equal(t, candidate.Email, "ivan@example.com")
+}
+
+func (s *ResumeFormatsSuite) TestHTML() {
+	t := s.T()
+	page := []byte(`<!DOCTYPE html><html><head><title>CV</title><style>p{}</style></head>` +
+		`<body><h1>Иван   Петров</h1><script>track()</script>` +
+		`<p>Email: ivan@example.com</p><table><tr><td>Go</td><td>5&nbsp;лет</td></tr></table></body></html>`)
+
+	_, resume := s.create("cv.htm", page)
+	assert.Equal(t, resume.FileType, "html")
+	assert.Equal(t, resume.ExtractionMethod, domain.ExtractionMethodParser)
+	assert.Equal(t, resume.FileName, "cv.htm")
+	assert.Equal(t, resume.ExtractedText, "Иван Петров\nEmail: ivan@example.com\nGo\t5 лет")
+}
+
+func (s *ResumeFormatsSuite) TestMarkdown() {
+	t := s.T()
+	md := []byte("# Иван Петров\n\n**Email:** [ivan@example.com](mailto:ivan@example.com)\n\n" +
+		"## Навыки\n- Go\n- `PostgreSQL`\n- snake_case\n")
+
+	_, resume := s.create("cv.md", md)
+	assert.Equal(t, resume.FileType, "md")
+	assert.Equal(t, resume.ExtractionMethod, domain.ExtractionMethodParser)
+	assert.Equal(t, resume.ExtractedText, "Иван Петров\n\nEmail: ivan@example.com\n\nНавыки\nGo\nPostgreSQL\nsnake_case")
+}
+
+func (s *ResumeFormatsSuite) TestMarkdownWithoutExtensionIsText() {
+	t := s.T()
+	_, resume := s.create("", []byte("# Иван Петров\n"))
+	assert.Equal(t, resume.FileType, "txt")
+	assert.Equal(t, resume.ExtractedText, "# Иван Петров")
+}
+
+func (s *ResumeFormatsSuite) TestExtensionDoesNotOverrideContent() {
+	t := s.T()
+	// A text file named .doc is not an OLE document: sniffing wins.
+	_, resume := s.create("cv.doc", []byte("Иван Петров"))
+	assert.Equal(t, resume.FileType, "txt")
+	assert.Equal(t, resume.FileName, "cv.txt")
+}
+
+func TestResumeFormatsSuite(t *testing.T) {
+	suite.Run(t, new(ResumeFormatsSuite))
+}
@@ -1,6 +1,6 @@
 package usecase
 
-//go:generate go run github.com/gojuno/minimock/v3/cmd/minimock@v3.4.7 -i ResumeStorage,TextExtractor,ProfileExtractor -o ./mocks -s _mock.go -g
+//go:generate go run github.com/gojuno/minimock/v3/cmd/minimock@v3.4.7 -i ResumeStorage,BlobStore,TextExtractor,ProfileExtractor,ApplicationAnalyzer,AnalysisPurger -o ./mocks -s _mock.go -g
 
 import (
 	"context"
@@ -16,20 +16,52 @@ type ResumeStorage interface {
 	UploadResume(ctx context.Context, in domain.UploadResumeInput) (*domain.Resume, error)
 	GetResume(ctx context.Context, resumeID string, requestUserID uint64, isAdmin bool) (*domain.Resume, error)
 	DownloadResume(ctx context.Context, resumeID string, requestUserID uint64, isAdmin bool) (*domain.ResumeFile, error)
-	DeleteCandidate(ctx context.Context, candidateID string, requestUserID uint64, isAdmin bool) error
+	// DeleteCandidate returns the blob store keys of the deleted resumes.
+	DeleteCandidate(ctx context.Context, candidateID string, requestUserID uint64, isAdmin bool) ([]string, error)
+
+	// PurgeVacancyCandidates deletes every candidate of a vacancy whose
+	// purge the vacancy service has started, or returns domain.ErrNotFound
+	// when it has not.
+	PurgeVacancyCandidates(ctx context.Context, vacancyID string) (*domain.PurgeVacancyCandidatesResult, error)
+
+	// TransferCandidateOwnership moves one batch of candidates to the new
+	// owner and returns how many moved.
+	TransferCandidateOwnership(ctx context.Context, in domain.TransferCandidateOwnershipInput) (uint32, error)
 
 	// CreateCandidateWithResume inserts a candidate and its first resume in a
 	// single transaction. Either both rows land or neither does — protects
 	// against orphaned candidates when the resume insert fails after the
 	// candidate insert succeeded.
 	CreateCandidateWithResume(ctx context.Context, candidateIn domain.CreateCandidateInput, resumeIn domain.NewResumeData) (*domain.Candidate, *domain.Resume, error)
+
+	// PublicVacancyOwner returns the owner of a vacancy that takes public
+	// applications (published and open), or domain.ErrNotFound.
+	PublicVacancyOwner(ctx context.Context, vacancyID string) (uint64, error)
+
+	// ListLegacyBlobs returns up to limit resumes whose bytes are still in
+	// resumes.file_data; MoveBlobOut swaps one of them over to a blob store
+	// key, reporting false when it is gone or already moved.
+	ListLegacyBlobs(ctx context.Context, limit int) ([]domain.LegacyResumeBlob, error)
+	MoveBlobOut(ctx context.Context, resumeID, storagePath string) (bool, error)
+}
+
+// BlobStore keeps resume file bytes out of Postgres. Implemented by
+// infrastructure/blobstore (local filesystem or S3-compatible object
+// storage). Put picks the key; resumes.storage_path records it.
+type BlobStore interface {
+	Put(ctx context.Context, data []byte) (string, error)
+	Get(ctx context.Context, key string) ([]byte, error)
+	// Delete succeeds for a key that does not exist.
+	Delete(ctx context.Context, key string) error
 }
 
 // TextExtractor is the file-format detection + text extraction driven port.
-// Implemented by infrastructure/extractor (PDF / DOCX / TXT).
+// Implemented by infrastructure/extractor (PDF with OCR fallback, DOCX, DOC,
+// RTF, ODT, HTML, Markdown, TXT). ExtractText reports which method produced
+// the text; it is stored with the resume.
 type TextExtractor interface {
 	DetectFileType(fileName string, data []byte) (string, error)
-	ExtractText(fileType string, data []byte) (string, error)
+	ExtractText(fileType string, data []byte) (domain.TextExtraction, error)
 	BuildFileName(fileName, fileType string) string
 }
 
@@ -40,16 +72,38 @@ type ProfileExtractor interface {
 	ExtractCandidateProfile(text, fileName string) domain.CandidateProfile
 }
 
+// ApplicationAnalyzer starts scoring a publicly submitted resume.
+// Implemented by infrastructure/analysis_client. Fire-and-forget: the
+// applicant must not wait for the analysis, so implementations return at
+// once and only log failures.
+type ApplicationAnalyzer interface {
+	StartApplicationAnalysis(ctx context.Context, resumeID string)
+}
+
+// AnalysisPurger deletes the analyses of a vacancy being purged, so the
+// resume-derived profile and summary they hold do not outlive the resumes.
+// Implemented by infrastructure/analysis_client. Unlike ApplicationAnalyzer
+// it is synchronous: the retention job must hear about a failure to retry.
+type AnalysisPurger interface {
+	PurgeVacancyAnalyses(ctx context.Context, vacancyID string) (uint32, error)
+}
+
 type ResumeService struct {
 	storage   ResumeStorage
+	blobs     BlobStore
 	extractor TextExtractor
 	profile   ProfileExtractor
+	analyzer  ApplicationAnalyzer
+	analyses  AnalysisPurger
 }
 
-func NewResumeService(storage ResumeStorage, extractor TextExtractor, profile ProfileExtractor) *ResumeService {
+func NewResumeService(storage ResumeStorage, blobs BlobStore, extractor TextExtractor, profile ProfileExtractor, analyzer ApplicationAnalyzer, analyses AnalysisPurger) *ResumeService {
 	return &ResumeService{
 		storage:   storage,
+		blobs:     blobs,
 		extractor: extractor,
 		profile:   profile,
+		analyzer:  analyzer,
+		analyses:  analyses,
 	}
 }
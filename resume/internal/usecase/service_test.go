@@ -8,6 +8,9 @@ import (
 	"github.com/artem13815/hr/resume/internal/usecase/mocks"
 )
 
+// testBlobKey is what the BlobStore mock hands out for every Put.
+const testBlobKey = "resumes/ab/ab01"
+
 // baseSuite gives each per-method suite a fresh ResumeService wired with a
 // fresh minimock storage. The mock registers itself with t.Cleanup, so any
 // unmet expectation fails the test automatically — no manual
@@ -20,12 +23,18 @@ import (
 // far).
 type baseSuite struct {
 	suite.Suite
-	storage *mocks.ResumeStorageMock
-	svc     *ResumeService
+	storage  *mocks.ResumeStorageMock
+	blobs    *mocks.BlobStoreMock
+	analyzer *mocks.ApplicationAnalyzerMock
+	analyses *mocks.AnalysisPurgerMock
+	svc      *ResumeService
 }
 
 func (s *baseSuite) SetupTest() {
 	t := s.T()
 	s.storage = mocks.NewResumeStorageMock(t)
-	s.svc = NewResumeService(s.storage, extractor.New(), profile.New())
+	s.blobs = mocks.NewBlobStoreMock(t)
+	s.analyzer = mocks.NewApplicationAnalyzerMock(t)
+	s.analyses = mocks.NewAnalysisPurgerMock(t)
+	s.svc = NewResumeService(s.storage, s.blobs, extractor.New(), profile.New(), s.analyzer, s.analyses)
 }
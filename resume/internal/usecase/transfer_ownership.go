@@ -0,0 +1,31 @@
+package usecase
+
+import (
+	"cmp"
+	"context"
+
+	"github.com/artem13815/hr/resume/internal/domain"
+)
+
+// Ownership transfer batch bounds, the same as the vacancy service's.
+const (
+	defaultTransferLimit = 200
+	maxTransferLimit     = 1000
+)
+
+// TransferCandidateOwnership hands up to in.Limit of the candidates
+// FromUserID added to ToUserID and returns how many moved. Access to a
+// candidate also follows its vacancy, so this matters for candidates in
+// the general pool and on vacancies someone else owns. Calling it again
+// moves the next batch; zero means nothing is left.
+func (s *ResumeService) TransferCandidateOwnership(ctx context.Context, in domain.TransferCandidateOwnershipInput) (uint32, error) {
+	if !in.IsAdmin {
+		return 0, ErrForbidden
+	}
+	if in.FromUserID == 0 || in.ToUserID == 0 || in.FromUserID == in.ToUserID || in.Limit > maxTransferLimit {
+		return 0, ErrInvalidArgument
+	}
+	in.Limit = cmp.Or(in.Limit, defaultTransferLimit)
+
+	return s.storage.TransferCandidateOwnership(ctx, in)
+}
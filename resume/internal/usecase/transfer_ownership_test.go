@@ -0,0 +1,72 @@
+package usecase
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+	"gotest.tools/v3/assert"
+
+	"github.com/artem13815/hr/resume/internal/domain"
+)
+
+type TransferCandidateOwnershipSuite struct{ baseSuite }
+
+func (s *TransferCandidateOwnershipSuite) TestMovesBatch() {
+	t := s.T()
+	ctx := t.Context()
+	in := domain.TransferCandidateOwnershipInput{IsAdmin: true, FromUserID: 1, ToUserID: 2, Limit: 50}
+
+	s.storage.TransferCandidateOwnershipMock.Expect(ctx, in).Return(12, nil)
+
+	got, err := s.svc.TransferCandidateOwnership(ctx, in)
+	assert.NilError(t, err)
+	assert.Equal(t, got, uint32(12))
+}
+
+func (s *TransferCandidateOwnershipSuite) TestZeroLimitDefaults() {
+	t := s.T()
+	ctx := t.Context()
+
+	s.storage.TransferCandidateOwnershipMock.Expect(ctx, domain.TransferCandidateOwnershipInput{
+		IsAdmin: true, FromUserID: 1, ToUserID: 2, Limit: defaultTransferLimit,
+	}).Return(0, nil)
+
+	_, err := s.svc.TransferCandidateOwnership(ctx, domain.TransferCandidateOwnershipInput{IsAdmin: true, FromUserID: 1, ToUserID: 2})
+	assert.NilError(t, err)
+}
+
+func (s *TransferCandidateOwnershipSuite) TestForbiddenForNonAdmin() {
+	t := s.T()
+	_, err := s.svc.TransferCandidateOwnership(t.Context(), domain.TransferCandidateOwnershipInput{FromUserID: 1, ToUserID: 2})
+	assert.ErrorIs(t, err, ErrForbidden)
+}
+
+func (s *TransferCandidateOwnershipSuite) TestInvalidArgument() {
+	t := s.T()
+	for _, in := range []domain.TransferCandidateOwnershipInput{
+		{IsAdmin: true, ToUserID: 2},
+		{IsAdmin: true, FromUserID: 1},
+		{IsAdmin: true, FromUserID: 1, ToUserID: 1},
+		{IsAdmin: true, FromUserID: 1, ToUserID: 2, Limit: maxTransferLimit + 1},
+	} {
+		_, err := s.svc.TransferCandidateOwnership(t.Context(), in)
+		assert.ErrorIs(t, err, ErrInvalidArgument)
+	}
+}
+
+func (s *TransferCandidateOwnershipSuite) TestStorageError() {
+	t := s.T()
+	ctx := t.Context()
+	storageErr := errors.New("pgx: connection refused")
+	in := domain.TransferCandidateOwnershipInput{IsAdmin: true, FromUserID: 1, ToUserID: 2, Limit: 10}
+
+	s.storage.TransferCandidateOwnershipMock.Expect(ctx, in).Return(0, storageErr)
+
+	_, err := s.svc.TransferCandidateOwnership(ctx, in)
+	assert.ErrorIs(t, err, storageErr)
+}
+
+func TestTransferCandidateOwnershipSuite(t *testing.T) {
+	suite.Run(t, new(TransferCandidateOwnershipSuite))
+}
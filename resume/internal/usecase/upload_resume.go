@@ -27,14 +27,21 @@ func (s *ResumeService) UploadResume(ctx context.Context, in domain.UploadResume
 	}
 	in.FileType = detectedType
 	in.FileName = s.extractor.BuildFileName(in.FileName, in.FileType)
-	extractedText, err := s.extractor.ExtractText(in.FileType, in.Data)
+	extraction, err := s.extractor.ExtractText(in.FileType, in.Data)
 	if err != nil {
 		return nil, ErrInvalidArgument
 	}
-	in.ExtractedText = extractedText
+	in.ExtractedText = extraction.Text
+	in.ExtractionMethod = extraction.Method
+
+	in.StoragePath, err = s.blobs.Put(ctx, in.Data)
+	if err != nil {
+		return nil, err
+	}
 
 	resume, err := s.storage.UploadResume(ctx, in)
 	if err != nil {
+		s.discardBlobs(ctx, in.StoragePath)
 		if errors.Is(err, domain.ErrNotFound) {
 			return nil, ErrNotFound
 		}
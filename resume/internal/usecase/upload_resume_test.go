@@ -27,6 +27,7 @@ func (s *UploadResumeSuite) TestSuccess() {
 	// Service runs extractor before storage, so we match by candidate id and
 	// admin flag — exact bytes / FileType / ExtractedText are derived inside
 	// the service. Using a custom Mock matcher keeps the test readable.
+	s.blobs.PutMock.Return(testBlobKey, nil)
 	s.storage.UploadResumeMock.Set(func(_ context.Context, in domain.UploadResumeInput) (*domain.Resume, error) {
 		assert.Equal(t, in.RequestUserID, uint64(7))
 		assert.Equal(t, in.CandidateID, "c-1")
@@ -34,6 +35,8 @@ func (s *UploadResumeSuite) TestSuccess() {
 		assert.Equal(t, in.FileName, "resume.txt")
 		assert.Assert(t, bytes.Equal(in.Data, txtPayload))
 		assert.Assert(t, in.ExtractedText != "")
+		assert.Equal(t, in.ExtractionMethod, domain.ExtractionMethodParser)
+		assert.Equal(t, in.StoragePath, testBlobKey)
 		return want, nil
 	})
 
@@ -100,6 +103,8 @@ func (s *UploadResumeSuite) TestNotFoundFromStorage() {
 	t := s.T()
 	ctx := t.Context()
 
+	s.blobs.PutMock.Return(testBlobKey, nil)
+	s.blobs.DeleteMock.ExpectKeyParam2(testBlobKey).Return(nil)
 	s.storage.UploadResumeMock.Set(func(_ context.Context, _ domain.UploadResumeInput) (*domain.Resume, error) {
 		return nil, domain.ErrNotFound
 	})
@@ -119,6 +124,8 @@ func (s *UploadResumeSuite) TestStorageError() {
 	ctx := t.Context()
 	storageErr := errors.New("pgx: connection lost")
 
+	s.blobs.PutMock.Return(testBlobKey, nil)
+	s.blobs.DeleteMock.ExpectKeyParam2(testBlobKey).Return(nil)
 	s.storage.UploadResumeMock.Set(func(_ context.Context, _ domain.UploadResumeInput) (*domain.Resume, error) {
 		return nil, storageErr
 	})
@@ -133,4 +140,23 @@ func (s *UploadResumeSuite) TestStorageError() {
 	assert.Assert(t, got == nil)
 }
 
+// TestBlobStoreError: nothing is recorded when the file could not be
+// stored.
+func (s *UploadResumeSuite) TestBlobStoreError() {
+	t := s.T()
+	ctx := t.Context()
+	blobErr := errors.New("s3: access denied")
+
+	s.blobs.PutMock.Expect(ctx, txtPayload).Return("", blobErr)
+
+	got, err := s.svc.UploadResume(ctx, domain.UploadResumeInput{
+		RequestUserID: 1,
+		CandidateID:   "c-1",
+		FileName:      "r.txt",
+		Data:          txtPayload,
+	})
+	assert.ErrorIs(t, err, blobErr)
+	assert.Assert(t, got == nil)
+}
+
 func TestUploadResumeSuite(t *testing.T) { suite.Run(t, new(UploadResumeSuite)) }
@@ -31,14 +31,20 @@ func run() error {
 		return err
 	}
 
+	skills, err := bootstrap.InitSkillTaxonomy(storage)
+	if err != nil {
+		storage.Close()
+		return err
+	}
+
 	maClient, maCleanup, err := bootstrap.InitMultiAgentClient(cfg)
 	if err != nil {
 		storage.Close()
 		return err
 	}
 
-	service := bootstrap.InitVacancyService(storage, maClient)
-	api := bootstrap.InitVacancyServiceAPI(service)
+	service := bootstrap.InitVacancyService(storage, maClient, skills, cfg)
+	api := bootstrap.InitVacancyServiceAPI(service, cfg)
 
 	authClient, authCleanup, err := auth_client.New(cfg)
 	if err != nil {
@@ -47,11 +53,27 @@ func run() error {
 		return err
 	}
 
+	stopReconciler := bootstrap.StartRoleReconciler(service, cfg)
+	events, stopEvents := bootstrap.InitVacancyEvents(storage)
+
+	stopRetention, err := bootstrap.StartRetentionPurger(storage, cfg)
+	if err != nil {
+		stopEvents()
+		stopReconciler()
+		authCleanup()
+		maCleanup()
+		storage.Close()
+		return err
+	}
+
 	// Hooks run LIFO during shutdown — close auth and multiagent conns
 	// before the pgxpool, mirroring construction order. multiagent goes
 	// before auth in this list because it was constructed earlier;
-	// runShutdown reverses the slice so auth tears down first.
-	return bootstrap.AppRun(api, authClient, cfg, storage.Close, maCleanup, authCleanup)
+	// runShutdown reverses the slice so auth tears down first. The role
+	// reconciler uses storage and multiagent, so it stops before either;
+	// the event listener holds a storage connection and stops next; the
+	// retention purger uses storage and its own resume conn and stops first.
+	return bootstrap.AppRun(api, events, authClient, cfg, storage.Close, maCleanup, authCleanup, stopReconciler, stopEvents, stopRetention)
 }
 
 func defaultConfigPathByEnv(appEnv string) string {
@@ -12,6 +12,49 @@ type Config struct {
 	Server     ServerConfig     `yaml:"server"`
 	Auth       AuthConfig       `yaml:"auth"`
 	MultiAgent MultiAgentConfig `yaml:"multiagent"`
+	Feed       FeedConfig       `yaml:"feed"`
+	// RoleReconciler is optional; see RoleReconcilerConfig for defaults.
+	RoleReconciler RoleReconcilerConfig `yaml:"role_reconciler"`
+	// Resume is optional: an empty grpc_addr leaves the retention job off,
+	// and soft-deleted vacancies are kept until it is set.
+	Resume    ResumeConfig    `yaml:"resume"`
+	Retention RetentionConfig `yaml:"retention"`
+}
+
+// ResumeConfig points at the resume service. Vacancy only calls its
+// internal PurgeVacancyCandidates RPC, from the retention job.
+type ResumeConfig struct {
+	GRPCAddr string `yaml:"grpc_addr"`
+}
+
+// RetentionConfig tunes the job that hard-deletes soft-deleted vacancies.
+// grace_period_days (how long an admin can still restore one) defaults to
+// 30; interval_seconds defaults to 3600 and a negative value disables the
+// job; batch_size (vacancies per run) defaults to 20.
+type RetentionConfig struct {
+	GracePeriodDays int `yaml:"grace_period_days"`
+	IntervalSeconds int `yaml:"interval_seconds"`
+	BatchSize       int `yaml:"batch_size"`
+}
+
+// RoleReconcilerConfig tunes the background job that retries keyword
+// fallback roles against the LLM classifier. interval_seconds defaults to
+// 900 and a negative value disables the job; batch_size (vacancies per
+// run) defaults to 50.
+type RoleReconcilerConfig struct {
+	IntervalSeconds int `yaml:"interval_seconds"`
+	BatchSize       int `yaml:"batch_size"`
+}
+
+// FeedConfig describes the organisation in the public vacancy feed
+// (schema.org JobPosting, job-board XML). All fields are optional:
+// without site_url vacancies are published without a link, without
+// organization_name without a hiring organisation. cache_max_age is the
+// Cache-Control max-age of feed responses in seconds, 300 by default.
+type FeedConfig struct {
+	OrganizationName string `yaml:"organization_name"`
+	SiteURL          string `yaml:"site_url"`
+	CacheMaxAge      int    `yaml:"cache_max_age"`
 }
 
 // MultiAgentConfig points at the multiagent gRPC service. Vacancy calls
@@ -100,6 +143,12 @@ func (c *Config) validate() error {
 		return fmt.Errorf("database.port is required")
 	case c.Database.DBName == "":
 		return fmt.Errorf("database.name is required")
+	case c.RoleReconciler.BatchSize < 0 || c.RoleReconciler.BatchSize > 500:
+		return fmt.Errorf("role_reconciler.batch_size must be between 0 and 500")
+	case c.Retention.GracePeriodDays < 0:
+		return fmt.Errorf("retention.grace_period_days must not be negative")
+	case c.Retention.BatchSize < 0 || c.Retention.BatchSize > 500:
+		return fmt.Errorf("retention.batch_size must be between 0 and 500")
 	}
 	return nil
 }
@@ -0,0 +1,93 @@
+package bootstrap
+
+import (
+	"cmp"
+	"context"
+	"log/slog"
+	"time"
+
+	"github.com/artem13815/hr/vacancy/config"
+	"github.com/artem13815/hr/vacancy/internal/domain"
+	"github.com/artem13815/hr/vacancy/internal/infrastructure/persistence"
+	"github.com/artem13815/hr/vacancy/internal/infrastructure/resume_client"
+	"github.com/artem13815/hr/vacancy/internal/usecase"
+)
+
+// Retention defaults, applied when retention is unset.
+const (
+	defaultRetentionGraceDays = 30
+	defaultRetentionInterval  = 3600
+	defaultRetentionBatch     = 20
+)
+
+// StartRetentionPurger hard-deletes soft-deleted vacancies past the grace
+// period every interval, one batch per tick, and returns the shutdown hook
+// that stops it, waits for the run in flight and closes the resume conn.
+// The hook must run before storage.Close. With resume.grpc_addr empty or a
+// negative interval the job stays off and the hook is a no-op; deleted
+// vacancies are then kept indefinitely, though still restorable only
+// within the grace period. Like the role reconciler,
+// the first run happens one interval after start.
+func StartRetentionPurger(storage *persistence.VacancyStorage, cfg *config.Config) (func(), error) {
+	interval := time.Duration(cmp.Or(cfg.Retention.IntervalSeconds, defaultRetentionInterval)) * time.Second
+	if interval < 0 || cfg.Resume.GRPCAddr == "" {
+		slog.Info("vacancy retention disabled")
+		return func() {}, nil
+	}
+	grace := retentionGracePeriod(cfg)
+	batch := uint32(cmp.Or(cfg.Retention.BatchSize, defaultRetentionBatch))
+
+	client, cleanup, err := resume_client.New(cfg.Resume.GRPCAddr)
+	if err != nil {
+		return nil, err
+	}
+	purger := usecase.NewVacancyPurger(storage, client)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	done := make(chan struct{})
+	go func() {
+		defer close(done)
+		ticker := time.NewTicker(interval)
+		defer ticker.Stop()
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case <-ticker.C:
+			}
+
+			res, err := purger.PurgeDeletedVacancies(ctx, domain.PurgeDeletedVacanciesInput{
+				DeletedBefore: time.Now().UTC().Add(-grace),
+				Limit:         batch,
+			})
+			if err != nil {
+				if ctx.Err() == nil {
+					slog.Error("vacancy retention run failed", "err", err)
+				}
+				continue
+			}
+			if res.Scanned > 0 {
+				slog.Info("vacancy retention run",
+					"scanned", res.Scanned,
+					"purged", res.Purged,
+					"restored", res.Restored,
+					"failed", res.Failed,
+					"candidates", res.Candidates,
+					"resumes", res.Resumes)
+			}
+		}
+	}()
+
+	return func() {
+		cancel()
+		<-done
+		cleanup()
+	}, nil
+}
+
+// retentionGracePeriod is how long a deleted vacancy stays restorable. The
+// purger and RestoreVacancy both use it, so a vacancy past it cannot be
+// restored even before the purger gets to it.
+func retentionGracePeriod(cfg *config.Config) time.Duration {
+	return time.Duration(cmp.Or(cfg.Retention.GracePeriodDays, defaultRetentionGraceDays)) * 24 * time.Hour
+}
@@ -0,0 +1,74 @@
+package bootstrap
+
+import (
+	"cmp"
+	"context"
+	"log/slog"
+	"time"
+
+	"github.com/artem13815/hr/vacancy/config"
+	"github.com/artem13815/hr/vacancy/internal/domain"
+	"github.com/artem13815/hr/vacancy/internal/usecase"
+)
+
+// Role reconciler defaults, applied when role_reconciler is unset.
+const (
+	defaultRoleReconcileInterval = 900
+	defaultRoleReconcileBatch    = 50
+)
+
+// StartRoleReconciler retries keyword fallback roles against the LLM every
+// interval, one batch per tick, and returns the shutdown hook that stops it
+// and waits for the run in flight. Each tick only picks vacancies
+// classified at least one interval ago, so a vacancy saved during an LLM
+// outage gets a retry after the next tick rather than immediately. The
+// first run happens one interval after start, not at boot.
+func StartRoleReconciler(service *usecase.VacancyService, cfg *config.Config) func() {
+	interval := time.Duration(cmp.Or(cfg.RoleReconciler.IntervalSeconds, defaultRoleReconcileInterval)) * time.Second
+	if interval < 0 {
+		slog.Info("role reconciler disabled")
+		return func() {}
+	}
+	batch := uint32(cmp.Or(cfg.RoleReconciler.BatchSize, defaultRoleReconcileBatch))
+
+	ctx, cancel := context.WithCancel(context.Background())
+	done := make(chan struct{})
+	go func() {
+		defer close(done)
+		ticker := time.NewTicker(interval)
+		defer ticker.Stop()
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case <-ticker.C:
+			}
+
+			// The reconciler acts for the system, with admin rights.
+			res, err := service.ReclassifyRoles(ctx, domain.ReclassifyRolesInput{
+				IsAdmin:          true,
+				Limit:            batch,
+				ClassifiedBefore: time.Now().Add(-interval),
+			})
+			if err != nil {
+				if ctx.Err() == nil {
+					slog.Error("role reconciler run failed", "err", err)
+				}
+				continue
+			}
+			if res.Scanned > 0 {
+				slog.Info("role reconciler run",
+					"scanned", res.Scanned,
+					"reclassified", res.Reclassified,
+					"changed", res.Changed,
+					"skipped", res.Skipped,
+					"llm_unavailable", res.LLMUnavailable)
+			}
+		}
+	}()
+
+	return func() {
+		cancel()
+		<-done
+	}
+}
@@ -18,6 +18,7 @@ import (
 	"github.com/artem13815/hr/vacancy/config"
 	"github.com/artem13815/hr/vacancy/internal/pb/auth_api"
 	"github.com/artem13815/hr/vacancy/internal/pb/vacancy_api"
+	"github.com/artem13815/hr/vacancy/internal/pb/vacancy_events_api"
 	transport_grpc "github.com/artem13815/hr/vacancy/internal/transport/grpc"
 	"github.com/artem13815/hr/vacancy/internal/transport/middleware"
 )
@@ -31,7 +32,7 @@ const gracefulStopTimeout = 15 * time.Second
 // it drains in-flight RPCs (GracefulStop with a Stop fallback) and invokes
 // onShutdown hooks in reverse order, LIFO-style, so resources tear down in
 // the inverse of their construction order.
-func AppRun(api *transport_grpc.VacancyServiceAPI, authClient auth_api.AuthServiceClient, cfg *config.Config, onShutdown ...func()) error {
+func AppRun(api *transport_grpc.VacancyServiceAPI, events *transport_grpc.VacancyEventsAPI, authClient auth_api.AuthServiceClient, cfg *config.Config, onShutdown ...func()) error {
 	lis, err := net.Listen("tcp", cfg.Server.GRPCAddr)
 	if err != nil {
 		return fmt.Errorf("listen %s: %w", cfg.Server.GRPCAddr, err)
@@ -63,6 +64,7 @@ func AppRun(api *transport_grpc.VacancyServiceAPI, authClient auth_api.AuthServi
 
 	s := grpc.NewServer(serverOpts...)
 	vacancy_api.RegisterVacancyServiceServer(s, api)
+	vacancy_events_api.RegisterVacancyEventServiceServer(s, events)
 
 	// Health service for k8s/compose probes. SERVING is set immediately —
 	// by the time we register the server, pgxpool and the auth client have
@@ -96,6 +98,9 @@ func AppRun(api *transport_grpc.VacancyServiceAPI, authClient auth_api.AuthServi
 	// new requests before we begin GracefulStop.
 	healthSrv.Shutdown()
 
+	// Event subscriptions are open-ended; end them so GracefulStop only
+	// waits for real in-flight requests.
+	events.Close()
 	gracefulStop(s)
 	runShutdown(onShutdown)
 	return nil
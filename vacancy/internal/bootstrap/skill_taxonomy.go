@@ -0,0 +1,33 @@
+package bootstrap
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/artem13815/hr/vacancy/internal/domain"
+	"github.com/artem13815/hr/vacancy/internal/infrastructure/persistence"
+	"github.com/artem13815/hr/vacancy/internal/infrastructure/taxonomy"
+)
+
+// skillTaxonomySyncTimeout bounds the boot-time mirror of the seed into
+// postgres, for the same reason persistence bounds its migration pass.
+const skillTaxonomySyncTimeout = 15 * time.Second
+
+// InitSkillTaxonomy loads the bundled skill dictionary and mirrors it into
+// the database for analysis. Either failure aborts the boot: a vacancy
+// service that canonicalizes differently from what analysis matches would
+// skew scores silently.
+func InitSkillTaxonomy(storage *persistence.VacancyStorage) (*domain.SkillTaxonomy, error) {
+	skills, err := taxonomy.Load()
+	if err != nil {
+		return nil, err
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), skillTaxonomySyncTimeout)
+	defer cancel()
+	if err := storage.SyncSkillTaxonomy(ctx, skills.Entries()); err != nil {
+		return nil, fmt.Errorf("sync skill taxonomy: %w", err)
+	}
+	return skills, nil
+}
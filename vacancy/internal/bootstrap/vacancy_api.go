@@ -1,10 +1,23 @@
 package bootstrap
 
 import (
+	"cmp"
+	"time"
+
+	"github.com/artem13815/hr/vacancy/config"
 	transport_grpc "github.com/artem13815/hr/vacancy/internal/transport/grpc"
 	"github.com/artem13815/hr/vacancy/internal/usecase"
 )
 
-func InitVacancyServiceAPI(service *usecase.VacancyService) *transport_grpc.VacancyServiceAPI {
-	return transport_grpc.NewVacancyServiceAPI(service)
+// defaultFeedCacheMaxAge applies when feed.cache_max_age is unset: long
+// enough to absorb crawler bursts, short enough that a withdrawn vacancy
+// disappears from the career site within minutes.
+const defaultFeedCacheMaxAge = 300
+
+func InitVacancyServiceAPI(service *usecase.VacancyService, cfg *config.Config) *transport_grpc.VacancyServiceAPI {
+	return transport_grpc.NewVacancyServiceAPI(service, transport_grpc.FeedOptions{
+		OrganizationName: cfg.Feed.OrganizationName,
+		SiteURL:          cfg.Feed.SiteURL,
+		CacheMaxAge:      time.Duration(cmp.Or(cfg.Feed.CacheMaxAge, defaultFeedCacheMaxAge)) * time.Second,
+	})
 }
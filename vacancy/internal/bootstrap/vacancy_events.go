@@ -0,0 +1,30 @@
+package bootstrap
+
+import (
+	"context"
+
+	"github.com/artem13815/hr/vacancy/internal/infrastructure/eventbus"
+	"github.com/artem13815/hr/vacancy/internal/infrastructure/persistence"
+	transport_grpc "github.com/artem13815/hr/vacancy/internal/transport/grpc"
+	"github.com/artem13815/hr/vacancy/internal/usecase"
+)
+
+// InitVacancyEvents wires the outbox relay behind VacancyEventService and
+// starts the LISTEN connection that wakes it. The returned hook stops the
+// listener and waits for it; it must run before storage.Close.
+func InitVacancyEvents(storage *persistence.VacancyStorage) (*transport_grpc.VacancyEventsAPI, func()) {
+	notifier := eventbus.NewNotifier(storage)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	done := make(chan struct{})
+	go func() {
+		defer close(done)
+		notifier.Run(ctx)
+	}()
+
+	relay := usecase.NewEventRelay(storage, notifier)
+	return transport_grpc.NewVacancyEventsAPI(relay), func() {
+		cancel()
+		<-done
+	}
+}
@@ -1,13 +1,16 @@
 package bootstrap
 
 import (
+	"github.com/artem13815/hr/vacancy/config"
+	"github.com/artem13815/hr/vacancy/internal/domain"
 	"github.com/artem13815/hr/vacancy/internal/infrastructure/multiagent_client"
 	"github.com/artem13815/hr/vacancy/internal/infrastructure/persistence"
 	pb "github.com/artem13815/hr/vacancy/internal/pb/multiagent_api"
 	"github.com/artem13815/hr/vacancy/internal/usecase"
 )
 
-func InitVacancyService(storage *persistence.VacancyStorage, maClient pb.MultiAgentServiceClient) *usecase.VacancyService {
+func InitVacancyService(storage *persistence.VacancyStorage, maClient pb.MultiAgentServiceClient, skills *domain.SkillTaxonomy, cfg *config.Config) *usecase.VacancyService {
 	classifier := multiagent_client.NewClassifier(maClient)
-	return usecase.NewVacancyService(storage, classifier)
+	suggester := multiagent_client.NewSkillSuggester(maClient)
+	return usecase.NewVacancyService(storage, classifier, suggester, skills, retentionGracePeriod(cfg))
 }
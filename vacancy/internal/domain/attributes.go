@@ -0,0 +1,73 @@
+package domain
+
+// Remote work policies. Stored verbatim in vacancies.remote_policy; empty
+// means the vacancy does not say.
+const (
+	RemoteOnsite = "onsite"
+	RemoteHybrid = "hybrid"
+	RemoteFull   = "remote"
+)
+
+// Employment types, stored in vacancies.employment_type.
+const (
+	EmploymentFullTime   = "full_time"
+	EmploymentPartTime   = "part_time"
+	EmploymentContract   = "contract"
+	EmploymentInternship = "internship"
+	EmploymentTemporary  = "temporary"
+)
+
+// Seniority levels, stored in vacancies.seniority and forwarded to the
+// multiagent decision prompt.
+const (
+	SeniorityIntern = "intern"
+	SeniorityJunior = "junior"
+	SeniorityMiddle = "middle"
+	SenioritySenior = "senior"
+	SeniorityLead   = "lead"
+)
+
+// VacancyAttributes are the structured facts of an opening that the feed
+// publishes and ListVacancies filters on. Zero values mean "not specified",
+// except Headcount, which the usecase defaults to 1.
+type VacancyAttributes struct {
+	Location     string
+	RemotePolicy string
+	// SalaryMin / SalaryMax are whole units of SalaryCurrency (ISO 4217,
+	// e.g. "RUB"). Either bound may be 0 for an open-ended range; a
+	// currency is required as soon as one of them is set.
+	SalaryMin      uint64
+	SalaryMax      uint64
+	SalaryCurrency string
+	EmploymentType string
+	Seniority      string
+	// Headcount is how many people the vacancy hires.
+	Headcount uint32
+}
+
+// IsKnownRemotePolicy reports whether p is one of the remote policies.
+func IsKnownRemotePolicy(p string) bool {
+	switch p {
+	case RemoteOnsite, RemoteHybrid, RemoteFull:
+		return true
+	}
+	return false
+}
+
+// IsKnownEmploymentType reports whether t is one of the employment types.
+func IsKnownEmploymentType(t string) bool {
+	switch t {
+	case EmploymentFullTime, EmploymentPartTime, EmploymentContract, EmploymentInternship, EmploymentTemporary:
+		return true
+	}
+	return false
+}
+
+// IsKnownSeniority reports whether s is one of the seniority levels.
+func IsKnownSeniority(s string) bool {
+	switch s {
+	case SeniorityIntern, SeniorityJunior, SeniorityMiddle, SenioritySenior, SeniorityLead:
+		return true
+	}
+	return false
+}
@@ -0,0 +1,48 @@
+package domain
+
+import "time"
+
+// Collaborator roles. A viewer can read the vacancy, its history and its
+// candidates; an editor can additionally update it, change its status and
+// start analyses. Managing collaborators stays with the owner and admins.
+const (
+	CollaboratorRoleViewer = "viewer"
+	CollaboratorRoleEditor = "editor"
+)
+
+// IsValidCollaboratorRole reports whether role is one of the collaborator
+// roles.
+func IsValidCollaboratorRole(role string) bool {
+	return role == CollaboratorRoleViewer || role == CollaboratorRoleEditor
+}
+
+type Collaborator struct {
+	VacancyID string
+	UserID    uint64
+	Role      string
+	AddedBy   uint64
+	CreatedAt time.Time
+}
+
+// AddCollaboratorInput grants (or re-grants with a new role) UserID access
+// to the vacancy. OwnerUserID/IsAdmin identify the caller.
+type AddCollaboratorInput struct {
+	VacancyID   string
+	OwnerUserID uint64
+	IsAdmin     bool
+	UserID      uint64
+	Role        string
+}
+
+type RemoveCollaboratorInput struct {
+	VacancyID   string
+	OwnerUserID uint64
+	IsAdmin     bool
+	UserID      uint64
+}
+
+type ListCollaboratorsInput struct {
+	VacancyID   string
+	OwnerUserID uint64
+	IsAdmin     bool
+}
@@ -2,11 +2,6 @@ package domain
 
 import "time"
 
-const (
-	StatusActive   = "active"
-	StatusArchived = "archived"
-)
-
 type SkillWeight struct {
 	Name       string
 	Weight     float32
@@ -28,6 +23,19 @@ type Vacancy struct {
 	Version   uint32
 	CreatedAt time.Time
 	UpdatedAt time.Time
+	// IsPublic opts the vacancy into the unauthenticated feed. Only open
+	// public vacancies are published; see ListPublicVacancies.
+	IsPublic   bool
+	Attributes VacancyAttributes
+	// RoleSource says how Role was decided (RoleSource*) and
+	// RoleClassifiedAt when.
+	RoleSource       string
+	RoleClassifiedAt time.Time
+	// RoleLocked pins a manually chosen Role: updates keep it and the role
+	// reconciler skips the vacancy until the lock is released.
+	RoleLocked bool
+	// ScoringPolicy is how analysis scores candidates for this vacancy.
+	ScoringPolicy ScoringPolicy
 }
 
 type CreateVacancyInput struct {
@@ -36,6 +44,22 @@ type CreateVacancyInput struct {
 	Description string
 	Role        string
 	Skills      []SkillWeight
+	// Status is the initial lifecycle status: StatusDraft or StatusOpen.
+	// Empty means StatusOpen so existing clients keep getting a vacancy
+	// that accepts candidates right away.
+	Status     string
+	Attributes VacancyAttributes
+	// RoleSource records how Role was decided; see Vacancy.RoleSource.
+	RoleSource string
+	RoleLocked bool
+	// ScoringPolicy is the vacancy's scoring policy; nil means the default.
+	ScoringPolicy *ScoringPolicy
+	// Force creates the vacancy even if open vacancies look like
+	// duplicates of it; see PossibleDuplicatesError.
+	Force bool
+	// IsAdmin widens the duplicate check to every open vacancy; otherwise
+	// it only covers those the owner can view.
+	IsAdmin bool
 }
 
 type GetVacancyInput struct {
@@ -48,18 +72,82 @@ type ListVacanciesInput struct {
 	OwnerUserID uint64
 	Limit       uint32
 	Offset      uint32
-	Query       string
-	IsAdmin     bool
+	// Query is a web-search style full-text query over title and
+	// description: words, "quoted phrases", -exclusions, "or". Empty lists
+	// everything newest first; otherwise results are ranked.
+	Query   string
+	IsAdmin bool
+	// Facet filters. Values within one facet are OR-ed, facets are AND-ed,
+	// and an empty facet does not filter.
+	Statuses []string
+	Roles    []string
+	// Skills matches skill names case-insensitively.
+	Skills []string
+	// CreatedFrom / CreatedTo bound created_at as [from, to); zero values
+	// leave that side open.
+	CreatedFrom time.Time
+	CreatedTo   time.Time
+	// Locations matches the location case-insensitively; RemotePolicies,
+	// EmploymentTypes and Seniorities match the attribute values.
+	Locations       []string
+	RemotePolicies  []string
+	EmploymentTypes []string
+	Seniorities     []string
+	// SalaryAtLeast keeps vacancies in SalaryCurrency whose range reaches
+	// the amount: the upper bound, or the lower one for open-ended ranges.
+	// It needs SalaryCurrency; a currency alone filters by currency.
+	SalaryAtLeast  uint64
+	SalaryCurrency string
+	// PageToken switches to keyset pagination: the opaque token from a
+	// previous page's NextPageToken. Offset is ignored when it is set.
+	PageToken string
+	// After is PageToken decoded by the usecase; storage reads only this.
+	After *VacancyCursor
+	// TotalMode picks how Total is computed (TotalExact / TotalEstimated /
+	// TotalNone). Empty means exact in offset mode and none in keyset mode.
+	TotalMode string
+}
+
+// Total count strategies for ListVacancies.
+const (
+	TotalExact     = "exact"
+	TotalEstimated = "estimated"
+	TotalNone      = "none"
+)
+
+// VacancyCursor is the keyset position of one row in ListVacancies order:
+// rank DESC, created_at DESC, id DESC. Rank is zero without a search query,
+// which reduces the key to (created_at, id).
+type VacancyCursor struct {
+	Rank      float32
+	CreatedAt time.Time
+	ID        string
 }
 
 type UpdateVacancyInput struct {
 	VacancyID   string
 	OwnerUserID uint64
 	IsAdmin     bool
-	Title       string
-	Description string
-	Role        string
-	Skills      []SkillWeight
+	// ExpectedVersion is the version the caller last read. Zero skips the
+	// check (last-write-wins) so clients predating optimistic concurrency
+	// keep working; any other value makes the update conditional.
+	ExpectedVersion uint32
+	Title           string
+	Description     string
+	Role            string
+	Skills          []SkillWeight
+	// Attributes replaces the structured attributes; nil keeps the stored
+	// ones, so clients that predate them do not wipe them on update.
+	Attributes *VacancyAttributes
+	// ScoringPolicy replaces the scoring policy; nil keeps the stored one.
+	ScoringPolicy *ScoringPolicy
+	// RoleSource records how Role was decided; see Vacancy.RoleSource.
+	RoleSource string
+	// RoleLocked is the lock state after the update. A non-empty Role from
+	// the caller pins it; UnlockRole releases a pinned role and lets
+	// classification pick one again.
+	RoleLocked bool
+	UnlockRole bool
 }
 
 type ArchiveVacancyInput struct {
@@ -68,7 +156,121 @@ type ArchiveVacancyInput struct {
 	IsAdmin     bool
 }
 
+// DeleteVacancyInput soft-deletes a vacancy. OwnerUserID is the caller and
+// is recorded as deleted_by.
+type DeleteVacancyInput struct {
+	VacancyID   string
+	OwnerUserID uint64
+	IsAdmin     bool
+}
+
+// UndeleteVacancyInput clears a soft delete. ActorUserID is the admin
+// restoring it; only a vacancy deleted after DeletedAfter (UTC, the start
+// of the grace window) qualifies.
+type UndeleteVacancyInput struct {
+	VacancyID    string
+	ActorUserID  uint64
+	DeletedAfter time.Time
+}
+
 type ListVacanciesResult struct {
 	Vacancies []Vacancy
 	Total     uint64
+	// Highlights holds one entry per vacancy, in the same order, when the
+	// request had a Query; nil otherwise.
+	Highlights []VacancyHighlight
+	// TotalMode says what Total holds: TotalExact, TotalEstimated (exact
+	// up to a cap, a lower bound beyond it) or TotalNone (not computed).
+	TotalMode string
+	// Next is the position of the last row when the page was full, i.e.
+	// there may be more; nil on the last page. NextPageToken is its
+	// encoded form, filled in by the usecase.
+	Next          *VacancyCursor
+	NextPageToken string
+}
+
+// Highlight markers wrap matched terms inside VacancyHighlight texts. They
+// are private-use code points, so they cannot collide with user text and
+// transport can escape the text before turning them into markup.
+const (
+	HighlightStart = "\uE000"
+	HighlightStop  = "\uE001"
+)
+
+// VacancyHighlight is the search-hit decoration for one vacancy: its
+// ts_rank score plus title and description fragments with matched terms
+// wrapped in HighlightStart / HighlightStop.
+type VacancyHighlight struct {
+	VacancyID string
+	Rank      float32
+	Title     string
+	Snippet   string
+}
+
+// VacancyVersion is an immutable snapshot of the scoring-relevant fields of
+// a vacancy as of one version. Written in the same transaction as the
+// create/update that produced it, so analyses.vacancy_version always
+// resolves to the exact skill matrix the candidate was scored against.
+type VacancyVersion struct {
+	VacancyID   string
+	Version     uint32
+	Title       string
+	Description string
+	Role        string
+	Skills      []SkillWeight
+	// CreatedBy is the caller who saved this version — the owner, or an
+	// admin editing on their behalf.
+	CreatedBy uint64
+	CreatedAt time.Time
+}
+
+type ListVacancyVersionsInput struct {
+	VacancyID   string
+	OwnerUserID uint64
+	IsAdmin     bool
+	Limit       uint32
+	Offset      uint32
+}
+
+type ListVacancyVersionsResult struct {
+	Versions []VacancyVersion
+	Total    uint64
+}
+
+type GetVacancyVersionInput struct {
+	VacancyID   string
+	Version     uint32
+	OwnerUserID uint64
+	IsAdmin     bool
+}
+
+type DiffVacancyVersionsInput struct {
+	VacancyID   string
+	FromVersion uint32
+	ToVersion   uint32
+	OwnerUserID uint64
+	IsAdmin     bool
+}
+
+// SkillChange describes a skill present in both versions whose weight or
+// must-have / nice-to-have flags differ. Name is taken from the newer side.
+type SkillChange struct {
+	Name   string
+	Before SkillWeight
+	After  SkillWeight
+}
+
+// VacancyVersionDiff is what changed between two snapshots. Skills are
+// matched by case-insensitive name, so a rename shows up as one removal
+// plus one addition.
+type VacancyVersionDiff struct {
+	VacancyID          string
+	FromVersion        uint32
+	ToVersion          uint32
+	TitleChanged       bool
+	DescriptionChanged bool
+	RoleChanged        bool
+	AddedSkills        []SkillWeight
+	RemovedSkills      []SkillWeight
+	ChangedSkills      []SkillChange
 }
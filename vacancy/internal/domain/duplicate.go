@@ -0,0 +1,61 @@
+package domain
+
+import (
+	"errors"
+	"fmt"
+)
+
+// Duplicate detection on CreateVacancy. A new vacancy is compared with the
+// open vacancies its owner can view: titles by trigram similarity,
+// skills by Jaccard similarity of their names. The weighted sum decides.
+const (
+	DuplicateTitleWeight = 0.6
+	DuplicateSkillWeight = 0.4
+	// DuplicateThreshold is the minimum combined similarity reported as a
+	// possible duplicate. An identical title alone (0.6) does not reach
+	// it: "Backend developer" for another stack is a different opening.
+	DuplicateThreshold = 0.7
+	// DuplicateCandidateLimit caps the title-similar vacancies storage
+	// returns for skill comparison; MaxPossibleDuplicates caps the report.
+	DuplicateCandidateLimit = 20
+	MaxPossibleDuplicates   = 5
+)
+
+// DuplicateCandidate is an open vacancy whose title is trigram-similar to
+// the one being created, as storage returns it.
+type DuplicateCandidate struct {
+	VacancyID       string
+	Title           string
+	OwnerUserID     uint64
+	TitleSimilarity float32
+	// Skills are the lower-cased skill names.
+	Skills []string
+}
+
+// PossibleDuplicate is a DuplicateCandidate that crossed
+// DuplicateThreshold, with the similarities that put it there.
+type PossibleDuplicate struct {
+	VacancyID       string
+	Title           string
+	OwnerUserID     uint64
+	TitleSimilarity float32
+	SkillSimilarity float32
+	Similarity      float32
+}
+
+// ErrPossibleDuplicates is the sentinel for a CreateVacancy refused because
+// open vacancies look like duplicates. Match it with errors.Is; use
+// errors.As against *PossibleDuplicatesError to read them.
+var ErrPossibleDuplicates = errors.New("possible duplicate vacancies")
+
+// PossibleDuplicatesError carries the duplicates that stopped the create;
+// the caller retries with Force to create the vacancy anyway.
+type PossibleDuplicatesError struct {
+	Duplicates []PossibleDuplicate
+}
+
+func (e *PossibleDuplicatesError) Error() string {
+	return fmt.Sprintf("%s: %d found", ErrPossibleDuplicates, len(e.Duplicates))
+}
+
+func (e *PossibleDuplicatesError) Unwrap() error { return ErrPossibleDuplicates }
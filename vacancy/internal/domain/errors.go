@@ -0,0 +1,25 @@
+package domain
+
+import (
+	"errors"
+	"fmt"
+)
+
+// ErrVersionConflict is the sentinel for a lost optimistic-concurrency race:
+// the caller edited a stale copy of the vacancy. Match it with errors.Is;
+// use errors.As against *VersionConflictError to read the current version.
+var ErrVersionConflict = errors.New("vacancy version conflict")
+
+// VersionConflictError carries the version the row actually has, so the
+// client can re-fetch (or show a diff) without guessing how far behind it
+// is. Storage returns it when the conditional UPDATE matched the vacancy but
+// not the expected version.
+type VersionConflictError struct {
+	CurrentVersion uint32
+}
+
+func (e *VersionConflictError) Error() string {
+	return fmt.Sprintf("%s: current version is %d", ErrVersionConflict, e.CurrentVersion)
+}
+
+func (e *VersionConflictError) Unwrap() error { return ErrVersionConflict }
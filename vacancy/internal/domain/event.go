@@ -0,0 +1,40 @@
+package domain
+
+import "time"
+
+// Vacancy event types written to the outbox (vacancy_events) in the same
+// transaction as the change they describe.
+const (
+	EventVacancyCreated       = "vacancy_created"
+	EventVacancyUpdated       = "vacancy_updated"
+	EventVacancyStatusChanged = "vacancy_status_changed"
+	// EventVacancyDeleted, EventVacancyRestored and EventVacancyPurged
+	// follow a vacancy through soft delete, undelete and the retention
+	// purge; they carry the version and status it had.
+	EventVacancyDeleted  = "vacancy_deleted"
+	EventVacancyRestored = "vacancy_restored"
+	EventVacancyPurged   = "vacancy_purged"
+)
+
+// VacancyEvent is one outbox row. Seq is assigned on insert; the relay
+// holds an event back until no transaction that could commit a smaller seq
+// is still running, so consumers see seq only grow. ID is what consumers
+// deduplicate on.
+type VacancyEvent struct {
+	Seq         uint64
+	ID          string
+	Type        string
+	VacancyID   string
+	OwnerUserID uint64
+	// ActorUserID is 0 for changes the system makes on its own.
+	ActorUserID uint64
+	OccurredAt  time.Time
+	// Version is the vacancy version after the change; OldVersion is set
+	// for EventVacancyUpdated only.
+	Version    uint32
+	OldVersion uint32
+	// Status is the status after the change; OldStatus is set for
+	// EventVacancyStatusChanged only.
+	Status    string
+	OldStatus string
+}
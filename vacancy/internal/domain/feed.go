@@ -0,0 +1,15 @@
+package domain
+
+type SetVacancyVisibilityInput struct {
+	VacancyID   string
+	OwnerUserID uint64
+	IsAdmin     bool
+	IsPublic    bool
+}
+
+// ListPublicVacanciesInput selects the feed. OwnerUserID 0 means the whole
+// organisation (every owner of the installation).
+type ListPublicVacanciesInput struct {
+	OwnerUserID uint64
+	Limit       uint32
+}
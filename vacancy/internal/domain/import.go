@@ -0,0 +1,57 @@
+package domain
+
+// Supported ImportVacancies payload formats.
+const (
+	// ImportFormatCSV is a header row plus one vacancy per record; see
+	// usecase/import_parse.go for the columns.
+	ImportFormatCSV = "csv"
+	// ImportFormatJSONLines is one JSON object per line, shaped like
+	// CreateVacancyRequest.
+	ImportFormatJSONLines = "jsonl"
+	// ImportFormatHH is the hh.ru vacancy JSON: a single vacancy, an array
+	// of them, or a search page with "items".
+	ImportFormatHH = "hh"
+)
+
+// Commit modes of ImportVacancies.
+const (
+	// ImportModeAllOrNothing writes every row in one transaction, and only
+	// when every row is valid.
+	ImportModeAllOrNothing = "all_or_nothing"
+	// ImportModeBestEffort writes the valid rows one by one and reports the
+	// rest.
+	ImportModeBestEffort = "best_effort"
+)
+
+type ImportVacanciesInput struct {
+	OwnerUserID uint64
+	Format      string
+	Data        []byte
+	Mode        string
+	DryRun      bool
+}
+
+// ImportRowResult describes one source row. Row is 1-based and points at the
+// file position a human would look for: the spreadsheet row for CSV (the
+// header is row 1), the line for JSON-lines, the array index for hh.ru.
+// Title, Status, Role and Skills show what would be (or was) stored; Vacancy
+// is set only once the row is written. Error is empty on success.
+type ImportRowResult struct {
+	Row     int
+	Title   string
+	Status  string
+	Role    string
+	Skills  []SkillWeight
+	Vacancy *Vacancy
+	Error   string
+}
+
+// ImportVacanciesResult reports every row. Committed is true when at least
+// one vacancy was written; never on a dry run, and in all-or-nothing mode
+// only when no row failed.
+type ImportVacanciesResult struct {
+	Rows      []ImportRowResult
+	Created   int
+	Failed    int
+	Committed bool
+}
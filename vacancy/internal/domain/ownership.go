@@ -0,0 +1,13 @@
+package domain
+
+// TransferVacancyOwnershipInput moves up to Limit vacancies owned by
+// FromUserID to ToUserID. Admin only: it is one batch of the admin
+// service's TransferOwnership.
+type TransferVacancyOwnershipInput struct {
+	IsAdmin bool
+	// ActorUserID is the admin; it goes into the VacancyUpdated events.
+	ActorUserID uint64
+	FromUserID  uint64
+	ToUserID    uint64
+	Limit       uint32
+}
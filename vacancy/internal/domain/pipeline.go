@@ -0,0 +1,146 @@
+package domain
+
+import (
+	"errors"
+	"time"
+)
+
+// Terminal pipeline stages. Every vacancy's pipeline ends with them; only
+// the active stages before them are configurable. Candidates without a
+// recorded stage are in the first active stage.
+const (
+	StageHired    = "hired"
+	StageRejected = "rejected"
+)
+
+// ErrStageInUse is returned by storage when a pipeline change would drop a
+// stage that candidates are currently in.
+var ErrStageInUse = errors.New("pipeline stage in use")
+
+// PipelineStage is one active stage of a vacancy's hiring pipeline. Key is
+// what candidate_stages stores; Name is the label shown to recruiters.
+type PipelineStage struct {
+	Key  string
+	Name string
+}
+
+// DefaultPipelineStages is the template every vacancy starts with. Mirrors
+// the DEFAULT of vacancies.pipeline_stages.
+func DefaultPipelineStages() []PipelineStage {
+	return []PipelineStage{
+		{Key: "screening", Name: "Screening"},
+		{Key: "interview", Name: "Interview"},
+		{Key: "offer", Name: "Offer"},
+	}
+}
+
+// IsTerminalStage reports whether key is hired or rejected.
+func IsTerminalStage(key string) bool {
+	return key == StageHired || key == StageRejected
+}
+
+// HasPipelineStage reports whether key is one of stages or a terminal
+// stage.
+func HasPipelineStage(stages []PipelineStage, key string) bool {
+	if IsTerminalStage(key) {
+		return true
+	}
+	for _, s := range stages {
+		if s.Key == key {
+			return true
+		}
+	}
+	return false
+}
+
+// VacancyPipeline is the pipeline configuration together with where the
+// vacancy's candidates currently are. Counts is keyed by stage, terminal
+// stages included; stages nobody is in are absent.
+type VacancyPipeline struct {
+	VacancyID string
+	Stages    []PipelineStage
+	Headcount uint32
+	Hired     uint32
+	Counts    map[string]uint32
+}
+
+type GetVacancyPipelineInput struct {
+	VacancyID   string
+	OwnerUserID uint64
+	IsAdmin     bool
+}
+
+// SetPipelineStagesInput replaces the vacancy's active stages. An empty
+// Stages restores the default template.
+type SetPipelineStagesInput struct {
+	VacancyID   string
+	OwnerUserID uint64
+	IsAdmin     bool
+	Stages      []PipelineStage
+}
+
+type MoveCandidateStageInput struct {
+	VacancyID   string
+	CandidateID string
+	OwnerUserID uint64
+	IsAdmin     bool
+	ToStage     string
+	// Reason is required when rejecting and optional otherwise.
+	Reason string
+}
+
+// ChangeCandidateStageInput is the storage-level stage write. Like
+// ChangeVacancyStatusInput it is conditional on the candidate still being
+// in FromStage.
+type ChangeCandidateStageInput struct {
+	VacancyID   string
+	CandidateID string
+	OwnerUserID uint64
+	IsAdmin     bool
+	FromStage   string
+	ToStage     string
+	Reason      string
+}
+
+// ChangeCandidateStageResult is the recorded change plus the vacancy's
+// hire count and status right after it, read in the same transaction.
+// VacancyFilled is set when the hire reached the headcount and the same
+// transaction moved the vacancy to filled.
+type ChangeCandidateStageResult struct {
+	Change        CandidateStageChange
+	Hired         uint32
+	VacancyStatus string
+	VacancyFilled bool
+}
+
+// HeadcountReachedReason is the status history reason of the automatic move
+// to filled.
+const HeadcountReachedReason = "Headcount reached"
+
+// MoveCandidateStageResult reports the move and the vacancy status after
+// it; VacancyFilled is set when this hire reached the headcount and moved
+// the vacancy to filled.
+type MoveCandidateStageResult struct {
+	Change        CandidateStageChange
+	VacancyStatus string
+	VacancyFilled bool
+}
+
+// CandidateStageChange is one row of a candidate's stage history.
+type CandidateStageChange struct {
+	ID          uint64
+	VacancyID   string
+	CandidateID string
+	FromStage   string
+	ToStage     string
+	Reason      string
+	ChangedBy   uint64
+	ChangedAt   time.Time
+}
+
+type ListCandidateStageHistoryInput struct {
+	VacancyID   string
+	CandidateID string
+	OwnerUserID uint64
+	IsAdmin     bool
+}
@@ -0,0 +1,29 @@
+package domain
+
+import "time"
+
+// PurgeDeletedVacanciesInput selects soft-deleted vacancies whose grace
+// period is over: deleted at or before DeletedBefore, in UTC like the
+// zone-less deleted_at column.
+type PurgeDeletedVacanciesInput struct {
+	DeletedBefore time.Time
+	Limit         uint32
+}
+
+// PurgedCandidates counts what the resume service removed for one vacancy.
+type PurgedCandidates struct {
+	Candidates uint32
+	Resumes    uint32
+}
+
+// PurgeDeletedVacanciesResult summarises one retention run. Scanned =
+// Purged + Restored + Failed; Candidates and Resumes add up the resume
+// service's side of the purged vacancies.
+type PurgeDeletedVacanciesResult struct {
+	Scanned    uint32
+	Purged     uint32
+	Restored   uint32
+	Failed     uint32
+	Candidates uint32
+	Resumes    uint32
+}
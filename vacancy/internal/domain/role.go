@@ -0,0 +1,73 @@
+package domain
+
+import "time"
+
+// Role sources: how a vacancy's role was decided. RoleSourceKeyword marks a
+// fallback result the role reconciler retries against the LLM;
+// RoleSourceManual is a role pinned by the caller (Vacancy.RoleLocked) and
+// is never overwritten by classification.
+const (
+	RoleSourceLLM     = "llm"
+	RoleSourceKeyword = "keyword"
+	RoleSourceManual  = "manual"
+)
+
+// IsKnownRoleSource reports whether s is one of the RoleSource* values.
+func IsKnownRoleSource(s string) bool {
+	switch s {
+	case RoleSourceLLM, RoleSourceKeyword, RoleSourceManual:
+		return true
+	}
+	return false
+}
+
+// ReclassifyRolesInput selects the vacancies a reclassification run
+// re-sends to the LLM classifier.
+type ReclassifyRolesInput struct {
+	// IsAdmin gates the RPC; the background reconciler runs as the system
+	// and sets it.
+	IsAdmin bool
+	// Sources limits the run to roles of these sources; empty means
+	// RoleSourceKeyword. RoleSourceManual is rejected.
+	Sources []string
+	// Limit caps how many vacancies one run classifies.
+	Limit uint32
+	// ClassifiedBefore skips vacancies classified at or after it, so a
+	// periodic run does not retry the same rows back to back. Zero means
+	// no bound.
+	ClassifiedBefore time.Time
+}
+
+// RoleClassificationTarget is what reclassification needs of a vacancy.
+// Version pins the write: a vacancy edited meanwhile has already been
+// classified by its update and is left alone.
+type RoleClassificationTarget struct {
+	ID          string
+	Title       string
+	Description string
+	Role        string
+	Version     uint32
+}
+
+// SetVacancyRoleInput is the storage write of a reclassification.
+type SetVacancyRoleInput struct {
+	VacancyID string
+	Version   uint32
+	Role      string
+	Source    string
+}
+
+// ReclassifyRolesResult counts one reclassification run.
+type ReclassifyRolesResult struct {
+	// Scanned is how many vacancies were selected.
+	Scanned uint32
+	// Reclassified got an LLM role (same or different from before).
+	Reclassified uint32
+	// Changed is the subset of Reclassified whose role value changed.
+	Changed uint32
+	// Skipped were edited while the run was classifying them.
+	Skipped uint32
+	// LLMUnavailable is set when the run stopped at a classifier failure;
+	// the rest of the batch is left for the next run.
+	LLMUnavailable bool
+}
@@ -0,0 +1,47 @@
+package domain
+
+// Default scoring policy: what analysis applied to every vacancy before
+// policies became per-vacancy. Also the column defaults of
+// vacancies.score_*.
+const (
+	DefaultMustHavePenalty = 10
+	DefaultNiceToHaveBonus = 2
+	DefaultHireThreshold   = 75
+	DefaultMaybeThreshold  = 45
+)
+
+// ScoringPolicy tunes how strictly the analysis service scores candidates
+// against the vacancy's skills. Stored in the vacancies.score_* columns and
+// read by analysis when it scores a resume.
+type ScoringPolicy struct {
+	// MustHavePenalty is subtracted from the 0–100 score per missed
+	// must-have skill; NiceToHaveBonus is added per matched nice-to-have.
+	MustHavePenalty float32
+	NiceToHaveBonus float32
+	// HireThreshold and MaybeThreshold are the minimum scores for the
+	// "hire" and "maybe" recommendations; below MaybeThreshold it is "no".
+	HireThreshold  float32
+	MaybeThreshold float32
+	// RejectOnMissingMustHave makes any missed must-have skill a "no",
+	// whatever the score.
+	RejectOnMissingMustHave bool
+}
+
+// DefaultScoringPolicy returns the policy of vacancies that never set one.
+func DefaultScoringPolicy() ScoringPolicy {
+	return ScoringPolicy{
+		MustHavePenalty: DefaultMustHavePenalty,
+		NiceToHaveBonus: DefaultNiceToHaveBonus,
+		HireThreshold:   DefaultHireThreshold,
+		MaybeThreshold:  DefaultMaybeThreshold,
+	}
+}
+
+// OrDefault returns the policy p points to, or the default one when p is
+// nil.
+func (p *ScoringPolicy) OrDefault() ScoringPolicy {
+	if p == nil {
+		return DefaultScoringPolicy()
+	}
+	return *p
+}
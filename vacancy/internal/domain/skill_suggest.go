@@ -0,0 +1,20 @@
+package domain
+
+// Where a suggested skill matrix came from. The editor shows keyword
+// suggestions with a "подобрано по ключевым словам" hint so the recruiter
+// knows to review them more carefully.
+const (
+	SkillSourceLLM     = "llm"
+	SkillSourceKeyword = "keyword"
+)
+
+type SuggestSkillsInput struct {
+	OwnerUserID uint64
+	Title       string
+	Description string
+}
+
+type SuggestSkillsResult struct {
+	Skills []SkillWeight
+	Source string
+}
@@ -0,0 +1,150 @@
+package domain
+
+import (
+	"slices"
+	"strings"
+)
+
+// SkillEntry is one canonical skill of the taxonomy. Name is the spelling
+// stored on vacancies; NameRU is the Russian display name when it differs
+// (e.g. "English" / "Английский язык"). Aliases are every other accepted
+// spelling — abbreviations, RU/EN transliterations, versions ("k8s",
+// "golang", "постгрес").
+type SkillEntry struct {
+	Name     string
+	NameRU   string
+	Category string
+	Aliases  []string
+}
+
+// SkillSuggestion is one autocomplete hit: the canonical entry plus the
+// spelling the query matched, so the editor can show "k8s → Kubernetes".
+type SkillSuggestion struct {
+	Name     string
+	NameRU   string
+	Category string
+	Matched  string
+}
+
+// SkillTaxonomy is the in-memory canonical skill dictionary. Built once at
+// boot from the bundled seed and read-only afterwards, so it is safe to
+// share across goroutines.
+type SkillTaxonomy struct {
+	entries []SkillEntry
+	// byKey maps every normalized spelling (name, Russian name, aliases) to
+	// its entry index.
+	byKey map[string]int
+	// spellings maps a normalized key back to the spelling as written in
+	// the seed, for display.
+	spellings map[string]string
+	// keys is byKey's key set, sorted, for prefix search.
+	keys []string
+}
+
+// NewSkillTaxonomy indexes entries. A spelling claimed by two entries keeps
+// the first one; the seed loader rejects such files, so this only matters
+// for hand-built taxonomies in tests.
+func NewSkillTaxonomy(entries []SkillEntry) *SkillTaxonomy {
+	t := &SkillTaxonomy{entries: entries, byKey: make(map[string]int), spellings: make(map[string]string)}
+	for i, e := range entries {
+		for _, spelling := range e.Spellings() {
+			key := NormalizeSkillKey(spelling)
+			if key == "" {
+				continue
+			}
+			if _, dup := t.byKey[key]; dup {
+				continue
+			}
+			t.byKey[key] = i
+			t.spellings[key] = strings.TrimSpace(spelling)
+			t.keys = append(t.keys, key)
+		}
+	}
+	slices.Sort(t.keys)
+	return t
+}
+
+// Spellings returns the canonical name, the Russian name and all aliases.
+func (e SkillEntry) Spellings() []string {
+	out := make([]string, 0, len(e.Aliases)+2)
+	out = append(out, e.Name)
+	if e.NameRU != "" {
+		out = append(out, e.NameRU)
+	}
+	return append(out, e.Aliases...)
+}
+
+// NormalizeSkillKey is the lookup key for a skill spelling: lower-cased,
+// trimmed, inner whitespace collapsed. The analysis service applies the
+// same rule in SQL when it resolves aliases.
+func NormalizeSkillKey(s string) string {
+	return strings.Join(strings.Fields(strings.ToLower(s)), " ")
+}
+
+// Entries returns the taxonomy in seed order.
+func (t *SkillTaxonomy) Entries() []SkillEntry {
+	if t == nil {
+		return nil
+	}
+	return t.entries
+}
+
+// Canonical maps any known spelling to the canonical name. Unknown names
+// come back trimmed and otherwise untouched: the taxonomy is a dictionary,
+// not a whitelist. A nil taxonomy canonicalizes nothing.
+func (t *SkillTaxonomy) Canonical(name string) string {
+	name = strings.TrimSpace(name)
+	if t == nil {
+		return name
+	}
+	if i, ok := t.byKey[NormalizeSkillKey(name)]; ok {
+		return t.entries[i].Name
+	}
+	return name
+}
+
+// Autocomplete returns up to limit entries whose spellings start with
+// query, then — if there is room — entries whose spellings merely contain
+// it. Each entry appears once, with the first spelling that matched.
+func (t *SkillTaxonomy) Autocomplete(query string, limit int) []SkillSuggestion {
+	query = NormalizeSkillKey(query)
+	if t == nil || query == "" || limit <= 0 {
+		return nil
+	}
+
+	out := make([]SkillSuggestion, 0, limit)
+	seen := make(map[int]struct{}, limit)
+	add := func(key string) bool {
+		i := t.byKey[key]
+		if _, ok := seen[i]; !ok {
+			seen[i] = struct{}{}
+			e := t.entries[i]
+			out = append(out, SkillSuggestion{Name: e.Name, NameRU: e.NameRU, Category: e.Category, Matched: t.spellings[key]})
+		}
+		return len(out) < limit
+	}
+
+	start, _ := slices.BinarySearch(t.keys, query)
+	for _, key := range t.keys[start:] {
+		if !strings.HasPrefix(key, query) {
+			break
+		}
+		if !add(key) {
+			return out
+		}
+	}
+	for _, key := range t.keys {
+		if strings.HasPrefix(key, query) || !strings.Contains(key, query) {
+			continue
+		}
+		if !add(key) {
+			return out
+		}
+	}
+	return out
+}
+
+type AutocompleteSkillsInput struct {
+	Query string
+	Limit uint32
+}
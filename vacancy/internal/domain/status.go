@@ -0,0 +1,101 @@
+package domain
+
+import "time"
+
+// Vacancy lifecycle statuses. The values are stored verbatim in
+// vacancies.status and read by resume/analysis, so renaming one needs a
+// migration in every service that looks at the column.
+const (
+	StatusDraft    = "draft"
+	StatusOpen     = "open"
+	StatusPaused   = "paused"
+	StatusClosed   = "closed"
+	StatusFilled   = "filled"
+	StatusArchived = "archived"
+)
+
+// transitions is the lifecycle state machine: draft → open ↔ paused →
+// closed/filled, and any non-archived status → archived. Leaving archived is
+// not a plain transition — RestoreVacancy returns the vacancy to the status
+// it had before it was archived.
+var transitions = map[string][]string{
+	StatusDraft:  {StatusOpen, StatusArchived},
+	StatusOpen:   {StatusPaused, StatusClosed, StatusFilled, StatusArchived},
+	StatusPaused: {StatusOpen, StatusClosed, StatusFilled, StatusArchived},
+	StatusClosed: {StatusOpen, StatusArchived},
+	StatusFilled: {StatusArchived},
+}
+
+// IsKnownStatus reports whether status is one of the lifecycle statuses.
+func IsKnownStatus(status string) bool {
+	switch status {
+	case StatusDraft, StatusOpen, StatusPaused, StatusClosed, StatusFilled, StatusArchived:
+		return true
+	}
+	return false
+}
+
+// CanTransition reports whether the state machine allows moving a vacancy
+// from one status to another.
+func CanTransition(from, to string) bool {
+	for _, next := range transitions[from] {
+		if next == to {
+			return true
+		}
+	}
+	return false
+}
+
+type TransitionVacancyInput struct {
+	VacancyID   string
+	OwnerUserID uint64
+	IsAdmin     bool
+	ToStatus    string
+	// Reason is free-form text kept in the status history ("position
+	// filled internally", "budget frozen"). Optional.
+	Reason string
+}
+
+type RestoreVacancyInput struct {
+	VacancyID   string
+	OwnerUserID uint64
+	IsAdmin     bool
+	Reason      string
+}
+
+// ChangeVacancyStatusInput is the storage-level status write. The update is
+// conditional on the vacancy still being in FromStatus so two concurrent
+// transitions cannot both pass the state-machine check.
+type ChangeVacancyStatusInput struct {
+	VacancyID   string
+	OwnerUserID uint64
+	IsAdmin     bool
+	FromStatus  string
+	ToStatus    string
+	Reason      string
+}
+
+// VacancyStatusChange is one row of the status history. FromStatus is empty
+// for the entry recorded when the vacancy was created.
+type VacancyStatusChange struct {
+	ID         uint64
+	VacancyID  string
+	FromStatus string
+	ToStatus   string
+	Reason     string
+	ChangedBy  uint64
+	ChangedAt  time.Time
+}
+
+type ListVacancyStatusHistoryInput struct {
+	VacancyID   string
+	OwnerUserID uint64
+	IsAdmin     bool
+	Limit       uint32
+	Offset      uint32
+}
+
+type ListVacancyStatusHistoryResult struct {
+	Changes []VacancyStatusChange
+	Total   uint64
+}
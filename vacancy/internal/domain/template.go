@@ -0,0 +1,104 @@
+package domain
+
+import "time"
+
+// Template scopes. A personal template is visible to its owner only; an
+// org-wide one to every user of the deployment (there is a single hiring
+// organisation per installation).
+const (
+	TemplateScopePersonal = "personal"
+	TemplateScopeOrg      = "org"
+)
+
+// VacancyTemplate is a reusable vacancy blueprint: the scoring-relevant
+// fields of a vacancy frozen under a name. Templates are immutable — saving
+// the same vacancy again creates a new template.
+type VacancyTemplate struct {
+	ID          string
+	OwnerUserID uint64
+	Scope       string
+	// Name labels the template in pickers; defaults to the source title.
+	Name        string
+	Title       string
+	Description string
+	Role        string
+	// RoleSource is the source vacancy's, so vacancies opened from the
+	// template inherit it along with Role.
+	RoleSource string
+	Skills     []SkillWeight
+	// SourceVacancyID is the vacancy the template was saved from. Kept for
+	// reference only — the vacancy may since have changed or be archived.
+	SourceVacancyID string
+	CreatedAt       time.Time
+}
+
+// CreateTemplateInput saves the current state of a vacancy as a template.
+type CreateTemplateInput struct {
+	VacancyID   string
+	OwnerUserID uint64
+	IsAdmin     bool
+	Name        string
+	// Scope is TemplateScopePersonal or TemplateScopeOrg; empty means
+	// personal. Only admins publish org-wide templates.
+	Scope string
+}
+
+// InsertTemplateInput is the storage-level write: the usecase has already
+// copied the fields out of the source vacancy.
+type InsertTemplateInput struct {
+	OwnerUserID     uint64
+	Scope           string
+	Name            string
+	Title           string
+	Description     string
+	Role            string
+	RoleSource      string
+	Skills          []SkillWeight
+	SourceVacancyID string
+}
+
+type GetTemplateInput struct {
+	TemplateID  string
+	OwnerUserID uint64
+	IsAdmin     bool
+}
+
+type ListTemplatesInput struct {
+	OwnerUserID uint64
+	IsAdmin     bool
+	// Scope narrows the list to one scope; empty lists the caller's
+	// personal templates plus all org-wide ones.
+	Scope  string
+	Limit  uint32
+	Offset uint32
+}
+
+type ListTemplatesResult struct {
+	Templates []VacancyTemplate
+	Total     uint64
+}
+
+type DeleteTemplateInput struct {
+	TemplateID  string
+	OwnerUserID uint64
+	IsAdmin     bool
+}
+
+type CreateVacancyFromTemplateInput struct {
+	TemplateID  string
+	OwnerUserID uint64
+	IsAdmin     bool
+	// Title overrides the template title ("Go backend developer, Q3").
+	// Empty keeps the template's.
+	Title string
+}
+
+// CloneVacancyInput copies an existing vacancy, skills included, into a new
+// draft owned by the caller.
+type CloneVacancyInput struct {
+	VacancyID   string
+	OwnerUserID uint64
+	IsAdmin     bool
+	// Title overrides the copied title. Empty keeps the source's.
+	Title string
+}
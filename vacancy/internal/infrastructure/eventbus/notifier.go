@@ -0,0 +1,90 @@
+package eventbus
+
+import (
+	"context"
+	"log/slog"
+	"sync"
+	"time"
+
+	"github.com/artem13815/hr/vacancy/internal/usecase"
+)
+
+// Reconnect backoff of the LISTEN connection.
+const (
+	minListenBackoff = time.Second
+	maxListenBackoff = 30 * time.Second
+)
+
+// Listener is the persistence side: it holds a LISTEN connection and calls
+// wake per notification until ctx is done or the connection fails.
+type Listener interface {
+	ListenVacancyEvents(ctx context.Context, wake func()) error
+}
+
+// Notifier fans one LISTEN connection out to every open event stream, so
+// the number of subscribers does not cost database connections. It
+// implements usecase.EventNotifier.
+type Notifier struct {
+	listener Listener
+
+	mu   sync.Mutex
+	subs map[chan struct{}]struct{}
+}
+
+func NewNotifier(listener Listener) *Notifier {
+	return &Notifier{listener: listener, subs: make(map[chan struct{}]struct{})}
+}
+
+var _ usecase.EventNotifier = (*Notifier)(nil)
+
+// Subscribe registers a subscriber. The channel has room for one signal, so
+// a slow subscriber coalesces wake-ups instead of blocking the listener.
+func (n *Notifier) Subscribe() (<-chan struct{}, func()) {
+	ch := make(chan struct{}, 1)
+	n.mu.Lock()
+	n.subs[ch] = struct{}{}
+	n.mu.Unlock()
+	return ch, func() {
+		n.mu.Lock()
+		delete(n.subs, ch)
+		n.mu.Unlock()
+	}
+}
+
+// Run keeps the LISTEN connection up until ctx is done, reconnecting with
+// backoff. Every subscriber is woken after a connection failure: a
+// notification may have been lost, and a spurious read is cheap.
+func (n *Notifier) Run(ctx context.Context) {
+	backoff := minListenBackoff
+	for {
+		start := time.Now()
+		err := n.listener.ListenVacancyEvents(ctx, n.wake)
+		if ctx.Err() != nil {
+			return
+		}
+		slog.Warn("vacancy event listener stopped; reconnecting", "err", err, "backoff", backoff)
+		n.wake()
+
+		// A connection that stayed up for a while resets the backoff.
+		if time.Since(start) > maxListenBackoff {
+			backoff = minListenBackoff
+		}
+		select {
+		case <-ctx.Done():
+			return
+		case <-time.After(backoff):
+		}
+		backoff = min(backoff*2, maxListenBackoff)
+	}
+}
+
+func (n *Notifier) wake() {
+	n.mu.Lock()
+	defer n.mu.Unlock()
+	for ch := range n.subs {
+		select {
+		case ch <- struct{}{}:
+		default:
+		}
+	}
+}
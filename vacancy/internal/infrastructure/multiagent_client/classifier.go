@@ -41,3 +41,14 @@ func (c *Classifier) Classify(ctx context.Context, title, description string) (s
 	}
 	return resp.GetRole(), nil
 }
+
+// ListRoles fetches the classifier vocabulary. Failures are wrapped with
+// usecase.ErrLLMUnavailable like Classify's, so the usecase falls back to
+// the keyword detector's roles.
+func (c *Classifier) ListRoles(ctx context.Context) ([]string, error) {
+	resp, err := c.client.ListRoles(ctx, &pb.ListRolesRequest{})
+	if err != nil {
+		return nil, fmt.Errorf("%w: %v", usecase.ErrLLMUnavailable, err)
+	}
+	return resp.GetRoles(), nil
+}
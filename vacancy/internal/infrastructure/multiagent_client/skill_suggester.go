@@ -0,0 +1,46 @@
+package multiagent_client
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/artem13815/hr/vacancy/internal/domain"
+	pb "github.com/artem13815/hr/vacancy/internal/pb/multiagent_api"
+	"github.com/artem13815/hr/vacancy/internal/usecase"
+)
+
+// SkillSuggester implements usecase.SkillSuggester by calling
+// multiagent.SuggestSkills over gRPC. Shares the conn with Classifier.
+type SkillSuggester struct {
+	client pb.MultiAgentServiceClient
+}
+
+func NewSkillSuggester(client pb.MultiAgentServiceClient) *SkillSuggester {
+	return &SkillSuggester{client: client}
+}
+
+var _ usecase.SkillSuggester = (*SkillSuggester)(nil)
+
+// Suggest wraps every RPC failure with usecase.ErrLLMUnavailable, same as
+// Classify: the usecase has a single fallback path and does not care
+// whether multiagent was down, slow or returned an unparsable answer.
+func (c *SkillSuggester) Suggest(ctx context.Context, title, description string) ([]domain.SkillWeight, error) {
+	resp, err := c.client.SuggestSkills(ctx, &pb.SuggestSkillsRequest{
+		Title:       title,
+		Description: description,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("%w: %v", usecase.ErrLLMUnavailable, err)
+	}
+
+	out := make([]domain.SkillWeight, 0, len(resp.GetSkills()))
+	for _, s := range resp.GetSkills() {
+		out = append(out, domain.SkillWeight{
+			Name:       s.GetName(),
+			Weight:     s.GetWeight(),
+			MustHave:   s.GetMustHave(),
+			NiceToHave: s.GetNiceToHave(),
+		})
+	}
+	return out, nil
+}
@@ -0,0 +1,22 @@
+package persistence
+
+// viewAccess renders the read-access predicate for the vacancy row named
+// table: admins, the owner and every collaborator pass. isAdmin and userID
+// are the placeholders the surrounding query binds them to. resume and
+// analysis repeat the same shape against vacancy_collaborators.
+// Soft-deleted vacancies fail it for everyone, admins included.
+func viewAccess(table, isAdmin, userID string) string {
+	return `(` + table + `.deleted_at IS NULL AND (` + isAdmin + ` OR ` + table + `.owner_user_id = ` + userID + ` OR EXISTS (
+        SELECT 1 FROM vacancy_collaborators vc
+        WHERE vc.vacancy_id = ` + table + `.id AND vc.user_id = ` + userID + `)))`
+}
+
+// editAccess is viewAccess narrowed to editors. The usecase turns viewers
+// away with ErrForbidden first, so a write that fails it lost a race with a
+// revoke or a delete.
+func editAccess(table, isAdmin, userID string) string {
+	return `(` + table + `.deleted_at IS NULL AND (` + isAdmin + ` OR ` + table + `.owner_user_id = ` + userID + ` OR EXISTS (
+        SELECT 1 FROM vacancy_collaborators vc
+        WHERE vc.vacancy_id = ` + table + `.id AND vc.user_id = ` + userID + `
+          AND vc.role = 'editor')))`
+}
@@ -0,0 +1,66 @@
+package persistence
+
+import (
+	"context"
+	"errors"
+
+	"github.com/artem13815/hr/vacancy/internal/domain"
+	"github.com/jackc/pgx/v5"
+)
+
+// ChangeVacancyStatus moves the vacancy from in.FromStatus to in.ToStatus and
+// records the change in vacancy_status_history and the event outbox, all in
+// one transaction.
+// Returns (nil, nil) when no row matched — the vacancy is gone, not ours, or
+// its status changed since the usecase validated the transition.
+func (s *VacancyStorage) ChangeVacancyStatus(ctx context.Context, in domain.ChangeVacancyStatusInput) (*domain.Vacancy, error) {
+	tx, err := s.db.Begin(ctx)
+	if err != nil {
+		return nil, err
+	}
+	defer tx.Rollback(ctx)
+
+	var vacancy domain.Vacancy
+	err = tx.QueryRow(ctx, `
+UPDATE vacancies
+SET status = $1,
+    updated_at = NOW()
+WHERE id = $2 AND status = $3 AND `+editAccess("vacancies", "$4", "$5")+`
+RETURNING `+vacancyColumns+`
+`, in.ToStatus, in.VacancyID, in.FromStatus, in.IsAdmin, in.OwnerUserID).Scan(vacancyFields(&vacancy)...)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, nil
+		}
+		return nil, err
+	}
+
+	if err := insertStatusChange(ctx, tx, vacancy.ID, in.FromStatus, in.ToStatus, in.Reason, in.OwnerUserID); err != nil {
+		return nil, err
+	}
+
+	skills, err := loadSkills(ctx, tx, vacancy.ID)
+	if err != nil {
+		return nil, err
+	}
+	vacancy.Skills = skills
+
+	err = appendEvent(ctx, tx, domain.VacancyEvent{
+		Type:        domain.EventVacancyStatusChanged,
+		VacancyID:   vacancy.ID,
+		OwnerUserID: vacancy.OwnerUserID,
+		ActorUserID: in.OwnerUserID,
+		Version:     vacancy.Version,
+		Status:      in.ToStatus,
+		OldStatus:   in.FromStatus,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	if err := tx.Commit(ctx); err != nil {
+		return nil, err
+	}
+
+	return &vacancy, nil
+}
@@ -0,0 +1,84 @@
+package persistence
+
+import (
+	"context"
+
+	"github.com/artem13815/hr/vacancy/internal/domain"
+)
+
+// AddCollaborator grants in.UserID access to the vacancy. Re-adding an
+// existing collaborator changes the role in place. The caller's right to
+// share the vacancy is checked by the usecase.
+func (s *VacancyStorage) AddCollaborator(ctx context.Context, in domain.AddCollaboratorInput) (*domain.Collaborator, error) {
+	var c domain.Collaborator
+	err := s.db.QueryRow(ctx, `
+INSERT INTO vacancy_collaborators (vacancy_id, user_id, role, added_by)
+VALUES ($1, $2, $3, $4)
+ON CONFLICT (vacancy_id, user_id) DO UPDATE
+SET role = EXCLUDED.role,
+    added_by = EXCLUDED.added_by
+RETURNING vacancy_id, user_id, role, added_by, created_at
+`, in.VacancyID, in.UserID, in.Role, in.OwnerUserID).Scan(
+		&c.VacancyID,
+		&c.UserID,
+		&c.Role,
+		&c.AddedBy,
+		&c.CreatedAt,
+	)
+	if err != nil {
+		return nil, err
+	}
+	return &c, nil
+}
+
+// RemoveCollaborator revokes a grant. Returns false when there was none.
+func (s *VacancyStorage) RemoveCollaborator(ctx context.Context, vacancyID string, userID uint64) (bool, error) {
+	cmd, err := s.db.Exec(ctx, `
+DELETE FROM vacancy_collaborators
+WHERE vacancy_id = $1 AND user_id = $2
+`, vacancyID, userID)
+	if err != nil {
+		return false, err
+	}
+	return cmd.RowsAffected() > 0, nil
+}
+
+// ListCollaborators returns the vacancy's grants, oldest first.
+func (s *VacancyStorage) ListCollaborators(ctx context.Context, vacancyID string) ([]domain.Collaborator, error) {
+	rows, err := s.db.Query(ctx, `
+SELECT vacancy_id, user_id, role, added_by, created_at
+FROM vacancy_collaborators
+WHERE vacancy_id = $1
+ORDER BY created_at ASC, user_id ASC
+`, vacancyID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	out := make([]domain.Collaborator, 0)
+	for rows.Next() {
+		var c domain.Collaborator
+		if err := rows.Scan(&c.VacancyID, &c.UserID, &c.Role, &c.AddedBy, &c.CreatedAt); err != nil {
+			return nil, err
+		}
+		out = append(out, c)
+	}
+	if rows.Err() != nil {
+		return nil, rows.Err()
+	}
+	return out, nil
+}
+
+// CanEditVacancy reports whether userID may edit the vacancy as an editor
+// collaborator; owners and admins are settled by the usecase without it.
+func (s *VacancyStorage) CanEditVacancy(ctx context.Context, vacancyID string, userID uint64) (bool, error) {
+	var ok bool
+	err := s.db.QueryRow(ctx, `
+SELECT EXISTS (
+    SELECT 1 FROM vacancies
+    WHERE id = $1 AND `+editAccess("vacancies", "false", "$2")+`
+)
+`, vacancyID, userID).Scan(&ok)
+	return ok, err
+}
@@ -0,0 +1,42 @@
+package persistence
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/artem13815/hr/vacancy/internal/domain"
+)
+
+func (s *VacancyStorage) CreateTemplate(ctx context.Context, in domain.InsertTemplateInput) (*domain.VacancyTemplate, error) {
+	id, err := newID()
+	if err != nil {
+		return nil, fmt.Errorf("failed to generate id: %w", err)
+	}
+	skills, err := marshalSkills(in.Skills)
+	if err != nil {
+		return nil, err
+	}
+
+	t := domain.VacancyTemplate{
+		ID:              id,
+		OwnerUserID:     in.OwnerUserID,
+		Scope:           in.Scope,
+		Name:            in.Name,
+		Title:           in.Title,
+		Description:     in.Description,
+		Role:            in.Role,
+		RoleSource:      in.RoleSource,
+		Skills:          in.Skills,
+		SourceVacancyID: in.SourceVacancyID,
+	}
+	err = s.db.QueryRow(ctx, `
+INSERT INTO vacancy_templates (id, owner_user_id, scope, name, title, description, role, skills, source_vacancy_id, role_source)
+VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10)
+RETURNING created_at
+`, id, in.OwnerUserID, in.Scope, in.Name, in.Title, in.Description, in.Role, skills, in.SourceVacancyID, in.RoleSource).Scan(&t.CreatedAt)
+	if err != nil {
+		return nil, err
+	}
+
+	return &t, nil
+}
@@ -0,0 +1,34 @@
+package persistence
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/artem13815/hr/vacancy/internal/domain"
+)
+
+// CreateVacancies inserts every vacancy in one transaction: either all rows
+// land or none does. Backs all-or-nothing ImportVacancies; the result is
+// in input order.
+func (s *VacancyStorage) CreateVacancies(ctx context.Context, ins []domain.CreateVacancyInput) ([]*domain.Vacancy, error) {
+	tx, err := s.db.Begin(ctx)
+	if err != nil {
+		return nil, err
+	}
+	defer tx.Rollback(ctx)
+
+	out := make([]*domain.Vacancy, 0, len(ins))
+	for i, in := range ins {
+		vacancy, err := insertVacancy(ctx, tx, in)
+		if err != nil {
+			return nil, fmt.Errorf("insert vacancy %d: %w", i+1, err)
+		}
+		out = append(out, vacancy)
+	}
+
+	if err := tx.Commit(ctx); err != nil {
+		return nil, err
+	}
+
+	return out, nil
+}
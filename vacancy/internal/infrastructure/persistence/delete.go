@@ -0,0 +1,104 @@
+package persistence
+
+import (
+	"context"
+	"errors"
+
+	"github.com/artem13815/hr/vacancy/internal/domain"
+	"github.com/jackc/pgx/v5"
+)
+
+// DeleteVacancy soft-deletes the vacancy and emits VacancyDeleted. Returns
+// false when it is already deleted or the caller cannot edit it; the
+// owner-only rule is the usecase's.
+func (s *VacancyStorage) DeleteVacancy(ctx context.Context, in domain.DeleteVacancyInput) (bool, error) {
+	tx, err := s.db.Begin(ctx)
+	if err != nil {
+		return false, err
+	}
+	defer tx.Rollback(ctx)
+
+	var vacancy domain.Vacancy
+	err = tx.QueryRow(ctx, `
+UPDATE vacancies
+SET deleted_at = NOW(),
+    deleted_by = $1,
+    updated_at = NOW()
+WHERE id = $2 AND `+editAccess("vacancies", "$3", "$1")+`
+RETURNING owner_user_id, version, status
+`, in.OwnerUserID, in.VacancyID, in.IsAdmin).Scan(&vacancy.OwnerUserID, &vacancy.Version, &vacancy.Status)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return false, nil
+		}
+		return false, err
+	}
+
+	err = appendEvent(ctx, tx, domain.VacancyEvent{
+		Type:        domain.EventVacancyDeleted,
+		VacancyID:   in.VacancyID,
+		OwnerUserID: vacancy.OwnerUserID,
+		ActorUserID: in.OwnerUserID,
+		Version:     vacancy.Version,
+		Status:      vacancy.Status,
+	})
+	if err != nil {
+		return false, err
+	}
+
+	if err := tx.Commit(ctx); err != nil {
+		return false, err
+	}
+	return true, nil
+}
+
+// UndeleteVacancy clears a soft delete and emits VacancyRestored. Returns
+// (nil, nil) when the vacancy is not deleted, was deleted before
+// in.DeletedAfter or its purge has already started — the grace window is
+// over even if the retention job has not got to it yet.
+func (s *VacancyStorage) UndeleteVacancy(ctx context.Context, in domain.UndeleteVacancyInput) (*domain.Vacancy, error) {
+	tx, err := s.db.Begin(ctx)
+	if err != nil {
+		return nil, err
+	}
+	defer tx.Rollback(ctx)
+
+	var vacancy domain.Vacancy
+	err = tx.QueryRow(ctx, `
+UPDATE vacancies
+SET deleted_at = NULL,
+    deleted_by = 0,
+    updated_at = NOW()
+WHERE id = $1 AND deleted_at > $2 AND purge_started_at IS NULL
+RETURNING `+vacancyColumns+`
+`, in.VacancyID, in.DeletedAfter).Scan(vacancyFields(&vacancy)...)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, nil
+		}
+		return nil, err
+	}
+
+	skills, err := loadSkills(ctx, tx, vacancy.ID)
+	if err != nil {
+		return nil, err
+	}
+	vacancy.Skills = skills
+
+	err = appendEvent(ctx, tx, domain.VacancyEvent{
+		Type:        domain.EventVacancyRestored,
+		VacancyID:   vacancy.ID,
+		OwnerUserID: vacancy.OwnerUserID,
+		ActorUserID: in.ActorUserID,
+		Version:     vacancy.Version,
+		Status:      vacancy.Status,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	if err := tx.Commit(ctx); err != nil {
+		return nil, err
+	}
+	return &vacancy, nil
+}
@@ -0,0 +1,18 @@
+package persistence
+
+import (
+	"context"
+)
+
+// DeleteTemplate removes the template. Returns false when it was already
+// gone. The caller's right to delete it is checked by the usecase.
+func (s *VacancyStorage) DeleteTemplate(ctx context.Context, templateID string) (bool, error) {
+	cmd, err := s.db.Exec(ctx, `
+DELETE FROM vacancy_templates
+WHERE id = $1
+`, templateID)
+	if err != nil {
+		return false, err
+	}
+	return cmd.RowsAffected() > 0, nil
+}
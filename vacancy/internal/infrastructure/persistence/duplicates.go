@@ -0,0 +1,41 @@
+package persistence
+
+import (
+	"context"
+
+	"github.com/artem13815/hr/vacancy/internal/domain"
+)
+
+// ListDuplicateCandidates returns the open vacancies the caller can view
+// whose lower-cased title is trigram-similar to title (the pg_trgm `%`
+// operator, similarity ≥ 0.3, served by idx_vacancies_title_trgm), most
+// similar first, with their skill names for the usecase to compare. The
+// caller learns nothing about vacancies GetVacancy would hide from it.
+func (s *VacancyStorage) ListDuplicateCandidates(ctx context.Context, title string, ownerUserID uint64, isAdmin bool, limit int) ([]domain.DuplicateCandidate, error) {
+	rows, err := s.db.Query(ctx, `
+SELECT v.id, v.title, v.owner_user_id, similarity(lower(v.title), $1)::real AS title_similarity,
+       COALESCE(array_agg(lower(s.name) ORDER BY s.position) FILTER (WHERE s.name IS NOT NULL), '{}')
+FROM vacancies v
+LEFT JOIN vacancy_skills s ON s.vacancy_id = v.id
+WHERE lower(v.title) % $1
+  AND v.status = 'open'
+  AND `+viewAccess("v", "$2", "$3")+`
+GROUP BY v.id
+ORDER BY title_similarity DESC, v.id
+LIMIT $4
+`, title, isAdmin, ownerUserID, limit)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var out []domain.DuplicateCandidate
+	for rows.Next() {
+		var c domain.DuplicateCandidate
+		if err := rows.Scan(&c.VacancyID, &c.Title, &c.OwnerUserID, &c.TitleSimilarity, &c.Skills); err != nil {
+			return nil, err
+		}
+		out = append(out, c)
+	}
+	return out, rows.Err()
+}
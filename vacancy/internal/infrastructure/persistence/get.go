@@ -11,21 +11,11 @@ import (
 func (s *VacancyStorage) GetVacancy(ctx context.Context, vacancyID string, ownerUserID uint64, isAdmin bool) (*domain.Vacancy, error) {
 	var vacancy domain.Vacancy
 	err := s.db.QueryRow(ctx, `
-SELECT id, owner_user_id, title, description, role, status, version, created_at, updated_at
+SELECT `+vacancyColumns+`
 FROM vacancies
 WHERE id = $1
-  AND ($2 OR owner_user_id = $3)
-`, vacancyID, isAdmin, ownerUserID).Scan(
-		&vacancy.ID,
-		&vacancy.OwnerUserID,
-		&vacancy.Title,
-		&vacancy.Description,
-		&vacancy.Role,
-		&vacancy.Status,
-		&vacancy.Version,
-		&vacancy.CreatedAt,
-		&vacancy.UpdatedAt,
-	)
+  AND `+viewAccess("vacancies", "$2", "$3")+`
+`, vacancyID, isAdmin, ownerUserID).Scan(vacancyFields(&vacancy)...)
 	if err != nil {
 		if errors.Is(err, pgx.ErrNoRows) {
 			return nil, nil
@@ -0,0 +1,46 @@
+package persistence
+
+import (
+	"context"
+	"errors"
+
+	"github.com/artem13815/hr/vacancy/internal/domain"
+	"github.com/jackc/pgx/v5"
+)
+
+// GetTemplate returns nil, nil when the template does not exist or is
+// another user's personal template — same contract as GetVacancy.
+func (s *VacancyStorage) GetTemplate(ctx context.Context, in domain.GetTemplateInput) (*domain.VacancyTemplate, error) {
+	var t domain.VacancyTemplate
+	var skills []byte
+	err := s.db.QueryRow(ctx, `
+SELECT id, owner_user_id, scope, name, title, description, role, skills, source_vacancy_id, created_at, role_source
+FROM vacancy_templates
+WHERE id = $1
+  AND ($2 OR scope = 'org' OR owner_user_id = $3)
+`, in.TemplateID, in.IsAdmin, in.OwnerUserID).Scan(
+		&t.ID,
+		&t.OwnerUserID,
+		&t.Scope,
+		&t.Name,
+		&t.Title,
+		&t.Description,
+		&t.Role,
+		&skills,
+		&t.SourceVacancyID,
+		&t.CreatedAt,
+		&t.RoleSource,
+	)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, nil
+		}
+		return nil, err
+	}
+
+	if t.Skills, err = unmarshalSkills(skills); err != nil {
+		return nil, err
+	}
+
+	return &t, nil
+}
@@ -0,0 +1,43 @@
+package persistence
+
+import (
+	"context"
+	"errors"
+
+	"github.com/artem13815/hr/vacancy/internal/domain"
+	"github.com/jackc/pgx/v5"
+)
+
+// GetVacancyVersion returns one snapshot, or (nil, nil) when either the
+// vacancy is not visible to the caller or the version was never recorded.
+func (s *VacancyStorage) GetVacancyVersion(ctx context.Context, in domain.GetVacancyVersionInput) (*domain.VacancyVersion, error) {
+	var vv domain.VacancyVersion
+	var skills []byte
+	err := s.db.QueryRow(ctx, `
+SELECT vv.vacancy_id, vv.version, vv.title, vv.description, vv.role, vv.skills, vv.created_by, vv.created_at
+FROM vacancy_versions vv
+JOIN vacancies v ON v.id = vv.vacancy_id
+WHERE vv.vacancy_id = $1 AND vv.version = $2
+  AND `+viewAccess("v", "$3", "$4")+`
+`, in.VacancyID, in.Version, in.IsAdmin, in.OwnerUserID).Scan(
+		&vv.VacancyID,
+		&vv.Version,
+		&vv.Title,
+		&vv.Description,
+		&vv.Role,
+		&skills,
+		&vv.CreatedBy,
+		&vv.CreatedAt,
+	)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, nil
+		}
+		return nil, err
+	}
+
+	if vv.Skills, err = unmarshalSkills(skills); err != nil {
+		return nil, err
+	}
+	return &vv, nil
+}
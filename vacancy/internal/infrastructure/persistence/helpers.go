@@ -4,6 +4,8 @@ import (
 	"context"
 	"crypto/rand"
 	"encoding/hex"
+	"encoding/json"
+	"fmt"
 
 	"github.com/artem13815/hr/vacancy/internal/domain"
 	"github.com/jackc/pgx/v5"
@@ -17,6 +19,45 @@ func newID() (string, error) {
 	return hex.EncodeToString(b), nil
 }
 
+// vacancyColumns is the column list every vacancy read selects, in the
+// order vacancyFields scans it. Keep the two in sync.
+const vacancyColumns = `id, owner_user_id, title, description, role, status, version, created_at, updated_at, is_public,
+    location, remote_policy, salary_min, salary_max, salary_currency, employment_type, seniority, headcount,
+    role_source, role_classified_at, role_locked,
+    score_must_have_penalty, score_nice_to_have_bonus, score_hire_threshold, score_maybe_threshold,
+    score_reject_missing_must_have`
+
+func vacancyFields(v *domain.Vacancy) []any {
+	return []any{
+		&v.ID,
+		&v.OwnerUserID,
+		&v.Title,
+		&v.Description,
+		&v.Role,
+		&v.Status,
+		&v.Version,
+		&v.CreatedAt,
+		&v.UpdatedAt,
+		&v.IsPublic,
+		&v.Attributes.Location,
+		&v.Attributes.RemotePolicy,
+		&v.Attributes.SalaryMin,
+		&v.Attributes.SalaryMax,
+		&v.Attributes.SalaryCurrency,
+		&v.Attributes.EmploymentType,
+		&v.Attributes.Seniority,
+		&v.Attributes.Headcount,
+		&v.RoleSource,
+		&v.RoleClassifiedAt,
+		&v.RoleLocked,
+		&v.ScoringPolicy.MustHavePenalty,
+		&v.ScoringPolicy.NiceToHaveBonus,
+		&v.ScoringPolicy.HireThreshold,
+		&v.ScoringPolicy.MaybeThreshold,
+		&v.ScoringPolicy.RejectOnMissingMustHave,
+	}
+}
+
 func loadSkills(ctx context.Context, q queryer, vacancyID string) ([]domain.SkillWeight, error) {
 	rows, err := q.Query(ctx, `
 SELECT name, weight, must_have, nice_to_have
@@ -44,6 +85,178 @@ ORDER BY position ASC
 	return skills, nil
 }
 
+// loadSkillsBatch loads the skills of several vacancies in one round-trip,
+// keyed by vacancy id and kept in position order. Vacancies without skills
+// are absent from the map.
+func loadSkillsBatch(ctx context.Context, q queryer, vacancyIDs []string) (map[string][]domain.SkillWeight, error) {
+	out := make(map[string][]domain.SkillWeight, len(vacancyIDs))
+	if len(vacancyIDs) == 0 {
+		return out, nil
+	}
+
+	rows, err := q.Query(ctx, `
+SELECT vacancy_id, name, weight, must_have, nice_to_have
+FROM vacancy_skills
+WHERE vacancy_id = ANY($1)
+ORDER BY vacancy_id, position ASC
+`, vacancyIDs)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		var vacancyID string
+		var s domain.SkillWeight
+		if err := rows.Scan(&vacancyID, &s.Name, &s.Weight, &s.MustHave, &s.NiceToHave); err != nil {
+			return nil, err
+		}
+		out[vacancyID] = append(out[vacancyID], s)
+	}
+	if rows.Err() != nil {
+		return nil, rows.Err()
+	}
+
+	return out, nil
+}
+
 type queryer interface {
 	Query(ctx context.Context, sql string, args ...any) (pgx.Rows, error)
 }
+
+// skillJSON is the on-disk shape of one skill inside vacancy_versions.skills.
+// Kept separate from domain.SkillWeight so the JSON field names are a
+// persistence decision, not a domain one.
+type skillJSON struct {
+	Name       string  `json:"name"`
+	Weight     float32 `json:"weight"`
+	MustHave   bool    `json:"must_have"`
+	NiceToHave bool    `json:"nice_to_have"`
+}
+
+func marshalSkills(skills []domain.SkillWeight) ([]byte, error) {
+	out := make([]skillJSON, 0, len(skills))
+	for _, s := range skills {
+		out = append(out, skillJSON{Name: s.Name, Weight: s.Weight, MustHave: s.MustHave, NiceToHave: s.NiceToHave})
+	}
+	b, err := json.Marshal(out)
+	if err != nil {
+		return nil, fmt.Errorf("marshal skills: %w", err)
+	}
+	return b, nil
+}
+
+func unmarshalSkills(raw []byte) ([]domain.SkillWeight, error) {
+	var in []skillJSON
+	if err := json.Unmarshal(raw, &in); err != nil {
+		return nil, fmt.Errorf("unmarshal skills: %w", err)
+	}
+	out := make([]domain.SkillWeight, 0, len(in))
+	for _, s := range in {
+		out = append(out, domain.SkillWeight{Name: s.Name, Weight: s.Weight, MustHave: s.MustHave, NiceToHave: s.NiceToHave})
+	}
+	return out, nil
+}
+
+// insertVersionSnapshot records v as an immutable vacancy_versions row.
+// Callers run it inside the transaction that wrote v, so a version number
+// can never exist on the vacancy without its snapshot.
+func insertVersionSnapshot(ctx context.Context, tx pgx.Tx, v *domain.Vacancy, createdBy uint64) error {
+	skills, err := marshalSkills(v.Skills)
+	if err != nil {
+		return err
+	}
+	_, err = tx.Exec(ctx, `
+INSERT INTO vacancy_versions (vacancy_id, version, title, description, role, skills, created_by, created_at)
+VALUES ($1, $2, $3, $4, $5, $6, $7, $8)
+`, v.ID, v.Version, v.Title, v.Description, v.Role, skills, createdBy, v.UpdatedAt)
+	if err != nil {
+		return fmt.Errorf("insert vacancy version: %w", err)
+	}
+	return nil
+}
+
+// insertStatusChange appends one row to vacancy_status_history. Runs inside
+// the caller's transaction so the history can never disagree with
+// vacancies.status.
+func insertStatusChange(ctx context.Context, tx pgx.Tx, vacancyID, from, to, reason string, changedBy uint64) error {
+	_, err := tx.Exec(ctx, `
+INSERT INTO vacancy_status_history (vacancy_id, from_status, to_status, reason, changed_by)
+VALUES ($1, $2, $3, $4, $5)
+`, vacancyID, from, to, reason, changedBy)
+	if err != nil {
+		return fmt.Errorf("insert status change: %w", err)
+	}
+	return nil
+}
+
+// insertVacancy writes a vacancy with its skills, the v1 snapshot and the
+// initial status history entry inside the caller's transaction.
+func insertVacancy(ctx context.Context, tx pgx.Tx, in domain.CreateVacancyInput) (*domain.Vacancy, error) {
+	id, err := newID()
+	if err != nil {
+		return nil, fmt.Errorf("failed to generate id: %w", err)
+	}
+
+	policy := in.ScoringPolicy.OrDefault()
+	_, err = tx.Exec(ctx, `
+INSERT INTO vacancies (id, owner_user_id, title, description, role, status, version,
+                       location, remote_policy, salary_min, salary_max, salary_currency, employment_type, seniority, headcount,
+                       role_source, role_locked,
+                       score_must_have_penalty, score_nice_to_have_bonus, score_hire_threshold, score_maybe_threshold,
+                       score_reject_missing_must_have)
+VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12, $13, $14, $15, $16, $17, $18, $19, $20, $21, $22)
+`, id, in.OwnerUserID, in.Title, in.Description, in.Role, in.Status, 1,
+		in.Attributes.Location, in.Attributes.RemotePolicy, in.Attributes.SalaryMin, in.Attributes.SalaryMax,
+		in.Attributes.SalaryCurrency, in.Attributes.EmploymentType, in.Attributes.Seniority, in.Attributes.Headcount,
+		in.RoleSource, in.RoleLocked,
+		policy.MustHavePenalty, policy.NiceToHaveBonus, policy.HireThreshold, policy.MaybeThreshold,
+		policy.RejectOnMissingMustHave)
+	if err != nil {
+		return nil, err
+	}
+
+	for i, skill := range in.Skills {
+		_, err = tx.Exec(ctx, `
+INSERT INTO vacancy_skills (vacancy_id, position, name, weight, must_have, nice_to_have)
+VALUES ($1, $2, $3, $4, $5, $6)
+`, id, i, skill.Name, skill.Weight, skill.MustHave, skill.NiceToHave)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	var vacancy domain.Vacancy
+	err = tx.QueryRow(ctx, `
+SELECT `+vacancyColumns+`
+FROM vacancies
+WHERE id = $1
+`, id).Scan(vacancyFields(&vacancy)...)
+	if err != nil {
+		return nil, err
+	}
+
+	vacancy.Skills = in.Skills
+
+	if err := insertVersionSnapshot(ctx, tx, &vacancy, in.OwnerUserID); err != nil {
+		return nil, err
+	}
+
+	if err := insertStatusChange(ctx, tx, vacancy.ID, "", vacancy.Status, "", in.OwnerUserID); err != nil {
+		return nil, err
+	}
+
+	err = appendEvent(ctx, tx, domain.VacancyEvent{
+		Type:        domain.EventVacancyCreated,
+		VacancyID:   vacancy.ID,
+		OwnerUserID: vacancy.OwnerUserID,
+		ActorUserID: in.OwnerUserID,
+		Version:     vacancy.Version,
+		Status:      vacancy.Status,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &vacancy, nil
+}
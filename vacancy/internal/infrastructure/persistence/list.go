@@ -2,55 +2,198 @@ package persistence
 
 import (
 	"context"
-	"strings"
+	"time"
 
 	"github.com/artem13815/hr/vacancy/internal/domain"
 )
 
+// listFilter is the WHERE clause shared by the count and page queries.
+// q.query is NULL without a search string, which turns the full-text
+// predicate off; every facet is skipped when its parameter is empty/NULL.
+var listFilter = `
+WHERE ` + viewAccess("v", "$1", "$2") + `
+  AND (q.query IS NULL OR v.search_vector @@ q.query)
+  AND (COALESCE(cardinality($4::text[]), 0) = 0 OR v.status = ANY($4))
+  AND (COALESCE(cardinality($5::text[]), 0) = 0 OR v.role = ANY($5))
+  AND ($6::timestamp IS NULL OR v.created_at >= $6)
+  AND ($7::timestamp IS NULL OR v.created_at < $7)
+  AND (COALESCE(cardinality($8::text[]), 0) = 0 OR EXISTS (
+        SELECT 1 FROM vacancy_skills s
+        WHERE s.vacancy_id = v.id AND lower(s.name) = ANY($8)))
+  AND (COALESCE(cardinality($9::text[]), 0) = 0 OR lower(v.location) = ANY($9))
+  AND (COALESCE(cardinality($10::text[]), 0) = 0 OR v.remote_policy = ANY($10))
+  AND (COALESCE(cardinality($11::text[]), 0) = 0 OR v.employment_type = ANY($11))
+  AND (COALESCE(cardinality($12::text[]), 0) = 0 OR v.seniority = ANY($12))
+  AND ($14 = '' OR v.salary_currency = $14)
+  AND ($13::bigint = 0 OR (CASE WHEN v.salary_max > 0 THEN v.salary_max ELSE v.salary_min END) >= $13)
+`
+
+// listQuery parses the search string with both text-search configs the
+// search_vector column is built from. websearch_to_tsquery never fails on
+// user input, so raw queries can be passed straight through.
+const listQuery = `
+WITH q AS (
+    SELECT CASE WHEN $3 = '' THEN NULL
+                ELSE websearch_to_tsquery('russian', $3) || websearch_to_tsquery('english', $3)
+           END AS query
+)
+`
+
+// Headline options: the title is highlighted whole, the description is cut
+// down to the best-matching fragments.
+const (
+	headlineMarkers        = `StartSel="` + domain.HighlightStart + `", StopSel="` + domain.HighlightStop + `"`
+	titleHeadlineOptions   = `HighlightAll=true, ` + headlineMarkers
+	snippetHeadlineOptions = `MaxFragments=2, MaxWords=30, MinWords=10, FragmentDelimiter=" … ", ` + headlineMarkers
+)
+
+// estimatedTotalCap bounds the COUNT behind TotalEstimated: counting stops
+// after this many matches and the result is reported as a lower bound.
+const estimatedTotalCap = 10000
+
+// Sort orders and keyset predicates. Without a search query the listing is
+// ordered by recency only, so the predicate stays a plain row comparison on
+// (created_at, id) that idx_vacancies_created_at_id can serve; with a query
+// rank leads and has to be part of the key.
+const (
+	browseOrder = `v.created_at DESC, v.id DESC`
+	searchOrder = `rank DESC, v.created_at DESC, v.id DESC`
+	browseAfter = `  AND (v.created_at, v.id) < ($19, $20)
+`
+	searchAfter = `  AND (COALESCE(ts_rank(v.search_vector, q.query), 0), v.created_at, v.id) < ($19::real, $20, $21)
+`
+)
+
 func (s *VacancyStorage) ListVacancies(ctx context.Context, in domain.ListVacanciesInput) (*domain.ListVacanciesResult, error) {
-	q := strings.TrimSpace(in.Query)
-	pattern := "%" + q + "%"
+	args := []any{
+		in.IsAdmin, in.OwnerUserID, in.Query,
+		in.Statuses, in.Roles, timeOrNil(in.CreatedFrom), timeOrNil(in.CreatedTo), in.Skills,
+		in.Locations, in.RemotePolicies, in.EmploymentTypes, in.Seniorities, in.SalaryAtLeast, in.SalaryCurrency,
+	}
 
-	var total uint64
-	err := s.db.QueryRow(ctx, `
+	res := &domain.ListVacanciesResult{TotalMode: in.TotalMode}
+	switch in.TotalMode {
+	case domain.TotalExact:
+		err := s.db.QueryRow(ctx, listQuery+`
 SELECT COUNT(*)
-FROM vacancies
-WHERE ($1 OR owner_user_id = $2)
-  AND ($3 = '%%' OR title ILIKE $3 OR description ILIKE $3)
-`, in.IsAdmin, in.OwnerUserID, pattern).Scan(&total)
-	if err != nil {
-		return nil, err
+FROM vacancies v, q
+`+listFilter, args...).Scan(&res.Total)
+		if err != nil {
+			return nil, err
+		}
+	case domain.TotalEstimated:
+		err := s.db.QueryRow(ctx, listQuery+`
+SELECT COUNT(*)
+FROM (
+    SELECT 1
+    FROM vacancies v, q
+`+listFilter+`
+    LIMIT $15
+) capped
+`, append(args, estimatedTotalCap)...).Scan(&res.Total)
+		if err != nil {
+			return nil, err
+		}
 	}
 
-	rows, err := s.db.Query(ctx, `
-SELECT id, owner_user_id, title, description, role, status, version, created_at, updated_at
-FROM vacancies
-WHERE ($1 OR owner_user_id = $2)
-  AND ($3 = '%%' OR title ILIKE $3 OR description ILIKE $3)
-ORDER BY created_at DESC
-LIMIT $4 OFFSET $5
-`, in.IsAdmin, in.OwnerUserID, pattern, in.Limit, in.Offset)
+	// Keyset mode replaces OFFSET with the cursor predicate; the cursor
+	// parameters follow the headline options as $19.. .
+	order, after := browseOrder, browseAfter
+	if in.Query != "" {
+		order, after = searchOrder, searchAfter
+	}
+	offset := in.Offset
+	var afterArgs []any
+	switch {
+	case in.After == nil:
+		after = ""
+	case in.Query == "":
+		offset = 0
+		afterArgs = []any{in.After.CreatedAt.UTC(), in.After.ID}
+	default:
+		offset = 0
+		afterArgs = []any{in.After.Rank, in.After.CreatedAt.UTC(), in.After.ID}
+	}
+	pageArgs := append(args, in.Limit, offset, titleHeadlineOptions, snippetHeadlineOptions)
+	pageArgs = append(pageArgs, afterArgs...)
+
+	// Headlines are computed in the outer query so ts_headline only runs
+	// for the rows on this page, not for every match.
+	rows, err := s.db.Query(ctx, listQuery+`
+SELECT page.id, page.owner_user_id, page.title, page.description, page.role, page.status, page.version,
+       page.created_at, page.updated_at, page.is_public,
+       page.location, page.remote_policy, page.salary_min, page.salary_max, page.salary_currency,
+       page.employment_type, page.seniority, page.headcount, page.role_source, page.role_classified_at,
+       page.role_locked, page.score_must_have_penalty, page.score_nice_to_have_bonus,
+       page.score_hire_threshold, page.score_maybe_threshold, page.score_reject_missing_must_have, page.rank,
+       CASE WHEN q.query IS NULL THEN '' ELSE ts_headline('russian', page.title, q.query, $17) END,
+       CASE WHEN q.query IS NULL THEN '' ELSE ts_headline('russian', page.description, q.query, $18) END
+FROM (
+    SELECT v.id, v.owner_user_id, v.title, v.description, v.role, v.status, v.version, v.created_at, v.updated_at,
+           v.is_public, v.location, v.remote_policy, v.salary_min, v.salary_max, v.salary_currency,
+           v.employment_type, v.seniority, v.headcount, v.role_source, v.role_classified_at, v.role_locked,
+           v.score_must_have_penalty, v.score_nice_to_have_bonus, v.score_hire_threshold, v.score_maybe_threshold,
+           v.score_reject_missing_must_have,
+           COALESCE(ts_rank(v.search_vector, q.query), 0) AS rank
+    FROM vacancies v, q
+`+listFilter+after+`
+    ORDER BY `+order+`
+    LIMIT $15 OFFSET $16
+) page, q
+ORDER BY page.rank DESC, page.created_at DESC, page.id DESC
+`, pageArgs...)
 	if err != nil {
 		return nil, err
 	}
 	defer rows.Close()
 
 	vacancies := make([]domain.Vacancy, 0)
+	highlights := make([]domain.VacancyHighlight, 0)
+	ids := make([]string, 0)
 	for rows.Next() {
 		var v domain.Vacancy
-		if err := rows.Scan(&v.ID, &v.OwnerUserID, &v.Title, &v.Description, &v.Role, &v.Status, &v.Version, &v.CreatedAt, &v.UpdatedAt); err != nil {
+		var h domain.VacancyHighlight
+		// The page columns above follow vacancyColumns order.
+		if err := rows.Scan(append(vacancyFields(&v), &h.Rank, &h.Title, &h.Snippet)...); err != nil {
 			return nil, err
 		}
-		skills, err := loadSkills(ctx, s.db, v.ID)
-		if err != nil {
-			return nil, err
-		}
-		v.Skills = skills
+		h.VacancyID = v.ID
 		vacancies = append(vacancies, v)
+		highlights = append(highlights, h)
+		ids = append(ids, v.ID)
 	}
 	if rows.Err() != nil {
 		return nil, rows.Err()
 	}
 
-	return &domain.ListVacanciesResult{Vacancies: vacancies, Total: total}, nil
+	skills, err := loadSkillsBatch(ctx, s.db, ids)
+	if err != nil {
+		return nil, err
+	}
+	for i := range vacancies {
+		vacancies[i].Skills = skills[vacancies[i].ID]
+		if vacancies[i].Skills == nil {
+			vacancies[i].Skills = make([]domain.SkillWeight, 0)
+		}
+	}
+
+	res.Vacancies = vacancies
+	if in.Query != "" {
+		res.Highlights = highlights
+	}
+	if n := len(vacancies); n > 0 && uint32(n) == in.Limit {
+		last := vacancies[n-1]
+		res.Next = &domain.VacancyCursor{Rank: highlights[n-1].Rank, CreatedAt: last.CreatedAt, ID: last.ID}
+	}
+	return res, nil
+}
+
+// timeOrNil maps the zero time to NULL so an unset range bound disables its
+// predicate. created_at is a zone-less TIMESTAMP filled by NOW() on a
+// UTC server, so bounds are compared in UTC too.
+func timeOrNil(t time.Time) any {
+	if t.IsZero() {
+		return nil
+	}
+	return t.UTC()
 }
@@ -0,0 +1,50 @@
+package persistence
+
+import (
+	"context"
+
+	"github.com/artem13815/hr/vacancy/internal/domain"
+)
+
+// ListVacancyStatusHistory returns status changes newest-first. Every
+// vacancy has at least its creation entry, so a Total of zero means "no such
+// vacancy for this caller" — same convention as ListVacancyVersions.
+func (s *VacancyStorage) ListVacancyStatusHistory(ctx context.Context, in domain.ListVacancyStatusHistoryInput) (*domain.ListVacancyStatusHistoryResult, error) {
+	var total uint64
+	err := s.db.QueryRow(ctx, `
+SELECT COUNT(*)
+FROM vacancy_status_history h
+JOIN vacancies v ON v.id = h.vacancy_id
+WHERE h.vacancy_id = $1 AND `+viewAccess("v", "$2", "$3")+`
+`, in.VacancyID, in.IsAdmin, in.OwnerUserID).Scan(&total)
+	if err != nil {
+		return nil, err
+	}
+
+	rows, err := s.db.Query(ctx, `
+SELECT h.id, h.vacancy_id, h.from_status, h.to_status, h.reason, h.changed_by, h.changed_at
+FROM vacancy_status_history h
+JOIN vacancies v ON v.id = h.vacancy_id
+WHERE h.vacancy_id = $1 AND `+viewAccess("v", "$2", "$3")+`
+ORDER BY h.id DESC
+LIMIT $4 OFFSET $5
+`, in.VacancyID, in.IsAdmin, in.OwnerUserID, in.Limit, in.Offset)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	changes := make([]domain.VacancyStatusChange, 0)
+	for rows.Next() {
+		var c domain.VacancyStatusChange
+		if err := rows.Scan(&c.ID, &c.VacancyID, &c.FromStatus, &c.ToStatus, &c.Reason, &c.ChangedBy, &c.ChangedAt); err != nil {
+			return nil, err
+		}
+		changes = append(changes, c)
+	}
+	if rows.Err() != nil {
+		return nil, rows.Err()
+	}
+
+	return &domain.ListVacancyStatusHistoryResult{Changes: changes, Total: total}, nil
+}
@@ -0,0 +1,56 @@
+package persistence
+
+import (
+	"context"
+
+	"github.com/artem13815/hr/vacancy/internal/domain"
+)
+
+// ListTemplates returns the caller's personal templates plus every org-wide
+// one, newest first, optionally narrowed to a single scope. Admins get the
+// same picker as everyone else: other users' personal templates stay out of
+// the list even though GetTemplate would let an admin read them.
+func (s *VacancyStorage) ListTemplates(ctx context.Context, in domain.ListTemplatesInput) (*domain.ListTemplatesResult, error) {
+	const filter = `
+WHERE (scope = 'org' OR owner_user_id = $1)
+  AND ($2 = '' OR scope = $2)
+`
+	var total uint64
+	err := s.db.QueryRow(ctx, `
+SELECT COUNT(*)
+FROM vacancy_templates
+`+filter, in.OwnerUserID, in.Scope).Scan(&total)
+	if err != nil {
+		return nil, err
+	}
+
+	rows, err := s.db.Query(ctx, `
+SELECT id, owner_user_id, scope, name, title, description, role, skills, source_vacancy_id, created_at, role_source
+FROM vacancy_templates
+`+filter+`
+ORDER BY created_at DESC, id DESC
+LIMIT $3 OFFSET $4
+`, in.OwnerUserID, in.Scope, in.Limit, in.Offset)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	templates := make([]domain.VacancyTemplate, 0)
+	for rows.Next() {
+		var t domain.VacancyTemplate
+		var skills []byte
+		if err := rows.Scan(&t.ID, &t.OwnerUserID, &t.Scope, &t.Name, &t.Title, &t.Description, &t.Role, &skills, &t.SourceVacancyID, &t.CreatedAt, &t.RoleSource); err != nil {
+			return nil, err
+		}
+		if t.Skills, err = unmarshalSkills(skills); err != nil {
+			return nil, err
+		}
+		templates = append(templates, t)
+	}
+	if rows.Err() != nil {
+		return nil, rows.Err()
+	}
+
+	return &domain.ListTemplatesResult{Templates: templates, Total: total}, nil
+}
@@ -0,0 +1,54 @@
+package persistence
+
+import (
+	"context"
+
+	"github.com/artem13815/hr/vacancy/internal/domain"
+)
+
+// ListVacancyVersions returns snapshots newest-first. Visibility follows the
+// parent vacancy: the JOIN carries the same owner/admin predicate as
+// GetVacancy, so a Total of zero means "no such vacancy for this caller".
+func (s *VacancyStorage) ListVacancyVersions(ctx context.Context, in domain.ListVacancyVersionsInput) (*domain.ListVacancyVersionsResult, error) {
+	var total uint64
+	err := s.db.QueryRow(ctx, `
+SELECT COUNT(*)
+FROM vacancy_versions vv
+JOIN vacancies v ON v.id = vv.vacancy_id
+WHERE vv.vacancy_id = $1 AND `+viewAccess("v", "$2", "$3")+`
+`, in.VacancyID, in.IsAdmin, in.OwnerUserID).Scan(&total)
+	if err != nil {
+		return nil, err
+	}
+
+	rows, err := s.db.Query(ctx, `
+SELECT vv.vacancy_id, vv.version, vv.title, vv.description, vv.role, vv.skills, vv.created_by, vv.created_at
+FROM vacancy_versions vv
+JOIN vacancies v ON v.id = vv.vacancy_id
+WHERE vv.vacancy_id = $1 AND `+viewAccess("v", "$2", "$3")+`
+ORDER BY vv.version DESC
+LIMIT $4 OFFSET $5
+`, in.VacancyID, in.IsAdmin, in.OwnerUserID, in.Limit, in.Offset)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	versions := make([]domain.VacancyVersion, 0)
+	for rows.Next() {
+		var vv domain.VacancyVersion
+		var skills []byte
+		if err := rows.Scan(&vv.VacancyID, &vv.Version, &vv.Title, &vv.Description, &vv.Role, &skills, &vv.CreatedBy, &vv.CreatedAt); err != nil {
+			return nil, err
+		}
+		if vv.Skills, err = unmarshalSkills(skills); err != nil {
+			return nil, err
+		}
+		versions = append(versions, vv)
+	}
+	if rows.Err() != nil {
+		return nil, rows.Err()
+	}
+
+	return &domain.ListVacancyVersionsResult{Versions: versions, Total: total}, nil
+}
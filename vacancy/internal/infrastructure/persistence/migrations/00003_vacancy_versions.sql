@@ -0,0 +1,41 @@
+-- +goose Up
+-- +goose StatementBegin
+CREATE TABLE IF NOT EXISTS vacancy_versions (
+    vacancy_id  VARCHAR(64)  NOT NULL,
+    version     INT          NOT NULL,
+    title       VARCHAR(255) NOT NULL,
+    description TEXT         NOT NULL,
+    role        VARCHAR(64)  NOT NULL DEFAULT '',
+    skills      JSONB        NOT NULL DEFAULT '[]'::jsonb,
+    created_by  BIGINT       NOT NULL,
+    created_at  TIMESTAMP    NOT NULL DEFAULT NOW(),
+    PRIMARY KEY (vacancy_id, version),
+    CONSTRAINT fk_vacancy_versions_vacancy FOREIGN KEY (vacancy_id) REFERENCES vacancies(id) ON DELETE CASCADE
+);
+-- +goose StatementEnd
+
+-- Backfill: every existing vacancy gets its current state as a snapshot so
+-- analyses pointing at the latest version can already resolve it. Older
+-- versions were never recorded and stay unrecoverable.
+-- +goose StatementBegin
+INSERT INTO vacancy_versions (vacancy_id, version, title, description, role, skills, created_by, created_at)
+SELECT v.id, v.version, v.title, v.description, v.role,
+       COALESCE((
+           SELECT jsonb_agg(jsonb_build_object(
+                      'name', s.name,
+                      'weight', s.weight,
+                      'must_have', s.must_have,
+                      'nice_to_have', s.nice_to_have
+                  ) ORDER BY s.position)
+           FROM vacancy_skills s
+           WHERE s.vacancy_id = v.id
+       ), '[]'::jsonb),
+       v.owner_user_id, v.updated_at
+FROM vacancies v
+ON CONFLICT (vacancy_id, version) DO NOTHING;
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP TABLE IF EXISTS vacancy_versions;
+-- +goose StatementEnd
@@ -0,0 +1,41 @@
+-- +goose Up
+-- "active" was the only non-archived status before the lifecycle state
+-- machine; it maps onto "open".
+-- +goose StatementBegin
+UPDATE vacancies SET status = 'open' WHERE status = 'active';
+-- +goose StatementEnd
+
+-- +goose StatementBegin
+CREATE TABLE IF NOT EXISTS vacancy_status_history (
+    id          BIGSERIAL    PRIMARY KEY,
+    vacancy_id  VARCHAR(64)  NOT NULL,
+    from_status VARCHAR(32)  NOT NULL DEFAULT '',
+    to_status   VARCHAR(32)  NOT NULL,
+    reason      TEXT         NOT NULL DEFAULT '',
+    changed_by  BIGINT       NOT NULL,
+    changed_at  TIMESTAMP    NOT NULL DEFAULT NOW(),
+    CONSTRAINT fk_vacancy_status_history_vacancy FOREIGN KEY (vacancy_id) REFERENCES vacancies(id) ON DELETE CASCADE
+);
+-- +goose StatementEnd
+
+-- +goose StatementBegin
+CREATE INDEX IF NOT EXISTS idx_vacancy_status_history_vacancy ON vacancy_status_history(vacancy_id, id DESC);
+-- +goose StatementEnd
+
+-- Backfill: one "created" entry per existing vacancy with its current
+-- status. Archived vacancies have no recorded pre-archive status, so
+-- RestoreVacancy sends them back to draft.
+-- +goose StatementBegin
+INSERT INTO vacancy_status_history (vacancy_id, from_status, to_status, changed_by, changed_at)
+SELECT id, '', status, owner_user_id, created_at
+FROM vacancies;
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP TABLE IF EXISTS vacancy_status_history;
+-- +goose StatementEnd
+
+-- +goose StatementBegin
+UPDATE vacancies SET status = 'active' WHERE status <> 'archived';
+-- +goose StatementEnd
@@ -0,0 +1,50 @@
+-- +goose Up
+-- Full-text search over title (weight A) and description (weight B). Both
+-- configs are indexed: "russian" stems Cyrillic word forms, "english" stems
+-- Latin ones; queries are parsed with both and OR-ed.
+-- +goose StatementBegin
+ALTER TABLE vacancies
+    ADD COLUMN IF NOT EXISTS search_vector tsvector GENERATED ALWAYS AS (
+        setweight(to_tsvector('russian', coalesce(title, '')), 'A') ||
+        setweight(to_tsvector('english', coalesce(title, '')), 'A') ||
+        setweight(to_tsvector('russian', coalesce(description, '')), 'B') ||
+        setweight(to_tsvector('english', coalesce(description, '')), 'B')
+    ) STORED;
+-- +goose StatementEnd
+
+-- +goose StatementBegin
+CREATE INDEX IF NOT EXISTS idx_vacancies_search_vector ON vacancies USING GIN (search_vector);
+-- +goose StatementEnd
+
+-- +goose StatementBegin
+CREATE INDEX IF NOT EXISTS idx_vacancies_role ON vacancies(role);
+-- +goose StatementEnd
+
+-- +goose StatementBegin
+CREATE INDEX IF NOT EXISTS idx_vacancies_created_at ON vacancies(created_at);
+-- +goose StatementEnd
+
+-- +goose StatementBegin
+CREATE INDEX IF NOT EXISTS idx_vacancy_skills_lower_name ON vacancy_skills(lower(name));
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP INDEX IF EXISTS idx_vacancy_skills_lower_name;
+-- +goose StatementEnd
+
+-- +goose StatementBegin
+DROP INDEX IF EXISTS idx_vacancies_created_at;
+-- +goose StatementEnd
+
+-- +goose StatementBegin
+DROP INDEX IF EXISTS idx_vacancies_role;
+-- +goose StatementEnd
+
+-- +goose StatementBegin
+DROP INDEX IF EXISTS idx_vacancies_search_vector;
+-- +goose StatementEnd
+
+-- +goose StatementBegin
+ALTER TABLE vacancies DROP COLUMN IF EXISTS search_vector;
+-- +goose StatementEnd
@@ -0,0 +1,20 @@
+-- +goose Up
+-- Keyset pagination walks (created_at, id) backwards; the composite index
+-- serves both the ORDER BY and the row-comparison predicate and makes the
+-- single-column created_at index redundant.
+-- +goose StatementBegin
+CREATE INDEX IF NOT EXISTS idx_vacancies_created_at_id ON vacancies(created_at, id);
+-- +goose StatementEnd
+
+-- +goose StatementBegin
+DROP INDEX IF EXISTS idx_vacancies_created_at;
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+CREATE INDEX IF NOT EXISTS idx_vacancies_created_at ON vacancies(created_at);
+-- +goose StatementEnd
+
+-- +goose StatementBegin
+DROP INDEX IF EXISTS idx_vacancies_created_at_id;
+-- +goose StatementEnd
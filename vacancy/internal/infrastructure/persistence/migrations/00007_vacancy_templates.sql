@@ -0,0 +1,30 @@
+-- +goose Up
+-- +goose StatementBegin
+CREATE TABLE IF NOT EXISTS vacancy_templates (
+    id                VARCHAR(64)  PRIMARY KEY,
+    owner_user_id     BIGINT       NOT NULL,
+    scope             VARCHAR(16)  NOT NULL DEFAULT 'personal',
+    name              VARCHAR(255) NOT NULL,
+    title             VARCHAR(255) NOT NULL,
+    description       TEXT         NOT NULL,
+    role              VARCHAR(64)  NOT NULL DEFAULT '',
+    skills            JSONB        NOT NULL DEFAULT '[]'::jsonb,
+    -- No FK: a template outlives the vacancy it was saved from.
+    source_vacancy_id VARCHAR(64)  NOT NULL DEFAULT '',
+    created_at        TIMESTAMP    NOT NULL DEFAULT NOW(),
+    CONSTRAINT chk_vacancy_templates_scope CHECK (scope IN ('personal', 'org'))
+);
+-- +goose StatementEnd
+
+-- +goose StatementBegin
+CREATE INDEX IF NOT EXISTS idx_vacancy_templates_owner ON vacancy_templates (owner_user_id, created_at DESC);
+-- +goose StatementEnd
+
+-- +goose StatementBegin
+CREATE INDEX IF NOT EXISTS idx_vacancy_templates_org ON vacancy_templates (created_at DESC) WHERE scope = 'org';
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP TABLE IF EXISTS vacancy_templates;
+-- +goose StatementEnd
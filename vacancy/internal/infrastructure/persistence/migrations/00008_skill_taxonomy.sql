@@ -0,0 +1,36 @@
+-- +goose Up
+-- Canonical skill dictionary. vacancy owns the data (bundled seed, synced at
+-- boot); analysis reads skill_aliases to match any spelling of a required
+-- skill in resume text.
+-- +goose StatementBegin
+CREATE TABLE IF NOT EXISTS skill_taxonomy (
+    name     VARCHAR(64) PRIMARY KEY,
+    name_ru  VARCHAR(64) NOT NULL DEFAULT '',
+    category VARCHAR(32) NOT NULL DEFAULT ''
+);
+-- +goose StatementEnd
+
+-- alias is the normalized spelling (lower-case, single spaces); spelling is
+-- the form written in the seed. The canonical name and the Russian name are
+-- aliases too, so a lookup never needs to special-case them.
+-- +goose StatementBegin
+CREATE TABLE IF NOT EXISTS skill_aliases (
+    alias      VARCHAR(128) PRIMARY KEY,
+    spelling   VARCHAR(128) NOT NULL,
+    skill_name VARCHAR(64)  NOT NULL,
+    CONSTRAINT fk_skill_aliases_skill FOREIGN KEY (skill_name) REFERENCES skill_taxonomy(name) ON DELETE CASCADE
+);
+-- +goose StatementEnd
+
+-- +goose StatementBegin
+CREATE INDEX IF NOT EXISTS idx_skill_aliases_skill_name ON skill_aliases (skill_name);
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP TABLE IF EXISTS skill_aliases;
+-- +goose StatementEnd
+
+-- +goose StatementBegin
+DROP TABLE IF EXISTS skill_taxonomy;
+-- +goose StatementEnd
@@ -0,0 +1,23 @@
+-- +goose Up
+-- is_public opts a vacancy into the unauthenticated JobPosting / XML feed.
+-- Off by default: existing vacancies stay private until someone publishes
+-- them. The partial index serves the feed query (open + public, newest
+-- first, optionally per owner) without touching private rows.
+-- +goose StatementBegin
+ALTER TABLE vacancies ADD COLUMN IF NOT EXISTS is_public BOOLEAN NOT NULL DEFAULT FALSE;
+-- +goose StatementEnd
+
+-- +goose StatementBegin
+CREATE INDEX IF NOT EXISTS idx_vacancies_public_feed
+    ON vacancies(owner_user_id, updated_at DESC)
+    WHERE is_public AND status = 'open';
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP INDEX IF EXISTS idx_vacancies_public_feed;
+-- +goose StatementEnd
+
+-- +goose StatementBegin
+ALTER TABLE vacancies DROP COLUMN IF EXISTS is_public;
+-- +goose StatementEnd
@@ -0,0 +1,25 @@
+-- +goose Up
+-- vacancy_collaborators grants users other than the owner access to one
+-- vacancy: viewers can read it (and its candidates and analyses), editors
+-- can also change it. Ownership itself stays in vacancies.owner_user_id.
+-- Resume and analysis read this table directly for their access checks.
+-- +goose StatementBegin
+CREATE TABLE IF NOT EXISTS vacancy_collaborators (
+    vacancy_id VARCHAR(64) NOT NULL REFERENCES vacancies(id) ON DELETE CASCADE,
+    user_id    BIGINT      NOT NULL,
+    role       TEXT        NOT NULL CHECK (role IN ('viewer', 'editor')),
+    added_by   BIGINT      NOT NULL,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    PRIMARY KEY (vacancy_id, user_id)
+);
+-- +goose StatementEnd
+
+-- +goose StatementBegin
+CREATE INDEX IF NOT EXISTS idx_vacancy_collaborators_user
+    ON vacancy_collaborators(user_id);
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP TABLE IF EXISTS vacancy_collaborators;
+-- +goose StatementEnd
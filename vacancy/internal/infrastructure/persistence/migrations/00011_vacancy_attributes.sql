@@ -0,0 +1,72 @@
+-- +goose Up
+-- Structured vacancy attributes. Empty strings and zero salaries mean "not
+-- specified", so existing rows need no backfill; headcount defaults to one
+-- hire. The enumerated columns carry CHECKs matching domain/attributes.go.
+-- +goose StatementBegin
+ALTER TABLE vacancies
+    ADD COLUMN IF NOT EXISTS location        TEXT    NOT NULL DEFAULT '',
+    ADD COLUMN IF NOT EXISTS remote_policy   TEXT    NOT NULL DEFAULT '',
+    ADD COLUMN IF NOT EXISTS salary_min      BIGINT  NOT NULL DEFAULT 0,
+    ADD COLUMN IF NOT EXISTS salary_max      BIGINT  NOT NULL DEFAULT 0,
+    ADD COLUMN IF NOT EXISTS salary_currency TEXT    NOT NULL DEFAULT '',
+    ADD COLUMN IF NOT EXISTS employment_type TEXT    NOT NULL DEFAULT '',
+    ADD COLUMN IF NOT EXISTS seniority       TEXT    NOT NULL DEFAULT '',
+    ADD COLUMN IF NOT EXISTS headcount       INTEGER NOT NULL DEFAULT 1;
+-- +goose StatementEnd
+
+-- +goose StatementBegin
+ALTER TABLE vacancies
+    ADD CONSTRAINT chk_vacancies_remote_policy
+        CHECK (remote_policy IN ('', 'onsite', 'hybrid', 'remote')),
+    ADD CONSTRAINT chk_vacancies_employment_type
+        CHECK (employment_type IN ('', 'full_time', 'part_time', 'contract', 'internship', 'temporary')),
+    ADD CONSTRAINT chk_vacancies_seniority
+        CHECK (seniority IN ('', 'intern', 'junior', 'middle', 'senior', 'lead')),
+    ADD CONSTRAINT chk_vacancies_salary
+        CHECK (salary_min >= 0 AND salary_max >= 0 AND (salary_max = 0 OR salary_min <= salary_max)),
+    ADD CONSTRAINT chk_vacancies_headcount
+        CHECK (headcount >= 1);
+-- +goose StatementEnd
+
+-- Facet indexes for ListVacancies; location is matched on lower().
+-- +goose StatementBegin
+CREATE INDEX IF NOT EXISTS idx_vacancies_location ON vacancies(lower(location));
+-- +goose StatementEnd
+
+-- +goose StatementBegin
+CREATE INDEX IF NOT EXISTS idx_vacancies_seniority ON vacancies(seniority);
+-- +goose StatementEnd
+
+-- +goose StatementBegin
+CREATE INDEX IF NOT EXISTS idx_vacancies_salary ON vacancies(salary_currency, salary_max, salary_min);
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP INDEX IF EXISTS idx_vacancies_salary;
+-- +goose StatementEnd
+
+-- +goose StatementBegin
+DROP INDEX IF EXISTS idx_vacancies_seniority;
+-- +goose StatementEnd
+
+-- +goose StatementBegin
+DROP INDEX IF EXISTS idx_vacancies_location;
+-- +goose StatementEnd
+
+-- +goose StatementBegin
+ALTER TABLE vacancies
+    DROP CONSTRAINT IF EXISTS chk_vacancies_headcount,
+    DROP CONSTRAINT IF EXISTS chk_vacancies_salary,
+    DROP CONSTRAINT IF EXISTS chk_vacancies_seniority,
+    DROP CONSTRAINT IF EXISTS chk_vacancies_employment_type,
+    DROP CONSTRAINT IF EXISTS chk_vacancies_remote_policy,
+    DROP COLUMN IF EXISTS headcount,
+    DROP COLUMN IF EXISTS seniority,
+    DROP COLUMN IF EXISTS employment_type,
+    DROP COLUMN IF EXISTS salary_currency,
+    DROP COLUMN IF EXISTS salary_max,
+    DROP COLUMN IF EXISTS salary_min,
+    DROP COLUMN IF EXISTS remote_policy,
+    DROP COLUMN IF EXISTS location;
+-- +goose StatementEnd
@@ -0,0 +1,51 @@
+-- +goose Up
+-- role_source records how vacancies.role was decided: 'llm' (multiagent
+-- ClassifyRole), 'keyword' (DetectRole fallback) or 'manual'. Existing rows
+-- have no provenance; they are marked 'keyword' so the role reconciler
+-- re-checks each of them once against the LLM. role_classified_at is
+-- backfilled with updated_at — every save re-ran classification.
+-- +goose StatementBegin
+ALTER TABLE vacancies
+    ADD COLUMN IF NOT EXISTS role_source        TEXT        NOT NULL DEFAULT 'keyword',
+    ADD COLUMN IF NOT EXISTS role_classified_at TIMESTAMPTZ NOT NULL DEFAULT NOW();
+-- +goose StatementEnd
+
+-- +goose StatementBegin
+UPDATE vacancies SET role_classified_at = updated_at;
+-- +goose StatementEnd
+
+-- +goose StatementBegin
+ALTER TABLE vacancies
+    ADD CONSTRAINT chk_vacancies_role_source
+        CHECK (role_source IN ('llm', 'keyword', 'manual'));
+-- +goose StatementEnd
+
+-- The reconciler's scan: fallback roles, oldest classification first.
+-- +goose StatementBegin
+CREATE INDEX IF NOT EXISTS idx_vacancies_role_reconcile
+    ON vacancies(role_classified_at)
+    WHERE role_source = 'keyword';
+-- +goose StatementEnd
+
+-- Templates carry the source vacancy's role_source so vacancies opened from
+-- them inherit it with the role.
+-- +goose StatementBegin
+ALTER TABLE vacancy_templates
+    ADD COLUMN IF NOT EXISTS role_source TEXT NOT NULL DEFAULT 'keyword';
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+ALTER TABLE vacancy_templates DROP COLUMN IF EXISTS role_source;
+-- +goose StatementEnd
+
+-- +goose StatementBegin
+DROP INDEX IF EXISTS idx_vacancies_role_reconcile;
+-- +goose StatementEnd
+
+-- +goose StatementBegin
+ALTER TABLE vacancies
+    DROP CONSTRAINT IF EXISTS chk_vacancies_role_source,
+    DROP COLUMN IF EXISTS role_classified_at,
+    DROP COLUMN IF EXISTS role_source;
+-- +goose StatementEnd
@@ -0,0 +1,21 @@
+-- +goose Up
+-- role_locked pins a role a recruiter chose by hand: updates keep it and
+-- the role reconciler skips the vacancy until the lock is released. A
+-- locked role is always a manual one.
+-- +goose StatementBegin
+ALTER TABLE vacancies
+    ADD COLUMN IF NOT EXISTS role_locked BOOLEAN NOT NULL DEFAULT FALSE;
+-- +goose StatementEnd
+
+-- +goose StatementBegin
+ALTER TABLE vacancies
+    ADD CONSTRAINT chk_vacancies_role_locked
+        CHECK (NOT role_locked OR role_source = 'manual');
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+ALTER TABLE vacancies
+    DROP CONSTRAINT IF EXISTS chk_vacancies_role_locked,
+    DROP COLUMN IF EXISTS role_locked;
+-- +goose StatementEnd
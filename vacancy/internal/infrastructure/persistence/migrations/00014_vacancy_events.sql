@@ -0,0 +1,27 @@
+-- +goose Up
+-- vacancy_events is the transactional outbox: every vacancy write appends
+-- its event here in the same transaction, and SubscribeVacancyEvents
+-- streams the table to other services by seq. Writers serialize on an
+-- advisory lock while appending (see appendEvent), so seq order is commit
+-- order and a reader that has seen seq N has seen every event below it.
+-- +goose StatementBegin
+CREATE TABLE IF NOT EXISTS vacancy_events (
+    seq           BIGSERIAL   PRIMARY KEY,
+    id            TEXT        NOT NULL UNIQUE,
+    type          TEXT        NOT NULL
+        CHECK (type IN ('vacancy_created', 'vacancy_updated', 'vacancy_status_changed')),
+    vacancy_id    TEXT        NOT NULL,
+    owner_user_id BIGINT      NOT NULL,
+    actor_user_id BIGINT      NOT NULL,
+    version       INTEGER     NOT NULL,
+    old_version   INTEGER     NOT NULL DEFAULT 0,
+    status        TEXT        NOT NULL,
+    old_status    TEXT        NOT NULL DEFAULT '',
+    occurred_at   TIMESTAMPTZ NOT NULL DEFAULT NOW()
+);
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP TABLE IF EXISTS vacancy_events;
+-- +goose StatementEnd
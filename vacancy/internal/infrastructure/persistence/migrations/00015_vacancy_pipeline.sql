@@ -0,0 +1,58 @@
+-- +goose Up
+-- Hiring pipeline. vacancies.pipeline_stages holds the configurable active
+-- stages in order; hired and rejected are implicit terminal stages.
+-- candidate_stages is the current stage of every candidate that has been
+-- moved at least once — a candidate without a row is in the first stage —
+-- and candidate_stage_history keeps every move. analysis reads both for its
+-- stage filter. Candidates live in the resume service's table, so there is
+-- no foreign key; readers join candidates to skip deleted ones.
+-- +goose StatementBegin
+ALTER TABLE vacancies
+    ADD COLUMN IF NOT EXISTS pipeline_stages JSONB NOT NULL
+        DEFAULT '[{"key":"screening","name":"Screening"},{"key":"interview","name":"Interview"},{"key":"offer","name":"Offer"}]'::jsonb;
+-- +goose StatementEnd
+
+-- +goose StatementBegin
+CREATE TABLE IF NOT EXISTS candidate_stages (
+    candidate_id VARCHAR(64) PRIMARY KEY,
+    vacancy_id   VARCHAR(64) NOT NULL,
+    stage        TEXT        NOT NULL,
+    reason       TEXT        NOT NULL DEFAULT '',
+    changed_by   BIGINT      NOT NULL,
+    changed_at   TIMESTAMP   NOT NULL DEFAULT NOW()
+);
+-- +goose StatementEnd
+
+-- +goose StatementBegin
+CREATE INDEX IF NOT EXISTS idx_candidate_stages_vacancy_stage ON candidate_stages(vacancy_id, stage);
+-- +goose StatementEnd
+
+-- +goose StatementBegin
+CREATE TABLE IF NOT EXISTS candidate_stage_history (
+    id           BIGSERIAL   PRIMARY KEY,
+    candidate_id VARCHAR(64) NOT NULL,
+    vacancy_id   VARCHAR(64) NOT NULL,
+    from_stage   TEXT        NOT NULL,
+    to_stage     TEXT        NOT NULL,
+    reason       TEXT        NOT NULL DEFAULT '',
+    changed_by   BIGINT      NOT NULL,
+    changed_at   TIMESTAMP   NOT NULL DEFAULT NOW()
+);
+-- +goose StatementEnd
+
+-- +goose StatementBegin
+CREATE INDEX IF NOT EXISTS idx_candidate_stage_history_candidate ON candidate_stage_history(candidate_id, id DESC);
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP TABLE IF EXISTS candidate_stage_history;
+-- +goose StatementEnd
+
+-- +goose StatementBegin
+DROP TABLE IF EXISTS candidate_stages;
+-- +goose StatementEnd
+
+-- +goose StatementBegin
+ALTER TABLE vacancies DROP COLUMN IF EXISTS pipeline_stages;
+-- +goose StatementEnd
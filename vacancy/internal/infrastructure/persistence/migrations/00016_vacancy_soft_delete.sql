@@ -0,0 +1,28 @@
+-- +goose Up
+-- Soft delete. A vacancy with deleted_at set is hidden from every read in
+-- vacancy, resume and analysis; the retention job hard-deletes it after the
+-- grace period. purge_started_at marks a purge in progress: from then on
+-- the vacancy can no longer be restored, and a purge interrupted half-way
+-- is picked up again by the next run.
+-- +goose StatementBegin
+ALTER TABLE vacancies
+    ADD COLUMN IF NOT EXISTS deleted_at       TIMESTAMP,
+    ADD COLUMN IF NOT EXISTS deleted_by       BIGINT NOT NULL DEFAULT 0,
+    ADD COLUMN IF NOT EXISTS purge_started_at TIMESTAMP;
+-- +goose StatementEnd
+
+-- +goose StatementBegin
+CREATE INDEX IF NOT EXISTS idx_vacancies_deleted_at ON vacancies(deleted_at) WHERE deleted_at IS NOT NULL;
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP INDEX IF EXISTS idx_vacancies_deleted_at;
+-- +goose StatementEnd
+
+-- +goose StatementBegin
+ALTER TABLE vacancies
+    DROP COLUMN IF EXISTS purge_started_at,
+    DROP COLUMN IF EXISTS deleted_by,
+    DROP COLUMN IF EXISTS deleted_at;
+-- +goose StatementEnd
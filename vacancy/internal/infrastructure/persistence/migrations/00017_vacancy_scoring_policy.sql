@@ -0,0 +1,33 @@
+-- +goose Up
+-- Per-vacancy scoring policy read by the analysis service. The defaults are
+-- the values analysis hard-coded before, so existing vacancies keep scoring
+-- exactly as they did. The CHECKs mirror usecase/validate.go.
+-- +goose StatementBegin
+ALTER TABLE vacancies
+    ADD COLUMN IF NOT EXISTS score_must_have_penalty        REAL    NOT NULL DEFAULT 10,
+    ADD COLUMN IF NOT EXISTS score_nice_to_have_bonus       REAL    NOT NULL DEFAULT 2,
+    ADD COLUMN IF NOT EXISTS score_hire_threshold           REAL    NOT NULL DEFAULT 75,
+    ADD COLUMN IF NOT EXISTS score_maybe_threshold          REAL    NOT NULL DEFAULT 45,
+    ADD COLUMN IF NOT EXISTS score_reject_missing_must_have BOOLEAN NOT NULL DEFAULT FALSE;
+-- +goose StatementEnd
+
+-- +goose StatementBegin
+ALTER TABLE vacancies
+    ADD CONSTRAINT chk_vacancies_scoring_policy
+        CHECK (score_must_have_penalty BETWEEN 0 AND 100
+           AND score_nice_to_have_bonus BETWEEN 0 AND 100
+           AND score_maybe_threshold > 0
+           AND score_maybe_threshold <= score_hire_threshold
+           AND score_hire_threshold <= 100);
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+ALTER TABLE vacancies
+    DROP CONSTRAINT IF EXISTS chk_vacancies_scoring_policy,
+    DROP COLUMN IF EXISTS score_reject_missing_must_have,
+    DROP COLUMN IF EXISTS score_maybe_threshold,
+    DROP COLUMN IF EXISTS score_hire_threshold,
+    DROP COLUMN IF EXISTS score_nice_to_have_bonus,
+    DROP COLUMN IF EXISTS score_must_have_penalty;
+-- +goose StatementEnd
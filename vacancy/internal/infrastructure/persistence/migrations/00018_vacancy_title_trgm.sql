@@ -0,0 +1,18 @@
+-- +goose Up
+-- Trigram index for duplicate detection on CreateVacancy: open vacancies
+-- whose lower(title) is similar to the new title (`%` operator).
+-- +goose StatementBegin
+CREATE EXTENSION IF NOT EXISTS pg_trgm;
+-- +goose StatementEnd
+
+-- +goose StatementBegin
+CREATE INDEX IF NOT EXISTS idx_vacancies_title_trgm
+    ON vacancies USING GIN (lower(title) gin_trgm_ops)
+    WHERE deleted_at IS NULL;
+-- +goose StatementEnd
+
+-- +goose Down
+-- The extension stays: other schemas may have started using it.
+-- +goose StatementBegin
+DROP INDEX IF EXISTS idx_vacancies_title_trgm;
+-- +goose StatementEnd
@@ -0,0 +1,26 @@
+-- +goose Up
+-- Soft delete, undelete and the retention purge go through the outbox
+-- like every other vacancy write.
+-- +goose StatementBegin
+ALTER TABLE vacancy_events DROP CONSTRAINT IF EXISTS vacancy_events_type_check;
+-- +goose StatementEnd
+
+-- +goose StatementBegin
+ALTER TABLE vacancy_events ADD CONSTRAINT vacancy_events_type_check
+    CHECK (type IN ('vacancy_created', 'vacancy_updated', 'vacancy_status_changed',
+                    'vacancy_deleted', 'vacancy_restored', 'vacancy_purged'));
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DELETE FROM vacancy_events WHERE type IN ('vacancy_deleted', 'vacancy_restored', 'vacancy_purged');
+-- +goose StatementEnd
+
+-- +goose StatementBegin
+ALTER TABLE vacancy_events DROP CONSTRAINT IF EXISTS vacancy_events_type_check;
+-- +goose StatementEnd
+
+-- +goose StatementBegin
+ALTER TABLE vacancy_events ADD CONSTRAINT vacancy_events_type_check
+    CHECK (type IN ('vacancy_created', 'vacancy_updated', 'vacancy_status_changed'));
+-- +goose StatementEnd
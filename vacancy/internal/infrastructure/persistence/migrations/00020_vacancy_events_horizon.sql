@@ -0,0 +1,20 @@
+-- +goose Up
+-- Writers no longer serialize on an advisory lock while appending, so seq
+-- order is not commit order. snapshot_xmax is the first unassigned xid
+-- right after the event got its seq: every transaction that could still
+-- commit an event with a smaller seq has an xid below it. The relay
+-- delivers events in seq order and stops at the first one whose
+-- snapshot_xmax is above the oldest running xid (see ListVacancyEvents).
+-- Existing rows were written under the lock and are deliverable as is.
+-- +goose StatementBegin
+ALTER TABLE vacancy_events ADD COLUMN IF NOT EXISTS snapshot_xmax xid8 NOT NULL DEFAULT '0';
+-- +goose StatementEnd
+
+-- +goose StatementBegin
+ALTER TABLE vacancy_events ALTER COLUMN snapshot_xmax DROP DEFAULT;
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+ALTER TABLE vacancy_events DROP COLUMN IF EXISTS snapshot_xmax;
+-- +goose StatementEnd
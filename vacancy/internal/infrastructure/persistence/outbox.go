@@ -0,0 +1,112 @@
+package persistence
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/artem13815/hr/vacancy/internal/domain"
+	"github.com/jackc/pgx/v5"
+)
+
+// eventChannel is the LISTEN/NOTIFY channel appendEvent signals on commit.
+const eventChannel = "vacancy_events"
+
+// appendEvent writes ev to the outbox inside the caller's transaction and
+// queues a NOTIFY, which Postgres delivers only if the transaction commits.
+//
+// Concurrent writers commit in any order, so a smaller seq can become
+// visible after a larger one. To let the relay tell, the row records the
+// snapshot xmax taken after its seq is allocated: any transaction that
+// holds a smaller seq already had an xid then, and that xid is below it.
+// The transaction takes its xid before allocating the seq for the same
+// reason. Each step is its own statement so that, under READ COMMITTED,
+// the snapshot is taken after nextval.
+func appendEvent(ctx context.Context, tx pgx.Tx, ev domain.VacancyEvent) error {
+	id, err := newID()
+	if err != nil {
+		return fmt.Errorf("failed to generate event id: %w", err)
+	}
+
+	if _, err := tx.Exec(ctx, `SELECT pg_current_xact_id()`); err != nil {
+		return fmt.Errorf("assign xid: %w", err)
+	}
+	var seq int64
+	err = tx.QueryRow(ctx, `SELECT nextval(pg_get_serial_sequence('vacancy_events', 'seq'))`).Scan(&seq)
+	if err != nil {
+		return fmt.Errorf("allocate event seq: %w", err)
+	}
+	_, err = tx.Exec(ctx, `
+INSERT INTO vacancy_events (seq, id, type, vacancy_id, owner_user_id, actor_user_id, version, old_version, status, old_status, snapshot_xmax)
+VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, pg_snapshot_xmax(pg_current_snapshot()))
+`, seq, id, ev.Type, ev.VacancyID, ev.OwnerUserID, ev.ActorUserID, ev.Version, ev.OldVersion, ev.Status, ev.OldStatus)
+	if err != nil {
+		return fmt.Errorf("insert vacancy event: %w", err)
+	}
+	if _, err := tx.Exec(ctx, `SELECT pg_notify($1, '')`, eventChannel); err != nil {
+		return fmt.Errorf("notify vacancy event: %w", err)
+	}
+	return nil
+}
+
+// ListVacancyEvents returns up to limit outbox events after afterSeq, in
+// seq order. It stops before the first event a transaction still running
+// might precede with a smaller seq (its snapshot_xmax is above the oldest
+// running xid), so a reader paging by seq never skips an event that
+// commits late. Such events come back once those transactions end.
+func (s *VacancyStorage) ListVacancyEvents(ctx context.Context, afterSeq uint64, limit int) ([]domain.VacancyEvent, error) {
+	rows, err := s.db.Query(ctx, `
+SELECT seq, id, type, vacancy_id, owner_user_id, actor_user_id, occurred_at, version, old_version, status, old_status,
+       snapshot_xmax <= pg_snapshot_xmin(pg_current_snapshot())
+FROM vacancy_events
+WHERE seq > $1
+ORDER BY seq ASC
+LIMIT $2
+`, afterSeq, limit)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	events := make([]domain.VacancyEvent, 0)
+	for rows.Next() {
+		var (
+			ev      domain.VacancyEvent
+			settled bool
+		)
+		if err := rows.Scan(&ev.Seq, &ev.ID, &ev.Type, &ev.VacancyID, &ev.OwnerUserID, &ev.ActorUserID,
+			&ev.OccurredAt, &ev.Version, &ev.OldVersion, &ev.Status, &ev.OldStatus, &settled); err != nil {
+			return nil, err
+		}
+		if !settled {
+			break
+		}
+		events = append(events, ev)
+	}
+	if rows.Err() != nil {
+		return nil, rows.Err()
+	}
+	return events, nil
+}
+
+// ListenVacancyEvents takes a connection out of the pool for good, LISTENs
+// on the outbox channel and calls wake for every notification. It returns
+// when ctx is done or the connection fails; the connection is closed
+// either way rather than handed back to the pool still listening.
+func (s *VacancyStorage) ListenVacancyEvents(ctx context.Context, wake func()) error {
+	pooled, err := s.db.Acquire(ctx)
+	if err != nil {
+		return err
+	}
+	conn := pooled.Hijack()
+	defer conn.Close(context.WithoutCancel(ctx))
+
+	if _, err := conn.Exec(ctx, "LISTEN "+eventChannel); err != nil {
+		return fmt.Errorf("listen %s: %w", eventChannel, err)
+	}
+	for {
+		if _, err := conn.WaitForNotification(ctx); err != nil {
+			return err
+		}
+		wake()
+	}
+}
@@ -0,0 +1,338 @@
+package persistence
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+
+	"github.com/artem13815/hr/vacancy/internal/domain"
+	"github.com/jackc/pgx/v5"
+)
+
+// stageJSON is the on-disk shape of one entry of vacancies.pipeline_stages.
+type stageJSON struct {
+	Key  string `json:"key"`
+	Name string `json:"name"`
+}
+
+// effectiveStage renders a candidate's current stage: the recorded one, or
+// the vacancy's first stage for candidates never moved. cs and v are the
+// candidate_stages and vacancies aliases of the surrounding query.
+const effectiveStage = `COALESCE(cs.stage, v.pipeline_stages->0->>'key')`
+
+func marshalStages(stages []domain.PipelineStage) ([]byte, error) {
+	out := make([]stageJSON, 0, len(stages))
+	for _, s := range stages {
+		out = append(out, stageJSON{Key: s.Key, Name: s.Name})
+	}
+	b, err := json.Marshal(out)
+	if err != nil {
+		return nil, fmt.Errorf("marshal pipeline stages: %w", err)
+	}
+	return b, nil
+}
+
+func unmarshalStages(raw []byte) ([]domain.PipelineStage, error) {
+	var in []stageJSON
+	if err := json.Unmarshal(raw, &in); err != nil {
+		return nil, fmt.Errorf("unmarshal pipeline stages: %w", err)
+	}
+	out := make([]domain.PipelineStage, 0, len(in))
+	for _, s := range in {
+		out = append(out, domain.PipelineStage{Key: s.Key, Name: s.Name})
+	}
+	return out, nil
+}
+
+// GetPipelineStages returns the vacancy's active stages. The caller's access
+// is checked by the usecase. Returns nil when the vacancy does not exist.
+func (s *VacancyStorage) GetPipelineStages(ctx context.Context, vacancyID string) ([]domain.PipelineStage, error) {
+	var raw []byte
+	err := s.db.QueryRow(ctx, `SELECT pipeline_stages FROM vacancies WHERE id = $1`, vacancyID).Scan(&raw)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, nil
+		}
+		return nil, err
+	}
+	return unmarshalStages(raw)
+}
+
+// CountCandidatesByStage counts the vacancy's candidates per current stage.
+func (s *VacancyStorage) CountCandidatesByStage(ctx context.Context, vacancyID string) (map[string]uint32, error) {
+	rows, err := s.db.Query(ctx, `
+SELECT `+effectiveStage+` AS stage, COUNT(*)
+FROM candidates c
+JOIN vacancies v ON v.id = c.vacancy_id
+LEFT JOIN candidate_stages cs ON cs.candidate_id = c.id
+WHERE c.vacancy_id = $1
+GROUP BY stage
+`, vacancyID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	out := make(map[string]uint32)
+	for rows.Next() {
+		var stage string
+		var n uint32
+		if err := rows.Scan(&stage, &n); err != nil {
+			return nil, err
+		}
+		out[stage] = n
+	}
+	if rows.Err() != nil {
+		return nil, rows.Err()
+	}
+	return out, nil
+}
+
+// SetPipelineStages replaces the vacancy's active stages. Returns false when
+// the caller cannot edit the vacancy, and domain.ErrStageInUse when a
+// candidate is still in a stage the new list drops. The UPDATE locks the
+// vacancy row, which ChangeCandidateStage also takes, so no candidate can
+// enter a dropped stage between the check and the commit.
+func (s *VacancyStorage) SetPipelineStages(ctx context.Context, in domain.SetPipelineStagesInput) (bool, error) {
+	raw, err := marshalStages(in.Stages)
+	if err != nil {
+		return false, err
+	}
+	keys := make([]string, 0, len(in.Stages)+2)
+	for _, st := range in.Stages {
+		keys = append(keys, st.Key)
+	}
+	keys = append(keys, domain.StageHired, domain.StageRejected)
+
+	tx, err := s.db.Begin(ctx)
+	if err != nil {
+		return false, err
+	}
+	defer tx.Rollback(ctx)
+
+	cmd, err := tx.Exec(ctx, `
+UPDATE vacancies
+SET pipeline_stages = $1,
+    updated_at = NOW()
+WHERE id = $2 AND `+editAccess("vacancies", "$3", "$4")+`
+`, raw, in.VacancyID, in.IsAdmin, in.OwnerUserID)
+	if err != nil {
+		return false, err
+	}
+	if cmd.RowsAffected() == 0 {
+		return false, nil
+	}
+
+	var inUse bool
+	err = tx.QueryRow(ctx, `
+SELECT EXISTS (
+  SELECT 1
+  FROM candidate_stages cs
+  JOIN candidates c ON c.id = cs.candidate_id
+  WHERE cs.vacancy_id = $1 AND cs.stage <> ALL($2)
+)
+`, in.VacancyID, keys).Scan(&inUse)
+	if err != nil {
+		return false, err
+	}
+	if inUse {
+		return false, domain.ErrStageInUse
+	}
+
+	if err := tx.Commit(ctx); err != nil {
+		return false, err
+	}
+	return true, nil
+}
+
+// GetCandidateStage returns the candidate's current stage, or "" when the
+// candidate does not belong to the vacancy.
+func (s *VacancyStorage) GetCandidateStage(ctx context.Context, vacancyID, candidateID string) (string, error) {
+	var stage string
+	err := s.db.QueryRow(ctx, `
+SELECT `+effectiveStage+`
+FROM candidates c
+JOIN vacancies v ON v.id = c.vacancy_id
+LEFT JOIN candidate_stages cs ON cs.candidate_id = c.id
+WHERE c.id = $1 AND c.vacancy_id = $2
+`, candidateID, vacancyID).Scan(&stage)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return "", nil
+		}
+		return "", err
+	}
+	return stage, nil
+}
+
+// ChangeCandidateStage moves the candidate from in.FromStage to in.ToStage,
+// records the move in candidate_stage_history and counts the vacancy's hires,
+// all in one transaction. The vacancy row is locked first, so moves on one
+// vacancy are serialized, the status checks see the current status and the
+// hire count is exact. The hire that reaches the headcount moves the vacancy
+// to filled in the same transaction, with its status history and event.
+// Returns nil when the caller cannot edit the vacancy, the vacancy is
+// archived (or, for a hire, not open or paused), the candidate left
+// FromStage, or ToStage was removed from the pipeline since the usecase
+// checked it.
+func (s *VacancyStorage) ChangeCandidateStage(ctx context.Context, in domain.ChangeCandidateStageInput) (*domain.ChangeCandidateStageResult, error) {
+	tx, err := s.db.Begin(ctx)
+	if err != nil {
+		return nil, err
+	}
+	defer tx.Rollback(ctx)
+
+	var (
+		raw         []byte
+		status      string
+		headcount   uint32
+		ownerUserID uint64
+		version     uint32
+	)
+	err = tx.QueryRow(ctx, `
+SELECT pipeline_stages, status, headcount, owner_user_id, version
+FROM vacancies
+WHERE id = $1 AND `+editAccess("vacancies", "$2", "$3")+`
+FOR UPDATE
+`, in.VacancyID, in.IsAdmin, in.OwnerUserID).Scan(&raw, &status, &headcount, &ownerUserID, &version)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, nil
+		}
+		return nil, err
+	}
+	if status == domain.StatusArchived {
+		return nil, nil
+	}
+	if in.ToStage == domain.StageHired && status != domain.StatusOpen && status != domain.StatusPaused {
+		return nil, nil
+	}
+	stages, err := unmarshalStages(raw)
+	if err != nil {
+		return nil, err
+	}
+	if !domain.HasPipelineStage(stages, in.ToStage) {
+		return nil, nil
+	}
+
+	var current string
+	err = tx.QueryRow(ctx, `
+SELECT `+effectiveStage+`
+FROM candidates c
+JOIN vacancies v ON v.id = c.vacancy_id
+LEFT JOIN candidate_stages cs ON cs.candidate_id = c.id
+WHERE c.id = $1 AND c.vacancy_id = $2
+`, in.CandidateID, in.VacancyID).Scan(&current)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, nil
+		}
+		return nil, err
+	}
+	if current != in.FromStage {
+		return nil, nil
+	}
+
+	_, err = tx.Exec(ctx, `
+INSERT INTO candidate_stages (candidate_id, vacancy_id, stage, reason, changed_by)
+VALUES ($1, $2, $3, $4, $5)
+ON CONFLICT (candidate_id) DO UPDATE
+SET stage = EXCLUDED.stage,
+    reason = EXCLUDED.reason,
+    changed_by = EXCLUDED.changed_by,
+    changed_at = NOW()
+`, in.CandidateID, in.VacancyID, in.ToStage, in.Reason, in.OwnerUserID)
+	if err != nil {
+		return nil, fmt.Errorf("upsert candidate stage: %w", err)
+	}
+
+	res := domain.ChangeCandidateStageResult{Change: domain.CandidateStageChange{
+		VacancyID:   in.VacancyID,
+		CandidateID: in.CandidateID,
+		FromStage:   in.FromStage,
+		ToStage:     in.ToStage,
+		Reason:      in.Reason,
+		ChangedBy:   in.OwnerUserID,
+	}}
+	err = tx.QueryRow(ctx, `
+INSERT INTO candidate_stage_history (candidate_id, vacancy_id, from_stage, to_stage, reason, changed_by)
+VALUES ($1, $2, $3, $4, $5, $6)
+RETURNING id, changed_at
+`, in.CandidateID, in.VacancyID, in.FromStage, in.ToStage, in.Reason, in.OwnerUserID).Scan(&res.Change.ID, &res.Change.ChangedAt)
+	if err != nil {
+		return nil, fmt.Errorf("insert candidate stage change: %w", err)
+	}
+
+	err = tx.QueryRow(ctx, `
+SELECT COUNT(*)
+FROM candidate_stages cs
+JOIN candidates c ON c.id = cs.candidate_id
+WHERE cs.vacancy_id = $1 AND cs.stage = $2
+`, in.VacancyID, domain.StageHired).Scan(&res.Hired)
+	if err != nil {
+		return nil, err
+	}
+
+	res.VacancyStatus = status
+	if in.ToStage == domain.StageHired && res.Hired >= headcount && domain.CanTransition(status, domain.StatusFilled) {
+		_, err = tx.Exec(ctx, `
+UPDATE vacancies
+SET status = $2,
+    updated_at = NOW()
+WHERE id = $1
+`, in.VacancyID, domain.StatusFilled)
+		if err != nil {
+			return nil, fmt.Errorf("fill vacancy: %w", err)
+		}
+		if err := insertStatusChange(ctx, tx, in.VacancyID, status, domain.StatusFilled, domain.HeadcountReachedReason, in.OwnerUserID); err != nil {
+			return nil, err
+		}
+		err = appendEvent(ctx, tx, domain.VacancyEvent{
+			Type:        domain.EventVacancyStatusChanged,
+			VacancyID:   in.VacancyID,
+			OwnerUserID: ownerUserID,
+			ActorUserID: in.OwnerUserID,
+			Version:     version,
+			Status:      domain.StatusFilled,
+			OldStatus:   status,
+		})
+		if err != nil {
+			return nil, err
+		}
+		res.VacancyStatus, res.VacancyFilled = domain.StatusFilled, true
+	}
+
+	if err := tx.Commit(ctx); err != nil {
+		return nil, err
+	}
+	return &res, nil
+}
+
+// ListCandidateStageHistory returns the candidate's stage moves, newest
+// first. The caller's access is checked by the usecase.
+func (s *VacancyStorage) ListCandidateStageHistory(ctx context.Context, vacancyID, candidateID string) ([]domain.CandidateStageChange, error) {
+	rows, err := s.db.Query(ctx, `
+SELECT id, vacancy_id, candidate_id, from_stage, to_stage, reason, changed_by, changed_at
+FROM candidate_stage_history
+WHERE vacancy_id = $1 AND candidate_id = $2
+ORDER BY id DESC
+`, vacancyID, candidateID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	out := make([]domain.CandidateStageChange, 0)
+	for rows.Next() {
+		var c domain.CandidateStageChange
+		if err := rows.Scan(&c.ID, &c.VacancyID, &c.CandidateID, &c.FromStage, &c.ToStage, &c.Reason, &c.ChangedBy, &c.ChangedAt); err != nil {
+			return nil, err
+		}
+		out = append(out, c)
+	}
+	if rows.Err() != nil {
+		return nil, rows.Err()
+	}
+	return out, nil
+}
@@ -0,0 +1,80 @@
+package persistence
+
+import (
+	"context"
+	"errors"
+
+	"github.com/artem13815/hr/vacancy/internal/domain"
+	"github.com/jackc/pgx/v5"
+)
+
+// publishedFilter is what "published" means for the feed: explicitly public
+// and currently open. Drafts, paused and closed vacancies drop out of the
+// feed without losing the flag; deleted ones drop out for good.
+const publishedFilter = `is_public AND status = 'open' AND deleted_at IS NULL`
+
+// GetPublicVacancy returns a published vacancy regardless of owner, or
+// (nil, nil) when it does not exist or is not published.
+func (s *VacancyStorage) GetPublicVacancy(ctx context.Context, vacancyID string) (*domain.Vacancy, error) {
+	var vacancy domain.Vacancy
+	err := s.db.QueryRow(ctx, `
+SELECT `+vacancyColumns+`
+FROM vacancies
+WHERE id = $1 AND `+publishedFilter+`
+`, vacancyID).Scan(vacancyFields(&vacancy)...)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, nil
+		}
+		return nil, err
+	}
+
+	skills, err := loadSkills(ctx, s.db, vacancy.ID)
+	if err != nil {
+		return nil, err
+	}
+	vacancy.Skills = skills
+
+	return &vacancy, nil
+}
+
+// ListPublicVacancies returns published vacancies, most recently updated
+// first, optionally of a single owner.
+func (s *VacancyStorage) ListPublicVacancies(ctx context.Context, in domain.ListPublicVacanciesInput) ([]domain.Vacancy, error) {
+	rows, err := s.db.Query(ctx, `
+SELECT `+vacancyColumns+`
+FROM vacancies
+WHERE `+publishedFilter+`
+  AND ($1::bigint = 0 OR owner_user_id = $1)
+ORDER BY updated_at DESC, id DESC
+LIMIT $2
+`, in.OwnerUserID, in.Limit)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	vacancies := make([]domain.Vacancy, 0)
+	ids := make([]string, 0)
+	for rows.Next() {
+		var v domain.Vacancy
+		if err := rows.Scan(vacancyFields(&v)...); err != nil {
+			return nil, err
+		}
+		vacancies = append(vacancies, v)
+		ids = append(ids, v.ID)
+	}
+	if rows.Err() != nil {
+		return nil, rows.Err()
+	}
+
+	skills, err := loadSkillsBatch(ctx, s.db, ids)
+	if err != nil {
+		return nil, err
+	}
+	for i := range vacancies {
+		vacancies[i].Skills = skills[vacancies[i].ID]
+	}
+
+	return vacancies, nil
+}
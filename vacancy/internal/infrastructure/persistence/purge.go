@@ -0,0 +1,107 @@
+package persistence
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/artem13815/hr/vacancy/internal/domain"
+	"github.com/jackc/pgx/v5"
+)
+
+// ListPurgeableVacancies returns vacancies deleted at or before cutoff,
+// longest-deleted first. Vacancies whose purge started in an earlier run
+// and did not finish are included, so the purge resumes.
+func (s *VacancyStorage) ListPurgeableVacancies(ctx context.Context, cutoff time.Time, limit uint32) ([]string, error) {
+	rows, err := s.db.Query(ctx, `
+SELECT id
+FROM vacancies
+WHERE deleted_at <= $1
+ORDER BY deleted_at ASC, id ASC
+LIMIT $2
+`, cutoff, limit)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	ids := make([]string, 0)
+	for rows.Next() {
+		var id string
+		if err := rows.Scan(&id); err != nil {
+			return nil, err
+		}
+		ids = append(ids, id)
+	}
+	if rows.Err() != nil {
+		return nil, rows.Err()
+	}
+	return ids, nil
+}
+
+// StartVacancyPurge marks the vacancy as being purged, which ends its
+// restore window. Returns false when it was restored since it was listed.
+// Marking an already marked vacancy keeps the original time.
+func (s *VacancyStorage) StartVacancyPurge(ctx context.Context, vacancyID string, cutoff time.Time) (bool, error) {
+	cmd, err := s.db.Exec(ctx, `
+UPDATE vacancies
+SET purge_started_at = COALESCE(purge_started_at, NOW())
+WHERE id = $1 AND deleted_at <= $2
+`, vacancyID, cutoff)
+	if err != nil {
+		return false, err
+	}
+	return cmd.RowsAffected() > 0, nil
+}
+
+// PurgeVacancy hard-deletes a vacancy whose purge has started, with
+// everything the vacancy service keeps about it, and emits VacancyPurged
+// (actor 0, the system). The vacancy row goes first, under the
+// purge_started_at guard, so a vacancy that was never marked (or is
+// already gone) loses nothing and emits nothing. Skills, versions, status
+// history and collaborators go by ON DELETE CASCADE; the pipeline tables
+// have no foreign key and are cleared explicitly after it. The outbox
+// keeps its events.
+func (s *VacancyStorage) PurgeVacancy(ctx context.Context, vacancyID string) error {
+	tx, err := s.db.Begin(ctx)
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback(ctx)
+
+	var vacancy domain.Vacancy
+	err = tx.QueryRow(ctx, `
+DELETE FROM vacancies
+WHERE id = $1 AND purge_started_at IS NOT NULL
+RETURNING owner_user_id, version, status
+`, vacancyID).Scan(&vacancy.OwnerUserID, &vacancy.Version, &vacancy.Status)
+	switch {
+	case errors.Is(err, pgx.ErrNoRows):
+		return nil
+	case err != nil:
+		return fmt.Errorf("purge vacancy: %w", err)
+	}
+
+	for _, q := range []string{
+		`DELETE FROM candidate_stage_history WHERE vacancy_id = $1`,
+		`DELETE FROM candidate_stages WHERE vacancy_id = $1`,
+	} {
+		if _, err := tx.Exec(ctx, q, vacancyID); err != nil {
+			return fmt.Errorf("purge vacancy: %w", err)
+		}
+	}
+
+	err = appendEvent(ctx, tx, domain.VacancyEvent{
+		Type:        domain.EventVacancyPurged,
+		VacancyID:   vacancyID,
+		OwnerUserID: vacancy.OwnerUserID,
+		Version:     vacancy.Version,
+		Status:      vacancy.Status,
+	})
+	if err != nil {
+		return err
+	}
+
+	return tx.Commit(ctx)
+}
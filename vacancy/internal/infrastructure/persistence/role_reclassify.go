@@ -0,0 +1,111 @@
+package persistence
+
+import (
+	"context"
+	"errors"
+
+	"github.com/artem13815/hr/vacancy/internal/domain"
+	"github.com/jackc/pgx/v5"
+)
+
+// ListRoleClassificationTargets selects vacancies whose role came from one
+// of in.Sources, least recently classified first. Archived vacancies are
+// skipped: nothing is scored against them any more.
+func (s *VacancyStorage) ListRoleClassificationTargets(ctx context.Context, in domain.ReclassifyRolesInput) ([]domain.RoleClassificationTarget, error) {
+	var before any
+	if !in.ClassifiedBefore.IsZero() {
+		before = in.ClassifiedBefore
+	}
+	rows, err := s.db.Query(ctx, `
+SELECT id, title, description, role, version
+FROM vacancies
+WHERE role_source = ANY($1)
+  AND NOT role_locked
+  AND status <> 'archived'
+  AND deleted_at IS NULL
+  AND ($2::timestamptz IS NULL OR role_classified_at < $2)
+ORDER BY role_classified_at ASC, id ASC
+LIMIT $3
+`, in.Sources, before, in.Limit)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	targets := make([]domain.RoleClassificationTarget, 0)
+	for rows.Next() {
+		var t domain.RoleClassificationTarget
+		if err := rows.Scan(&t.ID, &t.Title, &t.Description, &t.Role, &t.Version); err != nil {
+			return nil, err
+		}
+		targets = append(targets, t)
+	}
+	if rows.Err() != nil {
+		return nil, rows.Err()
+	}
+	return targets, nil
+}
+
+// SetVacancyRole stores a reclassified role when the vacancy is still at
+// in.Version and its role was not pinned meanwhile; false means the
+// row moved on and was left alone. A role that actually changes is a new
+// version like any edit: the version is bumped, a new snapshot recorded
+// (created_by 0, the system) and VacancyUpdated emitted, so snapshots stay
+// immutable and subscribers see the change. Confirming the current role
+// only refreshes role_source and role_classified_at.
+func (s *VacancyStorage) SetVacancyRole(ctx context.Context, in domain.SetVacancyRoleInput) (bool, error) {
+	tx, err := s.db.Begin(ctx)
+	if err != nil {
+		return false, err
+	}
+	defer tx.Rollback(ctx)
+
+	// SET expressions see the row as it was, so `role = $3` compares with
+	// the stored role.
+	var vacancy domain.Vacancy
+	err = tx.QueryRow(ctx, `
+UPDATE vacancies
+SET role = $3,
+    role_source = $4,
+    role_classified_at = NOW(),
+    version = CASE WHEN role = $3 THEN version ELSE version + 1 END,
+    updated_at = CASE WHEN role = $3 THEN updated_at ELSE NOW() END
+WHERE id = $1 AND version = $2 AND NOT role_locked
+RETURNING `+vacancyColumns+`
+`, in.VacancyID, in.Version, in.Role, in.Source).Scan(vacancyFields(&vacancy)...)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return false, nil
+		}
+		return false, err
+	}
+
+	if vacancy.Version != in.Version {
+		skills, err := loadSkills(ctx, tx, vacancy.ID)
+		if err != nil {
+			return false, err
+		}
+		vacancy.Skills = skills
+
+		if err := insertVersionSnapshot(ctx, tx, &vacancy, 0); err != nil {
+			return false, err
+		}
+
+		err = appendEvent(ctx, tx, domain.VacancyEvent{
+			Type:        domain.EventVacancyUpdated,
+			VacancyID:   vacancy.ID,
+			OwnerUserID: vacancy.OwnerUserID,
+			Version:     vacancy.Version,
+			OldVersion:  in.Version,
+			Status:      vacancy.Status,
+		})
+		if err != nil {
+			return false, err
+		}
+	}
+
+	if err := tx.Commit(ctx); err != nil {
+		return false, err
+	}
+	return true, nil
+}
@@ -0,0 +1,37 @@
+package persistence
+
+import (
+	"context"
+	"errors"
+
+	"github.com/artem13815/hr/vacancy/internal/domain"
+	"github.com/jackc/pgx/v5"
+)
+
+// SetVacancyVisibility flips is_public. Visibility is not part of the
+// content, so the version and the snapshot history are left alone. Returns
+// (nil, nil) when the vacancy is gone or not ours.
+func (s *VacancyStorage) SetVacancyVisibility(ctx context.Context, in domain.SetVacancyVisibilityInput) (*domain.Vacancy, error) {
+	var vacancy domain.Vacancy
+	err := s.db.QueryRow(ctx, `
+UPDATE vacancies
+SET is_public = $1,
+    updated_at = NOW()
+WHERE id = $2 AND `+editAccess("vacancies", "$3", "$4")+`
+RETURNING `+vacancyColumns+`
+`, in.IsPublic, in.VacancyID, in.IsAdmin, in.OwnerUserID).Scan(vacancyFields(&vacancy)...)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, nil
+		}
+		return nil, err
+	}
+
+	skills, err := loadSkills(ctx, s.db, vacancy.ID)
+	if err != nil {
+		return nil, err
+	}
+	vacancy.Skills = skills
+
+	return &vacancy, nil
+}
@@ -0,0 +1,29 @@
+package persistence
+
+import (
+	"context"
+	"errors"
+
+	"github.com/jackc/pgx/v5"
+)
+
+// StatusBeforeArchive returns the status the vacancy had when it was last
+// archived, or "" when the history has no such entry (vacancies archived
+// before the history table existed).
+func (s *VacancyStorage) StatusBeforeArchive(ctx context.Context, vacancyID string) (string, error) {
+	var status string
+	err := s.db.QueryRow(ctx, `
+SELECT from_status
+FROM vacancy_status_history
+WHERE vacancy_id = $1 AND to_status = 'archived'
+ORDER BY id DESC
+LIMIT 1
+`, vacancyID).Scan(&status)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return "", nil
+		}
+		return "", err
+	}
+	return status, nil
+}
@@ -0,0 +1,54 @@
+package persistence
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/artem13815/hr/vacancy/internal/domain"
+)
+
+// skillTaxonomyLockKey serializes concurrent boots: two replicas syncing at
+// once would otherwise race on the DELETE + INSERT below.
+const skillTaxonomyLockKey = 0x736b696c6c73 // "skills"
+
+// SyncSkillTaxonomy replaces the skill_taxonomy / skill_aliases contents with
+// the bundled seed in one transaction, so readers never see a half-written
+// dictionary. Runs at every boot; the seed is small (hundreds of rows).
+func (s *VacancyStorage) SyncSkillTaxonomy(ctx context.Context, entries []domain.SkillEntry) error {
+	tx, err := s.db.Begin(ctx)
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback(ctx)
+
+	if _, err := tx.Exec(ctx, `SELECT pg_advisory_xact_lock($1)`, int64(skillTaxonomyLockKey)); err != nil {
+		return fmt.Errorf("lock skill taxonomy: %w", err)
+	}
+	// Aliases go with their skill through ON DELETE CASCADE.
+	if _, err := tx.Exec(ctx, `DELETE FROM skill_taxonomy`); err != nil {
+		return fmt.Errorf("clear skill taxonomy: %w", err)
+	}
+
+	for _, e := range entries {
+		_, err := tx.Exec(ctx, `
+INSERT INTO skill_taxonomy (name, name_ru, category)
+VALUES ($1, $2, $3)
+`, e.Name, e.NameRU, e.Category)
+		if err != nil {
+			return fmt.Errorf("insert skill %q: %w", e.Name, err)
+		}
+		for _, spelling := range e.Spellings() {
+			_, err := tx.Exec(ctx, `
+INSERT INTO skill_aliases (alias, spelling, skill_name)
+VALUES ($1, $2, $3)
+ON CONFLICT (alias) DO NOTHING
+`, domain.NormalizeSkillKey(spelling), strings.TrimSpace(spelling), e.Name)
+			if err != nil {
+				return fmt.Errorf("insert alias %q: %w", spelling, err)
+			}
+		}
+	}
+
+	return tx.Commit(ctx)
+}
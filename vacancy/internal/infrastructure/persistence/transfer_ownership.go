@@ -0,0 +1,77 @@
+package persistence
+
+import (
+	"context"
+
+	"github.com/artem13815/hr/vacancy/internal/domain"
+)
+
+// TransferVacancyOwnership moves one batch of vacancies to the new owner in
+// a single statement. A collaborator grant the new owner held on a moved
+// vacancy is dropped, since owners need none. Version, status and history
+// are left as they are: the content did not change. Each moved vacancy
+// still gets a VacancyUpdated event in the same transaction, with version
+// equal to old_version, because events carry the owner.
+func (s *VacancyStorage) TransferVacancyOwnership(ctx context.Context, in domain.TransferVacancyOwnershipInput) (uint32, error) {
+	tx, err := s.db.Begin(ctx)
+	if err != nil {
+		return 0, err
+	}
+	defer tx.Rollback(ctx)
+
+	rows, err := tx.Query(ctx, `
+WITH moved AS (
+    UPDATE vacancies
+    SET owner_user_id = $2,
+        updated_at = NOW()
+    WHERE id IN (
+        SELECT id FROM vacancies
+        WHERE owner_user_id = $1
+        ORDER BY id
+        LIMIT $3
+        FOR UPDATE
+    )
+    RETURNING id, version, status
+), dropped AS (
+    DELETE FROM vacancy_collaborators
+    WHERE user_id = $2 AND vacancy_id IN (SELECT id FROM moved)
+)
+SELECT id, version, status FROM moved
+`, in.FromUserID, in.ToUserID, in.Limit)
+	if err != nil {
+		return 0, err
+	}
+	moved := make([]domain.Vacancy, 0)
+	for rows.Next() {
+		var v domain.Vacancy
+		if err := rows.Scan(&v.ID, &v.Version, &v.Status); err != nil {
+			rows.Close()
+			return 0, err
+		}
+		moved = append(moved, v)
+	}
+	rows.Close()
+	if rows.Err() != nil {
+		return 0, rows.Err()
+	}
+
+	for _, v := range moved {
+		err := appendEvent(ctx, tx, domain.VacancyEvent{
+			Type:        domain.EventVacancyUpdated,
+			VacancyID:   v.ID,
+			OwnerUserID: in.ToUserID,
+			ActorUserID: in.ActorUserID,
+			Version:     v.Version,
+			OldVersion:  v.Version,
+			Status:      v.Status,
+		})
+		if err != nil {
+			return 0, err
+		}
+	}
+
+	if err := tx.Commit(ctx); err != nil {
+		return 0, err
+	}
+	return uint32(len(moved)), nil
+}
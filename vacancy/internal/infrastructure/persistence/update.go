@@ -2,10 +2,18 @@ package persistence
 
 import (
 	"context"
+	"errors"
 
 	"github.com/artem13815/hr/vacancy/internal/domain"
+	"github.com/jackc/pgx/v5"
 )
 
+// UpdateVacancy applies the update only when the row is still at
+// in.ExpectedVersion (zero disables the check). A zero-row UPDATE is then
+// ambiguous — the vacancy is gone / not ours, or someone else saved first —
+// so we re-read the version under the same ownership predicate to tell the
+// two apart: (nil, nil) for the former, *domain.VersionConflictError for the
+// latter. A role kept under the lock keeps its role_classified_at.
 func (s *VacancyStorage) UpdateVacancy(ctx context.Context, in domain.UpdateVacancyInput) (*domain.Vacancy, error) {
 	tx, err := s.db.Begin(ctx)
 	if err != nil {
@@ -13,20 +21,41 @@ func (s *VacancyStorage) UpdateVacancy(ctx context.Context, in domain.UpdateVaca
 	}
 	defer tx.Rollback(ctx)
 
+	args := []any{in.Title, in.Description, in.Role, in.VacancyID, in.IsAdmin, in.OwnerUserID, in.ExpectedVersion}
+	args = append(args, attributeArgs(in.Attributes)...)
+	args = append(args, in.RoleSource, in.RoleLocked)
+	args = append(args, scoringPolicyArgs(in.ScoringPolicy)...)
 	cmd, err := tx.Exec(ctx, `
 UPDATE vacancies
 SET title = $1,
     description = $2,
     role = $3,
+    role_source = $17,
+    role_locked = $18,
+    role_classified_at = CASE WHEN $18 AND role_locked AND role = $3 THEN role_classified_at ELSE NOW() END,
+    location = CASE WHEN $8 THEN $9 ELSE location END,
+    remote_policy = CASE WHEN $8 THEN $10 ELSE remote_policy END,
+    salary_min = CASE WHEN $8 THEN $11 ELSE salary_min END,
+    salary_max = CASE WHEN $8 THEN $12 ELSE salary_max END,
+    salary_currency = CASE WHEN $8 THEN $13 ELSE salary_currency END,
+    employment_type = CASE WHEN $8 THEN $14 ELSE employment_type END,
+    seniority = CASE WHEN $8 THEN $15 ELSE seniority END,
+    headcount = CASE WHEN $8 THEN $16 ELSE headcount END,
+    score_must_have_penalty = CASE WHEN $19 THEN $20 ELSE score_must_have_penalty END,
+    score_nice_to_have_bonus = CASE WHEN $19 THEN $21 ELSE score_nice_to_have_bonus END,
+    score_hire_threshold = CASE WHEN $19 THEN $22 ELSE score_hire_threshold END,
+    score_maybe_threshold = CASE WHEN $19 THEN $23 ELSE score_maybe_threshold END,
+    score_reject_missing_must_have = CASE WHEN $19 THEN $24 ELSE score_reject_missing_must_have END,
     version = version + 1,
     updated_at = NOW()
-WHERE id = $4 AND ($5 OR owner_user_id = $6)
-`, in.Title, in.Description, in.Role, in.VacancyID, in.IsAdmin, in.OwnerUserID)
+WHERE id = $4 AND `+editAccess("vacancies", "$5", "$6")+`
+  AND ($7::int = 0 OR version = $7)
+`, args...)
 	if err != nil {
 		return nil, err
 	}
 	if cmd.RowsAffected() == 0 {
-		return nil, nil
+		return nil, currentVersionConflict(ctx, tx, in)
 	}
 
 	_, err = tx.Exec(ctx, `DELETE FROM vacancy_skills WHERE vacancy_id = $1`, in.VacancyID)
@@ -46,29 +75,80 @@ VALUES ($1, $2, $3, $4, $5, $6)
 
 	var vacancy domain.Vacancy
 	err = tx.QueryRow(ctx, `
-SELECT id, owner_user_id, title, description, role, status, version, created_at, updated_at
+SELECT `+vacancyColumns+`
 FROM vacancies
 WHERE id = $1
-`, in.VacancyID).Scan(
-		&vacancy.ID,
-		&vacancy.OwnerUserID,
-		&vacancy.Title,
-		&vacancy.Description,
-		&vacancy.Role,
-		&vacancy.Status,
-		&vacancy.Version,
-		&vacancy.CreatedAt,
-		&vacancy.UpdatedAt,
-	)
+`, in.VacancyID).Scan(vacancyFields(&vacancy)...)
 	if err != nil {
 		return nil, err
 	}
 
 	vacancy.Skills = in.Skills
 
+	if err := insertVersionSnapshot(ctx, tx, &vacancy, in.OwnerUserID); err != nil {
+		return nil, err
+	}
+
+	err = appendEvent(ctx, tx, domain.VacancyEvent{
+		Type:        domain.EventVacancyUpdated,
+		VacancyID:   vacancy.ID,
+		OwnerUserID: vacancy.OwnerUserID,
+		ActorUserID: in.OwnerUserID,
+		Version:     vacancy.Version,
+		OldVersion:  vacancy.Version - 1,
+		Status:      vacancy.Status,
+	})
+	if err != nil {
+		return nil, err
+	}
+
 	if err := tx.Commit(ctx); err != nil {
 		return nil, err
 	}
 
 	return &vacancy, nil
 }
+
+// attributeArgs binds $8..$16 of the UPDATE ($17 and $18 are the role
+// source and lock): a "replace" flag followed by the new values. A nil attrs
+// keeps the stored columns and binds unused placeholders.
+func attributeArgs(attrs *domain.VacancyAttributes) []any {
+	a := domain.VacancyAttributes{Headcount: 1}
+	if attrs != nil {
+		a = *attrs
+	}
+	return []any{
+		attrs != nil,
+		a.Location, a.RemotePolicy, a.SalaryMin, a.SalaryMax,
+		a.SalaryCurrency, a.EmploymentType, a.Seniority, a.Headcount,
+	}
+}
+
+// scoringPolicyArgs binds $19..$24 of the UPDATE the same way: a "replace"
+// flag, then the policy. A nil policy keeps the stored one.
+func scoringPolicyArgs(policy *domain.ScoringPolicy) []any {
+	p := policy.OrDefault()
+	return []any{
+		policy != nil,
+		p.MustHavePenalty, p.NiceToHaveBonus, p.HireThreshold, p.MaybeThreshold, p.RejectOnMissingMustHave,
+	}
+}
+
+// currentVersionConflict resolves a zero-row conditional UPDATE. Returns nil
+// when the vacancy is not visible to the caller (the usecase maps that to
+// not-found) and a *domain.VersionConflictError otherwise.
+func currentVersionConflict(ctx context.Context, tx pgx.Tx, in domain.UpdateVacancyInput) error {
+	var current uint32
+	err := tx.QueryRow(ctx, `
+SELECT version
+FROM vacancies
+WHERE id = $1 AND `+editAccess("vacancies", "$2", "$3")+`
+`, in.VacancyID, in.IsAdmin, in.OwnerUserID).Scan(&current)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil
+		}
+		return err
+	}
+	return &domain.VersionConflictError{CurrentVersion: current}
+}
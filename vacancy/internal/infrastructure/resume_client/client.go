@@ -0,0 +1,57 @@
+package resume_client
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"time"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials/insecure"
+
+	"github.com/artem13815/hr/vacancy/internal/domain"
+	pb "github.com/artem13815/hr/vacancy/internal/pb/resume_api"
+	"github.com/artem13815/hr/vacancy/internal/usecase"
+)
+
+// purgeTimeout bounds one PurgeVacancyCandidates call. It deletes a single
+// vacancy's candidates in one transaction, resume bytes included.
+const purgeTimeout = 60 * time.Second
+
+// Client implements usecase.CandidatePurger over the resume service's
+// internal PurgeVacancyCandidates RPC.
+type Client struct {
+	client pb.ResumeServiceClient
+}
+
+var _ usecase.CandidatePurger = (*Client)(nil)
+
+// New dials the resume service. Same lifetime rules as
+// multiagent_client.New: one long-lived conn, closed by the returned
+// cleanup during shutdown.
+func New(addr string) (*Client, func(), error) {
+	conn, err := grpc.NewClient(addr, grpc.WithTransportCredentials(insecure.NewCredentials()))
+	if err != nil {
+		return nil, nil, fmt.Errorf("dial resume service: %w", err)
+	}
+	cleanup := func() {
+		if err := conn.Close(); err != nil {
+			slog.Warn("close resume client conn", "err", err)
+		}
+	}
+	return &Client{client: pb.NewResumeServiceClient(conn)}, cleanup, nil
+}
+
+func (c *Client) PurgeVacancyCandidates(ctx context.Context, vacancyID string) (*domain.PurgedCandidates, error) {
+	ctx, cancel := context.WithTimeout(ctx, purgeTimeout)
+	defer cancel()
+
+	resp, err := c.client.PurgeVacancyCandidates(ctx, &pb.PurgeVacancyCandidatesRequest{VacancyId: vacancyID})
+	if err != nil {
+		return nil, fmt.Errorf("resume.PurgeVacancyCandidates: %w", err)
+	}
+	return &domain.PurgedCandidates{
+		Candidates: resp.GetCandidates(),
+		Resumes:    resp.GetResumes(),
+	}, nil
+}
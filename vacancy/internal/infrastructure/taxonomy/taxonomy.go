@@ -0,0 +1,69 @@
+// Package taxonomy loads the canonical skill dictionary bundled with the
+// binary. The seed is the single source of truth: vacancy serves it from
+// memory and mirrors it into the skill_taxonomy / skill_aliases tables at
+// boot so analysis can resolve aliases without a second copy of the file.
+//
+// Adding a skill or a spelling is a one-file commit to skills.json.
+package taxonomy
+
+import (
+	_ "embed"
+	"encoding/json"
+	"fmt"
+	"strings"
+	"unicode/utf8"
+
+	"github.com/artem13815/hr/vacancy/internal/domain"
+)
+
+// maxNameRunes matches vacancy_skills.name.
+const maxNameRunes = 64
+
+//go:embed skills.json
+var seed []byte
+
+type entryJSON struct {
+	Name     string   `json:"name"`
+	NameRU   string   `json:"name_ru"`
+	Category string   `json:"category"`
+	Aliases  []string `json:"aliases"`
+}
+
+// Load parses and validates the bundled seed. A broken seed fails the boot
+// loudly — same reasoning as prompts.New: better no process than one that
+// silently canonicalizes "Go" to the wrong skill.
+func Load() (*domain.SkillTaxonomy, error) {
+	var raw []entryJSON
+	if err := json.Unmarshal(seed, &raw); err != nil {
+		return nil, fmt.Errorf("parse skill taxonomy: %w", err)
+	}
+
+	entries := make([]domain.SkillEntry, 0, len(raw))
+	owner := make(map[string]string)
+	for _, r := range raw {
+		e := domain.SkillEntry{
+			Name:     strings.TrimSpace(r.Name),
+			NameRU:   strings.TrimSpace(r.NameRU),
+			Category: strings.TrimSpace(r.Category),
+			Aliases:  r.Aliases,
+		}
+		if e.Name == "" || utf8.RuneCountInString(e.Name) > maxNameRunes {
+			return nil, fmt.Errorf("skill taxonomy: invalid name %q", r.Name)
+		}
+		for _, spelling := range e.Spellings() {
+			key := domain.NormalizeSkillKey(spelling)
+			if key == "" {
+				return nil, fmt.Errorf("skill taxonomy: empty spelling in %q", e.Name)
+			}
+			// The same entry may list a spelling twice (e.g. an alias that
+			// only differs from the name in case); two entries may not.
+			if prev, dup := owner[key]; dup && prev != e.Name {
+				return nil, fmt.Errorf("skill taxonomy: %q claimed by both %q and %q", spelling, prev, e.Name)
+			}
+			owner[key] = e.Name
+		}
+		entries = append(entries, e)
+	}
+
+	return domain.NewSkillTaxonomy(entries), nil
+}
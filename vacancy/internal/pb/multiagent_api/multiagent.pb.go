@@ -90,9 +90,17 @@ type GenerateDecisionRequest struct {
 	ResumeText         string                 `protobuf:"bytes,12,opt,name=resume_text,json=resumeText,proto3" json:"resume_text,omitempty"`
 	// role selects the prompt template multiagent uses for this vacancy.
 	// Empty -> default prompt. See vacancy.Vacancy.role for the source.
-	Role          string `protobuf:"bytes,13,opt,name=role,proto3" json:"role,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+	Role string `protobuf:"bytes,13,opt,name=role,proto3" json:"role,omitempty"`
+	// Vacancy attributes the decision weighs against the resume: the
+	// seniority level ("junior", "senior", ...) and the salary range the
+	// vacancy offers. Empty / zero when the vacancy does not state them;
+	// salary_max = 0 means no upper bound.
+	VacancySeniority      string `protobuf:"bytes,14,opt,name=vacancy_seniority,json=vacancySeniority,proto3" json:"vacancy_seniority,omitempty"`
+	VacancySalaryMin      uint64 `protobuf:"varint,15,opt,name=vacancy_salary_min,json=vacancySalaryMin,proto3" json:"vacancy_salary_min,omitempty"`
+	VacancySalaryMax      uint64 `protobuf:"varint,16,opt,name=vacancy_salary_max,json=vacancySalaryMax,proto3" json:"vacancy_salary_max,omitempty"`
+	VacancySalaryCurrency string `protobuf:"bytes,17,opt,name=vacancy_salary_currency,json=vacancySalaryCurrency,proto3" json:"vacancy_salary_currency,omitempty"`
+	unknownFields         protoimpl.UnknownFields
+	sizeCache             protoimpl.SizeCache
 }
 
 func (x *GenerateDecisionRequest) Reset() {
@@ -216,6 +224,34 @@ func (x *GenerateDecisionRequest) GetRole() string {
 	return ""
 }
 
+func (x *GenerateDecisionRequest) GetVacancySeniority() string {
+	if x != nil {
+		return x.VacancySeniority
+	}
+	return ""
+}
+
+func (x *GenerateDecisionRequest) GetVacancySalaryMin() uint64 {
+	if x != nil {
+		return x.VacancySalaryMin
+	}
+	return 0
+}
+
+func (x *GenerateDecisionRequest) GetVacancySalaryMax() uint64 {
+	if x != nil {
+		return x.VacancySalaryMax
+	}
+	return 0
+}
+
+func (x *GenerateDecisionRequest) GetVacancySalaryCurrency() string {
+	if x != nil {
+		return x.VacancySalaryCurrency
+	}
+	return ""
+}
+
 type AgentResult struct {
 	state          protoimpl.MessageState `protogen:"open.v1"`
 	AgentName      string                 `protobuf:"bytes,1,opt,name=agent_name,json=agentName,proto3" json:"agent_name,omitempty"`
@@ -294,10 +330,16 @@ type GenerateDecisionResponse struct {
 	AgentResults      []*AgentResult         `protobuf:"bytes,6,rep,name=agent_results,json=agentResults,proto3" json:"agent_results,omitempty"`
 	RawTrace          string                 `protobuf:"bytes,7,opt,name=raw_trace,json=rawTrace,proto3" json:"raw_trace,omitempty"`
 	CreatedAt         *timestamppb.Timestamp `protobuf:"bytes,8,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
-	YearsExperience   float32                `protobuf:"fixed32,9,opt,name=years_experience,json=yearsExperience,proto3" json:"years_experience,omitempty"`
-	CandidateSummary  string                 `protobuf:"bytes,10,opt,name=candidate_summary,json=candidateSummary,proto3" json:"candidate_summary,omitempty"`
-	unknownFields     protoimpl.UnknownFields
-	sizeCache         protoimpl.SizeCache
+	// years_experience is the model's read of total professional experience.
+	// 0 means the model could not infer one — analysis falls back to its
+	// heuristic in that case.
+	YearsExperience float32 `protobuf:"fixed32,9,opt,name=years_experience,json=yearsExperience,proto3" json:"years_experience,omitempty"`
+	// candidate_summary is a short LLM-written profile blurb that replaces
+	// the heuristic resume-text preview. Empty means analysis keeps its
+	// 320-rune fallback.
+	CandidateSummary string `protobuf:"bytes,10,opt,name=candidate_summary,json=candidateSummary,proto3" json:"candidate_summary,omitempty"`
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
 }
 
 func (x *GenerateDecisionResponse) Reset() {
@@ -499,11 +541,267 @@ func (x *ClassifyRoleResponse) GetRole() string {
 	return ""
 }
 
+type SuggestSkillsRequest struct {
+	state       protoimpl.MessageState `protogen:"open.v1"`
+	Title       string                 `protobuf:"bytes,1,opt,name=title,proto3" json:"title,omitempty"`
+	Description string                 `protobuf:"bytes,2,opt,name=description,proto3" json:"description,omitempty"`
+	// max_skills caps the answer; 0 means the server default (15).
+	MaxSkills     uint32 `protobuf:"varint,3,opt,name=max_skills,json=maxSkills,proto3" json:"max_skills,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SuggestSkillsRequest) Reset() {
+	*x = SuggestSkillsRequest{}
+	mi := &file_multiagent_api_multiagent_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SuggestSkillsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SuggestSkillsRequest) ProtoMessage() {}
+
+func (x *SuggestSkillsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_multiagent_api_multiagent_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SuggestSkillsRequest.ProtoReflect.Descriptor instead.
+func (*SuggestSkillsRequest) Descriptor() ([]byte, []int) {
+	return file_multiagent_api_multiagent_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *SuggestSkillsRequest) GetTitle() string {
+	if x != nil {
+		return x.Title
+	}
+	return ""
+}
+
+func (x *SuggestSkillsRequest) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+func (x *SuggestSkillsRequest) GetMaxSkills() uint32 {
+	if x != nil {
+		return x.MaxSkills
+	}
+	return 0
+}
+
+type SuggestedSkill struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	Name  string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	// weight is in [0, 1] — the range vacancy accepts for SkillWeight.weight.
+	Weight        float32 `protobuf:"fixed32,2,opt,name=weight,proto3" json:"weight,omitempty"`
+	MustHave      bool    `protobuf:"varint,3,opt,name=must_have,json=mustHave,proto3" json:"must_have,omitempty"`
+	NiceToHave    bool    `protobuf:"varint,4,opt,name=nice_to_have,json=niceToHave,proto3" json:"nice_to_have,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SuggestedSkill) Reset() {
+	*x = SuggestedSkill{}
+	mi := &file_multiagent_api_multiagent_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SuggestedSkill) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SuggestedSkill) ProtoMessage() {}
+
+func (x *SuggestedSkill) ProtoReflect() protoreflect.Message {
+	mi := &file_multiagent_api_multiagent_proto_msgTypes[6]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SuggestedSkill.ProtoReflect.Descriptor instead.
+func (*SuggestedSkill) Descriptor() ([]byte, []int) {
+	return file_multiagent_api_multiagent_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *SuggestedSkill) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *SuggestedSkill) GetWeight() float32 {
+	if x != nil {
+		return x.Weight
+	}
+	return 0
+}
+
+func (x *SuggestedSkill) GetMustHave() bool {
+	if x != nil {
+		return x.MustHave
+	}
+	return false
+}
+
+func (x *SuggestedSkill) GetNiceToHave() bool {
+	if x != nil {
+		return x.NiceToHave
+	}
+	return false
+}
+
+type SuggestSkillsResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Most important first. Never both must_have and nice_to_have.
+	Skills        []*SuggestedSkill `protobuf:"bytes,1,rep,name=skills,proto3" json:"skills,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SuggestSkillsResponse) Reset() {
+	*x = SuggestSkillsResponse{}
+	mi := &file_multiagent_api_multiagent_proto_msgTypes[7]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SuggestSkillsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SuggestSkillsResponse) ProtoMessage() {}
+
+func (x *SuggestSkillsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_multiagent_api_multiagent_proto_msgTypes[7]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SuggestSkillsResponse.ProtoReflect.Descriptor instead.
+func (*SuggestSkillsResponse) Descriptor() ([]byte, []int) {
+	return file_multiagent_api_multiagent_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *SuggestSkillsResponse) GetSkills() []*SuggestedSkill {
+	if x != nil {
+		return x.Skills
+	}
+	return nil
+}
+
+type ListRolesRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListRolesRequest) Reset() {
+	*x = ListRolesRequest{}
+	mi := &file_multiagent_api_multiagent_proto_msgTypes[8]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListRolesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListRolesRequest) ProtoMessage() {}
+
+func (x *ListRolesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_multiagent_api_multiagent_proto_msgTypes[8]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListRolesRequest.ProtoReflect.Descriptor instead.
+func (*ListRolesRequest) Descriptor() ([]byte, []int) {
+	return file_multiagent_api_multiagent_proto_rawDescGZIP(), []int{8}
+}
+
+type ListRolesResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Sorted, with "default" last.
+	Roles         []string `protobuf:"bytes,1,rep,name=roles,proto3" json:"roles,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListRolesResponse) Reset() {
+	*x = ListRolesResponse{}
+	mi := &file_multiagent_api_multiagent_proto_msgTypes[9]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListRolesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListRolesResponse) ProtoMessage() {}
+
+func (x *ListRolesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_multiagent_api_multiagent_proto_msgTypes[9]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListRolesResponse.ProtoReflect.Descriptor instead.
+func (*ListRolesResponse) Descriptor() ([]byte, []int) {
+	return file_multiagent_api_multiagent_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *ListRolesResponse) GetRoles() []string {
+	if x != nil {
+		return x.Roles
+	}
+	return nil
+}
+
 var File_multiagent_api_multiagent_proto protoreflect.FileDescriptor
 
 const file_multiagent_api_multiagent_proto_rawDesc = "" +
 	"\n" +
-	"\x1fmultiagent_api/multiagent.proto\x12\x15multiagent.service.v1\x1a\x1fgoogle/protobuf/timestamp.proto\"\x9a\x04\n" +
+	"\x1fmultiagent_api/multiagent.proto\x12\x15multiagent.service.v1\x1a\x1fgoogle/protobuf/timestamp.proto\"\xdb\x05\n" +
 	"\x17GenerateDecisionRequest\x12\x14\n" +
 	"\x05model\x18\x01 \x01(\tR\x05model\x124\n" +
 	"\x04mode\x18\x02 \x01(\x0e2 .multiagent.service.v1.AgentModeR\x04mode\x12#\n" +
@@ -520,7 +818,11 @@ const file_multiagent_api_multiagent_proto_rawDesc = "" +
 	"matchScore\x12\x1f\n" +
 	"\vresume_text\x18\f \x01(\tR\n" +
 	"resumeText\x12\x12\n" +
-	"\x04role\x18\r \x01(\tR\x04role\"\x8f\x01\n" +
+	"\x04role\x18\r \x01(\tR\x04role\x12+\n" +
+	"\x11vacancy_seniority\x18\x0e \x01(\tR\x10vacancySeniority\x12,\n" +
+	"\x12vacancy_salary_min\x18\x0f \x01(\x04R\x10vacancySalaryMin\x12,\n" +
+	"\x12vacancy_salary_max\x18\x10 \x01(\x04R\x10vacancySalaryMax\x126\n" +
+	"\x17vacancy_salary_currency\x18\x11 \x01(\tR\x15vacancySalaryCurrency\"\x8f\x01\n" +
 	"\vAgentResult\x12\x1d\n" +
 	"\n" +
 	"agent_name\x18\x01 \x01(\tR\tagentName\x12\x18\n" +
@@ -548,15 +850,33 @@ const file_multiagent_api_multiagent_proto_rawDesc = "" +
 	"\x05title\x18\x01 \x01(\tR\x05title\x12 \n" +
 	"\vdescription\x18\x02 \x01(\tR\vdescription\"*\n" +
 	"\x14ClassifyRoleResponse\x12\x12\n" +
-	"\x04role\x18\x01 \x01(\tR\x04role*l\n" +
+	"\x04role\x18\x01 \x01(\tR\x04role\"m\n" +
+	"\x14SuggestSkillsRequest\x12\x14\n" +
+	"\x05title\x18\x01 \x01(\tR\x05title\x12 \n" +
+	"\vdescription\x18\x02 \x01(\tR\vdescription\x12\x1d\n" +
+	"\n" +
+	"max_skills\x18\x03 \x01(\rR\tmaxSkills\"{\n" +
+	"\x0eSuggestedSkill\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\x12\x16\n" +
+	"\x06weight\x18\x02 \x01(\x02R\x06weight\x12\x1b\n" +
+	"\tmust_have\x18\x03 \x01(\bR\bmustHave\x12 \n" +
+	"\fnice_to_have\x18\x04 \x01(\bR\n" +
+	"niceToHave\"V\n" +
+	"\x15SuggestSkillsResponse\x12=\n" +
+	"\x06skills\x18\x01 \x03(\v2%.multiagent.service.v1.SuggestedSkillR\x06skills\"\x12\n" +
+	"\x10ListRolesRequest\")\n" +
+	"\x11ListRolesResponse\x12\x14\n" +
+	"\x05roles\x18\x01 \x03(\tR\x05roles*l\n" +
 	"\tAgentMode\x12\x1a\n" +
 	"\x16AGENT_MODE_UNSPECIFIED\x10\x00\x12\x13\n" +
 	"\x0fAGENT_MODE_FAST\x10\x01\x12\x17\n" +
 	"\x13AGENT_MODE_BALANCED\x10\x02\x12\x15\n" +
-	"\x11AGENT_MODE_STRICT\x10\x032\xf1\x01\n" +
+	"\x11AGENT_MODE_STRICT\x10\x032\xbd\x03\n" +
 	"\x11MultiAgentService\x12s\n" +
 	"\x10GenerateDecision\x12..multiagent.service.v1.GenerateDecisionRequest\x1a/.multiagent.service.v1.GenerateDecisionResponse\x12g\n" +
-	"\fClassifyRole\x12*.multiagent.service.v1.ClassifyRoleRequest\x1a+.multiagent.service.v1.ClassifyRoleResponseB=Z;github.com/artem13815/hr/vacancy/internal/pb/multiagent_apib\x06proto3"
+	"\fClassifyRole\x12*.multiagent.service.v1.ClassifyRoleRequest\x1a+.multiagent.service.v1.ClassifyRoleResponse\x12j\n" +
+	"\rSuggestSkills\x12+.multiagent.service.v1.SuggestSkillsRequest\x1a,.multiagent.service.v1.SuggestSkillsResponse\x12^\n" +
+	"\tListRoles\x12'.multiagent.service.v1.ListRolesRequest\x1a(.multiagent.service.v1.ListRolesResponseB=Z;github.com/artem13815/hr/vacancy/internal/pb/multiagent_apib\x06proto3"
 
 var (
 	file_multiagent_api_multiagent_proto_rawDescOnce sync.Once
@@ -571,7 +891,7 @@ func file_multiagent_api_multiagent_proto_rawDescGZIP() []byte {
 }
 
 var file_multiagent_api_multiagent_proto_enumTypes = make([]protoimpl.EnumInfo, 1)
-var file_multiagent_api_multiagent_proto_msgTypes = make([]protoimpl.MessageInfo, 5)
+var file_multiagent_api_multiagent_proto_msgTypes = make([]protoimpl.MessageInfo, 10)
 var file_multiagent_api_multiagent_proto_goTypes = []any{
 	(AgentMode)(0),                   // 0: multiagent.service.v1.AgentMode
 	(*GenerateDecisionRequest)(nil),  // 1: multiagent.service.v1.GenerateDecisionRequest
@@ -579,21 +899,31 @@ var file_multiagent_api_multiagent_proto_goTypes = []any{
 	(*GenerateDecisionResponse)(nil), // 3: multiagent.service.v1.GenerateDecisionResponse
 	(*ClassifyRoleRequest)(nil),      // 4: multiagent.service.v1.ClassifyRoleRequest
 	(*ClassifyRoleResponse)(nil),     // 5: multiagent.service.v1.ClassifyRoleResponse
-	(*timestamppb.Timestamp)(nil),    // 6: google.protobuf.Timestamp
+	(*SuggestSkillsRequest)(nil),     // 6: multiagent.service.v1.SuggestSkillsRequest
+	(*SuggestedSkill)(nil),           // 7: multiagent.service.v1.SuggestedSkill
+	(*SuggestSkillsResponse)(nil),    // 8: multiagent.service.v1.SuggestSkillsResponse
+	(*ListRolesRequest)(nil),         // 9: multiagent.service.v1.ListRolesRequest
+	(*ListRolesResponse)(nil),        // 10: multiagent.service.v1.ListRolesResponse
+	(*timestamppb.Timestamp)(nil),    // 11: google.protobuf.Timestamp
 }
 var file_multiagent_api_multiagent_proto_depIdxs = []int32{
-	0, // 0: multiagent.service.v1.GenerateDecisionRequest.mode:type_name -> multiagent.service.v1.AgentMode
-	2, // 1: multiagent.service.v1.GenerateDecisionResponse.agent_results:type_name -> multiagent.service.v1.AgentResult
-	6, // 2: multiagent.service.v1.GenerateDecisionResponse.created_at:type_name -> google.protobuf.Timestamp
-	1, // 3: multiagent.service.v1.MultiAgentService.GenerateDecision:input_type -> multiagent.service.v1.GenerateDecisionRequest
-	4, // 4: multiagent.service.v1.MultiAgentService.ClassifyRole:input_type -> multiagent.service.v1.ClassifyRoleRequest
-	3, // 5: multiagent.service.v1.MultiAgentService.GenerateDecision:output_type -> multiagent.service.v1.GenerateDecisionResponse
-	5, // 6: multiagent.service.v1.MultiAgentService.ClassifyRole:output_type -> multiagent.service.v1.ClassifyRoleResponse
-	5, // [5:7] is the sub-list for method output_type
-	3, // [3:5] is the sub-list for method input_type
-	3, // [3:3] is the sub-list for extension type_name
-	3, // [3:3] is the sub-list for extension extendee
-	0, // [0:3] is the sub-list for field type_name
+	0,  // 0: multiagent.service.v1.GenerateDecisionRequest.mode:type_name -> multiagent.service.v1.AgentMode
+	2,  // 1: multiagent.service.v1.GenerateDecisionResponse.agent_results:type_name -> multiagent.service.v1.AgentResult
+	11, // 2: multiagent.service.v1.GenerateDecisionResponse.created_at:type_name -> google.protobuf.Timestamp
+	7,  // 3: multiagent.service.v1.SuggestSkillsResponse.skills:type_name -> multiagent.service.v1.SuggestedSkill
+	1,  // 4: multiagent.service.v1.MultiAgentService.GenerateDecision:input_type -> multiagent.service.v1.GenerateDecisionRequest
+	4,  // 5: multiagent.service.v1.MultiAgentService.ClassifyRole:input_type -> multiagent.service.v1.ClassifyRoleRequest
+	6,  // 6: multiagent.service.v1.MultiAgentService.SuggestSkills:input_type -> multiagent.service.v1.SuggestSkillsRequest
+	9,  // 7: multiagent.service.v1.MultiAgentService.ListRoles:input_type -> multiagent.service.v1.ListRolesRequest
+	3,  // 8: multiagent.service.v1.MultiAgentService.GenerateDecision:output_type -> multiagent.service.v1.GenerateDecisionResponse
+	5,  // 9: multiagent.service.v1.MultiAgentService.ClassifyRole:output_type -> multiagent.service.v1.ClassifyRoleResponse
+	8,  // 10: multiagent.service.v1.MultiAgentService.SuggestSkills:output_type -> multiagent.service.v1.SuggestSkillsResponse
+	10, // 11: multiagent.service.v1.MultiAgentService.ListRoles:output_type -> multiagent.service.v1.ListRolesResponse
+	8,  // [8:12] is the sub-list for method output_type
+	4,  // [4:8] is the sub-list for method input_type
+	4,  // [4:4] is the sub-list for extension type_name
+	4,  // [4:4] is the sub-list for extension extendee
+	0,  // [0:4] is the sub-list for field type_name
 }
 
 func init() { file_multiagent_api_multiagent_proto_init() }
@@ -607,7 +937,7 @@ func file_multiagent_api_multiagent_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_multiagent_api_multiagent_proto_rawDesc), len(file_multiagent_api_multiagent_proto_rawDesc)),
 			NumEnums:      1,
-			NumMessages:   5,
+			NumMessages:   10,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
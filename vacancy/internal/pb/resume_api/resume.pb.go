@@ -0,0 +1,193 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.11
+// 	protoc        v7.34.1
+// source: resume_api/resume.proto
+
+// Narrow contract: vacancy only needs PurgeVacancyCandidates from the resume
+// service, for the retention job. The package and service names match
+// resume's full FQDN (resume.service.v1.ResumeService/PurgeVacancyCandidates)
+// so the gRPC wire call reaches the same handler — message type names are
+// local and do not affect the wire format. Field tags MUST stay in sync with
+// resume_model.proto: PurgeVacancyCandidatesRequest and
+// PurgeVacancyCandidatesResponse.
+
+package resume_api
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type PurgeVacancyCandidatesRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	VacancyId     string                 `protobuf:"bytes,1,opt,name=vacancy_id,json=vacancyId,proto3" json:"vacancy_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PurgeVacancyCandidatesRequest) Reset() {
+	*x = PurgeVacancyCandidatesRequest{}
+	mi := &file_resume_api_resume_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PurgeVacancyCandidatesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PurgeVacancyCandidatesRequest) ProtoMessage() {}
+
+func (x *PurgeVacancyCandidatesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_resume_api_resume_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PurgeVacancyCandidatesRequest.ProtoReflect.Descriptor instead.
+func (*PurgeVacancyCandidatesRequest) Descriptor() ([]byte, []int) {
+	return file_resume_api_resume_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *PurgeVacancyCandidatesRequest) GetVacancyId() string {
+	if x != nil {
+		return x.VacancyId
+	}
+	return ""
+}
+
+type PurgeVacancyCandidatesResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Candidates    uint32                 `protobuf:"varint,1,opt,name=candidates,proto3" json:"candidates,omitempty"`
+	Resumes       uint32                 `protobuf:"varint,2,opt,name=resumes,proto3" json:"resumes,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PurgeVacancyCandidatesResponse) Reset() {
+	*x = PurgeVacancyCandidatesResponse{}
+	mi := &file_resume_api_resume_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PurgeVacancyCandidatesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PurgeVacancyCandidatesResponse) ProtoMessage() {}
+
+func (x *PurgeVacancyCandidatesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_resume_api_resume_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PurgeVacancyCandidatesResponse.ProtoReflect.Descriptor instead.
+func (*PurgeVacancyCandidatesResponse) Descriptor() ([]byte, []int) {
+	return file_resume_api_resume_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *PurgeVacancyCandidatesResponse) GetCandidates() uint32 {
+	if x != nil {
+		return x.Candidates
+	}
+	return 0
+}
+
+func (x *PurgeVacancyCandidatesResponse) GetResumes() uint32 {
+	if x != nil {
+		return x.Resumes
+	}
+	return 0
+}
+
+var File_resume_api_resume_proto protoreflect.FileDescriptor
+
+const file_resume_api_resume_proto_rawDesc = "" +
+	"\n" +
+	"\x17resume_api/resume.proto\x12\x11resume.service.v1\">\n" +
+	"\x1dPurgeVacancyCandidatesRequest\x12\x1d\n" +
+	"\n" +
+	"vacancy_id\x18\x01 \x01(\tR\tvacancyId\"Z\n" +
+	"\x1ePurgeVacancyCandidatesResponse\x12\x1e\n" +
+	"\n" +
+	"candidates\x18\x01 \x01(\rR\n" +
+	"candidates\x12\x18\n" +
+	"\aresumes\x18\x02 \x01(\rR\aresumes2\x90\x01\n" +
+	"\rResumeService\x12\x7f\n" +
+	"\x16PurgeVacancyCandidates\x120.resume.service.v1.PurgeVacancyCandidatesRequest\x1a1.resume.service.v1.PurgeVacancyCandidatesResponse\"\x00B9Z7github.com/artem13815/hr/vacancy/internal/pb/resume_apib\x06proto3"
+
+var (
+	file_resume_api_resume_proto_rawDescOnce sync.Once
+	file_resume_api_resume_proto_rawDescData []byte
+)
+
+func file_resume_api_resume_proto_rawDescGZIP() []byte {
+	file_resume_api_resume_proto_rawDescOnce.Do(func() {
+		file_resume_api_resume_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_resume_api_resume_proto_rawDesc), len(file_resume_api_resume_proto_rawDesc)))
+	})
+	return file_resume_api_resume_proto_rawDescData
+}
+
+var file_resume_api_resume_proto_msgTypes = make([]protoimpl.MessageInfo, 2)
+var file_resume_api_resume_proto_goTypes = []any{
+	(*PurgeVacancyCandidatesRequest)(nil),  // 0: resume.service.v1.PurgeVacancyCandidatesRequest
+	(*PurgeVacancyCandidatesResponse)(nil), // 1: resume.service.v1.PurgeVacancyCandidatesResponse
+}
+var file_resume_api_resume_proto_depIdxs = []int32{
+	0, // 0: resume.service.v1.ResumeService.PurgeVacancyCandidates:input_type -> resume.service.v1.PurgeVacancyCandidatesRequest
+	1, // 1: resume.service.v1.ResumeService.PurgeVacancyCandidates:output_type -> resume.service.v1.PurgeVacancyCandidatesResponse
+	1, // [1:2] is the sub-list for method output_type
+	0, // [0:1] is the sub-list for method input_type
+	0, // [0:0] is the sub-list for extension type_name
+	0, // [0:0] is the sub-list for extension extendee
+	0, // [0:0] is the sub-list for field type_name
+}
+
+func init() { file_resume_api_resume_proto_init() }
+func file_resume_api_resume_proto_init() {
+	if File_resume_api_resume_proto != nil {
+		return
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_resume_api_resume_proto_rawDesc), len(file_resume_api_resume_proto_rawDesc)),
+			NumEnums:      0,
+			NumMessages:   2,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_resume_api_resume_proto_goTypes,
+		DependencyIndexes: file_resume_api_resume_proto_depIdxs,
+		MessageInfos:      file_resume_api_resume_proto_msgTypes,
+	}.Build()
+	File_resume_api_resume_proto = out.File
+	file_resume_api_resume_proto_goTypes = nil
+	file_resume_api_resume_proto_depIdxs = nil
+}
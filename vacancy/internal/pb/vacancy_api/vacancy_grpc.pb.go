@@ -9,6 +9,7 @@ package vacancy_api
 import (
 	context "context"
 	models "github.com/artem13815/hr/vacancy/internal/pb/models"
+	httpbody "google.golang.org/genproto/googleapis/api/httpbody"
 	grpc "google.golang.org/grpc"
 	codes "google.golang.org/grpc/codes"
 	status "google.golang.org/grpc/status"
@@ -20,22 +21,107 @@ import (
 const _ = grpc.SupportPackageIsVersion9
 
 const (
-	VacancyService_CreateVacancy_FullMethodName  = "/vacancy.service.v1.VacancyService/CreateVacancy"
-	VacancyService_GetVacancy_FullMethodName     = "/vacancy.service.v1.VacancyService/GetVacancy"
-	VacancyService_ListVacancies_FullMethodName  = "/vacancy.service.v1.VacancyService/ListVacancies"
-	VacancyService_UpdateVacancy_FullMethodName  = "/vacancy.service.v1.VacancyService/UpdateVacancy"
-	VacancyService_ArchiveVacancy_FullMethodName = "/vacancy.service.v1.VacancyService/ArchiveVacancy"
+	VacancyService_CreateVacancy_FullMethodName             = "/vacancy.service.v1.VacancyService/CreateVacancy"
+	VacancyService_ImportVacancies_FullMethodName           = "/vacancy.service.v1.VacancyService/ImportVacancies"
+	VacancyService_GetVacancy_FullMethodName                = "/vacancy.service.v1.VacancyService/GetVacancy"
+	VacancyService_ListVacancies_FullMethodName             = "/vacancy.service.v1.VacancyService/ListVacancies"
+	VacancyService_UpdateVacancy_FullMethodName             = "/vacancy.service.v1.VacancyService/UpdateVacancy"
+	VacancyService_ArchiveVacancy_FullMethodName            = "/vacancy.service.v1.VacancyService/ArchiveVacancy"
+	VacancyService_DeleteVacancy_FullMethodName             = "/vacancy.service.v1.VacancyService/DeleteVacancy"
+	VacancyService_ListVacancyVersions_FullMethodName       = "/vacancy.service.v1.VacancyService/ListVacancyVersions"
+	VacancyService_GetVacancyVersion_FullMethodName         = "/vacancy.service.v1.VacancyService/GetVacancyVersion"
+	VacancyService_DiffVacancyVersions_FullMethodName       = "/vacancy.service.v1.VacancyService/DiffVacancyVersions"
+	VacancyService_TransitionVacancy_FullMethodName         = "/vacancy.service.v1.VacancyService/TransitionVacancy"
+	VacancyService_RestoreVacancy_FullMethodName            = "/vacancy.service.v1.VacancyService/RestoreVacancy"
+	VacancyService_ListVacancyStatusHistory_FullMethodName  = "/vacancy.service.v1.VacancyService/ListVacancyStatusHistory"
+	VacancyService_CloneVacancy_FullMethodName              = "/vacancy.service.v1.VacancyService/CloneVacancy"
+	VacancyService_CreateTemplate_FullMethodName            = "/vacancy.service.v1.VacancyService/CreateTemplate"
+	VacancyService_ListTemplates_FullMethodName             = "/vacancy.service.v1.VacancyService/ListTemplates"
+	VacancyService_CreateVacancyFromTemplate_FullMethodName = "/vacancy.service.v1.VacancyService/CreateVacancyFromTemplate"
+	VacancyService_DeleteTemplate_FullMethodName            = "/vacancy.service.v1.VacancyService/DeleteTemplate"
+	VacancyService_SetVacancyVisibility_FullMethodName      = "/vacancy.service.v1.VacancyService/SetVacancyVisibility"
+	VacancyService_AddCollaborator_FullMethodName           = "/vacancy.service.v1.VacancyService/AddCollaborator"
+	VacancyService_RemoveCollaborator_FullMethodName        = "/vacancy.service.v1.VacancyService/RemoveCollaborator"
+	VacancyService_ListCollaborators_FullMethodName         = "/vacancy.service.v1.VacancyService/ListCollaborators"
+	VacancyService_TransferVacancyOwnership_FullMethodName  = "/vacancy.service.v1.VacancyService/TransferVacancyOwnership"
+	VacancyService_GetVacancyPipeline_FullMethodName        = "/vacancy.service.v1.VacancyService/GetVacancyPipeline"
+	VacancyService_SetVacancyPipelineStages_FullMethodName  = "/vacancy.service.v1.VacancyService/SetVacancyPipelineStages"
+	VacancyService_MoveCandidateStage_FullMethodName        = "/vacancy.service.v1.VacancyService/MoveCandidateStage"
+	VacancyService_ListCandidateStageHistory_FullMethodName = "/vacancy.service.v1.VacancyService/ListCandidateStageHistory"
+	VacancyService_GetJobPosting_FullMethodName             = "/vacancy.service.v1.VacancyService/GetJobPosting"
+	VacancyService_GetVacancyFeed_FullMethodName            = "/vacancy.service.v1.VacancyService/GetVacancyFeed"
+	VacancyService_AutocompleteSkills_FullMethodName        = "/vacancy.service.v1.VacancyService/AutocompleteSkills"
+	VacancyService_SuggestSkills_FullMethodName             = "/vacancy.service.v1.VacancyService/SuggestSkills"
+	VacancyService_ListVacancyRoles_FullMethodName          = "/vacancy.service.v1.VacancyService/ListVacancyRoles"
+	VacancyService_ReclassifyVacancyRoles_FullMethodName    = "/vacancy.service.v1.VacancyService/ReclassifyVacancyRoles"
 )
 
 // VacancyServiceClient is the client API for VacancyService service.
 //
 // For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
 type VacancyServiceClient interface {
-	CreateVacancy(ctx context.Context, in *models.CreateVacancyRequest, opts ...grpc.CallOption) (*models.VacancyResponse, error)
+	CreateVacancy(ctx context.Context, in *models.CreateVacancyRequest, opts ...grpc.CallOption) (*models.CreateVacancyResponse, error)
+	// ImportVacancies bulk-creates vacancies from a CSV, JSON-lines or hh.ru
+	// export with per-row results; see ImportMode for commit semantics.
+	ImportVacancies(ctx context.Context, in *models.ImportVacanciesRequest, opts ...grpc.CallOption) (*models.ImportVacanciesResponse, error)
 	GetVacancy(ctx context.Context, in *models.GetVacancyRequest, opts ...grpc.CallOption) (*models.VacancyResponse, error)
 	ListVacancies(ctx context.Context, in *models.ListVacanciesRequest, opts ...grpc.CallOption) (*models.ListVacanciesResponse, error)
 	UpdateVacancy(ctx context.Context, in *models.UpdateVacancyRequest, opts ...grpc.CallOption) (*models.VacancyResponse, error)
 	ArchiveVacancy(ctx context.Context, in *models.ArchiveVacancyRequest, opts ...grpc.CallOption) (*models.ArchiveVacancyResponse, error)
+	// DeleteVacancy soft-deletes a vacancy: it disappears from every read,
+	// its candidates and analyses included. An admin can undo it with
+	// RestoreVacancy until the retention job purges it after the grace
+	// period. Owner or admin only.
+	DeleteVacancy(ctx context.Context, in *models.DeleteVacancyRequest, opts ...grpc.CallOption) (*models.DeleteVacancyResponse, error)
+	ListVacancyVersions(ctx context.Context, in *models.ListVacancyVersionsRequest, opts ...grpc.CallOption) (*models.ListVacancyVersionsResponse, error)
+	GetVacancyVersion(ctx context.Context, in *models.GetVacancyVersionRequest, opts ...grpc.CallOption) (*models.VacancyVersionResponse, error)
+	DiffVacancyVersions(ctx context.Context, in *models.DiffVacancyVersionsRequest, opts ...grpc.CallOption) (*models.DiffVacancyVersionsResponse, error)
+	TransitionVacancy(ctx context.Context, in *models.TransitionVacancyRequest, opts ...grpc.CallOption) (*models.VacancyResponse, error)
+	RestoreVacancy(ctx context.Context, in *models.RestoreVacancyRequest, opts ...grpc.CallOption) (*models.VacancyResponse, error)
+	ListVacancyStatusHistory(ctx context.Context, in *models.ListVacancyStatusHistoryRequest, opts ...grpc.CallOption) (*models.ListVacancyStatusHistoryResponse, error)
+	CloneVacancy(ctx context.Context, in *models.CloneVacancyRequest, opts ...grpc.CallOption) (*models.VacancyResponse, error)
+	CreateTemplate(ctx context.Context, in *models.CreateTemplateRequest, opts ...grpc.CallOption) (*models.VacancyTemplateResponse, error)
+	ListTemplates(ctx context.Context, in *models.ListTemplatesRequest, opts ...grpc.CallOption) (*models.ListTemplatesResponse, error)
+	CreateVacancyFromTemplate(ctx context.Context, in *models.CreateVacancyFromTemplateRequest, opts ...grpc.CallOption) (*models.VacancyResponse, error)
+	// DeleteTemplate removes a template for good. Its owner may delete it; an
+	// admin may also delete anyone's org-wide template. Vacancies opened from
+	// it are not affected.
+	DeleteTemplate(ctx context.Context, in *models.DeleteTemplateRequest, opts ...grpc.CallOption) (*models.DeleteTemplateResponse, error)
+	SetVacancyVisibility(ctx context.Context, in *models.SetVacancyVisibilityRequest, opts ...grpc.CallOption) (*models.VacancyResponse, error)
+	// Collaborators share one vacancy with other users. Only the owner and
+	// admins add or remove them; everyone with access can list them.
+	AddCollaborator(ctx context.Context, in *models.AddCollaboratorRequest, opts ...grpc.CallOption) (*models.CollaboratorResponse, error)
+	RemoveCollaborator(ctx context.Context, in *models.RemoveCollaboratorRequest, opts ...grpc.CallOption) (*models.RemoveCollaboratorResponse, error)
+	ListCollaborators(ctx context.Context, in *models.ListCollaboratorsRequest, opts ...grpc.CallOption) (*models.ListCollaboratorsResponse, error)
+	// The hiring pipeline: configurable stages per vacancy and candidate
+	// moves between them. Everyone with access reads; owners, editors and
+	// admins write.
+	// TransferVacancyOwnership moves one batch of a user's vacancies to
+	// another user. Admin only. No HTTP binding: the admin service's
+	// TransferOwnership calls it in a loop with the admin's bearer until a
+	// batch comes back short.
+	TransferVacancyOwnership(ctx context.Context, in *models.TransferVacancyOwnershipRequest, opts ...grpc.CallOption) (*models.TransferVacancyOwnershipResponse, error)
+	GetVacancyPipeline(ctx context.Context, in *models.GetVacancyPipelineRequest, opts ...grpc.CallOption) (*models.VacancyPipelineResponse, error)
+	SetVacancyPipelineStages(ctx context.Context, in *models.SetVacancyPipelineStagesRequest, opts ...grpc.CallOption) (*models.VacancyPipelineResponse, error)
+	MoveCandidateStage(ctx context.Context, in *models.MoveCandidateStageRequest, opts ...grpc.CallOption) (*models.MoveCandidateStageResponse, error)
+	ListCandidateStageHistory(ctx context.Context, in *models.ListCandidateStageHistoryRequest, opts ...grpc.CallOption) (*models.ListCandidateStageHistoryResponse, error)
+	// GetJobPosting and GetVacancyFeed are public: the vacancy auth
+	// interceptor skips them and the gateway serves them without a bearer.
+	// Both answer with a raw body (schema.org JSON-LD / XML) and a
+	// Cache-Control header.
+	GetJobPosting(ctx context.Context, in *models.GetJobPostingRequest, opts ...grpc.CallOption) (*httpbody.HttpBody, error)
+	GetVacancyFeed(ctx context.Context, in *models.GetVacancyFeedRequest, opts ...grpc.CallOption) (*httpbody.HttpBody, error)
+	AutocompleteSkills(ctx context.Context, in *models.AutocompleteSkillsRequest, opts ...grpc.CallOption) (*models.AutocompleteSkillsResponse, error)
+	// SuggestSkills drafts must-have / nice-to-have skills with weights from
+	// an unsaved title and description, for the editor's "suggest" button.
+	SuggestSkills(ctx context.Context, in *models.SuggestSkillsRequest, opts ...grpc.CallOption) (*models.SuggestSkillsResponse, error)
+	// ListVacancyRoles returns the role vocabulary for the editor's role
+	// picker; CreateVacancy and UpdateVacancy accept only these roles.
+	ListVacancyRoles(ctx context.Context, in *models.ListVacancyRolesRequest, opts ...grpc.CallOption) (*models.ListVacancyRolesResponse, error)
+	// ReclassifyVacancyRoles re-sends fallback (keyword) roles to the LLM
+	// classifier in bulk — the on-demand counterpart of the background role
+	// reconciler. Admin only.
+	ReclassifyVacancyRoles(ctx context.Context, in *models.ReclassifyVacancyRolesRequest, opts ...grpc.CallOption) (*models.ReclassifyVacancyRolesResponse, error)
 }
 
 type vacancyServiceClient struct {
@@ -46,9 +132,9 @@ func NewVacancyServiceClient(cc grpc.ClientConnInterface) VacancyServiceClient {
 	return &vacancyServiceClient{cc}
 }
 
-func (c *vacancyServiceClient) CreateVacancy(ctx context.Context, in *models.CreateVacancyRequest, opts ...grpc.CallOption) (*models.VacancyResponse, error) {
+func (c *vacancyServiceClient) CreateVacancy(ctx context.Context, in *models.CreateVacancyRequest, opts ...grpc.CallOption) (*models.CreateVacancyResponse, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
-	out := new(models.VacancyResponse)
+	out := new(models.CreateVacancyResponse)
 	err := c.cc.Invoke(ctx, VacancyService_CreateVacancy_FullMethodName, in, out, cOpts...)
 	if err != nil {
 		return nil, err
@@ -56,6 +142,16 @@ func (c *vacancyServiceClient) CreateVacancy(ctx context.Context, in *models.Cre
 	return out, nil
 }
 
+func (c *vacancyServiceClient) ImportVacancies(ctx context.Context, in *models.ImportVacanciesRequest, opts ...grpc.CallOption) (*models.ImportVacanciesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(models.ImportVacanciesResponse)
+	err := c.cc.Invoke(ctx, VacancyService_ImportVacancies_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *vacancyServiceClient) GetVacancy(ctx context.Context, in *models.GetVacancyRequest, opts ...grpc.CallOption) (*models.VacancyResponse, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(models.VacancyResponse)
@@ -96,15 +192,342 @@ func (c *vacancyServiceClient) ArchiveVacancy(ctx context.Context, in *models.Ar
 	return out, nil
 }
 
+func (c *vacancyServiceClient) DeleteVacancy(ctx context.Context, in *models.DeleteVacancyRequest, opts ...grpc.CallOption) (*models.DeleteVacancyResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(models.DeleteVacancyResponse)
+	err := c.cc.Invoke(ctx, VacancyService_DeleteVacancy_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *vacancyServiceClient) ListVacancyVersions(ctx context.Context, in *models.ListVacancyVersionsRequest, opts ...grpc.CallOption) (*models.ListVacancyVersionsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(models.ListVacancyVersionsResponse)
+	err := c.cc.Invoke(ctx, VacancyService_ListVacancyVersions_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *vacancyServiceClient) GetVacancyVersion(ctx context.Context, in *models.GetVacancyVersionRequest, opts ...grpc.CallOption) (*models.VacancyVersionResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(models.VacancyVersionResponse)
+	err := c.cc.Invoke(ctx, VacancyService_GetVacancyVersion_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *vacancyServiceClient) DiffVacancyVersions(ctx context.Context, in *models.DiffVacancyVersionsRequest, opts ...grpc.CallOption) (*models.DiffVacancyVersionsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(models.DiffVacancyVersionsResponse)
+	err := c.cc.Invoke(ctx, VacancyService_DiffVacancyVersions_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *vacancyServiceClient) TransitionVacancy(ctx context.Context, in *models.TransitionVacancyRequest, opts ...grpc.CallOption) (*models.VacancyResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(models.VacancyResponse)
+	err := c.cc.Invoke(ctx, VacancyService_TransitionVacancy_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *vacancyServiceClient) RestoreVacancy(ctx context.Context, in *models.RestoreVacancyRequest, opts ...grpc.CallOption) (*models.VacancyResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(models.VacancyResponse)
+	err := c.cc.Invoke(ctx, VacancyService_RestoreVacancy_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *vacancyServiceClient) ListVacancyStatusHistory(ctx context.Context, in *models.ListVacancyStatusHistoryRequest, opts ...grpc.CallOption) (*models.ListVacancyStatusHistoryResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(models.ListVacancyStatusHistoryResponse)
+	err := c.cc.Invoke(ctx, VacancyService_ListVacancyStatusHistory_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *vacancyServiceClient) CloneVacancy(ctx context.Context, in *models.CloneVacancyRequest, opts ...grpc.CallOption) (*models.VacancyResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(models.VacancyResponse)
+	err := c.cc.Invoke(ctx, VacancyService_CloneVacancy_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *vacancyServiceClient) CreateTemplate(ctx context.Context, in *models.CreateTemplateRequest, opts ...grpc.CallOption) (*models.VacancyTemplateResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(models.VacancyTemplateResponse)
+	err := c.cc.Invoke(ctx, VacancyService_CreateTemplate_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *vacancyServiceClient) ListTemplates(ctx context.Context, in *models.ListTemplatesRequest, opts ...grpc.CallOption) (*models.ListTemplatesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(models.ListTemplatesResponse)
+	err := c.cc.Invoke(ctx, VacancyService_ListTemplates_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *vacancyServiceClient) CreateVacancyFromTemplate(ctx context.Context, in *models.CreateVacancyFromTemplateRequest, opts ...grpc.CallOption) (*models.VacancyResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(models.VacancyResponse)
+	err := c.cc.Invoke(ctx, VacancyService_CreateVacancyFromTemplate_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *vacancyServiceClient) DeleteTemplate(ctx context.Context, in *models.DeleteTemplateRequest, opts ...grpc.CallOption) (*models.DeleteTemplateResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(models.DeleteTemplateResponse)
+	err := c.cc.Invoke(ctx, VacancyService_DeleteTemplate_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *vacancyServiceClient) SetVacancyVisibility(ctx context.Context, in *models.SetVacancyVisibilityRequest, opts ...grpc.CallOption) (*models.VacancyResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(models.VacancyResponse)
+	err := c.cc.Invoke(ctx, VacancyService_SetVacancyVisibility_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *vacancyServiceClient) AddCollaborator(ctx context.Context, in *models.AddCollaboratorRequest, opts ...grpc.CallOption) (*models.CollaboratorResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(models.CollaboratorResponse)
+	err := c.cc.Invoke(ctx, VacancyService_AddCollaborator_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *vacancyServiceClient) RemoveCollaborator(ctx context.Context, in *models.RemoveCollaboratorRequest, opts ...grpc.CallOption) (*models.RemoveCollaboratorResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(models.RemoveCollaboratorResponse)
+	err := c.cc.Invoke(ctx, VacancyService_RemoveCollaborator_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *vacancyServiceClient) ListCollaborators(ctx context.Context, in *models.ListCollaboratorsRequest, opts ...grpc.CallOption) (*models.ListCollaboratorsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(models.ListCollaboratorsResponse)
+	err := c.cc.Invoke(ctx, VacancyService_ListCollaborators_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *vacancyServiceClient) TransferVacancyOwnership(ctx context.Context, in *models.TransferVacancyOwnershipRequest, opts ...grpc.CallOption) (*models.TransferVacancyOwnershipResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(models.TransferVacancyOwnershipResponse)
+	err := c.cc.Invoke(ctx, VacancyService_TransferVacancyOwnership_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *vacancyServiceClient) GetVacancyPipeline(ctx context.Context, in *models.GetVacancyPipelineRequest, opts ...grpc.CallOption) (*models.VacancyPipelineResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(models.VacancyPipelineResponse)
+	err := c.cc.Invoke(ctx, VacancyService_GetVacancyPipeline_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *vacancyServiceClient) SetVacancyPipelineStages(ctx context.Context, in *models.SetVacancyPipelineStagesRequest, opts ...grpc.CallOption) (*models.VacancyPipelineResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(models.VacancyPipelineResponse)
+	err := c.cc.Invoke(ctx, VacancyService_SetVacancyPipelineStages_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *vacancyServiceClient) MoveCandidateStage(ctx context.Context, in *models.MoveCandidateStageRequest, opts ...grpc.CallOption) (*models.MoveCandidateStageResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(models.MoveCandidateStageResponse)
+	err := c.cc.Invoke(ctx, VacancyService_MoveCandidateStage_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *vacancyServiceClient) ListCandidateStageHistory(ctx context.Context, in *models.ListCandidateStageHistoryRequest, opts ...grpc.CallOption) (*models.ListCandidateStageHistoryResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(models.ListCandidateStageHistoryResponse)
+	err := c.cc.Invoke(ctx, VacancyService_ListCandidateStageHistory_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *vacancyServiceClient) GetJobPosting(ctx context.Context, in *models.GetJobPostingRequest, opts ...grpc.CallOption) (*httpbody.HttpBody, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(httpbody.HttpBody)
+	err := c.cc.Invoke(ctx, VacancyService_GetJobPosting_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *vacancyServiceClient) GetVacancyFeed(ctx context.Context, in *models.GetVacancyFeedRequest, opts ...grpc.CallOption) (*httpbody.HttpBody, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(httpbody.HttpBody)
+	err := c.cc.Invoke(ctx, VacancyService_GetVacancyFeed_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *vacancyServiceClient) AutocompleteSkills(ctx context.Context, in *models.AutocompleteSkillsRequest, opts ...grpc.CallOption) (*models.AutocompleteSkillsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(models.AutocompleteSkillsResponse)
+	err := c.cc.Invoke(ctx, VacancyService_AutocompleteSkills_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *vacancyServiceClient) SuggestSkills(ctx context.Context, in *models.SuggestSkillsRequest, opts ...grpc.CallOption) (*models.SuggestSkillsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(models.SuggestSkillsResponse)
+	err := c.cc.Invoke(ctx, VacancyService_SuggestSkills_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *vacancyServiceClient) ListVacancyRoles(ctx context.Context, in *models.ListVacancyRolesRequest, opts ...grpc.CallOption) (*models.ListVacancyRolesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(models.ListVacancyRolesResponse)
+	err := c.cc.Invoke(ctx, VacancyService_ListVacancyRoles_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *vacancyServiceClient) ReclassifyVacancyRoles(ctx context.Context, in *models.ReclassifyVacancyRolesRequest, opts ...grpc.CallOption) (*models.ReclassifyVacancyRolesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(models.ReclassifyVacancyRolesResponse)
+	err := c.cc.Invoke(ctx, VacancyService_ReclassifyVacancyRoles_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // VacancyServiceServer is the server API for VacancyService service.
 // All implementations must embed UnimplementedVacancyServiceServer
 // for forward compatibility.
 type VacancyServiceServer interface {
-	CreateVacancy(context.Context, *models.CreateVacancyRequest) (*models.VacancyResponse, error)
+	CreateVacancy(context.Context, *models.CreateVacancyRequest) (*models.CreateVacancyResponse, error)
+	// ImportVacancies bulk-creates vacancies from a CSV, JSON-lines or hh.ru
+	// export with per-row results; see ImportMode for commit semantics.
+	ImportVacancies(context.Context, *models.ImportVacanciesRequest) (*models.ImportVacanciesResponse, error)
 	GetVacancy(context.Context, *models.GetVacancyRequest) (*models.VacancyResponse, error)
 	ListVacancies(context.Context, *models.ListVacanciesRequest) (*models.ListVacanciesResponse, error)
 	UpdateVacancy(context.Context, *models.UpdateVacancyRequest) (*models.VacancyResponse, error)
 	ArchiveVacancy(context.Context, *models.ArchiveVacancyRequest) (*models.ArchiveVacancyResponse, error)
+	// DeleteVacancy soft-deletes a vacancy: it disappears from every read,
+	// its candidates and analyses included. An admin can undo it with
+	// RestoreVacancy until the retention job purges it after the grace
+	// period. Owner or admin only.
+	DeleteVacancy(context.Context, *models.DeleteVacancyRequest) (*models.DeleteVacancyResponse, error)
+	ListVacancyVersions(context.Context, *models.ListVacancyVersionsRequest) (*models.ListVacancyVersionsResponse, error)
+	GetVacancyVersion(context.Context, *models.GetVacancyVersionRequest) (*models.VacancyVersionResponse, error)
+	DiffVacancyVersions(context.Context, *models.DiffVacancyVersionsRequest) (*models.DiffVacancyVersionsResponse, error)
+	TransitionVacancy(context.Context, *models.TransitionVacancyRequest) (*models.VacancyResponse, error)
+	RestoreVacancy(context.Context, *models.RestoreVacancyRequest) (*models.VacancyResponse, error)
+	ListVacancyStatusHistory(context.Context, *models.ListVacancyStatusHistoryRequest) (*models.ListVacancyStatusHistoryResponse, error)
+	CloneVacancy(context.Context, *models.CloneVacancyRequest) (*models.VacancyResponse, error)
+	CreateTemplate(context.Context, *models.CreateTemplateRequest) (*models.VacancyTemplateResponse, error)
+	ListTemplates(context.Context, *models.ListTemplatesRequest) (*models.ListTemplatesResponse, error)
+	CreateVacancyFromTemplate(context.Context, *models.CreateVacancyFromTemplateRequest) (*models.VacancyResponse, error)
+	// DeleteTemplate removes a template for good. Its owner may delete it; an
+	// admin may also delete anyone's org-wide template. Vacancies opened from
+	// it are not affected.
+	DeleteTemplate(context.Context, *models.DeleteTemplateRequest) (*models.DeleteTemplateResponse, error)
+	SetVacancyVisibility(context.Context, *models.SetVacancyVisibilityRequest) (*models.VacancyResponse, error)
+	// Collaborators share one vacancy with other users. Only the owner and
+	// admins add or remove them; everyone with access can list them.
+	AddCollaborator(context.Context, *models.AddCollaboratorRequest) (*models.CollaboratorResponse, error)
+	RemoveCollaborator(context.Context, *models.RemoveCollaboratorRequest) (*models.RemoveCollaboratorResponse, error)
+	ListCollaborators(context.Context, *models.ListCollaboratorsRequest) (*models.ListCollaboratorsResponse, error)
+	// The hiring pipeline: configurable stages per vacancy and candidate
+	// moves between them. Everyone with access reads; owners, editors and
+	// admins write.
+	// TransferVacancyOwnership moves one batch of a user's vacancies to
+	// another user. Admin only. No HTTP binding: the admin service's
+	// TransferOwnership calls it in a loop with the admin's bearer until a
+	// batch comes back short.
+	TransferVacancyOwnership(context.Context, *models.TransferVacancyOwnershipRequest) (*models.TransferVacancyOwnershipResponse, error)
+	GetVacancyPipeline(context.Context, *models.GetVacancyPipelineRequest) (*models.VacancyPipelineResponse, error)
+	SetVacancyPipelineStages(context.Context, *models.SetVacancyPipelineStagesRequest) (*models.VacancyPipelineResponse, error)
+	MoveCandidateStage(context.Context, *models.MoveCandidateStageRequest) (*models.MoveCandidateStageResponse, error)
+	ListCandidateStageHistory(context.Context, *models.ListCandidateStageHistoryRequest) (*models.ListCandidateStageHistoryResponse, error)
+	// GetJobPosting and GetVacancyFeed are public: the vacancy auth
+	// interceptor skips them and the gateway serves them without a bearer.
+	// Both answer with a raw body (schema.org JSON-LD / XML) and a
+	// Cache-Control header.
+	GetJobPosting(context.Context, *models.GetJobPostingRequest) (*httpbody.HttpBody, error)
+	GetVacancyFeed(context.Context, *models.GetVacancyFeedRequest) (*httpbody.HttpBody, error)
+	AutocompleteSkills(context.Context, *models.AutocompleteSkillsRequest) (*models.AutocompleteSkillsResponse, error)
+	// SuggestSkills drafts must-have / nice-to-have skills with weights from
+	// an unsaved title and description, for the editor's "suggest" button.
+	SuggestSkills(context.Context, *models.SuggestSkillsRequest) (*models.SuggestSkillsResponse, error)
+	// ListVacancyRoles returns the role vocabulary for the editor's role
+	// picker; CreateVacancy and UpdateVacancy accept only these roles.
+	ListVacancyRoles(context.Context, *models.ListVacancyRolesRequest) (*models.ListVacancyRolesResponse, error)
+	// ReclassifyVacancyRoles re-sends fallback (keyword) roles to the LLM
+	// classifier in bulk — the on-demand counterpart of the background role
+	// reconciler. Admin only.
+	ReclassifyVacancyRoles(context.Context, *models.ReclassifyVacancyRolesRequest) (*models.ReclassifyVacancyRolesResponse, error)
 	mustEmbedUnimplementedVacancyServiceServer()
 }
 
@@ -115,9 +538,12 @@ type VacancyServiceServer interface {
 // pointer dereference when methods are called.
 type UnimplementedVacancyServiceServer struct{}
 
-func (UnimplementedVacancyServiceServer) CreateVacancy(context.Context, *models.CreateVacancyRequest) (*models.VacancyResponse, error) {
+func (UnimplementedVacancyServiceServer) CreateVacancy(context.Context, *models.CreateVacancyRequest) (*models.CreateVacancyResponse, error) {
 	return nil, status.Error(codes.Unimplemented, "method CreateVacancy not implemented")
 }
+func (UnimplementedVacancyServiceServer) ImportVacancies(context.Context, *models.ImportVacanciesRequest) (*models.ImportVacanciesResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method ImportVacancies not implemented")
+}
 func (UnimplementedVacancyServiceServer) GetVacancy(context.Context, *models.GetVacancyRequest) (*models.VacancyResponse, error) {
 	return nil, status.Error(codes.Unimplemented, "method GetVacancy not implemented")
 }
@@ -130,6 +556,87 @@ func (UnimplementedVacancyServiceServer) UpdateVacancy(context.Context, *models.
 func (UnimplementedVacancyServiceServer) ArchiveVacancy(context.Context, *models.ArchiveVacancyRequest) (*models.ArchiveVacancyResponse, error) {
 	return nil, status.Error(codes.Unimplemented, "method ArchiveVacancy not implemented")
 }
+func (UnimplementedVacancyServiceServer) DeleteVacancy(context.Context, *models.DeleteVacancyRequest) (*models.DeleteVacancyResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method DeleteVacancy not implemented")
+}
+func (UnimplementedVacancyServiceServer) ListVacancyVersions(context.Context, *models.ListVacancyVersionsRequest) (*models.ListVacancyVersionsResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method ListVacancyVersions not implemented")
+}
+func (UnimplementedVacancyServiceServer) GetVacancyVersion(context.Context, *models.GetVacancyVersionRequest) (*models.VacancyVersionResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method GetVacancyVersion not implemented")
+}
+func (UnimplementedVacancyServiceServer) DiffVacancyVersions(context.Context, *models.DiffVacancyVersionsRequest) (*models.DiffVacancyVersionsResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method DiffVacancyVersions not implemented")
+}
+func (UnimplementedVacancyServiceServer) TransitionVacancy(context.Context, *models.TransitionVacancyRequest) (*models.VacancyResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method TransitionVacancy not implemented")
+}
+func (UnimplementedVacancyServiceServer) RestoreVacancy(context.Context, *models.RestoreVacancyRequest) (*models.VacancyResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method RestoreVacancy not implemented")
+}
+func (UnimplementedVacancyServiceServer) ListVacancyStatusHistory(context.Context, *models.ListVacancyStatusHistoryRequest) (*models.ListVacancyStatusHistoryResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method ListVacancyStatusHistory not implemented")
+}
+func (UnimplementedVacancyServiceServer) CloneVacancy(context.Context, *models.CloneVacancyRequest) (*models.VacancyResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method CloneVacancy not implemented")
+}
+func (UnimplementedVacancyServiceServer) CreateTemplate(context.Context, *models.CreateTemplateRequest) (*models.VacancyTemplateResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method CreateTemplate not implemented")
+}
+func (UnimplementedVacancyServiceServer) ListTemplates(context.Context, *models.ListTemplatesRequest) (*models.ListTemplatesResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method ListTemplates not implemented")
+}
+func (UnimplementedVacancyServiceServer) CreateVacancyFromTemplate(context.Context, *models.CreateVacancyFromTemplateRequest) (*models.VacancyResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method CreateVacancyFromTemplate not implemented")
+}
+func (UnimplementedVacancyServiceServer) DeleteTemplate(context.Context, *models.DeleteTemplateRequest) (*models.DeleteTemplateResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method DeleteTemplate not implemented")
+}
+func (UnimplementedVacancyServiceServer) SetVacancyVisibility(context.Context, *models.SetVacancyVisibilityRequest) (*models.VacancyResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method SetVacancyVisibility not implemented")
+}
+func (UnimplementedVacancyServiceServer) AddCollaborator(context.Context, *models.AddCollaboratorRequest) (*models.CollaboratorResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method AddCollaborator not implemented")
+}
+func (UnimplementedVacancyServiceServer) RemoveCollaborator(context.Context, *models.RemoveCollaboratorRequest) (*models.RemoveCollaboratorResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method RemoveCollaborator not implemented")
+}
+func (UnimplementedVacancyServiceServer) ListCollaborators(context.Context, *models.ListCollaboratorsRequest) (*models.ListCollaboratorsResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method ListCollaborators not implemented")
+}
+func (UnimplementedVacancyServiceServer) TransferVacancyOwnership(context.Context, *models.TransferVacancyOwnershipRequest) (*models.TransferVacancyOwnershipResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method TransferVacancyOwnership not implemented")
+}
+func (UnimplementedVacancyServiceServer) GetVacancyPipeline(context.Context, *models.GetVacancyPipelineRequest) (*models.VacancyPipelineResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method GetVacancyPipeline not implemented")
+}
+func (UnimplementedVacancyServiceServer) SetVacancyPipelineStages(context.Context, *models.SetVacancyPipelineStagesRequest) (*models.VacancyPipelineResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method SetVacancyPipelineStages not implemented")
+}
+func (UnimplementedVacancyServiceServer) MoveCandidateStage(context.Context, *models.MoveCandidateStageRequest) (*models.MoveCandidateStageResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method MoveCandidateStage not implemented")
+}
+func (UnimplementedVacancyServiceServer) ListCandidateStageHistory(context.Context, *models.ListCandidateStageHistoryRequest) (*models.ListCandidateStageHistoryResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method ListCandidateStageHistory not implemented")
+}
+func (UnimplementedVacancyServiceServer) GetJobPosting(context.Context, *models.GetJobPostingRequest) (*httpbody.HttpBody, error) {
+	return nil, status.Error(codes.Unimplemented, "method GetJobPosting not implemented")
+}
+func (UnimplementedVacancyServiceServer) GetVacancyFeed(context.Context, *models.GetVacancyFeedRequest) (*httpbody.HttpBody, error) {
+	return nil, status.Error(codes.Unimplemented, "method GetVacancyFeed not implemented")
+}
+func (UnimplementedVacancyServiceServer) AutocompleteSkills(context.Context, *models.AutocompleteSkillsRequest) (*models.AutocompleteSkillsResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method AutocompleteSkills not implemented")
+}
+func (UnimplementedVacancyServiceServer) SuggestSkills(context.Context, *models.SuggestSkillsRequest) (*models.SuggestSkillsResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method SuggestSkills not implemented")
+}
+func (UnimplementedVacancyServiceServer) ListVacancyRoles(context.Context, *models.ListVacancyRolesRequest) (*models.ListVacancyRolesResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method ListVacancyRoles not implemented")
+}
+func (UnimplementedVacancyServiceServer) ReclassifyVacancyRoles(context.Context, *models.ReclassifyVacancyRolesRequest) (*models.ReclassifyVacancyRolesResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method ReclassifyVacancyRoles not implemented")
+}
 func (UnimplementedVacancyServiceServer) mustEmbedUnimplementedVacancyServiceServer() {}
 func (UnimplementedVacancyServiceServer) testEmbeddedByValue()                        {}
 
@@ -169,6 +676,24 @@ func _VacancyService_CreateVacancy_Handler(srv interface{}, ctx context.Context,
 	return interceptor(ctx, in, info, handler)
 }
 
+func _VacancyService_ImportVacancies_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(models.ImportVacanciesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(VacancyServiceServer).ImportVacancies(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: VacancyService_ImportVacancies_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(VacancyServiceServer).ImportVacancies(ctx, req.(*models.ImportVacanciesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _VacancyService_GetVacancy_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(models.GetVacancyRequest)
 	if err := dec(in); err != nil {
@@ -241,19 +766,509 @@ func _VacancyService_ArchiveVacancy_Handler(srv interface{}, ctx context.Context
 	return interceptor(ctx, in, info, handler)
 }
 
-// VacancyService_ServiceDesc is the grpc.ServiceDesc for VacancyService service.
-// It's only intended for direct use with grpc.RegisterService,
-// and not to be introspected or modified (even as a copy)
-var VacancyService_ServiceDesc = grpc.ServiceDesc{
-	ServiceName: "vacancy.service.v1.VacancyService",
-	HandlerType: (*VacancyServiceServer)(nil),
-	Methods: []grpc.MethodDesc{
-		{
-			MethodName: "CreateVacancy",
-			Handler:    _VacancyService_CreateVacancy_Handler,
-		},
-		{
-			MethodName: "GetVacancy",
+func _VacancyService_DeleteVacancy_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(models.DeleteVacancyRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(VacancyServiceServer).DeleteVacancy(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: VacancyService_DeleteVacancy_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(VacancyServiceServer).DeleteVacancy(ctx, req.(*models.DeleteVacancyRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _VacancyService_ListVacancyVersions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(models.ListVacancyVersionsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(VacancyServiceServer).ListVacancyVersions(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: VacancyService_ListVacancyVersions_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(VacancyServiceServer).ListVacancyVersions(ctx, req.(*models.ListVacancyVersionsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _VacancyService_GetVacancyVersion_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(models.GetVacancyVersionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(VacancyServiceServer).GetVacancyVersion(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: VacancyService_GetVacancyVersion_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(VacancyServiceServer).GetVacancyVersion(ctx, req.(*models.GetVacancyVersionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _VacancyService_DiffVacancyVersions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(models.DiffVacancyVersionsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(VacancyServiceServer).DiffVacancyVersions(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: VacancyService_DiffVacancyVersions_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(VacancyServiceServer).DiffVacancyVersions(ctx, req.(*models.DiffVacancyVersionsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _VacancyService_TransitionVacancy_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(models.TransitionVacancyRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(VacancyServiceServer).TransitionVacancy(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: VacancyService_TransitionVacancy_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(VacancyServiceServer).TransitionVacancy(ctx, req.(*models.TransitionVacancyRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _VacancyService_RestoreVacancy_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(models.RestoreVacancyRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(VacancyServiceServer).RestoreVacancy(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: VacancyService_RestoreVacancy_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(VacancyServiceServer).RestoreVacancy(ctx, req.(*models.RestoreVacancyRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _VacancyService_ListVacancyStatusHistory_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(models.ListVacancyStatusHistoryRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(VacancyServiceServer).ListVacancyStatusHistory(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: VacancyService_ListVacancyStatusHistory_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(VacancyServiceServer).ListVacancyStatusHistory(ctx, req.(*models.ListVacancyStatusHistoryRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _VacancyService_CloneVacancy_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(models.CloneVacancyRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(VacancyServiceServer).CloneVacancy(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: VacancyService_CloneVacancy_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(VacancyServiceServer).CloneVacancy(ctx, req.(*models.CloneVacancyRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _VacancyService_CreateTemplate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(models.CreateTemplateRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(VacancyServiceServer).CreateTemplate(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: VacancyService_CreateTemplate_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(VacancyServiceServer).CreateTemplate(ctx, req.(*models.CreateTemplateRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _VacancyService_ListTemplates_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(models.ListTemplatesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(VacancyServiceServer).ListTemplates(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: VacancyService_ListTemplates_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(VacancyServiceServer).ListTemplates(ctx, req.(*models.ListTemplatesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _VacancyService_CreateVacancyFromTemplate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(models.CreateVacancyFromTemplateRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(VacancyServiceServer).CreateVacancyFromTemplate(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: VacancyService_CreateVacancyFromTemplate_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(VacancyServiceServer).CreateVacancyFromTemplate(ctx, req.(*models.CreateVacancyFromTemplateRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _VacancyService_DeleteTemplate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(models.DeleteTemplateRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(VacancyServiceServer).DeleteTemplate(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: VacancyService_DeleteTemplate_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(VacancyServiceServer).DeleteTemplate(ctx, req.(*models.DeleteTemplateRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _VacancyService_SetVacancyVisibility_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(models.SetVacancyVisibilityRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(VacancyServiceServer).SetVacancyVisibility(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: VacancyService_SetVacancyVisibility_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(VacancyServiceServer).SetVacancyVisibility(ctx, req.(*models.SetVacancyVisibilityRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _VacancyService_AddCollaborator_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(models.AddCollaboratorRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(VacancyServiceServer).AddCollaborator(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: VacancyService_AddCollaborator_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(VacancyServiceServer).AddCollaborator(ctx, req.(*models.AddCollaboratorRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _VacancyService_RemoveCollaborator_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(models.RemoveCollaboratorRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(VacancyServiceServer).RemoveCollaborator(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: VacancyService_RemoveCollaborator_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(VacancyServiceServer).RemoveCollaborator(ctx, req.(*models.RemoveCollaboratorRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _VacancyService_ListCollaborators_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(models.ListCollaboratorsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(VacancyServiceServer).ListCollaborators(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: VacancyService_ListCollaborators_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(VacancyServiceServer).ListCollaborators(ctx, req.(*models.ListCollaboratorsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _VacancyService_TransferVacancyOwnership_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(models.TransferVacancyOwnershipRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(VacancyServiceServer).TransferVacancyOwnership(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: VacancyService_TransferVacancyOwnership_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(VacancyServiceServer).TransferVacancyOwnership(ctx, req.(*models.TransferVacancyOwnershipRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _VacancyService_GetVacancyPipeline_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(models.GetVacancyPipelineRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(VacancyServiceServer).GetVacancyPipeline(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: VacancyService_GetVacancyPipeline_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(VacancyServiceServer).GetVacancyPipeline(ctx, req.(*models.GetVacancyPipelineRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _VacancyService_SetVacancyPipelineStages_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(models.SetVacancyPipelineStagesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(VacancyServiceServer).SetVacancyPipelineStages(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: VacancyService_SetVacancyPipelineStages_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(VacancyServiceServer).SetVacancyPipelineStages(ctx, req.(*models.SetVacancyPipelineStagesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _VacancyService_MoveCandidateStage_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(models.MoveCandidateStageRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(VacancyServiceServer).MoveCandidateStage(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: VacancyService_MoveCandidateStage_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(VacancyServiceServer).MoveCandidateStage(ctx, req.(*models.MoveCandidateStageRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _VacancyService_ListCandidateStageHistory_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(models.ListCandidateStageHistoryRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(VacancyServiceServer).ListCandidateStageHistory(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: VacancyService_ListCandidateStageHistory_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(VacancyServiceServer).ListCandidateStageHistory(ctx, req.(*models.ListCandidateStageHistoryRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _VacancyService_GetJobPosting_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(models.GetJobPostingRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(VacancyServiceServer).GetJobPosting(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: VacancyService_GetJobPosting_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(VacancyServiceServer).GetJobPosting(ctx, req.(*models.GetJobPostingRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _VacancyService_GetVacancyFeed_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(models.GetVacancyFeedRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(VacancyServiceServer).GetVacancyFeed(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: VacancyService_GetVacancyFeed_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(VacancyServiceServer).GetVacancyFeed(ctx, req.(*models.GetVacancyFeedRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _VacancyService_AutocompleteSkills_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(models.AutocompleteSkillsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(VacancyServiceServer).AutocompleteSkills(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: VacancyService_AutocompleteSkills_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(VacancyServiceServer).AutocompleteSkills(ctx, req.(*models.AutocompleteSkillsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _VacancyService_SuggestSkills_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(models.SuggestSkillsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(VacancyServiceServer).SuggestSkills(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: VacancyService_SuggestSkills_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(VacancyServiceServer).SuggestSkills(ctx, req.(*models.SuggestSkillsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _VacancyService_ListVacancyRoles_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(models.ListVacancyRolesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(VacancyServiceServer).ListVacancyRoles(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: VacancyService_ListVacancyRoles_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(VacancyServiceServer).ListVacancyRoles(ctx, req.(*models.ListVacancyRolesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _VacancyService_ReclassifyVacancyRoles_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(models.ReclassifyVacancyRolesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(VacancyServiceServer).ReclassifyVacancyRoles(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: VacancyService_ReclassifyVacancyRoles_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(VacancyServiceServer).ReclassifyVacancyRoles(ctx, req.(*models.ReclassifyVacancyRolesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// VacancyService_ServiceDesc is the grpc.ServiceDesc for VacancyService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var VacancyService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "vacancy.service.v1.VacancyService",
+	HandlerType: (*VacancyServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "CreateVacancy",
+			Handler:    _VacancyService_CreateVacancy_Handler,
+		},
+		{
+			MethodName: "ImportVacancies",
+			Handler:    _VacancyService_ImportVacancies_Handler,
+		},
+		{
+			MethodName: "GetVacancy",
 			Handler:    _VacancyService_GetVacancy_Handler,
 		},
 		{
@@ -268,6 +1283,114 @@ var VacancyService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "ArchiveVacancy",
 			Handler:    _VacancyService_ArchiveVacancy_Handler,
 		},
+		{
+			MethodName: "DeleteVacancy",
+			Handler:    _VacancyService_DeleteVacancy_Handler,
+		},
+		{
+			MethodName: "ListVacancyVersions",
+			Handler:    _VacancyService_ListVacancyVersions_Handler,
+		},
+		{
+			MethodName: "GetVacancyVersion",
+			Handler:    _VacancyService_GetVacancyVersion_Handler,
+		},
+		{
+			MethodName: "DiffVacancyVersions",
+			Handler:    _VacancyService_DiffVacancyVersions_Handler,
+		},
+		{
+			MethodName: "TransitionVacancy",
+			Handler:    _VacancyService_TransitionVacancy_Handler,
+		},
+		{
+			MethodName: "RestoreVacancy",
+			Handler:    _VacancyService_RestoreVacancy_Handler,
+		},
+		{
+			MethodName: "ListVacancyStatusHistory",
+			Handler:    _VacancyService_ListVacancyStatusHistory_Handler,
+		},
+		{
+			MethodName: "CloneVacancy",
+			Handler:    _VacancyService_CloneVacancy_Handler,
+		},
+		{
+			MethodName: "CreateTemplate",
+			Handler:    _VacancyService_CreateTemplate_Handler,
+		},
+		{
+			MethodName: "ListTemplates",
+			Handler:    _VacancyService_ListTemplates_Handler,
+		},
+		{
+			MethodName: "CreateVacancyFromTemplate",
+			Handler:    _VacancyService_CreateVacancyFromTemplate_Handler,
+		},
+		{
+			MethodName: "DeleteTemplate",
+			Handler:    _VacancyService_DeleteTemplate_Handler,
+		},
+		{
+			MethodName: "SetVacancyVisibility",
+			Handler:    _VacancyService_SetVacancyVisibility_Handler,
+		},
+		{
+			MethodName: "AddCollaborator",
+			Handler:    _VacancyService_AddCollaborator_Handler,
+		},
+		{
+			MethodName: "RemoveCollaborator",
+			Handler:    _VacancyService_RemoveCollaborator_Handler,
+		},
+		{
+			MethodName: "ListCollaborators",
+			Handler:    _VacancyService_ListCollaborators_Handler,
+		},
+		{
+			MethodName: "TransferVacancyOwnership",
+			Handler:    _VacancyService_TransferVacancyOwnership_Handler,
+		},
+		{
+			MethodName: "GetVacancyPipeline",
+			Handler:    _VacancyService_GetVacancyPipeline_Handler,
+		},
+		{
+			MethodName: "SetVacancyPipelineStages",
+			Handler:    _VacancyService_SetVacancyPipelineStages_Handler,
+		},
+		{
+			MethodName: "MoveCandidateStage",
+			Handler:    _VacancyService_MoveCandidateStage_Handler,
+		},
+		{
+			MethodName: "ListCandidateStageHistory",
+			Handler:    _VacancyService_ListCandidateStageHistory_Handler,
+		},
+		{
+			MethodName: "GetJobPosting",
+			Handler:    _VacancyService_GetJobPosting_Handler,
+		},
+		{
+			MethodName: "GetVacancyFeed",
+			Handler:    _VacancyService_GetVacancyFeed_Handler,
+		},
+		{
+			MethodName: "AutocompleteSkills",
+			Handler:    _VacancyService_AutocompleteSkills_Handler,
+		},
+		{
+			MethodName: "SuggestSkills",
+			Handler:    _VacancyService_SuggestSkills_Handler,
+		},
+		{
+			MethodName: "ListVacancyRoles",
+			Handler:    _VacancyService_ListVacancyRoles_Handler,
+		},
+		{
+			MethodName: "ReclassifyVacancyRoles",
+			Handler:    _VacancyService_ReclassifyVacancyRoles_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "vacancy_api/vacancy.proto",
@@ -0,0 +1,144 @@
+// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
+// versions:
+// - protoc-gen-go-grpc v1.6.0
+// - protoc             v7.34.1
+// source: vacancy_events_api/vacancy_events.proto
+
+package vacancy_events_api
+
+import (
+	context "context"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+// Requires gRPC-Go v1.64.0 or later.
+const _ = grpc.SupportPackageIsVersion9
+
+const (
+	VacancyEventService_SubscribeVacancyEvents_FullMethodName = "/vacancy.events.v1.VacancyEventService/SubscribeVacancyEvents"
+)
+
+// VacancyEventServiceClient is the client API for VacancyEventService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+//
+// VacancyEventService streams the vacancy outbox to other services, so they
+// learn about vacancy changes without reading the vacancy tables. Internal
+// only: no HTTP binding, and the vacancy auth interceptor lets it through
+// without a user bearer, like analysis.StartApplicationAnalysis.
+type VacancyEventServiceClient interface {
+	// SubscribeVacancyEvents replays the outbox after after_seq, then keeps
+	// the stream open and pushes new events as they commit. Delivery is
+	// at-least-once: a consumer stores the seq of the last event it has
+	// processed, resubscribes from it after any error, and drops events whose
+	// id it has already seen.
+	SubscribeVacancyEvents(ctx context.Context, in *SubscribeVacancyEventsRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[VacancyEvent], error)
+}
+
+type vacancyEventServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewVacancyEventServiceClient(cc grpc.ClientConnInterface) VacancyEventServiceClient {
+	return &vacancyEventServiceClient{cc}
+}
+
+func (c *vacancyEventServiceClient) SubscribeVacancyEvents(ctx context.Context, in *SubscribeVacancyEventsRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[VacancyEvent], error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	stream, err := c.cc.NewStream(ctx, &VacancyEventService_ServiceDesc.Streams[0], VacancyEventService_SubscribeVacancyEvents_FullMethodName, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &grpc.GenericClientStream[SubscribeVacancyEventsRequest, VacancyEvent]{ClientStream: stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type VacancyEventService_SubscribeVacancyEventsClient = grpc.ServerStreamingClient[VacancyEvent]
+
+// VacancyEventServiceServer is the server API for VacancyEventService service.
+// All implementations must embed UnimplementedVacancyEventServiceServer
+// for forward compatibility.
+//
+// VacancyEventService streams the vacancy outbox to other services, so they
+// learn about vacancy changes without reading the vacancy tables. Internal
+// only: no HTTP binding, and the vacancy auth interceptor lets it through
+// without a user bearer, like analysis.StartApplicationAnalysis.
+type VacancyEventServiceServer interface {
+	// SubscribeVacancyEvents replays the outbox after after_seq, then keeps
+	// the stream open and pushes new events as they commit. Delivery is
+	// at-least-once: a consumer stores the seq of the last event it has
+	// processed, resubscribes from it after any error, and drops events whose
+	// id it has already seen.
+	SubscribeVacancyEvents(*SubscribeVacancyEventsRequest, grpc.ServerStreamingServer[VacancyEvent]) error
+	mustEmbedUnimplementedVacancyEventServiceServer()
+}
+
+// UnimplementedVacancyEventServiceServer must be embedded to have
+// forward compatible implementations.
+//
+// NOTE: this should be embedded by value instead of pointer to avoid a nil
+// pointer dereference when methods are called.
+type UnimplementedVacancyEventServiceServer struct{}
+
+func (UnimplementedVacancyEventServiceServer) SubscribeVacancyEvents(*SubscribeVacancyEventsRequest, grpc.ServerStreamingServer[VacancyEvent]) error {
+	return status.Error(codes.Unimplemented, "method SubscribeVacancyEvents not implemented")
+}
+func (UnimplementedVacancyEventServiceServer) mustEmbedUnimplementedVacancyEventServiceServer() {}
+func (UnimplementedVacancyEventServiceServer) testEmbeddedByValue()                             {}
+
+// UnsafeVacancyEventServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to VacancyEventServiceServer will
+// result in compilation errors.
+type UnsafeVacancyEventServiceServer interface {
+	mustEmbedUnimplementedVacancyEventServiceServer()
+}
+
+func RegisterVacancyEventServiceServer(s grpc.ServiceRegistrar, srv VacancyEventServiceServer) {
+	// If the following call panics, it indicates UnimplementedVacancyEventServiceServer was
+	// embedded by pointer and is nil.  This will cause panics if an
+	// unimplemented method is ever invoked, so we test this at initialization
+	// time to prevent it from happening at runtime later due to I/O.
+	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
+		t.testEmbeddedByValue()
+	}
+	s.RegisterService(&VacancyEventService_ServiceDesc, srv)
+}
+
+func _VacancyEventService_SubscribeVacancyEvents_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(SubscribeVacancyEventsRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(VacancyEventServiceServer).SubscribeVacancyEvents(m, &grpc.GenericServerStream[SubscribeVacancyEventsRequest, VacancyEvent]{ServerStream: stream})
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type VacancyEventService_SubscribeVacancyEventsServer = grpc.ServerStreamingServer[VacancyEvent]
+
+// VacancyEventService_ServiceDesc is the grpc.ServiceDesc for VacancyEventService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var VacancyEventService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "vacancy.events.v1.VacancyEventService",
+	HandlerType: (*VacancyEventServiceServer)(nil),
+	Methods:     []grpc.MethodDesc{},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "SubscribeVacancyEvents",
+			Handler:       _VacancyEventService_SubscribeVacancyEvents_Handler,
+			ServerStreams: true,
+		},
+	},
+	Metadata: "vacancy_events_api/vacancy_events.proto",
+}
@@ -26,10 +26,16 @@ func (a *VacancyServiceAPI) ArchiveVacancy(ctx context.Context, req *pb_models.A
 		switch {
 		case errors.Is(err, usecase.ErrInvalidArgument):
 			return nil, newError(codes.InvalidArgument, ErrCodeInvalidInput, "Invalid vacancy id.")
+		case errors.Is(err, usecase.ErrVacancyNotFound):
+			return nil, newError(codes.NotFound, ErrCodeNotFound, "Vacancy not found.")
+		case errors.Is(err, usecase.ErrForbidden):
+			return nil, newError(codes.PermissionDenied, ErrCodeForbidden, "Viewers cannot archive the vacancy.")
+		case errors.Is(err, usecase.ErrInvalidTransition):
+			return nil, newError(codes.FailedPrecondition, ErrCodeInvalidTransition, "Vacancy status changed concurrently; retry.")
 		default:
 			return nil, newError(codes.Internal, ErrCodeInternal, "Internal error.")
 		}
 	}
 
-	return &pb_models.ArchiveVacancyResponse{Status: "archived"}, nil
+	return &pb_models.ArchiveVacancyResponse{Status: domain.StatusArchived}, nil
 }
@@ -0,0 +1,112 @@
+package grpc
+
+import (
+	"github.com/artem13815/hr/vacancy/internal/domain"
+	pb_models "github.com/artem13815/hr/vacancy/internal/pb/models"
+)
+
+var pbRemotePolicies = map[string]pb_models.RemotePolicy{
+	domain.RemoteOnsite: pb_models.RemotePolicy_REMOTE_POLICY_ONSITE,
+	domain.RemoteHybrid: pb_models.RemotePolicy_REMOTE_POLICY_HYBRID,
+	domain.RemoteFull:   pb_models.RemotePolicy_REMOTE_POLICY_REMOTE,
+}
+
+var pbEmploymentTypes = map[string]pb_models.EmploymentType{
+	domain.EmploymentFullTime:   pb_models.EmploymentType_EMPLOYMENT_TYPE_FULL_TIME,
+	domain.EmploymentPartTime:   pb_models.EmploymentType_EMPLOYMENT_TYPE_PART_TIME,
+	domain.EmploymentContract:   pb_models.EmploymentType_EMPLOYMENT_TYPE_CONTRACT,
+	domain.EmploymentInternship: pb_models.EmploymentType_EMPLOYMENT_TYPE_INTERNSHIP,
+	domain.EmploymentTemporary:  pb_models.EmploymentType_EMPLOYMENT_TYPE_TEMPORARY,
+}
+
+var pbSeniorities = map[string]pb_models.Seniority{
+	domain.SeniorityIntern: pb_models.Seniority_SENIORITY_INTERN,
+	domain.SeniorityJunior: pb_models.Seniority_SENIORITY_JUNIOR,
+	domain.SeniorityMiddle: pb_models.Seniority_SENIORITY_MIDDLE,
+	domain.SenioritySenior: pb_models.Seniority_SENIORITY_SENIOR,
+	domain.SeniorityLead:   pb_models.Seniority_SENIORITY_LEAD,
+}
+
+// fromPBEnum is the inverse lookup of the maps above. UNSPECIFIED and
+// unknown values map to "", i.e. "not stated" on input and "no filter" in
+// a facet.
+func fromPBEnum[E comparable](values map[string]E, v E) string {
+	for s, pb := range values {
+		if pb == v {
+			return s
+		}
+	}
+	return ""
+}
+
+func fromPBEnums[E comparable](values map[string]E, in []E) []string {
+	if len(in) == 0 {
+		return nil
+	}
+	out := make([]string, 0, len(in))
+	for _, v := range in {
+		out = append(out, fromPBEnum(values, v))
+	}
+	return out
+}
+
+func toPBAttributes(a domain.VacancyAttributes) *pb_models.VacancyAttributes {
+	return &pb_models.VacancyAttributes{
+		Location:       a.Location,
+		RemotePolicy:   pbRemotePolicies[a.RemotePolicy],
+		SalaryMin:      a.SalaryMin,
+		SalaryMax:      a.SalaryMax,
+		SalaryCurrency: a.SalaryCurrency,
+		EmploymentType: pbEmploymentTypes[a.EmploymentType],
+		Seniority:      pbSeniorities[a.Seniority],
+		Headcount:      a.Headcount,
+	}
+}
+
+func fromPBAttributes(a *pb_models.VacancyAttributes) domain.VacancyAttributes {
+	return domain.VacancyAttributes{
+		Location:       a.GetLocation(),
+		RemotePolicy:   fromPBEnum(pbRemotePolicies, a.GetRemotePolicy()),
+		SalaryMin:      a.GetSalaryMin(),
+		SalaryMax:      a.GetSalaryMax(),
+		SalaryCurrency: a.GetSalaryCurrency(),
+		EmploymentType: fromPBEnum(pbEmploymentTypes, a.GetEmploymentType()),
+		Seniority:      fromPBEnum(pbSeniorities, a.GetSeniority()),
+		Headcount:      a.GetHeadcount(),
+	}
+}
+
+// fromPBAttributesPatch keeps "omitted" distinct from "all cleared" for
+// UpdateVacancy: a missing message leaves the stored attributes alone.
+func fromPBAttributesPatch(a *pb_models.VacancyAttributes) *domain.VacancyAttributes {
+	if a == nil {
+		return nil
+	}
+	attrs := fromPBAttributes(a)
+	return &attrs
+}
+
+func toPBScoringPolicy(p domain.ScoringPolicy) *pb_models.ScoringPolicy {
+	return &pb_models.ScoringPolicy{
+		MustHavePenalty:         p.MustHavePenalty,
+		NiceToHaveBonus:         p.NiceToHaveBonus,
+		HireThreshold:           p.HireThreshold,
+		MaybeThreshold:          p.MaybeThreshold,
+		RejectOnMissingMustHave: p.RejectOnMissingMustHave,
+	}
+}
+
+// fromPBScoringPolicy maps an omitted policy to nil: the default on create,
+// "keep" on update.
+func fromPBScoringPolicy(p *pb_models.ScoringPolicy) *domain.ScoringPolicy {
+	if p == nil {
+		return nil
+	}
+	return &domain.ScoringPolicy{
+		MustHavePenalty:         p.GetMustHavePenalty(),
+		NiceToHaveBonus:         p.GetNiceToHaveBonus(),
+		HireThreshold:           p.GetHireThreshold(),
+		MaybeThreshold:          p.GetMaybeThreshold(),
+		RejectOnMissingMustHave: p.GetRejectOnMissingMustHave(),
+	}
+}
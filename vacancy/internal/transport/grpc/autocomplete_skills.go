@@ -0,0 +1,43 @@
+package grpc
+
+import (
+	"context"
+	"errors"
+
+	"github.com/artem13815/hr/vacancy/internal/domain"
+	pb_models "github.com/artem13815/hr/vacancy/internal/pb/models"
+	"github.com/artem13815/hr/vacancy/internal/transport/middleware"
+	"github.com/artem13815/hr/vacancy/internal/usecase"
+	"google.golang.org/grpc/codes"
+)
+
+func (a *VacancyServiceAPI) AutocompleteSkills(ctx context.Context, req *pb_models.AutocompleteSkillsRequest) (*pb_models.AutocompleteSkillsResponse, error) {
+	if _, ok := middleware.Get(ctx); !ok {
+		return nil, newError(codes.Unauthenticated, ErrCodeUnauthorized, "Authentication required.")
+	}
+
+	suggestions, err := a.vacancyService.AutocompleteSkills(ctx, domain.AutocompleteSkillsInput{
+		Query: req.GetQuery(),
+		Limit: req.GetLimit(),
+	})
+	if err != nil {
+		switch {
+		case errors.Is(err, usecase.ErrInvalidArgument):
+			return nil, newError(codes.InvalidArgument, ErrCodeInvalidInput, "Query must be 1 to 64 characters.")
+		default:
+			return nil, newError(codes.Internal, ErrCodeInternal, "Internal error.")
+		}
+	}
+
+	out := make([]*pb_models.SkillSuggestion, 0, len(suggestions))
+	for _, s := range suggestions {
+		out = append(out, &pb_models.SkillSuggestion{
+			Name:     s.Name,
+			NameRu:   s.NameRU,
+			Category: s.Category,
+			Matched:  s.Matched,
+		})
+	}
+
+	return &pb_models.AutocompleteSkillsResponse{Skills: out}, nil
+}
@@ -0,0 +1,90 @@
+package grpc
+
+import (
+	"context"
+	"errors"
+
+	"github.com/artem13815/hr/vacancy/internal/domain"
+	pb_models "github.com/artem13815/hr/vacancy/internal/pb/models"
+	"github.com/artem13815/hr/vacancy/internal/transport/middleware"
+	"github.com/artem13815/hr/vacancy/internal/usecase"
+	"google.golang.org/grpc/codes"
+)
+
+func (a *VacancyServiceAPI) AddCollaborator(ctx context.Context, req *pb_models.AddCollaboratorRequest) (*pb_models.CollaboratorResponse, error) {
+	userCtx, ok := middleware.Get(ctx)
+	if !ok {
+		return nil, newError(codes.Unauthenticated, ErrCodeUnauthorized, "Authentication required.")
+	}
+
+	collaborator, err := a.vacancyService.AddCollaborator(ctx, domain.AddCollaboratorInput{
+		VacancyID:   req.GetVacancyId(),
+		OwnerUserID: userCtx.UserID,
+		IsAdmin:     userCtx.IsAdmin,
+		UserID:      req.GetUserId(),
+		Role:        req.GetRole(),
+	})
+	if err != nil {
+		return nil, collaboratorError(err)
+	}
+
+	return &pb_models.CollaboratorResponse{Collaborator: toPBCollaborator(*collaborator)}, nil
+}
+
+func (a *VacancyServiceAPI) RemoveCollaborator(ctx context.Context, req *pb_models.RemoveCollaboratorRequest) (*pb_models.RemoveCollaboratorResponse, error) {
+	userCtx, ok := middleware.Get(ctx)
+	if !ok {
+		return nil, newError(codes.Unauthenticated, ErrCodeUnauthorized, "Authentication required.")
+	}
+
+	err := a.vacancyService.RemoveCollaborator(ctx, domain.RemoveCollaboratorInput{
+		VacancyID:   req.GetVacancyId(),
+		OwnerUserID: userCtx.UserID,
+		IsAdmin:     userCtx.IsAdmin,
+		UserID:      req.GetUserId(),
+	})
+	if err != nil {
+		return nil, collaboratorError(err)
+	}
+
+	return &pb_models.RemoveCollaboratorResponse{}, nil
+}
+
+func (a *VacancyServiceAPI) ListCollaborators(ctx context.Context, req *pb_models.ListCollaboratorsRequest) (*pb_models.ListCollaboratorsResponse, error) {
+	userCtx, ok := middleware.Get(ctx)
+	if !ok {
+		return nil, newError(codes.Unauthenticated, ErrCodeUnauthorized, "Authentication required.")
+	}
+
+	collaborators, err := a.vacancyService.ListCollaborators(ctx, domain.ListCollaboratorsInput{
+		VacancyID:   req.GetVacancyId(),
+		OwnerUserID: userCtx.UserID,
+		IsAdmin:     userCtx.IsAdmin,
+	})
+	if err != nil {
+		return nil, collaboratorError(err)
+	}
+
+	out := make([]*pb_models.Collaborator, 0, len(collaborators))
+	for _, c := range collaborators {
+		out = append(out, toPBCollaborator(c))
+	}
+	return &pb_models.ListCollaboratorsResponse{Collaborators: out}, nil
+}
+
+// collaboratorError maps the errors shared by the three collaborator
+// handlers.
+func collaboratorError(err error) error {
+	switch {
+	case errors.Is(err, usecase.ErrInvalidArgument):
+		return newError(codes.InvalidArgument, ErrCodeInvalidInput, "Invalid vacancy id, user id or role.")
+	case errors.Is(err, usecase.ErrVacancyNotFound):
+		return newError(codes.NotFound, ErrCodeNotFound, "Vacancy not found.")
+	case errors.Is(err, usecase.ErrCollaboratorNotFound):
+		return newError(codes.NotFound, ErrCodeNotFound, "Collaborator not found.")
+	case errors.Is(err, usecase.ErrForbidden):
+		return newError(codes.PermissionDenied, ErrCodeForbidden, "Only the vacancy owner can manage collaborators.")
+	default:
+		return newError(codes.Internal, ErrCodeInternal, "Internal error.")
+	}
+}
@@ -8,24 +8,34 @@ import (
 	pb_models "github.com/artem13815/hr/vacancy/internal/pb/models"
 	"github.com/artem13815/hr/vacancy/internal/transport/middleware"
 	"github.com/artem13815/hr/vacancy/internal/usecase"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
 	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 )
 
-func (a *VacancyServiceAPI) CreateVacancy(ctx context.Context, req *pb_models.CreateVacancyRequest) (*pb_models.VacancyResponse, error) {
+func (a *VacancyServiceAPI) CreateVacancy(ctx context.Context, req *pb_models.CreateVacancyRequest) (*pb_models.CreateVacancyResponse, error) {
 	userCtx, ok := middleware.Get(ctx)
 	if !ok {
 		return nil, newError(codes.Unauthenticated, ErrCodeUnauthorized, "Authentication required.")
 	}
 
 	vacancy, err := a.vacancyService.CreateVacancy(ctx, domain.CreateVacancyInput{
-		OwnerUserID: userCtx.UserID,
-		Title:       req.GetTitle(),
-		Description: req.GetDescription(),
-		Role:        req.GetRole(),
-		Skills:      fromPBSkills(req.GetSkills()),
+		OwnerUserID:   userCtx.UserID,
+		Title:         req.GetTitle(),
+		Description:   req.GetDescription(),
+		Role:          req.GetRole(),
+		Skills:        fromPBSkills(req.GetSkills()),
+		Status:        initialStatus(req.GetDraft()),
+		Attributes:    fromPBAttributes(req.GetAttributes()),
+		ScoringPolicy: fromPBScoringPolicy(req.GetScoringPolicy()),
+		Force:         req.GetForce(),
+		IsAdmin:       userCtx.IsAdmin,
 	})
 	if err != nil {
+		var duplicates *domain.PossibleDuplicatesError
 		switch {
+		case errors.As(err, &duplicates):
+			return nil, possibleDuplicatesError(duplicates.Duplicates)
 		case errors.Is(err, usecase.ErrInvalidArgument):
 			return nil, newError(codes.InvalidArgument, ErrCodeInvalidInput, "Invalid vacancy payload.")
 		case errors.Is(err, usecase.ErrUnauthorized):
@@ -35,5 +45,41 @@ func (a *VacancyServiceAPI) CreateVacancy(ctx context.Context, req *pb_models.Cr
 		}
 	}
 
-	return &pb_models.VacancyResponse{Vacancy: toPBVacancy(*vacancy)}, nil
+	return &pb_models.CreateVacancyResponse{Vacancy: toPBVacancy(*vacancy)}, nil
+}
+
+// possibleDuplicatesError is ALREADY_EXISTS (HTTP 409) with the usual
+// ErrorInfo plus a PossibleDuplicates detail listing what was found.
+func possibleDuplicatesError(duplicates []domain.PossibleDuplicate) error {
+	st := status.New(codes.AlreadyExists, "Similar open vacancies exist; retry with force to create anyway.")
+	withDetails, err := st.WithDetails(
+		&errdetails.ErrorInfo{Reason: ErrCodePossibleDuplicate, Domain: errorDomain},
+		&pb_models.PossibleDuplicates{Duplicates: toPBDuplicates(duplicates)},
+	)
+	if err != nil {
+		return st.Err()
+	}
+	return withDetails.Err()
+}
+
+func toPBDuplicates(duplicates []domain.PossibleDuplicate) []*pb_models.VacancyDuplicate {
+	out := make([]*pb_models.VacancyDuplicate, 0, len(duplicates))
+	for _, d := range duplicates {
+		out = append(out, &pb_models.VacancyDuplicate{
+			VacancyId:       d.VacancyID,
+			Title:           d.Title,
+			OwnerUserId:     d.OwnerUserID,
+			TitleSimilarity: d.TitleSimilarity,
+			SkillSimilarity: d.SkillSimilarity,
+			Similarity:      d.Similarity,
+		})
+	}
+	return out
+}
+
+func initialStatus(draft bool) string {
+	if draft {
+		return domain.StatusDraft
+	}
+	return domain.StatusOpen
 }
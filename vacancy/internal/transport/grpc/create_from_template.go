@@ -0,0 +1,38 @@
+package grpc
+
+import (
+	"context"
+	"errors"
+
+	"github.com/artem13815/hr/vacancy/internal/domain"
+	pb_models "github.com/artem13815/hr/vacancy/internal/pb/models"
+	"github.com/artem13815/hr/vacancy/internal/transport/middleware"
+	"github.com/artem13815/hr/vacancy/internal/usecase"
+	"google.golang.org/grpc/codes"
+)
+
+func (a *VacancyServiceAPI) CreateVacancyFromTemplate(ctx context.Context, req *pb_models.CreateVacancyFromTemplateRequest) (*pb_models.VacancyResponse, error) {
+	userCtx, ok := middleware.Get(ctx)
+	if !ok {
+		return nil, newError(codes.Unauthenticated, ErrCodeUnauthorized, "Authentication required.")
+	}
+
+	vacancy, err := a.vacancyService.CreateVacancyFromTemplate(ctx, domain.CreateVacancyFromTemplateInput{
+		TemplateID:  req.GetTemplateId(),
+		OwnerUserID: userCtx.UserID,
+		IsAdmin:     userCtx.IsAdmin,
+		Title:       req.GetTitle(),
+	})
+	if err != nil {
+		switch {
+		case errors.Is(err, usecase.ErrInvalidArgument):
+			return nil, newError(codes.InvalidArgument, ErrCodeInvalidInput, "Invalid template id or title.")
+		case errors.Is(err, usecase.ErrTemplateNotFound):
+			return nil, newError(codes.NotFound, ErrCodeTemplateNotFound, "Template not found.")
+		default:
+			return nil, newError(codes.Internal, ErrCodeInternal, "Internal error.")
+		}
+	}
+
+	return &pb_models.VacancyResponse{Vacancy: toPBVacancy(*vacancy)}, nil
+}
@@ -0,0 +1,41 @@
+package grpc
+
+import (
+	"context"
+	"errors"
+
+	"github.com/artem13815/hr/vacancy/internal/domain"
+	pb_models "github.com/artem13815/hr/vacancy/internal/pb/models"
+	"github.com/artem13815/hr/vacancy/internal/transport/middleware"
+	"github.com/artem13815/hr/vacancy/internal/usecase"
+	"google.golang.org/grpc/codes"
+)
+
+func (a *VacancyServiceAPI) CreateTemplate(ctx context.Context, req *pb_models.CreateTemplateRequest) (*pb_models.VacancyTemplateResponse, error) {
+	userCtx, ok := middleware.Get(ctx)
+	if !ok {
+		return nil, newError(codes.Unauthenticated, ErrCodeUnauthorized, "Authentication required.")
+	}
+
+	tmpl, err := a.vacancyService.CreateTemplate(ctx, domain.CreateTemplateInput{
+		VacancyID:   req.GetVacancyId(),
+		OwnerUserID: userCtx.UserID,
+		IsAdmin:     userCtx.IsAdmin,
+		Name:        req.GetName(),
+		Scope:       fromPBTemplateScope(req.GetScope()),
+	})
+	if err != nil {
+		switch {
+		case errors.Is(err, usecase.ErrInvalidArgument):
+			return nil, newError(codes.InvalidArgument, ErrCodeInvalidInput, "Invalid vacancy id, template name or scope.")
+		case errors.Is(err, usecase.ErrVacancyNotFound):
+			return nil, newError(codes.NotFound, ErrCodeNotFound, "Vacancy not found.")
+		case errors.Is(err, usecase.ErrForbidden):
+			return nil, newError(codes.PermissionDenied, ErrCodeForbidden, "Only admins can create org-wide templates.")
+		default:
+			return nil, newError(codes.Internal, ErrCodeInternal, "Internal error.")
+		}
+	}
+
+	return &pb_models.VacancyTemplateResponse{Template: toPBTemplate(*tmpl)}, nil
+}
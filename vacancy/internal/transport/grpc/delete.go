@@ -0,0 +1,39 @@
+package grpc
+
+import (
+	"context"
+	"errors"
+
+	"github.com/artem13815/hr/vacancy/internal/domain"
+	pb_models "github.com/artem13815/hr/vacancy/internal/pb/models"
+	"github.com/artem13815/hr/vacancy/internal/transport/middleware"
+	"github.com/artem13815/hr/vacancy/internal/usecase"
+	"google.golang.org/grpc/codes"
+)
+
+func (a *VacancyServiceAPI) DeleteVacancy(ctx context.Context, req *pb_models.DeleteVacancyRequest) (*pb_models.DeleteVacancyResponse, error) {
+	userCtx, ok := middleware.Get(ctx)
+	if !ok {
+		return nil, newError(codes.Unauthenticated, ErrCodeUnauthorized, "Authentication required.")
+	}
+
+	err := a.vacancyService.DeleteVacancy(ctx, domain.DeleteVacancyInput{
+		VacancyID:   req.GetVacancyId(),
+		OwnerUserID: userCtx.UserID,
+		IsAdmin:     userCtx.IsAdmin,
+	})
+	if err != nil {
+		switch {
+		case errors.Is(err, usecase.ErrInvalidArgument):
+			return nil, newError(codes.InvalidArgument, ErrCodeInvalidInput, "Invalid vacancy id.")
+		case errors.Is(err, usecase.ErrVacancyNotFound):
+			return nil, newError(codes.NotFound, ErrCodeNotFound, "Vacancy not found.")
+		case errors.Is(err, usecase.ErrForbidden):
+			return nil, newError(codes.PermissionDenied, ErrCodeForbidden, "Only the vacancy owner can delete the vacancy.")
+		default:
+			return nil, newError(codes.Internal, ErrCodeInternal, "Internal error.")
+		}
+	}
+
+	return &pb_models.DeleteVacancyResponse{}, nil
+}
@@ -0,0 +1,39 @@
+package grpc
+
+import (
+	"context"
+	"errors"
+
+	"github.com/artem13815/hr/vacancy/internal/domain"
+	pb_models "github.com/artem13815/hr/vacancy/internal/pb/models"
+	"github.com/artem13815/hr/vacancy/internal/transport/middleware"
+	"github.com/artem13815/hr/vacancy/internal/usecase"
+	"google.golang.org/grpc/codes"
+)
+
+func (a *VacancyServiceAPI) DeleteTemplate(ctx context.Context, req *pb_models.DeleteTemplateRequest) (*pb_models.DeleteTemplateResponse, error) {
+	userCtx, ok := middleware.Get(ctx)
+	if !ok {
+		return nil, newError(codes.Unauthenticated, ErrCodeUnauthorized, "Authentication required.")
+	}
+
+	err := a.vacancyService.DeleteTemplate(ctx, domain.DeleteTemplateInput{
+		TemplateID:  req.GetTemplateId(),
+		OwnerUserID: userCtx.UserID,
+		IsAdmin:     userCtx.IsAdmin,
+	})
+	if err != nil {
+		switch {
+		case errors.Is(err, usecase.ErrInvalidArgument):
+			return nil, newError(codes.InvalidArgument, ErrCodeInvalidInput, "Invalid template id.")
+		case errors.Is(err, usecase.ErrTemplateNotFound):
+			return nil, newError(codes.NotFound, ErrCodeTemplateNotFound, "Template not found.")
+		case errors.Is(err, usecase.ErrForbidden):
+			return nil, newError(codes.PermissionDenied, ErrCodeForbidden, "Only the template owner can delete the template.")
+		default:
+			return nil, newError(codes.Internal, ErrCodeInternal, "Internal error.")
+		}
+	}
+
+	return &pb_models.DeleteTemplateResponse{}, nil
+}
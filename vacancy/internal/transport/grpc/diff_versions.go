@@ -0,0 +1,39 @@
+package grpc
+
+import (
+	"context"
+	"errors"
+
+	"github.com/artem13815/hr/vacancy/internal/domain"
+	pb_models "github.com/artem13815/hr/vacancy/internal/pb/models"
+	"github.com/artem13815/hr/vacancy/internal/transport/middleware"
+	"github.com/artem13815/hr/vacancy/internal/usecase"
+	"google.golang.org/grpc/codes"
+)
+
+func (a *VacancyServiceAPI) DiffVacancyVersions(ctx context.Context, req *pb_models.DiffVacancyVersionsRequest) (*pb_models.DiffVacancyVersionsResponse, error) {
+	userCtx, ok := middleware.Get(ctx)
+	if !ok {
+		return nil, newError(codes.Unauthenticated, ErrCodeUnauthorized, "Authentication required.")
+	}
+
+	diff, err := a.vacancyService.DiffVacancyVersions(ctx, domain.DiffVacancyVersionsInput{
+		VacancyID:   req.GetVacancyId(),
+		FromVersion: req.GetFromVersion(),
+		ToVersion:   req.GetToVersion(),
+		OwnerUserID: userCtx.UserID,
+		IsAdmin:     userCtx.IsAdmin,
+	})
+	if err != nil {
+		switch {
+		case errors.Is(err, usecase.ErrInvalidArgument):
+			return nil, newError(codes.InvalidArgument, ErrCodeInvalidInput, "Invalid vacancy id or versions.")
+		case errors.Is(err, usecase.ErrVersionNotFound):
+			return nil, newError(codes.NotFound, ErrCodeNotFound, "Vacancy version not found.")
+		default:
+			return nil, newError(codes.Internal, ErrCodeInternal, "Internal error.")
+		}
+	}
+
+	return toPBVersionDiff(*diff), nil
+}
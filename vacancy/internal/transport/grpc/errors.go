@@ -10,7 +10,33 @@ const (
 	ErrCodeInvalidInput = "INVALID_INPUT"
 	ErrCodeUnauthorized = "UNAUTHORIZED"
 	ErrCodeNotFound     = "NOT_FOUND"
-	ErrCodeInternal     = "INTERNAL_ERROR"
+	// ErrCodeTemplateNotFound distinguishes a missing template from a
+	// missing vacancy on routes that take both kinds of id.
+	ErrCodeTemplateNotFound = "TEMPLATE_NOT_FOUND"
+	ErrCodeInternal         = "INTERNAL_ERROR"
+	// ErrCodeVersionConflict accompanies codes.Aborted when an update was
+	// made against a stale version. ErrorInfo.Metadata["current_version"]
+	// carries the version the client should re-fetch.
+	ErrCodeVersionConflict = "VERSION_CONFLICT"
+	// ErrCodeInvalidTransition accompanies codes.FailedPrecondition when the
+	// lifecycle state machine rejects a status change.
+	ErrCodeInvalidTransition = "INVALID_TRANSITION"
+	// ErrCodeForbidden accompanies codes.PermissionDenied when the caller
+	// can see the vacancy but the operation is reserved for its owner.
+	ErrCodeForbidden = "FORBIDDEN"
+	// ErrCodeCandidateNotFound distinguishes a missing candidate from a
+	// missing vacancy on pipeline routes.
+	ErrCodeCandidateNotFound = "CANDIDATE_NOT_FOUND"
+	// ErrCodeInvalidStageTransition accompanies codes.FailedPrecondition when
+	// a candidate cannot make the requested pipeline move.
+	ErrCodeInvalidStageTransition = "INVALID_STAGE_TRANSITION"
+	// ErrCodeStageInUse accompanies codes.FailedPrecondition when a pipeline
+	// change would drop a stage that candidates are in.
+	ErrCodeStageInUse = "STAGE_IN_USE"
+	// ErrCodePossibleDuplicate accompanies codes.AlreadyExists when
+	// CreateVacancy found likely duplicates and was not forced; a
+	// PossibleDuplicates detail lists them.
+	ErrCodePossibleDuplicate = "POSSIBLE_DUPLICATE"
 
 	// errorDomain identifies which service surface produced the error. Clients
 	// that aggregate errors from multiple services use Domain to disambiguate
@@ -26,10 +52,18 @@ const (
 // for ErrorInfo), we fall back to a plain status with the human message so
 // the caller still gets the right code.
 func newError(grpcCode codes.Code, errCode, message string) error {
+	return newErrorWithMetadata(grpcCode, errCode, message, nil)
+}
+
+// newErrorWithMetadata is newError plus ErrorInfo.Metadata — for errors
+// whose machine-readable payload is more than the reason code (e.g. the
+// current version on a conflict).
+func newErrorWithMetadata(grpcCode codes.Code, errCode, message string, metadata map[string]string) error {
 	st := status.New(grpcCode, message)
 	withDetails, err := st.WithDetails(&errdetails.ErrorInfo{
-		Reason: errCode,
-		Domain: errorDomain,
+		Reason:   errCode,
+		Domain:   errorDomain,
+		Metadata: metadata,
 	})
 	if err != nil {
 		return st.Err()
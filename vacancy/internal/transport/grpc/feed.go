@@ -0,0 +1,82 @@
+package grpc
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+	"strings"
+	"time"
+
+	"google.golang.org/genproto/googleapis/api/httpbody"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+
+	pb_models "github.com/artem13815/hr/vacancy/internal/pb/models"
+	"github.com/artem13815/hr/vacancy/internal/usecase"
+)
+
+// FeedOptions describe the organisation in the public feed; see
+// config.FeedConfig. SiteURL is the career site the vacancy links point
+// at: <SiteURL>/vacancies/<id>.
+type FeedOptions struct {
+	OrganizationName string
+	SiteURL          string
+	CacheMaxAge      time.Duration
+}
+
+func (o FeedOptions) vacancyURL(id string) string {
+	if o.SiteURL == "" {
+		return ""
+	}
+	return strings.TrimRight(o.SiteURL, "/") + "/vacancies/" + id
+}
+
+// GetJobPosting and GetVacancyFeed run without an authenticated user (see
+// middleware.publicMethods): they only ever read published vacancies.
+
+func (a *VacancyServiceAPI) GetJobPosting(ctx context.Context, req *pb_models.GetJobPostingRequest) (*httpbody.HttpBody, error) {
+	vacancy, err := a.vacancyService.GetPublicVacancy(ctx, req.GetVacancyId())
+	if err != nil {
+		switch {
+		case errors.Is(err, usecase.ErrVacancyNotFound):
+			return nil, newError(codes.NotFound, ErrCodeNotFound, "Vacancy not found.")
+		default:
+			return nil, newError(codes.Internal, ErrCodeInternal, "Internal error.")
+		}
+	}
+
+	body, err := renderJobPosting(*vacancy, a.feed)
+	if err != nil {
+		slog.ErrorContext(ctx, "render job posting", "vacancy_id", vacancy.ID, "err", err)
+		return nil, newError(codes.Internal, ErrCodeInternal, "Internal error.")
+	}
+	a.setFeedCacheHeader(ctx)
+	return &httpbody.HttpBody{ContentType: "application/ld+json; charset=utf-8", Data: body}, nil
+}
+
+func (a *VacancyServiceAPI) GetVacancyFeed(ctx context.Context, req *pb_models.GetVacancyFeedRequest) (*httpbody.HttpBody, error) {
+	vacancies, err := a.vacancyService.ListPublicVacancies(ctx, req.GetOwnerUserId())
+	if err != nil {
+		return nil, newError(codes.Internal, ErrCodeInternal, "Internal error.")
+	}
+
+	body, err := renderVacancyFeed(vacancies, a.feed, time.Now())
+	if err != nil {
+		slog.ErrorContext(ctx, "render vacancy feed", "owner_user_id", req.GetOwnerUserId(), "err", err)
+		return nil, newError(codes.Internal, ErrCodeInternal, "Internal error.")
+	}
+	a.setFeedCacheHeader(ctx)
+	return &httpbody.HttpBody{ContentType: "application/xml; charset=utf-8", Data: body}, nil
+}
+
+// setFeedCacheHeader lets the gateway, CDNs and job-board crawlers cache
+// feed responses. The gateway maps the cache-control metadata onto the HTTP
+// header.
+func (a *VacancyServiceAPI) setFeedCacheHeader(ctx context.Context) {
+	maxAge := int(a.feed.CacheMaxAge.Seconds())
+	if err := grpc.SetHeader(ctx, metadata.Pairs("cache-control", fmt.Sprintf("public, max-age=%d", maxAge))); err != nil {
+		slog.WarnContext(ctx, "set feed cache header", "err", err)
+	}
+}
@@ -0,0 +1,239 @@
+package grpc
+
+import (
+	"encoding/json"
+	"encoding/xml"
+	"html"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/artem13815/hr/vacancy/internal/domain"
+)
+
+// schema.org JobPosting, the subset search engines read
+// (https://schema.org/JobPosting). description is HTML per the spec.
+type jobPostingLD struct {
+	Context            string          `json:"@context"`
+	Type               string          `json:"@type"`
+	Identifier         ldPropertyValue `json:"identifier"`
+	Title              string          `json:"title"`
+	Description        string          `json:"description"`
+	DatePosted         string          `json:"datePosted"`
+	Skills             []string        `json:"skills,omitempty"`
+	HiringOrganization *ldOrganization `json:"hiringOrganization,omitempty"`
+	URL                string          `json:"url,omitempty"`
+	JobLocation        *ldPlace        `json:"jobLocation,omitempty"`
+	JobLocationType    string          `json:"jobLocationType,omitempty"`
+	EmploymentType     string          `json:"employmentType,omitempty"`
+	BaseSalary         *ldSalary       `json:"baseSalary,omitempty"`
+	TotalJobOpenings   uint32          `json:"totalJobOpenings,omitempty"`
+}
+
+type ldPropertyValue struct {
+	Type  string `json:"@type"`
+	Name  string `json:"name,omitempty"`
+	Value string `json:"value"`
+}
+
+type ldOrganization struct {
+	Type   string `json:"@type"`
+	Name   string `json:"name"`
+	SameAs string `json:"sameAs,omitempty"`
+}
+
+type ldPlace struct {
+	Type    string    `json:"@type"`
+	Address ldAddress `json:"address"`
+}
+
+type ldAddress struct {
+	Type            string `json:"@type"`
+	AddressLocality string `json:"addressLocality"`
+}
+
+type ldSalary struct {
+	Type     string        `json:"@type"`
+	Currency string        `json:"currency"`
+	Value    ldSalaryValue `json:"value"`
+}
+
+type ldSalaryValue struct {
+	Type     string `json:"@type"`
+	MinValue uint64 `json:"minValue,omitempty"`
+	MaxValue uint64 `json:"maxValue,omitempty"`
+	UnitText string `json:"unitText"`
+}
+
+// ldEmploymentTypes maps onto the schema.org employmentType vocabulary.
+var ldEmploymentTypes = map[string]string{
+	domain.EmploymentFullTime:   "FULL_TIME",
+	domain.EmploymentPartTime:   "PART_TIME",
+	domain.EmploymentContract:   "CONTRACTOR",
+	domain.EmploymentInternship: "INTERN",
+	domain.EmploymentTemporary:  "TEMPORARY",
+}
+
+func renderJobPosting(v domain.Vacancy, o FeedOptions) ([]byte, error) {
+	ld := jobPostingLD{
+		Context:     "https://schema.org",
+		Type:        "JobPosting",
+		Identifier:  ldPropertyValue{Type: "PropertyValue", Name: o.OrganizationName, Value: v.ID},
+		Title:       v.Title,
+		Description: textToHTML(v.Description),
+		DatePosted:  v.CreatedAt.UTC().Format(time.DateOnly),
+		Skills:      skillNames(v.Skills),
+		URL:         o.vacancyURL(v.ID),
+	}
+	if o.OrganizationName != "" {
+		ld.HiringOrganization = &ldOrganization{Type: "Organization", Name: o.OrganizationName, SameAs: o.SiteURL}
+	}
+	a := v.Attributes
+	if a.Location != "" {
+		ld.JobLocation = &ldPlace{Type: "Place", Address: ldAddress{Type: "PostalAddress", AddressLocality: a.Location}}
+	}
+	if a.RemotePolicy == domain.RemoteFull {
+		ld.JobLocationType = "TELECOMMUTE"
+	}
+	ld.EmploymentType = ldEmploymentTypes[a.EmploymentType]
+	if a.SalaryMin > 0 || a.SalaryMax > 0 {
+		ld.BaseSalary = &ldSalary{Type: "MonetaryAmount", Currency: a.SalaryCurrency, Value: ldSalaryValue{
+			Type: "QuantitativeValue", MinValue: a.SalaryMin, MaxValue: a.SalaryMax, UnitText: "MONTH",
+		}}
+	}
+	ld.TotalJobOpenings = a.Headcount
+	return json.MarshalIndent(ld, "", "  ")
+}
+
+// xmlFeed follows the Yandex Jobs / hh.ru partner feed layout: a <source>
+// with one <vacancy> per published vacancy.
+type xmlFeed struct {
+	XMLName      xml.Name     `xml:"source"`
+	CreationTime string       `xml:"creation-time,attr"`
+	Host         string       `xml:"host,attr,omitempty"`
+	Vacancies    []xmlVacancy `xml:"vacancies>vacancy"`
+}
+
+type xmlVacancy struct {
+	ID           string          `xml:"id"`
+	URL          string          `xml:"url,omitempty"`
+	CreationDate string          `xml:"creation-date"`
+	UpdateDate   string          `xml:"update-date"`
+	JobName      string          `xml:"job-name"`
+	Description  string          `xml:"description"`
+	Requirement  *xmlRequirement `xml:"requirement,omitempty"`
+	Company      *xmlCompany     `xml:"company,omitempty"`
+	Salary       string          `xml:"salary,omitempty"`
+	Currency     string          `xml:"currency,omitempty"`
+	Addresses    []xmlAddress    `xml:"addresses>address,omitempty"`
+	Employment   string          `xml:"employment,omitempty"`
+	Schedule     string          `xml:"schedule,omitempty"`
+	Seniority    string          `xml:"seniority,omitempty"`
+	Headcount    uint32          `xml:"vacancies-count,omitempty"`
+}
+
+type xmlAddress struct {
+	Location string `xml:"location"`
+}
+
+type xmlRequirement struct {
+	Qualification string     `xml:"qualification,omitempty"`
+	Skills        []xmlSkill `xml:"skills>skill"`
+}
+
+type xmlSkill struct {
+	Name       string `xml:",chardata"`
+	MustHave   bool   `xml:"must-have,attr,omitempty"`
+	NiceToHave bool   `xml:"nice-to-have,attr,omitempty"`
+}
+
+type xmlCompany struct {
+	Name string `xml:"name"`
+	Site string `xml:"site,omitempty"`
+}
+
+func renderVacancyFeed(vacancies []domain.Vacancy, o FeedOptions, now time.Time) ([]byte, error) {
+	feed := xmlFeed{
+		CreationTime: feedTime(now),
+		Host:         o.SiteURL,
+		Vacancies:    make([]xmlVacancy, 0, len(vacancies)),
+	}
+	for _, v := range vacancies {
+		item := xmlVacancy{
+			ID:           v.ID,
+			URL:          o.vacancyURL(v.ID),
+			CreationDate: feedTime(v.CreatedAt),
+			UpdateDate:   feedTime(v.UpdatedAt),
+			JobName:      v.Title,
+			Description:  v.Description,
+		}
+		if len(v.Skills) > 0 {
+			req := &xmlRequirement{Qualification: strings.Join(skillNames(v.Skills), ", ")}
+			for _, s := range v.Skills {
+				req.Skills = append(req.Skills, xmlSkill{Name: s.Name, MustHave: s.MustHave, NiceToHave: s.NiceToHave})
+			}
+			item.Requirement = req
+		}
+		if o.OrganizationName != "" {
+			item.Company = &xmlCompany{Name: o.OrganizationName, Site: o.SiteURL}
+		}
+		a := v.Attributes
+		if a.SalaryMin > 0 || a.SalaryMax > 0 {
+			item.Salary, item.Currency = salaryRange(a.SalaryMin, a.SalaryMax), a.SalaryCurrency
+		}
+		if a.Location != "" {
+			item.Addresses = []xmlAddress{{Location: a.Location}}
+		}
+		item.Employment, item.Schedule, item.Seniority = a.EmploymentType, a.RemotePolicy, a.Seniority
+		item.Headcount = a.Headcount
+		feed.Vacancies = append(feed.Vacancies, item)
+	}
+
+	body, err := xml.MarshalIndent(feed, "", "  ")
+	if err != nil {
+		return nil, err
+	}
+	return append([]byte(xml.Header), body...), nil
+}
+
+// feedTime is the job-board date format: "2006-01-02 15:04:05 GMT+0".
+func feedTime(t time.Time) string {
+	return t.UTC().Format(time.DateTime) + " GMT+0"
+}
+
+// salaryRange is the feed's free-form salary: "от 100000", "до 200000" or
+// "100000-200000".
+func salaryRange(minSalary, maxSalary uint64) string {
+	switch {
+	case maxSalary == 0:
+		return "от " + strconv.FormatUint(minSalary, 10)
+	case minSalary == 0:
+		return "до " + strconv.FormatUint(maxSalary, 10)
+	default:
+		return strconv.FormatUint(minSalary, 10) + "-" + strconv.FormatUint(maxSalary, 10)
+	}
+}
+
+func skillNames(skills []domain.SkillWeight) []string {
+	names := make([]string, 0, len(skills))
+	for _, s := range skills {
+		names = append(names, s.Name)
+	}
+	return names
+}
+
+// textToHTML renders a plain-text description as HTML: blank lines split
+// paragraphs, single line breaks become <br>.
+func textToHTML(text string) string {
+	var b strings.Builder
+	for _, para := range strings.Split(strings.ReplaceAll(text, "\r\n", "\n"), "\n\n") {
+		para = strings.TrimSpace(para)
+		if para == "" {
+			continue
+		}
+		b.WriteString("<p>")
+		b.WriteString(strings.ReplaceAll(html.EscapeString(para), "\n", "<br>"))
+		b.WriteString("</p>")
+	}
+	return b.String()
+}
@@ -0,0 +1,38 @@
+package grpc
+
+import (
+	"context"
+	"errors"
+
+	"github.com/artem13815/hr/vacancy/internal/domain"
+	pb_models "github.com/artem13815/hr/vacancy/internal/pb/models"
+	"github.com/artem13815/hr/vacancy/internal/transport/middleware"
+	"github.com/artem13815/hr/vacancy/internal/usecase"
+	"google.golang.org/grpc/codes"
+)
+
+func (a *VacancyServiceAPI) GetVacancyVersion(ctx context.Context, req *pb_models.GetVacancyVersionRequest) (*pb_models.VacancyVersionResponse, error) {
+	userCtx, ok := middleware.Get(ctx)
+	if !ok {
+		return nil, newError(codes.Unauthenticated, ErrCodeUnauthorized, "Authentication required.")
+	}
+
+	version, err := a.vacancyService.GetVacancyVersion(ctx, domain.GetVacancyVersionInput{
+		VacancyID:   req.GetVacancyId(),
+		Version:     req.GetVersion(),
+		OwnerUserID: userCtx.UserID,
+		IsAdmin:     userCtx.IsAdmin,
+	})
+	if err != nil {
+		switch {
+		case errors.Is(err, usecase.ErrInvalidArgument):
+			return nil, newError(codes.InvalidArgument, ErrCodeInvalidInput, "Invalid vacancy id or version.")
+		case errors.Is(err, usecase.ErrVersionNotFound):
+			return nil, newError(codes.NotFound, ErrCodeNotFound, "Vacancy version not found.")
+		default:
+			return nil, newError(codes.Internal, ErrCodeInternal, "Internal error.")
+		}
+	}
+
+	return &pb_models.VacancyVersionResponse{Version: toPBVacancyVersion(*version)}, nil
+}
@@ -1,21 +1,23 @@
 package grpc
 
 import (
+	"context"
+	"html"
+	"strconv"
+	"strings"
+	"time"
+
 	"github.com/artem13815/hr/vacancy/internal/domain"
 	pb_common "github.com/artem13815/hr/vacancy/internal/pb/common"
 	pb_models "github.com/artem13815/hr/vacancy/internal/pb/models"
+	"google.golang.org/grpc/metadata"
 	"google.golang.org/protobuf/types/known/timestamppb"
 )
 
 func toPBSkills(skills []domain.SkillWeight) []*pb_models.SkillWeight {
 	out := make([]*pb_models.SkillWeight, 0, len(skills))
 	for _, s := range skills {
-		out = append(out, &pb_models.SkillWeight{
-			Name:       s.Name,
-			Weight:     s.Weight,
-			MustHave:   s.MustHave,
-			NiceToHave: s.NiceToHave,
-		})
+		out = append(out, toPBSkill(s))
 	}
 	return out
 }
@@ -45,18 +47,223 @@ func toPBVacancy(v domain.Vacancy) *pb_models.Vacancy {
 		Version:     v.Version,
 		CreatedAt:   timestamppb.New(v.CreatedAt),
 		UpdatedAt:   timestamppb.New(v.UpdatedAt),
+		IsPublic:    v.IsPublic,
+		Attributes:  toPBAttributes(v.Attributes),
+		RoleSource:  pbRoleSources[v.RoleSource],
+		// Set by every classification, including the reconciler's.
+		RoleClassifiedAt: timestamppb.New(v.RoleClassifiedAt),
+		RoleLocked:       v.RoleLocked,
+		ScoringPolicy:    toPBScoringPolicy(v.ScoringPolicy),
+	}
+}
+
+func toPBVacancyVersion(v domain.VacancyVersion) *pb_models.VacancyVersion {
+	return &pb_models.VacancyVersion{
+		VacancyId:   v.VacancyID,
+		Version:     v.Version,
+		Title:       v.Title,
+		Description: v.Description,
+		Role:        v.Role,
+		Skills:      toPBSkills(v.Skills),
+		CreatedBy:   v.CreatedBy,
+		CreatedAt:   timestamppb.New(v.CreatedAt),
+	}
+}
+
+func toPBCollaborator(c domain.Collaborator) *pb_models.Collaborator {
+	return &pb_models.Collaborator{
+		VacancyId: c.VacancyID,
+		UserId:    c.UserID,
+		Role:      c.Role,
+		AddedBy:   c.AddedBy,
+		CreatedAt: timestamppb.New(c.CreatedAt),
+	}
+}
+
+func toPBSkill(s domain.SkillWeight) *pb_models.SkillWeight {
+	return &pb_models.SkillWeight{
+		Name:       s.Name,
+		Weight:     s.Weight,
+		MustHave:   s.MustHave,
+		NiceToHave: s.NiceToHave,
+	}
+}
+
+func toPBVersionDiff(d domain.VacancyVersionDiff) *pb_models.DiffVacancyVersionsResponse {
+	changed := make([]*pb_models.SkillChange, 0, len(d.ChangedSkills))
+	for _, c := range d.ChangedSkills {
+		changed = append(changed, &pb_models.SkillChange{
+			Name:   c.Name,
+			Before: toPBSkill(c.Before),
+			After:  toPBSkill(c.After),
+		})
+	}
+	return &pb_models.DiffVacancyVersionsResponse{
+		VacancyId:          d.VacancyID,
+		FromVersion:        d.FromVersion,
+		ToVersion:          d.ToVersion,
+		TitleChanged:       d.TitleChanged,
+		DescriptionChanged: d.DescriptionChanged,
+		RoleChanged:        d.RoleChanged,
+		AddedSkills:        toPBSkills(d.AddedSkills),
+		RemovedSkills:      toPBSkills(d.RemovedSkills),
+		ChangedSkills:      changed,
 	}
 }
 
+var pbStatuses = map[string]pb_models.VacancyStatus{
+	domain.StatusDraft:    pb_models.VacancyStatus_VACANCY_STATUS_DRAFT,
+	domain.StatusOpen:     pb_models.VacancyStatus_VACANCY_STATUS_OPEN,
+	domain.StatusPaused:   pb_models.VacancyStatus_VACANCY_STATUS_PAUSED,
+	domain.StatusClosed:   pb_models.VacancyStatus_VACANCY_STATUS_CLOSED,
+	domain.StatusFilled:   pb_models.VacancyStatus_VACANCY_STATUS_FILLED,
+	domain.StatusArchived: pb_models.VacancyStatus_VACANCY_STATUS_ARCHIVED,
+}
+
+// toPBStatus maps a stored status onto the enum; anything unknown (including
+// "" for the creation entry of the status history) is UNSPECIFIED.
 func toPBStatus(status string) pb_models.VacancyStatus {
-	switch status {
-	case domain.StatusArchived:
-		return pb_models.VacancyStatus_VACANCY_STATUS_ARCHIVED
-	default:
-		return pb_models.VacancyStatus_VACANCY_STATUS_ACTIVE
+	return pbStatuses[status]
+}
+
+// fromPBStatus is the inverse of toPBStatus. UNSPECIFIED and unknown values
+// map to "", which the usecase rejects as an invalid status.
+func fromPBStatus(status pb_models.VacancyStatus) string {
+	for s, pb := range pbStatuses {
+		if pb == status {
+			return s
+		}
+	}
+	return ""
+}
+
+func fromPBStatuses(statuses []pb_models.VacancyStatus) []string {
+	if len(statuses) == 0 {
+		return nil
+	}
+	out := make([]string, 0, len(statuses))
+	for _, s := range statuses {
+		out = append(out, fromPBStatus(s))
+	}
+	return out
+}
+
+func toPBStatusChange(c domain.VacancyStatusChange) *pb_models.VacancyStatusChange {
+	return &pb_models.VacancyStatusChange{
+		Id:         c.ID,
+		VacancyId:  c.VacancyID,
+		FromStatus: toPBStatus(c.FromStatus),
+		ToStatus:   toPBStatus(c.ToStatus),
+		Reason:     c.Reason,
+		ChangedBy:  c.ChangedBy,
+		ChangedAt:  timestamppb.New(c.ChangedAt),
+	}
+}
+
+// highlightMarkup turns storage's private-use highlight markers into <mark>
+// tags after escaping the text, so vacancy content can never inject markup
+// of its own.
+var highlightMarkup = strings.NewReplacer(domain.HighlightStart, "<mark>", domain.HighlightStop, "</mark>")
+
+func toPBHighlight(h domain.VacancyHighlight) *pb_models.VacancyHighlight {
+	return &pb_models.VacancyHighlight{
+		VacancyId: h.VacancyID,
+		Rank:      h.Rank,
+		Title:     highlightMarkup.Replace(html.EscapeString(h.Title)),
+		Snippet:   highlightMarkup.Replace(html.EscapeString(h.Snippet)),
+	}
+}
+
+// fromPBTime maps an unset timestamp to the zero time ("no bound").
+func fromPBTime(ts *timestamppb.Timestamp) time.Time {
+	if ts == nil {
+		return time.Time{}
+	}
+	return ts.AsTime()
+}
+
+var pbTotalModes = map[string]pb_models.TotalMode{
+	domain.TotalExact:     pb_models.TotalMode_TOTAL_MODE_EXACT,
+	domain.TotalEstimated: pb_models.TotalMode_TOTAL_MODE_ESTIMATED,
+	domain.TotalNone:      pb_models.TotalMode_TOTAL_MODE_NONE,
+}
+
+func toPBTotalMode(mode string) pb_models.TotalMode {
+	return pbTotalModes[mode]
+}
+
+// fromPBTotalMode maps UNSPECIFIED to "" so the usecase picks the default
+// for the pagination mode.
+func fromPBTotalMode(mode pb_models.TotalMode) string {
+	for m, pb := range pbTotalModes {
+		if pb == mode {
+			return m
+		}
+	}
+	return ""
+}
+
+var pbTemplateScopes = map[string]pb_models.TemplateScope{
+	domain.TemplateScopePersonal: pb_models.TemplateScope_TEMPLATE_SCOPE_PERSONAL,
+	domain.TemplateScopeOrg:      pb_models.TemplateScope_TEMPLATE_SCOPE_ORG,
+}
+
+// fromPBTemplateScope maps UNSPECIFIED to "", which means personal on
+// create and "personal plus org" on list.
+func fromPBTemplateScope(scope pb_models.TemplateScope) string {
+	for s, pb := range pbTemplateScopes {
+		if pb == scope {
+			return s
+		}
+	}
+	return ""
+}
+
+func toPBTemplate(t domain.VacancyTemplate) *pb_models.VacancyTemplate {
+	return &pb_models.VacancyTemplate{
+		Id:              t.ID,
+		OwnerUserId:     t.OwnerUserID,
+		Scope:           pbTemplateScopes[t.Scope],
+		Name:            t.Name,
+		Title:           t.Title,
+		Description:     t.Description,
+		Role:            t.Role,
+		Skills:          toPBSkills(t.Skills),
+		SourceVacancyId: t.SourceVacancyID,
+		CreatedAt:       timestamppb.New(t.CreatedAt),
 	}
 }
 
 func toPBPage(limit, offset uint32, total uint64) *pb_common.PageResponse {
 	return &pb_common.PageResponse{Limit: limit, Offset: offset, Total: total}
 }
+
+// expectedVersionFrom resolves the optimistic-concurrency precondition for
+// an update. An explicit expected_version in the body wins; otherwise we
+// fall back to the If-Match header the gateway forwards as metadata, so
+// plain HTTP clients can round-trip the ETag they got from GetVacancy.
+// Returns ok=false only for an If-Match value we cannot parse — "*" and a
+// missing header both mean "no precondition".
+func expectedVersionFrom(ctx context.Context, fromBody uint32) (uint32, bool) {
+	if fromBody != 0 {
+		return fromBody, true
+	}
+	md, ok := metadata.FromIncomingContext(ctx)
+	if !ok {
+		return 0, true
+	}
+	values := md.Get("if-match")
+	if len(values) == 0 {
+		return 0, true
+	}
+	tag := strings.TrimSpace(values[0])
+	if tag == "" || tag == "*" {
+		return 0, true
+	}
+	tag = strings.Trim(strings.TrimPrefix(tag, "W/"), `"`)
+	version, err := strconv.ParseUint(tag, 10, 32)
+	if err != nil || version == 0 {
+		return 0, false
+	}
+	return uint32(version), true
+}
@@ -0,0 +1,81 @@
+package grpc
+
+import (
+	"context"
+	"errors"
+	"strings"
+
+	"github.com/artem13815/hr/vacancy/internal/domain"
+	pb_models "github.com/artem13815/hr/vacancy/internal/pb/models"
+	"github.com/artem13815/hr/vacancy/internal/transport/middleware"
+	"github.com/artem13815/hr/vacancy/internal/usecase"
+	"google.golang.org/grpc/codes"
+)
+
+var importFormats = map[pb_models.ImportFormat]string{
+	pb_models.ImportFormat_IMPORT_FORMAT_CSV:   domain.ImportFormatCSV,
+	pb_models.ImportFormat_IMPORT_FORMAT_JSONL: domain.ImportFormatJSONLines,
+	pb_models.ImportFormat_IMPORT_FORMAT_HH:    domain.ImportFormatHH,
+}
+
+var importModes = map[pb_models.ImportMode]string{
+	pb_models.ImportMode_IMPORT_MODE_ALL_OR_NOTHING: domain.ImportModeAllOrNothing,
+	pb_models.ImportMode_IMPORT_MODE_BEST_EFFORT:    domain.ImportModeBestEffort,
+}
+
+func (a *VacancyServiceAPI) ImportVacancies(ctx context.Context, req *pb_models.ImportVacanciesRequest) (*pb_models.ImportVacanciesResponse, error) {
+	userCtx, ok := middleware.Get(ctx)
+	if !ok {
+		return nil, newError(codes.Unauthenticated, ErrCodeUnauthorized, "Authentication required.")
+	}
+	if req.GetFormat() == pb_models.ImportFormat_IMPORT_FORMAT_UNSPECIFIED {
+		return nil, newError(codes.InvalidArgument, ErrCodeInvalidInput, "Import format is required.")
+	}
+	if len(req.GetData()) > usecase.MaxImportBytes {
+		return nil, newError(codes.InvalidArgument, ErrCodeInvalidInput, "Import file exceeds 3 MiB.")
+	}
+
+	res, err := a.vacancyService.ImportVacancies(ctx, domain.ImportVacanciesInput{
+		OwnerUserID: userCtx.UserID,
+		Format:      importFormats[req.GetFormat()],
+		Data:        req.GetData(),
+		Mode:        importModes[req.GetMode()],
+		DryRun:      req.GetDryRun(),
+	})
+	if err != nil {
+		switch {
+		case errors.Is(err, usecase.ErrInvalidArgument):
+			// The reason names a whole-file problem ("csv header has no
+			// title column") the user can act on.
+			reason := strings.TrimPrefix(err.Error(), usecase.ErrInvalidArgument.Error())
+			return nil, newError(codes.InvalidArgument, ErrCodeInvalidInput, "Invalid import file"+reason+".")
+		case errors.Is(err, usecase.ErrUnauthorized):
+			return nil, newError(codes.Unauthenticated, ErrCodeUnauthorized, "Authentication required.")
+		default:
+			return nil, newError(codes.Internal, ErrCodeInternal, "Internal error.")
+		}
+	}
+
+	rows := make([]*pb_models.ImportRowResult, 0, len(res.Rows))
+	for _, r := range res.Rows {
+		row := &pb_models.ImportRowResult{
+			Row:    uint32(r.Row),
+			Title:  r.Title,
+			Status: toPBStatus(r.Status),
+			Role:   r.Role,
+			Skills: toPBSkills(r.Skills),
+			Error:  r.Error,
+		}
+		if r.Vacancy != nil {
+			row.Vacancy = toPBVacancy(*r.Vacancy)
+		}
+		rows = append(rows, row)
+	}
+
+	return &pb_models.ImportVacanciesResponse{
+		Rows:      rows,
+		Created:   uint32(res.Created),
+		Failed:    uint32(res.Failed),
+		Committed: res.Committed,
+	}, nil
+}
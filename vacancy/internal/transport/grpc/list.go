@@ -30,9 +30,25 @@ func (a *VacancyServiceAPI) ListVacancies(ctx context.Context, req *pb_models.Li
 		Limit:       limit,
 		Offset:      offset,
 		Query:       req.GetQuery(),
+		Statuses:    fromPBStatuses(req.GetStatuses()),
+		Roles:       req.GetRoles(),
+		Skills:      req.GetSkills(),
+		CreatedFrom: fromPBTime(req.GetCreatedFrom()),
+		CreatedTo:   fromPBTime(req.GetCreatedTo()),
+		PageToken:   req.GetPageToken(),
+		TotalMode:   fromPBTotalMode(req.GetTotalMode()),
+
+		Locations:       req.GetLocations(),
+		RemotePolicies:  fromPBEnums(pbRemotePolicies, req.GetRemotePolicies()),
+		EmploymentTypes: fromPBEnums(pbEmploymentTypes, req.GetEmploymentTypes()),
+		Seniorities:     fromPBEnums(pbSeniorities, req.GetSeniorities()),
+		SalaryAtLeast:   req.GetSalaryAtLeast(),
+		SalaryCurrency:  req.GetSalaryCurrency(),
 	})
 	if err != nil {
 		switch {
+		case errors.Is(err, usecase.ErrInvalidArgument):
+			return nil, newError(codes.InvalidArgument, ErrCodeInvalidInput, "Invalid search query, filters or page token.")
 		case errors.Is(err, usecase.ErrUnauthorized):
 			return nil, newError(codes.Unauthenticated, ErrCodeUnauthorized, "Authentication required.")
 		default:
@@ -45,8 +61,20 @@ func (a *VacancyServiceAPI) ListVacancies(ctx context.Context, req *pb_models.Li
 		out = append(out, toPBVacancy(v))
 	}
 
+	highlights := make([]*pb_models.VacancyHighlight, 0, len(res.Highlights))
+	for _, h := range res.Highlights {
+		highlights = append(highlights, toPBHighlight(h))
+	}
+
+	if req.GetPageToken() != "" {
+		offset = 0
+	}
+
 	return &pb_models.ListVacanciesResponse{
-		Vacancies: out,
-		Page:      toPBPage(limit, offset, res.Total),
+		Vacancies:     out,
+		Page:          toPBPage(limit, offset, res.Total),
+		Highlights:    highlights,
+		NextPageToken: res.NextPageToken,
+		TotalMode:     toPBTotalMode(res.TotalMode),
 	}, nil
 }
@@ -0,0 +1,17 @@
+package grpc
+
+import (
+	"context"
+
+	pb_models "github.com/artem13815/hr/vacancy/internal/pb/models"
+	"github.com/artem13815/hr/vacancy/internal/transport/middleware"
+	"google.golang.org/grpc/codes"
+)
+
+func (a *VacancyServiceAPI) ListVacancyRoles(ctx context.Context, _ *pb_models.ListVacancyRolesRequest) (*pb_models.ListVacancyRolesResponse, error) {
+	if _, ok := middleware.Get(ctx); !ok {
+		return nil, newError(codes.Unauthenticated, ErrCodeUnauthorized, "Authentication required.")
+	}
+
+	return &pb_models.ListVacancyRolesResponse{Roles: a.vacancyService.ListRoles(ctx)}, nil
+}
@@ -0,0 +1,54 @@
+package grpc
+
+import (
+	"cmp"
+	"context"
+	"errors"
+
+	"github.com/artem13815/hr/vacancy/internal/domain"
+	pb_models "github.com/artem13815/hr/vacancy/internal/pb/models"
+	"github.com/artem13815/hr/vacancy/internal/transport/middleware"
+	"github.com/artem13815/hr/vacancy/internal/usecase"
+	"google.golang.org/grpc/codes"
+)
+
+func (a *VacancyServiceAPI) ListTemplates(ctx context.Context, req *pb_models.ListTemplatesRequest) (*pb_models.ListTemplatesResponse, error) {
+	userCtx, ok := middleware.Get(ctx)
+	if !ok {
+		return nil, newError(codes.Unauthenticated, ErrCodeUnauthorized, "Authentication required.")
+	}
+
+	var limit, offset uint32 = 20, 0
+	if p := req.GetPage(); p != nil {
+		limit = cmp.Or(p.GetLimit(), limit)
+		offset = p.GetOffset()
+	}
+
+	res, err := a.vacancyService.ListTemplates(ctx, domain.ListTemplatesInput{
+		OwnerUserID: userCtx.UserID,
+		IsAdmin:     userCtx.IsAdmin,
+		Scope:       fromPBTemplateScope(req.GetScope()),
+		Limit:       limit,
+		Offset:      offset,
+	})
+	if err != nil {
+		switch {
+		case errors.Is(err, usecase.ErrUnauthorized):
+			return nil, newError(codes.Unauthenticated, ErrCodeUnauthorized, "Authentication required.")
+		case errors.Is(err, usecase.ErrInvalidArgument):
+			return nil, newError(codes.InvalidArgument, ErrCodeInvalidInput, "Invalid template scope.")
+		default:
+			return nil, newError(codes.Internal, ErrCodeInternal, "Internal error.")
+		}
+	}
+
+	out := make([]*pb_models.VacancyTemplate, 0, len(res.Templates))
+	for _, t := range res.Templates {
+		out = append(out, toPBTemplate(t))
+	}
+
+	return &pb_models.ListTemplatesResponse{
+		Templates: out,
+		Page:      toPBPage(limit, offset, res.Total),
+	}, nil
+}
@@ -0,0 +1,54 @@
+package grpc
+
+import (
+	"cmp"
+	"context"
+	"errors"
+
+	"github.com/artem13815/hr/vacancy/internal/domain"
+	pb_models "github.com/artem13815/hr/vacancy/internal/pb/models"
+	"github.com/artem13815/hr/vacancy/internal/transport/middleware"
+	"github.com/artem13815/hr/vacancy/internal/usecase"
+	"google.golang.org/grpc/codes"
+)
+
+func (a *VacancyServiceAPI) ListVacancyVersions(ctx context.Context, req *pb_models.ListVacancyVersionsRequest) (*pb_models.ListVacancyVersionsResponse, error) {
+	userCtx, ok := middleware.Get(ctx)
+	if !ok {
+		return nil, newError(codes.Unauthenticated, ErrCodeUnauthorized, "Authentication required.")
+	}
+
+	var limit, offset uint32 = 20, 0
+	if p := req.GetPage(); p != nil {
+		limit = cmp.Or(p.GetLimit(), limit)
+		offset = p.GetOffset()
+	}
+
+	res, err := a.vacancyService.ListVacancyVersions(ctx, domain.ListVacancyVersionsInput{
+		VacancyID:   req.GetVacancyId(),
+		OwnerUserID: userCtx.UserID,
+		IsAdmin:     userCtx.IsAdmin,
+		Limit:       limit,
+		Offset:      offset,
+	})
+	if err != nil {
+		switch {
+		case errors.Is(err, usecase.ErrInvalidArgument):
+			return nil, newError(codes.InvalidArgument, ErrCodeInvalidInput, "Invalid vacancy id.")
+		case errors.Is(err, usecase.ErrVacancyNotFound):
+			return nil, newError(codes.NotFound, ErrCodeNotFound, "Vacancy not found.")
+		default:
+			return nil, newError(codes.Internal, ErrCodeInternal, "Internal error.")
+		}
+	}
+
+	out := make([]*pb_models.VacancyVersion, 0, len(res.Versions))
+	for _, v := range res.Versions {
+		out = append(out, toPBVacancyVersion(v))
+	}
+
+	return &pb_models.ListVacancyVersionsResponse{
+		Versions: out,
+		Page:     toPBPage(limit, offset, res.Total),
+	}, nil
+}
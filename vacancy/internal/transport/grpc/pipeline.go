@@ -0,0 +1,150 @@
+package grpc
+
+import (
+	"context"
+	"errors"
+
+	"github.com/artem13815/hr/vacancy/internal/domain"
+	pb_models "github.com/artem13815/hr/vacancy/internal/pb/models"
+	"github.com/artem13815/hr/vacancy/internal/transport/middleware"
+	"github.com/artem13815/hr/vacancy/internal/usecase"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+func (a *VacancyServiceAPI) GetVacancyPipeline(ctx context.Context, req *pb_models.GetVacancyPipelineRequest) (*pb_models.VacancyPipelineResponse, error) {
+	userCtx, ok := middleware.Get(ctx)
+	if !ok {
+		return nil, newError(codes.Unauthenticated, ErrCodeUnauthorized, "Authentication required.")
+	}
+
+	pipeline, err := a.vacancyService.GetVacancyPipeline(ctx, domain.GetVacancyPipelineInput{
+		VacancyID:   req.GetVacancyId(),
+		OwnerUserID: userCtx.UserID,
+		IsAdmin:     userCtx.IsAdmin,
+	})
+	if err != nil {
+		return nil, pipelineError(err)
+	}
+
+	return &pb_models.VacancyPipelineResponse{Pipeline: toPBPipeline(*pipeline)}, nil
+}
+
+func (a *VacancyServiceAPI) SetVacancyPipelineStages(ctx context.Context, req *pb_models.SetVacancyPipelineStagesRequest) (*pb_models.VacancyPipelineResponse, error) {
+	userCtx, ok := middleware.Get(ctx)
+	if !ok {
+		return nil, newError(codes.Unauthenticated, ErrCodeUnauthorized, "Authentication required.")
+	}
+
+	stages := make([]domain.PipelineStage, 0, len(req.GetStages()))
+	for _, st := range req.GetStages() {
+		stages = append(stages, domain.PipelineStage{Key: st.GetKey(), Name: st.GetName()})
+	}
+
+	pipeline, err := a.vacancyService.SetPipelineStages(ctx, domain.SetPipelineStagesInput{
+		VacancyID:   req.GetVacancyId(),
+		OwnerUserID: userCtx.UserID,
+		IsAdmin:     userCtx.IsAdmin,
+		Stages:      stages,
+	})
+	if err != nil {
+		return nil, pipelineError(err)
+	}
+
+	return &pb_models.VacancyPipelineResponse{Pipeline: toPBPipeline(*pipeline)}, nil
+}
+
+func (a *VacancyServiceAPI) MoveCandidateStage(ctx context.Context, req *pb_models.MoveCandidateStageRequest) (*pb_models.MoveCandidateStageResponse, error) {
+	userCtx, ok := middleware.Get(ctx)
+	if !ok {
+		return nil, newError(codes.Unauthenticated, ErrCodeUnauthorized, "Authentication required.")
+	}
+
+	res, err := a.vacancyService.MoveCandidateStage(ctx, domain.MoveCandidateStageInput{
+		VacancyID:   req.GetVacancyId(),
+		CandidateID: req.GetCandidateId(),
+		OwnerUserID: userCtx.UserID,
+		IsAdmin:     userCtx.IsAdmin,
+		ToStage:     req.GetStage(),
+		Reason:      req.GetReason(),
+	})
+	if err != nil {
+		return nil, pipelineError(err)
+	}
+
+	return &pb_models.MoveCandidateStageResponse{
+		Change:        toPBStageChange(res.Change),
+		VacancyStatus: toPBStatus(res.VacancyStatus),
+		VacancyFilled: res.VacancyFilled,
+	}, nil
+}
+
+func (a *VacancyServiceAPI) ListCandidateStageHistory(ctx context.Context, req *pb_models.ListCandidateStageHistoryRequest) (*pb_models.ListCandidateStageHistoryResponse, error) {
+	userCtx, ok := middleware.Get(ctx)
+	if !ok {
+		return nil, newError(codes.Unauthenticated, ErrCodeUnauthorized, "Authentication required.")
+	}
+
+	changes, err := a.vacancyService.ListCandidateStageHistory(ctx, domain.ListCandidateStageHistoryInput{
+		VacancyID:   req.GetVacancyId(),
+		CandidateID: req.GetCandidateId(),
+		OwnerUserID: userCtx.UserID,
+		IsAdmin:     userCtx.IsAdmin,
+	})
+	if err != nil {
+		return nil, pipelineError(err)
+	}
+
+	out := make([]*pb_models.CandidateStageChange, 0, len(changes))
+	for _, c := range changes {
+		out = append(out, toPBStageChange(c))
+	}
+	return &pb_models.ListCandidateStageHistoryResponse{Changes: out}, nil
+}
+
+// pipelineError maps the errors shared by the pipeline handlers.
+func pipelineError(err error) error {
+	switch {
+	case errors.Is(err, usecase.ErrInvalidArgument):
+		return newError(codes.InvalidArgument, ErrCodeInvalidInput, "Invalid vacancy id, candidate id, stage or reason.")
+	case errors.Is(err, usecase.ErrVacancyNotFound):
+		return newError(codes.NotFound, ErrCodeNotFound, "Vacancy not found.")
+	case errors.Is(err, usecase.ErrForbidden):
+		return newError(codes.PermissionDenied, ErrCodeForbidden, "Viewers cannot change the pipeline.")
+	case errors.Is(err, usecase.ErrCandidateNotFound):
+		return newError(codes.NotFound, ErrCodeCandidateNotFound, "Candidate not found.")
+	case errors.Is(err, usecase.ErrInvalidStageTransition):
+		return newError(codes.FailedPrecondition, ErrCodeInvalidStageTransition, "Stage move is not allowed.")
+	case errors.Is(err, usecase.ErrStageInUse):
+		return newError(codes.FailedPrecondition, ErrCodeStageInUse, "Candidates are still in a removed stage.")
+	default:
+		return newError(codes.Internal, ErrCodeInternal, "Internal error.")
+	}
+}
+
+func toPBPipeline(p domain.VacancyPipeline) *pb_models.VacancyPipeline {
+	stages := make([]*pb_models.PipelineStage, 0, len(p.Stages))
+	for _, st := range p.Stages {
+		stages = append(stages, &pb_models.PipelineStage{Key: st.Key, Name: st.Name})
+	}
+	return &pb_models.VacancyPipeline{
+		VacancyId:       p.VacancyID,
+		Stages:          stages,
+		Headcount:       p.Headcount,
+		Hired:           p.Hired,
+		CandidateCounts: p.Counts,
+	}
+}
+
+func toPBStageChange(c domain.CandidateStageChange) *pb_models.CandidateStageChange {
+	return &pb_models.CandidateStageChange{
+		Id:          c.ID,
+		VacancyId:   c.VacancyID,
+		CandidateId: c.CandidateID,
+		FromStage:   c.FromStage,
+		ToStage:     c.ToStage,
+		Reason:      c.Reason,
+		ChangedBy:   c.ChangedBy,
+		ChangedAt:   timestamppb.New(c.ChangedAt),
+	}
+}
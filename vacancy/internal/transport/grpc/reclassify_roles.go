@@ -0,0 +1,49 @@
+package grpc
+
+import (
+	"context"
+	"errors"
+
+	"github.com/artem13815/hr/vacancy/internal/domain"
+	pb_models "github.com/artem13815/hr/vacancy/internal/pb/models"
+	"github.com/artem13815/hr/vacancy/internal/transport/middleware"
+	"github.com/artem13815/hr/vacancy/internal/usecase"
+	"google.golang.org/grpc/codes"
+)
+
+var pbRoleSources = map[string]pb_models.RoleSource{
+	domain.RoleSourceLLM:     pb_models.RoleSource_ROLE_SOURCE_LLM,
+	domain.RoleSourceKeyword: pb_models.RoleSource_ROLE_SOURCE_KEYWORD,
+	domain.RoleSourceManual:  pb_models.RoleSource_ROLE_SOURCE_MANUAL,
+}
+
+func (a *VacancyServiceAPI) ReclassifyVacancyRoles(ctx context.Context, req *pb_models.ReclassifyVacancyRolesRequest) (*pb_models.ReclassifyVacancyRolesResponse, error) {
+	userCtx, ok := middleware.Get(ctx)
+	if !ok {
+		return nil, newError(codes.Unauthenticated, ErrCodeUnauthorized, "Authentication required.")
+	}
+
+	res, err := a.vacancyService.ReclassifyRoles(ctx, domain.ReclassifyRolesInput{
+		IsAdmin: userCtx.IsAdmin,
+		Sources: fromPBEnums(pbRoleSources, req.GetSources()),
+		Limit:   req.GetLimit(),
+	})
+	if err != nil {
+		switch {
+		case errors.Is(err, usecase.ErrForbidden):
+			return nil, newError(codes.PermissionDenied, ErrCodeForbidden, "Only admins can reclassify vacancy roles.")
+		case errors.Is(err, usecase.ErrInvalidArgument):
+			return nil, newError(codes.InvalidArgument, ErrCodeInvalidInput, "Sources must be LLM or KEYWORD; limit must not exceed 500.")
+		default:
+			return nil, newError(codes.Internal, ErrCodeInternal, "Internal error.")
+		}
+	}
+
+	return &pb_models.ReclassifyVacancyRolesResponse{
+		Scanned:        res.Scanned,
+		Reclassified:   res.Reclassified,
+		Changed:        res.Changed,
+		Skipped:        res.Skipped,
+		LlmUnavailable: res.LLMUnavailable,
+	}, nil
+}
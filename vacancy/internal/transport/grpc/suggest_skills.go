@@ -0,0 +1,40 @@
+package grpc
+
+import (
+	"context"
+	"errors"
+
+	"github.com/artem13815/hr/vacancy/internal/domain"
+	pb_models "github.com/artem13815/hr/vacancy/internal/pb/models"
+	"github.com/artem13815/hr/vacancy/internal/transport/middleware"
+	"github.com/artem13815/hr/vacancy/internal/usecase"
+	"google.golang.org/grpc/codes"
+)
+
+func (a *VacancyServiceAPI) SuggestSkills(ctx context.Context, req *pb_models.SuggestSkillsRequest) (*pb_models.SuggestSkillsResponse, error) {
+	userCtx, ok := middleware.Get(ctx)
+	if !ok {
+		return nil, newError(codes.Unauthenticated, ErrCodeUnauthorized, "Authentication required.")
+	}
+
+	res, err := a.vacancyService.SuggestSkills(ctx, domain.SuggestSkillsInput{
+		OwnerUserID: userCtx.UserID,
+		Title:       req.GetTitle(),
+		Description: req.GetDescription(),
+	})
+	if err != nil {
+		switch {
+		case errors.Is(err, usecase.ErrUnauthorized):
+			return nil, newError(codes.Unauthenticated, ErrCodeUnauthorized, "Authentication required.")
+		case errors.Is(err, usecase.ErrInvalidArgument):
+			return nil, newError(codes.InvalidArgument, ErrCodeInvalidInput, "Title or description is required; title up to 255 and description up to 4000 characters.")
+		default:
+			return nil, newError(codes.Internal, ErrCodeInternal, "Internal error.")
+		}
+	}
+
+	return &pb_models.SuggestSkillsResponse{
+		Skills: toPBSkills(res.Skills),
+		Source: res.Source,
+	}, nil
+}
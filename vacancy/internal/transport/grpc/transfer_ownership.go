@@ -0,0 +1,39 @@
+package grpc
+
+import (
+	"context"
+	"errors"
+
+	"github.com/artem13815/hr/vacancy/internal/domain"
+	pb_models "github.com/artem13815/hr/vacancy/internal/pb/models"
+	"github.com/artem13815/hr/vacancy/internal/transport/middleware"
+	"github.com/artem13815/hr/vacancy/internal/usecase"
+	"google.golang.org/grpc/codes"
+)
+
+func (a *VacancyServiceAPI) TransferVacancyOwnership(ctx context.Context, req *pb_models.TransferVacancyOwnershipRequest) (*pb_models.TransferVacancyOwnershipResponse, error) {
+	userCtx, ok := middleware.Get(ctx)
+	if !ok {
+		return nil, newError(codes.Unauthenticated, ErrCodeUnauthorized, "Authentication required.")
+	}
+
+	moved, err := a.vacancyService.TransferVacancyOwnership(ctx, domain.TransferVacancyOwnershipInput{
+		IsAdmin:     userCtx.IsAdmin,
+		ActorUserID: userCtx.UserID,
+		FromUserID:  req.GetFromUserId(),
+		ToUserID:    req.GetToUserId(),
+		Limit:       req.GetLimit(),
+	})
+	if err != nil {
+		switch {
+		case errors.Is(err, usecase.ErrForbidden):
+			return nil, newError(codes.PermissionDenied, ErrCodeForbidden, "Only admins can transfer vacancy ownership.")
+		case errors.Is(err, usecase.ErrInvalidArgument):
+			return nil, newError(codes.InvalidArgument, ErrCodeInvalidInput, "Two different user ids are required; limit must not exceed 1000.")
+		default:
+			return nil, newError(codes.Internal, ErrCodeInternal, "Internal error.")
+		}
+	}
+
+	return &pb_models.TransferVacancyOwnershipResponse{Transferred: moved}, nil
+}
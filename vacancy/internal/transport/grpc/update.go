@@ -3,6 +3,7 @@ package grpc
 import (
 	"context"
 	"errors"
+	"strconv"
 
 	"github.com/artem13815/hr/vacancy/internal/domain"
 	pb_models "github.com/artem13815/hr/vacancy/internal/pb/models"
@@ -17,21 +18,37 @@ func (a *VacancyServiceAPI) UpdateVacancy(ctx context.Context, req *pb_models.Up
 		return nil, newError(codes.Unauthenticated, ErrCodeUnauthorized, "Authentication required.")
 	}
 
+	expectedVersion, ok := expectedVersionFrom(ctx, req.GetExpectedVersion())
+	if !ok {
+		return nil, newError(codes.InvalidArgument, ErrCodeInvalidInput, "Invalid If-Match header.")
+	}
+
 	updated, err := a.vacancyService.UpdateVacancy(ctx, domain.UpdateVacancyInput{
-		VacancyID:   req.GetVacancyId(),
-		OwnerUserID: userCtx.UserID,
-		IsAdmin:     userCtx.IsAdmin,
-		Title:       req.GetTitle(),
-		Description: req.GetDescription(),
-		Role:        req.GetRole(),
-		Skills:      fromPBSkills(req.GetSkills()),
+		VacancyID:       req.GetVacancyId(),
+		OwnerUserID:     userCtx.UserID,
+		IsAdmin:         userCtx.IsAdmin,
+		ExpectedVersion: expectedVersion,
+		Title:           req.GetTitle(),
+		Description:     req.GetDescription(),
+		Role:            req.GetRole(),
+		Skills:          fromPBSkills(req.GetSkills()),
+		Attributes:      fromPBAttributesPatch(req.GetAttributes()),
+		UnlockRole:      req.GetUnlockRole(),
+		ScoringPolicy:   fromPBScoringPolicy(req.GetScoringPolicy()),
 	})
 	if err != nil {
+		var conflict *domain.VersionConflictError
 		switch {
 		case errors.Is(err, usecase.ErrInvalidArgument):
 			return nil, newError(codes.InvalidArgument, ErrCodeInvalidInput, "Invalid vacancy payload.")
 		case errors.Is(err, usecase.ErrVacancyNotFound):
 			return nil, newError(codes.NotFound, ErrCodeNotFound, "Vacancy not found.")
+		case errors.Is(err, usecase.ErrForbidden):
+			return nil, newError(codes.PermissionDenied, ErrCodeForbidden, "Viewers cannot edit the vacancy.")
+		case errors.As(err, &conflict):
+			return nil, newErrorWithMetadata(codes.Aborted, ErrCodeVersionConflict,
+				"Vacancy was modified by someone else; reload and retry.",
+				map[string]string{"current_version": strconv.FormatUint(uint64(conflict.CurrentVersion), 10)})
 		default:
 			return nil, newError(codes.Internal, ErrCodeInternal, "Internal error.")
 		}
@@ -9,19 +9,48 @@ import (
 
 type vacancyService interface {
 	CreateVacancy(ctx context.Context, in domain.CreateVacancyInput) (*domain.Vacancy, error)
+	ImportVacancies(ctx context.Context, in domain.ImportVacanciesInput) (*domain.ImportVacanciesResult, error)
 	GetVacancy(ctx context.Context, in domain.GetVacancyInput) (*domain.Vacancy, error)
 	ListVacancies(ctx context.Context, in domain.ListVacanciesInput) (*domain.ListVacanciesResult, error)
 	UpdateVacancy(ctx context.Context, in domain.UpdateVacancyInput) (*domain.Vacancy, error)
 	ArchiveVacancy(ctx context.Context, in domain.ArchiveVacancyInput) error
+	DeleteVacancy(ctx context.Context, in domain.DeleteVacancyInput) error
+	TransferVacancyOwnership(ctx context.Context, in domain.TransferVacancyOwnershipInput) (uint32, error)
+	ListVacancyVersions(ctx context.Context, in domain.ListVacancyVersionsInput) (*domain.ListVacancyVersionsResult, error)
+	GetVacancyVersion(ctx context.Context, in domain.GetVacancyVersionInput) (*domain.VacancyVersion, error)
+	DiffVacancyVersions(ctx context.Context, in domain.DiffVacancyVersionsInput) (*domain.VacancyVersionDiff, error)
+	TransitionVacancy(ctx context.Context, in domain.TransitionVacancyInput) (*domain.Vacancy, error)
+	RestoreVacancy(ctx context.Context, in domain.RestoreVacancyInput) (*domain.Vacancy, error)
+	ListVacancyStatusHistory(ctx context.Context, in domain.ListVacancyStatusHistoryInput) (*domain.ListVacancyStatusHistoryResult, error)
+	CloneVacancy(ctx context.Context, in domain.CloneVacancyInput) (*domain.Vacancy, error)
+	CreateTemplate(ctx context.Context, in domain.CreateTemplateInput) (*domain.VacancyTemplate, error)
+	ListTemplates(ctx context.Context, in domain.ListTemplatesInput) (*domain.ListTemplatesResult, error)
+	CreateVacancyFromTemplate(ctx context.Context, in domain.CreateVacancyFromTemplateInput) (*domain.Vacancy, error)
+	DeleteTemplate(ctx context.Context, in domain.DeleteTemplateInput) error
+	AutocompleteSkills(ctx context.Context, in domain.AutocompleteSkillsInput) ([]domain.SkillSuggestion, error)
+	SuggestSkills(ctx context.Context, in domain.SuggestSkillsInput) (*domain.SuggestSkillsResult, error)
+	SetVacancyVisibility(ctx context.Context, in domain.SetVacancyVisibilityInput) (*domain.Vacancy, error)
+	GetPublicVacancy(ctx context.Context, vacancyID string) (*domain.Vacancy, error)
+	ListPublicVacancies(ctx context.Context, ownerUserID uint64) ([]domain.Vacancy, error)
+	AddCollaborator(ctx context.Context, in domain.AddCollaboratorInput) (*domain.Collaborator, error)
+	RemoveCollaborator(ctx context.Context, in domain.RemoveCollaboratorInput) error
+	ListCollaborators(ctx context.Context, in domain.ListCollaboratorsInput) ([]domain.Collaborator, error)
+	ReclassifyRoles(ctx context.Context, in domain.ReclassifyRolesInput) (*domain.ReclassifyRolesResult, error)
+	ListRoles(ctx context.Context) []string
+	GetVacancyPipeline(ctx context.Context, in domain.GetVacancyPipelineInput) (*domain.VacancyPipeline, error)
+	SetPipelineStages(ctx context.Context, in domain.SetPipelineStagesInput) (*domain.VacancyPipeline, error)
+	MoveCandidateStage(ctx context.Context, in domain.MoveCandidateStageInput) (*domain.MoveCandidateStageResult, error)
+	ListCandidateStageHistory(ctx context.Context, in domain.ListCandidateStageHistoryInput) ([]domain.CandidateStageChange, error)
 }
 
 type VacancyServiceAPI struct {
 	vacancy_api.UnimplementedVacancyServiceServer
 	vacancyService vacancyService
+	feed           FeedOptions
 }
 
-func NewVacancyServiceAPI(vacancyService vacancyService) *VacancyServiceAPI {
-	return &VacancyServiceAPI{vacancyService: vacancyService}
+func NewVacancyServiceAPI(vacancyService vacancyService, feed FeedOptions) *VacancyServiceAPI {
+	return &VacancyServiceAPI{vacancyService: vacancyService, feed: feed}
 }
 
 var _ vacancy_api.VacancyServiceServer = (*VacancyServiceAPI)(nil)
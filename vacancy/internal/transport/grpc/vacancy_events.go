@@ -0,0 +1,90 @@
+package grpc
+
+import (
+	"context"
+	"log/slog"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"github.com/artem13815/hr/vacancy/internal/domain"
+	pb_events "github.com/artem13815/hr/vacancy/internal/pb/vacancy_events_api"
+)
+
+type eventRelay interface {
+	StreamEvents(ctx context.Context, afterSeq uint64, send func(domain.VacancyEvent) error) error
+}
+
+// VacancyEventsAPI serves VacancyEventService. It is a separate server type
+// from VacancyServiceAPI: the service is internal, has no gateway binding
+// and needs none of the vacancy CRUD dependencies.
+type VacancyEventsAPI struct {
+	pb_events.UnimplementedVacancyEventServiceServer
+	relay eventRelay
+
+	// closing is cancelled by Close and ends every open subscription.
+	closing context.Context
+	close   context.CancelFunc
+}
+
+func NewVacancyEventsAPI(relay eventRelay) *VacancyEventsAPI {
+	closing, closeFn := context.WithCancel(context.Background())
+	return &VacancyEventsAPI{relay: relay, closing: closing, close: closeFn}
+}
+
+// Close ends every open subscription with UNAVAILABLE. Subscriptions never
+// finish on their own, so AppRun calls this before GracefulStop, which would
+// otherwise wait for them until its timeout.
+func (a *VacancyEventsAPI) Close() {
+	a.close()
+}
+
+var _ pb_events.VacancyEventServiceServer = (*VacancyEventsAPI)(nil)
+
+var pbEventTypes = map[string]pb_events.VacancyEventType{
+	domain.EventVacancyCreated:       pb_events.VacancyEventType_VACANCY_EVENT_TYPE_CREATED,
+	domain.EventVacancyUpdated:       pb_events.VacancyEventType_VACANCY_EVENT_TYPE_UPDATED,
+	domain.EventVacancyStatusChanged: pb_events.VacancyEventType_VACANCY_EVENT_TYPE_STATUS_CHANGED,
+	domain.EventVacancyDeleted:       pb_events.VacancyEventType_VACANCY_EVENT_TYPE_DELETED,
+	domain.EventVacancyRestored:      pb_events.VacancyEventType_VACANCY_EVENT_TYPE_RESTORED,
+	domain.EventVacancyPurged:        pb_events.VacancyEventType_VACANCY_EVENT_TYPE_PURGED,
+}
+
+// SubscribeVacancyEvents runs until the subscriber disconnects or the
+// server shuts down. The subscriber answers any end of the stream by
+// resubscribing from its last processed seq.
+func (a *VacancyEventsAPI) SubscribeVacancyEvents(req *pb_events.SubscribeVacancyEventsRequest, stream pb_events.VacancyEventService_SubscribeVacancyEventsServer) error {
+	ctx, cancel := context.WithCancel(stream.Context())
+	defer cancel()
+	stop := context.AfterFunc(a.closing, cancel)
+	defer stop()
+
+	err := a.relay.StreamEvents(ctx, req.GetAfterSeq(), func(ev domain.VacancyEvent) error {
+		return stream.Send(toPBEvent(ev))
+	})
+	if a.closing.Err() != nil {
+		return status.Error(codes.Unavailable, "Server is shutting down.")
+	}
+	if ctx.Err() != nil {
+		return status.FromContextError(ctx.Err()).Err()
+	}
+	slog.ErrorContext(ctx, "vacancy event stream failed", "after_seq", req.GetAfterSeq(), "err", err)
+	return newError(codes.Internal, ErrCodeInternal, "Internal error.")
+}
+
+func toPBEvent(ev domain.VacancyEvent) *pb_events.VacancyEvent {
+	return &pb_events.VacancyEvent{
+		Id:          ev.ID,
+		Seq:         ev.Seq,
+		Type:        pbEventTypes[ev.Type],
+		VacancyId:   ev.VacancyID,
+		OwnerUserId: ev.OwnerUserID,
+		ActorUserId: ev.ActorUserID,
+		OccurredAt:  timestamppb.New(ev.OccurredAt),
+		Version:     ev.Version,
+		OldVersion:  ev.OldVersion,
+		Status:      ev.Status,
+		OldStatus:   ev.OldStatus,
+	}
+}
@@ -11,6 +11,8 @@ import (
 	"google.golang.org/grpc/status"
 
 	"github.com/artem13815/hr/vacancy/internal/pb/auth_api"
+	"github.com/artem13815/hr/vacancy/internal/pb/vacancy_api"
+	"github.com/artem13815/hr/vacancy/internal/pb/vacancy_events_api"
 )
 
 // authValidator is the narrow surface of auth_api.AuthServiceClient that the
@@ -20,13 +22,31 @@ type authValidator interface {
 	ValidateAccessToken(ctx context.Context, in *auth_api.ValidateAccessTokenRequest, opts ...grpc.CallOption) (*auth_api.ValidateAccessTokenResponse, error)
 }
 
+// publicMethods are served without a bearer: the published vacancy feed
+// for career sites and job boards. Their handlers never call Get and only
+// ever read vacancies marked public.
+var publicMethods = map[string]struct{}{
+	vacancy_api.VacancyService_GetJobPosting_FullMethodName:  {},
+	vacancy_api.VacancyService_GetVacancyFeed_FullMethodName: {},
+}
+
+// internalMethods are called service-to-service without a user bearer and
+// have no HTTP binding, so the gateway never exposes them. They carry ids
+// and statuses only, never vacancy content.
+var internalMethods = map[string]struct{}{
+	vacancy_events_api.VacancyEventService_SubscribeVacancyEvents_FullMethodName: {},
+}
+
 // UnaryAuthInterceptor validates the JWT on every RPC by calling the auth
 // service. The resulting identity is attached to the context — handlers MUST
 // read it via Get, never from the raw gRPC metadata, otherwise an attacker
 // on the docker network could impersonate any user just by setting
-// x-user-id.
+// x-user-id. publicMethods pass through unauthenticated.
 func UnaryAuthInterceptor(authClient authValidator) grpc.UnaryServerInterceptor {
 	return func(ctx context.Context, req any, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (any, error) {
+		if _, ok := publicMethods[info.FullMethod]; ok {
+			return handler(ctx, req)
+		}
 		uc, err := authenticate(ctx, authClient)
 		if err != nil {
 			return nil, err
@@ -37,9 +57,12 @@ func UnaryAuthInterceptor(authClient authValidator) grpc.UnaryServerInterceptor
 
 // StreamAuthInterceptor mirrors UnaryAuthInterceptor for streaming RPCs. We
 // wrap the ServerStream so handlers see the authenticated context via
-// stream.Context().
+// stream.Context(). internalMethods pass through unauthenticated.
 func StreamAuthInterceptor(authClient authValidator) grpc.StreamServerInterceptor {
 	return func(srv any, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+		if _, ok := internalMethods[info.FullMethod]; ok {
+			return handler(srv, ss)
+		}
 		uc, err := authenticate(ss.Context(), authClient)
 		if err != nil {
 			return err
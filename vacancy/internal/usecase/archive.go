@@ -6,9 +6,28 @@ import (
 	"github.com/artem13815/hr/vacancy/internal/domain"
 )
 
+// ArchiveVacancy is the status transition to archived, kept as its own RPC
+// for existing clients. Archiving an already archived vacancy is a no-op,
+// as it was before the lifecycle state machine.
 func (s *VacancyService) ArchiveVacancy(ctx context.Context, in domain.ArchiveVacancyInput) error {
 	if in.OwnerUserID == 0 || in.VacancyID == "" {
 		return ErrInvalidArgument
 	}
-	return s.storage.ArchiveVacancy(ctx, in)
+
+	vacancy, err := s.editableVacancy(ctx, in.VacancyID, in.OwnerUserID, in.IsAdmin)
+	if err != nil {
+		return err
+	}
+	if vacancy.Status == domain.StatusArchived {
+		return nil
+	}
+
+	_, err = s.changeStatus(ctx, domain.ChangeVacancyStatusInput{
+		VacancyID:   in.VacancyID,
+		OwnerUserID: in.OwnerUserID,
+		IsAdmin:     in.IsAdmin,
+		FromStatus:  vacancy.Status,
+		ToStatus:    domain.StatusArchived,
+	})
+	return err
 }
@@ -17,7 +17,13 @@ func (s *ArchiveVacancySuite) TestSuccess() {
 	ctx := t.Context()
 	in := domain.ArchiveVacancyInput{VacancyID: "v-1", OwnerUserID: 7}
 
-	s.storage.ArchiveVacancyMock.Expect(ctx, in).Return(nil)
+	s.storage.GetVacancyMock.Expect(ctx, "v-1", uint64(7), false).Return(&domain.Vacancy{ID: "v-1", OwnerUserID: 7, Status: domain.StatusPaused}, nil)
+	s.storage.ChangeVacancyStatusMock.Expect(ctx, domain.ChangeVacancyStatusInput{
+		VacancyID:   "v-1",
+		OwnerUserID: 7,
+		FromStatus:  domain.StatusPaused,
+		ToStatus:    domain.StatusArchived,
+	}).Return(&domain.Vacancy{ID: "v-1", OwnerUserID: 7, Status: domain.StatusArchived}, nil)
 
 	err := s.svc.ArchiveVacancy(ctx, in)
 	assert.NilError(t, err)
@@ -28,12 +34,41 @@ func (s *ArchiveVacancySuite) TestSuccessAdmin() {
 	ctx := t.Context()
 	in := domain.ArchiveVacancyInput{VacancyID: "v-1", OwnerUserID: 7, IsAdmin: true}
 
-	s.storage.ArchiveVacancyMock.Expect(ctx, in).Return(nil)
+	s.storage.GetVacancyMock.Expect(ctx, "v-1", uint64(7), true).Return(&domain.Vacancy{ID: "v-1", OwnerUserID: 7, Status: domain.StatusOpen}, nil)
+	s.storage.ChangeVacancyStatusMock.Expect(ctx, domain.ChangeVacancyStatusInput{
+		VacancyID:   "v-1",
+		OwnerUserID: 7,
+		IsAdmin:     true,
+		FromStatus:  domain.StatusOpen,
+		ToStatus:    domain.StatusArchived,
+	}).Return(&domain.Vacancy{ID: "v-1", OwnerUserID: 7, Status: domain.StatusArchived}, nil)
 
 	err := s.svc.ArchiveVacancy(ctx, in)
 	assert.NilError(t, err)
 }
 
+// TestAlreadyArchivedIsNoop keeps the pre-state-machine contract: archiving
+// twice succeeds and does not write a second history entry.
+func (s *ArchiveVacancySuite) TestAlreadyArchivedIsNoop() {
+	t := s.T()
+	ctx := t.Context()
+
+	s.storage.GetVacancyMock.Expect(ctx, "v-1", uint64(7), false).Return(&domain.Vacancy{ID: "v-1", OwnerUserID: 7, Status: domain.StatusArchived}, nil)
+
+	err := s.svc.ArchiveVacancy(ctx, domain.ArchiveVacancyInput{VacancyID: "v-1", OwnerUserID: 7})
+	assert.NilError(t, err)
+}
+
+func (s *ArchiveVacancySuite) TestNotFound() {
+	t := s.T()
+	ctx := t.Context()
+
+	s.storage.GetVacancyMock.Expect(ctx, "v-1", uint64(7), false).Return(nil, nil)
+
+	err := s.svc.ArchiveVacancy(ctx, domain.ArchiveVacancyInput{VacancyID: "v-1", OwnerUserID: 7})
+	assert.ErrorIs(t, err, ErrVacancyNotFound)
+}
+
 func (s *ArchiveVacancySuite) TestInvalidArgumentZeroOwner() {
 	t := s.T()
 	err := s.svc.ArchiveVacancy(t.Context(), domain.ArchiveVacancyInput{VacancyID: "v-1"})
@@ -49,13 +84,23 @@ func (s *ArchiveVacancySuite) TestInvalidArgumentEmptyID() {
 func (s *ArchiveVacancySuite) TestStorageError() {
 	t := s.T()
 	ctx := t.Context()
-	in := domain.ArchiveVacancyInput{VacancyID: "v-1", OwnerUserID: 1}
 	storageErr := errors.New("pgx: connection refused")
 
-	s.storage.ArchiveVacancyMock.Expect(ctx, in).Return(storageErr)
+	s.storage.GetVacancyMock.Expect(ctx, "v-1", uint64(1), false).Return(&domain.Vacancy{ID: "v-1", OwnerUserID: 1, Status: domain.StatusOpen}, nil)
+	s.storage.ChangeVacancyStatusMock.Return(nil, storageErr)
 
-	err := s.svc.ArchiveVacancy(ctx, in)
+	err := s.svc.ArchiveVacancy(ctx, domain.ArchiveVacancyInput{VacancyID: "v-1", OwnerUserID: 1})
 	assert.ErrorIs(t, err, storageErr)
 }
 
+func (s *ArchiveVacancySuite) TestViewerForbidden() {
+	t := s.T()
+	ctx := t.Context()
+	s.storage.GetVacancyMock.Return(&domain.Vacancy{ID: "v-1", OwnerUserID: 1, Status: domain.StatusOpen}, nil)
+	s.storage.CanEditVacancyMock.Expect(ctx, "v-1", uint64(8)).Return(false, nil)
+
+	err := s.svc.ArchiveVacancy(ctx, domain.ArchiveVacancyInput{VacancyID: "v-1", OwnerUserID: 8})
+	assert.ErrorIs(t, err, ErrForbidden)
+}
+
 func TestArchiveVacancySuite(t *testing.T) { suite.Run(t, new(ArchiveVacancySuite)) }
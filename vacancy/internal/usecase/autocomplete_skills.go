@@ -0,0 +1,27 @@
+package usecase
+
+import (
+	"cmp"
+	"context"
+	"strings"
+	"unicode/utf8"
+
+	"github.com/artem13815/hr/vacancy/internal/domain"
+)
+
+// AutocompleteSkills backs the skill picker of the vacancy editor: prefix
+// matches on any spelling first, then substring matches. Served from memory,
+// so it is cheap enough to call on every keystroke.
+func (s *VacancyService) AutocompleteSkills(_ context.Context, in domain.AutocompleteSkillsInput) ([]domain.SkillSuggestion, error) {
+	in.Query = strings.TrimSpace(in.Query)
+	if in.Query == "" || utf8.RuneCountInString(in.Query) > maxSkillQueryRunes {
+		return nil, ErrInvalidArgument
+	}
+	limit := min(cmp.Or(in.Limit, 10), 50)
+
+	out := s.taxonomy.Autocomplete(in.Query, int(limit))
+	if out == nil {
+		out = []domain.SkillSuggestion{}
+	}
+	return out, nil
+}
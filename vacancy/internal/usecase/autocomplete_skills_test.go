@@ -0,0 +1,71 @@
+package usecase
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+	"gotest.tools/v3/assert"
+
+	"github.com/artem13815/hr/vacancy/internal/domain"
+)
+
+type AutocompleteSkillsSuite struct{ baseSuite }
+
+func (s *AutocompleteSkillsSuite) TestPrefixMatchesAnySpelling() {
+	t := s.T()
+
+	got, err := s.svc.AutocompleteSkills(t.Context(), domain.AutocompleteSkillsInput{Query: "K8"})
+	assert.NilError(t, err)
+	assert.DeepEqual(t, got, []domain.SkillSuggestion{
+		{Name: "Kubernetes", Category: "devops", Matched: "k8s"},
+	})
+}
+
+// TestPrefixBeforeSubstring: "post" is a prefix of PostgreSQL spellings and
+// appears nowhere else; "гл" is only inside "английский", so it comes from
+// the substring pass and still resolves to the canonical entry.
+func (s *AutocompleteSkillsSuite) TestPrefixBeforeSubstring() {
+	t := s.T()
+
+	got, err := s.svc.AutocompleteSkills(t.Context(), domain.AutocompleteSkillsInput{Query: "post"})
+	assert.NilError(t, err)
+	assert.DeepEqual(t, got, []domain.SkillSuggestion{
+		{Name: "PostgreSQL", Category: "database", Matched: "postgres"},
+	})
+
+	got, err = s.svc.AutocompleteSkills(t.Context(), domain.AutocompleteSkillsInput{Query: "гл"})
+	assert.NilError(t, err)
+	assert.DeepEqual(t, got, []domain.SkillSuggestion{
+		{Name: "English", NameRU: "Английский язык", Category: "spoken_language", Matched: "английский"},
+	})
+}
+
+func (s *AutocompleteSkillsSuite) TestLimit() {
+	t := s.T()
+
+	// "е" occurs in Kubernetes, PostgreSQL's "постгрес" and English.
+	got, err := s.svc.AutocompleteSkills(t.Context(), domain.AutocompleteSkillsInput{Query: "е", Limit: 2})
+	assert.NilError(t, err)
+	assert.Equal(t, len(got), 2)
+}
+
+func (s *AutocompleteSkillsSuite) TestNoMatchIsEmpty() {
+	t := s.T()
+
+	got, err := s.svc.AutocompleteSkills(t.Context(), domain.AutocompleteSkillsInput{Query: "cobol"})
+	assert.NilError(t, err)
+	assert.Assert(t, got != nil)
+	assert.Equal(t, len(got), 0)
+}
+
+func (s *AutocompleteSkillsSuite) TestInvalidArgument() {
+	t := s.T()
+	for _, q := range []string{"", "   ", strings.Repeat("x", maxSkillQueryRunes+1)} {
+		got, err := s.svc.AutocompleteSkills(t.Context(), domain.AutocompleteSkillsInput{Query: q})
+		assert.ErrorIs(t, err, ErrInvalidArgument)
+		assert.Assert(t, got == nil)
+	}
+}
+
+func TestAutocompleteSkillsSuite(t *testing.T) { suite.Run(t, new(AutocompleteSkillsSuite)) }
@@ -0,0 +1,58 @@
+package usecase
+
+import (
+	"cmp"
+	"context"
+	"slices"
+	"strings"
+
+	"github.com/artem13815/hr/vacancy/internal/domain"
+)
+
+// CloneVacancy copies a vacancy the caller can read, skills, attributes and
+// scoring policy included, into a new draft owned by the caller. Status, version and
+// history start fresh; the source is left untouched.
+func (s *VacancyService) CloneVacancy(ctx context.Context, in domain.CloneVacancyInput) (*domain.Vacancy, error) {
+	if in.OwnerUserID == 0 || in.VacancyID == "" {
+		return nil, ErrInvalidArgument
+	}
+
+	source, err := s.storage.GetVacancy(ctx, in.VacancyID, in.OwnerUserID, in.IsAdmin)
+	if err != nil {
+		return nil, err
+	}
+	if source == nil {
+		return nil, ErrVacancyNotFound
+	}
+
+	return s.createDraftCopy(ctx, domain.CreateVacancyInput{
+		OwnerUserID:   in.OwnerUserID,
+		Title:         cmp.Or(strings.TrimSpace(in.Title), source.Title),
+		Description:   source.Description,
+		Role:          source.Role,
+		RoleSource:    source.RoleSource,
+		RoleLocked:    source.RoleLocked,
+		Skills:        slices.Clone(source.Skills),
+		Attributes:    source.Attributes,
+		ScoringPolicy: &source.ScoringPolicy,
+	})
+}
+
+// createDraftCopy is the shared tail of CloneVacancy and
+// CreateVacancyFromTemplate: the copy goes through the same validation as
+// CreateVacancy (a title override may be too long, an old template may
+// predate a rule) and always starts as a draft, so nothing reaches
+// candidates before the caller has reviewed it.
+func (s *VacancyService) createDraftCopy(ctx context.Context, in domain.CreateVacancyInput) (*domain.Vacancy, error) {
+	in.Status = domain.StatusDraft
+	in.Skills = normalizeSkills(in.Skills, s.taxonomy)
+	in.Attributes = normalizeAttributes(in.Attributes)
+	if err := validateCreateInput(in); err != nil {
+		return nil, err
+	}
+	if in.Role == "" {
+		in.Role, in.RoleSource = s.resolveRole(ctx, in.Title, in.Description)
+	}
+
+	return s.storage.CreateVacancy(ctx, in)
+}
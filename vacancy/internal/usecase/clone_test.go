@@ -0,0 +1,83 @@
+package usecase
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+	"gotest.tools/v3/assert"
+
+	"github.com/artem13815/hr/vacancy/internal/domain"
+)
+
+type CloneVacancySuite struct{ baseSuite }
+
+// TestAdminClonesIntoOwnDraft: an admin cloning someone else's open vacancy
+// gets a draft owned by the admin, with the skills and scoring policy
+// copied over.
+func (s *CloneVacancySuite) TestAdminClonesIntoOwnDraft() {
+	t := s.T()
+	ctx := t.Context()
+	source := &domain.Vacancy{
+		ID:          "v-1",
+		OwnerUserID: 7,
+		Title:       "Go backend developer",
+		Description: "Payments team.",
+		Role:        "programmer",
+		Skills:      []domain.SkillWeight{{Name: "Go", Weight: 0.7, MustHave: true}, {Name: "PostgreSQL", Weight: 0.3, NiceToHave: true}},
+		Status:      domain.StatusOpen,
+		Version:     4,
+		ScoringPolicy: domain.ScoringPolicy{
+			MustHavePenalty: 20, NiceToHaveBonus: 1, HireThreshold: 80, MaybeThreshold: 60, RejectOnMissingMustHave: true,
+		},
+	}
+	want := &domain.Vacancy{ID: "v-2", OwnerUserID: 1, Status: domain.StatusDraft, Version: 1}
+
+	s.storage.GetVacancyMock.Expect(ctx, "v-1", uint64(1), true).Return(source, nil)
+	s.storage.CreateVacancyMock.Expect(ctx, domain.CreateVacancyInput{
+		OwnerUserID:   1,
+		Title:         source.Title,
+		Description:   source.Description,
+		Role:          source.Role,
+		Skills:        source.Skills,
+		Status:        domain.StatusDraft,
+		Attributes:    domain.VacancyAttributes{Headcount: 1},
+		ScoringPolicy: &source.ScoringPolicy,
+	}).Return(want, nil)
+
+	got, err := s.svc.CloneVacancy(ctx, domain.CloneVacancyInput{VacancyID: "v-1", OwnerUserID: 1, IsAdmin: true})
+	assert.NilError(t, err)
+	assert.Equal(t, got, want)
+}
+
+func (s *CloneVacancySuite) TestNotFound() {
+	t := s.T()
+	ctx := t.Context()
+
+	s.storage.GetVacancyMock.Expect(ctx, "v-1", uint64(1), false).Return(nil, nil)
+
+	got, err := s.svc.CloneVacancy(ctx, domain.CloneVacancyInput{VacancyID: "v-1", OwnerUserID: 1})
+	assert.ErrorIs(t, err, ErrVacancyNotFound)
+	assert.Assert(t, got == nil)
+}
+
+func (s *CloneVacancySuite) TestInvalidArgument() {
+	t := s.T()
+	got, err := s.svc.CloneVacancy(t.Context(), domain.CloneVacancyInput{VacancyID: "v-1"})
+	assert.ErrorIs(t, err, ErrInvalidArgument)
+	assert.Assert(t, got == nil)
+}
+
+func (s *CloneVacancySuite) TestStorageError() {
+	t := s.T()
+	ctx := t.Context()
+	storageErr := errors.New("pgx: connection refused")
+
+	s.storage.GetVacancyMock.Return(nil, storageErr)
+
+	got, err := s.svc.CloneVacancy(ctx, domain.CloneVacancyInput{VacancyID: "v-1", OwnerUserID: 1})
+	assert.ErrorIs(t, err, storageErr)
+	assert.Assert(t, got == nil)
+}
+
+func TestCloneVacancySuite(t *testing.T) { suite.Run(t, new(CloneVacancySuite)) }
@@ -0,0 +1,115 @@
+package usecase
+
+import (
+	"context"
+
+	"github.com/artem13815/hr/vacancy/internal/domain"
+)
+
+// AddCollaborator shares the vacancy with another user. Only the owner and
+// admins manage grants; a collaborator gets ErrForbidden, anyone who cannot
+// see the vacancy at all gets ErrVacancyNotFound.
+func (s *VacancyService) AddCollaborator(ctx context.Context, in domain.AddCollaboratorInput) (*domain.Collaborator, error) {
+	if in.OwnerUserID == 0 || in.VacancyID == "" || in.UserID == 0 || !domain.IsValidCollaboratorRole(in.Role) {
+		return nil, ErrInvalidArgument
+	}
+
+	vacancy, err := s.managedVacancy(ctx, in.VacancyID, in.OwnerUserID, in.IsAdmin)
+	if err != nil {
+		return nil, err
+	}
+	// The owner already has full access; a grant would only be noise.
+	if in.UserID == vacancy.OwnerUserID {
+		return nil, ErrInvalidArgument
+	}
+
+	return s.storage.AddCollaborator(ctx, in)
+}
+
+// RemoveCollaborator revokes a grant. Revoking one that does not exist is
+// reported as not-found so a typo in the user id is visible to the client.
+func (s *VacancyService) RemoveCollaborator(ctx context.Context, in domain.RemoveCollaboratorInput) error {
+	if in.OwnerUserID == 0 || in.VacancyID == "" || in.UserID == 0 {
+		return ErrInvalidArgument
+	}
+
+	if _, err := s.managedVacancy(ctx, in.VacancyID, in.OwnerUserID, in.IsAdmin); err != nil {
+		return err
+	}
+
+	removed, err := s.storage.RemoveCollaborator(ctx, in.VacancyID, in.UserID)
+	if err != nil {
+		return err
+	}
+	if !removed {
+		return ErrCollaboratorNotFound
+	}
+	return nil
+}
+
+// ListCollaborators is readable by everyone who can see the vacancy, so a
+// viewer knows who else is on the hiring team.
+func (s *VacancyService) ListCollaborators(ctx context.Context, in domain.ListCollaboratorsInput) ([]domain.Collaborator, error) {
+	if in.OwnerUserID == 0 || in.VacancyID == "" {
+		return nil, ErrInvalidArgument
+	}
+
+	vacancy, err := s.storage.GetVacancy(ctx, in.VacancyID, in.OwnerUserID, in.IsAdmin)
+	if err != nil {
+		return nil, err
+	}
+	if vacancy == nil {
+		return nil, ErrVacancyNotFound
+	}
+
+	return s.storage.ListCollaborators(ctx, in.VacancyID)
+}
+
+// managedVacancy loads the vacancy and requires the caller to own it or be
+// an admin.
+func (s *VacancyService) managedVacancy(ctx context.Context, vacancyID string, userID uint64, isAdmin bool) (*domain.Vacancy, error) {
+	vacancy, err := s.storage.GetVacancy(ctx, vacancyID, userID, isAdmin)
+	if err != nil {
+		return nil, err
+	}
+	if vacancy == nil {
+		return nil, ErrVacancyNotFound
+	}
+	if !isAdmin && vacancy.OwnerUserID != userID {
+		return nil, ErrForbidden
+	}
+	return vacancy, nil
+}
+
+// editableVacancy loads the vacancy and requires the caller to be able to
+// edit it: the owner, an admin or an editor collaborator. A viewer gets
+// ErrForbidden rather than the not-found an editAccess write would yield.
+func (s *VacancyService) editableVacancy(ctx context.Context, vacancyID string, userID uint64, isAdmin bool) (*domain.Vacancy, error) {
+	vacancy, err := s.storage.GetVacancy(ctx, vacancyID, userID, isAdmin)
+	if err != nil {
+		return nil, err
+	}
+	if vacancy == nil {
+		return nil, ErrVacancyNotFound
+	}
+	if err := s.requireEditor(ctx, vacancy, userID, isAdmin); err != nil {
+		return nil, err
+	}
+	return vacancy, nil
+}
+
+// requireEditor is the access half of editableVacancy, for callers that
+// already hold the vacancy.
+func (s *VacancyService) requireEditor(ctx context.Context, vacancy *domain.Vacancy, userID uint64, isAdmin bool) error {
+	if isAdmin || vacancy.OwnerUserID == userID {
+		return nil
+	}
+	ok, err := s.storage.CanEditVacancy(ctx, vacancy.ID, userID)
+	if err != nil {
+		return err
+	}
+	if !ok {
+		return ErrForbidden
+	}
+	return nil
+}
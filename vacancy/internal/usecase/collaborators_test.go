@@ -0,0 +1,148 @@
+package usecase
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+	"gotest.tools/v3/assert"
+
+	"github.com/artem13815/hr/vacancy/internal/domain"
+)
+
+type CollaboratorsSuite struct{ baseSuite }
+
+func (s *CollaboratorsSuite) TestAddByOwner() {
+	t := s.T()
+	ctx := t.Context()
+	in := domain.AddCollaboratorInput{VacancyID: "v-1", OwnerUserID: 7, UserID: 8, Role: domain.CollaboratorRoleViewer}
+	want := &domain.Collaborator{VacancyID: "v-1", UserID: 8, Role: domain.CollaboratorRoleViewer, AddedBy: 7}
+
+	s.storage.GetVacancyMock.Expect(ctx, "v-1", uint64(7), false).Return(&domain.Vacancy{ID: "v-1", OwnerUserID: 7}, nil)
+	s.storage.AddCollaboratorMock.Expect(ctx, in).Return(want, nil)
+
+	got, err := s.svc.AddCollaborator(ctx, in)
+	assert.NilError(t, err)
+	assert.DeepEqual(t, got, want)
+}
+
+func (s *CollaboratorsSuite) TestAddByAdmin() {
+	t := s.T()
+	ctx := t.Context()
+	in := domain.AddCollaboratorInput{VacancyID: "v-1", OwnerUserID: 1, IsAdmin: true, UserID: 8, Role: domain.CollaboratorRoleEditor}
+	want := &domain.Collaborator{VacancyID: "v-1", UserID: 8, Role: domain.CollaboratorRoleEditor, AddedBy: 1}
+
+	s.storage.GetVacancyMock.Expect(ctx, "v-1", uint64(1), true).Return(&domain.Vacancy{ID: "v-1", OwnerUserID: 7}, nil)
+	s.storage.AddCollaboratorMock.Expect(ctx, in).Return(want, nil)
+
+	got, err := s.svc.AddCollaborator(ctx, in)
+	assert.NilError(t, err)
+	assert.DeepEqual(t, got, want)
+}
+
+func (s *CollaboratorsSuite) TestAddByCollaboratorForbidden() {
+	t := s.T()
+	s.storage.GetVacancyMock.Return(&domain.Vacancy{ID: "v-1", OwnerUserID: 7}, nil)
+
+	got, err := s.svc.AddCollaborator(t.Context(), domain.AddCollaboratorInput{
+		VacancyID: "v-1", OwnerUserID: 8, UserID: 9, Role: domain.CollaboratorRoleEditor,
+	})
+	assert.ErrorIs(t, err, ErrForbidden)
+	assert.Assert(t, got == nil)
+}
+
+func (s *CollaboratorsSuite) TestAddNotFound() {
+	t := s.T()
+	s.storage.GetVacancyMock.Return(nil, nil)
+
+	got, err := s.svc.AddCollaborator(t.Context(), domain.AddCollaboratorInput{
+		VacancyID: "v-1", OwnerUserID: 8, UserID: 9, Role: domain.CollaboratorRoleViewer,
+	})
+	assert.ErrorIs(t, err, ErrVacancyNotFound)
+	assert.Assert(t, got == nil)
+}
+
+func (s *CollaboratorsSuite) TestAddOwnerRejected() {
+	t := s.T()
+	s.storage.GetVacancyMock.Return(&domain.Vacancy{ID: "v-1", OwnerUserID: 7}, nil)
+
+	got, err := s.svc.AddCollaborator(t.Context(), domain.AddCollaboratorInput{
+		VacancyID: "v-1", OwnerUserID: 7, UserID: 7, Role: domain.CollaboratorRoleEditor,
+	})
+	assert.ErrorIs(t, err, ErrInvalidArgument)
+	assert.Assert(t, got == nil)
+}
+
+func (s *CollaboratorsSuite) TestAddInvalidArgument() {
+	t := s.T()
+	for _, in := range []domain.AddCollaboratorInput{
+		{OwnerUserID: 7, UserID: 8, Role: domain.CollaboratorRoleViewer},
+		{VacancyID: "v-1", UserID: 8, Role: domain.CollaboratorRoleViewer},
+		{VacancyID: "v-1", OwnerUserID: 7, Role: domain.CollaboratorRoleViewer},
+		{VacancyID: "v-1", OwnerUserID: 7, UserID: 8, Role: "owner"},
+	} {
+		got, err := s.svc.AddCollaborator(t.Context(), in)
+		assert.ErrorIs(t, err, ErrInvalidArgument)
+		assert.Assert(t, got == nil)
+	}
+}
+
+func (s *CollaboratorsSuite) TestRemove() {
+	t := s.T()
+	ctx := t.Context()
+
+	s.storage.GetVacancyMock.Expect(ctx, "v-1", uint64(7), false).Return(&domain.Vacancy{ID: "v-1", OwnerUserID: 7}, nil)
+	s.storage.RemoveCollaboratorMock.Expect(ctx, "v-1", uint64(8)).Return(true, nil)
+
+	err := s.svc.RemoveCollaborator(ctx, domain.RemoveCollaboratorInput{VacancyID: "v-1", OwnerUserID: 7, UserID: 8})
+	assert.NilError(t, err)
+}
+
+func (s *CollaboratorsSuite) TestRemoveMissingGrant() {
+	t := s.T()
+	s.storage.GetVacancyMock.Return(&domain.Vacancy{ID: "v-1", OwnerUserID: 7}, nil)
+	s.storage.RemoveCollaboratorMock.Return(false, nil)
+
+	err := s.svc.RemoveCollaborator(t.Context(), domain.RemoveCollaboratorInput{VacancyID: "v-1", OwnerUserID: 7, UserID: 8})
+	assert.ErrorIs(t, err, ErrCollaboratorNotFound)
+}
+
+func (s *CollaboratorsSuite) TestRemoveByCollaboratorForbidden() {
+	t := s.T()
+	s.storage.GetVacancyMock.Return(&domain.Vacancy{ID: "v-1", OwnerUserID: 7}, nil)
+
+	err := s.svc.RemoveCollaborator(t.Context(), domain.RemoveCollaboratorInput{VacancyID: "v-1", OwnerUserID: 8, UserID: 8})
+	assert.ErrorIs(t, err, ErrForbidden)
+}
+
+func (s *CollaboratorsSuite) TestRemoveStorageError() {
+	t := s.T()
+	s.storage.GetVacancyMock.Return(nil, errors.New("db down"))
+
+	err := s.svc.RemoveCollaborator(t.Context(), domain.RemoveCollaboratorInput{VacancyID: "v-1", OwnerUserID: 7, UserID: 8})
+	assert.ErrorContains(t, err, "db down")
+}
+
+func (s *CollaboratorsSuite) TestListByViewer() {
+	t := s.T()
+	ctx := t.Context()
+	want := []domain.Collaborator{{VacancyID: "v-1", UserID: 8, Role: domain.CollaboratorRoleViewer, AddedBy: 7}}
+
+	s.storage.GetVacancyMock.Expect(ctx, "v-1", uint64(8), false).Return(&domain.Vacancy{ID: "v-1", OwnerUserID: 7}, nil)
+	s.storage.ListCollaboratorsMock.Expect(ctx, "v-1").Return(want, nil)
+
+	got, err := s.svc.ListCollaborators(ctx, domain.ListCollaboratorsInput{VacancyID: "v-1", OwnerUserID: 8})
+	assert.NilError(t, err)
+	assert.DeepEqual(t, got, want)
+}
+
+func (s *CollaboratorsSuite) TestListNotFound() {
+	t := s.T()
+	s.storage.GetVacancyMock.Return(nil, nil)
+
+	got, err := s.svc.ListCollaborators(t.Context(), domain.ListCollaboratorsInput{VacancyID: "v-1", OwnerUserID: 9})
+	assert.ErrorIs(t, err, ErrVacancyNotFound)
+	assert.Assert(t, got == nil)
+}
+
+func TestCollaboratorsSuite(t *testing.T) { suite.Run(t, new(CollaboratorsSuite)) }
@@ -1,18 +1,46 @@
 package usecase
 
 import (
+	"cmp"
 	"context"
+	"strings"
 
 	"github.com/artem13815/hr/vacancy/internal/domain"
 )
 
+// CreateVacancy creates the vacancy unless open vacancies the owner can see
+// look like duplicates of it and in.Force is not set; then nothing is
+// written and a *domain.PossibleDuplicatesError carries the duplicates.
 func (s *VacancyService) CreateVacancy(ctx context.Context, in domain.CreateVacancyInput) (*domain.Vacancy, error) {
-	in.Skills = normalizeSkills(in.Skills)
+	in.Skills = normalizeSkills(in.Skills, s.taxonomy)
+	in.Attributes = normalizeAttributes(in.Attributes)
 	// Validate first so we don't pay for an LLM call on bad input.
 	if err := validateCreateInput(in); err != nil {
 		return nil, err
 	}
-	in.Role = s.resolveRole(ctx, in.Title, in.Description)
+	in.Status = cmp.Or(in.Status, domain.StatusOpen)
+	// A role the caller chose is pinned; otherwise classification decides,
+	// once the vacancy is known to be created.
+	pinned := strings.TrimSpace(in.Role) != ""
+	if pinned {
+		role, err := s.pinnedRole(ctx, in.Role)
+		if err != nil {
+			return nil, err
+		}
+		in.Role, in.RoleSource, in.RoleLocked = role, domain.RoleSourceManual, true
+	}
+	if !in.Force {
+		duplicates, err := s.possibleDuplicates(ctx, in)
+		if err != nil {
+			return nil, err
+		}
+		if len(duplicates) > 0 {
+			return nil, &domain.PossibleDuplicatesError{Duplicates: duplicates}
+		}
+	}
+	if !pinned {
+		in.Role, in.RoleSource = s.resolveRole(ctx, in.Title, in.Description)
+	}
 
 	return s.storage.CreateVacancy(ctx, in)
 }
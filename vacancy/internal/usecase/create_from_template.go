@@ -0,0 +1,41 @@
+package usecase
+
+import (
+	"cmp"
+	"context"
+	"strings"
+
+	"github.com/artem13815/hr/vacancy/internal/domain"
+)
+
+// CreateVacancyFromTemplate opens a new draft from a template the caller can
+// see. The template's role is reused as is: it was resolved when the source
+// vacancy was saved, so there is no reason to pay for another LLM call. A
+// role pinned on the source vacancy stays pinned on the new one.
+func (s *VacancyService) CreateVacancyFromTemplate(ctx context.Context, in domain.CreateVacancyFromTemplateInput) (*domain.Vacancy, error) {
+	if in.OwnerUserID == 0 || in.TemplateID == "" {
+		return nil, ErrInvalidArgument
+	}
+
+	tmpl, err := s.storage.GetTemplate(ctx, domain.GetTemplateInput{
+		TemplateID:  in.TemplateID,
+		OwnerUserID: in.OwnerUserID,
+		IsAdmin:     in.IsAdmin,
+	})
+	if err != nil {
+		return nil, err
+	}
+	if tmpl == nil {
+		return nil, ErrTemplateNotFound
+	}
+
+	return s.createDraftCopy(ctx, domain.CreateVacancyInput{
+		OwnerUserID: in.OwnerUserID,
+		Title:       cmp.Or(strings.TrimSpace(in.Title), tmpl.Title),
+		Description: tmpl.Description,
+		Role:        tmpl.Role,
+		RoleSource:  tmpl.RoleSource,
+		RoleLocked:  tmpl.RoleSource == domain.RoleSourceManual,
+		Skills:      tmpl.Skills,
+	})
+}
@@ -0,0 +1,118 @@
+package usecase
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+	"gotest.tools/v3/assert"
+
+	"github.com/artem13815/hr/vacancy/internal/domain"
+)
+
+type CreateVacancyFromTemplateSuite struct{ baseSuite }
+
+func goTemplate() *domain.VacancyTemplate {
+	return &domain.VacancyTemplate{
+		ID:          "t-1",
+		OwnerUserID: 7,
+		Scope:       domain.TemplateScopeOrg,
+		Title:       "Go backend developer",
+		Description: "Payments team.",
+		Role:        "programmer",
+		RoleSource:  domain.RoleSourceKeyword,
+		Skills:      validSkills(),
+	}
+}
+
+// TestCreatesDraftWithTemplateRole: the role and its source come from the
+// template, so the classifier is never called, and the vacancy is owned by
+// the caller rather than the template author.
+func (s *CreateVacancyFromTemplateSuite) TestCreatesDraftWithTemplateRole() {
+	t := s.T()
+	ctx := t.Context()
+	tmpl := goTemplate()
+	want := &domain.Vacancy{ID: "v-2", Status: domain.StatusDraft}
+
+	s.storage.GetTemplateMock.Expect(ctx, domain.GetTemplateInput{TemplateID: "t-1", OwnerUserID: 1}).Return(tmpl, nil)
+	s.storage.CreateVacancyMock.Expect(ctx, domain.CreateVacancyInput{
+		OwnerUserID: 1,
+		Title:       "Go backend developer, Q3",
+		Description: tmpl.Description,
+		Role:        "programmer",
+		RoleSource:  domain.RoleSourceKeyword,
+		Skills:      tmpl.Skills,
+		Status:      domain.StatusDraft,
+		Attributes:  domain.VacancyAttributes{Headcount: 1},
+	}).Return(want, nil)
+
+	got, err := s.svc.CreateVacancyFromTemplate(ctx, domain.CreateVacancyFromTemplateInput{
+		TemplateID:  "t-1",
+		OwnerUserID: 1,
+		Title:       " Go backend developer, Q3 ",
+	})
+	assert.NilError(t, err)
+	assert.Equal(t, got, want)
+}
+
+// TestEmptyRoleIsResolved covers templates saved from vacancies that
+// predate role classification.
+func (s *CreateVacancyFromTemplateSuite) TestEmptyRoleIsResolved() {
+	t := s.T()
+	ctx := t.Context()
+	tmpl := goTemplate()
+	tmpl.Role = ""
+
+	s.storage.GetTemplateMock.Return(tmpl, nil)
+	s.classifier.ClassifyMock.Return("programmer", nil)
+	s.storage.CreateVacancyMock.Expect(ctx, domain.CreateVacancyInput{
+		OwnerUserID: 1,
+		Title:       tmpl.Title,
+		Description: tmpl.Description,
+		Role:        "programmer",
+		RoleSource:  domain.RoleSourceLLM,
+		Skills:      tmpl.Skills,
+		Status:      domain.StatusDraft,
+		Attributes:  domain.VacancyAttributes{Headcount: 1},
+	}).Return(&domain.Vacancy{ID: "v-2"}, nil)
+
+	_, err := s.svc.CreateVacancyFromTemplate(ctx, domain.CreateVacancyFromTemplateInput{TemplateID: "t-1", OwnerUserID: 1})
+	assert.NilError(t, err)
+}
+
+func (s *CreateVacancyFromTemplateSuite) TestNotFound() {
+	t := s.T()
+	ctx := t.Context()
+
+	s.storage.GetTemplateMock.Return(nil, nil)
+
+	got, err := s.svc.CreateVacancyFromTemplate(ctx, domain.CreateVacancyFromTemplateInput{TemplateID: "t-1", OwnerUserID: 1})
+	assert.ErrorIs(t, err, ErrTemplateNotFound)
+	assert.Assert(t, got == nil)
+}
+
+func (s *CreateVacancyFromTemplateSuite) TestTitleOverrideTooLong() {
+	t := s.T()
+	ctx := t.Context()
+
+	s.storage.GetTemplateMock.Return(goTemplate(), nil)
+
+	got, err := s.svc.CreateVacancyFromTemplate(ctx, domain.CreateVacancyFromTemplateInput{
+		TemplateID:  "t-1",
+		OwnerUserID: 1,
+		Title:       strings.Repeat("я", maxTitleRunes+1),
+	})
+	assert.ErrorIs(t, err, ErrInvalidArgument)
+	assert.Assert(t, got == nil)
+}
+
+func (s *CreateVacancyFromTemplateSuite) TestInvalidArgument() {
+	t := s.T()
+	got, err := s.svc.CreateVacancyFromTemplate(t.Context(), domain.CreateVacancyFromTemplateInput{OwnerUserID: 1})
+	assert.ErrorIs(t, err, ErrInvalidArgument)
+	assert.Assert(t, got == nil)
+}
+
+func TestCreateVacancyFromTemplateSuite(t *testing.T) {
+	suite.Run(t, new(CreateVacancyFromTemplateSuite))
+}
@@ -0,0 +1,51 @@
+package usecase
+
+import (
+	"cmp"
+	"context"
+	"strings"
+	"unicode/utf8"
+
+	"github.com/artem13815/hr/vacancy/internal/domain"
+)
+
+// CreateTemplate freezes the current title, description, role and skill
+// matrix of a vacancy the caller can read into a reusable template. An
+// org-wide template shows up for every user, so only admins create one;
+// others get ErrForbidden.
+func (s *VacancyService) CreateTemplate(ctx context.Context, in domain.CreateTemplateInput) (*domain.VacancyTemplate, error) {
+	if in.OwnerUserID == 0 || in.VacancyID == "" {
+		return nil, ErrInvalidArgument
+	}
+	in.Scope = cmp.Or(in.Scope, domain.TemplateScopePersonal)
+	if in.Scope != domain.TemplateScopePersonal && in.Scope != domain.TemplateScopeOrg {
+		return nil, ErrInvalidArgument
+	}
+	if in.Scope == domain.TemplateScopeOrg && !in.IsAdmin {
+		return nil, ErrForbidden
+	}
+	in.Name = strings.TrimSpace(in.Name)
+	if utf8.RuneCountInString(in.Name) > maxTemplateRunes {
+		return nil, ErrInvalidArgument
+	}
+
+	vacancy, err := s.storage.GetVacancy(ctx, in.VacancyID, in.OwnerUserID, in.IsAdmin)
+	if err != nil {
+		return nil, err
+	}
+	if vacancy == nil {
+		return nil, ErrVacancyNotFound
+	}
+
+	return s.storage.CreateTemplate(ctx, domain.InsertTemplateInput{
+		OwnerUserID:     in.OwnerUserID,
+		Scope:           in.Scope,
+		Name:            cmp.Or(in.Name, vacancy.Title),
+		Title:           vacancy.Title,
+		Description:     vacancy.Description,
+		Role:            vacancy.Role,
+		RoleSource:      vacancy.RoleSource,
+		Skills:          vacancy.Skills,
+		SourceVacancyID: vacancy.ID,
+	})
+}
@@ -0,0 +1,126 @@
+package usecase
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+	"gotest.tools/v3/assert"
+
+	"github.com/artem13815/hr/vacancy/internal/domain"
+)
+
+type CreateTemplateSuite struct{ baseSuite }
+
+func (s *CreateTemplateSuite) TestCopiesVacancy() {
+	t := s.T()
+	ctx := t.Context()
+	source := &domain.Vacancy{
+		ID:          "v-1",
+		OwnerUserID: 1,
+		Title:       "Go backend developer",
+		Description: "Payments team.",
+		Role:        "programmer",
+		Skills:      validSkills(),
+		Status:      domain.StatusClosed,
+	}
+	want := &domain.VacancyTemplate{ID: "t-1"}
+
+	s.storage.GetVacancyMock.Expect(ctx, "v-1", uint64(1), true).Return(source, nil)
+	s.storage.CreateTemplateMock.Expect(ctx, domain.InsertTemplateInput{
+		OwnerUserID:     1,
+		Scope:           domain.TemplateScopeOrg,
+		Name:            "Quarterly Go hire",
+		Title:           source.Title,
+		Description:     source.Description,
+		Role:            source.Role,
+		Skills:          source.Skills,
+		SourceVacancyID: "v-1",
+	}).Return(want, nil)
+
+	got, err := s.svc.CreateTemplate(ctx, domain.CreateTemplateInput{
+		VacancyID:   "v-1",
+		OwnerUserID: 1,
+		IsAdmin:     true,
+		Name:        "  Quarterly Go hire ",
+		Scope:       domain.TemplateScopeOrg,
+	})
+	assert.NilError(t, err)
+	assert.Equal(t, got, want)
+}
+
+// TestDefaults: no name falls back to the vacancy title, no scope to
+// personal.
+func (s *CreateTemplateSuite) TestDefaults() {
+	t := s.T()
+	ctx := t.Context()
+	source := &domain.Vacancy{ID: "v-1", Title: "Go backend developer", Skills: validSkills()}
+
+	s.storage.GetVacancyMock.Expect(ctx, "v-1", uint64(1), true).Return(source, nil)
+	s.storage.CreateTemplateMock.Expect(ctx, domain.InsertTemplateInput{
+		OwnerUserID:     1,
+		Scope:           domain.TemplateScopePersonal,
+		Name:            "Go backend developer",
+		Title:           "Go backend developer",
+		Skills:          source.Skills,
+		SourceVacancyID: "v-1",
+	}).Return(&domain.VacancyTemplate{ID: "t-1"}, nil)
+
+	_, err := s.svc.CreateTemplate(ctx, domain.CreateTemplateInput{VacancyID: "v-1", OwnerUserID: 1, IsAdmin: true})
+	assert.NilError(t, err)
+}
+
+// TestOrgRequiresAdmin: an org template is visible to every user, so a
+// non-admin is refused before the vacancy is read.
+func (s *CreateTemplateSuite) TestOrgRequiresAdmin() {
+	t := s.T()
+
+	got, err := s.svc.CreateTemplate(t.Context(), domain.CreateTemplateInput{
+		VacancyID:   "v-1",
+		OwnerUserID: 1,
+		Scope:       domain.TemplateScopeOrg,
+	})
+	assert.ErrorIs(t, err, ErrForbidden)
+	assert.Assert(t, got == nil)
+}
+
+func (s *CreateTemplateSuite) TestVacancyNotFound() {
+	t := s.T()
+	ctx := t.Context()
+
+	s.storage.GetVacancyMock.Expect(ctx, "v-1", uint64(1), false).Return(nil, nil)
+
+	got, err := s.svc.CreateTemplate(ctx, domain.CreateTemplateInput{VacancyID: "v-1", OwnerUserID: 1})
+	assert.ErrorIs(t, err, ErrVacancyNotFound)
+	assert.Assert(t, got == nil)
+}
+
+func (s *CreateTemplateSuite) TestInvalidArgument() {
+	t := s.T()
+	cases := map[string]domain.CreateTemplateInput{
+		"no owner":      {VacancyID: "v-1"},
+		"no vacancy":    {OwnerUserID: 1},
+		"unknown scope": {VacancyID: "v-1", OwnerUserID: 1, Scope: "team"},
+		"long name":     {VacancyID: "v-1", OwnerUserID: 1, Name: string(make([]rune, maxTemplateRunes+1))},
+	}
+	for name, in := range cases {
+		got, err := s.svc.CreateTemplate(t.Context(), in)
+		assert.ErrorIs(t, err, ErrInvalidArgument, name)
+		assert.Assert(t, got == nil, name)
+	}
+}
+
+func (s *CreateTemplateSuite) TestStorageError() {
+	t := s.T()
+	ctx := t.Context()
+	storageErr := errors.New("pgx: connection refused")
+
+	s.storage.GetVacancyMock.Expect(ctx, "v-1", uint64(1), false).Return(&domain.Vacancy{ID: "v-1", Title: "Go"}, nil)
+	s.storage.CreateTemplateMock.Return(nil, storageErr)
+
+	got, err := s.svc.CreateTemplate(ctx, domain.CreateTemplateInput{VacancyID: "v-1", OwnerUserID: 1})
+	assert.ErrorIs(t, err, storageErr)
+	assert.Assert(t, got == nil)
+}
+
+func TestCreateTemplateSuite(t *testing.T) { suite.Run(t, new(CreateTemplateSuite)) }
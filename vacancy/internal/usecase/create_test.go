@@ -31,10 +31,14 @@ func (s *CreateVacancySuite) TestSuccess() {
 		Skills:      validSkills(),
 	}
 	expected := in
+	expected.Attributes.Headcount = 1
 	expected.Role = "programmer"
+	expected.RoleSource = domain.RoleSourceLLM
+	expected.Status = domain.StatusOpen
 	want := &domain.Vacancy{ID: "v-1", OwnerUserID: 1, Title: in.Title}
 
 	s.classifier.ClassifyMock.Return("programmer", nil)
+	s.storage.ListDuplicateCandidatesMock.Return(nil, nil)
 	s.storage.CreateVacancyMock.Expect(ctx, expected).Return(want, nil)
 
 	got, err := s.svc.CreateVacancy(ctx, in)
@@ -56,10 +60,14 @@ func (s *CreateVacancySuite) TestClassifierFallsBackToKeywords() {
 		Skills:      validSkills(),
 	}
 	expected := in
+	expected.Attributes.Headcount = 1
 	expected.Role = "programmer"
+	expected.RoleSource = domain.RoleSourceKeyword
+	expected.Status = domain.StatusOpen
 	want := &domain.Vacancy{ID: "v-1"}
 
 	s.classifier.ClassifyMock.Return("", ErrLLMUnavailable)
+	s.storage.ListDuplicateCandidatesMock.Return(nil, nil)
 	s.storage.CreateVacancyMock.Expect(ctx, expected).Return(want, nil)
 
 	got, err := s.svc.CreateVacancy(ctx, in)
@@ -80,10 +88,14 @@ func (s *CreateVacancySuite) TestEmptyClassifierResponseFallsBack() {
 		Skills:      validSkills(),
 	}
 	expected := in
+	expected.Attributes.Headcount = 1
 	expected.Role = "programmer"
+	expected.RoleSource = domain.RoleSourceKeyword
+	expected.Status = domain.StatusOpen
 	want := &domain.Vacancy{ID: "v-1"}
 
 	s.classifier.ClassifyMock.Return("", nil)
+	s.storage.ListDuplicateCandidatesMock.Return(nil, nil)
 	s.storage.CreateVacancyMock.Expect(ctx, expected).Return(want, nil)
 
 	got, err := s.svc.CreateVacancy(ctx, in)
@@ -112,6 +124,9 @@ func (s *CreateVacancySuite) TestNormalizesZeroWeightedSkills() {
 		OwnerUserID: 1,
 		Title:       "Lead",
 		Role:        "default",
+		RoleSource:  domain.RoleSourceLLM,
+		Status:      domain.StatusOpen,
+		Attributes:  domain.VacancyAttributes{Headcount: 1},
 		Skills: []domain.SkillWeight{
 			{Name: "Go", Weight: float32(1) / 3},
 			{Name: "SQL", Weight: float32(1) / 3},
@@ -121,6 +136,7 @@ func (s *CreateVacancySuite) TestNormalizesZeroWeightedSkills() {
 	want := &domain.Vacancy{ID: "v-2"}
 
 	s.classifier.ClassifyMock.Return("default", nil)
+	s.storage.ListDuplicateCandidatesMock.Return(nil, nil)
 	s.storage.CreateVacancyMock.Expect(ctx, expected).Return(want, nil)
 
 	got, err := s.svc.CreateVacancy(ctx, in)
@@ -204,6 +220,87 @@ func (s *CreateVacancySuite) TestInvalidArgumentWeightOutOfRange() {
 	assert.Assert(t, got == nil)
 }
 
+// TestCreatesDraft: the caller may start a vacancy as a draft; the status is
+// passed through untouched.
+func (s *CreateVacancySuite) TestCreatesDraft() {
+	t := s.T()
+	ctx := t.Context()
+	in := domain.CreateVacancyInput{
+		OwnerUserID: 1,
+		Title:       "ok",
+		Skills:      validSkills(),
+		Status:      domain.StatusDraft,
+	}
+	expected := in
+	expected.Attributes.Headcount = 1
+	expected.Role = "default"
+	expected.RoleSource = domain.RoleSourceLLM
+	want := &domain.Vacancy{ID: "v-1", Status: domain.StatusDraft}
+
+	s.classifier.ClassifyMock.Return("default", nil)
+	s.storage.ListDuplicateCandidatesMock.Return(nil, nil)
+	s.storage.CreateVacancyMock.Expect(ctx, expected).Return(want, nil)
+
+	got, err := s.svc.CreateVacancy(ctx, in)
+	assert.NilError(t, err)
+	assert.Equal(t, got, want)
+}
+
+// TestPassesScoringPolicy: an explicit policy reaches storage as given; a
+// nil one (every other test) leaves storage to apply the default.
+func (s *CreateVacancySuite) TestPassesScoringPolicy() {
+	t := s.T()
+	ctx := t.Context()
+	in := domain.CreateVacancyInput{
+		OwnerUserID: 1,
+		Title:       "ok",
+		Skills:      validSkills(),
+		ScoringPolicy: &domain.ScoringPolicy{
+			MustHavePenalty: 25, HireThreshold: 85, MaybeThreshold: 60, RejectOnMissingMustHave: true,
+		},
+	}
+	expected := in
+	expected.Status = domain.StatusOpen
+	expected.Attributes.Headcount = 1
+	expected.Role = "default"
+	expected.RoleSource = domain.RoleSourceLLM
+	want := &domain.Vacancy{ID: "v-1"}
+
+	s.classifier.ClassifyMock.Return("default", nil)
+	s.storage.ListDuplicateCandidatesMock.Return(nil, nil)
+	s.storage.CreateVacancyMock.Expect(ctx, expected).Return(want, nil)
+
+	got, err := s.svc.CreateVacancy(ctx, in)
+	assert.NilError(t, err)
+	assert.Equal(t, got, want)
+}
+
+func (s *CreateVacancySuite) TestInvalidArgumentScoringPolicy() {
+	t := s.T()
+	got, err := s.svc.CreateVacancy(t.Context(), domain.CreateVacancyInput{
+		OwnerUserID:   1,
+		Title:         "ok",
+		Skills:        validSkills(),
+		ScoringPolicy: &domain.ScoringPolicy{HireThreshold: 40, MaybeThreshold: 50},
+	})
+	assert.ErrorIs(t, err, ErrInvalidArgument)
+	assert.Assert(t, got == nil)
+}
+
+// TestInvalidArgumentInitialStatus: only draft and open are valid starting
+// points; anything else must go through TransitionVacancy.
+func (s *CreateVacancySuite) TestInvalidArgumentInitialStatus() {
+	t := s.T()
+	got, err := s.svc.CreateVacancy(t.Context(), domain.CreateVacancyInput{
+		OwnerUserID: 1,
+		Title:       "ok",
+		Skills:      validSkills(),
+		Status:      domain.StatusClosed,
+	})
+	assert.ErrorIs(t, err, ErrInvalidArgument)
+	assert.Assert(t, got == nil)
+}
+
 func (s *CreateVacancySuite) TestStorageError() {
 	t := s.T()
 	ctx := t.Context()
@@ -213,10 +310,14 @@ func (s *CreateVacancySuite) TestStorageError() {
 		Skills:      validSkills(),
 	}
 	expected := in
+	expected.Attributes.Headcount = 1
 	expected.Role = "default"
+	expected.RoleSource = domain.RoleSourceLLM
+	expected.Status = domain.StatusOpen
 	storageErr := errors.New("pgx: connection refused")
 
 	s.classifier.ClassifyMock.Return("default", nil)
+	s.storage.ListDuplicateCandidatesMock.Return(nil, nil)
 	s.storage.CreateVacancyMock.Expect(ctx, expected).Return(nil, storageErr)
 
 	got, err := s.svc.CreateVacancy(ctx, in)
@@ -224,4 +325,151 @@ func (s *CreateVacancySuite) TestStorageError() {
 	assert.Assert(t, got == nil)
 }
 
+// TestCanonicalizesSkills: aliases are rewritten to the taxonomy's canonical
+// name before validation and storage, and an alias of a skill already in
+// the list is dropped.
+func (s *CreateVacancySuite) TestCanonicalizesSkills() {
+	t := s.T()
+	ctx := t.Context()
+	in := domain.CreateVacancyInput{
+		OwnerUserID: 1,
+		Title:       "Backend Engineer",
+		Skills: []domain.SkillWeight{
+			{Name: "golang", Weight: 0.6, MustHave: true},
+			{Name: "k8s", Weight: 0.4},
+			{Name: "Go", Weight: 0.2},
+		},
+	}
+	expected := in
+	expected.Attributes.Headcount = 1
+	expected.Skills = []domain.SkillWeight{
+		{Name: "Go", Weight: 0.6, MustHave: true},
+		{Name: "Kubernetes", Weight: 0.4},
+	}
+	expected.Role = "programmer"
+	expected.RoleSource = domain.RoleSourceLLM
+	expected.Status = domain.StatusOpen
+
+	s.classifier.ClassifyMock.Return("programmer", nil)
+	s.storage.ListDuplicateCandidatesMock.Return(nil, nil)
+	s.storage.CreateVacancyMock.Expect(ctx, expected).Return(&domain.Vacancy{ID: "v-1"}, nil)
+
+	_, err := s.svc.CreateVacancy(ctx, in)
+	assert.NilError(t, err)
+}
+
+// TestPinsExplicitRole: a role from the caller is validated against the
+// vocabulary, normalized and stored locked, without asking the classifier.
+func (s *CreateVacancySuite) TestPinsExplicitRole() {
+	t := s.T()
+	ctx := t.Context()
+	in := domain.CreateVacancyInput{
+		OwnerUserID: 1,
+		Title:       "Data Engineer",
+		Role:        " Analyst ",
+		Skills:      validSkills(),
+	}
+	expected := in
+	expected.Attributes.Headcount = 1
+	expected.Role = "analyst"
+	expected.RoleSource = domain.RoleSourceManual
+	expected.RoleLocked = true
+	expected.Status = domain.StatusOpen
+
+	s.classifier.ListRolesMock.Return(testRoles, nil)
+	s.storage.ListDuplicateCandidatesMock.Return(nil, nil)
+	s.storage.CreateVacancyMock.Expect(ctx, expected).Return(&domain.Vacancy{ID: "v-1"}, nil)
+
+	_, err := s.svc.CreateVacancy(ctx, in)
+	assert.NilError(t, err)
+}
+
+func (s *CreateVacancySuite) TestInvalidArgumentUnknownRole() {
+	t := s.T()
+	s.classifier.ListRolesMock.Return(testRoles, nil)
+
+	got, err := s.svc.CreateVacancy(t.Context(), domain.CreateVacancyInput{
+		OwnerUserID: 1,
+		Title:       "Data Engineer",
+		Role:        "astronaut",
+		Skills:      validSkills(),
+	})
+	assert.ErrorIs(t, err, ErrInvalidArgument)
+	assert.Assert(t, got == nil)
+}
+
+// TestReportsPossibleDuplicates: title-similar open vacancies whose combined
+// title and skill similarity reaches the threshold block the create and come
+// back most similar first in the error; neither the classifier nor storage is asked to
+// create anything.
+func (s *CreateVacancySuite) TestReportsPossibleDuplicates() {
+	t := s.T()
+	ctx := t.Context()
+	in := domain.CreateVacancyInput{
+		OwnerUserID: 1,
+		Title:       "  Senior Go   Developer ",
+		Skills: []domain.SkillWeight{
+			{Name: "Go", Weight: 0.6, MustHave: true},
+			{Name: "PostgreSQL", Weight: 0.4},
+		},
+	}
+
+	s.storage.ListDuplicateCandidatesMock.Expect(ctx, "senior go developer", uint64(1), false, domain.DuplicateCandidateLimit).Return([]domain.DuplicateCandidate{
+		// Same title, other stack: 0.6, below the threshold.
+		{VacancyID: "v-python", Title: "Senior Go Developer", OwnerUserID: 3, TitleSimilarity: 1, Skills: []string{"python"}},
+		{VacancyID: "v-kafka", Title: "Go Developer", OwnerUserID: 2, TitleSimilarity: 0.8, Skills: []string{"go", "postgresql", "kafka"}},
+		{VacancyID: "v-same", Title: "Senior Go developer", OwnerUserID: 2, TitleSimilarity: 0.9, Skills: []string{"go", "postgresql"}},
+	}, nil)
+
+	got, err := s.svc.CreateVacancy(ctx, in)
+	assert.ErrorIs(t, err, ErrPossibleDuplicates)
+	assert.Assert(t, got == nil)
+	var duplicates *domain.PossibleDuplicatesError
+	assert.Assert(t, errors.As(err, &duplicates))
+	assert.DeepEqual(t, duplicates.Duplicates, []domain.PossibleDuplicate{
+		{VacancyID: "v-same", Title: "Senior Go developer", OwnerUserID: 2, TitleSimilarity: 0.9, SkillSimilarity: 1, Similarity: 0.6*float32(0.9) + 0.4*float32(1)},
+		{VacancyID: "v-kafka", Title: "Go Developer", OwnerUserID: 2, TitleSimilarity: 0.8, SkillSimilarity: float32(2) / 3, Similarity: 0.6*float32(0.8) + 0.4*(float32(2)/3)},
+	})
+}
+
+// TestForceSkipsDuplicateCheck: force creates the vacancy without looking
+// for duplicates.
+func (s *CreateVacancySuite) TestForceSkipsDuplicateCheck() {
+	t := s.T()
+	ctx := t.Context()
+	in := domain.CreateVacancyInput{
+		OwnerUserID: 1,
+		Title:       "Backend Engineer",
+		Skills:      validSkills(),
+		Force:       true,
+	}
+	expected := in
+	expected.Attributes.Headcount = 1
+	expected.Role = "programmer"
+	expected.RoleSource = domain.RoleSourceLLM
+	expected.Status = domain.StatusOpen
+	want := &domain.Vacancy{ID: "v-1"}
+
+	s.classifier.ClassifyMock.Return("programmer", nil)
+	s.storage.CreateVacancyMock.Expect(ctx, expected).Return(want, nil)
+
+	got, err := s.svc.CreateVacancy(ctx, in)
+	assert.NilError(t, err)
+	assert.DeepEqual(t, got, want)
+}
+
+func (s *CreateVacancySuite) TestDuplicateCheckError() {
+	t := s.T()
+	storageErr := errors.New("pgx: connection refused")
+	s.storage.ListDuplicateCandidatesMock.Return(nil, storageErr)
+
+	got, err := s.svc.CreateVacancy(t.Context(), domain.CreateVacancyInput{
+		OwnerUserID: 1,
+		Title:       "Backend Engineer",
+		Skills:      validSkills(),
+	})
+	assert.ErrorIs(t, err, storageErr)
+	assert.Assert(t, got == nil)
+}
+
 func TestCreateVacancySuite(t *testing.T) { suite.Run(t, new(CreateVacancySuite)) }
@@ -0,0 +1,31 @@
+package usecase
+
+import (
+	"context"
+
+	"github.com/artem13815/hr/vacancy/internal/domain"
+)
+
+// DeleteVacancy soft-deletes a vacancy: it disappears from every read, its
+// candidates included, and the retention job removes it for good after the
+// grace period. Only the owner or an admin may delete; collaborators get
+// ErrForbidden. An admin can bring it back with RestoreVacancy until then.
+func (s *VacancyService) DeleteVacancy(ctx context.Context, in domain.DeleteVacancyInput) error {
+	if in.OwnerUserID == 0 || in.VacancyID == "" {
+		return ErrInvalidArgument
+	}
+
+	if _, err := s.managedVacancy(ctx, in.VacancyID, in.OwnerUserID, in.IsAdmin); err != nil {
+		return err
+	}
+
+	deleted, err := s.storage.DeleteVacancy(ctx, in)
+	if err != nil {
+		return err
+	}
+	if !deleted {
+		// Deleted by someone else between the read and the write.
+		return ErrVacancyNotFound
+	}
+	return nil
+}
@@ -0,0 +1,42 @@
+package usecase
+
+import (
+	"context"
+
+	"github.com/artem13815/hr/vacancy/internal/domain"
+)
+
+// DeleteTemplate removes a template for good. The owner may delete it, and
+// an admin may delete any org-wide one; anyone else who can see the
+// template gets ErrForbidden. Vacancies already opened from it keep their
+// copied fields.
+func (s *VacancyService) DeleteTemplate(ctx context.Context, in domain.DeleteTemplateInput) error {
+	if in.OwnerUserID == 0 || in.TemplateID == "" {
+		return ErrInvalidArgument
+	}
+
+	tmpl, err := s.storage.GetTemplate(ctx, domain.GetTemplateInput{
+		TemplateID:  in.TemplateID,
+		OwnerUserID: in.OwnerUserID,
+		IsAdmin:     in.IsAdmin,
+	})
+	if err != nil {
+		return err
+	}
+	if tmpl == nil {
+		return ErrTemplateNotFound
+	}
+	if tmpl.OwnerUserID != in.OwnerUserID && !(in.IsAdmin && tmpl.Scope == domain.TemplateScopeOrg) {
+		return ErrForbidden
+	}
+
+	deleted, err := s.storage.DeleteTemplate(ctx, in.TemplateID)
+	if err != nil {
+		return err
+	}
+	if !deleted {
+		// Deleted by someone else between the read and the write.
+		return ErrTemplateNotFound
+	}
+	return nil
+}
@@ -0,0 +1,111 @@
+package usecase
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+	"gotest.tools/v3/assert"
+
+	"github.com/artem13815/hr/vacancy/internal/domain"
+)
+
+type DeleteTemplateSuite struct{ baseSuite }
+
+func (s *DeleteTemplateSuite) TestOwnerDeletes() {
+	t := s.T()
+	ctx := t.Context()
+	in := domain.DeleteTemplateInput{TemplateID: "t-1", OwnerUserID: 1}
+
+	s.storage.GetTemplateMock.Expect(ctx, domain.GetTemplateInput{TemplateID: "t-1", OwnerUserID: 1}).
+		Return(&domain.VacancyTemplate{ID: "t-1", OwnerUserID: 1, Scope: domain.TemplateScopeOrg}, nil)
+	s.storage.DeleteTemplateMock.Expect(ctx, "t-1").Return(true, nil)
+
+	assert.NilError(t, s.svc.DeleteTemplate(ctx, in))
+}
+
+func (s *DeleteTemplateSuite) TestAdminDeletesOrgTemplate() {
+	t := s.T()
+	ctx := t.Context()
+	in := domain.DeleteTemplateInput{TemplateID: "t-1", OwnerUserID: 2, IsAdmin: true}
+
+	s.storage.GetTemplateMock.Expect(ctx, domain.GetTemplateInput{TemplateID: "t-1", OwnerUserID: 2, IsAdmin: true}).
+		Return(&domain.VacancyTemplate{ID: "t-1", OwnerUserID: 1, Scope: domain.TemplateScopeOrg}, nil)
+	s.storage.DeleteTemplateMock.Expect(ctx, "t-1").Return(true, nil)
+
+	assert.NilError(t, s.svc.DeleteTemplate(ctx, in))
+}
+
+// TestForbidden: seeing a template is not enough to delete it. A user
+// sees every org template, and storage lets an admin read a personal one.
+func (s *DeleteTemplateSuite) TestForbidden() {
+	t := s.T()
+	cases := map[string]struct {
+		in   domain.DeleteTemplateInput
+		tmpl *domain.VacancyTemplate
+	}{
+		"org template, not admin": {
+			in:   domain.DeleteTemplateInput{TemplateID: "t-1", OwnerUserID: 2},
+			tmpl: &domain.VacancyTemplate{ID: "t-1", OwnerUserID: 1, Scope: domain.TemplateScopeOrg},
+		},
+		"personal template, admin": {
+			in:   domain.DeleteTemplateInput{TemplateID: "t-1", OwnerUserID: 2, IsAdmin: true},
+			tmpl: &domain.VacancyTemplate{ID: "t-1", OwnerUserID: 1, Scope: domain.TemplateScopePersonal},
+		},
+	}
+	for name, tc := range cases {
+		s.storage.GetTemplateMock.Return(tc.tmpl, nil)
+
+		err := s.svc.DeleteTemplate(t.Context(), tc.in)
+		assert.ErrorIs(t, err, ErrForbidden, name)
+	}
+}
+
+func (s *DeleteTemplateSuite) TestNotFound() {
+	t := s.T()
+	ctx := t.Context()
+
+	s.storage.GetTemplateMock.Return(nil, nil)
+
+	err := s.svc.DeleteTemplate(ctx, domain.DeleteTemplateInput{TemplateID: "t-1", OwnerUserID: 1})
+	assert.ErrorIs(t, err, ErrTemplateNotFound)
+}
+
+// TestDeletedConcurrently: the template vanished between the read and the
+// delete.
+func (s *DeleteTemplateSuite) TestDeletedConcurrently() {
+	t := s.T()
+	ctx := t.Context()
+
+	s.storage.GetTemplateMock.Return(&domain.VacancyTemplate{ID: "t-1", OwnerUserID: 1}, nil)
+	s.storage.DeleteTemplateMock.Expect(ctx, "t-1").Return(false, nil)
+
+	err := s.svc.DeleteTemplate(ctx, domain.DeleteTemplateInput{TemplateID: "t-1", OwnerUserID: 1})
+	assert.ErrorIs(t, err, ErrTemplateNotFound)
+}
+
+func (s *DeleteTemplateSuite) TestInvalidArgument() {
+	t := s.T()
+	cases := map[string]domain.DeleteTemplateInput{
+		"no owner":    {TemplateID: "t-1"},
+		"no template": {OwnerUserID: 1},
+	}
+	for name, in := range cases {
+		err := s.svc.DeleteTemplate(t.Context(), in)
+		assert.ErrorIs(t, err, ErrInvalidArgument, name)
+	}
+}
+
+func (s *DeleteTemplateSuite) TestStorageError() {
+	t := s.T()
+	ctx := t.Context()
+	storageErr := errors.New("pgx: connection refused")
+
+	s.storage.GetTemplateMock.Return(&domain.VacancyTemplate{ID: "t-1", OwnerUserID: 1}, nil)
+	s.storage.DeleteTemplateMock.Return(false, storageErr)
+
+	err := s.svc.DeleteTemplate(ctx, domain.DeleteTemplateInput{TemplateID: "t-1", OwnerUserID: 1})
+	assert.ErrorIs(t, err, storageErr)
+}
+
+func TestDeleteTemplateSuite(t *testing.T) { suite.Run(t, new(DeleteTemplateSuite)) }
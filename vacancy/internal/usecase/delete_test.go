@@ -0,0 +1,87 @@
+package usecase
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+	"gotest.tools/v3/assert"
+
+	"github.com/artem13815/hr/vacancy/internal/domain"
+)
+
+type DeleteVacancySuite struct{ baseSuite }
+
+func (s *DeleteVacancySuite) TestOwnerDeletes() {
+	t := s.T()
+	ctx := t.Context()
+	in := domain.DeleteVacancyInput{VacancyID: "v-1", OwnerUserID: 1}
+
+	s.storage.GetVacancyMock.Expect(ctx, "v-1", uint64(1), false).Return(&domain.Vacancy{ID: "v-1", OwnerUserID: 1}, nil)
+	s.storage.DeleteVacancyMock.Expect(ctx, in).Return(true, nil)
+
+	assert.NilError(t, s.svc.DeleteVacancy(ctx, in))
+}
+
+func (s *DeleteVacancySuite) TestAdminDeletesAnyVacancy() {
+	t := s.T()
+	ctx := t.Context()
+	in := domain.DeleteVacancyInput{VacancyID: "v-1", OwnerUserID: 9, IsAdmin: true}
+
+	s.storage.GetVacancyMock.Expect(ctx, "v-1", uint64(9), true).Return(&domain.Vacancy{ID: "v-1", OwnerUserID: 1}, nil)
+	s.storage.DeleteVacancyMock.Expect(ctx, in).Return(true, nil)
+
+	assert.NilError(t, s.svc.DeleteVacancy(ctx, in))
+}
+
+func (s *DeleteVacancySuite) TestCollaboratorForbidden() {
+	t := s.T()
+	ctx := t.Context()
+
+	s.storage.GetVacancyMock.Expect(ctx, "v-1", uint64(2), false).Return(&domain.Vacancy{ID: "v-1", OwnerUserID: 1}, nil)
+
+	err := s.svc.DeleteVacancy(ctx, domain.DeleteVacancyInput{VacancyID: "v-1", OwnerUserID: 2})
+	assert.ErrorIs(t, err, ErrForbidden)
+}
+
+func (s *DeleteVacancySuite) TestNotFound() {
+	t := s.T()
+	ctx := t.Context()
+
+	s.storage.GetVacancyMock.Expect(ctx, "v-1", uint64(1), false).Return(nil, nil)
+
+	err := s.svc.DeleteVacancy(ctx, domain.DeleteVacancyInput{VacancyID: "v-1", OwnerUserID: 1})
+	assert.ErrorIs(t, err, ErrVacancyNotFound)
+}
+
+// TestLostRace: the vacancy was deleted between the read and the write.
+func (s *DeleteVacancySuite) TestLostRace() {
+	t := s.T()
+	ctx := t.Context()
+	in := domain.DeleteVacancyInput{VacancyID: "v-1", OwnerUserID: 1}
+
+	s.storage.GetVacancyMock.Expect(ctx, "v-1", uint64(1), false).Return(&domain.Vacancy{ID: "v-1", OwnerUserID: 1}, nil)
+	s.storage.DeleteVacancyMock.Expect(ctx, in).Return(false, nil)
+
+	assert.ErrorIs(t, s.svc.DeleteVacancy(ctx, in), ErrVacancyNotFound)
+}
+
+func (s *DeleteVacancySuite) TestInvalidArgument() {
+	t := s.T()
+	err := s.svc.DeleteVacancy(t.Context(), domain.DeleteVacancyInput{OwnerUserID: 1})
+	assert.ErrorIs(t, err, ErrInvalidArgument)
+}
+
+func (s *DeleteVacancySuite) TestStorageError() {
+	t := s.T()
+	ctx := t.Context()
+	storageErr := errors.New("pgx: connection refused")
+	in := domain.DeleteVacancyInput{VacancyID: "v-1", OwnerUserID: 1}
+
+	s.storage.GetVacancyMock.Expect(ctx, "v-1", uint64(1), false).Return(&domain.Vacancy{ID: "v-1", OwnerUserID: 1}, nil)
+	s.storage.DeleteVacancyMock.Expect(ctx, in).Return(false, storageErr)
+
+	assert.ErrorIs(t, s.svc.DeleteVacancy(ctx, in), storageErr)
+}
+
+func TestDeleteVacancySuite(t *testing.T) { suite.Run(t, new(DeleteVacancySuite)) }
@@ -0,0 +1,84 @@
+package usecase
+
+import (
+	"context"
+	"strings"
+
+	"github.com/artem13815/hr/vacancy/internal/domain"
+)
+
+// DiffVacancyVersions loads two snapshots of the same vacancy and reports
+// what changed from FromVersion to ToVersion. Order is not enforced —
+// diffing "backwards" is a legitimate way to ask what a revert would do.
+func (s *VacancyService) DiffVacancyVersions(ctx context.Context, in domain.DiffVacancyVersionsInput) (*domain.VacancyVersionDiff, error) {
+	if in.OwnerUserID == 0 || in.VacancyID == "" || in.FromVersion == 0 || in.ToVersion == 0 {
+		return nil, ErrInvalidArgument
+	}
+
+	from, err := s.GetVacancyVersion(ctx, domain.GetVacancyVersionInput{
+		VacancyID:   in.VacancyID,
+		Version:     in.FromVersion,
+		OwnerUserID: in.OwnerUserID,
+		IsAdmin:     in.IsAdmin,
+	})
+	if err != nil {
+		return nil, err
+	}
+	to, err := s.GetVacancyVersion(ctx, domain.GetVacancyVersionInput{
+		VacancyID:   in.VacancyID,
+		Version:     in.ToVersion,
+		OwnerUserID: in.OwnerUserID,
+		IsAdmin:     in.IsAdmin,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return diffVersions(from, to), nil
+}
+
+// diffVersions is the pure comparison behind DiffVacancyVersions. Skills are
+// keyed by trimmed, lower-cased name — the same matching rule the analysis
+// scorer uses — and every output list keeps the position order of the
+// version it came from.
+func diffVersions(from, to *domain.VacancyVersion) *domain.VacancyVersionDiff {
+	diff := &domain.VacancyVersionDiff{
+		VacancyID:          to.VacancyID,
+		FromVersion:        from.Version,
+		ToVersion:          to.Version,
+		TitleChanged:       from.Title != to.Title,
+		DescriptionChanged: from.Description != to.Description,
+		RoleChanged:        from.Role != to.Role,
+		AddedSkills:        make([]domain.SkillWeight, 0),
+		RemovedSkills:      make([]domain.SkillWeight, 0),
+		ChangedSkills:      make([]domain.SkillChange, 0),
+	}
+
+	before := make(map[string]domain.SkillWeight, len(from.Skills))
+	for _, sk := range from.Skills {
+		before[skillKey(sk.Name)] = sk
+	}
+	after := make(map[string]struct{}, len(to.Skills))
+	for _, sk := range to.Skills {
+		key := skillKey(sk.Name)
+		after[key] = struct{}{}
+		old, ok := before[key]
+		switch {
+		case !ok:
+			diff.AddedSkills = append(diff.AddedSkills, sk)
+		case old.Weight != sk.Weight || old.MustHave != sk.MustHave || old.NiceToHave != sk.NiceToHave:
+			diff.ChangedSkills = append(diff.ChangedSkills, domain.SkillChange{Name: sk.Name, Before: old, After: sk})
+		}
+	}
+	for _, sk := range from.Skills {
+		if _, ok := after[skillKey(sk.Name)]; !ok {
+			diff.RemovedSkills = append(diff.RemovedSkills, sk)
+		}
+	}
+
+	return diff
+}
+
+func skillKey(name string) string {
+	return strings.ToLower(strings.TrimSpace(name))
+}
@@ -0,0 +1,104 @@
+package usecase
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+	"gotest.tools/v3/assert"
+
+	"github.com/artem13815/hr/vacancy/internal/domain"
+)
+
+type DiffVacancyVersionsSuite struct{ baseSuite }
+
+func (s *DiffVacancyVersionsSuite) TestSuccess() {
+	t := s.T()
+	ctx := t.Context()
+	from := &domain.VacancyVersion{
+		VacancyID: "v-1",
+		Version:   1,
+		Title:     "Backend",
+		Role:      "programmer",
+		Skills: []domain.SkillWeight{
+			{Name: "Go", Weight: 0.5, MustHave: true},
+			{Name: "SQL", Weight: 0.3},
+			{Name: "PHP", Weight: 0.2},
+		},
+	}
+	to := &domain.VacancyVersion{
+		VacancyID: "v-1",
+		Version:   3,
+		Title:     "Senior Backend",
+		Role:      "programmer",
+		Skills: []domain.SkillWeight{
+			{Name: "go", Weight: 0.6, MustHave: true},
+			{Name: "SQL", Weight: 0.3},
+			{Name: "Kubernetes", Weight: 0.1, NiceToHave: true},
+		},
+	}
+
+	s.storage.GetVacancyVersionMock.When(ctx, domain.GetVacancyVersionInput{VacancyID: "v-1", Version: 1, OwnerUserID: 7}).Then(from, nil)
+	s.storage.GetVacancyVersionMock.When(ctx, domain.GetVacancyVersionInput{VacancyID: "v-1", Version: 3, OwnerUserID: 7}).Then(to, nil)
+
+	got, err := s.svc.DiffVacancyVersions(ctx, domain.DiffVacancyVersionsInput{
+		VacancyID:   "v-1",
+		FromVersion: 1,
+		ToVersion:   3,
+		OwnerUserID: 7,
+	})
+	assert.NilError(t, err)
+	assert.DeepEqual(t, got, &domain.VacancyVersionDiff{
+		VacancyID:     "v-1",
+		FromVersion:   1,
+		ToVersion:     3,
+		TitleChanged:  true,
+		AddedSkills:   []domain.SkillWeight{{Name: "Kubernetes", Weight: 0.1, NiceToHave: true}},
+		RemovedSkills: []domain.SkillWeight{{Name: "PHP", Weight: 0.2}},
+		ChangedSkills: []domain.SkillChange{{
+			Name:   "go",
+			Before: domain.SkillWeight{Name: "Go", Weight: 0.5, MustHave: true},
+			After:  domain.SkillWeight{Name: "go", Weight: 0.6, MustHave: true},
+		}},
+	})
+}
+
+// TestMustHaveFlipIsAChange: flags matter as much as weights — flipping a
+// skill from nice-to-have to must-have changes how candidates are scored.
+func (s *DiffVacancyVersionsSuite) TestMustHaveFlipIsAChange() {
+	from := &domain.VacancyVersion{Version: 1, Skills: []domain.SkillWeight{{Name: "Go", Weight: 1, NiceToHave: true}}}
+	to := &domain.VacancyVersion{Version: 2, Skills: []domain.SkillWeight{{Name: "Go", Weight: 1, MustHave: true}}}
+
+	got := diffVersions(from, to)
+	assert.Equal(s.T(), len(got.ChangedSkills), 1)
+	assert.Equal(s.T(), len(got.AddedSkills), 0)
+	assert.Equal(s.T(), len(got.RemovedSkills), 0)
+}
+
+func (s *DiffVacancyVersionsSuite) TestMissingVersion() {
+	t := s.T()
+	ctx := t.Context()
+
+	s.storage.GetVacancyVersionMock.Expect(ctx, domain.GetVacancyVersionInput{VacancyID: "v-1", Version: 1, OwnerUserID: 7}).Return(nil, nil)
+
+	got, err := s.svc.DiffVacancyVersions(ctx, domain.DiffVacancyVersionsInput{
+		VacancyID:   "v-1",
+		FromVersion: 1,
+		ToVersion:   2,
+		OwnerUserID: 7,
+	})
+	assert.ErrorIs(t, err, ErrVersionNotFound)
+	assert.Assert(t, got == nil)
+}
+
+func (s *DiffVacancyVersionsSuite) TestInvalidArgument() {
+	t := s.T()
+	got, err := s.svc.DiffVacancyVersions(t.Context(), domain.DiffVacancyVersionsInput{
+		VacancyID:   "v-1",
+		FromVersion: 1,
+		OwnerUserID: 7,
+	})
+	assert.ErrorIs(t, err, ErrInvalidArgument)
+	assert.Assert(t, got == nil)
+}
+
+func TestDiffVacancyVersionsSuite(t *testing.T) { suite.Run(t, new(DiffVacancyVersionsSuite)) }
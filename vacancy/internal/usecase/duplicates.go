@@ -0,0 +1,87 @@
+package usecase
+
+import (
+	"cmp"
+	"context"
+	"slices"
+	"strings"
+
+	"github.com/artem13815/hr/vacancy/internal/domain"
+)
+
+// possibleDuplicates compares a vacancy about to be created with the
+// title-similar open vacancies storage finds among those the owner can view
+// and keeps the ones whose combined title and skill similarity reaches
+// domain.DuplicateThreshold, most similar first.
+func (s *VacancyService) possibleDuplicates(ctx context.Context, in domain.CreateVacancyInput) ([]domain.PossibleDuplicate, error) {
+	candidates, err := s.storage.ListDuplicateCandidates(ctx, normalizeTitle(in.Title), in.OwnerUserID, in.IsAdmin, domain.DuplicateCandidateLimit)
+	if err != nil {
+		return nil, err
+	}
+
+	names := make([]string, 0, len(in.Skills))
+	for _, skill := range in.Skills {
+		names = append(names, skill.Name)
+	}
+
+	var out []domain.PossibleDuplicate
+	for _, c := range candidates {
+		skillSimilarity := skillJaccard(names, c.Skills)
+		similarity := domain.DuplicateTitleWeight*c.TitleSimilarity + domain.DuplicateSkillWeight*skillSimilarity
+		if similarity < domain.DuplicateThreshold {
+			continue
+		}
+		out = append(out, domain.PossibleDuplicate{
+			VacancyID:       c.VacancyID,
+			Title:           c.Title,
+			OwnerUserID:     c.OwnerUserID,
+			TitleSimilarity: c.TitleSimilarity,
+			SkillSimilarity: skillSimilarity,
+			Similarity:      similarity,
+		})
+	}
+	slices.SortStableFunc(out, func(a, b domain.PossibleDuplicate) int {
+		return cmp.Compare(b.Similarity, a.Similarity)
+	})
+	if len(out) > domain.MaxPossibleDuplicates {
+		out = out[:domain.MaxPossibleDuplicates]
+	}
+	return out, nil
+}
+
+// normalizeTitle lower-cases the title and collapses whitespace, matching
+// the lower(title) the trigram index is built on.
+func normalizeTitle(title string) string {
+	return strings.Join(strings.Fields(strings.ToLower(title)), " ")
+}
+
+// skillJaccard is |a ∩ b| / |a ∪ b| over case-insensitive skill names.
+// Skills reach storage canonicalized by the taxonomy, so aliases of one
+// skill already share a name.
+func skillJaccard(a, b []string) float32 {
+	setA := skillNameSet(a)
+	setB := skillNameSet(b)
+	union := len(setA)
+	intersection := 0
+	for name := range setB {
+		if _, ok := setA[name]; ok {
+			intersection++
+		} else {
+			union++
+		}
+	}
+	if union == 0 {
+		return 0
+	}
+	return float32(intersection) / float32(union)
+}
+
+func skillNameSet(names []string) map[string]struct{} {
+	set := make(map[string]struct{}, len(names))
+	for _, name := range names {
+		if name = strings.ToLower(strings.TrimSpace(name)); name != "" {
+			set[name] = struct{}{}
+		}
+	}
+	return set
+}
@@ -1,11 +1,43 @@
 package usecase
 
-import "errors"
+import (
+	"errors"
+
+	"github.com/artem13815/hr/vacancy/internal/domain"
+)
 
 var (
-	ErrInvalidArgument = errors.New("invalid argument")
-	ErrUnauthorized    = errors.New("unauthorized")
-	ErrVacancyNotFound = errors.New("vacancy not found")
+	ErrInvalidArgument  = errors.New("invalid argument")
+	ErrUnauthorized     = errors.New("unauthorized")
+	ErrVacancyNotFound  = errors.New("vacancy not found")
+	ErrVersionNotFound  = errors.New("vacancy version not found")
+	ErrTemplateNotFound = errors.New("vacancy template not found")
+	// ErrCollaboratorNotFound is returned when revoking a grant the vacancy
+	// does not have.
+	ErrCollaboratorNotFound = errors.New("collaborator not found")
+	// ErrForbidden means the caller can see the vacancy but the operation
+	// is reserved for its owner (or an admin), e.g. managing collaborators.
+	ErrForbidden = errors.New("forbidden")
+	// ErrInvalidTransition means the lifecycle state machine does not allow
+	// the requested status change from the vacancy's current status.
+	ErrInvalidTransition = errors.New("invalid status transition")
+	// ErrCandidateNotFound means the candidate does not exist or belongs to
+	// another vacancy.
+	ErrCandidateNotFound = errors.New("candidate not found")
+	// ErrInvalidStageTransition means a candidate cannot make the requested
+	// pipeline move: it is already there, it was hired, or the vacancy is
+	// not accepting the move.
+	ErrInvalidStageTransition = errors.New("invalid stage transition")
+	// ErrStageInUse aliases the domain sentinel storage returns when a
+	// pipeline change would drop a stage that candidates are in.
+	ErrStageInUse = domain.ErrStageInUse
+	// ErrVersionConflict aliases the domain sentinel so transport can match
+	// it without importing domain for error handling. Storage wraps it in a
+	// *domain.VersionConflictError carrying the current version.
+	ErrVersionConflict = domain.ErrVersionConflict
+	// ErrPossibleDuplicates aliases the domain sentinel CreateVacancy wraps
+	// in a *domain.PossibleDuplicatesError when it refuses to create.
+	ErrPossibleDuplicates = domain.ErrPossibleDuplicates
 	// ErrLLMUnavailable is the soft-failure signal the RoleClassifier adapter
 	// returns when multiagent is down or rate-limited. resolveRole catches
 	// it and falls back to the deterministic keyword detector — vacancy CRUD
@@ -0,0 +1,83 @@
+package usecase
+
+//go:generate go run github.com/gojuno/minimock/v3/cmd/minimock@v3.4.7 -i EventStorage,EventNotifier -o ./mocks -s _mock.go -g
+
+import (
+	"context"
+	"time"
+
+	"github.com/artem13815/hr/vacancy/internal/domain"
+)
+
+// eventBatchSize is how many outbox rows one read fetches. A subscriber far
+// behind catches up in batches of this size without waiting for
+// notifications in between.
+const eventBatchSize = 100
+
+// eventPollInterval is the safety net under LISTEN/NOTIFY: a notification
+// lost while the listener reconnects delays delivery by at most this long.
+const eventPollInterval = 30 * time.Second
+
+// EventStorage reads the vacancy outbox. Implemented by
+// internal/infrastructure/persistence.
+type EventStorage interface {
+	ListVacancyEvents(ctx context.Context, afterSeq uint64, limit int) ([]domain.VacancyEvent, error)
+}
+
+// EventNotifier signals that new outbox events may have been committed.
+// Subscribe returns a channel that receives after such a commit and a
+// function releasing it. Signals are coalesced: one receive can stand for
+// any number of commits.
+type EventNotifier interface {
+	Subscribe() (<-chan struct{}, func())
+}
+
+// EventRelay delivers outbox events to one subscriber at a time.
+type EventRelay struct {
+	storage  EventStorage
+	notifier EventNotifier
+}
+
+func NewEventRelay(storage EventStorage, notifier EventNotifier) *EventRelay {
+	return &EventRelay{storage: storage, notifier: notifier}
+}
+
+// StreamEvents sends every event after afterSeq to send, in seq order, then
+// waits for new ones until ctx is done or send fails. The outbox is the
+// source of truth and notifications only wake the reader, so a subscriber
+// that reconnects with the seq of the last event it processed misses
+// nothing; it may see that event's successors twice, which is why
+// consumers deduplicate by event ID.
+func (r *EventRelay) StreamEvents(ctx context.Context, afterSeq uint64, send func(domain.VacancyEvent) error) error {
+	// Subscribe before the first read so a commit landing between the read
+	// and the wait still wakes us.
+	wake, unsubscribe := r.notifier.Subscribe()
+	defer unsubscribe()
+
+	poll := time.NewTicker(eventPollInterval)
+	defer poll.Stop()
+
+	cursor := afterSeq
+	for {
+		events, err := r.storage.ListVacancyEvents(ctx, cursor, eventBatchSize)
+		if err != nil {
+			return err
+		}
+		for _, ev := range events {
+			if err := send(ev); err != nil {
+				return err
+			}
+			cursor = ev.Seq
+		}
+		if len(events) == eventBatchSize {
+			continue
+		}
+
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-wake:
+		case <-poll.C:
+		}
+	}
+}
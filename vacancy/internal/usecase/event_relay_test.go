@@ -0,0 +1,119 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+	"gotest.tools/v3/assert"
+
+	"github.com/artem13815/hr/vacancy/internal/domain"
+	"github.com/artem13815/hr/vacancy/internal/usecase/mocks"
+)
+
+type EventRelaySuite struct {
+	suite.Suite
+	storage  *mocks.EventStorageMock
+	notifier *mocks.EventNotifierMock
+	relay    *EventRelay
+	wake     chan struct{}
+	released bool
+}
+
+func (s *EventRelaySuite) SetupTest() {
+	t := s.T()
+	s.storage = mocks.NewEventStorageMock(t)
+	s.notifier = mocks.NewEventNotifierMock(t)
+	s.relay = NewEventRelay(s.storage, s.notifier)
+	s.wake = make(chan struct{}, 1)
+	s.released = false
+	s.notifier.SubscribeMock.Return(s.wake, func() { s.released = true })
+}
+
+func eventsFrom(first, n uint64) []domain.VacancyEvent {
+	out := make([]domain.VacancyEvent, 0, n)
+	for seq := first; seq < first+n; seq++ {
+		out = append(out, domain.VacancyEvent{Seq: seq, ID: "e", Type: domain.EventVacancyUpdated})
+	}
+	return out
+}
+
+// TestCatchesUpInBatches: a full batch is followed by the next read right
+// away, each read resuming after the last event sent.
+func (s *EventRelaySuite) TestCatchesUpInBatches() {
+	t := s.T()
+	ctx, cancel := context.WithCancel(t.Context())
+	defer cancel()
+
+	s.storage.ListVacancyEventsMock.When(ctx, 7, eventBatchSize).Then(eventsFrom(8, eventBatchSize), nil)
+	s.storage.ListVacancyEventsMock.When(ctx, 107, eventBatchSize).Then(eventsFrom(108, 2), nil)
+
+	var sent []uint64
+	err := s.relay.StreamEvents(ctx, 7, func(ev domain.VacancyEvent) error {
+		sent = append(sent, ev.Seq)
+		if ev.Seq == 109 {
+			cancel()
+		}
+		return nil
+	})
+	assert.ErrorIs(t, err, context.Canceled)
+	assert.Equal(t, len(sent), eventBatchSize+2)
+	assert.Equal(t, sent[0], uint64(8))
+	assert.Equal(t, sent[len(sent)-1], uint64(109))
+	assert.Assert(t, s.released, "the notifier subscription must be released")
+}
+
+// TestWakesOnNotification: with nothing new the relay waits, and a
+// notification makes it read again.
+func (s *EventRelaySuite) TestWakesOnNotification() {
+	t := s.T()
+	ctx, cancel := context.WithCancel(t.Context())
+	defer cancel()
+
+	reads := 0
+	s.storage.ListVacancyEventsMock.Set(func(_ context.Context, afterSeq uint64, _ int) ([]domain.VacancyEvent, error) {
+		reads++
+		assert.Equal(t, afterSeq, uint64(0))
+		if reads == 1 {
+			s.wake <- struct{}{}
+			return nil, nil
+		}
+		return eventsFrom(1, 1), nil
+	})
+
+	err := s.relay.StreamEvents(ctx, 0, func(ev domain.VacancyEvent) error {
+		cancel()
+		return nil
+	})
+	assert.ErrorIs(t, err, context.Canceled)
+	assert.Equal(t, reads, 2)
+}
+
+// TestSendErrorStops: a subscriber that went away ends the stream with its
+// error; nothing is read past it.
+func (s *EventRelaySuite) TestSendErrorStops() {
+	t := s.T()
+	sendErr := errors.New("stream closed")
+	s.storage.ListVacancyEventsMock.Return(eventsFrom(1, 3), nil)
+
+	calls := 0
+	err := s.relay.StreamEvents(t.Context(), 0, func(domain.VacancyEvent) error {
+		calls++
+		return sendErr
+	})
+	assert.ErrorIs(t, err, sendErr)
+	assert.Equal(t, calls, 1)
+}
+
+func (s *EventRelaySuite) TestStorageError() {
+	t := s.T()
+	storageErr := errors.New("pgx: connection refused")
+	s.storage.ListVacancyEventsMock.Return(nil, storageErr)
+
+	err := s.relay.StreamEvents(t.Context(), 0, func(domain.VacancyEvent) error { return nil })
+	assert.ErrorIs(t, err, storageErr)
+	assert.Assert(t, s.released)
+}
+
+func TestEventRelaySuite(t *testing.T) { suite.Run(t, new(EventRelaySuite)) }
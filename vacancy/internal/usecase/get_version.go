@@ -0,0 +1,23 @@
+package usecase
+
+import (
+	"context"
+
+	"github.com/artem13815/hr/vacancy/internal/domain"
+)
+
+func (s *VacancyService) GetVacancyVersion(ctx context.Context, in domain.GetVacancyVersionInput) (*domain.VacancyVersion, error) {
+	if in.OwnerUserID == 0 || in.VacancyID == "" || in.Version == 0 {
+		return nil, ErrInvalidArgument
+	}
+
+	version, err := s.storage.GetVacancyVersion(ctx, in)
+	if err != nil {
+		return nil, err
+	}
+	if version == nil {
+		return nil, ErrVersionNotFound
+	}
+
+	return version, nil
+}
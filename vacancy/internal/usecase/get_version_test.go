@@ -0,0 +1,60 @@
+package usecase
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+	"gotest.tools/v3/assert"
+
+	"github.com/artem13815/hr/vacancy/internal/domain"
+)
+
+type GetVacancyVersionSuite struct{ baseSuite }
+
+func (s *GetVacancyVersionSuite) TestSuccess() {
+	t := s.T()
+	ctx := t.Context()
+	in := domain.GetVacancyVersionInput{VacancyID: "v-1", Version: 2, OwnerUserID: 7}
+	want := &domain.VacancyVersion{VacancyID: "v-1", Version: 2, Skills: []domain.SkillWeight{{Name: "Go", Weight: 1}}}
+
+	s.storage.GetVacancyVersionMock.Expect(ctx, in).Return(want, nil)
+
+	got, err := s.svc.GetVacancyVersion(ctx, in)
+	assert.NilError(t, err)
+	assert.DeepEqual(t, got, want)
+}
+
+func (s *GetVacancyVersionSuite) TestNotFound() {
+	t := s.T()
+	ctx := t.Context()
+	in := domain.GetVacancyVersionInput{VacancyID: "v-1", Version: 9, OwnerUserID: 7}
+
+	s.storage.GetVacancyVersionMock.Expect(ctx, in).Return(nil, nil)
+
+	got, err := s.svc.GetVacancyVersion(ctx, in)
+	assert.ErrorIs(t, err, ErrVersionNotFound)
+	assert.Assert(t, got == nil)
+}
+
+func (s *GetVacancyVersionSuite) TestInvalidArgumentZeroVersion() {
+	t := s.T()
+	got, err := s.svc.GetVacancyVersion(t.Context(), domain.GetVacancyVersionInput{VacancyID: "v-1", OwnerUserID: 7})
+	assert.ErrorIs(t, err, ErrInvalidArgument)
+	assert.Assert(t, got == nil)
+}
+
+func (s *GetVacancyVersionSuite) TestStorageError() {
+	t := s.T()
+	ctx := t.Context()
+	in := domain.GetVacancyVersionInput{VacancyID: "v-1", Version: 1, OwnerUserID: 7}
+	storageErr := errors.New("pgx: connection refused")
+
+	s.storage.GetVacancyVersionMock.Expect(ctx, in).Return(nil, storageErr)
+
+	got, err := s.svc.GetVacancyVersion(ctx, in)
+	assert.ErrorIs(t, err, storageErr)
+	assert.Assert(t, got == nil)
+}
+
+func TestGetVacancyVersionSuite(t *testing.T) { suite.Run(t, new(GetVacancyVersionSuite)) }
@@ -0,0 +1,153 @@
+package usecase
+
+import (
+	"cmp"
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+	"slices"
+	"strings"
+
+	"github.com/artem13815/hr/vacancy/internal/domain"
+)
+
+// Per-call caps of ImportVacancies. MaxImportBytes stays under gRPC's
+// default 4 MiB receive limit; MaxImportRows bounds the size of the
+// all-or-nothing transaction and of the per-row report. Exported so the API
+// layer can fail fast before the service is called. Larger migrations are
+// split into several files.
+const (
+	MaxImportBytes = 3 << 20
+	MaxImportRows  = 500
+)
+
+// ImportVacancies creates vacancies in bulk from a CSV, JSON-lines or hh.ru
+// export. Every row goes through the same path as CreateVacancy —
+// normalizeSkills, validateCreateInput, default status, pinned role — and
+// gets its own result, so a dry run shows exactly what would be stored.
+//
+// A role set in the row is pinned like CreateVacancy pins it; the vocabulary
+// is fetched once per import. Rows without one get the keyword DetectRole
+// role, not the LLM: one classifier call per row would keep the RPC open far
+// past any gateway timeout. The role reconciler upgrades those roles later.
+//
+// Commit modes:
+//   - all-or-nothing (default): nothing is written unless every row is
+//     valid; then all rows go in one transaction.
+//   - best-effort: valid rows are written one by one; invalid and failed
+//     rows are reported.
+//
+// Whole-file problems (unknown format, unreadable CSV header, too many rows)
+// return ErrInvalidArgument; row problems never fail the call.
+func (s *VacancyService) ImportVacancies(ctx context.Context, in domain.ImportVacanciesInput) (*domain.ImportVacanciesResult, error) {
+	if in.OwnerUserID == 0 {
+		return nil, ErrUnauthorized
+	}
+	if len(in.Data) == 0 || len(in.Data) > MaxImportBytes {
+		return nil, ErrInvalidArgument
+	}
+	in.Mode = cmp.Or(in.Mode, domain.ImportModeAllOrNothing)
+	if in.Mode != domain.ImportModeAllOrNothing && in.Mode != domain.ImportModeBestEffort {
+		return nil, ErrInvalidArgument
+	}
+
+	rows, err := parseImport(in.Format, in.Data)
+	if err != nil {
+		return nil, err
+	}
+	if len(rows) == 0 {
+		return nil, fmt.Errorf("%w: no rows to import", ErrInvalidArgument)
+	}
+	if len(rows) > MaxImportRows {
+		return nil, fmt.Errorf("%w: %d rows, at most %d per import", ErrInvalidArgument, len(rows), MaxImportRows)
+	}
+
+	var vocabulary []string
+	if slices.ContainsFunc(rows, func(r importRow) bool { return r.in.Role != "" }) {
+		vocabulary = s.ListRoles(ctx)
+	}
+
+	res := &domain.ImportVacanciesResult{Rows: make([]domain.ImportRowResult, len(rows))}
+	inputs := make([]domain.CreateVacancyInput, len(rows))
+	for i, r := range rows {
+		r.in.OwnerUserID = in.OwnerUserID
+		r.in.Skills = normalizeSkills(r.in.Skills, s.taxonomy)
+		r.in.Attributes = normalizeAttributes(r.in.Attributes)
+		if r.err == nil {
+			r.err = validateCreateInput(r.in)
+		}
+		if r.err == nil {
+			if r.in.Role != "" {
+				r.in.Role, r.err = pinRole(r.in.Role, vocabulary)
+				r.in.RoleSource, r.in.RoleLocked = domain.RoleSourceManual, true
+			} else {
+				r.in.Role, r.in.RoleSource = DetectRole(r.in.Title, r.in.Description), domain.RoleSourceKeyword
+			}
+		}
+		r.in.Status = cmp.Or(r.in.Status, domain.StatusOpen)
+		inputs[i] = r.in
+
+		res.Rows[i] = domain.ImportRowResult{
+			Row:    r.row,
+			Title:  r.in.Title,
+			Status: r.in.Status,
+			Skills: r.in.Skills,
+		}
+		if r.err != nil {
+			res.Rows[i].Error = importRowError(r.err)
+			res.Failed++
+			continue
+		}
+		res.Rows[i].Role = r.in.Role
+	}
+
+	// An all-or-nothing import with a bad row is going nowhere.
+	if in.Mode == domain.ImportModeAllOrNothing && res.Failed > 0 {
+		return res, nil
+	}
+	if in.DryRun {
+		return res, nil
+	}
+
+	if in.Mode == domain.ImportModeAllOrNothing {
+		created, err := s.storage.CreateVacancies(ctx, inputs)
+		if err != nil {
+			return nil, err
+		}
+		for i, v := range created {
+			res.Rows[i].Vacancy = v
+		}
+		res.Created = len(created)
+		res.Committed = true
+		return res, nil
+	}
+
+	for i := range rows {
+		if res.Rows[i].Error != "" {
+			continue
+		}
+		v, err := s.storage.CreateVacancy(ctx, inputs[i])
+		if err != nil {
+			slog.ErrorContext(ctx, "import vacancy row", "row", res.Rows[i].Row, "err", err)
+			res.Rows[i].Error = "failed to store vacancy"
+			res.Failed++
+			continue
+		}
+		res.Rows[i].Vacancy = v
+		res.Created++
+	}
+	res.Committed = res.Created > 0
+	return res, nil
+}
+
+// importRowError turns a validation error into the per-row message: the
+// reason without the sentinel prefix.
+func importRowError(err error) string {
+	if errors.Is(err, ErrInvalidArgument) {
+		if msg := strings.TrimPrefix(err.Error(), ErrInvalidArgument.Error()+": "); msg != err.Error() {
+			return msg
+		}
+	}
+	return err.Error()
+}
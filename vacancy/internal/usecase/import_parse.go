@@ -0,0 +1,362 @@
+package usecase
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/csv"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"html"
+	"io"
+	"regexp"
+	"strconv"
+	"strings"
+
+	"github.com/artem13815/hr/vacancy/internal/domain"
+)
+
+// importRow is one source row before validation. err is a row-level parse
+// error (bad JSON line, bad skill weight); file-level problems such as a
+// missing CSV title column fail the whole call instead.
+type importRow struct {
+	row int
+	in  domain.CreateVacancyInput
+	err error
+}
+
+var utf8BOM = []byte("\xef\xbb\xbf")
+
+func parseImport(format string, data []byte) ([]importRow, error) {
+	data = bytes.TrimPrefix(data, utf8BOM)
+	switch format {
+	case domain.ImportFormatCSV:
+		return parseImportCSV(data)
+	case domain.ImportFormatJSONLines:
+		return parseImportJSONLines(data)
+	case domain.ImportFormatHH:
+		return parseImportHH(data)
+	default:
+		return nil, fmt.Errorf("%w: unknown import format %q", ErrInvalidArgument, format)
+	}
+}
+
+// csvColumns maps accepted header names (normalized like skill keys) to
+// fields. Unknown columns are ignored — ATS exports carry plenty of them.
+var csvColumns = map[string]string{
+	"title":       "title",
+	"name":        "title",
+	"название":    "title",
+	"должность":   "title",
+	"description": "description",
+	"описание":    "description",
+	"status":      "status",
+	"статус":      "status",
+	"skills":      "skills",
+	"навыки":      "skills",
+	"role":        "role",
+	"роль":        "role",
+}
+
+// parseImportCSV reads a header row and one vacancy per record. Only title
+// is required. skills is a ';'-separated list, see parseSkillList. The
+// delimiter is ',' or ';' (what Excel writes in the Russian locale),
+// detected from the header line.
+func parseImportCSV(data []byte) ([]importRow, error) {
+	r := csv.NewReader(bytes.NewReader(data))
+	r.Comma = detectCSVDelimiter(data)
+	r.FieldsPerRecord = -1
+
+	header, err := r.Read()
+	if err != nil {
+		return nil, fmt.Errorf("%w: read csv header: %v", ErrInvalidArgument, err)
+	}
+	cols := make(map[string]int, len(csvColumns))
+	for i, h := range header {
+		field, ok := csvColumns[domain.NormalizeSkillKey(h)]
+		if !ok {
+			continue
+		}
+		if _, dup := cols[field]; !dup {
+			cols[field] = i
+		}
+	}
+	if _, ok := cols["title"]; !ok {
+		return nil, fmt.Errorf("%w: csv header has no title column", ErrInvalidArgument)
+	}
+
+	var rows []importRow
+	for n := 2; ; n++ {
+		rec, err := r.Read()
+		if errors.Is(err, io.EOF) {
+			break
+		}
+		if err != nil {
+			return nil, fmt.Errorf("%w: %v", ErrInvalidArgument, err)
+		}
+		field := func(name string) string {
+			i, ok := cols[name]
+			if !ok || i >= len(rec) {
+				return ""
+			}
+			return strings.TrimSpace(rec[i])
+		}
+		if strings.TrimSpace(strings.Join(rec, "")) == "" {
+			continue
+		}
+
+		row := importRow{row: n, in: domain.CreateVacancyInput{
+			Title:       field("title"),
+			Description: field("description"),
+			Status:      strings.ToLower(field("status")),
+			Role:        field("role"),
+		}}
+		row.in.Skills, row.err = parseSkillList(field("skills"))
+		rows = append(rows, row)
+	}
+	return rows, nil
+}
+
+func detectCSVDelimiter(data []byte) rune {
+	header, _, _ := bytes.Cut(data, []byte("\n"))
+	if bytes.Count(header, []byte(";")) > bytes.Count(header, []byte(",")) {
+		return ';'
+	}
+	return ','
+}
+
+// parseSkillList reads "Go:0.8:must; PostgreSQL:0.5; Kubernetes:nice".
+// After the name come optional attributes in any order: a weight ("0.5" or
+// "0,5") and "must" / "nice" (or "обязательно" / "желательно").
+func parseSkillList(s string) ([]domain.SkillWeight, error) {
+	var out []domain.SkillWeight
+	for _, entry := range strings.Split(s, ";") {
+		if strings.TrimSpace(entry) == "" {
+			continue
+		}
+		parts := strings.Split(entry, ":")
+		skill := domain.SkillWeight{Name: strings.TrimSpace(parts[0])}
+		for _, attr := range parts[1:] {
+			attr = strings.ToLower(strings.TrimSpace(attr))
+			switch attr {
+			case "must", "must_have", "обязательно":
+				skill.MustHave = true
+			case "nice", "nice_to_have", "желательно":
+				skill.NiceToHave = true
+			default:
+				w, err := strconv.ParseFloat(strings.Replace(attr, ",", ".", 1), 32)
+				if err != nil {
+					return nil, fmt.Errorf("%w: skill %q: unknown attribute %q", ErrInvalidArgument, skill.Name, attr)
+				}
+				skill.Weight = float32(w)
+			}
+		}
+		out = append(out, skill)
+	}
+	return out, nil
+}
+
+// importJSONRow is one JSON-lines record: the CreateVacancyRequest shape
+// with snake_case keys, plus an optional status ("draft" / "open") as an
+// alternative to draft. Attribute enums use the stored values ("hybrid",
+// "full_time", "senior"). Unknown keys are ignored.
+type importJSONRow struct {
+	Title       string `json:"title"`
+	Description string `json:"description"`
+	Status      string `json:"status"`
+	Role        string `json:"role"`
+	Draft       bool   `json:"draft"`
+	Skills      []struct {
+		Name       string  `json:"name"`
+		Weight     float32 `json:"weight"`
+		MustHave   bool    `json:"must_have"`
+		NiceToHave bool    `json:"nice_to_have"`
+	} `json:"skills"`
+	Attributes struct {
+		Location       string `json:"location"`
+		RemotePolicy   string `json:"remote_policy"`
+		SalaryMin      uint64 `json:"salary_min"`
+		SalaryMax      uint64 `json:"salary_max"`
+		SalaryCurrency string `json:"salary_currency"`
+		EmploymentType string `json:"employment_type"`
+		Seniority      string `json:"seniority"`
+		Headcount      uint32 `json:"headcount"`
+	} `json:"attributes"`
+}
+
+func parseImportJSONLines(data []byte) ([]importRow, error) {
+	sc := bufio.NewScanner(bytes.NewReader(data))
+	sc.Buffer(make([]byte, 0, 64*1024), MaxImportBytes)
+
+	var rows []importRow
+	for n := 1; sc.Scan(); n++ {
+		line := bytes.TrimSpace(sc.Bytes())
+		if len(line) == 0 {
+			continue
+		}
+		var rec importJSONRow
+		if err := json.Unmarshal(line, &rec); err != nil {
+			rows = append(rows, importRow{row: n, err: fmt.Errorf("%w: malformed json: %v", ErrInvalidArgument, err)})
+			continue
+		}
+		in := domain.CreateVacancyInput{
+			Title:       strings.TrimSpace(rec.Title),
+			Description: strings.TrimSpace(rec.Description),
+			Status:      strings.ToLower(strings.TrimSpace(rec.Status)),
+			Role:        strings.TrimSpace(rec.Role),
+			Attributes:  domain.VacancyAttributes(rec.Attributes),
+		}
+		if rec.Draft && in.Status == "" {
+			in.Status = domain.StatusDraft
+		}
+		for _, s := range rec.Skills {
+			in.Skills = append(in.Skills, domain.SkillWeight{
+				Name:       s.Name,
+				Weight:     s.Weight,
+				MustHave:   s.MustHave,
+				NiceToHave: s.NiceToHave,
+			})
+		}
+		rows = append(rows, importRow{row: n, in: in})
+	}
+	if err := sc.Err(); err != nil {
+		return nil, fmt.Errorf("%w: %v", ErrInvalidArgument, err)
+	}
+	return rows, nil
+}
+
+// hhVacancy is the subset of the hh.ru vacancy object (GET /vacancies/{id})
+// we carry over. Search pages list vacancies without description and
+// key_skills, so their rows fail validation — export full vacancies.
+type hhVacancy struct {
+	Name        string `json:"name"`
+	Description string `json:"description"`
+	KeySkills   []struct {
+		Name string `json:"name"`
+	} `json:"key_skills"`
+	Archived bool `json:"archived"`
+	Area     struct {
+		Name string `json:"name"`
+	} `json:"area"`
+	Salary *struct {
+		From     *uint64 `json:"from"`
+		To       *uint64 `json:"to"`
+		Currency string  `json:"currency"`
+	} `json:"salary"`
+	Schedule struct {
+		ID string `json:"id"`
+	} `json:"schedule"`
+	Employment struct {
+		ID string `json:"id"`
+	} `json:"employment"`
+}
+
+// hhEmploymentTypes maps hh.ru employment ids; "volunteer" has no
+// counterpart and is left unspecified.
+var hhEmploymentTypes = map[string]string{
+	"full":      domain.EmploymentFullTime,
+	"part":      domain.EmploymentPartTime,
+	"project":   domain.EmploymentContract,
+	"probation": domain.EmploymentInternship,
+}
+
+// hhAttributes carries over what hh.ru states explicitly. Only the
+// "remote" schedule says anything about the remote policy; hh.ru still
+// reports roubles under the pre-ISO code RUR.
+func hhAttributes(v hhVacancy) domain.VacancyAttributes {
+	a := domain.VacancyAttributes{
+		Location:       strings.TrimSpace(v.Area.Name),
+		EmploymentType: hhEmploymentTypes[v.Employment.ID],
+	}
+	if v.Schedule.ID == "remote" {
+		a.RemotePolicy = domain.RemoteFull
+	}
+	if s := v.Salary; s != nil && (s.From != nil || s.To != nil) {
+		if s.From != nil {
+			a.SalaryMin = *s.From
+		}
+		if s.To != nil {
+			a.SalaryMax = *s.To
+		}
+		a.SalaryCurrency = s.Currency
+		if strings.EqualFold(a.SalaryCurrency, "RUR") {
+			a.SalaryCurrency = "RUB"
+		}
+	}
+	return a
+}
+
+// parseImportHH accepts a single vacancy object, an array of them or a
+// search page {"items": [...]}. key_skills carry no weights or flags, so
+// every skill comes in with weight 0 and normalizeSkills spreads them
+// evenly. Archived hh.ru vacancies land as drafts rather than being
+// published again.
+func parseImportHH(data []byte) ([]importRow, error) {
+	data = bytes.TrimSpace(data)
+	var items []json.RawMessage
+	switch {
+	case bytes.HasPrefix(data, []byte("[")):
+		if err := json.Unmarshal(data, &items); err != nil {
+			return nil, fmt.Errorf("%w: malformed hh.ru json: %v", ErrInvalidArgument, err)
+		}
+	case bytes.HasPrefix(data, []byte("{")):
+		var page struct {
+			Items []json.RawMessage `json:"items"`
+		}
+		if err := json.Unmarshal(data, &page); err != nil {
+			return nil, fmt.Errorf("%w: malformed hh.ru json: %v", ErrInvalidArgument, err)
+		}
+		items = page.Items
+		if items == nil {
+			items = []json.RawMessage{data}
+		}
+	default:
+		return nil, fmt.Errorf("%w: hh.ru export must be a json object or array", ErrInvalidArgument)
+	}
+
+	rows := make([]importRow, 0, len(items))
+	for i, raw := range items {
+		var v hhVacancy
+		if err := json.Unmarshal(raw, &v); err != nil {
+			rows = append(rows, importRow{row: i + 1, err: fmt.Errorf("%w: malformed hh.ru vacancy: %v", ErrInvalidArgument, err)})
+			continue
+		}
+		in := domain.CreateVacancyInput{
+			Title:       strings.TrimSpace(v.Name),
+			Description: htmlToText(v.Description),
+			Attributes:  hhAttributes(v),
+		}
+		if v.Archived {
+			in.Status = domain.StatusDraft
+		}
+		for _, ks := range v.KeySkills {
+			in.Skills = append(in.Skills, domain.SkillWeight{Name: ks.Name})
+		}
+		rows = append(rows, importRow{row: i + 1, in: in})
+	}
+	return rows, nil
+}
+
+var (
+	htmlBreakRe  = regexp.MustCompile(`(?i)<\s*(br|/p|/li|/div|/h[1-6]|/ul|/ol)\s*/?>`)
+	htmlItemRe   = regexp.MustCompile(`(?i)<\s*li(\s[^>]*)?>`)
+	htmlTagRe    = regexp.MustCompile(`<[^>]*>`)
+	blankLinesRe = regexp.MustCompile(`\n{3,}`)
+)
+
+// htmlToText flattens the hh.ru description markup (p, ul/li, strong, br)
+// into the plain text vacancies store: block ends become line breaks, list
+// items become "- " lines, entities are decoded.
+func htmlToText(s string) string {
+	s = htmlBreakRe.ReplaceAllString(s, "\n")
+	s = htmlItemRe.ReplaceAllString(s, "- ")
+	s = htmlTagRe.ReplaceAllString(s, "")
+	s = html.UnescapeString(s)
+
+	lines := strings.Split(s, "\n")
+	for i, line := range lines {
+		lines[i] = strings.TrimSpace(line)
+	}
+	s = blankLinesRe.ReplaceAllString(strings.Join(lines, "\n"), "\n\n")
+	return strings.TrimSpace(s)
+}
@@ -0,0 +1,136 @@
+package usecase
+
+import (
+	"testing"
+
+	"gotest.tools/v3/assert"
+
+	"github.com/artem13815/hr/vacancy/internal/domain"
+)
+
+// Parser tests pin the source → CreateVacancyInput mapping per format.
+// Validation and normalization are covered by ImportVacanciesSuite.
+
+func TestParseImportCSVSemicolonAndRussianHeader(t *testing.T) {
+	t.Parallel()
+	data := "\xef\xbb\xbfНазвание;Описание;Навыки;Зарплата\n" +
+		"\"Go developer\";\"Строка 1\nСтрока 2\";\"Go:0,7:обязательно; Docker:желательно\";300000\n" +
+		";;;\n" +
+		"Аналитик;;SQL\n"
+
+	rows, err := parseImport(domain.ImportFormatCSV, []byte(data))
+	assert.NilError(t, err)
+	assertImportRows(t, rows, []importRow{
+		{row: 2, in: domain.CreateVacancyInput{
+			Title:       "Go developer",
+			Description: "Строка 1\nСтрока 2",
+			Skills: []domain.SkillWeight{
+				{Name: "Go", Weight: 0.7, MustHave: true},
+				{Name: "Docker", NiceToHave: true},
+			},
+		}},
+		{row: 4, in: domain.CreateVacancyInput{
+			Title:  "Аналитик",
+			Skills: []domain.SkillWeight{{Name: "SQL"}},
+		}},
+	})
+}
+
+func TestParseImportJSONLines(t *testing.T) {
+	t.Parallel()
+	data := `{"title": "Go developer", "status": "Draft", "skills": [{"name": "Go", "weight": 0.5, "must_have": true}], "extra": 1}
+
+{"title": broken}
+{"title": "QA", "draft": true, "skills": [{"name": "QA"}]}
+`
+	rows, err := parseImport(domain.ImportFormatJSONLines, []byte(data))
+	assert.NilError(t, err)
+	assert.Equal(t, len(rows), 3)
+	assert.DeepEqual(t, rows[0].in, domain.CreateVacancyInput{
+		Title:  "Go developer",
+		Status: domain.StatusDraft,
+		Skills: []domain.SkillWeight{{Name: "Go", Weight: 0.5, MustHave: true}},
+	})
+	assert.Equal(t, rows[1].row, 3)
+	assert.ErrorIs(t, rows[1].err, ErrInvalidArgument)
+	assert.Equal(t, rows[2].in.Status, domain.StatusDraft)
+}
+
+func TestParseImportJSONLinesAttributes(t *testing.T) {
+	t.Parallel()
+	data := `{"title": "Go developer", "attributes": {"location": "Москва", "remote_policy": "hybrid", "salary_min": 200000, "salary_currency": "RUB", "seniority": "senior", "headcount": 2}}`
+	rows, err := parseImport(domain.ImportFormatJSONLines, []byte(data))
+	assert.NilError(t, err)
+	assert.Equal(t, len(rows), 1)
+	assert.DeepEqual(t, rows[0].in.Attributes, domain.VacancyAttributes{
+		Location:       "Москва",
+		RemotePolicy:   domain.RemoteHybrid,
+		SalaryMin:      200000,
+		SalaryCurrency: "RUB",
+		Seniority:      domain.SenioritySenior,
+		Headcount:      2,
+	})
+}
+
+// TestParseImportHHAttributes: area, salary, schedule and employment are
+// carried over; RUR becomes RUB and an office schedule leaves the remote
+// policy unspecified.
+func TestParseImportHHAttributes(t *testing.T) {
+	t.Parallel()
+	data := `[
+		{"name": "Go", "area": {"name": "Казань"}, "salary": {"from": 150000, "to": null, "currency": "RUR"},
+		 "schedule": {"id": "remote"}, "employment": {"id": "project"}},
+		{"name": "QA", "salary": null, "schedule": {"id": "fullDay"}, "employment": {"id": "volunteer"}}
+	]`
+	rows, err := parseImport(domain.ImportFormatHH, []byte(data))
+	assert.NilError(t, err)
+	assert.Equal(t, len(rows), 2)
+	assert.DeepEqual(t, rows[0].in.Attributes, domain.VacancyAttributes{
+		Location:       "Казань",
+		RemotePolicy:   domain.RemoteFull,
+		SalaryMin:      150000,
+		SalaryCurrency: "RUB",
+		EmploymentType: domain.EmploymentContract,
+	})
+	assert.DeepEqual(t, rows[1].in.Attributes, domain.VacancyAttributes{})
+}
+
+func TestParseImportHH(t *testing.T) {
+	t.Parallel()
+	vacancy := `{"name": "Go разработчик", "archived": true,
+		"description": "<p>Мы ищем <strong>Go</strong>-разработчика.</p><p><strong>Требования:</strong></p><ul><li>Go &amp; PostgreSQL</li><li>Docker</li></ul>",
+		"key_skills": [{"name": "Go"}, {"name": "PostgreSQL"}]}`
+
+	for name, data := range map[string]string{
+		"single": vacancy,
+		"array":  "[" + vacancy + "]",
+		"page":   `{"found": 1, "items": [` + vacancy + `]}`,
+	} {
+		rows, err := parseImport(domain.ImportFormatHH, []byte(data))
+		assert.NilError(t, err, name)
+		assertImportRows(t, rows, []importRow{{row: 1, in: domain.CreateVacancyInput{
+			Title:       "Go разработчик",
+			Description: "Мы ищем Go-разработчика.\nТребования:\n- Go & PostgreSQL\n- Docker",
+			Status:      domain.StatusDraft,
+			Skills:      []domain.SkillWeight{{Name: "Go"}, {Name: "PostgreSQL"}},
+		}}})
+	}
+}
+
+func TestParseImportHHRejectsGarbage(t *testing.T) {
+	t.Parallel()
+	_, err := parseImport(domain.ImportFormatHH, []byte("name,skills"))
+	assert.ErrorIs(t, err, ErrInvalidArgument)
+}
+
+// assertImportRows compares parsed rows field by field: importRow is
+// unexported, which DeepEqual cannot look into.
+func assertImportRows(t *testing.T, got, want []importRow) {
+	t.Helper()
+	assert.Equal(t, len(got), len(want))
+	for i := range want {
+		assert.Equal(t, got[i].row, want[i].row)
+		assert.NilError(t, got[i].err)
+		assert.DeepEqual(t, got[i].in, want[i].in)
+	}
+}
@@ -0,0 +1,182 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+	"gotest.tools/v3/assert"
+
+	"github.com/artem13815/hr/vacancy/internal/domain"
+)
+
+type ImportVacanciesSuite struct{ baseSuite }
+
+const importCSV = "title,description,status,skills\n" +
+	"Go developer,Backend,draft,golang:0.8:must; postgres:0.2\n" +
+	"Бухгалтер,,,1С\n"
+
+func importInput(data, mode string, dryRun bool) domain.ImportVacanciesInput {
+	return domain.ImportVacanciesInput{
+		OwnerUserID: 1,
+		Format:      domain.ImportFormatCSV,
+		Data:        []byte(data),
+		Mode:        mode,
+		DryRun:      dryRun,
+	}
+}
+
+// TestDryRunDetectsRolesWithoutWriting: rows without a role get the keyword
+// role; neither the classifier nor storage is called.
+func (s *ImportVacanciesSuite) TestDryRunDetectsRolesWithoutWriting() {
+	t := s.T()
+
+	got, err := s.svc.ImportVacancies(t.Context(), importInput(importCSV, "", true))
+	assert.NilError(t, err)
+	assert.DeepEqual(t, got, &domain.ImportVacanciesResult{Rows: []domain.ImportRowResult{
+		{
+			Row: 2, Title: "Go developer", Status: domain.StatusDraft, Role: "programmer",
+			Skills: []domain.SkillWeight{
+				{Name: "Go", Weight: 0.8, MustHave: true},
+				{Name: "PostgreSQL", Weight: 0.2},
+			},
+		},
+		{
+			Row: 3, Title: "Бухгалтер", Status: domain.StatusOpen, Role: "accountant",
+			Skills: []domain.SkillWeight{{Name: "1С", Weight: 1}},
+		},
+	}})
+}
+
+func (s *ImportVacanciesSuite) TestAllOrNothingWritesInOneCall() {
+	t := s.T()
+	ctx := t.Context()
+	s.storage.CreateVacanciesMock.Set(func(_ context.Context, ins []domain.CreateVacancyInput) ([]*domain.Vacancy, error) {
+		assert.Equal(t, len(ins), 2)
+		assert.Equal(t, ins[0].OwnerUserID, uint64(1))
+		assert.Equal(t, ins[0].Role, "programmer")
+		assert.Equal(t, ins[0].RoleSource, domain.RoleSourceKeyword)
+		assert.Assert(t, !ins[0].RoleLocked)
+		assert.Equal(t, ins[1].Status, domain.StatusOpen)
+		return []*domain.Vacancy{{ID: "v-1"}, {ID: "v-2"}}, nil
+	})
+
+	got, err := s.svc.ImportVacancies(ctx, importInput(importCSV, domain.ImportModeAllOrNothing, false))
+	assert.NilError(t, err)
+	assert.Assert(t, got.Committed)
+	assert.Equal(t, got.Created, 2)
+	assert.Equal(t, got.Failed, 0)
+	assert.Equal(t, got.Rows[1].Vacancy.ID, "v-2")
+}
+
+// TestAllOrNothingStopsOnInvalidRow: one bad row blocks the whole import,
+// and nothing is written.
+func (s *ImportVacanciesSuite) TestAllOrNothingStopsOnInvalidRow() {
+	t := s.T()
+	data := importCSV + "Тестировщик,,archived,QA\n" + ",no title,,Go\n" + "Аналитик,,,SQL:high\n"
+
+	got, err := s.svc.ImportVacancies(t.Context(), importInput(data, domain.ImportModeAllOrNothing, false))
+	assert.NilError(t, err)
+	assert.Assert(t, !got.Committed)
+	assert.Equal(t, got.Created, 0)
+	assert.Equal(t, got.Failed, 3)
+	assert.Equal(t, got.Rows[0].Error, "")
+	assert.Equal(t, got.Rows[2].Error, "status must be draft or open")
+	assert.Equal(t, got.Rows[3].Error, "title is required")
+	assert.Equal(t, got.Rows[4].Error, `skill "SQL": unknown attribute "high"`)
+}
+
+func (s *ImportVacanciesSuite) TestAllOrNothingStorageError() {
+	t := s.T()
+	s.storage.CreateVacanciesMock.Return(nil, errors.New("db down"))
+
+	got, err := s.svc.ImportVacancies(t.Context(), importInput(importCSV, "", false))
+	assert.ErrorContains(t, err, "db down")
+	assert.Assert(t, got == nil)
+}
+
+func (s *ImportVacanciesSuite) TestBestEffortWritesValidRows() {
+	t := s.T()
+	data := importCSV + "Без навыков,,,\n"
+	s.storage.CreateVacancyMock.Set(func(_ context.Context, in domain.CreateVacancyInput) (*domain.Vacancy, error) {
+		if in.Title == "Бухгалтер" {
+			return nil, errors.New("db down")
+		}
+		return &domain.Vacancy{ID: "v-1", Title: in.Title}, nil
+	})
+
+	got, err := s.svc.ImportVacancies(t.Context(), importInput(data, domain.ImportModeBestEffort, false))
+	assert.NilError(t, err)
+	assert.Assert(t, got.Committed)
+	assert.Equal(t, got.Created, 1)
+	assert.Equal(t, got.Failed, 2)
+	assert.Equal(t, got.Rows[0].Vacancy.ID, "v-1")
+	assert.Equal(t, got.Rows[1].Error, "failed to store vacancy")
+	assert.Equal(t, got.Rows[2].Error, "at least one skill is required")
+	assert.Equal(t, got.Rows[2].Role, "")
+}
+
+// TestRowRolesArePinned: a role set in the row is pinned like in
+// CreateVacancy, against a vocabulary fetched once for the whole file.
+func (s *ImportVacanciesSuite) TestRowRolesArePinned() {
+	t := s.T()
+	data := "title,role,skills\n" +
+		"Go developer, Analyst ,Go\n" +
+		"Бухгалтер,,1С\n" +
+		"Тимлид,manager,Go\n" +
+		"Дизайнер,designer,Figma\n"
+	s.classifier.ListRolesMock.Return([]string{"analyst", "manager", "programmer", "default"}, nil)
+	s.storage.CreateVacancyMock.Set(func(_ context.Context, in domain.CreateVacancyInput) (*domain.Vacancy, error) {
+		if in.Title == "Бухгалтер" {
+			assert.Equal(t, in.RoleSource, domain.RoleSourceKeyword)
+			assert.Assert(t, !in.RoleLocked)
+		} else {
+			assert.Equal(t, in.RoleSource, domain.RoleSourceManual)
+			assert.Assert(t, in.RoleLocked)
+		}
+		return &domain.Vacancy{ID: "v-1", Title: in.Title, Role: in.Role}, nil
+	})
+
+	got, err := s.svc.ImportVacancies(t.Context(), importInput(data, domain.ImportModeBestEffort, false))
+	assert.NilError(t, err)
+	assert.Equal(t, s.classifier.ListRolesAfterCounter(), uint64(1))
+	assert.Equal(t, got.Created, 3)
+	assert.Equal(t, got.Failed, 1)
+	assert.Equal(t, got.Rows[0].Role, "analyst")
+	assert.Equal(t, got.Rows[1].Role, "accountant")
+	assert.Equal(t, got.Rows[2].Role, "manager")
+	assert.Equal(t, got.Rows[3].Error, `unknown role "designer"`)
+	assert.Equal(t, got.Rows[3].Role, "")
+}
+
+func (s *ImportVacanciesSuite) TestInvalidArgument() {
+	t := s.T()
+	cases := map[string]domain.ImportVacanciesInput{
+		"empty data":     importInput("", "", false),
+		"unknown mode":   importInput(importCSV, "sometimes", false),
+		"no title col":   importInput("name2,skills\nx,Go\n", "", false),
+		"header only":    importInput("title,skills\n", "", false),
+		"unknown format": {OwnerUserID: 1, Format: "xml", Data: []byte(importCSV)},
+		"too large":      importInput(strings.Repeat("x", MaxImportBytes+1), "", false),
+		"too many rows":  importInput("title,skills\n"+strings.Repeat("Go developer,Go\n", MaxImportRows+1), "", true),
+	}
+	for name, in := range cases {
+		got, err := s.svc.ImportVacancies(t.Context(), in)
+		assert.ErrorIs(t, err, ErrInvalidArgument, name)
+		assert.Assert(t, got == nil, name)
+	}
+}
+
+func (s *ImportVacanciesSuite) TestUnauthorized() {
+	t := s.T()
+	in := importInput(importCSV, "", false)
+	in.OwnerUserID = 0
+
+	got, err := s.svc.ImportVacancies(t.Context(), in)
+	assert.ErrorIs(t, err, ErrUnauthorized)
+	assert.Assert(t, got == nil)
+}
+
+func TestImportVacanciesSuite(t *testing.T) { suite.Run(t, new(ImportVacanciesSuite)) }
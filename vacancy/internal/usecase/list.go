@@ -3,6 +3,9 @@ package usecase
 import (
 	"cmp"
 	"context"
+	"math"
+	"strings"
+	"unicode/utf8"
 
 	"github.com/artem13815/hr/vacancy/internal/domain"
 )
@@ -11,7 +14,90 @@ func (s *VacancyService) ListVacancies(ctx context.Context, in domain.ListVacanc
 	if in.OwnerUserID == 0 {
 		return nil, ErrUnauthorized
 	}
+	for _, status := range in.Statuses {
+		if !domain.IsKnownStatus(status) {
+			return nil, ErrInvalidArgument
+		}
+	}
+	in.Query = strings.TrimSpace(in.Query)
+	if utf8.RuneCountInString(in.Query) > maxQueryRunes {
+		return nil, ErrInvalidArgument
+	}
+	if !in.CreatedFrom.IsZero() && !in.CreatedTo.IsZero() && !in.CreatedFrom.Before(in.CreatedTo) {
+		return nil, ErrInvalidArgument
+	}
+	// Roles are stored lower-case by resolveRole; skills are matched on
+	// lower(name). Normalising here keeps storage a plain ANY().
+	in.Roles = normalizeFacet(in.Roles)
+	in.Skills = normalizeFacet(in.Skills)
+	in.Locations = normalizeFacet(in.Locations)
+	in.RemotePolicies = normalizeFacet(in.RemotePolicies)
+	in.EmploymentTypes = normalizeFacet(in.EmploymentTypes)
+	in.Seniorities = normalizeFacet(in.Seniorities)
+	if !allKnown(in.RemotePolicies, domain.IsKnownRemotePolicy) ||
+		!allKnown(in.EmploymentTypes, domain.IsKnownEmploymentType) ||
+		!allKnown(in.Seniorities, domain.IsKnownSeniority) {
+		return nil, ErrInvalidArgument
+	}
+	// Salaries in different currencies are not comparable, so an amount
+	// without a currency is rejected rather than compared blindly.
+	in.SalaryCurrency = strings.ToUpper(strings.TrimSpace(in.SalaryCurrency))
+	if in.SalaryCurrency != "" && !isCurrencyCode(in.SalaryCurrency) {
+		return nil, ErrInvalidArgument
+	}
+	if (in.SalaryAtLeast > 0 && in.SalaryCurrency == "") || in.SalaryAtLeast > math.MaxInt64 {
+		return nil, ErrInvalidArgument
+	}
 	in.Limit = min(cmp.Or(in.Limit, 20), 100)
 
-	return s.storage.ListVacancies(ctx, in)
+	// Offset mode keeps the exact total existing clients rely on; keyset
+	// mode exists to avoid the COUNT, so it only counts when asked to.
+	in.After = nil
+	defaultTotal := domain.TotalExact
+	if in.PageToken != "" {
+		after, err := decodePageToken(in.PageToken, in.Query)
+		if err != nil {
+			return nil, err
+		}
+		in.After = after
+		in.Offset = 0
+		defaultTotal = domain.TotalNone
+	}
+	switch in.TotalMode {
+	case "":
+		in.TotalMode = defaultTotal
+	case domain.TotalExact, domain.TotalEstimated, domain.TotalNone:
+	default:
+		return nil, ErrInvalidArgument
+	}
+
+	res, err := s.storage.ListVacancies(ctx, in)
+	if err != nil {
+		return nil, err
+	}
+	if res.Next != nil {
+		res.NextPageToken = encodePageToken(*res.Next, in.Query)
+	}
+	return res, nil
+}
+
+func allKnown(values []string, known func(string) bool) bool {
+	for _, v := range values {
+		if !known(v) {
+			return false
+		}
+	}
+	return true
+}
+
+// normalizeFacet lower-cases and trims facet values, dropping empty ones.
+// Returns nil when nothing is left so an all-blank facet does not filter.
+func normalizeFacet(values []string) []string {
+	var out []string
+	for _, v := range values {
+		if v = strings.ToLower(strings.TrimSpace(v)); v != "" {
+			out = append(out, v)
+		}
+	}
+	return out
 }
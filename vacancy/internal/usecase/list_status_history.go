@@ -0,0 +1,27 @@
+package usecase
+
+import (
+	"cmp"
+	"context"
+
+	"github.com/artem13815/hr/vacancy/internal/domain"
+)
+
+func (s *VacancyService) ListVacancyStatusHistory(ctx context.Context, in domain.ListVacancyStatusHistoryInput) (*domain.ListVacancyStatusHistoryResult, error) {
+	if in.OwnerUserID == 0 || in.VacancyID == "" {
+		return nil, ErrInvalidArgument
+	}
+	in.Limit = min(cmp.Or(in.Limit, 20), 100)
+
+	res, err := s.storage.ListVacancyStatusHistory(ctx, in)
+	if err != nil {
+		return nil, err
+	}
+	// Every vacancy has at least its creation entry, so an empty history
+	// means the vacancy is missing or belongs to someone else.
+	if res.Total == 0 {
+		return nil, ErrVacancyNotFound
+	}
+
+	return res, nil
+}
@@ -0,0 +1,78 @@
+package usecase
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+	"gotest.tools/v3/assert"
+
+	"github.com/artem13815/hr/vacancy/internal/domain"
+)
+
+type ListVacancyStatusHistorySuite struct{ baseSuite }
+
+func (s *ListVacancyStatusHistorySuite) TestSuccess() {
+	t := s.T()
+	ctx := t.Context()
+	in := domain.ListVacancyStatusHistoryInput{VacancyID: "v-1", OwnerUserID: 1, Limit: 10}
+	want := &domain.ListVacancyStatusHistoryResult{
+		Changes: []domain.VacancyStatusChange{{ID: 2, VacancyID: "v-1", FromStatus: domain.StatusOpen, ToStatus: domain.StatusPaused}},
+		Total:   2,
+	}
+
+	s.storage.ListVacancyStatusHistoryMock.Expect(ctx, in).Return(want, nil)
+
+	got, err := s.svc.ListVacancyStatusHistory(ctx, in)
+	assert.NilError(t, err)
+	assert.DeepEqual(t, got, want)
+}
+
+func (s *ListVacancyStatusHistorySuite) TestLimitCapped() {
+	t := s.T()
+	ctx := t.Context()
+	want := &domain.ListVacancyStatusHistoryResult{Changes: []domain.VacancyStatusChange{{ID: 1}}, Total: 1}
+	expected := domain.ListVacancyStatusHistoryInput{VacancyID: "v-1", OwnerUserID: 1, Limit: 100}
+
+	s.storage.ListVacancyStatusHistoryMock.Expect(ctx, expected).Return(want, nil)
+
+	got, err := s.svc.ListVacancyStatusHistory(ctx, domain.ListVacancyStatusHistoryInput{VacancyID: "v-1", OwnerUserID: 1, Limit: 500})
+	assert.NilError(t, err)
+	assert.Equal(t, got, want)
+}
+
+func (s *ListVacancyStatusHistorySuite) TestEmptyHistoryIsNotFound() {
+	t := s.T()
+	ctx := t.Context()
+	expected := domain.ListVacancyStatusHistoryInput{VacancyID: "v-1", OwnerUserID: 1, Limit: 20}
+
+	s.storage.ListVacancyStatusHistoryMock.Expect(ctx, expected).Return(&domain.ListVacancyStatusHistoryResult{Changes: []domain.VacancyStatusChange{}}, nil)
+
+	got, err := s.svc.ListVacancyStatusHistory(ctx, domain.ListVacancyStatusHistoryInput{VacancyID: "v-1", OwnerUserID: 1})
+	assert.ErrorIs(t, err, ErrVacancyNotFound)
+	assert.Assert(t, got == nil)
+}
+
+func (s *ListVacancyStatusHistorySuite) TestInvalidArgument() {
+	t := s.T()
+	got, err := s.svc.ListVacancyStatusHistory(t.Context(), domain.ListVacancyStatusHistoryInput{OwnerUserID: 1})
+	assert.ErrorIs(t, err, ErrInvalidArgument)
+	assert.Assert(t, got == nil)
+}
+
+func (s *ListVacancyStatusHistorySuite) TestStorageError() {
+	t := s.T()
+	ctx := t.Context()
+	storageErr := errors.New("pgx: connection refused")
+	expected := domain.ListVacancyStatusHistoryInput{VacancyID: "v-1", OwnerUserID: 1, Limit: 20}
+
+	s.storage.ListVacancyStatusHistoryMock.Expect(ctx, expected).Return(nil, storageErr)
+
+	got, err := s.svc.ListVacancyStatusHistory(ctx, domain.ListVacancyStatusHistoryInput{VacancyID: "v-1", OwnerUserID: 1})
+	assert.ErrorIs(t, err, storageErr)
+	assert.Assert(t, got == nil)
+}
+
+func TestListVacancyStatusHistorySuite(t *testing.T) {
+	suite.Run(t, new(ListVacancyStatusHistorySuite))
+}
@@ -0,0 +1,20 @@
+package usecase
+
+import (
+	"cmp"
+	"context"
+
+	"github.com/artem13815/hr/vacancy/internal/domain"
+)
+
+func (s *VacancyService) ListTemplates(ctx context.Context, in domain.ListTemplatesInput) (*domain.ListTemplatesResult, error) {
+	if in.OwnerUserID == 0 {
+		return nil, ErrUnauthorized
+	}
+	if in.Scope != "" && in.Scope != domain.TemplateScopePersonal && in.Scope != domain.TemplateScopeOrg {
+		return nil, ErrInvalidArgument
+	}
+	in.Limit = min(cmp.Or(in.Limit, 20), 100)
+
+	return s.storage.ListTemplates(ctx, in)
+}
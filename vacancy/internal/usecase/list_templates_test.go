@@ -0,0 +1,51 @@
+package usecase
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+	"gotest.tools/v3/assert"
+
+	"github.com/artem13815/hr/vacancy/internal/domain"
+)
+
+type ListTemplatesSuite struct{ baseSuite }
+
+func (s *ListTemplatesSuite) TestDefaultLimit() {
+	t := s.T()
+	ctx := t.Context()
+	want := &domain.ListTemplatesResult{Templates: []domain.VacancyTemplate{{ID: "t-1"}}, Total: 1}
+
+	s.storage.ListTemplatesMock.Expect(ctx, domain.ListTemplatesInput{OwnerUserID: 1, Limit: 20}).Return(want, nil)
+
+	got, err := s.svc.ListTemplates(ctx, domain.ListTemplatesInput{OwnerUserID: 1})
+	assert.NilError(t, err)
+	assert.Equal(t, got, want)
+}
+
+func (s *ListTemplatesSuite) TestScopeAndLimitCap() {
+	t := s.T()
+	ctx := t.Context()
+	want := &domain.ListTemplatesResult{}
+
+	s.storage.ListTemplatesMock.Expect(ctx, domain.ListTemplatesInput{OwnerUserID: 1, Scope: domain.TemplateScopeOrg, Limit: 100}).Return(want, nil)
+
+	_, err := s.svc.ListTemplates(ctx, domain.ListTemplatesInput{OwnerUserID: 1, Scope: domain.TemplateScopeOrg, Limit: 500})
+	assert.NilError(t, err)
+}
+
+func (s *ListTemplatesSuite) TestUnknownScope() {
+	t := s.T()
+	got, err := s.svc.ListTemplates(t.Context(), domain.ListTemplatesInput{OwnerUserID: 1, Scope: "team"})
+	assert.ErrorIs(t, err, ErrInvalidArgument)
+	assert.Assert(t, got == nil)
+}
+
+func (s *ListTemplatesSuite) TestUnauthorized() {
+	t := s.T()
+	got, err := s.svc.ListTemplates(t.Context(), domain.ListTemplatesInput{})
+	assert.ErrorIs(t, err, ErrUnauthorized)
+	assert.Assert(t, got == nil)
+}
+
+func TestListTemplatesSuite(t *testing.T) { suite.Run(t, new(ListTemplatesSuite)) }
@@ -2,7 +2,9 @@ package usecase
 
 import (
 	"errors"
+	"strings"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/suite"
 	"gotest.tools/v3/assert"
@@ -26,7 +28,10 @@ func (s *ListVacanciesSuite) TestSuccess() {
 		Total:     1,
 	}
 
-	s.storage.ListVacanciesMock.Expect(ctx, in).Return(want, nil)
+	expected := in
+	expected.TotalMode = domain.TotalExact
+
+	s.storage.ListVacanciesMock.Expect(ctx, expected).Return(want, nil)
 
 	got, err := s.svc.ListVacancies(ctx, in)
 	assert.NilError(t, err)
@@ -46,7 +51,7 @@ func (s *ListVacanciesSuite) TestZeroLimitDefaultsTo20() {
 	t := s.T()
 	ctx := t.Context()
 	want := &domain.ListVacanciesResult{Vacancies: []domain.Vacancy{}}
-	expected := domain.ListVacanciesInput{OwnerUserID: 1, Limit: 20}
+	expected := domain.ListVacanciesInput{OwnerUserID: 1, Limit: 20, TotalMode: domain.TotalExact}
 
 	s.storage.ListVacanciesMock.Expect(ctx, expected).Return(want, nil)
 
@@ -62,7 +67,7 @@ func (s *ListVacanciesSuite) TestLimitOver100Capped() {
 	t := s.T()
 	ctx := t.Context()
 	want := &domain.ListVacanciesResult{Vacancies: []domain.Vacancy{}}
-	expected := domain.ListVacanciesInput{OwnerUserID: 1, Limit: 100}
+	expected := domain.ListVacanciesInput{OwnerUserID: 1, Limit: 100, TotalMode: domain.TotalExact}
 
 	s.storage.ListVacanciesMock.Expect(ctx, expected).Return(want, nil)
 
@@ -71,11 +76,200 @@ func (s *ListVacanciesSuite) TestLimitOver100Capped() {
 	assert.Equal(t, got, want)
 }
 
+func (s *ListVacanciesSuite) TestStatusFilterPassedThrough() {
+	t := s.T()
+	ctx := t.Context()
+	want := &domain.ListVacanciesResult{Vacancies: []domain.Vacancy{}}
+	expected := domain.ListVacanciesInput{OwnerUserID: 1, Limit: 20, Statuses: []string{domain.StatusOpen, domain.StatusPaused}, TotalMode: domain.TotalExact}
+
+	s.storage.ListVacanciesMock.Expect(ctx, expected).Return(want, nil)
+
+	got, err := s.svc.ListVacancies(ctx, domain.ListVacanciesInput{OwnerUserID: 1, Statuses: []string{domain.StatusOpen, domain.StatusPaused}})
+	assert.NilError(t, err)
+	assert.Equal(t, got, want)
+}
+
+func (s *ListVacanciesSuite) TestInvalidArgumentUnknownStatus() {
+	t := s.T()
+	got, err := s.svc.ListVacancies(t.Context(), domain.ListVacanciesInput{OwnerUserID: 1, Statuses: []string{"active"}})
+	assert.ErrorIs(t, err, ErrInvalidArgument)
+	assert.Assert(t, got == nil)
+}
+
+// TestFacetsNormalised: query is trimmed, role/skill facets lower-cased
+// with blanks dropped, so storage can match with a plain ANY().
+func (s *ListVacanciesSuite) TestFacetsNormalised() {
+	t := s.T()
+	ctx := t.Context()
+	from := time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)
+	to := from.AddDate(0, 1, 0)
+	want := &domain.ListVacanciesResult{Vacancies: []domain.Vacancy{}}
+	expected := domain.ListVacanciesInput{
+		OwnerUserID: 1,
+		Limit:       20,
+		Query:       "разработчик go",
+		Roles:       []string{"programmer"},
+		Skills:      []string{"postgresql", "go"},
+		CreatedFrom: from,
+		CreatedTo:   to,
+		TotalMode:   domain.TotalExact,
+	}
+
+	s.storage.ListVacanciesMock.Expect(ctx, expected).Return(want, nil)
+
+	got, err := s.svc.ListVacancies(ctx, domain.ListVacanciesInput{
+		OwnerUserID: 1,
+		Query:       "  разработчик go ",
+		Roles:       []string{" Programmer", ""},
+		Skills:      []string{"PostgreSQL", " ", "Go"},
+		CreatedFrom: from,
+		CreatedTo:   to,
+	})
+	assert.NilError(t, err)
+	assert.Equal(t, got, want)
+}
+
+// TestAttributeFacetsNormalised: attribute facets are lower-cased like the
+// others and the salary currency upper-cased, matching what create stores.
+func (s *ListVacanciesSuite) TestAttributeFacetsNormalised() {
+	t := s.T()
+	ctx := t.Context()
+	want := &domain.ListVacanciesResult{Vacancies: []domain.Vacancy{}}
+	expected := domain.ListVacanciesInput{
+		OwnerUserID:     1,
+		Limit:           20,
+		Locations:       []string{"москва"},
+		RemotePolicies:  []string{domain.RemoteHybrid, domain.RemoteFull},
+		EmploymentTypes: []string{domain.EmploymentFullTime},
+		Seniorities:     []string{domain.SenioritySenior},
+		SalaryAtLeast:   250000,
+		SalaryCurrency:  "RUB",
+		TotalMode:       domain.TotalExact,
+	}
+
+	s.storage.ListVacanciesMock.Expect(ctx, expected).Return(want, nil)
+
+	got, err := s.svc.ListVacancies(ctx, domain.ListVacanciesInput{
+		OwnerUserID:     1,
+		Locations:       []string{" Москва", ""},
+		RemotePolicies:  []string{"Hybrid", "remote"},
+		EmploymentTypes: []string{"FULL_TIME"},
+		Seniorities:     []string{"senior "},
+		SalaryAtLeast:   250000,
+		SalaryCurrency:  "rub",
+	})
+	assert.NilError(t, err)
+	assert.Equal(t, got, want)
+}
+
+func (s *ListVacanciesSuite) TestInvalidArgumentAttributeFacets() {
+	t := s.T()
+	for _, in := range []domain.ListVacanciesInput{
+		{OwnerUserID: 1, RemotePolicies: []string{"sometimes"}},
+		{OwnerUserID: 1, EmploymentTypes: []string{"gig"}},
+		{OwnerUserID: 1, Seniorities: []string{"principal"}},
+		{OwnerUserID: 1, SalaryAtLeast: 100000},
+		{OwnerUserID: 1, SalaryCurrency: "rubles"},
+	} {
+		got, err := s.svc.ListVacancies(t.Context(), in)
+		assert.ErrorIs(t, err, ErrInvalidArgument)
+		assert.Assert(t, got == nil)
+	}
+}
+
+func (s *ListVacanciesSuite) TestInvalidArgumentEmptyCreatedRange() {
+	t := s.T()
+	at := time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)
+	got, err := s.svc.ListVacancies(t.Context(), domain.ListVacanciesInput{OwnerUserID: 1, CreatedFrom: at, CreatedTo: at})
+	assert.ErrorIs(t, err, ErrInvalidArgument)
+	assert.Assert(t, got == nil)
+}
+
+func (s *ListVacanciesSuite) TestInvalidArgumentQueryTooLong() {
+	t := s.T()
+	got, err := s.svc.ListVacancies(t.Context(), domain.ListVacanciesInput{OwnerUserID: 1, Query: strings.Repeat("я", maxQueryRunes+1)})
+	assert.ErrorIs(t, err, ErrInvalidArgument)
+	assert.Assert(t, got == nil)
+}
+
+// TestKeysetRoundTrip: a full page yields a token; feeding it back decodes
+// to the same cursor, drops the offset and skips the COUNT by default.
+func (s *ListVacanciesSuite) TestKeysetRoundTrip() {
+	t := s.T()
+	ctx := t.Context()
+	cursor := domain.VacancyCursor{Rank: 0.25, CreatedAt: time.Date(2026, 3, 1, 10, 0, 0, 123000, time.UTC), ID: "v-2"}
+
+	s.storage.ListVacanciesMock.Expect(ctx, domain.ListVacanciesInput{OwnerUserID: 1, Limit: 2, Query: "go", TotalMode: domain.TotalExact}).
+		Return(&domain.ListVacanciesResult{Vacancies: []domain.Vacancy{{ID: "v-1"}, {ID: "v-2"}}, Next: &cursor}, nil)
+
+	first, err := s.svc.ListVacancies(ctx, domain.ListVacanciesInput{OwnerUserID: 1, Limit: 2, Query: "go"})
+	assert.NilError(t, err)
+	assert.Assert(t, first.NextPageToken != "")
+
+	s.storage.ListVacanciesMock.Expect(ctx, domain.ListVacanciesInput{
+		OwnerUserID: 1,
+		Limit:       2,
+		Query:       "go",
+		PageToken:   first.NextPageToken,
+		After:       &cursor,
+		TotalMode:   domain.TotalNone,
+	}).Return(&domain.ListVacanciesResult{Vacancies: []domain.Vacancy{{ID: "v-3"}}}, nil)
+
+	second, err := s.svc.ListVacancies(ctx, domain.ListVacanciesInput{
+		OwnerUserID: 1,
+		Limit:       2,
+		Offset:      40,
+		Query:       "go",
+		PageToken:   first.NextPageToken,
+	})
+	assert.NilError(t, err)
+	assert.Equal(t, second.NextPageToken, "")
+}
+
+func (s *ListVacanciesSuite) TestKeysetEstimatedTotal() {
+	t := s.T()
+	ctx := t.Context()
+	token := encodePageToken(domain.VacancyCursor{CreatedAt: time.Unix(1700000000, 0).UTC(), ID: "v-9"}, "")
+	want := &domain.ListVacanciesResult{Vacancies: []domain.Vacancy{}, Total: 10000, TotalMode: domain.TotalEstimated}
+
+	s.storage.ListVacanciesMock.Expect(ctx, domain.ListVacanciesInput{
+		OwnerUserID: 1,
+		IsAdmin:     true,
+		Limit:       20,
+		PageToken:   token,
+		After:       &domain.VacancyCursor{CreatedAt: time.Unix(1700000000, 0).UTC(), ID: "v-9"},
+		TotalMode:   domain.TotalEstimated,
+	}).Return(want, nil)
+
+	got, err := s.svc.ListVacancies(ctx, domain.ListVacanciesInput{OwnerUserID: 1, IsAdmin: true, PageToken: token, TotalMode: domain.TotalEstimated})
+	assert.NilError(t, err)
+	assert.Equal(t, got, want)
+}
+
+// TestInvalidPageToken covers garbage tokens and a token minted for a
+// different search query (ranks would not be comparable).
+func (s *ListVacanciesSuite) TestInvalidPageToken() {
+	t := s.T()
+	otherQuery := encodePageToken(domain.VacancyCursor{Rank: 0.5, CreatedAt: time.Now(), ID: "v-1"}, "python")
+	for _, token := range []string{"%%%", "bm90LWpzb24", otherQuery} {
+		got, err := s.svc.ListVacancies(t.Context(), domain.ListVacanciesInput{OwnerUserID: 1, Query: "go", PageToken: token})
+		assert.ErrorIs(t, err, ErrInvalidArgument)
+		assert.Assert(t, got == nil)
+	}
+}
+
+func (s *ListVacanciesSuite) TestInvalidArgumentTotalMode() {
+	t := s.T()
+	got, err := s.svc.ListVacancies(t.Context(), domain.ListVacanciesInput{OwnerUserID: 1, TotalMode: "approximate"})
+	assert.ErrorIs(t, err, ErrInvalidArgument)
+	assert.Assert(t, got == nil)
+}
+
 func (s *ListVacanciesSuite) TestStorageError() {
 	t := s.T()
 	ctx := t.Context()
 	storageErr := errors.New("pgx: connection refused")
-	expected := domain.ListVacanciesInput{OwnerUserID: 1, Limit: 20}
+	expected := domain.ListVacanciesInput{OwnerUserID: 1, Limit: 20, TotalMode: domain.TotalExact}
 
 	s.storage.ListVacanciesMock.Expect(ctx, expected).Return(nil, storageErr)
 
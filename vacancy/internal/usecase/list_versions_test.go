@@ -0,0 +1,80 @@
+package usecase
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+	"gotest.tools/v3/assert"
+
+	"github.com/artem13815/hr/vacancy/internal/domain"
+)
+
+type ListVacancyVersionsSuite struct{ baseSuite }
+
+func (s *ListVacancyVersionsSuite) TestSuccess() {
+	t := s.T()
+	ctx := t.Context()
+	in := domain.ListVacancyVersionsInput{VacancyID: "v-1", OwnerUserID: 1, Limit: 5, Offset: 5}
+	want := &domain.ListVacancyVersionsResult{
+		Versions: []domain.VacancyVersion{{VacancyID: "v-1", Version: 2}},
+		Total:    6,
+	}
+
+	s.storage.ListVacancyVersionsMock.Expect(ctx, in).Return(want, nil)
+
+	got, err := s.svc.ListVacancyVersions(ctx, in)
+	assert.NilError(t, err)
+	assert.DeepEqual(t, got, want)
+}
+
+func (s *ListVacancyVersionsSuite) TestZeroLimitDefaultsTo20() {
+	t := s.T()
+	ctx := t.Context()
+	want := &domain.ListVacancyVersionsResult{Versions: []domain.VacancyVersion{{Version: 1}}, Total: 1}
+	expected := domain.ListVacancyVersionsInput{VacancyID: "v-1", OwnerUserID: 1, Limit: 20}
+
+	s.storage.ListVacancyVersionsMock.Expect(ctx, expected).Return(want, nil)
+
+	got, err := s.svc.ListVacancyVersions(ctx, domain.ListVacancyVersionsInput{VacancyID: "v-1", OwnerUserID: 1})
+	assert.NilError(t, err)
+	assert.Equal(t, got, want)
+}
+
+// TestEmptyHistoryIsNotFound: storage scopes the history by vacancy
+// ownership, and every vacancy has a v1 snapshot — so zero rows means the
+// caller cannot see the vacancy at all.
+func (s *ListVacancyVersionsSuite) TestEmptyHistoryIsNotFound() {
+	t := s.T()
+	ctx := t.Context()
+	expected := domain.ListVacancyVersionsInput{VacancyID: "v-1", OwnerUserID: 1, Limit: 20}
+
+	s.storage.ListVacancyVersionsMock.Expect(ctx, expected).Return(&domain.ListVacancyVersionsResult{Versions: []domain.VacancyVersion{}}, nil)
+
+	got, err := s.svc.ListVacancyVersions(ctx, domain.ListVacancyVersionsInput{VacancyID: "v-1", OwnerUserID: 1})
+	assert.ErrorIs(t, err, ErrVacancyNotFound)
+	assert.Assert(t, got == nil)
+}
+
+func (s *ListVacancyVersionsSuite) TestInvalidArgument() {
+	t := s.T()
+	_, err := s.svc.ListVacancyVersions(t.Context(), domain.ListVacancyVersionsInput{VacancyID: "v-1"})
+	assert.ErrorIs(t, err, ErrInvalidArgument)
+	_, err = s.svc.ListVacancyVersions(t.Context(), domain.ListVacancyVersionsInput{OwnerUserID: 1})
+	assert.ErrorIs(t, err, ErrInvalidArgument)
+}
+
+func (s *ListVacancyVersionsSuite) TestStorageError() {
+	t := s.T()
+	ctx := t.Context()
+	storageErr := errors.New("pgx: connection refused")
+	expected := domain.ListVacancyVersionsInput{VacancyID: "v-1", OwnerUserID: 1, Limit: 20}
+
+	s.storage.ListVacancyVersionsMock.Expect(ctx, expected).Return(nil, storageErr)
+
+	got, err := s.svc.ListVacancyVersions(ctx, domain.ListVacancyVersionsInput{VacancyID: "v-1", OwnerUserID: 1})
+	assert.ErrorIs(t, err, storageErr)
+	assert.Assert(t, got == nil)
+}
+
+func TestListVacancyVersionsSuite(t *testing.T) { suite.Run(t, new(ListVacancyVersionsSuite)) }
@@ -0,0 +1,416 @@
+// Code generated by http://github.com/gojuno/minimock (v3.4.7). DO NOT EDIT.
+
+package mocks
+
+import (
+	"context"
+	"sync"
+	mm_atomic "sync/atomic"
+	mm_time "time"
+
+	"github.com/artem13815/hr/vacancy/internal/domain"
+	"github.com/gojuno/minimock/v3"
+)
+
+// CandidatePurgerMock implements mm_usecase.CandidatePurger
+type CandidatePurgerMock struct {
+	t          minimock.Tester
+	finishOnce sync.Once
+
+	funcPurgeVacancyCandidates          func(ctx context.Context, vacancyID string) (pp1 *domain.PurgedCandidates, err error)
+	funcPurgeVacancyCandidatesOrigin    string
+	inspectFuncPurgeVacancyCandidates   func(ctx context.Context, vacancyID string)
+	afterPurgeVacancyCandidatesCounter  uint64
+	beforePurgeVacancyCandidatesCounter uint64
+	PurgeVacancyCandidatesMock          mCandidatePurgerMockPurgeVacancyCandidates
+}
+
+// NewCandidatePurgerMock returns a mock for mm_usecase.CandidatePurger
+func NewCandidatePurgerMock(t minimock.Tester) *CandidatePurgerMock {
+	m := &CandidatePurgerMock{t: t}
+
+	if controller, ok := t.(minimock.MockController); ok {
+		controller.RegisterMocker(m)
+	}
+
+	m.PurgeVacancyCandidatesMock = mCandidatePurgerMockPurgeVacancyCandidates{mock: m}
+	m.PurgeVacancyCandidatesMock.callArgs = []*CandidatePurgerMockPurgeVacancyCandidatesParams{}
+
+	t.Cleanup(m.MinimockFinish)
+
+	return m
+}
+
+type mCandidatePurgerMockPurgeVacancyCandidates struct {
+	optional           bool
+	mock               *CandidatePurgerMock
+	defaultExpectation *CandidatePurgerMockPurgeVacancyCandidatesExpectation
+	expectations       []*CandidatePurgerMockPurgeVacancyCandidatesExpectation
+
+	callArgs []*CandidatePurgerMockPurgeVacancyCandidatesParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// CandidatePurgerMockPurgeVacancyCandidatesExpectation specifies expectation struct of the CandidatePurger.PurgeVacancyCandidates
+type CandidatePurgerMockPurgeVacancyCandidatesExpectation struct {
+	mock               *CandidatePurgerMock
+	params             *CandidatePurgerMockPurgeVacancyCandidatesParams
+	paramPtrs          *CandidatePurgerMockPurgeVacancyCandidatesParamPtrs
+	expectationOrigins CandidatePurgerMockPurgeVacancyCandidatesExpectationOrigins
+	results            *CandidatePurgerMockPurgeVacancyCandidatesResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// CandidatePurgerMockPurgeVacancyCandidatesParams contains parameters of the CandidatePurger.PurgeVacancyCandidates
+type CandidatePurgerMockPurgeVacancyCandidatesParams struct {
+	ctx       context.Context
+	vacancyID string
+}
+
+// CandidatePurgerMockPurgeVacancyCandidatesParamPtrs contains pointers to parameters of the CandidatePurger.PurgeVacancyCandidates
+type CandidatePurgerMockPurgeVacancyCandidatesParamPtrs struct {
+	ctx       *context.Context
+	vacancyID *string
+}
+
+// CandidatePurgerMockPurgeVacancyCandidatesResults contains results of the CandidatePurger.PurgeVacancyCandidates
+type CandidatePurgerMockPurgeVacancyCandidatesResults struct {
+	pp1 *domain.PurgedCandidates
+	err error
+}
+
+// CandidatePurgerMockPurgeVacancyCandidatesOrigins contains origins of expectations of the CandidatePurger.PurgeVacancyCandidates
+type CandidatePurgerMockPurgeVacancyCandidatesExpectationOrigins struct {
+	origin          string
+	originCtx       string
+	originVacancyID string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmPurgeVacancyCandidates *mCandidatePurgerMockPurgeVacancyCandidates) Optional() *mCandidatePurgerMockPurgeVacancyCandidates {
+	mmPurgeVacancyCandidates.optional = true
+	return mmPurgeVacancyCandidates
+}
+
+// Expect sets up expected params for CandidatePurger.PurgeVacancyCandidates
+func (mmPurgeVacancyCandidates *mCandidatePurgerMockPurgeVacancyCandidates) Expect(ctx context.Context, vacancyID string) *mCandidatePurgerMockPurgeVacancyCandidates {
+	if mmPurgeVacancyCandidates.mock.funcPurgeVacancyCandidates != nil {
+		mmPurgeVacancyCandidates.mock.t.Fatalf("CandidatePurgerMock.PurgeVacancyCandidates mock is already set by Set")
+	}
+
+	if mmPurgeVacancyCandidates.defaultExpectation == nil {
+		mmPurgeVacancyCandidates.defaultExpectation = &CandidatePurgerMockPurgeVacancyCandidatesExpectation{}
+	}
+
+	if mmPurgeVacancyCandidates.defaultExpectation.paramPtrs != nil {
+		mmPurgeVacancyCandidates.mock.t.Fatalf("CandidatePurgerMock.PurgeVacancyCandidates mock is already set by ExpectParams functions")
+	}
+
+	mmPurgeVacancyCandidates.defaultExpectation.params = &CandidatePurgerMockPurgeVacancyCandidatesParams{ctx, vacancyID}
+	mmPurgeVacancyCandidates.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmPurgeVacancyCandidates.expectations {
+		if minimock.Equal(e.params, mmPurgeVacancyCandidates.defaultExpectation.params) {
+			mmPurgeVacancyCandidates.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmPurgeVacancyCandidates.defaultExpectation.params)
+		}
+	}
+
+	return mmPurgeVacancyCandidates
+}
+
+// ExpectCtxParam1 sets up expected param ctx for CandidatePurger.PurgeVacancyCandidates
+func (mmPurgeVacancyCandidates *mCandidatePurgerMockPurgeVacancyCandidates) ExpectCtxParam1(ctx context.Context) *mCandidatePurgerMockPurgeVacancyCandidates {
+	if mmPurgeVacancyCandidates.mock.funcPurgeVacancyCandidates != nil {
+		mmPurgeVacancyCandidates.mock.t.Fatalf("CandidatePurgerMock.PurgeVacancyCandidates mock is already set by Set")
+	}
+
+	if mmPurgeVacancyCandidates.defaultExpectation == nil {
+		mmPurgeVacancyCandidates.defaultExpectation = &CandidatePurgerMockPurgeVacancyCandidatesExpectation{}
+	}
+
+	if mmPurgeVacancyCandidates.defaultExpectation.params != nil {
+		mmPurgeVacancyCandidates.mock.t.Fatalf("CandidatePurgerMock.PurgeVacancyCandidates mock is already set by Expect")
+	}
+
+	if mmPurgeVacancyCandidates.defaultExpectation.paramPtrs == nil {
+		mmPurgeVacancyCandidates.defaultExpectation.paramPtrs = &CandidatePurgerMockPurgeVacancyCandidatesParamPtrs{}
+	}
+	mmPurgeVacancyCandidates.defaultExpectation.paramPtrs.ctx = &ctx
+	mmPurgeVacancyCandidates.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmPurgeVacancyCandidates
+}
+
+// ExpectVacancyIDParam2 sets up expected param vacancyID for CandidatePurger.PurgeVacancyCandidates
+func (mmPurgeVacancyCandidates *mCandidatePurgerMockPurgeVacancyCandidates) ExpectVacancyIDParam2(vacancyID string) *mCandidatePurgerMockPurgeVacancyCandidates {
+	if mmPurgeVacancyCandidates.mock.funcPurgeVacancyCandidates != nil {
+		mmPurgeVacancyCandidates.mock.t.Fatalf("CandidatePurgerMock.PurgeVacancyCandidates mock is already set by Set")
+	}
+
+	if mmPurgeVacancyCandidates.defaultExpectation == nil {
+		mmPurgeVacancyCandidates.defaultExpectation = &CandidatePurgerMockPurgeVacancyCandidatesExpectation{}
+	}
+
+	if mmPurgeVacancyCandidates.defaultExpectation.params != nil {
+		mmPurgeVacancyCandidates.mock.t.Fatalf("CandidatePurgerMock.PurgeVacancyCandidates mock is already set by Expect")
+	}
+
+	if mmPurgeVacancyCandidates.defaultExpectation.paramPtrs == nil {
+		mmPurgeVacancyCandidates.defaultExpectation.paramPtrs = &CandidatePurgerMockPurgeVacancyCandidatesParamPtrs{}
+	}
+	mmPurgeVacancyCandidates.defaultExpectation.paramPtrs.vacancyID = &vacancyID
+	mmPurgeVacancyCandidates.defaultExpectation.expectationOrigins.originVacancyID = minimock.CallerInfo(1)
+
+	return mmPurgeVacancyCandidates
+}
+
+// Inspect accepts an inspector function that has same arguments as the CandidatePurger.PurgeVacancyCandidates
+func (mmPurgeVacancyCandidates *mCandidatePurgerMockPurgeVacancyCandidates) Inspect(f func(ctx context.Context, vacancyID string)) *mCandidatePurgerMockPurgeVacancyCandidates {
+	if mmPurgeVacancyCandidates.mock.inspectFuncPurgeVacancyCandidates != nil {
+		mmPurgeVacancyCandidates.mock.t.Fatalf("Inspect function is already set for CandidatePurgerMock.PurgeVacancyCandidates")
+	}
+
+	mmPurgeVacancyCandidates.mock.inspectFuncPurgeVacancyCandidates = f
+
+	return mmPurgeVacancyCandidates
+}
+
+// Return sets up results that will be returned by CandidatePurger.PurgeVacancyCandidates
+func (mmPurgeVacancyCandidates *mCandidatePurgerMockPurgeVacancyCandidates) Return(pp1 *domain.PurgedCandidates, err error) *CandidatePurgerMock {
+	if mmPurgeVacancyCandidates.mock.funcPurgeVacancyCandidates != nil {
+		mmPurgeVacancyCandidates.mock.t.Fatalf("CandidatePurgerMock.PurgeVacancyCandidates mock is already set by Set")
+	}
+
+	if mmPurgeVacancyCandidates.defaultExpectation == nil {
+		mmPurgeVacancyCandidates.defaultExpectation = &CandidatePurgerMockPurgeVacancyCandidatesExpectation{mock: mmPurgeVacancyCandidates.mock}
+	}
+	mmPurgeVacancyCandidates.defaultExpectation.results = &CandidatePurgerMockPurgeVacancyCandidatesResults{pp1, err}
+	mmPurgeVacancyCandidates.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmPurgeVacancyCandidates.mock
+}
+
+// Set uses given function f to mock the CandidatePurger.PurgeVacancyCandidates method
+func (mmPurgeVacancyCandidates *mCandidatePurgerMockPurgeVacancyCandidates) Set(f func(ctx context.Context, vacancyID string) (pp1 *domain.PurgedCandidates, err error)) *CandidatePurgerMock {
+	if mmPurgeVacancyCandidates.defaultExpectation != nil {
+		mmPurgeVacancyCandidates.mock.t.Fatalf("Default expectation is already set for the CandidatePurger.PurgeVacancyCandidates method")
+	}
+
+	if len(mmPurgeVacancyCandidates.expectations) > 0 {
+		mmPurgeVacancyCandidates.mock.t.Fatalf("Some expectations are already set for the CandidatePurger.PurgeVacancyCandidates method")
+	}
+
+	mmPurgeVacancyCandidates.mock.funcPurgeVacancyCandidates = f
+	mmPurgeVacancyCandidates.mock.funcPurgeVacancyCandidatesOrigin = minimock.CallerInfo(1)
+	return mmPurgeVacancyCandidates.mock
+}
+
+// When sets expectation for the CandidatePurger.PurgeVacancyCandidates which will trigger the result defined by the following
+// Then helper
+func (mmPurgeVacancyCandidates *mCandidatePurgerMockPurgeVacancyCandidates) When(ctx context.Context, vacancyID string) *CandidatePurgerMockPurgeVacancyCandidatesExpectation {
+	if mmPurgeVacancyCandidates.mock.funcPurgeVacancyCandidates != nil {
+		mmPurgeVacancyCandidates.mock.t.Fatalf("CandidatePurgerMock.PurgeVacancyCandidates mock is already set by Set")
+	}
+
+	expectation := &CandidatePurgerMockPurgeVacancyCandidatesExpectation{
+		mock:               mmPurgeVacancyCandidates.mock,
+		params:             &CandidatePurgerMockPurgeVacancyCandidatesParams{ctx, vacancyID},
+		expectationOrigins: CandidatePurgerMockPurgeVacancyCandidatesExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmPurgeVacancyCandidates.expectations = append(mmPurgeVacancyCandidates.expectations, expectation)
+	return expectation
+}
+
+// Then sets up CandidatePurger.PurgeVacancyCandidates return parameters for the expectation previously defined by the When method
+func (e *CandidatePurgerMockPurgeVacancyCandidatesExpectation) Then(pp1 *domain.PurgedCandidates, err error) *CandidatePurgerMock {
+	e.results = &CandidatePurgerMockPurgeVacancyCandidatesResults{pp1, err}
+	return e.mock
+}
+
+// Times sets number of times CandidatePurger.PurgeVacancyCandidates should be invoked
+func (mmPurgeVacancyCandidates *mCandidatePurgerMockPurgeVacancyCandidates) Times(n uint64) *mCandidatePurgerMockPurgeVacancyCandidates {
+	if n == 0 {
+		mmPurgeVacancyCandidates.mock.t.Fatalf("Times of CandidatePurgerMock.PurgeVacancyCandidates mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmPurgeVacancyCandidates.expectedInvocations, n)
+	mmPurgeVacancyCandidates.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmPurgeVacancyCandidates
+}
+
+func (mmPurgeVacancyCandidates *mCandidatePurgerMockPurgeVacancyCandidates) invocationsDone() bool {
+	if len(mmPurgeVacancyCandidates.expectations) == 0 && mmPurgeVacancyCandidates.defaultExpectation == nil && mmPurgeVacancyCandidates.mock.funcPurgeVacancyCandidates == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmPurgeVacancyCandidates.mock.afterPurgeVacancyCandidatesCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmPurgeVacancyCandidates.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// PurgeVacancyCandidates implements mm_usecase.CandidatePurger
+func (mmPurgeVacancyCandidates *CandidatePurgerMock) PurgeVacancyCandidates(ctx context.Context, vacancyID string) (pp1 *domain.PurgedCandidates, err error) {
+	mm_atomic.AddUint64(&mmPurgeVacancyCandidates.beforePurgeVacancyCandidatesCounter, 1)
+	defer mm_atomic.AddUint64(&mmPurgeVacancyCandidates.afterPurgeVacancyCandidatesCounter, 1)
+
+	mmPurgeVacancyCandidates.t.Helper()
+
+	if mmPurgeVacancyCandidates.inspectFuncPurgeVacancyCandidates != nil {
+		mmPurgeVacancyCandidates.inspectFuncPurgeVacancyCandidates(ctx, vacancyID)
+	}
+
+	mm_params := CandidatePurgerMockPurgeVacancyCandidatesParams{ctx, vacancyID}
+
+	// Record call args
+	mmPurgeVacancyCandidates.PurgeVacancyCandidatesMock.mutex.Lock()
+	mmPurgeVacancyCandidates.PurgeVacancyCandidatesMock.callArgs = append(mmPurgeVacancyCandidates.PurgeVacancyCandidatesMock.callArgs, &mm_params)
+	mmPurgeVacancyCandidates.PurgeVacancyCandidatesMock.mutex.Unlock()
+
+	for _, e := range mmPurgeVacancyCandidates.PurgeVacancyCandidatesMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.pp1, e.results.err
+		}
+	}
+
+	if mmPurgeVacancyCandidates.PurgeVacancyCandidatesMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmPurgeVacancyCandidates.PurgeVacancyCandidatesMock.defaultExpectation.Counter, 1)
+		mm_want := mmPurgeVacancyCandidates.PurgeVacancyCandidatesMock.defaultExpectation.params
+		mm_want_ptrs := mmPurgeVacancyCandidates.PurgeVacancyCandidatesMock.defaultExpectation.paramPtrs
+
+		mm_got := CandidatePurgerMockPurgeVacancyCandidatesParams{ctx, vacancyID}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmPurgeVacancyCandidates.t.Errorf("CandidatePurgerMock.PurgeVacancyCandidates got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmPurgeVacancyCandidates.PurgeVacancyCandidatesMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.vacancyID != nil && !minimock.Equal(*mm_want_ptrs.vacancyID, mm_got.vacancyID) {
+				mmPurgeVacancyCandidates.t.Errorf("CandidatePurgerMock.PurgeVacancyCandidates got unexpected parameter vacancyID, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmPurgeVacancyCandidates.PurgeVacancyCandidatesMock.defaultExpectation.expectationOrigins.originVacancyID, *mm_want_ptrs.vacancyID, mm_got.vacancyID, minimock.Diff(*mm_want_ptrs.vacancyID, mm_got.vacancyID))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmPurgeVacancyCandidates.t.Errorf("CandidatePurgerMock.PurgeVacancyCandidates got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmPurgeVacancyCandidates.PurgeVacancyCandidatesMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmPurgeVacancyCandidates.PurgeVacancyCandidatesMock.defaultExpectation.results
+		if mm_results == nil {
+			mmPurgeVacancyCandidates.t.Fatal("No results are set for the CandidatePurgerMock.PurgeVacancyCandidates")
+		}
+		return (*mm_results).pp1, (*mm_results).err
+	}
+	if mmPurgeVacancyCandidates.funcPurgeVacancyCandidates != nil {
+		return mmPurgeVacancyCandidates.funcPurgeVacancyCandidates(ctx, vacancyID)
+	}
+	mmPurgeVacancyCandidates.t.Fatalf("Unexpected call to CandidatePurgerMock.PurgeVacancyCandidates. %v %v", ctx, vacancyID)
+	return
+}
+
+// PurgeVacancyCandidatesAfterCounter returns a count of finished CandidatePurgerMock.PurgeVacancyCandidates invocations
+func (mmPurgeVacancyCandidates *CandidatePurgerMock) PurgeVacancyCandidatesAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmPurgeVacancyCandidates.afterPurgeVacancyCandidatesCounter)
+}
+
+// PurgeVacancyCandidatesBeforeCounter returns a count of CandidatePurgerMock.PurgeVacancyCandidates invocations
+func (mmPurgeVacancyCandidates *CandidatePurgerMock) PurgeVacancyCandidatesBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmPurgeVacancyCandidates.beforePurgeVacancyCandidatesCounter)
+}
+
+// Calls returns a list of arguments used in each call to CandidatePurgerMock.PurgeVacancyCandidates.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmPurgeVacancyCandidates *mCandidatePurgerMockPurgeVacancyCandidates) Calls() []*CandidatePurgerMockPurgeVacancyCandidatesParams {
+	mmPurgeVacancyCandidates.mutex.RLock()
+
+	argCopy := make([]*CandidatePurgerMockPurgeVacancyCandidatesParams, len(mmPurgeVacancyCandidates.callArgs))
+	copy(argCopy, mmPurgeVacancyCandidates.callArgs)
+
+	mmPurgeVacancyCandidates.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockPurgeVacancyCandidatesDone returns true if the count of the PurgeVacancyCandidates invocations corresponds
+// the number of defined expectations
+func (m *CandidatePurgerMock) MinimockPurgeVacancyCandidatesDone() bool {
+	if m.PurgeVacancyCandidatesMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.PurgeVacancyCandidatesMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.PurgeVacancyCandidatesMock.invocationsDone()
+}
+
+// MinimockPurgeVacancyCandidatesInspect logs each unmet expectation
+func (m *CandidatePurgerMock) MinimockPurgeVacancyCandidatesInspect() {
+	for _, e := range m.PurgeVacancyCandidatesMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to CandidatePurgerMock.PurgeVacancyCandidates at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterPurgeVacancyCandidatesCounter := mm_atomic.LoadUint64(&m.afterPurgeVacancyCandidatesCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.PurgeVacancyCandidatesMock.defaultExpectation != nil && afterPurgeVacancyCandidatesCounter < 1 {
+		if m.PurgeVacancyCandidatesMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to CandidatePurgerMock.PurgeVacancyCandidates at\n%s", m.PurgeVacancyCandidatesMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to CandidatePurgerMock.PurgeVacancyCandidates at\n%s with params: %#v", m.PurgeVacancyCandidatesMock.defaultExpectation.expectationOrigins.origin, *m.PurgeVacancyCandidatesMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcPurgeVacancyCandidates != nil && afterPurgeVacancyCandidatesCounter < 1 {
+		m.t.Errorf("Expected call to CandidatePurgerMock.PurgeVacancyCandidates at\n%s", m.funcPurgeVacancyCandidatesOrigin)
+	}
+
+	if !m.PurgeVacancyCandidatesMock.invocationsDone() && afterPurgeVacancyCandidatesCounter > 0 {
+		m.t.Errorf("Expected %d calls to CandidatePurgerMock.PurgeVacancyCandidates at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.PurgeVacancyCandidatesMock.expectedInvocations), m.PurgeVacancyCandidatesMock.expectedInvocationsOrigin, afterPurgeVacancyCandidatesCounter)
+	}
+}
+
+// MinimockFinish checks that all mocked methods have been called the expected number of times
+func (m *CandidatePurgerMock) MinimockFinish() {
+	m.finishOnce.Do(func() {
+		if !m.minimockDone() {
+			m.MinimockPurgeVacancyCandidatesInspect()
+		}
+	})
+}
+
+// MinimockWait waits for all mocked methods to be called the expected number of times
+func (m *CandidatePurgerMock) MinimockWait(timeout mm_time.Duration) {
+	timeoutCh := mm_time.After(timeout)
+	for {
+		if m.minimockDone() {
+			return
+		}
+		select {
+		case <-timeoutCh:
+			m.MinimockFinish()
+			return
+		case <-mm_time.After(10 * mm_time.Millisecond):
+		}
+	}
+}
+
+func (m *CandidatePurgerMock) minimockDone() bool {
+	done := true
+	return done &&
+		m.MinimockPurgeVacancyCandidatesDone()
+}
@@ -0,0 +1,262 @@
+// Code generated by http://github.com/gojuno/minimock (v3.4.7). DO NOT EDIT.
+
+package mocks
+
+import (
+	"sync"
+	mm_atomic "sync/atomic"
+	mm_time "time"
+
+	"github.com/gojuno/minimock/v3"
+)
+
+// EventNotifierMock implements mm_usecase.EventNotifier
+type EventNotifierMock struct {
+	t          minimock.Tester
+	finishOnce sync.Once
+
+	funcSubscribe func() (ch1 <-chan struct {
+	}, f1 func())
+	funcSubscribeOrigin    string
+	inspectFuncSubscribe   func()
+	afterSubscribeCounter  uint64
+	beforeSubscribeCounter uint64
+	SubscribeMock          mEventNotifierMockSubscribe
+}
+
+// NewEventNotifierMock returns a mock for mm_usecase.EventNotifier
+func NewEventNotifierMock(t minimock.Tester) *EventNotifierMock {
+	m := &EventNotifierMock{t: t}
+
+	if controller, ok := t.(minimock.MockController); ok {
+		controller.RegisterMocker(m)
+	}
+
+	m.SubscribeMock = mEventNotifierMockSubscribe{mock: m}
+
+	t.Cleanup(m.MinimockFinish)
+
+	return m
+}
+
+type mEventNotifierMockSubscribe struct {
+	optional           bool
+	mock               *EventNotifierMock
+	defaultExpectation *EventNotifierMockSubscribeExpectation
+	expectations       []*EventNotifierMockSubscribeExpectation
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// EventNotifierMockSubscribeExpectation specifies expectation struct of the EventNotifier.Subscribe
+type EventNotifierMockSubscribeExpectation struct {
+	mock *EventNotifierMock
+
+	results      *EventNotifierMockSubscribeResults
+	returnOrigin string
+	Counter      uint64
+}
+
+// EventNotifierMockSubscribeResults contains results of the EventNotifier.Subscribe
+type EventNotifierMockSubscribeResults struct {
+	ch1 <-chan struct {
+	}
+	f1 func()
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmSubscribe *mEventNotifierMockSubscribe) Optional() *mEventNotifierMockSubscribe {
+	mmSubscribe.optional = true
+	return mmSubscribe
+}
+
+// Expect sets up expected params for EventNotifier.Subscribe
+func (mmSubscribe *mEventNotifierMockSubscribe) Expect() *mEventNotifierMockSubscribe {
+	if mmSubscribe.mock.funcSubscribe != nil {
+		mmSubscribe.mock.t.Fatalf("EventNotifierMock.Subscribe mock is already set by Set")
+	}
+
+	if mmSubscribe.defaultExpectation == nil {
+		mmSubscribe.defaultExpectation = &EventNotifierMockSubscribeExpectation{}
+	}
+
+	return mmSubscribe
+}
+
+// Inspect accepts an inspector function that has same arguments as the EventNotifier.Subscribe
+func (mmSubscribe *mEventNotifierMockSubscribe) Inspect(f func()) *mEventNotifierMockSubscribe {
+	if mmSubscribe.mock.inspectFuncSubscribe != nil {
+		mmSubscribe.mock.t.Fatalf("Inspect function is already set for EventNotifierMock.Subscribe")
+	}
+
+	mmSubscribe.mock.inspectFuncSubscribe = f
+
+	return mmSubscribe
+}
+
+// Return sets up results that will be returned by EventNotifier.Subscribe
+func (mmSubscribe *mEventNotifierMockSubscribe) Return(ch1 <-chan struct {
+}, f1 func()) *EventNotifierMock {
+	if mmSubscribe.mock.funcSubscribe != nil {
+		mmSubscribe.mock.t.Fatalf("EventNotifierMock.Subscribe mock is already set by Set")
+	}
+
+	if mmSubscribe.defaultExpectation == nil {
+		mmSubscribe.defaultExpectation = &EventNotifierMockSubscribeExpectation{mock: mmSubscribe.mock}
+	}
+	mmSubscribe.defaultExpectation.results = &EventNotifierMockSubscribeResults{ch1, f1}
+	mmSubscribe.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmSubscribe.mock
+}
+
+// Set uses given function f to mock the EventNotifier.Subscribe method
+func (mmSubscribe *mEventNotifierMockSubscribe) Set(f func() (ch1 <-chan struct {
+}, f1 func())) *EventNotifierMock {
+	if mmSubscribe.defaultExpectation != nil {
+		mmSubscribe.mock.t.Fatalf("Default expectation is already set for the EventNotifier.Subscribe method")
+	}
+
+	if len(mmSubscribe.expectations) > 0 {
+		mmSubscribe.mock.t.Fatalf("Some expectations are already set for the EventNotifier.Subscribe method")
+	}
+
+	mmSubscribe.mock.funcSubscribe = f
+	mmSubscribe.mock.funcSubscribeOrigin = minimock.CallerInfo(1)
+	return mmSubscribe.mock
+}
+
+// Times sets number of times EventNotifier.Subscribe should be invoked
+func (mmSubscribe *mEventNotifierMockSubscribe) Times(n uint64) *mEventNotifierMockSubscribe {
+	if n == 0 {
+		mmSubscribe.mock.t.Fatalf("Times of EventNotifierMock.Subscribe mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmSubscribe.expectedInvocations, n)
+	mmSubscribe.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmSubscribe
+}
+
+func (mmSubscribe *mEventNotifierMockSubscribe) invocationsDone() bool {
+	if len(mmSubscribe.expectations) == 0 && mmSubscribe.defaultExpectation == nil && mmSubscribe.mock.funcSubscribe == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmSubscribe.mock.afterSubscribeCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmSubscribe.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// Subscribe implements mm_usecase.EventNotifier
+func (mmSubscribe *EventNotifierMock) Subscribe() (ch1 <-chan struct {
+}, f1 func()) {
+	mm_atomic.AddUint64(&mmSubscribe.beforeSubscribeCounter, 1)
+	defer mm_atomic.AddUint64(&mmSubscribe.afterSubscribeCounter, 1)
+
+	mmSubscribe.t.Helper()
+
+	if mmSubscribe.inspectFuncSubscribe != nil {
+		mmSubscribe.inspectFuncSubscribe()
+	}
+
+	if mmSubscribe.SubscribeMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmSubscribe.SubscribeMock.defaultExpectation.Counter, 1)
+
+		mm_results := mmSubscribe.SubscribeMock.defaultExpectation.results
+		if mm_results == nil {
+			mmSubscribe.t.Fatal("No results are set for the EventNotifierMock.Subscribe")
+		}
+		return (*mm_results).ch1, (*mm_results).f1
+	}
+	if mmSubscribe.funcSubscribe != nil {
+		return mmSubscribe.funcSubscribe()
+	}
+	mmSubscribe.t.Fatalf("Unexpected call to EventNotifierMock.Subscribe.")
+	return
+}
+
+// SubscribeAfterCounter returns a count of finished EventNotifierMock.Subscribe invocations
+func (mmSubscribe *EventNotifierMock) SubscribeAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmSubscribe.afterSubscribeCounter)
+}
+
+// SubscribeBeforeCounter returns a count of EventNotifierMock.Subscribe invocations
+func (mmSubscribe *EventNotifierMock) SubscribeBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmSubscribe.beforeSubscribeCounter)
+}
+
+// MinimockSubscribeDone returns true if the count of the Subscribe invocations corresponds
+// the number of defined expectations
+func (m *EventNotifierMock) MinimockSubscribeDone() bool {
+	if m.SubscribeMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.SubscribeMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.SubscribeMock.invocationsDone()
+}
+
+// MinimockSubscribeInspect logs each unmet expectation
+func (m *EventNotifierMock) MinimockSubscribeInspect() {
+	for _, e := range m.SubscribeMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Error("Expected call to EventNotifierMock.Subscribe")
+		}
+	}
+
+	afterSubscribeCounter := mm_atomic.LoadUint64(&m.afterSubscribeCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.SubscribeMock.defaultExpectation != nil && afterSubscribeCounter < 1 {
+		m.t.Errorf("Expected call to EventNotifierMock.Subscribe at\n%s", m.SubscribeMock.defaultExpectation.returnOrigin)
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcSubscribe != nil && afterSubscribeCounter < 1 {
+		m.t.Errorf("Expected call to EventNotifierMock.Subscribe at\n%s", m.funcSubscribeOrigin)
+	}
+
+	if !m.SubscribeMock.invocationsDone() && afterSubscribeCounter > 0 {
+		m.t.Errorf("Expected %d calls to EventNotifierMock.Subscribe at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.SubscribeMock.expectedInvocations), m.SubscribeMock.expectedInvocationsOrigin, afterSubscribeCounter)
+	}
+}
+
+// MinimockFinish checks that all mocked methods have been called the expected number of times
+func (m *EventNotifierMock) MinimockFinish() {
+	m.finishOnce.Do(func() {
+		if !m.minimockDone() {
+			m.MinimockSubscribeInspect()
+		}
+	})
+}
+
+// MinimockWait waits for all mocked methods to be called the expected number of times
+func (m *EventNotifierMock) MinimockWait(timeout mm_time.Duration) {
+	timeoutCh := mm_time.After(timeout)
+	for {
+		if m.minimockDone() {
+			return
+		}
+		select {
+		case <-timeoutCh:
+			m.MinimockFinish()
+			return
+		case <-mm_time.After(10 * mm_time.Millisecond):
+		}
+	}
+}
+
+func (m *EventNotifierMock) minimockDone() bool {
+	done := true
+	return done &&
+		m.MinimockSubscribeDone()
+}
@@ -0,0 +1,447 @@
+// Code generated by http://github.com/gojuno/minimock (v3.4.7). DO NOT EDIT.
+
+package mocks
+
+import (
+	"context"
+	"sync"
+	mm_atomic "sync/atomic"
+	mm_time "time"
+
+	"github.com/artem13815/hr/vacancy/internal/domain"
+	"github.com/gojuno/minimock/v3"
+)
+
+// EventStorageMock implements mm_usecase.EventStorage
+type EventStorageMock struct {
+	t          minimock.Tester
+	finishOnce sync.Once
+
+	funcListVacancyEvents          func(ctx context.Context, afterSeq uint64, limit int) (va1 []domain.VacancyEvent, err error)
+	funcListVacancyEventsOrigin    string
+	inspectFuncListVacancyEvents   func(ctx context.Context, afterSeq uint64, limit int)
+	afterListVacancyEventsCounter  uint64
+	beforeListVacancyEventsCounter uint64
+	ListVacancyEventsMock          mEventStorageMockListVacancyEvents
+}
+
+// NewEventStorageMock returns a mock for mm_usecase.EventStorage
+func NewEventStorageMock(t minimock.Tester) *EventStorageMock {
+	m := &EventStorageMock{t: t}
+
+	if controller, ok := t.(minimock.MockController); ok {
+		controller.RegisterMocker(m)
+	}
+
+	m.ListVacancyEventsMock = mEventStorageMockListVacancyEvents{mock: m}
+	m.ListVacancyEventsMock.callArgs = []*EventStorageMockListVacancyEventsParams{}
+
+	t.Cleanup(m.MinimockFinish)
+
+	return m
+}
+
+type mEventStorageMockListVacancyEvents struct {
+	optional           bool
+	mock               *EventStorageMock
+	defaultExpectation *EventStorageMockListVacancyEventsExpectation
+	expectations       []*EventStorageMockListVacancyEventsExpectation
+
+	callArgs []*EventStorageMockListVacancyEventsParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// EventStorageMockListVacancyEventsExpectation specifies expectation struct of the EventStorage.ListVacancyEvents
+type EventStorageMockListVacancyEventsExpectation struct {
+	mock               *EventStorageMock
+	params             *EventStorageMockListVacancyEventsParams
+	paramPtrs          *EventStorageMockListVacancyEventsParamPtrs
+	expectationOrigins EventStorageMockListVacancyEventsExpectationOrigins
+	results            *EventStorageMockListVacancyEventsResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// EventStorageMockListVacancyEventsParams contains parameters of the EventStorage.ListVacancyEvents
+type EventStorageMockListVacancyEventsParams struct {
+	ctx      context.Context
+	afterSeq uint64
+	limit    int
+}
+
+// EventStorageMockListVacancyEventsParamPtrs contains pointers to parameters of the EventStorage.ListVacancyEvents
+type EventStorageMockListVacancyEventsParamPtrs struct {
+	ctx      *context.Context
+	afterSeq *uint64
+	limit    *int
+}
+
+// EventStorageMockListVacancyEventsResults contains results of the EventStorage.ListVacancyEvents
+type EventStorageMockListVacancyEventsResults struct {
+	va1 []domain.VacancyEvent
+	err error
+}
+
+// EventStorageMockListVacancyEventsOrigins contains origins of expectations of the EventStorage.ListVacancyEvents
+type EventStorageMockListVacancyEventsExpectationOrigins struct {
+	origin         string
+	originCtx      string
+	originAfterSeq string
+	originLimit    string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmListVacancyEvents *mEventStorageMockListVacancyEvents) Optional() *mEventStorageMockListVacancyEvents {
+	mmListVacancyEvents.optional = true
+	return mmListVacancyEvents
+}
+
+// Expect sets up expected params for EventStorage.ListVacancyEvents
+func (mmListVacancyEvents *mEventStorageMockListVacancyEvents) Expect(ctx context.Context, afterSeq uint64, limit int) *mEventStorageMockListVacancyEvents {
+	if mmListVacancyEvents.mock.funcListVacancyEvents != nil {
+		mmListVacancyEvents.mock.t.Fatalf("EventStorageMock.ListVacancyEvents mock is already set by Set")
+	}
+
+	if mmListVacancyEvents.defaultExpectation == nil {
+		mmListVacancyEvents.defaultExpectation = &EventStorageMockListVacancyEventsExpectation{}
+	}
+
+	if mmListVacancyEvents.defaultExpectation.paramPtrs != nil {
+		mmListVacancyEvents.mock.t.Fatalf("EventStorageMock.ListVacancyEvents mock is already set by ExpectParams functions")
+	}
+
+	mmListVacancyEvents.defaultExpectation.params = &EventStorageMockListVacancyEventsParams{ctx, afterSeq, limit}
+	mmListVacancyEvents.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmListVacancyEvents.expectations {
+		if minimock.Equal(e.params, mmListVacancyEvents.defaultExpectation.params) {
+			mmListVacancyEvents.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmListVacancyEvents.defaultExpectation.params)
+		}
+	}
+
+	return mmListVacancyEvents
+}
+
+// ExpectCtxParam1 sets up expected param ctx for EventStorage.ListVacancyEvents
+func (mmListVacancyEvents *mEventStorageMockListVacancyEvents) ExpectCtxParam1(ctx context.Context) *mEventStorageMockListVacancyEvents {
+	if mmListVacancyEvents.mock.funcListVacancyEvents != nil {
+		mmListVacancyEvents.mock.t.Fatalf("EventStorageMock.ListVacancyEvents mock is already set by Set")
+	}
+
+	if mmListVacancyEvents.defaultExpectation == nil {
+		mmListVacancyEvents.defaultExpectation = &EventStorageMockListVacancyEventsExpectation{}
+	}
+
+	if mmListVacancyEvents.defaultExpectation.params != nil {
+		mmListVacancyEvents.mock.t.Fatalf("EventStorageMock.ListVacancyEvents mock is already set by Expect")
+	}
+
+	if mmListVacancyEvents.defaultExpectation.paramPtrs == nil {
+		mmListVacancyEvents.defaultExpectation.paramPtrs = &EventStorageMockListVacancyEventsParamPtrs{}
+	}
+	mmListVacancyEvents.defaultExpectation.paramPtrs.ctx = &ctx
+	mmListVacancyEvents.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmListVacancyEvents
+}
+
+// ExpectAfterSeqParam2 sets up expected param afterSeq for EventStorage.ListVacancyEvents
+func (mmListVacancyEvents *mEventStorageMockListVacancyEvents) ExpectAfterSeqParam2(afterSeq uint64) *mEventStorageMockListVacancyEvents {
+	if mmListVacancyEvents.mock.funcListVacancyEvents != nil {
+		mmListVacancyEvents.mock.t.Fatalf("EventStorageMock.ListVacancyEvents mock is already set by Set")
+	}
+
+	if mmListVacancyEvents.defaultExpectation == nil {
+		mmListVacancyEvents.defaultExpectation = &EventStorageMockListVacancyEventsExpectation{}
+	}
+
+	if mmListVacancyEvents.defaultExpectation.params != nil {
+		mmListVacancyEvents.mock.t.Fatalf("EventStorageMock.ListVacancyEvents mock is already set by Expect")
+	}
+
+	if mmListVacancyEvents.defaultExpectation.paramPtrs == nil {
+		mmListVacancyEvents.defaultExpectation.paramPtrs = &EventStorageMockListVacancyEventsParamPtrs{}
+	}
+	mmListVacancyEvents.defaultExpectation.paramPtrs.afterSeq = &afterSeq
+	mmListVacancyEvents.defaultExpectation.expectationOrigins.originAfterSeq = minimock.CallerInfo(1)
+
+	return mmListVacancyEvents
+}
+
+// ExpectLimitParam3 sets up expected param limit for EventStorage.ListVacancyEvents
+func (mmListVacancyEvents *mEventStorageMockListVacancyEvents) ExpectLimitParam3(limit int) *mEventStorageMockListVacancyEvents {
+	if mmListVacancyEvents.mock.funcListVacancyEvents != nil {
+		mmListVacancyEvents.mock.t.Fatalf("EventStorageMock.ListVacancyEvents mock is already set by Set")
+	}
+
+	if mmListVacancyEvents.defaultExpectation == nil {
+		mmListVacancyEvents.defaultExpectation = &EventStorageMockListVacancyEventsExpectation{}
+	}
+
+	if mmListVacancyEvents.defaultExpectation.params != nil {
+		mmListVacancyEvents.mock.t.Fatalf("EventStorageMock.ListVacancyEvents mock is already set by Expect")
+	}
+
+	if mmListVacancyEvents.defaultExpectation.paramPtrs == nil {
+		mmListVacancyEvents.defaultExpectation.paramPtrs = &EventStorageMockListVacancyEventsParamPtrs{}
+	}
+	mmListVacancyEvents.defaultExpectation.paramPtrs.limit = &limit
+	mmListVacancyEvents.defaultExpectation.expectationOrigins.originLimit = minimock.CallerInfo(1)
+
+	return mmListVacancyEvents
+}
+
+// Inspect accepts an inspector function that has same arguments as the EventStorage.ListVacancyEvents
+func (mmListVacancyEvents *mEventStorageMockListVacancyEvents) Inspect(f func(ctx context.Context, afterSeq uint64, limit int)) *mEventStorageMockListVacancyEvents {
+	if mmListVacancyEvents.mock.inspectFuncListVacancyEvents != nil {
+		mmListVacancyEvents.mock.t.Fatalf("Inspect function is already set for EventStorageMock.ListVacancyEvents")
+	}
+
+	mmListVacancyEvents.mock.inspectFuncListVacancyEvents = f
+
+	return mmListVacancyEvents
+}
+
+// Return sets up results that will be returned by EventStorage.ListVacancyEvents
+func (mmListVacancyEvents *mEventStorageMockListVacancyEvents) Return(va1 []domain.VacancyEvent, err error) *EventStorageMock {
+	if mmListVacancyEvents.mock.funcListVacancyEvents != nil {
+		mmListVacancyEvents.mock.t.Fatalf("EventStorageMock.ListVacancyEvents mock is already set by Set")
+	}
+
+	if mmListVacancyEvents.defaultExpectation == nil {
+		mmListVacancyEvents.defaultExpectation = &EventStorageMockListVacancyEventsExpectation{mock: mmListVacancyEvents.mock}
+	}
+	mmListVacancyEvents.defaultExpectation.results = &EventStorageMockListVacancyEventsResults{va1, err}
+	mmListVacancyEvents.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmListVacancyEvents.mock
+}
+
+// Set uses given function f to mock the EventStorage.ListVacancyEvents method
+func (mmListVacancyEvents *mEventStorageMockListVacancyEvents) Set(f func(ctx context.Context, afterSeq uint64, limit int) (va1 []domain.VacancyEvent, err error)) *EventStorageMock {
+	if mmListVacancyEvents.defaultExpectation != nil {
+		mmListVacancyEvents.mock.t.Fatalf("Default expectation is already set for the EventStorage.ListVacancyEvents method")
+	}
+
+	if len(mmListVacancyEvents.expectations) > 0 {
+		mmListVacancyEvents.mock.t.Fatalf("Some expectations are already set for the EventStorage.ListVacancyEvents method")
+	}
+
+	mmListVacancyEvents.mock.funcListVacancyEvents = f
+	mmListVacancyEvents.mock.funcListVacancyEventsOrigin = minimock.CallerInfo(1)
+	return mmListVacancyEvents.mock
+}
+
+// When sets expectation for the EventStorage.ListVacancyEvents which will trigger the result defined by the following
+// Then helper
+func (mmListVacancyEvents *mEventStorageMockListVacancyEvents) When(ctx context.Context, afterSeq uint64, limit int) *EventStorageMockListVacancyEventsExpectation {
+	if mmListVacancyEvents.mock.funcListVacancyEvents != nil {
+		mmListVacancyEvents.mock.t.Fatalf("EventStorageMock.ListVacancyEvents mock is already set by Set")
+	}
+
+	expectation := &EventStorageMockListVacancyEventsExpectation{
+		mock:               mmListVacancyEvents.mock,
+		params:             &EventStorageMockListVacancyEventsParams{ctx, afterSeq, limit},
+		expectationOrigins: EventStorageMockListVacancyEventsExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmListVacancyEvents.expectations = append(mmListVacancyEvents.expectations, expectation)
+	return expectation
+}
+
+// Then sets up EventStorage.ListVacancyEvents return parameters for the expectation previously defined by the When method
+func (e *EventStorageMockListVacancyEventsExpectation) Then(va1 []domain.VacancyEvent, err error) *EventStorageMock {
+	e.results = &EventStorageMockListVacancyEventsResults{va1, err}
+	return e.mock
+}
+
+// Times sets number of times EventStorage.ListVacancyEvents should be invoked
+func (mmListVacancyEvents *mEventStorageMockListVacancyEvents) Times(n uint64) *mEventStorageMockListVacancyEvents {
+	if n == 0 {
+		mmListVacancyEvents.mock.t.Fatalf("Times of EventStorageMock.ListVacancyEvents mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmListVacancyEvents.expectedInvocations, n)
+	mmListVacancyEvents.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmListVacancyEvents
+}
+
+func (mmListVacancyEvents *mEventStorageMockListVacancyEvents) invocationsDone() bool {
+	if len(mmListVacancyEvents.expectations) == 0 && mmListVacancyEvents.defaultExpectation == nil && mmListVacancyEvents.mock.funcListVacancyEvents == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmListVacancyEvents.mock.afterListVacancyEventsCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmListVacancyEvents.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// ListVacancyEvents implements mm_usecase.EventStorage
+func (mmListVacancyEvents *EventStorageMock) ListVacancyEvents(ctx context.Context, afterSeq uint64, limit int) (va1 []domain.VacancyEvent, err error) {
+	mm_atomic.AddUint64(&mmListVacancyEvents.beforeListVacancyEventsCounter, 1)
+	defer mm_atomic.AddUint64(&mmListVacancyEvents.afterListVacancyEventsCounter, 1)
+
+	mmListVacancyEvents.t.Helper()
+
+	if mmListVacancyEvents.inspectFuncListVacancyEvents != nil {
+		mmListVacancyEvents.inspectFuncListVacancyEvents(ctx, afterSeq, limit)
+	}
+
+	mm_params := EventStorageMockListVacancyEventsParams{ctx, afterSeq, limit}
+
+	// Record call args
+	mmListVacancyEvents.ListVacancyEventsMock.mutex.Lock()
+	mmListVacancyEvents.ListVacancyEventsMock.callArgs = append(mmListVacancyEvents.ListVacancyEventsMock.callArgs, &mm_params)
+	mmListVacancyEvents.ListVacancyEventsMock.mutex.Unlock()
+
+	for _, e := range mmListVacancyEvents.ListVacancyEventsMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.va1, e.results.err
+		}
+	}
+
+	if mmListVacancyEvents.ListVacancyEventsMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmListVacancyEvents.ListVacancyEventsMock.defaultExpectation.Counter, 1)
+		mm_want := mmListVacancyEvents.ListVacancyEventsMock.defaultExpectation.params
+		mm_want_ptrs := mmListVacancyEvents.ListVacancyEventsMock.defaultExpectation.paramPtrs
+
+		mm_got := EventStorageMockListVacancyEventsParams{ctx, afterSeq, limit}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmListVacancyEvents.t.Errorf("EventStorageMock.ListVacancyEvents got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmListVacancyEvents.ListVacancyEventsMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.afterSeq != nil && !minimock.Equal(*mm_want_ptrs.afterSeq, mm_got.afterSeq) {
+				mmListVacancyEvents.t.Errorf("EventStorageMock.ListVacancyEvents got unexpected parameter afterSeq, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmListVacancyEvents.ListVacancyEventsMock.defaultExpectation.expectationOrigins.originAfterSeq, *mm_want_ptrs.afterSeq, mm_got.afterSeq, minimock.Diff(*mm_want_ptrs.afterSeq, mm_got.afterSeq))
+			}
+
+			if mm_want_ptrs.limit != nil && !minimock.Equal(*mm_want_ptrs.limit, mm_got.limit) {
+				mmListVacancyEvents.t.Errorf("EventStorageMock.ListVacancyEvents got unexpected parameter limit, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmListVacancyEvents.ListVacancyEventsMock.defaultExpectation.expectationOrigins.originLimit, *mm_want_ptrs.limit, mm_got.limit, minimock.Diff(*mm_want_ptrs.limit, mm_got.limit))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmListVacancyEvents.t.Errorf("EventStorageMock.ListVacancyEvents got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmListVacancyEvents.ListVacancyEventsMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmListVacancyEvents.ListVacancyEventsMock.defaultExpectation.results
+		if mm_results == nil {
+			mmListVacancyEvents.t.Fatal("No results are set for the EventStorageMock.ListVacancyEvents")
+		}
+		return (*mm_results).va1, (*mm_results).err
+	}
+	if mmListVacancyEvents.funcListVacancyEvents != nil {
+		return mmListVacancyEvents.funcListVacancyEvents(ctx, afterSeq, limit)
+	}
+	mmListVacancyEvents.t.Fatalf("Unexpected call to EventStorageMock.ListVacancyEvents. %v %v %v", ctx, afterSeq, limit)
+	return
+}
+
+// ListVacancyEventsAfterCounter returns a count of finished EventStorageMock.ListVacancyEvents invocations
+func (mmListVacancyEvents *EventStorageMock) ListVacancyEventsAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmListVacancyEvents.afterListVacancyEventsCounter)
+}
+
+// ListVacancyEventsBeforeCounter returns a count of EventStorageMock.ListVacancyEvents invocations
+func (mmListVacancyEvents *EventStorageMock) ListVacancyEventsBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmListVacancyEvents.beforeListVacancyEventsCounter)
+}
+
+// Calls returns a list of arguments used in each call to EventStorageMock.ListVacancyEvents.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmListVacancyEvents *mEventStorageMockListVacancyEvents) Calls() []*EventStorageMockListVacancyEventsParams {
+	mmListVacancyEvents.mutex.RLock()
+
+	argCopy := make([]*EventStorageMockListVacancyEventsParams, len(mmListVacancyEvents.callArgs))
+	copy(argCopy, mmListVacancyEvents.callArgs)
+
+	mmListVacancyEvents.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockListVacancyEventsDone returns true if the count of the ListVacancyEvents invocations corresponds
+// the number of defined expectations
+func (m *EventStorageMock) MinimockListVacancyEventsDone() bool {
+	if m.ListVacancyEventsMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.ListVacancyEventsMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.ListVacancyEventsMock.invocationsDone()
+}
+
+// MinimockListVacancyEventsInspect logs each unmet expectation
+func (m *EventStorageMock) MinimockListVacancyEventsInspect() {
+	for _, e := range m.ListVacancyEventsMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to EventStorageMock.ListVacancyEvents at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterListVacancyEventsCounter := mm_atomic.LoadUint64(&m.afterListVacancyEventsCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.ListVacancyEventsMock.defaultExpectation != nil && afterListVacancyEventsCounter < 1 {
+		if m.ListVacancyEventsMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to EventStorageMock.ListVacancyEvents at\n%s", m.ListVacancyEventsMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to EventStorageMock.ListVacancyEvents at\n%s with params: %#v", m.ListVacancyEventsMock.defaultExpectation.expectationOrigins.origin, *m.ListVacancyEventsMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcListVacancyEvents != nil && afterListVacancyEventsCounter < 1 {
+		m.t.Errorf("Expected call to EventStorageMock.ListVacancyEvents at\n%s", m.funcListVacancyEventsOrigin)
+	}
+
+	if !m.ListVacancyEventsMock.invocationsDone() && afterListVacancyEventsCounter > 0 {
+		m.t.Errorf("Expected %d calls to EventStorageMock.ListVacancyEvents at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.ListVacancyEventsMock.expectedInvocations), m.ListVacancyEventsMock.expectedInvocationsOrigin, afterListVacancyEventsCounter)
+	}
+}
+
+// MinimockFinish checks that all mocked methods have been called the expected number of times
+func (m *EventStorageMock) MinimockFinish() {
+	m.finishOnce.Do(func() {
+		if !m.minimockDone() {
+			m.MinimockListVacancyEventsInspect()
+		}
+	})
+}
+
+// MinimockWait waits for all mocked methods to be called the expected number of times
+func (m *EventStorageMock) MinimockWait(timeout mm_time.Duration) {
+	timeoutCh := mm_time.After(timeout)
+	for {
+		if m.minimockDone() {
+			return
+		}
+		select {
+		case <-timeoutCh:
+			m.MinimockFinish()
+			return
+		case <-mm_time.After(10 * mm_time.Millisecond):
+		}
+	}
+}
+
+func (m *EventStorageMock) minimockDone() bool {
+	done := true
+	return done &&
+		m.MinimockListVacancyEventsDone()
+}
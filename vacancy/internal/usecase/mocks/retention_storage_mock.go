@@ -0,0 +1,1189 @@
+// Code generated by http://github.com/gojuno/minimock (v3.4.7). DO NOT EDIT.
+
+package mocks
+
+import (
+	"context"
+	"sync"
+	mm_atomic "sync/atomic"
+	"time"
+	mm_time "time"
+
+	"github.com/gojuno/minimock/v3"
+)
+
+// RetentionStorageMock implements mm_usecase.RetentionStorage
+type RetentionStorageMock struct {
+	t          minimock.Tester
+	finishOnce sync.Once
+
+	funcListPurgeableVacancies          func(ctx context.Context, cutoff time.Time, limit uint32) (sa1 []string, err error)
+	funcListPurgeableVacanciesOrigin    string
+	inspectFuncListPurgeableVacancies   func(ctx context.Context, cutoff time.Time, limit uint32)
+	afterListPurgeableVacanciesCounter  uint64
+	beforeListPurgeableVacanciesCounter uint64
+	ListPurgeableVacanciesMock          mRetentionStorageMockListPurgeableVacancies
+
+	funcPurgeVacancy          func(ctx context.Context, vacancyID string) (err error)
+	funcPurgeVacancyOrigin    string
+	inspectFuncPurgeVacancy   func(ctx context.Context, vacancyID string)
+	afterPurgeVacancyCounter  uint64
+	beforePurgeVacancyCounter uint64
+	PurgeVacancyMock          mRetentionStorageMockPurgeVacancy
+
+	funcStartVacancyPurge          func(ctx context.Context, vacancyID string, cutoff time.Time) (b1 bool, err error)
+	funcStartVacancyPurgeOrigin    string
+	inspectFuncStartVacancyPurge   func(ctx context.Context, vacancyID string, cutoff time.Time)
+	afterStartVacancyPurgeCounter  uint64
+	beforeStartVacancyPurgeCounter uint64
+	StartVacancyPurgeMock          mRetentionStorageMockStartVacancyPurge
+}
+
+// NewRetentionStorageMock returns a mock for mm_usecase.RetentionStorage
+func NewRetentionStorageMock(t minimock.Tester) *RetentionStorageMock {
+	m := &RetentionStorageMock{t: t}
+
+	if controller, ok := t.(minimock.MockController); ok {
+		controller.RegisterMocker(m)
+	}
+
+	m.ListPurgeableVacanciesMock = mRetentionStorageMockListPurgeableVacancies{mock: m}
+	m.ListPurgeableVacanciesMock.callArgs = []*RetentionStorageMockListPurgeableVacanciesParams{}
+
+	m.PurgeVacancyMock = mRetentionStorageMockPurgeVacancy{mock: m}
+	m.PurgeVacancyMock.callArgs = []*RetentionStorageMockPurgeVacancyParams{}
+
+	m.StartVacancyPurgeMock = mRetentionStorageMockStartVacancyPurge{mock: m}
+	m.StartVacancyPurgeMock.callArgs = []*RetentionStorageMockStartVacancyPurgeParams{}
+
+	t.Cleanup(m.MinimockFinish)
+
+	return m
+}
+
+type mRetentionStorageMockListPurgeableVacancies struct {
+	optional           bool
+	mock               *RetentionStorageMock
+	defaultExpectation *RetentionStorageMockListPurgeableVacanciesExpectation
+	expectations       []*RetentionStorageMockListPurgeableVacanciesExpectation
+
+	callArgs []*RetentionStorageMockListPurgeableVacanciesParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// RetentionStorageMockListPurgeableVacanciesExpectation specifies expectation struct of the RetentionStorage.ListPurgeableVacancies
+type RetentionStorageMockListPurgeableVacanciesExpectation struct {
+	mock               *RetentionStorageMock
+	params             *RetentionStorageMockListPurgeableVacanciesParams
+	paramPtrs          *RetentionStorageMockListPurgeableVacanciesParamPtrs
+	expectationOrigins RetentionStorageMockListPurgeableVacanciesExpectationOrigins
+	results            *RetentionStorageMockListPurgeableVacanciesResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// RetentionStorageMockListPurgeableVacanciesParams contains parameters of the RetentionStorage.ListPurgeableVacancies
+type RetentionStorageMockListPurgeableVacanciesParams struct {
+	ctx    context.Context
+	cutoff time.Time
+	limit  uint32
+}
+
+// RetentionStorageMockListPurgeableVacanciesParamPtrs contains pointers to parameters of the RetentionStorage.ListPurgeableVacancies
+type RetentionStorageMockListPurgeableVacanciesParamPtrs struct {
+	ctx    *context.Context
+	cutoff *time.Time
+	limit  *uint32
+}
+
+// RetentionStorageMockListPurgeableVacanciesResults contains results of the RetentionStorage.ListPurgeableVacancies
+type RetentionStorageMockListPurgeableVacanciesResults struct {
+	sa1 []string
+	err error
+}
+
+// RetentionStorageMockListPurgeableVacanciesOrigins contains origins of expectations of the RetentionStorage.ListPurgeableVacancies
+type RetentionStorageMockListPurgeableVacanciesExpectationOrigins struct {
+	origin       string
+	originCtx    string
+	originCutoff string
+	originLimit  string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmListPurgeableVacancies *mRetentionStorageMockListPurgeableVacancies) Optional() *mRetentionStorageMockListPurgeableVacancies {
+	mmListPurgeableVacancies.optional = true
+	return mmListPurgeableVacancies
+}
+
+// Expect sets up expected params for RetentionStorage.ListPurgeableVacancies
+func (mmListPurgeableVacancies *mRetentionStorageMockListPurgeableVacancies) Expect(ctx context.Context, cutoff time.Time, limit uint32) *mRetentionStorageMockListPurgeableVacancies {
+	if mmListPurgeableVacancies.mock.funcListPurgeableVacancies != nil {
+		mmListPurgeableVacancies.mock.t.Fatalf("RetentionStorageMock.ListPurgeableVacancies mock is already set by Set")
+	}
+
+	if mmListPurgeableVacancies.defaultExpectation == nil {
+		mmListPurgeableVacancies.defaultExpectation = &RetentionStorageMockListPurgeableVacanciesExpectation{}
+	}
+
+	if mmListPurgeableVacancies.defaultExpectation.paramPtrs != nil {
+		mmListPurgeableVacancies.mock.t.Fatalf("RetentionStorageMock.ListPurgeableVacancies mock is already set by ExpectParams functions")
+	}
+
+	mmListPurgeableVacancies.defaultExpectation.params = &RetentionStorageMockListPurgeableVacanciesParams{ctx, cutoff, limit}
+	mmListPurgeableVacancies.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmListPurgeableVacancies.expectations {
+		if minimock.Equal(e.params, mmListPurgeableVacancies.defaultExpectation.params) {
+			mmListPurgeableVacancies.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmListPurgeableVacancies.defaultExpectation.params)
+		}
+	}
+
+	return mmListPurgeableVacancies
+}
+
+// ExpectCtxParam1 sets up expected param ctx for RetentionStorage.ListPurgeableVacancies
+func (mmListPurgeableVacancies *mRetentionStorageMockListPurgeableVacancies) ExpectCtxParam1(ctx context.Context) *mRetentionStorageMockListPurgeableVacancies {
+	if mmListPurgeableVacancies.mock.funcListPurgeableVacancies != nil {
+		mmListPurgeableVacancies.mock.t.Fatalf("RetentionStorageMock.ListPurgeableVacancies mock is already set by Set")
+	}
+
+	if mmListPurgeableVacancies.defaultExpectation == nil {
+		mmListPurgeableVacancies.defaultExpectation = &RetentionStorageMockListPurgeableVacanciesExpectation{}
+	}
+
+	if mmListPurgeableVacancies.defaultExpectation.params != nil {
+		mmListPurgeableVacancies.mock.t.Fatalf("RetentionStorageMock.ListPurgeableVacancies mock is already set by Expect")
+	}
+
+	if mmListPurgeableVacancies.defaultExpectation.paramPtrs == nil {
+		mmListPurgeableVacancies.defaultExpectation.paramPtrs = &RetentionStorageMockListPurgeableVacanciesParamPtrs{}
+	}
+	mmListPurgeableVacancies.defaultExpectation.paramPtrs.ctx = &ctx
+	mmListPurgeableVacancies.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmListPurgeableVacancies
+}
+
+// ExpectCutoffParam2 sets up expected param cutoff for RetentionStorage.ListPurgeableVacancies
+func (mmListPurgeableVacancies *mRetentionStorageMockListPurgeableVacancies) ExpectCutoffParam2(cutoff time.Time) *mRetentionStorageMockListPurgeableVacancies {
+	if mmListPurgeableVacancies.mock.funcListPurgeableVacancies != nil {
+		mmListPurgeableVacancies.mock.t.Fatalf("RetentionStorageMock.ListPurgeableVacancies mock is already set by Set")
+	}
+
+	if mmListPurgeableVacancies.defaultExpectation == nil {
+		mmListPurgeableVacancies.defaultExpectation = &RetentionStorageMockListPurgeableVacanciesExpectation{}
+	}
+
+	if mmListPurgeableVacancies.defaultExpectation.params != nil {
+		mmListPurgeableVacancies.mock.t.Fatalf("RetentionStorageMock.ListPurgeableVacancies mock is already set by Expect")
+	}
+
+	if mmListPurgeableVacancies.defaultExpectation.paramPtrs == nil {
+		mmListPurgeableVacancies.defaultExpectation.paramPtrs = &RetentionStorageMockListPurgeableVacanciesParamPtrs{}
+	}
+	mmListPurgeableVacancies.defaultExpectation.paramPtrs.cutoff = &cutoff
+	mmListPurgeableVacancies.defaultExpectation.expectationOrigins.originCutoff = minimock.CallerInfo(1)
+
+	return mmListPurgeableVacancies
+}
+
+// ExpectLimitParam3 sets up expected param limit for RetentionStorage.ListPurgeableVacancies
+func (mmListPurgeableVacancies *mRetentionStorageMockListPurgeableVacancies) ExpectLimitParam3(limit uint32) *mRetentionStorageMockListPurgeableVacancies {
+	if mmListPurgeableVacancies.mock.funcListPurgeableVacancies != nil {
+		mmListPurgeableVacancies.mock.t.Fatalf("RetentionStorageMock.ListPurgeableVacancies mock is already set by Set")
+	}
+
+	if mmListPurgeableVacancies.defaultExpectation == nil {
+		mmListPurgeableVacancies.defaultExpectation = &RetentionStorageMockListPurgeableVacanciesExpectation{}
+	}
+
+	if mmListPurgeableVacancies.defaultExpectation.params != nil {
+		mmListPurgeableVacancies.mock.t.Fatalf("RetentionStorageMock.ListPurgeableVacancies mock is already set by Expect")
+	}
+
+	if mmListPurgeableVacancies.defaultExpectation.paramPtrs == nil {
+		mmListPurgeableVacancies.defaultExpectation.paramPtrs = &RetentionStorageMockListPurgeableVacanciesParamPtrs{}
+	}
+	mmListPurgeableVacancies.defaultExpectation.paramPtrs.limit = &limit
+	mmListPurgeableVacancies.defaultExpectation.expectationOrigins.originLimit = minimock.CallerInfo(1)
+
+	return mmListPurgeableVacancies
+}
+
+// Inspect accepts an inspector function that has same arguments as the RetentionStorage.ListPurgeableVacancies
+func (mmListPurgeableVacancies *mRetentionStorageMockListPurgeableVacancies) Inspect(f func(ctx context.Context, cutoff time.Time, limit uint32)) *mRetentionStorageMockListPurgeableVacancies {
+	if mmListPurgeableVacancies.mock.inspectFuncListPurgeableVacancies != nil {
+		mmListPurgeableVacancies.mock.t.Fatalf("Inspect function is already set for RetentionStorageMock.ListPurgeableVacancies")
+	}
+
+	mmListPurgeableVacancies.mock.inspectFuncListPurgeableVacancies = f
+
+	return mmListPurgeableVacancies
+}
+
+// Return sets up results that will be returned by RetentionStorage.ListPurgeableVacancies
+func (mmListPurgeableVacancies *mRetentionStorageMockListPurgeableVacancies) Return(sa1 []string, err error) *RetentionStorageMock {
+	if mmListPurgeableVacancies.mock.funcListPurgeableVacancies != nil {
+		mmListPurgeableVacancies.mock.t.Fatalf("RetentionStorageMock.ListPurgeableVacancies mock is already set by Set")
+	}
+
+	if mmListPurgeableVacancies.defaultExpectation == nil {
+		mmListPurgeableVacancies.defaultExpectation = &RetentionStorageMockListPurgeableVacanciesExpectation{mock: mmListPurgeableVacancies.mock}
+	}
+	mmListPurgeableVacancies.defaultExpectation.results = &RetentionStorageMockListPurgeableVacanciesResults{sa1, err}
+	mmListPurgeableVacancies.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmListPurgeableVacancies.mock
+}
+
+// Set uses given function f to mock the RetentionStorage.ListPurgeableVacancies method
+func (mmListPurgeableVacancies *mRetentionStorageMockListPurgeableVacancies) Set(f func(ctx context.Context, cutoff time.Time, limit uint32) (sa1 []string, err error)) *RetentionStorageMock {
+	if mmListPurgeableVacancies.defaultExpectation != nil {
+		mmListPurgeableVacancies.mock.t.Fatalf("Default expectation is already set for the RetentionStorage.ListPurgeableVacancies method")
+	}
+
+	if len(mmListPurgeableVacancies.expectations) > 0 {
+		mmListPurgeableVacancies.mock.t.Fatalf("Some expectations are already set for the RetentionStorage.ListPurgeableVacancies method")
+	}
+
+	mmListPurgeableVacancies.mock.funcListPurgeableVacancies = f
+	mmListPurgeableVacancies.mock.funcListPurgeableVacanciesOrigin = minimock.CallerInfo(1)
+	return mmListPurgeableVacancies.mock
+}
+
+// When sets expectation for the RetentionStorage.ListPurgeableVacancies which will trigger the result defined by the following
+// Then helper
+func (mmListPurgeableVacancies *mRetentionStorageMockListPurgeableVacancies) When(ctx context.Context, cutoff time.Time, limit uint32) *RetentionStorageMockListPurgeableVacanciesExpectation {
+	if mmListPurgeableVacancies.mock.funcListPurgeableVacancies != nil {
+		mmListPurgeableVacancies.mock.t.Fatalf("RetentionStorageMock.ListPurgeableVacancies mock is already set by Set")
+	}
+
+	expectation := &RetentionStorageMockListPurgeableVacanciesExpectation{
+		mock:               mmListPurgeableVacancies.mock,
+		params:             &RetentionStorageMockListPurgeableVacanciesParams{ctx, cutoff, limit},
+		expectationOrigins: RetentionStorageMockListPurgeableVacanciesExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmListPurgeableVacancies.expectations = append(mmListPurgeableVacancies.expectations, expectation)
+	return expectation
+}
+
+// Then sets up RetentionStorage.ListPurgeableVacancies return parameters for the expectation previously defined by the When method
+func (e *RetentionStorageMockListPurgeableVacanciesExpectation) Then(sa1 []string, err error) *RetentionStorageMock {
+	e.results = &RetentionStorageMockListPurgeableVacanciesResults{sa1, err}
+	return e.mock
+}
+
+// Times sets number of times RetentionStorage.ListPurgeableVacancies should be invoked
+func (mmListPurgeableVacancies *mRetentionStorageMockListPurgeableVacancies) Times(n uint64) *mRetentionStorageMockListPurgeableVacancies {
+	if n == 0 {
+		mmListPurgeableVacancies.mock.t.Fatalf("Times of RetentionStorageMock.ListPurgeableVacancies mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmListPurgeableVacancies.expectedInvocations, n)
+	mmListPurgeableVacancies.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmListPurgeableVacancies
+}
+
+func (mmListPurgeableVacancies *mRetentionStorageMockListPurgeableVacancies) invocationsDone() bool {
+	if len(mmListPurgeableVacancies.expectations) == 0 && mmListPurgeableVacancies.defaultExpectation == nil && mmListPurgeableVacancies.mock.funcListPurgeableVacancies == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmListPurgeableVacancies.mock.afterListPurgeableVacanciesCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmListPurgeableVacancies.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// ListPurgeableVacancies implements mm_usecase.RetentionStorage
+func (mmListPurgeableVacancies *RetentionStorageMock) ListPurgeableVacancies(ctx context.Context, cutoff time.Time, limit uint32) (sa1 []string, err error) {
+	mm_atomic.AddUint64(&mmListPurgeableVacancies.beforeListPurgeableVacanciesCounter, 1)
+	defer mm_atomic.AddUint64(&mmListPurgeableVacancies.afterListPurgeableVacanciesCounter, 1)
+
+	mmListPurgeableVacancies.t.Helper()
+
+	if mmListPurgeableVacancies.inspectFuncListPurgeableVacancies != nil {
+		mmListPurgeableVacancies.inspectFuncListPurgeableVacancies(ctx, cutoff, limit)
+	}
+
+	mm_params := RetentionStorageMockListPurgeableVacanciesParams{ctx, cutoff, limit}
+
+	// Record call args
+	mmListPurgeableVacancies.ListPurgeableVacanciesMock.mutex.Lock()
+	mmListPurgeableVacancies.ListPurgeableVacanciesMock.callArgs = append(mmListPurgeableVacancies.ListPurgeableVacanciesMock.callArgs, &mm_params)
+	mmListPurgeableVacancies.ListPurgeableVacanciesMock.mutex.Unlock()
+
+	for _, e := range mmListPurgeableVacancies.ListPurgeableVacanciesMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.sa1, e.results.err
+		}
+	}
+
+	if mmListPurgeableVacancies.ListPurgeableVacanciesMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmListPurgeableVacancies.ListPurgeableVacanciesMock.defaultExpectation.Counter, 1)
+		mm_want := mmListPurgeableVacancies.ListPurgeableVacanciesMock.defaultExpectation.params
+		mm_want_ptrs := mmListPurgeableVacancies.ListPurgeableVacanciesMock.defaultExpectation.paramPtrs
+
+		mm_got := RetentionStorageMockListPurgeableVacanciesParams{ctx, cutoff, limit}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmListPurgeableVacancies.t.Errorf("RetentionStorageMock.ListPurgeableVacancies got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmListPurgeableVacancies.ListPurgeableVacanciesMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.cutoff != nil && !minimock.Equal(*mm_want_ptrs.cutoff, mm_got.cutoff) {
+				mmListPurgeableVacancies.t.Errorf("RetentionStorageMock.ListPurgeableVacancies got unexpected parameter cutoff, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmListPurgeableVacancies.ListPurgeableVacanciesMock.defaultExpectation.expectationOrigins.originCutoff, *mm_want_ptrs.cutoff, mm_got.cutoff, minimock.Diff(*mm_want_ptrs.cutoff, mm_got.cutoff))
+			}
+
+			if mm_want_ptrs.limit != nil && !minimock.Equal(*mm_want_ptrs.limit, mm_got.limit) {
+				mmListPurgeableVacancies.t.Errorf("RetentionStorageMock.ListPurgeableVacancies got unexpected parameter limit, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmListPurgeableVacancies.ListPurgeableVacanciesMock.defaultExpectation.expectationOrigins.originLimit, *mm_want_ptrs.limit, mm_got.limit, minimock.Diff(*mm_want_ptrs.limit, mm_got.limit))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmListPurgeableVacancies.t.Errorf("RetentionStorageMock.ListPurgeableVacancies got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmListPurgeableVacancies.ListPurgeableVacanciesMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmListPurgeableVacancies.ListPurgeableVacanciesMock.defaultExpectation.results
+		if mm_results == nil {
+			mmListPurgeableVacancies.t.Fatal("No results are set for the RetentionStorageMock.ListPurgeableVacancies")
+		}
+		return (*mm_results).sa1, (*mm_results).err
+	}
+	if mmListPurgeableVacancies.funcListPurgeableVacancies != nil {
+		return mmListPurgeableVacancies.funcListPurgeableVacancies(ctx, cutoff, limit)
+	}
+	mmListPurgeableVacancies.t.Fatalf("Unexpected call to RetentionStorageMock.ListPurgeableVacancies. %v %v %v", ctx, cutoff, limit)
+	return
+}
+
+// ListPurgeableVacanciesAfterCounter returns a count of finished RetentionStorageMock.ListPurgeableVacancies invocations
+func (mmListPurgeableVacancies *RetentionStorageMock) ListPurgeableVacanciesAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmListPurgeableVacancies.afterListPurgeableVacanciesCounter)
+}
+
+// ListPurgeableVacanciesBeforeCounter returns a count of RetentionStorageMock.ListPurgeableVacancies invocations
+func (mmListPurgeableVacancies *RetentionStorageMock) ListPurgeableVacanciesBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmListPurgeableVacancies.beforeListPurgeableVacanciesCounter)
+}
+
+// Calls returns a list of arguments used in each call to RetentionStorageMock.ListPurgeableVacancies.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmListPurgeableVacancies *mRetentionStorageMockListPurgeableVacancies) Calls() []*RetentionStorageMockListPurgeableVacanciesParams {
+	mmListPurgeableVacancies.mutex.RLock()
+
+	argCopy := make([]*RetentionStorageMockListPurgeableVacanciesParams, len(mmListPurgeableVacancies.callArgs))
+	copy(argCopy, mmListPurgeableVacancies.callArgs)
+
+	mmListPurgeableVacancies.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockListPurgeableVacanciesDone returns true if the count of the ListPurgeableVacancies invocations corresponds
+// the number of defined expectations
+func (m *RetentionStorageMock) MinimockListPurgeableVacanciesDone() bool {
+	if m.ListPurgeableVacanciesMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.ListPurgeableVacanciesMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.ListPurgeableVacanciesMock.invocationsDone()
+}
+
+// MinimockListPurgeableVacanciesInspect logs each unmet expectation
+func (m *RetentionStorageMock) MinimockListPurgeableVacanciesInspect() {
+	for _, e := range m.ListPurgeableVacanciesMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to RetentionStorageMock.ListPurgeableVacancies at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterListPurgeableVacanciesCounter := mm_atomic.LoadUint64(&m.afterListPurgeableVacanciesCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.ListPurgeableVacanciesMock.defaultExpectation != nil && afterListPurgeableVacanciesCounter < 1 {
+		if m.ListPurgeableVacanciesMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to RetentionStorageMock.ListPurgeableVacancies at\n%s", m.ListPurgeableVacanciesMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to RetentionStorageMock.ListPurgeableVacancies at\n%s with params: %#v", m.ListPurgeableVacanciesMock.defaultExpectation.expectationOrigins.origin, *m.ListPurgeableVacanciesMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcListPurgeableVacancies != nil && afterListPurgeableVacanciesCounter < 1 {
+		m.t.Errorf("Expected call to RetentionStorageMock.ListPurgeableVacancies at\n%s", m.funcListPurgeableVacanciesOrigin)
+	}
+
+	if !m.ListPurgeableVacanciesMock.invocationsDone() && afterListPurgeableVacanciesCounter > 0 {
+		m.t.Errorf("Expected %d calls to RetentionStorageMock.ListPurgeableVacancies at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.ListPurgeableVacanciesMock.expectedInvocations), m.ListPurgeableVacanciesMock.expectedInvocationsOrigin, afterListPurgeableVacanciesCounter)
+	}
+}
+
+type mRetentionStorageMockPurgeVacancy struct {
+	optional           bool
+	mock               *RetentionStorageMock
+	defaultExpectation *RetentionStorageMockPurgeVacancyExpectation
+	expectations       []*RetentionStorageMockPurgeVacancyExpectation
+
+	callArgs []*RetentionStorageMockPurgeVacancyParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// RetentionStorageMockPurgeVacancyExpectation specifies expectation struct of the RetentionStorage.PurgeVacancy
+type RetentionStorageMockPurgeVacancyExpectation struct {
+	mock               *RetentionStorageMock
+	params             *RetentionStorageMockPurgeVacancyParams
+	paramPtrs          *RetentionStorageMockPurgeVacancyParamPtrs
+	expectationOrigins RetentionStorageMockPurgeVacancyExpectationOrigins
+	results            *RetentionStorageMockPurgeVacancyResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// RetentionStorageMockPurgeVacancyParams contains parameters of the RetentionStorage.PurgeVacancy
+type RetentionStorageMockPurgeVacancyParams struct {
+	ctx       context.Context
+	vacancyID string
+}
+
+// RetentionStorageMockPurgeVacancyParamPtrs contains pointers to parameters of the RetentionStorage.PurgeVacancy
+type RetentionStorageMockPurgeVacancyParamPtrs struct {
+	ctx       *context.Context
+	vacancyID *string
+}
+
+// RetentionStorageMockPurgeVacancyResults contains results of the RetentionStorage.PurgeVacancy
+type RetentionStorageMockPurgeVacancyResults struct {
+	err error
+}
+
+// RetentionStorageMockPurgeVacancyOrigins contains origins of expectations of the RetentionStorage.PurgeVacancy
+type RetentionStorageMockPurgeVacancyExpectationOrigins struct {
+	origin          string
+	originCtx       string
+	originVacancyID string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmPurgeVacancy *mRetentionStorageMockPurgeVacancy) Optional() *mRetentionStorageMockPurgeVacancy {
+	mmPurgeVacancy.optional = true
+	return mmPurgeVacancy
+}
+
+// Expect sets up expected params for RetentionStorage.PurgeVacancy
+func (mmPurgeVacancy *mRetentionStorageMockPurgeVacancy) Expect(ctx context.Context, vacancyID string) *mRetentionStorageMockPurgeVacancy {
+	if mmPurgeVacancy.mock.funcPurgeVacancy != nil {
+		mmPurgeVacancy.mock.t.Fatalf("RetentionStorageMock.PurgeVacancy mock is already set by Set")
+	}
+
+	if mmPurgeVacancy.defaultExpectation == nil {
+		mmPurgeVacancy.defaultExpectation = &RetentionStorageMockPurgeVacancyExpectation{}
+	}
+
+	if mmPurgeVacancy.defaultExpectation.paramPtrs != nil {
+		mmPurgeVacancy.mock.t.Fatalf("RetentionStorageMock.PurgeVacancy mock is already set by ExpectParams functions")
+	}
+
+	mmPurgeVacancy.defaultExpectation.params = &RetentionStorageMockPurgeVacancyParams{ctx, vacancyID}
+	mmPurgeVacancy.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmPurgeVacancy.expectations {
+		if minimock.Equal(e.params, mmPurgeVacancy.defaultExpectation.params) {
+			mmPurgeVacancy.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmPurgeVacancy.defaultExpectation.params)
+		}
+	}
+
+	return mmPurgeVacancy
+}
+
+// ExpectCtxParam1 sets up expected param ctx for RetentionStorage.PurgeVacancy
+func (mmPurgeVacancy *mRetentionStorageMockPurgeVacancy) ExpectCtxParam1(ctx context.Context) *mRetentionStorageMockPurgeVacancy {
+	if mmPurgeVacancy.mock.funcPurgeVacancy != nil {
+		mmPurgeVacancy.mock.t.Fatalf("RetentionStorageMock.PurgeVacancy mock is already set by Set")
+	}
+
+	if mmPurgeVacancy.defaultExpectation == nil {
+		mmPurgeVacancy.defaultExpectation = &RetentionStorageMockPurgeVacancyExpectation{}
+	}
+
+	if mmPurgeVacancy.defaultExpectation.params != nil {
+		mmPurgeVacancy.mock.t.Fatalf("RetentionStorageMock.PurgeVacancy mock is already set by Expect")
+	}
+
+	if mmPurgeVacancy.defaultExpectation.paramPtrs == nil {
+		mmPurgeVacancy.defaultExpectation.paramPtrs = &RetentionStorageMockPurgeVacancyParamPtrs{}
+	}
+	mmPurgeVacancy.defaultExpectation.paramPtrs.ctx = &ctx
+	mmPurgeVacancy.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmPurgeVacancy
+}
+
+// ExpectVacancyIDParam2 sets up expected param vacancyID for RetentionStorage.PurgeVacancy
+func (mmPurgeVacancy *mRetentionStorageMockPurgeVacancy) ExpectVacancyIDParam2(vacancyID string) *mRetentionStorageMockPurgeVacancy {
+	if mmPurgeVacancy.mock.funcPurgeVacancy != nil {
+		mmPurgeVacancy.mock.t.Fatalf("RetentionStorageMock.PurgeVacancy mock is already set by Set")
+	}
+
+	if mmPurgeVacancy.defaultExpectation == nil {
+		mmPurgeVacancy.defaultExpectation = &RetentionStorageMockPurgeVacancyExpectation{}
+	}
+
+	if mmPurgeVacancy.defaultExpectation.params != nil {
+		mmPurgeVacancy.mock.t.Fatalf("RetentionStorageMock.PurgeVacancy mock is already set by Expect")
+	}
+
+	if mmPurgeVacancy.defaultExpectation.paramPtrs == nil {
+		mmPurgeVacancy.defaultExpectation.paramPtrs = &RetentionStorageMockPurgeVacancyParamPtrs{}
+	}
+	mmPurgeVacancy.defaultExpectation.paramPtrs.vacancyID = &vacancyID
+	mmPurgeVacancy.defaultExpectation.expectationOrigins.originVacancyID = minimock.CallerInfo(1)
+
+	return mmPurgeVacancy
+}
+
+// Inspect accepts an inspector function that has same arguments as the RetentionStorage.PurgeVacancy
+func (mmPurgeVacancy *mRetentionStorageMockPurgeVacancy) Inspect(f func(ctx context.Context, vacancyID string)) *mRetentionStorageMockPurgeVacancy {
+	if mmPurgeVacancy.mock.inspectFuncPurgeVacancy != nil {
+		mmPurgeVacancy.mock.t.Fatalf("Inspect function is already set for RetentionStorageMock.PurgeVacancy")
+	}
+
+	mmPurgeVacancy.mock.inspectFuncPurgeVacancy = f
+
+	return mmPurgeVacancy
+}
+
+// Return sets up results that will be returned by RetentionStorage.PurgeVacancy
+func (mmPurgeVacancy *mRetentionStorageMockPurgeVacancy) Return(err error) *RetentionStorageMock {
+	if mmPurgeVacancy.mock.funcPurgeVacancy != nil {
+		mmPurgeVacancy.mock.t.Fatalf("RetentionStorageMock.PurgeVacancy mock is already set by Set")
+	}
+
+	if mmPurgeVacancy.defaultExpectation == nil {
+		mmPurgeVacancy.defaultExpectation = &RetentionStorageMockPurgeVacancyExpectation{mock: mmPurgeVacancy.mock}
+	}
+	mmPurgeVacancy.defaultExpectation.results = &RetentionStorageMockPurgeVacancyResults{err}
+	mmPurgeVacancy.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmPurgeVacancy.mock
+}
+
+// Set uses given function f to mock the RetentionStorage.PurgeVacancy method
+func (mmPurgeVacancy *mRetentionStorageMockPurgeVacancy) Set(f func(ctx context.Context, vacancyID string) (err error)) *RetentionStorageMock {
+	if mmPurgeVacancy.defaultExpectation != nil {
+		mmPurgeVacancy.mock.t.Fatalf("Default expectation is already set for the RetentionStorage.PurgeVacancy method")
+	}
+
+	if len(mmPurgeVacancy.expectations) > 0 {
+		mmPurgeVacancy.mock.t.Fatalf("Some expectations are already set for the RetentionStorage.PurgeVacancy method")
+	}
+
+	mmPurgeVacancy.mock.funcPurgeVacancy = f
+	mmPurgeVacancy.mock.funcPurgeVacancyOrigin = minimock.CallerInfo(1)
+	return mmPurgeVacancy.mock
+}
+
+// When sets expectation for the RetentionStorage.PurgeVacancy which will trigger the result defined by the following
+// Then helper
+func (mmPurgeVacancy *mRetentionStorageMockPurgeVacancy) When(ctx context.Context, vacancyID string) *RetentionStorageMockPurgeVacancyExpectation {
+	if mmPurgeVacancy.mock.funcPurgeVacancy != nil {
+		mmPurgeVacancy.mock.t.Fatalf("RetentionStorageMock.PurgeVacancy mock is already set by Set")
+	}
+
+	expectation := &RetentionStorageMockPurgeVacancyExpectation{
+		mock:               mmPurgeVacancy.mock,
+		params:             &RetentionStorageMockPurgeVacancyParams{ctx, vacancyID},
+		expectationOrigins: RetentionStorageMockPurgeVacancyExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmPurgeVacancy.expectations = append(mmPurgeVacancy.expectations, expectation)
+	return expectation
+}
+
+// Then sets up RetentionStorage.PurgeVacancy return parameters for the expectation previously defined by the When method
+func (e *RetentionStorageMockPurgeVacancyExpectation) Then(err error) *RetentionStorageMock {
+	e.results = &RetentionStorageMockPurgeVacancyResults{err}
+	return e.mock
+}
+
+// Times sets number of times RetentionStorage.PurgeVacancy should be invoked
+func (mmPurgeVacancy *mRetentionStorageMockPurgeVacancy) Times(n uint64) *mRetentionStorageMockPurgeVacancy {
+	if n == 0 {
+		mmPurgeVacancy.mock.t.Fatalf("Times of RetentionStorageMock.PurgeVacancy mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmPurgeVacancy.expectedInvocations, n)
+	mmPurgeVacancy.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmPurgeVacancy
+}
+
+func (mmPurgeVacancy *mRetentionStorageMockPurgeVacancy) invocationsDone() bool {
+	if len(mmPurgeVacancy.expectations) == 0 && mmPurgeVacancy.defaultExpectation == nil && mmPurgeVacancy.mock.funcPurgeVacancy == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmPurgeVacancy.mock.afterPurgeVacancyCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmPurgeVacancy.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// PurgeVacancy implements mm_usecase.RetentionStorage
+func (mmPurgeVacancy *RetentionStorageMock) PurgeVacancy(ctx context.Context, vacancyID string) (err error) {
+	mm_atomic.AddUint64(&mmPurgeVacancy.beforePurgeVacancyCounter, 1)
+	defer mm_atomic.AddUint64(&mmPurgeVacancy.afterPurgeVacancyCounter, 1)
+
+	mmPurgeVacancy.t.Helper()
+
+	if mmPurgeVacancy.inspectFuncPurgeVacancy != nil {
+		mmPurgeVacancy.inspectFuncPurgeVacancy(ctx, vacancyID)
+	}
+
+	mm_params := RetentionStorageMockPurgeVacancyParams{ctx, vacancyID}
+
+	// Record call args
+	mmPurgeVacancy.PurgeVacancyMock.mutex.Lock()
+	mmPurgeVacancy.PurgeVacancyMock.callArgs = append(mmPurgeVacancy.PurgeVacancyMock.callArgs, &mm_params)
+	mmPurgeVacancy.PurgeVacancyMock.mutex.Unlock()
+
+	for _, e := range mmPurgeVacancy.PurgeVacancyMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.err
+		}
+	}
+
+	if mmPurgeVacancy.PurgeVacancyMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmPurgeVacancy.PurgeVacancyMock.defaultExpectation.Counter, 1)
+		mm_want := mmPurgeVacancy.PurgeVacancyMock.defaultExpectation.params
+		mm_want_ptrs := mmPurgeVacancy.PurgeVacancyMock.defaultExpectation.paramPtrs
+
+		mm_got := RetentionStorageMockPurgeVacancyParams{ctx, vacancyID}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmPurgeVacancy.t.Errorf("RetentionStorageMock.PurgeVacancy got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmPurgeVacancy.PurgeVacancyMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.vacancyID != nil && !minimock.Equal(*mm_want_ptrs.vacancyID, mm_got.vacancyID) {
+				mmPurgeVacancy.t.Errorf("RetentionStorageMock.PurgeVacancy got unexpected parameter vacancyID, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmPurgeVacancy.PurgeVacancyMock.defaultExpectation.expectationOrigins.originVacancyID, *mm_want_ptrs.vacancyID, mm_got.vacancyID, minimock.Diff(*mm_want_ptrs.vacancyID, mm_got.vacancyID))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmPurgeVacancy.t.Errorf("RetentionStorageMock.PurgeVacancy got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmPurgeVacancy.PurgeVacancyMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmPurgeVacancy.PurgeVacancyMock.defaultExpectation.results
+		if mm_results == nil {
+			mmPurgeVacancy.t.Fatal("No results are set for the RetentionStorageMock.PurgeVacancy")
+		}
+		return (*mm_results).err
+	}
+	if mmPurgeVacancy.funcPurgeVacancy != nil {
+		return mmPurgeVacancy.funcPurgeVacancy(ctx, vacancyID)
+	}
+	mmPurgeVacancy.t.Fatalf("Unexpected call to RetentionStorageMock.PurgeVacancy. %v %v", ctx, vacancyID)
+	return
+}
+
+// PurgeVacancyAfterCounter returns a count of finished RetentionStorageMock.PurgeVacancy invocations
+func (mmPurgeVacancy *RetentionStorageMock) PurgeVacancyAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmPurgeVacancy.afterPurgeVacancyCounter)
+}
+
+// PurgeVacancyBeforeCounter returns a count of RetentionStorageMock.PurgeVacancy invocations
+func (mmPurgeVacancy *RetentionStorageMock) PurgeVacancyBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmPurgeVacancy.beforePurgeVacancyCounter)
+}
+
+// Calls returns a list of arguments used in each call to RetentionStorageMock.PurgeVacancy.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmPurgeVacancy *mRetentionStorageMockPurgeVacancy) Calls() []*RetentionStorageMockPurgeVacancyParams {
+	mmPurgeVacancy.mutex.RLock()
+
+	argCopy := make([]*RetentionStorageMockPurgeVacancyParams, len(mmPurgeVacancy.callArgs))
+	copy(argCopy, mmPurgeVacancy.callArgs)
+
+	mmPurgeVacancy.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockPurgeVacancyDone returns true if the count of the PurgeVacancy invocations corresponds
+// the number of defined expectations
+func (m *RetentionStorageMock) MinimockPurgeVacancyDone() bool {
+	if m.PurgeVacancyMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.PurgeVacancyMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.PurgeVacancyMock.invocationsDone()
+}
+
+// MinimockPurgeVacancyInspect logs each unmet expectation
+func (m *RetentionStorageMock) MinimockPurgeVacancyInspect() {
+	for _, e := range m.PurgeVacancyMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to RetentionStorageMock.PurgeVacancy at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterPurgeVacancyCounter := mm_atomic.LoadUint64(&m.afterPurgeVacancyCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.PurgeVacancyMock.defaultExpectation != nil && afterPurgeVacancyCounter < 1 {
+		if m.PurgeVacancyMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to RetentionStorageMock.PurgeVacancy at\n%s", m.PurgeVacancyMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to RetentionStorageMock.PurgeVacancy at\n%s with params: %#v", m.PurgeVacancyMock.defaultExpectation.expectationOrigins.origin, *m.PurgeVacancyMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcPurgeVacancy != nil && afterPurgeVacancyCounter < 1 {
+		m.t.Errorf("Expected call to RetentionStorageMock.PurgeVacancy at\n%s", m.funcPurgeVacancyOrigin)
+	}
+
+	if !m.PurgeVacancyMock.invocationsDone() && afterPurgeVacancyCounter > 0 {
+		m.t.Errorf("Expected %d calls to RetentionStorageMock.PurgeVacancy at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.PurgeVacancyMock.expectedInvocations), m.PurgeVacancyMock.expectedInvocationsOrigin, afterPurgeVacancyCounter)
+	}
+}
+
+type mRetentionStorageMockStartVacancyPurge struct {
+	optional           bool
+	mock               *RetentionStorageMock
+	defaultExpectation *RetentionStorageMockStartVacancyPurgeExpectation
+	expectations       []*RetentionStorageMockStartVacancyPurgeExpectation
+
+	callArgs []*RetentionStorageMockStartVacancyPurgeParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// RetentionStorageMockStartVacancyPurgeExpectation specifies expectation struct of the RetentionStorage.StartVacancyPurge
+type RetentionStorageMockStartVacancyPurgeExpectation struct {
+	mock               *RetentionStorageMock
+	params             *RetentionStorageMockStartVacancyPurgeParams
+	paramPtrs          *RetentionStorageMockStartVacancyPurgeParamPtrs
+	expectationOrigins RetentionStorageMockStartVacancyPurgeExpectationOrigins
+	results            *RetentionStorageMockStartVacancyPurgeResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// RetentionStorageMockStartVacancyPurgeParams contains parameters of the RetentionStorage.StartVacancyPurge
+type RetentionStorageMockStartVacancyPurgeParams struct {
+	ctx       context.Context
+	vacancyID string
+	cutoff    time.Time
+}
+
+// RetentionStorageMockStartVacancyPurgeParamPtrs contains pointers to parameters of the RetentionStorage.StartVacancyPurge
+type RetentionStorageMockStartVacancyPurgeParamPtrs struct {
+	ctx       *context.Context
+	vacancyID *string
+	cutoff    *time.Time
+}
+
+// RetentionStorageMockStartVacancyPurgeResults contains results of the RetentionStorage.StartVacancyPurge
+type RetentionStorageMockStartVacancyPurgeResults struct {
+	b1  bool
+	err error
+}
+
+// RetentionStorageMockStartVacancyPurgeOrigins contains origins of expectations of the RetentionStorage.StartVacancyPurge
+type RetentionStorageMockStartVacancyPurgeExpectationOrigins struct {
+	origin          string
+	originCtx       string
+	originVacancyID string
+	originCutoff    string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmStartVacancyPurge *mRetentionStorageMockStartVacancyPurge) Optional() *mRetentionStorageMockStartVacancyPurge {
+	mmStartVacancyPurge.optional = true
+	return mmStartVacancyPurge
+}
+
+// Expect sets up expected params for RetentionStorage.StartVacancyPurge
+func (mmStartVacancyPurge *mRetentionStorageMockStartVacancyPurge) Expect(ctx context.Context, vacancyID string, cutoff time.Time) *mRetentionStorageMockStartVacancyPurge {
+	if mmStartVacancyPurge.mock.funcStartVacancyPurge != nil {
+		mmStartVacancyPurge.mock.t.Fatalf("RetentionStorageMock.StartVacancyPurge mock is already set by Set")
+	}
+
+	if mmStartVacancyPurge.defaultExpectation == nil {
+		mmStartVacancyPurge.defaultExpectation = &RetentionStorageMockStartVacancyPurgeExpectation{}
+	}
+
+	if mmStartVacancyPurge.defaultExpectation.paramPtrs != nil {
+		mmStartVacancyPurge.mock.t.Fatalf("RetentionStorageMock.StartVacancyPurge mock is already set by ExpectParams functions")
+	}
+
+	mmStartVacancyPurge.defaultExpectation.params = &RetentionStorageMockStartVacancyPurgeParams{ctx, vacancyID, cutoff}
+	mmStartVacancyPurge.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmStartVacancyPurge.expectations {
+		if minimock.Equal(e.params, mmStartVacancyPurge.defaultExpectation.params) {
+			mmStartVacancyPurge.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmStartVacancyPurge.defaultExpectation.params)
+		}
+	}
+
+	return mmStartVacancyPurge
+}
+
+// ExpectCtxParam1 sets up expected param ctx for RetentionStorage.StartVacancyPurge
+func (mmStartVacancyPurge *mRetentionStorageMockStartVacancyPurge) ExpectCtxParam1(ctx context.Context) *mRetentionStorageMockStartVacancyPurge {
+	if mmStartVacancyPurge.mock.funcStartVacancyPurge != nil {
+		mmStartVacancyPurge.mock.t.Fatalf("RetentionStorageMock.StartVacancyPurge mock is already set by Set")
+	}
+
+	if mmStartVacancyPurge.defaultExpectation == nil {
+		mmStartVacancyPurge.defaultExpectation = &RetentionStorageMockStartVacancyPurgeExpectation{}
+	}
+
+	if mmStartVacancyPurge.defaultExpectation.params != nil {
+		mmStartVacancyPurge.mock.t.Fatalf("RetentionStorageMock.StartVacancyPurge mock is already set by Expect")
+	}
+
+	if mmStartVacancyPurge.defaultExpectation.paramPtrs == nil {
+		mmStartVacancyPurge.defaultExpectation.paramPtrs = &RetentionStorageMockStartVacancyPurgeParamPtrs{}
+	}
+	mmStartVacancyPurge.defaultExpectation.paramPtrs.ctx = &ctx
+	mmStartVacancyPurge.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmStartVacancyPurge
+}
+
+// ExpectVacancyIDParam2 sets up expected param vacancyID for RetentionStorage.StartVacancyPurge
+func (mmStartVacancyPurge *mRetentionStorageMockStartVacancyPurge) ExpectVacancyIDParam2(vacancyID string) *mRetentionStorageMockStartVacancyPurge {
+	if mmStartVacancyPurge.mock.funcStartVacancyPurge != nil {
+		mmStartVacancyPurge.mock.t.Fatalf("RetentionStorageMock.StartVacancyPurge mock is already set by Set")
+	}
+
+	if mmStartVacancyPurge.defaultExpectation == nil {
+		mmStartVacancyPurge.defaultExpectation = &RetentionStorageMockStartVacancyPurgeExpectation{}
+	}
+
+	if mmStartVacancyPurge.defaultExpectation.params != nil {
+		mmStartVacancyPurge.mock.t.Fatalf("RetentionStorageMock.StartVacancyPurge mock is already set by Expect")
+	}
+
+	if mmStartVacancyPurge.defaultExpectation.paramPtrs == nil {
+		mmStartVacancyPurge.defaultExpectation.paramPtrs = &RetentionStorageMockStartVacancyPurgeParamPtrs{}
+	}
+	mmStartVacancyPurge.defaultExpectation.paramPtrs.vacancyID = &vacancyID
+	mmStartVacancyPurge.defaultExpectation.expectationOrigins.originVacancyID = minimock.CallerInfo(1)
+
+	return mmStartVacancyPurge
+}
+
+// ExpectCutoffParam3 sets up expected param cutoff for RetentionStorage.StartVacancyPurge
+func (mmStartVacancyPurge *mRetentionStorageMockStartVacancyPurge) ExpectCutoffParam3(cutoff time.Time) *mRetentionStorageMockStartVacancyPurge {
+	if mmStartVacancyPurge.mock.funcStartVacancyPurge != nil {
+		mmStartVacancyPurge.mock.t.Fatalf("RetentionStorageMock.StartVacancyPurge mock is already set by Set")
+	}
+
+	if mmStartVacancyPurge.defaultExpectation == nil {
+		mmStartVacancyPurge.defaultExpectation = &RetentionStorageMockStartVacancyPurgeExpectation{}
+	}
+
+	if mmStartVacancyPurge.defaultExpectation.params != nil {
+		mmStartVacancyPurge.mock.t.Fatalf("RetentionStorageMock.StartVacancyPurge mock is already set by Expect")
+	}
+
+	if mmStartVacancyPurge.defaultExpectation.paramPtrs == nil {
+		mmStartVacancyPurge.defaultExpectation.paramPtrs = &RetentionStorageMockStartVacancyPurgeParamPtrs{}
+	}
+	mmStartVacancyPurge.defaultExpectation.paramPtrs.cutoff = &cutoff
+	mmStartVacancyPurge.defaultExpectation.expectationOrigins.originCutoff = minimock.CallerInfo(1)
+
+	return mmStartVacancyPurge
+}
+
+// Inspect accepts an inspector function that has same arguments as the RetentionStorage.StartVacancyPurge
+func (mmStartVacancyPurge *mRetentionStorageMockStartVacancyPurge) Inspect(f func(ctx context.Context, vacancyID string, cutoff time.Time)) *mRetentionStorageMockStartVacancyPurge {
+	if mmStartVacancyPurge.mock.inspectFuncStartVacancyPurge != nil {
+		mmStartVacancyPurge.mock.t.Fatalf("Inspect function is already set for RetentionStorageMock.StartVacancyPurge")
+	}
+
+	mmStartVacancyPurge.mock.inspectFuncStartVacancyPurge = f
+
+	return mmStartVacancyPurge
+}
+
+// Return sets up results that will be returned by RetentionStorage.StartVacancyPurge
+func (mmStartVacancyPurge *mRetentionStorageMockStartVacancyPurge) Return(b1 bool, err error) *RetentionStorageMock {
+	if mmStartVacancyPurge.mock.funcStartVacancyPurge != nil {
+		mmStartVacancyPurge.mock.t.Fatalf("RetentionStorageMock.StartVacancyPurge mock is already set by Set")
+	}
+
+	if mmStartVacancyPurge.defaultExpectation == nil {
+		mmStartVacancyPurge.defaultExpectation = &RetentionStorageMockStartVacancyPurgeExpectation{mock: mmStartVacancyPurge.mock}
+	}
+	mmStartVacancyPurge.defaultExpectation.results = &RetentionStorageMockStartVacancyPurgeResults{b1, err}
+	mmStartVacancyPurge.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmStartVacancyPurge.mock
+}
+
+// Set uses given function f to mock the RetentionStorage.StartVacancyPurge method
+func (mmStartVacancyPurge *mRetentionStorageMockStartVacancyPurge) Set(f func(ctx context.Context, vacancyID string, cutoff time.Time) (b1 bool, err error)) *RetentionStorageMock {
+	if mmStartVacancyPurge.defaultExpectation != nil {
+		mmStartVacancyPurge.mock.t.Fatalf("Default expectation is already set for the RetentionStorage.StartVacancyPurge method")
+	}
+
+	if len(mmStartVacancyPurge.expectations) > 0 {
+		mmStartVacancyPurge.mock.t.Fatalf("Some expectations are already set for the RetentionStorage.StartVacancyPurge method")
+	}
+
+	mmStartVacancyPurge.mock.funcStartVacancyPurge = f
+	mmStartVacancyPurge.mock.funcStartVacancyPurgeOrigin = minimock.CallerInfo(1)
+	return mmStartVacancyPurge.mock
+}
+
+// When sets expectation for the RetentionStorage.StartVacancyPurge which will trigger the result defined by the following
+// Then helper
+func (mmStartVacancyPurge *mRetentionStorageMockStartVacancyPurge) When(ctx context.Context, vacancyID string, cutoff time.Time) *RetentionStorageMockStartVacancyPurgeExpectation {
+	if mmStartVacancyPurge.mock.funcStartVacancyPurge != nil {
+		mmStartVacancyPurge.mock.t.Fatalf("RetentionStorageMock.StartVacancyPurge mock is already set by Set")
+	}
+
+	expectation := &RetentionStorageMockStartVacancyPurgeExpectation{
+		mock:               mmStartVacancyPurge.mock,
+		params:             &RetentionStorageMockStartVacancyPurgeParams{ctx, vacancyID, cutoff},
+		expectationOrigins: RetentionStorageMockStartVacancyPurgeExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmStartVacancyPurge.expectations = append(mmStartVacancyPurge.expectations, expectation)
+	return expectation
+}
+
+// Then sets up RetentionStorage.StartVacancyPurge return parameters for the expectation previously defined by the When method
+func (e *RetentionStorageMockStartVacancyPurgeExpectation) Then(b1 bool, err error) *RetentionStorageMock {
+	e.results = &RetentionStorageMockStartVacancyPurgeResults{b1, err}
+	return e.mock
+}
+
+// Times sets number of times RetentionStorage.StartVacancyPurge should be invoked
+func (mmStartVacancyPurge *mRetentionStorageMockStartVacancyPurge) Times(n uint64) *mRetentionStorageMockStartVacancyPurge {
+	if n == 0 {
+		mmStartVacancyPurge.mock.t.Fatalf("Times of RetentionStorageMock.StartVacancyPurge mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmStartVacancyPurge.expectedInvocations, n)
+	mmStartVacancyPurge.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmStartVacancyPurge
+}
+
+func (mmStartVacancyPurge *mRetentionStorageMockStartVacancyPurge) invocationsDone() bool {
+	if len(mmStartVacancyPurge.expectations) == 0 && mmStartVacancyPurge.defaultExpectation == nil && mmStartVacancyPurge.mock.funcStartVacancyPurge == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmStartVacancyPurge.mock.afterStartVacancyPurgeCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmStartVacancyPurge.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// StartVacancyPurge implements mm_usecase.RetentionStorage
+func (mmStartVacancyPurge *RetentionStorageMock) StartVacancyPurge(ctx context.Context, vacancyID string, cutoff time.Time) (b1 bool, err error) {
+	mm_atomic.AddUint64(&mmStartVacancyPurge.beforeStartVacancyPurgeCounter, 1)
+	defer mm_atomic.AddUint64(&mmStartVacancyPurge.afterStartVacancyPurgeCounter, 1)
+
+	mmStartVacancyPurge.t.Helper()
+
+	if mmStartVacancyPurge.inspectFuncStartVacancyPurge != nil {
+		mmStartVacancyPurge.inspectFuncStartVacancyPurge(ctx, vacancyID, cutoff)
+	}
+
+	mm_params := RetentionStorageMockStartVacancyPurgeParams{ctx, vacancyID, cutoff}
+
+	// Record call args
+	mmStartVacancyPurge.StartVacancyPurgeMock.mutex.Lock()
+	mmStartVacancyPurge.StartVacancyPurgeMock.callArgs = append(mmStartVacancyPurge.StartVacancyPurgeMock.callArgs, &mm_params)
+	mmStartVacancyPurge.StartVacancyPurgeMock.mutex.Unlock()
+
+	for _, e := range mmStartVacancyPurge.StartVacancyPurgeMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.b1, e.results.err
+		}
+	}
+
+	if mmStartVacancyPurge.StartVacancyPurgeMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmStartVacancyPurge.StartVacancyPurgeMock.defaultExpectation.Counter, 1)
+		mm_want := mmStartVacancyPurge.StartVacancyPurgeMock.defaultExpectation.params
+		mm_want_ptrs := mmStartVacancyPurge.StartVacancyPurgeMock.defaultExpectation.paramPtrs
+
+		mm_got := RetentionStorageMockStartVacancyPurgeParams{ctx, vacancyID, cutoff}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmStartVacancyPurge.t.Errorf("RetentionStorageMock.StartVacancyPurge got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmStartVacancyPurge.StartVacancyPurgeMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.vacancyID != nil && !minimock.Equal(*mm_want_ptrs.vacancyID, mm_got.vacancyID) {
+				mmStartVacancyPurge.t.Errorf("RetentionStorageMock.StartVacancyPurge got unexpected parameter vacancyID, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmStartVacancyPurge.StartVacancyPurgeMock.defaultExpectation.expectationOrigins.originVacancyID, *mm_want_ptrs.vacancyID, mm_got.vacancyID, minimock.Diff(*mm_want_ptrs.vacancyID, mm_got.vacancyID))
+			}
+
+			if mm_want_ptrs.cutoff != nil && !minimock.Equal(*mm_want_ptrs.cutoff, mm_got.cutoff) {
+				mmStartVacancyPurge.t.Errorf("RetentionStorageMock.StartVacancyPurge got unexpected parameter cutoff, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmStartVacancyPurge.StartVacancyPurgeMock.defaultExpectation.expectationOrigins.originCutoff, *mm_want_ptrs.cutoff, mm_got.cutoff, minimock.Diff(*mm_want_ptrs.cutoff, mm_got.cutoff))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmStartVacancyPurge.t.Errorf("RetentionStorageMock.StartVacancyPurge got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmStartVacancyPurge.StartVacancyPurgeMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmStartVacancyPurge.StartVacancyPurgeMock.defaultExpectation.results
+		if mm_results == nil {
+			mmStartVacancyPurge.t.Fatal("No results are set for the RetentionStorageMock.StartVacancyPurge")
+		}
+		return (*mm_results).b1, (*mm_results).err
+	}
+	if mmStartVacancyPurge.funcStartVacancyPurge != nil {
+		return mmStartVacancyPurge.funcStartVacancyPurge(ctx, vacancyID, cutoff)
+	}
+	mmStartVacancyPurge.t.Fatalf("Unexpected call to RetentionStorageMock.StartVacancyPurge. %v %v %v", ctx, vacancyID, cutoff)
+	return
+}
+
+// StartVacancyPurgeAfterCounter returns a count of finished RetentionStorageMock.StartVacancyPurge invocations
+func (mmStartVacancyPurge *RetentionStorageMock) StartVacancyPurgeAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmStartVacancyPurge.afterStartVacancyPurgeCounter)
+}
+
+// StartVacancyPurgeBeforeCounter returns a count of RetentionStorageMock.StartVacancyPurge invocations
+func (mmStartVacancyPurge *RetentionStorageMock) StartVacancyPurgeBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmStartVacancyPurge.beforeStartVacancyPurgeCounter)
+}
+
+// Calls returns a list of arguments used in each call to RetentionStorageMock.StartVacancyPurge.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmStartVacancyPurge *mRetentionStorageMockStartVacancyPurge) Calls() []*RetentionStorageMockStartVacancyPurgeParams {
+	mmStartVacancyPurge.mutex.RLock()
+
+	argCopy := make([]*RetentionStorageMockStartVacancyPurgeParams, len(mmStartVacancyPurge.callArgs))
+	copy(argCopy, mmStartVacancyPurge.callArgs)
+
+	mmStartVacancyPurge.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockStartVacancyPurgeDone returns true if the count of the StartVacancyPurge invocations corresponds
+// the number of defined expectations
+func (m *RetentionStorageMock) MinimockStartVacancyPurgeDone() bool {
+	if m.StartVacancyPurgeMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.StartVacancyPurgeMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.StartVacancyPurgeMock.invocationsDone()
+}
+
+// MinimockStartVacancyPurgeInspect logs each unmet expectation
+func (m *RetentionStorageMock) MinimockStartVacancyPurgeInspect() {
+	for _, e := range m.StartVacancyPurgeMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to RetentionStorageMock.StartVacancyPurge at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterStartVacancyPurgeCounter := mm_atomic.LoadUint64(&m.afterStartVacancyPurgeCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.StartVacancyPurgeMock.defaultExpectation != nil && afterStartVacancyPurgeCounter < 1 {
+		if m.StartVacancyPurgeMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to RetentionStorageMock.StartVacancyPurge at\n%s", m.StartVacancyPurgeMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to RetentionStorageMock.StartVacancyPurge at\n%s with params: %#v", m.StartVacancyPurgeMock.defaultExpectation.expectationOrigins.origin, *m.StartVacancyPurgeMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcStartVacancyPurge != nil && afterStartVacancyPurgeCounter < 1 {
+		m.t.Errorf("Expected call to RetentionStorageMock.StartVacancyPurge at\n%s", m.funcStartVacancyPurgeOrigin)
+	}
+
+	if !m.StartVacancyPurgeMock.invocationsDone() && afterStartVacancyPurgeCounter > 0 {
+		m.t.Errorf("Expected %d calls to RetentionStorageMock.StartVacancyPurge at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.StartVacancyPurgeMock.expectedInvocations), m.StartVacancyPurgeMock.expectedInvocationsOrigin, afterStartVacancyPurgeCounter)
+	}
+}
+
+// MinimockFinish checks that all mocked methods have been called the expected number of times
+func (m *RetentionStorageMock) MinimockFinish() {
+	m.finishOnce.Do(func() {
+		if !m.minimockDone() {
+			m.MinimockListPurgeableVacanciesInspect()
+
+			m.MinimockPurgeVacancyInspect()
+
+			m.MinimockStartVacancyPurgeInspect()
+		}
+	})
+}
+
+// MinimockWait waits for all mocked methods to be called the expected number of times
+func (m *RetentionStorageMock) MinimockWait(timeout mm_time.Duration) {
+	timeoutCh := mm_time.After(timeout)
+	for {
+		if m.minimockDone() {
+			return
+		}
+		select {
+		case <-timeoutCh:
+			m.MinimockFinish()
+			return
+		case <-mm_time.After(10 * mm_time.Millisecond):
+		}
+	}
+}
+
+func (m *RetentionStorageMock) minimockDone() bool {
+	done := true
+	return done &&
+		m.MinimockListPurgeableVacanciesDone() &&
+		m.MinimockPurgeVacancyDone() &&
+		m.MinimockStartVacancyPurgeDone()
+}
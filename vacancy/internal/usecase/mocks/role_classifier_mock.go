@@ -22,6 +22,13 @@ type RoleClassifierMock struct {
 	afterClassifyCounter  uint64
 	beforeClassifyCounter uint64
 	ClassifyMock          mRoleClassifierMockClassify
+
+	funcListRoles          func(ctx context.Context) (sa1 []string, err error)
+	funcListRolesOrigin    string
+	inspectFuncListRoles   func(ctx context.Context)
+	afterListRolesCounter  uint64
+	beforeListRolesCounter uint64
+	ListRolesMock          mRoleClassifierMockListRoles
 }
 
 // NewRoleClassifierMock returns a mock for mm_usecase.RoleClassifier
@@ -35,6 +42,9 @@ func NewRoleClassifierMock(t minimock.Tester) *RoleClassifierMock {
 	m.ClassifyMock = mRoleClassifierMockClassify{mock: m}
 	m.ClassifyMock.callArgs = []*RoleClassifierMockClassifyParams{}
 
+	m.ListRolesMock = mRoleClassifierMockListRoles{mock: m}
+	m.ListRolesMock.callArgs = []*RoleClassifierMockListRolesParams{}
+
 	t.Cleanup(m.MinimockFinish)
 
 	return m
@@ -414,11 +424,325 @@ func (m *RoleClassifierMock) MinimockClassifyInspect() {
 	}
 }
 
+type mRoleClassifierMockListRoles struct {
+	optional           bool
+	mock               *RoleClassifierMock
+	defaultExpectation *RoleClassifierMockListRolesExpectation
+	expectations       []*RoleClassifierMockListRolesExpectation
+
+	callArgs []*RoleClassifierMockListRolesParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// RoleClassifierMockListRolesExpectation specifies expectation struct of the RoleClassifier.ListRoles
+type RoleClassifierMockListRolesExpectation struct {
+	mock               *RoleClassifierMock
+	params             *RoleClassifierMockListRolesParams
+	paramPtrs          *RoleClassifierMockListRolesParamPtrs
+	expectationOrigins RoleClassifierMockListRolesExpectationOrigins
+	results            *RoleClassifierMockListRolesResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// RoleClassifierMockListRolesParams contains parameters of the RoleClassifier.ListRoles
+type RoleClassifierMockListRolesParams struct {
+	ctx context.Context
+}
+
+// RoleClassifierMockListRolesParamPtrs contains pointers to parameters of the RoleClassifier.ListRoles
+type RoleClassifierMockListRolesParamPtrs struct {
+	ctx *context.Context
+}
+
+// RoleClassifierMockListRolesResults contains results of the RoleClassifier.ListRoles
+type RoleClassifierMockListRolesResults struct {
+	sa1 []string
+	err error
+}
+
+// RoleClassifierMockListRolesOrigins contains origins of expectations of the RoleClassifier.ListRoles
+type RoleClassifierMockListRolesExpectationOrigins struct {
+	origin    string
+	originCtx string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmListRoles *mRoleClassifierMockListRoles) Optional() *mRoleClassifierMockListRoles {
+	mmListRoles.optional = true
+	return mmListRoles
+}
+
+// Expect sets up expected params for RoleClassifier.ListRoles
+func (mmListRoles *mRoleClassifierMockListRoles) Expect(ctx context.Context) *mRoleClassifierMockListRoles {
+	if mmListRoles.mock.funcListRoles != nil {
+		mmListRoles.mock.t.Fatalf("RoleClassifierMock.ListRoles mock is already set by Set")
+	}
+
+	if mmListRoles.defaultExpectation == nil {
+		mmListRoles.defaultExpectation = &RoleClassifierMockListRolesExpectation{}
+	}
+
+	if mmListRoles.defaultExpectation.paramPtrs != nil {
+		mmListRoles.mock.t.Fatalf("RoleClassifierMock.ListRoles mock is already set by ExpectParams functions")
+	}
+
+	mmListRoles.defaultExpectation.params = &RoleClassifierMockListRolesParams{ctx}
+	mmListRoles.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmListRoles.expectations {
+		if minimock.Equal(e.params, mmListRoles.defaultExpectation.params) {
+			mmListRoles.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmListRoles.defaultExpectation.params)
+		}
+	}
+
+	return mmListRoles
+}
+
+// ExpectCtxParam1 sets up expected param ctx for RoleClassifier.ListRoles
+func (mmListRoles *mRoleClassifierMockListRoles) ExpectCtxParam1(ctx context.Context) *mRoleClassifierMockListRoles {
+	if mmListRoles.mock.funcListRoles != nil {
+		mmListRoles.mock.t.Fatalf("RoleClassifierMock.ListRoles mock is already set by Set")
+	}
+
+	if mmListRoles.defaultExpectation == nil {
+		mmListRoles.defaultExpectation = &RoleClassifierMockListRolesExpectation{}
+	}
+
+	if mmListRoles.defaultExpectation.params != nil {
+		mmListRoles.mock.t.Fatalf("RoleClassifierMock.ListRoles mock is already set by Expect")
+	}
+
+	if mmListRoles.defaultExpectation.paramPtrs == nil {
+		mmListRoles.defaultExpectation.paramPtrs = &RoleClassifierMockListRolesParamPtrs{}
+	}
+	mmListRoles.defaultExpectation.paramPtrs.ctx = &ctx
+	mmListRoles.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmListRoles
+}
+
+// Inspect accepts an inspector function that has same arguments as the RoleClassifier.ListRoles
+func (mmListRoles *mRoleClassifierMockListRoles) Inspect(f func(ctx context.Context)) *mRoleClassifierMockListRoles {
+	if mmListRoles.mock.inspectFuncListRoles != nil {
+		mmListRoles.mock.t.Fatalf("Inspect function is already set for RoleClassifierMock.ListRoles")
+	}
+
+	mmListRoles.mock.inspectFuncListRoles = f
+
+	return mmListRoles
+}
+
+// Return sets up results that will be returned by RoleClassifier.ListRoles
+func (mmListRoles *mRoleClassifierMockListRoles) Return(sa1 []string, err error) *RoleClassifierMock {
+	if mmListRoles.mock.funcListRoles != nil {
+		mmListRoles.mock.t.Fatalf("RoleClassifierMock.ListRoles mock is already set by Set")
+	}
+
+	if mmListRoles.defaultExpectation == nil {
+		mmListRoles.defaultExpectation = &RoleClassifierMockListRolesExpectation{mock: mmListRoles.mock}
+	}
+	mmListRoles.defaultExpectation.results = &RoleClassifierMockListRolesResults{sa1, err}
+	mmListRoles.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmListRoles.mock
+}
+
+// Set uses given function f to mock the RoleClassifier.ListRoles method
+func (mmListRoles *mRoleClassifierMockListRoles) Set(f func(ctx context.Context) (sa1 []string, err error)) *RoleClassifierMock {
+	if mmListRoles.defaultExpectation != nil {
+		mmListRoles.mock.t.Fatalf("Default expectation is already set for the RoleClassifier.ListRoles method")
+	}
+
+	if len(mmListRoles.expectations) > 0 {
+		mmListRoles.mock.t.Fatalf("Some expectations are already set for the RoleClassifier.ListRoles method")
+	}
+
+	mmListRoles.mock.funcListRoles = f
+	mmListRoles.mock.funcListRolesOrigin = minimock.CallerInfo(1)
+	return mmListRoles.mock
+}
+
+// When sets expectation for the RoleClassifier.ListRoles which will trigger the result defined by the following
+// Then helper
+func (mmListRoles *mRoleClassifierMockListRoles) When(ctx context.Context) *RoleClassifierMockListRolesExpectation {
+	if mmListRoles.mock.funcListRoles != nil {
+		mmListRoles.mock.t.Fatalf("RoleClassifierMock.ListRoles mock is already set by Set")
+	}
+
+	expectation := &RoleClassifierMockListRolesExpectation{
+		mock:               mmListRoles.mock,
+		params:             &RoleClassifierMockListRolesParams{ctx},
+		expectationOrigins: RoleClassifierMockListRolesExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmListRoles.expectations = append(mmListRoles.expectations, expectation)
+	return expectation
+}
+
+// Then sets up RoleClassifier.ListRoles return parameters for the expectation previously defined by the When method
+func (e *RoleClassifierMockListRolesExpectation) Then(sa1 []string, err error) *RoleClassifierMock {
+	e.results = &RoleClassifierMockListRolesResults{sa1, err}
+	return e.mock
+}
+
+// Times sets number of times RoleClassifier.ListRoles should be invoked
+func (mmListRoles *mRoleClassifierMockListRoles) Times(n uint64) *mRoleClassifierMockListRoles {
+	if n == 0 {
+		mmListRoles.mock.t.Fatalf("Times of RoleClassifierMock.ListRoles mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmListRoles.expectedInvocations, n)
+	mmListRoles.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmListRoles
+}
+
+func (mmListRoles *mRoleClassifierMockListRoles) invocationsDone() bool {
+	if len(mmListRoles.expectations) == 0 && mmListRoles.defaultExpectation == nil && mmListRoles.mock.funcListRoles == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmListRoles.mock.afterListRolesCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmListRoles.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// ListRoles implements mm_usecase.RoleClassifier
+func (mmListRoles *RoleClassifierMock) ListRoles(ctx context.Context) (sa1 []string, err error) {
+	mm_atomic.AddUint64(&mmListRoles.beforeListRolesCounter, 1)
+	defer mm_atomic.AddUint64(&mmListRoles.afterListRolesCounter, 1)
+
+	mmListRoles.t.Helper()
+
+	if mmListRoles.inspectFuncListRoles != nil {
+		mmListRoles.inspectFuncListRoles(ctx)
+	}
+
+	mm_params := RoleClassifierMockListRolesParams{ctx}
+
+	// Record call args
+	mmListRoles.ListRolesMock.mutex.Lock()
+	mmListRoles.ListRolesMock.callArgs = append(mmListRoles.ListRolesMock.callArgs, &mm_params)
+	mmListRoles.ListRolesMock.mutex.Unlock()
+
+	for _, e := range mmListRoles.ListRolesMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.sa1, e.results.err
+		}
+	}
+
+	if mmListRoles.ListRolesMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmListRoles.ListRolesMock.defaultExpectation.Counter, 1)
+		mm_want := mmListRoles.ListRolesMock.defaultExpectation.params
+		mm_want_ptrs := mmListRoles.ListRolesMock.defaultExpectation.paramPtrs
+
+		mm_got := RoleClassifierMockListRolesParams{ctx}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmListRoles.t.Errorf("RoleClassifierMock.ListRoles got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmListRoles.ListRolesMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmListRoles.t.Errorf("RoleClassifierMock.ListRoles got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmListRoles.ListRolesMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmListRoles.ListRolesMock.defaultExpectation.results
+		if mm_results == nil {
+			mmListRoles.t.Fatal("No results are set for the RoleClassifierMock.ListRoles")
+		}
+		return (*mm_results).sa1, (*mm_results).err
+	}
+	if mmListRoles.funcListRoles != nil {
+		return mmListRoles.funcListRoles(ctx)
+	}
+	mmListRoles.t.Fatalf("Unexpected call to RoleClassifierMock.ListRoles. %v", ctx)
+	return
+}
+
+// ListRolesAfterCounter returns a count of finished RoleClassifierMock.ListRoles invocations
+func (mmListRoles *RoleClassifierMock) ListRolesAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmListRoles.afterListRolesCounter)
+}
+
+// ListRolesBeforeCounter returns a count of RoleClassifierMock.ListRoles invocations
+func (mmListRoles *RoleClassifierMock) ListRolesBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmListRoles.beforeListRolesCounter)
+}
+
+// Calls returns a list of arguments used in each call to RoleClassifierMock.ListRoles.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmListRoles *mRoleClassifierMockListRoles) Calls() []*RoleClassifierMockListRolesParams {
+	mmListRoles.mutex.RLock()
+
+	argCopy := make([]*RoleClassifierMockListRolesParams, len(mmListRoles.callArgs))
+	copy(argCopy, mmListRoles.callArgs)
+
+	mmListRoles.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockListRolesDone returns true if the count of the ListRoles invocations corresponds
+// the number of defined expectations
+func (m *RoleClassifierMock) MinimockListRolesDone() bool {
+	if m.ListRolesMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.ListRolesMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.ListRolesMock.invocationsDone()
+}
+
+// MinimockListRolesInspect logs each unmet expectation
+func (m *RoleClassifierMock) MinimockListRolesInspect() {
+	for _, e := range m.ListRolesMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to RoleClassifierMock.ListRoles at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterListRolesCounter := mm_atomic.LoadUint64(&m.afterListRolesCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.ListRolesMock.defaultExpectation != nil && afterListRolesCounter < 1 {
+		if m.ListRolesMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to RoleClassifierMock.ListRoles at\n%s", m.ListRolesMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to RoleClassifierMock.ListRoles at\n%s with params: %#v", m.ListRolesMock.defaultExpectation.expectationOrigins.origin, *m.ListRolesMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcListRoles != nil && afterListRolesCounter < 1 {
+		m.t.Errorf("Expected call to RoleClassifierMock.ListRoles at\n%s", m.funcListRolesOrigin)
+	}
+
+	if !m.ListRolesMock.invocationsDone() && afterListRolesCounter > 0 {
+		m.t.Errorf("Expected %d calls to RoleClassifierMock.ListRoles at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.ListRolesMock.expectedInvocations), m.ListRolesMock.expectedInvocationsOrigin, afterListRolesCounter)
+	}
+}
+
 // MinimockFinish checks that all mocked methods have been called the expected number of times
 func (m *RoleClassifierMock) MinimockFinish() {
 	m.finishOnce.Do(func() {
 		if !m.minimockDone() {
 			m.MinimockClassifyInspect()
+
+			m.MinimockListRolesInspect()
 		}
 	})
 }
@@ -442,5 +766,6 @@ func (m *RoleClassifierMock) MinimockWait(timeout mm_time.Duration) {
 func (m *RoleClassifierMock) minimockDone() bool {
 	done := true
 	return done &&
-		m.MinimockClassifyDone()
+		m.MinimockClassifyDone() &&
+		m.MinimockListRolesDone()
 }
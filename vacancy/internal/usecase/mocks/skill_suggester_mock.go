@@ -0,0 +1,447 @@
+// Code generated by http://github.com/gojuno/minimock (v3.4.7). DO NOT EDIT.
+
+package mocks
+
+import (
+	"context"
+	"sync"
+	mm_atomic "sync/atomic"
+	mm_time "time"
+
+	"github.com/artem13815/hr/vacancy/internal/domain"
+	"github.com/gojuno/minimock/v3"
+)
+
+// SkillSuggesterMock implements mm_usecase.SkillSuggester
+type SkillSuggesterMock struct {
+	t          minimock.Tester
+	finishOnce sync.Once
+
+	funcSuggest          func(ctx context.Context, title string, description string) (sa1 []domain.SkillWeight, err error)
+	funcSuggestOrigin    string
+	inspectFuncSuggest   func(ctx context.Context, title string, description string)
+	afterSuggestCounter  uint64
+	beforeSuggestCounter uint64
+	SuggestMock          mSkillSuggesterMockSuggest
+}
+
+// NewSkillSuggesterMock returns a mock for mm_usecase.SkillSuggester
+func NewSkillSuggesterMock(t minimock.Tester) *SkillSuggesterMock {
+	m := &SkillSuggesterMock{t: t}
+
+	if controller, ok := t.(minimock.MockController); ok {
+		controller.RegisterMocker(m)
+	}
+
+	m.SuggestMock = mSkillSuggesterMockSuggest{mock: m}
+	m.SuggestMock.callArgs = []*SkillSuggesterMockSuggestParams{}
+
+	t.Cleanup(m.MinimockFinish)
+
+	return m
+}
+
+type mSkillSuggesterMockSuggest struct {
+	optional           bool
+	mock               *SkillSuggesterMock
+	defaultExpectation *SkillSuggesterMockSuggestExpectation
+	expectations       []*SkillSuggesterMockSuggestExpectation
+
+	callArgs []*SkillSuggesterMockSuggestParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// SkillSuggesterMockSuggestExpectation specifies expectation struct of the SkillSuggester.Suggest
+type SkillSuggesterMockSuggestExpectation struct {
+	mock               *SkillSuggesterMock
+	params             *SkillSuggesterMockSuggestParams
+	paramPtrs          *SkillSuggesterMockSuggestParamPtrs
+	expectationOrigins SkillSuggesterMockSuggestExpectationOrigins
+	results            *SkillSuggesterMockSuggestResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// SkillSuggesterMockSuggestParams contains parameters of the SkillSuggester.Suggest
+type SkillSuggesterMockSuggestParams struct {
+	ctx         context.Context
+	title       string
+	description string
+}
+
+// SkillSuggesterMockSuggestParamPtrs contains pointers to parameters of the SkillSuggester.Suggest
+type SkillSuggesterMockSuggestParamPtrs struct {
+	ctx         *context.Context
+	title       *string
+	description *string
+}
+
+// SkillSuggesterMockSuggestResults contains results of the SkillSuggester.Suggest
+type SkillSuggesterMockSuggestResults struct {
+	sa1 []domain.SkillWeight
+	err error
+}
+
+// SkillSuggesterMockSuggestOrigins contains origins of expectations of the SkillSuggester.Suggest
+type SkillSuggesterMockSuggestExpectationOrigins struct {
+	origin            string
+	originCtx         string
+	originTitle       string
+	originDescription string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmSuggest *mSkillSuggesterMockSuggest) Optional() *mSkillSuggesterMockSuggest {
+	mmSuggest.optional = true
+	return mmSuggest
+}
+
+// Expect sets up expected params for SkillSuggester.Suggest
+func (mmSuggest *mSkillSuggesterMockSuggest) Expect(ctx context.Context, title string, description string) *mSkillSuggesterMockSuggest {
+	if mmSuggest.mock.funcSuggest != nil {
+		mmSuggest.mock.t.Fatalf("SkillSuggesterMock.Suggest mock is already set by Set")
+	}
+
+	if mmSuggest.defaultExpectation == nil {
+		mmSuggest.defaultExpectation = &SkillSuggesterMockSuggestExpectation{}
+	}
+
+	if mmSuggest.defaultExpectation.paramPtrs != nil {
+		mmSuggest.mock.t.Fatalf("SkillSuggesterMock.Suggest mock is already set by ExpectParams functions")
+	}
+
+	mmSuggest.defaultExpectation.params = &SkillSuggesterMockSuggestParams{ctx, title, description}
+	mmSuggest.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmSuggest.expectations {
+		if minimock.Equal(e.params, mmSuggest.defaultExpectation.params) {
+			mmSuggest.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmSuggest.defaultExpectation.params)
+		}
+	}
+
+	return mmSuggest
+}
+
+// ExpectCtxParam1 sets up expected param ctx for SkillSuggester.Suggest
+func (mmSuggest *mSkillSuggesterMockSuggest) ExpectCtxParam1(ctx context.Context) *mSkillSuggesterMockSuggest {
+	if mmSuggest.mock.funcSuggest != nil {
+		mmSuggest.mock.t.Fatalf("SkillSuggesterMock.Suggest mock is already set by Set")
+	}
+
+	if mmSuggest.defaultExpectation == nil {
+		mmSuggest.defaultExpectation = &SkillSuggesterMockSuggestExpectation{}
+	}
+
+	if mmSuggest.defaultExpectation.params != nil {
+		mmSuggest.mock.t.Fatalf("SkillSuggesterMock.Suggest mock is already set by Expect")
+	}
+
+	if mmSuggest.defaultExpectation.paramPtrs == nil {
+		mmSuggest.defaultExpectation.paramPtrs = &SkillSuggesterMockSuggestParamPtrs{}
+	}
+	mmSuggest.defaultExpectation.paramPtrs.ctx = &ctx
+	mmSuggest.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmSuggest
+}
+
+// ExpectTitleParam2 sets up expected param title for SkillSuggester.Suggest
+func (mmSuggest *mSkillSuggesterMockSuggest) ExpectTitleParam2(title string) *mSkillSuggesterMockSuggest {
+	if mmSuggest.mock.funcSuggest != nil {
+		mmSuggest.mock.t.Fatalf("SkillSuggesterMock.Suggest mock is already set by Set")
+	}
+
+	if mmSuggest.defaultExpectation == nil {
+		mmSuggest.defaultExpectation = &SkillSuggesterMockSuggestExpectation{}
+	}
+
+	if mmSuggest.defaultExpectation.params != nil {
+		mmSuggest.mock.t.Fatalf("SkillSuggesterMock.Suggest mock is already set by Expect")
+	}
+
+	if mmSuggest.defaultExpectation.paramPtrs == nil {
+		mmSuggest.defaultExpectation.paramPtrs = &SkillSuggesterMockSuggestParamPtrs{}
+	}
+	mmSuggest.defaultExpectation.paramPtrs.title = &title
+	mmSuggest.defaultExpectation.expectationOrigins.originTitle = minimock.CallerInfo(1)
+
+	return mmSuggest
+}
+
+// ExpectDescriptionParam3 sets up expected param description for SkillSuggester.Suggest
+func (mmSuggest *mSkillSuggesterMockSuggest) ExpectDescriptionParam3(description string) *mSkillSuggesterMockSuggest {
+	if mmSuggest.mock.funcSuggest != nil {
+		mmSuggest.mock.t.Fatalf("SkillSuggesterMock.Suggest mock is already set by Set")
+	}
+
+	if mmSuggest.defaultExpectation == nil {
+		mmSuggest.defaultExpectation = &SkillSuggesterMockSuggestExpectation{}
+	}
+
+	if mmSuggest.defaultExpectation.params != nil {
+		mmSuggest.mock.t.Fatalf("SkillSuggesterMock.Suggest mock is already set by Expect")
+	}
+
+	if mmSuggest.defaultExpectation.paramPtrs == nil {
+		mmSuggest.defaultExpectation.paramPtrs = &SkillSuggesterMockSuggestParamPtrs{}
+	}
+	mmSuggest.defaultExpectation.paramPtrs.description = &description
+	mmSuggest.defaultExpectation.expectationOrigins.originDescription = minimock.CallerInfo(1)
+
+	return mmSuggest
+}
+
+// Inspect accepts an inspector function that has same arguments as the SkillSuggester.Suggest
+func (mmSuggest *mSkillSuggesterMockSuggest) Inspect(f func(ctx context.Context, title string, description string)) *mSkillSuggesterMockSuggest {
+	if mmSuggest.mock.inspectFuncSuggest != nil {
+		mmSuggest.mock.t.Fatalf("Inspect function is already set for SkillSuggesterMock.Suggest")
+	}
+
+	mmSuggest.mock.inspectFuncSuggest = f
+
+	return mmSuggest
+}
+
+// Return sets up results that will be returned by SkillSuggester.Suggest
+func (mmSuggest *mSkillSuggesterMockSuggest) Return(sa1 []domain.SkillWeight, err error) *SkillSuggesterMock {
+	if mmSuggest.mock.funcSuggest != nil {
+		mmSuggest.mock.t.Fatalf("SkillSuggesterMock.Suggest mock is already set by Set")
+	}
+
+	if mmSuggest.defaultExpectation == nil {
+		mmSuggest.defaultExpectation = &SkillSuggesterMockSuggestExpectation{mock: mmSuggest.mock}
+	}
+	mmSuggest.defaultExpectation.results = &SkillSuggesterMockSuggestResults{sa1, err}
+	mmSuggest.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmSuggest.mock
+}
+
+// Set uses given function f to mock the SkillSuggester.Suggest method
+func (mmSuggest *mSkillSuggesterMockSuggest) Set(f func(ctx context.Context, title string, description string) (sa1 []domain.SkillWeight, err error)) *SkillSuggesterMock {
+	if mmSuggest.defaultExpectation != nil {
+		mmSuggest.mock.t.Fatalf("Default expectation is already set for the SkillSuggester.Suggest method")
+	}
+
+	if len(mmSuggest.expectations) > 0 {
+		mmSuggest.mock.t.Fatalf("Some expectations are already set for the SkillSuggester.Suggest method")
+	}
+
+	mmSuggest.mock.funcSuggest = f
+	mmSuggest.mock.funcSuggestOrigin = minimock.CallerInfo(1)
+	return mmSuggest.mock
+}
+
+// When sets expectation for the SkillSuggester.Suggest which will trigger the result defined by the following
+// Then helper
+func (mmSuggest *mSkillSuggesterMockSuggest) When(ctx context.Context, title string, description string) *SkillSuggesterMockSuggestExpectation {
+	if mmSuggest.mock.funcSuggest != nil {
+		mmSuggest.mock.t.Fatalf("SkillSuggesterMock.Suggest mock is already set by Set")
+	}
+
+	expectation := &SkillSuggesterMockSuggestExpectation{
+		mock:               mmSuggest.mock,
+		params:             &SkillSuggesterMockSuggestParams{ctx, title, description},
+		expectationOrigins: SkillSuggesterMockSuggestExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmSuggest.expectations = append(mmSuggest.expectations, expectation)
+	return expectation
+}
+
+// Then sets up SkillSuggester.Suggest return parameters for the expectation previously defined by the When method
+func (e *SkillSuggesterMockSuggestExpectation) Then(sa1 []domain.SkillWeight, err error) *SkillSuggesterMock {
+	e.results = &SkillSuggesterMockSuggestResults{sa1, err}
+	return e.mock
+}
+
+// Times sets number of times SkillSuggester.Suggest should be invoked
+func (mmSuggest *mSkillSuggesterMockSuggest) Times(n uint64) *mSkillSuggesterMockSuggest {
+	if n == 0 {
+		mmSuggest.mock.t.Fatalf("Times of SkillSuggesterMock.Suggest mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmSuggest.expectedInvocations, n)
+	mmSuggest.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmSuggest
+}
+
+func (mmSuggest *mSkillSuggesterMockSuggest) invocationsDone() bool {
+	if len(mmSuggest.expectations) == 0 && mmSuggest.defaultExpectation == nil && mmSuggest.mock.funcSuggest == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmSuggest.mock.afterSuggestCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmSuggest.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// Suggest implements mm_usecase.SkillSuggester
+func (mmSuggest *SkillSuggesterMock) Suggest(ctx context.Context, title string, description string) (sa1 []domain.SkillWeight, err error) {
+	mm_atomic.AddUint64(&mmSuggest.beforeSuggestCounter, 1)
+	defer mm_atomic.AddUint64(&mmSuggest.afterSuggestCounter, 1)
+
+	mmSuggest.t.Helper()
+
+	if mmSuggest.inspectFuncSuggest != nil {
+		mmSuggest.inspectFuncSuggest(ctx, title, description)
+	}
+
+	mm_params := SkillSuggesterMockSuggestParams{ctx, title, description}
+
+	// Record call args
+	mmSuggest.SuggestMock.mutex.Lock()
+	mmSuggest.SuggestMock.callArgs = append(mmSuggest.SuggestMock.callArgs, &mm_params)
+	mmSuggest.SuggestMock.mutex.Unlock()
+
+	for _, e := range mmSuggest.SuggestMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.sa1, e.results.err
+		}
+	}
+
+	if mmSuggest.SuggestMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmSuggest.SuggestMock.defaultExpectation.Counter, 1)
+		mm_want := mmSuggest.SuggestMock.defaultExpectation.params
+		mm_want_ptrs := mmSuggest.SuggestMock.defaultExpectation.paramPtrs
+
+		mm_got := SkillSuggesterMockSuggestParams{ctx, title, description}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmSuggest.t.Errorf("SkillSuggesterMock.Suggest got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmSuggest.SuggestMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.title != nil && !minimock.Equal(*mm_want_ptrs.title, mm_got.title) {
+				mmSuggest.t.Errorf("SkillSuggesterMock.Suggest got unexpected parameter title, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmSuggest.SuggestMock.defaultExpectation.expectationOrigins.originTitle, *mm_want_ptrs.title, mm_got.title, minimock.Diff(*mm_want_ptrs.title, mm_got.title))
+			}
+
+			if mm_want_ptrs.description != nil && !minimock.Equal(*mm_want_ptrs.description, mm_got.description) {
+				mmSuggest.t.Errorf("SkillSuggesterMock.Suggest got unexpected parameter description, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmSuggest.SuggestMock.defaultExpectation.expectationOrigins.originDescription, *mm_want_ptrs.description, mm_got.description, minimock.Diff(*mm_want_ptrs.description, mm_got.description))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmSuggest.t.Errorf("SkillSuggesterMock.Suggest got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmSuggest.SuggestMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmSuggest.SuggestMock.defaultExpectation.results
+		if mm_results == nil {
+			mmSuggest.t.Fatal("No results are set for the SkillSuggesterMock.Suggest")
+		}
+		return (*mm_results).sa1, (*mm_results).err
+	}
+	if mmSuggest.funcSuggest != nil {
+		return mmSuggest.funcSuggest(ctx, title, description)
+	}
+	mmSuggest.t.Fatalf("Unexpected call to SkillSuggesterMock.Suggest. %v %v %v", ctx, title, description)
+	return
+}
+
+// SuggestAfterCounter returns a count of finished SkillSuggesterMock.Suggest invocations
+func (mmSuggest *SkillSuggesterMock) SuggestAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmSuggest.afterSuggestCounter)
+}
+
+// SuggestBeforeCounter returns a count of SkillSuggesterMock.Suggest invocations
+func (mmSuggest *SkillSuggesterMock) SuggestBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmSuggest.beforeSuggestCounter)
+}
+
+// Calls returns a list of arguments used in each call to SkillSuggesterMock.Suggest.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmSuggest *mSkillSuggesterMockSuggest) Calls() []*SkillSuggesterMockSuggestParams {
+	mmSuggest.mutex.RLock()
+
+	argCopy := make([]*SkillSuggesterMockSuggestParams, len(mmSuggest.callArgs))
+	copy(argCopy, mmSuggest.callArgs)
+
+	mmSuggest.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockSuggestDone returns true if the count of the Suggest invocations corresponds
+// the number of defined expectations
+func (m *SkillSuggesterMock) MinimockSuggestDone() bool {
+	if m.SuggestMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.SuggestMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.SuggestMock.invocationsDone()
+}
+
+// MinimockSuggestInspect logs each unmet expectation
+func (m *SkillSuggesterMock) MinimockSuggestInspect() {
+	for _, e := range m.SuggestMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to SkillSuggesterMock.Suggest at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterSuggestCounter := mm_atomic.LoadUint64(&m.afterSuggestCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.SuggestMock.defaultExpectation != nil && afterSuggestCounter < 1 {
+		if m.SuggestMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to SkillSuggesterMock.Suggest at\n%s", m.SuggestMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to SkillSuggesterMock.Suggest at\n%s with params: %#v", m.SuggestMock.defaultExpectation.expectationOrigins.origin, *m.SuggestMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcSuggest != nil && afterSuggestCounter < 1 {
+		m.t.Errorf("Expected call to SkillSuggesterMock.Suggest at\n%s", m.funcSuggestOrigin)
+	}
+
+	if !m.SuggestMock.invocationsDone() && afterSuggestCounter > 0 {
+		m.t.Errorf("Expected %d calls to SkillSuggesterMock.Suggest at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.SuggestMock.expectedInvocations), m.SuggestMock.expectedInvocationsOrigin, afterSuggestCounter)
+	}
+}
+
+// MinimockFinish checks that all mocked methods have been called the expected number of times
+func (m *SkillSuggesterMock) MinimockFinish() {
+	m.finishOnce.Do(func() {
+		if !m.minimockDone() {
+			m.MinimockSuggestInspect()
+		}
+	})
+}
+
+// MinimockWait waits for all mocked methods to be called the expected number of times
+func (m *SkillSuggesterMock) MinimockWait(timeout mm_time.Duration) {
+	timeoutCh := mm_time.After(timeout)
+	for {
+		if m.minimockDone() {
+			return
+		}
+		select {
+		case <-timeoutCh:
+			m.MinimockFinish()
+			return
+		case <-mm_time.After(10 * mm_time.Millisecond):
+		}
+	}
+}
+
+func (m *SkillSuggesterMock) minimockDone() bool {
+	done := true
+	return done &&
+		m.MinimockSuggestDone()
+}
@@ -17,12 +17,54 @@ type VacancyStorageMock struct {
 	t          minimock.Tester
 	finishOnce sync.Once
 
-	funcArchiveVacancy          func(ctx context.Context, in domain.ArchiveVacancyInput) (err error)
-	funcArchiveVacancyOrigin    string
-	inspectFuncArchiveVacancy   func(ctx context.Context, in domain.ArchiveVacancyInput)
-	afterArchiveVacancyCounter  uint64
-	beforeArchiveVacancyCounter uint64
-	ArchiveVacancyMock          mVacancyStorageMockArchiveVacancy
+	funcAddCollaborator          func(ctx context.Context, in domain.AddCollaboratorInput) (cp1 *domain.Collaborator, err error)
+	funcAddCollaboratorOrigin    string
+	inspectFuncAddCollaborator   func(ctx context.Context, in domain.AddCollaboratorInput)
+	afterAddCollaboratorCounter  uint64
+	beforeAddCollaboratorCounter uint64
+	AddCollaboratorMock          mVacancyStorageMockAddCollaborator
+
+	funcCanEditVacancy          func(ctx context.Context, vacancyID string, userID uint64) (b1 bool, err error)
+	funcCanEditVacancyOrigin    string
+	inspectFuncCanEditVacancy   func(ctx context.Context, vacancyID string, userID uint64)
+	afterCanEditVacancyCounter  uint64
+	beforeCanEditVacancyCounter uint64
+	CanEditVacancyMock          mVacancyStorageMockCanEditVacancy
+
+	funcChangeCandidateStage          func(ctx context.Context, in domain.ChangeCandidateStageInput) (cp1 *domain.ChangeCandidateStageResult, err error)
+	funcChangeCandidateStageOrigin    string
+	inspectFuncChangeCandidateStage   func(ctx context.Context, in domain.ChangeCandidateStageInput)
+	afterChangeCandidateStageCounter  uint64
+	beforeChangeCandidateStageCounter uint64
+	ChangeCandidateStageMock          mVacancyStorageMockChangeCandidateStage
+
+	funcChangeVacancyStatus          func(ctx context.Context, in domain.ChangeVacancyStatusInput) (vp1 *domain.Vacancy, err error)
+	funcChangeVacancyStatusOrigin    string
+	inspectFuncChangeVacancyStatus   func(ctx context.Context, in domain.ChangeVacancyStatusInput)
+	afterChangeVacancyStatusCounter  uint64
+	beforeChangeVacancyStatusCounter uint64
+	ChangeVacancyStatusMock          mVacancyStorageMockChangeVacancyStatus
+
+	funcCountCandidatesByStage          func(ctx context.Context, vacancyID string) (m1 map[string]uint32, err error)
+	funcCountCandidatesByStageOrigin    string
+	inspectFuncCountCandidatesByStage   func(ctx context.Context, vacancyID string)
+	afterCountCandidatesByStageCounter  uint64
+	beforeCountCandidatesByStageCounter uint64
+	CountCandidatesByStageMock          mVacancyStorageMockCountCandidatesByStage
+
+	funcCreateTemplate          func(ctx context.Context, in domain.InsertTemplateInput) (vp1 *domain.VacancyTemplate, err error)
+	funcCreateTemplateOrigin    string
+	inspectFuncCreateTemplate   func(ctx context.Context, in domain.InsertTemplateInput)
+	afterCreateTemplateCounter  uint64
+	beforeCreateTemplateCounter uint64
+	CreateTemplateMock          mVacancyStorageMockCreateTemplate
+
+	funcCreateVacancies          func(ctx context.Context, ins []domain.CreateVacancyInput) (vpa1 []*domain.Vacancy, err error)
+	funcCreateVacanciesOrigin    string
+	inspectFuncCreateVacancies   func(ctx context.Context, ins []domain.CreateVacancyInput)
+	afterCreateVacanciesCounter  uint64
+	beforeCreateVacanciesCounter uint64
+	CreateVacanciesMock          mVacancyStorageMockCreateVacancies
 
 	funcCreateVacancy          func(ctx context.Context, in domain.CreateVacancyInput) (vp1 *domain.Vacancy, err error)
 	funcCreateVacancyOrigin    string
@@ -31,6 +73,48 @@ type VacancyStorageMock struct {
 	beforeCreateVacancyCounter uint64
 	CreateVacancyMock          mVacancyStorageMockCreateVacancy
 
+	funcDeleteTemplate          func(ctx context.Context, templateID string) (b1 bool, err error)
+	funcDeleteTemplateOrigin    string
+	inspectFuncDeleteTemplate   func(ctx context.Context, templateID string)
+	afterDeleteTemplateCounter  uint64
+	beforeDeleteTemplateCounter uint64
+	DeleteTemplateMock          mVacancyStorageMockDeleteTemplate
+
+	funcDeleteVacancy          func(ctx context.Context, in domain.DeleteVacancyInput) (b1 bool, err error)
+	funcDeleteVacancyOrigin    string
+	inspectFuncDeleteVacancy   func(ctx context.Context, in domain.DeleteVacancyInput)
+	afterDeleteVacancyCounter  uint64
+	beforeDeleteVacancyCounter uint64
+	DeleteVacancyMock          mVacancyStorageMockDeleteVacancy
+
+	funcGetCandidateStage          func(ctx context.Context, vacancyID string, candidateID string) (s1 string, err error)
+	funcGetCandidateStageOrigin    string
+	inspectFuncGetCandidateStage   func(ctx context.Context, vacancyID string, candidateID string)
+	afterGetCandidateStageCounter  uint64
+	beforeGetCandidateStageCounter uint64
+	GetCandidateStageMock          mVacancyStorageMockGetCandidateStage
+
+	funcGetPipelineStages          func(ctx context.Context, vacancyID string) (pa1 []domain.PipelineStage, err error)
+	funcGetPipelineStagesOrigin    string
+	inspectFuncGetPipelineStages   func(ctx context.Context, vacancyID string)
+	afterGetPipelineStagesCounter  uint64
+	beforeGetPipelineStagesCounter uint64
+	GetPipelineStagesMock          mVacancyStorageMockGetPipelineStages
+
+	funcGetPublicVacancy          func(ctx context.Context, vacancyID string) (vp1 *domain.Vacancy, err error)
+	funcGetPublicVacancyOrigin    string
+	inspectFuncGetPublicVacancy   func(ctx context.Context, vacancyID string)
+	afterGetPublicVacancyCounter  uint64
+	beforeGetPublicVacancyCounter uint64
+	GetPublicVacancyMock          mVacancyStorageMockGetPublicVacancy
+
+	funcGetTemplate          func(ctx context.Context, in domain.GetTemplateInput) (vp1 *domain.VacancyTemplate, err error)
+	funcGetTemplateOrigin    string
+	inspectFuncGetTemplate   func(ctx context.Context, in domain.GetTemplateInput)
+	afterGetTemplateCounter  uint64
+	beforeGetTemplateCounter uint64
+	GetTemplateMock          mVacancyStorageMockGetTemplate
+
 	funcGetVacancy          func(ctx context.Context, vacancyID string, ownerUserID uint64, isAdmin bool) (vp1 *domain.Vacancy, err error)
 	funcGetVacancyOrigin    string
 	inspectFuncGetVacancy   func(ctx context.Context, vacancyID string, ownerUserID uint64, isAdmin bool)
@@ -38,6 +122,55 @@ type VacancyStorageMock struct {
 	beforeGetVacancyCounter uint64
 	GetVacancyMock          mVacancyStorageMockGetVacancy
 
+	funcGetVacancyVersion          func(ctx context.Context, in domain.GetVacancyVersionInput) (vp1 *domain.VacancyVersion, err error)
+	funcGetVacancyVersionOrigin    string
+	inspectFuncGetVacancyVersion   func(ctx context.Context, in domain.GetVacancyVersionInput)
+	afterGetVacancyVersionCounter  uint64
+	beforeGetVacancyVersionCounter uint64
+	GetVacancyVersionMock          mVacancyStorageMockGetVacancyVersion
+
+	funcListCandidateStageHistory          func(ctx context.Context, vacancyID string, candidateID string) (ca1 []domain.CandidateStageChange, err error)
+	funcListCandidateStageHistoryOrigin    string
+	inspectFuncListCandidateStageHistory   func(ctx context.Context, vacancyID string, candidateID string)
+	afterListCandidateStageHistoryCounter  uint64
+	beforeListCandidateStageHistoryCounter uint64
+	ListCandidateStageHistoryMock          mVacancyStorageMockListCandidateStageHistory
+
+	funcListCollaborators          func(ctx context.Context, vacancyID string) (ca1 []domain.Collaborator, err error)
+	funcListCollaboratorsOrigin    string
+	inspectFuncListCollaborators   func(ctx context.Context, vacancyID string)
+	afterListCollaboratorsCounter  uint64
+	beforeListCollaboratorsCounter uint64
+	ListCollaboratorsMock          mVacancyStorageMockListCollaborators
+
+	funcListDuplicateCandidates          func(ctx context.Context, title string, ownerUserID uint64, isAdmin bool, limit int) (da1 []domain.DuplicateCandidate, err error)
+	funcListDuplicateCandidatesOrigin    string
+	inspectFuncListDuplicateCandidates   func(ctx context.Context, title string, ownerUserID uint64, isAdmin bool, limit int)
+	afterListDuplicateCandidatesCounter  uint64
+	beforeListDuplicateCandidatesCounter uint64
+	ListDuplicateCandidatesMock          mVacancyStorageMockListDuplicateCandidates
+
+	funcListPublicVacancies          func(ctx context.Context, in domain.ListPublicVacanciesInput) (va1 []domain.Vacancy, err error)
+	funcListPublicVacanciesOrigin    string
+	inspectFuncListPublicVacancies   func(ctx context.Context, in domain.ListPublicVacanciesInput)
+	afterListPublicVacanciesCounter  uint64
+	beforeListPublicVacanciesCounter uint64
+	ListPublicVacanciesMock          mVacancyStorageMockListPublicVacancies
+
+	funcListRoleClassificationTargets          func(ctx context.Context, in domain.ReclassifyRolesInput) (ra1 []domain.RoleClassificationTarget, err error)
+	funcListRoleClassificationTargetsOrigin    string
+	inspectFuncListRoleClassificationTargets   func(ctx context.Context, in domain.ReclassifyRolesInput)
+	afterListRoleClassificationTargetsCounter  uint64
+	beforeListRoleClassificationTargetsCounter uint64
+	ListRoleClassificationTargetsMock          mVacancyStorageMockListRoleClassificationTargets
+
+	funcListTemplates          func(ctx context.Context, in domain.ListTemplatesInput) (lp1 *domain.ListTemplatesResult, err error)
+	funcListTemplatesOrigin    string
+	inspectFuncListTemplates   func(ctx context.Context, in domain.ListTemplatesInput)
+	afterListTemplatesCounter  uint64
+	beforeListTemplatesCounter uint64
+	ListTemplatesMock          mVacancyStorageMockListTemplates
+
 	funcListVacancies          func(ctx context.Context, in domain.ListVacanciesInput) (lp1 *domain.ListVacanciesResult, err error)
 	funcListVacanciesOrigin    string
 	inspectFuncListVacancies   func(ctx context.Context, in domain.ListVacanciesInput)
@@ -45,6 +178,69 @@ type VacancyStorageMock struct {
 	beforeListVacanciesCounter uint64
 	ListVacanciesMock          mVacancyStorageMockListVacancies
 
+	funcListVacancyStatusHistory          func(ctx context.Context, in domain.ListVacancyStatusHistoryInput) (lp1 *domain.ListVacancyStatusHistoryResult, err error)
+	funcListVacancyStatusHistoryOrigin    string
+	inspectFuncListVacancyStatusHistory   func(ctx context.Context, in domain.ListVacancyStatusHistoryInput)
+	afterListVacancyStatusHistoryCounter  uint64
+	beforeListVacancyStatusHistoryCounter uint64
+	ListVacancyStatusHistoryMock          mVacancyStorageMockListVacancyStatusHistory
+
+	funcListVacancyVersions          func(ctx context.Context, in domain.ListVacancyVersionsInput) (lp1 *domain.ListVacancyVersionsResult, err error)
+	funcListVacancyVersionsOrigin    string
+	inspectFuncListVacancyVersions   func(ctx context.Context, in domain.ListVacancyVersionsInput)
+	afterListVacancyVersionsCounter  uint64
+	beforeListVacancyVersionsCounter uint64
+	ListVacancyVersionsMock          mVacancyStorageMockListVacancyVersions
+
+	funcRemoveCollaborator          func(ctx context.Context, vacancyID string, userID uint64) (b1 bool, err error)
+	funcRemoveCollaboratorOrigin    string
+	inspectFuncRemoveCollaborator   func(ctx context.Context, vacancyID string, userID uint64)
+	afterRemoveCollaboratorCounter  uint64
+	beforeRemoveCollaboratorCounter uint64
+	RemoveCollaboratorMock          mVacancyStorageMockRemoveCollaborator
+
+	funcSetPipelineStages          func(ctx context.Context, in domain.SetPipelineStagesInput) (b1 bool, err error)
+	funcSetPipelineStagesOrigin    string
+	inspectFuncSetPipelineStages   func(ctx context.Context, in domain.SetPipelineStagesInput)
+	afterSetPipelineStagesCounter  uint64
+	beforeSetPipelineStagesCounter uint64
+	SetPipelineStagesMock          mVacancyStorageMockSetPipelineStages
+
+	funcSetVacancyRole          func(ctx context.Context, in domain.SetVacancyRoleInput) (b1 bool, err error)
+	funcSetVacancyRoleOrigin    string
+	inspectFuncSetVacancyRole   func(ctx context.Context, in domain.SetVacancyRoleInput)
+	afterSetVacancyRoleCounter  uint64
+	beforeSetVacancyRoleCounter uint64
+	SetVacancyRoleMock          mVacancyStorageMockSetVacancyRole
+
+	funcSetVacancyVisibility          func(ctx context.Context, in domain.SetVacancyVisibilityInput) (vp1 *domain.Vacancy, err error)
+	funcSetVacancyVisibilityOrigin    string
+	inspectFuncSetVacancyVisibility   func(ctx context.Context, in domain.SetVacancyVisibilityInput)
+	afterSetVacancyVisibilityCounter  uint64
+	beforeSetVacancyVisibilityCounter uint64
+	SetVacancyVisibilityMock          mVacancyStorageMockSetVacancyVisibility
+
+	funcStatusBeforeArchive          func(ctx context.Context, vacancyID string) (s1 string, err error)
+	funcStatusBeforeArchiveOrigin    string
+	inspectFuncStatusBeforeArchive   func(ctx context.Context, vacancyID string)
+	afterStatusBeforeArchiveCounter  uint64
+	beforeStatusBeforeArchiveCounter uint64
+	StatusBeforeArchiveMock          mVacancyStorageMockStatusBeforeArchive
+
+	funcTransferVacancyOwnership          func(ctx context.Context, in domain.TransferVacancyOwnershipInput) (u1 uint32, err error)
+	funcTransferVacancyOwnershipOrigin    string
+	inspectFuncTransferVacancyOwnership   func(ctx context.Context, in domain.TransferVacancyOwnershipInput)
+	afterTransferVacancyOwnershipCounter  uint64
+	beforeTransferVacancyOwnershipCounter uint64
+	TransferVacancyOwnershipMock          mVacancyStorageMockTransferVacancyOwnership
+
+	funcUndeleteVacancy          func(ctx context.Context, in domain.UndeleteVacancyInput) (vp1 *domain.Vacancy, err error)
+	funcUndeleteVacancyOrigin    string
+	inspectFuncUndeleteVacancy   func(ctx context.Context, in domain.UndeleteVacancyInput)
+	afterUndeleteVacancyCounter  uint64
+	beforeUndeleteVacancyCounter uint64
+	UndeleteVacancyMock          mVacancyStorageMockUndeleteVacancy
+
 	funcUpdateVacancy          func(ctx context.Context, in domain.UpdateVacancyInput) (vp1 *domain.Vacancy, err error)
 	funcUpdateVacancyOrigin    string
 	inspectFuncUpdateVacancy   func(ctx context.Context, in domain.UpdateVacancyInput)
@@ -61,18 +257,102 @@ func NewVacancyStorageMock(t minimock.Tester) *VacancyStorageMock {
 		controller.RegisterMocker(m)
 	}
 
-	m.ArchiveVacancyMock = mVacancyStorageMockArchiveVacancy{mock: m}
-	m.ArchiveVacancyMock.callArgs = []*VacancyStorageMockArchiveVacancyParams{}
+	m.AddCollaboratorMock = mVacancyStorageMockAddCollaborator{mock: m}
+	m.AddCollaboratorMock.callArgs = []*VacancyStorageMockAddCollaboratorParams{}
+
+	m.CanEditVacancyMock = mVacancyStorageMockCanEditVacancy{mock: m}
+	m.CanEditVacancyMock.callArgs = []*VacancyStorageMockCanEditVacancyParams{}
+
+	m.ChangeCandidateStageMock = mVacancyStorageMockChangeCandidateStage{mock: m}
+	m.ChangeCandidateStageMock.callArgs = []*VacancyStorageMockChangeCandidateStageParams{}
+
+	m.ChangeVacancyStatusMock = mVacancyStorageMockChangeVacancyStatus{mock: m}
+	m.ChangeVacancyStatusMock.callArgs = []*VacancyStorageMockChangeVacancyStatusParams{}
+
+	m.CountCandidatesByStageMock = mVacancyStorageMockCountCandidatesByStage{mock: m}
+	m.CountCandidatesByStageMock.callArgs = []*VacancyStorageMockCountCandidatesByStageParams{}
+
+	m.CreateTemplateMock = mVacancyStorageMockCreateTemplate{mock: m}
+	m.CreateTemplateMock.callArgs = []*VacancyStorageMockCreateTemplateParams{}
+
+	m.CreateVacanciesMock = mVacancyStorageMockCreateVacancies{mock: m}
+	m.CreateVacanciesMock.callArgs = []*VacancyStorageMockCreateVacanciesParams{}
 
 	m.CreateVacancyMock = mVacancyStorageMockCreateVacancy{mock: m}
 	m.CreateVacancyMock.callArgs = []*VacancyStorageMockCreateVacancyParams{}
 
+	m.DeleteTemplateMock = mVacancyStorageMockDeleteTemplate{mock: m}
+	m.DeleteTemplateMock.callArgs = []*VacancyStorageMockDeleteTemplateParams{}
+
+	m.DeleteVacancyMock = mVacancyStorageMockDeleteVacancy{mock: m}
+	m.DeleteVacancyMock.callArgs = []*VacancyStorageMockDeleteVacancyParams{}
+
+	m.GetCandidateStageMock = mVacancyStorageMockGetCandidateStage{mock: m}
+	m.GetCandidateStageMock.callArgs = []*VacancyStorageMockGetCandidateStageParams{}
+
+	m.GetPipelineStagesMock = mVacancyStorageMockGetPipelineStages{mock: m}
+	m.GetPipelineStagesMock.callArgs = []*VacancyStorageMockGetPipelineStagesParams{}
+
+	m.GetPublicVacancyMock = mVacancyStorageMockGetPublicVacancy{mock: m}
+	m.GetPublicVacancyMock.callArgs = []*VacancyStorageMockGetPublicVacancyParams{}
+
+	m.GetTemplateMock = mVacancyStorageMockGetTemplate{mock: m}
+	m.GetTemplateMock.callArgs = []*VacancyStorageMockGetTemplateParams{}
+
 	m.GetVacancyMock = mVacancyStorageMockGetVacancy{mock: m}
 	m.GetVacancyMock.callArgs = []*VacancyStorageMockGetVacancyParams{}
 
+	m.GetVacancyVersionMock = mVacancyStorageMockGetVacancyVersion{mock: m}
+	m.GetVacancyVersionMock.callArgs = []*VacancyStorageMockGetVacancyVersionParams{}
+
+	m.ListCandidateStageHistoryMock = mVacancyStorageMockListCandidateStageHistory{mock: m}
+	m.ListCandidateStageHistoryMock.callArgs = []*VacancyStorageMockListCandidateStageHistoryParams{}
+
+	m.ListCollaboratorsMock = mVacancyStorageMockListCollaborators{mock: m}
+	m.ListCollaboratorsMock.callArgs = []*VacancyStorageMockListCollaboratorsParams{}
+
+	m.ListDuplicateCandidatesMock = mVacancyStorageMockListDuplicateCandidates{mock: m}
+	m.ListDuplicateCandidatesMock.callArgs = []*VacancyStorageMockListDuplicateCandidatesParams{}
+
+	m.ListPublicVacanciesMock = mVacancyStorageMockListPublicVacancies{mock: m}
+	m.ListPublicVacanciesMock.callArgs = []*VacancyStorageMockListPublicVacanciesParams{}
+
+	m.ListRoleClassificationTargetsMock = mVacancyStorageMockListRoleClassificationTargets{mock: m}
+	m.ListRoleClassificationTargetsMock.callArgs = []*VacancyStorageMockListRoleClassificationTargetsParams{}
+
+	m.ListTemplatesMock = mVacancyStorageMockListTemplates{mock: m}
+	m.ListTemplatesMock.callArgs = []*VacancyStorageMockListTemplatesParams{}
+
 	m.ListVacanciesMock = mVacancyStorageMockListVacancies{mock: m}
 	m.ListVacanciesMock.callArgs = []*VacancyStorageMockListVacanciesParams{}
 
+	m.ListVacancyStatusHistoryMock = mVacancyStorageMockListVacancyStatusHistory{mock: m}
+	m.ListVacancyStatusHistoryMock.callArgs = []*VacancyStorageMockListVacancyStatusHistoryParams{}
+
+	m.ListVacancyVersionsMock = mVacancyStorageMockListVacancyVersions{mock: m}
+	m.ListVacancyVersionsMock.callArgs = []*VacancyStorageMockListVacancyVersionsParams{}
+
+	m.RemoveCollaboratorMock = mVacancyStorageMockRemoveCollaborator{mock: m}
+	m.RemoveCollaboratorMock.callArgs = []*VacancyStorageMockRemoveCollaboratorParams{}
+
+	m.SetPipelineStagesMock = mVacancyStorageMockSetPipelineStages{mock: m}
+	m.SetPipelineStagesMock.callArgs = []*VacancyStorageMockSetPipelineStagesParams{}
+
+	m.SetVacancyRoleMock = mVacancyStorageMockSetVacancyRole{mock: m}
+	m.SetVacancyRoleMock.callArgs = []*VacancyStorageMockSetVacancyRoleParams{}
+
+	m.SetVacancyVisibilityMock = mVacancyStorageMockSetVacancyVisibility{mock: m}
+	m.SetVacancyVisibilityMock.callArgs = []*VacancyStorageMockSetVacancyVisibilityParams{}
+
+	m.StatusBeforeArchiveMock = mVacancyStorageMockStatusBeforeArchive{mock: m}
+	m.StatusBeforeArchiveMock.callArgs = []*VacancyStorageMockStatusBeforeArchiveParams{}
+
+	m.TransferVacancyOwnershipMock = mVacancyStorageMockTransferVacancyOwnership{mock: m}
+	m.TransferVacancyOwnershipMock.callArgs = []*VacancyStorageMockTransferVacancyOwnershipParams{}
+
+	m.UndeleteVacancyMock = mVacancyStorageMockUndeleteVacancy{mock: m}
+	m.UndeleteVacancyMock.callArgs = []*VacancyStorageMockUndeleteVacancyParams{}
+
 	m.UpdateVacancyMock = mVacancyStorageMockUpdateVacancy{mock: m}
 	m.UpdateVacancyMock.callArgs = []*VacancyStorageMockUpdateVacancyParams{}
 
@@ -81,49 +361,50 @@ func NewVacancyStorageMock(t minimock.Tester) *VacancyStorageMock {
 	return m
 }
 
-type mVacancyStorageMockArchiveVacancy struct {
+type mVacancyStorageMockAddCollaborator struct {
 	optional           bool
 	mock               *VacancyStorageMock
-	defaultExpectation *VacancyStorageMockArchiveVacancyExpectation
-	expectations       []*VacancyStorageMockArchiveVacancyExpectation
+	defaultExpectation *VacancyStorageMockAddCollaboratorExpectation
+	expectations       []*VacancyStorageMockAddCollaboratorExpectation
 
-	callArgs []*VacancyStorageMockArchiveVacancyParams
+	callArgs []*VacancyStorageMockAddCollaboratorParams
 	mutex    sync.RWMutex
 
 	expectedInvocations       uint64
 	expectedInvocationsOrigin string
 }
 
-// VacancyStorageMockArchiveVacancyExpectation specifies expectation struct of the VacancyStorage.ArchiveVacancy
-type VacancyStorageMockArchiveVacancyExpectation struct {
+// VacancyStorageMockAddCollaboratorExpectation specifies expectation struct of the VacancyStorage.AddCollaborator
+type VacancyStorageMockAddCollaboratorExpectation struct {
 	mock               *VacancyStorageMock
-	params             *VacancyStorageMockArchiveVacancyParams
-	paramPtrs          *VacancyStorageMockArchiveVacancyParamPtrs
-	expectationOrigins VacancyStorageMockArchiveVacancyExpectationOrigins
-	results            *VacancyStorageMockArchiveVacancyResults
+	params             *VacancyStorageMockAddCollaboratorParams
+	paramPtrs          *VacancyStorageMockAddCollaboratorParamPtrs
+	expectationOrigins VacancyStorageMockAddCollaboratorExpectationOrigins
+	results            *VacancyStorageMockAddCollaboratorResults
 	returnOrigin       string
 	Counter            uint64
 }
 
-// VacancyStorageMockArchiveVacancyParams contains parameters of the VacancyStorage.ArchiveVacancy
-type VacancyStorageMockArchiveVacancyParams struct {
+// VacancyStorageMockAddCollaboratorParams contains parameters of the VacancyStorage.AddCollaborator
+type VacancyStorageMockAddCollaboratorParams struct {
 	ctx context.Context
-	in  domain.ArchiveVacancyInput
+	in  domain.AddCollaboratorInput
 }
 
-// VacancyStorageMockArchiveVacancyParamPtrs contains pointers to parameters of the VacancyStorage.ArchiveVacancy
-type VacancyStorageMockArchiveVacancyParamPtrs struct {
+// VacancyStorageMockAddCollaboratorParamPtrs contains pointers to parameters of the VacancyStorage.AddCollaborator
+type VacancyStorageMockAddCollaboratorParamPtrs struct {
 	ctx *context.Context
-	in  *domain.ArchiveVacancyInput
+	in  *domain.AddCollaboratorInput
 }
 
-// VacancyStorageMockArchiveVacancyResults contains results of the VacancyStorage.ArchiveVacancy
-type VacancyStorageMockArchiveVacancyResults struct {
+// VacancyStorageMockAddCollaboratorResults contains results of the VacancyStorage.AddCollaborator
+type VacancyStorageMockAddCollaboratorResults struct {
+	cp1 *domain.Collaborator
 	err error
 }
 
-// VacancyStorageMockArchiveVacancyOrigins contains origins of expectations of the VacancyStorage.ArchiveVacancy
-type VacancyStorageMockArchiveVacancyExpectationOrigins struct {
+// VacancyStorageMockAddCollaboratorOrigins contains origins of expectations of the VacancyStorage.AddCollaborator
+type VacancyStorageMockAddCollaboratorExpectationOrigins struct {
 	origin    string
 	originCtx string
 	originIn  string
@@ -134,342 +415,345 @@ type VacancyStorageMockArchiveVacancyExpectationOrigins struct {
 // Optional() makes method check to work in '0 or more' mode.
 // It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
 // catch the problems when the expected method call is totally skipped during test run.
-func (mmArchiveVacancy *mVacancyStorageMockArchiveVacancy) Optional() *mVacancyStorageMockArchiveVacancy {
-	mmArchiveVacancy.optional = true
-	return mmArchiveVacancy
+func (mmAddCollaborator *mVacancyStorageMockAddCollaborator) Optional() *mVacancyStorageMockAddCollaborator {
+	mmAddCollaborator.optional = true
+	return mmAddCollaborator
 }
 
-// Expect sets up expected params for VacancyStorage.ArchiveVacancy
-func (mmArchiveVacancy *mVacancyStorageMockArchiveVacancy) Expect(ctx context.Context, in domain.ArchiveVacancyInput) *mVacancyStorageMockArchiveVacancy {
-	if mmArchiveVacancy.mock.funcArchiveVacancy != nil {
-		mmArchiveVacancy.mock.t.Fatalf("VacancyStorageMock.ArchiveVacancy mock is already set by Set")
+// Expect sets up expected params for VacancyStorage.AddCollaborator
+func (mmAddCollaborator *mVacancyStorageMockAddCollaborator) Expect(ctx context.Context, in domain.AddCollaboratorInput) *mVacancyStorageMockAddCollaborator {
+	if mmAddCollaborator.mock.funcAddCollaborator != nil {
+		mmAddCollaborator.mock.t.Fatalf("VacancyStorageMock.AddCollaborator mock is already set by Set")
 	}
 
-	if mmArchiveVacancy.defaultExpectation == nil {
-		mmArchiveVacancy.defaultExpectation = &VacancyStorageMockArchiveVacancyExpectation{}
+	if mmAddCollaborator.defaultExpectation == nil {
+		mmAddCollaborator.defaultExpectation = &VacancyStorageMockAddCollaboratorExpectation{}
 	}
 
-	if mmArchiveVacancy.defaultExpectation.paramPtrs != nil {
-		mmArchiveVacancy.mock.t.Fatalf("VacancyStorageMock.ArchiveVacancy mock is already set by ExpectParams functions")
+	if mmAddCollaborator.defaultExpectation.paramPtrs != nil {
+		mmAddCollaborator.mock.t.Fatalf("VacancyStorageMock.AddCollaborator mock is already set by ExpectParams functions")
 	}
 
-	mmArchiveVacancy.defaultExpectation.params = &VacancyStorageMockArchiveVacancyParams{ctx, in}
-	mmArchiveVacancy.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
-	for _, e := range mmArchiveVacancy.expectations {
-		if minimock.Equal(e.params, mmArchiveVacancy.defaultExpectation.params) {
-			mmArchiveVacancy.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmArchiveVacancy.defaultExpectation.params)
+	mmAddCollaborator.defaultExpectation.params = &VacancyStorageMockAddCollaboratorParams{ctx, in}
+	mmAddCollaborator.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmAddCollaborator.expectations {
+		if minimock.Equal(e.params, mmAddCollaborator.defaultExpectation.params) {
+			mmAddCollaborator.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmAddCollaborator.defaultExpectation.params)
 		}
 	}
 
-	return mmArchiveVacancy
+	return mmAddCollaborator
 }
 
-// ExpectCtxParam1 sets up expected param ctx for VacancyStorage.ArchiveVacancy
-func (mmArchiveVacancy *mVacancyStorageMockArchiveVacancy) ExpectCtxParam1(ctx context.Context) *mVacancyStorageMockArchiveVacancy {
-	if mmArchiveVacancy.mock.funcArchiveVacancy != nil {
-		mmArchiveVacancy.mock.t.Fatalf("VacancyStorageMock.ArchiveVacancy mock is already set by Set")
+// ExpectCtxParam1 sets up expected param ctx for VacancyStorage.AddCollaborator
+func (mmAddCollaborator *mVacancyStorageMockAddCollaborator) ExpectCtxParam1(ctx context.Context) *mVacancyStorageMockAddCollaborator {
+	if mmAddCollaborator.mock.funcAddCollaborator != nil {
+		mmAddCollaborator.mock.t.Fatalf("VacancyStorageMock.AddCollaborator mock is already set by Set")
 	}
 
-	if mmArchiveVacancy.defaultExpectation == nil {
-		mmArchiveVacancy.defaultExpectation = &VacancyStorageMockArchiveVacancyExpectation{}
+	if mmAddCollaborator.defaultExpectation == nil {
+		mmAddCollaborator.defaultExpectation = &VacancyStorageMockAddCollaboratorExpectation{}
 	}
 
-	if mmArchiveVacancy.defaultExpectation.params != nil {
-		mmArchiveVacancy.mock.t.Fatalf("VacancyStorageMock.ArchiveVacancy mock is already set by Expect")
+	if mmAddCollaborator.defaultExpectation.params != nil {
+		mmAddCollaborator.mock.t.Fatalf("VacancyStorageMock.AddCollaborator mock is already set by Expect")
 	}
 
-	if mmArchiveVacancy.defaultExpectation.paramPtrs == nil {
-		mmArchiveVacancy.defaultExpectation.paramPtrs = &VacancyStorageMockArchiveVacancyParamPtrs{}
+	if mmAddCollaborator.defaultExpectation.paramPtrs == nil {
+		mmAddCollaborator.defaultExpectation.paramPtrs = &VacancyStorageMockAddCollaboratorParamPtrs{}
 	}
-	mmArchiveVacancy.defaultExpectation.paramPtrs.ctx = &ctx
-	mmArchiveVacancy.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+	mmAddCollaborator.defaultExpectation.paramPtrs.ctx = &ctx
+	mmAddCollaborator.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
 
-	return mmArchiveVacancy
+	return mmAddCollaborator
 }
 
-// ExpectInParam2 sets up expected param in for VacancyStorage.ArchiveVacancy
-func (mmArchiveVacancy *mVacancyStorageMockArchiveVacancy) ExpectInParam2(in domain.ArchiveVacancyInput) *mVacancyStorageMockArchiveVacancy {
-	if mmArchiveVacancy.mock.funcArchiveVacancy != nil {
-		mmArchiveVacancy.mock.t.Fatalf("VacancyStorageMock.ArchiveVacancy mock is already set by Set")
+// ExpectInParam2 sets up expected param in for VacancyStorage.AddCollaborator
+func (mmAddCollaborator *mVacancyStorageMockAddCollaborator) ExpectInParam2(in domain.AddCollaboratorInput) *mVacancyStorageMockAddCollaborator {
+	if mmAddCollaborator.mock.funcAddCollaborator != nil {
+		mmAddCollaborator.mock.t.Fatalf("VacancyStorageMock.AddCollaborator mock is already set by Set")
 	}
 
-	if mmArchiveVacancy.defaultExpectation == nil {
-		mmArchiveVacancy.defaultExpectation = &VacancyStorageMockArchiveVacancyExpectation{}
+	if mmAddCollaborator.defaultExpectation == nil {
+		mmAddCollaborator.defaultExpectation = &VacancyStorageMockAddCollaboratorExpectation{}
 	}
 
-	if mmArchiveVacancy.defaultExpectation.params != nil {
-		mmArchiveVacancy.mock.t.Fatalf("VacancyStorageMock.ArchiveVacancy mock is already set by Expect")
+	if mmAddCollaborator.defaultExpectation.params != nil {
+		mmAddCollaborator.mock.t.Fatalf("VacancyStorageMock.AddCollaborator mock is already set by Expect")
 	}
 
-	if mmArchiveVacancy.defaultExpectation.paramPtrs == nil {
-		mmArchiveVacancy.defaultExpectation.paramPtrs = &VacancyStorageMockArchiveVacancyParamPtrs{}
+	if mmAddCollaborator.defaultExpectation.paramPtrs == nil {
+		mmAddCollaborator.defaultExpectation.paramPtrs = &VacancyStorageMockAddCollaboratorParamPtrs{}
 	}
-	mmArchiveVacancy.defaultExpectation.paramPtrs.in = &in
-	mmArchiveVacancy.defaultExpectation.expectationOrigins.originIn = minimock.CallerInfo(1)
+	mmAddCollaborator.defaultExpectation.paramPtrs.in = &in
+	mmAddCollaborator.defaultExpectation.expectationOrigins.originIn = minimock.CallerInfo(1)
 
-	return mmArchiveVacancy
+	return mmAddCollaborator
 }
 
-// Inspect accepts an inspector function that has same arguments as the VacancyStorage.ArchiveVacancy
-func (mmArchiveVacancy *mVacancyStorageMockArchiveVacancy) Inspect(f func(ctx context.Context, in domain.ArchiveVacancyInput)) *mVacancyStorageMockArchiveVacancy {
-	if mmArchiveVacancy.mock.inspectFuncArchiveVacancy != nil {
-		mmArchiveVacancy.mock.t.Fatalf("Inspect function is already set for VacancyStorageMock.ArchiveVacancy")
+// Inspect accepts an inspector function that has same arguments as the VacancyStorage.AddCollaborator
+func (mmAddCollaborator *mVacancyStorageMockAddCollaborator) Inspect(f func(ctx context.Context, in domain.AddCollaboratorInput)) *mVacancyStorageMockAddCollaborator {
+	if mmAddCollaborator.mock.inspectFuncAddCollaborator != nil {
+		mmAddCollaborator.mock.t.Fatalf("Inspect function is already set for VacancyStorageMock.AddCollaborator")
 	}
 
-	mmArchiveVacancy.mock.inspectFuncArchiveVacancy = f
+	mmAddCollaborator.mock.inspectFuncAddCollaborator = f
 
-	return mmArchiveVacancy
+	return mmAddCollaborator
 }
 
-// Return sets up results that will be returned by VacancyStorage.ArchiveVacancy
-func (mmArchiveVacancy *mVacancyStorageMockArchiveVacancy) Return(err error) *VacancyStorageMock {
-	if mmArchiveVacancy.mock.funcArchiveVacancy != nil {
-		mmArchiveVacancy.mock.t.Fatalf("VacancyStorageMock.ArchiveVacancy mock is already set by Set")
+// Return sets up results that will be returned by VacancyStorage.AddCollaborator
+func (mmAddCollaborator *mVacancyStorageMockAddCollaborator) Return(cp1 *domain.Collaborator, err error) *VacancyStorageMock {
+	if mmAddCollaborator.mock.funcAddCollaborator != nil {
+		mmAddCollaborator.mock.t.Fatalf("VacancyStorageMock.AddCollaborator mock is already set by Set")
 	}
 
-	if mmArchiveVacancy.defaultExpectation == nil {
-		mmArchiveVacancy.defaultExpectation = &VacancyStorageMockArchiveVacancyExpectation{mock: mmArchiveVacancy.mock}
+	if mmAddCollaborator.defaultExpectation == nil {
+		mmAddCollaborator.defaultExpectation = &VacancyStorageMockAddCollaboratorExpectation{mock: mmAddCollaborator.mock}
 	}
-	mmArchiveVacancy.defaultExpectation.results = &VacancyStorageMockArchiveVacancyResults{err}
-	mmArchiveVacancy.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
-	return mmArchiveVacancy.mock
+	mmAddCollaborator.defaultExpectation.results = &VacancyStorageMockAddCollaboratorResults{cp1, err}
+	mmAddCollaborator.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmAddCollaborator.mock
 }
 
-// Set uses given function f to mock the VacancyStorage.ArchiveVacancy method
-func (mmArchiveVacancy *mVacancyStorageMockArchiveVacancy) Set(f func(ctx context.Context, in domain.ArchiveVacancyInput) (err error)) *VacancyStorageMock {
-	if mmArchiveVacancy.defaultExpectation != nil {
-		mmArchiveVacancy.mock.t.Fatalf("Default expectation is already set for the VacancyStorage.ArchiveVacancy method")
+// Set uses given function f to mock the VacancyStorage.AddCollaborator method
+func (mmAddCollaborator *mVacancyStorageMockAddCollaborator) Set(f func(ctx context.Context, in domain.AddCollaboratorInput) (cp1 *domain.Collaborator, err error)) *VacancyStorageMock {
+	if mmAddCollaborator.defaultExpectation != nil {
+		mmAddCollaborator.mock.t.Fatalf("Default expectation is already set for the VacancyStorage.AddCollaborator method")
 	}
 
-	if len(mmArchiveVacancy.expectations) > 0 {
-		mmArchiveVacancy.mock.t.Fatalf("Some expectations are already set for the VacancyStorage.ArchiveVacancy method")
+	if len(mmAddCollaborator.expectations) > 0 {
+		mmAddCollaborator.mock.t.Fatalf("Some expectations are already set for the VacancyStorage.AddCollaborator method")
 	}
 
-	mmArchiveVacancy.mock.funcArchiveVacancy = f
-	mmArchiveVacancy.mock.funcArchiveVacancyOrigin = minimock.CallerInfo(1)
-	return mmArchiveVacancy.mock
+	mmAddCollaborator.mock.funcAddCollaborator = f
+	mmAddCollaborator.mock.funcAddCollaboratorOrigin = minimock.CallerInfo(1)
+	return mmAddCollaborator.mock
 }
 
-// When sets expectation for the VacancyStorage.ArchiveVacancy which will trigger the result defined by the following
+// When sets expectation for the VacancyStorage.AddCollaborator which will trigger the result defined by the following
 // Then helper
-func (mmArchiveVacancy *mVacancyStorageMockArchiveVacancy) When(ctx context.Context, in domain.ArchiveVacancyInput) *VacancyStorageMockArchiveVacancyExpectation {
-	if mmArchiveVacancy.mock.funcArchiveVacancy != nil {
-		mmArchiveVacancy.mock.t.Fatalf("VacancyStorageMock.ArchiveVacancy mock is already set by Set")
+func (mmAddCollaborator *mVacancyStorageMockAddCollaborator) When(ctx context.Context, in domain.AddCollaboratorInput) *VacancyStorageMockAddCollaboratorExpectation {
+	if mmAddCollaborator.mock.funcAddCollaborator != nil {
+		mmAddCollaborator.mock.t.Fatalf("VacancyStorageMock.AddCollaborator mock is already set by Set")
 	}
 
-	expectation := &VacancyStorageMockArchiveVacancyExpectation{
-		mock:               mmArchiveVacancy.mock,
-		params:             &VacancyStorageMockArchiveVacancyParams{ctx, in},
-		expectationOrigins: VacancyStorageMockArchiveVacancyExpectationOrigins{origin: minimock.CallerInfo(1)},
+	expectation := &VacancyStorageMockAddCollaboratorExpectation{
+		mock:               mmAddCollaborator.mock,
+		params:             &VacancyStorageMockAddCollaboratorParams{ctx, in},
+		expectationOrigins: VacancyStorageMockAddCollaboratorExpectationOrigins{origin: minimock.CallerInfo(1)},
 	}
-	mmArchiveVacancy.expectations = append(mmArchiveVacancy.expectations, expectation)
+	mmAddCollaborator.expectations = append(mmAddCollaborator.expectations, expectation)
 	return expectation
 }
 
-// Then sets up VacancyStorage.ArchiveVacancy return parameters for the expectation previously defined by the When method
-func (e *VacancyStorageMockArchiveVacancyExpectation) Then(err error) *VacancyStorageMock {
-	e.results = &VacancyStorageMockArchiveVacancyResults{err}
+// Then sets up VacancyStorage.AddCollaborator return parameters for the expectation previously defined by the When method
+func (e *VacancyStorageMockAddCollaboratorExpectation) Then(cp1 *domain.Collaborator, err error) *VacancyStorageMock {
+	e.results = &VacancyStorageMockAddCollaboratorResults{cp1, err}
 	return e.mock
 }
 
-// Times sets number of times VacancyStorage.ArchiveVacancy should be invoked
-func (mmArchiveVacancy *mVacancyStorageMockArchiveVacancy) Times(n uint64) *mVacancyStorageMockArchiveVacancy {
+// Times sets number of times VacancyStorage.AddCollaborator should be invoked
+func (mmAddCollaborator *mVacancyStorageMockAddCollaborator) Times(n uint64) *mVacancyStorageMockAddCollaborator {
 	if n == 0 {
-		mmArchiveVacancy.mock.t.Fatalf("Times of VacancyStorageMock.ArchiveVacancy mock can not be zero")
+		mmAddCollaborator.mock.t.Fatalf("Times of VacancyStorageMock.AddCollaborator mock can not be zero")
 	}
-	mm_atomic.StoreUint64(&mmArchiveVacancy.expectedInvocations, n)
-	mmArchiveVacancy.expectedInvocationsOrigin = minimock.CallerInfo(1)
-	return mmArchiveVacancy
+	mm_atomic.StoreUint64(&mmAddCollaborator.expectedInvocations, n)
+	mmAddCollaborator.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmAddCollaborator
 }
 
-func (mmArchiveVacancy *mVacancyStorageMockArchiveVacancy) invocationsDone() bool {
-	if len(mmArchiveVacancy.expectations) == 0 && mmArchiveVacancy.defaultExpectation == nil && mmArchiveVacancy.mock.funcArchiveVacancy == nil {
+func (mmAddCollaborator *mVacancyStorageMockAddCollaborator) invocationsDone() bool {
+	if len(mmAddCollaborator.expectations) == 0 && mmAddCollaborator.defaultExpectation == nil && mmAddCollaborator.mock.funcAddCollaborator == nil {
 		return true
 	}
 
-	totalInvocations := mm_atomic.LoadUint64(&mmArchiveVacancy.mock.afterArchiveVacancyCounter)
-	expectedInvocations := mm_atomic.LoadUint64(&mmArchiveVacancy.expectedInvocations)
+	totalInvocations := mm_atomic.LoadUint64(&mmAddCollaborator.mock.afterAddCollaboratorCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmAddCollaborator.expectedInvocations)
 
 	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
 }
 
-// ArchiveVacancy implements mm_usecase.VacancyStorage
-func (mmArchiveVacancy *VacancyStorageMock) ArchiveVacancy(ctx context.Context, in domain.ArchiveVacancyInput) (err error) {
-	mm_atomic.AddUint64(&mmArchiveVacancy.beforeArchiveVacancyCounter, 1)
-	defer mm_atomic.AddUint64(&mmArchiveVacancy.afterArchiveVacancyCounter, 1)
+// AddCollaborator implements mm_usecase.VacancyStorage
+func (mmAddCollaborator *VacancyStorageMock) AddCollaborator(ctx context.Context, in domain.AddCollaboratorInput) (cp1 *domain.Collaborator, err error) {
+	mm_atomic.AddUint64(&mmAddCollaborator.beforeAddCollaboratorCounter, 1)
+	defer mm_atomic.AddUint64(&mmAddCollaborator.afterAddCollaboratorCounter, 1)
 
-	mmArchiveVacancy.t.Helper()
+	mmAddCollaborator.t.Helper()
 
-	if mmArchiveVacancy.inspectFuncArchiveVacancy != nil {
-		mmArchiveVacancy.inspectFuncArchiveVacancy(ctx, in)
+	if mmAddCollaborator.inspectFuncAddCollaborator != nil {
+		mmAddCollaborator.inspectFuncAddCollaborator(ctx, in)
 	}
 
-	mm_params := VacancyStorageMockArchiveVacancyParams{ctx, in}
+	mm_params := VacancyStorageMockAddCollaboratorParams{ctx, in}
 
 	// Record call args
-	mmArchiveVacancy.ArchiveVacancyMock.mutex.Lock()
-	mmArchiveVacancy.ArchiveVacancyMock.callArgs = append(mmArchiveVacancy.ArchiveVacancyMock.callArgs, &mm_params)
-	mmArchiveVacancy.ArchiveVacancyMock.mutex.Unlock()
+	mmAddCollaborator.AddCollaboratorMock.mutex.Lock()
+	mmAddCollaborator.AddCollaboratorMock.callArgs = append(mmAddCollaborator.AddCollaboratorMock.callArgs, &mm_params)
+	mmAddCollaborator.AddCollaboratorMock.mutex.Unlock()
 
-	for _, e := range mmArchiveVacancy.ArchiveVacancyMock.expectations {
+	for _, e := range mmAddCollaborator.AddCollaboratorMock.expectations {
 		if minimock.Equal(*e.params, mm_params) {
 			mm_atomic.AddUint64(&e.Counter, 1)
-			return e.results.err
+			return e.results.cp1, e.results.err
 		}
 	}
 
-	if mmArchiveVacancy.ArchiveVacancyMock.defaultExpectation != nil {
-		mm_atomic.AddUint64(&mmArchiveVacancy.ArchiveVacancyMock.defaultExpectation.Counter, 1)
-		mm_want := mmArchiveVacancy.ArchiveVacancyMock.defaultExpectation.params
-		mm_want_ptrs := mmArchiveVacancy.ArchiveVacancyMock.defaultExpectation.paramPtrs
+	if mmAddCollaborator.AddCollaboratorMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmAddCollaborator.AddCollaboratorMock.defaultExpectation.Counter, 1)
+		mm_want := mmAddCollaborator.AddCollaboratorMock.defaultExpectation.params
+		mm_want_ptrs := mmAddCollaborator.AddCollaboratorMock.defaultExpectation.paramPtrs
 
-		mm_got := VacancyStorageMockArchiveVacancyParams{ctx, in}
+		mm_got := VacancyStorageMockAddCollaboratorParams{ctx, in}
 
 		if mm_want_ptrs != nil {
 
 			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
-				mmArchiveVacancy.t.Errorf("VacancyStorageMock.ArchiveVacancy got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
-					mmArchiveVacancy.ArchiveVacancyMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+				mmAddCollaborator.t.Errorf("VacancyStorageMock.AddCollaborator got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmAddCollaborator.AddCollaboratorMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
 			}
 
 			if mm_want_ptrs.in != nil && !minimock.Equal(*mm_want_ptrs.in, mm_got.in) {
-				mmArchiveVacancy.t.Errorf("VacancyStorageMock.ArchiveVacancy got unexpected parameter in, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
-					mmArchiveVacancy.ArchiveVacancyMock.defaultExpectation.expectationOrigins.originIn, *mm_want_ptrs.in, mm_got.in, minimock.Diff(*mm_want_ptrs.in, mm_got.in))
+				mmAddCollaborator.t.Errorf("VacancyStorageMock.AddCollaborator got unexpected parameter in, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmAddCollaborator.AddCollaboratorMock.defaultExpectation.expectationOrigins.originIn, *mm_want_ptrs.in, mm_got.in, minimock.Diff(*mm_want_ptrs.in, mm_got.in))
 			}
 
 		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
-			mmArchiveVacancy.t.Errorf("VacancyStorageMock.ArchiveVacancy got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
-				mmArchiveVacancy.ArchiveVacancyMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+			mmAddCollaborator.t.Errorf("VacancyStorageMock.AddCollaborator got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmAddCollaborator.AddCollaboratorMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
 		}
 
-		mm_results := mmArchiveVacancy.ArchiveVacancyMock.defaultExpectation.results
+		mm_results := mmAddCollaborator.AddCollaboratorMock.defaultExpectation.results
 		if mm_results == nil {
-			mmArchiveVacancy.t.Fatal("No results are set for the VacancyStorageMock.ArchiveVacancy")
+			mmAddCollaborator.t.Fatal("No results are set for the VacancyStorageMock.AddCollaborator")
 		}
-		return (*mm_results).err
+		return (*mm_results).cp1, (*mm_results).err
 	}
-	if mmArchiveVacancy.funcArchiveVacancy != nil {
-		return mmArchiveVacancy.funcArchiveVacancy(ctx, in)
+	if mmAddCollaborator.funcAddCollaborator != nil {
+		return mmAddCollaborator.funcAddCollaborator(ctx, in)
 	}
-	mmArchiveVacancy.t.Fatalf("Unexpected call to VacancyStorageMock.ArchiveVacancy. %v %v", ctx, in)
+	mmAddCollaborator.t.Fatalf("Unexpected call to VacancyStorageMock.AddCollaborator. %v %v", ctx, in)
 	return
 }
 
-// ArchiveVacancyAfterCounter returns a count of finished VacancyStorageMock.ArchiveVacancy invocations
-func (mmArchiveVacancy *VacancyStorageMock) ArchiveVacancyAfterCounter() uint64 {
-	return mm_atomic.LoadUint64(&mmArchiveVacancy.afterArchiveVacancyCounter)
+// AddCollaboratorAfterCounter returns a count of finished VacancyStorageMock.AddCollaborator invocations
+func (mmAddCollaborator *VacancyStorageMock) AddCollaboratorAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmAddCollaborator.afterAddCollaboratorCounter)
 }
 
-// ArchiveVacancyBeforeCounter returns a count of VacancyStorageMock.ArchiveVacancy invocations
-func (mmArchiveVacancy *VacancyStorageMock) ArchiveVacancyBeforeCounter() uint64 {
-	return mm_atomic.LoadUint64(&mmArchiveVacancy.beforeArchiveVacancyCounter)
+// AddCollaboratorBeforeCounter returns a count of VacancyStorageMock.AddCollaborator invocations
+func (mmAddCollaborator *VacancyStorageMock) AddCollaboratorBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmAddCollaborator.beforeAddCollaboratorCounter)
 }
 
-// Calls returns a list of arguments used in each call to VacancyStorageMock.ArchiveVacancy.
+// Calls returns a list of arguments used in each call to VacancyStorageMock.AddCollaborator.
 // The list is in the same order as the calls were made (i.e. recent calls have a higher index)
-func (mmArchiveVacancy *mVacancyStorageMockArchiveVacancy) Calls() []*VacancyStorageMockArchiveVacancyParams {
-	mmArchiveVacancy.mutex.RLock()
+func (mmAddCollaborator *mVacancyStorageMockAddCollaborator) Calls() []*VacancyStorageMockAddCollaboratorParams {
+	mmAddCollaborator.mutex.RLock()
 
-	argCopy := make([]*VacancyStorageMockArchiveVacancyParams, len(mmArchiveVacancy.callArgs))
-	copy(argCopy, mmArchiveVacancy.callArgs)
+	argCopy := make([]*VacancyStorageMockAddCollaboratorParams, len(mmAddCollaborator.callArgs))
+	copy(argCopy, mmAddCollaborator.callArgs)
 
-	mmArchiveVacancy.mutex.RUnlock()
+	mmAddCollaborator.mutex.RUnlock()
 
 	return argCopy
 }
 
-// MinimockArchiveVacancyDone returns true if the count of the ArchiveVacancy invocations corresponds
+// MinimockAddCollaboratorDone returns true if the count of the AddCollaborator invocations corresponds
 // the number of defined expectations
-func (m *VacancyStorageMock) MinimockArchiveVacancyDone() bool {
-	if m.ArchiveVacancyMock.optional {
+func (m *VacancyStorageMock) MinimockAddCollaboratorDone() bool {
+	if m.AddCollaboratorMock.optional {
 		// Optional methods provide '0 or more' call count restriction.
 		return true
 	}
 
-	for _, e := range m.ArchiveVacancyMock.expectations {
+	for _, e := range m.AddCollaboratorMock.expectations {
 		if mm_atomic.LoadUint64(&e.Counter) < 1 {
 			return false
 		}
 	}
 
-	return m.ArchiveVacancyMock.invocationsDone()
+	return m.AddCollaboratorMock.invocationsDone()
 }
 
-// MinimockArchiveVacancyInspect logs each unmet expectation
-func (m *VacancyStorageMock) MinimockArchiveVacancyInspect() {
-	for _, e := range m.ArchiveVacancyMock.expectations {
+// MinimockAddCollaboratorInspect logs each unmet expectation
+func (m *VacancyStorageMock) MinimockAddCollaboratorInspect() {
+	for _, e := range m.AddCollaboratorMock.expectations {
 		if mm_atomic.LoadUint64(&e.Counter) < 1 {
-			m.t.Errorf("Expected call to VacancyStorageMock.ArchiveVacancy at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+			m.t.Errorf("Expected call to VacancyStorageMock.AddCollaborator at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
 		}
 	}
 
-	afterArchiveVacancyCounter := mm_atomic.LoadUint64(&m.afterArchiveVacancyCounter)
+	afterAddCollaboratorCounter := mm_atomic.LoadUint64(&m.afterAddCollaboratorCounter)
 	// if default expectation was set then invocations count should be greater than zero
-	if m.ArchiveVacancyMock.defaultExpectation != nil && afterArchiveVacancyCounter < 1 {
-		if m.ArchiveVacancyMock.defaultExpectation.params == nil {
-			m.t.Errorf("Expected call to VacancyStorageMock.ArchiveVacancy at\n%s", m.ArchiveVacancyMock.defaultExpectation.returnOrigin)
+	if m.AddCollaboratorMock.defaultExpectation != nil && afterAddCollaboratorCounter < 1 {
+		if m.AddCollaboratorMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to VacancyStorageMock.AddCollaborator at\n%s", m.AddCollaboratorMock.defaultExpectation.returnOrigin)
 		} else {
-			m.t.Errorf("Expected call to VacancyStorageMock.ArchiveVacancy at\n%s with params: %#v", m.ArchiveVacancyMock.defaultExpectation.expectationOrigins.origin, *m.ArchiveVacancyMock.defaultExpectation.params)
+			m.t.Errorf("Expected call to VacancyStorageMock.AddCollaborator at\n%s with params: %#v", m.AddCollaboratorMock.defaultExpectation.expectationOrigins.origin, *m.AddCollaboratorMock.defaultExpectation.params)
 		}
 	}
 	// if func was set then invocations count should be greater than zero
-	if m.funcArchiveVacancy != nil && afterArchiveVacancyCounter < 1 {
-		m.t.Errorf("Expected call to VacancyStorageMock.ArchiveVacancy at\n%s", m.funcArchiveVacancyOrigin)
+	if m.funcAddCollaborator != nil && afterAddCollaboratorCounter < 1 {
+		m.t.Errorf("Expected call to VacancyStorageMock.AddCollaborator at\n%s", m.funcAddCollaboratorOrigin)
 	}
 
-	if !m.ArchiveVacancyMock.invocationsDone() && afterArchiveVacancyCounter > 0 {
-		m.t.Errorf("Expected %d calls to VacancyStorageMock.ArchiveVacancy at\n%s but found %d calls",
-			mm_atomic.LoadUint64(&m.ArchiveVacancyMock.expectedInvocations), m.ArchiveVacancyMock.expectedInvocationsOrigin, afterArchiveVacancyCounter)
+	if !m.AddCollaboratorMock.invocationsDone() && afterAddCollaboratorCounter > 0 {
+		m.t.Errorf("Expected %d calls to VacancyStorageMock.AddCollaborator at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.AddCollaboratorMock.expectedInvocations), m.AddCollaboratorMock.expectedInvocationsOrigin, afterAddCollaboratorCounter)
 	}
 }
 
-type mVacancyStorageMockCreateVacancy struct {
+type mVacancyStorageMockCanEditVacancy struct {
 	optional           bool
 	mock               *VacancyStorageMock
-	defaultExpectation *VacancyStorageMockCreateVacancyExpectation
-	expectations       []*VacancyStorageMockCreateVacancyExpectation
+	defaultExpectation *VacancyStorageMockCanEditVacancyExpectation
+	expectations       []*VacancyStorageMockCanEditVacancyExpectation
 
-	callArgs []*VacancyStorageMockCreateVacancyParams
+	callArgs []*VacancyStorageMockCanEditVacancyParams
 	mutex    sync.RWMutex
 
 	expectedInvocations       uint64
 	expectedInvocationsOrigin string
 }
 
-// VacancyStorageMockCreateVacancyExpectation specifies expectation struct of the VacancyStorage.CreateVacancy
-type VacancyStorageMockCreateVacancyExpectation struct {
+// VacancyStorageMockCanEditVacancyExpectation specifies expectation struct of the VacancyStorage.CanEditVacancy
+type VacancyStorageMockCanEditVacancyExpectation struct {
 	mock               *VacancyStorageMock
-	params             *VacancyStorageMockCreateVacancyParams
-	paramPtrs          *VacancyStorageMockCreateVacancyParamPtrs
-	expectationOrigins VacancyStorageMockCreateVacancyExpectationOrigins
-	results            *VacancyStorageMockCreateVacancyResults
+	params             *VacancyStorageMockCanEditVacancyParams
+	paramPtrs          *VacancyStorageMockCanEditVacancyParamPtrs
+	expectationOrigins VacancyStorageMockCanEditVacancyExpectationOrigins
+	results            *VacancyStorageMockCanEditVacancyResults
 	returnOrigin       string
 	Counter            uint64
 }
 
-// VacancyStorageMockCreateVacancyParams contains parameters of the VacancyStorage.CreateVacancy
-type VacancyStorageMockCreateVacancyParams struct {
-	ctx context.Context
-	in  domain.CreateVacancyInput
+// VacancyStorageMockCanEditVacancyParams contains parameters of the VacancyStorage.CanEditVacancy
+type VacancyStorageMockCanEditVacancyParams struct {
+	ctx       context.Context
+	vacancyID string
+	userID    uint64
 }
 
-// VacancyStorageMockCreateVacancyParamPtrs contains pointers to parameters of the VacancyStorage.CreateVacancy
-type VacancyStorageMockCreateVacancyParamPtrs struct {
-	ctx *context.Context
-	in  *domain.CreateVacancyInput
+// VacancyStorageMockCanEditVacancyParamPtrs contains pointers to parameters of the VacancyStorage.CanEditVacancy
+type VacancyStorageMockCanEditVacancyParamPtrs struct {
+	ctx       *context.Context
+	vacancyID *string
+	userID    *uint64
 }
 
-// VacancyStorageMockCreateVacancyResults contains results of the VacancyStorage.CreateVacancy
-type VacancyStorageMockCreateVacancyResults struct {
-	vp1 *domain.Vacancy
+// VacancyStorageMockCanEditVacancyResults contains results of the VacancyStorage.CanEditVacancy
+type VacancyStorageMockCanEditVacancyResults struct {
+	b1  bool
 	err error
 }
 
-// VacancyStorageMockCreateVacancyOrigins contains origins of expectations of the VacancyStorage.CreateVacancy
-type VacancyStorageMockCreateVacancyExpectationOrigins struct {
-	origin    string
-	originCtx string
-	originIn  string
+// VacancyStorageMockCanEditVacancyOrigins contains origins of expectations of the VacancyStorage.CanEditVacancy
+type VacancyStorageMockCanEditVacancyExpectationOrigins struct {
+	origin          string
+	originCtx       string
+	originVacancyID string
+	originUserID    string
 }
 
 // Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
@@ -477,744 +761,10562 @@ type VacancyStorageMockCreateVacancyExpectationOrigins struct {
 // Optional() makes method check to work in '0 or more' mode.
 // It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
 // catch the problems when the expected method call is totally skipped during test run.
-func (mmCreateVacancy *mVacancyStorageMockCreateVacancy) Optional() *mVacancyStorageMockCreateVacancy {
-	mmCreateVacancy.optional = true
-	return mmCreateVacancy
+func (mmCanEditVacancy *mVacancyStorageMockCanEditVacancy) Optional() *mVacancyStorageMockCanEditVacancy {
+	mmCanEditVacancy.optional = true
+	return mmCanEditVacancy
 }
 
-// Expect sets up expected params for VacancyStorage.CreateVacancy
-func (mmCreateVacancy *mVacancyStorageMockCreateVacancy) Expect(ctx context.Context, in domain.CreateVacancyInput) *mVacancyStorageMockCreateVacancy {
-	if mmCreateVacancy.mock.funcCreateVacancy != nil {
-		mmCreateVacancy.mock.t.Fatalf("VacancyStorageMock.CreateVacancy mock is already set by Set")
+// Expect sets up expected params for VacancyStorage.CanEditVacancy
+func (mmCanEditVacancy *mVacancyStorageMockCanEditVacancy) Expect(ctx context.Context, vacancyID string, userID uint64) *mVacancyStorageMockCanEditVacancy {
+	if mmCanEditVacancy.mock.funcCanEditVacancy != nil {
+		mmCanEditVacancy.mock.t.Fatalf("VacancyStorageMock.CanEditVacancy mock is already set by Set")
 	}
 
-	if mmCreateVacancy.defaultExpectation == nil {
-		mmCreateVacancy.defaultExpectation = &VacancyStorageMockCreateVacancyExpectation{}
+	if mmCanEditVacancy.defaultExpectation == nil {
+		mmCanEditVacancy.defaultExpectation = &VacancyStorageMockCanEditVacancyExpectation{}
 	}
 
-	if mmCreateVacancy.defaultExpectation.paramPtrs != nil {
-		mmCreateVacancy.mock.t.Fatalf("VacancyStorageMock.CreateVacancy mock is already set by ExpectParams functions")
+	if mmCanEditVacancy.defaultExpectation.paramPtrs != nil {
+		mmCanEditVacancy.mock.t.Fatalf("VacancyStorageMock.CanEditVacancy mock is already set by ExpectParams functions")
 	}
 
-	mmCreateVacancy.defaultExpectation.params = &VacancyStorageMockCreateVacancyParams{ctx, in}
-	mmCreateVacancy.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
-	for _, e := range mmCreateVacancy.expectations {
-		if minimock.Equal(e.params, mmCreateVacancy.defaultExpectation.params) {
-			mmCreateVacancy.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmCreateVacancy.defaultExpectation.params)
+	mmCanEditVacancy.defaultExpectation.params = &VacancyStorageMockCanEditVacancyParams{ctx, vacancyID, userID}
+	mmCanEditVacancy.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmCanEditVacancy.expectations {
+		if minimock.Equal(e.params, mmCanEditVacancy.defaultExpectation.params) {
+			mmCanEditVacancy.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmCanEditVacancy.defaultExpectation.params)
 		}
 	}
 
-	return mmCreateVacancy
+	return mmCanEditVacancy
 }
 
-// ExpectCtxParam1 sets up expected param ctx for VacancyStorage.CreateVacancy
-func (mmCreateVacancy *mVacancyStorageMockCreateVacancy) ExpectCtxParam1(ctx context.Context) *mVacancyStorageMockCreateVacancy {
-	if mmCreateVacancy.mock.funcCreateVacancy != nil {
-		mmCreateVacancy.mock.t.Fatalf("VacancyStorageMock.CreateVacancy mock is already set by Set")
+// ExpectCtxParam1 sets up expected param ctx for VacancyStorage.CanEditVacancy
+func (mmCanEditVacancy *mVacancyStorageMockCanEditVacancy) ExpectCtxParam1(ctx context.Context) *mVacancyStorageMockCanEditVacancy {
+	if mmCanEditVacancy.mock.funcCanEditVacancy != nil {
+		mmCanEditVacancy.mock.t.Fatalf("VacancyStorageMock.CanEditVacancy mock is already set by Set")
 	}
 
-	if mmCreateVacancy.defaultExpectation == nil {
-		mmCreateVacancy.defaultExpectation = &VacancyStorageMockCreateVacancyExpectation{}
+	if mmCanEditVacancy.defaultExpectation == nil {
+		mmCanEditVacancy.defaultExpectation = &VacancyStorageMockCanEditVacancyExpectation{}
 	}
 
-	if mmCreateVacancy.defaultExpectation.params != nil {
-		mmCreateVacancy.mock.t.Fatalf("VacancyStorageMock.CreateVacancy mock is already set by Expect")
+	if mmCanEditVacancy.defaultExpectation.params != nil {
+		mmCanEditVacancy.mock.t.Fatalf("VacancyStorageMock.CanEditVacancy mock is already set by Expect")
 	}
 
-	if mmCreateVacancy.defaultExpectation.paramPtrs == nil {
-		mmCreateVacancy.defaultExpectation.paramPtrs = &VacancyStorageMockCreateVacancyParamPtrs{}
+	if mmCanEditVacancy.defaultExpectation.paramPtrs == nil {
+		mmCanEditVacancy.defaultExpectation.paramPtrs = &VacancyStorageMockCanEditVacancyParamPtrs{}
 	}
-	mmCreateVacancy.defaultExpectation.paramPtrs.ctx = &ctx
-	mmCreateVacancy.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+	mmCanEditVacancy.defaultExpectation.paramPtrs.ctx = &ctx
+	mmCanEditVacancy.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
 
-	return mmCreateVacancy
+	return mmCanEditVacancy
 }
 
-// ExpectInParam2 sets up expected param in for VacancyStorage.CreateVacancy
-func (mmCreateVacancy *mVacancyStorageMockCreateVacancy) ExpectInParam2(in domain.CreateVacancyInput) *mVacancyStorageMockCreateVacancy {
-	if mmCreateVacancy.mock.funcCreateVacancy != nil {
-		mmCreateVacancy.mock.t.Fatalf("VacancyStorageMock.CreateVacancy mock is already set by Set")
+// ExpectVacancyIDParam2 sets up expected param vacancyID for VacancyStorage.CanEditVacancy
+func (mmCanEditVacancy *mVacancyStorageMockCanEditVacancy) ExpectVacancyIDParam2(vacancyID string) *mVacancyStorageMockCanEditVacancy {
+	if mmCanEditVacancy.mock.funcCanEditVacancy != nil {
+		mmCanEditVacancy.mock.t.Fatalf("VacancyStorageMock.CanEditVacancy mock is already set by Set")
 	}
 
-	if mmCreateVacancy.defaultExpectation == nil {
-		mmCreateVacancy.defaultExpectation = &VacancyStorageMockCreateVacancyExpectation{}
+	if mmCanEditVacancy.defaultExpectation == nil {
+		mmCanEditVacancy.defaultExpectation = &VacancyStorageMockCanEditVacancyExpectation{}
 	}
 
-	if mmCreateVacancy.defaultExpectation.params != nil {
-		mmCreateVacancy.mock.t.Fatalf("VacancyStorageMock.CreateVacancy mock is already set by Expect")
+	if mmCanEditVacancy.defaultExpectation.params != nil {
+		mmCanEditVacancy.mock.t.Fatalf("VacancyStorageMock.CanEditVacancy mock is already set by Expect")
 	}
 
-	if mmCreateVacancy.defaultExpectation.paramPtrs == nil {
-		mmCreateVacancy.defaultExpectation.paramPtrs = &VacancyStorageMockCreateVacancyParamPtrs{}
+	if mmCanEditVacancy.defaultExpectation.paramPtrs == nil {
+		mmCanEditVacancy.defaultExpectation.paramPtrs = &VacancyStorageMockCanEditVacancyParamPtrs{}
 	}
-	mmCreateVacancy.defaultExpectation.paramPtrs.in = &in
-	mmCreateVacancy.defaultExpectation.expectationOrigins.originIn = minimock.CallerInfo(1)
+	mmCanEditVacancy.defaultExpectation.paramPtrs.vacancyID = &vacancyID
+	mmCanEditVacancy.defaultExpectation.expectationOrigins.originVacancyID = minimock.CallerInfo(1)
 
-	return mmCreateVacancy
+	return mmCanEditVacancy
 }
 
-// Inspect accepts an inspector function that has same arguments as the VacancyStorage.CreateVacancy
-func (mmCreateVacancy *mVacancyStorageMockCreateVacancy) Inspect(f func(ctx context.Context, in domain.CreateVacancyInput)) *mVacancyStorageMockCreateVacancy {
-	if mmCreateVacancy.mock.inspectFuncCreateVacancy != nil {
-		mmCreateVacancy.mock.t.Fatalf("Inspect function is already set for VacancyStorageMock.CreateVacancy")
+// ExpectUserIDParam3 sets up expected param userID for VacancyStorage.CanEditVacancy
+func (mmCanEditVacancy *mVacancyStorageMockCanEditVacancy) ExpectUserIDParam3(userID uint64) *mVacancyStorageMockCanEditVacancy {
+	if mmCanEditVacancy.mock.funcCanEditVacancy != nil {
+		mmCanEditVacancy.mock.t.Fatalf("VacancyStorageMock.CanEditVacancy mock is already set by Set")
 	}
 
-	mmCreateVacancy.mock.inspectFuncCreateVacancy = f
+	if mmCanEditVacancy.defaultExpectation == nil {
+		mmCanEditVacancy.defaultExpectation = &VacancyStorageMockCanEditVacancyExpectation{}
+	}
 
-	return mmCreateVacancy
+	if mmCanEditVacancy.defaultExpectation.params != nil {
+		mmCanEditVacancy.mock.t.Fatalf("VacancyStorageMock.CanEditVacancy mock is already set by Expect")
+	}
+
+	if mmCanEditVacancy.defaultExpectation.paramPtrs == nil {
+		mmCanEditVacancy.defaultExpectation.paramPtrs = &VacancyStorageMockCanEditVacancyParamPtrs{}
+	}
+	mmCanEditVacancy.defaultExpectation.paramPtrs.userID = &userID
+	mmCanEditVacancy.defaultExpectation.expectationOrigins.originUserID = minimock.CallerInfo(1)
+
+	return mmCanEditVacancy
 }
 
-// Return sets up results that will be returned by VacancyStorage.CreateVacancy
-func (mmCreateVacancy *mVacancyStorageMockCreateVacancy) Return(vp1 *domain.Vacancy, err error) *VacancyStorageMock {
-	if mmCreateVacancy.mock.funcCreateVacancy != nil {
-		mmCreateVacancy.mock.t.Fatalf("VacancyStorageMock.CreateVacancy mock is already set by Set")
+// Inspect accepts an inspector function that has same arguments as the VacancyStorage.CanEditVacancy
+func (mmCanEditVacancy *mVacancyStorageMockCanEditVacancy) Inspect(f func(ctx context.Context, vacancyID string, userID uint64)) *mVacancyStorageMockCanEditVacancy {
+	if mmCanEditVacancy.mock.inspectFuncCanEditVacancy != nil {
+		mmCanEditVacancy.mock.t.Fatalf("Inspect function is already set for VacancyStorageMock.CanEditVacancy")
 	}
 
-	if mmCreateVacancy.defaultExpectation == nil {
-		mmCreateVacancy.defaultExpectation = &VacancyStorageMockCreateVacancyExpectation{mock: mmCreateVacancy.mock}
+	mmCanEditVacancy.mock.inspectFuncCanEditVacancy = f
+
+	return mmCanEditVacancy
+}
+
+// Return sets up results that will be returned by VacancyStorage.CanEditVacancy
+func (mmCanEditVacancy *mVacancyStorageMockCanEditVacancy) Return(b1 bool, err error) *VacancyStorageMock {
+	if mmCanEditVacancy.mock.funcCanEditVacancy != nil {
+		mmCanEditVacancy.mock.t.Fatalf("VacancyStorageMock.CanEditVacancy mock is already set by Set")
 	}
-	mmCreateVacancy.defaultExpectation.results = &VacancyStorageMockCreateVacancyResults{vp1, err}
-	mmCreateVacancy.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
-	return mmCreateVacancy.mock
+
+	if mmCanEditVacancy.defaultExpectation == nil {
+		mmCanEditVacancy.defaultExpectation = &VacancyStorageMockCanEditVacancyExpectation{mock: mmCanEditVacancy.mock}
+	}
+	mmCanEditVacancy.defaultExpectation.results = &VacancyStorageMockCanEditVacancyResults{b1, err}
+	mmCanEditVacancy.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmCanEditVacancy.mock
 }
 
-// Set uses given function f to mock the VacancyStorage.CreateVacancy method
-func (mmCreateVacancy *mVacancyStorageMockCreateVacancy) Set(f func(ctx context.Context, in domain.CreateVacancyInput) (vp1 *domain.Vacancy, err error)) *VacancyStorageMock {
-	if mmCreateVacancy.defaultExpectation != nil {
-		mmCreateVacancy.mock.t.Fatalf("Default expectation is already set for the VacancyStorage.CreateVacancy method")
+// Set uses given function f to mock the VacancyStorage.CanEditVacancy method
+func (mmCanEditVacancy *mVacancyStorageMockCanEditVacancy) Set(f func(ctx context.Context, vacancyID string, userID uint64) (b1 bool, err error)) *VacancyStorageMock {
+	if mmCanEditVacancy.defaultExpectation != nil {
+		mmCanEditVacancy.mock.t.Fatalf("Default expectation is already set for the VacancyStorage.CanEditVacancy method")
 	}
 
-	if len(mmCreateVacancy.expectations) > 0 {
-		mmCreateVacancy.mock.t.Fatalf("Some expectations are already set for the VacancyStorage.CreateVacancy method")
+	if len(mmCanEditVacancy.expectations) > 0 {
+		mmCanEditVacancy.mock.t.Fatalf("Some expectations are already set for the VacancyStorage.CanEditVacancy method")
 	}
 
-	mmCreateVacancy.mock.funcCreateVacancy = f
-	mmCreateVacancy.mock.funcCreateVacancyOrigin = minimock.CallerInfo(1)
-	return mmCreateVacancy.mock
+	mmCanEditVacancy.mock.funcCanEditVacancy = f
+	mmCanEditVacancy.mock.funcCanEditVacancyOrigin = minimock.CallerInfo(1)
+	return mmCanEditVacancy.mock
 }
 
-// When sets expectation for the VacancyStorage.CreateVacancy which will trigger the result defined by the following
+// When sets expectation for the VacancyStorage.CanEditVacancy which will trigger the result defined by the following
 // Then helper
-func (mmCreateVacancy *mVacancyStorageMockCreateVacancy) When(ctx context.Context, in domain.CreateVacancyInput) *VacancyStorageMockCreateVacancyExpectation {
-	if mmCreateVacancy.mock.funcCreateVacancy != nil {
-		mmCreateVacancy.mock.t.Fatalf("VacancyStorageMock.CreateVacancy mock is already set by Set")
+func (mmCanEditVacancy *mVacancyStorageMockCanEditVacancy) When(ctx context.Context, vacancyID string, userID uint64) *VacancyStorageMockCanEditVacancyExpectation {
+	if mmCanEditVacancy.mock.funcCanEditVacancy != nil {
+		mmCanEditVacancy.mock.t.Fatalf("VacancyStorageMock.CanEditVacancy mock is already set by Set")
 	}
 
-	expectation := &VacancyStorageMockCreateVacancyExpectation{
-		mock:               mmCreateVacancy.mock,
-		params:             &VacancyStorageMockCreateVacancyParams{ctx, in},
-		expectationOrigins: VacancyStorageMockCreateVacancyExpectationOrigins{origin: minimock.CallerInfo(1)},
+	expectation := &VacancyStorageMockCanEditVacancyExpectation{
+		mock:               mmCanEditVacancy.mock,
+		params:             &VacancyStorageMockCanEditVacancyParams{ctx, vacancyID, userID},
+		expectationOrigins: VacancyStorageMockCanEditVacancyExpectationOrigins{origin: minimock.CallerInfo(1)},
 	}
-	mmCreateVacancy.expectations = append(mmCreateVacancy.expectations, expectation)
+	mmCanEditVacancy.expectations = append(mmCanEditVacancy.expectations, expectation)
 	return expectation
 }
 
-// Then sets up VacancyStorage.CreateVacancy return parameters for the expectation previously defined by the When method
-func (e *VacancyStorageMockCreateVacancyExpectation) Then(vp1 *domain.Vacancy, err error) *VacancyStorageMock {
-	e.results = &VacancyStorageMockCreateVacancyResults{vp1, err}
+// Then sets up VacancyStorage.CanEditVacancy return parameters for the expectation previously defined by the When method
+func (e *VacancyStorageMockCanEditVacancyExpectation) Then(b1 bool, err error) *VacancyStorageMock {
+	e.results = &VacancyStorageMockCanEditVacancyResults{b1, err}
 	return e.mock
 }
 
-// Times sets number of times VacancyStorage.CreateVacancy should be invoked
-func (mmCreateVacancy *mVacancyStorageMockCreateVacancy) Times(n uint64) *mVacancyStorageMockCreateVacancy {
+// Times sets number of times VacancyStorage.CanEditVacancy should be invoked
+func (mmCanEditVacancy *mVacancyStorageMockCanEditVacancy) Times(n uint64) *mVacancyStorageMockCanEditVacancy {
 	if n == 0 {
-		mmCreateVacancy.mock.t.Fatalf("Times of VacancyStorageMock.CreateVacancy mock can not be zero")
+		mmCanEditVacancy.mock.t.Fatalf("Times of VacancyStorageMock.CanEditVacancy mock can not be zero")
 	}
-	mm_atomic.StoreUint64(&mmCreateVacancy.expectedInvocations, n)
-	mmCreateVacancy.expectedInvocationsOrigin = minimock.CallerInfo(1)
-	return mmCreateVacancy
+	mm_atomic.StoreUint64(&mmCanEditVacancy.expectedInvocations, n)
+	mmCanEditVacancy.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmCanEditVacancy
 }
 
-func (mmCreateVacancy *mVacancyStorageMockCreateVacancy) invocationsDone() bool {
-	if len(mmCreateVacancy.expectations) == 0 && mmCreateVacancy.defaultExpectation == nil && mmCreateVacancy.mock.funcCreateVacancy == nil {
+func (mmCanEditVacancy *mVacancyStorageMockCanEditVacancy) invocationsDone() bool {
+	if len(mmCanEditVacancy.expectations) == 0 && mmCanEditVacancy.defaultExpectation == nil && mmCanEditVacancy.mock.funcCanEditVacancy == nil {
 		return true
 	}
 
-	totalInvocations := mm_atomic.LoadUint64(&mmCreateVacancy.mock.afterCreateVacancyCounter)
-	expectedInvocations := mm_atomic.LoadUint64(&mmCreateVacancy.expectedInvocations)
+	totalInvocations := mm_atomic.LoadUint64(&mmCanEditVacancy.mock.afterCanEditVacancyCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmCanEditVacancy.expectedInvocations)
 
 	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
 }
 
-// CreateVacancy implements mm_usecase.VacancyStorage
-func (mmCreateVacancy *VacancyStorageMock) CreateVacancy(ctx context.Context, in domain.CreateVacancyInput) (vp1 *domain.Vacancy, err error) {
-	mm_atomic.AddUint64(&mmCreateVacancy.beforeCreateVacancyCounter, 1)
-	defer mm_atomic.AddUint64(&mmCreateVacancy.afterCreateVacancyCounter, 1)
+// CanEditVacancy implements mm_usecase.VacancyStorage
+func (mmCanEditVacancy *VacancyStorageMock) CanEditVacancy(ctx context.Context, vacancyID string, userID uint64) (b1 bool, err error) {
+	mm_atomic.AddUint64(&mmCanEditVacancy.beforeCanEditVacancyCounter, 1)
+	defer mm_atomic.AddUint64(&mmCanEditVacancy.afterCanEditVacancyCounter, 1)
 
-	mmCreateVacancy.t.Helper()
+	mmCanEditVacancy.t.Helper()
 
-	if mmCreateVacancy.inspectFuncCreateVacancy != nil {
-		mmCreateVacancy.inspectFuncCreateVacancy(ctx, in)
+	if mmCanEditVacancy.inspectFuncCanEditVacancy != nil {
+		mmCanEditVacancy.inspectFuncCanEditVacancy(ctx, vacancyID, userID)
 	}
 
-	mm_params := VacancyStorageMockCreateVacancyParams{ctx, in}
+	mm_params := VacancyStorageMockCanEditVacancyParams{ctx, vacancyID, userID}
 
 	// Record call args
-	mmCreateVacancy.CreateVacancyMock.mutex.Lock()
-	mmCreateVacancy.CreateVacancyMock.callArgs = append(mmCreateVacancy.CreateVacancyMock.callArgs, &mm_params)
-	mmCreateVacancy.CreateVacancyMock.mutex.Unlock()
+	mmCanEditVacancy.CanEditVacancyMock.mutex.Lock()
+	mmCanEditVacancy.CanEditVacancyMock.callArgs = append(mmCanEditVacancy.CanEditVacancyMock.callArgs, &mm_params)
+	mmCanEditVacancy.CanEditVacancyMock.mutex.Unlock()
 
-	for _, e := range mmCreateVacancy.CreateVacancyMock.expectations {
+	for _, e := range mmCanEditVacancy.CanEditVacancyMock.expectations {
 		if minimock.Equal(*e.params, mm_params) {
 			mm_atomic.AddUint64(&e.Counter, 1)
-			return e.results.vp1, e.results.err
+			return e.results.b1, e.results.err
 		}
 	}
 
-	if mmCreateVacancy.CreateVacancyMock.defaultExpectation != nil {
-		mm_atomic.AddUint64(&mmCreateVacancy.CreateVacancyMock.defaultExpectation.Counter, 1)
-		mm_want := mmCreateVacancy.CreateVacancyMock.defaultExpectation.params
-		mm_want_ptrs := mmCreateVacancy.CreateVacancyMock.defaultExpectation.paramPtrs
+	if mmCanEditVacancy.CanEditVacancyMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmCanEditVacancy.CanEditVacancyMock.defaultExpectation.Counter, 1)
+		mm_want := mmCanEditVacancy.CanEditVacancyMock.defaultExpectation.params
+		mm_want_ptrs := mmCanEditVacancy.CanEditVacancyMock.defaultExpectation.paramPtrs
 
-		mm_got := VacancyStorageMockCreateVacancyParams{ctx, in}
+		mm_got := VacancyStorageMockCanEditVacancyParams{ctx, vacancyID, userID}
 
 		if mm_want_ptrs != nil {
 
 			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
-				mmCreateVacancy.t.Errorf("VacancyStorageMock.CreateVacancy got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
-					mmCreateVacancy.CreateVacancyMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+				mmCanEditVacancy.t.Errorf("VacancyStorageMock.CanEditVacancy got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmCanEditVacancy.CanEditVacancyMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
 			}
 
-			if mm_want_ptrs.in != nil && !minimock.Equal(*mm_want_ptrs.in, mm_got.in) {
-				mmCreateVacancy.t.Errorf("VacancyStorageMock.CreateVacancy got unexpected parameter in, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
-					mmCreateVacancy.CreateVacancyMock.defaultExpectation.expectationOrigins.originIn, *mm_want_ptrs.in, mm_got.in, minimock.Diff(*mm_want_ptrs.in, mm_got.in))
+			if mm_want_ptrs.vacancyID != nil && !minimock.Equal(*mm_want_ptrs.vacancyID, mm_got.vacancyID) {
+				mmCanEditVacancy.t.Errorf("VacancyStorageMock.CanEditVacancy got unexpected parameter vacancyID, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmCanEditVacancy.CanEditVacancyMock.defaultExpectation.expectationOrigins.originVacancyID, *mm_want_ptrs.vacancyID, mm_got.vacancyID, minimock.Diff(*mm_want_ptrs.vacancyID, mm_got.vacancyID))
+			}
+
+			if mm_want_ptrs.userID != nil && !minimock.Equal(*mm_want_ptrs.userID, mm_got.userID) {
+				mmCanEditVacancy.t.Errorf("VacancyStorageMock.CanEditVacancy got unexpected parameter userID, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmCanEditVacancy.CanEditVacancyMock.defaultExpectation.expectationOrigins.originUserID, *mm_want_ptrs.userID, mm_got.userID, minimock.Diff(*mm_want_ptrs.userID, mm_got.userID))
 			}
 
 		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
-			mmCreateVacancy.t.Errorf("VacancyStorageMock.CreateVacancy got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
-				mmCreateVacancy.CreateVacancyMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+			mmCanEditVacancy.t.Errorf("VacancyStorageMock.CanEditVacancy got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmCanEditVacancy.CanEditVacancyMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
 		}
 
-		mm_results := mmCreateVacancy.CreateVacancyMock.defaultExpectation.results
+		mm_results := mmCanEditVacancy.CanEditVacancyMock.defaultExpectation.results
 		if mm_results == nil {
-			mmCreateVacancy.t.Fatal("No results are set for the VacancyStorageMock.CreateVacancy")
+			mmCanEditVacancy.t.Fatal("No results are set for the VacancyStorageMock.CanEditVacancy")
 		}
-		return (*mm_results).vp1, (*mm_results).err
+		return (*mm_results).b1, (*mm_results).err
 	}
-	if mmCreateVacancy.funcCreateVacancy != nil {
-		return mmCreateVacancy.funcCreateVacancy(ctx, in)
+	if mmCanEditVacancy.funcCanEditVacancy != nil {
+		return mmCanEditVacancy.funcCanEditVacancy(ctx, vacancyID, userID)
 	}
-	mmCreateVacancy.t.Fatalf("Unexpected call to VacancyStorageMock.CreateVacancy. %v %v", ctx, in)
+	mmCanEditVacancy.t.Fatalf("Unexpected call to VacancyStorageMock.CanEditVacancy. %v %v %v", ctx, vacancyID, userID)
 	return
 }
 
-// CreateVacancyAfterCounter returns a count of finished VacancyStorageMock.CreateVacancy invocations
-func (mmCreateVacancy *VacancyStorageMock) CreateVacancyAfterCounter() uint64 {
-	return mm_atomic.LoadUint64(&mmCreateVacancy.afterCreateVacancyCounter)
+// CanEditVacancyAfterCounter returns a count of finished VacancyStorageMock.CanEditVacancy invocations
+func (mmCanEditVacancy *VacancyStorageMock) CanEditVacancyAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmCanEditVacancy.afterCanEditVacancyCounter)
 }
 
-// CreateVacancyBeforeCounter returns a count of VacancyStorageMock.CreateVacancy invocations
-func (mmCreateVacancy *VacancyStorageMock) CreateVacancyBeforeCounter() uint64 {
-	return mm_atomic.LoadUint64(&mmCreateVacancy.beforeCreateVacancyCounter)
+// CanEditVacancyBeforeCounter returns a count of VacancyStorageMock.CanEditVacancy invocations
+func (mmCanEditVacancy *VacancyStorageMock) CanEditVacancyBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmCanEditVacancy.beforeCanEditVacancyCounter)
 }
 
-// Calls returns a list of arguments used in each call to VacancyStorageMock.CreateVacancy.
+// Calls returns a list of arguments used in each call to VacancyStorageMock.CanEditVacancy.
 // The list is in the same order as the calls were made (i.e. recent calls have a higher index)
-func (mmCreateVacancy *mVacancyStorageMockCreateVacancy) Calls() []*VacancyStorageMockCreateVacancyParams {
-	mmCreateVacancy.mutex.RLock()
+func (mmCanEditVacancy *mVacancyStorageMockCanEditVacancy) Calls() []*VacancyStorageMockCanEditVacancyParams {
+	mmCanEditVacancy.mutex.RLock()
 
-	argCopy := make([]*VacancyStorageMockCreateVacancyParams, len(mmCreateVacancy.callArgs))
-	copy(argCopy, mmCreateVacancy.callArgs)
+	argCopy := make([]*VacancyStorageMockCanEditVacancyParams, len(mmCanEditVacancy.callArgs))
+	copy(argCopy, mmCanEditVacancy.callArgs)
 
-	mmCreateVacancy.mutex.RUnlock()
+	mmCanEditVacancy.mutex.RUnlock()
 
 	return argCopy
 }
 
-// MinimockCreateVacancyDone returns true if the count of the CreateVacancy invocations corresponds
+// MinimockCanEditVacancyDone returns true if the count of the CanEditVacancy invocations corresponds
 // the number of defined expectations
-func (m *VacancyStorageMock) MinimockCreateVacancyDone() bool {
-	if m.CreateVacancyMock.optional {
+func (m *VacancyStorageMock) MinimockCanEditVacancyDone() bool {
+	if m.CanEditVacancyMock.optional {
 		// Optional methods provide '0 or more' call count restriction.
 		return true
 	}
 
-	for _, e := range m.CreateVacancyMock.expectations {
+	for _, e := range m.CanEditVacancyMock.expectations {
 		if mm_atomic.LoadUint64(&e.Counter) < 1 {
 			return false
 		}
 	}
 
-	return m.CreateVacancyMock.invocationsDone()
+	return m.CanEditVacancyMock.invocationsDone()
 }
 
-// MinimockCreateVacancyInspect logs each unmet expectation
-func (m *VacancyStorageMock) MinimockCreateVacancyInspect() {
-	for _, e := range m.CreateVacancyMock.expectations {
+// MinimockCanEditVacancyInspect logs each unmet expectation
+func (m *VacancyStorageMock) MinimockCanEditVacancyInspect() {
+	for _, e := range m.CanEditVacancyMock.expectations {
 		if mm_atomic.LoadUint64(&e.Counter) < 1 {
-			m.t.Errorf("Expected call to VacancyStorageMock.CreateVacancy at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+			m.t.Errorf("Expected call to VacancyStorageMock.CanEditVacancy at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
 		}
 	}
 
-	afterCreateVacancyCounter := mm_atomic.LoadUint64(&m.afterCreateVacancyCounter)
+	afterCanEditVacancyCounter := mm_atomic.LoadUint64(&m.afterCanEditVacancyCounter)
 	// if default expectation was set then invocations count should be greater than zero
-	if m.CreateVacancyMock.defaultExpectation != nil && afterCreateVacancyCounter < 1 {
-		if m.CreateVacancyMock.defaultExpectation.params == nil {
-			m.t.Errorf("Expected call to VacancyStorageMock.CreateVacancy at\n%s", m.CreateVacancyMock.defaultExpectation.returnOrigin)
+	if m.CanEditVacancyMock.defaultExpectation != nil && afterCanEditVacancyCounter < 1 {
+		if m.CanEditVacancyMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to VacancyStorageMock.CanEditVacancy at\n%s", m.CanEditVacancyMock.defaultExpectation.returnOrigin)
 		} else {
-			m.t.Errorf("Expected call to VacancyStorageMock.CreateVacancy at\n%s with params: %#v", m.CreateVacancyMock.defaultExpectation.expectationOrigins.origin, *m.CreateVacancyMock.defaultExpectation.params)
+			m.t.Errorf("Expected call to VacancyStorageMock.CanEditVacancy at\n%s with params: %#v", m.CanEditVacancyMock.defaultExpectation.expectationOrigins.origin, *m.CanEditVacancyMock.defaultExpectation.params)
 		}
 	}
 	// if func was set then invocations count should be greater than zero
-	if m.funcCreateVacancy != nil && afterCreateVacancyCounter < 1 {
-		m.t.Errorf("Expected call to VacancyStorageMock.CreateVacancy at\n%s", m.funcCreateVacancyOrigin)
+	if m.funcCanEditVacancy != nil && afterCanEditVacancyCounter < 1 {
+		m.t.Errorf("Expected call to VacancyStorageMock.CanEditVacancy at\n%s", m.funcCanEditVacancyOrigin)
 	}
 
-	if !m.CreateVacancyMock.invocationsDone() && afterCreateVacancyCounter > 0 {
-		m.t.Errorf("Expected %d calls to VacancyStorageMock.CreateVacancy at\n%s but found %d calls",
-			mm_atomic.LoadUint64(&m.CreateVacancyMock.expectedInvocations), m.CreateVacancyMock.expectedInvocationsOrigin, afterCreateVacancyCounter)
+	if !m.CanEditVacancyMock.invocationsDone() && afterCanEditVacancyCounter > 0 {
+		m.t.Errorf("Expected %d calls to VacancyStorageMock.CanEditVacancy at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.CanEditVacancyMock.expectedInvocations), m.CanEditVacancyMock.expectedInvocationsOrigin, afterCanEditVacancyCounter)
 	}
 }
 
-type mVacancyStorageMockGetVacancy struct {
+type mVacancyStorageMockChangeCandidateStage struct {
 	optional           bool
 	mock               *VacancyStorageMock
-	defaultExpectation *VacancyStorageMockGetVacancyExpectation
-	expectations       []*VacancyStorageMockGetVacancyExpectation
+	defaultExpectation *VacancyStorageMockChangeCandidateStageExpectation
+	expectations       []*VacancyStorageMockChangeCandidateStageExpectation
 
-	callArgs []*VacancyStorageMockGetVacancyParams
+	callArgs []*VacancyStorageMockChangeCandidateStageParams
 	mutex    sync.RWMutex
 
 	expectedInvocations       uint64
 	expectedInvocationsOrigin string
 }
 
-// VacancyStorageMockGetVacancyExpectation specifies expectation struct of the VacancyStorage.GetVacancy
-type VacancyStorageMockGetVacancyExpectation struct {
+// VacancyStorageMockChangeCandidateStageExpectation specifies expectation struct of the VacancyStorage.ChangeCandidateStage
+type VacancyStorageMockChangeCandidateStageExpectation struct {
 	mock               *VacancyStorageMock
-	params             *VacancyStorageMockGetVacancyParams
-	paramPtrs          *VacancyStorageMockGetVacancyParamPtrs
-	expectationOrigins VacancyStorageMockGetVacancyExpectationOrigins
-	results            *VacancyStorageMockGetVacancyResults
+	params             *VacancyStorageMockChangeCandidateStageParams
+	paramPtrs          *VacancyStorageMockChangeCandidateStageParamPtrs
+	expectationOrigins VacancyStorageMockChangeCandidateStageExpectationOrigins
+	results            *VacancyStorageMockChangeCandidateStageResults
 	returnOrigin       string
 	Counter            uint64
 }
 
-// VacancyStorageMockGetVacancyParams contains parameters of the VacancyStorage.GetVacancy
-type VacancyStorageMockGetVacancyParams struct {
-	ctx         context.Context
-	vacancyID   string
-	ownerUserID uint64
-	isAdmin     bool
+// VacancyStorageMockChangeCandidateStageParams contains parameters of the VacancyStorage.ChangeCandidateStage
+type VacancyStorageMockChangeCandidateStageParams struct {
+	ctx context.Context
+	in  domain.ChangeCandidateStageInput
 }
 
-// VacancyStorageMockGetVacancyParamPtrs contains pointers to parameters of the VacancyStorage.GetVacancy
-type VacancyStorageMockGetVacancyParamPtrs struct {
-	ctx         *context.Context
-	vacancyID   *string
-	ownerUserID *uint64
-	isAdmin     *bool
+// VacancyStorageMockChangeCandidateStageParamPtrs contains pointers to parameters of the VacancyStorage.ChangeCandidateStage
+type VacancyStorageMockChangeCandidateStageParamPtrs struct {
+	ctx *context.Context
+	in  *domain.ChangeCandidateStageInput
 }
 
-// VacancyStorageMockGetVacancyResults contains results of the VacancyStorage.GetVacancy
-type VacancyStorageMockGetVacancyResults struct {
-	vp1 *domain.Vacancy
+// VacancyStorageMockChangeCandidateStageResults contains results of the VacancyStorage.ChangeCandidateStage
+type VacancyStorageMockChangeCandidateStageResults struct {
+	cp1 *domain.ChangeCandidateStageResult
 	err error
 }
 
-// VacancyStorageMockGetVacancyOrigins contains origins of expectations of the VacancyStorage.GetVacancy
-type VacancyStorageMockGetVacancyExpectationOrigins struct {
-	origin            string
-	originCtx         string
-	originVacancyID   string
-	originOwnerUserID string
-	originIsAdmin     string
-}
+// VacancyStorageMockChangeCandidateStageOrigins contains origins of expectations of the VacancyStorage.ChangeCandidateStage
+type VacancyStorageMockChangeCandidateStageExpectationOrigins struct {
+	origin    string
+	originCtx string
+	originIn  string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmChangeCandidateStage *mVacancyStorageMockChangeCandidateStage) Optional() *mVacancyStorageMockChangeCandidateStage {
+	mmChangeCandidateStage.optional = true
+	return mmChangeCandidateStage
+}
+
+// Expect sets up expected params for VacancyStorage.ChangeCandidateStage
+func (mmChangeCandidateStage *mVacancyStorageMockChangeCandidateStage) Expect(ctx context.Context, in domain.ChangeCandidateStageInput) *mVacancyStorageMockChangeCandidateStage {
+	if mmChangeCandidateStage.mock.funcChangeCandidateStage != nil {
+		mmChangeCandidateStage.mock.t.Fatalf("VacancyStorageMock.ChangeCandidateStage mock is already set by Set")
+	}
+
+	if mmChangeCandidateStage.defaultExpectation == nil {
+		mmChangeCandidateStage.defaultExpectation = &VacancyStorageMockChangeCandidateStageExpectation{}
+	}
+
+	if mmChangeCandidateStage.defaultExpectation.paramPtrs != nil {
+		mmChangeCandidateStage.mock.t.Fatalf("VacancyStorageMock.ChangeCandidateStage mock is already set by ExpectParams functions")
+	}
+
+	mmChangeCandidateStage.defaultExpectation.params = &VacancyStorageMockChangeCandidateStageParams{ctx, in}
+	mmChangeCandidateStage.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmChangeCandidateStage.expectations {
+		if minimock.Equal(e.params, mmChangeCandidateStage.defaultExpectation.params) {
+			mmChangeCandidateStage.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmChangeCandidateStage.defaultExpectation.params)
+		}
+	}
+
+	return mmChangeCandidateStage
+}
+
+// ExpectCtxParam1 sets up expected param ctx for VacancyStorage.ChangeCandidateStage
+func (mmChangeCandidateStage *mVacancyStorageMockChangeCandidateStage) ExpectCtxParam1(ctx context.Context) *mVacancyStorageMockChangeCandidateStage {
+	if mmChangeCandidateStage.mock.funcChangeCandidateStage != nil {
+		mmChangeCandidateStage.mock.t.Fatalf("VacancyStorageMock.ChangeCandidateStage mock is already set by Set")
+	}
+
+	if mmChangeCandidateStage.defaultExpectation == nil {
+		mmChangeCandidateStage.defaultExpectation = &VacancyStorageMockChangeCandidateStageExpectation{}
+	}
+
+	if mmChangeCandidateStage.defaultExpectation.params != nil {
+		mmChangeCandidateStage.mock.t.Fatalf("VacancyStorageMock.ChangeCandidateStage mock is already set by Expect")
+	}
+
+	if mmChangeCandidateStage.defaultExpectation.paramPtrs == nil {
+		mmChangeCandidateStage.defaultExpectation.paramPtrs = &VacancyStorageMockChangeCandidateStageParamPtrs{}
+	}
+	mmChangeCandidateStage.defaultExpectation.paramPtrs.ctx = &ctx
+	mmChangeCandidateStage.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmChangeCandidateStage
+}
+
+// ExpectInParam2 sets up expected param in for VacancyStorage.ChangeCandidateStage
+func (mmChangeCandidateStage *mVacancyStorageMockChangeCandidateStage) ExpectInParam2(in domain.ChangeCandidateStageInput) *mVacancyStorageMockChangeCandidateStage {
+	if mmChangeCandidateStage.mock.funcChangeCandidateStage != nil {
+		mmChangeCandidateStage.mock.t.Fatalf("VacancyStorageMock.ChangeCandidateStage mock is already set by Set")
+	}
+
+	if mmChangeCandidateStage.defaultExpectation == nil {
+		mmChangeCandidateStage.defaultExpectation = &VacancyStorageMockChangeCandidateStageExpectation{}
+	}
+
+	if mmChangeCandidateStage.defaultExpectation.params != nil {
+		mmChangeCandidateStage.mock.t.Fatalf("VacancyStorageMock.ChangeCandidateStage mock is already set by Expect")
+	}
+
+	if mmChangeCandidateStage.defaultExpectation.paramPtrs == nil {
+		mmChangeCandidateStage.defaultExpectation.paramPtrs = &VacancyStorageMockChangeCandidateStageParamPtrs{}
+	}
+	mmChangeCandidateStage.defaultExpectation.paramPtrs.in = &in
+	mmChangeCandidateStage.defaultExpectation.expectationOrigins.originIn = minimock.CallerInfo(1)
+
+	return mmChangeCandidateStage
+}
+
+// Inspect accepts an inspector function that has same arguments as the VacancyStorage.ChangeCandidateStage
+func (mmChangeCandidateStage *mVacancyStorageMockChangeCandidateStage) Inspect(f func(ctx context.Context, in domain.ChangeCandidateStageInput)) *mVacancyStorageMockChangeCandidateStage {
+	if mmChangeCandidateStage.mock.inspectFuncChangeCandidateStage != nil {
+		mmChangeCandidateStage.mock.t.Fatalf("Inspect function is already set for VacancyStorageMock.ChangeCandidateStage")
+	}
+
+	mmChangeCandidateStage.mock.inspectFuncChangeCandidateStage = f
+
+	return mmChangeCandidateStage
+}
+
+// Return sets up results that will be returned by VacancyStorage.ChangeCandidateStage
+func (mmChangeCandidateStage *mVacancyStorageMockChangeCandidateStage) Return(cp1 *domain.ChangeCandidateStageResult, err error) *VacancyStorageMock {
+	if mmChangeCandidateStage.mock.funcChangeCandidateStage != nil {
+		mmChangeCandidateStage.mock.t.Fatalf("VacancyStorageMock.ChangeCandidateStage mock is already set by Set")
+	}
+
+	if mmChangeCandidateStage.defaultExpectation == nil {
+		mmChangeCandidateStage.defaultExpectation = &VacancyStorageMockChangeCandidateStageExpectation{mock: mmChangeCandidateStage.mock}
+	}
+	mmChangeCandidateStage.defaultExpectation.results = &VacancyStorageMockChangeCandidateStageResults{cp1, err}
+	mmChangeCandidateStage.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmChangeCandidateStage.mock
+}
+
+// Set uses given function f to mock the VacancyStorage.ChangeCandidateStage method
+func (mmChangeCandidateStage *mVacancyStorageMockChangeCandidateStage) Set(f func(ctx context.Context, in domain.ChangeCandidateStageInput) (cp1 *domain.ChangeCandidateStageResult, err error)) *VacancyStorageMock {
+	if mmChangeCandidateStage.defaultExpectation != nil {
+		mmChangeCandidateStage.mock.t.Fatalf("Default expectation is already set for the VacancyStorage.ChangeCandidateStage method")
+	}
+
+	if len(mmChangeCandidateStage.expectations) > 0 {
+		mmChangeCandidateStage.mock.t.Fatalf("Some expectations are already set for the VacancyStorage.ChangeCandidateStage method")
+	}
+
+	mmChangeCandidateStage.mock.funcChangeCandidateStage = f
+	mmChangeCandidateStage.mock.funcChangeCandidateStageOrigin = minimock.CallerInfo(1)
+	return mmChangeCandidateStage.mock
+}
+
+// When sets expectation for the VacancyStorage.ChangeCandidateStage which will trigger the result defined by the following
+// Then helper
+func (mmChangeCandidateStage *mVacancyStorageMockChangeCandidateStage) When(ctx context.Context, in domain.ChangeCandidateStageInput) *VacancyStorageMockChangeCandidateStageExpectation {
+	if mmChangeCandidateStage.mock.funcChangeCandidateStage != nil {
+		mmChangeCandidateStage.mock.t.Fatalf("VacancyStorageMock.ChangeCandidateStage mock is already set by Set")
+	}
+
+	expectation := &VacancyStorageMockChangeCandidateStageExpectation{
+		mock:               mmChangeCandidateStage.mock,
+		params:             &VacancyStorageMockChangeCandidateStageParams{ctx, in},
+		expectationOrigins: VacancyStorageMockChangeCandidateStageExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmChangeCandidateStage.expectations = append(mmChangeCandidateStage.expectations, expectation)
+	return expectation
+}
+
+// Then sets up VacancyStorage.ChangeCandidateStage return parameters for the expectation previously defined by the When method
+func (e *VacancyStorageMockChangeCandidateStageExpectation) Then(cp1 *domain.ChangeCandidateStageResult, err error) *VacancyStorageMock {
+	e.results = &VacancyStorageMockChangeCandidateStageResults{cp1, err}
+	return e.mock
+}
+
+// Times sets number of times VacancyStorage.ChangeCandidateStage should be invoked
+func (mmChangeCandidateStage *mVacancyStorageMockChangeCandidateStage) Times(n uint64) *mVacancyStorageMockChangeCandidateStage {
+	if n == 0 {
+		mmChangeCandidateStage.mock.t.Fatalf("Times of VacancyStorageMock.ChangeCandidateStage mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmChangeCandidateStage.expectedInvocations, n)
+	mmChangeCandidateStage.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmChangeCandidateStage
+}
+
+func (mmChangeCandidateStage *mVacancyStorageMockChangeCandidateStage) invocationsDone() bool {
+	if len(mmChangeCandidateStage.expectations) == 0 && mmChangeCandidateStage.defaultExpectation == nil && mmChangeCandidateStage.mock.funcChangeCandidateStage == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmChangeCandidateStage.mock.afterChangeCandidateStageCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmChangeCandidateStage.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// ChangeCandidateStage implements mm_usecase.VacancyStorage
+func (mmChangeCandidateStage *VacancyStorageMock) ChangeCandidateStage(ctx context.Context, in domain.ChangeCandidateStageInput) (cp1 *domain.ChangeCandidateStageResult, err error) {
+	mm_atomic.AddUint64(&mmChangeCandidateStage.beforeChangeCandidateStageCounter, 1)
+	defer mm_atomic.AddUint64(&mmChangeCandidateStage.afterChangeCandidateStageCounter, 1)
+
+	mmChangeCandidateStage.t.Helper()
+
+	if mmChangeCandidateStage.inspectFuncChangeCandidateStage != nil {
+		mmChangeCandidateStage.inspectFuncChangeCandidateStage(ctx, in)
+	}
+
+	mm_params := VacancyStorageMockChangeCandidateStageParams{ctx, in}
+
+	// Record call args
+	mmChangeCandidateStage.ChangeCandidateStageMock.mutex.Lock()
+	mmChangeCandidateStage.ChangeCandidateStageMock.callArgs = append(mmChangeCandidateStage.ChangeCandidateStageMock.callArgs, &mm_params)
+	mmChangeCandidateStage.ChangeCandidateStageMock.mutex.Unlock()
+
+	for _, e := range mmChangeCandidateStage.ChangeCandidateStageMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.cp1, e.results.err
+		}
+	}
+
+	if mmChangeCandidateStage.ChangeCandidateStageMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmChangeCandidateStage.ChangeCandidateStageMock.defaultExpectation.Counter, 1)
+		mm_want := mmChangeCandidateStage.ChangeCandidateStageMock.defaultExpectation.params
+		mm_want_ptrs := mmChangeCandidateStage.ChangeCandidateStageMock.defaultExpectation.paramPtrs
+
+		mm_got := VacancyStorageMockChangeCandidateStageParams{ctx, in}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmChangeCandidateStage.t.Errorf("VacancyStorageMock.ChangeCandidateStage got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmChangeCandidateStage.ChangeCandidateStageMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.in != nil && !minimock.Equal(*mm_want_ptrs.in, mm_got.in) {
+				mmChangeCandidateStage.t.Errorf("VacancyStorageMock.ChangeCandidateStage got unexpected parameter in, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmChangeCandidateStage.ChangeCandidateStageMock.defaultExpectation.expectationOrigins.originIn, *mm_want_ptrs.in, mm_got.in, minimock.Diff(*mm_want_ptrs.in, mm_got.in))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmChangeCandidateStage.t.Errorf("VacancyStorageMock.ChangeCandidateStage got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmChangeCandidateStage.ChangeCandidateStageMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmChangeCandidateStage.ChangeCandidateStageMock.defaultExpectation.results
+		if mm_results == nil {
+			mmChangeCandidateStage.t.Fatal("No results are set for the VacancyStorageMock.ChangeCandidateStage")
+		}
+		return (*mm_results).cp1, (*mm_results).err
+	}
+	if mmChangeCandidateStage.funcChangeCandidateStage != nil {
+		return mmChangeCandidateStage.funcChangeCandidateStage(ctx, in)
+	}
+	mmChangeCandidateStage.t.Fatalf("Unexpected call to VacancyStorageMock.ChangeCandidateStage. %v %v", ctx, in)
+	return
+}
+
+// ChangeCandidateStageAfterCounter returns a count of finished VacancyStorageMock.ChangeCandidateStage invocations
+func (mmChangeCandidateStage *VacancyStorageMock) ChangeCandidateStageAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmChangeCandidateStage.afterChangeCandidateStageCounter)
+}
+
+// ChangeCandidateStageBeforeCounter returns a count of VacancyStorageMock.ChangeCandidateStage invocations
+func (mmChangeCandidateStage *VacancyStorageMock) ChangeCandidateStageBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmChangeCandidateStage.beforeChangeCandidateStageCounter)
+}
+
+// Calls returns a list of arguments used in each call to VacancyStorageMock.ChangeCandidateStage.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmChangeCandidateStage *mVacancyStorageMockChangeCandidateStage) Calls() []*VacancyStorageMockChangeCandidateStageParams {
+	mmChangeCandidateStage.mutex.RLock()
+
+	argCopy := make([]*VacancyStorageMockChangeCandidateStageParams, len(mmChangeCandidateStage.callArgs))
+	copy(argCopy, mmChangeCandidateStage.callArgs)
+
+	mmChangeCandidateStage.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockChangeCandidateStageDone returns true if the count of the ChangeCandidateStage invocations corresponds
+// the number of defined expectations
+func (m *VacancyStorageMock) MinimockChangeCandidateStageDone() bool {
+	if m.ChangeCandidateStageMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.ChangeCandidateStageMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.ChangeCandidateStageMock.invocationsDone()
+}
+
+// MinimockChangeCandidateStageInspect logs each unmet expectation
+func (m *VacancyStorageMock) MinimockChangeCandidateStageInspect() {
+	for _, e := range m.ChangeCandidateStageMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to VacancyStorageMock.ChangeCandidateStage at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterChangeCandidateStageCounter := mm_atomic.LoadUint64(&m.afterChangeCandidateStageCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.ChangeCandidateStageMock.defaultExpectation != nil && afterChangeCandidateStageCounter < 1 {
+		if m.ChangeCandidateStageMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to VacancyStorageMock.ChangeCandidateStage at\n%s", m.ChangeCandidateStageMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to VacancyStorageMock.ChangeCandidateStage at\n%s with params: %#v", m.ChangeCandidateStageMock.defaultExpectation.expectationOrigins.origin, *m.ChangeCandidateStageMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcChangeCandidateStage != nil && afterChangeCandidateStageCounter < 1 {
+		m.t.Errorf("Expected call to VacancyStorageMock.ChangeCandidateStage at\n%s", m.funcChangeCandidateStageOrigin)
+	}
+
+	if !m.ChangeCandidateStageMock.invocationsDone() && afterChangeCandidateStageCounter > 0 {
+		m.t.Errorf("Expected %d calls to VacancyStorageMock.ChangeCandidateStage at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.ChangeCandidateStageMock.expectedInvocations), m.ChangeCandidateStageMock.expectedInvocationsOrigin, afterChangeCandidateStageCounter)
+	}
+}
+
+type mVacancyStorageMockChangeVacancyStatus struct {
+	optional           bool
+	mock               *VacancyStorageMock
+	defaultExpectation *VacancyStorageMockChangeVacancyStatusExpectation
+	expectations       []*VacancyStorageMockChangeVacancyStatusExpectation
+
+	callArgs []*VacancyStorageMockChangeVacancyStatusParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// VacancyStorageMockChangeVacancyStatusExpectation specifies expectation struct of the VacancyStorage.ChangeVacancyStatus
+type VacancyStorageMockChangeVacancyStatusExpectation struct {
+	mock               *VacancyStorageMock
+	params             *VacancyStorageMockChangeVacancyStatusParams
+	paramPtrs          *VacancyStorageMockChangeVacancyStatusParamPtrs
+	expectationOrigins VacancyStorageMockChangeVacancyStatusExpectationOrigins
+	results            *VacancyStorageMockChangeVacancyStatusResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// VacancyStorageMockChangeVacancyStatusParams contains parameters of the VacancyStorage.ChangeVacancyStatus
+type VacancyStorageMockChangeVacancyStatusParams struct {
+	ctx context.Context
+	in  domain.ChangeVacancyStatusInput
+}
+
+// VacancyStorageMockChangeVacancyStatusParamPtrs contains pointers to parameters of the VacancyStorage.ChangeVacancyStatus
+type VacancyStorageMockChangeVacancyStatusParamPtrs struct {
+	ctx *context.Context
+	in  *domain.ChangeVacancyStatusInput
+}
+
+// VacancyStorageMockChangeVacancyStatusResults contains results of the VacancyStorage.ChangeVacancyStatus
+type VacancyStorageMockChangeVacancyStatusResults struct {
+	vp1 *domain.Vacancy
+	err error
+}
+
+// VacancyStorageMockChangeVacancyStatusOrigins contains origins of expectations of the VacancyStorage.ChangeVacancyStatus
+type VacancyStorageMockChangeVacancyStatusExpectationOrigins struct {
+	origin    string
+	originCtx string
+	originIn  string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmChangeVacancyStatus *mVacancyStorageMockChangeVacancyStatus) Optional() *mVacancyStorageMockChangeVacancyStatus {
+	mmChangeVacancyStatus.optional = true
+	return mmChangeVacancyStatus
+}
+
+// Expect sets up expected params for VacancyStorage.ChangeVacancyStatus
+func (mmChangeVacancyStatus *mVacancyStorageMockChangeVacancyStatus) Expect(ctx context.Context, in domain.ChangeVacancyStatusInput) *mVacancyStorageMockChangeVacancyStatus {
+	if mmChangeVacancyStatus.mock.funcChangeVacancyStatus != nil {
+		mmChangeVacancyStatus.mock.t.Fatalf("VacancyStorageMock.ChangeVacancyStatus mock is already set by Set")
+	}
+
+	if mmChangeVacancyStatus.defaultExpectation == nil {
+		mmChangeVacancyStatus.defaultExpectation = &VacancyStorageMockChangeVacancyStatusExpectation{}
+	}
+
+	if mmChangeVacancyStatus.defaultExpectation.paramPtrs != nil {
+		mmChangeVacancyStatus.mock.t.Fatalf("VacancyStorageMock.ChangeVacancyStatus mock is already set by ExpectParams functions")
+	}
+
+	mmChangeVacancyStatus.defaultExpectation.params = &VacancyStorageMockChangeVacancyStatusParams{ctx, in}
+	mmChangeVacancyStatus.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmChangeVacancyStatus.expectations {
+		if minimock.Equal(e.params, mmChangeVacancyStatus.defaultExpectation.params) {
+			mmChangeVacancyStatus.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmChangeVacancyStatus.defaultExpectation.params)
+		}
+	}
+
+	return mmChangeVacancyStatus
+}
+
+// ExpectCtxParam1 sets up expected param ctx for VacancyStorage.ChangeVacancyStatus
+func (mmChangeVacancyStatus *mVacancyStorageMockChangeVacancyStatus) ExpectCtxParam1(ctx context.Context) *mVacancyStorageMockChangeVacancyStatus {
+	if mmChangeVacancyStatus.mock.funcChangeVacancyStatus != nil {
+		mmChangeVacancyStatus.mock.t.Fatalf("VacancyStorageMock.ChangeVacancyStatus mock is already set by Set")
+	}
+
+	if mmChangeVacancyStatus.defaultExpectation == nil {
+		mmChangeVacancyStatus.defaultExpectation = &VacancyStorageMockChangeVacancyStatusExpectation{}
+	}
+
+	if mmChangeVacancyStatus.defaultExpectation.params != nil {
+		mmChangeVacancyStatus.mock.t.Fatalf("VacancyStorageMock.ChangeVacancyStatus mock is already set by Expect")
+	}
+
+	if mmChangeVacancyStatus.defaultExpectation.paramPtrs == nil {
+		mmChangeVacancyStatus.defaultExpectation.paramPtrs = &VacancyStorageMockChangeVacancyStatusParamPtrs{}
+	}
+	mmChangeVacancyStatus.defaultExpectation.paramPtrs.ctx = &ctx
+	mmChangeVacancyStatus.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmChangeVacancyStatus
+}
+
+// ExpectInParam2 sets up expected param in for VacancyStorage.ChangeVacancyStatus
+func (mmChangeVacancyStatus *mVacancyStorageMockChangeVacancyStatus) ExpectInParam2(in domain.ChangeVacancyStatusInput) *mVacancyStorageMockChangeVacancyStatus {
+	if mmChangeVacancyStatus.mock.funcChangeVacancyStatus != nil {
+		mmChangeVacancyStatus.mock.t.Fatalf("VacancyStorageMock.ChangeVacancyStatus mock is already set by Set")
+	}
+
+	if mmChangeVacancyStatus.defaultExpectation == nil {
+		mmChangeVacancyStatus.defaultExpectation = &VacancyStorageMockChangeVacancyStatusExpectation{}
+	}
+
+	if mmChangeVacancyStatus.defaultExpectation.params != nil {
+		mmChangeVacancyStatus.mock.t.Fatalf("VacancyStorageMock.ChangeVacancyStatus mock is already set by Expect")
+	}
+
+	if mmChangeVacancyStatus.defaultExpectation.paramPtrs == nil {
+		mmChangeVacancyStatus.defaultExpectation.paramPtrs = &VacancyStorageMockChangeVacancyStatusParamPtrs{}
+	}
+	mmChangeVacancyStatus.defaultExpectation.paramPtrs.in = &in
+	mmChangeVacancyStatus.defaultExpectation.expectationOrigins.originIn = minimock.CallerInfo(1)
+
+	return mmChangeVacancyStatus
+}
+
+// Inspect accepts an inspector function that has same arguments as the VacancyStorage.ChangeVacancyStatus
+func (mmChangeVacancyStatus *mVacancyStorageMockChangeVacancyStatus) Inspect(f func(ctx context.Context, in domain.ChangeVacancyStatusInput)) *mVacancyStorageMockChangeVacancyStatus {
+	if mmChangeVacancyStatus.mock.inspectFuncChangeVacancyStatus != nil {
+		mmChangeVacancyStatus.mock.t.Fatalf("Inspect function is already set for VacancyStorageMock.ChangeVacancyStatus")
+	}
+
+	mmChangeVacancyStatus.mock.inspectFuncChangeVacancyStatus = f
+
+	return mmChangeVacancyStatus
+}
+
+// Return sets up results that will be returned by VacancyStorage.ChangeVacancyStatus
+func (mmChangeVacancyStatus *mVacancyStorageMockChangeVacancyStatus) Return(vp1 *domain.Vacancy, err error) *VacancyStorageMock {
+	if mmChangeVacancyStatus.mock.funcChangeVacancyStatus != nil {
+		mmChangeVacancyStatus.mock.t.Fatalf("VacancyStorageMock.ChangeVacancyStatus mock is already set by Set")
+	}
+
+	if mmChangeVacancyStatus.defaultExpectation == nil {
+		mmChangeVacancyStatus.defaultExpectation = &VacancyStorageMockChangeVacancyStatusExpectation{mock: mmChangeVacancyStatus.mock}
+	}
+	mmChangeVacancyStatus.defaultExpectation.results = &VacancyStorageMockChangeVacancyStatusResults{vp1, err}
+	mmChangeVacancyStatus.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmChangeVacancyStatus.mock
+}
+
+// Set uses given function f to mock the VacancyStorage.ChangeVacancyStatus method
+func (mmChangeVacancyStatus *mVacancyStorageMockChangeVacancyStatus) Set(f func(ctx context.Context, in domain.ChangeVacancyStatusInput) (vp1 *domain.Vacancy, err error)) *VacancyStorageMock {
+	if mmChangeVacancyStatus.defaultExpectation != nil {
+		mmChangeVacancyStatus.mock.t.Fatalf("Default expectation is already set for the VacancyStorage.ChangeVacancyStatus method")
+	}
+
+	if len(mmChangeVacancyStatus.expectations) > 0 {
+		mmChangeVacancyStatus.mock.t.Fatalf("Some expectations are already set for the VacancyStorage.ChangeVacancyStatus method")
+	}
+
+	mmChangeVacancyStatus.mock.funcChangeVacancyStatus = f
+	mmChangeVacancyStatus.mock.funcChangeVacancyStatusOrigin = minimock.CallerInfo(1)
+	return mmChangeVacancyStatus.mock
+}
+
+// When sets expectation for the VacancyStorage.ChangeVacancyStatus which will trigger the result defined by the following
+// Then helper
+func (mmChangeVacancyStatus *mVacancyStorageMockChangeVacancyStatus) When(ctx context.Context, in domain.ChangeVacancyStatusInput) *VacancyStorageMockChangeVacancyStatusExpectation {
+	if mmChangeVacancyStatus.mock.funcChangeVacancyStatus != nil {
+		mmChangeVacancyStatus.mock.t.Fatalf("VacancyStorageMock.ChangeVacancyStatus mock is already set by Set")
+	}
+
+	expectation := &VacancyStorageMockChangeVacancyStatusExpectation{
+		mock:               mmChangeVacancyStatus.mock,
+		params:             &VacancyStorageMockChangeVacancyStatusParams{ctx, in},
+		expectationOrigins: VacancyStorageMockChangeVacancyStatusExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmChangeVacancyStatus.expectations = append(mmChangeVacancyStatus.expectations, expectation)
+	return expectation
+}
+
+// Then sets up VacancyStorage.ChangeVacancyStatus return parameters for the expectation previously defined by the When method
+func (e *VacancyStorageMockChangeVacancyStatusExpectation) Then(vp1 *domain.Vacancy, err error) *VacancyStorageMock {
+	e.results = &VacancyStorageMockChangeVacancyStatusResults{vp1, err}
+	return e.mock
+}
+
+// Times sets number of times VacancyStorage.ChangeVacancyStatus should be invoked
+func (mmChangeVacancyStatus *mVacancyStorageMockChangeVacancyStatus) Times(n uint64) *mVacancyStorageMockChangeVacancyStatus {
+	if n == 0 {
+		mmChangeVacancyStatus.mock.t.Fatalf("Times of VacancyStorageMock.ChangeVacancyStatus mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmChangeVacancyStatus.expectedInvocations, n)
+	mmChangeVacancyStatus.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmChangeVacancyStatus
+}
+
+func (mmChangeVacancyStatus *mVacancyStorageMockChangeVacancyStatus) invocationsDone() bool {
+	if len(mmChangeVacancyStatus.expectations) == 0 && mmChangeVacancyStatus.defaultExpectation == nil && mmChangeVacancyStatus.mock.funcChangeVacancyStatus == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmChangeVacancyStatus.mock.afterChangeVacancyStatusCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmChangeVacancyStatus.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// ChangeVacancyStatus implements mm_usecase.VacancyStorage
+func (mmChangeVacancyStatus *VacancyStorageMock) ChangeVacancyStatus(ctx context.Context, in domain.ChangeVacancyStatusInput) (vp1 *domain.Vacancy, err error) {
+	mm_atomic.AddUint64(&mmChangeVacancyStatus.beforeChangeVacancyStatusCounter, 1)
+	defer mm_atomic.AddUint64(&mmChangeVacancyStatus.afterChangeVacancyStatusCounter, 1)
+
+	mmChangeVacancyStatus.t.Helper()
+
+	if mmChangeVacancyStatus.inspectFuncChangeVacancyStatus != nil {
+		mmChangeVacancyStatus.inspectFuncChangeVacancyStatus(ctx, in)
+	}
+
+	mm_params := VacancyStorageMockChangeVacancyStatusParams{ctx, in}
+
+	// Record call args
+	mmChangeVacancyStatus.ChangeVacancyStatusMock.mutex.Lock()
+	mmChangeVacancyStatus.ChangeVacancyStatusMock.callArgs = append(mmChangeVacancyStatus.ChangeVacancyStatusMock.callArgs, &mm_params)
+	mmChangeVacancyStatus.ChangeVacancyStatusMock.mutex.Unlock()
+
+	for _, e := range mmChangeVacancyStatus.ChangeVacancyStatusMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.vp1, e.results.err
+		}
+	}
+
+	if mmChangeVacancyStatus.ChangeVacancyStatusMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmChangeVacancyStatus.ChangeVacancyStatusMock.defaultExpectation.Counter, 1)
+		mm_want := mmChangeVacancyStatus.ChangeVacancyStatusMock.defaultExpectation.params
+		mm_want_ptrs := mmChangeVacancyStatus.ChangeVacancyStatusMock.defaultExpectation.paramPtrs
+
+		mm_got := VacancyStorageMockChangeVacancyStatusParams{ctx, in}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmChangeVacancyStatus.t.Errorf("VacancyStorageMock.ChangeVacancyStatus got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmChangeVacancyStatus.ChangeVacancyStatusMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.in != nil && !minimock.Equal(*mm_want_ptrs.in, mm_got.in) {
+				mmChangeVacancyStatus.t.Errorf("VacancyStorageMock.ChangeVacancyStatus got unexpected parameter in, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmChangeVacancyStatus.ChangeVacancyStatusMock.defaultExpectation.expectationOrigins.originIn, *mm_want_ptrs.in, mm_got.in, minimock.Diff(*mm_want_ptrs.in, mm_got.in))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmChangeVacancyStatus.t.Errorf("VacancyStorageMock.ChangeVacancyStatus got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmChangeVacancyStatus.ChangeVacancyStatusMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmChangeVacancyStatus.ChangeVacancyStatusMock.defaultExpectation.results
+		if mm_results == nil {
+			mmChangeVacancyStatus.t.Fatal("No results are set for the VacancyStorageMock.ChangeVacancyStatus")
+		}
+		return (*mm_results).vp1, (*mm_results).err
+	}
+	if mmChangeVacancyStatus.funcChangeVacancyStatus != nil {
+		return mmChangeVacancyStatus.funcChangeVacancyStatus(ctx, in)
+	}
+	mmChangeVacancyStatus.t.Fatalf("Unexpected call to VacancyStorageMock.ChangeVacancyStatus. %v %v", ctx, in)
+	return
+}
+
+// ChangeVacancyStatusAfterCounter returns a count of finished VacancyStorageMock.ChangeVacancyStatus invocations
+func (mmChangeVacancyStatus *VacancyStorageMock) ChangeVacancyStatusAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmChangeVacancyStatus.afterChangeVacancyStatusCounter)
+}
+
+// ChangeVacancyStatusBeforeCounter returns a count of VacancyStorageMock.ChangeVacancyStatus invocations
+func (mmChangeVacancyStatus *VacancyStorageMock) ChangeVacancyStatusBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmChangeVacancyStatus.beforeChangeVacancyStatusCounter)
+}
+
+// Calls returns a list of arguments used in each call to VacancyStorageMock.ChangeVacancyStatus.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmChangeVacancyStatus *mVacancyStorageMockChangeVacancyStatus) Calls() []*VacancyStorageMockChangeVacancyStatusParams {
+	mmChangeVacancyStatus.mutex.RLock()
+
+	argCopy := make([]*VacancyStorageMockChangeVacancyStatusParams, len(mmChangeVacancyStatus.callArgs))
+	copy(argCopy, mmChangeVacancyStatus.callArgs)
+
+	mmChangeVacancyStatus.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockChangeVacancyStatusDone returns true if the count of the ChangeVacancyStatus invocations corresponds
+// the number of defined expectations
+func (m *VacancyStorageMock) MinimockChangeVacancyStatusDone() bool {
+	if m.ChangeVacancyStatusMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.ChangeVacancyStatusMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.ChangeVacancyStatusMock.invocationsDone()
+}
+
+// MinimockChangeVacancyStatusInspect logs each unmet expectation
+func (m *VacancyStorageMock) MinimockChangeVacancyStatusInspect() {
+	for _, e := range m.ChangeVacancyStatusMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to VacancyStorageMock.ChangeVacancyStatus at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterChangeVacancyStatusCounter := mm_atomic.LoadUint64(&m.afterChangeVacancyStatusCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.ChangeVacancyStatusMock.defaultExpectation != nil && afterChangeVacancyStatusCounter < 1 {
+		if m.ChangeVacancyStatusMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to VacancyStorageMock.ChangeVacancyStatus at\n%s", m.ChangeVacancyStatusMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to VacancyStorageMock.ChangeVacancyStatus at\n%s with params: %#v", m.ChangeVacancyStatusMock.defaultExpectation.expectationOrigins.origin, *m.ChangeVacancyStatusMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcChangeVacancyStatus != nil && afterChangeVacancyStatusCounter < 1 {
+		m.t.Errorf("Expected call to VacancyStorageMock.ChangeVacancyStatus at\n%s", m.funcChangeVacancyStatusOrigin)
+	}
+
+	if !m.ChangeVacancyStatusMock.invocationsDone() && afterChangeVacancyStatusCounter > 0 {
+		m.t.Errorf("Expected %d calls to VacancyStorageMock.ChangeVacancyStatus at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.ChangeVacancyStatusMock.expectedInvocations), m.ChangeVacancyStatusMock.expectedInvocationsOrigin, afterChangeVacancyStatusCounter)
+	}
+}
+
+type mVacancyStorageMockCountCandidatesByStage struct {
+	optional           bool
+	mock               *VacancyStorageMock
+	defaultExpectation *VacancyStorageMockCountCandidatesByStageExpectation
+	expectations       []*VacancyStorageMockCountCandidatesByStageExpectation
+
+	callArgs []*VacancyStorageMockCountCandidatesByStageParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// VacancyStorageMockCountCandidatesByStageExpectation specifies expectation struct of the VacancyStorage.CountCandidatesByStage
+type VacancyStorageMockCountCandidatesByStageExpectation struct {
+	mock               *VacancyStorageMock
+	params             *VacancyStorageMockCountCandidatesByStageParams
+	paramPtrs          *VacancyStorageMockCountCandidatesByStageParamPtrs
+	expectationOrigins VacancyStorageMockCountCandidatesByStageExpectationOrigins
+	results            *VacancyStorageMockCountCandidatesByStageResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// VacancyStorageMockCountCandidatesByStageParams contains parameters of the VacancyStorage.CountCandidatesByStage
+type VacancyStorageMockCountCandidatesByStageParams struct {
+	ctx       context.Context
+	vacancyID string
+}
+
+// VacancyStorageMockCountCandidatesByStageParamPtrs contains pointers to parameters of the VacancyStorage.CountCandidatesByStage
+type VacancyStorageMockCountCandidatesByStageParamPtrs struct {
+	ctx       *context.Context
+	vacancyID *string
+}
+
+// VacancyStorageMockCountCandidatesByStageResults contains results of the VacancyStorage.CountCandidatesByStage
+type VacancyStorageMockCountCandidatesByStageResults struct {
+	m1  map[string]uint32
+	err error
+}
+
+// VacancyStorageMockCountCandidatesByStageOrigins contains origins of expectations of the VacancyStorage.CountCandidatesByStage
+type VacancyStorageMockCountCandidatesByStageExpectationOrigins struct {
+	origin          string
+	originCtx       string
+	originVacancyID string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmCountCandidatesByStage *mVacancyStorageMockCountCandidatesByStage) Optional() *mVacancyStorageMockCountCandidatesByStage {
+	mmCountCandidatesByStage.optional = true
+	return mmCountCandidatesByStage
+}
+
+// Expect sets up expected params for VacancyStorage.CountCandidatesByStage
+func (mmCountCandidatesByStage *mVacancyStorageMockCountCandidatesByStage) Expect(ctx context.Context, vacancyID string) *mVacancyStorageMockCountCandidatesByStage {
+	if mmCountCandidatesByStage.mock.funcCountCandidatesByStage != nil {
+		mmCountCandidatesByStage.mock.t.Fatalf("VacancyStorageMock.CountCandidatesByStage mock is already set by Set")
+	}
+
+	if mmCountCandidatesByStage.defaultExpectation == nil {
+		mmCountCandidatesByStage.defaultExpectation = &VacancyStorageMockCountCandidatesByStageExpectation{}
+	}
+
+	if mmCountCandidatesByStage.defaultExpectation.paramPtrs != nil {
+		mmCountCandidatesByStage.mock.t.Fatalf("VacancyStorageMock.CountCandidatesByStage mock is already set by ExpectParams functions")
+	}
+
+	mmCountCandidatesByStage.defaultExpectation.params = &VacancyStorageMockCountCandidatesByStageParams{ctx, vacancyID}
+	mmCountCandidatesByStage.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmCountCandidatesByStage.expectations {
+		if minimock.Equal(e.params, mmCountCandidatesByStage.defaultExpectation.params) {
+			mmCountCandidatesByStage.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmCountCandidatesByStage.defaultExpectation.params)
+		}
+	}
+
+	return mmCountCandidatesByStage
+}
+
+// ExpectCtxParam1 sets up expected param ctx for VacancyStorage.CountCandidatesByStage
+func (mmCountCandidatesByStage *mVacancyStorageMockCountCandidatesByStage) ExpectCtxParam1(ctx context.Context) *mVacancyStorageMockCountCandidatesByStage {
+	if mmCountCandidatesByStage.mock.funcCountCandidatesByStage != nil {
+		mmCountCandidatesByStage.mock.t.Fatalf("VacancyStorageMock.CountCandidatesByStage mock is already set by Set")
+	}
+
+	if mmCountCandidatesByStage.defaultExpectation == nil {
+		mmCountCandidatesByStage.defaultExpectation = &VacancyStorageMockCountCandidatesByStageExpectation{}
+	}
+
+	if mmCountCandidatesByStage.defaultExpectation.params != nil {
+		mmCountCandidatesByStage.mock.t.Fatalf("VacancyStorageMock.CountCandidatesByStage mock is already set by Expect")
+	}
+
+	if mmCountCandidatesByStage.defaultExpectation.paramPtrs == nil {
+		mmCountCandidatesByStage.defaultExpectation.paramPtrs = &VacancyStorageMockCountCandidatesByStageParamPtrs{}
+	}
+	mmCountCandidatesByStage.defaultExpectation.paramPtrs.ctx = &ctx
+	mmCountCandidatesByStage.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmCountCandidatesByStage
+}
+
+// ExpectVacancyIDParam2 sets up expected param vacancyID for VacancyStorage.CountCandidatesByStage
+func (mmCountCandidatesByStage *mVacancyStorageMockCountCandidatesByStage) ExpectVacancyIDParam2(vacancyID string) *mVacancyStorageMockCountCandidatesByStage {
+	if mmCountCandidatesByStage.mock.funcCountCandidatesByStage != nil {
+		mmCountCandidatesByStage.mock.t.Fatalf("VacancyStorageMock.CountCandidatesByStage mock is already set by Set")
+	}
+
+	if mmCountCandidatesByStage.defaultExpectation == nil {
+		mmCountCandidatesByStage.defaultExpectation = &VacancyStorageMockCountCandidatesByStageExpectation{}
+	}
+
+	if mmCountCandidatesByStage.defaultExpectation.params != nil {
+		mmCountCandidatesByStage.mock.t.Fatalf("VacancyStorageMock.CountCandidatesByStage mock is already set by Expect")
+	}
+
+	if mmCountCandidatesByStage.defaultExpectation.paramPtrs == nil {
+		mmCountCandidatesByStage.defaultExpectation.paramPtrs = &VacancyStorageMockCountCandidatesByStageParamPtrs{}
+	}
+	mmCountCandidatesByStage.defaultExpectation.paramPtrs.vacancyID = &vacancyID
+	mmCountCandidatesByStage.defaultExpectation.expectationOrigins.originVacancyID = minimock.CallerInfo(1)
+
+	return mmCountCandidatesByStage
+}
+
+// Inspect accepts an inspector function that has same arguments as the VacancyStorage.CountCandidatesByStage
+func (mmCountCandidatesByStage *mVacancyStorageMockCountCandidatesByStage) Inspect(f func(ctx context.Context, vacancyID string)) *mVacancyStorageMockCountCandidatesByStage {
+	if mmCountCandidatesByStage.mock.inspectFuncCountCandidatesByStage != nil {
+		mmCountCandidatesByStage.mock.t.Fatalf("Inspect function is already set for VacancyStorageMock.CountCandidatesByStage")
+	}
+
+	mmCountCandidatesByStage.mock.inspectFuncCountCandidatesByStage = f
+
+	return mmCountCandidatesByStage
+}
+
+// Return sets up results that will be returned by VacancyStorage.CountCandidatesByStage
+func (mmCountCandidatesByStage *mVacancyStorageMockCountCandidatesByStage) Return(m1 map[string]uint32, err error) *VacancyStorageMock {
+	if mmCountCandidatesByStage.mock.funcCountCandidatesByStage != nil {
+		mmCountCandidatesByStage.mock.t.Fatalf("VacancyStorageMock.CountCandidatesByStage mock is already set by Set")
+	}
+
+	if mmCountCandidatesByStage.defaultExpectation == nil {
+		mmCountCandidatesByStage.defaultExpectation = &VacancyStorageMockCountCandidatesByStageExpectation{mock: mmCountCandidatesByStage.mock}
+	}
+	mmCountCandidatesByStage.defaultExpectation.results = &VacancyStorageMockCountCandidatesByStageResults{m1, err}
+	mmCountCandidatesByStage.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmCountCandidatesByStage.mock
+}
+
+// Set uses given function f to mock the VacancyStorage.CountCandidatesByStage method
+func (mmCountCandidatesByStage *mVacancyStorageMockCountCandidatesByStage) Set(f func(ctx context.Context, vacancyID string) (m1 map[string]uint32, err error)) *VacancyStorageMock {
+	if mmCountCandidatesByStage.defaultExpectation != nil {
+		mmCountCandidatesByStage.mock.t.Fatalf("Default expectation is already set for the VacancyStorage.CountCandidatesByStage method")
+	}
+
+	if len(mmCountCandidatesByStage.expectations) > 0 {
+		mmCountCandidatesByStage.mock.t.Fatalf("Some expectations are already set for the VacancyStorage.CountCandidatesByStage method")
+	}
+
+	mmCountCandidatesByStage.mock.funcCountCandidatesByStage = f
+	mmCountCandidatesByStage.mock.funcCountCandidatesByStageOrigin = minimock.CallerInfo(1)
+	return mmCountCandidatesByStage.mock
+}
+
+// When sets expectation for the VacancyStorage.CountCandidatesByStage which will trigger the result defined by the following
+// Then helper
+func (mmCountCandidatesByStage *mVacancyStorageMockCountCandidatesByStage) When(ctx context.Context, vacancyID string) *VacancyStorageMockCountCandidatesByStageExpectation {
+	if mmCountCandidatesByStage.mock.funcCountCandidatesByStage != nil {
+		mmCountCandidatesByStage.mock.t.Fatalf("VacancyStorageMock.CountCandidatesByStage mock is already set by Set")
+	}
+
+	expectation := &VacancyStorageMockCountCandidatesByStageExpectation{
+		mock:               mmCountCandidatesByStage.mock,
+		params:             &VacancyStorageMockCountCandidatesByStageParams{ctx, vacancyID},
+		expectationOrigins: VacancyStorageMockCountCandidatesByStageExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmCountCandidatesByStage.expectations = append(mmCountCandidatesByStage.expectations, expectation)
+	return expectation
+}
+
+// Then sets up VacancyStorage.CountCandidatesByStage return parameters for the expectation previously defined by the When method
+func (e *VacancyStorageMockCountCandidatesByStageExpectation) Then(m1 map[string]uint32, err error) *VacancyStorageMock {
+	e.results = &VacancyStorageMockCountCandidatesByStageResults{m1, err}
+	return e.mock
+}
+
+// Times sets number of times VacancyStorage.CountCandidatesByStage should be invoked
+func (mmCountCandidatesByStage *mVacancyStorageMockCountCandidatesByStage) Times(n uint64) *mVacancyStorageMockCountCandidatesByStage {
+	if n == 0 {
+		mmCountCandidatesByStage.mock.t.Fatalf("Times of VacancyStorageMock.CountCandidatesByStage mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmCountCandidatesByStage.expectedInvocations, n)
+	mmCountCandidatesByStage.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmCountCandidatesByStage
+}
+
+func (mmCountCandidatesByStage *mVacancyStorageMockCountCandidatesByStage) invocationsDone() bool {
+	if len(mmCountCandidatesByStage.expectations) == 0 && mmCountCandidatesByStage.defaultExpectation == nil && mmCountCandidatesByStage.mock.funcCountCandidatesByStage == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmCountCandidatesByStage.mock.afterCountCandidatesByStageCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmCountCandidatesByStage.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// CountCandidatesByStage implements mm_usecase.VacancyStorage
+func (mmCountCandidatesByStage *VacancyStorageMock) CountCandidatesByStage(ctx context.Context, vacancyID string) (m1 map[string]uint32, err error) {
+	mm_atomic.AddUint64(&mmCountCandidatesByStage.beforeCountCandidatesByStageCounter, 1)
+	defer mm_atomic.AddUint64(&mmCountCandidatesByStage.afterCountCandidatesByStageCounter, 1)
+
+	mmCountCandidatesByStage.t.Helper()
+
+	if mmCountCandidatesByStage.inspectFuncCountCandidatesByStage != nil {
+		mmCountCandidatesByStage.inspectFuncCountCandidatesByStage(ctx, vacancyID)
+	}
+
+	mm_params := VacancyStorageMockCountCandidatesByStageParams{ctx, vacancyID}
+
+	// Record call args
+	mmCountCandidatesByStage.CountCandidatesByStageMock.mutex.Lock()
+	mmCountCandidatesByStage.CountCandidatesByStageMock.callArgs = append(mmCountCandidatesByStage.CountCandidatesByStageMock.callArgs, &mm_params)
+	mmCountCandidatesByStage.CountCandidatesByStageMock.mutex.Unlock()
+
+	for _, e := range mmCountCandidatesByStage.CountCandidatesByStageMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.m1, e.results.err
+		}
+	}
+
+	if mmCountCandidatesByStage.CountCandidatesByStageMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmCountCandidatesByStage.CountCandidatesByStageMock.defaultExpectation.Counter, 1)
+		mm_want := mmCountCandidatesByStage.CountCandidatesByStageMock.defaultExpectation.params
+		mm_want_ptrs := mmCountCandidatesByStage.CountCandidatesByStageMock.defaultExpectation.paramPtrs
+
+		mm_got := VacancyStorageMockCountCandidatesByStageParams{ctx, vacancyID}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmCountCandidatesByStage.t.Errorf("VacancyStorageMock.CountCandidatesByStage got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmCountCandidatesByStage.CountCandidatesByStageMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.vacancyID != nil && !minimock.Equal(*mm_want_ptrs.vacancyID, mm_got.vacancyID) {
+				mmCountCandidatesByStage.t.Errorf("VacancyStorageMock.CountCandidatesByStage got unexpected parameter vacancyID, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmCountCandidatesByStage.CountCandidatesByStageMock.defaultExpectation.expectationOrigins.originVacancyID, *mm_want_ptrs.vacancyID, mm_got.vacancyID, minimock.Diff(*mm_want_ptrs.vacancyID, mm_got.vacancyID))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmCountCandidatesByStage.t.Errorf("VacancyStorageMock.CountCandidatesByStage got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmCountCandidatesByStage.CountCandidatesByStageMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmCountCandidatesByStage.CountCandidatesByStageMock.defaultExpectation.results
+		if mm_results == nil {
+			mmCountCandidatesByStage.t.Fatal("No results are set for the VacancyStorageMock.CountCandidatesByStage")
+		}
+		return (*mm_results).m1, (*mm_results).err
+	}
+	if mmCountCandidatesByStage.funcCountCandidatesByStage != nil {
+		return mmCountCandidatesByStage.funcCountCandidatesByStage(ctx, vacancyID)
+	}
+	mmCountCandidatesByStage.t.Fatalf("Unexpected call to VacancyStorageMock.CountCandidatesByStage. %v %v", ctx, vacancyID)
+	return
+}
+
+// CountCandidatesByStageAfterCounter returns a count of finished VacancyStorageMock.CountCandidatesByStage invocations
+func (mmCountCandidatesByStage *VacancyStorageMock) CountCandidatesByStageAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmCountCandidatesByStage.afterCountCandidatesByStageCounter)
+}
+
+// CountCandidatesByStageBeforeCounter returns a count of VacancyStorageMock.CountCandidatesByStage invocations
+func (mmCountCandidatesByStage *VacancyStorageMock) CountCandidatesByStageBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmCountCandidatesByStage.beforeCountCandidatesByStageCounter)
+}
+
+// Calls returns a list of arguments used in each call to VacancyStorageMock.CountCandidatesByStage.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmCountCandidatesByStage *mVacancyStorageMockCountCandidatesByStage) Calls() []*VacancyStorageMockCountCandidatesByStageParams {
+	mmCountCandidatesByStage.mutex.RLock()
+
+	argCopy := make([]*VacancyStorageMockCountCandidatesByStageParams, len(mmCountCandidatesByStage.callArgs))
+	copy(argCopy, mmCountCandidatesByStage.callArgs)
+
+	mmCountCandidatesByStage.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockCountCandidatesByStageDone returns true if the count of the CountCandidatesByStage invocations corresponds
+// the number of defined expectations
+func (m *VacancyStorageMock) MinimockCountCandidatesByStageDone() bool {
+	if m.CountCandidatesByStageMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.CountCandidatesByStageMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.CountCandidatesByStageMock.invocationsDone()
+}
+
+// MinimockCountCandidatesByStageInspect logs each unmet expectation
+func (m *VacancyStorageMock) MinimockCountCandidatesByStageInspect() {
+	for _, e := range m.CountCandidatesByStageMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to VacancyStorageMock.CountCandidatesByStage at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterCountCandidatesByStageCounter := mm_atomic.LoadUint64(&m.afterCountCandidatesByStageCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.CountCandidatesByStageMock.defaultExpectation != nil && afterCountCandidatesByStageCounter < 1 {
+		if m.CountCandidatesByStageMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to VacancyStorageMock.CountCandidatesByStage at\n%s", m.CountCandidatesByStageMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to VacancyStorageMock.CountCandidatesByStage at\n%s with params: %#v", m.CountCandidatesByStageMock.defaultExpectation.expectationOrigins.origin, *m.CountCandidatesByStageMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcCountCandidatesByStage != nil && afterCountCandidatesByStageCounter < 1 {
+		m.t.Errorf("Expected call to VacancyStorageMock.CountCandidatesByStage at\n%s", m.funcCountCandidatesByStageOrigin)
+	}
+
+	if !m.CountCandidatesByStageMock.invocationsDone() && afterCountCandidatesByStageCounter > 0 {
+		m.t.Errorf("Expected %d calls to VacancyStorageMock.CountCandidatesByStage at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.CountCandidatesByStageMock.expectedInvocations), m.CountCandidatesByStageMock.expectedInvocationsOrigin, afterCountCandidatesByStageCounter)
+	}
+}
+
+type mVacancyStorageMockCreateTemplate struct {
+	optional           bool
+	mock               *VacancyStorageMock
+	defaultExpectation *VacancyStorageMockCreateTemplateExpectation
+	expectations       []*VacancyStorageMockCreateTemplateExpectation
+
+	callArgs []*VacancyStorageMockCreateTemplateParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// VacancyStorageMockCreateTemplateExpectation specifies expectation struct of the VacancyStorage.CreateTemplate
+type VacancyStorageMockCreateTemplateExpectation struct {
+	mock               *VacancyStorageMock
+	params             *VacancyStorageMockCreateTemplateParams
+	paramPtrs          *VacancyStorageMockCreateTemplateParamPtrs
+	expectationOrigins VacancyStorageMockCreateTemplateExpectationOrigins
+	results            *VacancyStorageMockCreateTemplateResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// VacancyStorageMockCreateTemplateParams contains parameters of the VacancyStorage.CreateTemplate
+type VacancyStorageMockCreateTemplateParams struct {
+	ctx context.Context
+	in  domain.InsertTemplateInput
+}
+
+// VacancyStorageMockCreateTemplateParamPtrs contains pointers to parameters of the VacancyStorage.CreateTemplate
+type VacancyStorageMockCreateTemplateParamPtrs struct {
+	ctx *context.Context
+	in  *domain.InsertTemplateInput
+}
+
+// VacancyStorageMockCreateTemplateResults contains results of the VacancyStorage.CreateTemplate
+type VacancyStorageMockCreateTemplateResults struct {
+	vp1 *domain.VacancyTemplate
+	err error
+}
+
+// VacancyStorageMockCreateTemplateOrigins contains origins of expectations of the VacancyStorage.CreateTemplate
+type VacancyStorageMockCreateTemplateExpectationOrigins struct {
+	origin    string
+	originCtx string
+	originIn  string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmCreateTemplate *mVacancyStorageMockCreateTemplate) Optional() *mVacancyStorageMockCreateTemplate {
+	mmCreateTemplate.optional = true
+	return mmCreateTemplate
+}
+
+// Expect sets up expected params for VacancyStorage.CreateTemplate
+func (mmCreateTemplate *mVacancyStorageMockCreateTemplate) Expect(ctx context.Context, in domain.InsertTemplateInput) *mVacancyStorageMockCreateTemplate {
+	if mmCreateTemplate.mock.funcCreateTemplate != nil {
+		mmCreateTemplate.mock.t.Fatalf("VacancyStorageMock.CreateTemplate mock is already set by Set")
+	}
+
+	if mmCreateTemplate.defaultExpectation == nil {
+		mmCreateTemplate.defaultExpectation = &VacancyStorageMockCreateTemplateExpectation{}
+	}
+
+	if mmCreateTemplate.defaultExpectation.paramPtrs != nil {
+		mmCreateTemplate.mock.t.Fatalf("VacancyStorageMock.CreateTemplate mock is already set by ExpectParams functions")
+	}
+
+	mmCreateTemplate.defaultExpectation.params = &VacancyStorageMockCreateTemplateParams{ctx, in}
+	mmCreateTemplate.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmCreateTemplate.expectations {
+		if minimock.Equal(e.params, mmCreateTemplate.defaultExpectation.params) {
+			mmCreateTemplate.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmCreateTemplate.defaultExpectation.params)
+		}
+	}
+
+	return mmCreateTemplate
+}
+
+// ExpectCtxParam1 sets up expected param ctx for VacancyStorage.CreateTemplate
+func (mmCreateTemplate *mVacancyStorageMockCreateTemplate) ExpectCtxParam1(ctx context.Context) *mVacancyStorageMockCreateTemplate {
+	if mmCreateTemplate.mock.funcCreateTemplate != nil {
+		mmCreateTemplate.mock.t.Fatalf("VacancyStorageMock.CreateTemplate mock is already set by Set")
+	}
+
+	if mmCreateTemplate.defaultExpectation == nil {
+		mmCreateTemplate.defaultExpectation = &VacancyStorageMockCreateTemplateExpectation{}
+	}
+
+	if mmCreateTemplate.defaultExpectation.params != nil {
+		mmCreateTemplate.mock.t.Fatalf("VacancyStorageMock.CreateTemplate mock is already set by Expect")
+	}
+
+	if mmCreateTemplate.defaultExpectation.paramPtrs == nil {
+		mmCreateTemplate.defaultExpectation.paramPtrs = &VacancyStorageMockCreateTemplateParamPtrs{}
+	}
+	mmCreateTemplate.defaultExpectation.paramPtrs.ctx = &ctx
+	mmCreateTemplate.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmCreateTemplate
+}
+
+// ExpectInParam2 sets up expected param in for VacancyStorage.CreateTemplate
+func (mmCreateTemplate *mVacancyStorageMockCreateTemplate) ExpectInParam2(in domain.InsertTemplateInput) *mVacancyStorageMockCreateTemplate {
+	if mmCreateTemplate.mock.funcCreateTemplate != nil {
+		mmCreateTemplate.mock.t.Fatalf("VacancyStorageMock.CreateTemplate mock is already set by Set")
+	}
+
+	if mmCreateTemplate.defaultExpectation == nil {
+		mmCreateTemplate.defaultExpectation = &VacancyStorageMockCreateTemplateExpectation{}
+	}
+
+	if mmCreateTemplate.defaultExpectation.params != nil {
+		mmCreateTemplate.mock.t.Fatalf("VacancyStorageMock.CreateTemplate mock is already set by Expect")
+	}
+
+	if mmCreateTemplate.defaultExpectation.paramPtrs == nil {
+		mmCreateTemplate.defaultExpectation.paramPtrs = &VacancyStorageMockCreateTemplateParamPtrs{}
+	}
+	mmCreateTemplate.defaultExpectation.paramPtrs.in = &in
+	mmCreateTemplate.defaultExpectation.expectationOrigins.originIn = minimock.CallerInfo(1)
+
+	return mmCreateTemplate
+}
+
+// Inspect accepts an inspector function that has same arguments as the VacancyStorage.CreateTemplate
+func (mmCreateTemplate *mVacancyStorageMockCreateTemplate) Inspect(f func(ctx context.Context, in domain.InsertTemplateInput)) *mVacancyStorageMockCreateTemplate {
+	if mmCreateTemplate.mock.inspectFuncCreateTemplate != nil {
+		mmCreateTemplate.mock.t.Fatalf("Inspect function is already set for VacancyStorageMock.CreateTemplate")
+	}
+
+	mmCreateTemplate.mock.inspectFuncCreateTemplate = f
+
+	return mmCreateTemplate
+}
+
+// Return sets up results that will be returned by VacancyStorage.CreateTemplate
+func (mmCreateTemplate *mVacancyStorageMockCreateTemplate) Return(vp1 *domain.VacancyTemplate, err error) *VacancyStorageMock {
+	if mmCreateTemplate.mock.funcCreateTemplate != nil {
+		mmCreateTemplate.mock.t.Fatalf("VacancyStorageMock.CreateTemplate mock is already set by Set")
+	}
+
+	if mmCreateTemplate.defaultExpectation == nil {
+		mmCreateTemplate.defaultExpectation = &VacancyStorageMockCreateTemplateExpectation{mock: mmCreateTemplate.mock}
+	}
+	mmCreateTemplate.defaultExpectation.results = &VacancyStorageMockCreateTemplateResults{vp1, err}
+	mmCreateTemplate.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmCreateTemplate.mock
+}
+
+// Set uses given function f to mock the VacancyStorage.CreateTemplate method
+func (mmCreateTemplate *mVacancyStorageMockCreateTemplate) Set(f func(ctx context.Context, in domain.InsertTemplateInput) (vp1 *domain.VacancyTemplate, err error)) *VacancyStorageMock {
+	if mmCreateTemplate.defaultExpectation != nil {
+		mmCreateTemplate.mock.t.Fatalf("Default expectation is already set for the VacancyStorage.CreateTemplate method")
+	}
+
+	if len(mmCreateTemplate.expectations) > 0 {
+		mmCreateTemplate.mock.t.Fatalf("Some expectations are already set for the VacancyStorage.CreateTemplate method")
+	}
+
+	mmCreateTemplate.mock.funcCreateTemplate = f
+	mmCreateTemplate.mock.funcCreateTemplateOrigin = minimock.CallerInfo(1)
+	return mmCreateTemplate.mock
+}
+
+// When sets expectation for the VacancyStorage.CreateTemplate which will trigger the result defined by the following
+// Then helper
+func (mmCreateTemplate *mVacancyStorageMockCreateTemplate) When(ctx context.Context, in domain.InsertTemplateInput) *VacancyStorageMockCreateTemplateExpectation {
+	if mmCreateTemplate.mock.funcCreateTemplate != nil {
+		mmCreateTemplate.mock.t.Fatalf("VacancyStorageMock.CreateTemplate mock is already set by Set")
+	}
+
+	expectation := &VacancyStorageMockCreateTemplateExpectation{
+		mock:               mmCreateTemplate.mock,
+		params:             &VacancyStorageMockCreateTemplateParams{ctx, in},
+		expectationOrigins: VacancyStorageMockCreateTemplateExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmCreateTemplate.expectations = append(mmCreateTemplate.expectations, expectation)
+	return expectation
+}
+
+// Then sets up VacancyStorage.CreateTemplate return parameters for the expectation previously defined by the When method
+func (e *VacancyStorageMockCreateTemplateExpectation) Then(vp1 *domain.VacancyTemplate, err error) *VacancyStorageMock {
+	e.results = &VacancyStorageMockCreateTemplateResults{vp1, err}
+	return e.mock
+}
+
+// Times sets number of times VacancyStorage.CreateTemplate should be invoked
+func (mmCreateTemplate *mVacancyStorageMockCreateTemplate) Times(n uint64) *mVacancyStorageMockCreateTemplate {
+	if n == 0 {
+		mmCreateTemplate.mock.t.Fatalf("Times of VacancyStorageMock.CreateTemplate mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmCreateTemplate.expectedInvocations, n)
+	mmCreateTemplate.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmCreateTemplate
+}
+
+func (mmCreateTemplate *mVacancyStorageMockCreateTemplate) invocationsDone() bool {
+	if len(mmCreateTemplate.expectations) == 0 && mmCreateTemplate.defaultExpectation == nil && mmCreateTemplate.mock.funcCreateTemplate == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmCreateTemplate.mock.afterCreateTemplateCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmCreateTemplate.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// CreateTemplate implements mm_usecase.VacancyStorage
+func (mmCreateTemplate *VacancyStorageMock) CreateTemplate(ctx context.Context, in domain.InsertTemplateInput) (vp1 *domain.VacancyTemplate, err error) {
+	mm_atomic.AddUint64(&mmCreateTemplate.beforeCreateTemplateCounter, 1)
+	defer mm_atomic.AddUint64(&mmCreateTemplate.afterCreateTemplateCounter, 1)
+
+	mmCreateTemplate.t.Helper()
+
+	if mmCreateTemplate.inspectFuncCreateTemplate != nil {
+		mmCreateTemplate.inspectFuncCreateTemplate(ctx, in)
+	}
+
+	mm_params := VacancyStorageMockCreateTemplateParams{ctx, in}
+
+	// Record call args
+	mmCreateTemplate.CreateTemplateMock.mutex.Lock()
+	mmCreateTemplate.CreateTemplateMock.callArgs = append(mmCreateTemplate.CreateTemplateMock.callArgs, &mm_params)
+	mmCreateTemplate.CreateTemplateMock.mutex.Unlock()
+
+	for _, e := range mmCreateTemplate.CreateTemplateMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.vp1, e.results.err
+		}
+	}
+
+	if mmCreateTemplate.CreateTemplateMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmCreateTemplate.CreateTemplateMock.defaultExpectation.Counter, 1)
+		mm_want := mmCreateTemplate.CreateTemplateMock.defaultExpectation.params
+		mm_want_ptrs := mmCreateTemplate.CreateTemplateMock.defaultExpectation.paramPtrs
+
+		mm_got := VacancyStorageMockCreateTemplateParams{ctx, in}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmCreateTemplate.t.Errorf("VacancyStorageMock.CreateTemplate got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmCreateTemplate.CreateTemplateMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.in != nil && !minimock.Equal(*mm_want_ptrs.in, mm_got.in) {
+				mmCreateTemplate.t.Errorf("VacancyStorageMock.CreateTemplate got unexpected parameter in, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmCreateTemplate.CreateTemplateMock.defaultExpectation.expectationOrigins.originIn, *mm_want_ptrs.in, mm_got.in, minimock.Diff(*mm_want_ptrs.in, mm_got.in))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmCreateTemplate.t.Errorf("VacancyStorageMock.CreateTemplate got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmCreateTemplate.CreateTemplateMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmCreateTemplate.CreateTemplateMock.defaultExpectation.results
+		if mm_results == nil {
+			mmCreateTemplate.t.Fatal("No results are set for the VacancyStorageMock.CreateTemplate")
+		}
+		return (*mm_results).vp1, (*mm_results).err
+	}
+	if mmCreateTemplate.funcCreateTemplate != nil {
+		return mmCreateTemplate.funcCreateTemplate(ctx, in)
+	}
+	mmCreateTemplate.t.Fatalf("Unexpected call to VacancyStorageMock.CreateTemplate. %v %v", ctx, in)
+	return
+}
+
+// CreateTemplateAfterCounter returns a count of finished VacancyStorageMock.CreateTemplate invocations
+func (mmCreateTemplate *VacancyStorageMock) CreateTemplateAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmCreateTemplate.afterCreateTemplateCounter)
+}
+
+// CreateTemplateBeforeCounter returns a count of VacancyStorageMock.CreateTemplate invocations
+func (mmCreateTemplate *VacancyStorageMock) CreateTemplateBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmCreateTemplate.beforeCreateTemplateCounter)
+}
+
+// Calls returns a list of arguments used in each call to VacancyStorageMock.CreateTemplate.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmCreateTemplate *mVacancyStorageMockCreateTemplate) Calls() []*VacancyStorageMockCreateTemplateParams {
+	mmCreateTemplate.mutex.RLock()
+
+	argCopy := make([]*VacancyStorageMockCreateTemplateParams, len(mmCreateTemplate.callArgs))
+	copy(argCopy, mmCreateTemplate.callArgs)
+
+	mmCreateTemplate.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockCreateTemplateDone returns true if the count of the CreateTemplate invocations corresponds
+// the number of defined expectations
+func (m *VacancyStorageMock) MinimockCreateTemplateDone() bool {
+	if m.CreateTemplateMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.CreateTemplateMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.CreateTemplateMock.invocationsDone()
+}
+
+// MinimockCreateTemplateInspect logs each unmet expectation
+func (m *VacancyStorageMock) MinimockCreateTemplateInspect() {
+	for _, e := range m.CreateTemplateMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to VacancyStorageMock.CreateTemplate at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterCreateTemplateCounter := mm_atomic.LoadUint64(&m.afterCreateTemplateCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.CreateTemplateMock.defaultExpectation != nil && afterCreateTemplateCounter < 1 {
+		if m.CreateTemplateMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to VacancyStorageMock.CreateTemplate at\n%s", m.CreateTemplateMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to VacancyStorageMock.CreateTemplate at\n%s with params: %#v", m.CreateTemplateMock.defaultExpectation.expectationOrigins.origin, *m.CreateTemplateMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcCreateTemplate != nil && afterCreateTemplateCounter < 1 {
+		m.t.Errorf("Expected call to VacancyStorageMock.CreateTemplate at\n%s", m.funcCreateTemplateOrigin)
+	}
+
+	if !m.CreateTemplateMock.invocationsDone() && afterCreateTemplateCounter > 0 {
+		m.t.Errorf("Expected %d calls to VacancyStorageMock.CreateTemplate at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.CreateTemplateMock.expectedInvocations), m.CreateTemplateMock.expectedInvocationsOrigin, afterCreateTemplateCounter)
+	}
+}
+
+type mVacancyStorageMockCreateVacancies struct {
+	optional           bool
+	mock               *VacancyStorageMock
+	defaultExpectation *VacancyStorageMockCreateVacanciesExpectation
+	expectations       []*VacancyStorageMockCreateVacanciesExpectation
+
+	callArgs []*VacancyStorageMockCreateVacanciesParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// VacancyStorageMockCreateVacanciesExpectation specifies expectation struct of the VacancyStorage.CreateVacancies
+type VacancyStorageMockCreateVacanciesExpectation struct {
+	mock               *VacancyStorageMock
+	params             *VacancyStorageMockCreateVacanciesParams
+	paramPtrs          *VacancyStorageMockCreateVacanciesParamPtrs
+	expectationOrigins VacancyStorageMockCreateVacanciesExpectationOrigins
+	results            *VacancyStorageMockCreateVacanciesResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// VacancyStorageMockCreateVacanciesParams contains parameters of the VacancyStorage.CreateVacancies
+type VacancyStorageMockCreateVacanciesParams struct {
+	ctx context.Context
+	ins []domain.CreateVacancyInput
+}
+
+// VacancyStorageMockCreateVacanciesParamPtrs contains pointers to parameters of the VacancyStorage.CreateVacancies
+type VacancyStorageMockCreateVacanciesParamPtrs struct {
+	ctx *context.Context
+	ins *[]domain.CreateVacancyInput
+}
+
+// VacancyStorageMockCreateVacanciesResults contains results of the VacancyStorage.CreateVacancies
+type VacancyStorageMockCreateVacanciesResults struct {
+	vpa1 []*domain.Vacancy
+	err  error
+}
+
+// VacancyStorageMockCreateVacanciesOrigins contains origins of expectations of the VacancyStorage.CreateVacancies
+type VacancyStorageMockCreateVacanciesExpectationOrigins struct {
+	origin    string
+	originCtx string
+	originIns string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmCreateVacancies *mVacancyStorageMockCreateVacancies) Optional() *mVacancyStorageMockCreateVacancies {
+	mmCreateVacancies.optional = true
+	return mmCreateVacancies
+}
+
+// Expect sets up expected params for VacancyStorage.CreateVacancies
+func (mmCreateVacancies *mVacancyStorageMockCreateVacancies) Expect(ctx context.Context, ins []domain.CreateVacancyInput) *mVacancyStorageMockCreateVacancies {
+	if mmCreateVacancies.mock.funcCreateVacancies != nil {
+		mmCreateVacancies.mock.t.Fatalf("VacancyStorageMock.CreateVacancies mock is already set by Set")
+	}
+
+	if mmCreateVacancies.defaultExpectation == nil {
+		mmCreateVacancies.defaultExpectation = &VacancyStorageMockCreateVacanciesExpectation{}
+	}
+
+	if mmCreateVacancies.defaultExpectation.paramPtrs != nil {
+		mmCreateVacancies.mock.t.Fatalf("VacancyStorageMock.CreateVacancies mock is already set by ExpectParams functions")
+	}
+
+	mmCreateVacancies.defaultExpectation.params = &VacancyStorageMockCreateVacanciesParams{ctx, ins}
+	mmCreateVacancies.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmCreateVacancies.expectations {
+		if minimock.Equal(e.params, mmCreateVacancies.defaultExpectation.params) {
+			mmCreateVacancies.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmCreateVacancies.defaultExpectation.params)
+		}
+	}
+
+	return mmCreateVacancies
+}
+
+// ExpectCtxParam1 sets up expected param ctx for VacancyStorage.CreateVacancies
+func (mmCreateVacancies *mVacancyStorageMockCreateVacancies) ExpectCtxParam1(ctx context.Context) *mVacancyStorageMockCreateVacancies {
+	if mmCreateVacancies.mock.funcCreateVacancies != nil {
+		mmCreateVacancies.mock.t.Fatalf("VacancyStorageMock.CreateVacancies mock is already set by Set")
+	}
+
+	if mmCreateVacancies.defaultExpectation == nil {
+		mmCreateVacancies.defaultExpectation = &VacancyStorageMockCreateVacanciesExpectation{}
+	}
+
+	if mmCreateVacancies.defaultExpectation.params != nil {
+		mmCreateVacancies.mock.t.Fatalf("VacancyStorageMock.CreateVacancies mock is already set by Expect")
+	}
+
+	if mmCreateVacancies.defaultExpectation.paramPtrs == nil {
+		mmCreateVacancies.defaultExpectation.paramPtrs = &VacancyStorageMockCreateVacanciesParamPtrs{}
+	}
+	mmCreateVacancies.defaultExpectation.paramPtrs.ctx = &ctx
+	mmCreateVacancies.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmCreateVacancies
+}
+
+// ExpectInsParam2 sets up expected param ins for VacancyStorage.CreateVacancies
+func (mmCreateVacancies *mVacancyStorageMockCreateVacancies) ExpectInsParam2(ins []domain.CreateVacancyInput) *mVacancyStorageMockCreateVacancies {
+	if mmCreateVacancies.mock.funcCreateVacancies != nil {
+		mmCreateVacancies.mock.t.Fatalf("VacancyStorageMock.CreateVacancies mock is already set by Set")
+	}
+
+	if mmCreateVacancies.defaultExpectation == nil {
+		mmCreateVacancies.defaultExpectation = &VacancyStorageMockCreateVacanciesExpectation{}
+	}
+
+	if mmCreateVacancies.defaultExpectation.params != nil {
+		mmCreateVacancies.mock.t.Fatalf("VacancyStorageMock.CreateVacancies mock is already set by Expect")
+	}
+
+	if mmCreateVacancies.defaultExpectation.paramPtrs == nil {
+		mmCreateVacancies.defaultExpectation.paramPtrs = &VacancyStorageMockCreateVacanciesParamPtrs{}
+	}
+	mmCreateVacancies.defaultExpectation.paramPtrs.ins = &ins
+	mmCreateVacancies.defaultExpectation.expectationOrigins.originIns = minimock.CallerInfo(1)
+
+	return mmCreateVacancies
+}
+
+// Inspect accepts an inspector function that has same arguments as the VacancyStorage.CreateVacancies
+func (mmCreateVacancies *mVacancyStorageMockCreateVacancies) Inspect(f func(ctx context.Context, ins []domain.CreateVacancyInput)) *mVacancyStorageMockCreateVacancies {
+	if mmCreateVacancies.mock.inspectFuncCreateVacancies != nil {
+		mmCreateVacancies.mock.t.Fatalf("Inspect function is already set for VacancyStorageMock.CreateVacancies")
+	}
+
+	mmCreateVacancies.mock.inspectFuncCreateVacancies = f
+
+	return mmCreateVacancies
+}
+
+// Return sets up results that will be returned by VacancyStorage.CreateVacancies
+func (mmCreateVacancies *mVacancyStorageMockCreateVacancies) Return(vpa1 []*domain.Vacancy, err error) *VacancyStorageMock {
+	if mmCreateVacancies.mock.funcCreateVacancies != nil {
+		mmCreateVacancies.mock.t.Fatalf("VacancyStorageMock.CreateVacancies mock is already set by Set")
+	}
+
+	if mmCreateVacancies.defaultExpectation == nil {
+		mmCreateVacancies.defaultExpectation = &VacancyStorageMockCreateVacanciesExpectation{mock: mmCreateVacancies.mock}
+	}
+	mmCreateVacancies.defaultExpectation.results = &VacancyStorageMockCreateVacanciesResults{vpa1, err}
+	mmCreateVacancies.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmCreateVacancies.mock
+}
+
+// Set uses given function f to mock the VacancyStorage.CreateVacancies method
+func (mmCreateVacancies *mVacancyStorageMockCreateVacancies) Set(f func(ctx context.Context, ins []domain.CreateVacancyInput) (vpa1 []*domain.Vacancy, err error)) *VacancyStorageMock {
+	if mmCreateVacancies.defaultExpectation != nil {
+		mmCreateVacancies.mock.t.Fatalf("Default expectation is already set for the VacancyStorage.CreateVacancies method")
+	}
+
+	if len(mmCreateVacancies.expectations) > 0 {
+		mmCreateVacancies.mock.t.Fatalf("Some expectations are already set for the VacancyStorage.CreateVacancies method")
+	}
+
+	mmCreateVacancies.mock.funcCreateVacancies = f
+	mmCreateVacancies.mock.funcCreateVacanciesOrigin = minimock.CallerInfo(1)
+	return mmCreateVacancies.mock
+}
+
+// When sets expectation for the VacancyStorage.CreateVacancies which will trigger the result defined by the following
+// Then helper
+func (mmCreateVacancies *mVacancyStorageMockCreateVacancies) When(ctx context.Context, ins []domain.CreateVacancyInput) *VacancyStorageMockCreateVacanciesExpectation {
+	if mmCreateVacancies.mock.funcCreateVacancies != nil {
+		mmCreateVacancies.mock.t.Fatalf("VacancyStorageMock.CreateVacancies mock is already set by Set")
+	}
+
+	expectation := &VacancyStorageMockCreateVacanciesExpectation{
+		mock:               mmCreateVacancies.mock,
+		params:             &VacancyStorageMockCreateVacanciesParams{ctx, ins},
+		expectationOrigins: VacancyStorageMockCreateVacanciesExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmCreateVacancies.expectations = append(mmCreateVacancies.expectations, expectation)
+	return expectation
+}
+
+// Then sets up VacancyStorage.CreateVacancies return parameters for the expectation previously defined by the When method
+func (e *VacancyStorageMockCreateVacanciesExpectation) Then(vpa1 []*domain.Vacancy, err error) *VacancyStorageMock {
+	e.results = &VacancyStorageMockCreateVacanciesResults{vpa1, err}
+	return e.mock
+}
+
+// Times sets number of times VacancyStorage.CreateVacancies should be invoked
+func (mmCreateVacancies *mVacancyStorageMockCreateVacancies) Times(n uint64) *mVacancyStorageMockCreateVacancies {
+	if n == 0 {
+		mmCreateVacancies.mock.t.Fatalf("Times of VacancyStorageMock.CreateVacancies mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmCreateVacancies.expectedInvocations, n)
+	mmCreateVacancies.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmCreateVacancies
+}
+
+func (mmCreateVacancies *mVacancyStorageMockCreateVacancies) invocationsDone() bool {
+	if len(mmCreateVacancies.expectations) == 0 && mmCreateVacancies.defaultExpectation == nil && mmCreateVacancies.mock.funcCreateVacancies == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmCreateVacancies.mock.afterCreateVacanciesCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmCreateVacancies.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// CreateVacancies implements mm_usecase.VacancyStorage
+func (mmCreateVacancies *VacancyStorageMock) CreateVacancies(ctx context.Context, ins []domain.CreateVacancyInput) (vpa1 []*domain.Vacancy, err error) {
+	mm_atomic.AddUint64(&mmCreateVacancies.beforeCreateVacanciesCounter, 1)
+	defer mm_atomic.AddUint64(&mmCreateVacancies.afterCreateVacanciesCounter, 1)
+
+	mmCreateVacancies.t.Helper()
+
+	if mmCreateVacancies.inspectFuncCreateVacancies != nil {
+		mmCreateVacancies.inspectFuncCreateVacancies(ctx, ins)
+	}
+
+	mm_params := VacancyStorageMockCreateVacanciesParams{ctx, ins}
+
+	// Record call args
+	mmCreateVacancies.CreateVacanciesMock.mutex.Lock()
+	mmCreateVacancies.CreateVacanciesMock.callArgs = append(mmCreateVacancies.CreateVacanciesMock.callArgs, &mm_params)
+	mmCreateVacancies.CreateVacanciesMock.mutex.Unlock()
+
+	for _, e := range mmCreateVacancies.CreateVacanciesMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.vpa1, e.results.err
+		}
+	}
+
+	if mmCreateVacancies.CreateVacanciesMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmCreateVacancies.CreateVacanciesMock.defaultExpectation.Counter, 1)
+		mm_want := mmCreateVacancies.CreateVacanciesMock.defaultExpectation.params
+		mm_want_ptrs := mmCreateVacancies.CreateVacanciesMock.defaultExpectation.paramPtrs
+
+		mm_got := VacancyStorageMockCreateVacanciesParams{ctx, ins}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmCreateVacancies.t.Errorf("VacancyStorageMock.CreateVacancies got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmCreateVacancies.CreateVacanciesMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.ins != nil && !minimock.Equal(*mm_want_ptrs.ins, mm_got.ins) {
+				mmCreateVacancies.t.Errorf("VacancyStorageMock.CreateVacancies got unexpected parameter ins, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmCreateVacancies.CreateVacanciesMock.defaultExpectation.expectationOrigins.originIns, *mm_want_ptrs.ins, mm_got.ins, minimock.Diff(*mm_want_ptrs.ins, mm_got.ins))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmCreateVacancies.t.Errorf("VacancyStorageMock.CreateVacancies got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmCreateVacancies.CreateVacanciesMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmCreateVacancies.CreateVacanciesMock.defaultExpectation.results
+		if mm_results == nil {
+			mmCreateVacancies.t.Fatal("No results are set for the VacancyStorageMock.CreateVacancies")
+		}
+		return (*mm_results).vpa1, (*mm_results).err
+	}
+	if mmCreateVacancies.funcCreateVacancies != nil {
+		return mmCreateVacancies.funcCreateVacancies(ctx, ins)
+	}
+	mmCreateVacancies.t.Fatalf("Unexpected call to VacancyStorageMock.CreateVacancies. %v %v", ctx, ins)
+	return
+}
+
+// CreateVacanciesAfterCounter returns a count of finished VacancyStorageMock.CreateVacancies invocations
+func (mmCreateVacancies *VacancyStorageMock) CreateVacanciesAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmCreateVacancies.afterCreateVacanciesCounter)
+}
+
+// CreateVacanciesBeforeCounter returns a count of VacancyStorageMock.CreateVacancies invocations
+func (mmCreateVacancies *VacancyStorageMock) CreateVacanciesBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmCreateVacancies.beforeCreateVacanciesCounter)
+}
+
+// Calls returns a list of arguments used in each call to VacancyStorageMock.CreateVacancies.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmCreateVacancies *mVacancyStorageMockCreateVacancies) Calls() []*VacancyStorageMockCreateVacanciesParams {
+	mmCreateVacancies.mutex.RLock()
+
+	argCopy := make([]*VacancyStorageMockCreateVacanciesParams, len(mmCreateVacancies.callArgs))
+	copy(argCopy, mmCreateVacancies.callArgs)
+
+	mmCreateVacancies.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockCreateVacanciesDone returns true if the count of the CreateVacancies invocations corresponds
+// the number of defined expectations
+func (m *VacancyStorageMock) MinimockCreateVacanciesDone() bool {
+	if m.CreateVacanciesMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.CreateVacanciesMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.CreateVacanciesMock.invocationsDone()
+}
+
+// MinimockCreateVacanciesInspect logs each unmet expectation
+func (m *VacancyStorageMock) MinimockCreateVacanciesInspect() {
+	for _, e := range m.CreateVacanciesMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to VacancyStorageMock.CreateVacancies at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterCreateVacanciesCounter := mm_atomic.LoadUint64(&m.afterCreateVacanciesCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.CreateVacanciesMock.defaultExpectation != nil && afterCreateVacanciesCounter < 1 {
+		if m.CreateVacanciesMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to VacancyStorageMock.CreateVacancies at\n%s", m.CreateVacanciesMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to VacancyStorageMock.CreateVacancies at\n%s with params: %#v", m.CreateVacanciesMock.defaultExpectation.expectationOrigins.origin, *m.CreateVacanciesMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcCreateVacancies != nil && afterCreateVacanciesCounter < 1 {
+		m.t.Errorf("Expected call to VacancyStorageMock.CreateVacancies at\n%s", m.funcCreateVacanciesOrigin)
+	}
+
+	if !m.CreateVacanciesMock.invocationsDone() && afterCreateVacanciesCounter > 0 {
+		m.t.Errorf("Expected %d calls to VacancyStorageMock.CreateVacancies at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.CreateVacanciesMock.expectedInvocations), m.CreateVacanciesMock.expectedInvocationsOrigin, afterCreateVacanciesCounter)
+	}
+}
+
+type mVacancyStorageMockCreateVacancy struct {
+	optional           bool
+	mock               *VacancyStorageMock
+	defaultExpectation *VacancyStorageMockCreateVacancyExpectation
+	expectations       []*VacancyStorageMockCreateVacancyExpectation
+
+	callArgs []*VacancyStorageMockCreateVacancyParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// VacancyStorageMockCreateVacancyExpectation specifies expectation struct of the VacancyStorage.CreateVacancy
+type VacancyStorageMockCreateVacancyExpectation struct {
+	mock               *VacancyStorageMock
+	params             *VacancyStorageMockCreateVacancyParams
+	paramPtrs          *VacancyStorageMockCreateVacancyParamPtrs
+	expectationOrigins VacancyStorageMockCreateVacancyExpectationOrigins
+	results            *VacancyStorageMockCreateVacancyResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// VacancyStorageMockCreateVacancyParams contains parameters of the VacancyStorage.CreateVacancy
+type VacancyStorageMockCreateVacancyParams struct {
+	ctx context.Context
+	in  domain.CreateVacancyInput
+}
+
+// VacancyStorageMockCreateVacancyParamPtrs contains pointers to parameters of the VacancyStorage.CreateVacancy
+type VacancyStorageMockCreateVacancyParamPtrs struct {
+	ctx *context.Context
+	in  *domain.CreateVacancyInput
+}
+
+// VacancyStorageMockCreateVacancyResults contains results of the VacancyStorage.CreateVacancy
+type VacancyStorageMockCreateVacancyResults struct {
+	vp1 *domain.Vacancy
+	err error
+}
+
+// VacancyStorageMockCreateVacancyOrigins contains origins of expectations of the VacancyStorage.CreateVacancy
+type VacancyStorageMockCreateVacancyExpectationOrigins struct {
+	origin    string
+	originCtx string
+	originIn  string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmCreateVacancy *mVacancyStorageMockCreateVacancy) Optional() *mVacancyStorageMockCreateVacancy {
+	mmCreateVacancy.optional = true
+	return mmCreateVacancy
+}
+
+// Expect sets up expected params for VacancyStorage.CreateVacancy
+func (mmCreateVacancy *mVacancyStorageMockCreateVacancy) Expect(ctx context.Context, in domain.CreateVacancyInput) *mVacancyStorageMockCreateVacancy {
+	if mmCreateVacancy.mock.funcCreateVacancy != nil {
+		mmCreateVacancy.mock.t.Fatalf("VacancyStorageMock.CreateVacancy mock is already set by Set")
+	}
+
+	if mmCreateVacancy.defaultExpectation == nil {
+		mmCreateVacancy.defaultExpectation = &VacancyStorageMockCreateVacancyExpectation{}
+	}
+
+	if mmCreateVacancy.defaultExpectation.paramPtrs != nil {
+		mmCreateVacancy.mock.t.Fatalf("VacancyStorageMock.CreateVacancy mock is already set by ExpectParams functions")
+	}
+
+	mmCreateVacancy.defaultExpectation.params = &VacancyStorageMockCreateVacancyParams{ctx, in}
+	mmCreateVacancy.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmCreateVacancy.expectations {
+		if minimock.Equal(e.params, mmCreateVacancy.defaultExpectation.params) {
+			mmCreateVacancy.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmCreateVacancy.defaultExpectation.params)
+		}
+	}
+
+	return mmCreateVacancy
+}
+
+// ExpectCtxParam1 sets up expected param ctx for VacancyStorage.CreateVacancy
+func (mmCreateVacancy *mVacancyStorageMockCreateVacancy) ExpectCtxParam1(ctx context.Context) *mVacancyStorageMockCreateVacancy {
+	if mmCreateVacancy.mock.funcCreateVacancy != nil {
+		mmCreateVacancy.mock.t.Fatalf("VacancyStorageMock.CreateVacancy mock is already set by Set")
+	}
+
+	if mmCreateVacancy.defaultExpectation == nil {
+		mmCreateVacancy.defaultExpectation = &VacancyStorageMockCreateVacancyExpectation{}
+	}
+
+	if mmCreateVacancy.defaultExpectation.params != nil {
+		mmCreateVacancy.mock.t.Fatalf("VacancyStorageMock.CreateVacancy mock is already set by Expect")
+	}
+
+	if mmCreateVacancy.defaultExpectation.paramPtrs == nil {
+		mmCreateVacancy.defaultExpectation.paramPtrs = &VacancyStorageMockCreateVacancyParamPtrs{}
+	}
+	mmCreateVacancy.defaultExpectation.paramPtrs.ctx = &ctx
+	mmCreateVacancy.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmCreateVacancy
+}
+
+// ExpectInParam2 sets up expected param in for VacancyStorage.CreateVacancy
+func (mmCreateVacancy *mVacancyStorageMockCreateVacancy) ExpectInParam2(in domain.CreateVacancyInput) *mVacancyStorageMockCreateVacancy {
+	if mmCreateVacancy.mock.funcCreateVacancy != nil {
+		mmCreateVacancy.mock.t.Fatalf("VacancyStorageMock.CreateVacancy mock is already set by Set")
+	}
+
+	if mmCreateVacancy.defaultExpectation == nil {
+		mmCreateVacancy.defaultExpectation = &VacancyStorageMockCreateVacancyExpectation{}
+	}
+
+	if mmCreateVacancy.defaultExpectation.params != nil {
+		mmCreateVacancy.mock.t.Fatalf("VacancyStorageMock.CreateVacancy mock is already set by Expect")
+	}
+
+	if mmCreateVacancy.defaultExpectation.paramPtrs == nil {
+		mmCreateVacancy.defaultExpectation.paramPtrs = &VacancyStorageMockCreateVacancyParamPtrs{}
+	}
+	mmCreateVacancy.defaultExpectation.paramPtrs.in = &in
+	mmCreateVacancy.defaultExpectation.expectationOrigins.originIn = minimock.CallerInfo(1)
+
+	return mmCreateVacancy
+}
+
+// Inspect accepts an inspector function that has same arguments as the VacancyStorage.CreateVacancy
+func (mmCreateVacancy *mVacancyStorageMockCreateVacancy) Inspect(f func(ctx context.Context, in domain.CreateVacancyInput)) *mVacancyStorageMockCreateVacancy {
+	if mmCreateVacancy.mock.inspectFuncCreateVacancy != nil {
+		mmCreateVacancy.mock.t.Fatalf("Inspect function is already set for VacancyStorageMock.CreateVacancy")
+	}
+
+	mmCreateVacancy.mock.inspectFuncCreateVacancy = f
+
+	return mmCreateVacancy
+}
+
+// Return sets up results that will be returned by VacancyStorage.CreateVacancy
+func (mmCreateVacancy *mVacancyStorageMockCreateVacancy) Return(vp1 *domain.Vacancy, err error) *VacancyStorageMock {
+	if mmCreateVacancy.mock.funcCreateVacancy != nil {
+		mmCreateVacancy.mock.t.Fatalf("VacancyStorageMock.CreateVacancy mock is already set by Set")
+	}
+
+	if mmCreateVacancy.defaultExpectation == nil {
+		mmCreateVacancy.defaultExpectation = &VacancyStorageMockCreateVacancyExpectation{mock: mmCreateVacancy.mock}
+	}
+	mmCreateVacancy.defaultExpectation.results = &VacancyStorageMockCreateVacancyResults{vp1, err}
+	mmCreateVacancy.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmCreateVacancy.mock
+}
+
+// Set uses given function f to mock the VacancyStorage.CreateVacancy method
+func (mmCreateVacancy *mVacancyStorageMockCreateVacancy) Set(f func(ctx context.Context, in domain.CreateVacancyInput) (vp1 *domain.Vacancy, err error)) *VacancyStorageMock {
+	if mmCreateVacancy.defaultExpectation != nil {
+		mmCreateVacancy.mock.t.Fatalf("Default expectation is already set for the VacancyStorage.CreateVacancy method")
+	}
+
+	if len(mmCreateVacancy.expectations) > 0 {
+		mmCreateVacancy.mock.t.Fatalf("Some expectations are already set for the VacancyStorage.CreateVacancy method")
+	}
+
+	mmCreateVacancy.mock.funcCreateVacancy = f
+	mmCreateVacancy.mock.funcCreateVacancyOrigin = minimock.CallerInfo(1)
+	return mmCreateVacancy.mock
+}
+
+// When sets expectation for the VacancyStorage.CreateVacancy which will trigger the result defined by the following
+// Then helper
+func (mmCreateVacancy *mVacancyStorageMockCreateVacancy) When(ctx context.Context, in domain.CreateVacancyInput) *VacancyStorageMockCreateVacancyExpectation {
+	if mmCreateVacancy.mock.funcCreateVacancy != nil {
+		mmCreateVacancy.mock.t.Fatalf("VacancyStorageMock.CreateVacancy mock is already set by Set")
+	}
+
+	expectation := &VacancyStorageMockCreateVacancyExpectation{
+		mock:               mmCreateVacancy.mock,
+		params:             &VacancyStorageMockCreateVacancyParams{ctx, in},
+		expectationOrigins: VacancyStorageMockCreateVacancyExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmCreateVacancy.expectations = append(mmCreateVacancy.expectations, expectation)
+	return expectation
+}
+
+// Then sets up VacancyStorage.CreateVacancy return parameters for the expectation previously defined by the When method
+func (e *VacancyStorageMockCreateVacancyExpectation) Then(vp1 *domain.Vacancy, err error) *VacancyStorageMock {
+	e.results = &VacancyStorageMockCreateVacancyResults{vp1, err}
+	return e.mock
+}
+
+// Times sets number of times VacancyStorage.CreateVacancy should be invoked
+func (mmCreateVacancy *mVacancyStorageMockCreateVacancy) Times(n uint64) *mVacancyStorageMockCreateVacancy {
+	if n == 0 {
+		mmCreateVacancy.mock.t.Fatalf("Times of VacancyStorageMock.CreateVacancy mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmCreateVacancy.expectedInvocations, n)
+	mmCreateVacancy.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmCreateVacancy
+}
+
+func (mmCreateVacancy *mVacancyStorageMockCreateVacancy) invocationsDone() bool {
+	if len(mmCreateVacancy.expectations) == 0 && mmCreateVacancy.defaultExpectation == nil && mmCreateVacancy.mock.funcCreateVacancy == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmCreateVacancy.mock.afterCreateVacancyCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmCreateVacancy.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// CreateVacancy implements mm_usecase.VacancyStorage
+func (mmCreateVacancy *VacancyStorageMock) CreateVacancy(ctx context.Context, in domain.CreateVacancyInput) (vp1 *domain.Vacancy, err error) {
+	mm_atomic.AddUint64(&mmCreateVacancy.beforeCreateVacancyCounter, 1)
+	defer mm_atomic.AddUint64(&mmCreateVacancy.afterCreateVacancyCounter, 1)
+
+	mmCreateVacancy.t.Helper()
+
+	if mmCreateVacancy.inspectFuncCreateVacancy != nil {
+		mmCreateVacancy.inspectFuncCreateVacancy(ctx, in)
+	}
+
+	mm_params := VacancyStorageMockCreateVacancyParams{ctx, in}
+
+	// Record call args
+	mmCreateVacancy.CreateVacancyMock.mutex.Lock()
+	mmCreateVacancy.CreateVacancyMock.callArgs = append(mmCreateVacancy.CreateVacancyMock.callArgs, &mm_params)
+	mmCreateVacancy.CreateVacancyMock.mutex.Unlock()
+
+	for _, e := range mmCreateVacancy.CreateVacancyMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.vp1, e.results.err
+		}
+	}
+
+	if mmCreateVacancy.CreateVacancyMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmCreateVacancy.CreateVacancyMock.defaultExpectation.Counter, 1)
+		mm_want := mmCreateVacancy.CreateVacancyMock.defaultExpectation.params
+		mm_want_ptrs := mmCreateVacancy.CreateVacancyMock.defaultExpectation.paramPtrs
+
+		mm_got := VacancyStorageMockCreateVacancyParams{ctx, in}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmCreateVacancy.t.Errorf("VacancyStorageMock.CreateVacancy got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmCreateVacancy.CreateVacancyMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.in != nil && !minimock.Equal(*mm_want_ptrs.in, mm_got.in) {
+				mmCreateVacancy.t.Errorf("VacancyStorageMock.CreateVacancy got unexpected parameter in, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmCreateVacancy.CreateVacancyMock.defaultExpectation.expectationOrigins.originIn, *mm_want_ptrs.in, mm_got.in, minimock.Diff(*mm_want_ptrs.in, mm_got.in))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmCreateVacancy.t.Errorf("VacancyStorageMock.CreateVacancy got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmCreateVacancy.CreateVacancyMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmCreateVacancy.CreateVacancyMock.defaultExpectation.results
+		if mm_results == nil {
+			mmCreateVacancy.t.Fatal("No results are set for the VacancyStorageMock.CreateVacancy")
+		}
+		return (*mm_results).vp1, (*mm_results).err
+	}
+	if mmCreateVacancy.funcCreateVacancy != nil {
+		return mmCreateVacancy.funcCreateVacancy(ctx, in)
+	}
+	mmCreateVacancy.t.Fatalf("Unexpected call to VacancyStorageMock.CreateVacancy. %v %v", ctx, in)
+	return
+}
+
+// CreateVacancyAfterCounter returns a count of finished VacancyStorageMock.CreateVacancy invocations
+func (mmCreateVacancy *VacancyStorageMock) CreateVacancyAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmCreateVacancy.afterCreateVacancyCounter)
+}
+
+// CreateVacancyBeforeCounter returns a count of VacancyStorageMock.CreateVacancy invocations
+func (mmCreateVacancy *VacancyStorageMock) CreateVacancyBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmCreateVacancy.beforeCreateVacancyCounter)
+}
+
+// Calls returns a list of arguments used in each call to VacancyStorageMock.CreateVacancy.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmCreateVacancy *mVacancyStorageMockCreateVacancy) Calls() []*VacancyStorageMockCreateVacancyParams {
+	mmCreateVacancy.mutex.RLock()
+
+	argCopy := make([]*VacancyStorageMockCreateVacancyParams, len(mmCreateVacancy.callArgs))
+	copy(argCopy, mmCreateVacancy.callArgs)
+
+	mmCreateVacancy.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockCreateVacancyDone returns true if the count of the CreateVacancy invocations corresponds
+// the number of defined expectations
+func (m *VacancyStorageMock) MinimockCreateVacancyDone() bool {
+	if m.CreateVacancyMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.CreateVacancyMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.CreateVacancyMock.invocationsDone()
+}
+
+// MinimockCreateVacancyInspect logs each unmet expectation
+func (m *VacancyStorageMock) MinimockCreateVacancyInspect() {
+	for _, e := range m.CreateVacancyMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to VacancyStorageMock.CreateVacancy at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterCreateVacancyCounter := mm_atomic.LoadUint64(&m.afterCreateVacancyCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.CreateVacancyMock.defaultExpectation != nil && afterCreateVacancyCounter < 1 {
+		if m.CreateVacancyMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to VacancyStorageMock.CreateVacancy at\n%s", m.CreateVacancyMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to VacancyStorageMock.CreateVacancy at\n%s with params: %#v", m.CreateVacancyMock.defaultExpectation.expectationOrigins.origin, *m.CreateVacancyMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcCreateVacancy != nil && afterCreateVacancyCounter < 1 {
+		m.t.Errorf("Expected call to VacancyStorageMock.CreateVacancy at\n%s", m.funcCreateVacancyOrigin)
+	}
+
+	if !m.CreateVacancyMock.invocationsDone() && afterCreateVacancyCounter > 0 {
+		m.t.Errorf("Expected %d calls to VacancyStorageMock.CreateVacancy at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.CreateVacancyMock.expectedInvocations), m.CreateVacancyMock.expectedInvocationsOrigin, afterCreateVacancyCounter)
+	}
+}
+
+type mVacancyStorageMockDeleteTemplate struct {
+	optional           bool
+	mock               *VacancyStorageMock
+	defaultExpectation *VacancyStorageMockDeleteTemplateExpectation
+	expectations       []*VacancyStorageMockDeleteTemplateExpectation
+
+	callArgs []*VacancyStorageMockDeleteTemplateParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// VacancyStorageMockDeleteTemplateExpectation specifies expectation struct of the VacancyStorage.DeleteTemplate
+type VacancyStorageMockDeleteTemplateExpectation struct {
+	mock               *VacancyStorageMock
+	params             *VacancyStorageMockDeleteTemplateParams
+	paramPtrs          *VacancyStorageMockDeleteTemplateParamPtrs
+	expectationOrigins VacancyStorageMockDeleteTemplateExpectationOrigins
+	results            *VacancyStorageMockDeleteTemplateResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// VacancyStorageMockDeleteTemplateParams contains parameters of the VacancyStorage.DeleteTemplate
+type VacancyStorageMockDeleteTemplateParams struct {
+	ctx        context.Context
+	templateID string
+}
+
+// VacancyStorageMockDeleteTemplateParamPtrs contains pointers to parameters of the VacancyStorage.DeleteTemplate
+type VacancyStorageMockDeleteTemplateParamPtrs struct {
+	ctx        *context.Context
+	templateID *string
+}
+
+// VacancyStorageMockDeleteTemplateResults contains results of the VacancyStorage.DeleteTemplate
+type VacancyStorageMockDeleteTemplateResults struct {
+	b1  bool
+	err error
+}
+
+// VacancyStorageMockDeleteTemplateOrigins contains origins of expectations of the VacancyStorage.DeleteTemplate
+type VacancyStorageMockDeleteTemplateExpectationOrigins struct {
+	origin           string
+	originCtx        string
+	originTemplateID string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmDeleteTemplate *mVacancyStorageMockDeleteTemplate) Optional() *mVacancyStorageMockDeleteTemplate {
+	mmDeleteTemplate.optional = true
+	return mmDeleteTemplate
+}
+
+// Expect sets up expected params for VacancyStorage.DeleteTemplate
+func (mmDeleteTemplate *mVacancyStorageMockDeleteTemplate) Expect(ctx context.Context, templateID string) *mVacancyStorageMockDeleteTemplate {
+	if mmDeleteTemplate.mock.funcDeleteTemplate != nil {
+		mmDeleteTemplate.mock.t.Fatalf("VacancyStorageMock.DeleteTemplate mock is already set by Set")
+	}
+
+	if mmDeleteTemplate.defaultExpectation == nil {
+		mmDeleteTemplate.defaultExpectation = &VacancyStorageMockDeleteTemplateExpectation{}
+	}
+
+	if mmDeleteTemplate.defaultExpectation.paramPtrs != nil {
+		mmDeleteTemplate.mock.t.Fatalf("VacancyStorageMock.DeleteTemplate mock is already set by ExpectParams functions")
+	}
+
+	mmDeleteTemplate.defaultExpectation.params = &VacancyStorageMockDeleteTemplateParams{ctx, templateID}
+	mmDeleteTemplate.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmDeleteTemplate.expectations {
+		if minimock.Equal(e.params, mmDeleteTemplate.defaultExpectation.params) {
+			mmDeleteTemplate.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmDeleteTemplate.defaultExpectation.params)
+		}
+	}
+
+	return mmDeleteTemplate
+}
+
+// ExpectCtxParam1 sets up expected param ctx for VacancyStorage.DeleteTemplate
+func (mmDeleteTemplate *mVacancyStorageMockDeleteTemplate) ExpectCtxParam1(ctx context.Context) *mVacancyStorageMockDeleteTemplate {
+	if mmDeleteTemplate.mock.funcDeleteTemplate != nil {
+		mmDeleteTemplate.mock.t.Fatalf("VacancyStorageMock.DeleteTemplate mock is already set by Set")
+	}
+
+	if mmDeleteTemplate.defaultExpectation == nil {
+		mmDeleteTemplate.defaultExpectation = &VacancyStorageMockDeleteTemplateExpectation{}
+	}
+
+	if mmDeleteTemplate.defaultExpectation.params != nil {
+		mmDeleteTemplate.mock.t.Fatalf("VacancyStorageMock.DeleteTemplate mock is already set by Expect")
+	}
+
+	if mmDeleteTemplate.defaultExpectation.paramPtrs == nil {
+		mmDeleteTemplate.defaultExpectation.paramPtrs = &VacancyStorageMockDeleteTemplateParamPtrs{}
+	}
+	mmDeleteTemplate.defaultExpectation.paramPtrs.ctx = &ctx
+	mmDeleteTemplate.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmDeleteTemplate
+}
+
+// ExpectTemplateIDParam2 sets up expected param templateID for VacancyStorage.DeleteTemplate
+func (mmDeleteTemplate *mVacancyStorageMockDeleteTemplate) ExpectTemplateIDParam2(templateID string) *mVacancyStorageMockDeleteTemplate {
+	if mmDeleteTemplate.mock.funcDeleteTemplate != nil {
+		mmDeleteTemplate.mock.t.Fatalf("VacancyStorageMock.DeleteTemplate mock is already set by Set")
+	}
+
+	if mmDeleteTemplate.defaultExpectation == nil {
+		mmDeleteTemplate.defaultExpectation = &VacancyStorageMockDeleteTemplateExpectation{}
+	}
+
+	if mmDeleteTemplate.defaultExpectation.params != nil {
+		mmDeleteTemplate.mock.t.Fatalf("VacancyStorageMock.DeleteTemplate mock is already set by Expect")
+	}
+
+	if mmDeleteTemplate.defaultExpectation.paramPtrs == nil {
+		mmDeleteTemplate.defaultExpectation.paramPtrs = &VacancyStorageMockDeleteTemplateParamPtrs{}
+	}
+	mmDeleteTemplate.defaultExpectation.paramPtrs.templateID = &templateID
+	mmDeleteTemplate.defaultExpectation.expectationOrigins.originTemplateID = minimock.CallerInfo(1)
+
+	return mmDeleteTemplate
+}
+
+// Inspect accepts an inspector function that has same arguments as the VacancyStorage.DeleteTemplate
+func (mmDeleteTemplate *mVacancyStorageMockDeleteTemplate) Inspect(f func(ctx context.Context, templateID string)) *mVacancyStorageMockDeleteTemplate {
+	if mmDeleteTemplate.mock.inspectFuncDeleteTemplate != nil {
+		mmDeleteTemplate.mock.t.Fatalf("Inspect function is already set for VacancyStorageMock.DeleteTemplate")
+	}
+
+	mmDeleteTemplate.mock.inspectFuncDeleteTemplate = f
+
+	return mmDeleteTemplate
+}
+
+// Return sets up results that will be returned by VacancyStorage.DeleteTemplate
+func (mmDeleteTemplate *mVacancyStorageMockDeleteTemplate) Return(b1 bool, err error) *VacancyStorageMock {
+	if mmDeleteTemplate.mock.funcDeleteTemplate != nil {
+		mmDeleteTemplate.mock.t.Fatalf("VacancyStorageMock.DeleteTemplate mock is already set by Set")
+	}
+
+	if mmDeleteTemplate.defaultExpectation == nil {
+		mmDeleteTemplate.defaultExpectation = &VacancyStorageMockDeleteTemplateExpectation{mock: mmDeleteTemplate.mock}
+	}
+	mmDeleteTemplate.defaultExpectation.results = &VacancyStorageMockDeleteTemplateResults{b1, err}
+	mmDeleteTemplate.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmDeleteTemplate.mock
+}
+
+// Set uses given function f to mock the VacancyStorage.DeleteTemplate method
+func (mmDeleteTemplate *mVacancyStorageMockDeleteTemplate) Set(f func(ctx context.Context, templateID string) (b1 bool, err error)) *VacancyStorageMock {
+	if mmDeleteTemplate.defaultExpectation != nil {
+		mmDeleteTemplate.mock.t.Fatalf("Default expectation is already set for the VacancyStorage.DeleteTemplate method")
+	}
+
+	if len(mmDeleteTemplate.expectations) > 0 {
+		mmDeleteTemplate.mock.t.Fatalf("Some expectations are already set for the VacancyStorage.DeleteTemplate method")
+	}
+
+	mmDeleteTemplate.mock.funcDeleteTemplate = f
+	mmDeleteTemplate.mock.funcDeleteTemplateOrigin = minimock.CallerInfo(1)
+	return mmDeleteTemplate.mock
+}
+
+// When sets expectation for the VacancyStorage.DeleteTemplate which will trigger the result defined by the following
+// Then helper
+func (mmDeleteTemplate *mVacancyStorageMockDeleteTemplate) When(ctx context.Context, templateID string) *VacancyStorageMockDeleteTemplateExpectation {
+	if mmDeleteTemplate.mock.funcDeleteTemplate != nil {
+		mmDeleteTemplate.mock.t.Fatalf("VacancyStorageMock.DeleteTemplate mock is already set by Set")
+	}
+
+	expectation := &VacancyStorageMockDeleteTemplateExpectation{
+		mock:               mmDeleteTemplate.mock,
+		params:             &VacancyStorageMockDeleteTemplateParams{ctx, templateID},
+		expectationOrigins: VacancyStorageMockDeleteTemplateExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmDeleteTemplate.expectations = append(mmDeleteTemplate.expectations, expectation)
+	return expectation
+}
+
+// Then sets up VacancyStorage.DeleteTemplate return parameters for the expectation previously defined by the When method
+func (e *VacancyStorageMockDeleteTemplateExpectation) Then(b1 bool, err error) *VacancyStorageMock {
+	e.results = &VacancyStorageMockDeleteTemplateResults{b1, err}
+	return e.mock
+}
+
+// Times sets number of times VacancyStorage.DeleteTemplate should be invoked
+func (mmDeleteTemplate *mVacancyStorageMockDeleteTemplate) Times(n uint64) *mVacancyStorageMockDeleteTemplate {
+	if n == 0 {
+		mmDeleteTemplate.mock.t.Fatalf("Times of VacancyStorageMock.DeleteTemplate mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmDeleteTemplate.expectedInvocations, n)
+	mmDeleteTemplate.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmDeleteTemplate
+}
+
+func (mmDeleteTemplate *mVacancyStorageMockDeleteTemplate) invocationsDone() bool {
+	if len(mmDeleteTemplate.expectations) == 0 && mmDeleteTemplate.defaultExpectation == nil && mmDeleteTemplate.mock.funcDeleteTemplate == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmDeleteTemplate.mock.afterDeleteTemplateCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmDeleteTemplate.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// DeleteTemplate implements mm_usecase.VacancyStorage
+func (mmDeleteTemplate *VacancyStorageMock) DeleteTemplate(ctx context.Context, templateID string) (b1 bool, err error) {
+	mm_atomic.AddUint64(&mmDeleteTemplate.beforeDeleteTemplateCounter, 1)
+	defer mm_atomic.AddUint64(&mmDeleteTemplate.afterDeleteTemplateCounter, 1)
+
+	mmDeleteTemplate.t.Helper()
+
+	if mmDeleteTemplate.inspectFuncDeleteTemplate != nil {
+		mmDeleteTemplate.inspectFuncDeleteTemplate(ctx, templateID)
+	}
+
+	mm_params := VacancyStorageMockDeleteTemplateParams{ctx, templateID}
+
+	// Record call args
+	mmDeleteTemplate.DeleteTemplateMock.mutex.Lock()
+	mmDeleteTemplate.DeleteTemplateMock.callArgs = append(mmDeleteTemplate.DeleteTemplateMock.callArgs, &mm_params)
+	mmDeleteTemplate.DeleteTemplateMock.mutex.Unlock()
+
+	for _, e := range mmDeleteTemplate.DeleteTemplateMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.b1, e.results.err
+		}
+	}
+
+	if mmDeleteTemplate.DeleteTemplateMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmDeleteTemplate.DeleteTemplateMock.defaultExpectation.Counter, 1)
+		mm_want := mmDeleteTemplate.DeleteTemplateMock.defaultExpectation.params
+		mm_want_ptrs := mmDeleteTemplate.DeleteTemplateMock.defaultExpectation.paramPtrs
+
+		mm_got := VacancyStorageMockDeleteTemplateParams{ctx, templateID}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmDeleteTemplate.t.Errorf("VacancyStorageMock.DeleteTemplate got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmDeleteTemplate.DeleteTemplateMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.templateID != nil && !minimock.Equal(*mm_want_ptrs.templateID, mm_got.templateID) {
+				mmDeleteTemplate.t.Errorf("VacancyStorageMock.DeleteTemplate got unexpected parameter templateID, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmDeleteTemplate.DeleteTemplateMock.defaultExpectation.expectationOrigins.originTemplateID, *mm_want_ptrs.templateID, mm_got.templateID, minimock.Diff(*mm_want_ptrs.templateID, mm_got.templateID))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmDeleteTemplate.t.Errorf("VacancyStorageMock.DeleteTemplate got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmDeleteTemplate.DeleteTemplateMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmDeleteTemplate.DeleteTemplateMock.defaultExpectation.results
+		if mm_results == nil {
+			mmDeleteTemplate.t.Fatal("No results are set for the VacancyStorageMock.DeleteTemplate")
+		}
+		return (*mm_results).b1, (*mm_results).err
+	}
+	if mmDeleteTemplate.funcDeleteTemplate != nil {
+		return mmDeleteTemplate.funcDeleteTemplate(ctx, templateID)
+	}
+	mmDeleteTemplate.t.Fatalf("Unexpected call to VacancyStorageMock.DeleteTemplate. %v %v", ctx, templateID)
+	return
+}
+
+// DeleteTemplateAfterCounter returns a count of finished VacancyStorageMock.DeleteTemplate invocations
+func (mmDeleteTemplate *VacancyStorageMock) DeleteTemplateAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmDeleteTemplate.afterDeleteTemplateCounter)
+}
+
+// DeleteTemplateBeforeCounter returns a count of VacancyStorageMock.DeleteTemplate invocations
+func (mmDeleteTemplate *VacancyStorageMock) DeleteTemplateBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmDeleteTemplate.beforeDeleteTemplateCounter)
+}
+
+// Calls returns a list of arguments used in each call to VacancyStorageMock.DeleteTemplate.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmDeleteTemplate *mVacancyStorageMockDeleteTemplate) Calls() []*VacancyStorageMockDeleteTemplateParams {
+	mmDeleteTemplate.mutex.RLock()
+
+	argCopy := make([]*VacancyStorageMockDeleteTemplateParams, len(mmDeleteTemplate.callArgs))
+	copy(argCopy, mmDeleteTemplate.callArgs)
+
+	mmDeleteTemplate.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockDeleteTemplateDone returns true if the count of the DeleteTemplate invocations corresponds
+// the number of defined expectations
+func (m *VacancyStorageMock) MinimockDeleteTemplateDone() bool {
+	if m.DeleteTemplateMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.DeleteTemplateMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.DeleteTemplateMock.invocationsDone()
+}
+
+// MinimockDeleteTemplateInspect logs each unmet expectation
+func (m *VacancyStorageMock) MinimockDeleteTemplateInspect() {
+	for _, e := range m.DeleteTemplateMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to VacancyStorageMock.DeleteTemplate at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterDeleteTemplateCounter := mm_atomic.LoadUint64(&m.afterDeleteTemplateCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.DeleteTemplateMock.defaultExpectation != nil && afterDeleteTemplateCounter < 1 {
+		if m.DeleteTemplateMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to VacancyStorageMock.DeleteTemplate at\n%s", m.DeleteTemplateMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to VacancyStorageMock.DeleteTemplate at\n%s with params: %#v", m.DeleteTemplateMock.defaultExpectation.expectationOrigins.origin, *m.DeleteTemplateMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcDeleteTemplate != nil && afterDeleteTemplateCounter < 1 {
+		m.t.Errorf("Expected call to VacancyStorageMock.DeleteTemplate at\n%s", m.funcDeleteTemplateOrigin)
+	}
+
+	if !m.DeleteTemplateMock.invocationsDone() && afterDeleteTemplateCounter > 0 {
+		m.t.Errorf("Expected %d calls to VacancyStorageMock.DeleteTemplate at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.DeleteTemplateMock.expectedInvocations), m.DeleteTemplateMock.expectedInvocationsOrigin, afterDeleteTemplateCounter)
+	}
+}
+
+type mVacancyStorageMockDeleteVacancy struct {
+	optional           bool
+	mock               *VacancyStorageMock
+	defaultExpectation *VacancyStorageMockDeleteVacancyExpectation
+	expectations       []*VacancyStorageMockDeleteVacancyExpectation
+
+	callArgs []*VacancyStorageMockDeleteVacancyParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// VacancyStorageMockDeleteVacancyExpectation specifies expectation struct of the VacancyStorage.DeleteVacancy
+type VacancyStorageMockDeleteVacancyExpectation struct {
+	mock               *VacancyStorageMock
+	params             *VacancyStorageMockDeleteVacancyParams
+	paramPtrs          *VacancyStorageMockDeleteVacancyParamPtrs
+	expectationOrigins VacancyStorageMockDeleteVacancyExpectationOrigins
+	results            *VacancyStorageMockDeleteVacancyResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// VacancyStorageMockDeleteVacancyParams contains parameters of the VacancyStorage.DeleteVacancy
+type VacancyStorageMockDeleteVacancyParams struct {
+	ctx context.Context
+	in  domain.DeleteVacancyInput
+}
+
+// VacancyStorageMockDeleteVacancyParamPtrs contains pointers to parameters of the VacancyStorage.DeleteVacancy
+type VacancyStorageMockDeleteVacancyParamPtrs struct {
+	ctx *context.Context
+	in  *domain.DeleteVacancyInput
+}
+
+// VacancyStorageMockDeleteVacancyResults contains results of the VacancyStorage.DeleteVacancy
+type VacancyStorageMockDeleteVacancyResults struct {
+	b1  bool
+	err error
+}
+
+// VacancyStorageMockDeleteVacancyOrigins contains origins of expectations of the VacancyStorage.DeleteVacancy
+type VacancyStorageMockDeleteVacancyExpectationOrigins struct {
+	origin    string
+	originCtx string
+	originIn  string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmDeleteVacancy *mVacancyStorageMockDeleteVacancy) Optional() *mVacancyStorageMockDeleteVacancy {
+	mmDeleteVacancy.optional = true
+	return mmDeleteVacancy
+}
+
+// Expect sets up expected params for VacancyStorage.DeleteVacancy
+func (mmDeleteVacancy *mVacancyStorageMockDeleteVacancy) Expect(ctx context.Context, in domain.DeleteVacancyInput) *mVacancyStorageMockDeleteVacancy {
+	if mmDeleteVacancy.mock.funcDeleteVacancy != nil {
+		mmDeleteVacancy.mock.t.Fatalf("VacancyStorageMock.DeleteVacancy mock is already set by Set")
+	}
+
+	if mmDeleteVacancy.defaultExpectation == nil {
+		mmDeleteVacancy.defaultExpectation = &VacancyStorageMockDeleteVacancyExpectation{}
+	}
+
+	if mmDeleteVacancy.defaultExpectation.paramPtrs != nil {
+		mmDeleteVacancy.mock.t.Fatalf("VacancyStorageMock.DeleteVacancy mock is already set by ExpectParams functions")
+	}
+
+	mmDeleteVacancy.defaultExpectation.params = &VacancyStorageMockDeleteVacancyParams{ctx, in}
+	mmDeleteVacancy.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmDeleteVacancy.expectations {
+		if minimock.Equal(e.params, mmDeleteVacancy.defaultExpectation.params) {
+			mmDeleteVacancy.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmDeleteVacancy.defaultExpectation.params)
+		}
+	}
+
+	return mmDeleteVacancy
+}
+
+// ExpectCtxParam1 sets up expected param ctx for VacancyStorage.DeleteVacancy
+func (mmDeleteVacancy *mVacancyStorageMockDeleteVacancy) ExpectCtxParam1(ctx context.Context) *mVacancyStorageMockDeleteVacancy {
+	if mmDeleteVacancy.mock.funcDeleteVacancy != nil {
+		mmDeleteVacancy.mock.t.Fatalf("VacancyStorageMock.DeleteVacancy mock is already set by Set")
+	}
+
+	if mmDeleteVacancy.defaultExpectation == nil {
+		mmDeleteVacancy.defaultExpectation = &VacancyStorageMockDeleteVacancyExpectation{}
+	}
+
+	if mmDeleteVacancy.defaultExpectation.params != nil {
+		mmDeleteVacancy.mock.t.Fatalf("VacancyStorageMock.DeleteVacancy mock is already set by Expect")
+	}
+
+	if mmDeleteVacancy.defaultExpectation.paramPtrs == nil {
+		mmDeleteVacancy.defaultExpectation.paramPtrs = &VacancyStorageMockDeleteVacancyParamPtrs{}
+	}
+	mmDeleteVacancy.defaultExpectation.paramPtrs.ctx = &ctx
+	mmDeleteVacancy.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmDeleteVacancy
+}
+
+// ExpectInParam2 sets up expected param in for VacancyStorage.DeleteVacancy
+func (mmDeleteVacancy *mVacancyStorageMockDeleteVacancy) ExpectInParam2(in domain.DeleteVacancyInput) *mVacancyStorageMockDeleteVacancy {
+	if mmDeleteVacancy.mock.funcDeleteVacancy != nil {
+		mmDeleteVacancy.mock.t.Fatalf("VacancyStorageMock.DeleteVacancy mock is already set by Set")
+	}
+
+	if mmDeleteVacancy.defaultExpectation == nil {
+		mmDeleteVacancy.defaultExpectation = &VacancyStorageMockDeleteVacancyExpectation{}
+	}
+
+	if mmDeleteVacancy.defaultExpectation.params != nil {
+		mmDeleteVacancy.mock.t.Fatalf("VacancyStorageMock.DeleteVacancy mock is already set by Expect")
+	}
+
+	if mmDeleteVacancy.defaultExpectation.paramPtrs == nil {
+		mmDeleteVacancy.defaultExpectation.paramPtrs = &VacancyStorageMockDeleteVacancyParamPtrs{}
+	}
+	mmDeleteVacancy.defaultExpectation.paramPtrs.in = &in
+	mmDeleteVacancy.defaultExpectation.expectationOrigins.originIn = minimock.CallerInfo(1)
+
+	return mmDeleteVacancy
+}
+
+// Inspect accepts an inspector function that has same arguments as the VacancyStorage.DeleteVacancy
+func (mmDeleteVacancy *mVacancyStorageMockDeleteVacancy) Inspect(f func(ctx context.Context, in domain.DeleteVacancyInput)) *mVacancyStorageMockDeleteVacancy {
+	if mmDeleteVacancy.mock.inspectFuncDeleteVacancy != nil {
+		mmDeleteVacancy.mock.t.Fatalf("Inspect function is already set for VacancyStorageMock.DeleteVacancy")
+	}
+
+	mmDeleteVacancy.mock.inspectFuncDeleteVacancy = f
+
+	return mmDeleteVacancy
+}
+
+// Return sets up results that will be returned by VacancyStorage.DeleteVacancy
+func (mmDeleteVacancy *mVacancyStorageMockDeleteVacancy) Return(b1 bool, err error) *VacancyStorageMock {
+	if mmDeleteVacancy.mock.funcDeleteVacancy != nil {
+		mmDeleteVacancy.mock.t.Fatalf("VacancyStorageMock.DeleteVacancy mock is already set by Set")
+	}
+
+	if mmDeleteVacancy.defaultExpectation == nil {
+		mmDeleteVacancy.defaultExpectation = &VacancyStorageMockDeleteVacancyExpectation{mock: mmDeleteVacancy.mock}
+	}
+	mmDeleteVacancy.defaultExpectation.results = &VacancyStorageMockDeleteVacancyResults{b1, err}
+	mmDeleteVacancy.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmDeleteVacancy.mock
+}
+
+// Set uses given function f to mock the VacancyStorage.DeleteVacancy method
+func (mmDeleteVacancy *mVacancyStorageMockDeleteVacancy) Set(f func(ctx context.Context, in domain.DeleteVacancyInput) (b1 bool, err error)) *VacancyStorageMock {
+	if mmDeleteVacancy.defaultExpectation != nil {
+		mmDeleteVacancy.mock.t.Fatalf("Default expectation is already set for the VacancyStorage.DeleteVacancy method")
+	}
+
+	if len(mmDeleteVacancy.expectations) > 0 {
+		mmDeleteVacancy.mock.t.Fatalf("Some expectations are already set for the VacancyStorage.DeleteVacancy method")
+	}
+
+	mmDeleteVacancy.mock.funcDeleteVacancy = f
+	mmDeleteVacancy.mock.funcDeleteVacancyOrigin = minimock.CallerInfo(1)
+	return mmDeleteVacancy.mock
+}
+
+// When sets expectation for the VacancyStorage.DeleteVacancy which will trigger the result defined by the following
+// Then helper
+func (mmDeleteVacancy *mVacancyStorageMockDeleteVacancy) When(ctx context.Context, in domain.DeleteVacancyInput) *VacancyStorageMockDeleteVacancyExpectation {
+	if mmDeleteVacancy.mock.funcDeleteVacancy != nil {
+		mmDeleteVacancy.mock.t.Fatalf("VacancyStorageMock.DeleteVacancy mock is already set by Set")
+	}
+
+	expectation := &VacancyStorageMockDeleteVacancyExpectation{
+		mock:               mmDeleteVacancy.mock,
+		params:             &VacancyStorageMockDeleteVacancyParams{ctx, in},
+		expectationOrigins: VacancyStorageMockDeleteVacancyExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmDeleteVacancy.expectations = append(mmDeleteVacancy.expectations, expectation)
+	return expectation
+}
+
+// Then sets up VacancyStorage.DeleteVacancy return parameters for the expectation previously defined by the When method
+func (e *VacancyStorageMockDeleteVacancyExpectation) Then(b1 bool, err error) *VacancyStorageMock {
+	e.results = &VacancyStorageMockDeleteVacancyResults{b1, err}
+	return e.mock
+}
+
+// Times sets number of times VacancyStorage.DeleteVacancy should be invoked
+func (mmDeleteVacancy *mVacancyStorageMockDeleteVacancy) Times(n uint64) *mVacancyStorageMockDeleteVacancy {
+	if n == 0 {
+		mmDeleteVacancy.mock.t.Fatalf("Times of VacancyStorageMock.DeleteVacancy mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmDeleteVacancy.expectedInvocations, n)
+	mmDeleteVacancy.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmDeleteVacancy
+}
+
+func (mmDeleteVacancy *mVacancyStorageMockDeleteVacancy) invocationsDone() bool {
+	if len(mmDeleteVacancy.expectations) == 0 && mmDeleteVacancy.defaultExpectation == nil && mmDeleteVacancy.mock.funcDeleteVacancy == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmDeleteVacancy.mock.afterDeleteVacancyCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmDeleteVacancy.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// DeleteVacancy implements mm_usecase.VacancyStorage
+func (mmDeleteVacancy *VacancyStorageMock) DeleteVacancy(ctx context.Context, in domain.DeleteVacancyInput) (b1 bool, err error) {
+	mm_atomic.AddUint64(&mmDeleteVacancy.beforeDeleteVacancyCounter, 1)
+	defer mm_atomic.AddUint64(&mmDeleteVacancy.afterDeleteVacancyCounter, 1)
+
+	mmDeleteVacancy.t.Helper()
+
+	if mmDeleteVacancy.inspectFuncDeleteVacancy != nil {
+		mmDeleteVacancy.inspectFuncDeleteVacancy(ctx, in)
+	}
+
+	mm_params := VacancyStorageMockDeleteVacancyParams{ctx, in}
+
+	// Record call args
+	mmDeleteVacancy.DeleteVacancyMock.mutex.Lock()
+	mmDeleteVacancy.DeleteVacancyMock.callArgs = append(mmDeleteVacancy.DeleteVacancyMock.callArgs, &mm_params)
+	mmDeleteVacancy.DeleteVacancyMock.mutex.Unlock()
+
+	for _, e := range mmDeleteVacancy.DeleteVacancyMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.b1, e.results.err
+		}
+	}
+
+	if mmDeleteVacancy.DeleteVacancyMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmDeleteVacancy.DeleteVacancyMock.defaultExpectation.Counter, 1)
+		mm_want := mmDeleteVacancy.DeleteVacancyMock.defaultExpectation.params
+		mm_want_ptrs := mmDeleteVacancy.DeleteVacancyMock.defaultExpectation.paramPtrs
+
+		mm_got := VacancyStorageMockDeleteVacancyParams{ctx, in}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmDeleteVacancy.t.Errorf("VacancyStorageMock.DeleteVacancy got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmDeleteVacancy.DeleteVacancyMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.in != nil && !minimock.Equal(*mm_want_ptrs.in, mm_got.in) {
+				mmDeleteVacancy.t.Errorf("VacancyStorageMock.DeleteVacancy got unexpected parameter in, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmDeleteVacancy.DeleteVacancyMock.defaultExpectation.expectationOrigins.originIn, *mm_want_ptrs.in, mm_got.in, minimock.Diff(*mm_want_ptrs.in, mm_got.in))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmDeleteVacancy.t.Errorf("VacancyStorageMock.DeleteVacancy got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmDeleteVacancy.DeleteVacancyMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmDeleteVacancy.DeleteVacancyMock.defaultExpectation.results
+		if mm_results == nil {
+			mmDeleteVacancy.t.Fatal("No results are set for the VacancyStorageMock.DeleteVacancy")
+		}
+		return (*mm_results).b1, (*mm_results).err
+	}
+	if mmDeleteVacancy.funcDeleteVacancy != nil {
+		return mmDeleteVacancy.funcDeleteVacancy(ctx, in)
+	}
+	mmDeleteVacancy.t.Fatalf("Unexpected call to VacancyStorageMock.DeleteVacancy. %v %v", ctx, in)
+	return
+}
+
+// DeleteVacancyAfterCounter returns a count of finished VacancyStorageMock.DeleteVacancy invocations
+func (mmDeleteVacancy *VacancyStorageMock) DeleteVacancyAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmDeleteVacancy.afterDeleteVacancyCounter)
+}
+
+// DeleteVacancyBeforeCounter returns a count of VacancyStorageMock.DeleteVacancy invocations
+func (mmDeleteVacancy *VacancyStorageMock) DeleteVacancyBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmDeleteVacancy.beforeDeleteVacancyCounter)
+}
+
+// Calls returns a list of arguments used in each call to VacancyStorageMock.DeleteVacancy.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmDeleteVacancy *mVacancyStorageMockDeleteVacancy) Calls() []*VacancyStorageMockDeleteVacancyParams {
+	mmDeleteVacancy.mutex.RLock()
+
+	argCopy := make([]*VacancyStorageMockDeleteVacancyParams, len(mmDeleteVacancy.callArgs))
+	copy(argCopy, mmDeleteVacancy.callArgs)
+
+	mmDeleteVacancy.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockDeleteVacancyDone returns true if the count of the DeleteVacancy invocations corresponds
+// the number of defined expectations
+func (m *VacancyStorageMock) MinimockDeleteVacancyDone() bool {
+	if m.DeleteVacancyMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.DeleteVacancyMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.DeleteVacancyMock.invocationsDone()
+}
+
+// MinimockDeleteVacancyInspect logs each unmet expectation
+func (m *VacancyStorageMock) MinimockDeleteVacancyInspect() {
+	for _, e := range m.DeleteVacancyMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to VacancyStorageMock.DeleteVacancy at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterDeleteVacancyCounter := mm_atomic.LoadUint64(&m.afterDeleteVacancyCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.DeleteVacancyMock.defaultExpectation != nil && afterDeleteVacancyCounter < 1 {
+		if m.DeleteVacancyMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to VacancyStorageMock.DeleteVacancy at\n%s", m.DeleteVacancyMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to VacancyStorageMock.DeleteVacancy at\n%s with params: %#v", m.DeleteVacancyMock.defaultExpectation.expectationOrigins.origin, *m.DeleteVacancyMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcDeleteVacancy != nil && afterDeleteVacancyCounter < 1 {
+		m.t.Errorf("Expected call to VacancyStorageMock.DeleteVacancy at\n%s", m.funcDeleteVacancyOrigin)
+	}
+
+	if !m.DeleteVacancyMock.invocationsDone() && afterDeleteVacancyCounter > 0 {
+		m.t.Errorf("Expected %d calls to VacancyStorageMock.DeleteVacancy at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.DeleteVacancyMock.expectedInvocations), m.DeleteVacancyMock.expectedInvocationsOrigin, afterDeleteVacancyCounter)
+	}
+}
+
+type mVacancyStorageMockGetCandidateStage struct {
+	optional           bool
+	mock               *VacancyStorageMock
+	defaultExpectation *VacancyStorageMockGetCandidateStageExpectation
+	expectations       []*VacancyStorageMockGetCandidateStageExpectation
+
+	callArgs []*VacancyStorageMockGetCandidateStageParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// VacancyStorageMockGetCandidateStageExpectation specifies expectation struct of the VacancyStorage.GetCandidateStage
+type VacancyStorageMockGetCandidateStageExpectation struct {
+	mock               *VacancyStorageMock
+	params             *VacancyStorageMockGetCandidateStageParams
+	paramPtrs          *VacancyStorageMockGetCandidateStageParamPtrs
+	expectationOrigins VacancyStorageMockGetCandidateStageExpectationOrigins
+	results            *VacancyStorageMockGetCandidateStageResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// VacancyStorageMockGetCandidateStageParams contains parameters of the VacancyStorage.GetCandidateStage
+type VacancyStorageMockGetCandidateStageParams struct {
+	ctx         context.Context
+	vacancyID   string
+	candidateID string
+}
+
+// VacancyStorageMockGetCandidateStageParamPtrs contains pointers to parameters of the VacancyStorage.GetCandidateStage
+type VacancyStorageMockGetCandidateStageParamPtrs struct {
+	ctx         *context.Context
+	vacancyID   *string
+	candidateID *string
+}
+
+// VacancyStorageMockGetCandidateStageResults contains results of the VacancyStorage.GetCandidateStage
+type VacancyStorageMockGetCandidateStageResults struct {
+	s1  string
+	err error
+}
+
+// VacancyStorageMockGetCandidateStageOrigins contains origins of expectations of the VacancyStorage.GetCandidateStage
+type VacancyStorageMockGetCandidateStageExpectationOrigins struct {
+	origin            string
+	originCtx         string
+	originVacancyID   string
+	originCandidateID string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmGetCandidateStage *mVacancyStorageMockGetCandidateStage) Optional() *mVacancyStorageMockGetCandidateStage {
+	mmGetCandidateStage.optional = true
+	return mmGetCandidateStage
+}
+
+// Expect sets up expected params for VacancyStorage.GetCandidateStage
+func (mmGetCandidateStage *mVacancyStorageMockGetCandidateStage) Expect(ctx context.Context, vacancyID string, candidateID string) *mVacancyStorageMockGetCandidateStage {
+	if mmGetCandidateStage.mock.funcGetCandidateStage != nil {
+		mmGetCandidateStage.mock.t.Fatalf("VacancyStorageMock.GetCandidateStage mock is already set by Set")
+	}
+
+	if mmGetCandidateStage.defaultExpectation == nil {
+		mmGetCandidateStage.defaultExpectation = &VacancyStorageMockGetCandidateStageExpectation{}
+	}
+
+	if mmGetCandidateStage.defaultExpectation.paramPtrs != nil {
+		mmGetCandidateStage.mock.t.Fatalf("VacancyStorageMock.GetCandidateStage mock is already set by ExpectParams functions")
+	}
+
+	mmGetCandidateStage.defaultExpectation.params = &VacancyStorageMockGetCandidateStageParams{ctx, vacancyID, candidateID}
+	mmGetCandidateStage.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmGetCandidateStage.expectations {
+		if minimock.Equal(e.params, mmGetCandidateStage.defaultExpectation.params) {
+			mmGetCandidateStage.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmGetCandidateStage.defaultExpectation.params)
+		}
+	}
+
+	return mmGetCandidateStage
+}
+
+// ExpectCtxParam1 sets up expected param ctx for VacancyStorage.GetCandidateStage
+func (mmGetCandidateStage *mVacancyStorageMockGetCandidateStage) ExpectCtxParam1(ctx context.Context) *mVacancyStorageMockGetCandidateStage {
+	if mmGetCandidateStage.mock.funcGetCandidateStage != nil {
+		mmGetCandidateStage.mock.t.Fatalf("VacancyStorageMock.GetCandidateStage mock is already set by Set")
+	}
+
+	if mmGetCandidateStage.defaultExpectation == nil {
+		mmGetCandidateStage.defaultExpectation = &VacancyStorageMockGetCandidateStageExpectation{}
+	}
+
+	if mmGetCandidateStage.defaultExpectation.params != nil {
+		mmGetCandidateStage.mock.t.Fatalf("VacancyStorageMock.GetCandidateStage mock is already set by Expect")
+	}
+
+	if mmGetCandidateStage.defaultExpectation.paramPtrs == nil {
+		mmGetCandidateStage.defaultExpectation.paramPtrs = &VacancyStorageMockGetCandidateStageParamPtrs{}
+	}
+	mmGetCandidateStage.defaultExpectation.paramPtrs.ctx = &ctx
+	mmGetCandidateStage.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmGetCandidateStage
+}
+
+// ExpectVacancyIDParam2 sets up expected param vacancyID for VacancyStorage.GetCandidateStage
+func (mmGetCandidateStage *mVacancyStorageMockGetCandidateStage) ExpectVacancyIDParam2(vacancyID string) *mVacancyStorageMockGetCandidateStage {
+	if mmGetCandidateStage.mock.funcGetCandidateStage != nil {
+		mmGetCandidateStage.mock.t.Fatalf("VacancyStorageMock.GetCandidateStage mock is already set by Set")
+	}
+
+	if mmGetCandidateStage.defaultExpectation == nil {
+		mmGetCandidateStage.defaultExpectation = &VacancyStorageMockGetCandidateStageExpectation{}
+	}
+
+	if mmGetCandidateStage.defaultExpectation.params != nil {
+		mmGetCandidateStage.mock.t.Fatalf("VacancyStorageMock.GetCandidateStage mock is already set by Expect")
+	}
+
+	if mmGetCandidateStage.defaultExpectation.paramPtrs == nil {
+		mmGetCandidateStage.defaultExpectation.paramPtrs = &VacancyStorageMockGetCandidateStageParamPtrs{}
+	}
+	mmGetCandidateStage.defaultExpectation.paramPtrs.vacancyID = &vacancyID
+	mmGetCandidateStage.defaultExpectation.expectationOrigins.originVacancyID = minimock.CallerInfo(1)
+
+	return mmGetCandidateStage
+}
+
+// ExpectCandidateIDParam3 sets up expected param candidateID for VacancyStorage.GetCandidateStage
+func (mmGetCandidateStage *mVacancyStorageMockGetCandidateStage) ExpectCandidateIDParam3(candidateID string) *mVacancyStorageMockGetCandidateStage {
+	if mmGetCandidateStage.mock.funcGetCandidateStage != nil {
+		mmGetCandidateStage.mock.t.Fatalf("VacancyStorageMock.GetCandidateStage mock is already set by Set")
+	}
+
+	if mmGetCandidateStage.defaultExpectation == nil {
+		mmGetCandidateStage.defaultExpectation = &VacancyStorageMockGetCandidateStageExpectation{}
+	}
+
+	if mmGetCandidateStage.defaultExpectation.params != nil {
+		mmGetCandidateStage.mock.t.Fatalf("VacancyStorageMock.GetCandidateStage mock is already set by Expect")
+	}
+
+	if mmGetCandidateStage.defaultExpectation.paramPtrs == nil {
+		mmGetCandidateStage.defaultExpectation.paramPtrs = &VacancyStorageMockGetCandidateStageParamPtrs{}
+	}
+	mmGetCandidateStage.defaultExpectation.paramPtrs.candidateID = &candidateID
+	mmGetCandidateStage.defaultExpectation.expectationOrigins.originCandidateID = minimock.CallerInfo(1)
+
+	return mmGetCandidateStage
+}
+
+// Inspect accepts an inspector function that has same arguments as the VacancyStorage.GetCandidateStage
+func (mmGetCandidateStage *mVacancyStorageMockGetCandidateStage) Inspect(f func(ctx context.Context, vacancyID string, candidateID string)) *mVacancyStorageMockGetCandidateStage {
+	if mmGetCandidateStage.mock.inspectFuncGetCandidateStage != nil {
+		mmGetCandidateStage.mock.t.Fatalf("Inspect function is already set for VacancyStorageMock.GetCandidateStage")
+	}
+
+	mmGetCandidateStage.mock.inspectFuncGetCandidateStage = f
+
+	return mmGetCandidateStage
+}
+
+// Return sets up results that will be returned by VacancyStorage.GetCandidateStage
+func (mmGetCandidateStage *mVacancyStorageMockGetCandidateStage) Return(s1 string, err error) *VacancyStorageMock {
+	if mmGetCandidateStage.mock.funcGetCandidateStage != nil {
+		mmGetCandidateStage.mock.t.Fatalf("VacancyStorageMock.GetCandidateStage mock is already set by Set")
+	}
+
+	if mmGetCandidateStage.defaultExpectation == nil {
+		mmGetCandidateStage.defaultExpectation = &VacancyStorageMockGetCandidateStageExpectation{mock: mmGetCandidateStage.mock}
+	}
+	mmGetCandidateStage.defaultExpectation.results = &VacancyStorageMockGetCandidateStageResults{s1, err}
+	mmGetCandidateStage.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmGetCandidateStage.mock
+}
+
+// Set uses given function f to mock the VacancyStorage.GetCandidateStage method
+func (mmGetCandidateStage *mVacancyStorageMockGetCandidateStage) Set(f func(ctx context.Context, vacancyID string, candidateID string) (s1 string, err error)) *VacancyStorageMock {
+	if mmGetCandidateStage.defaultExpectation != nil {
+		mmGetCandidateStage.mock.t.Fatalf("Default expectation is already set for the VacancyStorage.GetCandidateStage method")
+	}
+
+	if len(mmGetCandidateStage.expectations) > 0 {
+		mmGetCandidateStage.mock.t.Fatalf("Some expectations are already set for the VacancyStorage.GetCandidateStage method")
+	}
+
+	mmGetCandidateStage.mock.funcGetCandidateStage = f
+	mmGetCandidateStage.mock.funcGetCandidateStageOrigin = minimock.CallerInfo(1)
+	return mmGetCandidateStage.mock
+}
+
+// When sets expectation for the VacancyStorage.GetCandidateStage which will trigger the result defined by the following
+// Then helper
+func (mmGetCandidateStage *mVacancyStorageMockGetCandidateStage) When(ctx context.Context, vacancyID string, candidateID string) *VacancyStorageMockGetCandidateStageExpectation {
+	if mmGetCandidateStage.mock.funcGetCandidateStage != nil {
+		mmGetCandidateStage.mock.t.Fatalf("VacancyStorageMock.GetCandidateStage mock is already set by Set")
+	}
+
+	expectation := &VacancyStorageMockGetCandidateStageExpectation{
+		mock:               mmGetCandidateStage.mock,
+		params:             &VacancyStorageMockGetCandidateStageParams{ctx, vacancyID, candidateID},
+		expectationOrigins: VacancyStorageMockGetCandidateStageExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmGetCandidateStage.expectations = append(mmGetCandidateStage.expectations, expectation)
+	return expectation
+}
+
+// Then sets up VacancyStorage.GetCandidateStage return parameters for the expectation previously defined by the When method
+func (e *VacancyStorageMockGetCandidateStageExpectation) Then(s1 string, err error) *VacancyStorageMock {
+	e.results = &VacancyStorageMockGetCandidateStageResults{s1, err}
+	return e.mock
+}
+
+// Times sets number of times VacancyStorage.GetCandidateStage should be invoked
+func (mmGetCandidateStage *mVacancyStorageMockGetCandidateStage) Times(n uint64) *mVacancyStorageMockGetCandidateStage {
+	if n == 0 {
+		mmGetCandidateStage.mock.t.Fatalf("Times of VacancyStorageMock.GetCandidateStage mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmGetCandidateStage.expectedInvocations, n)
+	mmGetCandidateStage.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmGetCandidateStage
+}
+
+func (mmGetCandidateStage *mVacancyStorageMockGetCandidateStage) invocationsDone() bool {
+	if len(mmGetCandidateStage.expectations) == 0 && mmGetCandidateStage.defaultExpectation == nil && mmGetCandidateStage.mock.funcGetCandidateStage == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmGetCandidateStage.mock.afterGetCandidateStageCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmGetCandidateStage.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// GetCandidateStage implements mm_usecase.VacancyStorage
+func (mmGetCandidateStage *VacancyStorageMock) GetCandidateStage(ctx context.Context, vacancyID string, candidateID string) (s1 string, err error) {
+	mm_atomic.AddUint64(&mmGetCandidateStage.beforeGetCandidateStageCounter, 1)
+	defer mm_atomic.AddUint64(&mmGetCandidateStage.afterGetCandidateStageCounter, 1)
+
+	mmGetCandidateStage.t.Helper()
+
+	if mmGetCandidateStage.inspectFuncGetCandidateStage != nil {
+		mmGetCandidateStage.inspectFuncGetCandidateStage(ctx, vacancyID, candidateID)
+	}
+
+	mm_params := VacancyStorageMockGetCandidateStageParams{ctx, vacancyID, candidateID}
+
+	// Record call args
+	mmGetCandidateStage.GetCandidateStageMock.mutex.Lock()
+	mmGetCandidateStage.GetCandidateStageMock.callArgs = append(mmGetCandidateStage.GetCandidateStageMock.callArgs, &mm_params)
+	mmGetCandidateStage.GetCandidateStageMock.mutex.Unlock()
+
+	for _, e := range mmGetCandidateStage.GetCandidateStageMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.s1, e.results.err
+		}
+	}
+
+	if mmGetCandidateStage.GetCandidateStageMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmGetCandidateStage.GetCandidateStageMock.defaultExpectation.Counter, 1)
+		mm_want := mmGetCandidateStage.GetCandidateStageMock.defaultExpectation.params
+		mm_want_ptrs := mmGetCandidateStage.GetCandidateStageMock.defaultExpectation.paramPtrs
+
+		mm_got := VacancyStorageMockGetCandidateStageParams{ctx, vacancyID, candidateID}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmGetCandidateStage.t.Errorf("VacancyStorageMock.GetCandidateStage got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmGetCandidateStage.GetCandidateStageMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.vacancyID != nil && !minimock.Equal(*mm_want_ptrs.vacancyID, mm_got.vacancyID) {
+				mmGetCandidateStage.t.Errorf("VacancyStorageMock.GetCandidateStage got unexpected parameter vacancyID, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmGetCandidateStage.GetCandidateStageMock.defaultExpectation.expectationOrigins.originVacancyID, *mm_want_ptrs.vacancyID, mm_got.vacancyID, minimock.Diff(*mm_want_ptrs.vacancyID, mm_got.vacancyID))
+			}
+
+			if mm_want_ptrs.candidateID != nil && !minimock.Equal(*mm_want_ptrs.candidateID, mm_got.candidateID) {
+				mmGetCandidateStage.t.Errorf("VacancyStorageMock.GetCandidateStage got unexpected parameter candidateID, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmGetCandidateStage.GetCandidateStageMock.defaultExpectation.expectationOrigins.originCandidateID, *mm_want_ptrs.candidateID, mm_got.candidateID, minimock.Diff(*mm_want_ptrs.candidateID, mm_got.candidateID))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmGetCandidateStage.t.Errorf("VacancyStorageMock.GetCandidateStage got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmGetCandidateStage.GetCandidateStageMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmGetCandidateStage.GetCandidateStageMock.defaultExpectation.results
+		if mm_results == nil {
+			mmGetCandidateStage.t.Fatal("No results are set for the VacancyStorageMock.GetCandidateStage")
+		}
+		return (*mm_results).s1, (*mm_results).err
+	}
+	if mmGetCandidateStage.funcGetCandidateStage != nil {
+		return mmGetCandidateStage.funcGetCandidateStage(ctx, vacancyID, candidateID)
+	}
+	mmGetCandidateStage.t.Fatalf("Unexpected call to VacancyStorageMock.GetCandidateStage. %v %v %v", ctx, vacancyID, candidateID)
+	return
+}
+
+// GetCandidateStageAfterCounter returns a count of finished VacancyStorageMock.GetCandidateStage invocations
+func (mmGetCandidateStage *VacancyStorageMock) GetCandidateStageAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmGetCandidateStage.afterGetCandidateStageCounter)
+}
+
+// GetCandidateStageBeforeCounter returns a count of VacancyStorageMock.GetCandidateStage invocations
+func (mmGetCandidateStage *VacancyStorageMock) GetCandidateStageBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmGetCandidateStage.beforeGetCandidateStageCounter)
+}
+
+// Calls returns a list of arguments used in each call to VacancyStorageMock.GetCandidateStage.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmGetCandidateStage *mVacancyStorageMockGetCandidateStage) Calls() []*VacancyStorageMockGetCandidateStageParams {
+	mmGetCandidateStage.mutex.RLock()
+
+	argCopy := make([]*VacancyStorageMockGetCandidateStageParams, len(mmGetCandidateStage.callArgs))
+	copy(argCopy, mmGetCandidateStage.callArgs)
+
+	mmGetCandidateStage.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockGetCandidateStageDone returns true if the count of the GetCandidateStage invocations corresponds
+// the number of defined expectations
+func (m *VacancyStorageMock) MinimockGetCandidateStageDone() bool {
+	if m.GetCandidateStageMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.GetCandidateStageMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.GetCandidateStageMock.invocationsDone()
+}
+
+// MinimockGetCandidateStageInspect logs each unmet expectation
+func (m *VacancyStorageMock) MinimockGetCandidateStageInspect() {
+	for _, e := range m.GetCandidateStageMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to VacancyStorageMock.GetCandidateStage at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterGetCandidateStageCounter := mm_atomic.LoadUint64(&m.afterGetCandidateStageCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.GetCandidateStageMock.defaultExpectation != nil && afterGetCandidateStageCounter < 1 {
+		if m.GetCandidateStageMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to VacancyStorageMock.GetCandidateStage at\n%s", m.GetCandidateStageMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to VacancyStorageMock.GetCandidateStage at\n%s with params: %#v", m.GetCandidateStageMock.defaultExpectation.expectationOrigins.origin, *m.GetCandidateStageMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcGetCandidateStage != nil && afterGetCandidateStageCounter < 1 {
+		m.t.Errorf("Expected call to VacancyStorageMock.GetCandidateStage at\n%s", m.funcGetCandidateStageOrigin)
+	}
+
+	if !m.GetCandidateStageMock.invocationsDone() && afterGetCandidateStageCounter > 0 {
+		m.t.Errorf("Expected %d calls to VacancyStorageMock.GetCandidateStage at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.GetCandidateStageMock.expectedInvocations), m.GetCandidateStageMock.expectedInvocationsOrigin, afterGetCandidateStageCounter)
+	}
+}
+
+type mVacancyStorageMockGetPipelineStages struct {
+	optional           bool
+	mock               *VacancyStorageMock
+	defaultExpectation *VacancyStorageMockGetPipelineStagesExpectation
+	expectations       []*VacancyStorageMockGetPipelineStagesExpectation
+
+	callArgs []*VacancyStorageMockGetPipelineStagesParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// VacancyStorageMockGetPipelineStagesExpectation specifies expectation struct of the VacancyStorage.GetPipelineStages
+type VacancyStorageMockGetPipelineStagesExpectation struct {
+	mock               *VacancyStorageMock
+	params             *VacancyStorageMockGetPipelineStagesParams
+	paramPtrs          *VacancyStorageMockGetPipelineStagesParamPtrs
+	expectationOrigins VacancyStorageMockGetPipelineStagesExpectationOrigins
+	results            *VacancyStorageMockGetPipelineStagesResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// VacancyStorageMockGetPipelineStagesParams contains parameters of the VacancyStorage.GetPipelineStages
+type VacancyStorageMockGetPipelineStagesParams struct {
+	ctx       context.Context
+	vacancyID string
+}
+
+// VacancyStorageMockGetPipelineStagesParamPtrs contains pointers to parameters of the VacancyStorage.GetPipelineStages
+type VacancyStorageMockGetPipelineStagesParamPtrs struct {
+	ctx       *context.Context
+	vacancyID *string
+}
+
+// VacancyStorageMockGetPipelineStagesResults contains results of the VacancyStorage.GetPipelineStages
+type VacancyStorageMockGetPipelineStagesResults struct {
+	pa1 []domain.PipelineStage
+	err error
+}
+
+// VacancyStorageMockGetPipelineStagesOrigins contains origins of expectations of the VacancyStorage.GetPipelineStages
+type VacancyStorageMockGetPipelineStagesExpectationOrigins struct {
+	origin          string
+	originCtx       string
+	originVacancyID string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmGetPipelineStages *mVacancyStorageMockGetPipelineStages) Optional() *mVacancyStorageMockGetPipelineStages {
+	mmGetPipelineStages.optional = true
+	return mmGetPipelineStages
+}
+
+// Expect sets up expected params for VacancyStorage.GetPipelineStages
+func (mmGetPipelineStages *mVacancyStorageMockGetPipelineStages) Expect(ctx context.Context, vacancyID string) *mVacancyStorageMockGetPipelineStages {
+	if mmGetPipelineStages.mock.funcGetPipelineStages != nil {
+		mmGetPipelineStages.mock.t.Fatalf("VacancyStorageMock.GetPipelineStages mock is already set by Set")
+	}
+
+	if mmGetPipelineStages.defaultExpectation == nil {
+		mmGetPipelineStages.defaultExpectation = &VacancyStorageMockGetPipelineStagesExpectation{}
+	}
+
+	if mmGetPipelineStages.defaultExpectation.paramPtrs != nil {
+		mmGetPipelineStages.mock.t.Fatalf("VacancyStorageMock.GetPipelineStages mock is already set by ExpectParams functions")
+	}
+
+	mmGetPipelineStages.defaultExpectation.params = &VacancyStorageMockGetPipelineStagesParams{ctx, vacancyID}
+	mmGetPipelineStages.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmGetPipelineStages.expectations {
+		if minimock.Equal(e.params, mmGetPipelineStages.defaultExpectation.params) {
+			mmGetPipelineStages.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmGetPipelineStages.defaultExpectation.params)
+		}
+	}
+
+	return mmGetPipelineStages
+}
+
+// ExpectCtxParam1 sets up expected param ctx for VacancyStorage.GetPipelineStages
+func (mmGetPipelineStages *mVacancyStorageMockGetPipelineStages) ExpectCtxParam1(ctx context.Context) *mVacancyStorageMockGetPipelineStages {
+	if mmGetPipelineStages.mock.funcGetPipelineStages != nil {
+		mmGetPipelineStages.mock.t.Fatalf("VacancyStorageMock.GetPipelineStages mock is already set by Set")
+	}
+
+	if mmGetPipelineStages.defaultExpectation == nil {
+		mmGetPipelineStages.defaultExpectation = &VacancyStorageMockGetPipelineStagesExpectation{}
+	}
+
+	if mmGetPipelineStages.defaultExpectation.params != nil {
+		mmGetPipelineStages.mock.t.Fatalf("VacancyStorageMock.GetPipelineStages mock is already set by Expect")
+	}
+
+	if mmGetPipelineStages.defaultExpectation.paramPtrs == nil {
+		mmGetPipelineStages.defaultExpectation.paramPtrs = &VacancyStorageMockGetPipelineStagesParamPtrs{}
+	}
+	mmGetPipelineStages.defaultExpectation.paramPtrs.ctx = &ctx
+	mmGetPipelineStages.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmGetPipelineStages
+}
+
+// ExpectVacancyIDParam2 sets up expected param vacancyID for VacancyStorage.GetPipelineStages
+func (mmGetPipelineStages *mVacancyStorageMockGetPipelineStages) ExpectVacancyIDParam2(vacancyID string) *mVacancyStorageMockGetPipelineStages {
+	if mmGetPipelineStages.mock.funcGetPipelineStages != nil {
+		mmGetPipelineStages.mock.t.Fatalf("VacancyStorageMock.GetPipelineStages mock is already set by Set")
+	}
+
+	if mmGetPipelineStages.defaultExpectation == nil {
+		mmGetPipelineStages.defaultExpectation = &VacancyStorageMockGetPipelineStagesExpectation{}
+	}
+
+	if mmGetPipelineStages.defaultExpectation.params != nil {
+		mmGetPipelineStages.mock.t.Fatalf("VacancyStorageMock.GetPipelineStages mock is already set by Expect")
+	}
+
+	if mmGetPipelineStages.defaultExpectation.paramPtrs == nil {
+		mmGetPipelineStages.defaultExpectation.paramPtrs = &VacancyStorageMockGetPipelineStagesParamPtrs{}
+	}
+	mmGetPipelineStages.defaultExpectation.paramPtrs.vacancyID = &vacancyID
+	mmGetPipelineStages.defaultExpectation.expectationOrigins.originVacancyID = minimock.CallerInfo(1)
+
+	return mmGetPipelineStages
+}
+
+// Inspect accepts an inspector function that has same arguments as the VacancyStorage.GetPipelineStages
+func (mmGetPipelineStages *mVacancyStorageMockGetPipelineStages) Inspect(f func(ctx context.Context, vacancyID string)) *mVacancyStorageMockGetPipelineStages {
+	if mmGetPipelineStages.mock.inspectFuncGetPipelineStages != nil {
+		mmGetPipelineStages.mock.t.Fatalf("Inspect function is already set for VacancyStorageMock.GetPipelineStages")
+	}
+
+	mmGetPipelineStages.mock.inspectFuncGetPipelineStages = f
+
+	return mmGetPipelineStages
+}
+
+// Return sets up results that will be returned by VacancyStorage.GetPipelineStages
+func (mmGetPipelineStages *mVacancyStorageMockGetPipelineStages) Return(pa1 []domain.PipelineStage, err error) *VacancyStorageMock {
+	if mmGetPipelineStages.mock.funcGetPipelineStages != nil {
+		mmGetPipelineStages.mock.t.Fatalf("VacancyStorageMock.GetPipelineStages mock is already set by Set")
+	}
+
+	if mmGetPipelineStages.defaultExpectation == nil {
+		mmGetPipelineStages.defaultExpectation = &VacancyStorageMockGetPipelineStagesExpectation{mock: mmGetPipelineStages.mock}
+	}
+	mmGetPipelineStages.defaultExpectation.results = &VacancyStorageMockGetPipelineStagesResults{pa1, err}
+	mmGetPipelineStages.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmGetPipelineStages.mock
+}
+
+// Set uses given function f to mock the VacancyStorage.GetPipelineStages method
+func (mmGetPipelineStages *mVacancyStorageMockGetPipelineStages) Set(f func(ctx context.Context, vacancyID string) (pa1 []domain.PipelineStage, err error)) *VacancyStorageMock {
+	if mmGetPipelineStages.defaultExpectation != nil {
+		mmGetPipelineStages.mock.t.Fatalf("Default expectation is already set for the VacancyStorage.GetPipelineStages method")
+	}
+
+	if len(mmGetPipelineStages.expectations) > 0 {
+		mmGetPipelineStages.mock.t.Fatalf("Some expectations are already set for the VacancyStorage.GetPipelineStages method")
+	}
+
+	mmGetPipelineStages.mock.funcGetPipelineStages = f
+	mmGetPipelineStages.mock.funcGetPipelineStagesOrigin = minimock.CallerInfo(1)
+	return mmGetPipelineStages.mock
+}
+
+// When sets expectation for the VacancyStorage.GetPipelineStages which will trigger the result defined by the following
+// Then helper
+func (mmGetPipelineStages *mVacancyStorageMockGetPipelineStages) When(ctx context.Context, vacancyID string) *VacancyStorageMockGetPipelineStagesExpectation {
+	if mmGetPipelineStages.mock.funcGetPipelineStages != nil {
+		mmGetPipelineStages.mock.t.Fatalf("VacancyStorageMock.GetPipelineStages mock is already set by Set")
+	}
+
+	expectation := &VacancyStorageMockGetPipelineStagesExpectation{
+		mock:               mmGetPipelineStages.mock,
+		params:             &VacancyStorageMockGetPipelineStagesParams{ctx, vacancyID},
+		expectationOrigins: VacancyStorageMockGetPipelineStagesExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmGetPipelineStages.expectations = append(mmGetPipelineStages.expectations, expectation)
+	return expectation
+}
+
+// Then sets up VacancyStorage.GetPipelineStages return parameters for the expectation previously defined by the When method
+func (e *VacancyStorageMockGetPipelineStagesExpectation) Then(pa1 []domain.PipelineStage, err error) *VacancyStorageMock {
+	e.results = &VacancyStorageMockGetPipelineStagesResults{pa1, err}
+	return e.mock
+}
+
+// Times sets number of times VacancyStorage.GetPipelineStages should be invoked
+func (mmGetPipelineStages *mVacancyStorageMockGetPipelineStages) Times(n uint64) *mVacancyStorageMockGetPipelineStages {
+	if n == 0 {
+		mmGetPipelineStages.mock.t.Fatalf("Times of VacancyStorageMock.GetPipelineStages mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmGetPipelineStages.expectedInvocations, n)
+	mmGetPipelineStages.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmGetPipelineStages
+}
+
+func (mmGetPipelineStages *mVacancyStorageMockGetPipelineStages) invocationsDone() bool {
+	if len(mmGetPipelineStages.expectations) == 0 && mmGetPipelineStages.defaultExpectation == nil && mmGetPipelineStages.mock.funcGetPipelineStages == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmGetPipelineStages.mock.afterGetPipelineStagesCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmGetPipelineStages.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// GetPipelineStages implements mm_usecase.VacancyStorage
+func (mmGetPipelineStages *VacancyStorageMock) GetPipelineStages(ctx context.Context, vacancyID string) (pa1 []domain.PipelineStage, err error) {
+	mm_atomic.AddUint64(&mmGetPipelineStages.beforeGetPipelineStagesCounter, 1)
+	defer mm_atomic.AddUint64(&mmGetPipelineStages.afterGetPipelineStagesCounter, 1)
+
+	mmGetPipelineStages.t.Helper()
+
+	if mmGetPipelineStages.inspectFuncGetPipelineStages != nil {
+		mmGetPipelineStages.inspectFuncGetPipelineStages(ctx, vacancyID)
+	}
+
+	mm_params := VacancyStorageMockGetPipelineStagesParams{ctx, vacancyID}
+
+	// Record call args
+	mmGetPipelineStages.GetPipelineStagesMock.mutex.Lock()
+	mmGetPipelineStages.GetPipelineStagesMock.callArgs = append(mmGetPipelineStages.GetPipelineStagesMock.callArgs, &mm_params)
+	mmGetPipelineStages.GetPipelineStagesMock.mutex.Unlock()
+
+	for _, e := range mmGetPipelineStages.GetPipelineStagesMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.pa1, e.results.err
+		}
+	}
+
+	if mmGetPipelineStages.GetPipelineStagesMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmGetPipelineStages.GetPipelineStagesMock.defaultExpectation.Counter, 1)
+		mm_want := mmGetPipelineStages.GetPipelineStagesMock.defaultExpectation.params
+		mm_want_ptrs := mmGetPipelineStages.GetPipelineStagesMock.defaultExpectation.paramPtrs
+
+		mm_got := VacancyStorageMockGetPipelineStagesParams{ctx, vacancyID}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmGetPipelineStages.t.Errorf("VacancyStorageMock.GetPipelineStages got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmGetPipelineStages.GetPipelineStagesMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.vacancyID != nil && !minimock.Equal(*mm_want_ptrs.vacancyID, mm_got.vacancyID) {
+				mmGetPipelineStages.t.Errorf("VacancyStorageMock.GetPipelineStages got unexpected parameter vacancyID, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmGetPipelineStages.GetPipelineStagesMock.defaultExpectation.expectationOrigins.originVacancyID, *mm_want_ptrs.vacancyID, mm_got.vacancyID, minimock.Diff(*mm_want_ptrs.vacancyID, mm_got.vacancyID))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmGetPipelineStages.t.Errorf("VacancyStorageMock.GetPipelineStages got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmGetPipelineStages.GetPipelineStagesMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmGetPipelineStages.GetPipelineStagesMock.defaultExpectation.results
+		if mm_results == nil {
+			mmGetPipelineStages.t.Fatal("No results are set for the VacancyStorageMock.GetPipelineStages")
+		}
+		return (*mm_results).pa1, (*mm_results).err
+	}
+	if mmGetPipelineStages.funcGetPipelineStages != nil {
+		return mmGetPipelineStages.funcGetPipelineStages(ctx, vacancyID)
+	}
+	mmGetPipelineStages.t.Fatalf("Unexpected call to VacancyStorageMock.GetPipelineStages. %v %v", ctx, vacancyID)
+	return
+}
+
+// GetPipelineStagesAfterCounter returns a count of finished VacancyStorageMock.GetPipelineStages invocations
+func (mmGetPipelineStages *VacancyStorageMock) GetPipelineStagesAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmGetPipelineStages.afterGetPipelineStagesCounter)
+}
+
+// GetPipelineStagesBeforeCounter returns a count of VacancyStorageMock.GetPipelineStages invocations
+func (mmGetPipelineStages *VacancyStorageMock) GetPipelineStagesBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmGetPipelineStages.beforeGetPipelineStagesCounter)
+}
+
+// Calls returns a list of arguments used in each call to VacancyStorageMock.GetPipelineStages.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmGetPipelineStages *mVacancyStorageMockGetPipelineStages) Calls() []*VacancyStorageMockGetPipelineStagesParams {
+	mmGetPipelineStages.mutex.RLock()
+
+	argCopy := make([]*VacancyStorageMockGetPipelineStagesParams, len(mmGetPipelineStages.callArgs))
+	copy(argCopy, mmGetPipelineStages.callArgs)
+
+	mmGetPipelineStages.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockGetPipelineStagesDone returns true if the count of the GetPipelineStages invocations corresponds
+// the number of defined expectations
+func (m *VacancyStorageMock) MinimockGetPipelineStagesDone() bool {
+	if m.GetPipelineStagesMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.GetPipelineStagesMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.GetPipelineStagesMock.invocationsDone()
+}
+
+// MinimockGetPipelineStagesInspect logs each unmet expectation
+func (m *VacancyStorageMock) MinimockGetPipelineStagesInspect() {
+	for _, e := range m.GetPipelineStagesMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to VacancyStorageMock.GetPipelineStages at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterGetPipelineStagesCounter := mm_atomic.LoadUint64(&m.afterGetPipelineStagesCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.GetPipelineStagesMock.defaultExpectation != nil && afterGetPipelineStagesCounter < 1 {
+		if m.GetPipelineStagesMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to VacancyStorageMock.GetPipelineStages at\n%s", m.GetPipelineStagesMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to VacancyStorageMock.GetPipelineStages at\n%s with params: %#v", m.GetPipelineStagesMock.defaultExpectation.expectationOrigins.origin, *m.GetPipelineStagesMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcGetPipelineStages != nil && afterGetPipelineStagesCounter < 1 {
+		m.t.Errorf("Expected call to VacancyStorageMock.GetPipelineStages at\n%s", m.funcGetPipelineStagesOrigin)
+	}
+
+	if !m.GetPipelineStagesMock.invocationsDone() && afterGetPipelineStagesCounter > 0 {
+		m.t.Errorf("Expected %d calls to VacancyStorageMock.GetPipelineStages at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.GetPipelineStagesMock.expectedInvocations), m.GetPipelineStagesMock.expectedInvocationsOrigin, afterGetPipelineStagesCounter)
+	}
+}
+
+type mVacancyStorageMockGetPublicVacancy struct {
+	optional           bool
+	mock               *VacancyStorageMock
+	defaultExpectation *VacancyStorageMockGetPublicVacancyExpectation
+	expectations       []*VacancyStorageMockGetPublicVacancyExpectation
+
+	callArgs []*VacancyStorageMockGetPublicVacancyParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// VacancyStorageMockGetPublicVacancyExpectation specifies expectation struct of the VacancyStorage.GetPublicVacancy
+type VacancyStorageMockGetPublicVacancyExpectation struct {
+	mock               *VacancyStorageMock
+	params             *VacancyStorageMockGetPublicVacancyParams
+	paramPtrs          *VacancyStorageMockGetPublicVacancyParamPtrs
+	expectationOrigins VacancyStorageMockGetPublicVacancyExpectationOrigins
+	results            *VacancyStorageMockGetPublicVacancyResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// VacancyStorageMockGetPublicVacancyParams contains parameters of the VacancyStorage.GetPublicVacancy
+type VacancyStorageMockGetPublicVacancyParams struct {
+	ctx       context.Context
+	vacancyID string
+}
+
+// VacancyStorageMockGetPublicVacancyParamPtrs contains pointers to parameters of the VacancyStorage.GetPublicVacancy
+type VacancyStorageMockGetPublicVacancyParamPtrs struct {
+	ctx       *context.Context
+	vacancyID *string
+}
+
+// VacancyStorageMockGetPublicVacancyResults contains results of the VacancyStorage.GetPublicVacancy
+type VacancyStorageMockGetPublicVacancyResults struct {
+	vp1 *domain.Vacancy
+	err error
+}
+
+// VacancyStorageMockGetPublicVacancyOrigins contains origins of expectations of the VacancyStorage.GetPublicVacancy
+type VacancyStorageMockGetPublicVacancyExpectationOrigins struct {
+	origin          string
+	originCtx       string
+	originVacancyID string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmGetPublicVacancy *mVacancyStorageMockGetPublicVacancy) Optional() *mVacancyStorageMockGetPublicVacancy {
+	mmGetPublicVacancy.optional = true
+	return mmGetPublicVacancy
+}
+
+// Expect sets up expected params for VacancyStorage.GetPublicVacancy
+func (mmGetPublicVacancy *mVacancyStorageMockGetPublicVacancy) Expect(ctx context.Context, vacancyID string) *mVacancyStorageMockGetPublicVacancy {
+	if mmGetPublicVacancy.mock.funcGetPublicVacancy != nil {
+		mmGetPublicVacancy.mock.t.Fatalf("VacancyStorageMock.GetPublicVacancy mock is already set by Set")
+	}
+
+	if mmGetPublicVacancy.defaultExpectation == nil {
+		mmGetPublicVacancy.defaultExpectation = &VacancyStorageMockGetPublicVacancyExpectation{}
+	}
+
+	if mmGetPublicVacancy.defaultExpectation.paramPtrs != nil {
+		mmGetPublicVacancy.mock.t.Fatalf("VacancyStorageMock.GetPublicVacancy mock is already set by ExpectParams functions")
+	}
+
+	mmGetPublicVacancy.defaultExpectation.params = &VacancyStorageMockGetPublicVacancyParams{ctx, vacancyID}
+	mmGetPublicVacancy.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmGetPublicVacancy.expectations {
+		if minimock.Equal(e.params, mmGetPublicVacancy.defaultExpectation.params) {
+			mmGetPublicVacancy.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmGetPublicVacancy.defaultExpectation.params)
+		}
+	}
+
+	return mmGetPublicVacancy
+}
+
+// ExpectCtxParam1 sets up expected param ctx for VacancyStorage.GetPublicVacancy
+func (mmGetPublicVacancy *mVacancyStorageMockGetPublicVacancy) ExpectCtxParam1(ctx context.Context) *mVacancyStorageMockGetPublicVacancy {
+	if mmGetPublicVacancy.mock.funcGetPublicVacancy != nil {
+		mmGetPublicVacancy.mock.t.Fatalf("VacancyStorageMock.GetPublicVacancy mock is already set by Set")
+	}
+
+	if mmGetPublicVacancy.defaultExpectation == nil {
+		mmGetPublicVacancy.defaultExpectation = &VacancyStorageMockGetPublicVacancyExpectation{}
+	}
+
+	if mmGetPublicVacancy.defaultExpectation.params != nil {
+		mmGetPublicVacancy.mock.t.Fatalf("VacancyStorageMock.GetPublicVacancy mock is already set by Expect")
+	}
+
+	if mmGetPublicVacancy.defaultExpectation.paramPtrs == nil {
+		mmGetPublicVacancy.defaultExpectation.paramPtrs = &VacancyStorageMockGetPublicVacancyParamPtrs{}
+	}
+	mmGetPublicVacancy.defaultExpectation.paramPtrs.ctx = &ctx
+	mmGetPublicVacancy.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmGetPublicVacancy
+}
+
+// ExpectVacancyIDParam2 sets up expected param vacancyID for VacancyStorage.GetPublicVacancy
+func (mmGetPublicVacancy *mVacancyStorageMockGetPublicVacancy) ExpectVacancyIDParam2(vacancyID string) *mVacancyStorageMockGetPublicVacancy {
+	if mmGetPublicVacancy.mock.funcGetPublicVacancy != nil {
+		mmGetPublicVacancy.mock.t.Fatalf("VacancyStorageMock.GetPublicVacancy mock is already set by Set")
+	}
+
+	if mmGetPublicVacancy.defaultExpectation == nil {
+		mmGetPublicVacancy.defaultExpectation = &VacancyStorageMockGetPublicVacancyExpectation{}
+	}
+
+	if mmGetPublicVacancy.defaultExpectation.params != nil {
+		mmGetPublicVacancy.mock.t.Fatalf("VacancyStorageMock.GetPublicVacancy mock is already set by Expect")
+	}
+
+	if mmGetPublicVacancy.defaultExpectation.paramPtrs == nil {
+		mmGetPublicVacancy.defaultExpectation.paramPtrs = &VacancyStorageMockGetPublicVacancyParamPtrs{}
+	}
+	mmGetPublicVacancy.defaultExpectation.paramPtrs.vacancyID = &vacancyID
+	mmGetPublicVacancy.defaultExpectation.expectationOrigins.originVacancyID = minimock.CallerInfo(1)
+
+	return mmGetPublicVacancy
+}
+
+// Inspect accepts an inspector function that has same arguments as the VacancyStorage.GetPublicVacancy
+func (mmGetPublicVacancy *mVacancyStorageMockGetPublicVacancy) Inspect(f func(ctx context.Context, vacancyID string)) *mVacancyStorageMockGetPublicVacancy {
+	if mmGetPublicVacancy.mock.inspectFuncGetPublicVacancy != nil {
+		mmGetPublicVacancy.mock.t.Fatalf("Inspect function is already set for VacancyStorageMock.GetPublicVacancy")
+	}
+
+	mmGetPublicVacancy.mock.inspectFuncGetPublicVacancy = f
+
+	return mmGetPublicVacancy
+}
+
+// Return sets up results that will be returned by VacancyStorage.GetPublicVacancy
+func (mmGetPublicVacancy *mVacancyStorageMockGetPublicVacancy) Return(vp1 *domain.Vacancy, err error) *VacancyStorageMock {
+	if mmGetPublicVacancy.mock.funcGetPublicVacancy != nil {
+		mmGetPublicVacancy.mock.t.Fatalf("VacancyStorageMock.GetPublicVacancy mock is already set by Set")
+	}
+
+	if mmGetPublicVacancy.defaultExpectation == nil {
+		mmGetPublicVacancy.defaultExpectation = &VacancyStorageMockGetPublicVacancyExpectation{mock: mmGetPublicVacancy.mock}
+	}
+	mmGetPublicVacancy.defaultExpectation.results = &VacancyStorageMockGetPublicVacancyResults{vp1, err}
+	mmGetPublicVacancy.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmGetPublicVacancy.mock
+}
+
+// Set uses given function f to mock the VacancyStorage.GetPublicVacancy method
+func (mmGetPublicVacancy *mVacancyStorageMockGetPublicVacancy) Set(f func(ctx context.Context, vacancyID string) (vp1 *domain.Vacancy, err error)) *VacancyStorageMock {
+	if mmGetPublicVacancy.defaultExpectation != nil {
+		mmGetPublicVacancy.mock.t.Fatalf("Default expectation is already set for the VacancyStorage.GetPublicVacancy method")
+	}
+
+	if len(mmGetPublicVacancy.expectations) > 0 {
+		mmGetPublicVacancy.mock.t.Fatalf("Some expectations are already set for the VacancyStorage.GetPublicVacancy method")
+	}
+
+	mmGetPublicVacancy.mock.funcGetPublicVacancy = f
+	mmGetPublicVacancy.mock.funcGetPublicVacancyOrigin = minimock.CallerInfo(1)
+	return mmGetPublicVacancy.mock
+}
+
+// When sets expectation for the VacancyStorage.GetPublicVacancy which will trigger the result defined by the following
+// Then helper
+func (mmGetPublicVacancy *mVacancyStorageMockGetPublicVacancy) When(ctx context.Context, vacancyID string) *VacancyStorageMockGetPublicVacancyExpectation {
+	if mmGetPublicVacancy.mock.funcGetPublicVacancy != nil {
+		mmGetPublicVacancy.mock.t.Fatalf("VacancyStorageMock.GetPublicVacancy mock is already set by Set")
+	}
+
+	expectation := &VacancyStorageMockGetPublicVacancyExpectation{
+		mock:               mmGetPublicVacancy.mock,
+		params:             &VacancyStorageMockGetPublicVacancyParams{ctx, vacancyID},
+		expectationOrigins: VacancyStorageMockGetPublicVacancyExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmGetPublicVacancy.expectations = append(mmGetPublicVacancy.expectations, expectation)
+	return expectation
+}
+
+// Then sets up VacancyStorage.GetPublicVacancy return parameters for the expectation previously defined by the When method
+func (e *VacancyStorageMockGetPublicVacancyExpectation) Then(vp1 *domain.Vacancy, err error) *VacancyStorageMock {
+	e.results = &VacancyStorageMockGetPublicVacancyResults{vp1, err}
+	return e.mock
+}
+
+// Times sets number of times VacancyStorage.GetPublicVacancy should be invoked
+func (mmGetPublicVacancy *mVacancyStorageMockGetPublicVacancy) Times(n uint64) *mVacancyStorageMockGetPublicVacancy {
+	if n == 0 {
+		mmGetPublicVacancy.mock.t.Fatalf("Times of VacancyStorageMock.GetPublicVacancy mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmGetPublicVacancy.expectedInvocations, n)
+	mmGetPublicVacancy.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmGetPublicVacancy
+}
+
+func (mmGetPublicVacancy *mVacancyStorageMockGetPublicVacancy) invocationsDone() bool {
+	if len(mmGetPublicVacancy.expectations) == 0 && mmGetPublicVacancy.defaultExpectation == nil && mmGetPublicVacancy.mock.funcGetPublicVacancy == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmGetPublicVacancy.mock.afterGetPublicVacancyCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmGetPublicVacancy.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// GetPublicVacancy implements mm_usecase.VacancyStorage
+func (mmGetPublicVacancy *VacancyStorageMock) GetPublicVacancy(ctx context.Context, vacancyID string) (vp1 *domain.Vacancy, err error) {
+	mm_atomic.AddUint64(&mmGetPublicVacancy.beforeGetPublicVacancyCounter, 1)
+	defer mm_atomic.AddUint64(&mmGetPublicVacancy.afterGetPublicVacancyCounter, 1)
+
+	mmGetPublicVacancy.t.Helper()
+
+	if mmGetPublicVacancy.inspectFuncGetPublicVacancy != nil {
+		mmGetPublicVacancy.inspectFuncGetPublicVacancy(ctx, vacancyID)
+	}
+
+	mm_params := VacancyStorageMockGetPublicVacancyParams{ctx, vacancyID}
+
+	// Record call args
+	mmGetPublicVacancy.GetPublicVacancyMock.mutex.Lock()
+	mmGetPublicVacancy.GetPublicVacancyMock.callArgs = append(mmGetPublicVacancy.GetPublicVacancyMock.callArgs, &mm_params)
+	mmGetPublicVacancy.GetPublicVacancyMock.mutex.Unlock()
+
+	for _, e := range mmGetPublicVacancy.GetPublicVacancyMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.vp1, e.results.err
+		}
+	}
+
+	if mmGetPublicVacancy.GetPublicVacancyMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmGetPublicVacancy.GetPublicVacancyMock.defaultExpectation.Counter, 1)
+		mm_want := mmGetPublicVacancy.GetPublicVacancyMock.defaultExpectation.params
+		mm_want_ptrs := mmGetPublicVacancy.GetPublicVacancyMock.defaultExpectation.paramPtrs
+
+		mm_got := VacancyStorageMockGetPublicVacancyParams{ctx, vacancyID}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmGetPublicVacancy.t.Errorf("VacancyStorageMock.GetPublicVacancy got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmGetPublicVacancy.GetPublicVacancyMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.vacancyID != nil && !minimock.Equal(*mm_want_ptrs.vacancyID, mm_got.vacancyID) {
+				mmGetPublicVacancy.t.Errorf("VacancyStorageMock.GetPublicVacancy got unexpected parameter vacancyID, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmGetPublicVacancy.GetPublicVacancyMock.defaultExpectation.expectationOrigins.originVacancyID, *mm_want_ptrs.vacancyID, mm_got.vacancyID, minimock.Diff(*mm_want_ptrs.vacancyID, mm_got.vacancyID))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmGetPublicVacancy.t.Errorf("VacancyStorageMock.GetPublicVacancy got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmGetPublicVacancy.GetPublicVacancyMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmGetPublicVacancy.GetPublicVacancyMock.defaultExpectation.results
+		if mm_results == nil {
+			mmGetPublicVacancy.t.Fatal("No results are set for the VacancyStorageMock.GetPublicVacancy")
+		}
+		return (*mm_results).vp1, (*mm_results).err
+	}
+	if mmGetPublicVacancy.funcGetPublicVacancy != nil {
+		return mmGetPublicVacancy.funcGetPublicVacancy(ctx, vacancyID)
+	}
+	mmGetPublicVacancy.t.Fatalf("Unexpected call to VacancyStorageMock.GetPublicVacancy. %v %v", ctx, vacancyID)
+	return
+}
+
+// GetPublicVacancyAfterCounter returns a count of finished VacancyStorageMock.GetPublicVacancy invocations
+func (mmGetPublicVacancy *VacancyStorageMock) GetPublicVacancyAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmGetPublicVacancy.afterGetPublicVacancyCounter)
+}
+
+// GetPublicVacancyBeforeCounter returns a count of VacancyStorageMock.GetPublicVacancy invocations
+func (mmGetPublicVacancy *VacancyStorageMock) GetPublicVacancyBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmGetPublicVacancy.beforeGetPublicVacancyCounter)
+}
+
+// Calls returns a list of arguments used in each call to VacancyStorageMock.GetPublicVacancy.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmGetPublicVacancy *mVacancyStorageMockGetPublicVacancy) Calls() []*VacancyStorageMockGetPublicVacancyParams {
+	mmGetPublicVacancy.mutex.RLock()
+
+	argCopy := make([]*VacancyStorageMockGetPublicVacancyParams, len(mmGetPublicVacancy.callArgs))
+	copy(argCopy, mmGetPublicVacancy.callArgs)
+
+	mmGetPublicVacancy.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockGetPublicVacancyDone returns true if the count of the GetPublicVacancy invocations corresponds
+// the number of defined expectations
+func (m *VacancyStorageMock) MinimockGetPublicVacancyDone() bool {
+	if m.GetPublicVacancyMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.GetPublicVacancyMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.GetPublicVacancyMock.invocationsDone()
+}
+
+// MinimockGetPublicVacancyInspect logs each unmet expectation
+func (m *VacancyStorageMock) MinimockGetPublicVacancyInspect() {
+	for _, e := range m.GetPublicVacancyMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to VacancyStorageMock.GetPublicVacancy at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterGetPublicVacancyCounter := mm_atomic.LoadUint64(&m.afterGetPublicVacancyCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.GetPublicVacancyMock.defaultExpectation != nil && afterGetPublicVacancyCounter < 1 {
+		if m.GetPublicVacancyMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to VacancyStorageMock.GetPublicVacancy at\n%s", m.GetPublicVacancyMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to VacancyStorageMock.GetPublicVacancy at\n%s with params: %#v", m.GetPublicVacancyMock.defaultExpectation.expectationOrigins.origin, *m.GetPublicVacancyMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcGetPublicVacancy != nil && afterGetPublicVacancyCounter < 1 {
+		m.t.Errorf("Expected call to VacancyStorageMock.GetPublicVacancy at\n%s", m.funcGetPublicVacancyOrigin)
+	}
+
+	if !m.GetPublicVacancyMock.invocationsDone() && afterGetPublicVacancyCounter > 0 {
+		m.t.Errorf("Expected %d calls to VacancyStorageMock.GetPublicVacancy at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.GetPublicVacancyMock.expectedInvocations), m.GetPublicVacancyMock.expectedInvocationsOrigin, afterGetPublicVacancyCounter)
+	}
+}
+
+type mVacancyStorageMockGetTemplate struct {
+	optional           bool
+	mock               *VacancyStorageMock
+	defaultExpectation *VacancyStorageMockGetTemplateExpectation
+	expectations       []*VacancyStorageMockGetTemplateExpectation
+
+	callArgs []*VacancyStorageMockGetTemplateParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// VacancyStorageMockGetTemplateExpectation specifies expectation struct of the VacancyStorage.GetTemplate
+type VacancyStorageMockGetTemplateExpectation struct {
+	mock               *VacancyStorageMock
+	params             *VacancyStorageMockGetTemplateParams
+	paramPtrs          *VacancyStorageMockGetTemplateParamPtrs
+	expectationOrigins VacancyStorageMockGetTemplateExpectationOrigins
+	results            *VacancyStorageMockGetTemplateResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// VacancyStorageMockGetTemplateParams contains parameters of the VacancyStorage.GetTemplate
+type VacancyStorageMockGetTemplateParams struct {
+	ctx context.Context
+	in  domain.GetTemplateInput
+}
+
+// VacancyStorageMockGetTemplateParamPtrs contains pointers to parameters of the VacancyStorage.GetTemplate
+type VacancyStorageMockGetTemplateParamPtrs struct {
+	ctx *context.Context
+	in  *domain.GetTemplateInput
+}
+
+// VacancyStorageMockGetTemplateResults contains results of the VacancyStorage.GetTemplate
+type VacancyStorageMockGetTemplateResults struct {
+	vp1 *domain.VacancyTemplate
+	err error
+}
+
+// VacancyStorageMockGetTemplateOrigins contains origins of expectations of the VacancyStorage.GetTemplate
+type VacancyStorageMockGetTemplateExpectationOrigins struct {
+	origin    string
+	originCtx string
+	originIn  string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmGetTemplate *mVacancyStorageMockGetTemplate) Optional() *mVacancyStorageMockGetTemplate {
+	mmGetTemplate.optional = true
+	return mmGetTemplate
+}
+
+// Expect sets up expected params for VacancyStorage.GetTemplate
+func (mmGetTemplate *mVacancyStorageMockGetTemplate) Expect(ctx context.Context, in domain.GetTemplateInput) *mVacancyStorageMockGetTemplate {
+	if mmGetTemplate.mock.funcGetTemplate != nil {
+		mmGetTemplate.mock.t.Fatalf("VacancyStorageMock.GetTemplate mock is already set by Set")
+	}
+
+	if mmGetTemplate.defaultExpectation == nil {
+		mmGetTemplate.defaultExpectation = &VacancyStorageMockGetTemplateExpectation{}
+	}
+
+	if mmGetTemplate.defaultExpectation.paramPtrs != nil {
+		mmGetTemplate.mock.t.Fatalf("VacancyStorageMock.GetTemplate mock is already set by ExpectParams functions")
+	}
+
+	mmGetTemplate.defaultExpectation.params = &VacancyStorageMockGetTemplateParams{ctx, in}
+	mmGetTemplate.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmGetTemplate.expectations {
+		if minimock.Equal(e.params, mmGetTemplate.defaultExpectation.params) {
+			mmGetTemplate.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmGetTemplate.defaultExpectation.params)
+		}
+	}
+
+	return mmGetTemplate
+}
+
+// ExpectCtxParam1 sets up expected param ctx for VacancyStorage.GetTemplate
+func (mmGetTemplate *mVacancyStorageMockGetTemplate) ExpectCtxParam1(ctx context.Context) *mVacancyStorageMockGetTemplate {
+	if mmGetTemplate.mock.funcGetTemplate != nil {
+		mmGetTemplate.mock.t.Fatalf("VacancyStorageMock.GetTemplate mock is already set by Set")
+	}
+
+	if mmGetTemplate.defaultExpectation == nil {
+		mmGetTemplate.defaultExpectation = &VacancyStorageMockGetTemplateExpectation{}
+	}
+
+	if mmGetTemplate.defaultExpectation.params != nil {
+		mmGetTemplate.mock.t.Fatalf("VacancyStorageMock.GetTemplate mock is already set by Expect")
+	}
+
+	if mmGetTemplate.defaultExpectation.paramPtrs == nil {
+		mmGetTemplate.defaultExpectation.paramPtrs = &VacancyStorageMockGetTemplateParamPtrs{}
+	}
+	mmGetTemplate.defaultExpectation.paramPtrs.ctx = &ctx
+	mmGetTemplate.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmGetTemplate
+}
+
+// ExpectInParam2 sets up expected param in for VacancyStorage.GetTemplate
+func (mmGetTemplate *mVacancyStorageMockGetTemplate) ExpectInParam2(in domain.GetTemplateInput) *mVacancyStorageMockGetTemplate {
+	if mmGetTemplate.mock.funcGetTemplate != nil {
+		mmGetTemplate.mock.t.Fatalf("VacancyStorageMock.GetTemplate mock is already set by Set")
+	}
+
+	if mmGetTemplate.defaultExpectation == nil {
+		mmGetTemplate.defaultExpectation = &VacancyStorageMockGetTemplateExpectation{}
+	}
+
+	if mmGetTemplate.defaultExpectation.params != nil {
+		mmGetTemplate.mock.t.Fatalf("VacancyStorageMock.GetTemplate mock is already set by Expect")
+	}
+
+	if mmGetTemplate.defaultExpectation.paramPtrs == nil {
+		mmGetTemplate.defaultExpectation.paramPtrs = &VacancyStorageMockGetTemplateParamPtrs{}
+	}
+	mmGetTemplate.defaultExpectation.paramPtrs.in = &in
+	mmGetTemplate.defaultExpectation.expectationOrigins.originIn = minimock.CallerInfo(1)
+
+	return mmGetTemplate
+}
+
+// Inspect accepts an inspector function that has same arguments as the VacancyStorage.GetTemplate
+func (mmGetTemplate *mVacancyStorageMockGetTemplate) Inspect(f func(ctx context.Context, in domain.GetTemplateInput)) *mVacancyStorageMockGetTemplate {
+	if mmGetTemplate.mock.inspectFuncGetTemplate != nil {
+		mmGetTemplate.mock.t.Fatalf("Inspect function is already set for VacancyStorageMock.GetTemplate")
+	}
+
+	mmGetTemplate.mock.inspectFuncGetTemplate = f
+
+	return mmGetTemplate
+}
+
+// Return sets up results that will be returned by VacancyStorage.GetTemplate
+func (mmGetTemplate *mVacancyStorageMockGetTemplate) Return(vp1 *domain.VacancyTemplate, err error) *VacancyStorageMock {
+	if mmGetTemplate.mock.funcGetTemplate != nil {
+		mmGetTemplate.mock.t.Fatalf("VacancyStorageMock.GetTemplate mock is already set by Set")
+	}
+
+	if mmGetTemplate.defaultExpectation == nil {
+		mmGetTemplate.defaultExpectation = &VacancyStorageMockGetTemplateExpectation{mock: mmGetTemplate.mock}
+	}
+	mmGetTemplate.defaultExpectation.results = &VacancyStorageMockGetTemplateResults{vp1, err}
+	mmGetTemplate.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmGetTemplate.mock
+}
+
+// Set uses given function f to mock the VacancyStorage.GetTemplate method
+func (mmGetTemplate *mVacancyStorageMockGetTemplate) Set(f func(ctx context.Context, in domain.GetTemplateInput) (vp1 *domain.VacancyTemplate, err error)) *VacancyStorageMock {
+	if mmGetTemplate.defaultExpectation != nil {
+		mmGetTemplate.mock.t.Fatalf("Default expectation is already set for the VacancyStorage.GetTemplate method")
+	}
+
+	if len(mmGetTemplate.expectations) > 0 {
+		mmGetTemplate.mock.t.Fatalf("Some expectations are already set for the VacancyStorage.GetTemplate method")
+	}
+
+	mmGetTemplate.mock.funcGetTemplate = f
+	mmGetTemplate.mock.funcGetTemplateOrigin = minimock.CallerInfo(1)
+	return mmGetTemplate.mock
+}
+
+// When sets expectation for the VacancyStorage.GetTemplate which will trigger the result defined by the following
+// Then helper
+func (mmGetTemplate *mVacancyStorageMockGetTemplate) When(ctx context.Context, in domain.GetTemplateInput) *VacancyStorageMockGetTemplateExpectation {
+	if mmGetTemplate.mock.funcGetTemplate != nil {
+		mmGetTemplate.mock.t.Fatalf("VacancyStorageMock.GetTemplate mock is already set by Set")
+	}
+
+	expectation := &VacancyStorageMockGetTemplateExpectation{
+		mock:               mmGetTemplate.mock,
+		params:             &VacancyStorageMockGetTemplateParams{ctx, in},
+		expectationOrigins: VacancyStorageMockGetTemplateExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmGetTemplate.expectations = append(mmGetTemplate.expectations, expectation)
+	return expectation
+}
+
+// Then sets up VacancyStorage.GetTemplate return parameters for the expectation previously defined by the When method
+func (e *VacancyStorageMockGetTemplateExpectation) Then(vp1 *domain.VacancyTemplate, err error) *VacancyStorageMock {
+	e.results = &VacancyStorageMockGetTemplateResults{vp1, err}
+	return e.mock
+}
+
+// Times sets number of times VacancyStorage.GetTemplate should be invoked
+func (mmGetTemplate *mVacancyStorageMockGetTemplate) Times(n uint64) *mVacancyStorageMockGetTemplate {
+	if n == 0 {
+		mmGetTemplate.mock.t.Fatalf("Times of VacancyStorageMock.GetTemplate mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmGetTemplate.expectedInvocations, n)
+	mmGetTemplate.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmGetTemplate
+}
+
+func (mmGetTemplate *mVacancyStorageMockGetTemplate) invocationsDone() bool {
+	if len(mmGetTemplate.expectations) == 0 && mmGetTemplate.defaultExpectation == nil && mmGetTemplate.mock.funcGetTemplate == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmGetTemplate.mock.afterGetTemplateCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmGetTemplate.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// GetTemplate implements mm_usecase.VacancyStorage
+func (mmGetTemplate *VacancyStorageMock) GetTemplate(ctx context.Context, in domain.GetTemplateInput) (vp1 *domain.VacancyTemplate, err error) {
+	mm_atomic.AddUint64(&mmGetTemplate.beforeGetTemplateCounter, 1)
+	defer mm_atomic.AddUint64(&mmGetTemplate.afterGetTemplateCounter, 1)
+
+	mmGetTemplate.t.Helper()
+
+	if mmGetTemplate.inspectFuncGetTemplate != nil {
+		mmGetTemplate.inspectFuncGetTemplate(ctx, in)
+	}
+
+	mm_params := VacancyStorageMockGetTemplateParams{ctx, in}
+
+	// Record call args
+	mmGetTemplate.GetTemplateMock.mutex.Lock()
+	mmGetTemplate.GetTemplateMock.callArgs = append(mmGetTemplate.GetTemplateMock.callArgs, &mm_params)
+	mmGetTemplate.GetTemplateMock.mutex.Unlock()
+
+	for _, e := range mmGetTemplate.GetTemplateMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.vp1, e.results.err
+		}
+	}
+
+	if mmGetTemplate.GetTemplateMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmGetTemplate.GetTemplateMock.defaultExpectation.Counter, 1)
+		mm_want := mmGetTemplate.GetTemplateMock.defaultExpectation.params
+		mm_want_ptrs := mmGetTemplate.GetTemplateMock.defaultExpectation.paramPtrs
+
+		mm_got := VacancyStorageMockGetTemplateParams{ctx, in}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmGetTemplate.t.Errorf("VacancyStorageMock.GetTemplate got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmGetTemplate.GetTemplateMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.in != nil && !minimock.Equal(*mm_want_ptrs.in, mm_got.in) {
+				mmGetTemplate.t.Errorf("VacancyStorageMock.GetTemplate got unexpected parameter in, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmGetTemplate.GetTemplateMock.defaultExpectation.expectationOrigins.originIn, *mm_want_ptrs.in, mm_got.in, minimock.Diff(*mm_want_ptrs.in, mm_got.in))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmGetTemplate.t.Errorf("VacancyStorageMock.GetTemplate got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmGetTemplate.GetTemplateMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmGetTemplate.GetTemplateMock.defaultExpectation.results
+		if mm_results == nil {
+			mmGetTemplate.t.Fatal("No results are set for the VacancyStorageMock.GetTemplate")
+		}
+		return (*mm_results).vp1, (*mm_results).err
+	}
+	if mmGetTemplate.funcGetTemplate != nil {
+		return mmGetTemplate.funcGetTemplate(ctx, in)
+	}
+	mmGetTemplate.t.Fatalf("Unexpected call to VacancyStorageMock.GetTemplate. %v %v", ctx, in)
+	return
+}
+
+// GetTemplateAfterCounter returns a count of finished VacancyStorageMock.GetTemplate invocations
+func (mmGetTemplate *VacancyStorageMock) GetTemplateAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmGetTemplate.afterGetTemplateCounter)
+}
+
+// GetTemplateBeforeCounter returns a count of VacancyStorageMock.GetTemplate invocations
+func (mmGetTemplate *VacancyStorageMock) GetTemplateBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmGetTemplate.beforeGetTemplateCounter)
+}
+
+// Calls returns a list of arguments used in each call to VacancyStorageMock.GetTemplate.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmGetTemplate *mVacancyStorageMockGetTemplate) Calls() []*VacancyStorageMockGetTemplateParams {
+	mmGetTemplate.mutex.RLock()
+
+	argCopy := make([]*VacancyStorageMockGetTemplateParams, len(mmGetTemplate.callArgs))
+	copy(argCopy, mmGetTemplate.callArgs)
+
+	mmGetTemplate.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockGetTemplateDone returns true if the count of the GetTemplate invocations corresponds
+// the number of defined expectations
+func (m *VacancyStorageMock) MinimockGetTemplateDone() bool {
+	if m.GetTemplateMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.GetTemplateMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.GetTemplateMock.invocationsDone()
+}
+
+// MinimockGetTemplateInspect logs each unmet expectation
+func (m *VacancyStorageMock) MinimockGetTemplateInspect() {
+	for _, e := range m.GetTemplateMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to VacancyStorageMock.GetTemplate at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterGetTemplateCounter := mm_atomic.LoadUint64(&m.afterGetTemplateCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.GetTemplateMock.defaultExpectation != nil && afterGetTemplateCounter < 1 {
+		if m.GetTemplateMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to VacancyStorageMock.GetTemplate at\n%s", m.GetTemplateMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to VacancyStorageMock.GetTemplate at\n%s with params: %#v", m.GetTemplateMock.defaultExpectation.expectationOrigins.origin, *m.GetTemplateMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcGetTemplate != nil && afterGetTemplateCounter < 1 {
+		m.t.Errorf("Expected call to VacancyStorageMock.GetTemplate at\n%s", m.funcGetTemplateOrigin)
+	}
+
+	if !m.GetTemplateMock.invocationsDone() && afterGetTemplateCounter > 0 {
+		m.t.Errorf("Expected %d calls to VacancyStorageMock.GetTemplate at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.GetTemplateMock.expectedInvocations), m.GetTemplateMock.expectedInvocationsOrigin, afterGetTemplateCounter)
+	}
+}
+
+type mVacancyStorageMockGetVacancy struct {
+	optional           bool
+	mock               *VacancyStorageMock
+	defaultExpectation *VacancyStorageMockGetVacancyExpectation
+	expectations       []*VacancyStorageMockGetVacancyExpectation
+
+	callArgs []*VacancyStorageMockGetVacancyParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// VacancyStorageMockGetVacancyExpectation specifies expectation struct of the VacancyStorage.GetVacancy
+type VacancyStorageMockGetVacancyExpectation struct {
+	mock               *VacancyStorageMock
+	params             *VacancyStorageMockGetVacancyParams
+	paramPtrs          *VacancyStorageMockGetVacancyParamPtrs
+	expectationOrigins VacancyStorageMockGetVacancyExpectationOrigins
+	results            *VacancyStorageMockGetVacancyResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// VacancyStorageMockGetVacancyParams contains parameters of the VacancyStorage.GetVacancy
+type VacancyStorageMockGetVacancyParams struct {
+	ctx         context.Context
+	vacancyID   string
+	ownerUserID uint64
+	isAdmin     bool
+}
+
+// VacancyStorageMockGetVacancyParamPtrs contains pointers to parameters of the VacancyStorage.GetVacancy
+type VacancyStorageMockGetVacancyParamPtrs struct {
+	ctx         *context.Context
+	vacancyID   *string
+	ownerUserID *uint64
+	isAdmin     *bool
+}
+
+// VacancyStorageMockGetVacancyResults contains results of the VacancyStorage.GetVacancy
+type VacancyStorageMockGetVacancyResults struct {
+	vp1 *domain.Vacancy
+	err error
+}
+
+// VacancyStorageMockGetVacancyOrigins contains origins of expectations of the VacancyStorage.GetVacancy
+type VacancyStorageMockGetVacancyExpectationOrigins struct {
+	origin            string
+	originCtx         string
+	originVacancyID   string
+	originOwnerUserID string
+	originIsAdmin     string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmGetVacancy *mVacancyStorageMockGetVacancy) Optional() *mVacancyStorageMockGetVacancy {
+	mmGetVacancy.optional = true
+	return mmGetVacancy
+}
+
+// Expect sets up expected params for VacancyStorage.GetVacancy
+func (mmGetVacancy *mVacancyStorageMockGetVacancy) Expect(ctx context.Context, vacancyID string, ownerUserID uint64, isAdmin bool) *mVacancyStorageMockGetVacancy {
+	if mmGetVacancy.mock.funcGetVacancy != nil {
+		mmGetVacancy.mock.t.Fatalf("VacancyStorageMock.GetVacancy mock is already set by Set")
+	}
+
+	if mmGetVacancy.defaultExpectation == nil {
+		mmGetVacancy.defaultExpectation = &VacancyStorageMockGetVacancyExpectation{}
+	}
+
+	if mmGetVacancy.defaultExpectation.paramPtrs != nil {
+		mmGetVacancy.mock.t.Fatalf("VacancyStorageMock.GetVacancy mock is already set by ExpectParams functions")
+	}
+
+	mmGetVacancy.defaultExpectation.params = &VacancyStorageMockGetVacancyParams{ctx, vacancyID, ownerUserID, isAdmin}
+	mmGetVacancy.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmGetVacancy.expectations {
+		if minimock.Equal(e.params, mmGetVacancy.defaultExpectation.params) {
+			mmGetVacancy.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmGetVacancy.defaultExpectation.params)
+		}
+	}
+
+	return mmGetVacancy
+}
+
+// ExpectCtxParam1 sets up expected param ctx for VacancyStorage.GetVacancy
+func (mmGetVacancy *mVacancyStorageMockGetVacancy) ExpectCtxParam1(ctx context.Context) *mVacancyStorageMockGetVacancy {
+	if mmGetVacancy.mock.funcGetVacancy != nil {
+		mmGetVacancy.mock.t.Fatalf("VacancyStorageMock.GetVacancy mock is already set by Set")
+	}
+
+	if mmGetVacancy.defaultExpectation == nil {
+		mmGetVacancy.defaultExpectation = &VacancyStorageMockGetVacancyExpectation{}
+	}
+
+	if mmGetVacancy.defaultExpectation.params != nil {
+		mmGetVacancy.mock.t.Fatalf("VacancyStorageMock.GetVacancy mock is already set by Expect")
+	}
+
+	if mmGetVacancy.defaultExpectation.paramPtrs == nil {
+		mmGetVacancy.defaultExpectation.paramPtrs = &VacancyStorageMockGetVacancyParamPtrs{}
+	}
+	mmGetVacancy.defaultExpectation.paramPtrs.ctx = &ctx
+	mmGetVacancy.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmGetVacancy
+}
+
+// ExpectVacancyIDParam2 sets up expected param vacancyID for VacancyStorage.GetVacancy
+func (mmGetVacancy *mVacancyStorageMockGetVacancy) ExpectVacancyIDParam2(vacancyID string) *mVacancyStorageMockGetVacancy {
+	if mmGetVacancy.mock.funcGetVacancy != nil {
+		mmGetVacancy.mock.t.Fatalf("VacancyStorageMock.GetVacancy mock is already set by Set")
+	}
+
+	if mmGetVacancy.defaultExpectation == nil {
+		mmGetVacancy.defaultExpectation = &VacancyStorageMockGetVacancyExpectation{}
+	}
+
+	if mmGetVacancy.defaultExpectation.params != nil {
+		mmGetVacancy.mock.t.Fatalf("VacancyStorageMock.GetVacancy mock is already set by Expect")
+	}
+
+	if mmGetVacancy.defaultExpectation.paramPtrs == nil {
+		mmGetVacancy.defaultExpectation.paramPtrs = &VacancyStorageMockGetVacancyParamPtrs{}
+	}
+	mmGetVacancy.defaultExpectation.paramPtrs.vacancyID = &vacancyID
+	mmGetVacancy.defaultExpectation.expectationOrigins.originVacancyID = minimock.CallerInfo(1)
+
+	return mmGetVacancy
+}
+
+// ExpectOwnerUserIDParam3 sets up expected param ownerUserID for VacancyStorage.GetVacancy
+func (mmGetVacancy *mVacancyStorageMockGetVacancy) ExpectOwnerUserIDParam3(ownerUserID uint64) *mVacancyStorageMockGetVacancy {
+	if mmGetVacancy.mock.funcGetVacancy != nil {
+		mmGetVacancy.mock.t.Fatalf("VacancyStorageMock.GetVacancy mock is already set by Set")
+	}
+
+	if mmGetVacancy.defaultExpectation == nil {
+		mmGetVacancy.defaultExpectation = &VacancyStorageMockGetVacancyExpectation{}
+	}
+
+	if mmGetVacancy.defaultExpectation.params != nil {
+		mmGetVacancy.mock.t.Fatalf("VacancyStorageMock.GetVacancy mock is already set by Expect")
+	}
+
+	if mmGetVacancy.defaultExpectation.paramPtrs == nil {
+		mmGetVacancy.defaultExpectation.paramPtrs = &VacancyStorageMockGetVacancyParamPtrs{}
+	}
+	mmGetVacancy.defaultExpectation.paramPtrs.ownerUserID = &ownerUserID
+	mmGetVacancy.defaultExpectation.expectationOrigins.originOwnerUserID = minimock.CallerInfo(1)
+
+	return mmGetVacancy
+}
+
+// ExpectIsAdminParam4 sets up expected param isAdmin for VacancyStorage.GetVacancy
+func (mmGetVacancy *mVacancyStorageMockGetVacancy) ExpectIsAdminParam4(isAdmin bool) *mVacancyStorageMockGetVacancy {
+	if mmGetVacancy.mock.funcGetVacancy != nil {
+		mmGetVacancy.mock.t.Fatalf("VacancyStorageMock.GetVacancy mock is already set by Set")
+	}
+
+	if mmGetVacancy.defaultExpectation == nil {
+		mmGetVacancy.defaultExpectation = &VacancyStorageMockGetVacancyExpectation{}
+	}
+
+	if mmGetVacancy.defaultExpectation.params != nil {
+		mmGetVacancy.mock.t.Fatalf("VacancyStorageMock.GetVacancy mock is already set by Expect")
+	}
+
+	if mmGetVacancy.defaultExpectation.paramPtrs == nil {
+		mmGetVacancy.defaultExpectation.paramPtrs = &VacancyStorageMockGetVacancyParamPtrs{}
+	}
+	mmGetVacancy.defaultExpectation.paramPtrs.isAdmin = &isAdmin
+	mmGetVacancy.defaultExpectation.expectationOrigins.originIsAdmin = minimock.CallerInfo(1)
+
+	return mmGetVacancy
+}
+
+// Inspect accepts an inspector function that has same arguments as the VacancyStorage.GetVacancy
+func (mmGetVacancy *mVacancyStorageMockGetVacancy) Inspect(f func(ctx context.Context, vacancyID string, ownerUserID uint64, isAdmin bool)) *mVacancyStorageMockGetVacancy {
+	if mmGetVacancy.mock.inspectFuncGetVacancy != nil {
+		mmGetVacancy.mock.t.Fatalf("Inspect function is already set for VacancyStorageMock.GetVacancy")
+	}
+
+	mmGetVacancy.mock.inspectFuncGetVacancy = f
+
+	return mmGetVacancy
+}
+
+// Return sets up results that will be returned by VacancyStorage.GetVacancy
+func (mmGetVacancy *mVacancyStorageMockGetVacancy) Return(vp1 *domain.Vacancy, err error) *VacancyStorageMock {
+	if mmGetVacancy.mock.funcGetVacancy != nil {
+		mmGetVacancy.mock.t.Fatalf("VacancyStorageMock.GetVacancy mock is already set by Set")
+	}
+
+	if mmGetVacancy.defaultExpectation == nil {
+		mmGetVacancy.defaultExpectation = &VacancyStorageMockGetVacancyExpectation{mock: mmGetVacancy.mock}
+	}
+	mmGetVacancy.defaultExpectation.results = &VacancyStorageMockGetVacancyResults{vp1, err}
+	mmGetVacancy.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmGetVacancy.mock
+}
+
+// Set uses given function f to mock the VacancyStorage.GetVacancy method
+func (mmGetVacancy *mVacancyStorageMockGetVacancy) Set(f func(ctx context.Context, vacancyID string, ownerUserID uint64, isAdmin bool) (vp1 *domain.Vacancy, err error)) *VacancyStorageMock {
+	if mmGetVacancy.defaultExpectation != nil {
+		mmGetVacancy.mock.t.Fatalf("Default expectation is already set for the VacancyStorage.GetVacancy method")
+	}
+
+	if len(mmGetVacancy.expectations) > 0 {
+		mmGetVacancy.mock.t.Fatalf("Some expectations are already set for the VacancyStorage.GetVacancy method")
+	}
+
+	mmGetVacancy.mock.funcGetVacancy = f
+	mmGetVacancy.mock.funcGetVacancyOrigin = minimock.CallerInfo(1)
+	return mmGetVacancy.mock
+}
+
+// When sets expectation for the VacancyStorage.GetVacancy which will trigger the result defined by the following
+// Then helper
+func (mmGetVacancy *mVacancyStorageMockGetVacancy) When(ctx context.Context, vacancyID string, ownerUserID uint64, isAdmin bool) *VacancyStorageMockGetVacancyExpectation {
+	if mmGetVacancy.mock.funcGetVacancy != nil {
+		mmGetVacancy.mock.t.Fatalf("VacancyStorageMock.GetVacancy mock is already set by Set")
+	}
+
+	expectation := &VacancyStorageMockGetVacancyExpectation{
+		mock:               mmGetVacancy.mock,
+		params:             &VacancyStorageMockGetVacancyParams{ctx, vacancyID, ownerUserID, isAdmin},
+		expectationOrigins: VacancyStorageMockGetVacancyExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmGetVacancy.expectations = append(mmGetVacancy.expectations, expectation)
+	return expectation
+}
+
+// Then sets up VacancyStorage.GetVacancy return parameters for the expectation previously defined by the When method
+func (e *VacancyStorageMockGetVacancyExpectation) Then(vp1 *domain.Vacancy, err error) *VacancyStorageMock {
+	e.results = &VacancyStorageMockGetVacancyResults{vp1, err}
+	return e.mock
+}
+
+// Times sets number of times VacancyStorage.GetVacancy should be invoked
+func (mmGetVacancy *mVacancyStorageMockGetVacancy) Times(n uint64) *mVacancyStorageMockGetVacancy {
+	if n == 0 {
+		mmGetVacancy.mock.t.Fatalf("Times of VacancyStorageMock.GetVacancy mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmGetVacancy.expectedInvocations, n)
+	mmGetVacancy.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmGetVacancy
+}
+
+func (mmGetVacancy *mVacancyStorageMockGetVacancy) invocationsDone() bool {
+	if len(mmGetVacancy.expectations) == 0 && mmGetVacancy.defaultExpectation == nil && mmGetVacancy.mock.funcGetVacancy == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmGetVacancy.mock.afterGetVacancyCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmGetVacancy.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// GetVacancy implements mm_usecase.VacancyStorage
+func (mmGetVacancy *VacancyStorageMock) GetVacancy(ctx context.Context, vacancyID string, ownerUserID uint64, isAdmin bool) (vp1 *domain.Vacancy, err error) {
+	mm_atomic.AddUint64(&mmGetVacancy.beforeGetVacancyCounter, 1)
+	defer mm_atomic.AddUint64(&mmGetVacancy.afterGetVacancyCounter, 1)
+
+	mmGetVacancy.t.Helper()
+
+	if mmGetVacancy.inspectFuncGetVacancy != nil {
+		mmGetVacancy.inspectFuncGetVacancy(ctx, vacancyID, ownerUserID, isAdmin)
+	}
+
+	mm_params := VacancyStorageMockGetVacancyParams{ctx, vacancyID, ownerUserID, isAdmin}
+
+	// Record call args
+	mmGetVacancy.GetVacancyMock.mutex.Lock()
+	mmGetVacancy.GetVacancyMock.callArgs = append(mmGetVacancy.GetVacancyMock.callArgs, &mm_params)
+	mmGetVacancy.GetVacancyMock.mutex.Unlock()
+
+	for _, e := range mmGetVacancy.GetVacancyMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.vp1, e.results.err
+		}
+	}
+
+	if mmGetVacancy.GetVacancyMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmGetVacancy.GetVacancyMock.defaultExpectation.Counter, 1)
+		mm_want := mmGetVacancy.GetVacancyMock.defaultExpectation.params
+		mm_want_ptrs := mmGetVacancy.GetVacancyMock.defaultExpectation.paramPtrs
+
+		mm_got := VacancyStorageMockGetVacancyParams{ctx, vacancyID, ownerUserID, isAdmin}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmGetVacancy.t.Errorf("VacancyStorageMock.GetVacancy got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmGetVacancy.GetVacancyMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.vacancyID != nil && !minimock.Equal(*mm_want_ptrs.vacancyID, mm_got.vacancyID) {
+				mmGetVacancy.t.Errorf("VacancyStorageMock.GetVacancy got unexpected parameter vacancyID, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmGetVacancy.GetVacancyMock.defaultExpectation.expectationOrigins.originVacancyID, *mm_want_ptrs.vacancyID, mm_got.vacancyID, minimock.Diff(*mm_want_ptrs.vacancyID, mm_got.vacancyID))
+			}
+
+			if mm_want_ptrs.ownerUserID != nil && !minimock.Equal(*mm_want_ptrs.ownerUserID, mm_got.ownerUserID) {
+				mmGetVacancy.t.Errorf("VacancyStorageMock.GetVacancy got unexpected parameter ownerUserID, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmGetVacancy.GetVacancyMock.defaultExpectation.expectationOrigins.originOwnerUserID, *mm_want_ptrs.ownerUserID, mm_got.ownerUserID, minimock.Diff(*mm_want_ptrs.ownerUserID, mm_got.ownerUserID))
+			}
+
+			if mm_want_ptrs.isAdmin != nil && !minimock.Equal(*mm_want_ptrs.isAdmin, mm_got.isAdmin) {
+				mmGetVacancy.t.Errorf("VacancyStorageMock.GetVacancy got unexpected parameter isAdmin, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmGetVacancy.GetVacancyMock.defaultExpectation.expectationOrigins.originIsAdmin, *mm_want_ptrs.isAdmin, mm_got.isAdmin, minimock.Diff(*mm_want_ptrs.isAdmin, mm_got.isAdmin))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmGetVacancy.t.Errorf("VacancyStorageMock.GetVacancy got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmGetVacancy.GetVacancyMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmGetVacancy.GetVacancyMock.defaultExpectation.results
+		if mm_results == nil {
+			mmGetVacancy.t.Fatal("No results are set for the VacancyStorageMock.GetVacancy")
+		}
+		return (*mm_results).vp1, (*mm_results).err
+	}
+	if mmGetVacancy.funcGetVacancy != nil {
+		return mmGetVacancy.funcGetVacancy(ctx, vacancyID, ownerUserID, isAdmin)
+	}
+	mmGetVacancy.t.Fatalf("Unexpected call to VacancyStorageMock.GetVacancy. %v %v %v %v", ctx, vacancyID, ownerUserID, isAdmin)
+	return
+}
+
+// GetVacancyAfterCounter returns a count of finished VacancyStorageMock.GetVacancy invocations
+func (mmGetVacancy *VacancyStorageMock) GetVacancyAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmGetVacancy.afterGetVacancyCounter)
+}
+
+// GetVacancyBeforeCounter returns a count of VacancyStorageMock.GetVacancy invocations
+func (mmGetVacancy *VacancyStorageMock) GetVacancyBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmGetVacancy.beforeGetVacancyCounter)
+}
+
+// Calls returns a list of arguments used in each call to VacancyStorageMock.GetVacancy.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmGetVacancy *mVacancyStorageMockGetVacancy) Calls() []*VacancyStorageMockGetVacancyParams {
+	mmGetVacancy.mutex.RLock()
+
+	argCopy := make([]*VacancyStorageMockGetVacancyParams, len(mmGetVacancy.callArgs))
+	copy(argCopy, mmGetVacancy.callArgs)
+
+	mmGetVacancy.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockGetVacancyDone returns true if the count of the GetVacancy invocations corresponds
+// the number of defined expectations
+func (m *VacancyStorageMock) MinimockGetVacancyDone() bool {
+	if m.GetVacancyMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.GetVacancyMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.GetVacancyMock.invocationsDone()
+}
+
+// MinimockGetVacancyInspect logs each unmet expectation
+func (m *VacancyStorageMock) MinimockGetVacancyInspect() {
+	for _, e := range m.GetVacancyMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to VacancyStorageMock.GetVacancy at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterGetVacancyCounter := mm_atomic.LoadUint64(&m.afterGetVacancyCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.GetVacancyMock.defaultExpectation != nil && afterGetVacancyCounter < 1 {
+		if m.GetVacancyMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to VacancyStorageMock.GetVacancy at\n%s", m.GetVacancyMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to VacancyStorageMock.GetVacancy at\n%s with params: %#v", m.GetVacancyMock.defaultExpectation.expectationOrigins.origin, *m.GetVacancyMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcGetVacancy != nil && afterGetVacancyCounter < 1 {
+		m.t.Errorf("Expected call to VacancyStorageMock.GetVacancy at\n%s", m.funcGetVacancyOrigin)
+	}
+
+	if !m.GetVacancyMock.invocationsDone() && afterGetVacancyCounter > 0 {
+		m.t.Errorf("Expected %d calls to VacancyStorageMock.GetVacancy at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.GetVacancyMock.expectedInvocations), m.GetVacancyMock.expectedInvocationsOrigin, afterGetVacancyCounter)
+	}
+}
+
+type mVacancyStorageMockGetVacancyVersion struct {
+	optional           bool
+	mock               *VacancyStorageMock
+	defaultExpectation *VacancyStorageMockGetVacancyVersionExpectation
+	expectations       []*VacancyStorageMockGetVacancyVersionExpectation
+
+	callArgs []*VacancyStorageMockGetVacancyVersionParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// VacancyStorageMockGetVacancyVersionExpectation specifies expectation struct of the VacancyStorage.GetVacancyVersion
+type VacancyStorageMockGetVacancyVersionExpectation struct {
+	mock               *VacancyStorageMock
+	params             *VacancyStorageMockGetVacancyVersionParams
+	paramPtrs          *VacancyStorageMockGetVacancyVersionParamPtrs
+	expectationOrigins VacancyStorageMockGetVacancyVersionExpectationOrigins
+	results            *VacancyStorageMockGetVacancyVersionResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// VacancyStorageMockGetVacancyVersionParams contains parameters of the VacancyStorage.GetVacancyVersion
+type VacancyStorageMockGetVacancyVersionParams struct {
+	ctx context.Context
+	in  domain.GetVacancyVersionInput
+}
+
+// VacancyStorageMockGetVacancyVersionParamPtrs contains pointers to parameters of the VacancyStorage.GetVacancyVersion
+type VacancyStorageMockGetVacancyVersionParamPtrs struct {
+	ctx *context.Context
+	in  *domain.GetVacancyVersionInput
+}
+
+// VacancyStorageMockGetVacancyVersionResults contains results of the VacancyStorage.GetVacancyVersion
+type VacancyStorageMockGetVacancyVersionResults struct {
+	vp1 *domain.VacancyVersion
+	err error
+}
+
+// VacancyStorageMockGetVacancyVersionOrigins contains origins of expectations of the VacancyStorage.GetVacancyVersion
+type VacancyStorageMockGetVacancyVersionExpectationOrigins struct {
+	origin    string
+	originCtx string
+	originIn  string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmGetVacancyVersion *mVacancyStorageMockGetVacancyVersion) Optional() *mVacancyStorageMockGetVacancyVersion {
+	mmGetVacancyVersion.optional = true
+	return mmGetVacancyVersion
+}
+
+// Expect sets up expected params for VacancyStorage.GetVacancyVersion
+func (mmGetVacancyVersion *mVacancyStorageMockGetVacancyVersion) Expect(ctx context.Context, in domain.GetVacancyVersionInput) *mVacancyStorageMockGetVacancyVersion {
+	if mmGetVacancyVersion.mock.funcGetVacancyVersion != nil {
+		mmGetVacancyVersion.mock.t.Fatalf("VacancyStorageMock.GetVacancyVersion mock is already set by Set")
+	}
+
+	if mmGetVacancyVersion.defaultExpectation == nil {
+		mmGetVacancyVersion.defaultExpectation = &VacancyStorageMockGetVacancyVersionExpectation{}
+	}
+
+	if mmGetVacancyVersion.defaultExpectation.paramPtrs != nil {
+		mmGetVacancyVersion.mock.t.Fatalf("VacancyStorageMock.GetVacancyVersion mock is already set by ExpectParams functions")
+	}
+
+	mmGetVacancyVersion.defaultExpectation.params = &VacancyStorageMockGetVacancyVersionParams{ctx, in}
+	mmGetVacancyVersion.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmGetVacancyVersion.expectations {
+		if minimock.Equal(e.params, mmGetVacancyVersion.defaultExpectation.params) {
+			mmGetVacancyVersion.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmGetVacancyVersion.defaultExpectation.params)
+		}
+	}
+
+	return mmGetVacancyVersion
+}
+
+// ExpectCtxParam1 sets up expected param ctx for VacancyStorage.GetVacancyVersion
+func (mmGetVacancyVersion *mVacancyStorageMockGetVacancyVersion) ExpectCtxParam1(ctx context.Context) *mVacancyStorageMockGetVacancyVersion {
+	if mmGetVacancyVersion.mock.funcGetVacancyVersion != nil {
+		mmGetVacancyVersion.mock.t.Fatalf("VacancyStorageMock.GetVacancyVersion mock is already set by Set")
+	}
+
+	if mmGetVacancyVersion.defaultExpectation == nil {
+		mmGetVacancyVersion.defaultExpectation = &VacancyStorageMockGetVacancyVersionExpectation{}
+	}
+
+	if mmGetVacancyVersion.defaultExpectation.params != nil {
+		mmGetVacancyVersion.mock.t.Fatalf("VacancyStorageMock.GetVacancyVersion mock is already set by Expect")
+	}
+
+	if mmGetVacancyVersion.defaultExpectation.paramPtrs == nil {
+		mmGetVacancyVersion.defaultExpectation.paramPtrs = &VacancyStorageMockGetVacancyVersionParamPtrs{}
+	}
+	mmGetVacancyVersion.defaultExpectation.paramPtrs.ctx = &ctx
+	mmGetVacancyVersion.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmGetVacancyVersion
+}
+
+// ExpectInParam2 sets up expected param in for VacancyStorage.GetVacancyVersion
+func (mmGetVacancyVersion *mVacancyStorageMockGetVacancyVersion) ExpectInParam2(in domain.GetVacancyVersionInput) *mVacancyStorageMockGetVacancyVersion {
+	if mmGetVacancyVersion.mock.funcGetVacancyVersion != nil {
+		mmGetVacancyVersion.mock.t.Fatalf("VacancyStorageMock.GetVacancyVersion mock is already set by Set")
+	}
+
+	if mmGetVacancyVersion.defaultExpectation == nil {
+		mmGetVacancyVersion.defaultExpectation = &VacancyStorageMockGetVacancyVersionExpectation{}
+	}
+
+	if mmGetVacancyVersion.defaultExpectation.params != nil {
+		mmGetVacancyVersion.mock.t.Fatalf("VacancyStorageMock.GetVacancyVersion mock is already set by Expect")
+	}
+
+	if mmGetVacancyVersion.defaultExpectation.paramPtrs == nil {
+		mmGetVacancyVersion.defaultExpectation.paramPtrs = &VacancyStorageMockGetVacancyVersionParamPtrs{}
+	}
+	mmGetVacancyVersion.defaultExpectation.paramPtrs.in = &in
+	mmGetVacancyVersion.defaultExpectation.expectationOrigins.originIn = minimock.CallerInfo(1)
+
+	return mmGetVacancyVersion
+}
+
+// Inspect accepts an inspector function that has same arguments as the VacancyStorage.GetVacancyVersion
+func (mmGetVacancyVersion *mVacancyStorageMockGetVacancyVersion) Inspect(f func(ctx context.Context, in domain.GetVacancyVersionInput)) *mVacancyStorageMockGetVacancyVersion {
+	if mmGetVacancyVersion.mock.inspectFuncGetVacancyVersion != nil {
+		mmGetVacancyVersion.mock.t.Fatalf("Inspect function is already set for VacancyStorageMock.GetVacancyVersion")
+	}
+
+	mmGetVacancyVersion.mock.inspectFuncGetVacancyVersion = f
+
+	return mmGetVacancyVersion
+}
+
+// Return sets up results that will be returned by VacancyStorage.GetVacancyVersion
+func (mmGetVacancyVersion *mVacancyStorageMockGetVacancyVersion) Return(vp1 *domain.VacancyVersion, err error) *VacancyStorageMock {
+	if mmGetVacancyVersion.mock.funcGetVacancyVersion != nil {
+		mmGetVacancyVersion.mock.t.Fatalf("VacancyStorageMock.GetVacancyVersion mock is already set by Set")
+	}
+
+	if mmGetVacancyVersion.defaultExpectation == nil {
+		mmGetVacancyVersion.defaultExpectation = &VacancyStorageMockGetVacancyVersionExpectation{mock: mmGetVacancyVersion.mock}
+	}
+	mmGetVacancyVersion.defaultExpectation.results = &VacancyStorageMockGetVacancyVersionResults{vp1, err}
+	mmGetVacancyVersion.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmGetVacancyVersion.mock
+}
+
+// Set uses given function f to mock the VacancyStorage.GetVacancyVersion method
+func (mmGetVacancyVersion *mVacancyStorageMockGetVacancyVersion) Set(f func(ctx context.Context, in domain.GetVacancyVersionInput) (vp1 *domain.VacancyVersion, err error)) *VacancyStorageMock {
+	if mmGetVacancyVersion.defaultExpectation != nil {
+		mmGetVacancyVersion.mock.t.Fatalf("Default expectation is already set for the VacancyStorage.GetVacancyVersion method")
+	}
+
+	if len(mmGetVacancyVersion.expectations) > 0 {
+		mmGetVacancyVersion.mock.t.Fatalf("Some expectations are already set for the VacancyStorage.GetVacancyVersion method")
+	}
+
+	mmGetVacancyVersion.mock.funcGetVacancyVersion = f
+	mmGetVacancyVersion.mock.funcGetVacancyVersionOrigin = minimock.CallerInfo(1)
+	return mmGetVacancyVersion.mock
+}
+
+// When sets expectation for the VacancyStorage.GetVacancyVersion which will trigger the result defined by the following
+// Then helper
+func (mmGetVacancyVersion *mVacancyStorageMockGetVacancyVersion) When(ctx context.Context, in domain.GetVacancyVersionInput) *VacancyStorageMockGetVacancyVersionExpectation {
+	if mmGetVacancyVersion.mock.funcGetVacancyVersion != nil {
+		mmGetVacancyVersion.mock.t.Fatalf("VacancyStorageMock.GetVacancyVersion mock is already set by Set")
+	}
+
+	expectation := &VacancyStorageMockGetVacancyVersionExpectation{
+		mock:               mmGetVacancyVersion.mock,
+		params:             &VacancyStorageMockGetVacancyVersionParams{ctx, in},
+		expectationOrigins: VacancyStorageMockGetVacancyVersionExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmGetVacancyVersion.expectations = append(mmGetVacancyVersion.expectations, expectation)
+	return expectation
+}
+
+// Then sets up VacancyStorage.GetVacancyVersion return parameters for the expectation previously defined by the When method
+func (e *VacancyStorageMockGetVacancyVersionExpectation) Then(vp1 *domain.VacancyVersion, err error) *VacancyStorageMock {
+	e.results = &VacancyStorageMockGetVacancyVersionResults{vp1, err}
+	return e.mock
+}
+
+// Times sets number of times VacancyStorage.GetVacancyVersion should be invoked
+func (mmGetVacancyVersion *mVacancyStorageMockGetVacancyVersion) Times(n uint64) *mVacancyStorageMockGetVacancyVersion {
+	if n == 0 {
+		mmGetVacancyVersion.mock.t.Fatalf("Times of VacancyStorageMock.GetVacancyVersion mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmGetVacancyVersion.expectedInvocations, n)
+	mmGetVacancyVersion.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmGetVacancyVersion
+}
+
+func (mmGetVacancyVersion *mVacancyStorageMockGetVacancyVersion) invocationsDone() bool {
+	if len(mmGetVacancyVersion.expectations) == 0 && mmGetVacancyVersion.defaultExpectation == nil && mmGetVacancyVersion.mock.funcGetVacancyVersion == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmGetVacancyVersion.mock.afterGetVacancyVersionCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmGetVacancyVersion.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// GetVacancyVersion implements mm_usecase.VacancyStorage
+func (mmGetVacancyVersion *VacancyStorageMock) GetVacancyVersion(ctx context.Context, in domain.GetVacancyVersionInput) (vp1 *domain.VacancyVersion, err error) {
+	mm_atomic.AddUint64(&mmGetVacancyVersion.beforeGetVacancyVersionCounter, 1)
+	defer mm_atomic.AddUint64(&mmGetVacancyVersion.afterGetVacancyVersionCounter, 1)
+
+	mmGetVacancyVersion.t.Helper()
+
+	if mmGetVacancyVersion.inspectFuncGetVacancyVersion != nil {
+		mmGetVacancyVersion.inspectFuncGetVacancyVersion(ctx, in)
+	}
+
+	mm_params := VacancyStorageMockGetVacancyVersionParams{ctx, in}
+
+	// Record call args
+	mmGetVacancyVersion.GetVacancyVersionMock.mutex.Lock()
+	mmGetVacancyVersion.GetVacancyVersionMock.callArgs = append(mmGetVacancyVersion.GetVacancyVersionMock.callArgs, &mm_params)
+	mmGetVacancyVersion.GetVacancyVersionMock.mutex.Unlock()
+
+	for _, e := range mmGetVacancyVersion.GetVacancyVersionMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.vp1, e.results.err
+		}
+	}
+
+	if mmGetVacancyVersion.GetVacancyVersionMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmGetVacancyVersion.GetVacancyVersionMock.defaultExpectation.Counter, 1)
+		mm_want := mmGetVacancyVersion.GetVacancyVersionMock.defaultExpectation.params
+		mm_want_ptrs := mmGetVacancyVersion.GetVacancyVersionMock.defaultExpectation.paramPtrs
+
+		mm_got := VacancyStorageMockGetVacancyVersionParams{ctx, in}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmGetVacancyVersion.t.Errorf("VacancyStorageMock.GetVacancyVersion got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmGetVacancyVersion.GetVacancyVersionMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.in != nil && !minimock.Equal(*mm_want_ptrs.in, mm_got.in) {
+				mmGetVacancyVersion.t.Errorf("VacancyStorageMock.GetVacancyVersion got unexpected parameter in, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmGetVacancyVersion.GetVacancyVersionMock.defaultExpectation.expectationOrigins.originIn, *mm_want_ptrs.in, mm_got.in, minimock.Diff(*mm_want_ptrs.in, mm_got.in))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmGetVacancyVersion.t.Errorf("VacancyStorageMock.GetVacancyVersion got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmGetVacancyVersion.GetVacancyVersionMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmGetVacancyVersion.GetVacancyVersionMock.defaultExpectation.results
+		if mm_results == nil {
+			mmGetVacancyVersion.t.Fatal("No results are set for the VacancyStorageMock.GetVacancyVersion")
+		}
+		return (*mm_results).vp1, (*mm_results).err
+	}
+	if mmGetVacancyVersion.funcGetVacancyVersion != nil {
+		return mmGetVacancyVersion.funcGetVacancyVersion(ctx, in)
+	}
+	mmGetVacancyVersion.t.Fatalf("Unexpected call to VacancyStorageMock.GetVacancyVersion. %v %v", ctx, in)
+	return
+}
+
+// GetVacancyVersionAfterCounter returns a count of finished VacancyStorageMock.GetVacancyVersion invocations
+func (mmGetVacancyVersion *VacancyStorageMock) GetVacancyVersionAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmGetVacancyVersion.afterGetVacancyVersionCounter)
+}
+
+// GetVacancyVersionBeforeCounter returns a count of VacancyStorageMock.GetVacancyVersion invocations
+func (mmGetVacancyVersion *VacancyStorageMock) GetVacancyVersionBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmGetVacancyVersion.beforeGetVacancyVersionCounter)
+}
+
+// Calls returns a list of arguments used in each call to VacancyStorageMock.GetVacancyVersion.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmGetVacancyVersion *mVacancyStorageMockGetVacancyVersion) Calls() []*VacancyStorageMockGetVacancyVersionParams {
+	mmGetVacancyVersion.mutex.RLock()
+
+	argCopy := make([]*VacancyStorageMockGetVacancyVersionParams, len(mmGetVacancyVersion.callArgs))
+	copy(argCopy, mmGetVacancyVersion.callArgs)
+
+	mmGetVacancyVersion.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockGetVacancyVersionDone returns true if the count of the GetVacancyVersion invocations corresponds
+// the number of defined expectations
+func (m *VacancyStorageMock) MinimockGetVacancyVersionDone() bool {
+	if m.GetVacancyVersionMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.GetVacancyVersionMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.GetVacancyVersionMock.invocationsDone()
+}
+
+// MinimockGetVacancyVersionInspect logs each unmet expectation
+func (m *VacancyStorageMock) MinimockGetVacancyVersionInspect() {
+	for _, e := range m.GetVacancyVersionMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to VacancyStorageMock.GetVacancyVersion at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterGetVacancyVersionCounter := mm_atomic.LoadUint64(&m.afterGetVacancyVersionCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.GetVacancyVersionMock.defaultExpectation != nil && afterGetVacancyVersionCounter < 1 {
+		if m.GetVacancyVersionMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to VacancyStorageMock.GetVacancyVersion at\n%s", m.GetVacancyVersionMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to VacancyStorageMock.GetVacancyVersion at\n%s with params: %#v", m.GetVacancyVersionMock.defaultExpectation.expectationOrigins.origin, *m.GetVacancyVersionMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcGetVacancyVersion != nil && afterGetVacancyVersionCounter < 1 {
+		m.t.Errorf("Expected call to VacancyStorageMock.GetVacancyVersion at\n%s", m.funcGetVacancyVersionOrigin)
+	}
+
+	if !m.GetVacancyVersionMock.invocationsDone() && afterGetVacancyVersionCounter > 0 {
+		m.t.Errorf("Expected %d calls to VacancyStorageMock.GetVacancyVersion at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.GetVacancyVersionMock.expectedInvocations), m.GetVacancyVersionMock.expectedInvocationsOrigin, afterGetVacancyVersionCounter)
+	}
+}
+
+type mVacancyStorageMockListCandidateStageHistory struct {
+	optional           bool
+	mock               *VacancyStorageMock
+	defaultExpectation *VacancyStorageMockListCandidateStageHistoryExpectation
+	expectations       []*VacancyStorageMockListCandidateStageHistoryExpectation
+
+	callArgs []*VacancyStorageMockListCandidateStageHistoryParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// VacancyStorageMockListCandidateStageHistoryExpectation specifies expectation struct of the VacancyStorage.ListCandidateStageHistory
+type VacancyStorageMockListCandidateStageHistoryExpectation struct {
+	mock               *VacancyStorageMock
+	params             *VacancyStorageMockListCandidateStageHistoryParams
+	paramPtrs          *VacancyStorageMockListCandidateStageHistoryParamPtrs
+	expectationOrigins VacancyStorageMockListCandidateStageHistoryExpectationOrigins
+	results            *VacancyStorageMockListCandidateStageHistoryResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// VacancyStorageMockListCandidateStageHistoryParams contains parameters of the VacancyStorage.ListCandidateStageHistory
+type VacancyStorageMockListCandidateStageHistoryParams struct {
+	ctx         context.Context
+	vacancyID   string
+	candidateID string
+}
+
+// VacancyStorageMockListCandidateStageHistoryParamPtrs contains pointers to parameters of the VacancyStorage.ListCandidateStageHistory
+type VacancyStorageMockListCandidateStageHistoryParamPtrs struct {
+	ctx         *context.Context
+	vacancyID   *string
+	candidateID *string
+}
+
+// VacancyStorageMockListCandidateStageHistoryResults contains results of the VacancyStorage.ListCandidateStageHistory
+type VacancyStorageMockListCandidateStageHistoryResults struct {
+	ca1 []domain.CandidateStageChange
+	err error
+}
+
+// VacancyStorageMockListCandidateStageHistoryOrigins contains origins of expectations of the VacancyStorage.ListCandidateStageHistory
+type VacancyStorageMockListCandidateStageHistoryExpectationOrigins struct {
+	origin            string
+	originCtx         string
+	originVacancyID   string
+	originCandidateID string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmListCandidateStageHistory *mVacancyStorageMockListCandidateStageHistory) Optional() *mVacancyStorageMockListCandidateStageHistory {
+	mmListCandidateStageHistory.optional = true
+	return mmListCandidateStageHistory
+}
+
+// Expect sets up expected params for VacancyStorage.ListCandidateStageHistory
+func (mmListCandidateStageHistory *mVacancyStorageMockListCandidateStageHistory) Expect(ctx context.Context, vacancyID string, candidateID string) *mVacancyStorageMockListCandidateStageHistory {
+	if mmListCandidateStageHistory.mock.funcListCandidateStageHistory != nil {
+		mmListCandidateStageHistory.mock.t.Fatalf("VacancyStorageMock.ListCandidateStageHistory mock is already set by Set")
+	}
+
+	if mmListCandidateStageHistory.defaultExpectation == nil {
+		mmListCandidateStageHistory.defaultExpectation = &VacancyStorageMockListCandidateStageHistoryExpectation{}
+	}
+
+	if mmListCandidateStageHistory.defaultExpectation.paramPtrs != nil {
+		mmListCandidateStageHistory.mock.t.Fatalf("VacancyStorageMock.ListCandidateStageHistory mock is already set by ExpectParams functions")
+	}
+
+	mmListCandidateStageHistory.defaultExpectation.params = &VacancyStorageMockListCandidateStageHistoryParams{ctx, vacancyID, candidateID}
+	mmListCandidateStageHistory.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmListCandidateStageHistory.expectations {
+		if minimock.Equal(e.params, mmListCandidateStageHistory.defaultExpectation.params) {
+			mmListCandidateStageHistory.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmListCandidateStageHistory.defaultExpectation.params)
+		}
+	}
+
+	return mmListCandidateStageHistory
+}
+
+// ExpectCtxParam1 sets up expected param ctx for VacancyStorage.ListCandidateStageHistory
+func (mmListCandidateStageHistory *mVacancyStorageMockListCandidateStageHistory) ExpectCtxParam1(ctx context.Context) *mVacancyStorageMockListCandidateStageHistory {
+	if mmListCandidateStageHistory.mock.funcListCandidateStageHistory != nil {
+		mmListCandidateStageHistory.mock.t.Fatalf("VacancyStorageMock.ListCandidateStageHistory mock is already set by Set")
+	}
+
+	if mmListCandidateStageHistory.defaultExpectation == nil {
+		mmListCandidateStageHistory.defaultExpectation = &VacancyStorageMockListCandidateStageHistoryExpectation{}
+	}
+
+	if mmListCandidateStageHistory.defaultExpectation.params != nil {
+		mmListCandidateStageHistory.mock.t.Fatalf("VacancyStorageMock.ListCandidateStageHistory mock is already set by Expect")
+	}
+
+	if mmListCandidateStageHistory.defaultExpectation.paramPtrs == nil {
+		mmListCandidateStageHistory.defaultExpectation.paramPtrs = &VacancyStorageMockListCandidateStageHistoryParamPtrs{}
+	}
+	mmListCandidateStageHistory.defaultExpectation.paramPtrs.ctx = &ctx
+	mmListCandidateStageHistory.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmListCandidateStageHistory
+}
+
+// ExpectVacancyIDParam2 sets up expected param vacancyID for VacancyStorage.ListCandidateStageHistory
+func (mmListCandidateStageHistory *mVacancyStorageMockListCandidateStageHistory) ExpectVacancyIDParam2(vacancyID string) *mVacancyStorageMockListCandidateStageHistory {
+	if mmListCandidateStageHistory.mock.funcListCandidateStageHistory != nil {
+		mmListCandidateStageHistory.mock.t.Fatalf("VacancyStorageMock.ListCandidateStageHistory mock is already set by Set")
+	}
+
+	if mmListCandidateStageHistory.defaultExpectation == nil {
+		mmListCandidateStageHistory.defaultExpectation = &VacancyStorageMockListCandidateStageHistoryExpectation{}
+	}
+
+	if mmListCandidateStageHistory.defaultExpectation.params != nil {
+		mmListCandidateStageHistory.mock.t.Fatalf("VacancyStorageMock.ListCandidateStageHistory mock is already set by Expect")
+	}
+
+	if mmListCandidateStageHistory.defaultExpectation.paramPtrs == nil {
+		mmListCandidateStageHistory.defaultExpectation.paramPtrs = &VacancyStorageMockListCandidateStageHistoryParamPtrs{}
+	}
+	mmListCandidateStageHistory.defaultExpectation.paramPtrs.vacancyID = &vacancyID
+	mmListCandidateStageHistory.defaultExpectation.expectationOrigins.originVacancyID = minimock.CallerInfo(1)
+
+	return mmListCandidateStageHistory
+}
+
+// ExpectCandidateIDParam3 sets up expected param candidateID for VacancyStorage.ListCandidateStageHistory
+func (mmListCandidateStageHistory *mVacancyStorageMockListCandidateStageHistory) ExpectCandidateIDParam3(candidateID string) *mVacancyStorageMockListCandidateStageHistory {
+	if mmListCandidateStageHistory.mock.funcListCandidateStageHistory != nil {
+		mmListCandidateStageHistory.mock.t.Fatalf("VacancyStorageMock.ListCandidateStageHistory mock is already set by Set")
+	}
+
+	if mmListCandidateStageHistory.defaultExpectation == nil {
+		mmListCandidateStageHistory.defaultExpectation = &VacancyStorageMockListCandidateStageHistoryExpectation{}
+	}
+
+	if mmListCandidateStageHistory.defaultExpectation.params != nil {
+		mmListCandidateStageHistory.mock.t.Fatalf("VacancyStorageMock.ListCandidateStageHistory mock is already set by Expect")
+	}
+
+	if mmListCandidateStageHistory.defaultExpectation.paramPtrs == nil {
+		mmListCandidateStageHistory.defaultExpectation.paramPtrs = &VacancyStorageMockListCandidateStageHistoryParamPtrs{}
+	}
+	mmListCandidateStageHistory.defaultExpectation.paramPtrs.candidateID = &candidateID
+	mmListCandidateStageHistory.defaultExpectation.expectationOrigins.originCandidateID = minimock.CallerInfo(1)
+
+	return mmListCandidateStageHistory
+}
+
+// Inspect accepts an inspector function that has same arguments as the VacancyStorage.ListCandidateStageHistory
+func (mmListCandidateStageHistory *mVacancyStorageMockListCandidateStageHistory) Inspect(f func(ctx context.Context, vacancyID string, candidateID string)) *mVacancyStorageMockListCandidateStageHistory {
+	if mmListCandidateStageHistory.mock.inspectFuncListCandidateStageHistory != nil {
+		mmListCandidateStageHistory.mock.t.Fatalf("Inspect function is already set for VacancyStorageMock.ListCandidateStageHistory")
+	}
+
+	mmListCandidateStageHistory.mock.inspectFuncListCandidateStageHistory = f
+
+	return mmListCandidateStageHistory
+}
+
+// Return sets up results that will be returned by VacancyStorage.ListCandidateStageHistory
+func (mmListCandidateStageHistory *mVacancyStorageMockListCandidateStageHistory) Return(ca1 []domain.CandidateStageChange, err error) *VacancyStorageMock {
+	if mmListCandidateStageHistory.mock.funcListCandidateStageHistory != nil {
+		mmListCandidateStageHistory.mock.t.Fatalf("VacancyStorageMock.ListCandidateStageHistory mock is already set by Set")
+	}
+
+	if mmListCandidateStageHistory.defaultExpectation == nil {
+		mmListCandidateStageHistory.defaultExpectation = &VacancyStorageMockListCandidateStageHistoryExpectation{mock: mmListCandidateStageHistory.mock}
+	}
+	mmListCandidateStageHistory.defaultExpectation.results = &VacancyStorageMockListCandidateStageHistoryResults{ca1, err}
+	mmListCandidateStageHistory.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmListCandidateStageHistory.mock
+}
+
+// Set uses given function f to mock the VacancyStorage.ListCandidateStageHistory method
+func (mmListCandidateStageHistory *mVacancyStorageMockListCandidateStageHistory) Set(f func(ctx context.Context, vacancyID string, candidateID string) (ca1 []domain.CandidateStageChange, err error)) *VacancyStorageMock {
+	if mmListCandidateStageHistory.defaultExpectation != nil {
+		mmListCandidateStageHistory.mock.t.Fatalf("Default expectation is already set for the VacancyStorage.ListCandidateStageHistory method")
+	}
+
+	if len(mmListCandidateStageHistory.expectations) > 0 {
+		mmListCandidateStageHistory.mock.t.Fatalf("Some expectations are already set for the VacancyStorage.ListCandidateStageHistory method")
+	}
+
+	mmListCandidateStageHistory.mock.funcListCandidateStageHistory = f
+	mmListCandidateStageHistory.mock.funcListCandidateStageHistoryOrigin = minimock.CallerInfo(1)
+	return mmListCandidateStageHistory.mock
+}
+
+// When sets expectation for the VacancyStorage.ListCandidateStageHistory which will trigger the result defined by the following
+// Then helper
+func (mmListCandidateStageHistory *mVacancyStorageMockListCandidateStageHistory) When(ctx context.Context, vacancyID string, candidateID string) *VacancyStorageMockListCandidateStageHistoryExpectation {
+	if mmListCandidateStageHistory.mock.funcListCandidateStageHistory != nil {
+		mmListCandidateStageHistory.mock.t.Fatalf("VacancyStorageMock.ListCandidateStageHistory mock is already set by Set")
+	}
+
+	expectation := &VacancyStorageMockListCandidateStageHistoryExpectation{
+		mock:               mmListCandidateStageHistory.mock,
+		params:             &VacancyStorageMockListCandidateStageHistoryParams{ctx, vacancyID, candidateID},
+		expectationOrigins: VacancyStorageMockListCandidateStageHistoryExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmListCandidateStageHistory.expectations = append(mmListCandidateStageHistory.expectations, expectation)
+	return expectation
+}
+
+// Then sets up VacancyStorage.ListCandidateStageHistory return parameters for the expectation previously defined by the When method
+func (e *VacancyStorageMockListCandidateStageHistoryExpectation) Then(ca1 []domain.CandidateStageChange, err error) *VacancyStorageMock {
+	e.results = &VacancyStorageMockListCandidateStageHistoryResults{ca1, err}
+	return e.mock
+}
+
+// Times sets number of times VacancyStorage.ListCandidateStageHistory should be invoked
+func (mmListCandidateStageHistory *mVacancyStorageMockListCandidateStageHistory) Times(n uint64) *mVacancyStorageMockListCandidateStageHistory {
+	if n == 0 {
+		mmListCandidateStageHistory.mock.t.Fatalf("Times of VacancyStorageMock.ListCandidateStageHistory mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmListCandidateStageHistory.expectedInvocations, n)
+	mmListCandidateStageHistory.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmListCandidateStageHistory
+}
+
+func (mmListCandidateStageHistory *mVacancyStorageMockListCandidateStageHistory) invocationsDone() bool {
+	if len(mmListCandidateStageHistory.expectations) == 0 && mmListCandidateStageHistory.defaultExpectation == nil && mmListCandidateStageHistory.mock.funcListCandidateStageHistory == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmListCandidateStageHistory.mock.afterListCandidateStageHistoryCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmListCandidateStageHistory.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// ListCandidateStageHistory implements mm_usecase.VacancyStorage
+func (mmListCandidateStageHistory *VacancyStorageMock) ListCandidateStageHistory(ctx context.Context, vacancyID string, candidateID string) (ca1 []domain.CandidateStageChange, err error) {
+	mm_atomic.AddUint64(&mmListCandidateStageHistory.beforeListCandidateStageHistoryCounter, 1)
+	defer mm_atomic.AddUint64(&mmListCandidateStageHistory.afterListCandidateStageHistoryCounter, 1)
+
+	mmListCandidateStageHistory.t.Helper()
+
+	if mmListCandidateStageHistory.inspectFuncListCandidateStageHistory != nil {
+		mmListCandidateStageHistory.inspectFuncListCandidateStageHistory(ctx, vacancyID, candidateID)
+	}
+
+	mm_params := VacancyStorageMockListCandidateStageHistoryParams{ctx, vacancyID, candidateID}
+
+	// Record call args
+	mmListCandidateStageHistory.ListCandidateStageHistoryMock.mutex.Lock()
+	mmListCandidateStageHistory.ListCandidateStageHistoryMock.callArgs = append(mmListCandidateStageHistory.ListCandidateStageHistoryMock.callArgs, &mm_params)
+	mmListCandidateStageHistory.ListCandidateStageHistoryMock.mutex.Unlock()
+
+	for _, e := range mmListCandidateStageHistory.ListCandidateStageHistoryMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.ca1, e.results.err
+		}
+	}
+
+	if mmListCandidateStageHistory.ListCandidateStageHistoryMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmListCandidateStageHistory.ListCandidateStageHistoryMock.defaultExpectation.Counter, 1)
+		mm_want := mmListCandidateStageHistory.ListCandidateStageHistoryMock.defaultExpectation.params
+		mm_want_ptrs := mmListCandidateStageHistory.ListCandidateStageHistoryMock.defaultExpectation.paramPtrs
+
+		mm_got := VacancyStorageMockListCandidateStageHistoryParams{ctx, vacancyID, candidateID}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmListCandidateStageHistory.t.Errorf("VacancyStorageMock.ListCandidateStageHistory got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmListCandidateStageHistory.ListCandidateStageHistoryMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.vacancyID != nil && !minimock.Equal(*mm_want_ptrs.vacancyID, mm_got.vacancyID) {
+				mmListCandidateStageHistory.t.Errorf("VacancyStorageMock.ListCandidateStageHistory got unexpected parameter vacancyID, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmListCandidateStageHistory.ListCandidateStageHistoryMock.defaultExpectation.expectationOrigins.originVacancyID, *mm_want_ptrs.vacancyID, mm_got.vacancyID, minimock.Diff(*mm_want_ptrs.vacancyID, mm_got.vacancyID))
+			}
+
+			if mm_want_ptrs.candidateID != nil && !minimock.Equal(*mm_want_ptrs.candidateID, mm_got.candidateID) {
+				mmListCandidateStageHistory.t.Errorf("VacancyStorageMock.ListCandidateStageHistory got unexpected parameter candidateID, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmListCandidateStageHistory.ListCandidateStageHistoryMock.defaultExpectation.expectationOrigins.originCandidateID, *mm_want_ptrs.candidateID, mm_got.candidateID, minimock.Diff(*mm_want_ptrs.candidateID, mm_got.candidateID))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmListCandidateStageHistory.t.Errorf("VacancyStorageMock.ListCandidateStageHistory got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmListCandidateStageHistory.ListCandidateStageHistoryMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmListCandidateStageHistory.ListCandidateStageHistoryMock.defaultExpectation.results
+		if mm_results == nil {
+			mmListCandidateStageHistory.t.Fatal("No results are set for the VacancyStorageMock.ListCandidateStageHistory")
+		}
+		return (*mm_results).ca1, (*mm_results).err
+	}
+	if mmListCandidateStageHistory.funcListCandidateStageHistory != nil {
+		return mmListCandidateStageHistory.funcListCandidateStageHistory(ctx, vacancyID, candidateID)
+	}
+	mmListCandidateStageHistory.t.Fatalf("Unexpected call to VacancyStorageMock.ListCandidateStageHistory. %v %v %v", ctx, vacancyID, candidateID)
+	return
+}
+
+// ListCandidateStageHistoryAfterCounter returns a count of finished VacancyStorageMock.ListCandidateStageHistory invocations
+func (mmListCandidateStageHistory *VacancyStorageMock) ListCandidateStageHistoryAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmListCandidateStageHistory.afterListCandidateStageHistoryCounter)
+}
+
+// ListCandidateStageHistoryBeforeCounter returns a count of VacancyStorageMock.ListCandidateStageHistory invocations
+func (mmListCandidateStageHistory *VacancyStorageMock) ListCandidateStageHistoryBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmListCandidateStageHistory.beforeListCandidateStageHistoryCounter)
+}
+
+// Calls returns a list of arguments used in each call to VacancyStorageMock.ListCandidateStageHistory.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmListCandidateStageHistory *mVacancyStorageMockListCandidateStageHistory) Calls() []*VacancyStorageMockListCandidateStageHistoryParams {
+	mmListCandidateStageHistory.mutex.RLock()
+
+	argCopy := make([]*VacancyStorageMockListCandidateStageHistoryParams, len(mmListCandidateStageHistory.callArgs))
+	copy(argCopy, mmListCandidateStageHistory.callArgs)
+
+	mmListCandidateStageHistory.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockListCandidateStageHistoryDone returns true if the count of the ListCandidateStageHistory invocations corresponds
+// the number of defined expectations
+func (m *VacancyStorageMock) MinimockListCandidateStageHistoryDone() bool {
+	if m.ListCandidateStageHistoryMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.ListCandidateStageHistoryMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.ListCandidateStageHistoryMock.invocationsDone()
+}
+
+// MinimockListCandidateStageHistoryInspect logs each unmet expectation
+func (m *VacancyStorageMock) MinimockListCandidateStageHistoryInspect() {
+	for _, e := range m.ListCandidateStageHistoryMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to VacancyStorageMock.ListCandidateStageHistory at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterListCandidateStageHistoryCounter := mm_atomic.LoadUint64(&m.afterListCandidateStageHistoryCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.ListCandidateStageHistoryMock.defaultExpectation != nil && afterListCandidateStageHistoryCounter < 1 {
+		if m.ListCandidateStageHistoryMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to VacancyStorageMock.ListCandidateStageHistory at\n%s", m.ListCandidateStageHistoryMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to VacancyStorageMock.ListCandidateStageHistory at\n%s with params: %#v", m.ListCandidateStageHistoryMock.defaultExpectation.expectationOrigins.origin, *m.ListCandidateStageHistoryMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcListCandidateStageHistory != nil && afterListCandidateStageHistoryCounter < 1 {
+		m.t.Errorf("Expected call to VacancyStorageMock.ListCandidateStageHistory at\n%s", m.funcListCandidateStageHistoryOrigin)
+	}
+
+	if !m.ListCandidateStageHistoryMock.invocationsDone() && afterListCandidateStageHistoryCounter > 0 {
+		m.t.Errorf("Expected %d calls to VacancyStorageMock.ListCandidateStageHistory at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.ListCandidateStageHistoryMock.expectedInvocations), m.ListCandidateStageHistoryMock.expectedInvocationsOrigin, afterListCandidateStageHistoryCounter)
+	}
+}
+
+type mVacancyStorageMockListCollaborators struct {
+	optional           bool
+	mock               *VacancyStorageMock
+	defaultExpectation *VacancyStorageMockListCollaboratorsExpectation
+	expectations       []*VacancyStorageMockListCollaboratorsExpectation
+
+	callArgs []*VacancyStorageMockListCollaboratorsParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// VacancyStorageMockListCollaboratorsExpectation specifies expectation struct of the VacancyStorage.ListCollaborators
+type VacancyStorageMockListCollaboratorsExpectation struct {
+	mock               *VacancyStorageMock
+	params             *VacancyStorageMockListCollaboratorsParams
+	paramPtrs          *VacancyStorageMockListCollaboratorsParamPtrs
+	expectationOrigins VacancyStorageMockListCollaboratorsExpectationOrigins
+	results            *VacancyStorageMockListCollaboratorsResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// VacancyStorageMockListCollaboratorsParams contains parameters of the VacancyStorage.ListCollaborators
+type VacancyStorageMockListCollaboratorsParams struct {
+	ctx       context.Context
+	vacancyID string
+}
+
+// VacancyStorageMockListCollaboratorsParamPtrs contains pointers to parameters of the VacancyStorage.ListCollaborators
+type VacancyStorageMockListCollaboratorsParamPtrs struct {
+	ctx       *context.Context
+	vacancyID *string
+}
+
+// VacancyStorageMockListCollaboratorsResults contains results of the VacancyStorage.ListCollaborators
+type VacancyStorageMockListCollaboratorsResults struct {
+	ca1 []domain.Collaborator
+	err error
+}
+
+// VacancyStorageMockListCollaboratorsOrigins contains origins of expectations of the VacancyStorage.ListCollaborators
+type VacancyStorageMockListCollaboratorsExpectationOrigins struct {
+	origin          string
+	originCtx       string
+	originVacancyID string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmListCollaborators *mVacancyStorageMockListCollaborators) Optional() *mVacancyStorageMockListCollaborators {
+	mmListCollaborators.optional = true
+	return mmListCollaborators
+}
+
+// Expect sets up expected params for VacancyStorage.ListCollaborators
+func (mmListCollaborators *mVacancyStorageMockListCollaborators) Expect(ctx context.Context, vacancyID string) *mVacancyStorageMockListCollaborators {
+	if mmListCollaborators.mock.funcListCollaborators != nil {
+		mmListCollaborators.mock.t.Fatalf("VacancyStorageMock.ListCollaborators mock is already set by Set")
+	}
+
+	if mmListCollaborators.defaultExpectation == nil {
+		mmListCollaborators.defaultExpectation = &VacancyStorageMockListCollaboratorsExpectation{}
+	}
+
+	if mmListCollaborators.defaultExpectation.paramPtrs != nil {
+		mmListCollaborators.mock.t.Fatalf("VacancyStorageMock.ListCollaborators mock is already set by ExpectParams functions")
+	}
+
+	mmListCollaborators.defaultExpectation.params = &VacancyStorageMockListCollaboratorsParams{ctx, vacancyID}
+	mmListCollaborators.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmListCollaborators.expectations {
+		if minimock.Equal(e.params, mmListCollaborators.defaultExpectation.params) {
+			mmListCollaborators.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmListCollaborators.defaultExpectation.params)
+		}
+	}
+
+	return mmListCollaborators
+}
+
+// ExpectCtxParam1 sets up expected param ctx for VacancyStorage.ListCollaborators
+func (mmListCollaborators *mVacancyStorageMockListCollaborators) ExpectCtxParam1(ctx context.Context) *mVacancyStorageMockListCollaborators {
+	if mmListCollaborators.mock.funcListCollaborators != nil {
+		mmListCollaborators.mock.t.Fatalf("VacancyStorageMock.ListCollaborators mock is already set by Set")
+	}
+
+	if mmListCollaborators.defaultExpectation == nil {
+		mmListCollaborators.defaultExpectation = &VacancyStorageMockListCollaboratorsExpectation{}
+	}
+
+	if mmListCollaborators.defaultExpectation.params != nil {
+		mmListCollaborators.mock.t.Fatalf("VacancyStorageMock.ListCollaborators mock is already set by Expect")
+	}
+
+	if mmListCollaborators.defaultExpectation.paramPtrs == nil {
+		mmListCollaborators.defaultExpectation.paramPtrs = &VacancyStorageMockListCollaboratorsParamPtrs{}
+	}
+	mmListCollaborators.defaultExpectation.paramPtrs.ctx = &ctx
+	mmListCollaborators.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmListCollaborators
+}
+
+// ExpectVacancyIDParam2 sets up expected param vacancyID for VacancyStorage.ListCollaborators
+func (mmListCollaborators *mVacancyStorageMockListCollaborators) ExpectVacancyIDParam2(vacancyID string) *mVacancyStorageMockListCollaborators {
+	if mmListCollaborators.mock.funcListCollaborators != nil {
+		mmListCollaborators.mock.t.Fatalf("VacancyStorageMock.ListCollaborators mock is already set by Set")
+	}
+
+	if mmListCollaborators.defaultExpectation == nil {
+		mmListCollaborators.defaultExpectation = &VacancyStorageMockListCollaboratorsExpectation{}
+	}
+
+	if mmListCollaborators.defaultExpectation.params != nil {
+		mmListCollaborators.mock.t.Fatalf("VacancyStorageMock.ListCollaborators mock is already set by Expect")
+	}
+
+	if mmListCollaborators.defaultExpectation.paramPtrs == nil {
+		mmListCollaborators.defaultExpectation.paramPtrs = &VacancyStorageMockListCollaboratorsParamPtrs{}
+	}
+	mmListCollaborators.defaultExpectation.paramPtrs.vacancyID = &vacancyID
+	mmListCollaborators.defaultExpectation.expectationOrigins.originVacancyID = minimock.CallerInfo(1)
+
+	return mmListCollaborators
+}
+
+// Inspect accepts an inspector function that has same arguments as the VacancyStorage.ListCollaborators
+func (mmListCollaborators *mVacancyStorageMockListCollaborators) Inspect(f func(ctx context.Context, vacancyID string)) *mVacancyStorageMockListCollaborators {
+	if mmListCollaborators.mock.inspectFuncListCollaborators != nil {
+		mmListCollaborators.mock.t.Fatalf("Inspect function is already set for VacancyStorageMock.ListCollaborators")
+	}
+
+	mmListCollaborators.mock.inspectFuncListCollaborators = f
+
+	return mmListCollaborators
+}
+
+// Return sets up results that will be returned by VacancyStorage.ListCollaborators
+func (mmListCollaborators *mVacancyStorageMockListCollaborators) Return(ca1 []domain.Collaborator, err error) *VacancyStorageMock {
+	if mmListCollaborators.mock.funcListCollaborators != nil {
+		mmListCollaborators.mock.t.Fatalf("VacancyStorageMock.ListCollaborators mock is already set by Set")
+	}
+
+	if mmListCollaborators.defaultExpectation == nil {
+		mmListCollaborators.defaultExpectation = &VacancyStorageMockListCollaboratorsExpectation{mock: mmListCollaborators.mock}
+	}
+	mmListCollaborators.defaultExpectation.results = &VacancyStorageMockListCollaboratorsResults{ca1, err}
+	mmListCollaborators.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmListCollaborators.mock
+}
+
+// Set uses given function f to mock the VacancyStorage.ListCollaborators method
+func (mmListCollaborators *mVacancyStorageMockListCollaborators) Set(f func(ctx context.Context, vacancyID string) (ca1 []domain.Collaborator, err error)) *VacancyStorageMock {
+	if mmListCollaborators.defaultExpectation != nil {
+		mmListCollaborators.mock.t.Fatalf("Default expectation is already set for the VacancyStorage.ListCollaborators method")
+	}
+
+	if len(mmListCollaborators.expectations) > 0 {
+		mmListCollaborators.mock.t.Fatalf("Some expectations are already set for the VacancyStorage.ListCollaborators method")
+	}
+
+	mmListCollaborators.mock.funcListCollaborators = f
+	mmListCollaborators.mock.funcListCollaboratorsOrigin = minimock.CallerInfo(1)
+	return mmListCollaborators.mock
+}
+
+// When sets expectation for the VacancyStorage.ListCollaborators which will trigger the result defined by the following
+// Then helper
+func (mmListCollaborators *mVacancyStorageMockListCollaborators) When(ctx context.Context, vacancyID string) *VacancyStorageMockListCollaboratorsExpectation {
+	if mmListCollaborators.mock.funcListCollaborators != nil {
+		mmListCollaborators.mock.t.Fatalf("VacancyStorageMock.ListCollaborators mock is already set by Set")
+	}
+
+	expectation := &VacancyStorageMockListCollaboratorsExpectation{
+		mock:               mmListCollaborators.mock,
+		params:             &VacancyStorageMockListCollaboratorsParams{ctx, vacancyID},
+		expectationOrigins: VacancyStorageMockListCollaboratorsExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmListCollaborators.expectations = append(mmListCollaborators.expectations, expectation)
+	return expectation
+}
+
+// Then sets up VacancyStorage.ListCollaborators return parameters for the expectation previously defined by the When method
+func (e *VacancyStorageMockListCollaboratorsExpectation) Then(ca1 []domain.Collaborator, err error) *VacancyStorageMock {
+	e.results = &VacancyStorageMockListCollaboratorsResults{ca1, err}
+	return e.mock
+}
+
+// Times sets number of times VacancyStorage.ListCollaborators should be invoked
+func (mmListCollaborators *mVacancyStorageMockListCollaborators) Times(n uint64) *mVacancyStorageMockListCollaborators {
+	if n == 0 {
+		mmListCollaborators.mock.t.Fatalf("Times of VacancyStorageMock.ListCollaborators mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmListCollaborators.expectedInvocations, n)
+	mmListCollaborators.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmListCollaborators
+}
+
+func (mmListCollaborators *mVacancyStorageMockListCollaborators) invocationsDone() bool {
+	if len(mmListCollaborators.expectations) == 0 && mmListCollaborators.defaultExpectation == nil && mmListCollaborators.mock.funcListCollaborators == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmListCollaborators.mock.afterListCollaboratorsCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmListCollaborators.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// ListCollaborators implements mm_usecase.VacancyStorage
+func (mmListCollaborators *VacancyStorageMock) ListCollaborators(ctx context.Context, vacancyID string) (ca1 []domain.Collaborator, err error) {
+	mm_atomic.AddUint64(&mmListCollaborators.beforeListCollaboratorsCounter, 1)
+	defer mm_atomic.AddUint64(&mmListCollaborators.afterListCollaboratorsCounter, 1)
+
+	mmListCollaborators.t.Helper()
+
+	if mmListCollaborators.inspectFuncListCollaborators != nil {
+		mmListCollaborators.inspectFuncListCollaborators(ctx, vacancyID)
+	}
+
+	mm_params := VacancyStorageMockListCollaboratorsParams{ctx, vacancyID}
+
+	// Record call args
+	mmListCollaborators.ListCollaboratorsMock.mutex.Lock()
+	mmListCollaborators.ListCollaboratorsMock.callArgs = append(mmListCollaborators.ListCollaboratorsMock.callArgs, &mm_params)
+	mmListCollaborators.ListCollaboratorsMock.mutex.Unlock()
+
+	for _, e := range mmListCollaborators.ListCollaboratorsMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.ca1, e.results.err
+		}
+	}
+
+	if mmListCollaborators.ListCollaboratorsMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmListCollaborators.ListCollaboratorsMock.defaultExpectation.Counter, 1)
+		mm_want := mmListCollaborators.ListCollaboratorsMock.defaultExpectation.params
+		mm_want_ptrs := mmListCollaborators.ListCollaboratorsMock.defaultExpectation.paramPtrs
+
+		mm_got := VacancyStorageMockListCollaboratorsParams{ctx, vacancyID}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmListCollaborators.t.Errorf("VacancyStorageMock.ListCollaborators got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmListCollaborators.ListCollaboratorsMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.vacancyID != nil && !minimock.Equal(*mm_want_ptrs.vacancyID, mm_got.vacancyID) {
+				mmListCollaborators.t.Errorf("VacancyStorageMock.ListCollaborators got unexpected parameter vacancyID, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmListCollaborators.ListCollaboratorsMock.defaultExpectation.expectationOrigins.originVacancyID, *mm_want_ptrs.vacancyID, mm_got.vacancyID, minimock.Diff(*mm_want_ptrs.vacancyID, mm_got.vacancyID))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmListCollaborators.t.Errorf("VacancyStorageMock.ListCollaborators got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmListCollaborators.ListCollaboratorsMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmListCollaborators.ListCollaboratorsMock.defaultExpectation.results
+		if mm_results == nil {
+			mmListCollaborators.t.Fatal("No results are set for the VacancyStorageMock.ListCollaborators")
+		}
+		return (*mm_results).ca1, (*mm_results).err
+	}
+	if mmListCollaborators.funcListCollaborators != nil {
+		return mmListCollaborators.funcListCollaborators(ctx, vacancyID)
+	}
+	mmListCollaborators.t.Fatalf("Unexpected call to VacancyStorageMock.ListCollaborators. %v %v", ctx, vacancyID)
+	return
+}
+
+// ListCollaboratorsAfterCounter returns a count of finished VacancyStorageMock.ListCollaborators invocations
+func (mmListCollaborators *VacancyStorageMock) ListCollaboratorsAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmListCollaborators.afterListCollaboratorsCounter)
+}
+
+// ListCollaboratorsBeforeCounter returns a count of VacancyStorageMock.ListCollaborators invocations
+func (mmListCollaborators *VacancyStorageMock) ListCollaboratorsBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmListCollaborators.beforeListCollaboratorsCounter)
+}
+
+// Calls returns a list of arguments used in each call to VacancyStorageMock.ListCollaborators.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmListCollaborators *mVacancyStorageMockListCollaborators) Calls() []*VacancyStorageMockListCollaboratorsParams {
+	mmListCollaborators.mutex.RLock()
+
+	argCopy := make([]*VacancyStorageMockListCollaboratorsParams, len(mmListCollaborators.callArgs))
+	copy(argCopy, mmListCollaborators.callArgs)
+
+	mmListCollaborators.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockListCollaboratorsDone returns true if the count of the ListCollaborators invocations corresponds
+// the number of defined expectations
+func (m *VacancyStorageMock) MinimockListCollaboratorsDone() bool {
+	if m.ListCollaboratorsMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.ListCollaboratorsMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.ListCollaboratorsMock.invocationsDone()
+}
+
+// MinimockListCollaboratorsInspect logs each unmet expectation
+func (m *VacancyStorageMock) MinimockListCollaboratorsInspect() {
+	for _, e := range m.ListCollaboratorsMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to VacancyStorageMock.ListCollaborators at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterListCollaboratorsCounter := mm_atomic.LoadUint64(&m.afterListCollaboratorsCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.ListCollaboratorsMock.defaultExpectation != nil && afterListCollaboratorsCounter < 1 {
+		if m.ListCollaboratorsMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to VacancyStorageMock.ListCollaborators at\n%s", m.ListCollaboratorsMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to VacancyStorageMock.ListCollaborators at\n%s with params: %#v", m.ListCollaboratorsMock.defaultExpectation.expectationOrigins.origin, *m.ListCollaboratorsMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcListCollaborators != nil && afterListCollaboratorsCounter < 1 {
+		m.t.Errorf("Expected call to VacancyStorageMock.ListCollaborators at\n%s", m.funcListCollaboratorsOrigin)
+	}
+
+	if !m.ListCollaboratorsMock.invocationsDone() && afterListCollaboratorsCounter > 0 {
+		m.t.Errorf("Expected %d calls to VacancyStorageMock.ListCollaborators at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.ListCollaboratorsMock.expectedInvocations), m.ListCollaboratorsMock.expectedInvocationsOrigin, afterListCollaboratorsCounter)
+	}
+}
+
+type mVacancyStorageMockListDuplicateCandidates struct {
+	optional           bool
+	mock               *VacancyStorageMock
+	defaultExpectation *VacancyStorageMockListDuplicateCandidatesExpectation
+	expectations       []*VacancyStorageMockListDuplicateCandidatesExpectation
+
+	callArgs []*VacancyStorageMockListDuplicateCandidatesParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// VacancyStorageMockListDuplicateCandidatesExpectation specifies expectation struct of the VacancyStorage.ListDuplicateCandidates
+type VacancyStorageMockListDuplicateCandidatesExpectation struct {
+	mock               *VacancyStorageMock
+	params             *VacancyStorageMockListDuplicateCandidatesParams
+	paramPtrs          *VacancyStorageMockListDuplicateCandidatesParamPtrs
+	expectationOrigins VacancyStorageMockListDuplicateCandidatesExpectationOrigins
+	results            *VacancyStorageMockListDuplicateCandidatesResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// VacancyStorageMockListDuplicateCandidatesParams contains parameters of the VacancyStorage.ListDuplicateCandidates
+type VacancyStorageMockListDuplicateCandidatesParams struct {
+	ctx         context.Context
+	title       string
+	ownerUserID uint64
+	isAdmin     bool
+	limit       int
+}
+
+// VacancyStorageMockListDuplicateCandidatesParamPtrs contains pointers to parameters of the VacancyStorage.ListDuplicateCandidates
+type VacancyStorageMockListDuplicateCandidatesParamPtrs struct {
+	ctx         *context.Context
+	title       *string
+	ownerUserID *uint64
+	isAdmin     *bool
+	limit       *int
+}
+
+// VacancyStorageMockListDuplicateCandidatesResults contains results of the VacancyStorage.ListDuplicateCandidates
+type VacancyStorageMockListDuplicateCandidatesResults struct {
+	da1 []domain.DuplicateCandidate
+	err error
+}
+
+// VacancyStorageMockListDuplicateCandidatesOrigins contains origins of expectations of the VacancyStorage.ListDuplicateCandidates
+type VacancyStorageMockListDuplicateCandidatesExpectationOrigins struct {
+	origin            string
+	originCtx         string
+	originTitle       string
+	originOwnerUserID string
+	originIsAdmin     string
+	originLimit       string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmListDuplicateCandidates *mVacancyStorageMockListDuplicateCandidates) Optional() *mVacancyStorageMockListDuplicateCandidates {
+	mmListDuplicateCandidates.optional = true
+	return mmListDuplicateCandidates
+}
+
+// Expect sets up expected params for VacancyStorage.ListDuplicateCandidates
+func (mmListDuplicateCandidates *mVacancyStorageMockListDuplicateCandidates) Expect(ctx context.Context, title string, ownerUserID uint64, isAdmin bool, limit int) *mVacancyStorageMockListDuplicateCandidates {
+	if mmListDuplicateCandidates.mock.funcListDuplicateCandidates != nil {
+		mmListDuplicateCandidates.mock.t.Fatalf("VacancyStorageMock.ListDuplicateCandidates mock is already set by Set")
+	}
+
+	if mmListDuplicateCandidates.defaultExpectation == nil {
+		mmListDuplicateCandidates.defaultExpectation = &VacancyStorageMockListDuplicateCandidatesExpectation{}
+	}
+
+	if mmListDuplicateCandidates.defaultExpectation.paramPtrs != nil {
+		mmListDuplicateCandidates.mock.t.Fatalf("VacancyStorageMock.ListDuplicateCandidates mock is already set by ExpectParams functions")
+	}
+
+	mmListDuplicateCandidates.defaultExpectation.params = &VacancyStorageMockListDuplicateCandidatesParams{ctx, title, ownerUserID, isAdmin, limit}
+	mmListDuplicateCandidates.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmListDuplicateCandidates.expectations {
+		if minimock.Equal(e.params, mmListDuplicateCandidates.defaultExpectation.params) {
+			mmListDuplicateCandidates.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmListDuplicateCandidates.defaultExpectation.params)
+		}
+	}
+
+	return mmListDuplicateCandidates
+}
+
+// ExpectCtxParam1 sets up expected param ctx for VacancyStorage.ListDuplicateCandidates
+func (mmListDuplicateCandidates *mVacancyStorageMockListDuplicateCandidates) ExpectCtxParam1(ctx context.Context) *mVacancyStorageMockListDuplicateCandidates {
+	if mmListDuplicateCandidates.mock.funcListDuplicateCandidates != nil {
+		mmListDuplicateCandidates.mock.t.Fatalf("VacancyStorageMock.ListDuplicateCandidates mock is already set by Set")
+	}
+
+	if mmListDuplicateCandidates.defaultExpectation == nil {
+		mmListDuplicateCandidates.defaultExpectation = &VacancyStorageMockListDuplicateCandidatesExpectation{}
+	}
+
+	if mmListDuplicateCandidates.defaultExpectation.params != nil {
+		mmListDuplicateCandidates.mock.t.Fatalf("VacancyStorageMock.ListDuplicateCandidates mock is already set by Expect")
+	}
+
+	if mmListDuplicateCandidates.defaultExpectation.paramPtrs == nil {
+		mmListDuplicateCandidates.defaultExpectation.paramPtrs = &VacancyStorageMockListDuplicateCandidatesParamPtrs{}
+	}
+	mmListDuplicateCandidates.defaultExpectation.paramPtrs.ctx = &ctx
+	mmListDuplicateCandidates.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmListDuplicateCandidates
+}
+
+// ExpectTitleParam2 sets up expected param title for VacancyStorage.ListDuplicateCandidates
+func (mmListDuplicateCandidates *mVacancyStorageMockListDuplicateCandidates) ExpectTitleParam2(title string) *mVacancyStorageMockListDuplicateCandidates {
+	if mmListDuplicateCandidates.mock.funcListDuplicateCandidates != nil {
+		mmListDuplicateCandidates.mock.t.Fatalf("VacancyStorageMock.ListDuplicateCandidates mock is already set by Set")
+	}
+
+	if mmListDuplicateCandidates.defaultExpectation == nil {
+		mmListDuplicateCandidates.defaultExpectation = &VacancyStorageMockListDuplicateCandidatesExpectation{}
+	}
+
+	if mmListDuplicateCandidates.defaultExpectation.params != nil {
+		mmListDuplicateCandidates.mock.t.Fatalf("VacancyStorageMock.ListDuplicateCandidates mock is already set by Expect")
+	}
+
+	if mmListDuplicateCandidates.defaultExpectation.paramPtrs == nil {
+		mmListDuplicateCandidates.defaultExpectation.paramPtrs = &VacancyStorageMockListDuplicateCandidatesParamPtrs{}
+	}
+	mmListDuplicateCandidates.defaultExpectation.paramPtrs.title = &title
+	mmListDuplicateCandidates.defaultExpectation.expectationOrigins.originTitle = minimock.CallerInfo(1)
+
+	return mmListDuplicateCandidates
+}
+
+// ExpectOwnerUserIDParam3 sets up expected param ownerUserID for VacancyStorage.ListDuplicateCandidates
+func (mmListDuplicateCandidates *mVacancyStorageMockListDuplicateCandidates) ExpectOwnerUserIDParam3(ownerUserID uint64) *mVacancyStorageMockListDuplicateCandidates {
+	if mmListDuplicateCandidates.mock.funcListDuplicateCandidates != nil {
+		mmListDuplicateCandidates.mock.t.Fatalf("VacancyStorageMock.ListDuplicateCandidates mock is already set by Set")
+	}
+
+	if mmListDuplicateCandidates.defaultExpectation == nil {
+		mmListDuplicateCandidates.defaultExpectation = &VacancyStorageMockListDuplicateCandidatesExpectation{}
+	}
+
+	if mmListDuplicateCandidates.defaultExpectation.params != nil {
+		mmListDuplicateCandidates.mock.t.Fatalf("VacancyStorageMock.ListDuplicateCandidates mock is already set by Expect")
+	}
+
+	if mmListDuplicateCandidates.defaultExpectation.paramPtrs == nil {
+		mmListDuplicateCandidates.defaultExpectation.paramPtrs = &VacancyStorageMockListDuplicateCandidatesParamPtrs{}
+	}
+	mmListDuplicateCandidates.defaultExpectation.paramPtrs.ownerUserID = &ownerUserID
+	mmListDuplicateCandidates.defaultExpectation.expectationOrigins.originOwnerUserID = minimock.CallerInfo(1)
+
+	return mmListDuplicateCandidates
+}
+
+// ExpectIsAdminParam4 sets up expected param isAdmin for VacancyStorage.ListDuplicateCandidates
+func (mmListDuplicateCandidates *mVacancyStorageMockListDuplicateCandidates) ExpectIsAdminParam4(isAdmin bool) *mVacancyStorageMockListDuplicateCandidates {
+	if mmListDuplicateCandidates.mock.funcListDuplicateCandidates != nil {
+		mmListDuplicateCandidates.mock.t.Fatalf("VacancyStorageMock.ListDuplicateCandidates mock is already set by Set")
+	}
+
+	if mmListDuplicateCandidates.defaultExpectation == nil {
+		mmListDuplicateCandidates.defaultExpectation = &VacancyStorageMockListDuplicateCandidatesExpectation{}
+	}
+
+	if mmListDuplicateCandidates.defaultExpectation.params != nil {
+		mmListDuplicateCandidates.mock.t.Fatalf("VacancyStorageMock.ListDuplicateCandidates mock is already set by Expect")
+	}
+
+	if mmListDuplicateCandidates.defaultExpectation.paramPtrs == nil {
+		mmListDuplicateCandidates.defaultExpectation.paramPtrs = &VacancyStorageMockListDuplicateCandidatesParamPtrs{}
+	}
+	mmListDuplicateCandidates.defaultExpectation.paramPtrs.isAdmin = &isAdmin
+	mmListDuplicateCandidates.defaultExpectation.expectationOrigins.originIsAdmin = minimock.CallerInfo(1)
+
+	return mmListDuplicateCandidates
+}
+
+// ExpectLimitParam5 sets up expected param limit for VacancyStorage.ListDuplicateCandidates
+func (mmListDuplicateCandidates *mVacancyStorageMockListDuplicateCandidates) ExpectLimitParam5(limit int) *mVacancyStorageMockListDuplicateCandidates {
+	if mmListDuplicateCandidates.mock.funcListDuplicateCandidates != nil {
+		mmListDuplicateCandidates.mock.t.Fatalf("VacancyStorageMock.ListDuplicateCandidates mock is already set by Set")
+	}
+
+	if mmListDuplicateCandidates.defaultExpectation == nil {
+		mmListDuplicateCandidates.defaultExpectation = &VacancyStorageMockListDuplicateCandidatesExpectation{}
+	}
+
+	if mmListDuplicateCandidates.defaultExpectation.params != nil {
+		mmListDuplicateCandidates.mock.t.Fatalf("VacancyStorageMock.ListDuplicateCandidates mock is already set by Expect")
+	}
+
+	if mmListDuplicateCandidates.defaultExpectation.paramPtrs == nil {
+		mmListDuplicateCandidates.defaultExpectation.paramPtrs = &VacancyStorageMockListDuplicateCandidatesParamPtrs{}
+	}
+	mmListDuplicateCandidates.defaultExpectation.paramPtrs.limit = &limit
+	mmListDuplicateCandidates.defaultExpectation.expectationOrigins.originLimit = minimock.CallerInfo(1)
+
+	return mmListDuplicateCandidates
+}
+
+// Inspect accepts an inspector function that has same arguments as the VacancyStorage.ListDuplicateCandidates
+func (mmListDuplicateCandidates *mVacancyStorageMockListDuplicateCandidates) Inspect(f func(ctx context.Context, title string, ownerUserID uint64, isAdmin bool, limit int)) *mVacancyStorageMockListDuplicateCandidates {
+	if mmListDuplicateCandidates.mock.inspectFuncListDuplicateCandidates != nil {
+		mmListDuplicateCandidates.mock.t.Fatalf("Inspect function is already set for VacancyStorageMock.ListDuplicateCandidates")
+	}
+
+	mmListDuplicateCandidates.mock.inspectFuncListDuplicateCandidates = f
+
+	return mmListDuplicateCandidates
+}
+
+// Return sets up results that will be returned by VacancyStorage.ListDuplicateCandidates
+func (mmListDuplicateCandidates *mVacancyStorageMockListDuplicateCandidates) Return(da1 []domain.DuplicateCandidate, err error) *VacancyStorageMock {
+	if mmListDuplicateCandidates.mock.funcListDuplicateCandidates != nil {
+		mmListDuplicateCandidates.mock.t.Fatalf("VacancyStorageMock.ListDuplicateCandidates mock is already set by Set")
+	}
+
+	if mmListDuplicateCandidates.defaultExpectation == nil {
+		mmListDuplicateCandidates.defaultExpectation = &VacancyStorageMockListDuplicateCandidatesExpectation{mock: mmListDuplicateCandidates.mock}
+	}
+	mmListDuplicateCandidates.defaultExpectation.results = &VacancyStorageMockListDuplicateCandidatesResults{da1, err}
+	mmListDuplicateCandidates.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmListDuplicateCandidates.mock
+}
+
+// Set uses given function f to mock the VacancyStorage.ListDuplicateCandidates method
+func (mmListDuplicateCandidates *mVacancyStorageMockListDuplicateCandidates) Set(f func(ctx context.Context, title string, ownerUserID uint64, isAdmin bool, limit int) (da1 []domain.DuplicateCandidate, err error)) *VacancyStorageMock {
+	if mmListDuplicateCandidates.defaultExpectation != nil {
+		mmListDuplicateCandidates.mock.t.Fatalf("Default expectation is already set for the VacancyStorage.ListDuplicateCandidates method")
+	}
+
+	if len(mmListDuplicateCandidates.expectations) > 0 {
+		mmListDuplicateCandidates.mock.t.Fatalf("Some expectations are already set for the VacancyStorage.ListDuplicateCandidates method")
+	}
+
+	mmListDuplicateCandidates.mock.funcListDuplicateCandidates = f
+	mmListDuplicateCandidates.mock.funcListDuplicateCandidatesOrigin = minimock.CallerInfo(1)
+	return mmListDuplicateCandidates.mock
+}
+
+// When sets expectation for the VacancyStorage.ListDuplicateCandidates which will trigger the result defined by the following
+// Then helper
+func (mmListDuplicateCandidates *mVacancyStorageMockListDuplicateCandidates) When(ctx context.Context, title string, ownerUserID uint64, isAdmin bool, limit int) *VacancyStorageMockListDuplicateCandidatesExpectation {
+	if mmListDuplicateCandidates.mock.funcListDuplicateCandidates != nil {
+		mmListDuplicateCandidates.mock.t.Fatalf("VacancyStorageMock.ListDuplicateCandidates mock is already set by Set")
+	}
+
+	expectation := &VacancyStorageMockListDuplicateCandidatesExpectation{
+		mock:               mmListDuplicateCandidates.mock,
+		params:             &VacancyStorageMockListDuplicateCandidatesParams{ctx, title, ownerUserID, isAdmin, limit},
+		expectationOrigins: VacancyStorageMockListDuplicateCandidatesExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmListDuplicateCandidates.expectations = append(mmListDuplicateCandidates.expectations, expectation)
+	return expectation
+}
+
+// Then sets up VacancyStorage.ListDuplicateCandidates return parameters for the expectation previously defined by the When method
+func (e *VacancyStorageMockListDuplicateCandidatesExpectation) Then(da1 []domain.DuplicateCandidate, err error) *VacancyStorageMock {
+	e.results = &VacancyStorageMockListDuplicateCandidatesResults{da1, err}
+	return e.mock
+}
+
+// Times sets number of times VacancyStorage.ListDuplicateCandidates should be invoked
+func (mmListDuplicateCandidates *mVacancyStorageMockListDuplicateCandidates) Times(n uint64) *mVacancyStorageMockListDuplicateCandidates {
+	if n == 0 {
+		mmListDuplicateCandidates.mock.t.Fatalf("Times of VacancyStorageMock.ListDuplicateCandidates mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmListDuplicateCandidates.expectedInvocations, n)
+	mmListDuplicateCandidates.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmListDuplicateCandidates
+}
+
+func (mmListDuplicateCandidates *mVacancyStorageMockListDuplicateCandidates) invocationsDone() bool {
+	if len(mmListDuplicateCandidates.expectations) == 0 && mmListDuplicateCandidates.defaultExpectation == nil && mmListDuplicateCandidates.mock.funcListDuplicateCandidates == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmListDuplicateCandidates.mock.afterListDuplicateCandidatesCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmListDuplicateCandidates.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// ListDuplicateCandidates implements mm_usecase.VacancyStorage
+func (mmListDuplicateCandidates *VacancyStorageMock) ListDuplicateCandidates(ctx context.Context, title string, ownerUserID uint64, isAdmin bool, limit int) (da1 []domain.DuplicateCandidate, err error) {
+	mm_atomic.AddUint64(&mmListDuplicateCandidates.beforeListDuplicateCandidatesCounter, 1)
+	defer mm_atomic.AddUint64(&mmListDuplicateCandidates.afterListDuplicateCandidatesCounter, 1)
+
+	mmListDuplicateCandidates.t.Helper()
+
+	if mmListDuplicateCandidates.inspectFuncListDuplicateCandidates != nil {
+		mmListDuplicateCandidates.inspectFuncListDuplicateCandidates(ctx, title, ownerUserID, isAdmin, limit)
+	}
+
+	mm_params := VacancyStorageMockListDuplicateCandidatesParams{ctx, title, ownerUserID, isAdmin, limit}
+
+	// Record call args
+	mmListDuplicateCandidates.ListDuplicateCandidatesMock.mutex.Lock()
+	mmListDuplicateCandidates.ListDuplicateCandidatesMock.callArgs = append(mmListDuplicateCandidates.ListDuplicateCandidatesMock.callArgs, &mm_params)
+	mmListDuplicateCandidates.ListDuplicateCandidatesMock.mutex.Unlock()
+
+	for _, e := range mmListDuplicateCandidates.ListDuplicateCandidatesMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.da1, e.results.err
+		}
+	}
+
+	if mmListDuplicateCandidates.ListDuplicateCandidatesMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmListDuplicateCandidates.ListDuplicateCandidatesMock.defaultExpectation.Counter, 1)
+		mm_want := mmListDuplicateCandidates.ListDuplicateCandidatesMock.defaultExpectation.params
+		mm_want_ptrs := mmListDuplicateCandidates.ListDuplicateCandidatesMock.defaultExpectation.paramPtrs
+
+		mm_got := VacancyStorageMockListDuplicateCandidatesParams{ctx, title, ownerUserID, isAdmin, limit}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmListDuplicateCandidates.t.Errorf("VacancyStorageMock.ListDuplicateCandidates got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmListDuplicateCandidates.ListDuplicateCandidatesMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.title != nil && !minimock.Equal(*mm_want_ptrs.title, mm_got.title) {
+				mmListDuplicateCandidates.t.Errorf("VacancyStorageMock.ListDuplicateCandidates got unexpected parameter title, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmListDuplicateCandidates.ListDuplicateCandidatesMock.defaultExpectation.expectationOrigins.originTitle, *mm_want_ptrs.title, mm_got.title, minimock.Diff(*mm_want_ptrs.title, mm_got.title))
+			}
+
+			if mm_want_ptrs.ownerUserID != nil && !minimock.Equal(*mm_want_ptrs.ownerUserID, mm_got.ownerUserID) {
+				mmListDuplicateCandidates.t.Errorf("VacancyStorageMock.ListDuplicateCandidates got unexpected parameter ownerUserID, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmListDuplicateCandidates.ListDuplicateCandidatesMock.defaultExpectation.expectationOrigins.originOwnerUserID, *mm_want_ptrs.ownerUserID, mm_got.ownerUserID, minimock.Diff(*mm_want_ptrs.ownerUserID, mm_got.ownerUserID))
+			}
+
+			if mm_want_ptrs.isAdmin != nil && !minimock.Equal(*mm_want_ptrs.isAdmin, mm_got.isAdmin) {
+				mmListDuplicateCandidates.t.Errorf("VacancyStorageMock.ListDuplicateCandidates got unexpected parameter isAdmin, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmListDuplicateCandidates.ListDuplicateCandidatesMock.defaultExpectation.expectationOrigins.originIsAdmin, *mm_want_ptrs.isAdmin, mm_got.isAdmin, minimock.Diff(*mm_want_ptrs.isAdmin, mm_got.isAdmin))
+			}
+
+			if mm_want_ptrs.limit != nil && !minimock.Equal(*mm_want_ptrs.limit, mm_got.limit) {
+				mmListDuplicateCandidates.t.Errorf("VacancyStorageMock.ListDuplicateCandidates got unexpected parameter limit, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmListDuplicateCandidates.ListDuplicateCandidatesMock.defaultExpectation.expectationOrigins.originLimit, *mm_want_ptrs.limit, mm_got.limit, minimock.Diff(*mm_want_ptrs.limit, mm_got.limit))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmListDuplicateCandidates.t.Errorf("VacancyStorageMock.ListDuplicateCandidates got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmListDuplicateCandidates.ListDuplicateCandidatesMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmListDuplicateCandidates.ListDuplicateCandidatesMock.defaultExpectation.results
+		if mm_results == nil {
+			mmListDuplicateCandidates.t.Fatal("No results are set for the VacancyStorageMock.ListDuplicateCandidates")
+		}
+		return (*mm_results).da1, (*mm_results).err
+	}
+	if mmListDuplicateCandidates.funcListDuplicateCandidates != nil {
+		return mmListDuplicateCandidates.funcListDuplicateCandidates(ctx, title, ownerUserID, isAdmin, limit)
+	}
+	mmListDuplicateCandidates.t.Fatalf("Unexpected call to VacancyStorageMock.ListDuplicateCandidates. %v %v %v %v %v", ctx, title, ownerUserID, isAdmin, limit)
+	return
+}
+
+// ListDuplicateCandidatesAfterCounter returns a count of finished VacancyStorageMock.ListDuplicateCandidates invocations
+func (mmListDuplicateCandidates *VacancyStorageMock) ListDuplicateCandidatesAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmListDuplicateCandidates.afterListDuplicateCandidatesCounter)
+}
+
+// ListDuplicateCandidatesBeforeCounter returns a count of VacancyStorageMock.ListDuplicateCandidates invocations
+func (mmListDuplicateCandidates *VacancyStorageMock) ListDuplicateCandidatesBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmListDuplicateCandidates.beforeListDuplicateCandidatesCounter)
+}
+
+// Calls returns a list of arguments used in each call to VacancyStorageMock.ListDuplicateCandidates.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmListDuplicateCandidates *mVacancyStorageMockListDuplicateCandidates) Calls() []*VacancyStorageMockListDuplicateCandidatesParams {
+	mmListDuplicateCandidates.mutex.RLock()
+
+	argCopy := make([]*VacancyStorageMockListDuplicateCandidatesParams, len(mmListDuplicateCandidates.callArgs))
+	copy(argCopy, mmListDuplicateCandidates.callArgs)
+
+	mmListDuplicateCandidates.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockListDuplicateCandidatesDone returns true if the count of the ListDuplicateCandidates invocations corresponds
+// the number of defined expectations
+func (m *VacancyStorageMock) MinimockListDuplicateCandidatesDone() bool {
+	if m.ListDuplicateCandidatesMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.ListDuplicateCandidatesMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.ListDuplicateCandidatesMock.invocationsDone()
+}
+
+// MinimockListDuplicateCandidatesInspect logs each unmet expectation
+func (m *VacancyStorageMock) MinimockListDuplicateCandidatesInspect() {
+	for _, e := range m.ListDuplicateCandidatesMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to VacancyStorageMock.ListDuplicateCandidates at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterListDuplicateCandidatesCounter := mm_atomic.LoadUint64(&m.afterListDuplicateCandidatesCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.ListDuplicateCandidatesMock.defaultExpectation != nil && afterListDuplicateCandidatesCounter < 1 {
+		if m.ListDuplicateCandidatesMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to VacancyStorageMock.ListDuplicateCandidates at\n%s", m.ListDuplicateCandidatesMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to VacancyStorageMock.ListDuplicateCandidates at\n%s with params: %#v", m.ListDuplicateCandidatesMock.defaultExpectation.expectationOrigins.origin, *m.ListDuplicateCandidatesMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcListDuplicateCandidates != nil && afterListDuplicateCandidatesCounter < 1 {
+		m.t.Errorf("Expected call to VacancyStorageMock.ListDuplicateCandidates at\n%s", m.funcListDuplicateCandidatesOrigin)
+	}
+
+	if !m.ListDuplicateCandidatesMock.invocationsDone() && afterListDuplicateCandidatesCounter > 0 {
+		m.t.Errorf("Expected %d calls to VacancyStorageMock.ListDuplicateCandidates at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.ListDuplicateCandidatesMock.expectedInvocations), m.ListDuplicateCandidatesMock.expectedInvocationsOrigin, afterListDuplicateCandidatesCounter)
+	}
+}
+
+type mVacancyStorageMockListPublicVacancies struct {
+	optional           bool
+	mock               *VacancyStorageMock
+	defaultExpectation *VacancyStorageMockListPublicVacanciesExpectation
+	expectations       []*VacancyStorageMockListPublicVacanciesExpectation
+
+	callArgs []*VacancyStorageMockListPublicVacanciesParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// VacancyStorageMockListPublicVacanciesExpectation specifies expectation struct of the VacancyStorage.ListPublicVacancies
+type VacancyStorageMockListPublicVacanciesExpectation struct {
+	mock               *VacancyStorageMock
+	params             *VacancyStorageMockListPublicVacanciesParams
+	paramPtrs          *VacancyStorageMockListPublicVacanciesParamPtrs
+	expectationOrigins VacancyStorageMockListPublicVacanciesExpectationOrigins
+	results            *VacancyStorageMockListPublicVacanciesResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// VacancyStorageMockListPublicVacanciesParams contains parameters of the VacancyStorage.ListPublicVacancies
+type VacancyStorageMockListPublicVacanciesParams struct {
+	ctx context.Context
+	in  domain.ListPublicVacanciesInput
+}
+
+// VacancyStorageMockListPublicVacanciesParamPtrs contains pointers to parameters of the VacancyStorage.ListPublicVacancies
+type VacancyStorageMockListPublicVacanciesParamPtrs struct {
+	ctx *context.Context
+	in  *domain.ListPublicVacanciesInput
+}
+
+// VacancyStorageMockListPublicVacanciesResults contains results of the VacancyStorage.ListPublicVacancies
+type VacancyStorageMockListPublicVacanciesResults struct {
+	va1 []domain.Vacancy
+	err error
+}
+
+// VacancyStorageMockListPublicVacanciesOrigins contains origins of expectations of the VacancyStorage.ListPublicVacancies
+type VacancyStorageMockListPublicVacanciesExpectationOrigins struct {
+	origin    string
+	originCtx string
+	originIn  string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmListPublicVacancies *mVacancyStorageMockListPublicVacancies) Optional() *mVacancyStorageMockListPublicVacancies {
+	mmListPublicVacancies.optional = true
+	return mmListPublicVacancies
+}
+
+// Expect sets up expected params for VacancyStorage.ListPublicVacancies
+func (mmListPublicVacancies *mVacancyStorageMockListPublicVacancies) Expect(ctx context.Context, in domain.ListPublicVacanciesInput) *mVacancyStorageMockListPublicVacancies {
+	if mmListPublicVacancies.mock.funcListPublicVacancies != nil {
+		mmListPublicVacancies.mock.t.Fatalf("VacancyStorageMock.ListPublicVacancies mock is already set by Set")
+	}
+
+	if mmListPublicVacancies.defaultExpectation == nil {
+		mmListPublicVacancies.defaultExpectation = &VacancyStorageMockListPublicVacanciesExpectation{}
+	}
+
+	if mmListPublicVacancies.defaultExpectation.paramPtrs != nil {
+		mmListPublicVacancies.mock.t.Fatalf("VacancyStorageMock.ListPublicVacancies mock is already set by ExpectParams functions")
+	}
+
+	mmListPublicVacancies.defaultExpectation.params = &VacancyStorageMockListPublicVacanciesParams{ctx, in}
+	mmListPublicVacancies.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmListPublicVacancies.expectations {
+		if minimock.Equal(e.params, mmListPublicVacancies.defaultExpectation.params) {
+			mmListPublicVacancies.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmListPublicVacancies.defaultExpectation.params)
+		}
+	}
+
+	return mmListPublicVacancies
+}
+
+// ExpectCtxParam1 sets up expected param ctx for VacancyStorage.ListPublicVacancies
+func (mmListPublicVacancies *mVacancyStorageMockListPublicVacancies) ExpectCtxParam1(ctx context.Context) *mVacancyStorageMockListPublicVacancies {
+	if mmListPublicVacancies.mock.funcListPublicVacancies != nil {
+		mmListPublicVacancies.mock.t.Fatalf("VacancyStorageMock.ListPublicVacancies mock is already set by Set")
+	}
+
+	if mmListPublicVacancies.defaultExpectation == nil {
+		mmListPublicVacancies.defaultExpectation = &VacancyStorageMockListPublicVacanciesExpectation{}
+	}
+
+	if mmListPublicVacancies.defaultExpectation.params != nil {
+		mmListPublicVacancies.mock.t.Fatalf("VacancyStorageMock.ListPublicVacancies mock is already set by Expect")
+	}
+
+	if mmListPublicVacancies.defaultExpectation.paramPtrs == nil {
+		mmListPublicVacancies.defaultExpectation.paramPtrs = &VacancyStorageMockListPublicVacanciesParamPtrs{}
+	}
+	mmListPublicVacancies.defaultExpectation.paramPtrs.ctx = &ctx
+	mmListPublicVacancies.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmListPublicVacancies
+}
+
+// ExpectInParam2 sets up expected param in for VacancyStorage.ListPublicVacancies
+func (mmListPublicVacancies *mVacancyStorageMockListPublicVacancies) ExpectInParam2(in domain.ListPublicVacanciesInput) *mVacancyStorageMockListPublicVacancies {
+	if mmListPublicVacancies.mock.funcListPublicVacancies != nil {
+		mmListPublicVacancies.mock.t.Fatalf("VacancyStorageMock.ListPublicVacancies mock is already set by Set")
+	}
+
+	if mmListPublicVacancies.defaultExpectation == nil {
+		mmListPublicVacancies.defaultExpectation = &VacancyStorageMockListPublicVacanciesExpectation{}
+	}
+
+	if mmListPublicVacancies.defaultExpectation.params != nil {
+		mmListPublicVacancies.mock.t.Fatalf("VacancyStorageMock.ListPublicVacancies mock is already set by Expect")
+	}
+
+	if mmListPublicVacancies.defaultExpectation.paramPtrs == nil {
+		mmListPublicVacancies.defaultExpectation.paramPtrs = &VacancyStorageMockListPublicVacanciesParamPtrs{}
+	}
+	mmListPublicVacancies.defaultExpectation.paramPtrs.in = &in
+	mmListPublicVacancies.defaultExpectation.expectationOrigins.originIn = minimock.CallerInfo(1)
+
+	return mmListPublicVacancies
+}
+
+// Inspect accepts an inspector function that has same arguments as the VacancyStorage.ListPublicVacancies
+func (mmListPublicVacancies *mVacancyStorageMockListPublicVacancies) Inspect(f func(ctx context.Context, in domain.ListPublicVacanciesInput)) *mVacancyStorageMockListPublicVacancies {
+	if mmListPublicVacancies.mock.inspectFuncListPublicVacancies != nil {
+		mmListPublicVacancies.mock.t.Fatalf("Inspect function is already set for VacancyStorageMock.ListPublicVacancies")
+	}
+
+	mmListPublicVacancies.mock.inspectFuncListPublicVacancies = f
+
+	return mmListPublicVacancies
+}
+
+// Return sets up results that will be returned by VacancyStorage.ListPublicVacancies
+func (mmListPublicVacancies *mVacancyStorageMockListPublicVacancies) Return(va1 []domain.Vacancy, err error) *VacancyStorageMock {
+	if mmListPublicVacancies.mock.funcListPublicVacancies != nil {
+		mmListPublicVacancies.mock.t.Fatalf("VacancyStorageMock.ListPublicVacancies mock is already set by Set")
+	}
+
+	if mmListPublicVacancies.defaultExpectation == nil {
+		mmListPublicVacancies.defaultExpectation = &VacancyStorageMockListPublicVacanciesExpectation{mock: mmListPublicVacancies.mock}
+	}
+	mmListPublicVacancies.defaultExpectation.results = &VacancyStorageMockListPublicVacanciesResults{va1, err}
+	mmListPublicVacancies.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmListPublicVacancies.mock
+}
+
+// Set uses given function f to mock the VacancyStorage.ListPublicVacancies method
+func (mmListPublicVacancies *mVacancyStorageMockListPublicVacancies) Set(f func(ctx context.Context, in domain.ListPublicVacanciesInput) (va1 []domain.Vacancy, err error)) *VacancyStorageMock {
+	if mmListPublicVacancies.defaultExpectation != nil {
+		mmListPublicVacancies.mock.t.Fatalf("Default expectation is already set for the VacancyStorage.ListPublicVacancies method")
+	}
+
+	if len(mmListPublicVacancies.expectations) > 0 {
+		mmListPublicVacancies.mock.t.Fatalf("Some expectations are already set for the VacancyStorage.ListPublicVacancies method")
+	}
+
+	mmListPublicVacancies.mock.funcListPublicVacancies = f
+	mmListPublicVacancies.mock.funcListPublicVacanciesOrigin = minimock.CallerInfo(1)
+	return mmListPublicVacancies.mock
+}
+
+// When sets expectation for the VacancyStorage.ListPublicVacancies which will trigger the result defined by the following
+// Then helper
+func (mmListPublicVacancies *mVacancyStorageMockListPublicVacancies) When(ctx context.Context, in domain.ListPublicVacanciesInput) *VacancyStorageMockListPublicVacanciesExpectation {
+	if mmListPublicVacancies.mock.funcListPublicVacancies != nil {
+		mmListPublicVacancies.mock.t.Fatalf("VacancyStorageMock.ListPublicVacancies mock is already set by Set")
+	}
+
+	expectation := &VacancyStorageMockListPublicVacanciesExpectation{
+		mock:               mmListPublicVacancies.mock,
+		params:             &VacancyStorageMockListPublicVacanciesParams{ctx, in},
+		expectationOrigins: VacancyStorageMockListPublicVacanciesExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmListPublicVacancies.expectations = append(mmListPublicVacancies.expectations, expectation)
+	return expectation
+}
+
+// Then sets up VacancyStorage.ListPublicVacancies return parameters for the expectation previously defined by the When method
+func (e *VacancyStorageMockListPublicVacanciesExpectation) Then(va1 []domain.Vacancy, err error) *VacancyStorageMock {
+	e.results = &VacancyStorageMockListPublicVacanciesResults{va1, err}
+	return e.mock
+}
+
+// Times sets number of times VacancyStorage.ListPublicVacancies should be invoked
+func (mmListPublicVacancies *mVacancyStorageMockListPublicVacancies) Times(n uint64) *mVacancyStorageMockListPublicVacancies {
+	if n == 0 {
+		mmListPublicVacancies.mock.t.Fatalf("Times of VacancyStorageMock.ListPublicVacancies mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmListPublicVacancies.expectedInvocations, n)
+	mmListPublicVacancies.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmListPublicVacancies
+}
+
+func (mmListPublicVacancies *mVacancyStorageMockListPublicVacancies) invocationsDone() bool {
+	if len(mmListPublicVacancies.expectations) == 0 && mmListPublicVacancies.defaultExpectation == nil && mmListPublicVacancies.mock.funcListPublicVacancies == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmListPublicVacancies.mock.afterListPublicVacanciesCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmListPublicVacancies.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// ListPublicVacancies implements mm_usecase.VacancyStorage
+func (mmListPublicVacancies *VacancyStorageMock) ListPublicVacancies(ctx context.Context, in domain.ListPublicVacanciesInput) (va1 []domain.Vacancy, err error) {
+	mm_atomic.AddUint64(&mmListPublicVacancies.beforeListPublicVacanciesCounter, 1)
+	defer mm_atomic.AddUint64(&mmListPublicVacancies.afterListPublicVacanciesCounter, 1)
+
+	mmListPublicVacancies.t.Helper()
+
+	if mmListPublicVacancies.inspectFuncListPublicVacancies != nil {
+		mmListPublicVacancies.inspectFuncListPublicVacancies(ctx, in)
+	}
+
+	mm_params := VacancyStorageMockListPublicVacanciesParams{ctx, in}
+
+	// Record call args
+	mmListPublicVacancies.ListPublicVacanciesMock.mutex.Lock()
+	mmListPublicVacancies.ListPublicVacanciesMock.callArgs = append(mmListPublicVacancies.ListPublicVacanciesMock.callArgs, &mm_params)
+	mmListPublicVacancies.ListPublicVacanciesMock.mutex.Unlock()
+
+	for _, e := range mmListPublicVacancies.ListPublicVacanciesMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.va1, e.results.err
+		}
+	}
+
+	if mmListPublicVacancies.ListPublicVacanciesMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmListPublicVacancies.ListPublicVacanciesMock.defaultExpectation.Counter, 1)
+		mm_want := mmListPublicVacancies.ListPublicVacanciesMock.defaultExpectation.params
+		mm_want_ptrs := mmListPublicVacancies.ListPublicVacanciesMock.defaultExpectation.paramPtrs
+
+		mm_got := VacancyStorageMockListPublicVacanciesParams{ctx, in}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmListPublicVacancies.t.Errorf("VacancyStorageMock.ListPublicVacancies got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmListPublicVacancies.ListPublicVacanciesMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.in != nil && !minimock.Equal(*mm_want_ptrs.in, mm_got.in) {
+				mmListPublicVacancies.t.Errorf("VacancyStorageMock.ListPublicVacancies got unexpected parameter in, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmListPublicVacancies.ListPublicVacanciesMock.defaultExpectation.expectationOrigins.originIn, *mm_want_ptrs.in, mm_got.in, minimock.Diff(*mm_want_ptrs.in, mm_got.in))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmListPublicVacancies.t.Errorf("VacancyStorageMock.ListPublicVacancies got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmListPublicVacancies.ListPublicVacanciesMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmListPublicVacancies.ListPublicVacanciesMock.defaultExpectation.results
+		if mm_results == nil {
+			mmListPublicVacancies.t.Fatal("No results are set for the VacancyStorageMock.ListPublicVacancies")
+		}
+		return (*mm_results).va1, (*mm_results).err
+	}
+	if mmListPublicVacancies.funcListPublicVacancies != nil {
+		return mmListPublicVacancies.funcListPublicVacancies(ctx, in)
+	}
+	mmListPublicVacancies.t.Fatalf("Unexpected call to VacancyStorageMock.ListPublicVacancies. %v %v", ctx, in)
+	return
+}
+
+// ListPublicVacanciesAfterCounter returns a count of finished VacancyStorageMock.ListPublicVacancies invocations
+func (mmListPublicVacancies *VacancyStorageMock) ListPublicVacanciesAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmListPublicVacancies.afterListPublicVacanciesCounter)
+}
+
+// ListPublicVacanciesBeforeCounter returns a count of VacancyStorageMock.ListPublicVacancies invocations
+func (mmListPublicVacancies *VacancyStorageMock) ListPublicVacanciesBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmListPublicVacancies.beforeListPublicVacanciesCounter)
+}
+
+// Calls returns a list of arguments used in each call to VacancyStorageMock.ListPublicVacancies.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmListPublicVacancies *mVacancyStorageMockListPublicVacancies) Calls() []*VacancyStorageMockListPublicVacanciesParams {
+	mmListPublicVacancies.mutex.RLock()
+
+	argCopy := make([]*VacancyStorageMockListPublicVacanciesParams, len(mmListPublicVacancies.callArgs))
+	copy(argCopy, mmListPublicVacancies.callArgs)
+
+	mmListPublicVacancies.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockListPublicVacanciesDone returns true if the count of the ListPublicVacancies invocations corresponds
+// the number of defined expectations
+func (m *VacancyStorageMock) MinimockListPublicVacanciesDone() bool {
+	if m.ListPublicVacanciesMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.ListPublicVacanciesMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.ListPublicVacanciesMock.invocationsDone()
+}
+
+// MinimockListPublicVacanciesInspect logs each unmet expectation
+func (m *VacancyStorageMock) MinimockListPublicVacanciesInspect() {
+	for _, e := range m.ListPublicVacanciesMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to VacancyStorageMock.ListPublicVacancies at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterListPublicVacanciesCounter := mm_atomic.LoadUint64(&m.afterListPublicVacanciesCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.ListPublicVacanciesMock.defaultExpectation != nil && afterListPublicVacanciesCounter < 1 {
+		if m.ListPublicVacanciesMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to VacancyStorageMock.ListPublicVacancies at\n%s", m.ListPublicVacanciesMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to VacancyStorageMock.ListPublicVacancies at\n%s with params: %#v", m.ListPublicVacanciesMock.defaultExpectation.expectationOrigins.origin, *m.ListPublicVacanciesMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcListPublicVacancies != nil && afterListPublicVacanciesCounter < 1 {
+		m.t.Errorf("Expected call to VacancyStorageMock.ListPublicVacancies at\n%s", m.funcListPublicVacanciesOrigin)
+	}
+
+	if !m.ListPublicVacanciesMock.invocationsDone() && afterListPublicVacanciesCounter > 0 {
+		m.t.Errorf("Expected %d calls to VacancyStorageMock.ListPublicVacancies at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.ListPublicVacanciesMock.expectedInvocations), m.ListPublicVacanciesMock.expectedInvocationsOrigin, afterListPublicVacanciesCounter)
+	}
+}
+
+type mVacancyStorageMockListRoleClassificationTargets struct {
+	optional           bool
+	mock               *VacancyStorageMock
+	defaultExpectation *VacancyStorageMockListRoleClassificationTargetsExpectation
+	expectations       []*VacancyStorageMockListRoleClassificationTargetsExpectation
+
+	callArgs []*VacancyStorageMockListRoleClassificationTargetsParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// VacancyStorageMockListRoleClassificationTargetsExpectation specifies expectation struct of the VacancyStorage.ListRoleClassificationTargets
+type VacancyStorageMockListRoleClassificationTargetsExpectation struct {
+	mock               *VacancyStorageMock
+	params             *VacancyStorageMockListRoleClassificationTargetsParams
+	paramPtrs          *VacancyStorageMockListRoleClassificationTargetsParamPtrs
+	expectationOrigins VacancyStorageMockListRoleClassificationTargetsExpectationOrigins
+	results            *VacancyStorageMockListRoleClassificationTargetsResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// VacancyStorageMockListRoleClassificationTargetsParams contains parameters of the VacancyStorage.ListRoleClassificationTargets
+type VacancyStorageMockListRoleClassificationTargetsParams struct {
+	ctx context.Context
+	in  domain.ReclassifyRolesInput
+}
+
+// VacancyStorageMockListRoleClassificationTargetsParamPtrs contains pointers to parameters of the VacancyStorage.ListRoleClassificationTargets
+type VacancyStorageMockListRoleClassificationTargetsParamPtrs struct {
+	ctx *context.Context
+	in  *domain.ReclassifyRolesInput
+}
+
+// VacancyStorageMockListRoleClassificationTargetsResults contains results of the VacancyStorage.ListRoleClassificationTargets
+type VacancyStorageMockListRoleClassificationTargetsResults struct {
+	ra1 []domain.RoleClassificationTarget
+	err error
+}
+
+// VacancyStorageMockListRoleClassificationTargetsOrigins contains origins of expectations of the VacancyStorage.ListRoleClassificationTargets
+type VacancyStorageMockListRoleClassificationTargetsExpectationOrigins struct {
+	origin    string
+	originCtx string
+	originIn  string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmListRoleClassificationTargets *mVacancyStorageMockListRoleClassificationTargets) Optional() *mVacancyStorageMockListRoleClassificationTargets {
+	mmListRoleClassificationTargets.optional = true
+	return mmListRoleClassificationTargets
+}
+
+// Expect sets up expected params for VacancyStorage.ListRoleClassificationTargets
+func (mmListRoleClassificationTargets *mVacancyStorageMockListRoleClassificationTargets) Expect(ctx context.Context, in domain.ReclassifyRolesInput) *mVacancyStorageMockListRoleClassificationTargets {
+	if mmListRoleClassificationTargets.mock.funcListRoleClassificationTargets != nil {
+		mmListRoleClassificationTargets.mock.t.Fatalf("VacancyStorageMock.ListRoleClassificationTargets mock is already set by Set")
+	}
+
+	if mmListRoleClassificationTargets.defaultExpectation == nil {
+		mmListRoleClassificationTargets.defaultExpectation = &VacancyStorageMockListRoleClassificationTargetsExpectation{}
+	}
+
+	if mmListRoleClassificationTargets.defaultExpectation.paramPtrs != nil {
+		mmListRoleClassificationTargets.mock.t.Fatalf("VacancyStorageMock.ListRoleClassificationTargets mock is already set by ExpectParams functions")
+	}
+
+	mmListRoleClassificationTargets.defaultExpectation.params = &VacancyStorageMockListRoleClassificationTargetsParams{ctx, in}
+	mmListRoleClassificationTargets.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmListRoleClassificationTargets.expectations {
+		if minimock.Equal(e.params, mmListRoleClassificationTargets.defaultExpectation.params) {
+			mmListRoleClassificationTargets.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmListRoleClassificationTargets.defaultExpectation.params)
+		}
+	}
+
+	return mmListRoleClassificationTargets
+}
+
+// ExpectCtxParam1 sets up expected param ctx for VacancyStorage.ListRoleClassificationTargets
+func (mmListRoleClassificationTargets *mVacancyStorageMockListRoleClassificationTargets) ExpectCtxParam1(ctx context.Context) *mVacancyStorageMockListRoleClassificationTargets {
+	if mmListRoleClassificationTargets.mock.funcListRoleClassificationTargets != nil {
+		mmListRoleClassificationTargets.mock.t.Fatalf("VacancyStorageMock.ListRoleClassificationTargets mock is already set by Set")
+	}
+
+	if mmListRoleClassificationTargets.defaultExpectation == nil {
+		mmListRoleClassificationTargets.defaultExpectation = &VacancyStorageMockListRoleClassificationTargetsExpectation{}
+	}
+
+	if mmListRoleClassificationTargets.defaultExpectation.params != nil {
+		mmListRoleClassificationTargets.mock.t.Fatalf("VacancyStorageMock.ListRoleClassificationTargets mock is already set by Expect")
+	}
+
+	if mmListRoleClassificationTargets.defaultExpectation.paramPtrs == nil {
+		mmListRoleClassificationTargets.defaultExpectation.paramPtrs = &VacancyStorageMockListRoleClassificationTargetsParamPtrs{}
+	}
+	mmListRoleClassificationTargets.defaultExpectation.paramPtrs.ctx = &ctx
+	mmListRoleClassificationTargets.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmListRoleClassificationTargets
+}
+
+// ExpectInParam2 sets up expected param in for VacancyStorage.ListRoleClassificationTargets
+func (mmListRoleClassificationTargets *mVacancyStorageMockListRoleClassificationTargets) ExpectInParam2(in domain.ReclassifyRolesInput) *mVacancyStorageMockListRoleClassificationTargets {
+	if mmListRoleClassificationTargets.mock.funcListRoleClassificationTargets != nil {
+		mmListRoleClassificationTargets.mock.t.Fatalf("VacancyStorageMock.ListRoleClassificationTargets mock is already set by Set")
+	}
+
+	if mmListRoleClassificationTargets.defaultExpectation == nil {
+		mmListRoleClassificationTargets.defaultExpectation = &VacancyStorageMockListRoleClassificationTargetsExpectation{}
+	}
+
+	if mmListRoleClassificationTargets.defaultExpectation.params != nil {
+		mmListRoleClassificationTargets.mock.t.Fatalf("VacancyStorageMock.ListRoleClassificationTargets mock is already set by Expect")
+	}
+
+	if mmListRoleClassificationTargets.defaultExpectation.paramPtrs == nil {
+		mmListRoleClassificationTargets.defaultExpectation.paramPtrs = &VacancyStorageMockListRoleClassificationTargetsParamPtrs{}
+	}
+	mmListRoleClassificationTargets.defaultExpectation.paramPtrs.in = &in
+	mmListRoleClassificationTargets.defaultExpectation.expectationOrigins.originIn = minimock.CallerInfo(1)
+
+	return mmListRoleClassificationTargets
+}
+
+// Inspect accepts an inspector function that has same arguments as the VacancyStorage.ListRoleClassificationTargets
+func (mmListRoleClassificationTargets *mVacancyStorageMockListRoleClassificationTargets) Inspect(f func(ctx context.Context, in domain.ReclassifyRolesInput)) *mVacancyStorageMockListRoleClassificationTargets {
+	if mmListRoleClassificationTargets.mock.inspectFuncListRoleClassificationTargets != nil {
+		mmListRoleClassificationTargets.mock.t.Fatalf("Inspect function is already set for VacancyStorageMock.ListRoleClassificationTargets")
+	}
+
+	mmListRoleClassificationTargets.mock.inspectFuncListRoleClassificationTargets = f
+
+	return mmListRoleClassificationTargets
+}
+
+// Return sets up results that will be returned by VacancyStorage.ListRoleClassificationTargets
+func (mmListRoleClassificationTargets *mVacancyStorageMockListRoleClassificationTargets) Return(ra1 []domain.RoleClassificationTarget, err error) *VacancyStorageMock {
+	if mmListRoleClassificationTargets.mock.funcListRoleClassificationTargets != nil {
+		mmListRoleClassificationTargets.mock.t.Fatalf("VacancyStorageMock.ListRoleClassificationTargets mock is already set by Set")
+	}
+
+	if mmListRoleClassificationTargets.defaultExpectation == nil {
+		mmListRoleClassificationTargets.defaultExpectation = &VacancyStorageMockListRoleClassificationTargetsExpectation{mock: mmListRoleClassificationTargets.mock}
+	}
+	mmListRoleClassificationTargets.defaultExpectation.results = &VacancyStorageMockListRoleClassificationTargetsResults{ra1, err}
+	mmListRoleClassificationTargets.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmListRoleClassificationTargets.mock
+}
+
+// Set uses given function f to mock the VacancyStorage.ListRoleClassificationTargets method
+func (mmListRoleClassificationTargets *mVacancyStorageMockListRoleClassificationTargets) Set(f func(ctx context.Context, in domain.ReclassifyRolesInput) (ra1 []domain.RoleClassificationTarget, err error)) *VacancyStorageMock {
+	if mmListRoleClassificationTargets.defaultExpectation != nil {
+		mmListRoleClassificationTargets.mock.t.Fatalf("Default expectation is already set for the VacancyStorage.ListRoleClassificationTargets method")
+	}
+
+	if len(mmListRoleClassificationTargets.expectations) > 0 {
+		mmListRoleClassificationTargets.mock.t.Fatalf("Some expectations are already set for the VacancyStorage.ListRoleClassificationTargets method")
+	}
+
+	mmListRoleClassificationTargets.mock.funcListRoleClassificationTargets = f
+	mmListRoleClassificationTargets.mock.funcListRoleClassificationTargetsOrigin = minimock.CallerInfo(1)
+	return mmListRoleClassificationTargets.mock
+}
+
+// When sets expectation for the VacancyStorage.ListRoleClassificationTargets which will trigger the result defined by the following
+// Then helper
+func (mmListRoleClassificationTargets *mVacancyStorageMockListRoleClassificationTargets) When(ctx context.Context, in domain.ReclassifyRolesInput) *VacancyStorageMockListRoleClassificationTargetsExpectation {
+	if mmListRoleClassificationTargets.mock.funcListRoleClassificationTargets != nil {
+		mmListRoleClassificationTargets.mock.t.Fatalf("VacancyStorageMock.ListRoleClassificationTargets mock is already set by Set")
+	}
+
+	expectation := &VacancyStorageMockListRoleClassificationTargetsExpectation{
+		mock:               mmListRoleClassificationTargets.mock,
+		params:             &VacancyStorageMockListRoleClassificationTargetsParams{ctx, in},
+		expectationOrigins: VacancyStorageMockListRoleClassificationTargetsExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmListRoleClassificationTargets.expectations = append(mmListRoleClassificationTargets.expectations, expectation)
+	return expectation
+}
+
+// Then sets up VacancyStorage.ListRoleClassificationTargets return parameters for the expectation previously defined by the When method
+func (e *VacancyStorageMockListRoleClassificationTargetsExpectation) Then(ra1 []domain.RoleClassificationTarget, err error) *VacancyStorageMock {
+	e.results = &VacancyStorageMockListRoleClassificationTargetsResults{ra1, err}
+	return e.mock
+}
+
+// Times sets number of times VacancyStorage.ListRoleClassificationTargets should be invoked
+func (mmListRoleClassificationTargets *mVacancyStorageMockListRoleClassificationTargets) Times(n uint64) *mVacancyStorageMockListRoleClassificationTargets {
+	if n == 0 {
+		mmListRoleClassificationTargets.mock.t.Fatalf("Times of VacancyStorageMock.ListRoleClassificationTargets mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmListRoleClassificationTargets.expectedInvocations, n)
+	mmListRoleClassificationTargets.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmListRoleClassificationTargets
+}
+
+func (mmListRoleClassificationTargets *mVacancyStorageMockListRoleClassificationTargets) invocationsDone() bool {
+	if len(mmListRoleClassificationTargets.expectations) == 0 && mmListRoleClassificationTargets.defaultExpectation == nil && mmListRoleClassificationTargets.mock.funcListRoleClassificationTargets == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmListRoleClassificationTargets.mock.afterListRoleClassificationTargetsCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmListRoleClassificationTargets.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// ListRoleClassificationTargets implements mm_usecase.VacancyStorage
+func (mmListRoleClassificationTargets *VacancyStorageMock) ListRoleClassificationTargets(ctx context.Context, in domain.ReclassifyRolesInput) (ra1 []domain.RoleClassificationTarget, err error) {
+	mm_atomic.AddUint64(&mmListRoleClassificationTargets.beforeListRoleClassificationTargetsCounter, 1)
+	defer mm_atomic.AddUint64(&mmListRoleClassificationTargets.afterListRoleClassificationTargetsCounter, 1)
+
+	mmListRoleClassificationTargets.t.Helper()
+
+	if mmListRoleClassificationTargets.inspectFuncListRoleClassificationTargets != nil {
+		mmListRoleClassificationTargets.inspectFuncListRoleClassificationTargets(ctx, in)
+	}
+
+	mm_params := VacancyStorageMockListRoleClassificationTargetsParams{ctx, in}
+
+	// Record call args
+	mmListRoleClassificationTargets.ListRoleClassificationTargetsMock.mutex.Lock()
+	mmListRoleClassificationTargets.ListRoleClassificationTargetsMock.callArgs = append(mmListRoleClassificationTargets.ListRoleClassificationTargetsMock.callArgs, &mm_params)
+	mmListRoleClassificationTargets.ListRoleClassificationTargetsMock.mutex.Unlock()
+
+	for _, e := range mmListRoleClassificationTargets.ListRoleClassificationTargetsMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.ra1, e.results.err
+		}
+	}
+
+	if mmListRoleClassificationTargets.ListRoleClassificationTargetsMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmListRoleClassificationTargets.ListRoleClassificationTargetsMock.defaultExpectation.Counter, 1)
+		mm_want := mmListRoleClassificationTargets.ListRoleClassificationTargetsMock.defaultExpectation.params
+		mm_want_ptrs := mmListRoleClassificationTargets.ListRoleClassificationTargetsMock.defaultExpectation.paramPtrs
+
+		mm_got := VacancyStorageMockListRoleClassificationTargetsParams{ctx, in}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmListRoleClassificationTargets.t.Errorf("VacancyStorageMock.ListRoleClassificationTargets got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmListRoleClassificationTargets.ListRoleClassificationTargetsMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.in != nil && !minimock.Equal(*mm_want_ptrs.in, mm_got.in) {
+				mmListRoleClassificationTargets.t.Errorf("VacancyStorageMock.ListRoleClassificationTargets got unexpected parameter in, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmListRoleClassificationTargets.ListRoleClassificationTargetsMock.defaultExpectation.expectationOrigins.originIn, *mm_want_ptrs.in, mm_got.in, minimock.Diff(*mm_want_ptrs.in, mm_got.in))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmListRoleClassificationTargets.t.Errorf("VacancyStorageMock.ListRoleClassificationTargets got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmListRoleClassificationTargets.ListRoleClassificationTargetsMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmListRoleClassificationTargets.ListRoleClassificationTargetsMock.defaultExpectation.results
+		if mm_results == nil {
+			mmListRoleClassificationTargets.t.Fatal("No results are set for the VacancyStorageMock.ListRoleClassificationTargets")
+		}
+		return (*mm_results).ra1, (*mm_results).err
+	}
+	if mmListRoleClassificationTargets.funcListRoleClassificationTargets != nil {
+		return mmListRoleClassificationTargets.funcListRoleClassificationTargets(ctx, in)
+	}
+	mmListRoleClassificationTargets.t.Fatalf("Unexpected call to VacancyStorageMock.ListRoleClassificationTargets. %v %v", ctx, in)
+	return
+}
+
+// ListRoleClassificationTargetsAfterCounter returns a count of finished VacancyStorageMock.ListRoleClassificationTargets invocations
+func (mmListRoleClassificationTargets *VacancyStorageMock) ListRoleClassificationTargetsAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmListRoleClassificationTargets.afterListRoleClassificationTargetsCounter)
+}
+
+// ListRoleClassificationTargetsBeforeCounter returns a count of VacancyStorageMock.ListRoleClassificationTargets invocations
+func (mmListRoleClassificationTargets *VacancyStorageMock) ListRoleClassificationTargetsBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmListRoleClassificationTargets.beforeListRoleClassificationTargetsCounter)
+}
+
+// Calls returns a list of arguments used in each call to VacancyStorageMock.ListRoleClassificationTargets.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmListRoleClassificationTargets *mVacancyStorageMockListRoleClassificationTargets) Calls() []*VacancyStorageMockListRoleClassificationTargetsParams {
+	mmListRoleClassificationTargets.mutex.RLock()
+
+	argCopy := make([]*VacancyStorageMockListRoleClassificationTargetsParams, len(mmListRoleClassificationTargets.callArgs))
+	copy(argCopy, mmListRoleClassificationTargets.callArgs)
+
+	mmListRoleClassificationTargets.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockListRoleClassificationTargetsDone returns true if the count of the ListRoleClassificationTargets invocations corresponds
+// the number of defined expectations
+func (m *VacancyStorageMock) MinimockListRoleClassificationTargetsDone() bool {
+	if m.ListRoleClassificationTargetsMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.ListRoleClassificationTargetsMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.ListRoleClassificationTargetsMock.invocationsDone()
+}
+
+// MinimockListRoleClassificationTargetsInspect logs each unmet expectation
+func (m *VacancyStorageMock) MinimockListRoleClassificationTargetsInspect() {
+	for _, e := range m.ListRoleClassificationTargetsMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to VacancyStorageMock.ListRoleClassificationTargets at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterListRoleClassificationTargetsCounter := mm_atomic.LoadUint64(&m.afterListRoleClassificationTargetsCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.ListRoleClassificationTargetsMock.defaultExpectation != nil && afterListRoleClassificationTargetsCounter < 1 {
+		if m.ListRoleClassificationTargetsMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to VacancyStorageMock.ListRoleClassificationTargets at\n%s", m.ListRoleClassificationTargetsMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to VacancyStorageMock.ListRoleClassificationTargets at\n%s with params: %#v", m.ListRoleClassificationTargetsMock.defaultExpectation.expectationOrigins.origin, *m.ListRoleClassificationTargetsMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcListRoleClassificationTargets != nil && afterListRoleClassificationTargetsCounter < 1 {
+		m.t.Errorf("Expected call to VacancyStorageMock.ListRoleClassificationTargets at\n%s", m.funcListRoleClassificationTargetsOrigin)
+	}
+
+	if !m.ListRoleClassificationTargetsMock.invocationsDone() && afterListRoleClassificationTargetsCounter > 0 {
+		m.t.Errorf("Expected %d calls to VacancyStorageMock.ListRoleClassificationTargets at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.ListRoleClassificationTargetsMock.expectedInvocations), m.ListRoleClassificationTargetsMock.expectedInvocationsOrigin, afterListRoleClassificationTargetsCounter)
+	}
+}
+
+type mVacancyStorageMockListTemplates struct {
+	optional           bool
+	mock               *VacancyStorageMock
+	defaultExpectation *VacancyStorageMockListTemplatesExpectation
+	expectations       []*VacancyStorageMockListTemplatesExpectation
+
+	callArgs []*VacancyStorageMockListTemplatesParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// VacancyStorageMockListTemplatesExpectation specifies expectation struct of the VacancyStorage.ListTemplates
+type VacancyStorageMockListTemplatesExpectation struct {
+	mock               *VacancyStorageMock
+	params             *VacancyStorageMockListTemplatesParams
+	paramPtrs          *VacancyStorageMockListTemplatesParamPtrs
+	expectationOrigins VacancyStorageMockListTemplatesExpectationOrigins
+	results            *VacancyStorageMockListTemplatesResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// VacancyStorageMockListTemplatesParams contains parameters of the VacancyStorage.ListTemplates
+type VacancyStorageMockListTemplatesParams struct {
+	ctx context.Context
+	in  domain.ListTemplatesInput
+}
+
+// VacancyStorageMockListTemplatesParamPtrs contains pointers to parameters of the VacancyStorage.ListTemplates
+type VacancyStorageMockListTemplatesParamPtrs struct {
+	ctx *context.Context
+	in  *domain.ListTemplatesInput
+}
+
+// VacancyStorageMockListTemplatesResults contains results of the VacancyStorage.ListTemplates
+type VacancyStorageMockListTemplatesResults struct {
+	lp1 *domain.ListTemplatesResult
+	err error
+}
+
+// VacancyStorageMockListTemplatesOrigins contains origins of expectations of the VacancyStorage.ListTemplates
+type VacancyStorageMockListTemplatesExpectationOrigins struct {
+	origin    string
+	originCtx string
+	originIn  string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmListTemplates *mVacancyStorageMockListTemplates) Optional() *mVacancyStorageMockListTemplates {
+	mmListTemplates.optional = true
+	return mmListTemplates
+}
+
+// Expect sets up expected params for VacancyStorage.ListTemplates
+func (mmListTemplates *mVacancyStorageMockListTemplates) Expect(ctx context.Context, in domain.ListTemplatesInput) *mVacancyStorageMockListTemplates {
+	if mmListTemplates.mock.funcListTemplates != nil {
+		mmListTemplates.mock.t.Fatalf("VacancyStorageMock.ListTemplates mock is already set by Set")
+	}
+
+	if mmListTemplates.defaultExpectation == nil {
+		mmListTemplates.defaultExpectation = &VacancyStorageMockListTemplatesExpectation{}
+	}
+
+	if mmListTemplates.defaultExpectation.paramPtrs != nil {
+		mmListTemplates.mock.t.Fatalf("VacancyStorageMock.ListTemplates mock is already set by ExpectParams functions")
+	}
+
+	mmListTemplates.defaultExpectation.params = &VacancyStorageMockListTemplatesParams{ctx, in}
+	mmListTemplates.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmListTemplates.expectations {
+		if minimock.Equal(e.params, mmListTemplates.defaultExpectation.params) {
+			mmListTemplates.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmListTemplates.defaultExpectation.params)
+		}
+	}
+
+	return mmListTemplates
+}
+
+// ExpectCtxParam1 sets up expected param ctx for VacancyStorage.ListTemplates
+func (mmListTemplates *mVacancyStorageMockListTemplates) ExpectCtxParam1(ctx context.Context) *mVacancyStorageMockListTemplates {
+	if mmListTemplates.mock.funcListTemplates != nil {
+		mmListTemplates.mock.t.Fatalf("VacancyStorageMock.ListTemplates mock is already set by Set")
+	}
+
+	if mmListTemplates.defaultExpectation == nil {
+		mmListTemplates.defaultExpectation = &VacancyStorageMockListTemplatesExpectation{}
+	}
+
+	if mmListTemplates.defaultExpectation.params != nil {
+		mmListTemplates.mock.t.Fatalf("VacancyStorageMock.ListTemplates mock is already set by Expect")
+	}
+
+	if mmListTemplates.defaultExpectation.paramPtrs == nil {
+		mmListTemplates.defaultExpectation.paramPtrs = &VacancyStorageMockListTemplatesParamPtrs{}
+	}
+	mmListTemplates.defaultExpectation.paramPtrs.ctx = &ctx
+	mmListTemplates.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmListTemplates
+}
+
+// ExpectInParam2 sets up expected param in for VacancyStorage.ListTemplates
+func (mmListTemplates *mVacancyStorageMockListTemplates) ExpectInParam2(in domain.ListTemplatesInput) *mVacancyStorageMockListTemplates {
+	if mmListTemplates.mock.funcListTemplates != nil {
+		mmListTemplates.mock.t.Fatalf("VacancyStorageMock.ListTemplates mock is already set by Set")
+	}
+
+	if mmListTemplates.defaultExpectation == nil {
+		mmListTemplates.defaultExpectation = &VacancyStorageMockListTemplatesExpectation{}
+	}
+
+	if mmListTemplates.defaultExpectation.params != nil {
+		mmListTemplates.mock.t.Fatalf("VacancyStorageMock.ListTemplates mock is already set by Expect")
+	}
+
+	if mmListTemplates.defaultExpectation.paramPtrs == nil {
+		mmListTemplates.defaultExpectation.paramPtrs = &VacancyStorageMockListTemplatesParamPtrs{}
+	}
+	mmListTemplates.defaultExpectation.paramPtrs.in = &in
+	mmListTemplates.defaultExpectation.expectationOrigins.originIn = minimock.CallerInfo(1)
+
+	return mmListTemplates
+}
+
+// Inspect accepts an inspector function that has same arguments as the VacancyStorage.ListTemplates
+func (mmListTemplates *mVacancyStorageMockListTemplates) Inspect(f func(ctx context.Context, in domain.ListTemplatesInput)) *mVacancyStorageMockListTemplates {
+	if mmListTemplates.mock.inspectFuncListTemplates != nil {
+		mmListTemplates.mock.t.Fatalf("Inspect function is already set for VacancyStorageMock.ListTemplates")
+	}
+
+	mmListTemplates.mock.inspectFuncListTemplates = f
+
+	return mmListTemplates
+}
+
+// Return sets up results that will be returned by VacancyStorage.ListTemplates
+func (mmListTemplates *mVacancyStorageMockListTemplates) Return(lp1 *domain.ListTemplatesResult, err error) *VacancyStorageMock {
+	if mmListTemplates.mock.funcListTemplates != nil {
+		mmListTemplates.mock.t.Fatalf("VacancyStorageMock.ListTemplates mock is already set by Set")
+	}
+
+	if mmListTemplates.defaultExpectation == nil {
+		mmListTemplates.defaultExpectation = &VacancyStorageMockListTemplatesExpectation{mock: mmListTemplates.mock}
+	}
+	mmListTemplates.defaultExpectation.results = &VacancyStorageMockListTemplatesResults{lp1, err}
+	mmListTemplates.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmListTemplates.mock
+}
+
+// Set uses given function f to mock the VacancyStorage.ListTemplates method
+func (mmListTemplates *mVacancyStorageMockListTemplates) Set(f func(ctx context.Context, in domain.ListTemplatesInput) (lp1 *domain.ListTemplatesResult, err error)) *VacancyStorageMock {
+	if mmListTemplates.defaultExpectation != nil {
+		mmListTemplates.mock.t.Fatalf("Default expectation is already set for the VacancyStorage.ListTemplates method")
+	}
+
+	if len(mmListTemplates.expectations) > 0 {
+		mmListTemplates.mock.t.Fatalf("Some expectations are already set for the VacancyStorage.ListTemplates method")
+	}
+
+	mmListTemplates.mock.funcListTemplates = f
+	mmListTemplates.mock.funcListTemplatesOrigin = minimock.CallerInfo(1)
+	return mmListTemplates.mock
+}
+
+// When sets expectation for the VacancyStorage.ListTemplates which will trigger the result defined by the following
+// Then helper
+func (mmListTemplates *mVacancyStorageMockListTemplates) When(ctx context.Context, in domain.ListTemplatesInput) *VacancyStorageMockListTemplatesExpectation {
+	if mmListTemplates.mock.funcListTemplates != nil {
+		mmListTemplates.mock.t.Fatalf("VacancyStorageMock.ListTemplates mock is already set by Set")
+	}
+
+	expectation := &VacancyStorageMockListTemplatesExpectation{
+		mock:               mmListTemplates.mock,
+		params:             &VacancyStorageMockListTemplatesParams{ctx, in},
+		expectationOrigins: VacancyStorageMockListTemplatesExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmListTemplates.expectations = append(mmListTemplates.expectations, expectation)
+	return expectation
+}
+
+// Then sets up VacancyStorage.ListTemplates return parameters for the expectation previously defined by the When method
+func (e *VacancyStorageMockListTemplatesExpectation) Then(lp1 *domain.ListTemplatesResult, err error) *VacancyStorageMock {
+	e.results = &VacancyStorageMockListTemplatesResults{lp1, err}
+	return e.mock
+}
+
+// Times sets number of times VacancyStorage.ListTemplates should be invoked
+func (mmListTemplates *mVacancyStorageMockListTemplates) Times(n uint64) *mVacancyStorageMockListTemplates {
+	if n == 0 {
+		mmListTemplates.mock.t.Fatalf("Times of VacancyStorageMock.ListTemplates mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmListTemplates.expectedInvocations, n)
+	mmListTemplates.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmListTemplates
+}
+
+func (mmListTemplates *mVacancyStorageMockListTemplates) invocationsDone() bool {
+	if len(mmListTemplates.expectations) == 0 && mmListTemplates.defaultExpectation == nil && mmListTemplates.mock.funcListTemplates == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmListTemplates.mock.afterListTemplatesCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmListTemplates.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// ListTemplates implements mm_usecase.VacancyStorage
+func (mmListTemplates *VacancyStorageMock) ListTemplates(ctx context.Context, in domain.ListTemplatesInput) (lp1 *domain.ListTemplatesResult, err error) {
+	mm_atomic.AddUint64(&mmListTemplates.beforeListTemplatesCounter, 1)
+	defer mm_atomic.AddUint64(&mmListTemplates.afterListTemplatesCounter, 1)
+
+	mmListTemplates.t.Helper()
+
+	if mmListTemplates.inspectFuncListTemplates != nil {
+		mmListTemplates.inspectFuncListTemplates(ctx, in)
+	}
+
+	mm_params := VacancyStorageMockListTemplatesParams{ctx, in}
+
+	// Record call args
+	mmListTemplates.ListTemplatesMock.mutex.Lock()
+	mmListTemplates.ListTemplatesMock.callArgs = append(mmListTemplates.ListTemplatesMock.callArgs, &mm_params)
+	mmListTemplates.ListTemplatesMock.mutex.Unlock()
+
+	for _, e := range mmListTemplates.ListTemplatesMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.lp1, e.results.err
+		}
+	}
+
+	if mmListTemplates.ListTemplatesMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmListTemplates.ListTemplatesMock.defaultExpectation.Counter, 1)
+		mm_want := mmListTemplates.ListTemplatesMock.defaultExpectation.params
+		mm_want_ptrs := mmListTemplates.ListTemplatesMock.defaultExpectation.paramPtrs
+
+		mm_got := VacancyStorageMockListTemplatesParams{ctx, in}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmListTemplates.t.Errorf("VacancyStorageMock.ListTemplates got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmListTemplates.ListTemplatesMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.in != nil && !minimock.Equal(*mm_want_ptrs.in, mm_got.in) {
+				mmListTemplates.t.Errorf("VacancyStorageMock.ListTemplates got unexpected parameter in, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmListTemplates.ListTemplatesMock.defaultExpectation.expectationOrigins.originIn, *mm_want_ptrs.in, mm_got.in, minimock.Diff(*mm_want_ptrs.in, mm_got.in))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmListTemplates.t.Errorf("VacancyStorageMock.ListTemplates got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmListTemplates.ListTemplatesMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmListTemplates.ListTemplatesMock.defaultExpectation.results
+		if mm_results == nil {
+			mmListTemplates.t.Fatal("No results are set for the VacancyStorageMock.ListTemplates")
+		}
+		return (*mm_results).lp1, (*mm_results).err
+	}
+	if mmListTemplates.funcListTemplates != nil {
+		return mmListTemplates.funcListTemplates(ctx, in)
+	}
+	mmListTemplates.t.Fatalf("Unexpected call to VacancyStorageMock.ListTemplates. %v %v", ctx, in)
+	return
+}
+
+// ListTemplatesAfterCounter returns a count of finished VacancyStorageMock.ListTemplates invocations
+func (mmListTemplates *VacancyStorageMock) ListTemplatesAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmListTemplates.afterListTemplatesCounter)
+}
+
+// ListTemplatesBeforeCounter returns a count of VacancyStorageMock.ListTemplates invocations
+func (mmListTemplates *VacancyStorageMock) ListTemplatesBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmListTemplates.beforeListTemplatesCounter)
+}
+
+// Calls returns a list of arguments used in each call to VacancyStorageMock.ListTemplates.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmListTemplates *mVacancyStorageMockListTemplates) Calls() []*VacancyStorageMockListTemplatesParams {
+	mmListTemplates.mutex.RLock()
+
+	argCopy := make([]*VacancyStorageMockListTemplatesParams, len(mmListTemplates.callArgs))
+	copy(argCopy, mmListTemplates.callArgs)
+
+	mmListTemplates.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockListTemplatesDone returns true if the count of the ListTemplates invocations corresponds
+// the number of defined expectations
+func (m *VacancyStorageMock) MinimockListTemplatesDone() bool {
+	if m.ListTemplatesMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.ListTemplatesMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.ListTemplatesMock.invocationsDone()
+}
+
+// MinimockListTemplatesInspect logs each unmet expectation
+func (m *VacancyStorageMock) MinimockListTemplatesInspect() {
+	for _, e := range m.ListTemplatesMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to VacancyStorageMock.ListTemplates at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterListTemplatesCounter := mm_atomic.LoadUint64(&m.afterListTemplatesCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.ListTemplatesMock.defaultExpectation != nil && afterListTemplatesCounter < 1 {
+		if m.ListTemplatesMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to VacancyStorageMock.ListTemplates at\n%s", m.ListTemplatesMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to VacancyStorageMock.ListTemplates at\n%s with params: %#v", m.ListTemplatesMock.defaultExpectation.expectationOrigins.origin, *m.ListTemplatesMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcListTemplates != nil && afterListTemplatesCounter < 1 {
+		m.t.Errorf("Expected call to VacancyStorageMock.ListTemplates at\n%s", m.funcListTemplatesOrigin)
+	}
+
+	if !m.ListTemplatesMock.invocationsDone() && afterListTemplatesCounter > 0 {
+		m.t.Errorf("Expected %d calls to VacancyStorageMock.ListTemplates at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.ListTemplatesMock.expectedInvocations), m.ListTemplatesMock.expectedInvocationsOrigin, afterListTemplatesCounter)
+	}
+}
+
+type mVacancyStorageMockListVacancies struct {
+	optional           bool
+	mock               *VacancyStorageMock
+	defaultExpectation *VacancyStorageMockListVacanciesExpectation
+	expectations       []*VacancyStorageMockListVacanciesExpectation
+
+	callArgs []*VacancyStorageMockListVacanciesParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// VacancyStorageMockListVacanciesExpectation specifies expectation struct of the VacancyStorage.ListVacancies
+type VacancyStorageMockListVacanciesExpectation struct {
+	mock               *VacancyStorageMock
+	params             *VacancyStorageMockListVacanciesParams
+	paramPtrs          *VacancyStorageMockListVacanciesParamPtrs
+	expectationOrigins VacancyStorageMockListVacanciesExpectationOrigins
+	results            *VacancyStorageMockListVacanciesResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// VacancyStorageMockListVacanciesParams contains parameters of the VacancyStorage.ListVacancies
+type VacancyStorageMockListVacanciesParams struct {
+	ctx context.Context
+	in  domain.ListVacanciesInput
+}
+
+// VacancyStorageMockListVacanciesParamPtrs contains pointers to parameters of the VacancyStorage.ListVacancies
+type VacancyStorageMockListVacanciesParamPtrs struct {
+	ctx *context.Context
+	in  *domain.ListVacanciesInput
+}
+
+// VacancyStorageMockListVacanciesResults contains results of the VacancyStorage.ListVacancies
+type VacancyStorageMockListVacanciesResults struct {
+	lp1 *domain.ListVacanciesResult
+	err error
+}
+
+// VacancyStorageMockListVacanciesOrigins contains origins of expectations of the VacancyStorage.ListVacancies
+type VacancyStorageMockListVacanciesExpectationOrigins struct {
+	origin    string
+	originCtx string
+	originIn  string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmListVacancies *mVacancyStorageMockListVacancies) Optional() *mVacancyStorageMockListVacancies {
+	mmListVacancies.optional = true
+	return mmListVacancies
+}
+
+// Expect sets up expected params for VacancyStorage.ListVacancies
+func (mmListVacancies *mVacancyStorageMockListVacancies) Expect(ctx context.Context, in domain.ListVacanciesInput) *mVacancyStorageMockListVacancies {
+	if mmListVacancies.mock.funcListVacancies != nil {
+		mmListVacancies.mock.t.Fatalf("VacancyStorageMock.ListVacancies mock is already set by Set")
+	}
+
+	if mmListVacancies.defaultExpectation == nil {
+		mmListVacancies.defaultExpectation = &VacancyStorageMockListVacanciesExpectation{}
+	}
+
+	if mmListVacancies.defaultExpectation.paramPtrs != nil {
+		mmListVacancies.mock.t.Fatalf("VacancyStorageMock.ListVacancies mock is already set by ExpectParams functions")
+	}
+
+	mmListVacancies.defaultExpectation.params = &VacancyStorageMockListVacanciesParams{ctx, in}
+	mmListVacancies.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmListVacancies.expectations {
+		if minimock.Equal(e.params, mmListVacancies.defaultExpectation.params) {
+			mmListVacancies.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmListVacancies.defaultExpectation.params)
+		}
+	}
+
+	return mmListVacancies
+}
+
+// ExpectCtxParam1 sets up expected param ctx for VacancyStorage.ListVacancies
+func (mmListVacancies *mVacancyStorageMockListVacancies) ExpectCtxParam1(ctx context.Context) *mVacancyStorageMockListVacancies {
+	if mmListVacancies.mock.funcListVacancies != nil {
+		mmListVacancies.mock.t.Fatalf("VacancyStorageMock.ListVacancies mock is already set by Set")
+	}
+
+	if mmListVacancies.defaultExpectation == nil {
+		mmListVacancies.defaultExpectation = &VacancyStorageMockListVacanciesExpectation{}
+	}
+
+	if mmListVacancies.defaultExpectation.params != nil {
+		mmListVacancies.mock.t.Fatalf("VacancyStorageMock.ListVacancies mock is already set by Expect")
+	}
+
+	if mmListVacancies.defaultExpectation.paramPtrs == nil {
+		mmListVacancies.defaultExpectation.paramPtrs = &VacancyStorageMockListVacanciesParamPtrs{}
+	}
+	mmListVacancies.defaultExpectation.paramPtrs.ctx = &ctx
+	mmListVacancies.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmListVacancies
+}
+
+// ExpectInParam2 sets up expected param in for VacancyStorage.ListVacancies
+func (mmListVacancies *mVacancyStorageMockListVacancies) ExpectInParam2(in domain.ListVacanciesInput) *mVacancyStorageMockListVacancies {
+	if mmListVacancies.mock.funcListVacancies != nil {
+		mmListVacancies.mock.t.Fatalf("VacancyStorageMock.ListVacancies mock is already set by Set")
+	}
+
+	if mmListVacancies.defaultExpectation == nil {
+		mmListVacancies.defaultExpectation = &VacancyStorageMockListVacanciesExpectation{}
+	}
+
+	if mmListVacancies.defaultExpectation.params != nil {
+		mmListVacancies.mock.t.Fatalf("VacancyStorageMock.ListVacancies mock is already set by Expect")
+	}
+
+	if mmListVacancies.defaultExpectation.paramPtrs == nil {
+		mmListVacancies.defaultExpectation.paramPtrs = &VacancyStorageMockListVacanciesParamPtrs{}
+	}
+	mmListVacancies.defaultExpectation.paramPtrs.in = &in
+	mmListVacancies.defaultExpectation.expectationOrigins.originIn = minimock.CallerInfo(1)
+
+	return mmListVacancies
+}
+
+// Inspect accepts an inspector function that has same arguments as the VacancyStorage.ListVacancies
+func (mmListVacancies *mVacancyStorageMockListVacancies) Inspect(f func(ctx context.Context, in domain.ListVacanciesInput)) *mVacancyStorageMockListVacancies {
+	if mmListVacancies.mock.inspectFuncListVacancies != nil {
+		mmListVacancies.mock.t.Fatalf("Inspect function is already set for VacancyStorageMock.ListVacancies")
+	}
+
+	mmListVacancies.mock.inspectFuncListVacancies = f
+
+	return mmListVacancies
+}
+
+// Return sets up results that will be returned by VacancyStorage.ListVacancies
+func (mmListVacancies *mVacancyStorageMockListVacancies) Return(lp1 *domain.ListVacanciesResult, err error) *VacancyStorageMock {
+	if mmListVacancies.mock.funcListVacancies != nil {
+		mmListVacancies.mock.t.Fatalf("VacancyStorageMock.ListVacancies mock is already set by Set")
+	}
+
+	if mmListVacancies.defaultExpectation == nil {
+		mmListVacancies.defaultExpectation = &VacancyStorageMockListVacanciesExpectation{mock: mmListVacancies.mock}
+	}
+	mmListVacancies.defaultExpectation.results = &VacancyStorageMockListVacanciesResults{lp1, err}
+	mmListVacancies.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmListVacancies.mock
+}
+
+// Set uses given function f to mock the VacancyStorage.ListVacancies method
+func (mmListVacancies *mVacancyStorageMockListVacancies) Set(f func(ctx context.Context, in domain.ListVacanciesInput) (lp1 *domain.ListVacanciesResult, err error)) *VacancyStorageMock {
+	if mmListVacancies.defaultExpectation != nil {
+		mmListVacancies.mock.t.Fatalf("Default expectation is already set for the VacancyStorage.ListVacancies method")
+	}
+
+	if len(mmListVacancies.expectations) > 0 {
+		mmListVacancies.mock.t.Fatalf("Some expectations are already set for the VacancyStorage.ListVacancies method")
+	}
+
+	mmListVacancies.mock.funcListVacancies = f
+	mmListVacancies.mock.funcListVacanciesOrigin = minimock.CallerInfo(1)
+	return mmListVacancies.mock
+}
+
+// When sets expectation for the VacancyStorage.ListVacancies which will trigger the result defined by the following
+// Then helper
+func (mmListVacancies *mVacancyStorageMockListVacancies) When(ctx context.Context, in domain.ListVacanciesInput) *VacancyStorageMockListVacanciesExpectation {
+	if mmListVacancies.mock.funcListVacancies != nil {
+		mmListVacancies.mock.t.Fatalf("VacancyStorageMock.ListVacancies mock is already set by Set")
+	}
+
+	expectation := &VacancyStorageMockListVacanciesExpectation{
+		mock:               mmListVacancies.mock,
+		params:             &VacancyStorageMockListVacanciesParams{ctx, in},
+		expectationOrigins: VacancyStorageMockListVacanciesExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmListVacancies.expectations = append(mmListVacancies.expectations, expectation)
+	return expectation
+}
+
+// Then sets up VacancyStorage.ListVacancies return parameters for the expectation previously defined by the When method
+func (e *VacancyStorageMockListVacanciesExpectation) Then(lp1 *domain.ListVacanciesResult, err error) *VacancyStorageMock {
+	e.results = &VacancyStorageMockListVacanciesResults{lp1, err}
+	return e.mock
+}
+
+// Times sets number of times VacancyStorage.ListVacancies should be invoked
+func (mmListVacancies *mVacancyStorageMockListVacancies) Times(n uint64) *mVacancyStorageMockListVacancies {
+	if n == 0 {
+		mmListVacancies.mock.t.Fatalf("Times of VacancyStorageMock.ListVacancies mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmListVacancies.expectedInvocations, n)
+	mmListVacancies.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmListVacancies
+}
+
+func (mmListVacancies *mVacancyStorageMockListVacancies) invocationsDone() bool {
+	if len(mmListVacancies.expectations) == 0 && mmListVacancies.defaultExpectation == nil && mmListVacancies.mock.funcListVacancies == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmListVacancies.mock.afterListVacanciesCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmListVacancies.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// ListVacancies implements mm_usecase.VacancyStorage
+func (mmListVacancies *VacancyStorageMock) ListVacancies(ctx context.Context, in domain.ListVacanciesInput) (lp1 *domain.ListVacanciesResult, err error) {
+	mm_atomic.AddUint64(&mmListVacancies.beforeListVacanciesCounter, 1)
+	defer mm_atomic.AddUint64(&mmListVacancies.afterListVacanciesCounter, 1)
+
+	mmListVacancies.t.Helper()
+
+	if mmListVacancies.inspectFuncListVacancies != nil {
+		mmListVacancies.inspectFuncListVacancies(ctx, in)
+	}
+
+	mm_params := VacancyStorageMockListVacanciesParams{ctx, in}
+
+	// Record call args
+	mmListVacancies.ListVacanciesMock.mutex.Lock()
+	mmListVacancies.ListVacanciesMock.callArgs = append(mmListVacancies.ListVacanciesMock.callArgs, &mm_params)
+	mmListVacancies.ListVacanciesMock.mutex.Unlock()
+
+	for _, e := range mmListVacancies.ListVacanciesMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.lp1, e.results.err
+		}
+	}
+
+	if mmListVacancies.ListVacanciesMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmListVacancies.ListVacanciesMock.defaultExpectation.Counter, 1)
+		mm_want := mmListVacancies.ListVacanciesMock.defaultExpectation.params
+		mm_want_ptrs := mmListVacancies.ListVacanciesMock.defaultExpectation.paramPtrs
+
+		mm_got := VacancyStorageMockListVacanciesParams{ctx, in}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmListVacancies.t.Errorf("VacancyStorageMock.ListVacancies got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmListVacancies.ListVacanciesMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.in != nil && !minimock.Equal(*mm_want_ptrs.in, mm_got.in) {
+				mmListVacancies.t.Errorf("VacancyStorageMock.ListVacancies got unexpected parameter in, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmListVacancies.ListVacanciesMock.defaultExpectation.expectationOrigins.originIn, *mm_want_ptrs.in, mm_got.in, minimock.Diff(*mm_want_ptrs.in, mm_got.in))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmListVacancies.t.Errorf("VacancyStorageMock.ListVacancies got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmListVacancies.ListVacanciesMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmListVacancies.ListVacanciesMock.defaultExpectation.results
+		if mm_results == nil {
+			mmListVacancies.t.Fatal("No results are set for the VacancyStorageMock.ListVacancies")
+		}
+		return (*mm_results).lp1, (*mm_results).err
+	}
+	if mmListVacancies.funcListVacancies != nil {
+		return mmListVacancies.funcListVacancies(ctx, in)
+	}
+	mmListVacancies.t.Fatalf("Unexpected call to VacancyStorageMock.ListVacancies. %v %v", ctx, in)
+	return
+}
+
+// ListVacanciesAfterCounter returns a count of finished VacancyStorageMock.ListVacancies invocations
+func (mmListVacancies *VacancyStorageMock) ListVacanciesAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmListVacancies.afterListVacanciesCounter)
+}
+
+// ListVacanciesBeforeCounter returns a count of VacancyStorageMock.ListVacancies invocations
+func (mmListVacancies *VacancyStorageMock) ListVacanciesBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmListVacancies.beforeListVacanciesCounter)
+}
+
+// Calls returns a list of arguments used in each call to VacancyStorageMock.ListVacancies.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmListVacancies *mVacancyStorageMockListVacancies) Calls() []*VacancyStorageMockListVacanciesParams {
+	mmListVacancies.mutex.RLock()
+
+	argCopy := make([]*VacancyStorageMockListVacanciesParams, len(mmListVacancies.callArgs))
+	copy(argCopy, mmListVacancies.callArgs)
+
+	mmListVacancies.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockListVacanciesDone returns true if the count of the ListVacancies invocations corresponds
+// the number of defined expectations
+func (m *VacancyStorageMock) MinimockListVacanciesDone() bool {
+	if m.ListVacanciesMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.ListVacanciesMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.ListVacanciesMock.invocationsDone()
+}
+
+// MinimockListVacanciesInspect logs each unmet expectation
+func (m *VacancyStorageMock) MinimockListVacanciesInspect() {
+	for _, e := range m.ListVacanciesMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to VacancyStorageMock.ListVacancies at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterListVacanciesCounter := mm_atomic.LoadUint64(&m.afterListVacanciesCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.ListVacanciesMock.defaultExpectation != nil && afterListVacanciesCounter < 1 {
+		if m.ListVacanciesMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to VacancyStorageMock.ListVacancies at\n%s", m.ListVacanciesMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to VacancyStorageMock.ListVacancies at\n%s with params: %#v", m.ListVacanciesMock.defaultExpectation.expectationOrigins.origin, *m.ListVacanciesMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcListVacancies != nil && afterListVacanciesCounter < 1 {
+		m.t.Errorf("Expected call to VacancyStorageMock.ListVacancies at\n%s", m.funcListVacanciesOrigin)
+	}
+
+	if !m.ListVacanciesMock.invocationsDone() && afterListVacanciesCounter > 0 {
+		m.t.Errorf("Expected %d calls to VacancyStorageMock.ListVacancies at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.ListVacanciesMock.expectedInvocations), m.ListVacanciesMock.expectedInvocationsOrigin, afterListVacanciesCounter)
+	}
+}
+
+type mVacancyStorageMockListVacancyStatusHistory struct {
+	optional           bool
+	mock               *VacancyStorageMock
+	defaultExpectation *VacancyStorageMockListVacancyStatusHistoryExpectation
+	expectations       []*VacancyStorageMockListVacancyStatusHistoryExpectation
+
+	callArgs []*VacancyStorageMockListVacancyStatusHistoryParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// VacancyStorageMockListVacancyStatusHistoryExpectation specifies expectation struct of the VacancyStorage.ListVacancyStatusHistory
+type VacancyStorageMockListVacancyStatusHistoryExpectation struct {
+	mock               *VacancyStorageMock
+	params             *VacancyStorageMockListVacancyStatusHistoryParams
+	paramPtrs          *VacancyStorageMockListVacancyStatusHistoryParamPtrs
+	expectationOrigins VacancyStorageMockListVacancyStatusHistoryExpectationOrigins
+	results            *VacancyStorageMockListVacancyStatusHistoryResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// VacancyStorageMockListVacancyStatusHistoryParams contains parameters of the VacancyStorage.ListVacancyStatusHistory
+type VacancyStorageMockListVacancyStatusHistoryParams struct {
+	ctx context.Context
+	in  domain.ListVacancyStatusHistoryInput
+}
+
+// VacancyStorageMockListVacancyStatusHistoryParamPtrs contains pointers to parameters of the VacancyStorage.ListVacancyStatusHistory
+type VacancyStorageMockListVacancyStatusHistoryParamPtrs struct {
+	ctx *context.Context
+	in  *domain.ListVacancyStatusHistoryInput
+}
+
+// VacancyStorageMockListVacancyStatusHistoryResults contains results of the VacancyStorage.ListVacancyStatusHistory
+type VacancyStorageMockListVacancyStatusHistoryResults struct {
+	lp1 *domain.ListVacancyStatusHistoryResult
+	err error
+}
+
+// VacancyStorageMockListVacancyStatusHistoryOrigins contains origins of expectations of the VacancyStorage.ListVacancyStatusHistory
+type VacancyStorageMockListVacancyStatusHistoryExpectationOrigins struct {
+	origin    string
+	originCtx string
+	originIn  string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmListVacancyStatusHistory *mVacancyStorageMockListVacancyStatusHistory) Optional() *mVacancyStorageMockListVacancyStatusHistory {
+	mmListVacancyStatusHistory.optional = true
+	return mmListVacancyStatusHistory
+}
+
+// Expect sets up expected params for VacancyStorage.ListVacancyStatusHistory
+func (mmListVacancyStatusHistory *mVacancyStorageMockListVacancyStatusHistory) Expect(ctx context.Context, in domain.ListVacancyStatusHistoryInput) *mVacancyStorageMockListVacancyStatusHistory {
+	if mmListVacancyStatusHistory.mock.funcListVacancyStatusHistory != nil {
+		mmListVacancyStatusHistory.mock.t.Fatalf("VacancyStorageMock.ListVacancyStatusHistory mock is already set by Set")
+	}
+
+	if mmListVacancyStatusHistory.defaultExpectation == nil {
+		mmListVacancyStatusHistory.defaultExpectation = &VacancyStorageMockListVacancyStatusHistoryExpectation{}
+	}
+
+	if mmListVacancyStatusHistory.defaultExpectation.paramPtrs != nil {
+		mmListVacancyStatusHistory.mock.t.Fatalf("VacancyStorageMock.ListVacancyStatusHistory mock is already set by ExpectParams functions")
+	}
+
+	mmListVacancyStatusHistory.defaultExpectation.params = &VacancyStorageMockListVacancyStatusHistoryParams{ctx, in}
+	mmListVacancyStatusHistory.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmListVacancyStatusHistory.expectations {
+		if minimock.Equal(e.params, mmListVacancyStatusHistory.defaultExpectation.params) {
+			mmListVacancyStatusHistory.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmListVacancyStatusHistory.defaultExpectation.params)
+		}
+	}
+
+	return mmListVacancyStatusHistory
+}
+
+// ExpectCtxParam1 sets up expected param ctx for VacancyStorage.ListVacancyStatusHistory
+func (mmListVacancyStatusHistory *mVacancyStorageMockListVacancyStatusHistory) ExpectCtxParam1(ctx context.Context) *mVacancyStorageMockListVacancyStatusHistory {
+	if mmListVacancyStatusHistory.mock.funcListVacancyStatusHistory != nil {
+		mmListVacancyStatusHistory.mock.t.Fatalf("VacancyStorageMock.ListVacancyStatusHistory mock is already set by Set")
+	}
+
+	if mmListVacancyStatusHistory.defaultExpectation == nil {
+		mmListVacancyStatusHistory.defaultExpectation = &VacancyStorageMockListVacancyStatusHistoryExpectation{}
+	}
+
+	if mmListVacancyStatusHistory.defaultExpectation.params != nil {
+		mmListVacancyStatusHistory.mock.t.Fatalf("VacancyStorageMock.ListVacancyStatusHistory mock is already set by Expect")
+	}
+
+	if mmListVacancyStatusHistory.defaultExpectation.paramPtrs == nil {
+		mmListVacancyStatusHistory.defaultExpectation.paramPtrs = &VacancyStorageMockListVacancyStatusHistoryParamPtrs{}
+	}
+	mmListVacancyStatusHistory.defaultExpectation.paramPtrs.ctx = &ctx
+	mmListVacancyStatusHistory.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmListVacancyStatusHistory
+}
+
+// ExpectInParam2 sets up expected param in for VacancyStorage.ListVacancyStatusHistory
+func (mmListVacancyStatusHistory *mVacancyStorageMockListVacancyStatusHistory) ExpectInParam2(in domain.ListVacancyStatusHistoryInput) *mVacancyStorageMockListVacancyStatusHistory {
+	if mmListVacancyStatusHistory.mock.funcListVacancyStatusHistory != nil {
+		mmListVacancyStatusHistory.mock.t.Fatalf("VacancyStorageMock.ListVacancyStatusHistory mock is already set by Set")
+	}
+
+	if mmListVacancyStatusHistory.defaultExpectation == nil {
+		mmListVacancyStatusHistory.defaultExpectation = &VacancyStorageMockListVacancyStatusHistoryExpectation{}
+	}
+
+	if mmListVacancyStatusHistory.defaultExpectation.params != nil {
+		mmListVacancyStatusHistory.mock.t.Fatalf("VacancyStorageMock.ListVacancyStatusHistory mock is already set by Expect")
+	}
+
+	if mmListVacancyStatusHistory.defaultExpectation.paramPtrs == nil {
+		mmListVacancyStatusHistory.defaultExpectation.paramPtrs = &VacancyStorageMockListVacancyStatusHistoryParamPtrs{}
+	}
+	mmListVacancyStatusHistory.defaultExpectation.paramPtrs.in = &in
+	mmListVacancyStatusHistory.defaultExpectation.expectationOrigins.originIn = minimock.CallerInfo(1)
+
+	return mmListVacancyStatusHistory
+}
+
+// Inspect accepts an inspector function that has same arguments as the VacancyStorage.ListVacancyStatusHistory
+func (mmListVacancyStatusHistory *mVacancyStorageMockListVacancyStatusHistory) Inspect(f func(ctx context.Context, in domain.ListVacancyStatusHistoryInput)) *mVacancyStorageMockListVacancyStatusHistory {
+	if mmListVacancyStatusHistory.mock.inspectFuncListVacancyStatusHistory != nil {
+		mmListVacancyStatusHistory.mock.t.Fatalf("Inspect function is already set for VacancyStorageMock.ListVacancyStatusHistory")
+	}
+
+	mmListVacancyStatusHistory.mock.inspectFuncListVacancyStatusHistory = f
+
+	return mmListVacancyStatusHistory
+}
+
+// Return sets up results that will be returned by VacancyStorage.ListVacancyStatusHistory
+func (mmListVacancyStatusHistory *mVacancyStorageMockListVacancyStatusHistory) Return(lp1 *domain.ListVacancyStatusHistoryResult, err error) *VacancyStorageMock {
+	if mmListVacancyStatusHistory.mock.funcListVacancyStatusHistory != nil {
+		mmListVacancyStatusHistory.mock.t.Fatalf("VacancyStorageMock.ListVacancyStatusHistory mock is already set by Set")
+	}
+
+	if mmListVacancyStatusHistory.defaultExpectation == nil {
+		mmListVacancyStatusHistory.defaultExpectation = &VacancyStorageMockListVacancyStatusHistoryExpectation{mock: mmListVacancyStatusHistory.mock}
+	}
+	mmListVacancyStatusHistory.defaultExpectation.results = &VacancyStorageMockListVacancyStatusHistoryResults{lp1, err}
+	mmListVacancyStatusHistory.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmListVacancyStatusHistory.mock
+}
+
+// Set uses given function f to mock the VacancyStorage.ListVacancyStatusHistory method
+func (mmListVacancyStatusHistory *mVacancyStorageMockListVacancyStatusHistory) Set(f func(ctx context.Context, in domain.ListVacancyStatusHistoryInput) (lp1 *domain.ListVacancyStatusHistoryResult, err error)) *VacancyStorageMock {
+	if mmListVacancyStatusHistory.defaultExpectation != nil {
+		mmListVacancyStatusHistory.mock.t.Fatalf("Default expectation is already set for the VacancyStorage.ListVacancyStatusHistory method")
+	}
+
+	if len(mmListVacancyStatusHistory.expectations) > 0 {
+		mmListVacancyStatusHistory.mock.t.Fatalf("Some expectations are already set for the VacancyStorage.ListVacancyStatusHistory method")
+	}
+
+	mmListVacancyStatusHistory.mock.funcListVacancyStatusHistory = f
+	mmListVacancyStatusHistory.mock.funcListVacancyStatusHistoryOrigin = minimock.CallerInfo(1)
+	return mmListVacancyStatusHistory.mock
+}
+
+// When sets expectation for the VacancyStorage.ListVacancyStatusHistory which will trigger the result defined by the following
+// Then helper
+func (mmListVacancyStatusHistory *mVacancyStorageMockListVacancyStatusHistory) When(ctx context.Context, in domain.ListVacancyStatusHistoryInput) *VacancyStorageMockListVacancyStatusHistoryExpectation {
+	if mmListVacancyStatusHistory.mock.funcListVacancyStatusHistory != nil {
+		mmListVacancyStatusHistory.mock.t.Fatalf("VacancyStorageMock.ListVacancyStatusHistory mock is already set by Set")
+	}
+
+	expectation := &VacancyStorageMockListVacancyStatusHistoryExpectation{
+		mock:               mmListVacancyStatusHistory.mock,
+		params:             &VacancyStorageMockListVacancyStatusHistoryParams{ctx, in},
+		expectationOrigins: VacancyStorageMockListVacancyStatusHistoryExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmListVacancyStatusHistory.expectations = append(mmListVacancyStatusHistory.expectations, expectation)
+	return expectation
+}
+
+// Then sets up VacancyStorage.ListVacancyStatusHistory return parameters for the expectation previously defined by the When method
+func (e *VacancyStorageMockListVacancyStatusHistoryExpectation) Then(lp1 *domain.ListVacancyStatusHistoryResult, err error) *VacancyStorageMock {
+	e.results = &VacancyStorageMockListVacancyStatusHistoryResults{lp1, err}
+	return e.mock
+}
+
+// Times sets number of times VacancyStorage.ListVacancyStatusHistory should be invoked
+func (mmListVacancyStatusHistory *mVacancyStorageMockListVacancyStatusHistory) Times(n uint64) *mVacancyStorageMockListVacancyStatusHistory {
+	if n == 0 {
+		mmListVacancyStatusHistory.mock.t.Fatalf("Times of VacancyStorageMock.ListVacancyStatusHistory mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmListVacancyStatusHistory.expectedInvocations, n)
+	mmListVacancyStatusHistory.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmListVacancyStatusHistory
+}
+
+func (mmListVacancyStatusHistory *mVacancyStorageMockListVacancyStatusHistory) invocationsDone() bool {
+	if len(mmListVacancyStatusHistory.expectations) == 0 && mmListVacancyStatusHistory.defaultExpectation == nil && mmListVacancyStatusHistory.mock.funcListVacancyStatusHistory == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmListVacancyStatusHistory.mock.afterListVacancyStatusHistoryCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmListVacancyStatusHistory.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// ListVacancyStatusHistory implements mm_usecase.VacancyStorage
+func (mmListVacancyStatusHistory *VacancyStorageMock) ListVacancyStatusHistory(ctx context.Context, in domain.ListVacancyStatusHistoryInput) (lp1 *domain.ListVacancyStatusHistoryResult, err error) {
+	mm_atomic.AddUint64(&mmListVacancyStatusHistory.beforeListVacancyStatusHistoryCounter, 1)
+	defer mm_atomic.AddUint64(&mmListVacancyStatusHistory.afterListVacancyStatusHistoryCounter, 1)
+
+	mmListVacancyStatusHistory.t.Helper()
+
+	if mmListVacancyStatusHistory.inspectFuncListVacancyStatusHistory != nil {
+		mmListVacancyStatusHistory.inspectFuncListVacancyStatusHistory(ctx, in)
+	}
+
+	mm_params := VacancyStorageMockListVacancyStatusHistoryParams{ctx, in}
+
+	// Record call args
+	mmListVacancyStatusHistory.ListVacancyStatusHistoryMock.mutex.Lock()
+	mmListVacancyStatusHistory.ListVacancyStatusHistoryMock.callArgs = append(mmListVacancyStatusHistory.ListVacancyStatusHistoryMock.callArgs, &mm_params)
+	mmListVacancyStatusHistory.ListVacancyStatusHistoryMock.mutex.Unlock()
+
+	for _, e := range mmListVacancyStatusHistory.ListVacancyStatusHistoryMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.lp1, e.results.err
+		}
+	}
+
+	if mmListVacancyStatusHistory.ListVacancyStatusHistoryMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmListVacancyStatusHistory.ListVacancyStatusHistoryMock.defaultExpectation.Counter, 1)
+		mm_want := mmListVacancyStatusHistory.ListVacancyStatusHistoryMock.defaultExpectation.params
+		mm_want_ptrs := mmListVacancyStatusHistory.ListVacancyStatusHistoryMock.defaultExpectation.paramPtrs
+
+		mm_got := VacancyStorageMockListVacancyStatusHistoryParams{ctx, in}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmListVacancyStatusHistory.t.Errorf("VacancyStorageMock.ListVacancyStatusHistory got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmListVacancyStatusHistory.ListVacancyStatusHistoryMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.in != nil && !minimock.Equal(*mm_want_ptrs.in, mm_got.in) {
+				mmListVacancyStatusHistory.t.Errorf("VacancyStorageMock.ListVacancyStatusHistory got unexpected parameter in, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmListVacancyStatusHistory.ListVacancyStatusHistoryMock.defaultExpectation.expectationOrigins.originIn, *mm_want_ptrs.in, mm_got.in, minimock.Diff(*mm_want_ptrs.in, mm_got.in))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmListVacancyStatusHistory.t.Errorf("VacancyStorageMock.ListVacancyStatusHistory got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmListVacancyStatusHistory.ListVacancyStatusHistoryMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmListVacancyStatusHistory.ListVacancyStatusHistoryMock.defaultExpectation.results
+		if mm_results == nil {
+			mmListVacancyStatusHistory.t.Fatal("No results are set for the VacancyStorageMock.ListVacancyStatusHistory")
+		}
+		return (*mm_results).lp1, (*mm_results).err
+	}
+	if mmListVacancyStatusHistory.funcListVacancyStatusHistory != nil {
+		return mmListVacancyStatusHistory.funcListVacancyStatusHistory(ctx, in)
+	}
+	mmListVacancyStatusHistory.t.Fatalf("Unexpected call to VacancyStorageMock.ListVacancyStatusHistory. %v %v", ctx, in)
+	return
+}
+
+// ListVacancyStatusHistoryAfterCounter returns a count of finished VacancyStorageMock.ListVacancyStatusHistory invocations
+func (mmListVacancyStatusHistory *VacancyStorageMock) ListVacancyStatusHistoryAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmListVacancyStatusHistory.afterListVacancyStatusHistoryCounter)
+}
+
+// ListVacancyStatusHistoryBeforeCounter returns a count of VacancyStorageMock.ListVacancyStatusHistory invocations
+func (mmListVacancyStatusHistory *VacancyStorageMock) ListVacancyStatusHistoryBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmListVacancyStatusHistory.beforeListVacancyStatusHistoryCounter)
+}
+
+// Calls returns a list of arguments used in each call to VacancyStorageMock.ListVacancyStatusHistory.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmListVacancyStatusHistory *mVacancyStorageMockListVacancyStatusHistory) Calls() []*VacancyStorageMockListVacancyStatusHistoryParams {
+	mmListVacancyStatusHistory.mutex.RLock()
+
+	argCopy := make([]*VacancyStorageMockListVacancyStatusHistoryParams, len(mmListVacancyStatusHistory.callArgs))
+	copy(argCopy, mmListVacancyStatusHistory.callArgs)
+
+	mmListVacancyStatusHistory.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockListVacancyStatusHistoryDone returns true if the count of the ListVacancyStatusHistory invocations corresponds
+// the number of defined expectations
+func (m *VacancyStorageMock) MinimockListVacancyStatusHistoryDone() bool {
+	if m.ListVacancyStatusHistoryMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.ListVacancyStatusHistoryMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.ListVacancyStatusHistoryMock.invocationsDone()
+}
+
+// MinimockListVacancyStatusHistoryInspect logs each unmet expectation
+func (m *VacancyStorageMock) MinimockListVacancyStatusHistoryInspect() {
+	for _, e := range m.ListVacancyStatusHistoryMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to VacancyStorageMock.ListVacancyStatusHistory at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterListVacancyStatusHistoryCounter := mm_atomic.LoadUint64(&m.afterListVacancyStatusHistoryCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.ListVacancyStatusHistoryMock.defaultExpectation != nil && afterListVacancyStatusHistoryCounter < 1 {
+		if m.ListVacancyStatusHistoryMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to VacancyStorageMock.ListVacancyStatusHistory at\n%s", m.ListVacancyStatusHistoryMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to VacancyStorageMock.ListVacancyStatusHistory at\n%s with params: %#v", m.ListVacancyStatusHistoryMock.defaultExpectation.expectationOrigins.origin, *m.ListVacancyStatusHistoryMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcListVacancyStatusHistory != nil && afterListVacancyStatusHistoryCounter < 1 {
+		m.t.Errorf("Expected call to VacancyStorageMock.ListVacancyStatusHistory at\n%s", m.funcListVacancyStatusHistoryOrigin)
+	}
+
+	if !m.ListVacancyStatusHistoryMock.invocationsDone() && afterListVacancyStatusHistoryCounter > 0 {
+		m.t.Errorf("Expected %d calls to VacancyStorageMock.ListVacancyStatusHistory at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.ListVacancyStatusHistoryMock.expectedInvocations), m.ListVacancyStatusHistoryMock.expectedInvocationsOrigin, afterListVacancyStatusHistoryCounter)
+	}
+}
+
+type mVacancyStorageMockListVacancyVersions struct {
+	optional           bool
+	mock               *VacancyStorageMock
+	defaultExpectation *VacancyStorageMockListVacancyVersionsExpectation
+	expectations       []*VacancyStorageMockListVacancyVersionsExpectation
+
+	callArgs []*VacancyStorageMockListVacancyVersionsParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// VacancyStorageMockListVacancyVersionsExpectation specifies expectation struct of the VacancyStorage.ListVacancyVersions
+type VacancyStorageMockListVacancyVersionsExpectation struct {
+	mock               *VacancyStorageMock
+	params             *VacancyStorageMockListVacancyVersionsParams
+	paramPtrs          *VacancyStorageMockListVacancyVersionsParamPtrs
+	expectationOrigins VacancyStorageMockListVacancyVersionsExpectationOrigins
+	results            *VacancyStorageMockListVacancyVersionsResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// VacancyStorageMockListVacancyVersionsParams contains parameters of the VacancyStorage.ListVacancyVersions
+type VacancyStorageMockListVacancyVersionsParams struct {
+	ctx context.Context
+	in  domain.ListVacancyVersionsInput
+}
+
+// VacancyStorageMockListVacancyVersionsParamPtrs contains pointers to parameters of the VacancyStorage.ListVacancyVersions
+type VacancyStorageMockListVacancyVersionsParamPtrs struct {
+	ctx *context.Context
+	in  *domain.ListVacancyVersionsInput
+}
+
+// VacancyStorageMockListVacancyVersionsResults contains results of the VacancyStorage.ListVacancyVersions
+type VacancyStorageMockListVacancyVersionsResults struct {
+	lp1 *domain.ListVacancyVersionsResult
+	err error
+}
+
+// VacancyStorageMockListVacancyVersionsOrigins contains origins of expectations of the VacancyStorage.ListVacancyVersions
+type VacancyStorageMockListVacancyVersionsExpectationOrigins struct {
+	origin    string
+	originCtx string
+	originIn  string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmListVacancyVersions *mVacancyStorageMockListVacancyVersions) Optional() *mVacancyStorageMockListVacancyVersions {
+	mmListVacancyVersions.optional = true
+	return mmListVacancyVersions
+}
+
+// Expect sets up expected params for VacancyStorage.ListVacancyVersions
+func (mmListVacancyVersions *mVacancyStorageMockListVacancyVersions) Expect(ctx context.Context, in domain.ListVacancyVersionsInput) *mVacancyStorageMockListVacancyVersions {
+	if mmListVacancyVersions.mock.funcListVacancyVersions != nil {
+		mmListVacancyVersions.mock.t.Fatalf("VacancyStorageMock.ListVacancyVersions mock is already set by Set")
+	}
+
+	if mmListVacancyVersions.defaultExpectation == nil {
+		mmListVacancyVersions.defaultExpectation = &VacancyStorageMockListVacancyVersionsExpectation{}
+	}
+
+	if mmListVacancyVersions.defaultExpectation.paramPtrs != nil {
+		mmListVacancyVersions.mock.t.Fatalf("VacancyStorageMock.ListVacancyVersions mock is already set by ExpectParams functions")
+	}
+
+	mmListVacancyVersions.defaultExpectation.params = &VacancyStorageMockListVacancyVersionsParams{ctx, in}
+	mmListVacancyVersions.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmListVacancyVersions.expectations {
+		if minimock.Equal(e.params, mmListVacancyVersions.defaultExpectation.params) {
+			mmListVacancyVersions.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmListVacancyVersions.defaultExpectation.params)
+		}
+	}
+
+	return mmListVacancyVersions
+}
+
+// ExpectCtxParam1 sets up expected param ctx for VacancyStorage.ListVacancyVersions
+func (mmListVacancyVersions *mVacancyStorageMockListVacancyVersions) ExpectCtxParam1(ctx context.Context) *mVacancyStorageMockListVacancyVersions {
+	if mmListVacancyVersions.mock.funcListVacancyVersions != nil {
+		mmListVacancyVersions.mock.t.Fatalf("VacancyStorageMock.ListVacancyVersions mock is already set by Set")
+	}
+
+	if mmListVacancyVersions.defaultExpectation == nil {
+		mmListVacancyVersions.defaultExpectation = &VacancyStorageMockListVacancyVersionsExpectation{}
+	}
+
+	if mmListVacancyVersions.defaultExpectation.params != nil {
+		mmListVacancyVersions.mock.t.Fatalf("VacancyStorageMock.ListVacancyVersions mock is already set by Expect")
+	}
+
+	if mmListVacancyVersions.defaultExpectation.paramPtrs == nil {
+		mmListVacancyVersions.defaultExpectation.paramPtrs = &VacancyStorageMockListVacancyVersionsParamPtrs{}
+	}
+	mmListVacancyVersions.defaultExpectation.paramPtrs.ctx = &ctx
+	mmListVacancyVersions.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmListVacancyVersions
+}
+
+// ExpectInParam2 sets up expected param in for VacancyStorage.ListVacancyVersions
+func (mmListVacancyVersions *mVacancyStorageMockListVacancyVersions) ExpectInParam2(in domain.ListVacancyVersionsInput) *mVacancyStorageMockListVacancyVersions {
+	if mmListVacancyVersions.mock.funcListVacancyVersions != nil {
+		mmListVacancyVersions.mock.t.Fatalf("VacancyStorageMock.ListVacancyVersions mock is already set by Set")
+	}
+
+	if mmListVacancyVersions.defaultExpectation == nil {
+		mmListVacancyVersions.defaultExpectation = &VacancyStorageMockListVacancyVersionsExpectation{}
+	}
+
+	if mmListVacancyVersions.defaultExpectation.params != nil {
+		mmListVacancyVersions.mock.t.Fatalf("VacancyStorageMock.ListVacancyVersions mock is already set by Expect")
+	}
+
+	if mmListVacancyVersions.defaultExpectation.paramPtrs == nil {
+		mmListVacancyVersions.defaultExpectation.paramPtrs = &VacancyStorageMockListVacancyVersionsParamPtrs{}
+	}
+	mmListVacancyVersions.defaultExpectation.paramPtrs.in = &in
+	mmListVacancyVersions.defaultExpectation.expectationOrigins.originIn = minimock.CallerInfo(1)
+
+	return mmListVacancyVersions
+}
+
+// Inspect accepts an inspector function that has same arguments as the VacancyStorage.ListVacancyVersions
+func (mmListVacancyVersions *mVacancyStorageMockListVacancyVersions) Inspect(f func(ctx context.Context, in domain.ListVacancyVersionsInput)) *mVacancyStorageMockListVacancyVersions {
+	if mmListVacancyVersions.mock.inspectFuncListVacancyVersions != nil {
+		mmListVacancyVersions.mock.t.Fatalf("Inspect function is already set for VacancyStorageMock.ListVacancyVersions")
+	}
+
+	mmListVacancyVersions.mock.inspectFuncListVacancyVersions = f
+
+	return mmListVacancyVersions
+}
+
+// Return sets up results that will be returned by VacancyStorage.ListVacancyVersions
+func (mmListVacancyVersions *mVacancyStorageMockListVacancyVersions) Return(lp1 *domain.ListVacancyVersionsResult, err error) *VacancyStorageMock {
+	if mmListVacancyVersions.mock.funcListVacancyVersions != nil {
+		mmListVacancyVersions.mock.t.Fatalf("VacancyStorageMock.ListVacancyVersions mock is already set by Set")
+	}
+
+	if mmListVacancyVersions.defaultExpectation == nil {
+		mmListVacancyVersions.defaultExpectation = &VacancyStorageMockListVacancyVersionsExpectation{mock: mmListVacancyVersions.mock}
+	}
+	mmListVacancyVersions.defaultExpectation.results = &VacancyStorageMockListVacancyVersionsResults{lp1, err}
+	mmListVacancyVersions.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmListVacancyVersions.mock
+}
+
+// Set uses given function f to mock the VacancyStorage.ListVacancyVersions method
+func (mmListVacancyVersions *mVacancyStorageMockListVacancyVersions) Set(f func(ctx context.Context, in domain.ListVacancyVersionsInput) (lp1 *domain.ListVacancyVersionsResult, err error)) *VacancyStorageMock {
+	if mmListVacancyVersions.defaultExpectation != nil {
+		mmListVacancyVersions.mock.t.Fatalf("Default expectation is already set for the VacancyStorage.ListVacancyVersions method")
+	}
+
+	if len(mmListVacancyVersions.expectations) > 0 {
+		mmListVacancyVersions.mock.t.Fatalf("Some expectations are already set for the VacancyStorage.ListVacancyVersions method")
+	}
+
+	mmListVacancyVersions.mock.funcListVacancyVersions = f
+	mmListVacancyVersions.mock.funcListVacancyVersionsOrigin = minimock.CallerInfo(1)
+	return mmListVacancyVersions.mock
+}
+
+// When sets expectation for the VacancyStorage.ListVacancyVersions which will trigger the result defined by the following
+// Then helper
+func (mmListVacancyVersions *mVacancyStorageMockListVacancyVersions) When(ctx context.Context, in domain.ListVacancyVersionsInput) *VacancyStorageMockListVacancyVersionsExpectation {
+	if mmListVacancyVersions.mock.funcListVacancyVersions != nil {
+		mmListVacancyVersions.mock.t.Fatalf("VacancyStorageMock.ListVacancyVersions mock is already set by Set")
+	}
+
+	expectation := &VacancyStorageMockListVacancyVersionsExpectation{
+		mock:               mmListVacancyVersions.mock,
+		params:             &VacancyStorageMockListVacancyVersionsParams{ctx, in},
+		expectationOrigins: VacancyStorageMockListVacancyVersionsExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmListVacancyVersions.expectations = append(mmListVacancyVersions.expectations, expectation)
+	return expectation
+}
+
+// Then sets up VacancyStorage.ListVacancyVersions return parameters for the expectation previously defined by the When method
+func (e *VacancyStorageMockListVacancyVersionsExpectation) Then(lp1 *domain.ListVacancyVersionsResult, err error) *VacancyStorageMock {
+	e.results = &VacancyStorageMockListVacancyVersionsResults{lp1, err}
+	return e.mock
+}
+
+// Times sets number of times VacancyStorage.ListVacancyVersions should be invoked
+func (mmListVacancyVersions *mVacancyStorageMockListVacancyVersions) Times(n uint64) *mVacancyStorageMockListVacancyVersions {
+	if n == 0 {
+		mmListVacancyVersions.mock.t.Fatalf("Times of VacancyStorageMock.ListVacancyVersions mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmListVacancyVersions.expectedInvocations, n)
+	mmListVacancyVersions.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmListVacancyVersions
+}
+
+func (mmListVacancyVersions *mVacancyStorageMockListVacancyVersions) invocationsDone() bool {
+	if len(mmListVacancyVersions.expectations) == 0 && mmListVacancyVersions.defaultExpectation == nil && mmListVacancyVersions.mock.funcListVacancyVersions == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmListVacancyVersions.mock.afterListVacancyVersionsCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmListVacancyVersions.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// ListVacancyVersions implements mm_usecase.VacancyStorage
+func (mmListVacancyVersions *VacancyStorageMock) ListVacancyVersions(ctx context.Context, in domain.ListVacancyVersionsInput) (lp1 *domain.ListVacancyVersionsResult, err error) {
+	mm_atomic.AddUint64(&mmListVacancyVersions.beforeListVacancyVersionsCounter, 1)
+	defer mm_atomic.AddUint64(&mmListVacancyVersions.afterListVacancyVersionsCounter, 1)
+
+	mmListVacancyVersions.t.Helper()
+
+	if mmListVacancyVersions.inspectFuncListVacancyVersions != nil {
+		mmListVacancyVersions.inspectFuncListVacancyVersions(ctx, in)
+	}
+
+	mm_params := VacancyStorageMockListVacancyVersionsParams{ctx, in}
+
+	// Record call args
+	mmListVacancyVersions.ListVacancyVersionsMock.mutex.Lock()
+	mmListVacancyVersions.ListVacancyVersionsMock.callArgs = append(mmListVacancyVersions.ListVacancyVersionsMock.callArgs, &mm_params)
+	mmListVacancyVersions.ListVacancyVersionsMock.mutex.Unlock()
+
+	for _, e := range mmListVacancyVersions.ListVacancyVersionsMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.lp1, e.results.err
+		}
+	}
+
+	if mmListVacancyVersions.ListVacancyVersionsMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmListVacancyVersions.ListVacancyVersionsMock.defaultExpectation.Counter, 1)
+		mm_want := mmListVacancyVersions.ListVacancyVersionsMock.defaultExpectation.params
+		mm_want_ptrs := mmListVacancyVersions.ListVacancyVersionsMock.defaultExpectation.paramPtrs
+
+		mm_got := VacancyStorageMockListVacancyVersionsParams{ctx, in}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmListVacancyVersions.t.Errorf("VacancyStorageMock.ListVacancyVersions got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmListVacancyVersions.ListVacancyVersionsMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.in != nil && !minimock.Equal(*mm_want_ptrs.in, mm_got.in) {
+				mmListVacancyVersions.t.Errorf("VacancyStorageMock.ListVacancyVersions got unexpected parameter in, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmListVacancyVersions.ListVacancyVersionsMock.defaultExpectation.expectationOrigins.originIn, *mm_want_ptrs.in, mm_got.in, minimock.Diff(*mm_want_ptrs.in, mm_got.in))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmListVacancyVersions.t.Errorf("VacancyStorageMock.ListVacancyVersions got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmListVacancyVersions.ListVacancyVersionsMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmListVacancyVersions.ListVacancyVersionsMock.defaultExpectation.results
+		if mm_results == nil {
+			mmListVacancyVersions.t.Fatal("No results are set for the VacancyStorageMock.ListVacancyVersions")
+		}
+		return (*mm_results).lp1, (*mm_results).err
+	}
+	if mmListVacancyVersions.funcListVacancyVersions != nil {
+		return mmListVacancyVersions.funcListVacancyVersions(ctx, in)
+	}
+	mmListVacancyVersions.t.Fatalf("Unexpected call to VacancyStorageMock.ListVacancyVersions. %v %v", ctx, in)
+	return
+}
+
+// ListVacancyVersionsAfterCounter returns a count of finished VacancyStorageMock.ListVacancyVersions invocations
+func (mmListVacancyVersions *VacancyStorageMock) ListVacancyVersionsAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmListVacancyVersions.afterListVacancyVersionsCounter)
+}
+
+// ListVacancyVersionsBeforeCounter returns a count of VacancyStorageMock.ListVacancyVersions invocations
+func (mmListVacancyVersions *VacancyStorageMock) ListVacancyVersionsBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmListVacancyVersions.beforeListVacancyVersionsCounter)
+}
+
+// Calls returns a list of arguments used in each call to VacancyStorageMock.ListVacancyVersions.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmListVacancyVersions *mVacancyStorageMockListVacancyVersions) Calls() []*VacancyStorageMockListVacancyVersionsParams {
+	mmListVacancyVersions.mutex.RLock()
+
+	argCopy := make([]*VacancyStorageMockListVacancyVersionsParams, len(mmListVacancyVersions.callArgs))
+	copy(argCopy, mmListVacancyVersions.callArgs)
+
+	mmListVacancyVersions.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockListVacancyVersionsDone returns true if the count of the ListVacancyVersions invocations corresponds
+// the number of defined expectations
+func (m *VacancyStorageMock) MinimockListVacancyVersionsDone() bool {
+	if m.ListVacancyVersionsMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.ListVacancyVersionsMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.ListVacancyVersionsMock.invocationsDone()
+}
+
+// MinimockListVacancyVersionsInspect logs each unmet expectation
+func (m *VacancyStorageMock) MinimockListVacancyVersionsInspect() {
+	for _, e := range m.ListVacancyVersionsMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to VacancyStorageMock.ListVacancyVersions at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterListVacancyVersionsCounter := mm_atomic.LoadUint64(&m.afterListVacancyVersionsCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.ListVacancyVersionsMock.defaultExpectation != nil && afterListVacancyVersionsCounter < 1 {
+		if m.ListVacancyVersionsMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to VacancyStorageMock.ListVacancyVersions at\n%s", m.ListVacancyVersionsMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to VacancyStorageMock.ListVacancyVersions at\n%s with params: %#v", m.ListVacancyVersionsMock.defaultExpectation.expectationOrigins.origin, *m.ListVacancyVersionsMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcListVacancyVersions != nil && afterListVacancyVersionsCounter < 1 {
+		m.t.Errorf("Expected call to VacancyStorageMock.ListVacancyVersions at\n%s", m.funcListVacancyVersionsOrigin)
+	}
+
+	if !m.ListVacancyVersionsMock.invocationsDone() && afterListVacancyVersionsCounter > 0 {
+		m.t.Errorf("Expected %d calls to VacancyStorageMock.ListVacancyVersions at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.ListVacancyVersionsMock.expectedInvocations), m.ListVacancyVersionsMock.expectedInvocationsOrigin, afterListVacancyVersionsCounter)
+	}
+}
+
+type mVacancyStorageMockRemoveCollaborator struct {
+	optional           bool
+	mock               *VacancyStorageMock
+	defaultExpectation *VacancyStorageMockRemoveCollaboratorExpectation
+	expectations       []*VacancyStorageMockRemoveCollaboratorExpectation
+
+	callArgs []*VacancyStorageMockRemoveCollaboratorParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// VacancyStorageMockRemoveCollaboratorExpectation specifies expectation struct of the VacancyStorage.RemoveCollaborator
+type VacancyStorageMockRemoveCollaboratorExpectation struct {
+	mock               *VacancyStorageMock
+	params             *VacancyStorageMockRemoveCollaboratorParams
+	paramPtrs          *VacancyStorageMockRemoveCollaboratorParamPtrs
+	expectationOrigins VacancyStorageMockRemoveCollaboratorExpectationOrigins
+	results            *VacancyStorageMockRemoveCollaboratorResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// VacancyStorageMockRemoveCollaboratorParams contains parameters of the VacancyStorage.RemoveCollaborator
+type VacancyStorageMockRemoveCollaboratorParams struct {
+	ctx       context.Context
+	vacancyID string
+	userID    uint64
+}
+
+// VacancyStorageMockRemoveCollaboratorParamPtrs contains pointers to parameters of the VacancyStorage.RemoveCollaborator
+type VacancyStorageMockRemoveCollaboratorParamPtrs struct {
+	ctx       *context.Context
+	vacancyID *string
+	userID    *uint64
+}
+
+// VacancyStorageMockRemoveCollaboratorResults contains results of the VacancyStorage.RemoveCollaborator
+type VacancyStorageMockRemoveCollaboratorResults struct {
+	b1  bool
+	err error
+}
+
+// VacancyStorageMockRemoveCollaboratorOrigins contains origins of expectations of the VacancyStorage.RemoveCollaborator
+type VacancyStorageMockRemoveCollaboratorExpectationOrigins struct {
+	origin          string
+	originCtx       string
+	originVacancyID string
+	originUserID    string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmRemoveCollaborator *mVacancyStorageMockRemoveCollaborator) Optional() *mVacancyStorageMockRemoveCollaborator {
+	mmRemoveCollaborator.optional = true
+	return mmRemoveCollaborator
+}
+
+// Expect sets up expected params for VacancyStorage.RemoveCollaborator
+func (mmRemoveCollaborator *mVacancyStorageMockRemoveCollaborator) Expect(ctx context.Context, vacancyID string, userID uint64) *mVacancyStorageMockRemoveCollaborator {
+	if mmRemoveCollaborator.mock.funcRemoveCollaborator != nil {
+		mmRemoveCollaborator.mock.t.Fatalf("VacancyStorageMock.RemoveCollaborator mock is already set by Set")
+	}
+
+	if mmRemoveCollaborator.defaultExpectation == nil {
+		mmRemoveCollaborator.defaultExpectation = &VacancyStorageMockRemoveCollaboratorExpectation{}
+	}
+
+	if mmRemoveCollaborator.defaultExpectation.paramPtrs != nil {
+		mmRemoveCollaborator.mock.t.Fatalf("VacancyStorageMock.RemoveCollaborator mock is already set by ExpectParams functions")
+	}
+
+	mmRemoveCollaborator.defaultExpectation.params = &VacancyStorageMockRemoveCollaboratorParams{ctx, vacancyID, userID}
+	mmRemoveCollaborator.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmRemoveCollaborator.expectations {
+		if minimock.Equal(e.params, mmRemoveCollaborator.defaultExpectation.params) {
+			mmRemoveCollaborator.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmRemoveCollaborator.defaultExpectation.params)
+		}
+	}
+
+	return mmRemoveCollaborator
+}
+
+// ExpectCtxParam1 sets up expected param ctx for VacancyStorage.RemoveCollaborator
+func (mmRemoveCollaborator *mVacancyStorageMockRemoveCollaborator) ExpectCtxParam1(ctx context.Context) *mVacancyStorageMockRemoveCollaborator {
+	if mmRemoveCollaborator.mock.funcRemoveCollaborator != nil {
+		mmRemoveCollaborator.mock.t.Fatalf("VacancyStorageMock.RemoveCollaborator mock is already set by Set")
+	}
+
+	if mmRemoveCollaborator.defaultExpectation == nil {
+		mmRemoveCollaborator.defaultExpectation = &VacancyStorageMockRemoveCollaboratorExpectation{}
+	}
+
+	if mmRemoveCollaborator.defaultExpectation.params != nil {
+		mmRemoveCollaborator.mock.t.Fatalf("VacancyStorageMock.RemoveCollaborator mock is already set by Expect")
+	}
+
+	if mmRemoveCollaborator.defaultExpectation.paramPtrs == nil {
+		mmRemoveCollaborator.defaultExpectation.paramPtrs = &VacancyStorageMockRemoveCollaboratorParamPtrs{}
+	}
+	mmRemoveCollaborator.defaultExpectation.paramPtrs.ctx = &ctx
+	mmRemoveCollaborator.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmRemoveCollaborator
+}
+
+// ExpectVacancyIDParam2 sets up expected param vacancyID for VacancyStorage.RemoveCollaborator
+func (mmRemoveCollaborator *mVacancyStorageMockRemoveCollaborator) ExpectVacancyIDParam2(vacancyID string) *mVacancyStorageMockRemoveCollaborator {
+	if mmRemoveCollaborator.mock.funcRemoveCollaborator != nil {
+		mmRemoveCollaborator.mock.t.Fatalf("VacancyStorageMock.RemoveCollaborator mock is already set by Set")
+	}
+
+	if mmRemoveCollaborator.defaultExpectation == nil {
+		mmRemoveCollaborator.defaultExpectation = &VacancyStorageMockRemoveCollaboratorExpectation{}
+	}
+
+	if mmRemoveCollaborator.defaultExpectation.params != nil {
+		mmRemoveCollaborator.mock.t.Fatalf("VacancyStorageMock.RemoveCollaborator mock is already set by Expect")
+	}
+
+	if mmRemoveCollaborator.defaultExpectation.paramPtrs == nil {
+		mmRemoveCollaborator.defaultExpectation.paramPtrs = &VacancyStorageMockRemoveCollaboratorParamPtrs{}
+	}
+	mmRemoveCollaborator.defaultExpectation.paramPtrs.vacancyID = &vacancyID
+	mmRemoveCollaborator.defaultExpectation.expectationOrigins.originVacancyID = minimock.CallerInfo(1)
+
+	return mmRemoveCollaborator
+}
+
+// ExpectUserIDParam3 sets up expected param userID for VacancyStorage.RemoveCollaborator
+func (mmRemoveCollaborator *mVacancyStorageMockRemoveCollaborator) ExpectUserIDParam3(userID uint64) *mVacancyStorageMockRemoveCollaborator {
+	if mmRemoveCollaborator.mock.funcRemoveCollaborator != nil {
+		mmRemoveCollaborator.mock.t.Fatalf("VacancyStorageMock.RemoveCollaborator mock is already set by Set")
+	}
+
+	if mmRemoveCollaborator.defaultExpectation == nil {
+		mmRemoveCollaborator.defaultExpectation = &VacancyStorageMockRemoveCollaboratorExpectation{}
+	}
+
+	if mmRemoveCollaborator.defaultExpectation.params != nil {
+		mmRemoveCollaborator.mock.t.Fatalf("VacancyStorageMock.RemoveCollaborator mock is already set by Expect")
+	}
+
+	if mmRemoveCollaborator.defaultExpectation.paramPtrs == nil {
+		mmRemoveCollaborator.defaultExpectation.paramPtrs = &VacancyStorageMockRemoveCollaboratorParamPtrs{}
+	}
+	mmRemoveCollaborator.defaultExpectation.paramPtrs.userID = &userID
+	mmRemoveCollaborator.defaultExpectation.expectationOrigins.originUserID = minimock.CallerInfo(1)
+
+	return mmRemoveCollaborator
+}
+
+// Inspect accepts an inspector function that has same arguments as the VacancyStorage.RemoveCollaborator
+func (mmRemoveCollaborator *mVacancyStorageMockRemoveCollaborator) Inspect(f func(ctx context.Context, vacancyID string, userID uint64)) *mVacancyStorageMockRemoveCollaborator {
+	if mmRemoveCollaborator.mock.inspectFuncRemoveCollaborator != nil {
+		mmRemoveCollaborator.mock.t.Fatalf("Inspect function is already set for VacancyStorageMock.RemoveCollaborator")
+	}
+
+	mmRemoveCollaborator.mock.inspectFuncRemoveCollaborator = f
+
+	return mmRemoveCollaborator
+}
+
+// Return sets up results that will be returned by VacancyStorage.RemoveCollaborator
+func (mmRemoveCollaborator *mVacancyStorageMockRemoveCollaborator) Return(b1 bool, err error) *VacancyStorageMock {
+	if mmRemoveCollaborator.mock.funcRemoveCollaborator != nil {
+		mmRemoveCollaborator.mock.t.Fatalf("VacancyStorageMock.RemoveCollaborator mock is already set by Set")
+	}
+
+	if mmRemoveCollaborator.defaultExpectation == nil {
+		mmRemoveCollaborator.defaultExpectation = &VacancyStorageMockRemoveCollaboratorExpectation{mock: mmRemoveCollaborator.mock}
+	}
+	mmRemoveCollaborator.defaultExpectation.results = &VacancyStorageMockRemoveCollaboratorResults{b1, err}
+	mmRemoveCollaborator.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmRemoveCollaborator.mock
+}
+
+// Set uses given function f to mock the VacancyStorage.RemoveCollaborator method
+func (mmRemoveCollaborator *mVacancyStorageMockRemoveCollaborator) Set(f func(ctx context.Context, vacancyID string, userID uint64) (b1 bool, err error)) *VacancyStorageMock {
+	if mmRemoveCollaborator.defaultExpectation != nil {
+		mmRemoveCollaborator.mock.t.Fatalf("Default expectation is already set for the VacancyStorage.RemoveCollaborator method")
+	}
+
+	if len(mmRemoveCollaborator.expectations) > 0 {
+		mmRemoveCollaborator.mock.t.Fatalf("Some expectations are already set for the VacancyStorage.RemoveCollaborator method")
+	}
+
+	mmRemoveCollaborator.mock.funcRemoveCollaborator = f
+	mmRemoveCollaborator.mock.funcRemoveCollaboratorOrigin = minimock.CallerInfo(1)
+	return mmRemoveCollaborator.mock
+}
+
+// When sets expectation for the VacancyStorage.RemoveCollaborator which will trigger the result defined by the following
+// Then helper
+func (mmRemoveCollaborator *mVacancyStorageMockRemoveCollaborator) When(ctx context.Context, vacancyID string, userID uint64) *VacancyStorageMockRemoveCollaboratorExpectation {
+	if mmRemoveCollaborator.mock.funcRemoveCollaborator != nil {
+		mmRemoveCollaborator.mock.t.Fatalf("VacancyStorageMock.RemoveCollaborator mock is already set by Set")
+	}
+
+	expectation := &VacancyStorageMockRemoveCollaboratorExpectation{
+		mock:               mmRemoveCollaborator.mock,
+		params:             &VacancyStorageMockRemoveCollaboratorParams{ctx, vacancyID, userID},
+		expectationOrigins: VacancyStorageMockRemoveCollaboratorExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmRemoveCollaborator.expectations = append(mmRemoveCollaborator.expectations, expectation)
+	return expectation
+}
+
+// Then sets up VacancyStorage.RemoveCollaborator return parameters for the expectation previously defined by the When method
+func (e *VacancyStorageMockRemoveCollaboratorExpectation) Then(b1 bool, err error) *VacancyStorageMock {
+	e.results = &VacancyStorageMockRemoveCollaboratorResults{b1, err}
+	return e.mock
+}
+
+// Times sets number of times VacancyStorage.RemoveCollaborator should be invoked
+func (mmRemoveCollaborator *mVacancyStorageMockRemoveCollaborator) Times(n uint64) *mVacancyStorageMockRemoveCollaborator {
+	if n == 0 {
+		mmRemoveCollaborator.mock.t.Fatalf("Times of VacancyStorageMock.RemoveCollaborator mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmRemoveCollaborator.expectedInvocations, n)
+	mmRemoveCollaborator.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmRemoveCollaborator
+}
+
+func (mmRemoveCollaborator *mVacancyStorageMockRemoveCollaborator) invocationsDone() bool {
+	if len(mmRemoveCollaborator.expectations) == 0 && mmRemoveCollaborator.defaultExpectation == nil && mmRemoveCollaborator.mock.funcRemoveCollaborator == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmRemoveCollaborator.mock.afterRemoveCollaboratorCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmRemoveCollaborator.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// RemoveCollaborator implements mm_usecase.VacancyStorage
+func (mmRemoveCollaborator *VacancyStorageMock) RemoveCollaborator(ctx context.Context, vacancyID string, userID uint64) (b1 bool, err error) {
+	mm_atomic.AddUint64(&mmRemoveCollaborator.beforeRemoveCollaboratorCounter, 1)
+	defer mm_atomic.AddUint64(&mmRemoveCollaborator.afterRemoveCollaboratorCounter, 1)
+
+	mmRemoveCollaborator.t.Helper()
+
+	if mmRemoveCollaborator.inspectFuncRemoveCollaborator != nil {
+		mmRemoveCollaborator.inspectFuncRemoveCollaborator(ctx, vacancyID, userID)
+	}
+
+	mm_params := VacancyStorageMockRemoveCollaboratorParams{ctx, vacancyID, userID}
+
+	// Record call args
+	mmRemoveCollaborator.RemoveCollaboratorMock.mutex.Lock()
+	mmRemoveCollaborator.RemoveCollaboratorMock.callArgs = append(mmRemoveCollaborator.RemoveCollaboratorMock.callArgs, &mm_params)
+	mmRemoveCollaborator.RemoveCollaboratorMock.mutex.Unlock()
+
+	for _, e := range mmRemoveCollaborator.RemoveCollaboratorMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.b1, e.results.err
+		}
+	}
+
+	if mmRemoveCollaborator.RemoveCollaboratorMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmRemoveCollaborator.RemoveCollaboratorMock.defaultExpectation.Counter, 1)
+		mm_want := mmRemoveCollaborator.RemoveCollaboratorMock.defaultExpectation.params
+		mm_want_ptrs := mmRemoveCollaborator.RemoveCollaboratorMock.defaultExpectation.paramPtrs
+
+		mm_got := VacancyStorageMockRemoveCollaboratorParams{ctx, vacancyID, userID}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmRemoveCollaborator.t.Errorf("VacancyStorageMock.RemoveCollaborator got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmRemoveCollaborator.RemoveCollaboratorMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.vacancyID != nil && !minimock.Equal(*mm_want_ptrs.vacancyID, mm_got.vacancyID) {
+				mmRemoveCollaborator.t.Errorf("VacancyStorageMock.RemoveCollaborator got unexpected parameter vacancyID, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmRemoveCollaborator.RemoveCollaboratorMock.defaultExpectation.expectationOrigins.originVacancyID, *mm_want_ptrs.vacancyID, mm_got.vacancyID, minimock.Diff(*mm_want_ptrs.vacancyID, mm_got.vacancyID))
+			}
+
+			if mm_want_ptrs.userID != nil && !minimock.Equal(*mm_want_ptrs.userID, mm_got.userID) {
+				mmRemoveCollaborator.t.Errorf("VacancyStorageMock.RemoveCollaborator got unexpected parameter userID, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmRemoveCollaborator.RemoveCollaboratorMock.defaultExpectation.expectationOrigins.originUserID, *mm_want_ptrs.userID, mm_got.userID, minimock.Diff(*mm_want_ptrs.userID, mm_got.userID))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmRemoveCollaborator.t.Errorf("VacancyStorageMock.RemoveCollaborator got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmRemoveCollaborator.RemoveCollaboratorMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmRemoveCollaborator.RemoveCollaboratorMock.defaultExpectation.results
+		if mm_results == nil {
+			mmRemoveCollaborator.t.Fatal("No results are set for the VacancyStorageMock.RemoveCollaborator")
+		}
+		return (*mm_results).b1, (*mm_results).err
+	}
+	if mmRemoveCollaborator.funcRemoveCollaborator != nil {
+		return mmRemoveCollaborator.funcRemoveCollaborator(ctx, vacancyID, userID)
+	}
+	mmRemoveCollaborator.t.Fatalf("Unexpected call to VacancyStorageMock.RemoveCollaborator. %v %v %v", ctx, vacancyID, userID)
+	return
+}
+
+// RemoveCollaboratorAfterCounter returns a count of finished VacancyStorageMock.RemoveCollaborator invocations
+func (mmRemoveCollaborator *VacancyStorageMock) RemoveCollaboratorAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmRemoveCollaborator.afterRemoveCollaboratorCounter)
+}
+
+// RemoveCollaboratorBeforeCounter returns a count of VacancyStorageMock.RemoveCollaborator invocations
+func (mmRemoveCollaborator *VacancyStorageMock) RemoveCollaboratorBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmRemoveCollaborator.beforeRemoveCollaboratorCounter)
+}
+
+// Calls returns a list of arguments used in each call to VacancyStorageMock.RemoveCollaborator.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmRemoveCollaborator *mVacancyStorageMockRemoveCollaborator) Calls() []*VacancyStorageMockRemoveCollaboratorParams {
+	mmRemoveCollaborator.mutex.RLock()
+
+	argCopy := make([]*VacancyStorageMockRemoveCollaboratorParams, len(mmRemoveCollaborator.callArgs))
+	copy(argCopy, mmRemoveCollaborator.callArgs)
+
+	mmRemoveCollaborator.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockRemoveCollaboratorDone returns true if the count of the RemoveCollaborator invocations corresponds
+// the number of defined expectations
+func (m *VacancyStorageMock) MinimockRemoveCollaboratorDone() bool {
+	if m.RemoveCollaboratorMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.RemoveCollaboratorMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.RemoveCollaboratorMock.invocationsDone()
+}
+
+// MinimockRemoveCollaboratorInspect logs each unmet expectation
+func (m *VacancyStorageMock) MinimockRemoveCollaboratorInspect() {
+	for _, e := range m.RemoveCollaboratorMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to VacancyStorageMock.RemoveCollaborator at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterRemoveCollaboratorCounter := mm_atomic.LoadUint64(&m.afterRemoveCollaboratorCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.RemoveCollaboratorMock.defaultExpectation != nil && afterRemoveCollaboratorCounter < 1 {
+		if m.RemoveCollaboratorMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to VacancyStorageMock.RemoveCollaborator at\n%s", m.RemoveCollaboratorMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to VacancyStorageMock.RemoveCollaborator at\n%s with params: %#v", m.RemoveCollaboratorMock.defaultExpectation.expectationOrigins.origin, *m.RemoveCollaboratorMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcRemoveCollaborator != nil && afterRemoveCollaboratorCounter < 1 {
+		m.t.Errorf("Expected call to VacancyStorageMock.RemoveCollaborator at\n%s", m.funcRemoveCollaboratorOrigin)
+	}
+
+	if !m.RemoveCollaboratorMock.invocationsDone() && afterRemoveCollaboratorCounter > 0 {
+		m.t.Errorf("Expected %d calls to VacancyStorageMock.RemoveCollaborator at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.RemoveCollaboratorMock.expectedInvocations), m.RemoveCollaboratorMock.expectedInvocationsOrigin, afterRemoveCollaboratorCounter)
+	}
+}
+
+type mVacancyStorageMockSetPipelineStages struct {
+	optional           bool
+	mock               *VacancyStorageMock
+	defaultExpectation *VacancyStorageMockSetPipelineStagesExpectation
+	expectations       []*VacancyStorageMockSetPipelineStagesExpectation
+
+	callArgs []*VacancyStorageMockSetPipelineStagesParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// VacancyStorageMockSetPipelineStagesExpectation specifies expectation struct of the VacancyStorage.SetPipelineStages
+type VacancyStorageMockSetPipelineStagesExpectation struct {
+	mock               *VacancyStorageMock
+	params             *VacancyStorageMockSetPipelineStagesParams
+	paramPtrs          *VacancyStorageMockSetPipelineStagesParamPtrs
+	expectationOrigins VacancyStorageMockSetPipelineStagesExpectationOrigins
+	results            *VacancyStorageMockSetPipelineStagesResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// VacancyStorageMockSetPipelineStagesParams contains parameters of the VacancyStorage.SetPipelineStages
+type VacancyStorageMockSetPipelineStagesParams struct {
+	ctx context.Context
+	in  domain.SetPipelineStagesInput
+}
+
+// VacancyStorageMockSetPipelineStagesParamPtrs contains pointers to parameters of the VacancyStorage.SetPipelineStages
+type VacancyStorageMockSetPipelineStagesParamPtrs struct {
+	ctx *context.Context
+	in  *domain.SetPipelineStagesInput
+}
+
+// VacancyStorageMockSetPipelineStagesResults contains results of the VacancyStorage.SetPipelineStages
+type VacancyStorageMockSetPipelineStagesResults struct {
+	b1  bool
+	err error
+}
+
+// VacancyStorageMockSetPipelineStagesOrigins contains origins of expectations of the VacancyStorage.SetPipelineStages
+type VacancyStorageMockSetPipelineStagesExpectationOrigins struct {
+	origin    string
+	originCtx string
+	originIn  string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmSetPipelineStages *mVacancyStorageMockSetPipelineStages) Optional() *mVacancyStorageMockSetPipelineStages {
+	mmSetPipelineStages.optional = true
+	return mmSetPipelineStages
+}
+
+// Expect sets up expected params for VacancyStorage.SetPipelineStages
+func (mmSetPipelineStages *mVacancyStorageMockSetPipelineStages) Expect(ctx context.Context, in domain.SetPipelineStagesInput) *mVacancyStorageMockSetPipelineStages {
+	if mmSetPipelineStages.mock.funcSetPipelineStages != nil {
+		mmSetPipelineStages.mock.t.Fatalf("VacancyStorageMock.SetPipelineStages mock is already set by Set")
+	}
+
+	if mmSetPipelineStages.defaultExpectation == nil {
+		mmSetPipelineStages.defaultExpectation = &VacancyStorageMockSetPipelineStagesExpectation{}
+	}
+
+	if mmSetPipelineStages.defaultExpectation.paramPtrs != nil {
+		mmSetPipelineStages.mock.t.Fatalf("VacancyStorageMock.SetPipelineStages mock is already set by ExpectParams functions")
+	}
+
+	mmSetPipelineStages.defaultExpectation.params = &VacancyStorageMockSetPipelineStagesParams{ctx, in}
+	mmSetPipelineStages.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmSetPipelineStages.expectations {
+		if minimock.Equal(e.params, mmSetPipelineStages.defaultExpectation.params) {
+			mmSetPipelineStages.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmSetPipelineStages.defaultExpectation.params)
+		}
+	}
+
+	return mmSetPipelineStages
+}
+
+// ExpectCtxParam1 sets up expected param ctx for VacancyStorage.SetPipelineStages
+func (mmSetPipelineStages *mVacancyStorageMockSetPipelineStages) ExpectCtxParam1(ctx context.Context) *mVacancyStorageMockSetPipelineStages {
+	if mmSetPipelineStages.mock.funcSetPipelineStages != nil {
+		mmSetPipelineStages.mock.t.Fatalf("VacancyStorageMock.SetPipelineStages mock is already set by Set")
+	}
+
+	if mmSetPipelineStages.defaultExpectation == nil {
+		mmSetPipelineStages.defaultExpectation = &VacancyStorageMockSetPipelineStagesExpectation{}
+	}
+
+	if mmSetPipelineStages.defaultExpectation.params != nil {
+		mmSetPipelineStages.mock.t.Fatalf("VacancyStorageMock.SetPipelineStages mock is already set by Expect")
+	}
+
+	if mmSetPipelineStages.defaultExpectation.paramPtrs == nil {
+		mmSetPipelineStages.defaultExpectation.paramPtrs = &VacancyStorageMockSetPipelineStagesParamPtrs{}
+	}
+	mmSetPipelineStages.defaultExpectation.paramPtrs.ctx = &ctx
+	mmSetPipelineStages.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmSetPipelineStages
+}
+
+// ExpectInParam2 sets up expected param in for VacancyStorage.SetPipelineStages
+func (mmSetPipelineStages *mVacancyStorageMockSetPipelineStages) ExpectInParam2(in domain.SetPipelineStagesInput) *mVacancyStorageMockSetPipelineStages {
+	if mmSetPipelineStages.mock.funcSetPipelineStages != nil {
+		mmSetPipelineStages.mock.t.Fatalf("VacancyStorageMock.SetPipelineStages mock is already set by Set")
+	}
+
+	if mmSetPipelineStages.defaultExpectation == nil {
+		mmSetPipelineStages.defaultExpectation = &VacancyStorageMockSetPipelineStagesExpectation{}
+	}
+
+	if mmSetPipelineStages.defaultExpectation.params != nil {
+		mmSetPipelineStages.mock.t.Fatalf("VacancyStorageMock.SetPipelineStages mock is already set by Expect")
+	}
+
+	if mmSetPipelineStages.defaultExpectation.paramPtrs == nil {
+		mmSetPipelineStages.defaultExpectation.paramPtrs = &VacancyStorageMockSetPipelineStagesParamPtrs{}
+	}
+	mmSetPipelineStages.defaultExpectation.paramPtrs.in = &in
+	mmSetPipelineStages.defaultExpectation.expectationOrigins.originIn = minimock.CallerInfo(1)
+
+	return mmSetPipelineStages
+}
+
+// Inspect accepts an inspector function that has same arguments as the VacancyStorage.SetPipelineStages
+func (mmSetPipelineStages *mVacancyStorageMockSetPipelineStages) Inspect(f func(ctx context.Context, in domain.SetPipelineStagesInput)) *mVacancyStorageMockSetPipelineStages {
+	if mmSetPipelineStages.mock.inspectFuncSetPipelineStages != nil {
+		mmSetPipelineStages.mock.t.Fatalf("Inspect function is already set for VacancyStorageMock.SetPipelineStages")
+	}
+
+	mmSetPipelineStages.mock.inspectFuncSetPipelineStages = f
+
+	return mmSetPipelineStages
+}
+
+// Return sets up results that will be returned by VacancyStorage.SetPipelineStages
+func (mmSetPipelineStages *mVacancyStorageMockSetPipelineStages) Return(b1 bool, err error) *VacancyStorageMock {
+	if mmSetPipelineStages.mock.funcSetPipelineStages != nil {
+		mmSetPipelineStages.mock.t.Fatalf("VacancyStorageMock.SetPipelineStages mock is already set by Set")
+	}
+
+	if mmSetPipelineStages.defaultExpectation == nil {
+		mmSetPipelineStages.defaultExpectation = &VacancyStorageMockSetPipelineStagesExpectation{mock: mmSetPipelineStages.mock}
+	}
+	mmSetPipelineStages.defaultExpectation.results = &VacancyStorageMockSetPipelineStagesResults{b1, err}
+	mmSetPipelineStages.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmSetPipelineStages.mock
+}
+
+// Set uses given function f to mock the VacancyStorage.SetPipelineStages method
+func (mmSetPipelineStages *mVacancyStorageMockSetPipelineStages) Set(f func(ctx context.Context, in domain.SetPipelineStagesInput) (b1 bool, err error)) *VacancyStorageMock {
+	if mmSetPipelineStages.defaultExpectation != nil {
+		mmSetPipelineStages.mock.t.Fatalf("Default expectation is already set for the VacancyStorage.SetPipelineStages method")
+	}
+
+	if len(mmSetPipelineStages.expectations) > 0 {
+		mmSetPipelineStages.mock.t.Fatalf("Some expectations are already set for the VacancyStorage.SetPipelineStages method")
+	}
+
+	mmSetPipelineStages.mock.funcSetPipelineStages = f
+	mmSetPipelineStages.mock.funcSetPipelineStagesOrigin = minimock.CallerInfo(1)
+	return mmSetPipelineStages.mock
+}
+
+// When sets expectation for the VacancyStorage.SetPipelineStages which will trigger the result defined by the following
+// Then helper
+func (mmSetPipelineStages *mVacancyStorageMockSetPipelineStages) When(ctx context.Context, in domain.SetPipelineStagesInput) *VacancyStorageMockSetPipelineStagesExpectation {
+	if mmSetPipelineStages.mock.funcSetPipelineStages != nil {
+		mmSetPipelineStages.mock.t.Fatalf("VacancyStorageMock.SetPipelineStages mock is already set by Set")
+	}
+
+	expectation := &VacancyStorageMockSetPipelineStagesExpectation{
+		mock:               mmSetPipelineStages.mock,
+		params:             &VacancyStorageMockSetPipelineStagesParams{ctx, in},
+		expectationOrigins: VacancyStorageMockSetPipelineStagesExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmSetPipelineStages.expectations = append(mmSetPipelineStages.expectations, expectation)
+	return expectation
+}
+
+// Then sets up VacancyStorage.SetPipelineStages return parameters for the expectation previously defined by the When method
+func (e *VacancyStorageMockSetPipelineStagesExpectation) Then(b1 bool, err error) *VacancyStorageMock {
+	e.results = &VacancyStorageMockSetPipelineStagesResults{b1, err}
+	return e.mock
+}
+
+// Times sets number of times VacancyStorage.SetPipelineStages should be invoked
+func (mmSetPipelineStages *mVacancyStorageMockSetPipelineStages) Times(n uint64) *mVacancyStorageMockSetPipelineStages {
+	if n == 0 {
+		mmSetPipelineStages.mock.t.Fatalf("Times of VacancyStorageMock.SetPipelineStages mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmSetPipelineStages.expectedInvocations, n)
+	mmSetPipelineStages.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmSetPipelineStages
+}
+
+func (mmSetPipelineStages *mVacancyStorageMockSetPipelineStages) invocationsDone() bool {
+	if len(mmSetPipelineStages.expectations) == 0 && mmSetPipelineStages.defaultExpectation == nil && mmSetPipelineStages.mock.funcSetPipelineStages == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmSetPipelineStages.mock.afterSetPipelineStagesCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmSetPipelineStages.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// SetPipelineStages implements mm_usecase.VacancyStorage
+func (mmSetPipelineStages *VacancyStorageMock) SetPipelineStages(ctx context.Context, in domain.SetPipelineStagesInput) (b1 bool, err error) {
+	mm_atomic.AddUint64(&mmSetPipelineStages.beforeSetPipelineStagesCounter, 1)
+	defer mm_atomic.AddUint64(&mmSetPipelineStages.afterSetPipelineStagesCounter, 1)
+
+	mmSetPipelineStages.t.Helper()
+
+	if mmSetPipelineStages.inspectFuncSetPipelineStages != nil {
+		mmSetPipelineStages.inspectFuncSetPipelineStages(ctx, in)
+	}
+
+	mm_params := VacancyStorageMockSetPipelineStagesParams{ctx, in}
+
+	// Record call args
+	mmSetPipelineStages.SetPipelineStagesMock.mutex.Lock()
+	mmSetPipelineStages.SetPipelineStagesMock.callArgs = append(mmSetPipelineStages.SetPipelineStagesMock.callArgs, &mm_params)
+	mmSetPipelineStages.SetPipelineStagesMock.mutex.Unlock()
+
+	for _, e := range mmSetPipelineStages.SetPipelineStagesMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.b1, e.results.err
+		}
+	}
+
+	if mmSetPipelineStages.SetPipelineStagesMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmSetPipelineStages.SetPipelineStagesMock.defaultExpectation.Counter, 1)
+		mm_want := mmSetPipelineStages.SetPipelineStagesMock.defaultExpectation.params
+		mm_want_ptrs := mmSetPipelineStages.SetPipelineStagesMock.defaultExpectation.paramPtrs
+
+		mm_got := VacancyStorageMockSetPipelineStagesParams{ctx, in}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmSetPipelineStages.t.Errorf("VacancyStorageMock.SetPipelineStages got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmSetPipelineStages.SetPipelineStagesMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.in != nil && !minimock.Equal(*mm_want_ptrs.in, mm_got.in) {
+				mmSetPipelineStages.t.Errorf("VacancyStorageMock.SetPipelineStages got unexpected parameter in, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmSetPipelineStages.SetPipelineStagesMock.defaultExpectation.expectationOrigins.originIn, *mm_want_ptrs.in, mm_got.in, minimock.Diff(*mm_want_ptrs.in, mm_got.in))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmSetPipelineStages.t.Errorf("VacancyStorageMock.SetPipelineStages got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmSetPipelineStages.SetPipelineStagesMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmSetPipelineStages.SetPipelineStagesMock.defaultExpectation.results
+		if mm_results == nil {
+			mmSetPipelineStages.t.Fatal("No results are set for the VacancyStorageMock.SetPipelineStages")
+		}
+		return (*mm_results).b1, (*mm_results).err
+	}
+	if mmSetPipelineStages.funcSetPipelineStages != nil {
+		return mmSetPipelineStages.funcSetPipelineStages(ctx, in)
+	}
+	mmSetPipelineStages.t.Fatalf("Unexpected call to VacancyStorageMock.SetPipelineStages. %v %v", ctx, in)
+	return
+}
+
+// SetPipelineStagesAfterCounter returns a count of finished VacancyStorageMock.SetPipelineStages invocations
+func (mmSetPipelineStages *VacancyStorageMock) SetPipelineStagesAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmSetPipelineStages.afterSetPipelineStagesCounter)
+}
+
+// SetPipelineStagesBeforeCounter returns a count of VacancyStorageMock.SetPipelineStages invocations
+func (mmSetPipelineStages *VacancyStorageMock) SetPipelineStagesBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmSetPipelineStages.beforeSetPipelineStagesCounter)
+}
+
+// Calls returns a list of arguments used in each call to VacancyStorageMock.SetPipelineStages.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmSetPipelineStages *mVacancyStorageMockSetPipelineStages) Calls() []*VacancyStorageMockSetPipelineStagesParams {
+	mmSetPipelineStages.mutex.RLock()
+
+	argCopy := make([]*VacancyStorageMockSetPipelineStagesParams, len(mmSetPipelineStages.callArgs))
+	copy(argCopy, mmSetPipelineStages.callArgs)
+
+	mmSetPipelineStages.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockSetPipelineStagesDone returns true if the count of the SetPipelineStages invocations corresponds
+// the number of defined expectations
+func (m *VacancyStorageMock) MinimockSetPipelineStagesDone() bool {
+	if m.SetPipelineStagesMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.SetPipelineStagesMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.SetPipelineStagesMock.invocationsDone()
+}
+
+// MinimockSetPipelineStagesInspect logs each unmet expectation
+func (m *VacancyStorageMock) MinimockSetPipelineStagesInspect() {
+	for _, e := range m.SetPipelineStagesMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to VacancyStorageMock.SetPipelineStages at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterSetPipelineStagesCounter := mm_atomic.LoadUint64(&m.afterSetPipelineStagesCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.SetPipelineStagesMock.defaultExpectation != nil && afterSetPipelineStagesCounter < 1 {
+		if m.SetPipelineStagesMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to VacancyStorageMock.SetPipelineStages at\n%s", m.SetPipelineStagesMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to VacancyStorageMock.SetPipelineStages at\n%s with params: %#v", m.SetPipelineStagesMock.defaultExpectation.expectationOrigins.origin, *m.SetPipelineStagesMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcSetPipelineStages != nil && afterSetPipelineStagesCounter < 1 {
+		m.t.Errorf("Expected call to VacancyStorageMock.SetPipelineStages at\n%s", m.funcSetPipelineStagesOrigin)
+	}
+
+	if !m.SetPipelineStagesMock.invocationsDone() && afterSetPipelineStagesCounter > 0 {
+		m.t.Errorf("Expected %d calls to VacancyStorageMock.SetPipelineStages at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.SetPipelineStagesMock.expectedInvocations), m.SetPipelineStagesMock.expectedInvocationsOrigin, afterSetPipelineStagesCounter)
+	}
+}
+
+type mVacancyStorageMockSetVacancyRole struct {
+	optional           bool
+	mock               *VacancyStorageMock
+	defaultExpectation *VacancyStorageMockSetVacancyRoleExpectation
+	expectations       []*VacancyStorageMockSetVacancyRoleExpectation
+
+	callArgs []*VacancyStorageMockSetVacancyRoleParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// VacancyStorageMockSetVacancyRoleExpectation specifies expectation struct of the VacancyStorage.SetVacancyRole
+type VacancyStorageMockSetVacancyRoleExpectation struct {
+	mock               *VacancyStorageMock
+	params             *VacancyStorageMockSetVacancyRoleParams
+	paramPtrs          *VacancyStorageMockSetVacancyRoleParamPtrs
+	expectationOrigins VacancyStorageMockSetVacancyRoleExpectationOrigins
+	results            *VacancyStorageMockSetVacancyRoleResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// VacancyStorageMockSetVacancyRoleParams contains parameters of the VacancyStorage.SetVacancyRole
+type VacancyStorageMockSetVacancyRoleParams struct {
+	ctx context.Context
+	in  domain.SetVacancyRoleInput
+}
+
+// VacancyStorageMockSetVacancyRoleParamPtrs contains pointers to parameters of the VacancyStorage.SetVacancyRole
+type VacancyStorageMockSetVacancyRoleParamPtrs struct {
+	ctx *context.Context
+	in  *domain.SetVacancyRoleInput
+}
+
+// VacancyStorageMockSetVacancyRoleResults contains results of the VacancyStorage.SetVacancyRole
+type VacancyStorageMockSetVacancyRoleResults struct {
+	b1  bool
+	err error
+}
+
+// VacancyStorageMockSetVacancyRoleOrigins contains origins of expectations of the VacancyStorage.SetVacancyRole
+type VacancyStorageMockSetVacancyRoleExpectationOrigins struct {
+	origin    string
+	originCtx string
+	originIn  string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmSetVacancyRole *mVacancyStorageMockSetVacancyRole) Optional() *mVacancyStorageMockSetVacancyRole {
+	mmSetVacancyRole.optional = true
+	return mmSetVacancyRole
+}
+
+// Expect sets up expected params for VacancyStorage.SetVacancyRole
+func (mmSetVacancyRole *mVacancyStorageMockSetVacancyRole) Expect(ctx context.Context, in domain.SetVacancyRoleInput) *mVacancyStorageMockSetVacancyRole {
+	if mmSetVacancyRole.mock.funcSetVacancyRole != nil {
+		mmSetVacancyRole.mock.t.Fatalf("VacancyStorageMock.SetVacancyRole mock is already set by Set")
+	}
+
+	if mmSetVacancyRole.defaultExpectation == nil {
+		mmSetVacancyRole.defaultExpectation = &VacancyStorageMockSetVacancyRoleExpectation{}
+	}
+
+	if mmSetVacancyRole.defaultExpectation.paramPtrs != nil {
+		mmSetVacancyRole.mock.t.Fatalf("VacancyStorageMock.SetVacancyRole mock is already set by ExpectParams functions")
+	}
+
+	mmSetVacancyRole.defaultExpectation.params = &VacancyStorageMockSetVacancyRoleParams{ctx, in}
+	mmSetVacancyRole.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmSetVacancyRole.expectations {
+		if minimock.Equal(e.params, mmSetVacancyRole.defaultExpectation.params) {
+			mmSetVacancyRole.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmSetVacancyRole.defaultExpectation.params)
+		}
+	}
+
+	return mmSetVacancyRole
+}
+
+// ExpectCtxParam1 sets up expected param ctx for VacancyStorage.SetVacancyRole
+func (mmSetVacancyRole *mVacancyStorageMockSetVacancyRole) ExpectCtxParam1(ctx context.Context) *mVacancyStorageMockSetVacancyRole {
+	if mmSetVacancyRole.mock.funcSetVacancyRole != nil {
+		mmSetVacancyRole.mock.t.Fatalf("VacancyStorageMock.SetVacancyRole mock is already set by Set")
+	}
+
+	if mmSetVacancyRole.defaultExpectation == nil {
+		mmSetVacancyRole.defaultExpectation = &VacancyStorageMockSetVacancyRoleExpectation{}
+	}
+
+	if mmSetVacancyRole.defaultExpectation.params != nil {
+		mmSetVacancyRole.mock.t.Fatalf("VacancyStorageMock.SetVacancyRole mock is already set by Expect")
+	}
+
+	if mmSetVacancyRole.defaultExpectation.paramPtrs == nil {
+		mmSetVacancyRole.defaultExpectation.paramPtrs = &VacancyStorageMockSetVacancyRoleParamPtrs{}
+	}
+	mmSetVacancyRole.defaultExpectation.paramPtrs.ctx = &ctx
+	mmSetVacancyRole.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmSetVacancyRole
+}
+
+// ExpectInParam2 sets up expected param in for VacancyStorage.SetVacancyRole
+func (mmSetVacancyRole *mVacancyStorageMockSetVacancyRole) ExpectInParam2(in domain.SetVacancyRoleInput) *mVacancyStorageMockSetVacancyRole {
+	if mmSetVacancyRole.mock.funcSetVacancyRole != nil {
+		mmSetVacancyRole.mock.t.Fatalf("VacancyStorageMock.SetVacancyRole mock is already set by Set")
+	}
+
+	if mmSetVacancyRole.defaultExpectation == nil {
+		mmSetVacancyRole.defaultExpectation = &VacancyStorageMockSetVacancyRoleExpectation{}
+	}
+
+	if mmSetVacancyRole.defaultExpectation.params != nil {
+		mmSetVacancyRole.mock.t.Fatalf("VacancyStorageMock.SetVacancyRole mock is already set by Expect")
+	}
+
+	if mmSetVacancyRole.defaultExpectation.paramPtrs == nil {
+		mmSetVacancyRole.defaultExpectation.paramPtrs = &VacancyStorageMockSetVacancyRoleParamPtrs{}
+	}
+	mmSetVacancyRole.defaultExpectation.paramPtrs.in = &in
+	mmSetVacancyRole.defaultExpectation.expectationOrigins.originIn = minimock.CallerInfo(1)
+
+	return mmSetVacancyRole
+}
+
+// Inspect accepts an inspector function that has same arguments as the VacancyStorage.SetVacancyRole
+func (mmSetVacancyRole *mVacancyStorageMockSetVacancyRole) Inspect(f func(ctx context.Context, in domain.SetVacancyRoleInput)) *mVacancyStorageMockSetVacancyRole {
+	if mmSetVacancyRole.mock.inspectFuncSetVacancyRole != nil {
+		mmSetVacancyRole.mock.t.Fatalf("Inspect function is already set for VacancyStorageMock.SetVacancyRole")
+	}
+
+	mmSetVacancyRole.mock.inspectFuncSetVacancyRole = f
+
+	return mmSetVacancyRole
+}
+
+// Return sets up results that will be returned by VacancyStorage.SetVacancyRole
+func (mmSetVacancyRole *mVacancyStorageMockSetVacancyRole) Return(b1 bool, err error) *VacancyStorageMock {
+	if mmSetVacancyRole.mock.funcSetVacancyRole != nil {
+		mmSetVacancyRole.mock.t.Fatalf("VacancyStorageMock.SetVacancyRole mock is already set by Set")
+	}
+
+	if mmSetVacancyRole.defaultExpectation == nil {
+		mmSetVacancyRole.defaultExpectation = &VacancyStorageMockSetVacancyRoleExpectation{mock: mmSetVacancyRole.mock}
+	}
+	mmSetVacancyRole.defaultExpectation.results = &VacancyStorageMockSetVacancyRoleResults{b1, err}
+	mmSetVacancyRole.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmSetVacancyRole.mock
+}
+
+// Set uses given function f to mock the VacancyStorage.SetVacancyRole method
+func (mmSetVacancyRole *mVacancyStorageMockSetVacancyRole) Set(f func(ctx context.Context, in domain.SetVacancyRoleInput) (b1 bool, err error)) *VacancyStorageMock {
+	if mmSetVacancyRole.defaultExpectation != nil {
+		mmSetVacancyRole.mock.t.Fatalf("Default expectation is already set for the VacancyStorage.SetVacancyRole method")
+	}
+
+	if len(mmSetVacancyRole.expectations) > 0 {
+		mmSetVacancyRole.mock.t.Fatalf("Some expectations are already set for the VacancyStorage.SetVacancyRole method")
+	}
+
+	mmSetVacancyRole.mock.funcSetVacancyRole = f
+	mmSetVacancyRole.mock.funcSetVacancyRoleOrigin = minimock.CallerInfo(1)
+	return mmSetVacancyRole.mock
+}
+
+// When sets expectation for the VacancyStorage.SetVacancyRole which will trigger the result defined by the following
+// Then helper
+func (mmSetVacancyRole *mVacancyStorageMockSetVacancyRole) When(ctx context.Context, in domain.SetVacancyRoleInput) *VacancyStorageMockSetVacancyRoleExpectation {
+	if mmSetVacancyRole.mock.funcSetVacancyRole != nil {
+		mmSetVacancyRole.mock.t.Fatalf("VacancyStorageMock.SetVacancyRole mock is already set by Set")
+	}
+
+	expectation := &VacancyStorageMockSetVacancyRoleExpectation{
+		mock:               mmSetVacancyRole.mock,
+		params:             &VacancyStorageMockSetVacancyRoleParams{ctx, in},
+		expectationOrigins: VacancyStorageMockSetVacancyRoleExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmSetVacancyRole.expectations = append(mmSetVacancyRole.expectations, expectation)
+	return expectation
+}
+
+// Then sets up VacancyStorage.SetVacancyRole return parameters for the expectation previously defined by the When method
+func (e *VacancyStorageMockSetVacancyRoleExpectation) Then(b1 bool, err error) *VacancyStorageMock {
+	e.results = &VacancyStorageMockSetVacancyRoleResults{b1, err}
+	return e.mock
+}
+
+// Times sets number of times VacancyStorage.SetVacancyRole should be invoked
+func (mmSetVacancyRole *mVacancyStorageMockSetVacancyRole) Times(n uint64) *mVacancyStorageMockSetVacancyRole {
+	if n == 0 {
+		mmSetVacancyRole.mock.t.Fatalf("Times of VacancyStorageMock.SetVacancyRole mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmSetVacancyRole.expectedInvocations, n)
+	mmSetVacancyRole.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmSetVacancyRole
+}
+
+func (mmSetVacancyRole *mVacancyStorageMockSetVacancyRole) invocationsDone() bool {
+	if len(mmSetVacancyRole.expectations) == 0 && mmSetVacancyRole.defaultExpectation == nil && mmSetVacancyRole.mock.funcSetVacancyRole == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmSetVacancyRole.mock.afterSetVacancyRoleCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmSetVacancyRole.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// SetVacancyRole implements mm_usecase.VacancyStorage
+func (mmSetVacancyRole *VacancyStorageMock) SetVacancyRole(ctx context.Context, in domain.SetVacancyRoleInput) (b1 bool, err error) {
+	mm_atomic.AddUint64(&mmSetVacancyRole.beforeSetVacancyRoleCounter, 1)
+	defer mm_atomic.AddUint64(&mmSetVacancyRole.afterSetVacancyRoleCounter, 1)
+
+	mmSetVacancyRole.t.Helper()
+
+	if mmSetVacancyRole.inspectFuncSetVacancyRole != nil {
+		mmSetVacancyRole.inspectFuncSetVacancyRole(ctx, in)
+	}
+
+	mm_params := VacancyStorageMockSetVacancyRoleParams{ctx, in}
+
+	// Record call args
+	mmSetVacancyRole.SetVacancyRoleMock.mutex.Lock()
+	mmSetVacancyRole.SetVacancyRoleMock.callArgs = append(mmSetVacancyRole.SetVacancyRoleMock.callArgs, &mm_params)
+	mmSetVacancyRole.SetVacancyRoleMock.mutex.Unlock()
+
+	for _, e := range mmSetVacancyRole.SetVacancyRoleMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.b1, e.results.err
+		}
+	}
+
+	if mmSetVacancyRole.SetVacancyRoleMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmSetVacancyRole.SetVacancyRoleMock.defaultExpectation.Counter, 1)
+		mm_want := mmSetVacancyRole.SetVacancyRoleMock.defaultExpectation.params
+		mm_want_ptrs := mmSetVacancyRole.SetVacancyRoleMock.defaultExpectation.paramPtrs
+
+		mm_got := VacancyStorageMockSetVacancyRoleParams{ctx, in}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmSetVacancyRole.t.Errorf("VacancyStorageMock.SetVacancyRole got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmSetVacancyRole.SetVacancyRoleMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.in != nil && !minimock.Equal(*mm_want_ptrs.in, mm_got.in) {
+				mmSetVacancyRole.t.Errorf("VacancyStorageMock.SetVacancyRole got unexpected parameter in, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmSetVacancyRole.SetVacancyRoleMock.defaultExpectation.expectationOrigins.originIn, *mm_want_ptrs.in, mm_got.in, minimock.Diff(*mm_want_ptrs.in, mm_got.in))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmSetVacancyRole.t.Errorf("VacancyStorageMock.SetVacancyRole got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmSetVacancyRole.SetVacancyRoleMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmSetVacancyRole.SetVacancyRoleMock.defaultExpectation.results
+		if mm_results == nil {
+			mmSetVacancyRole.t.Fatal("No results are set for the VacancyStorageMock.SetVacancyRole")
+		}
+		return (*mm_results).b1, (*mm_results).err
+	}
+	if mmSetVacancyRole.funcSetVacancyRole != nil {
+		return mmSetVacancyRole.funcSetVacancyRole(ctx, in)
+	}
+	mmSetVacancyRole.t.Fatalf("Unexpected call to VacancyStorageMock.SetVacancyRole. %v %v", ctx, in)
+	return
+}
+
+// SetVacancyRoleAfterCounter returns a count of finished VacancyStorageMock.SetVacancyRole invocations
+func (mmSetVacancyRole *VacancyStorageMock) SetVacancyRoleAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmSetVacancyRole.afterSetVacancyRoleCounter)
+}
+
+// SetVacancyRoleBeforeCounter returns a count of VacancyStorageMock.SetVacancyRole invocations
+func (mmSetVacancyRole *VacancyStorageMock) SetVacancyRoleBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmSetVacancyRole.beforeSetVacancyRoleCounter)
+}
+
+// Calls returns a list of arguments used in each call to VacancyStorageMock.SetVacancyRole.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmSetVacancyRole *mVacancyStorageMockSetVacancyRole) Calls() []*VacancyStorageMockSetVacancyRoleParams {
+	mmSetVacancyRole.mutex.RLock()
+
+	argCopy := make([]*VacancyStorageMockSetVacancyRoleParams, len(mmSetVacancyRole.callArgs))
+	copy(argCopy, mmSetVacancyRole.callArgs)
+
+	mmSetVacancyRole.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockSetVacancyRoleDone returns true if the count of the SetVacancyRole invocations corresponds
+// the number of defined expectations
+func (m *VacancyStorageMock) MinimockSetVacancyRoleDone() bool {
+	if m.SetVacancyRoleMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.SetVacancyRoleMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.SetVacancyRoleMock.invocationsDone()
+}
+
+// MinimockSetVacancyRoleInspect logs each unmet expectation
+func (m *VacancyStorageMock) MinimockSetVacancyRoleInspect() {
+	for _, e := range m.SetVacancyRoleMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to VacancyStorageMock.SetVacancyRole at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterSetVacancyRoleCounter := mm_atomic.LoadUint64(&m.afterSetVacancyRoleCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.SetVacancyRoleMock.defaultExpectation != nil && afterSetVacancyRoleCounter < 1 {
+		if m.SetVacancyRoleMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to VacancyStorageMock.SetVacancyRole at\n%s", m.SetVacancyRoleMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to VacancyStorageMock.SetVacancyRole at\n%s with params: %#v", m.SetVacancyRoleMock.defaultExpectation.expectationOrigins.origin, *m.SetVacancyRoleMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcSetVacancyRole != nil && afterSetVacancyRoleCounter < 1 {
+		m.t.Errorf("Expected call to VacancyStorageMock.SetVacancyRole at\n%s", m.funcSetVacancyRoleOrigin)
+	}
+
+	if !m.SetVacancyRoleMock.invocationsDone() && afterSetVacancyRoleCounter > 0 {
+		m.t.Errorf("Expected %d calls to VacancyStorageMock.SetVacancyRole at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.SetVacancyRoleMock.expectedInvocations), m.SetVacancyRoleMock.expectedInvocationsOrigin, afterSetVacancyRoleCounter)
+	}
+}
+
+type mVacancyStorageMockSetVacancyVisibility struct {
+	optional           bool
+	mock               *VacancyStorageMock
+	defaultExpectation *VacancyStorageMockSetVacancyVisibilityExpectation
+	expectations       []*VacancyStorageMockSetVacancyVisibilityExpectation
+
+	callArgs []*VacancyStorageMockSetVacancyVisibilityParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// VacancyStorageMockSetVacancyVisibilityExpectation specifies expectation struct of the VacancyStorage.SetVacancyVisibility
+type VacancyStorageMockSetVacancyVisibilityExpectation struct {
+	mock               *VacancyStorageMock
+	params             *VacancyStorageMockSetVacancyVisibilityParams
+	paramPtrs          *VacancyStorageMockSetVacancyVisibilityParamPtrs
+	expectationOrigins VacancyStorageMockSetVacancyVisibilityExpectationOrigins
+	results            *VacancyStorageMockSetVacancyVisibilityResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// VacancyStorageMockSetVacancyVisibilityParams contains parameters of the VacancyStorage.SetVacancyVisibility
+type VacancyStorageMockSetVacancyVisibilityParams struct {
+	ctx context.Context
+	in  domain.SetVacancyVisibilityInput
+}
+
+// VacancyStorageMockSetVacancyVisibilityParamPtrs contains pointers to parameters of the VacancyStorage.SetVacancyVisibility
+type VacancyStorageMockSetVacancyVisibilityParamPtrs struct {
+	ctx *context.Context
+	in  *domain.SetVacancyVisibilityInput
+}
+
+// VacancyStorageMockSetVacancyVisibilityResults contains results of the VacancyStorage.SetVacancyVisibility
+type VacancyStorageMockSetVacancyVisibilityResults struct {
+	vp1 *domain.Vacancy
+	err error
+}
+
+// VacancyStorageMockSetVacancyVisibilityOrigins contains origins of expectations of the VacancyStorage.SetVacancyVisibility
+type VacancyStorageMockSetVacancyVisibilityExpectationOrigins struct {
+	origin    string
+	originCtx string
+	originIn  string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmSetVacancyVisibility *mVacancyStorageMockSetVacancyVisibility) Optional() *mVacancyStorageMockSetVacancyVisibility {
+	mmSetVacancyVisibility.optional = true
+	return mmSetVacancyVisibility
+}
+
+// Expect sets up expected params for VacancyStorage.SetVacancyVisibility
+func (mmSetVacancyVisibility *mVacancyStorageMockSetVacancyVisibility) Expect(ctx context.Context, in domain.SetVacancyVisibilityInput) *mVacancyStorageMockSetVacancyVisibility {
+	if mmSetVacancyVisibility.mock.funcSetVacancyVisibility != nil {
+		mmSetVacancyVisibility.mock.t.Fatalf("VacancyStorageMock.SetVacancyVisibility mock is already set by Set")
+	}
+
+	if mmSetVacancyVisibility.defaultExpectation == nil {
+		mmSetVacancyVisibility.defaultExpectation = &VacancyStorageMockSetVacancyVisibilityExpectation{}
+	}
+
+	if mmSetVacancyVisibility.defaultExpectation.paramPtrs != nil {
+		mmSetVacancyVisibility.mock.t.Fatalf("VacancyStorageMock.SetVacancyVisibility mock is already set by ExpectParams functions")
+	}
+
+	mmSetVacancyVisibility.defaultExpectation.params = &VacancyStorageMockSetVacancyVisibilityParams{ctx, in}
+	mmSetVacancyVisibility.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmSetVacancyVisibility.expectations {
+		if minimock.Equal(e.params, mmSetVacancyVisibility.defaultExpectation.params) {
+			mmSetVacancyVisibility.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmSetVacancyVisibility.defaultExpectation.params)
+		}
+	}
+
+	return mmSetVacancyVisibility
+}
+
+// ExpectCtxParam1 sets up expected param ctx for VacancyStorage.SetVacancyVisibility
+func (mmSetVacancyVisibility *mVacancyStorageMockSetVacancyVisibility) ExpectCtxParam1(ctx context.Context) *mVacancyStorageMockSetVacancyVisibility {
+	if mmSetVacancyVisibility.mock.funcSetVacancyVisibility != nil {
+		mmSetVacancyVisibility.mock.t.Fatalf("VacancyStorageMock.SetVacancyVisibility mock is already set by Set")
+	}
+
+	if mmSetVacancyVisibility.defaultExpectation == nil {
+		mmSetVacancyVisibility.defaultExpectation = &VacancyStorageMockSetVacancyVisibilityExpectation{}
+	}
+
+	if mmSetVacancyVisibility.defaultExpectation.params != nil {
+		mmSetVacancyVisibility.mock.t.Fatalf("VacancyStorageMock.SetVacancyVisibility mock is already set by Expect")
+	}
+
+	if mmSetVacancyVisibility.defaultExpectation.paramPtrs == nil {
+		mmSetVacancyVisibility.defaultExpectation.paramPtrs = &VacancyStorageMockSetVacancyVisibilityParamPtrs{}
+	}
+	mmSetVacancyVisibility.defaultExpectation.paramPtrs.ctx = &ctx
+	mmSetVacancyVisibility.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmSetVacancyVisibility
+}
+
+// ExpectInParam2 sets up expected param in for VacancyStorage.SetVacancyVisibility
+func (mmSetVacancyVisibility *mVacancyStorageMockSetVacancyVisibility) ExpectInParam2(in domain.SetVacancyVisibilityInput) *mVacancyStorageMockSetVacancyVisibility {
+	if mmSetVacancyVisibility.mock.funcSetVacancyVisibility != nil {
+		mmSetVacancyVisibility.mock.t.Fatalf("VacancyStorageMock.SetVacancyVisibility mock is already set by Set")
+	}
+
+	if mmSetVacancyVisibility.defaultExpectation == nil {
+		mmSetVacancyVisibility.defaultExpectation = &VacancyStorageMockSetVacancyVisibilityExpectation{}
+	}
+
+	if mmSetVacancyVisibility.defaultExpectation.params != nil {
+		mmSetVacancyVisibility.mock.t.Fatalf("VacancyStorageMock.SetVacancyVisibility mock is already set by Expect")
+	}
+
+	if mmSetVacancyVisibility.defaultExpectation.paramPtrs == nil {
+		mmSetVacancyVisibility.defaultExpectation.paramPtrs = &VacancyStorageMockSetVacancyVisibilityParamPtrs{}
+	}
+	mmSetVacancyVisibility.defaultExpectation.paramPtrs.in = &in
+	mmSetVacancyVisibility.defaultExpectation.expectationOrigins.originIn = minimock.CallerInfo(1)
+
+	return mmSetVacancyVisibility
+}
+
+// Inspect accepts an inspector function that has same arguments as the VacancyStorage.SetVacancyVisibility
+func (mmSetVacancyVisibility *mVacancyStorageMockSetVacancyVisibility) Inspect(f func(ctx context.Context, in domain.SetVacancyVisibilityInput)) *mVacancyStorageMockSetVacancyVisibility {
+	if mmSetVacancyVisibility.mock.inspectFuncSetVacancyVisibility != nil {
+		mmSetVacancyVisibility.mock.t.Fatalf("Inspect function is already set for VacancyStorageMock.SetVacancyVisibility")
+	}
+
+	mmSetVacancyVisibility.mock.inspectFuncSetVacancyVisibility = f
+
+	return mmSetVacancyVisibility
+}
+
+// Return sets up results that will be returned by VacancyStorage.SetVacancyVisibility
+func (mmSetVacancyVisibility *mVacancyStorageMockSetVacancyVisibility) Return(vp1 *domain.Vacancy, err error) *VacancyStorageMock {
+	if mmSetVacancyVisibility.mock.funcSetVacancyVisibility != nil {
+		mmSetVacancyVisibility.mock.t.Fatalf("VacancyStorageMock.SetVacancyVisibility mock is already set by Set")
+	}
+
+	if mmSetVacancyVisibility.defaultExpectation == nil {
+		mmSetVacancyVisibility.defaultExpectation = &VacancyStorageMockSetVacancyVisibilityExpectation{mock: mmSetVacancyVisibility.mock}
+	}
+	mmSetVacancyVisibility.defaultExpectation.results = &VacancyStorageMockSetVacancyVisibilityResults{vp1, err}
+	mmSetVacancyVisibility.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmSetVacancyVisibility.mock
+}
+
+// Set uses given function f to mock the VacancyStorage.SetVacancyVisibility method
+func (mmSetVacancyVisibility *mVacancyStorageMockSetVacancyVisibility) Set(f func(ctx context.Context, in domain.SetVacancyVisibilityInput) (vp1 *domain.Vacancy, err error)) *VacancyStorageMock {
+	if mmSetVacancyVisibility.defaultExpectation != nil {
+		mmSetVacancyVisibility.mock.t.Fatalf("Default expectation is already set for the VacancyStorage.SetVacancyVisibility method")
+	}
+
+	if len(mmSetVacancyVisibility.expectations) > 0 {
+		mmSetVacancyVisibility.mock.t.Fatalf("Some expectations are already set for the VacancyStorage.SetVacancyVisibility method")
+	}
+
+	mmSetVacancyVisibility.mock.funcSetVacancyVisibility = f
+	mmSetVacancyVisibility.mock.funcSetVacancyVisibilityOrigin = minimock.CallerInfo(1)
+	return mmSetVacancyVisibility.mock
+}
+
+// When sets expectation for the VacancyStorage.SetVacancyVisibility which will trigger the result defined by the following
+// Then helper
+func (mmSetVacancyVisibility *mVacancyStorageMockSetVacancyVisibility) When(ctx context.Context, in domain.SetVacancyVisibilityInput) *VacancyStorageMockSetVacancyVisibilityExpectation {
+	if mmSetVacancyVisibility.mock.funcSetVacancyVisibility != nil {
+		mmSetVacancyVisibility.mock.t.Fatalf("VacancyStorageMock.SetVacancyVisibility mock is already set by Set")
+	}
+
+	expectation := &VacancyStorageMockSetVacancyVisibilityExpectation{
+		mock:               mmSetVacancyVisibility.mock,
+		params:             &VacancyStorageMockSetVacancyVisibilityParams{ctx, in},
+		expectationOrigins: VacancyStorageMockSetVacancyVisibilityExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmSetVacancyVisibility.expectations = append(mmSetVacancyVisibility.expectations, expectation)
+	return expectation
+}
+
+// Then sets up VacancyStorage.SetVacancyVisibility return parameters for the expectation previously defined by the When method
+func (e *VacancyStorageMockSetVacancyVisibilityExpectation) Then(vp1 *domain.Vacancy, err error) *VacancyStorageMock {
+	e.results = &VacancyStorageMockSetVacancyVisibilityResults{vp1, err}
+	return e.mock
+}
+
+// Times sets number of times VacancyStorage.SetVacancyVisibility should be invoked
+func (mmSetVacancyVisibility *mVacancyStorageMockSetVacancyVisibility) Times(n uint64) *mVacancyStorageMockSetVacancyVisibility {
+	if n == 0 {
+		mmSetVacancyVisibility.mock.t.Fatalf("Times of VacancyStorageMock.SetVacancyVisibility mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmSetVacancyVisibility.expectedInvocations, n)
+	mmSetVacancyVisibility.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmSetVacancyVisibility
+}
+
+func (mmSetVacancyVisibility *mVacancyStorageMockSetVacancyVisibility) invocationsDone() bool {
+	if len(mmSetVacancyVisibility.expectations) == 0 && mmSetVacancyVisibility.defaultExpectation == nil && mmSetVacancyVisibility.mock.funcSetVacancyVisibility == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmSetVacancyVisibility.mock.afterSetVacancyVisibilityCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmSetVacancyVisibility.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// SetVacancyVisibility implements mm_usecase.VacancyStorage
+func (mmSetVacancyVisibility *VacancyStorageMock) SetVacancyVisibility(ctx context.Context, in domain.SetVacancyVisibilityInput) (vp1 *domain.Vacancy, err error) {
+	mm_atomic.AddUint64(&mmSetVacancyVisibility.beforeSetVacancyVisibilityCounter, 1)
+	defer mm_atomic.AddUint64(&mmSetVacancyVisibility.afterSetVacancyVisibilityCounter, 1)
+
+	mmSetVacancyVisibility.t.Helper()
+
+	if mmSetVacancyVisibility.inspectFuncSetVacancyVisibility != nil {
+		mmSetVacancyVisibility.inspectFuncSetVacancyVisibility(ctx, in)
+	}
+
+	mm_params := VacancyStorageMockSetVacancyVisibilityParams{ctx, in}
+
+	// Record call args
+	mmSetVacancyVisibility.SetVacancyVisibilityMock.mutex.Lock()
+	mmSetVacancyVisibility.SetVacancyVisibilityMock.callArgs = append(mmSetVacancyVisibility.SetVacancyVisibilityMock.callArgs, &mm_params)
+	mmSetVacancyVisibility.SetVacancyVisibilityMock.mutex.Unlock()
+
+	for _, e := range mmSetVacancyVisibility.SetVacancyVisibilityMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.vp1, e.results.err
+		}
+	}
+
+	if mmSetVacancyVisibility.SetVacancyVisibilityMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmSetVacancyVisibility.SetVacancyVisibilityMock.defaultExpectation.Counter, 1)
+		mm_want := mmSetVacancyVisibility.SetVacancyVisibilityMock.defaultExpectation.params
+		mm_want_ptrs := mmSetVacancyVisibility.SetVacancyVisibilityMock.defaultExpectation.paramPtrs
+
+		mm_got := VacancyStorageMockSetVacancyVisibilityParams{ctx, in}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmSetVacancyVisibility.t.Errorf("VacancyStorageMock.SetVacancyVisibility got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmSetVacancyVisibility.SetVacancyVisibilityMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.in != nil && !minimock.Equal(*mm_want_ptrs.in, mm_got.in) {
+				mmSetVacancyVisibility.t.Errorf("VacancyStorageMock.SetVacancyVisibility got unexpected parameter in, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmSetVacancyVisibility.SetVacancyVisibilityMock.defaultExpectation.expectationOrigins.originIn, *mm_want_ptrs.in, mm_got.in, minimock.Diff(*mm_want_ptrs.in, mm_got.in))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmSetVacancyVisibility.t.Errorf("VacancyStorageMock.SetVacancyVisibility got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmSetVacancyVisibility.SetVacancyVisibilityMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmSetVacancyVisibility.SetVacancyVisibilityMock.defaultExpectation.results
+		if mm_results == nil {
+			mmSetVacancyVisibility.t.Fatal("No results are set for the VacancyStorageMock.SetVacancyVisibility")
+		}
+		return (*mm_results).vp1, (*mm_results).err
+	}
+	if mmSetVacancyVisibility.funcSetVacancyVisibility != nil {
+		return mmSetVacancyVisibility.funcSetVacancyVisibility(ctx, in)
+	}
+	mmSetVacancyVisibility.t.Fatalf("Unexpected call to VacancyStorageMock.SetVacancyVisibility. %v %v", ctx, in)
+	return
+}
+
+// SetVacancyVisibilityAfterCounter returns a count of finished VacancyStorageMock.SetVacancyVisibility invocations
+func (mmSetVacancyVisibility *VacancyStorageMock) SetVacancyVisibilityAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmSetVacancyVisibility.afterSetVacancyVisibilityCounter)
+}
+
+// SetVacancyVisibilityBeforeCounter returns a count of VacancyStorageMock.SetVacancyVisibility invocations
+func (mmSetVacancyVisibility *VacancyStorageMock) SetVacancyVisibilityBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmSetVacancyVisibility.beforeSetVacancyVisibilityCounter)
+}
+
+// Calls returns a list of arguments used in each call to VacancyStorageMock.SetVacancyVisibility.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmSetVacancyVisibility *mVacancyStorageMockSetVacancyVisibility) Calls() []*VacancyStorageMockSetVacancyVisibilityParams {
+	mmSetVacancyVisibility.mutex.RLock()
+
+	argCopy := make([]*VacancyStorageMockSetVacancyVisibilityParams, len(mmSetVacancyVisibility.callArgs))
+	copy(argCopy, mmSetVacancyVisibility.callArgs)
+
+	mmSetVacancyVisibility.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockSetVacancyVisibilityDone returns true if the count of the SetVacancyVisibility invocations corresponds
+// the number of defined expectations
+func (m *VacancyStorageMock) MinimockSetVacancyVisibilityDone() bool {
+	if m.SetVacancyVisibilityMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.SetVacancyVisibilityMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.SetVacancyVisibilityMock.invocationsDone()
+}
+
+// MinimockSetVacancyVisibilityInspect logs each unmet expectation
+func (m *VacancyStorageMock) MinimockSetVacancyVisibilityInspect() {
+	for _, e := range m.SetVacancyVisibilityMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to VacancyStorageMock.SetVacancyVisibility at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterSetVacancyVisibilityCounter := mm_atomic.LoadUint64(&m.afterSetVacancyVisibilityCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.SetVacancyVisibilityMock.defaultExpectation != nil && afterSetVacancyVisibilityCounter < 1 {
+		if m.SetVacancyVisibilityMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to VacancyStorageMock.SetVacancyVisibility at\n%s", m.SetVacancyVisibilityMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to VacancyStorageMock.SetVacancyVisibility at\n%s with params: %#v", m.SetVacancyVisibilityMock.defaultExpectation.expectationOrigins.origin, *m.SetVacancyVisibilityMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcSetVacancyVisibility != nil && afterSetVacancyVisibilityCounter < 1 {
+		m.t.Errorf("Expected call to VacancyStorageMock.SetVacancyVisibility at\n%s", m.funcSetVacancyVisibilityOrigin)
+	}
+
+	if !m.SetVacancyVisibilityMock.invocationsDone() && afterSetVacancyVisibilityCounter > 0 {
+		m.t.Errorf("Expected %d calls to VacancyStorageMock.SetVacancyVisibility at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.SetVacancyVisibilityMock.expectedInvocations), m.SetVacancyVisibilityMock.expectedInvocationsOrigin, afterSetVacancyVisibilityCounter)
+	}
+}
+
+type mVacancyStorageMockStatusBeforeArchive struct {
+	optional           bool
+	mock               *VacancyStorageMock
+	defaultExpectation *VacancyStorageMockStatusBeforeArchiveExpectation
+	expectations       []*VacancyStorageMockStatusBeforeArchiveExpectation
+
+	callArgs []*VacancyStorageMockStatusBeforeArchiveParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// VacancyStorageMockStatusBeforeArchiveExpectation specifies expectation struct of the VacancyStorage.StatusBeforeArchive
+type VacancyStorageMockStatusBeforeArchiveExpectation struct {
+	mock               *VacancyStorageMock
+	params             *VacancyStorageMockStatusBeforeArchiveParams
+	paramPtrs          *VacancyStorageMockStatusBeforeArchiveParamPtrs
+	expectationOrigins VacancyStorageMockStatusBeforeArchiveExpectationOrigins
+	results            *VacancyStorageMockStatusBeforeArchiveResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// VacancyStorageMockStatusBeforeArchiveParams contains parameters of the VacancyStorage.StatusBeforeArchive
+type VacancyStorageMockStatusBeforeArchiveParams struct {
+	ctx       context.Context
+	vacancyID string
+}
+
+// VacancyStorageMockStatusBeforeArchiveParamPtrs contains pointers to parameters of the VacancyStorage.StatusBeforeArchive
+type VacancyStorageMockStatusBeforeArchiveParamPtrs struct {
+	ctx       *context.Context
+	vacancyID *string
+}
+
+// VacancyStorageMockStatusBeforeArchiveResults contains results of the VacancyStorage.StatusBeforeArchive
+type VacancyStorageMockStatusBeforeArchiveResults struct {
+	s1  string
+	err error
+}
+
+// VacancyStorageMockStatusBeforeArchiveOrigins contains origins of expectations of the VacancyStorage.StatusBeforeArchive
+type VacancyStorageMockStatusBeforeArchiveExpectationOrigins struct {
+	origin          string
+	originCtx       string
+	originVacancyID string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmStatusBeforeArchive *mVacancyStorageMockStatusBeforeArchive) Optional() *mVacancyStorageMockStatusBeforeArchive {
+	mmStatusBeforeArchive.optional = true
+	return mmStatusBeforeArchive
+}
+
+// Expect sets up expected params for VacancyStorage.StatusBeforeArchive
+func (mmStatusBeforeArchive *mVacancyStorageMockStatusBeforeArchive) Expect(ctx context.Context, vacancyID string) *mVacancyStorageMockStatusBeforeArchive {
+	if mmStatusBeforeArchive.mock.funcStatusBeforeArchive != nil {
+		mmStatusBeforeArchive.mock.t.Fatalf("VacancyStorageMock.StatusBeforeArchive mock is already set by Set")
+	}
+
+	if mmStatusBeforeArchive.defaultExpectation == nil {
+		mmStatusBeforeArchive.defaultExpectation = &VacancyStorageMockStatusBeforeArchiveExpectation{}
+	}
+
+	if mmStatusBeforeArchive.defaultExpectation.paramPtrs != nil {
+		mmStatusBeforeArchive.mock.t.Fatalf("VacancyStorageMock.StatusBeforeArchive mock is already set by ExpectParams functions")
+	}
+
+	mmStatusBeforeArchive.defaultExpectation.params = &VacancyStorageMockStatusBeforeArchiveParams{ctx, vacancyID}
+	mmStatusBeforeArchive.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmStatusBeforeArchive.expectations {
+		if minimock.Equal(e.params, mmStatusBeforeArchive.defaultExpectation.params) {
+			mmStatusBeforeArchive.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmStatusBeforeArchive.defaultExpectation.params)
+		}
+	}
+
+	return mmStatusBeforeArchive
+}
+
+// ExpectCtxParam1 sets up expected param ctx for VacancyStorage.StatusBeforeArchive
+func (mmStatusBeforeArchive *mVacancyStorageMockStatusBeforeArchive) ExpectCtxParam1(ctx context.Context) *mVacancyStorageMockStatusBeforeArchive {
+	if mmStatusBeforeArchive.mock.funcStatusBeforeArchive != nil {
+		mmStatusBeforeArchive.mock.t.Fatalf("VacancyStorageMock.StatusBeforeArchive mock is already set by Set")
+	}
+
+	if mmStatusBeforeArchive.defaultExpectation == nil {
+		mmStatusBeforeArchive.defaultExpectation = &VacancyStorageMockStatusBeforeArchiveExpectation{}
+	}
+
+	if mmStatusBeforeArchive.defaultExpectation.params != nil {
+		mmStatusBeforeArchive.mock.t.Fatalf("VacancyStorageMock.StatusBeforeArchive mock is already set by Expect")
+	}
+
+	if mmStatusBeforeArchive.defaultExpectation.paramPtrs == nil {
+		mmStatusBeforeArchive.defaultExpectation.paramPtrs = &VacancyStorageMockStatusBeforeArchiveParamPtrs{}
+	}
+	mmStatusBeforeArchive.defaultExpectation.paramPtrs.ctx = &ctx
+	mmStatusBeforeArchive.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmStatusBeforeArchive
+}
+
+// ExpectVacancyIDParam2 sets up expected param vacancyID for VacancyStorage.StatusBeforeArchive
+func (mmStatusBeforeArchive *mVacancyStorageMockStatusBeforeArchive) ExpectVacancyIDParam2(vacancyID string) *mVacancyStorageMockStatusBeforeArchive {
+	if mmStatusBeforeArchive.mock.funcStatusBeforeArchive != nil {
+		mmStatusBeforeArchive.mock.t.Fatalf("VacancyStorageMock.StatusBeforeArchive mock is already set by Set")
+	}
+
+	if mmStatusBeforeArchive.defaultExpectation == nil {
+		mmStatusBeforeArchive.defaultExpectation = &VacancyStorageMockStatusBeforeArchiveExpectation{}
+	}
+
+	if mmStatusBeforeArchive.defaultExpectation.params != nil {
+		mmStatusBeforeArchive.mock.t.Fatalf("VacancyStorageMock.StatusBeforeArchive mock is already set by Expect")
+	}
+
+	if mmStatusBeforeArchive.defaultExpectation.paramPtrs == nil {
+		mmStatusBeforeArchive.defaultExpectation.paramPtrs = &VacancyStorageMockStatusBeforeArchiveParamPtrs{}
+	}
+	mmStatusBeforeArchive.defaultExpectation.paramPtrs.vacancyID = &vacancyID
+	mmStatusBeforeArchive.defaultExpectation.expectationOrigins.originVacancyID = minimock.CallerInfo(1)
+
+	return mmStatusBeforeArchive
+}
+
+// Inspect accepts an inspector function that has same arguments as the VacancyStorage.StatusBeforeArchive
+func (mmStatusBeforeArchive *mVacancyStorageMockStatusBeforeArchive) Inspect(f func(ctx context.Context, vacancyID string)) *mVacancyStorageMockStatusBeforeArchive {
+	if mmStatusBeforeArchive.mock.inspectFuncStatusBeforeArchive != nil {
+		mmStatusBeforeArchive.mock.t.Fatalf("Inspect function is already set for VacancyStorageMock.StatusBeforeArchive")
+	}
+
+	mmStatusBeforeArchive.mock.inspectFuncStatusBeforeArchive = f
+
+	return mmStatusBeforeArchive
+}
+
+// Return sets up results that will be returned by VacancyStorage.StatusBeforeArchive
+func (mmStatusBeforeArchive *mVacancyStorageMockStatusBeforeArchive) Return(s1 string, err error) *VacancyStorageMock {
+	if mmStatusBeforeArchive.mock.funcStatusBeforeArchive != nil {
+		mmStatusBeforeArchive.mock.t.Fatalf("VacancyStorageMock.StatusBeforeArchive mock is already set by Set")
+	}
+
+	if mmStatusBeforeArchive.defaultExpectation == nil {
+		mmStatusBeforeArchive.defaultExpectation = &VacancyStorageMockStatusBeforeArchiveExpectation{mock: mmStatusBeforeArchive.mock}
+	}
+	mmStatusBeforeArchive.defaultExpectation.results = &VacancyStorageMockStatusBeforeArchiveResults{s1, err}
+	mmStatusBeforeArchive.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmStatusBeforeArchive.mock
+}
 
-// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
-// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
-// Optional() makes method check to work in '0 or more' mode.
-// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
-// catch the problems when the expected method call is totally skipped during test run.
-func (mmGetVacancy *mVacancyStorageMockGetVacancy) Optional() *mVacancyStorageMockGetVacancy {
-	mmGetVacancy.optional = true
-	return mmGetVacancy
+// Set uses given function f to mock the VacancyStorage.StatusBeforeArchive method
+func (mmStatusBeforeArchive *mVacancyStorageMockStatusBeforeArchive) Set(f func(ctx context.Context, vacancyID string) (s1 string, err error)) *VacancyStorageMock {
+	if mmStatusBeforeArchive.defaultExpectation != nil {
+		mmStatusBeforeArchive.mock.t.Fatalf("Default expectation is already set for the VacancyStorage.StatusBeforeArchive method")
+	}
+
+	if len(mmStatusBeforeArchive.expectations) > 0 {
+		mmStatusBeforeArchive.mock.t.Fatalf("Some expectations are already set for the VacancyStorage.StatusBeforeArchive method")
+	}
+
+	mmStatusBeforeArchive.mock.funcStatusBeforeArchive = f
+	mmStatusBeforeArchive.mock.funcStatusBeforeArchiveOrigin = minimock.CallerInfo(1)
+	return mmStatusBeforeArchive.mock
 }
 
-// Expect sets up expected params for VacancyStorage.GetVacancy
-func (mmGetVacancy *mVacancyStorageMockGetVacancy) Expect(ctx context.Context, vacancyID string, ownerUserID uint64, isAdmin bool) *mVacancyStorageMockGetVacancy {
-	if mmGetVacancy.mock.funcGetVacancy != nil {
-		mmGetVacancy.mock.t.Fatalf("VacancyStorageMock.GetVacancy mock is already set by Set")
+// When sets expectation for the VacancyStorage.StatusBeforeArchive which will trigger the result defined by the following
+// Then helper
+func (mmStatusBeforeArchive *mVacancyStorageMockStatusBeforeArchive) When(ctx context.Context, vacancyID string) *VacancyStorageMockStatusBeforeArchiveExpectation {
+	if mmStatusBeforeArchive.mock.funcStatusBeforeArchive != nil {
+		mmStatusBeforeArchive.mock.t.Fatalf("VacancyStorageMock.StatusBeforeArchive mock is already set by Set")
 	}
 
-	if mmGetVacancy.defaultExpectation == nil {
-		mmGetVacancy.defaultExpectation = &VacancyStorageMockGetVacancyExpectation{}
+	expectation := &VacancyStorageMockStatusBeforeArchiveExpectation{
+		mock:               mmStatusBeforeArchive.mock,
+		params:             &VacancyStorageMockStatusBeforeArchiveParams{ctx, vacancyID},
+		expectationOrigins: VacancyStorageMockStatusBeforeArchiveExpectationOrigins{origin: minimock.CallerInfo(1)},
 	}
+	mmStatusBeforeArchive.expectations = append(mmStatusBeforeArchive.expectations, expectation)
+	return expectation
+}
 
-	if mmGetVacancy.defaultExpectation.paramPtrs != nil {
-		mmGetVacancy.mock.t.Fatalf("VacancyStorageMock.GetVacancy mock is already set by ExpectParams functions")
+// Then sets up VacancyStorage.StatusBeforeArchive return parameters for the expectation previously defined by the When method
+func (e *VacancyStorageMockStatusBeforeArchiveExpectation) Then(s1 string, err error) *VacancyStorageMock {
+	e.results = &VacancyStorageMockStatusBeforeArchiveResults{s1, err}
+	return e.mock
+}
+
+// Times sets number of times VacancyStorage.StatusBeforeArchive should be invoked
+func (mmStatusBeforeArchive *mVacancyStorageMockStatusBeforeArchive) Times(n uint64) *mVacancyStorageMockStatusBeforeArchive {
+	if n == 0 {
+		mmStatusBeforeArchive.mock.t.Fatalf("Times of VacancyStorageMock.StatusBeforeArchive mock can not be zero")
 	}
+	mm_atomic.StoreUint64(&mmStatusBeforeArchive.expectedInvocations, n)
+	mmStatusBeforeArchive.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmStatusBeforeArchive
+}
 
-	mmGetVacancy.defaultExpectation.params = &VacancyStorageMockGetVacancyParams{ctx, vacancyID, ownerUserID, isAdmin}
-	mmGetVacancy.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
-	for _, e := range mmGetVacancy.expectations {
-		if minimock.Equal(e.params, mmGetVacancy.defaultExpectation.params) {
-			mmGetVacancy.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmGetVacancy.defaultExpectation.params)
+func (mmStatusBeforeArchive *mVacancyStorageMockStatusBeforeArchive) invocationsDone() bool {
+	if len(mmStatusBeforeArchive.expectations) == 0 && mmStatusBeforeArchive.defaultExpectation == nil && mmStatusBeforeArchive.mock.funcStatusBeforeArchive == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmStatusBeforeArchive.mock.afterStatusBeforeArchiveCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmStatusBeforeArchive.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// StatusBeforeArchive implements mm_usecase.VacancyStorage
+func (mmStatusBeforeArchive *VacancyStorageMock) StatusBeforeArchive(ctx context.Context, vacancyID string) (s1 string, err error) {
+	mm_atomic.AddUint64(&mmStatusBeforeArchive.beforeStatusBeforeArchiveCounter, 1)
+	defer mm_atomic.AddUint64(&mmStatusBeforeArchive.afterStatusBeforeArchiveCounter, 1)
+
+	mmStatusBeforeArchive.t.Helper()
+
+	if mmStatusBeforeArchive.inspectFuncStatusBeforeArchive != nil {
+		mmStatusBeforeArchive.inspectFuncStatusBeforeArchive(ctx, vacancyID)
+	}
+
+	mm_params := VacancyStorageMockStatusBeforeArchiveParams{ctx, vacancyID}
+
+	// Record call args
+	mmStatusBeforeArchive.StatusBeforeArchiveMock.mutex.Lock()
+	mmStatusBeforeArchive.StatusBeforeArchiveMock.callArgs = append(mmStatusBeforeArchive.StatusBeforeArchiveMock.callArgs, &mm_params)
+	mmStatusBeforeArchive.StatusBeforeArchiveMock.mutex.Unlock()
+
+	for _, e := range mmStatusBeforeArchive.StatusBeforeArchiveMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.s1, e.results.err
 		}
 	}
 
-	return mmGetVacancy
+	if mmStatusBeforeArchive.StatusBeforeArchiveMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmStatusBeforeArchive.StatusBeforeArchiveMock.defaultExpectation.Counter, 1)
+		mm_want := mmStatusBeforeArchive.StatusBeforeArchiveMock.defaultExpectation.params
+		mm_want_ptrs := mmStatusBeforeArchive.StatusBeforeArchiveMock.defaultExpectation.paramPtrs
+
+		mm_got := VacancyStorageMockStatusBeforeArchiveParams{ctx, vacancyID}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmStatusBeforeArchive.t.Errorf("VacancyStorageMock.StatusBeforeArchive got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmStatusBeforeArchive.StatusBeforeArchiveMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.vacancyID != nil && !minimock.Equal(*mm_want_ptrs.vacancyID, mm_got.vacancyID) {
+				mmStatusBeforeArchive.t.Errorf("VacancyStorageMock.StatusBeforeArchive got unexpected parameter vacancyID, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmStatusBeforeArchive.StatusBeforeArchiveMock.defaultExpectation.expectationOrigins.originVacancyID, *mm_want_ptrs.vacancyID, mm_got.vacancyID, minimock.Diff(*mm_want_ptrs.vacancyID, mm_got.vacancyID))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmStatusBeforeArchive.t.Errorf("VacancyStorageMock.StatusBeforeArchive got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmStatusBeforeArchive.StatusBeforeArchiveMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmStatusBeforeArchive.StatusBeforeArchiveMock.defaultExpectation.results
+		if mm_results == nil {
+			mmStatusBeforeArchive.t.Fatal("No results are set for the VacancyStorageMock.StatusBeforeArchive")
+		}
+		return (*mm_results).s1, (*mm_results).err
+	}
+	if mmStatusBeforeArchive.funcStatusBeforeArchive != nil {
+		return mmStatusBeforeArchive.funcStatusBeforeArchive(ctx, vacancyID)
+	}
+	mmStatusBeforeArchive.t.Fatalf("Unexpected call to VacancyStorageMock.StatusBeforeArchive. %v %v", ctx, vacancyID)
+	return
 }
 
-// ExpectCtxParam1 sets up expected param ctx for VacancyStorage.GetVacancy
-func (mmGetVacancy *mVacancyStorageMockGetVacancy) ExpectCtxParam1(ctx context.Context) *mVacancyStorageMockGetVacancy {
-	if mmGetVacancy.mock.funcGetVacancy != nil {
-		mmGetVacancy.mock.t.Fatalf("VacancyStorageMock.GetVacancy mock is already set by Set")
+// StatusBeforeArchiveAfterCounter returns a count of finished VacancyStorageMock.StatusBeforeArchive invocations
+func (mmStatusBeforeArchive *VacancyStorageMock) StatusBeforeArchiveAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmStatusBeforeArchive.afterStatusBeforeArchiveCounter)
+}
+
+// StatusBeforeArchiveBeforeCounter returns a count of VacancyStorageMock.StatusBeforeArchive invocations
+func (mmStatusBeforeArchive *VacancyStorageMock) StatusBeforeArchiveBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmStatusBeforeArchive.beforeStatusBeforeArchiveCounter)
+}
+
+// Calls returns a list of arguments used in each call to VacancyStorageMock.StatusBeforeArchive.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmStatusBeforeArchive *mVacancyStorageMockStatusBeforeArchive) Calls() []*VacancyStorageMockStatusBeforeArchiveParams {
+	mmStatusBeforeArchive.mutex.RLock()
+
+	argCopy := make([]*VacancyStorageMockStatusBeforeArchiveParams, len(mmStatusBeforeArchive.callArgs))
+	copy(argCopy, mmStatusBeforeArchive.callArgs)
+
+	mmStatusBeforeArchive.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockStatusBeforeArchiveDone returns true if the count of the StatusBeforeArchive invocations corresponds
+// the number of defined expectations
+func (m *VacancyStorageMock) MinimockStatusBeforeArchiveDone() bool {
+	if m.StatusBeforeArchiveMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
 	}
 
-	if mmGetVacancy.defaultExpectation == nil {
-		mmGetVacancy.defaultExpectation = &VacancyStorageMockGetVacancyExpectation{}
+	for _, e := range m.StatusBeforeArchiveMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
 	}
 
-	if mmGetVacancy.defaultExpectation.params != nil {
-		mmGetVacancy.mock.t.Fatalf("VacancyStorageMock.GetVacancy mock is already set by Expect")
+	return m.StatusBeforeArchiveMock.invocationsDone()
+}
+
+// MinimockStatusBeforeArchiveInspect logs each unmet expectation
+func (m *VacancyStorageMock) MinimockStatusBeforeArchiveInspect() {
+	for _, e := range m.StatusBeforeArchiveMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to VacancyStorageMock.StatusBeforeArchive at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
 	}
 
-	if mmGetVacancy.defaultExpectation.paramPtrs == nil {
-		mmGetVacancy.defaultExpectation.paramPtrs = &VacancyStorageMockGetVacancyParamPtrs{}
+	afterStatusBeforeArchiveCounter := mm_atomic.LoadUint64(&m.afterStatusBeforeArchiveCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.StatusBeforeArchiveMock.defaultExpectation != nil && afterStatusBeforeArchiveCounter < 1 {
+		if m.StatusBeforeArchiveMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to VacancyStorageMock.StatusBeforeArchive at\n%s", m.StatusBeforeArchiveMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to VacancyStorageMock.StatusBeforeArchive at\n%s with params: %#v", m.StatusBeforeArchiveMock.defaultExpectation.expectationOrigins.origin, *m.StatusBeforeArchiveMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcStatusBeforeArchive != nil && afterStatusBeforeArchiveCounter < 1 {
+		m.t.Errorf("Expected call to VacancyStorageMock.StatusBeforeArchive at\n%s", m.funcStatusBeforeArchiveOrigin)
 	}
-	mmGetVacancy.defaultExpectation.paramPtrs.ctx = &ctx
-	mmGetVacancy.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
 
-	return mmGetVacancy
+	if !m.StatusBeforeArchiveMock.invocationsDone() && afterStatusBeforeArchiveCounter > 0 {
+		m.t.Errorf("Expected %d calls to VacancyStorageMock.StatusBeforeArchive at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.StatusBeforeArchiveMock.expectedInvocations), m.StatusBeforeArchiveMock.expectedInvocationsOrigin, afterStatusBeforeArchiveCounter)
+	}
 }
 
-// ExpectVacancyIDParam2 sets up expected param vacancyID for VacancyStorage.GetVacancy
-func (mmGetVacancy *mVacancyStorageMockGetVacancy) ExpectVacancyIDParam2(vacancyID string) *mVacancyStorageMockGetVacancy {
-	if mmGetVacancy.mock.funcGetVacancy != nil {
-		mmGetVacancy.mock.t.Fatalf("VacancyStorageMock.GetVacancy mock is already set by Set")
+type mVacancyStorageMockTransferVacancyOwnership struct {
+	optional           bool
+	mock               *VacancyStorageMock
+	defaultExpectation *VacancyStorageMockTransferVacancyOwnershipExpectation
+	expectations       []*VacancyStorageMockTransferVacancyOwnershipExpectation
+
+	callArgs []*VacancyStorageMockTransferVacancyOwnershipParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// VacancyStorageMockTransferVacancyOwnershipExpectation specifies expectation struct of the VacancyStorage.TransferVacancyOwnership
+type VacancyStorageMockTransferVacancyOwnershipExpectation struct {
+	mock               *VacancyStorageMock
+	params             *VacancyStorageMockTransferVacancyOwnershipParams
+	paramPtrs          *VacancyStorageMockTransferVacancyOwnershipParamPtrs
+	expectationOrigins VacancyStorageMockTransferVacancyOwnershipExpectationOrigins
+	results            *VacancyStorageMockTransferVacancyOwnershipResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// VacancyStorageMockTransferVacancyOwnershipParams contains parameters of the VacancyStorage.TransferVacancyOwnership
+type VacancyStorageMockTransferVacancyOwnershipParams struct {
+	ctx context.Context
+	in  domain.TransferVacancyOwnershipInput
+}
+
+// VacancyStorageMockTransferVacancyOwnershipParamPtrs contains pointers to parameters of the VacancyStorage.TransferVacancyOwnership
+type VacancyStorageMockTransferVacancyOwnershipParamPtrs struct {
+	ctx *context.Context
+	in  *domain.TransferVacancyOwnershipInput
+}
+
+// VacancyStorageMockTransferVacancyOwnershipResults contains results of the VacancyStorage.TransferVacancyOwnership
+type VacancyStorageMockTransferVacancyOwnershipResults struct {
+	u1  uint32
+	err error
+}
+
+// VacancyStorageMockTransferVacancyOwnershipOrigins contains origins of expectations of the VacancyStorage.TransferVacancyOwnership
+type VacancyStorageMockTransferVacancyOwnershipExpectationOrigins struct {
+	origin    string
+	originCtx string
+	originIn  string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmTransferVacancyOwnership *mVacancyStorageMockTransferVacancyOwnership) Optional() *mVacancyStorageMockTransferVacancyOwnership {
+	mmTransferVacancyOwnership.optional = true
+	return mmTransferVacancyOwnership
+}
+
+// Expect sets up expected params for VacancyStorage.TransferVacancyOwnership
+func (mmTransferVacancyOwnership *mVacancyStorageMockTransferVacancyOwnership) Expect(ctx context.Context, in domain.TransferVacancyOwnershipInput) *mVacancyStorageMockTransferVacancyOwnership {
+	if mmTransferVacancyOwnership.mock.funcTransferVacancyOwnership != nil {
+		mmTransferVacancyOwnership.mock.t.Fatalf("VacancyStorageMock.TransferVacancyOwnership mock is already set by Set")
 	}
 
-	if mmGetVacancy.defaultExpectation == nil {
-		mmGetVacancy.defaultExpectation = &VacancyStorageMockGetVacancyExpectation{}
+	if mmTransferVacancyOwnership.defaultExpectation == nil {
+		mmTransferVacancyOwnership.defaultExpectation = &VacancyStorageMockTransferVacancyOwnershipExpectation{}
 	}
 
-	if mmGetVacancy.defaultExpectation.params != nil {
-		mmGetVacancy.mock.t.Fatalf("VacancyStorageMock.GetVacancy mock is already set by Expect")
+	if mmTransferVacancyOwnership.defaultExpectation.paramPtrs != nil {
+		mmTransferVacancyOwnership.mock.t.Fatalf("VacancyStorageMock.TransferVacancyOwnership mock is already set by ExpectParams functions")
 	}
 
-	if mmGetVacancy.defaultExpectation.paramPtrs == nil {
-		mmGetVacancy.defaultExpectation.paramPtrs = &VacancyStorageMockGetVacancyParamPtrs{}
+	mmTransferVacancyOwnership.defaultExpectation.params = &VacancyStorageMockTransferVacancyOwnershipParams{ctx, in}
+	mmTransferVacancyOwnership.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmTransferVacancyOwnership.expectations {
+		if minimock.Equal(e.params, mmTransferVacancyOwnership.defaultExpectation.params) {
+			mmTransferVacancyOwnership.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmTransferVacancyOwnership.defaultExpectation.params)
+		}
 	}
-	mmGetVacancy.defaultExpectation.paramPtrs.vacancyID = &vacancyID
-	mmGetVacancy.defaultExpectation.expectationOrigins.originVacancyID = minimock.CallerInfo(1)
 
-	return mmGetVacancy
+	return mmTransferVacancyOwnership
 }
 
-// ExpectOwnerUserIDParam3 sets up expected param ownerUserID for VacancyStorage.GetVacancy
-func (mmGetVacancy *mVacancyStorageMockGetVacancy) ExpectOwnerUserIDParam3(ownerUserID uint64) *mVacancyStorageMockGetVacancy {
-	if mmGetVacancy.mock.funcGetVacancy != nil {
-		mmGetVacancy.mock.t.Fatalf("VacancyStorageMock.GetVacancy mock is already set by Set")
+// ExpectCtxParam1 sets up expected param ctx for VacancyStorage.TransferVacancyOwnership
+func (mmTransferVacancyOwnership *mVacancyStorageMockTransferVacancyOwnership) ExpectCtxParam1(ctx context.Context) *mVacancyStorageMockTransferVacancyOwnership {
+	if mmTransferVacancyOwnership.mock.funcTransferVacancyOwnership != nil {
+		mmTransferVacancyOwnership.mock.t.Fatalf("VacancyStorageMock.TransferVacancyOwnership mock is already set by Set")
 	}
 
-	if mmGetVacancy.defaultExpectation == nil {
-		mmGetVacancy.defaultExpectation = &VacancyStorageMockGetVacancyExpectation{}
+	if mmTransferVacancyOwnership.defaultExpectation == nil {
+		mmTransferVacancyOwnership.defaultExpectation = &VacancyStorageMockTransferVacancyOwnershipExpectation{}
 	}
 
-	if mmGetVacancy.defaultExpectation.params != nil {
-		mmGetVacancy.mock.t.Fatalf("VacancyStorageMock.GetVacancy mock is already set by Expect")
+	if mmTransferVacancyOwnership.defaultExpectation.params != nil {
+		mmTransferVacancyOwnership.mock.t.Fatalf("VacancyStorageMock.TransferVacancyOwnership mock is already set by Expect")
 	}
 
-	if mmGetVacancy.defaultExpectation.paramPtrs == nil {
-		mmGetVacancy.defaultExpectation.paramPtrs = &VacancyStorageMockGetVacancyParamPtrs{}
+	if mmTransferVacancyOwnership.defaultExpectation.paramPtrs == nil {
+		mmTransferVacancyOwnership.defaultExpectation.paramPtrs = &VacancyStorageMockTransferVacancyOwnershipParamPtrs{}
 	}
-	mmGetVacancy.defaultExpectation.paramPtrs.ownerUserID = &ownerUserID
-	mmGetVacancy.defaultExpectation.expectationOrigins.originOwnerUserID = minimock.CallerInfo(1)
+	mmTransferVacancyOwnership.defaultExpectation.paramPtrs.ctx = &ctx
+	mmTransferVacancyOwnership.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
 
-	return mmGetVacancy
+	return mmTransferVacancyOwnership
 }
 
-// ExpectIsAdminParam4 sets up expected param isAdmin for VacancyStorage.GetVacancy
-func (mmGetVacancy *mVacancyStorageMockGetVacancy) ExpectIsAdminParam4(isAdmin bool) *mVacancyStorageMockGetVacancy {
-	if mmGetVacancy.mock.funcGetVacancy != nil {
-		mmGetVacancy.mock.t.Fatalf("VacancyStorageMock.GetVacancy mock is already set by Set")
+// ExpectInParam2 sets up expected param in for VacancyStorage.TransferVacancyOwnership
+func (mmTransferVacancyOwnership *mVacancyStorageMockTransferVacancyOwnership) ExpectInParam2(in domain.TransferVacancyOwnershipInput) *mVacancyStorageMockTransferVacancyOwnership {
+	if mmTransferVacancyOwnership.mock.funcTransferVacancyOwnership != nil {
+		mmTransferVacancyOwnership.mock.t.Fatalf("VacancyStorageMock.TransferVacancyOwnership mock is already set by Set")
 	}
 
-	if mmGetVacancy.defaultExpectation == nil {
-		mmGetVacancy.defaultExpectation = &VacancyStorageMockGetVacancyExpectation{}
+	if mmTransferVacancyOwnership.defaultExpectation == nil {
+		mmTransferVacancyOwnership.defaultExpectation = &VacancyStorageMockTransferVacancyOwnershipExpectation{}
 	}
 
-	if mmGetVacancy.defaultExpectation.params != nil {
-		mmGetVacancy.mock.t.Fatalf("VacancyStorageMock.GetVacancy mock is already set by Expect")
+	if mmTransferVacancyOwnership.defaultExpectation.params != nil {
+		mmTransferVacancyOwnership.mock.t.Fatalf("VacancyStorageMock.TransferVacancyOwnership mock is already set by Expect")
 	}
 
-	if mmGetVacancy.defaultExpectation.paramPtrs == nil {
-		mmGetVacancy.defaultExpectation.paramPtrs = &VacancyStorageMockGetVacancyParamPtrs{}
+	if mmTransferVacancyOwnership.defaultExpectation.paramPtrs == nil {
+		mmTransferVacancyOwnership.defaultExpectation.paramPtrs = &VacancyStorageMockTransferVacancyOwnershipParamPtrs{}
 	}
-	mmGetVacancy.defaultExpectation.paramPtrs.isAdmin = &isAdmin
-	mmGetVacancy.defaultExpectation.expectationOrigins.originIsAdmin = minimock.CallerInfo(1)
+	mmTransferVacancyOwnership.defaultExpectation.paramPtrs.in = &in
+	mmTransferVacancyOwnership.defaultExpectation.expectationOrigins.originIn = minimock.CallerInfo(1)
 
-	return mmGetVacancy
+	return mmTransferVacancyOwnership
 }
 
-// Inspect accepts an inspector function that has same arguments as the VacancyStorage.GetVacancy
-func (mmGetVacancy *mVacancyStorageMockGetVacancy) Inspect(f func(ctx context.Context, vacancyID string, ownerUserID uint64, isAdmin bool)) *mVacancyStorageMockGetVacancy {
-	if mmGetVacancy.mock.inspectFuncGetVacancy != nil {
-		mmGetVacancy.mock.t.Fatalf("Inspect function is already set for VacancyStorageMock.GetVacancy")
+// Inspect accepts an inspector function that has same arguments as the VacancyStorage.TransferVacancyOwnership
+func (mmTransferVacancyOwnership *mVacancyStorageMockTransferVacancyOwnership) Inspect(f func(ctx context.Context, in domain.TransferVacancyOwnershipInput)) *mVacancyStorageMockTransferVacancyOwnership {
+	if mmTransferVacancyOwnership.mock.inspectFuncTransferVacancyOwnership != nil {
+		mmTransferVacancyOwnership.mock.t.Fatalf("Inspect function is already set for VacancyStorageMock.TransferVacancyOwnership")
 	}
 
-	mmGetVacancy.mock.inspectFuncGetVacancy = f
+	mmTransferVacancyOwnership.mock.inspectFuncTransferVacancyOwnership = f
 
-	return mmGetVacancy
+	return mmTransferVacancyOwnership
 }
 
-// Return sets up results that will be returned by VacancyStorage.GetVacancy
-func (mmGetVacancy *mVacancyStorageMockGetVacancy) Return(vp1 *domain.Vacancy, err error) *VacancyStorageMock {
-	if mmGetVacancy.mock.funcGetVacancy != nil {
-		mmGetVacancy.mock.t.Fatalf("VacancyStorageMock.GetVacancy mock is already set by Set")
+// Return sets up results that will be returned by VacancyStorage.TransferVacancyOwnership
+func (mmTransferVacancyOwnership *mVacancyStorageMockTransferVacancyOwnership) Return(u1 uint32, err error) *VacancyStorageMock {
+	if mmTransferVacancyOwnership.mock.funcTransferVacancyOwnership != nil {
+		mmTransferVacancyOwnership.mock.t.Fatalf("VacancyStorageMock.TransferVacancyOwnership mock is already set by Set")
 	}
 
-	if mmGetVacancy.defaultExpectation == nil {
-		mmGetVacancy.defaultExpectation = &VacancyStorageMockGetVacancyExpectation{mock: mmGetVacancy.mock}
+	if mmTransferVacancyOwnership.defaultExpectation == nil {
+		mmTransferVacancyOwnership.defaultExpectation = &VacancyStorageMockTransferVacancyOwnershipExpectation{mock: mmTransferVacancyOwnership.mock}
 	}
-	mmGetVacancy.defaultExpectation.results = &VacancyStorageMockGetVacancyResults{vp1, err}
-	mmGetVacancy.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
-	return mmGetVacancy.mock
+	mmTransferVacancyOwnership.defaultExpectation.results = &VacancyStorageMockTransferVacancyOwnershipResults{u1, err}
+	mmTransferVacancyOwnership.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmTransferVacancyOwnership.mock
 }
 
-// Set uses given function f to mock the VacancyStorage.GetVacancy method
-func (mmGetVacancy *mVacancyStorageMockGetVacancy) Set(f func(ctx context.Context, vacancyID string, ownerUserID uint64, isAdmin bool) (vp1 *domain.Vacancy, err error)) *VacancyStorageMock {
-	if mmGetVacancy.defaultExpectation != nil {
-		mmGetVacancy.mock.t.Fatalf("Default expectation is already set for the VacancyStorage.GetVacancy method")
+// Set uses given function f to mock the VacancyStorage.TransferVacancyOwnership method
+func (mmTransferVacancyOwnership *mVacancyStorageMockTransferVacancyOwnership) Set(f func(ctx context.Context, in domain.TransferVacancyOwnershipInput) (u1 uint32, err error)) *VacancyStorageMock {
+	if mmTransferVacancyOwnership.defaultExpectation != nil {
+		mmTransferVacancyOwnership.mock.t.Fatalf("Default expectation is already set for the VacancyStorage.TransferVacancyOwnership method")
 	}
 
-	if len(mmGetVacancy.expectations) > 0 {
-		mmGetVacancy.mock.t.Fatalf("Some expectations are already set for the VacancyStorage.GetVacancy method")
+	if len(mmTransferVacancyOwnership.expectations) > 0 {
+		mmTransferVacancyOwnership.mock.t.Fatalf("Some expectations are already set for the VacancyStorage.TransferVacancyOwnership method")
 	}
 
-	mmGetVacancy.mock.funcGetVacancy = f
-	mmGetVacancy.mock.funcGetVacancyOrigin = minimock.CallerInfo(1)
-	return mmGetVacancy.mock
+	mmTransferVacancyOwnership.mock.funcTransferVacancyOwnership = f
+	mmTransferVacancyOwnership.mock.funcTransferVacancyOwnershipOrigin = minimock.CallerInfo(1)
+	return mmTransferVacancyOwnership.mock
 }
 
-// When sets expectation for the VacancyStorage.GetVacancy which will trigger the result defined by the following
+// When sets expectation for the VacancyStorage.TransferVacancyOwnership which will trigger the result defined by the following
 // Then helper
-func (mmGetVacancy *mVacancyStorageMockGetVacancy) When(ctx context.Context, vacancyID string, ownerUserID uint64, isAdmin bool) *VacancyStorageMockGetVacancyExpectation {
-	if mmGetVacancy.mock.funcGetVacancy != nil {
-		mmGetVacancy.mock.t.Fatalf("VacancyStorageMock.GetVacancy mock is already set by Set")
+func (mmTransferVacancyOwnership *mVacancyStorageMockTransferVacancyOwnership) When(ctx context.Context, in domain.TransferVacancyOwnershipInput) *VacancyStorageMockTransferVacancyOwnershipExpectation {
+	if mmTransferVacancyOwnership.mock.funcTransferVacancyOwnership != nil {
+		mmTransferVacancyOwnership.mock.t.Fatalf("VacancyStorageMock.TransferVacancyOwnership mock is already set by Set")
 	}
 
-	expectation := &VacancyStorageMockGetVacancyExpectation{
-		mock:               mmGetVacancy.mock,
-		params:             &VacancyStorageMockGetVacancyParams{ctx, vacancyID, ownerUserID, isAdmin},
-		expectationOrigins: VacancyStorageMockGetVacancyExpectationOrigins{origin: minimock.CallerInfo(1)},
+	expectation := &VacancyStorageMockTransferVacancyOwnershipExpectation{
+		mock:               mmTransferVacancyOwnership.mock,
+		params:             &VacancyStorageMockTransferVacancyOwnershipParams{ctx, in},
+		expectationOrigins: VacancyStorageMockTransferVacancyOwnershipExpectationOrigins{origin: minimock.CallerInfo(1)},
 	}
-	mmGetVacancy.expectations = append(mmGetVacancy.expectations, expectation)
+	mmTransferVacancyOwnership.expectations = append(mmTransferVacancyOwnership.expectations, expectation)
 	return expectation
 }
 
-// Then sets up VacancyStorage.GetVacancy return parameters for the expectation previously defined by the When method
-func (e *VacancyStorageMockGetVacancyExpectation) Then(vp1 *domain.Vacancy, err error) *VacancyStorageMock {
-	e.results = &VacancyStorageMockGetVacancyResults{vp1, err}
+// Then sets up VacancyStorage.TransferVacancyOwnership return parameters for the expectation previously defined by the When method
+func (e *VacancyStorageMockTransferVacancyOwnershipExpectation) Then(u1 uint32, err error) *VacancyStorageMock {
+	e.results = &VacancyStorageMockTransferVacancyOwnershipResults{u1, err}
 	return e.mock
 }
 
-// Times sets number of times VacancyStorage.GetVacancy should be invoked
-func (mmGetVacancy *mVacancyStorageMockGetVacancy) Times(n uint64) *mVacancyStorageMockGetVacancy {
+// Times sets number of times VacancyStorage.TransferVacancyOwnership should be invoked
+func (mmTransferVacancyOwnership *mVacancyStorageMockTransferVacancyOwnership) Times(n uint64) *mVacancyStorageMockTransferVacancyOwnership {
 	if n == 0 {
-		mmGetVacancy.mock.t.Fatalf("Times of VacancyStorageMock.GetVacancy mock can not be zero")
+		mmTransferVacancyOwnership.mock.t.Fatalf("Times of VacancyStorageMock.TransferVacancyOwnership mock can not be zero")
 	}
-	mm_atomic.StoreUint64(&mmGetVacancy.expectedInvocations, n)
-	mmGetVacancy.expectedInvocationsOrigin = minimock.CallerInfo(1)
-	return mmGetVacancy
+	mm_atomic.StoreUint64(&mmTransferVacancyOwnership.expectedInvocations, n)
+	mmTransferVacancyOwnership.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmTransferVacancyOwnership
 }
 
-func (mmGetVacancy *mVacancyStorageMockGetVacancy) invocationsDone() bool {
-	if len(mmGetVacancy.expectations) == 0 && mmGetVacancy.defaultExpectation == nil && mmGetVacancy.mock.funcGetVacancy == nil {
+func (mmTransferVacancyOwnership *mVacancyStorageMockTransferVacancyOwnership) invocationsDone() bool {
+	if len(mmTransferVacancyOwnership.expectations) == 0 && mmTransferVacancyOwnership.defaultExpectation == nil && mmTransferVacancyOwnership.mock.funcTransferVacancyOwnership == nil {
 		return true
 	}
 
-	totalInvocations := mm_atomic.LoadUint64(&mmGetVacancy.mock.afterGetVacancyCounter)
-	expectedInvocations := mm_atomic.LoadUint64(&mmGetVacancy.expectedInvocations)
+	totalInvocations := mm_atomic.LoadUint64(&mmTransferVacancyOwnership.mock.afterTransferVacancyOwnershipCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmTransferVacancyOwnership.expectedInvocations)
 
 	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
 }
 
-// GetVacancy implements mm_usecase.VacancyStorage
-func (mmGetVacancy *VacancyStorageMock) GetVacancy(ctx context.Context, vacancyID string, ownerUserID uint64, isAdmin bool) (vp1 *domain.Vacancy, err error) {
-	mm_atomic.AddUint64(&mmGetVacancy.beforeGetVacancyCounter, 1)
-	defer mm_atomic.AddUint64(&mmGetVacancy.afterGetVacancyCounter, 1)
+// TransferVacancyOwnership implements mm_usecase.VacancyStorage
+func (mmTransferVacancyOwnership *VacancyStorageMock) TransferVacancyOwnership(ctx context.Context, in domain.TransferVacancyOwnershipInput) (u1 uint32, err error) {
+	mm_atomic.AddUint64(&mmTransferVacancyOwnership.beforeTransferVacancyOwnershipCounter, 1)
+	defer mm_atomic.AddUint64(&mmTransferVacancyOwnership.afterTransferVacancyOwnershipCounter, 1)
 
-	mmGetVacancy.t.Helper()
+	mmTransferVacancyOwnership.t.Helper()
 
-	if mmGetVacancy.inspectFuncGetVacancy != nil {
-		mmGetVacancy.inspectFuncGetVacancy(ctx, vacancyID, ownerUserID, isAdmin)
+	if mmTransferVacancyOwnership.inspectFuncTransferVacancyOwnership != nil {
+		mmTransferVacancyOwnership.inspectFuncTransferVacancyOwnership(ctx, in)
 	}
 
-	mm_params := VacancyStorageMockGetVacancyParams{ctx, vacancyID, ownerUserID, isAdmin}
+	mm_params := VacancyStorageMockTransferVacancyOwnershipParams{ctx, in}
 
 	// Record call args
-	mmGetVacancy.GetVacancyMock.mutex.Lock()
-	mmGetVacancy.GetVacancyMock.callArgs = append(mmGetVacancy.GetVacancyMock.callArgs, &mm_params)
-	mmGetVacancy.GetVacancyMock.mutex.Unlock()
+	mmTransferVacancyOwnership.TransferVacancyOwnershipMock.mutex.Lock()
+	mmTransferVacancyOwnership.TransferVacancyOwnershipMock.callArgs = append(mmTransferVacancyOwnership.TransferVacancyOwnershipMock.callArgs, &mm_params)
+	mmTransferVacancyOwnership.TransferVacancyOwnershipMock.mutex.Unlock()
 
-	for _, e := range mmGetVacancy.GetVacancyMock.expectations {
+	for _, e := range mmTransferVacancyOwnership.TransferVacancyOwnershipMock.expectations {
 		if minimock.Equal(*e.params, mm_params) {
 			mm_atomic.AddUint64(&e.Counter, 1)
-			return e.results.vp1, e.results.err
+			return e.results.u1, e.results.err
 		}
 	}
 
-	if mmGetVacancy.GetVacancyMock.defaultExpectation != nil {
-		mm_atomic.AddUint64(&mmGetVacancy.GetVacancyMock.defaultExpectation.Counter, 1)
-		mm_want := mmGetVacancy.GetVacancyMock.defaultExpectation.params
-		mm_want_ptrs := mmGetVacancy.GetVacancyMock.defaultExpectation.paramPtrs
-
-		mm_got := VacancyStorageMockGetVacancyParams{ctx, vacancyID, ownerUserID, isAdmin}
-
-		if mm_want_ptrs != nil {
-
-			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
-				mmGetVacancy.t.Errorf("VacancyStorageMock.GetVacancy got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
-					mmGetVacancy.GetVacancyMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
-			}
-
-			if mm_want_ptrs.vacancyID != nil && !minimock.Equal(*mm_want_ptrs.vacancyID, mm_got.vacancyID) {
-				mmGetVacancy.t.Errorf("VacancyStorageMock.GetVacancy got unexpected parameter vacancyID, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
-					mmGetVacancy.GetVacancyMock.defaultExpectation.expectationOrigins.originVacancyID, *mm_want_ptrs.vacancyID, mm_got.vacancyID, minimock.Diff(*mm_want_ptrs.vacancyID, mm_got.vacancyID))
-			}
+	if mmTransferVacancyOwnership.TransferVacancyOwnershipMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmTransferVacancyOwnership.TransferVacancyOwnershipMock.defaultExpectation.Counter, 1)
+		mm_want := mmTransferVacancyOwnership.TransferVacancyOwnershipMock.defaultExpectation.params
+		mm_want_ptrs := mmTransferVacancyOwnership.TransferVacancyOwnershipMock.defaultExpectation.paramPtrs
 
-			if mm_want_ptrs.ownerUserID != nil && !minimock.Equal(*mm_want_ptrs.ownerUserID, mm_got.ownerUserID) {
-				mmGetVacancy.t.Errorf("VacancyStorageMock.GetVacancy got unexpected parameter ownerUserID, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
-					mmGetVacancy.GetVacancyMock.defaultExpectation.expectationOrigins.originOwnerUserID, *mm_want_ptrs.ownerUserID, mm_got.ownerUserID, minimock.Diff(*mm_want_ptrs.ownerUserID, mm_got.ownerUserID))
+		mm_got := VacancyStorageMockTransferVacancyOwnershipParams{ctx, in}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmTransferVacancyOwnership.t.Errorf("VacancyStorageMock.TransferVacancyOwnership got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmTransferVacancyOwnership.TransferVacancyOwnershipMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
 			}
 
-			if mm_want_ptrs.isAdmin != nil && !minimock.Equal(*mm_want_ptrs.isAdmin, mm_got.isAdmin) {
-				mmGetVacancy.t.Errorf("VacancyStorageMock.GetVacancy got unexpected parameter isAdmin, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
-					mmGetVacancy.GetVacancyMock.defaultExpectation.expectationOrigins.originIsAdmin, *mm_want_ptrs.isAdmin, mm_got.isAdmin, minimock.Diff(*mm_want_ptrs.isAdmin, mm_got.isAdmin))
+			if mm_want_ptrs.in != nil && !minimock.Equal(*mm_want_ptrs.in, mm_got.in) {
+				mmTransferVacancyOwnership.t.Errorf("VacancyStorageMock.TransferVacancyOwnership got unexpected parameter in, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmTransferVacancyOwnership.TransferVacancyOwnershipMock.defaultExpectation.expectationOrigins.originIn, *mm_want_ptrs.in, mm_got.in, minimock.Diff(*mm_want_ptrs.in, mm_got.in))
 			}
 
 		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
-			mmGetVacancy.t.Errorf("VacancyStorageMock.GetVacancy got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
-				mmGetVacancy.GetVacancyMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+			mmTransferVacancyOwnership.t.Errorf("VacancyStorageMock.TransferVacancyOwnership got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmTransferVacancyOwnership.TransferVacancyOwnershipMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
 		}
 
-		mm_results := mmGetVacancy.GetVacancyMock.defaultExpectation.results
+		mm_results := mmTransferVacancyOwnership.TransferVacancyOwnershipMock.defaultExpectation.results
 		if mm_results == nil {
-			mmGetVacancy.t.Fatal("No results are set for the VacancyStorageMock.GetVacancy")
+			mmTransferVacancyOwnership.t.Fatal("No results are set for the VacancyStorageMock.TransferVacancyOwnership")
 		}
-		return (*mm_results).vp1, (*mm_results).err
+		return (*mm_results).u1, (*mm_results).err
 	}
-	if mmGetVacancy.funcGetVacancy != nil {
-		return mmGetVacancy.funcGetVacancy(ctx, vacancyID, ownerUserID, isAdmin)
+	if mmTransferVacancyOwnership.funcTransferVacancyOwnership != nil {
+		return mmTransferVacancyOwnership.funcTransferVacancyOwnership(ctx, in)
 	}
-	mmGetVacancy.t.Fatalf("Unexpected call to VacancyStorageMock.GetVacancy. %v %v %v %v", ctx, vacancyID, ownerUserID, isAdmin)
+	mmTransferVacancyOwnership.t.Fatalf("Unexpected call to VacancyStorageMock.TransferVacancyOwnership. %v %v", ctx, in)
 	return
 }
 
-// GetVacancyAfterCounter returns a count of finished VacancyStorageMock.GetVacancy invocations
-func (mmGetVacancy *VacancyStorageMock) GetVacancyAfterCounter() uint64 {
-	return mm_atomic.LoadUint64(&mmGetVacancy.afterGetVacancyCounter)
+// TransferVacancyOwnershipAfterCounter returns a count of finished VacancyStorageMock.TransferVacancyOwnership invocations
+func (mmTransferVacancyOwnership *VacancyStorageMock) TransferVacancyOwnershipAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmTransferVacancyOwnership.afterTransferVacancyOwnershipCounter)
 }
 
-// GetVacancyBeforeCounter returns a count of VacancyStorageMock.GetVacancy invocations
-func (mmGetVacancy *VacancyStorageMock) GetVacancyBeforeCounter() uint64 {
-	return mm_atomic.LoadUint64(&mmGetVacancy.beforeGetVacancyCounter)
+// TransferVacancyOwnershipBeforeCounter returns a count of VacancyStorageMock.TransferVacancyOwnership invocations
+func (mmTransferVacancyOwnership *VacancyStorageMock) TransferVacancyOwnershipBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmTransferVacancyOwnership.beforeTransferVacancyOwnershipCounter)
 }
 
-// Calls returns a list of arguments used in each call to VacancyStorageMock.GetVacancy.
+// Calls returns a list of arguments used in each call to VacancyStorageMock.TransferVacancyOwnership.
 // The list is in the same order as the calls were made (i.e. recent calls have a higher index)
-func (mmGetVacancy *mVacancyStorageMockGetVacancy) Calls() []*VacancyStorageMockGetVacancyParams {
-	mmGetVacancy.mutex.RLock()
+func (mmTransferVacancyOwnership *mVacancyStorageMockTransferVacancyOwnership) Calls() []*VacancyStorageMockTransferVacancyOwnershipParams {
+	mmTransferVacancyOwnership.mutex.RLock()
 
-	argCopy := make([]*VacancyStorageMockGetVacancyParams, len(mmGetVacancy.callArgs))
-	copy(argCopy, mmGetVacancy.callArgs)
+	argCopy := make([]*VacancyStorageMockTransferVacancyOwnershipParams, len(mmTransferVacancyOwnership.callArgs))
+	copy(argCopy, mmTransferVacancyOwnership.callArgs)
 
-	mmGetVacancy.mutex.RUnlock()
+	mmTransferVacancyOwnership.mutex.RUnlock()
 
 	return argCopy
 }
 
-// MinimockGetVacancyDone returns true if the count of the GetVacancy invocations corresponds
+// MinimockTransferVacancyOwnershipDone returns true if the count of the TransferVacancyOwnership invocations corresponds
 // the number of defined expectations
-func (m *VacancyStorageMock) MinimockGetVacancyDone() bool {
-	if m.GetVacancyMock.optional {
+func (m *VacancyStorageMock) MinimockTransferVacancyOwnershipDone() bool {
+	if m.TransferVacancyOwnershipMock.optional {
 		// Optional methods provide '0 or more' call count restriction.
 		return true
 	}
 
-	for _, e := range m.GetVacancyMock.expectations {
+	for _, e := range m.TransferVacancyOwnershipMock.expectations {
 		if mm_atomic.LoadUint64(&e.Counter) < 1 {
 			return false
 		}
 	}
 
-	return m.GetVacancyMock.invocationsDone()
+	return m.TransferVacancyOwnershipMock.invocationsDone()
 }
 
-// MinimockGetVacancyInspect logs each unmet expectation
-func (m *VacancyStorageMock) MinimockGetVacancyInspect() {
-	for _, e := range m.GetVacancyMock.expectations {
+// MinimockTransferVacancyOwnershipInspect logs each unmet expectation
+func (m *VacancyStorageMock) MinimockTransferVacancyOwnershipInspect() {
+	for _, e := range m.TransferVacancyOwnershipMock.expectations {
 		if mm_atomic.LoadUint64(&e.Counter) < 1 {
-			m.t.Errorf("Expected call to VacancyStorageMock.GetVacancy at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+			m.t.Errorf("Expected call to VacancyStorageMock.TransferVacancyOwnership at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
 		}
 	}
 
-	afterGetVacancyCounter := mm_atomic.LoadUint64(&m.afterGetVacancyCounter)
+	afterTransferVacancyOwnershipCounter := mm_atomic.LoadUint64(&m.afterTransferVacancyOwnershipCounter)
 	// if default expectation was set then invocations count should be greater than zero
-	if m.GetVacancyMock.defaultExpectation != nil && afterGetVacancyCounter < 1 {
-		if m.GetVacancyMock.defaultExpectation.params == nil {
-			m.t.Errorf("Expected call to VacancyStorageMock.GetVacancy at\n%s", m.GetVacancyMock.defaultExpectation.returnOrigin)
+	if m.TransferVacancyOwnershipMock.defaultExpectation != nil && afterTransferVacancyOwnershipCounter < 1 {
+		if m.TransferVacancyOwnershipMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to VacancyStorageMock.TransferVacancyOwnership at\n%s", m.TransferVacancyOwnershipMock.defaultExpectation.returnOrigin)
 		} else {
-			m.t.Errorf("Expected call to VacancyStorageMock.GetVacancy at\n%s with params: %#v", m.GetVacancyMock.defaultExpectation.expectationOrigins.origin, *m.GetVacancyMock.defaultExpectation.params)
+			m.t.Errorf("Expected call to VacancyStorageMock.TransferVacancyOwnership at\n%s with params: %#v", m.TransferVacancyOwnershipMock.defaultExpectation.expectationOrigins.origin, *m.TransferVacancyOwnershipMock.defaultExpectation.params)
 		}
 	}
 	// if func was set then invocations count should be greater than zero
-	if m.funcGetVacancy != nil && afterGetVacancyCounter < 1 {
-		m.t.Errorf("Expected call to VacancyStorageMock.GetVacancy at\n%s", m.funcGetVacancyOrigin)
+	if m.funcTransferVacancyOwnership != nil && afterTransferVacancyOwnershipCounter < 1 {
+		m.t.Errorf("Expected call to VacancyStorageMock.TransferVacancyOwnership at\n%s", m.funcTransferVacancyOwnershipOrigin)
 	}
 
-	if !m.GetVacancyMock.invocationsDone() && afterGetVacancyCounter > 0 {
-		m.t.Errorf("Expected %d calls to VacancyStorageMock.GetVacancy at\n%s but found %d calls",
-			mm_atomic.LoadUint64(&m.GetVacancyMock.expectedInvocations), m.GetVacancyMock.expectedInvocationsOrigin, afterGetVacancyCounter)
+	if !m.TransferVacancyOwnershipMock.invocationsDone() && afterTransferVacancyOwnershipCounter > 0 {
+		m.t.Errorf("Expected %d calls to VacancyStorageMock.TransferVacancyOwnership at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.TransferVacancyOwnershipMock.expectedInvocations), m.TransferVacancyOwnershipMock.expectedInvocationsOrigin, afterTransferVacancyOwnershipCounter)
 	}
 }
 
-type mVacancyStorageMockListVacancies struct {
+type mVacancyStorageMockUndeleteVacancy struct {
 	optional           bool
 	mock               *VacancyStorageMock
-	defaultExpectation *VacancyStorageMockListVacanciesExpectation
-	expectations       []*VacancyStorageMockListVacanciesExpectation
+	defaultExpectation *VacancyStorageMockUndeleteVacancyExpectation
+	expectations       []*VacancyStorageMockUndeleteVacancyExpectation
 
-	callArgs []*VacancyStorageMockListVacanciesParams
+	callArgs []*VacancyStorageMockUndeleteVacancyParams
 	mutex    sync.RWMutex
 
 	expectedInvocations       uint64
 	expectedInvocationsOrigin string
 }
 
-// VacancyStorageMockListVacanciesExpectation specifies expectation struct of the VacancyStorage.ListVacancies
-type VacancyStorageMockListVacanciesExpectation struct {
+// VacancyStorageMockUndeleteVacancyExpectation specifies expectation struct of the VacancyStorage.UndeleteVacancy
+type VacancyStorageMockUndeleteVacancyExpectation struct {
 	mock               *VacancyStorageMock
-	params             *VacancyStorageMockListVacanciesParams
-	paramPtrs          *VacancyStorageMockListVacanciesParamPtrs
-	expectationOrigins VacancyStorageMockListVacanciesExpectationOrigins
-	results            *VacancyStorageMockListVacanciesResults
+	params             *VacancyStorageMockUndeleteVacancyParams
+	paramPtrs          *VacancyStorageMockUndeleteVacancyParamPtrs
+	expectationOrigins VacancyStorageMockUndeleteVacancyExpectationOrigins
+	results            *VacancyStorageMockUndeleteVacancyResults
 	returnOrigin       string
 	Counter            uint64
 }
 
-// VacancyStorageMockListVacanciesParams contains parameters of the VacancyStorage.ListVacancies
-type VacancyStorageMockListVacanciesParams struct {
+// VacancyStorageMockUndeleteVacancyParams contains parameters of the VacancyStorage.UndeleteVacancy
+type VacancyStorageMockUndeleteVacancyParams struct {
 	ctx context.Context
-	in  domain.ListVacanciesInput
+	in  domain.UndeleteVacancyInput
 }
 
-// VacancyStorageMockListVacanciesParamPtrs contains pointers to parameters of the VacancyStorage.ListVacancies
-type VacancyStorageMockListVacanciesParamPtrs struct {
+// VacancyStorageMockUndeleteVacancyParamPtrs contains pointers to parameters of the VacancyStorage.UndeleteVacancy
+type VacancyStorageMockUndeleteVacancyParamPtrs struct {
 	ctx *context.Context
-	in  *domain.ListVacanciesInput
+	in  *domain.UndeleteVacancyInput
 }
 
-// VacancyStorageMockListVacanciesResults contains results of the VacancyStorage.ListVacancies
-type VacancyStorageMockListVacanciesResults struct {
-	lp1 *domain.ListVacanciesResult
+// VacancyStorageMockUndeleteVacancyResults contains results of the VacancyStorage.UndeleteVacancy
+type VacancyStorageMockUndeleteVacancyResults struct {
+	vp1 *domain.Vacancy
 	err error
 }
 
-// VacancyStorageMockListVacanciesOrigins contains origins of expectations of the VacancyStorage.ListVacancies
-type VacancyStorageMockListVacanciesExpectationOrigins struct {
+// VacancyStorageMockUndeleteVacancyOrigins contains origins of expectations of the VacancyStorage.UndeleteVacancy
+type VacancyStorageMockUndeleteVacancyExpectationOrigins struct {
 	origin    string
 	originCtx string
 	originIn  string
@@ -1225,292 +11327,292 @@ type VacancyStorageMockListVacanciesExpectationOrigins struct {
 // Optional() makes method check to work in '0 or more' mode.
 // It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
 // catch the problems when the expected method call is totally skipped during test run.
-func (mmListVacancies *mVacancyStorageMockListVacancies) Optional() *mVacancyStorageMockListVacancies {
-	mmListVacancies.optional = true
-	return mmListVacancies
+func (mmUndeleteVacancy *mVacancyStorageMockUndeleteVacancy) Optional() *mVacancyStorageMockUndeleteVacancy {
+	mmUndeleteVacancy.optional = true
+	return mmUndeleteVacancy
 }
 
-// Expect sets up expected params for VacancyStorage.ListVacancies
-func (mmListVacancies *mVacancyStorageMockListVacancies) Expect(ctx context.Context, in domain.ListVacanciesInput) *mVacancyStorageMockListVacancies {
-	if mmListVacancies.mock.funcListVacancies != nil {
-		mmListVacancies.mock.t.Fatalf("VacancyStorageMock.ListVacancies mock is already set by Set")
+// Expect sets up expected params for VacancyStorage.UndeleteVacancy
+func (mmUndeleteVacancy *mVacancyStorageMockUndeleteVacancy) Expect(ctx context.Context, in domain.UndeleteVacancyInput) *mVacancyStorageMockUndeleteVacancy {
+	if mmUndeleteVacancy.mock.funcUndeleteVacancy != nil {
+		mmUndeleteVacancy.mock.t.Fatalf("VacancyStorageMock.UndeleteVacancy mock is already set by Set")
 	}
 
-	if mmListVacancies.defaultExpectation == nil {
-		mmListVacancies.defaultExpectation = &VacancyStorageMockListVacanciesExpectation{}
+	if mmUndeleteVacancy.defaultExpectation == nil {
+		mmUndeleteVacancy.defaultExpectation = &VacancyStorageMockUndeleteVacancyExpectation{}
 	}
 
-	if mmListVacancies.defaultExpectation.paramPtrs != nil {
-		mmListVacancies.mock.t.Fatalf("VacancyStorageMock.ListVacancies mock is already set by ExpectParams functions")
+	if mmUndeleteVacancy.defaultExpectation.paramPtrs != nil {
+		mmUndeleteVacancy.mock.t.Fatalf("VacancyStorageMock.UndeleteVacancy mock is already set by ExpectParams functions")
 	}
 
-	mmListVacancies.defaultExpectation.params = &VacancyStorageMockListVacanciesParams{ctx, in}
-	mmListVacancies.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
-	for _, e := range mmListVacancies.expectations {
-		if minimock.Equal(e.params, mmListVacancies.defaultExpectation.params) {
-			mmListVacancies.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmListVacancies.defaultExpectation.params)
+	mmUndeleteVacancy.defaultExpectation.params = &VacancyStorageMockUndeleteVacancyParams{ctx, in}
+	mmUndeleteVacancy.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmUndeleteVacancy.expectations {
+		if minimock.Equal(e.params, mmUndeleteVacancy.defaultExpectation.params) {
+			mmUndeleteVacancy.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmUndeleteVacancy.defaultExpectation.params)
 		}
 	}
 
-	return mmListVacancies
+	return mmUndeleteVacancy
 }
 
-// ExpectCtxParam1 sets up expected param ctx for VacancyStorage.ListVacancies
-func (mmListVacancies *mVacancyStorageMockListVacancies) ExpectCtxParam1(ctx context.Context) *mVacancyStorageMockListVacancies {
-	if mmListVacancies.mock.funcListVacancies != nil {
-		mmListVacancies.mock.t.Fatalf("VacancyStorageMock.ListVacancies mock is already set by Set")
+// ExpectCtxParam1 sets up expected param ctx for VacancyStorage.UndeleteVacancy
+func (mmUndeleteVacancy *mVacancyStorageMockUndeleteVacancy) ExpectCtxParam1(ctx context.Context) *mVacancyStorageMockUndeleteVacancy {
+	if mmUndeleteVacancy.mock.funcUndeleteVacancy != nil {
+		mmUndeleteVacancy.mock.t.Fatalf("VacancyStorageMock.UndeleteVacancy mock is already set by Set")
 	}
 
-	if mmListVacancies.defaultExpectation == nil {
-		mmListVacancies.defaultExpectation = &VacancyStorageMockListVacanciesExpectation{}
+	if mmUndeleteVacancy.defaultExpectation == nil {
+		mmUndeleteVacancy.defaultExpectation = &VacancyStorageMockUndeleteVacancyExpectation{}
 	}
 
-	if mmListVacancies.defaultExpectation.params != nil {
-		mmListVacancies.mock.t.Fatalf("VacancyStorageMock.ListVacancies mock is already set by Expect")
+	if mmUndeleteVacancy.defaultExpectation.params != nil {
+		mmUndeleteVacancy.mock.t.Fatalf("VacancyStorageMock.UndeleteVacancy mock is already set by Expect")
 	}
 
-	if mmListVacancies.defaultExpectation.paramPtrs == nil {
-		mmListVacancies.defaultExpectation.paramPtrs = &VacancyStorageMockListVacanciesParamPtrs{}
+	if mmUndeleteVacancy.defaultExpectation.paramPtrs == nil {
+		mmUndeleteVacancy.defaultExpectation.paramPtrs = &VacancyStorageMockUndeleteVacancyParamPtrs{}
 	}
-	mmListVacancies.defaultExpectation.paramPtrs.ctx = &ctx
-	mmListVacancies.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+	mmUndeleteVacancy.defaultExpectation.paramPtrs.ctx = &ctx
+	mmUndeleteVacancy.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
 
-	return mmListVacancies
+	return mmUndeleteVacancy
 }
 
-// ExpectInParam2 sets up expected param in for VacancyStorage.ListVacancies
-func (mmListVacancies *mVacancyStorageMockListVacancies) ExpectInParam2(in domain.ListVacanciesInput) *mVacancyStorageMockListVacancies {
-	if mmListVacancies.mock.funcListVacancies != nil {
-		mmListVacancies.mock.t.Fatalf("VacancyStorageMock.ListVacancies mock is already set by Set")
+// ExpectInParam2 sets up expected param in for VacancyStorage.UndeleteVacancy
+func (mmUndeleteVacancy *mVacancyStorageMockUndeleteVacancy) ExpectInParam2(in domain.UndeleteVacancyInput) *mVacancyStorageMockUndeleteVacancy {
+	if mmUndeleteVacancy.mock.funcUndeleteVacancy != nil {
+		mmUndeleteVacancy.mock.t.Fatalf("VacancyStorageMock.UndeleteVacancy mock is already set by Set")
 	}
 
-	if mmListVacancies.defaultExpectation == nil {
-		mmListVacancies.defaultExpectation = &VacancyStorageMockListVacanciesExpectation{}
+	if mmUndeleteVacancy.defaultExpectation == nil {
+		mmUndeleteVacancy.defaultExpectation = &VacancyStorageMockUndeleteVacancyExpectation{}
 	}
 
-	if mmListVacancies.defaultExpectation.params != nil {
-		mmListVacancies.mock.t.Fatalf("VacancyStorageMock.ListVacancies mock is already set by Expect")
+	if mmUndeleteVacancy.defaultExpectation.params != nil {
+		mmUndeleteVacancy.mock.t.Fatalf("VacancyStorageMock.UndeleteVacancy mock is already set by Expect")
 	}
 
-	if mmListVacancies.defaultExpectation.paramPtrs == nil {
-		mmListVacancies.defaultExpectation.paramPtrs = &VacancyStorageMockListVacanciesParamPtrs{}
+	if mmUndeleteVacancy.defaultExpectation.paramPtrs == nil {
+		mmUndeleteVacancy.defaultExpectation.paramPtrs = &VacancyStorageMockUndeleteVacancyParamPtrs{}
 	}
-	mmListVacancies.defaultExpectation.paramPtrs.in = &in
-	mmListVacancies.defaultExpectation.expectationOrigins.originIn = minimock.CallerInfo(1)
+	mmUndeleteVacancy.defaultExpectation.paramPtrs.in = &in
+	mmUndeleteVacancy.defaultExpectation.expectationOrigins.originIn = minimock.CallerInfo(1)
 
-	return mmListVacancies
+	return mmUndeleteVacancy
 }
 
-// Inspect accepts an inspector function that has same arguments as the VacancyStorage.ListVacancies
-func (mmListVacancies *mVacancyStorageMockListVacancies) Inspect(f func(ctx context.Context, in domain.ListVacanciesInput)) *mVacancyStorageMockListVacancies {
-	if mmListVacancies.mock.inspectFuncListVacancies != nil {
-		mmListVacancies.mock.t.Fatalf("Inspect function is already set for VacancyStorageMock.ListVacancies")
+// Inspect accepts an inspector function that has same arguments as the VacancyStorage.UndeleteVacancy
+func (mmUndeleteVacancy *mVacancyStorageMockUndeleteVacancy) Inspect(f func(ctx context.Context, in domain.UndeleteVacancyInput)) *mVacancyStorageMockUndeleteVacancy {
+	if mmUndeleteVacancy.mock.inspectFuncUndeleteVacancy != nil {
+		mmUndeleteVacancy.mock.t.Fatalf("Inspect function is already set for VacancyStorageMock.UndeleteVacancy")
 	}
 
-	mmListVacancies.mock.inspectFuncListVacancies = f
+	mmUndeleteVacancy.mock.inspectFuncUndeleteVacancy = f
 
-	return mmListVacancies
+	return mmUndeleteVacancy
 }
 
-// Return sets up results that will be returned by VacancyStorage.ListVacancies
-func (mmListVacancies *mVacancyStorageMockListVacancies) Return(lp1 *domain.ListVacanciesResult, err error) *VacancyStorageMock {
-	if mmListVacancies.mock.funcListVacancies != nil {
-		mmListVacancies.mock.t.Fatalf("VacancyStorageMock.ListVacancies mock is already set by Set")
+// Return sets up results that will be returned by VacancyStorage.UndeleteVacancy
+func (mmUndeleteVacancy *mVacancyStorageMockUndeleteVacancy) Return(vp1 *domain.Vacancy, err error) *VacancyStorageMock {
+	if mmUndeleteVacancy.mock.funcUndeleteVacancy != nil {
+		mmUndeleteVacancy.mock.t.Fatalf("VacancyStorageMock.UndeleteVacancy mock is already set by Set")
 	}
 
-	if mmListVacancies.defaultExpectation == nil {
-		mmListVacancies.defaultExpectation = &VacancyStorageMockListVacanciesExpectation{mock: mmListVacancies.mock}
+	if mmUndeleteVacancy.defaultExpectation == nil {
+		mmUndeleteVacancy.defaultExpectation = &VacancyStorageMockUndeleteVacancyExpectation{mock: mmUndeleteVacancy.mock}
 	}
-	mmListVacancies.defaultExpectation.results = &VacancyStorageMockListVacanciesResults{lp1, err}
-	mmListVacancies.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
-	return mmListVacancies.mock
+	mmUndeleteVacancy.defaultExpectation.results = &VacancyStorageMockUndeleteVacancyResults{vp1, err}
+	mmUndeleteVacancy.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmUndeleteVacancy.mock
 }
 
-// Set uses given function f to mock the VacancyStorage.ListVacancies method
-func (mmListVacancies *mVacancyStorageMockListVacancies) Set(f func(ctx context.Context, in domain.ListVacanciesInput) (lp1 *domain.ListVacanciesResult, err error)) *VacancyStorageMock {
-	if mmListVacancies.defaultExpectation != nil {
-		mmListVacancies.mock.t.Fatalf("Default expectation is already set for the VacancyStorage.ListVacancies method")
+// Set uses given function f to mock the VacancyStorage.UndeleteVacancy method
+func (mmUndeleteVacancy *mVacancyStorageMockUndeleteVacancy) Set(f func(ctx context.Context, in domain.UndeleteVacancyInput) (vp1 *domain.Vacancy, err error)) *VacancyStorageMock {
+	if mmUndeleteVacancy.defaultExpectation != nil {
+		mmUndeleteVacancy.mock.t.Fatalf("Default expectation is already set for the VacancyStorage.UndeleteVacancy method")
 	}
 
-	if len(mmListVacancies.expectations) > 0 {
-		mmListVacancies.mock.t.Fatalf("Some expectations are already set for the VacancyStorage.ListVacancies method")
+	if len(mmUndeleteVacancy.expectations) > 0 {
+		mmUndeleteVacancy.mock.t.Fatalf("Some expectations are already set for the VacancyStorage.UndeleteVacancy method")
 	}
 
-	mmListVacancies.mock.funcListVacancies = f
-	mmListVacancies.mock.funcListVacanciesOrigin = minimock.CallerInfo(1)
-	return mmListVacancies.mock
+	mmUndeleteVacancy.mock.funcUndeleteVacancy = f
+	mmUndeleteVacancy.mock.funcUndeleteVacancyOrigin = minimock.CallerInfo(1)
+	return mmUndeleteVacancy.mock
 }
 
-// When sets expectation for the VacancyStorage.ListVacancies which will trigger the result defined by the following
+// When sets expectation for the VacancyStorage.UndeleteVacancy which will trigger the result defined by the following
 // Then helper
-func (mmListVacancies *mVacancyStorageMockListVacancies) When(ctx context.Context, in domain.ListVacanciesInput) *VacancyStorageMockListVacanciesExpectation {
-	if mmListVacancies.mock.funcListVacancies != nil {
-		mmListVacancies.mock.t.Fatalf("VacancyStorageMock.ListVacancies mock is already set by Set")
+func (mmUndeleteVacancy *mVacancyStorageMockUndeleteVacancy) When(ctx context.Context, in domain.UndeleteVacancyInput) *VacancyStorageMockUndeleteVacancyExpectation {
+	if mmUndeleteVacancy.mock.funcUndeleteVacancy != nil {
+		mmUndeleteVacancy.mock.t.Fatalf("VacancyStorageMock.UndeleteVacancy mock is already set by Set")
 	}
 
-	expectation := &VacancyStorageMockListVacanciesExpectation{
-		mock:               mmListVacancies.mock,
-		params:             &VacancyStorageMockListVacanciesParams{ctx, in},
-		expectationOrigins: VacancyStorageMockListVacanciesExpectationOrigins{origin: minimock.CallerInfo(1)},
+	expectation := &VacancyStorageMockUndeleteVacancyExpectation{
+		mock:               mmUndeleteVacancy.mock,
+		params:             &VacancyStorageMockUndeleteVacancyParams{ctx, in},
+		expectationOrigins: VacancyStorageMockUndeleteVacancyExpectationOrigins{origin: minimock.CallerInfo(1)},
 	}
-	mmListVacancies.expectations = append(mmListVacancies.expectations, expectation)
+	mmUndeleteVacancy.expectations = append(mmUndeleteVacancy.expectations, expectation)
 	return expectation
 }
 
-// Then sets up VacancyStorage.ListVacancies return parameters for the expectation previously defined by the When method
-func (e *VacancyStorageMockListVacanciesExpectation) Then(lp1 *domain.ListVacanciesResult, err error) *VacancyStorageMock {
-	e.results = &VacancyStorageMockListVacanciesResults{lp1, err}
+// Then sets up VacancyStorage.UndeleteVacancy return parameters for the expectation previously defined by the When method
+func (e *VacancyStorageMockUndeleteVacancyExpectation) Then(vp1 *domain.Vacancy, err error) *VacancyStorageMock {
+	e.results = &VacancyStorageMockUndeleteVacancyResults{vp1, err}
 	return e.mock
 }
 
-// Times sets number of times VacancyStorage.ListVacancies should be invoked
-func (mmListVacancies *mVacancyStorageMockListVacancies) Times(n uint64) *mVacancyStorageMockListVacancies {
+// Times sets number of times VacancyStorage.UndeleteVacancy should be invoked
+func (mmUndeleteVacancy *mVacancyStorageMockUndeleteVacancy) Times(n uint64) *mVacancyStorageMockUndeleteVacancy {
 	if n == 0 {
-		mmListVacancies.mock.t.Fatalf("Times of VacancyStorageMock.ListVacancies mock can not be zero")
+		mmUndeleteVacancy.mock.t.Fatalf("Times of VacancyStorageMock.UndeleteVacancy mock can not be zero")
 	}
-	mm_atomic.StoreUint64(&mmListVacancies.expectedInvocations, n)
-	mmListVacancies.expectedInvocationsOrigin = minimock.CallerInfo(1)
-	return mmListVacancies
+	mm_atomic.StoreUint64(&mmUndeleteVacancy.expectedInvocations, n)
+	mmUndeleteVacancy.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmUndeleteVacancy
 }
 
-func (mmListVacancies *mVacancyStorageMockListVacancies) invocationsDone() bool {
-	if len(mmListVacancies.expectations) == 0 && mmListVacancies.defaultExpectation == nil && mmListVacancies.mock.funcListVacancies == nil {
+func (mmUndeleteVacancy *mVacancyStorageMockUndeleteVacancy) invocationsDone() bool {
+	if len(mmUndeleteVacancy.expectations) == 0 && mmUndeleteVacancy.defaultExpectation == nil && mmUndeleteVacancy.mock.funcUndeleteVacancy == nil {
 		return true
 	}
 
-	totalInvocations := mm_atomic.LoadUint64(&mmListVacancies.mock.afterListVacanciesCounter)
-	expectedInvocations := mm_atomic.LoadUint64(&mmListVacancies.expectedInvocations)
+	totalInvocations := mm_atomic.LoadUint64(&mmUndeleteVacancy.mock.afterUndeleteVacancyCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmUndeleteVacancy.expectedInvocations)
 
 	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
 }
 
-// ListVacancies implements mm_usecase.VacancyStorage
-func (mmListVacancies *VacancyStorageMock) ListVacancies(ctx context.Context, in domain.ListVacanciesInput) (lp1 *domain.ListVacanciesResult, err error) {
-	mm_atomic.AddUint64(&mmListVacancies.beforeListVacanciesCounter, 1)
-	defer mm_atomic.AddUint64(&mmListVacancies.afterListVacanciesCounter, 1)
+// UndeleteVacancy implements mm_usecase.VacancyStorage
+func (mmUndeleteVacancy *VacancyStorageMock) UndeleteVacancy(ctx context.Context, in domain.UndeleteVacancyInput) (vp1 *domain.Vacancy, err error) {
+	mm_atomic.AddUint64(&mmUndeleteVacancy.beforeUndeleteVacancyCounter, 1)
+	defer mm_atomic.AddUint64(&mmUndeleteVacancy.afterUndeleteVacancyCounter, 1)
 
-	mmListVacancies.t.Helper()
+	mmUndeleteVacancy.t.Helper()
 
-	if mmListVacancies.inspectFuncListVacancies != nil {
-		mmListVacancies.inspectFuncListVacancies(ctx, in)
+	if mmUndeleteVacancy.inspectFuncUndeleteVacancy != nil {
+		mmUndeleteVacancy.inspectFuncUndeleteVacancy(ctx, in)
 	}
 
-	mm_params := VacancyStorageMockListVacanciesParams{ctx, in}
+	mm_params := VacancyStorageMockUndeleteVacancyParams{ctx, in}
 
 	// Record call args
-	mmListVacancies.ListVacanciesMock.mutex.Lock()
-	mmListVacancies.ListVacanciesMock.callArgs = append(mmListVacancies.ListVacanciesMock.callArgs, &mm_params)
-	mmListVacancies.ListVacanciesMock.mutex.Unlock()
+	mmUndeleteVacancy.UndeleteVacancyMock.mutex.Lock()
+	mmUndeleteVacancy.UndeleteVacancyMock.callArgs = append(mmUndeleteVacancy.UndeleteVacancyMock.callArgs, &mm_params)
+	mmUndeleteVacancy.UndeleteVacancyMock.mutex.Unlock()
 
-	for _, e := range mmListVacancies.ListVacanciesMock.expectations {
+	for _, e := range mmUndeleteVacancy.UndeleteVacancyMock.expectations {
 		if minimock.Equal(*e.params, mm_params) {
 			mm_atomic.AddUint64(&e.Counter, 1)
-			return e.results.lp1, e.results.err
+			return e.results.vp1, e.results.err
 		}
 	}
 
-	if mmListVacancies.ListVacanciesMock.defaultExpectation != nil {
-		mm_atomic.AddUint64(&mmListVacancies.ListVacanciesMock.defaultExpectation.Counter, 1)
-		mm_want := mmListVacancies.ListVacanciesMock.defaultExpectation.params
-		mm_want_ptrs := mmListVacancies.ListVacanciesMock.defaultExpectation.paramPtrs
+	if mmUndeleteVacancy.UndeleteVacancyMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmUndeleteVacancy.UndeleteVacancyMock.defaultExpectation.Counter, 1)
+		mm_want := mmUndeleteVacancy.UndeleteVacancyMock.defaultExpectation.params
+		mm_want_ptrs := mmUndeleteVacancy.UndeleteVacancyMock.defaultExpectation.paramPtrs
 
-		mm_got := VacancyStorageMockListVacanciesParams{ctx, in}
+		mm_got := VacancyStorageMockUndeleteVacancyParams{ctx, in}
 
 		if mm_want_ptrs != nil {
 
 			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
-				mmListVacancies.t.Errorf("VacancyStorageMock.ListVacancies got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
-					mmListVacancies.ListVacanciesMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+				mmUndeleteVacancy.t.Errorf("VacancyStorageMock.UndeleteVacancy got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmUndeleteVacancy.UndeleteVacancyMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
 			}
 
 			if mm_want_ptrs.in != nil && !minimock.Equal(*mm_want_ptrs.in, mm_got.in) {
-				mmListVacancies.t.Errorf("VacancyStorageMock.ListVacancies got unexpected parameter in, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
-					mmListVacancies.ListVacanciesMock.defaultExpectation.expectationOrigins.originIn, *mm_want_ptrs.in, mm_got.in, minimock.Diff(*mm_want_ptrs.in, mm_got.in))
+				mmUndeleteVacancy.t.Errorf("VacancyStorageMock.UndeleteVacancy got unexpected parameter in, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmUndeleteVacancy.UndeleteVacancyMock.defaultExpectation.expectationOrigins.originIn, *mm_want_ptrs.in, mm_got.in, minimock.Diff(*mm_want_ptrs.in, mm_got.in))
 			}
 
 		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
-			mmListVacancies.t.Errorf("VacancyStorageMock.ListVacancies got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
-				mmListVacancies.ListVacanciesMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+			mmUndeleteVacancy.t.Errorf("VacancyStorageMock.UndeleteVacancy got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmUndeleteVacancy.UndeleteVacancyMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
 		}
 
-		mm_results := mmListVacancies.ListVacanciesMock.defaultExpectation.results
+		mm_results := mmUndeleteVacancy.UndeleteVacancyMock.defaultExpectation.results
 		if mm_results == nil {
-			mmListVacancies.t.Fatal("No results are set for the VacancyStorageMock.ListVacancies")
+			mmUndeleteVacancy.t.Fatal("No results are set for the VacancyStorageMock.UndeleteVacancy")
 		}
-		return (*mm_results).lp1, (*mm_results).err
+		return (*mm_results).vp1, (*mm_results).err
 	}
-	if mmListVacancies.funcListVacancies != nil {
-		return mmListVacancies.funcListVacancies(ctx, in)
+	if mmUndeleteVacancy.funcUndeleteVacancy != nil {
+		return mmUndeleteVacancy.funcUndeleteVacancy(ctx, in)
 	}
-	mmListVacancies.t.Fatalf("Unexpected call to VacancyStorageMock.ListVacancies. %v %v", ctx, in)
+	mmUndeleteVacancy.t.Fatalf("Unexpected call to VacancyStorageMock.UndeleteVacancy. %v %v", ctx, in)
 	return
 }
 
-// ListVacanciesAfterCounter returns a count of finished VacancyStorageMock.ListVacancies invocations
-func (mmListVacancies *VacancyStorageMock) ListVacanciesAfterCounter() uint64 {
-	return mm_atomic.LoadUint64(&mmListVacancies.afterListVacanciesCounter)
+// UndeleteVacancyAfterCounter returns a count of finished VacancyStorageMock.UndeleteVacancy invocations
+func (mmUndeleteVacancy *VacancyStorageMock) UndeleteVacancyAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmUndeleteVacancy.afterUndeleteVacancyCounter)
 }
 
-// ListVacanciesBeforeCounter returns a count of VacancyStorageMock.ListVacancies invocations
-func (mmListVacancies *VacancyStorageMock) ListVacanciesBeforeCounter() uint64 {
-	return mm_atomic.LoadUint64(&mmListVacancies.beforeListVacanciesCounter)
+// UndeleteVacancyBeforeCounter returns a count of VacancyStorageMock.UndeleteVacancy invocations
+func (mmUndeleteVacancy *VacancyStorageMock) UndeleteVacancyBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmUndeleteVacancy.beforeUndeleteVacancyCounter)
 }
 
-// Calls returns a list of arguments used in each call to VacancyStorageMock.ListVacancies.
+// Calls returns a list of arguments used in each call to VacancyStorageMock.UndeleteVacancy.
 // The list is in the same order as the calls were made (i.e. recent calls have a higher index)
-func (mmListVacancies *mVacancyStorageMockListVacancies) Calls() []*VacancyStorageMockListVacanciesParams {
-	mmListVacancies.mutex.RLock()
+func (mmUndeleteVacancy *mVacancyStorageMockUndeleteVacancy) Calls() []*VacancyStorageMockUndeleteVacancyParams {
+	mmUndeleteVacancy.mutex.RLock()
 
-	argCopy := make([]*VacancyStorageMockListVacanciesParams, len(mmListVacancies.callArgs))
-	copy(argCopy, mmListVacancies.callArgs)
+	argCopy := make([]*VacancyStorageMockUndeleteVacancyParams, len(mmUndeleteVacancy.callArgs))
+	copy(argCopy, mmUndeleteVacancy.callArgs)
 
-	mmListVacancies.mutex.RUnlock()
+	mmUndeleteVacancy.mutex.RUnlock()
 
 	return argCopy
 }
 
-// MinimockListVacanciesDone returns true if the count of the ListVacancies invocations corresponds
+// MinimockUndeleteVacancyDone returns true if the count of the UndeleteVacancy invocations corresponds
 // the number of defined expectations
-func (m *VacancyStorageMock) MinimockListVacanciesDone() bool {
-	if m.ListVacanciesMock.optional {
+func (m *VacancyStorageMock) MinimockUndeleteVacancyDone() bool {
+	if m.UndeleteVacancyMock.optional {
 		// Optional methods provide '0 or more' call count restriction.
 		return true
 	}
 
-	for _, e := range m.ListVacanciesMock.expectations {
+	for _, e := range m.UndeleteVacancyMock.expectations {
 		if mm_atomic.LoadUint64(&e.Counter) < 1 {
 			return false
 		}
 	}
 
-	return m.ListVacanciesMock.invocationsDone()
+	return m.UndeleteVacancyMock.invocationsDone()
 }
 
-// MinimockListVacanciesInspect logs each unmet expectation
-func (m *VacancyStorageMock) MinimockListVacanciesInspect() {
-	for _, e := range m.ListVacanciesMock.expectations {
+// MinimockUndeleteVacancyInspect logs each unmet expectation
+func (m *VacancyStorageMock) MinimockUndeleteVacancyInspect() {
+	for _, e := range m.UndeleteVacancyMock.expectations {
 		if mm_atomic.LoadUint64(&e.Counter) < 1 {
-			m.t.Errorf("Expected call to VacancyStorageMock.ListVacancies at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+			m.t.Errorf("Expected call to VacancyStorageMock.UndeleteVacancy at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
 		}
 	}
 
-	afterListVacanciesCounter := mm_atomic.LoadUint64(&m.afterListVacanciesCounter)
+	afterUndeleteVacancyCounter := mm_atomic.LoadUint64(&m.afterUndeleteVacancyCounter)
 	// if default expectation was set then invocations count should be greater than zero
-	if m.ListVacanciesMock.defaultExpectation != nil && afterListVacanciesCounter < 1 {
-		if m.ListVacanciesMock.defaultExpectation.params == nil {
-			m.t.Errorf("Expected call to VacancyStorageMock.ListVacancies at\n%s", m.ListVacanciesMock.defaultExpectation.returnOrigin)
+	if m.UndeleteVacancyMock.defaultExpectation != nil && afterUndeleteVacancyCounter < 1 {
+		if m.UndeleteVacancyMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to VacancyStorageMock.UndeleteVacancy at\n%s", m.UndeleteVacancyMock.defaultExpectation.returnOrigin)
 		} else {
-			m.t.Errorf("Expected call to VacancyStorageMock.ListVacancies at\n%s with params: %#v", m.ListVacanciesMock.defaultExpectation.expectationOrigins.origin, *m.ListVacanciesMock.defaultExpectation.params)
+			m.t.Errorf("Expected call to VacancyStorageMock.UndeleteVacancy at\n%s with params: %#v", m.UndeleteVacancyMock.defaultExpectation.expectationOrigins.origin, *m.UndeleteVacancyMock.defaultExpectation.params)
 		}
 	}
 	// if func was set then invocations count should be greater than zero
-	if m.funcListVacancies != nil && afterListVacanciesCounter < 1 {
-		m.t.Errorf("Expected call to VacancyStorageMock.ListVacancies at\n%s", m.funcListVacanciesOrigin)
+	if m.funcUndeleteVacancy != nil && afterUndeleteVacancyCounter < 1 {
+		m.t.Errorf("Expected call to VacancyStorageMock.UndeleteVacancy at\n%s", m.funcUndeleteVacancyOrigin)
 	}
 
-	if !m.ListVacanciesMock.invocationsDone() && afterListVacanciesCounter > 0 {
-		m.t.Errorf("Expected %d calls to VacancyStorageMock.ListVacancies at\n%s but found %d calls",
-			mm_atomic.LoadUint64(&m.ListVacanciesMock.expectedInvocations), m.ListVacanciesMock.expectedInvocationsOrigin, afterListVacanciesCounter)
+	if !m.UndeleteVacancyMock.invocationsDone() && afterUndeleteVacancyCounter > 0 {
+		m.t.Errorf("Expected %d calls to VacancyStorageMock.UndeleteVacancy at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.UndeleteVacancyMock.expectedInvocations), m.UndeleteVacancyMock.expectedInvocationsOrigin, afterUndeleteVacancyCounter)
 	}
 }
 
@@ -1861,14 +11963,70 @@ func (m *VacancyStorageMock) MinimockUpdateVacancyInspect() {
 func (m *VacancyStorageMock) MinimockFinish() {
 	m.finishOnce.Do(func() {
 		if !m.minimockDone() {
-			m.MinimockArchiveVacancyInspect()
+			m.MinimockAddCollaboratorInspect()
+
+			m.MinimockCanEditVacancyInspect()
+
+			m.MinimockChangeCandidateStageInspect()
+
+			m.MinimockChangeVacancyStatusInspect()
+
+			m.MinimockCountCandidatesByStageInspect()
+
+			m.MinimockCreateTemplateInspect()
+
+			m.MinimockCreateVacanciesInspect()
 
 			m.MinimockCreateVacancyInspect()
 
+			m.MinimockDeleteTemplateInspect()
+
+			m.MinimockDeleteVacancyInspect()
+
+			m.MinimockGetCandidateStageInspect()
+
+			m.MinimockGetPipelineStagesInspect()
+
+			m.MinimockGetPublicVacancyInspect()
+
+			m.MinimockGetTemplateInspect()
+
 			m.MinimockGetVacancyInspect()
 
+			m.MinimockGetVacancyVersionInspect()
+
+			m.MinimockListCandidateStageHistoryInspect()
+
+			m.MinimockListCollaboratorsInspect()
+
+			m.MinimockListDuplicateCandidatesInspect()
+
+			m.MinimockListPublicVacanciesInspect()
+
+			m.MinimockListRoleClassificationTargetsInspect()
+
+			m.MinimockListTemplatesInspect()
+
 			m.MinimockListVacanciesInspect()
 
+			m.MinimockListVacancyStatusHistoryInspect()
+
+			m.MinimockListVacancyVersionsInspect()
+
+			m.MinimockRemoveCollaboratorInspect()
+
+			m.MinimockSetPipelineStagesInspect()
+
+			m.MinimockSetVacancyRoleInspect()
+
+			m.MinimockSetVacancyVisibilityInspect()
+
+			m.MinimockStatusBeforeArchiveInspect()
+
+			m.MinimockTransferVacancyOwnershipInspect()
+
+			m.MinimockUndeleteVacancyInspect()
+
 			m.MinimockUpdateVacancyInspect()
 		}
 	})
@@ -1893,9 +12051,37 @@ func (m *VacancyStorageMock) MinimockWait(timeout mm_time.Duration) {
 func (m *VacancyStorageMock) minimockDone() bool {
 	done := true
 	return done &&
-		m.MinimockArchiveVacancyDone() &&
+		m.MinimockAddCollaboratorDone() &&
+		m.MinimockCanEditVacancyDone() &&
+		m.MinimockChangeCandidateStageDone() &&
+		m.MinimockChangeVacancyStatusDone() &&
+		m.MinimockCountCandidatesByStageDone() &&
+		m.MinimockCreateTemplateDone() &&
+		m.MinimockCreateVacanciesDone() &&
 		m.MinimockCreateVacancyDone() &&
+		m.MinimockDeleteTemplateDone() &&
+		m.MinimockDeleteVacancyDone() &&
+		m.MinimockGetCandidateStageDone() &&
+		m.MinimockGetPipelineStagesDone() &&
+		m.MinimockGetPublicVacancyDone() &&
+		m.MinimockGetTemplateDone() &&
 		m.MinimockGetVacancyDone() &&
+		m.MinimockGetVacancyVersionDone() &&
+		m.MinimockListCandidateStageHistoryDone() &&
+		m.MinimockListCollaboratorsDone() &&
+		m.MinimockListDuplicateCandidatesDone() &&
+		m.MinimockListPublicVacanciesDone() &&
+		m.MinimockListRoleClassificationTargetsDone() &&
+		m.MinimockListTemplatesDone() &&
 		m.MinimockListVacanciesDone() &&
+		m.MinimockListVacancyStatusHistoryDone() &&
+		m.MinimockListVacancyVersionsDone() &&
+		m.MinimockRemoveCollaboratorDone() &&
+		m.MinimockSetPipelineStagesDone() &&
+		m.MinimockSetVacancyRoleDone() &&
+		m.MinimockSetVacancyVisibilityDone() &&
+		m.MinimockStatusBeforeArchiveDone() &&
+		m.MinimockTransferVacancyOwnershipDone() &&
+		m.MinimockUndeleteVacancyDone() &&
 		m.MinimockUpdateVacancyDone()
 }
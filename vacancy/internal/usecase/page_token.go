@@ -0,0 +1,62 @@
+package usecase
+
+import (
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/binary"
+	"encoding/json"
+	"time"
+
+	"github.com/artem13815/hr/vacancy/internal/domain"
+)
+
+// pageToken is the JSON inside a ListVacancies page token. Query holds a
+// fingerprint of the search string: ranks are only comparable under the
+// same query, so a token cannot be replayed against a different one.
+type pageToken struct {
+	Rank      float32 `json:"r,omitempty"`
+	CreatedAt int64   `json:"c"`
+	ID        string  `json:"i"`
+	Query     uint64  `json:"q,omitempty"`
+}
+
+// encodePageToken makes the opaque next-page token for cursor c. Base64url
+// keeps it safe to pass as a query parameter.
+func encodePageToken(c domain.VacancyCursor, query string) string {
+	raw, _ := json.Marshal(pageToken{
+		Rank:      c.Rank,
+		CreatedAt: c.CreatedAt.UnixMicro(),
+		ID:        c.ID,
+		Query:     queryFingerprint(query),
+	})
+	return base64.RawURLEncoding.EncodeToString(raw)
+}
+
+// decodePageToken is the inverse of encodePageToken. Malformed tokens and
+// tokens minted for another query are ErrInvalidArgument.
+func decodePageToken(token, query string) (*domain.VacancyCursor, error) {
+	raw, err := base64.RawURLEncoding.DecodeString(token)
+	if err != nil {
+		return nil, ErrInvalidArgument
+	}
+	var t pageToken
+	if err := json.Unmarshal(raw, &t); err != nil || t.ID == "" {
+		return nil, ErrInvalidArgument
+	}
+	if t.Query != queryFingerprint(query) {
+		return nil, ErrInvalidArgument
+	}
+	return &domain.VacancyCursor{
+		Rank:      t.Rank,
+		CreatedAt: time.UnixMicro(t.CreatedAt).UTC(),
+		ID:        t.ID,
+	}, nil
+}
+
+func queryFingerprint(query string) uint64 {
+	if query == "" {
+		return 0
+	}
+	sum := sha256.Sum256([]byte(query))
+	return binary.BigEndian.Uint64(sum[:8])
+}
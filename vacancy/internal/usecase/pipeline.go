@@ -0,0 +1,217 @@
+package usecase
+
+import (
+	"cmp"
+	"context"
+	"regexp"
+	"strings"
+	"unicode/utf8"
+
+	"github.com/artem13815/hr/vacancy/internal/domain"
+)
+
+const (
+	maxPipelineStages = 20
+	maxStageNameRunes = 64
+)
+
+// stageKeyRe is the shape of a stage key: it ends up in URLs, filters and
+// analytics, so it stays a short lowercase identifier.
+var stageKeyRe = regexp.MustCompile(`^[a-z][a-z0-9_]{0,31}$`)
+
+// GetVacancyPipeline returns the pipeline with per-stage candidate counts.
+// Readable by everyone who can see the vacancy.
+func (s *VacancyService) GetVacancyPipeline(ctx context.Context, in domain.GetVacancyPipelineInput) (*domain.VacancyPipeline, error) {
+	if in.OwnerUserID == 0 || in.VacancyID == "" {
+		return nil, ErrInvalidArgument
+	}
+
+	vacancy, err := s.storage.GetVacancy(ctx, in.VacancyID, in.OwnerUserID, in.IsAdmin)
+	if err != nil {
+		return nil, err
+	}
+	if vacancy == nil {
+		return nil, ErrVacancyNotFound
+	}
+
+	stages, err := s.storage.GetPipelineStages(ctx, in.VacancyID)
+	if err != nil {
+		return nil, err
+	}
+	if stages == nil {
+		return nil, ErrVacancyNotFound
+	}
+	counts, err := s.storage.CountCandidatesByStage(ctx, in.VacancyID)
+	if err != nil {
+		return nil, err
+	}
+
+	return &domain.VacancyPipeline{
+		VacancyID: in.VacancyID,
+		Stages:    stages,
+		Headcount: vacancy.Attributes.Headcount,
+		Hired:     counts[domain.StageHired],
+		Counts:    counts,
+	}, nil
+}
+
+// SetPipelineStages replaces the vacancy's active stages; an empty list
+// restores the default template. Dropping a stage that candidates are in is
+// refused with ErrStageInUse — move them first.
+func (s *VacancyService) SetPipelineStages(ctx context.Context, in domain.SetPipelineStagesInput) (*domain.VacancyPipeline, error) {
+	if in.OwnerUserID == 0 || in.VacancyID == "" {
+		return nil, ErrInvalidArgument
+	}
+	stages, err := normalizeStages(in.Stages)
+	if err != nil {
+		return nil, err
+	}
+	in.Stages = stages
+
+	if _, err := s.editableVacancy(ctx, in.VacancyID, in.OwnerUserID, in.IsAdmin); err != nil {
+		return nil, err
+	}
+	ok, err := s.storage.SetPipelineStages(ctx, in)
+	if err != nil {
+		return nil, err
+	}
+	if !ok {
+		return nil, ErrVacancyNotFound
+	}
+
+	return s.GetVacancyPipeline(ctx, domain.GetVacancyPipelineInput{
+		VacancyID:   in.VacancyID,
+		OwnerUserID: in.OwnerUserID,
+		IsAdmin:     in.IsAdmin,
+	})
+}
+
+// normalizeStages lowercases keys, defaults blank names to the key and
+// rejects duplicates, terminal keys and malformed input.
+func normalizeStages(in []domain.PipelineStage) ([]domain.PipelineStage, error) {
+	if len(in) == 0 {
+		return domain.DefaultPipelineStages(), nil
+	}
+	if len(in) > maxPipelineStages {
+		return nil, ErrInvalidArgument
+	}
+
+	out := make([]domain.PipelineStage, 0, len(in))
+	seen := make(map[string]bool, len(in))
+	for _, st := range in {
+		key := strings.ToLower(strings.TrimSpace(st.Key))
+		if !stageKeyRe.MatchString(key) || domain.IsTerminalStage(key) || seen[key] {
+			return nil, ErrInvalidArgument
+		}
+		seen[key] = true
+
+		name := cmp.Or(strings.TrimSpace(st.Name), key)
+		if utf8.RuneCountInString(name) > maxStageNameRunes {
+			return nil, ErrInvalidArgument
+		}
+		out = append(out, domain.PipelineStage{Key: key, Name: name})
+	}
+	return out, nil
+}
+
+// MoveCandidateStage moves a candidate along the vacancy's pipeline. Any
+// stage can follow any other, except that hired is final and a hire needs an
+// open or paused vacancy. Rejecting requires a reason. The hire that brings
+// the hire count to the vacancy's headcount also moves the vacancy to filled.
+func (s *VacancyService) MoveCandidateStage(ctx context.Context, in domain.MoveCandidateStageInput) (*domain.MoveCandidateStageResult, error) {
+	if in.OwnerUserID == 0 || in.VacancyID == "" || in.CandidateID == "" {
+		return nil, ErrInvalidArgument
+	}
+	in.ToStage = strings.ToLower(strings.TrimSpace(in.ToStage))
+	in.Reason = strings.TrimSpace(in.Reason)
+	if in.ToStage == "" || utf8.RuneCountInString(in.Reason) > maxReasonRunes {
+		return nil, ErrInvalidArgument
+	}
+	if in.ToStage == domain.StageRejected && in.Reason == "" {
+		return nil, ErrInvalidArgument
+	}
+
+	vacancy, err := s.editableVacancy(ctx, in.VacancyID, in.OwnerUserID, in.IsAdmin)
+	if err != nil {
+		return nil, err
+	}
+	if vacancy.Status == domain.StatusArchived {
+		return nil, ErrInvalidStageTransition
+	}
+	if in.ToStage == domain.StageHired && vacancy.Status != domain.StatusOpen && vacancy.Status != domain.StatusPaused {
+		return nil, ErrInvalidStageTransition
+	}
+
+	stages, err := s.storage.GetPipelineStages(ctx, in.VacancyID)
+	if err != nil {
+		return nil, err
+	}
+	if !domain.HasPipelineStage(stages, in.ToStage) {
+		return nil, ErrInvalidArgument
+	}
+
+	from, err := s.storage.GetCandidateStage(ctx, in.VacancyID, in.CandidateID)
+	if err != nil {
+		return nil, err
+	}
+	if from == "" {
+		return nil, ErrCandidateNotFound
+	}
+	if from == in.ToStage || from == domain.StageHired {
+		return nil, ErrInvalidStageTransition
+	}
+
+	// The checks above fail fast on a stale read; storage repeats the
+	// status ones under the row lock and fills the vacancy in the same
+	// transaction. It returns nil when the vacancy status changed, the
+	// candidate moved or the stage vanished between our reads and its
+	// locked write; like changeStatus, that is reported as invalid rather
+	// than retried.
+	res, err := s.storage.ChangeCandidateStage(ctx, domain.ChangeCandidateStageInput{
+		VacancyID:   in.VacancyID,
+		CandidateID: in.CandidateID,
+		OwnerUserID: in.OwnerUserID,
+		IsAdmin:     in.IsAdmin,
+		FromStage:   from,
+		ToStage:     in.ToStage,
+		Reason:      in.Reason,
+	})
+	if err != nil {
+		return nil, err
+	}
+	if res == nil {
+		return nil, ErrInvalidStageTransition
+	}
+
+	return &domain.MoveCandidateStageResult{
+		Change:        res.Change,
+		VacancyStatus: res.VacancyStatus,
+		VacancyFilled: res.VacancyFilled,
+	}, nil
+}
+
+// ListCandidateStageHistory returns a candidate's stage moves, newest first.
+// Readable by everyone who can see the vacancy.
+func (s *VacancyService) ListCandidateStageHistory(ctx context.Context, in domain.ListCandidateStageHistoryInput) ([]domain.CandidateStageChange, error) {
+	if in.OwnerUserID == 0 || in.VacancyID == "" || in.CandidateID == "" {
+		return nil, ErrInvalidArgument
+	}
+
+	vacancy, err := s.storage.GetVacancy(ctx, in.VacancyID, in.OwnerUserID, in.IsAdmin)
+	if err != nil {
+		return nil, err
+	}
+	if vacancy == nil {
+		return nil, ErrVacancyNotFound
+	}
+
+	stage, err := s.storage.GetCandidateStage(ctx, in.VacancyID, in.CandidateID)
+	if err != nil {
+		return nil, err
+	}
+	if stage == "" {
+		return nil, ErrCandidateNotFound
+	}
+
+	return s.storage.ListCandidateStageHistory(ctx, in.VacancyID, in.CandidateID)
+}
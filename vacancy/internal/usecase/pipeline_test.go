@@ -0,0 +1,286 @@
+package usecase
+
+import (
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+	"gotest.tools/v3/assert"
+
+	"github.com/artem13815/hr/vacancy/internal/domain"
+)
+
+type PipelineSuite struct{ baseSuite }
+
+func openVacancy(headcount uint32) *domain.Vacancy {
+	return &domain.Vacancy{ID: "v-1", OwnerUserID: 1, Status: domain.StatusOpen, Attributes: domain.VacancyAttributes{Headcount: headcount}}
+}
+
+func (s *PipelineSuite) TestGetPipeline() {
+	t := s.T()
+	ctx := t.Context()
+	counts := map[string]uint32{"screening": 4, domain.StageHired: 1}
+
+	s.storage.GetVacancyMock.Expect(ctx, "v-1", uint64(1), false).Return(openVacancy(2), nil)
+	s.storage.GetPipelineStagesMock.Expect(ctx, "v-1").Return(domain.DefaultPipelineStages(), nil)
+	s.storage.CountCandidatesByStageMock.Expect(ctx, "v-1").Return(counts, nil)
+
+	got, err := s.svc.GetVacancyPipeline(ctx, domain.GetVacancyPipelineInput{VacancyID: "v-1", OwnerUserID: 1})
+	assert.NilError(t, err)
+	assert.DeepEqual(t, got, &domain.VacancyPipeline{
+		VacancyID: "v-1",
+		Stages:    domain.DefaultPipelineStages(),
+		Headcount: 2,
+		Hired:     1,
+		Counts:    counts,
+	})
+}
+
+func (s *PipelineSuite) TestGetPipelineNotFound() {
+	t := s.T()
+	ctx := t.Context()
+
+	s.storage.GetVacancyMock.Return(nil, nil)
+
+	_, err := s.svc.GetVacancyPipeline(ctx, domain.GetVacancyPipelineInput{VacancyID: "v-1", OwnerUserID: 1})
+	assert.ErrorIs(t, err, ErrVacancyNotFound)
+}
+
+func (s *PipelineSuite) TestSetStagesNormalizes() {
+	t := s.T()
+	ctx := t.Context()
+	want := []domain.PipelineStage{{Key: "phone_screen", Name: "Phone screen"}, {Key: "onsite", Name: "onsite"}}
+
+	s.storage.SetPipelineStagesMock.Expect(ctx, domain.SetPipelineStagesInput{VacancyID: "v-1", OwnerUserID: 1, Stages: want}).Return(true, nil)
+	s.storage.GetVacancyMock.Return(openVacancy(1), nil)
+	s.storage.GetPipelineStagesMock.Return(want, nil)
+	s.storage.CountCandidatesByStageMock.Return(map[string]uint32{}, nil)
+
+	got, err := s.svc.SetPipelineStages(ctx, domain.SetPipelineStagesInput{
+		VacancyID:   "v-1",
+		OwnerUserID: 1,
+		Stages:      []domain.PipelineStage{{Key: " Phone_Screen ", Name: " Phone screen "}, {Key: "onsite"}},
+	})
+	assert.NilError(t, err)
+	assert.DeepEqual(t, got.Stages, want)
+}
+
+func (s *PipelineSuite) TestSetStagesEmptyRestoresDefault() {
+	t := s.T()
+	ctx := t.Context()
+
+	s.storage.SetPipelineStagesMock.Expect(ctx, domain.SetPipelineStagesInput{VacancyID: "v-1", OwnerUserID: 1, Stages: domain.DefaultPipelineStages()}).Return(true, nil)
+	s.storage.GetVacancyMock.Return(openVacancy(1), nil)
+	s.storage.GetPipelineStagesMock.Return(domain.DefaultPipelineStages(), nil)
+	s.storage.CountCandidatesByStageMock.Return(map[string]uint32{}, nil)
+
+	_, err := s.svc.SetPipelineStages(ctx, domain.SetPipelineStagesInput{VacancyID: "v-1", OwnerUserID: 1})
+	assert.NilError(t, err)
+}
+
+func (s *PipelineSuite) TestSetStagesInvalid() {
+	t := s.T()
+	tooMany := make([]domain.PipelineStage, maxPipelineStages+1)
+	for i := range tooMany {
+		tooMany[i] = domain.PipelineStage{Key: "s" + strings.Repeat("x", i)}
+	}
+	cases := [][]domain.PipelineStage{
+		{{Key: "hired"}},
+		{{Key: "rejected"}},
+		{{Key: "a"}, {Key: "A"}},
+		{{Key: "1st"}},
+		{{Key: "two words"}},
+		{{Key: "ok", Name: strings.Repeat("я", maxStageNameRunes+1)}},
+		tooMany,
+	}
+	for _, stages := range cases {
+		_, err := s.svc.SetPipelineStages(t.Context(), domain.SetPipelineStagesInput{VacancyID: "v-1", OwnerUserID: 1, Stages: stages})
+		assert.ErrorIs(t, err, ErrInvalidArgument, "%v", stages)
+	}
+}
+
+func (s *PipelineSuite) TestSetStagesInUse() {
+	t := s.T()
+	s.storage.GetVacancyMock.Return(openVacancy(1), nil)
+
+	s.storage.SetPipelineStagesMock.Return(false, domain.ErrStageInUse)
+
+	_, err := s.svc.SetPipelineStages(t.Context(), domain.SetPipelineStagesInput{VacancyID: "v-1", OwnerUserID: 1, Stages: []domain.PipelineStage{{Key: "a"}}})
+	assert.ErrorIs(t, err, ErrStageInUse)
+}
+
+func (s *PipelineSuite) TestSetStagesNotEditable() {
+	t := s.T()
+	s.storage.GetVacancyMock.Return(openVacancy(1), nil)
+
+	s.storage.SetPipelineStagesMock.Return(false, nil)
+
+	_, err := s.svc.SetPipelineStages(t.Context(), domain.SetPipelineStagesInput{VacancyID: "v-1", OwnerUserID: 1})
+	assert.ErrorIs(t, err, ErrVacancyNotFound)
+}
+
+func (s *PipelineSuite) TestMove() {
+	t := s.T()
+	ctx := t.Context()
+	change := domain.CandidateStageChange{ID: 5, VacancyID: "v-1", CandidateID: "c-1", FromStage: "screening", ToStage: "interview"}
+
+	s.storage.GetVacancyMock.Expect(ctx, "v-1", uint64(1), false).Return(openVacancy(1), nil)
+	s.storage.GetPipelineStagesMock.Return(domain.DefaultPipelineStages(), nil)
+	s.storage.GetCandidateStageMock.Expect(ctx, "v-1", "c-1").Return("screening", nil)
+	s.storage.ChangeCandidateStageMock.Expect(ctx, domain.ChangeCandidateStageInput{
+		VacancyID:   "v-1",
+		CandidateID: "c-1",
+		OwnerUserID: 1,
+		FromStage:   "screening",
+		ToStage:     "interview",
+	}).Return(&domain.ChangeCandidateStageResult{Change: change, VacancyStatus: domain.StatusOpen}, nil)
+
+	got, err := s.svc.MoveCandidateStage(ctx, domain.MoveCandidateStageInput{VacancyID: "v-1", CandidateID: "c-1", OwnerUserID: 1, ToStage: " Interview "})
+	assert.NilError(t, err)
+	assert.DeepEqual(t, got, &domain.MoveCandidateStageResult{Change: change, VacancyStatus: domain.StatusOpen})
+}
+
+func (s *PipelineSuite) TestHireReachingHeadcountFillsVacancy() {
+	t := s.T()
+	ctx := t.Context()
+
+	s.storage.GetVacancyMock.Return(openVacancy(2), nil)
+	s.storage.GetPipelineStagesMock.Return(domain.DefaultPipelineStages(), nil)
+	s.storage.GetCandidateStageMock.Return("offer", nil)
+	s.storage.ChangeCandidateStageMock.Return(&domain.ChangeCandidateStageResult{Hired: 2, VacancyStatus: domain.StatusFilled, VacancyFilled: true}, nil)
+
+	got, err := s.svc.MoveCandidateStage(ctx, domain.MoveCandidateStageInput{VacancyID: "v-1", CandidateID: "c-1", OwnerUserID: 1, ToStage: domain.StageHired})
+	assert.NilError(t, err)
+	assert.Equal(t, got.VacancyStatus, domain.StatusFilled)
+	assert.Assert(t, got.VacancyFilled)
+}
+
+func (s *PipelineSuite) TestHireBelowHeadcountKeepsVacancyOpen() {
+	t := s.T()
+	ctx := t.Context()
+
+	s.storage.GetVacancyMock.Return(openVacancy(3), nil)
+	s.storage.GetPipelineStagesMock.Return(domain.DefaultPipelineStages(), nil)
+	s.storage.GetCandidateStageMock.Return("offer", nil)
+	s.storage.ChangeCandidateStageMock.Return(&domain.ChangeCandidateStageResult{Hired: 2, VacancyStatus: domain.StatusOpen}, nil)
+
+	got, err := s.svc.MoveCandidateStage(ctx, domain.MoveCandidateStageInput{VacancyID: "v-1", CandidateID: "c-1", OwnerUserID: 1, ToStage: domain.StageHired})
+	assert.NilError(t, err)
+	assert.Equal(t, got.VacancyStatus, domain.StatusOpen)
+	assert.Assert(t, !got.VacancyFilled)
+}
+
+// TestHireStorageErrorFails: the hire and the auto-fill are one
+// transaction, so a storage failure fails the move as a whole.
+func (s *PipelineSuite) TestHireStorageErrorFails() {
+	t := s.T()
+	ctx := t.Context()
+
+	s.storage.GetVacancyMock.Return(openVacancy(1), nil)
+	s.storage.GetPipelineStagesMock.Return(domain.DefaultPipelineStages(), nil)
+	s.storage.GetCandidateStageMock.Return("offer", nil)
+	s.storage.ChangeCandidateStageMock.Return(nil, errors.New("db down"))
+
+	got, err := s.svc.MoveCandidateStage(ctx, domain.MoveCandidateStageInput{VacancyID: "v-1", CandidateID: "c-1", OwnerUserID: 1, ToStage: domain.StageHired})
+	assert.ErrorContains(t, err, "db down")
+	assert.Assert(t, got == nil)
+}
+
+func (s *PipelineSuite) TestHireNeedsOpenVacancy() {
+	t := s.T()
+
+	s.storage.GetVacancyMock.Return(&domain.Vacancy{ID: "v-1", OwnerUserID: 1, Status: domain.StatusClosed}, nil)
+
+	_, err := s.svc.MoveCandidateStage(t.Context(), domain.MoveCandidateStageInput{VacancyID: "v-1", CandidateID: "c-1", OwnerUserID: 1, ToStage: domain.StageHired})
+	assert.ErrorIs(t, err, ErrInvalidStageTransition)
+}
+
+func (s *PipelineSuite) TestRejectNeedsReason() {
+	t := s.T()
+
+	_, err := s.svc.MoveCandidateStage(t.Context(), domain.MoveCandidateStageInput{VacancyID: "v-1", CandidateID: "c-1", OwnerUserID: 1, ToStage: domain.StageRejected, Reason: "  "})
+	assert.ErrorIs(t, err, ErrInvalidArgument)
+}
+
+func (s *PipelineSuite) TestUnknownStage() {
+	t := s.T()
+
+	s.storage.GetVacancyMock.Return(openVacancy(1), nil)
+	s.storage.GetPipelineStagesMock.Return(domain.DefaultPipelineStages(), nil)
+
+	_, err := s.svc.MoveCandidateStage(t.Context(), domain.MoveCandidateStageInput{VacancyID: "v-1", CandidateID: "c-1", OwnerUserID: 1, ToStage: "onsite"})
+	assert.ErrorIs(t, err, ErrInvalidArgument)
+}
+
+func (s *PipelineSuite) TestCandidateNotInVacancy() {
+	t := s.T()
+
+	s.storage.GetVacancyMock.Return(openVacancy(1), nil)
+	s.storage.GetPipelineStagesMock.Return(domain.DefaultPipelineStages(), nil)
+	s.storage.GetCandidateStageMock.Return("", nil)
+
+	_, err := s.svc.MoveCandidateStage(t.Context(), domain.MoveCandidateStageInput{VacancyID: "v-1", CandidateID: "c-9", OwnerUserID: 1, ToStage: "offer"})
+	assert.ErrorIs(t, err, ErrCandidateNotFound)
+}
+
+func (s *PipelineSuite) TestHiredIsFinal() {
+	t := s.T()
+
+	s.storage.GetVacancyMock.Return(openVacancy(1), nil)
+	s.storage.GetPipelineStagesMock.Return(domain.DefaultPipelineStages(), nil)
+	s.storage.GetCandidateStageMock.Return(domain.StageHired, nil)
+
+	_, err := s.svc.MoveCandidateStage(t.Context(), domain.MoveCandidateStageInput{VacancyID: "v-1", CandidateID: "c-1", OwnerUserID: 1, ToStage: domain.StageRejected, Reason: "changed mind"})
+	assert.ErrorIs(t, err, ErrInvalidStageTransition)
+}
+
+// TestConcurrentMove: the candidate left its stage between the read and the
+// locked write.
+func (s *PipelineSuite) TestConcurrentMove() {
+	t := s.T()
+
+	s.storage.GetVacancyMock.Return(openVacancy(1), nil)
+	s.storage.GetPipelineStagesMock.Return(domain.DefaultPipelineStages(), nil)
+	s.storage.GetCandidateStageMock.Return("screening", nil)
+	s.storage.ChangeCandidateStageMock.Return(nil, nil)
+
+	_, err := s.svc.MoveCandidateStage(t.Context(), domain.MoveCandidateStageInput{VacancyID: "v-1", CandidateID: "c-1", OwnerUserID: 1, ToStage: "offer"})
+	assert.ErrorIs(t, err, ErrInvalidStageTransition)
+}
+
+func (s *PipelineSuite) TestHistory() {
+	t := s.T()
+	ctx := t.Context()
+	want := []domain.CandidateStageChange{{ID: 2, ToStage: "interview"}, {ID: 1, ToStage: "screening"}}
+
+	s.storage.GetVacancyMock.Return(openVacancy(1), nil)
+	s.storage.GetCandidateStageMock.Return("interview", nil)
+	s.storage.ListCandidateStageHistoryMock.Expect(ctx, "v-1", "c-1").Return(want, nil)
+
+	got, err := s.svc.ListCandidateStageHistory(ctx, domain.ListCandidateStageHistoryInput{VacancyID: "v-1", CandidateID: "c-1", OwnerUserID: 1})
+	assert.NilError(t, err)
+	assert.DeepEqual(t, got, want)
+}
+
+func (s *PipelineSuite) TestSetStagesViewerForbidden() {
+	t := s.T()
+	ctx := t.Context()
+	s.storage.GetVacancyMock.Return(openVacancy(1), nil)
+	s.storage.CanEditVacancyMock.Expect(ctx, "v-1", uint64(8)).Return(false, nil)
+
+	_, err := s.svc.SetPipelineStages(ctx, domain.SetPipelineStagesInput{VacancyID: "v-1", OwnerUserID: 8})
+	assert.ErrorIs(t, err, ErrForbidden)
+}
+
+func (s *PipelineSuite) TestMoveViewerForbidden() {
+	t := s.T()
+	ctx := t.Context()
+	s.storage.GetVacancyMock.Return(&domain.Vacancy{ID: "v-1", OwnerUserID: 1, Status: domain.StatusClosed}, nil)
+	s.storage.CanEditVacancyMock.Expect(ctx, "v-1", uint64(8)).Return(false, nil)
+
+	_, err := s.svc.MoveCandidateStage(ctx, domain.MoveCandidateStageInput{VacancyID: "v-1", CandidateID: "c-1", OwnerUserID: 8, ToStage: domain.StageHired})
+	assert.ErrorIs(t, err, ErrForbidden)
+}
+
+func TestPipelineSuite(t *testing.T) { suite.Run(t, new(PipelineSuite)) }
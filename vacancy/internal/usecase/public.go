@@ -0,0 +1,40 @@
+package usecase
+
+import (
+	"context"
+
+	"github.com/artem13815/hr/vacancy/internal/domain"
+)
+
+// maxFeedVacancies caps one feed document. Job boards re-fetch the whole
+// feed on every poll, so it has to stay small enough to render and cache
+// in one go.
+const maxFeedVacancies = 1000
+
+// GetPublicVacancy backs the unauthenticated JobPosting page. Anything that
+// is not published — missing, private or not open — is ErrVacancyNotFound,
+// so the endpoint does not reveal which private ids exist.
+func (s *VacancyService) GetPublicVacancy(ctx context.Context, vacancyID string) (*domain.Vacancy, error) {
+	if vacancyID == "" {
+		return nil, ErrVacancyNotFound
+	}
+
+	vacancy, err := s.storage.GetPublicVacancy(ctx, vacancyID)
+	if err != nil {
+		return nil, err
+	}
+	if vacancy == nil {
+		return nil, ErrVacancyNotFound
+	}
+	return vacancy, nil
+}
+
+// ListPublicVacancies backs the unauthenticated XML feed: published
+// vacancies of one owner, or of the whole organisation when ownerUserID is
+// 0. An owner with nothing published gets an empty feed, not an error.
+func (s *VacancyService) ListPublicVacancies(ctx context.Context, ownerUserID uint64) ([]domain.Vacancy, error) {
+	return s.storage.ListPublicVacancies(ctx, domain.ListPublicVacanciesInput{
+		OwnerUserID: ownerUserID,
+		Limit:       maxFeedVacancies,
+	})
+}
@@ -0,0 +1,69 @@
+package usecase
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+	"gotest.tools/v3/assert"
+
+	"github.com/artem13815/hr/vacancy/internal/domain"
+)
+
+type PublicVacanciesSuite struct{ baseSuite }
+
+func (s *PublicVacanciesSuite) TestGetSuccess() {
+	t := s.T()
+	ctx := t.Context()
+	want := &domain.Vacancy{ID: "v-1", Status: domain.StatusOpen, IsPublic: true}
+
+	s.storage.GetPublicVacancyMock.Expect(ctx, "v-1").Return(want, nil)
+
+	got, err := s.svc.GetPublicVacancy(ctx, "v-1")
+	assert.NilError(t, err)
+	assert.DeepEqual(t, got, want)
+}
+
+// TestGetNotPublished: private, unpublished and missing vacancies look the
+// same from outside.
+func (s *PublicVacanciesSuite) TestGetNotPublished() {
+	t := s.T()
+	s.storage.GetPublicVacancyMock.Return(nil, nil)
+
+	got, err := s.svc.GetPublicVacancy(t.Context(), "v-1")
+	assert.ErrorIs(t, err, ErrVacancyNotFound)
+	assert.Assert(t, got == nil)
+}
+
+func (s *PublicVacanciesSuite) TestGetEmptyID() {
+	t := s.T()
+	got, err := s.svc.GetPublicVacancy(t.Context(), "")
+	assert.ErrorIs(t, err, ErrVacancyNotFound)
+	assert.Assert(t, got == nil)
+}
+
+func (s *PublicVacanciesSuite) TestListCapsFeed() {
+	t := s.T()
+	ctx := t.Context()
+	want := []domain.Vacancy{{ID: "v-1"}, {ID: "v-2"}}
+
+	s.storage.ListPublicVacanciesMock.Expect(ctx, domain.ListPublicVacanciesInput{
+		OwnerUserID: 7,
+		Limit:       maxFeedVacancies,
+	}).Return(want, nil)
+
+	got, err := s.svc.ListPublicVacancies(ctx, 7)
+	assert.NilError(t, err)
+	assert.DeepEqual(t, got, want)
+}
+
+func (s *PublicVacanciesSuite) TestListStorageError() {
+	t := s.T()
+	s.storage.ListPublicVacanciesMock.Return(nil, errors.New("db down"))
+
+	got, err := s.svc.ListPublicVacancies(t.Context(), 0)
+	assert.ErrorContains(t, err, "db down")
+	assert.Assert(t, got == nil)
+}
+
+func TestPublicVacanciesSuite(t *testing.T) { suite.Run(t, new(PublicVacanciesSuite)) }
@@ -0,0 +1,85 @@
+package usecase
+
+import (
+	"context"
+	"log/slog"
+	"slices"
+
+	"github.com/artem13815/hr/vacancy/internal/domain"
+)
+
+// Reclassification batch bounds. Vacancies are classified one by one, each
+// capped by classifyTimeout, so the cap keeps a single admin call well
+// inside a gateway timeout when the LLM is slow.
+const (
+	defaultReclassifyLimit = 100
+	maxReclassifyLimit     = 500
+)
+
+// ReclassifyRoles re-runs the LLM classifier over vacancies whose role came
+// from the selected sources (keyword fallback by default) and stores the
+// LLM's answer with RoleSourceLLM. Manual roles are never touched.
+//
+// The run stops at the first classifier failure: the LLM is evidently still
+// down, so the rest of the batch would only fall back again. The vacancies
+// left over keep their role and are picked up by the next run, oldest
+// classification first. A vacancy edited while the run was waiting on the
+// LLM has been classified by that update and is skipped.
+func (s *VacancyService) ReclassifyRoles(ctx context.Context, in domain.ReclassifyRolesInput) (*domain.ReclassifyRolesResult, error) {
+	if !in.IsAdmin {
+		return nil, ErrForbidden
+	}
+	if len(in.Sources) == 0 {
+		in.Sources = []string{domain.RoleSourceKeyword}
+	}
+	for _, src := range in.Sources {
+		if !domain.IsKnownRoleSource(src) || src == domain.RoleSourceManual {
+			return nil, ErrInvalidArgument
+		}
+	}
+	slices.Sort(in.Sources)
+	in.Sources = slices.Compact(in.Sources)
+	switch {
+	case in.Limit == 0:
+		in.Limit = defaultReclassifyLimit
+	case in.Limit > maxReclassifyLimit:
+		return nil, ErrInvalidArgument
+	}
+
+	targets, err := s.storage.ListRoleClassificationTargets(ctx, in)
+	if err != nil {
+		return nil, err
+	}
+
+	res := &domain.ReclassifyRolesResult{Scanned: uint32(len(targets))}
+	for _, t := range targets {
+		cctx, cancel := context.WithTimeout(ctx, classifyTimeout)
+		role, err := s.classifier.Classify(cctx, t.Title, t.Description)
+		cancel()
+		if err != nil || role == "" {
+			slog.WarnContext(ctx, "vacancy role reclassification stopped: classifier unavailable",
+				"err", err, "vacancy_id", t.ID, "reclassified", res.Reclassified)
+			res.LLMUnavailable = true
+			break
+		}
+
+		stored, err := s.storage.SetVacancyRole(ctx, domain.SetVacancyRoleInput{
+			VacancyID: t.ID,
+			Version:   t.Version,
+			Role:      role,
+			Source:    domain.RoleSourceLLM,
+		})
+		if err != nil {
+			return nil, err
+		}
+		if !stored {
+			res.Skipped++
+			continue
+		}
+		res.Reclassified++
+		if role != t.Role {
+			res.Changed++
+		}
+	}
+	return res, nil
+}
@@ -0,0 +1,126 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+	"gotest.tools/v3/assert"
+
+	"github.com/artem13815/hr/vacancy/internal/domain"
+)
+
+type ReclassifyRolesSuite struct{ baseSuite }
+
+func keywordTargets() []domain.RoleClassificationTarget {
+	return []domain.RoleClassificationTarget{
+		{ID: "v-1", Title: "Backend Engineer", Role: "programmer", Version: 2},
+		{ID: "v-2", Title: "Бухгалтер", Role: "default", Version: 5},
+		{ID: "v-3", Title: "Team lead", Role: "programmer", Version: 1},
+	}
+}
+
+// TestDefaultsAndCounts: an empty request retries keyword roles with the
+// default limit; unchanged and changed roles both count as reclassified,
+// and a vacancy edited meanwhile is skipped.
+func (s *ReclassifyRolesSuite) TestDefaultsAndCounts() {
+	t := s.T()
+	ctx := t.Context()
+
+	s.storage.ListRoleClassificationTargetsMock.Expect(ctx, domain.ReclassifyRolesInput{
+		IsAdmin: true,
+		Sources: []string{domain.RoleSourceKeyword},
+		Limit:   defaultReclassifyLimit,
+	}).Return(keywordTargets(), nil)
+	s.classifier.ClassifyMock.Set(func(_ context.Context, title, _ string) (string, error) {
+		if title == "Бухгалтер" {
+			return "accountant", nil
+		}
+		return "programmer", nil
+	})
+	s.storage.SetVacancyRoleMock.Set(func(_ context.Context, in domain.SetVacancyRoleInput) (bool, error) {
+		assert.Equal(t, in.Source, domain.RoleSourceLLM)
+		return in.VacancyID != "v-3", nil
+	})
+
+	got, err := s.svc.ReclassifyRoles(ctx, domain.ReclassifyRolesInput{IsAdmin: true})
+	assert.NilError(t, err)
+	assert.DeepEqual(t, got, &domain.ReclassifyRolesResult{Scanned: 3, Reclassified: 2, Changed: 1, Skipped: 1})
+}
+
+// TestStopsWhenLLMUnavailable: the first classifier failure ends the run
+// without writing anything for the rest of the batch.
+func (s *ReclassifyRolesSuite) TestStopsWhenLLMUnavailable() {
+	t := s.T()
+	ctx := t.Context()
+
+	s.storage.ListRoleClassificationTargetsMock.Return(keywordTargets(), nil)
+	s.classifier.ClassifyMock.Set(func(_ context.Context, title, _ string) (string, error) {
+		if title == "Бухгалтер" {
+			return "", ErrLLMUnavailable
+		}
+		return "programmer", nil
+	})
+	s.storage.SetVacancyRoleMock.Expect(ctx, domain.SetVacancyRoleInput{
+		VacancyID: "v-1", Version: 2, Role: "programmer", Source: domain.RoleSourceLLM,
+	}).Return(true, nil)
+
+	got, err := s.svc.ReclassifyRoles(ctx, domain.ReclassifyRolesInput{IsAdmin: true})
+	assert.NilError(t, err)
+	assert.DeepEqual(t, got, &domain.ReclassifyRolesResult{Scanned: 3, Reclassified: 1, LLMUnavailable: true})
+}
+
+func (s *ReclassifyRolesSuite) TestSourcesDeduplicated() {
+	t := s.T()
+	ctx := t.Context()
+
+	s.storage.ListRoleClassificationTargetsMock.Expect(ctx, domain.ReclassifyRolesInput{
+		IsAdmin: true,
+		Sources: []string{domain.RoleSourceKeyword, domain.RoleSourceLLM},
+		Limit:   10,
+	}).Return(nil, nil)
+
+	got, err := s.svc.ReclassifyRoles(ctx, domain.ReclassifyRolesInput{
+		IsAdmin: true,
+		Sources: []string{domain.RoleSourceLLM, domain.RoleSourceKeyword, domain.RoleSourceLLM},
+		Limit:   10,
+	})
+	assert.NilError(t, err)
+	assert.DeepEqual(t, got, &domain.ReclassifyRolesResult{})
+}
+
+func (s *ReclassifyRolesSuite) TestForbiddenForNonAdmin() {
+	t := s.T()
+	got, err := s.svc.ReclassifyRoles(t.Context(), domain.ReclassifyRolesInput{})
+	assert.ErrorIs(t, err, ErrForbidden)
+	assert.Assert(t, got == nil)
+}
+
+func (s *ReclassifyRolesSuite) TestInvalidArgument() {
+	t := s.T()
+	for name, in := range map[string]domain.ReclassifyRolesInput{
+		"manual source":  {Sources: []string{domain.RoleSourceManual}},
+		"unknown source": {Sources: []string{"guess"}},
+		"limit too big":  {Limit: maxReclassifyLimit + 1},
+	} {
+		in.IsAdmin = true
+		got, err := s.svc.ReclassifyRoles(t.Context(), in)
+		assert.ErrorIs(t, err, ErrInvalidArgument, name)
+		assert.Assert(t, got == nil, name)
+	}
+}
+
+func (s *ReclassifyRolesSuite) TestStorageError() {
+	t := s.T()
+	storageErr := errors.New("pgx: connection refused")
+	s.storage.ListRoleClassificationTargetsMock.Return(keywordTargets(), nil)
+	s.classifier.ClassifyMock.Return("programmer", nil)
+	s.storage.SetVacancyRoleMock.Return(false, storageErr)
+
+	got, err := s.svc.ReclassifyRoles(t.Context(), domain.ReclassifyRolesInput{IsAdmin: true})
+	assert.ErrorIs(t, err, storageErr)
+	assert.Assert(t, got == nil)
+}
+
+func TestReclassifyRolesSuite(t *testing.T) { suite.Run(t, new(ReclassifyRolesSuite)) }
@@ -4,6 +4,8 @@ import (
 	"context"
 	"log/slog"
 	"time"
+
+	"github.com/artem13815/hr/vacancy/internal/domain"
 )
 
 // classifyTimeout caps how long Create/Update will wait on the LLM. Set
@@ -22,9 +24,10 @@ const classifyTimeout = 5 * time.Second
 // We deliberately do not propagate classifier errors to the caller —
 // vacancy CRUD must not fail just because the classifier hiccuped. The
 // failure is observable through the slog warning and the multiagent-side
-// metrics; the user sees a vacancy with a heuristic role, which can be
-// re-classified later by re-saving.
-func (s *VacancyService) resolveRole(ctx context.Context, title, description string) string {
+// metrics; the user sees a vacancy with a heuristic role. The returned
+// source (domain.RoleSourceLLM / RoleSourceKeyword) is stored with the
+// role, and the role reconciler retries keyword roles in the background.
+func (s *VacancyService) resolveRole(ctx context.Context, title, description string) (role, source string) {
 	cctx, cancel := context.WithTimeout(ctx, classifyTimeout)
 	defer cancel()
 
@@ -32,12 +35,12 @@ func (s *VacancyService) resolveRole(ctx context.Context, title, description str
 	if err != nil {
 		slog.WarnContext(ctx, "vacancy role classifier fell back to keyword detector",
 			"err", err, "title", title)
-		return DetectRole(title, description)
+		return DetectRole(title, description), domain.RoleSourceKeyword
 	}
 	if role == "" {
 		slog.WarnContext(ctx, "vacancy role classifier returned empty role; using keyword detector",
 			"title", title)
-		return DetectRole(title, description)
+		return DetectRole(title, description), domain.RoleSourceKeyword
 	}
-	return role
+	return role, domain.RoleSourceLLM
 }
@@ -0,0 +1,79 @@
+package usecase
+
+import (
+	"cmp"
+	"context"
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	"github.com/artem13815/hr/vacancy/internal/domain"
+)
+
+// RestoreVacancy undoes ArchiveVacancy: the vacancy goes back to the status
+// it had when it was archived. Vacancies archived before the status history
+// existed have no such record and come back as drafts, so a restore never
+// reopens a vacancy to candidates by surprise.
+//
+// For admins it also undoes DeleteVacancy while the grace period lasts: a
+// deleted vacancy comes back exactly as it was, status included. Once the
+// period is over, or the retention job has started purging it, it is gone
+// for good.
+func (s *VacancyService) RestoreVacancy(ctx context.Context, in domain.RestoreVacancyInput) (*domain.Vacancy, error) {
+	if in.OwnerUserID == 0 || in.VacancyID == "" {
+		return nil, ErrInvalidArgument
+	}
+	in.Reason = strings.TrimSpace(in.Reason)
+	if utf8.RuneCountInString(in.Reason) > maxReasonRunes {
+		return nil, ErrInvalidArgument
+	}
+
+	vacancy, err := s.storage.GetVacancy(ctx, in.VacancyID, in.OwnerUserID, in.IsAdmin)
+	if err != nil {
+		return nil, err
+	}
+	if vacancy == nil {
+		if !in.IsAdmin {
+			return nil, ErrVacancyNotFound
+		}
+		return s.undeleteVacancy(ctx, domain.UndeleteVacancyInput{
+			VacancyID:    in.VacancyID,
+			ActorUserID:  in.OwnerUserID,
+			DeletedAfter: time.Now().UTC().Add(-s.restoreWindow),
+		})
+	}
+	if err := s.requireEditor(ctx, vacancy, in.OwnerUserID, in.IsAdmin); err != nil {
+		return nil, err
+	}
+	if vacancy.Status != domain.StatusArchived {
+		return nil, ErrInvalidTransition
+	}
+
+	previous, err := s.storage.StatusBeforeArchive(ctx, in.VacancyID)
+	if err != nil {
+		return nil, err
+	}
+	if previous == domain.StatusArchived || !domain.IsKnownStatus(previous) {
+		previous = ""
+	}
+
+	return s.changeStatus(ctx, domain.ChangeVacancyStatusInput{
+		VacancyID:   in.VacancyID,
+		OwnerUserID: in.OwnerUserID,
+		IsAdmin:     in.IsAdmin,
+		FromStatus:  domain.StatusArchived,
+		ToStatus:    cmp.Or(previous, domain.StatusDraft),
+		Reason:      in.Reason,
+	})
+}
+
+func (s *VacancyService) undeleteVacancy(ctx context.Context, in domain.UndeleteVacancyInput) (*domain.Vacancy, error) {
+	vacancy, err := s.storage.UndeleteVacancy(ctx, in)
+	if err != nil {
+		return nil, err
+	}
+	if vacancy == nil {
+		return nil, ErrVacancyNotFound
+	}
+	return vacancy, nil
+}
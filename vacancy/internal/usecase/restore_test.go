@@ -0,0 +1,153 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/suite"
+	"gotest.tools/v3/assert"
+
+	"github.com/artem13815/hr/vacancy/internal/domain"
+)
+
+type RestoreVacancySuite struct{ baseSuite }
+
+func (s *RestoreVacancySuite) TestRestoresPreviousStatus() {
+	t := s.T()
+	ctx := t.Context()
+	want := &domain.Vacancy{ID: "v-1", OwnerUserID: 1, Status: domain.StatusPaused}
+
+	s.storage.GetVacancyMock.Expect(ctx, "v-1", uint64(1), false).Return(&domain.Vacancy{ID: "v-1", OwnerUserID: 1, Status: domain.StatusArchived}, nil)
+	s.storage.StatusBeforeArchiveMock.Expect(ctx, "v-1").Return(domain.StatusPaused, nil)
+	s.storage.ChangeVacancyStatusMock.Expect(ctx, domain.ChangeVacancyStatusInput{
+		VacancyID:   "v-1",
+		OwnerUserID: 1,
+		FromStatus:  domain.StatusArchived,
+		ToStatus:    domain.StatusPaused,
+		Reason:      "archived by mistake",
+	}).Return(want, nil)
+
+	got, err := s.svc.RestoreVacancy(ctx, domain.RestoreVacancyInput{VacancyID: "v-1", OwnerUserID: 1, Reason: "archived by mistake"})
+	assert.NilError(t, err)
+	assert.Equal(t, got, want)
+}
+
+// TestUnknownPreviousStatusRestoresDraft covers vacancies archived before
+// the history table existed: they come back as drafts, never straight to
+// open.
+func (s *RestoreVacancySuite) TestUnknownPreviousStatusRestoresDraft() {
+	t := s.T()
+	ctx := t.Context()
+	want := &domain.Vacancy{ID: "v-1", OwnerUserID: 1, Status: domain.StatusDraft}
+
+	s.storage.GetVacancyMock.Expect(ctx, "v-1", uint64(1), true).Return(&domain.Vacancy{ID: "v-1", OwnerUserID: 1, Status: domain.StatusArchived}, nil)
+	s.storage.StatusBeforeArchiveMock.Expect(ctx, "v-1").Return("", nil)
+	s.storage.ChangeVacancyStatusMock.Expect(ctx, domain.ChangeVacancyStatusInput{
+		VacancyID:   "v-1",
+		OwnerUserID: 1,
+		IsAdmin:     true,
+		FromStatus:  domain.StatusArchived,
+		ToStatus:    domain.StatusDraft,
+	}).Return(want, nil)
+
+	got, err := s.svc.RestoreVacancy(ctx, domain.RestoreVacancyInput{VacancyID: "v-1", OwnerUserID: 1, IsAdmin: true})
+	assert.NilError(t, err)
+	assert.Equal(t, got, want)
+}
+
+func (s *RestoreVacancySuite) TestNotArchived() {
+	t := s.T()
+	ctx := t.Context()
+
+	s.storage.GetVacancyMock.Expect(ctx, "v-1", uint64(1), false).Return(&domain.Vacancy{ID: "v-1", OwnerUserID: 1, Status: domain.StatusOpen}, nil)
+
+	got, err := s.svc.RestoreVacancy(ctx, domain.RestoreVacancyInput{VacancyID: "v-1", OwnerUserID: 1})
+	assert.ErrorIs(t, err, ErrInvalidTransition)
+	assert.Assert(t, got == nil)
+}
+
+func (s *RestoreVacancySuite) TestNotFound() {
+	t := s.T()
+	ctx := t.Context()
+
+	s.storage.GetVacancyMock.Expect(ctx, "v-1", uint64(1), false).Return(nil, nil)
+
+	got, err := s.svc.RestoreVacancy(ctx, domain.RestoreVacancyInput{VacancyID: "v-1", OwnerUserID: 1})
+	assert.ErrorIs(t, err, ErrVacancyNotFound)
+	assert.Assert(t, got == nil)
+}
+
+// TestAdminUndeletes: a vacancy invisible to an admin is a deleted one, and
+// the restore brings it back as it was.
+func (s *RestoreVacancySuite) TestAdminUndeletes() {
+	t := s.T()
+	ctx := t.Context()
+	want := &domain.Vacancy{ID: "v-1", OwnerUserID: 1, Status: domain.StatusOpen}
+
+	s.storage.GetVacancyMock.Expect(ctx, "v-1", uint64(9), true).Return(nil, nil)
+	before := time.Now().UTC()
+	s.storage.UndeleteVacancyMock.Set(func(_ context.Context, in domain.UndeleteVacancyInput) (*domain.Vacancy, error) {
+		// The grace window ends testRestoreWindow before now, in UTC like
+		// the deleted_at column.
+		after := time.Now().UTC()
+		assert.Equal(t, in.VacancyID, "v-1")
+		assert.Equal(t, in.ActorUserID, uint64(9))
+		assert.Equal(t, in.DeletedAfter.Location(), time.UTC)
+		assert.Assert(t, !in.DeletedAfter.Before(before.Add(-testRestoreWindow)))
+		assert.Assert(t, !in.DeletedAfter.After(after.Add(-testRestoreWindow)))
+		return want, nil
+	})
+
+	got, err := s.svc.RestoreVacancy(ctx, domain.RestoreVacancyInput{VacancyID: "v-1", OwnerUserID: 9, IsAdmin: true})
+	assert.NilError(t, err)
+	assert.Equal(t, got, want)
+}
+
+// TestUndeleteAfterGraceWindow: past the grace window, or once the purge
+// has started, there is nothing to restore.
+func (s *RestoreVacancySuite) TestUndeleteAfterGraceWindow() {
+	t := s.T()
+	ctx := t.Context()
+
+	s.storage.GetVacancyMock.Expect(ctx, "v-1", uint64(9), true).Return(nil, nil)
+	s.storage.UndeleteVacancyMock.Return(nil, nil)
+
+	got, err := s.svc.RestoreVacancy(ctx, domain.RestoreVacancyInput{VacancyID: "v-1", OwnerUserID: 9, IsAdmin: true})
+	assert.ErrorIs(t, err, ErrVacancyNotFound)
+	assert.Assert(t, got == nil)
+}
+
+func (s *RestoreVacancySuite) TestInvalidArgument() {
+	t := s.T()
+	got, err := s.svc.RestoreVacancy(t.Context(), domain.RestoreVacancyInput{VacancyID: "v-1"})
+	assert.ErrorIs(t, err, ErrInvalidArgument)
+	assert.Assert(t, got == nil)
+}
+
+func (s *RestoreVacancySuite) TestHistoryError() {
+	t := s.T()
+	ctx := t.Context()
+	storageErr := errors.New("pgx: connection refused")
+
+	s.storage.GetVacancyMock.Expect(ctx, "v-1", uint64(1), false).Return(&domain.Vacancy{ID: "v-1", OwnerUserID: 1, Status: domain.StatusArchived}, nil)
+	s.storage.StatusBeforeArchiveMock.Expect(ctx, "v-1").Return("", storageErr)
+
+	got, err := s.svc.RestoreVacancy(ctx, domain.RestoreVacancyInput{VacancyID: "v-1", OwnerUserID: 1})
+	assert.ErrorIs(t, err, storageErr)
+	assert.Assert(t, got == nil)
+}
+
+func (s *RestoreVacancySuite) TestViewerForbidden() {
+	t := s.T()
+	ctx := t.Context()
+	s.storage.GetVacancyMock.Return(&domain.Vacancy{ID: "v-1", OwnerUserID: 1, Status: domain.StatusArchived}, nil)
+	s.storage.CanEditVacancyMock.Expect(ctx, "v-1", uint64(8)).Return(false, nil)
+
+	got, err := s.svc.RestoreVacancy(ctx, domain.RestoreVacancyInput{VacancyID: "v-1", OwnerUserID: 8})
+	assert.ErrorIs(t, err, ErrForbidden)
+	assert.Assert(t, got == nil)
+}
+
+func TestRestoreVacancySuite(t *testing.T) { suite.Run(t, new(RestoreVacancySuite)) }
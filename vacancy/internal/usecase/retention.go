@@ -0,0 +1,91 @@
+package usecase
+
+//go:generate go run github.com/gojuno/minimock/v3/cmd/minimock@v3.4.7 -i RetentionStorage,CandidatePurger -o ./mocks -s _mock.go -g
+
+import (
+	"context"
+	"log/slog"
+	"time"
+
+	"github.com/artem13815/hr/vacancy/internal/domain"
+)
+
+// RetentionStorage finds and removes soft-deleted vacancies. Implemented by
+// internal/infrastructure/persistence.
+type RetentionStorage interface {
+	ListPurgeableVacancies(ctx context.Context, cutoff time.Time, limit uint32) ([]string, error)
+	StartVacancyPurge(ctx context.Context, vacancyID string, cutoff time.Time) (bool, error)
+	PurgeVacancy(ctx context.Context, vacancyID string) error
+}
+
+// CandidatePurger removes a vacancy's candidates and their resumes. The
+// resume service owns those rows; implemented by
+// internal/infrastructure/resume_client.
+type CandidatePurger interface {
+	PurgeVacancyCandidates(ctx context.Context, vacancyID string) (*domain.PurgedCandidates, error)
+}
+
+// VacancyPurger hard-deletes vacancies once their soft-delete grace period
+// is over.
+type VacancyPurger struct {
+	storage    RetentionStorage
+	candidates CandidatePurger
+}
+
+func NewVacancyPurger(storage RetentionStorage, candidates CandidatePurger) *VacancyPurger {
+	return &VacancyPurger{storage: storage, candidates: candidates}
+}
+
+// PurgeDeletedVacancies purges up to in.Limit vacancies deleted at or before
+// in.DeletedBefore. Each vacancy is first marked as being purged, which
+// closes its restore window; then the resume service drops its candidates
+// and resumes; then the vacancy itself goes. A vacancy restored since it
+// was listed is left alone. A failure after the mark leaves the vacancy
+// marked and hidden, and the next run picks it up again — every step is
+// safe to repeat. Failures are counted, not returned, so one stuck vacancy
+// does not hold up the rest of the batch.
+func (p *VacancyPurger) PurgeDeletedVacancies(ctx context.Context, in domain.PurgeDeletedVacanciesInput) (*domain.PurgeDeletedVacanciesResult, error) {
+	if in.DeletedBefore.IsZero() || in.Limit == 0 {
+		return nil, ErrInvalidArgument
+	}
+
+	ids, err := p.storage.ListPurgeableVacancies(ctx, in.DeletedBefore, in.Limit)
+	if err != nil {
+		return nil, err
+	}
+
+	res := &domain.PurgeDeletedVacanciesResult{Scanned: uint32(len(ids))}
+	for _, id := range ids {
+		if ctx.Err() != nil {
+			return nil, ctx.Err()
+		}
+
+		started, err := p.storage.StartVacancyPurge(ctx, id, in.DeletedBefore)
+		if err != nil {
+			slog.Error("start vacancy purge failed", "vacancy_id", id, "err", err)
+			res.Failed++
+			continue
+		}
+		if !started {
+			res.Restored++
+			continue
+		}
+
+		purged, err := p.candidates.PurgeVacancyCandidates(ctx, id)
+		if err != nil {
+			slog.Error("purge vacancy candidates failed", "vacancy_id", id, "err", err)
+			res.Failed++
+			continue
+		}
+		if err := p.storage.PurgeVacancy(ctx, id); err != nil {
+			slog.Error("purge vacancy failed", "vacancy_id", id, "err", err)
+			res.Failed++
+			continue
+		}
+
+		res.Purged++
+		res.Candidates += purged.Candidates
+		res.Resumes += purged.Resumes
+	}
+	return res, nil
+}
@@ -0,0 +1,97 @@
+package usecase
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/suite"
+	"gotest.tools/v3/assert"
+
+	"github.com/artem13815/hr/vacancy/internal/domain"
+	"github.com/artem13815/hr/vacancy/internal/usecase/mocks"
+)
+
+type VacancyPurgerSuite struct {
+	suite.Suite
+	storage    *mocks.RetentionStorageMock
+	candidates *mocks.CandidatePurgerMock
+	purger     *VacancyPurger
+	cutoff     time.Time
+}
+
+func (s *VacancyPurgerSuite) SetupTest() {
+	t := s.T()
+	s.storage = mocks.NewRetentionStorageMock(t)
+	s.candidates = mocks.NewCandidatePurgerMock(t)
+	s.purger = NewVacancyPurger(s.storage, s.candidates)
+	s.cutoff = time.Date(2026, 9, 1, 0, 0, 0, 0, time.UTC)
+}
+
+func (s *VacancyPurgerSuite) TestPurgesCandidatesThenVacancy() {
+	t := s.T()
+	ctx := t.Context()
+
+	s.storage.ListPurgeableVacanciesMock.Expect(ctx, s.cutoff, 10).Return([]string{"v-1", "v-2"}, nil)
+	s.storage.StartVacancyPurgeMock.Return(true, nil)
+	s.candidates.PurgeVacancyCandidatesMock.When(ctx, "v-1").Then(&domain.PurgedCandidates{Candidates: 3, Resumes: 2}, nil)
+	s.candidates.PurgeVacancyCandidatesMock.When(ctx, "v-2").Then(&domain.PurgedCandidates{Candidates: 1, Resumes: 1}, nil)
+	s.storage.PurgeVacancyMock.Return(nil)
+
+	got, err := s.purger.PurgeDeletedVacancies(ctx, domain.PurgeDeletedVacanciesInput{DeletedBefore: s.cutoff, Limit: 10})
+	assert.NilError(t, err)
+	assert.DeepEqual(t, got, &domain.PurgeDeletedVacanciesResult{Scanned: 2, Purged: 2, Candidates: 4, Resumes: 3})
+	assert.Equal(t, s.storage.PurgeVacancyAfterCounter(), uint64(2))
+}
+
+// TestRestoredSinceListed: a vacancy restored between the list and the
+// mark is left alone, and the resume service is not called for it.
+func (s *VacancyPurgerSuite) TestRestoredSinceListed() {
+	t := s.T()
+	ctx := t.Context()
+
+	s.storage.ListPurgeableVacanciesMock.Expect(ctx, s.cutoff, 10).Return([]string{"v-1"}, nil)
+	s.storage.StartVacancyPurgeMock.Expect(ctx, "v-1", s.cutoff).Return(false, nil)
+
+	got, err := s.purger.PurgeDeletedVacancies(ctx, domain.PurgeDeletedVacanciesInput{DeletedBefore: s.cutoff, Limit: 10})
+	assert.NilError(t, err)
+	assert.DeepEqual(t, got, &domain.PurgeDeletedVacanciesResult{Scanned: 1, Restored: 1})
+}
+
+// TestResumeFailureKeepsVacancy: when the resume service fails the vacancy
+// row stays, so the next run retries it, and the batch carries on.
+func (s *VacancyPurgerSuite) TestResumeFailureKeepsVacancy() {
+	t := s.T()
+	ctx := t.Context()
+
+	s.storage.ListPurgeableVacanciesMock.Expect(ctx, s.cutoff, 10).Return([]string{"v-1", "v-2"}, nil)
+	s.storage.StartVacancyPurgeMock.Return(true, nil)
+	s.candidates.PurgeVacancyCandidatesMock.When(ctx, "v-1").Then(nil, errors.New("resume: unavailable"))
+	s.candidates.PurgeVacancyCandidatesMock.When(ctx, "v-2").Then(&domain.PurgedCandidates{}, nil)
+	s.storage.PurgeVacancyMock.Expect(ctx, "v-2").Return(nil)
+
+	got, err := s.purger.PurgeDeletedVacancies(ctx, domain.PurgeDeletedVacanciesInput{DeletedBefore: s.cutoff, Limit: 10})
+	assert.NilError(t, err)
+	assert.DeepEqual(t, got, &domain.PurgeDeletedVacanciesResult{Scanned: 2, Purged: 1, Failed: 1})
+}
+
+func (s *VacancyPurgerSuite) TestListError() {
+	t := s.T()
+	ctx := t.Context()
+	storageErr := errors.New("pgx: connection refused")
+
+	s.storage.ListPurgeableVacanciesMock.Expect(ctx, s.cutoff, 10).Return(nil, storageErr)
+
+	got, err := s.purger.PurgeDeletedVacancies(ctx, domain.PurgeDeletedVacanciesInput{DeletedBefore: s.cutoff, Limit: 10})
+	assert.ErrorIs(t, err, storageErr)
+	assert.Assert(t, got == nil)
+}
+
+func (s *VacancyPurgerSuite) TestInvalidArgument() {
+	t := s.T()
+	got, err := s.purger.PurgeDeletedVacancies(t.Context(), domain.PurgeDeletedVacanciesInput{Limit: 10})
+	assert.ErrorIs(t, err, ErrInvalidArgument)
+	assert.Assert(t, got == nil)
+}
+
+func TestVacancyPurgerSuite(t *testing.T) { suite.Run(t, new(VacancyPurgerSuite)) }
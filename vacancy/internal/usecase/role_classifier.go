@@ -19,6 +19,10 @@ import "context"
 // Why a port and not a direct gRPC call from the service: keeps the
 // usecase test hermetic (no network, no protobuf), and lets the deterministic
 // fallback live entirely in pure code.
+//
+// ListRoles returns the vocabulary Classify answers from ("default"
+// included); it is what a manually pinned role is validated against.
 type RoleClassifier interface {
 	Classify(ctx context.Context, title, description string) (string, error)
+	ListRoles(ctx context.Context) ([]string, error)
 }
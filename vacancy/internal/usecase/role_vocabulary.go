@@ -0,0 +1,52 @@
+package usecase
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"slices"
+	"strings"
+)
+
+// ListRoles returns the roles a vacancy can be pinned to: multiagent's
+// prompt roles plus "default". When multiagent is unreachable the keyword
+// detector's roles stand in — they mirror the same prompt set, so the editor
+// keeps working and pinned roles stay in-vocabulary.
+func (s *VacancyService) ListRoles(ctx context.Context) []string {
+	cctx, cancel := context.WithTimeout(ctx, classifyTimeout)
+	defer cancel()
+
+	roles, err := s.classifier.ListRoles(cctx)
+	if err != nil || len(roles) == 0 {
+		slog.WarnContext(ctx, "vacancy role vocabulary fell back to keyword detector roles", "err", err)
+		return keywordRoles()
+	}
+	return roles
+}
+
+// keywordRoles is the DetectRole vocabulary in ListRoles order: sorted,
+// "default" last.
+func keywordRoles() []string {
+	roles := make([]string, 0, len(roleKeywords)+1)
+	for _, r := range roleKeywords {
+		roles = append(roles, r.role)
+	}
+	slices.Sort(roles)
+	return append(roles, "default")
+}
+
+// pinnedRole normalizes a caller-chosen role and checks it against the
+// vocabulary; an unknown role is ErrInvalidArgument.
+func (s *VacancyService) pinnedRole(ctx context.Context, role string) (string, error) {
+	return pinRole(role, s.ListRoles(ctx))
+}
+
+// pinRole is pinnedRole against an already fetched vocabulary, for callers
+// that pin many roles at once.
+func pinRole(role string, vocabulary []string) (string, error) {
+	role = strings.ToLower(strings.TrimSpace(role))
+	if !slices.Contains(vocabulary, role) {
+		return "", fmt.Errorf("%w: unknown role %q", ErrInvalidArgument, role)
+	}
+	return role, nil
+}
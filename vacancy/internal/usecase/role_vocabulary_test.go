@@ -0,0 +1,47 @@
+package usecase
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+	"gotest.tools/v3/assert"
+)
+
+type RoleVocabularySuite struct{ baseSuite }
+
+// testRoles is a multiagent ListRoles answer: sorted, "default" last.
+var testRoles = []string{"analyst", "manager", "programmer", "default"}
+
+func (s *RoleVocabularySuite) TestListRoles() {
+	t := s.T()
+	s.classifier.ListRolesMock.Return(testRoles, nil)
+
+	assert.DeepEqual(t, s.svc.ListRoles(t.Context()), testRoles)
+}
+
+// TestListRolesFallsBackToKeywordRoles: with multiagent down the editor
+// still gets the keyword detector's vocabulary, which mirrors the prompts.
+func (s *RoleVocabularySuite) TestListRolesFallsBackToKeywordRoles() {
+	t := s.T()
+	s.classifier.ListRolesMock.Return(nil, ErrLLMUnavailable)
+
+	assert.DeepEqual(t, s.svc.ListRoles(t.Context()), []string{
+		"accountant", "analyst", "doctor", "electrician", "manager", "programmer", "default",
+	})
+}
+
+// TestPinnedRoleDuringOutage: a role is still pinnable while multiagent is
+// down, against the fallback vocabulary.
+func (s *RoleVocabularySuite) TestPinnedRoleDuringOutage() {
+	t := s.T()
+	s.classifier.ListRolesMock.Return(nil, ErrLLMUnavailable)
+
+	role, err := s.svc.pinnedRole(t.Context(), "Doctor")
+	assert.NilError(t, err)
+	assert.Equal(t, role, "doctor")
+
+	_, err = s.svc.pinnedRole(t.Context(), "astronaut")
+	assert.ErrorIs(t, err, ErrInvalidArgument)
+}
+
+func TestRoleVocabularySuite(t *testing.T) { suite.Run(t, new(RoleVocabularySuite)) }
@@ -1,8 +1,11 @@
 package usecase
 
 import (
+	"time"
+
 	"github.com/stretchr/testify/suite"
 
+	"github.com/artem13815/hr/vacancy/internal/domain"
 	"github.com/artem13815/hr/vacancy/internal/usecase/mocks"
 )
 
@@ -14,6 +17,7 @@ type baseSuite struct {
 	suite.Suite
 	storage    *mocks.VacancyStorageMock
 	classifier *mocks.RoleClassifierMock
+	suggester  *mocks.SkillSuggesterMock
 	svc        *VacancyService
 }
 
@@ -21,5 +25,21 @@ func (s *baseSuite) SetupTest() {
 	t := s.T()
 	s.storage = mocks.NewVacancyStorageMock(t)
 	s.classifier = mocks.NewRoleClassifierMock(t)
-	s.svc = NewVacancyService(s.storage, s.classifier)
+	s.suggester = mocks.NewSkillSuggesterMock(t)
+	s.svc = NewVacancyService(s.storage, s.classifier, s.suggester, testTaxonomy(), testRestoreWindow)
+}
+
+// testRestoreWindow is the retention grace period tests run with.
+const testRestoreWindow = 30 * 24 * time.Hour
+
+// testTaxonomy is a tiny stand-in for the bundled seed. Canonical names
+// match the spellings existing tests already use, so only tests that send
+// an alias see a rename.
+func testTaxonomy() *domain.SkillTaxonomy {
+	return domain.NewSkillTaxonomy([]domain.SkillEntry{
+		{Name: "Go", Category: "language", Aliases: []string{"golang", "голанг"}},
+		{Name: "Kubernetes", Category: "devops", Aliases: []string{"k8s", "кубернетес"}},
+		{Name: "PostgreSQL", Category: "database", Aliases: []string{"postgres", "постгрес"}},
+		{Name: "English", NameRU: "Английский язык", Category: "spoken_language", Aliases: []string{"английский"}},
+	})
 }
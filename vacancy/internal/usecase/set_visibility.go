@@ -0,0 +1,28 @@
+package usecase
+
+import (
+	"context"
+
+	"github.com/artem13815/hr/vacancy/internal/domain"
+)
+
+// SetVacancyVisibility publishes a vacancy to the public feed or withdraws
+// it. The flag can be set in any status; the feed only shows open
+// vacancies, so a public draft appears once it is opened.
+func (s *VacancyService) SetVacancyVisibility(ctx context.Context, in domain.SetVacancyVisibilityInput) (*domain.Vacancy, error) {
+	if in.OwnerUserID == 0 || in.VacancyID == "" {
+		return nil, ErrInvalidArgument
+	}
+
+	if _, err := s.editableVacancy(ctx, in.VacancyID, in.OwnerUserID, in.IsAdmin); err != nil {
+		return nil, err
+	}
+	vacancy, err := s.storage.SetVacancyVisibility(ctx, in)
+	if err != nil {
+		return nil, err
+	}
+	if vacancy == nil {
+		return nil, ErrVacancyNotFound
+	}
+	return vacancy, nil
+}
@@ -0,0 +1,71 @@
+package usecase
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+	"gotest.tools/v3/assert"
+
+	"github.com/artem13815/hr/vacancy/internal/domain"
+)
+
+type SetVacancyVisibilitySuite struct{ baseSuite }
+
+func (s *SetVacancyVisibilitySuite) TestSuccess() {
+	t := s.T()
+	ctx := t.Context()
+	in := domain.SetVacancyVisibilityInput{VacancyID: "v-1", OwnerUserID: 7, IsPublic: true}
+	want := &domain.Vacancy{ID: "v-1", OwnerUserID: 7, IsPublic: true}
+
+	s.storage.GetVacancyMock.Expect(ctx, "v-1", uint64(7), false).Return(&domain.Vacancy{ID: "v-1", OwnerUserID: 7}, nil)
+	s.storage.SetVacancyVisibilityMock.Expect(ctx, in).Return(want, nil)
+
+	got, err := s.svc.SetVacancyVisibility(ctx, in)
+	assert.NilError(t, err)
+	assert.DeepEqual(t, got, want)
+}
+
+func (s *SetVacancyVisibilitySuite) TestNotFound() {
+	t := s.T()
+	s.storage.GetVacancyMock.Return(nil, nil)
+
+	got, err := s.svc.SetVacancyVisibility(t.Context(), domain.SetVacancyVisibilityInput{VacancyID: "v-1", OwnerUserID: 7})
+	assert.ErrorIs(t, err, ErrVacancyNotFound)
+	assert.Assert(t, got == nil)
+}
+
+func (s *SetVacancyVisibilitySuite) TestStorageError() {
+	t := s.T()
+	s.storage.GetVacancyMock.Return(&domain.Vacancy{ID: "v-1", OwnerUserID: 7}, nil)
+	s.storage.SetVacancyVisibilityMock.Return(nil, errors.New("db down"))
+
+	got, err := s.svc.SetVacancyVisibility(t.Context(), domain.SetVacancyVisibilityInput{VacancyID: "v-1", OwnerUserID: 7})
+	assert.ErrorContains(t, err, "db down")
+	assert.Assert(t, got == nil)
+}
+
+func (s *SetVacancyVisibilitySuite) TestInvalidArgument() {
+	t := s.T()
+	for _, in := range []domain.SetVacancyVisibilityInput{
+		{VacancyID: "v-1"},
+		{OwnerUserID: 7},
+	} {
+		got, err := s.svc.SetVacancyVisibility(t.Context(), in)
+		assert.ErrorIs(t, err, ErrInvalidArgument)
+		assert.Assert(t, got == nil)
+	}
+}
+
+func (s *SetVacancyVisibilitySuite) TestViewerForbidden() {
+	t := s.T()
+	ctx := t.Context()
+	s.storage.GetVacancyMock.Return(&domain.Vacancy{ID: "v-1", OwnerUserID: 7}, nil)
+	s.storage.CanEditVacancyMock.Expect(ctx, "v-1", uint64(8)).Return(false, nil)
+
+	got, err := s.svc.SetVacancyVisibility(ctx, domain.SetVacancyVisibilityInput{VacancyID: "v-1", OwnerUserID: 8, IsPublic: true})
+	assert.ErrorIs(t, err, ErrForbidden)
+	assert.Assert(t, got == nil)
+}
+
+func TestSetVacancyVisibilitySuite(t *testing.T) { suite.Run(t, new(SetVacancyVisibilitySuite)) }
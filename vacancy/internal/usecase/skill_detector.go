@@ -0,0 +1,171 @@
+package usecase
+
+import (
+	"strings"
+	"unicode"
+	"unicode/utf8"
+
+	"github.com/artem13815/hr/vacancy/internal/domain"
+)
+
+// Weights the keyword scan assigns. They only need to rank sections
+// against each other; the recruiter tunes them in the editor anyway.
+const (
+	detectedMustWeight  = 0.8
+	detectedPlainWeight = 0.5
+	detectedNiceWeight  = 0.3
+	maxDetectedSkills   = 15
+)
+
+// Section markers, lowercase substrings. A line carrying a marker flags the
+// skills on it; a marker line ending with ':' is a header and flags every
+// following line until the next header.
+var (
+	niceMarkers = []string{
+		"плюсом", "преимуществом", "желательно", "приветствуется",
+		"nice to have", "nice-to-have", "a plus", "preferred", "bonus",
+	}
+	mustMarkers = []string{
+		"требования", "обязательно", "необходимо", "must", "required", "requirements",
+	}
+)
+
+type skillSection int
+
+const (
+	sectionPlain skillSection = iota
+	sectionMust
+	sectionNice
+)
+
+// DetectSkills is the deterministic fallback for SuggestSkills: it scans
+// the text for every spelling in the taxonomy and flags skills by the
+// section they first appear in. The title counts as a must-have section —
+// "Go developer" means Go is required. Returns skills in order of first
+// mention, capped at maxDetectedSkills; nil taxonomy detects nothing.
+func DetectSkills(title, description string, taxonomy *domain.SkillTaxonomy) []domain.SkillWeight {
+	entries := taxonomy.Entries()
+	if len(entries) == 0 {
+		return nil
+	}
+
+	out := make([]domain.SkillWeight, 0)
+	seen := make(map[string]struct{})
+	scan := func(line string, section skillSection) {
+		for _, hit := range findSkills(strings.ToLower(line), entries) {
+			if len(out) == maxDetectedSkills {
+				return
+			}
+			if _, dup := seen[hit]; dup {
+				continue
+			}
+			seen[hit] = struct{}{}
+			out = append(out, detectedSkill(hit, section))
+		}
+	}
+
+	scan(title, sectionMust)
+	header := sectionPlain
+	for _, line := range strings.Split(description, "\n") {
+		lower := strings.ToLower(strings.TrimSpace(line))
+		marker := sectionPlain
+		switch {
+		case containsAny(lower, niceMarkers):
+			marker = sectionNice
+		case containsAny(lower, mustMarkers):
+			marker = sectionMust
+		}
+		if strings.HasSuffix(lower, ":") {
+			// Any header — even "Условия:" without a marker — closes the
+			// previous section.
+			header = marker
+		}
+		section := header
+		if marker != sectionPlain {
+			section = marker
+		}
+		scan(line, section)
+	}
+	return out
+}
+
+// findSkills returns canonical names of entries mentioned in lowerText,
+// ordered by position of the first mention.
+func findSkills(lowerText string, entries []domain.SkillEntry) []string {
+	type hit struct {
+		name string
+		pos  int
+	}
+	var hits []hit
+	for _, e := range entries {
+		pos := -1
+		for _, spelling := range e.Spellings() {
+			key := domain.NormalizeSkillKey(spelling)
+			if key == "" {
+				continue
+			}
+			if p := indexWord(lowerText, key); p >= 0 && (pos < 0 || p < pos) {
+				pos = p
+			}
+		}
+		if pos >= 0 {
+			hits = append(hits, hit{name: e.Name, pos: pos})
+		}
+	}
+
+	// Insertion sort keeps seed order among ties and is plenty for a line.
+	for i := 1; i < len(hits); i++ {
+		for j := i; j > 0 && hits[j].pos < hits[j-1].pos; j-- {
+			hits[j], hits[j-1] = hits[j-1], hits[j]
+		}
+	}
+	names := make([]string, 0, len(hits))
+	for _, h := range hits {
+		names = append(names, h.name)
+	}
+	return names
+}
+
+// indexWord finds word as a whole word: the runes on either side must not
+// be letters or digits, so "go" does not fire inside "google" and "java"
+// not inside "javascript".
+func indexWord(text, word string) int {
+	for from := 0; from < len(text); {
+		i := strings.Index(text[from:], word)
+		if i < 0 {
+			return -1
+		}
+		start, end := from+i, from+i+len(word)
+		before, _ := utf8.DecodeLastRuneInString(text[:start])
+		after, _ := utf8.DecodeRuneInString(text[end:])
+		if (start == 0 || !isWordRune(before)) && (end == len(text) || !isWordRune(after)) {
+			return start
+		}
+		from = start + 1
+	}
+	return -1
+}
+
+func isWordRune(r rune) bool {
+	return unicode.IsLetter(r) || unicode.IsDigit(r)
+}
+
+func containsAny(s string, fragments []string) bool {
+	for _, f := range fragments {
+		if strings.Contains(s, f) {
+			return true
+		}
+	}
+	return false
+}
+
+func detectedSkill(name string, section skillSection) domain.SkillWeight {
+	switch section {
+	case sectionMust:
+		return domain.SkillWeight{Name: name, Weight: detectedMustWeight, MustHave: true}
+	case sectionNice:
+		return domain.SkillWeight{Name: name, Weight: detectedNiceWeight, NiceToHave: true}
+	default:
+		return domain.SkillWeight{Name: name, Weight: detectedPlainWeight}
+	}
+}
@@ -0,0 +1,80 @@
+package usecase
+
+import (
+	"testing"
+
+	"gotest.tools/v3/assert"
+
+	"github.com/artem13815/hr/vacancy/internal/domain"
+)
+
+// TestDetectSkills pins the keyword fallback: whole-word matching on every
+// spelling, section flags from markers and headers, first-mention order.
+// Pure function — no suite.
+func TestDetectSkills(t *testing.T) {
+	t.Parallel()
+
+	must := func(name string) domain.SkillWeight {
+		return domain.SkillWeight{Name: name, Weight: detectedMustWeight, MustHave: true}
+	}
+	nice := func(name string) domain.SkillWeight {
+		return domain.SkillWeight{Name: name, Weight: detectedNiceWeight, NiceToHave: true}
+	}
+	plain := func(name string) domain.SkillWeight {
+		return domain.SkillWeight{Name: name, Weight: detectedPlainWeight}
+	}
+
+	tests := []struct {
+		name        string
+		title       string
+		description string
+		want        []domain.SkillWeight
+	}{
+		{
+			name:  "title is must-have",
+			title: "Senior Golang developer",
+			want:  []domain.SkillWeight{must("Go")},
+		},
+		{
+			name:        "plain mention",
+			title:       "Backend developer",
+			description: "Стек: postgres и кубернетес.",
+			want:        []domain.SkillWeight{plain("PostgreSQL"), plain("Kubernetes")},
+		},
+		{
+			name:        "header opens section until next header",
+			title:       "Backend developer",
+			description: "Требования:\n- Go\n- PostgreSQL\nБудет преимуществом:\n- k8s\nУсловия:\n- английский язык в команде",
+			want:        []domain.SkillWeight{must("Go"), must("PostgreSQL"), nice("Kubernetes"), plain("English")},
+		},
+		{
+			name:        "inline marker flags only its line",
+			title:       "Backend developer",
+			description: "Kubernetes is a plus.\nWe use PostgreSQL.",
+			want:        []domain.SkillWeight{nice("Kubernetes"), plain("PostgreSQL")},
+		},
+		{
+			name:        "first mention wins",
+			title:       "Go developer",
+			description: "Nice to have: Go experience with k8s",
+			want:        []domain.SkillWeight{must("Go"), nice("Kubernetes")},
+		},
+		{
+			name:  "whole words only",
+			title: "Google ads manager, gopher",
+			want:  []domain.SkillWeight{},
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+			assert.DeepEqual(t, DetectSkills(tc.title, tc.description, testTaxonomy()), tc.want)
+		})
+	}
+}
+
+func TestDetectSkillsNilTaxonomy(t *testing.T) {
+	t.Parallel()
+	assert.Assert(t, DetectSkills("Go developer", "", nil) == nil)
+}
@@ -0,0 +1,21 @@
+package usecase
+
+import (
+	"context"
+
+	"github.com/artem13815/hr/vacancy/internal/domain"
+)
+
+// SkillSuggester drafts a skill matrix from a vacancy's title and
+// description. The implementation lives in
+// internal/infrastructure/multiagent_client and proxies to
+// multiagent.SuggestSkills over gRPC; tests inject a mock.
+//
+// Contract:
+//   - Returned skills are a draft: the usecase re-validates every entry
+//     against the same rules Create applies and drops the ones that fail.
+//   - Returns ErrLLMUnavailable on any provider/transport failure so the
+//     usecase can fall back to the taxonomy keyword scan (DetectSkills).
+type SkillSuggester interface {
+	Suggest(ctx context.Context, title, description string) ([]domain.SkillWeight, error)
+}
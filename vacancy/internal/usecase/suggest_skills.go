@@ -0,0 +1,75 @@
+package usecase
+
+import (
+	"context"
+	"log/slog"
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	"github.com/artem13815/hr/vacancy/internal/domain"
+)
+
+// suggestTimeout is longer than classifyTimeout: the answer is a whole
+// skill matrix rather than one word, and the recruiter is explicitly
+// waiting on the "Подобрать навыки" button rather than on a save.
+const suggestTimeout = 15 * time.Second
+
+// SuggestSkills drafts a skill matrix for the vacancy editor. Nothing is
+// stored — the recruiter reviews the draft and sends it back through
+// Create/Update.
+//
+// The LLM is the happy path. Every suggestion is re-checked against the
+// same per-skill rules Create enforces (validateSkill) and the invalid ones
+// are dropped, so the draft always saves as-is. When the LLM is unavailable
+// or nothing it returned survives validation, the taxonomy keyword scan
+// takes over, mirroring how resolveRole falls back to DetectRole. Source
+// tells the caller which path produced the result.
+func (s *VacancyService) SuggestSkills(ctx context.Context, in domain.SuggestSkillsInput) (*domain.SuggestSkillsResult, error) {
+	if in.OwnerUserID == 0 {
+		return nil, ErrUnauthorized
+	}
+	if strings.TrimSpace(in.Title) == "" && strings.TrimSpace(in.Description) == "" {
+		return nil, ErrInvalidArgument
+	}
+	if utf8.RuneCountInString(in.Title) > maxTitleRunes || utf8.RuneCountInString(in.Description) > maxDescriptionRunes {
+		return nil, ErrInvalidArgument
+	}
+
+	cctx, cancel := context.WithTimeout(ctx, suggestTimeout)
+	defer cancel()
+
+	suggested, err := s.suggester.Suggest(cctx, in.Title, in.Description)
+	if err != nil {
+		slog.WarnContext(ctx, "skill suggester fell back to keyword detector",
+			"err", err, "title", in.Title)
+	} else if skills := s.validSuggestions(suggested); len(skills) > 0 {
+		return &domain.SuggestSkillsResult{Skills: skills, Source: domain.SkillSourceLLM}, nil
+	} else {
+		slog.WarnContext(ctx, "skill suggester returned no valid skills; using keyword detector",
+			"title", in.Title, "returned", len(suggested))
+	}
+
+	skills := DetectSkills(in.Title, in.Description, s.taxonomy)
+	if skills == nil {
+		skills = []domain.SkillWeight{}
+	}
+	return &domain.SuggestSkillsResult{Skills: skills, Source: domain.SkillSourceKeyword}, nil
+}
+
+// validSuggestions drops suggestions Create would reject, resolves the
+// both-flags case in favour of must-have, then canonicalizes and dedupes
+// through the taxonomy exactly like a submitted skill list.
+func (s *VacancyService) validSuggestions(suggested []domain.SkillWeight) []domain.SkillWeight {
+	out := make([]domain.SkillWeight, 0, len(suggested))
+	for _, sk := range suggested {
+		if validateSkill(sk) != nil {
+			continue
+		}
+		if sk.MustHave {
+			sk.NiceToHave = false
+		}
+		out = append(out, sk)
+	}
+	return normalizeSkills(out, s.taxonomy)
+}
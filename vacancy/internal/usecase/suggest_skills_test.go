@@ -0,0 +1,119 @@
+package usecase
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+	"gotest.tools/v3/assert"
+
+	"github.com/artem13815/hr/vacancy/internal/domain"
+)
+
+type SuggestSkillsSuite struct{ baseSuite }
+
+func suggestInput() domain.SuggestSkillsInput {
+	return domain.SuggestSkillsInput{
+		OwnerUserID: 1,
+		Title:       "Golang developer",
+		Description: "Требования:\n- postgres\n\nБудет плюсом: k8s",
+	}
+}
+
+func (s *SuggestSkillsSuite) TestLLMSuccess() {
+	t := s.T()
+	s.suggester.SuggestMock.Inspect(func(_ context.Context, title, description string) {
+		assert.Equal(t, title, "Golang developer")
+		assert.Assert(t, strings.Contains(description, "postgres"))
+	}).Return([]domain.SkillWeight{
+		{Name: "golang", Weight: 0.9, MustHave: true},
+		{Name: "Postgres", Weight: 0.6, MustHave: true},
+		{Name: "k8s", Weight: 0.3, NiceToHave: true},
+	}, nil)
+
+	got, err := s.svc.SuggestSkills(t.Context(), suggestInput())
+	assert.NilError(t, err)
+	assert.Equal(t, got.Source, domain.SkillSourceLLM)
+	assert.DeepEqual(t, got.Skills, []domain.SkillWeight{
+		{Name: "Go", Weight: 0.9, MustHave: true},
+		{Name: "PostgreSQL", Weight: 0.6, MustHave: true},
+		{Name: "Kubernetes", Weight: 0.3, NiceToHave: true},
+	})
+}
+
+// TestLLMInvalidEntriesDropped: entries Create would reject are dropped
+// instead of failing the draft; duplicates after canonicalization collapse
+// and both flags resolve to must-have.
+func (s *SuggestSkillsSuite) TestLLMInvalidEntriesDropped() {
+	t := s.T()
+	s.suggester.SuggestMock.Return([]domain.SkillWeight{
+		{Name: "Go", Weight: 1.2, MustHave: true},
+		{Name: " ", Weight: 0.5},
+		{Name: "Kafka", Weight: 0.7, MustHave: true, NiceToHave: true},
+		{Name: "golang", Weight: 0.4},
+		{Name: "kafka", Weight: 0.2},
+	}, nil)
+
+	got, err := s.svc.SuggestSkills(t.Context(), suggestInput())
+	assert.NilError(t, err)
+	assert.Equal(t, got.Source, domain.SkillSourceLLM)
+	assert.DeepEqual(t, got.Skills, []domain.SkillWeight{
+		{Name: "Kafka", Weight: 0.7, MustHave: true},
+		{Name: "Go", Weight: 0.4},
+	})
+}
+
+func (s *SuggestSkillsSuite) TestFallbackOnLLMUnavailable() {
+	t := s.T()
+	s.suggester.SuggestMock.Return(nil, ErrLLMUnavailable)
+
+	got, err := s.svc.SuggestSkills(t.Context(), suggestInput())
+	assert.NilError(t, err)
+	assert.Equal(t, got.Source, domain.SkillSourceKeyword)
+	assert.DeepEqual(t, got.Skills, []domain.SkillWeight{
+		{Name: "Go", Weight: detectedMustWeight, MustHave: true},
+		{Name: "PostgreSQL", Weight: detectedMustWeight, MustHave: true},
+		{Name: "Kubernetes", Weight: detectedNiceWeight, NiceToHave: true},
+	})
+}
+
+func (s *SuggestSkillsSuite) TestFallbackWhenNothingValid() {
+	t := s.T()
+	s.suggester.SuggestMock.Return([]domain.SkillWeight{{Name: "Go", Weight: -1}}, nil)
+
+	got, err := s.svc.SuggestSkills(t.Context(), domain.SuggestSkillsInput{
+		OwnerUserID: 1,
+		Title:       "Курьер",
+	})
+	assert.NilError(t, err)
+	assert.Equal(t, got.Source, domain.SkillSourceKeyword)
+	assert.Assert(t, got.Skills != nil)
+	assert.Equal(t, len(got.Skills), 0)
+}
+
+func (s *SuggestSkillsSuite) TestInvalidArgument() {
+	t := s.T()
+	cases := map[string]domain.SuggestSkillsInput{
+		"empty text":       {OwnerUserID: 1, Title: " ", Description: "\n"},
+		"title too long":   {OwnerUserID: 1, Title: strings.Repeat("я", maxTitleRunes+1)},
+		"description long": {OwnerUserID: 1, Title: "Go", Description: strings.Repeat("я", maxDescriptionRunes+1)},
+	}
+	for name, in := range cases {
+		got, err := s.svc.SuggestSkills(t.Context(), in)
+		assert.ErrorIs(t, err, ErrInvalidArgument, name)
+		assert.Assert(t, got == nil, name)
+	}
+}
+
+func (s *SuggestSkillsSuite) TestUnauthorized() {
+	t := s.T()
+	in := suggestInput()
+	in.OwnerUserID = 0
+
+	got, err := s.svc.SuggestSkills(t.Context(), in)
+	assert.ErrorIs(t, err, ErrUnauthorized)
+	assert.Assert(t, got == nil)
+}
+
+func TestSuggestSkillsSuite(t *testing.T) { suite.Run(t, new(SuggestSkillsSuite)) }
@@ -0,0 +1,33 @@
+package usecase
+
+import (
+	"cmp"
+	"context"
+
+	"github.com/artem13815/hr/vacancy/internal/domain"
+)
+
+// Ownership transfer batch bounds. One batch is one UPDATE, so the cap only
+// keeps the transaction short; the admin service loops until a batch comes
+// back short.
+const (
+	defaultTransferLimit = 200
+	maxTransferLimit     = 1000
+)
+
+// TransferVacancyOwnership hands up to in.Limit of FromUserID's vacancies to
+// ToUserID and returns how many moved. Deleted vacancies move too, so a
+// restore brings them back to the new owner. Calling it again moves the
+// next batch; zero means nothing is left, which also makes a repeated
+// transfer a no-op.
+func (s *VacancyService) TransferVacancyOwnership(ctx context.Context, in domain.TransferVacancyOwnershipInput) (uint32, error) {
+	if !in.IsAdmin {
+		return 0, ErrForbidden
+	}
+	if in.FromUserID == 0 || in.ToUserID == 0 || in.FromUserID == in.ToUserID || in.Limit > maxTransferLimit {
+		return 0, ErrInvalidArgument
+	}
+	in.Limit = cmp.Or(in.Limit, defaultTransferLimit)
+
+	return s.storage.TransferVacancyOwnership(ctx, in)
+}
@@ -0,0 +1,73 @@
+package usecase
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+	"gotest.tools/v3/assert"
+
+	"github.com/artem13815/hr/vacancy/internal/domain"
+)
+
+type TransferVacancyOwnershipSuite struct{ baseSuite }
+
+func (s *TransferVacancyOwnershipSuite) TestMovesBatch() {
+	t := s.T()
+	ctx := t.Context()
+	in := domain.TransferVacancyOwnershipInput{IsAdmin: true, ActorUserID: 9, FromUserID: 1, ToUserID: 2, Limit: 50}
+
+	s.storage.TransferVacancyOwnershipMock.Expect(ctx, in).Return(50, nil)
+
+	got, err := s.svc.TransferVacancyOwnership(ctx, in)
+	assert.NilError(t, err)
+	assert.Equal(t, got, uint32(50))
+}
+
+func (s *TransferVacancyOwnershipSuite) TestZeroLimitDefaults() {
+	t := s.T()
+	ctx := t.Context()
+
+	s.storage.TransferVacancyOwnershipMock.Expect(ctx, domain.TransferVacancyOwnershipInput{
+		IsAdmin: true, FromUserID: 1, ToUserID: 2, Limit: defaultTransferLimit,
+	}).Return(0, nil)
+
+	got, err := s.svc.TransferVacancyOwnership(ctx, domain.TransferVacancyOwnershipInput{IsAdmin: true, FromUserID: 1, ToUserID: 2})
+	assert.NilError(t, err)
+	assert.Equal(t, got, uint32(0))
+}
+
+func (s *TransferVacancyOwnershipSuite) TestForbiddenForNonAdmin() {
+	t := s.T()
+	_, err := s.svc.TransferVacancyOwnership(t.Context(), domain.TransferVacancyOwnershipInput{FromUserID: 1, ToUserID: 2})
+	assert.ErrorIs(t, err, ErrForbidden)
+}
+
+func (s *TransferVacancyOwnershipSuite) TestInvalidArgument() {
+	t := s.T()
+	for _, in := range []domain.TransferVacancyOwnershipInput{
+		{IsAdmin: true, ToUserID: 2},
+		{IsAdmin: true, FromUserID: 1},
+		{IsAdmin: true, FromUserID: 1, ToUserID: 1},
+		{IsAdmin: true, FromUserID: 1, ToUserID: 2, Limit: maxTransferLimit + 1},
+	} {
+		_, err := s.svc.TransferVacancyOwnership(t.Context(), in)
+		assert.ErrorIs(t, err, ErrInvalidArgument)
+	}
+}
+
+func (s *TransferVacancyOwnershipSuite) TestStorageError() {
+	t := s.T()
+	ctx := t.Context()
+	storageErr := errors.New("pgx: connection refused")
+	in := domain.TransferVacancyOwnershipInput{IsAdmin: true, FromUserID: 1, ToUserID: 2, Limit: 10}
+
+	s.storage.TransferVacancyOwnershipMock.Expect(ctx, in).Return(0, storageErr)
+
+	_, err := s.svc.TransferVacancyOwnership(ctx, in)
+	assert.ErrorIs(t, err, storageErr)
+}
+
+func TestTransferVacancyOwnershipSuite(t *testing.T) {
+	suite.Run(t, new(TransferVacancyOwnershipSuite))
+}
@@ -0,0 +1,54 @@
+package usecase
+
+import (
+	"context"
+	"strings"
+	"unicode/utf8"
+
+	"github.com/artem13815/hr/vacancy/internal/domain"
+)
+
+// TransitionVacancy moves a vacancy along the lifecycle state machine
+// (domain.CanTransition). Leaving archived goes through RestoreVacancy
+// instead, because the target status comes from the history, not the caller.
+func (s *VacancyService) TransitionVacancy(ctx context.Context, in domain.TransitionVacancyInput) (*domain.Vacancy, error) {
+	if in.OwnerUserID == 0 || in.VacancyID == "" || !domain.IsKnownStatus(in.ToStatus) {
+		return nil, ErrInvalidArgument
+	}
+	in.Reason = strings.TrimSpace(in.Reason)
+	if utf8.RuneCountInString(in.Reason) > maxReasonRunes {
+		return nil, ErrInvalidArgument
+	}
+
+	vacancy, err := s.editableVacancy(ctx, in.VacancyID, in.OwnerUserID, in.IsAdmin)
+	if err != nil {
+		return nil, err
+	}
+	if !domain.CanTransition(vacancy.Status, in.ToStatus) {
+		return nil, ErrInvalidTransition
+	}
+
+	return s.changeStatus(ctx, domain.ChangeVacancyStatusInput{
+		VacancyID:   in.VacancyID,
+		OwnerUserID: in.OwnerUserID,
+		IsAdmin:     in.IsAdmin,
+		FromStatus:  vacancy.Status,
+		ToStatus:    in.ToStatus,
+		Reason:      in.Reason,
+	})
+}
+
+// changeStatus writes an already-validated transition. Storage returns nil
+// when the vacancy left FromStatus between our read and the conditional
+// UPDATE; the transition was checked against a stale status, so it is
+// reported as invalid rather than retried.
+func (s *VacancyService) changeStatus(ctx context.Context, in domain.ChangeVacancyStatusInput) (*domain.Vacancy, error) {
+	vacancy, err := s.storage.ChangeVacancyStatus(ctx, in)
+	if err != nil {
+		return nil, err
+	}
+	if vacancy == nil {
+		return nil, ErrInvalidTransition
+	}
+	return vacancy, nil
+}
@@ -0,0 +1,158 @@
+package usecase
+
+import (
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+	"gotest.tools/v3/assert"
+
+	"github.com/artem13815/hr/vacancy/internal/domain"
+)
+
+type TransitionVacancySuite struct{ baseSuite }
+
+func (s *TransitionVacancySuite) TestSuccess() {
+	t := s.T()
+	ctx := t.Context()
+	want := &domain.Vacancy{ID: "v-1", OwnerUserID: 1, Status: domain.StatusPaused}
+
+	s.storage.GetVacancyMock.Expect(ctx, "v-1", uint64(1), false).Return(&domain.Vacancy{ID: "v-1", OwnerUserID: 1, Status: domain.StatusOpen}, nil)
+	s.storage.ChangeVacancyStatusMock.Expect(ctx, domain.ChangeVacancyStatusInput{
+		VacancyID:   "v-1",
+		OwnerUserID: 1,
+		FromStatus:  domain.StatusOpen,
+		ToStatus:    domain.StatusPaused,
+		Reason:      "budget frozen",
+	}).Return(want, nil)
+
+	got, err := s.svc.TransitionVacancy(ctx, domain.TransitionVacancyInput{
+		VacancyID:   "v-1",
+		OwnerUserID: 1,
+		ToStatus:    domain.StatusPaused,
+		Reason:      "  budget frozen ",
+	})
+	assert.NilError(t, err)
+	assert.Equal(t, got, want)
+}
+
+// TestStateMachine walks the allowed and forbidden edges so a change to
+// domain.transitions shows up here.
+func (s *TransitionVacancySuite) TestStateMachine() {
+	t := s.T()
+	cases := []struct {
+		from, to string
+		allowed  bool
+	}{
+		{domain.StatusDraft, domain.StatusOpen, true},
+		{domain.StatusDraft, domain.StatusPaused, false},
+		{domain.StatusDraft, domain.StatusClosed, false},
+		{domain.StatusOpen, domain.StatusPaused, true},
+		{domain.StatusOpen, domain.StatusClosed, true},
+		{domain.StatusOpen, domain.StatusFilled, true},
+		{domain.StatusOpen, domain.StatusDraft, false},
+		{domain.StatusPaused, domain.StatusOpen, true},
+		{domain.StatusPaused, domain.StatusFilled, true},
+		{domain.StatusClosed, domain.StatusOpen, true},
+		{domain.StatusClosed, domain.StatusPaused, false},
+		{domain.StatusFilled, domain.StatusOpen, false},
+		{domain.StatusFilled, domain.StatusArchived, true},
+		{domain.StatusArchived, domain.StatusOpen, false},
+		{domain.StatusOpen, domain.StatusOpen, false},
+	}
+	for _, c := range cases {
+		assert.Equal(t, domain.CanTransition(c.from, c.to), c.allowed, "%s -> %s", c.from, c.to)
+	}
+}
+
+func (s *TransitionVacancySuite) TestForbiddenTransition() {
+	t := s.T()
+	ctx := t.Context()
+
+	s.storage.GetVacancyMock.Expect(ctx, "v-1", uint64(1), false).Return(&domain.Vacancy{ID: "v-1", OwnerUserID: 1, Status: domain.StatusFilled}, nil)
+
+	got, err := s.svc.TransitionVacancy(ctx, domain.TransitionVacancyInput{VacancyID: "v-1", OwnerUserID: 1, ToStatus: domain.StatusOpen})
+	assert.ErrorIs(t, err, ErrInvalidTransition)
+	assert.Assert(t, got == nil)
+}
+
+// TestConcurrentChange: the vacancy left its status between the read and
+// the conditional UPDATE, so storage matched no row.
+func (s *TransitionVacancySuite) TestConcurrentChange() {
+	t := s.T()
+	ctx := t.Context()
+
+	s.storage.GetVacancyMock.Expect(ctx, "v-1", uint64(1), false).Return(&domain.Vacancy{ID: "v-1", OwnerUserID: 1, Status: domain.StatusOpen}, nil)
+	s.storage.ChangeVacancyStatusMock.Return(nil, nil)
+
+	got, err := s.svc.TransitionVacancy(ctx, domain.TransitionVacancyInput{VacancyID: "v-1", OwnerUserID: 1, ToStatus: domain.StatusClosed})
+	assert.ErrorIs(t, err, ErrInvalidTransition)
+	assert.Assert(t, got == nil)
+}
+
+func (s *TransitionVacancySuite) TestNotFound() {
+	t := s.T()
+	ctx := t.Context()
+
+	s.storage.GetVacancyMock.Expect(ctx, "v-1", uint64(1), false).Return(nil, nil)
+
+	got, err := s.svc.TransitionVacancy(ctx, domain.TransitionVacancyInput{VacancyID: "v-1", OwnerUserID: 1, ToStatus: domain.StatusOpen})
+	assert.ErrorIs(t, err, ErrVacancyNotFound)
+	assert.Assert(t, got == nil)
+}
+
+func (s *TransitionVacancySuite) TestInvalidArgument() {
+	t := s.T()
+	cases := []domain.TransitionVacancyInput{
+		{VacancyID: "v-1", ToStatus: domain.StatusOpen},
+		{OwnerUserID: 1, ToStatus: domain.StatusOpen},
+		{VacancyID: "v-1", OwnerUserID: 1},
+		{VacancyID: "v-1", OwnerUserID: 1, ToStatus: "active"},
+		{VacancyID: "v-1", OwnerUserID: 1, ToStatus: domain.StatusOpen, Reason: strings.Repeat("я", maxReasonRunes+1)},
+	}
+	for _, in := range cases {
+		got, err := s.svc.TransitionVacancy(t.Context(), in)
+		assert.ErrorIs(t, err, ErrInvalidArgument)
+		assert.Assert(t, got == nil)
+	}
+}
+
+func (s *TransitionVacancySuite) TestStorageError() {
+	t := s.T()
+	ctx := t.Context()
+	storageErr := errors.New("pgx: connection refused")
+
+	s.storage.GetVacancyMock.Expect(ctx, "v-1", uint64(1), false).Return(nil, storageErr)
+
+	got, err := s.svc.TransitionVacancy(ctx, domain.TransitionVacancyInput{VacancyID: "v-1", OwnerUserID: 1, ToStatus: domain.StatusOpen})
+	assert.ErrorIs(t, err, storageErr)
+	assert.Assert(t, got == nil)
+}
+
+// TestViewerForbidden: a viewer collaborator can read the vacancy but is
+// turned away before the transition is even checked.
+func (s *TransitionVacancySuite) TestViewerForbidden() {
+	t := s.T()
+	ctx := t.Context()
+	s.storage.GetVacancyMock.Return(&domain.Vacancy{ID: "v-1", OwnerUserID: 1, Status: domain.StatusFilled}, nil)
+	s.storage.CanEditVacancyMock.Expect(ctx, "v-1", uint64(8)).Return(false, nil)
+
+	got, err := s.svc.TransitionVacancy(ctx, domain.TransitionVacancyInput{VacancyID: "v-1", OwnerUserID: 8, ToStatus: domain.StatusOpen})
+	assert.ErrorIs(t, err, ErrForbidden)
+	assert.Assert(t, got == nil)
+}
+
+func (s *TransitionVacancySuite) TestEditorCollaborator() {
+	t := s.T()
+	ctx := t.Context()
+	s.storage.GetVacancyMock.Return(&domain.Vacancy{ID: "v-1", OwnerUserID: 1, Status: domain.StatusOpen}, nil)
+	s.storage.CanEditVacancyMock.Expect(ctx, "v-1", uint64(8)).Return(true, nil)
+	s.storage.ChangeVacancyStatusMock.Return(&domain.Vacancy{ID: "v-1", Status: domain.StatusPaused}, nil)
+
+	got, err := s.svc.TransitionVacancy(ctx, domain.TransitionVacancyInput{VacancyID: "v-1", OwnerUserID: 8, ToStatus: domain.StatusPaused})
+	assert.NilError(t, err)
+	assert.Equal(t, got.Status, domain.StatusPaused)
+}
+
+func TestTransitionVacancySuite(t *testing.T) { suite.Run(t, new(TransitionVacancySuite)) }
@@ -2,6 +2,7 @@ package usecase
 
 import (
 	"context"
+	"fmt"
 	"strings"
 
 	"github.com/artem13815/hr/vacancy/internal/domain"
@@ -17,9 +18,32 @@ func (s *VacancyService) UpdateVacancy(ctx context.Context, in domain.UpdateVaca
 	if len(in.Skills) == 0 {
 		return nil, ErrInvalidArgument
 	}
+	if strings.TrimSpace(in.Role) != "" && in.UnlockRole {
+		return nil, fmt.Errorf("%w: role and unlock_role are mutually exclusive", ErrInvalidArgument)
+	}
+
+	if in.Attributes != nil {
+		attrs := normalizeAttributes(*in.Attributes)
+		if err := validateAttributes(attrs); err != nil {
+			return nil, err
+		}
+		in.Attributes = &attrs
+	}
+	if in.ScoringPolicy != nil {
+		if err := validateScoringPolicy(*in.ScoringPolicy); err != nil {
+			return nil, err
+		}
+	}
+
+	current, err := s.editableVacancy(ctx, in.VacancyID, in.OwnerUserID, in.IsAdmin)
+	if err != nil {
+		return nil, err
+	}
 
-	in.Skills = normalizeSkills(in.Skills)
-	in.Role = s.resolveRole(ctx, in.Title, in.Description)
+	in.Skills = normalizeSkills(in.Skills, s.taxonomy)
+	if err := s.updateRole(ctx, &in, current); err != nil {
+		return nil, err
+	}
 	updated, err := s.storage.UpdateVacancy(ctx, in)
 	if err != nil {
 		return nil, err
@@ -30,3 +54,28 @@ func (s *VacancyService) UpdateVacancy(ctx context.Context, in domain.UpdateVaca
 
 	return updated, nil
 }
+
+// updateRole decides the role an update stores. A role from the caller is
+// pinned. Otherwise a pinned role is kept without asking the classifier,
+// unless the update releases the lock; then classification picks the role
+// like it does for an unlocked vacancy. current is the vacancy as read
+// before the update.
+func (s *VacancyService) updateRole(ctx context.Context, in *domain.UpdateVacancyInput, current *domain.Vacancy) error {
+	if strings.TrimSpace(in.Role) != "" {
+		role, err := s.pinnedRole(ctx, in.Role)
+		if err != nil {
+			return err
+		}
+		in.Role, in.RoleSource, in.RoleLocked = role, domain.RoleSourceManual, true
+		return nil
+	}
+
+	if !in.UnlockRole && current.RoleLocked {
+		in.Role, in.RoleSource, in.RoleLocked = current.Role, domain.RoleSourceManual, true
+		return nil
+	}
+
+	in.Role, in.RoleSource = s.resolveRole(ctx, in.Title, in.Description)
+	in.RoleLocked = false
+	return nil
+}
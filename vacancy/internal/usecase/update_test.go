@@ -24,8 +24,10 @@ func (s *UpdateVacancySuite) TestSuccess() {
 	}
 	expected := in
 	expected.Role = "programmer"
+	expected.RoleSource = domain.RoleSourceLLM
 	want := &domain.Vacancy{ID: "v-1", Title: in.Title}
 
+	s.storage.GetVacancyMock.Return(&domain.Vacancy{ID: in.VacancyID, OwnerUserID: in.OwnerUserID}, nil)
 	s.classifier.ClassifyMock.Return("programmer", nil)
 	s.storage.UpdateVacancyMock.Expect(ctx, expected).Return(want, nil)
 
@@ -48,8 +50,10 @@ func (s *UpdateVacancySuite) TestClassifierFallsBackToKeywords() {
 	}
 	expected := in
 	expected.Role = "programmer"
+	expected.RoleSource = domain.RoleSourceKeyword
 	want := &domain.Vacancy{ID: "v-1"}
 
+	s.storage.GetVacancyMock.Return(&domain.Vacancy{ID: in.VacancyID, OwnerUserID: in.OwnerUserID}, nil)
 	s.classifier.ClassifyMock.Return("", ErrLLMUnavailable)
 	s.storage.UpdateVacancyMock.Expect(ctx, expected).Return(want, nil)
 
@@ -77,6 +81,7 @@ func (s *UpdateVacancySuite) TestNormalizesZeroWeightedSkills() {
 		OwnerUserID: 1,
 		Title:       "Lead",
 		Role:        "default",
+		RoleSource:  domain.RoleSourceLLM,
 		Skills: []domain.SkillWeight{
 			{Name: "Go", Weight: 0.5},
 			{Name: "SQL", Weight: 0.5},
@@ -84,6 +89,7 @@ func (s *UpdateVacancySuite) TestNormalizesZeroWeightedSkills() {
 	}
 	want := &domain.Vacancy{ID: "v-1"}
 
+	s.storage.GetVacancyMock.Return(&domain.Vacancy{ID: in.VacancyID, OwnerUserID: in.OwnerUserID}, nil)
 	s.classifier.ClassifyMock.Return("default", nil)
 	s.storage.UpdateVacancyMock.Expect(ctx, expected).Return(want, nil)
 
@@ -151,7 +157,9 @@ func (s *UpdateVacancySuite) TestNotFoundOnNilStorageResult() {
 	}
 	expected := in
 	expected.Role = "default"
+	expected.RoleSource = domain.RoleSourceLLM
 
+	s.storage.GetVacancyMock.Return(&domain.Vacancy{ID: in.VacancyID, OwnerUserID: in.OwnerUserID}, nil)
 	s.classifier.ClassifyMock.Return("default", nil)
 	s.storage.UpdateVacancyMock.Expect(ctx, expected).Return(nil, nil)
 
@@ -160,6 +168,61 @@ func (s *UpdateVacancySuite) TestNotFoundOnNilStorageResult() {
 	assert.Assert(t, got == nil)
 }
 
+// TestExpectedVersionForwarded: the optimistic-concurrency precondition is
+// storage's job; the usecase only has to pass it through untouched.
+func (s *UpdateVacancySuite) TestExpectedVersionForwarded() {
+	t := s.T()
+	ctx := t.Context()
+	in := domain.UpdateVacancyInput{
+		VacancyID:       "v-1",
+		OwnerUserID:     1,
+		ExpectedVersion: 3,
+		Title:           "ok",
+		Skills:          []domain.SkillWeight{{Name: "Go", Weight: 0.5}},
+	}
+	expected := in
+	expected.Role = "default"
+	expected.RoleSource = domain.RoleSourceLLM
+	want := &domain.Vacancy{ID: "v-1", Version: 4}
+
+	s.storage.GetVacancyMock.Return(&domain.Vacancy{ID: in.VacancyID, OwnerUserID: in.OwnerUserID}, nil)
+	s.classifier.ClassifyMock.Return("default", nil)
+	s.storage.UpdateVacancyMock.Expect(ctx, expected).Return(want, nil)
+
+	got, err := s.svc.UpdateVacancy(ctx, in)
+	assert.NilError(t, err)
+	assert.DeepEqual(t, got, want)
+}
+
+// TestVersionConflict: a stale ExpectedVersion surfaces as
+// ErrVersionConflict with the current version still reachable via
+// errors.As — transport needs it for the ErrorInfo metadata.
+func (s *UpdateVacancySuite) TestVersionConflict() {
+	t := s.T()
+	ctx := t.Context()
+	in := domain.UpdateVacancyInput{
+		VacancyID:       "v-1",
+		OwnerUserID:     1,
+		ExpectedVersion: 3,
+		Title:           "ok",
+		Skills:          []domain.SkillWeight{{Name: "Go", Weight: 0.5}},
+	}
+	expected := in
+	expected.Role = "default"
+	expected.RoleSource = domain.RoleSourceLLM
+
+	s.storage.GetVacancyMock.Return(&domain.Vacancy{ID: in.VacancyID, OwnerUserID: in.OwnerUserID}, nil)
+	s.classifier.ClassifyMock.Return("default", nil)
+	s.storage.UpdateVacancyMock.Expect(ctx, expected).Return(nil, &domain.VersionConflictError{CurrentVersion: 5})
+
+	got, err := s.svc.UpdateVacancy(ctx, in)
+	assert.ErrorIs(t, err, ErrVersionConflict)
+	var conflict *domain.VersionConflictError
+	assert.Assert(t, errors.As(err, &conflict))
+	assert.Equal(t, conflict.CurrentVersion, uint32(5))
+	assert.Assert(t, got == nil)
+}
+
 func (s *UpdateVacancySuite) TestStorageError() {
 	t := s.T()
 	ctx := t.Context()
@@ -171,8 +234,10 @@ func (s *UpdateVacancySuite) TestStorageError() {
 	}
 	expected := in
 	expected.Role = "default"
+	expected.RoleSource = domain.RoleSourceLLM
 	storageErr := errors.New("pgx: connection refused")
 
+	s.storage.GetVacancyMock.Return(&domain.Vacancy{ID: in.VacancyID, OwnerUserID: in.OwnerUserID}, nil)
 	s.classifier.ClassifyMock.Return("default", nil)
 	s.storage.UpdateVacancyMock.Expect(ctx, expected).Return(nil, storageErr)
 
@@ -181,4 +246,183 @@ func (s *UpdateVacancySuite) TestStorageError() {
 	assert.Assert(t, got == nil)
 }
 
+// TestAttributesNormalised: attributes sent with the update are normalised
+// and validated like on create; omitting them (nil) keeps the stored ones,
+// which TestSuccess covers.
+func (s *UpdateVacancySuite) TestAttributesNormalised() {
+	t := s.T()
+	ctx := t.Context()
+	in := domain.UpdateVacancyInput{
+		VacancyID:   "v-1",
+		OwnerUserID: 1,
+		Title:       "Senior Backend",
+		Skills:      []domain.SkillWeight{{Name: "Go", Weight: 0.7}},
+		Attributes:  &domain.VacancyAttributes{Seniority: "Senior", SalaryMax: 400000, SalaryCurrency: "rub"},
+	}
+	expected := in
+	expected.Role = "programmer"
+	expected.RoleSource = domain.RoleSourceLLM
+	expected.Attributes = &domain.VacancyAttributes{
+		Seniority:      domain.SenioritySenior,
+		SalaryMax:      400000,
+		SalaryCurrency: "RUB",
+		Headcount:      1,
+	}
+	want := &domain.Vacancy{ID: "v-1", Title: in.Title}
+
+	s.storage.GetVacancyMock.Return(&domain.Vacancy{ID: in.VacancyID, OwnerUserID: in.OwnerUserID}, nil)
+	s.classifier.ClassifyMock.Return("programmer", nil)
+	s.storage.UpdateVacancyMock.Expect(ctx, expected).Return(want, nil)
+
+	got, err := s.svc.UpdateVacancy(ctx, in)
+	assert.NilError(t, err)
+	assert.DeepEqual(t, got, want)
+}
+
+func (s *UpdateVacancySuite) TestInvalidArgumentAttributes() {
+	t := s.T()
+	got, err := s.svc.UpdateVacancy(t.Context(), domain.UpdateVacancyInput{
+		VacancyID:   "v-1",
+		OwnerUserID: 1,
+		Title:       "Senior Backend",
+		Skills:      []domain.SkillWeight{{Name: "Go", Weight: 0.7}},
+		Attributes:  &domain.VacancyAttributes{SalaryMin: 100000},
+	})
+	assert.ErrorIs(t, err, ErrInvalidArgument)
+	assert.Assert(t, got == nil)
+}
+
+func (s *UpdateVacancySuite) TestInvalidArgumentScoringPolicy() {
+	t := s.T()
+	got, err := s.svc.UpdateVacancy(t.Context(), domain.UpdateVacancyInput{
+		VacancyID:     "v-1",
+		OwnerUserID:   1,
+		Title:         "Senior Backend",
+		Skills:        []domain.SkillWeight{{Name: "Go", Weight: 0.7}},
+		ScoringPolicy: &domain.ScoringPolicy{MustHavePenalty: -5, HireThreshold: 75, MaybeThreshold: 45},
+	})
+	assert.ErrorIs(t, err, ErrInvalidArgument)
+	assert.Assert(t, got == nil)
+}
+
+// TestPinsExplicitRole: a role from the caller is pinned over the current
+// one without asking the classifier.
+func (s *UpdateVacancySuite) TestPinsExplicitRole() {
+	t := s.T()
+	ctx := t.Context()
+	in := domain.UpdateVacancyInput{
+		VacancyID:   "v-1",
+		OwnerUserID: 1,
+		Title:       "Data Engineer",
+		Role:        "analyst",
+		Skills:      []domain.SkillWeight{{Name: "Go", Weight: 0.5}},
+	}
+	expected := in
+	expected.RoleSource = domain.RoleSourceManual
+	expected.RoleLocked = true
+
+	s.storage.GetVacancyMock.Return(&domain.Vacancy{ID: "v-1", OwnerUserID: 1, Role: "programmer", RoleLocked: true}, nil)
+	s.classifier.ListRolesMock.Return(testRoles, nil)
+	s.storage.UpdateVacancyMock.Expect(ctx, expected).Return(&domain.Vacancy{ID: "v-1"}, nil)
+
+	_, err := s.svc.UpdateVacancy(ctx, in)
+	assert.NilError(t, err)
+}
+
+// TestKeepsLockedRole: without a role from the caller a pinned role
+// survives the update and the classifier is not called.
+func (s *UpdateVacancySuite) TestKeepsLockedRole() {
+	t := s.T()
+	ctx := t.Context()
+	in := domain.UpdateVacancyInput{
+		VacancyID:   "v-1",
+		OwnerUserID: 1,
+		Title:       "Senior Go Developer",
+		Skills:      []domain.SkillWeight{{Name: "Go", Weight: 0.5}},
+	}
+	expected := in
+	expected.Role = "analyst"
+	expected.RoleSource = domain.RoleSourceManual
+	expected.RoleLocked = true
+
+	s.storage.GetVacancyMock.Expect(ctx, in.VacancyID, in.OwnerUserID, in.IsAdmin).
+		Return(&domain.Vacancy{ID: "v-1", OwnerUserID: 1, Role: "analyst", RoleSource: domain.RoleSourceManual, RoleLocked: true}, nil)
+	s.storage.UpdateVacancyMock.Expect(ctx, expected).Return(&domain.Vacancy{ID: "v-1"}, nil)
+
+	_, err := s.svc.UpdateVacancy(ctx, in)
+	assert.NilError(t, err)
+}
+
+// TestUnlockRoleReclassifies: releasing the lock hands the role back to the
+// classifier whatever the current lock is.
+func (s *UpdateVacancySuite) TestUnlockRoleReclassifies() {
+	t := s.T()
+	ctx := t.Context()
+	in := domain.UpdateVacancyInput{
+		VacancyID:   "v-1",
+		OwnerUserID: 1,
+		Title:       "Senior Go Developer",
+		Skills:      []domain.SkillWeight{{Name: "Go", Weight: 0.5}},
+		UnlockRole:  true,
+	}
+	expected := in
+	expected.Role = "programmer"
+	expected.RoleSource = domain.RoleSourceLLM
+
+	s.storage.GetVacancyMock.Return(&domain.Vacancy{ID: "v-1", OwnerUserID: 1, RoleLocked: true}, nil)
+	s.classifier.ClassifyMock.Return("programmer", nil)
+	s.storage.UpdateVacancyMock.Expect(ctx, expected).Return(&domain.Vacancy{ID: "v-1"}, nil)
+
+	_, err := s.svc.UpdateVacancy(ctx, in)
+	assert.NilError(t, err)
+}
+
+func (s *UpdateVacancySuite) TestInvalidArgumentRoleWithUnlock() {
+	t := s.T()
+	got, err := s.svc.UpdateVacancy(t.Context(), domain.UpdateVacancyInput{
+		VacancyID:   "v-1",
+		OwnerUserID: 1,
+		Title:       "ok",
+		Role:        "analyst",
+		Skills:      []domain.SkillWeight{{Name: "Go", Weight: 0.5}},
+		UnlockRole:  true,
+	})
+	assert.ErrorIs(t, err, ErrInvalidArgument)
+	assert.Assert(t, got == nil)
+}
+
+// TestNotFoundBeforeClassifying: the access check already tells a missing
+// vacancy apart, so the classifier is not paid for.
+func (s *UpdateVacancySuite) TestNotFoundBeforeClassifying() {
+	t := s.T()
+	s.storage.GetVacancyMock.Return(nil, nil)
+
+	got, err := s.svc.UpdateVacancy(t.Context(), domain.UpdateVacancyInput{
+		VacancyID:   "missing",
+		OwnerUserID: 1,
+		Title:       "ok",
+		Skills:      []domain.SkillWeight{{Name: "Go", Weight: 0.5}},
+	})
+	assert.ErrorIs(t, err, ErrVacancyNotFound)
+	assert.Assert(t, got == nil)
+}
+
+// TestViewerForbidden: a viewer is turned away before the classifier or
+// storage write is reached.
+func (s *UpdateVacancySuite) TestViewerForbidden() {
+	t := s.T()
+	ctx := t.Context()
+	s.storage.GetVacancyMock.Return(&domain.Vacancy{ID: "v-1", OwnerUserID: 1}, nil)
+	s.storage.CanEditVacancyMock.Expect(ctx, "v-1", uint64(8)).Return(false, nil)
+
+	got, err := s.svc.UpdateVacancy(ctx, domain.UpdateVacancyInput{
+		VacancyID:   "v-1",
+		OwnerUserID: 8,
+		Title:       "ok",
+		Skills:      []domain.SkillWeight{{Name: "Go", Weight: 0.5}},
+	})
+	assert.ErrorIs(t, err, ErrForbidden)
+	assert.Assert(t, got == nil)
+}
+
 func TestUpdateVacancySuite(t *testing.T) { suite.Run(t, new(UpdateVacancySuite)) }
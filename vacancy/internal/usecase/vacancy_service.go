@@ -1,30 +1,67 @@
 package usecase
 
-//go:generate go run github.com/gojuno/minimock/v3/cmd/minimock@v3.4.7 -i VacancyStorage,RoleClassifier -o ./mocks -s _mock.go -g
+//go:generate go run github.com/gojuno/minimock/v3/cmd/minimock@v3.4.7 -i VacancyStorage,RoleClassifier,SkillSuggester -o ./mocks -s _mock.go -g
 
 import (
 	"context"
+	"time"
 
 	"github.com/artem13815/hr/vacancy/internal/domain"
 )
 
 type VacancyStorage interface {
 	CreateVacancy(ctx context.Context, in domain.CreateVacancyInput) (*domain.Vacancy, error)
+	CreateVacancies(ctx context.Context, ins []domain.CreateVacancyInput) ([]*domain.Vacancy, error)
+	ListDuplicateCandidates(ctx context.Context, title string, ownerUserID uint64, isAdmin bool, limit int) ([]domain.DuplicateCandidate, error)
 	GetVacancy(ctx context.Context, vacancyID string, ownerUserID uint64, isAdmin bool) (*domain.Vacancy, error)
 	ListVacancies(ctx context.Context, in domain.ListVacanciesInput) (*domain.ListVacanciesResult, error)
 	UpdateVacancy(ctx context.Context, in domain.UpdateVacancyInput) (*domain.Vacancy, error)
-	ArchiveVacancy(ctx context.Context, in domain.ArchiveVacancyInput) error
+	DeleteVacancy(ctx context.Context, in domain.DeleteVacancyInput) (bool, error)
+	UndeleteVacancy(ctx context.Context, in domain.UndeleteVacancyInput) (*domain.Vacancy, error)
+	TransferVacancyOwnership(ctx context.Context, in domain.TransferVacancyOwnershipInput) (uint32, error)
+	ChangeVacancyStatus(ctx context.Context, in domain.ChangeVacancyStatusInput) (*domain.Vacancy, error)
+	StatusBeforeArchive(ctx context.Context, vacancyID string) (string, error)
+	ListVacancyStatusHistory(ctx context.Context, in domain.ListVacancyStatusHistoryInput) (*domain.ListVacancyStatusHistoryResult, error)
+	ListVacancyVersions(ctx context.Context, in domain.ListVacancyVersionsInput) (*domain.ListVacancyVersionsResult, error)
+	GetVacancyVersion(ctx context.Context, in domain.GetVacancyVersionInput) (*domain.VacancyVersion, error)
+	CreateTemplate(ctx context.Context, in domain.InsertTemplateInput) (*domain.VacancyTemplate, error)
+	GetTemplate(ctx context.Context, in domain.GetTemplateInput) (*domain.VacancyTemplate, error)
+	ListTemplates(ctx context.Context, in domain.ListTemplatesInput) (*domain.ListTemplatesResult, error)
+	DeleteTemplate(ctx context.Context, templateID string) (bool, error)
+	SetVacancyVisibility(ctx context.Context, in domain.SetVacancyVisibilityInput) (*domain.Vacancy, error)
+	GetPublicVacancy(ctx context.Context, vacancyID string) (*domain.Vacancy, error)
+	ListPublicVacancies(ctx context.Context, in domain.ListPublicVacanciesInput) ([]domain.Vacancy, error)
+	AddCollaborator(ctx context.Context, in domain.AddCollaboratorInput) (*domain.Collaborator, error)
+	RemoveCollaborator(ctx context.Context, vacancyID string, userID uint64) (bool, error)
+	ListCollaborators(ctx context.Context, vacancyID string) ([]domain.Collaborator, error)
+	CanEditVacancy(ctx context.Context, vacancyID string, userID uint64) (bool, error)
+	ListRoleClassificationTargets(ctx context.Context, in domain.ReclassifyRolesInput) ([]domain.RoleClassificationTarget, error)
+	SetVacancyRole(ctx context.Context, in domain.SetVacancyRoleInput) (bool, error)
+	GetPipelineStages(ctx context.Context, vacancyID string) ([]domain.PipelineStage, error)
+	CountCandidatesByStage(ctx context.Context, vacancyID string) (map[string]uint32, error)
+	SetPipelineStages(ctx context.Context, in domain.SetPipelineStagesInput) (bool, error)
+	GetCandidateStage(ctx context.Context, vacancyID, candidateID string) (string, error)
+	ChangeCandidateStage(ctx context.Context, in domain.ChangeCandidateStageInput) (*domain.ChangeCandidateStageResult, error)
+	ListCandidateStageHistory(ctx context.Context, vacancyID, candidateID string) ([]domain.CandidateStageChange, error)
 }
 
 type VacancyService struct {
 	storage    VacancyStorage
 	classifier RoleClassifier
+	suggester  SkillSuggester
+	taxonomy   *domain.SkillTaxonomy
+	// restoreWindow is the soft-delete grace period: RestoreVacancy only
+	// undeletes vacancies deleted less than this long ago.
+	restoreWindow time.Duration
 }
 
-// NewVacancyService wires the storage with the role classifier. Both are
-// required — when the LLM stack is intentionally absent (e.g. some test
-// harness), pass a stub classifier that returns ErrLLMUnavailable so the
-// usecase falls back to the keyword detector cleanly.
-func NewVacancyService(storage VacancyStorage, classifier RoleClassifier) *VacancyService {
-	return &VacancyService{storage: storage, classifier: classifier}
+// NewVacancyService wires the storage with the role classifier and the
+// skill suggester. All three are required — when the LLM stack is
+// intentionally absent (e.g. some test harness), pass stubs that return
+// ErrLLMUnavailable so the usecase falls back to the keyword detectors
+// cleanly. taxonomy may be nil,
+// which leaves skill names exactly as the client sent them. restoreWindow
+// is the retention grace period the purge job runs with.
+func NewVacancyService(storage VacancyStorage, classifier RoleClassifier, suggester SkillSuggester, taxonomy *domain.SkillTaxonomy, restoreWindow time.Duration) *VacancyService {
+	return &VacancyService{storage: storage, classifier: classifier, suggester: suggester, taxonomy: taxonomy, restoreWindow: restoreWindow}
 }
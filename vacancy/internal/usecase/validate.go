@@ -1,6 +1,8 @@
 package usecase
 
 import (
+	"fmt"
+	"math"
 	"strings"
 	"unicode/utf8"
 
@@ -15,40 +17,181 @@ import (
 const (
 	maxTitleRunes       = 255
 	maxDescriptionRunes = 4000
+	maxReasonRunes      = 1000
+	maxQueryRunes       = 200
+	maxTemplateRunes    = 255
+	maxSkillQueryRunes  = 64
+	maxLocationRunes    = 255
+	maxHeadcount        = 10000
+
+	// maxScore bounds the scoring policy: scores are on a 0–100 scale, so
+	// neither a threshold nor a single penalty or bonus can exceed it.
+	maxScore = 100
 )
 
+// validateCreateInput returns ErrInvalidArgument wrapped with the reason,
+// so ImportVacancies can report it per row; transport only matches the
+// sentinel.
 func validateCreateInput(in domain.CreateVacancyInput) error {
 	if in.OwnerUserID == 0 {
 		return ErrUnauthorized
 	}
 	if strings.TrimSpace(in.Title) == "" {
-		return ErrInvalidArgument
+		return fmt.Errorf("%w: title is required", ErrInvalidArgument)
 	}
 	if utf8.RuneCountInString(in.Title) > maxTitleRunes {
-		return ErrInvalidArgument
+		return fmt.Errorf("%w: title exceeds %d characters", ErrInvalidArgument, maxTitleRunes)
 	}
 	if utf8.RuneCountInString(in.Description) > maxDescriptionRunes {
-		return ErrInvalidArgument
+		return fmt.Errorf("%w: description exceeds %d characters", ErrInvalidArgument, maxDescriptionRunes)
+	}
+	// A vacancy starts either as a draft or already open; every other status
+	// is reachable only through TransitionVacancy.
+	if in.Status != "" && in.Status != domain.StatusDraft && in.Status != domain.StatusOpen {
+		return fmt.Errorf("%w: status must be draft or open", ErrInvalidArgument)
 	}
 	if len(in.Skills) == 0 {
-		return ErrInvalidArgument
+		return fmt.Errorf("%w: at least one skill is required", ErrInvalidArgument)
 	}
 	for _, s := range in.Skills {
-		if strings.TrimSpace(s.Name) == "" {
-			return ErrInvalidArgument
+		if err := validateSkill(s); err != nil {
+			return err
 		}
-		if s.Weight < 0 || s.Weight > 1 {
-			return ErrInvalidArgument
+	}
+	if err := validateAttributes(in.Attributes); err != nil {
+		return err
+	}
+	if in.ScoringPolicy != nil {
+		return validateScoringPolicy(*in.ScoringPolicy)
+	}
+	return nil
+}
+
+// validateScoringPolicy keeps penalties, bonuses and thresholds on the
+// 0–100 score scale and the maybe tier at or below the hire one. A zero
+// maybe threshold would recommend everyone, so it must be positive. Shared
+// by create and update. NaN and ±Inf are rejected first: NaN slips through
+// every comparison below and would only fail the table's CHECK.
+func validateScoringPolicy(p domain.ScoringPolicy) error {
+	for _, v := range []float32{p.MustHavePenalty, p.NiceToHaveBonus, p.HireThreshold, p.MaybeThreshold} {
+		if math.IsNaN(float64(v)) || math.IsInf(float64(v), 0) {
+			return fmt.Errorf("%w: scoring policy values must be finite numbers", ErrInvalidArgument)
 		}
 	}
+	if p.MustHavePenalty < 0 || p.MustHavePenalty > maxScore {
+		return fmt.Errorf("%w: must-have penalty must be between 0 and %d", ErrInvalidArgument, maxScore)
+	}
+	if p.NiceToHaveBonus < 0 || p.NiceToHaveBonus > maxScore {
+		return fmt.Errorf("%w: nice-to-have bonus must be between 0 and %d", ErrInvalidArgument, maxScore)
+	}
+	if p.HireThreshold <= 0 || p.HireThreshold > maxScore {
+		return fmt.Errorf("%w: hire threshold must be above 0 and at most %d", ErrInvalidArgument, maxScore)
+	}
+	if p.MaybeThreshold <= 0 || p.MaybeThreshold > p.HireThreshold {
+		return fmt.Errorf("%w: maybe threshold must be above 0 and at most the hire threshold", ErrInvalidArgument)
+	}
 	return nil
 }
 
-func normalizeSkills(skills []domain.SkillWeight) []domain.SkillWeight {
+// validateAttributes checks attributes already passed through
+// normalizeAttributes. Shared by create, import, clone and update.
+func validateAttributes(a domain.VacancyAttributes) error {
+	if utf8.RuneCountInString(a.Location) > maxLocationRunes {
+		return fmt.Errorf("%w: location exceeds %d characters", ErrInvalidArgument, maxLocationRunes)
+	}
+	if a.RemotePolicy != "" && !domain.IsKnownRemotePolicy(a.RemotePolicy) {
+		return fmt.Errorf("%w: unknown remote policy %q", ErrInvalidArgument, a.RemotePolicy)
+	}
+	if a.EmploymentType != "" && !domain.IsKnownEmploymentType(a.EmploymentType) {
+		return fmt.Errorf("%w: unknown employment type %q", ErrInvalidArgument, a.EmploymentType)
+	}
+	if a.Seniority != "" && !domain.IsKnownSeniority(a.Seniority) {
+		return fmt.Errorf("%w: unknown seniority %q", ErrInvalidArgument, a.Seniority)
+	}
+	if a.SalaryMax > 0 && a.SalaryMin > a.SalaryMax {
+		return fmt.Errorf("%w: salary_min exceeds salary_max", ErrInvalidArgument)
+	}
+	if (a.SalaryMin > 0 || a.SalaryMax > 0) && a.SalaryCurrency == "" {
+		return fmt.Errorf("%w: salary currency is required with a salary", ErrInvalidArgument)
+	}
+	if a.SalaryCurrency != "" && !isCurrencyCode(a.SalaryCurrency) {
+		return fmt.Errorf("%w: salary currency must be an ISO 4217 code", ErrInvalidArgument)
+	}
+	// Salaries go to BIGINT columns.
+	if a.SalaryMin > math.MaxInt64 || a.SalaryMax > math.MaxInt64 {
+		return fmt.Errorf("%w: salary is out of range", ErrInvalidArgument)
+	}
+	if a.Headcount < 1 || a.Headcount > maxHeadcount {
+		return fmt.Errorf("%w: headcount must be between 1 and %d", ErrInvalidArgument, maxHeadcount)
+	}
+	return nil
+}
+
+// normalizeAttributes trims free text, upper-cases the currency, lower-cases
+// the enumerated values and defaults the headcount to one hire.
+func normalizeAttributes(a domain.VacancyAttributes) domain.VacancyAttributes {
+	a.Location = strings.TrimSpace(a.Location)
+	a.RemotePolicy = strings.ToLower(strings.TrimSpace(a.RemotePolicy))
+	a.EmploymentType = strings.ToLower(strings.TrimSpace(a.EmploymentType))
+	a.Seniority = strings.ToLower(strings.TrimSpace(a.Seniority))
+	a.SalaryCurrency = strings.ToUpper(strings.TrimSpace(a.SalaryCurrency))
+	if a.Headcount == 0 {
+		a.Headcount = 1
+	}
+	return a
+}
+
+// isCurrencyCode accepts the shape of an ISO 4217 code (three Latin
+// letters, upper-case after normalization); the list itself is not
+// hard-coded.
+func isCurrencyCode(code string) bool {
+	if len(code) != 3 {
+		return false
+	}
+	for _, r := range code {
+		if r < 'A' || r > 'Z' {
+			return false
+		}
+	}
+	return true
+}
+
+// validateSkill holds the per-skill rules shared by Create/Update and
+// SuggestSkills, which drops LLM suggestions that fail them.
+func validateSkill(s domain.SkillWeight) error {
+	if strings.TrimSpace(s.Name) == "" {
+		return fmt.Errorf("%w: skill name is required", ErrInvalidArgument)
+	}
+	if s.Weight < 0 || s.Weight > 1 {
+		return fmt.Errorf("%w: weight of skill %q must be between 0 and 1", ErrInvalidArgument, s.Name)
+	}
+	return nil
+}
+
+// normalizeSkills canonicalizes skill names through the taxonomy ("golang"
+// → "Go", "k8s" → "Kubernetes"), drops later duplicates that collapse onto
+// the same canonical skill (the first keeps its weight and flags), and
+// spreads weights evenly when none is positive.
+func normalizeSkills(skills []domain.SkillWeight, taxonomy *domain.SkillTaxonomy) []domain.SkillWeight {
 	if len(skills) == 0 {
 		return skills
 	}
 
+	canonical := make([]domain.SkillWeight, 0, len(skills))
+	seen := make(map[string]struct{}, len(skills))
+	for _, s := range skills {
+		s.Name = taxonomy.Canonical(s.Name)
+		// Blank names are left for validation to reject.
+		if key := domain.NormalizeSkillKey(s.Name); key != "" {
+			if _, dup := seen[key]; dup {
+				continue
+			}
+			seen[key] = struct{}{}
+		}
+		canonical = append(canonical, s)
+	}
+	skills = canonical
+
 	hasPositive := false
 	for _, s := range skills {
 		if s.Weight > 0 {
@@ -1,6 +1,8 @@
 package usecase
 
 import (
+	"math"
+	"strings"
 	"testing"
 
 	"gotest.tools/v3/assert"
@@ -69,8 +71,119 @@ func TestNormalizeSkills(t *testing.T) {
 
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
-			got := normalizeSkills(tt.in)
+			got := normalizeSkills(tt.in, nil)
 			assert.DeepEqual(t, got, tt.want)
 		})
 	}
 }
+
+func TestNormalizeSkillsCanonicalizes(t *testing.T) {
+	taxonomy := domain.NewSkillTaxonomy([]domain.SkillEntry{
+		{Name: "Go", Aliases: []string{"golang"}},
+		{Name: "Kubernetes", Aliases: []string{"k8s", "кубернетес"}},
+	})
+
+	got := normalizeSkills([]domain.SkillWeight{
+		{Name: " golang ", Weight: 0.6, MustHave: true},
+		{Name: "K8S", Weight: 0.3},
+		{Name: "Go", Weight: 0.1, NiceToHave: true},
+		{Name: "Terraform", Weight: 0.1},
+	}, taxonomy)
+
+	assert.DeepEqual(t, got, []domain.SkillWeight{
+		{Name: "Go", Weight: 0.6, MustHave: true},
+		{Name: "Kubernetes", Weight: 0.3},
+		{Name: "Terraform", Weight: 0.1},
+	})
+}
+
+func TestNormalizeAttributes(t *testing.T) {
+	got := normalizeAttributes(domain.VacancyAttributes{
+		Location:       "  Москва ",
+		RemotePolicy:   " Hybrid",
+		SalaryMin:      200000,
+		SalaryCurrency: "rub ",
+		EmploymentType: "FULL_TIME",
+		Seniority:      "Senior",
+	})
+
+	assert.DeepEqual(t, got, domain.VacancyAttributes{
+		Location:       "Москва",
+		RemotePolicy:   domain.RemoteHybrid,
+		SalaryMin:      200000,
+		SalaryCurrency: "RUB",
+		EmploymentType: domain.EmploymentFullTime,
+		Seniority:      domain.SenioritySenior,
+		Headcount:      1,
+	})
+}
+
+func TestValidateAttributes(t *testing.T) {
+	valid := domain.VacancyAttributes{
+		Location:       "Москва",
+		RemotePolicy:   domain.RemoteFull,
+		SalaryMin:      100,
+		SalaryMax:      200,
+		SalaryCurrency: "USD",
+		EmploymentType: domain.EmploymentContract,
+		Seniority:      domain.SeniorityLead,
+		Headcount:      3,
+	}
+	assert.NilError(t, validateAttributes(valid))
+	assert.NilError(t, validateAttributes(domain.VacancyAttributes{Headcount: 1}))
+
+	tests := []struct {
+		name   string
+		mutate func(a *domain.VacancyAttributes)
+	}{
+		{"location too long", func(a *domain.VacancyAttributes) { a.Location = strings.Repeat("я", maxLocationRunes+1) }},
+		{"unknown remote policy", func(a *domain.VacancyAttributes) { a.RemotePolicy = "sometimes" }},
+		{"unknown employment type", func(a *domain.VacancyAttributes) { a.EmploymentType = "gig" }},
+		{"unknown seniority", func(a *domain.VacancyAttributes) { a.Seniority = "principal" }},
+		{"inverted salary range", func(a *domain.VacancyAttributes) { a.SalaryMin, a.SalaryMax = 300, 200 }},
+		{"salary without currency", func(a *domain.VacancyAttributes) { a.SalaryCurrency = "" }},
+		{"malformed currency", func(a *domain.VacancyAttributes) { a.SalaryCurrency = "US$" }},
+		{"salary out of range", func(a *domain.VacancyAttributes) { a.SalaryMin, a.SalaryMax = 0, math.MaxUint64 }},
+		{"zero headcount", func(a *domain.VacancyAttributes) { a.Headcount = 0 }},
+		{"headcount too large", func(a *domain.VacancyAttributes) { a.Headcount = maxHeadcount + 1 }},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			a := valid
+			tt.mutate(&a)
+			assert.ErrorIs(t, validateAttributes(a), ErrInvalidArgument)
+		})
+	}
+}
+
+func TestValidateScoringPolicy(t *testing.T) {
+	assert.NilError(t, validateScoringPolicy(domain.DefaultScoringPolicy()))
+	assert.NilError(t, validateScoringPolicy(domain.ScoringPolicy{HireThreshold: 60, MaybeThreshold: 60}))
+
+	tests := []struct {
+		name   string
+		mutate func(p *domain.ScoringPolicy)
+	}{
+		{"negative penalty", func(p *domain.ScoringPolicy) { p.MustHavePenalty = -1 }},
+		{"penalty above scale", func(p *domain.ScoringPolicy) { p.MustHavePenalty = 101 }},
+		{"negative bonus", func(p *domain.ScoringPolicy) { p.NiceToHaveBonus = -1 }},
+		{"bonus above scale", func(p *domain.ScoringPolicy) { p.NiceToHaveBonus = 101 }},
+		{"zero hire threshold", func(p *domain.ScoringPolicy) { p.HireThreshold, p.MaybeThreshold = 0, 0 }},
+		{"hire threshold above scale", func(p *domain.ScoringPolicy) { p.HireThreshold = 101 }},
+		{"zero maybe threshold", func(p *domain.ScoringPolicy) { p.MaybeThreshold = 0 }},
+		{"maybe above hire", func(p *domain.ScoringPolicy) { p.MaybeThreshold = p.HireThreshold + 1 }},
+		{"NaN penalty", func(p *domain.ScoringPolicy) { p.MustHavePenalty = float32(math.NaN()) }},
+		{"NaN bonus", func(p *domain.ScoringPolicy) { p.NiceToHaveBonus = float32(math.NaN()) }},
+		{"NaN hire threshold", func(p *domain.ScoringPolicy) { p.HireThreshold = float32(math.NaN()) }},
+		{"NaN maybe threshold", func(p *domain.ScoringPolicy) { p.MaybeThreshold = float32(math.NaN()) }},
+		{"infinite hire threshold", func(p *domain.ScoringPolicy) { p.HireThreshold = float32(math.Inf(1)) }},
+		{"negative infinite bonus", func(p *domain.ScoringPolicy) { p.NiceToHaveBonus = float32(math.Inf(-1)) }},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			p := domain.DefaultScoringPolicy()
+			tt.mutate(&p)
+			assert.ErrorIs(t, validateScoringPolicy(p), ErrInvalidArgument)
+		})
+	}
+}
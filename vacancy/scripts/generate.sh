@@ -11,7 +11,7 @@ protoc -I ./api \
   -I "${GATEWAY_PATH}" \
   --go_out=./internal/pb --go_opt=paths=source_relative \
   --go-grpc_out=./internal/pb --go-grpc_opt=paths=source_relative \
-  ./api/common/common.proto ./api/models/vacancy_model.proto ./api/vacancy_api/vacancy.proto ./api/auth_api/auth.proto ./api/multiagent_api/multiagent.proto
+  ./api/common/common.proto ./api/models/vacancy_model.proto ./api/vacancy_api/vacancy.proto ./api/auth_api/auth.proto ./api/multiagent_api/multiagent.proto ./api/vacancy_events_api/vacancy_events.proto ./api/resume_api/resume.proto
 
 protoc -I ./api \
   -I ./api/google/api \